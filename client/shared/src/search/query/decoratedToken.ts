@@ -923,8 +923,17 @@ const decorateContainsBody = (body: string, offset: number): DecoratedToken[] |
 /**
  * Decorates the body part of predicate syntax `name(body)`.
  */
-const decoratePredicateBody = (path: string[], body: string, offset: number): DecoratedToken[] => {
+const decoratePredicateBody = (field: string, path: string[], body: string, offset: number): DecoratedToken[] => {
     const decorated: DecoratedToken[] = []
+    if (field === 'file') {
+        // file:contains(...) takes a regular expression.
+        return mapRegexpMetaSucceed({
+            type: 'pattern',
+            range: { start: offset, end: body.length },
+            value: body,
+            kind: PatternKind.Regexp,
+        })
+    }
     switch (path.join('.')) {
         case 'contains':
             // eslint-disable-next-line no-case-declarations
@@ -935,6 +944,8 @@ const decoratePredicateBody = (path: string[], body: string, offset: number): De
             break
         case 'contains.file':
         case 'contains.content':
+        case 'has.file':
+        case 'has.content':
             return mapRegexpMetaSucceed({
                 type: 'pattern',
                 range: { start: offset, end: body.length },
@@ -983,7 +994,7 @@ const decoratePredicate = (predicate: Predicate, range: CharacterRange): Decorat
         value: predicate,
     })
     offset = offset + 1
-    decorated.push(...decoratePredicateBody(predicate.path, body, offset))
+    decorated.push(...decoratePredicateBody(predicate.field, predicate.path, body, offset))
     offset = offset + body.length
     decorated.push({
         type: 'metaPredicate',
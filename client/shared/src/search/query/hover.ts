@@ -157,6 +157,9 @@ const toSelectorHover = (token: MetaSelector): string => {
 
 const toContainsHover = (token: MetaPredicate): string => {
     const parameters = token.value.parameters.slice(1, -1)
+    if (token.value.field === 'file') {
+        return `**Built-in predicate**. Search only inside files whose **content** matches the regular expression \`${parameters}\`.`
+    }
     switch (token.value.path.join('.')) {
         case 'contains':
             return '**Built-in predicate**. Search only inside repositories that satisfy the specified `file:` and `content:` filters. `file:` and `content:` filters should be regular expressions.'
@@ -166,6 +169,10 @@ const toContainsHover = (token: MetaPredicate): string => {
             return `**Built-in predicate**. Search only inside repositories that contain **file content** matching the regular expression \`${parameters}\`.`
         case 'contains.commit.after':
             return `**Built-in predicate**. Search only inside repositories that have been committed to since \`${parameters}\`.`
+        case 'has.file':
+            return `**Built-in predicate**. Search only inside repositories that have a **file path** matching the regular expression \`${parameters}\`.`
+        case 'has.content':
+            return `**Built-in predicate**. Search only inside repositories that have **file content** matching the regular expression \`${parameters}\`.`
     }
     return ''
 }
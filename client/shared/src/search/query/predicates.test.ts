@@ -8,19 +8,25 @@ expect.addSnapshotSerializer({
 describe('scanPredicate', () => {
     test('scan recognized and valid syntax', () => {
         expect(scanPredicate('repo', 'contains(stuff)')).toMatchInlineSnapshot(
-            '{"path":["contains"],"parameters":"(stuff)"}'
+            '{"field":"repo","path":["contains"],"parameters":"(stuff)"}'
         )
     })
 
     test('scan recognized dot syntax', () => {
         expect(scanPredicate('repo', 'contains.commit.after(stuff)')).toMatchInlineSnapshot(
-            '{"path":["contains","commit","after"],"parameters":"(stuff)"}'
+            '{"field":"repo","path":["contains","commit","after"],"parameters":"(stuff)"}'
         )
     })
 
     test('scan recognized and valid syntax with escapes', () => {
         expect(scanPredicate('repo', 'contains(\\((stuff))')).toMatchInlineSnapshot(
-            '{"path":["contains"],"parameters":"(\\\\((stuff))"}'
+            '{"field":"repo","path":["contains"],"parameters":"(\\\\((stuff))"}'
+        )
+    })
+
+    test('scan file predicate', () => {
+        expect(scanPredicate('f', 'contains(import "fmt")')).toMatchInlineSnapshot(
+            '{"field":"file","path":["contains"],"parameters":"(import \\"fmt\\")"}'
         )
     })
 
@@ -38,7 +44,7 @@ describe('scanPredicate', () => {
 
     test('resolve field aliases for predicates', () => {
         expect(scanPredicate('r', 'contains.file(stuff)')).toMatchInlineSnapshot(
-            '{"path":["contains","file"],"parameters":"(stuff)"}'
+            '{"field":"repo","path":["contains","file"],"parameters":"(stuff)"}'
         )
     })
 })
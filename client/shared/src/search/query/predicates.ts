@@ -26,12 +26,21 @@ export const PREDICATES: Access[] = [
                     },
                 ],
             },
+            {
+                name: 'has',
+                fields: [{ name: 'file' }, { name: 'content' }],
+            },
         ],
     },
+    {
+        name: 'file',
+        fields: [{ name: 'contains' }],
+    },
 ]
 
-/** Represents a predicate's components corresponding to the syntax path(parameters). */
+/** Represents a predicate's components corresponding to the syntax field:path(parameters). */
 export interface Predicate {
+    field: string
     path: string[]
     parameters: string
 }
@@ -129,7 +138,7 @@ export const scanPredicate = (field: string, value: string): Predicate | undefin
         return undefined
     }
 
-    return { path, parameters }
+    return { field, path, parameters }
 }
 
 export const predicateCompletion = (field: string): Completion[] => {
@@ -155,6 +164,16 @@ export const predicateCompletion = (field: string): Completion[] => {
                 insertText: 'contains.commit.after(${1:1 month ago})',
                 asSnippet: true,
             },
+            {
+                label: 'has.file(...)',
+                insertText: 'has.file(${1:Dockerfile})',
+                asSnippet: true,
+            },
+            {
+                label: 'has.content(...)',
+                insertText: 'has.content(${1:TODO})',
+                asSnippet: true,
+            },
         ]
     }
     return []
@@ -25,6 +25,8 @@ export interface FileLineMatch {
     branches?: string[]
     version?: string
     lineMatches: LineMatch[]
+    /** Whether line matches were omitted or shortened to limit the size of the event. */
+    truncated?: boolean
 }
 
 interface LineMatch {
@@ -47,6 +49,9 @@ interface SymbolMatch {
     name: string
     containerName: string
     kind: SymbolKind
+    qualifiedName?: string
+    parents?: string[]
+    signature?: string
 }
 
 type MarkdownText = string
@@ -85,6 +90,12 @@ export interface Progress {
      */
     repositoriesCount?: number
 
+    /**
+     * How far the search has gotten through the repositories it searches. Is
+     * set once they are resolved.
+     */
+    repositories?: RepositoriesProgress
+
     // The number of non-overlapping matches. If skipped is non-empty, then
     // this is a lower bound.
     matchCount: number
@@ -101,10 +112,47 @@ export interface Progress {
      */
     skipped: Skipped[]
 
+    // Each repository that could not be searched, ordered by name. Only set on the final progress event.
+    skippedRepositories?: SkippedRepository[]
+
     // The URL of the trace for this query, if it exists.
     trace?: string
 }
 
+export interface SkippedRepository {
+    // The name of the repository.
+    name: string
+
+    // Why the repository was skipped.
+    reason: Skipped['reason']
+
+    // A message explaining why the repository was skipped.
+    message?: string
+
+    // A suggested query expression to search the repository on retry, eg a repo: filter.
+    suggested?: Skipped['suggested']
+}
+
+export interface RepositoriesProgress {
+    // The number of repositories being searched.
+    total: number
+
+    // The number of repositories that have been searched.
+    searched: number
+
+    // The number of repositories that could not be searched.
+    skipped: number
+
+    // The number of skipped repositories by reason.
+    skippedReasons?: Partial<Record<Skipped['reason'], number>>
+
+    // An estimate from 0 to 100 of how much of the search has completed.
+    percentComplete: number
+
+    // An estimate of the wall clock time in milliseconds until the search completes.
+    remainingMs?: number
+}
+
 export interface Skipped {
     /**
      * Why a document/shard/repository was skipped. We group counts by reason.
@@ -67,6 +67,7 @@ import {
     fetchSavedSearches,
     fetchRecentSearches,
     fetchRecentFileViews,
+    fetchRepoCollections,
     fetchAutoDefinedSearchContexts,
     fetchSearchContexts,
     convertVersionContextToSearchContext,
@@ -569,6 +570,7 @@ class ColdSourcegraphWebApp extends React.Component<SourcegraphWebAppProps, Sour
                                         fetchSavedSearches={fetchSavedSearches}
                                         fetchRecentSearches={fetchRecentSearches}
                                         fetchRecentFileViews={fetchRecentFileViews}
+                                        fetchRepoCollections={fetchRepoCollections}
                                         streamSearch={aggregateStreamingSearch}
                                         onUserExternalServicesOrRepositoriesUpdate={
                                             this.onUserExternalServicesOrRepositoriesUpdate
@@ -34,6 +34,11 @@ export const enterpriseRoutes: readonly LayoutRouteProps<{}>[] = [
         path: '/stats',
         render: lazyComponent(() => import('./search/stats/SearchStatsPage'), 'SearchStatsPage'),
     },
+    {
+        path: '/search/exports',
+        render: lazyComponent(() => import('./search/exports/SearchExportsPage'), 'SearchExportsPage'),
+        exact: true,
+    },
     {
         path: '/code-monitoring',
         render: lazyComponent(
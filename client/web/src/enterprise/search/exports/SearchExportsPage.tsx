@@ -0,0 +1,189 @@
+import * as H from 'history'
+import DownloadIcon from 'mdi-react/DownloadIcon'
+import TimerSandIcon from 'mdi-react/TimerSandIcon'
+import React, { useCallback, useEffect, useMemo, useState } from 'react'
+import { timer } from 'rxjs'
+import { catchError, concatMap, takeWhile } from 'rxjs/operators'
+
+import { Form } from '@sourcegraph/branded/src/components/Form'
+import { LoadingSpinner } from '@sourcegraph/react-loading-spinner'
+import { TelemetryProps } from '@sourcegraph/shared/src/telemetry/telemetryService'
+import { asError, ErrorLike, isErrorLike } from '@sourcegraph/shared/src/util/errors'
+import { useObservable } from '@sourcegraph/shared/src/util/useObservable'
+import { Container, PageHeader } from '@sourcegraph/wildcard'
+
+import { ErrorAlert } from '../../../components/alerts'
+import { PageTitle } from '../../../components/PageTitle'
+import { Timestamp } from '../../../components/time/Timestamp'
+import { SearchExportFields, SearchExportFormat, SearchExportState, SearchPatternType } from '../../../graphql-operations'
+
+import { createSearchExport as defaultCreateSearchExport, fetchSearchExports as defaultFetchSearchExports } from './backend'
+
+interface Props extends TelemetryProps {
+    location: H.Location
+    history: H.History
+
+    /** Mockable in tests. */
+    createSearchExport?: typeof defaultCreateSearchExport
+    fetchSearchExports?: typeof defaultFetchSearchExports
+}
+
+const REFRESH_INTERVAL_MS = 5000
+
+const pendingStates = new Set([SearchExportState.QUEUED, SearchExportState.PROCESSING, SearchExportState.ERRORED])
+
+const classNamesByState = new Map([
+    [SearchExportState.COMPLETED, 'badge-success'],
+    [SearchExportState.ERRORED, 'badge-warning'],
+    [SearchExportState.FAILED, 'badge-danger'],
+])
+
+/**
+ * Runs search queries in the background, without the result limits of interactive searches, and lists the
+ * results of previous background searches for download.
+ */
+export const SearchExportsPage: React.FunctionComponent<Props> = ({
+    location,
+    history,
+    telemetryService,
+    createSearchExport = defaultCreateSearchExport,
+    fetchSearchExports = defaultFetchSearchExports,
+}) => {
+    useEffect(() => telemetryService.logViewEvent('SearchExports'), [telemetryService])
+
+    const parameters = new URLSearchParams(location.search)
+    const patternType = (parameters.get('patternType') as SearchPatternType | null) || null
+    const [query, setQuery] = useState(parameters.get('q') || '')
+    const [format, setFormat] = useState(SearchExportFormat.CSV)
+    const onQueryChange = useCallback<React.ChangeEventHandler<HTMLInputElement>>(event => {
+        setQuery(event.currentTarget.value)
+    }, [])
+    const onFormatChange = useCallback<React.ChangeEventHandler<HTMLSelectElement>>(event => {
+        setFormat(event.currentTarget.value as SearchExportFormat)
+    }, [])
+
+    // Incremented to restart polling after a new export is created.
+    const [refreshes, setRefreshes] = useState(0)
+    const [creation, setCreation] = useState<'loading' | ErrorLike>()
+    const onSubmit = useCallback<React.FormEventHandler<HTMLFormElement>>(
+        async event => {
+            event.preventDefault()
+            setCreation('loading')
+            try {
+                await createSearchExport({ query, patternType, format }).toPromise()
+                setCreation(undefined)
+                setRefreshes(refreshes => refreshes + 1)
+                history.replace({ ...location, search: '' })
+            } catch (error) {
+                setCreation(asError(error))
+            }
+        },
+        [createSearchExport, query, patternType, format, history, location]
+    )
+
+    const exportsOrError = useObservable(
+        useMemo(
+            () =>
+                timer(0, REFRESH_INTERVAL_MS).pipe(
+                    concatMap(() =>
+                        fetchSearchExports({ first: 20 }).pipe(catchError((error): [ErrorLike] => [asError(error)]))
+                    ),
+                    takeWhile(shouldReload, true)
+                ),
+            // Polling stops once all exports have finished, so it has to be restarted on refresh.
+            // eslint-disable-next-line react-hooks/exhaustive-deps
+            [fetchSearchExports, refreshes]
+        )
+    )
+
+    return (
+        <div className="container mt-4">
+            <PageTitle title="Background searches" />
+            <PageHeader
+                path={[{ icon: TimerSandIcon, text: 'Background searches' }]}
+                description="Run a search over all matching repositories without the result limits of interactive searches, and download all of its results once it completes. Results are kept for a day."
+                className="mb-3"
+            />
+            <Form onSubmit={onSubmit} className="form">
+                <div className="form-group d-flex align-items-stretch">
+                    <input
+                        className="form-control flex-1 test-search-export-query"
+                        type="search"
+                        placeholder="Enter a Sourcegraph search query"
+                        value={query}
+                        onChange={onQueryChange}
+                        autoCapitalize="off"
+                        spellCheck={false}
+                        autoCorrect="off"
+                        autoComplete="off"
+                    />
+                    <select
+                        className="form-control w-auto ml-2"
+                        aria-label="Format"
+                        value={format}
+                        onChange={onFormatChange}
+                    >
+                        <option value={SearchExportFormat.CSV}>CSV</option>
+                        <option value={SearchExportFormat.JSONL}>JSON lines</option>
+                    </select>
+                    <button
+                        type="submit"
+                        className="btn btn-primary ml-2 test-search-export-submit"
+                        disabled={query.trim() === '' || creation === 'loading'}
+                    >
+                        Run in background
+                    </button>
+                </div>
+            </Form>
+            {isErrorLike(creation) && <ErrorAlert error={creation} />}
+            <Container>
+                {exportsOrError === undefined ? (
+                    <LoadingSpinner className="icon-inline" />
+                ) : isErrorLike(exportsOrError) ? (
+                    <ErrorAlert error={exportsOrError} />
+                ) : exportsOrError.length === 0 ? (
+                    <p className="text-muted mb-0">No background searches yet.</p>
+                ) : (
+                    <ul className="list-group list-group-flush">
+                        {exportsOrError.map(searchExport => (
+                            <SearchExportNode key={searchExport.id} node={searchExport} />
+                        ))}
+                    </ul>
+                )}
+            </Container>
+        </div>
+    )
+}
+
+const SearchExportNode: React.FunctionComponent<{ node: SearchExportFields }> = ({ node }) => (
+    <li className="list-group-item px-0">
+        <div className="d-flex align-items-center justify-content-between">
+            <code className="text-break">{node.query}</code>
+            <span className={`badge ${classNamesByState.get(node.state) ?? 'badge-secondary'} text-uppercase ml-2`}>
+                {node.state}
+            </span>
+        </div>
+        <small className="text-muted">
+            Started <Timestamp date={node.createdAt} />
+            {node.finishedAt && (
+                <>
+                    , finished <Timestamp date={node.finishedAt} />
+                </>
+            )}{' '}
+            &middot; {node.resultCount} {node.resultCount === 1 ? 'result' : 'results'}
+        </small>
+        {node.failure && <div className="text-danger small">{node.failure}</div>}
+        {node.downloadURL && (
+            <div>
+                <a href={node.downloadURL} className="btn btn-sm btn-link px-0" download={true}>
+                    <DownloadIcon className="icon-inline mr-1" />
+                    Download {node.format === SearchExportFormat.CSV ? 'CSV' : 'JSON lines'}
+                </a>
+            </div>
+        )}
+    </li>
+)
+
+function shouldReload(exportsOrError: SearchExportFields[] | ErrorLike): boolean {
+    return !isErrorLike(exportsOrError) && exportsOrError.some(node => pendingStates.has(node.state))
+}
@@ -0,0 +1,65 @@
+import { Observable } from 'rxjs'
+import { map } from 'rxjs/operators'
+
+import { dataOrThrowErrors, gql } from '@sourcegraph/shared/src/graphql/graphql'
+
+import { requestGraphQL } from '../../../backend/graphql'
+import {
+    CreateSearchExportResult,
+    CreateSearchExportVariables,
+    SearchExportFields,
+    SearchExportsResult,
+    SearchExportsVariables,
+} from '../../../graphql-operations'
+
+const searchExportFieldsFragment = gql`
+    fragment SearchExportFields on SearchExport {
+        id
+        query
+        format
+        state
+        failure
+        resultCount
+        createdAt
+        finishedAt
+        downloadURL
+    }
+`
+
+export function createSearchExport(args: CreateSearchExportVariables): Observable<SearchExportFields> {
+    return requestGraphQL<CreateSearchExportResult, CreateSearchExportVariables>(
+        gql`
+            mutation CreateSearchExport($query: String!, $patternType: SearchPatternType, $format: SearchExportFormat!) {
+                createSearchExport(query: $query, patternType: $patternType, format: $format) {
+                    ...SearchExportFields
+                }
+            }
+
+            ${searchExportFieldsFragment}
+        `,
+        args
+    ).pipe(
+        map(dataOrThrowErrors),
+        map(data => data.createSearchExport)
+    )
+}
+
+export function fetchSearchExports(args: SearchExportsVariables): Observable<SearchExportFields[]> {
+    return requestGraphQL<SearchExportsResult, SearchExportsVariables>(
+        gql`
+            query SearchExports($first: Int) {
+                searchExports(first: $first) {
+                    nodes {
+                        ...SearchExportFields
+                    }
+                }
+            }
+
+            ${searchExportFieldsFragment}
+        `,
+        args
+    ).pipe(
+        map(dataOrThrowErrors),
+        map(data => data.searchExports.nodes)
+    )
+}
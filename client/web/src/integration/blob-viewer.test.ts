@@ -906,35 +906,13 @@ describe('Blob viewer', () => {
                 }),
                 TreeEntries: () => createTreeEntriesResult(repositorySourcegraphUrl, files),
                 ResolveRev: () => createResolveRevisionResult(repositorySourcegraphUrl, commitID),
-                HighlightedFile: ({ filePath }) => ({
+                HighlightedFile: () => ({
                     repository: {
                         commit: {
                             file: {
                                 isDirectory: false,
                                 richHTML: '',
-                                highlight: {
-                                    aborted: false,
-                                    html:
-                                        '<table><tbody><tr><td class="line" data-line="1"/><td class="code">' +
-                                        '<span class="hl-source hl-js hl-react"><span class="hl-comment hl-line hl-double-slash hl-js">' +
-                                        '<span class="hl-punctuation hl-definition hl-comment hl-js">//</span>' +
-                                        ` file path: ${filePath}\n` +
-                                        '</span></span></td></tr>' +
-                                        '<tr><td class="line" data-line="2"/>' +
-                                        '<td class="code"><span class="hl-source hl-js hl-react">' +
-                                        '<span class="hl-meta hl-function-call hl-method hl-js">' +
-                                        '<span class="hl-support hl-type hl-object hl-console hl-js test-console-token">console</span>' +
-                                        '<span class="hl-punctuation hl-accessor hl-js">.</span>' +
-                                        '<span class="hl-support hl-function hl-console hl-js test-log-token">log</span>' +
-                                        '<span class="hl-meta hl-group hl-js"><span class="hl-punctuation hl-section hl-group hl-begin hl-js">(</span>' +
-                                        '<span class="hl-meta hl-string hl-js"><span class="hl-string hl-quoted hl-double hl-js">' +
-                                        '<span class="hl-punctuation hl-definition hl-string hl-begin hl-js">&quot;</span>' +
-                                        'Hello world' +
-                                        '<span class="hl-punctuation hl-definition hl-string hl-end hl-js">&quot;</span></span></span>' +
-                                        '<span class="hl-punctuation hl-section hl-group hl-end hl-js">)</span></span>' +
-                                        '\n</span></span></td></tr></tbody></table>',
-                                    lineRanges: [],
-                                },
+                                highlightedLineRanges: [],
                             },
                         },
                     },
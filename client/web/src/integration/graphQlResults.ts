@@ -135,6 +135,9 @@ export const commonWebGraphQlResults: Partial<WebGraphQlOperations & SharedGraph
     savedSearches: () => ({
         savedSearches: [],
     }),
+    RepoCollections: () => ({
+        repoCollections: [],
+    }),
     LogEvent: () => ({
         logEvent: {
             alwaysNil: null,
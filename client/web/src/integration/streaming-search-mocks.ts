@@ -227,91 +227,88 @@ export const highlightFileResult: Partial<WebGraphQlOperations> = {
                           file: {
                               isDirectory: false,
                               richHTML: '',
-                              highlight: {
-                                  aborted: false,
-                                  lineRanges: [
-                                      [
-                                          '<tr><td class="line" data-line="1"></td><td class="code"><div><span style="color:#cb4b16;">import </span><span style="color:#657b83;">{ </span><span style="color:#268bd2;">index </span><span style="color:#657b83;">} </span><span style="color:#cb4b16;">from </span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">./index</span><span style="color:#839496;">&#39;\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="2"></td><td class="code"><div><span style="color:#cb4b16;">import </span><span style="color:#657b83;">{ </span><span style="color:#268bd2;">Edge</span><span style="color:#657b83;">, </span><span style="color:#268bd2;">Vertex </span><span style="color:#657b83;">} </span><span style="color:#cb4b16;">from </span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">lsif-protocol</span><span style="color:#839496;">&#39;\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="3"></td><td class="code"><div><span style="color:#cb4b16;">import </span><span style="color:#268bd2;">_ </span><span style="color:#cb4b16;">from </span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">lodash</span><span style="color:#839496;">&#39;\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="4"></td><td class="code"><div><span style="color:#cb4b16;">import </span><span style="color:#b58900;">* </span><span style="color:#cb4b16;">as </span><span style="color:#268bd2;">path </span><span style="color:#cb4b16;">from </span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">path</span><span style="color:#839496;">&#39;\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="5"></td><td class="code"><div><span style="color:#cb4b16;">import </span><span style="color:#b58900;">* </span><span style="color:#cb4b16;">as </span><span style="color:#268bd2;">cp </span><span style="color:#cb4b16;">from </span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">child_process</span><span style="color:#839496;">&#39;\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="6"></td><td class="code"><div><span style="color:#657b83;">\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="7"></td><td class="code"><div><span style="color:#268bd2;">const GENERATE </span><span style="color:#657b83;">= </span><span style="color:#b58900;">false\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="8"></td><td class="code"><div><span style="color:#657b83;">\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="9"></td><td class="code"><div><span style="color:#268bd2;">function </span><span style="color:#b58900;">generate</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">example</span><span style="color:#859900;">: string</span><span style="color:#657b83;">)</span><span style="color:#859900;">: void </span><span style="color:#657b83;">{\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="10"></td><td class="code"><div><span style="color:#657b83;">    </span><span style="color:#268bd2;">cp</span><span style="color:#657b83;">.</span><span style="color:#b58900;">execFileSync</span><span style="color:#657b83;">(</span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">./generate-csv</span><span style="color:#839496;">&#39;</span><span style="color:#657b83;">, </span><span style="color:#268bd2;">[</span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">$CXX -c *.cpp</span><span style="color:#839496;">&#39;</span><span style="color:#268bd2;">]</span><span style="color:#657b83;">, {\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="11"></td><td class="code"><div><span style="color:#657b83;">        env: {\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="12"></td><td class="code"><div><span style="color:#657b83;">            ABSROOTDIR: </span><span style="color:#268bd2;">path</span><span style="color:#657b83;">.</span><span style="color:#859900;">resolve</span><span style="color:#657b83;">(</span><span style="color:#839496;">`</span><span style="color:#2aa198;">examples/${</span><span style="color:#268bd2;">example</span><span style="color:#2aa198;">}/root</span><span style="color:#839496;">`</span><span style="color:#657b83;">),\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="13"></td><td class="code"><div><span style="color:#657b83;">            ABSOUTDIR: </span><span style="color:#268bd2;">path</span><span style="color:#657b83;">.</span><span style="color:#859900;">resolve</span><span style="color:#657b83;">(</span><span style="color:#839496;">`</span><span style="color:#2aa198;">examples/${</span><span style="color:#268bd2;">example</span><span style="color:#2aa198;">}/output</span><span style="color:#839496;">`</span><span style="color:#657b83;">),\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="14"></td><td class="code"><div><span style="color:#657b83;">            CLEAN: </span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">true</span><span style="color:#839496;">&#39;</span><span style="color:#657b83;">,\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="15"></td><td class="code"><div><span style="color:#657b83;">        },\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="16"></td><td class="code"><div><span style="color:#657b83;">    })\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="17"></td><td class="code"><div><span style="color:#657b83;">}\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="18"></td><td class="code"><div><span style="color:#657b83;">\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="19"></td><td class="code"><div><span style="color:#586e75;">async </span><span style="color:#268bd2;">function </span><span style="color:#b58900;">indexExample</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">example</span><span style="color:#859900;">: string</span><span style="color:#657b83;">)</span><span style="color:#859900;">: </span><span style="color:#b58900;">Promise</span><span style="color:#657b83;">&lt;(</span><span style="color:#b58900;">Edge </span><span style="color:#859900;">| </span><span style="color:#b58900;">Vertex</span><span style="color:#657b83;">)</span><span style="color:#268bd2;">[]</span><span style="color:#657b83;">&gt; {\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="20"></td><td class="code"><div><span style="color:#657b83;">    </span><span style="color:#859900;">if </span><span style="color:#657b83;">(</span><span style="color:#268bd2;">GENERATE</span><span style="color:#657b83;">) {\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="21"></td><td class="code"><div><span style="color:#657b83;">        </span><span style="color:#b58900;">generate</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">example</span><span style="color:#657b83;">)\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="22"></td><td class="code"><div><span style="color:#657b83;">    }\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="23"></td><td class="code"><div><span style="color:#657b83;">\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="24"></td><td class="code"><div><span style="color:#657b83;">    </span><span style="color:#268bd2;">const output</span><span style="color:#859900;">: </span><span style="color:#657b83;">(</span><span style="color:#b58900;">Edge </span><span style="color:#859900;">| </span><span style="color:#b58900;">Vertex</span><span style="color:#657b83;">)</span><span style="color:#268bd2;">[] </span><span style="color:#657b83;">= </span><span style="color:#268bd2;">[]\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="25"></td><td class="code"><div><span style="color:#657b83;">\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="26"></td><td class="code"><div><span style="color:#657b83;">    </span><span style="color:#859900;">await </span><span style="color:#b58900;">index</span><span style="color:#657b83;">({\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="27"></td><td class="code"><div><span style="color:#657b83;">        csvFileGlob: </span><span style="color:#839496;">`</span><span style="color:#2aa198;">examples/${</span><span style="color:#268bd2;">example</span><span style="color:#2aa198;">}/output/*.csv</span><span style="color:#839496;">`</span><span style="color:#657b83;">,\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="28"></td><td class="code"><div><span style="color:#657b83;">        root: </span><span style="color:#839496;">`</span><span style="color:#2aa198;">examples/${</span><span style="color:#268bd2;">example</span><span style="color:#2aa198;">}/root</span><span style="color:#839496;">`</span><span style="color:#657b83;">,\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="29"></td><td class="code"><div><span style="color:#657b83;">        </span><span style="color:#b58900;">emit</span><span style="color:#657b83;">: </span><span style="color:#268bd2;">item =&gt;\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="30"></td><td class="code"><div><span style="color:#657b83;">            </span><span style="color:#859900;">new </span><span style="color:#b58900;">Promise</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">resolve =&gt; </span><span style="color:#657b83;">{\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="31"></td><td class="code"><div><span style="color:#657b83;">                </span><span style="color:#268bd2;">output</span><span style="color:#657b83;">.</span><span style="color:#859900;">push</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">item</span><span style="color:#657b83;">)\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="32"></td><td class="code"><div><span style="color:#657b83;">                </span><span style="color:#b58900;">resolve</span><span style="color:#657b83;">()\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="33"></td><td class="code"><div><span style="color:#657b83;">            }),\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="34"></td><td class="code"><div><span style="color:#657b83;">    })\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="35"></td><td class="code"><div><span style="color:#657b83;">\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="36"></td><td class="code"><div><span style="color:#657b83;">    </span><span style="color:#859900;">return </span><span style="color:#268bd2;">output\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="37"></td><td class="code"><div><span style="color:#657b83;">}\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="38"></td><td class="code"><div><span style="color:#657b83;">\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="39"></td><td class="code"><div><span style="color:#b58900;">test</span><span style="color:#657b83;">(</span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">does not emit items with duplicate IDs</span><span style="color:#839496;">&#39;</span><span style="color:#657b83;">, </span><span style="color:#586e75;">async </span><span style="color:#657b83;">() </span><span style="color:#268bd2;">=&gt; </span><span style="color:#657b83;">{\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="40"></td><td class="code"><div><span style="color:#657b83;">    </span><span style="color:#268bd2;">const output </span><span style="color:#657b83;">= </span><span style="color:#859900;">await </span><span style="color:#b58900;">indexExample</span><span style="color:#657b83;">(</span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">five</span><span style="color:#839496;">&#39;</span><span style="color:#657b83;">)\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="41"></td><td class="code"><div><span style="color:#657b83;">\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="42"></td><td class="code"><div><span style="color:#657b83;">    </span><span style="color:#268bd2;">const setsOfDupes </span><span style="color:#657b83;">= </span><span style="color:#b58900;">_</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">output</span><span style="color:#657b83;">)\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="43"></td><td class="code"><div><span style="color:#657b83;">        .</span><span style="color:#b58900;">groupBy</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">item =&gt; item</span><span style="color:#657b83;">.</span><span style="color:#859900;">id</span><span style="color:#657b83;">)\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="44"></td><td class="code"><div><span style="color:#657b83;">        .</span><span style="color:#859900;">values</span><span style="color:#657b83;">()\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="45"></td><td class="code"><div><span style="color:#657b83;">        .</span><span style="color:#b58900;">map</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">group =&gt; </span><span style="color:#657b83;">({ </span><span style="color:#268bd2;">group</span><span style="color:#657b83;">, count: </span><span style="color:#268bd2;">group</span><span style="color:#657b83;">.</span><span style="color:#859900;">length </span><span style="color:#657b83;">}))\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="46"></td><td class="code"><div><span style="color:#657b83;">        .</span><span style="color:#b58900;">value</span><span style="color:#657b83;">()\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="47"></td><td class="code"><div><span style="color:#657b83;">        .</span><span style="color:#b58900;">filter</span><span style="color:#657b83;">(({ </span><span style="color:#268bd2;">count </span><span style="color:#657b83;">}) </span><span style="color:#268bd2;">=&gt; count </span><span style="color:#859900;">&gt; </span><span style="color:#6c71c4;">1</span><span style="color:#657b83;">)\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="48"></td><td class="code"><div><span style="color:#657b83;">        .</span><span style="color:#b58900;">map</span><span style="color:#657b83;">(({ </span><span style="color:#268bd2;">group </span><span style="color:#657b83;">}) </span><span style="color:#268bd2;">=&gt; group</span><span style="color:#657b83;">)\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="49"></td><td class="code"><div><span style="color:#657b83;">\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="50"></td><td class="code"><div><span style="color:#657b83;">    </span><span style="color:#859900;">if </span><span style="color:#657b83;">(</span><span style="color:#268bd2;">setsOfDupes</span><span style="color:#657b83;">.</span><span style="color:#859900;">length &gt; </span><span style="color:#6c71c4;">0</span><span style="color:#657b83;">) {\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="51"></td><td class="code"><div><span style="color:#657b83;">        </span><span style="color:#b58900;">fail</span><span style="color:#657b83;">(\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="52"></td><td class="code"><div><span style="color:#657b83;">            </span><span style="color:#859900;">new </span><span style="color:#b58900;">Error</span><span style="color:#657b83;">(\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="53"></td><td class="code"><div><span style="color:#657b83;">                </span><span style="color:#839496;">`</span><span style="color:#2aa198;">Sets of lines with duplicate IDs:</span><span style="color:#dc322f;">\\n</span><span style="color:#839496;">` </span><span style="color:#657b83;">+\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="54"></td><td class="code"><div><span style="color:#657b83;">                    </span><span style="color:#268bd2;">setsOfDupes\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="55"></td><td class="code"><div><span style="color:#657b83;">                        .</span><span style="color:#b58900;">map</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">dupes =&gt;\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="56"></td><td class="code"><div><span style="color:#657b83;">                            </span><span style="color:#268bd2;">dupes</span><span style="color:#657b83;">.</span><span style="color:#b58900;">map</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">item =&gt; </span><span style="color:#859900;">JSON</span><span style="color:#657b83;">.</span><span style="color:#859900;">stringify</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">item</span><span style="color:#657b83;">)).</span><span style="color:#859900;">join</span><span style="color:#657b83;">(</span><span style="color:#839496;">&#39;</span><span style="color:#dc322f;">\\n</span><span style="color:#839496;">&#39;</span><span style="color:#657b83;">)\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="57"></td><td class="code"><div><span style="color:#657b83;">                        )\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="58"></td><td class="code"><div><span style="color:#657b83;">                        .</span><span style="color:#859900;">join</span><span style="color:#657b83;">(</span><span style="color:#839496;">&#39;</span><span style="color:#dc322f;">\\n\\n</span><span style="color:#839496;">&#39;</span><span style="color:#657b83;">)\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="59"></td><td class="code"><div><span style="color:#657b83;">            )\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="60"></td><td class="code"><div><span style="color:#657b83;">        )\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="61"></td><td class="code"><div><span style="color:#657b83;">    }\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="62"></td><td class="code"><div><span style="color:#657b83;">})\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="63"></td><td class="code"><div><span style="color:#657b83;">\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="64"></td><td class="code"><div><span style="color:#b58900;">test</span><span style="color:#657b83;">(</span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">five</span><span style="color:#839496;">&#39;</span><span style="color:#657b83;">, </span><span style="color:#586e75;">async </span><span style="color:#657b83;">() </span><span style="color:#268bd2;">=&gt; </span><span style="color:#657b83;">{\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="65"></td><td class="code"><div><span style="color:#657b83;">    </span><span style="color:#268bd2;">const output </span><span style="color:#657b83;">= (</span><span style="color:#859900;">await </span><span style="color:#b58900;">indexExample</span><span style="color:#657b83;">(</span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">five</span><span style="color:#839496;">&#39;</span><span style="color:#657b83;">)).</span><span style="color:#b58900;">map</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">v =&gt; </span><span style="color:#859900;">JSON</span><span style="color:#657b83;">.</span><span style="color:#859900;">stringify</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">v</span><span style="color:#657b83;">))\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="66"></td><td class="code"><div><span style="color:#657b83;">\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="67"></td><td class="code"><div><span style="color:#657b83;">    </span><span style="color:#b58900;">expect</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">output</span><span style="color:#657b83;">.</span><span style="color:#859900;">join</span><span style="color:#657b83;">(</span><span style="color:#839496;">&#39;</span><span style="color:#dc322f;">\\n</span><span style="color:#839496;">&#39;</span><span style="color:#657b83;">)).</span><span style="color:#b58900;">toMatchSnapshot</span><span style="color:#657b83;">()\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="68"></td><td class="code"><div><span style="color:#657b83;">})\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="69"></td><td class="code"><div><span style="color:#657b83;">\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="70"></td><td class="code"><div><span style="color:#b58900;">test</span><span style="color:#657b83;">(</span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">cross-app</span><span style="color:#839496;">&#39;</span><span style="color:#657b83;">, </span><span style="color:#586e75;">async </span><span style="color:#657b83;">() </span><span style="color:#268bd2;">=&gt; </span><span style="color:#657b83;">{\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="71"></td><td class="code"><div><span style="color:#657b83;">    </span><span style="color:#268bd2;">const app </span><span style="color:#657b83;">= (</span><span style="color:#859900;">await </span><span style="color:#b58900;">indexExample</span><span style="color:#657b83;">(</span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">cross-app</span><span style="color:#839496;">&#39;</span><span style="color:#657b83;">)).</span><span style="color:#b58900;">map</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">v =&gt; </span><span style="color:#859900;">JSON</span><span style="color:#657b83;">.</span><span style="color:#859900;">stringify</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">v</span><span style="color:#657b83;">))\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="72"></td><td class="code"><div><span style="color:#657b83;">    </span><span style="color:#b58900;">expect</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">app</span><span style="color:#657b83;">.</span><span style="color:#859900;">join</span><span style="color:#657b83;">(</span><span style="color:#839496;">&#39;</span><span style="color:#dc322f;">\\n</span><span style="color:#839496;">&#39;</span><span style="color:#657b83;">)).</span><span style="color:#b58900;">toMatchSnapshot</span><span style="color:#657b83;">()\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="73"></td><td class="code"><div><span style="color:#657b83;">})\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="74"></td><td class="code"><div><span style="color:#657b83;">\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="75"></td><td class="code"><div><span style="color:#b58900;">test</span><span style="color:#657b83;">(</span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">cross-lib</span><span style="color:#839496;">&#39;</span><span style="color:#657b83;">, </span><span style="color:#586e75;">async </span><span style="color:#657b83;">() </span><span style="color:#268bd2;">=&gt; </span><span style="color:#657b83;">{\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="76"></td><td class="code"><div><span style="color:#657b83;">    </span><span style="color:#268bd2;">const lib </span><span style="color:#657b83;">= (</span><span style="color:#859900;">await </span><span style="color:#b58900;">indexExample</span><span style="color:#657b83;">(</span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">cross-lib</span><span style="color:#839496;">&#39;</span><span style="color:#657b83;">)).</span><span style="color:#b58900;">map</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">v =&gt; </span><span style="color:#859900;">JSON</span><span style="color:#657b83;">.</span><span style="color:#859900;">stringify</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">v</span><span style="color:#657b83;">))\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="77"></td><td class="code"><div><span style="color:#657b83;">    </span><span style="color:#b58900;">expect</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">lib</span><span style="color:#657b83;">.</span><span style="color:#859900;">join</span><span style="color:#657b83;">(</span><span style="color:#839496;">&#39;</span><span style="color:#dc322f;">\\n</span><span style="color:#839496;">&#39;</span><span style="color:#657b83;">)).</span><span style="color:#b58900;">toMatchSnapshot</span><span style="color:#657b83;">()\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="78"></td><td class="code"><div><span style="color:#657b83;">})</span></div></td></tr>',
-                                      ],
+                              highlightedLineRanges: [
+                                  [
+                                      '<tr><td class="line" data-line="1"></td><td class="code"><div><span style="color:#cb4b16;">import </span><span style="color:#657b83;">{ </span><span style="color:#268bd2;">index </span><span style="color:#657b83;">} </span><span style="color:#cb4b16;">from </span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">./index</span><span style="color:#839496;">&#39;\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="2"></td><td class="code"><div><span style="color:#cb4b16;">import </span><span style="color:#657b83;">{ </span><span style="color:#268bd2;">Edge</span><span style="color:#657b83;">, </span><span style="color:#268bd2;">Vertex </span><span style="color:#657b83;">} </span><span style="color:#cb4b16;">from </span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">lsif-protocol</span><span style="color:#839496;">&#39;\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="3"></td><td class="code"><div><span style="color:#cb4b16;">import </span><span style="color:#268bd2;">_ </span><span style="color:#cb4b16;">from </span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">lodash</span><span style="color:#839496;">&#39;\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="4"></td><td class="code"><div><span style="color:#cb4b16;">import </span><span style="color:#b58900;">* </span><span style="color:#cb4b16;">as </span><span style="color:#268bd2;">path </span><span style="color:#cb4b16;">from </span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">path</span><span style="color:#839496;">&#39;\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="5"></td><td class="code"><div><span style="color:#cb4b16;">import </span><span style="color:#b58900;">* </span><span style="color:#cb4b16;">as </span><span style="color:#268bd2;">cp </span><span style="color:#cb4b16;">from </span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">child_process</span><span style="color:#839496;">&#39;\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="6"></td><td class="code"><div><span style="color:#657b83;">\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="7"></td><td class="code"><div><span style="color:#268bd2;">const GENERATE </span><span style="color:#657b83;">= </span><span style="color:#b58900;">false\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="8"></td><td class="code"><div><span style="color:#657b83;">\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="9"></td><td class="code"><div><span style="color:#268bd2;">function </span><span style="color:#b58900;">generate</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">example</span><span style="color:#859900;">: string</span><span style="color:#657b83;">)</span><span style="color:#859900;">: void </span><span style="color:#657b83;">{\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="10"></td><td class="code"><div><span style="color:#657b83;">    </span><span style="color:#268bd2;">cp</span><span style="color:#657b83;">.</span><span style="color:#b58900;">execFileSync</span><span style="color:#657b83;">(</span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">./generate-csv</span><span style="color:#839496;">&#39;</span><span style="color:#657b83;">, </span><span style="color:#268bd2;">[</span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">$CXX -c *.cpp</span><span style="color:#839496;">&#39;</span><span style="color:#268bd2;">]</span><span style="color:#657b83;">, {\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="11"></td><td class="code"><div><span style="color:#657b83;">        env: {\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="12"></td><td class="code"><div><span style="color:#657b83;">            ABSROOTDIR: </span><span style="color:#268bd2;">path</span><span style="color:#657b83;">.</span><span style="color:#859900;">resolve</span><span style="color:#657b83;">(</span><span style="color:#839496;">`</span><span style="color:#2aa198;">examples/${</span><span style="color:#268bd2;">example</span><span style="color:#2aa198;">}/root</span><span style="color:#839496;">`</span><span style="color:#657b83;">),\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="13"></td><td class="code"><div><span style="color:#657b83;">            ABSOUTDIR: </span><span style="color:#268bd2;">path</span><span style="color:#657b83;">.</span><span style="color:#859900;">resolve</span><span style="color:#657b83;">(</span><span style="color:#839496;">`</span><span style="color:#2aa198;">examples/${</span><span style="color:#268bd2;">example</span><span style="color:#2aa198;">}/output</span><span style="color:#839496;">`</span><span style="color:#657b83;">),\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="14"></td><td class="code"><div><span style="color:#657b83;">            CLEAN: </span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">true</span><span style="color:#839496;">&#39;</span><span style="color:#657b83;">,\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="15"></td><td class="code"><div><span style="color:#657b83;">        },\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="16"></td><td class="code"><div><span style="color:#657b83;">    })\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="17"></td><td class="code"><div><span style="color:#657b83;">}\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="18"></td><td class="code"><div><span style="color:#657b83;">\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="19"></td><td class="code"><div><span style="color:#586e75;">async </span><span style="color:#268bd2;">function </span><span style="color:#b58900;">indexExample</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">example</span><span style="color:#859900;">: string</span><span style="color:#657b83;">)</span><span style="color:#859900;">: </span><span style="color:#b58900;">Promise</span><span style="color:#657b83;">&lt;(</span><span style="color:#b58900;">Edge </span><span style="color:#859900;">| </span><span style="color:#b58900;">Vertex</span><span style="color:#657b83;">)</span><span style="color:#268bd2;">[]</span><span style="color:#657b83;">&gt; {\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="20"></td><td class="code"><div><span style="color:#657b83;">    </span><span style="color:#859900;">if </span><span style="color:#657b83;">(</span><span style="color:#268bd2;">GENERATE</span><span style="color:#657b83;">) {\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="21"></td><td class="code"><div><span style="color:#657b83;">        </span><span style="color:#b58900;">generate</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">example</span><span style="color:#657b83;">)\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="22"></td><td class="code"><div><span style="color:#657b83;">    }\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="23"></td><td class="code"><div><span style="color:#657b83;">\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="24"></td><td class="code"><div><span style="color:#657b83;">    </span><span style="color:#268bd2;">const output</span><span style="color:#859900;">: </span><span style="color:#657b83;">(</span><span style="color:#b58900;">Edge </span><span style="color:#859900;">| </span><span style="color:#b58900;">Vertex</span><span style="color:#657b83;">)</span><span style="color:#268bd2;">[] </span><span style="color:#657b83;">= </span><span style="color:#268bd2;">[]\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="25"></td><td class="code"><div><span style="color:#657b83;">\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="26"></td><td class="code"><div><span style="color:#657b83;">    </span><span style="color:#859900;">await </span><span style="color:#b58900;">index</span><span style="color:#657b83;">({\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="27"></td><td class="code"><div><span style="color:#657b83;">        csvFileGlob: </span><span style="color:#839496;">`</span><span style="color:#2aa198;">examples/${</span><span style="color:#268bd2;">example</span><span style="color:#2aa198;">}/output/*.csv</span><span style="color:#839496;">`</span><span style="color:#657b83;">,\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="28"></td><td class="code"><div><span style="color:#657b83;">        root: </span><span style="color:#839496;">`</span><span style="color:#2aa198;">examples/${</span><span style="color:#268bd2;">example</span><span style="color:#2aa198;">}/root</span><span style="color:#839496;">`</span><span style="color:#657b83;">,\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="29"></td><td class="code"><div><span style="color:#657b83;">        </span><span style="color:#b58900;">emit</span><span style="color:#657b83;">: </span><span style="color:#268bd2;">item =&gt;\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="30"></td><td class="code"><div><span style="color:#657b83;">            </span><span style="color:#859900;">new </span><span style="color:#b58900;">Promise</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">resolve =&gt; </span><span style="color:#657b83;">{\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="31"></td><td class="code"><div><span style="color:#657b83;">                </span><span style="color:#268bd2;">output</span><span style="color:#657b83;">.</span><span style="color:#859900;">push</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">item</span><span style="color:#657b83;">)\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="32"></td><td class="code"><div><span style="color:#657b83;">                </span><span style="color:#b58900;">resolve</span><span style="color:#657b83;">()\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="33"></td><td class="code"><div><span style="color:#657b83;">            }),\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="34"></td><td class="code"><div><span style="color:#657b83;">    })\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="35"></td><td class="code"><div><span style="color:#657b83;">\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="36"></td><td class="code"><div><span style="color:#657b83;">    </span><span style="color:#859900;">return </span><span style="color:#268bd2;">output\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="37"></td><td class="code"><div><span style="color:#657b83;">}\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="38"></td><td class="code"><div><span style="color:#657b83;">\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="39"></td><td class="code"><div><span style="color:#b58900;">test</span><span style="color:#657b83;">(</span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">does not emit items with duplicate IDs</span><span style="color:#839496;">&#39;</span><span style="color:#657b83;">, </span><span style="color:#586e75;">async </span><span style="color:#657b83;">() </span><span style="color:#268bd2;">=&gt; </span><span style="color:#657b83;">{\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="40"></td><td class="code"><div><span style="color:#657b83;">    </span><span style="color:#268bd2;">const output </span><span style="color:#657b83;">= </span><span style="color:#859900;">await </span><span style="color:#b58900;">indexExample</span><span style="color:#657b83;">(</span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">five</span><span style="color:#839496;">&#39;</span><span style="color:#657b83;">)\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="41"></td><td class="code"><div><span style="color:#657b83;">\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="42"></td><td class="code"><div><span style="color:#657b83;">    </span><span style="color:#268bd2;">const setsOfDupes </span><span style="color:#657b83;">= </span><span style="color:#b58900;">_</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">output</span><span style="color:#657b83;">)\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="43"></td><td class="code"><div><span style="color:#657b83;">        .</span><span style="color:#b58900;">groupBy</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">item =&gt; item</span><span style="color:#657b83;">.</span><span style="color:#859900;">id</span><span style="color:#657b83;">)\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="44"></td><td class="code"><div><span style="color:#657b83;">        .</span><span style="color:#859900;">values</span><span style="color:#657b83;">()\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="45"></td><td class="code"><div><span style="color:#657b83;">        .</span><span style="color:#b58900;">map</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">group =&gt; </span><span style="color:#657b83;">({ </span><span style="color:#268bd2;">group</span><span style="color:#657b83;">, count: </span><span style="color:#268bd2;">group</span><span style="color:#657b83;">.</span><span style="color:#859900;">length </span><span style="color:#657b83;">}))\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="46"></td><td class="code"><div><span style="color:#657b83;">        .</span><span style="color:#b58900;">value</span><span style="color:#657b83;">()\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="47"></td><td class="code"><div><span style="color:#657b83;">        .</span><span style="color:#b58900;">filter</span><span style="color:#657b83;">(({ </span><span style="color:#268bd2;">count </span><span style="color:#657b83;">}) </span><span style="color:#268bd2;">=&gt; count </span><span style="color:#859900;">&gt; </span><span style="color:#6c71c4;">1</span><span style="color:#657b83;">)\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="48"></td><td class="code"><div><span style="color:#657b83;">        .</span><span style="color:#b58900;">map</span><span style="color:#657b83;">(({ </span><span style="color:#268bd2;">group </span><span style="color:#657b83;">}) </span><span style="color:#268bd2;">=&gt; group</span><span style="color:#657b83;">)\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="49"></td><td class="code"><div><span style="color:#657b83;">\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="50"></td><td class="code"><div><span style="color:#657b83;">    </span><span style="color:#859900;">if </span><span style="color:#657b83;">(</span><span style="color:#268bd2;">setsOfDupes</span><span style="color:#657b83;">.</span><span style="color:#859900;">length &gt; </span><span style="color:#6c71c4;">0</span><span style="color:#657b83;">) {\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="51"></td><td class="code"><div><span style="color:#657b83;">        </span><span style="color:#b58900;">fail</span><span style="color:#657b83;">(\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="52"></td><td class="code"><div><span style="color:#657b83;">            </span><span style="color:#859900;">new </span><span style="color:#b58900;">Error</span><span style="color:#657b83;">(\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="53"></td><td class="code"><div><span style="color:#657b83;">                </span><span style="color:#839496;">`</span><span style="color:#2aa198;">Sets of lines with duplicate IDs:</span><span style="color:#dc322f;">\\n</span><span style="color:#839496;">` </span><span style="color:#657b83;">+\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="54"></td><td class="code"><div><span style="color:#657b83;">                    </span><span style="color:#268bd2;">setsOfDupes\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="55"></td><td class="code"><div><span style="color:#657b83;">                        .</span><span style="color:#b58900;">map</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">dupes =&gt;\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="56"></td><td class="code"><div><span style="color:#657b83;">                            </span><span style="color:#268bd2;">dupes</span><span style="color:#657b83;">.</span><span style="color:#b58900;">map</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">item =&gt; </span><span style="color:#859900;">JSON</span><span style="color:#657b83;">.</span><span style="color:#859900;">stringify</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">item</span><span style="color:#657b83;">)).</span><span style="color:#859900;">join</span><span style="color:#657b83;">(</span><span style="color:#839496;">&#39;</span><span style="color:#dc322f;">\\n</span><span style="color:#839496;">&#39;</span><span style="color:#657b83;">)\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="57"></td><td class="code"><div><span style="color:#657b83;">                        )\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="58"></td><td class="code"><div><span style="color:#657b83;">                        .</span><span style="color:#859900;">join</span><span style="color:#657b83;">(</span><span style="color:#839496;">&#39;</span><span style="color:#dc322f;">\\n\\n</span><span style="color:#839496;">&#39;</span><span style="color:#657b83;">)\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="59"></td><td class="code"><div><span style="color:#657b83;">            )\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="60"></td><td class="code"><div><span style="color:#657b83;">        )\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="61"></td><td class="code"><div><span style="color:#657b83;">    }\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="62"></td><td class="code"><div><span style="color:#657b83;">})\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="63"></td><td class="code"><div><span style="color:#657b83;">\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="64"></td><td class="code"><div><span style="color:#b58900;">test</span><span style="color:#657b83;">(</span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">five</span><span style="color:#839496;">&#39;</span><span style="color:#657b83;">, </span><span style="color:#586e75;">async </span><span style="color:#657b83;">() </span><span style="color:#268bd2;">=&gt; </span><span style="color:#657b83;">{\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="65"></td><td class="code"><div><span style="color:#657b83;">    </span><span style="color:#268bd2;">const output </span><span style="color:#657b83;">= (</span><span style="color:#859900;">await </span><span style="color:#b58900;">indexExample</span><span style="color:#657b83;">(</span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">five</span><span style="color:#839496;">&#39;</span><span style="color:#657b83;">)).</span><span style="color:#b58900;">map</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">v =&gt; </span><span style="color:#859900;">JSON</span><span style="color:#657b83;">.</span><span style="color:#859900;">stringify</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">v</span><span style="color:#657b83;">))\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="66"></td><td class="code"><div><span style="color:#657b83;">\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="67"></td><td class="code"><div><span style="color:#657b83;">    </span><span style="color:#b58900;">expect</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">output</span><span style="color:#657b83;">.</span><span style="color:#859900;">join</span><span style="color:#657b83;">(</span><span style="color:#839496;">&#39;</span><span style="color:#dc322f;">\\n</span><span style="color:#839496;">&#39;</span><span style="color:#657b83;">)).</span><span style="color:#b58900;">toMatchSnapshot</span><span style="color:#657b83;">()\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="68"></td><td class="code"><div><span style="color:#657b83;">})\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="69"></td><td class="code"><div><span style="color:#657b83;">\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="70"></td><td class="code"><div><span style="color:#b58900;">test</span><span style="color:#657b83;">(</span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">cross-app</span><span style="color:#839496;">&#39;</span><span style="color:#657b83;">, </span><span style="color:#586e75;">async </span><span style="color:#657b83;">() </span><span style="color:#268bd2;">=&gt; </span><span style="color:#657b83;">{\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="71"></td><td class="code"><div><span style="color:#657b83;">    </span><span style="color:#268bd2;">const app </span><span style="color:#657b83;">= (</span><span style="color:#859900;">await </span><span style="color:#b58900;">indexExample</span><span style="color:#657b83;">(</span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">cross-app</span><span style="color:#839496;">&#39;</span><span style="color:#657b83;">)).</span><span style="color:#b58900;">map</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">v =&gt; </span><span style="color:#859900;">JSON</span><span style="color:#657b83;">.</span><span style="color:#859900;">stringify</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">v</span><span style="color:#657b83;">))\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="72"></td><td class="code"><div><span style="color:#657b83;">    </span><span style="color:#b58900;">expect</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">app</span><span style="color:#657b83;">.</span><span style="color:#859900;">join</span><span style="color:#657b83;">(</span><span style="color:#839496;">&#39;</span><span style="color:#dc322f;">\\n</span><span style="color:#839496;">&#39;</span><span style="color:#657b83;">)).</span><span style="color:#b58900;">toMatchSnapshot</span><span style="color:#657b83;">()\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="73"></td><td class="code"><div><span style="color:#657b83;">})\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="74"></td><td class="code"><div><span style="color:#657b83;">\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="75"></td><td class="code"><div><span style="color:#b58900;">test</span><span style="color:#657b83;">(</span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">cross-lib</span><span style="color:#839496;">&#39;</span><span style="color:#657b83;">, </span><span style="color:#586e75;">async </span><span style="color:#657b83;">() </span><span style="color:#268bd2;">=&gt; </span><span style="color:#657b83;">{\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="76"></td><td class="code"><div><span style="color:#657b83;">    </span><span style="color:#268bd2;">const lib </span><span style="color:#657b83;">= (</span><span style="color:#859900;">await </span><span style="color:#b58900;">indexExample</span><span style="color:#657b83;">(</span><span style="color:#839496;">&#39;</span><span style="color:#2aa198;">cross-lib</span><span style="color:#839496;">&#39;</span><span style="color:#657b83;">)).</span><span style="color:#b58900;">map</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">v =&gt; </span><span style="color:#859900;">JSON</span><span style="color:#657b83;">.</span><span style="color:#859900;">stringify</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">v</span><span style="color:#657b83;">))\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="77"></td><td class="code"><div><span style="color:#657b83;">    </span><span style="color:#b58900;">expect</span><span style="color:#657b83;">(</span><span style="color:#268bd2;">lib</span><span style="color:#657b83;">.</span><span style="color:#859900;">join</span><span style="color:#657b83;">(</span><span style="color:#839496;">&#39;</span><span style="color:#dc322f;">\\n</span><span style="color:#839496;">&#39;</span><span style="color:#657b83;">)).</span><span style="color:#b58900;">toMatchSnapshot</span><span style="color:#657b83;">()\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="78"></td><td class="code"><div><span style="color:#657b83;">})</span></div></td></tr>',
                                   ],
-                              },
+                              ],
                           },
                       },
                   },
@@ -322,91 +319,88 @@ export const highlightFileResult: Partial<WebGraphQlOperations> = {
                           file: {
                               isDirectory: false,
                               richHTML: '',
-                              highlight: {
-                                  aborted: false,
-                                  lineRanges: [
-                                      [
-                                          '<tr><td class="line" data-line="1"></td><td class="code"><div><span style="color:#35a5ff;">import </span><span style="color:#c0c5ce;">{ </span><span style="color:#72c3fc;">index </span><span style="color:#c0c5ce;">} </span><span style="color:#35a5ff;">from </span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">./index</span><span style="color:#bdd4e3;">&#39;\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="2"></td><td class="code"><div><span style="color:#35a5ff;">import </span><span style="color:#c0c5ce;">{ </span><span style="color:#72c3fc;">Edge</span><span style="color:#c0c5ce;">, </span><span style="color:#72c3fc;">Vertex </span><span style="color:#c0c5ce;">} </span><span style="color:#35a5ff;">from </span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">lsif-protocol</span><span style="color:#bdd4e3;">&#39;\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="3"></td><td class="code"><div><span style="color:#35a5ff;">import </span><span style="color:#72c3fc;">_ </span><span style="color:#35a5ff;">from </span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">lodash</span><span style="color:#bdd4e3;">&#39;\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="4"></td><td class="code"><div><span style="color:#35a5ff;">import </span><span style="color:#329af0;">* </span><span style="color:#35a5ff;">as </span><span style="color:#72c3fc;">path </span><span style="color:#35a5ff;">from </span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">path</span><span style="color:#bdd4e3;">&#39;\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="5"></td><td class="code"><div><span style="color:#35a5ff;">import </span><span style="color:#329af0;">* </span><span style="color:#35a5ff;">as </span><span style="color:#72c3fc;">cp </span><span style="color:#35a5ff;">from </span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">child_process</span><span style="color:#bdd4e3;">&#39;\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="6"></td><td class="code"><div><span style="color:#c0c5ce;">\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="7"></td><td class="code"><div><span style="color:#329af0;">const </span><span style="color:#72c3fc;">GENERATE </span><span style="color:#329af0;">= false\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="8"></td><td class="code"><div><span style="color:#c0c5ce;">\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="9"></td><td class="code"><div><span style="color:#329af0;">function </span><span style="color:#fff3bf;">generate</span><span style="color:#bdd4e3;">(</span><span style="color:#72c3fc;">example</span><span style="color:#329af0;">: </span><span style="color:#c0c5ce;">string</span><span style="color:#bdd4e3;">)</span><span style="color:#329af0;">: </span><span style="color:#c0c5ce;">void {\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="10"></td><td class="code"><div><span style="color:#c0c5ce;">    </span><span style="color:#72c3fc;">cp</span><span style="color:#c0c5ce;">.</span><span style="color:#fff3bf;">execFileSync</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">./generate-csv</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">, [</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">$CXX -c *.cpp</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">], {\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="11"></td><td class="code"><div><span style="color:#c0c5ce;">        env: {\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="12"></td><td class="code"><div><span style="color:#c0c5ce;">            ABSROOTDIR: </span><span style="color:#72c3fc;">path</span><span style="color:#c0c5ce;">.</span><span style="color:#fff3bf;">resolve</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">`</span><span style="color:#ffb0af;">examples/${</span><span style="color:#72c3fc;">example</span><span style="color:#ffb0af;">}/root</span><span style="color:#bdd4e3;">`</span><span style="color:#c0c5ce;">),\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="13"></td><td class="code"><div><span style="color:#c0c5ce;">            ABSOUTDIR: </span><span style="color:#72c3fc;">path</span><span style="color:#c0c5ce;">.</span><span style="color:#fff3bf;">resolve</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">`</span><span style="color:#ffb0af;">examples/${</span><span style="color:#72c3fc;">example</span><span style="color:#ffb0af;">}/output</span><span style="color:#bdd4e3;">`</span><span style="color:#c0c5ce;">),\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="14"></td><td class="code"><div><span style="color:#c0c5ce;">            CLEAN: </span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">true</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">,\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="15"></td><td class="code"><div><span style="color:#c0c5ce;">        },\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="16"></td><td class="code"><div><span style="color:#c0c5ce;">    })\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="17"></td><td class="code"><div><span style="color:#c0c5ce;">}\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="18"></td><td class="code"><div><span style="color:#c0c5ce;">\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="19"></td><td class="code"><div><span style="color:#329af0;">async function </span><span style="color:#fff3bf;">indexExample</span><span style="color:#bdd4e3;">(</span><span style="color:#72c3fc;">example</span><span style="color:#329af0;">: </span><span style="color:#c0c5ce;">string</span><span style="color:#bdd4e3;">)</span><span style="color:#329af0;">: </span><span style="color:#c0c5ce;">Promise&lt;(Edge </span><span style="color:#329af0;">| </span><span style="color:#c0c5ce;">Vertex)[]&gt; {\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="20"></td><td class="code"><div><span style="color:#c0c5ce;">    </span><span style="color:#35a5ff;">if </span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">GENERATE</span><span style="color:#c0c5ce;">) {\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="21"></td><td class="code"><div><span style="color:#c0c5ce;">        </span><span style="color:#fff3bf;">generate</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">example</span><span style="color:#c0c5ce;">)\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="22"></td><td class="code"><div><span style="color:#c0c5ce;">    }\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="23"></td><td class="code"><div><span style="color:#c0c5ce;">\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="24"></td><td class="code"><div><span style="color:#c0c5ce;">    </span><span style="color:#329af0;">const </span><span style="color:#72c3fc;">output</span><span style="color:#329af0;">: </span><span style="color:#c0c5ce;">(Edge </span><span style="color:#329af0;">| </span><span style="color:#c0c5ce;">Vertex)[] </span><span style="color:#329af0;">= </span><span style="color:#c0c5ce;">[]\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="25"></td><td class="code"><div><span style="color:#c0c5ce;">\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="26"></td><td class="code"><div><span style="color:#c0c5ce;">    </span><span style="color:#35a5ff;">await </span><span style="color:#fff3bf;">index</span><span style="color:#c0c5ce;">({\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="27"></td><td class="code"><div><span style="color:#c0c5ce;">        csvFileGlob: </span><span style="color:#bdd4e3;">`</span><span style="color:#ffb0af;">examples/${</span><span style="color:#72c3fc;">example</span><span style="color:#ffb0af;">}/output/*.csv</span><span style="color:#bdd4e3;">`</span><span style="color:#c0c5ce;">,\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="28"></td><td class="code"><div><span style="color:#c0c5ce;">        root: </span><span style="color:#bdd4e3;">`</span><span style="color:#ffb0af;">examples/${</span><span style="color:#72c3fc;">example</span><span style="color:#ffb0af;">}/root</span><span style="color:#bdd4e3;">`</span><span style="color:#c0c5ce;">,\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="29"></td><td class="code"><div><span style="color:#c0c5ce;">        </span><span style="color:#fff3bf;">emit</span><span style="color:#c0c5ce;">: </span><span style="color:#72c3fc;">item </span><span style="color:#329af0;">=&gt;\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="30"></td><td class="code"><div><span style="color:#c0c5ce;">            </span><span style="color:#329af0;">new </span><span style="color:#c0c5ce;">Promise(</span><span style="color:#72c3fc;">resolve </span><span style="color:#329af0;">=&gt; </span><span style="color:#c0c5ce;">{\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="31"></td><td class="code"><div><span style="color:#c0c5ce;">                </span><span style="color:#72c3fc;">output</span><span style="color:#c0c5ce;">.</span><span style="color:#fff3bf;">push</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">item</span><span style="color:#c0c5ce;">)\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="32"></td><td class="code"><div><span style="color:#c0c5ce;">                </span><span style="color:#fff3bf;">resolve</span><span style="color:#c0c5ce;">()\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="33"></td><td class="code"><div><span style="color:#c0c5ce;">            }),\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="34"></td><td class="code"><div><span style="color:#c0c5ce;">    })\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="35"></td><td class="code"><div><span style="color:#c0c5ce;">\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="36"></td><td class="code"><div><span style="color:#c0c5ce;">    </span><span style="color:#35a5ff;">return </span><span style="color:#72c3fc;">output\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="37"></td><td class="code"><div><span style="color:#c0c5ce;">}\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="38"></td><td class="code"><div><span style="color:#c0c5ce;">\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="39"></td><td class="code"><div><span style="color:#fff3bf;">test</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">does not emit items with duplicate IDs</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">, </span><span style="color:#329af0;">async </span><span style="color:#bdd4e3;">() </span><span style="color:#329af0;">=&gt; </span><span style="color:#c0c5ce;">{\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="40"></td><td class="code"><div><span style="color:#c0c5ce;">    </span><span style="color:#329af0;">const </span><span style="color:#72c3fc;">output </span><span style="color:#329af0;">= </span><span style="color:#35a5ff;">await </span><span style="color:#fff3bf;">indexExample</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">five</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">)\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="41"></td><td class="code"><div><span style="color:#c0c5ce;">\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="42"></td><td class="code"><div><span style="color:#c0c5ce;">    </span><span style="color:#329af0;">const </span><span style="color:#72c3fc;">setsOfDupes </span><span style="color:#329af0;">= </span><span style="color:#fff3bf;">_</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">output</span><span style="color:#c0c5ce;">)\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="43"></td><td class="code"><div><span style="color:#c0c5ce;">        .</span><span style="color:#fff3bf;">groupBy</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">item </span><span style="color:#329af0;">=&gt; </span><span style="color:#72c3fc;">item</span><span style="color:#c0c5ce;">.id)\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="44"></td><td class="code"><div><span style="color:#c0c5ce;">        .</span><span style="color:#fff3bf;">values</span><span style="color:#c0c5ce;">()\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="45"></td><td class="code"><div><span style="color:#c0c5ce;">        .</span><span style="color:#fff3bf;">map</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">group </span><span style="color:#329af0;">=&gt; </span><span style="color:#c0c5ce;">({ </span><span style="color:#72c3fc;">group</span><span style="color:#c0c5ce;">, count: </span><span style="color:#72c3fc;">group</span><span style="color:#c0c5ce;">.length }))\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="46"></td><td class="code"><div><span style="color:#c0c5ce;">        .</span><span style="color:#fff3bf;">value</span><span style="color:#c0c5ce;">()\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="47"></td><td class="code"><div><span style="color:#c0c5ce;">        .</span><span style="color:#fff3bf;">filter</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">(</span><span style="color:#c0c5ce;">{ </span><span style="color:#72c3fc;">count </span><span style="color:#c0c5ce;">}</span><span style="color:#bdd4e3;">) </span><span style="color:#329af0;">=&gt; </span><span style="color:#72c3fc;">count </span><span style="color:#329af0;">&gt; </span><span style="color:#d3f9d8;">1</span><span style="color:#c0c5ce;">)\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="48"></td><td class="code"><div><span style="color:#c0c5ce;">        .</span><span style="color:#fff3bf;">map</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">(</span><span style="color:#c0c5ce;">{ </span><span style="color:#72c3fc;">group </span><span style="color:#c0c5ce;">}</span><span style="color:#bdd4e3;">) </span><span style="color:#329af0;">=&gt; </span><span style="color:#72c3fc;">group</span><span style="color:#c0c5ce;">)\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="49"></td><td class="code"><div><span style="color:#c0c5ce;">\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="50"></td><td class="code"><div><span style="color:#c0c5ce;">    </span><span style="color:#35a5ff;">if </span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">setsOfDupes</span><span style="color:#c0c5ce;">.length </span><span style="color:#329af0;">&gt; </span><span style="color:#d3f9d8;">0</span><span style="color:#c0c5ce;">) {\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="51"></td><td class="code"><div><span style="color:#c0c5ce;">        </span><span style="color:#fff3bf;">fail</span><span style="color:#c0c5ce;">(\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="52"></td><td class="code"><div><span style="color:#c0c5ce;">            </span><span style="color:#329af0;">new </span><span style="color:#c0c5ce;">Error(\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="53"></td><td class="code"><div><span style="color:#c0c5ce;">                </span><span style="color:#bdd4e3;">`</span><span style="color:#ffb0af;">Sets of lines with duplicate IDs:</span><span style="color:#96b5b4;">\\n</span><span style="color:#bdd4e3;">` </span><span style="color:#329af0;">+\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="54"></td><td class="code"><div><span style="color:#c0c5ce;">                    </span><span style="color:#72c3fc;">setsOfDupes\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="55"></td><td class="code"><div><span style="color:#c0c5ce;">                        .</span><span style="color:#fff3bf;">map</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">dupes </span><span style="color:#329af0;">=&gt;\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="56"></td><td class="code"><div><span style="color:#c0c5ce;">                            </span><span style="color:#72c3fc;">dupes</span><span style="color:#c0c5ce;">.</span><span style="color:#fff3bf;">map</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">item </span><span style="color:#329af0;">=&gt; </span><span style="color:#c0c5ce;">JSON.</span><span style="color:#fff3bf;">stringify</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">item</span><span style="color:#c0c5ce;">)).</span><span style="color:#fff3bf;">join</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#96b5b4;">\\n</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">)\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="57"></td><td class="code"><div><span style="color:#c0c5ce;">                        )\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="58"></td><td class="code"><div><span style="color:#c0c5ce;">                        .</span><span style="color:#fff3bf;">join</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#96b5b4;">\\n\\n</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">)\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="59"></td><td class="code"><div><span style="color:#c0c5ce;">            )\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="60"></td><td class="code"><div><span style="color:#c0c5ce;">        )\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="61"></td><td class="code"><div><span style="color:#c0c5ce;">    }\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="62"></td><td class="code"><div><span style="color:#c0c5ce;">})\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="63"></td><td class="code"><div><span style="color:#c0c5ce;">\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="64"></td><td class="code"><div><span style="color:#fff3bf;">test</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">five</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">, </span><span style="color:#329af0;">async </span><span style="color:#bdd4e3;">() </span><span style="color:#329af0;">=&gt; </span><span style="color:#c0c5ce;">{\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="65"></td><td class="code"><div><span style="color:#c0c5ce;">    </span><span style="color:#329af0;">const </span><span style="color:#72c3fc;">output </span><span style="color:#329af0;">= </span><span style="color:#c0c5ce;">(</span><span style="color:#35a5ff;">await </span><span style="color:#fff3bf;">indexExample</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">five</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">)).</span><span style="color:#fff3bf;">map</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">v </span><span style="color:#329af0;">=&gt; </span><span style="color:#c0c5ce;">JSON.</span><span style="color:#fff3bf;">stringify</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">v</span><span style="color:#c0c5ce;">))\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="66"></td><td class="code"><div><span style="color:#c0c5ce;">\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="67"></td><td class="code"><div><span style="color:#c0c5ce;">    </span><span style="color:#fff3bf;">expect</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">output</span><span style="color:#c0c5ce;">.</span><span style="color:#fff3bf;">join</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#96b5b4;">\\n</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">)).</span><span style="color:#fff3bf;">toMatchSnapshot</span><span style="color:#c0c5ce;">()\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="68"></td><td class="code"><div><span style="color:#c0c5ce;">})\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="69"></td><td class="code"><div><span style="color:#c0c5ce;">\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="70"></td><td class="code"><div><span style="color:#fff3bf;">test</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">cross-app</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">, </span><span style="color:#329af0;">async </span><span style="color:#bdd4e3;">() </span><span style="color:#329af0;">=&gt; </span><span style="color:#c0c5ce;">{\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="71"></td><td class="code"><div><span style="color:#c0c5ce;">    </span><span style="color:#329af0;">const </span><span style="color:#72c3fc;">app </span><span style="color:#329af0;">= </span><span style="color:#c0c5ce;">(</span><span style="color:#35a5ff;">await </span><span style="color:#fff3bf;">indexExample</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">cross-app</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">)).</span><span style="color:#fff3bf;">map</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">v </span><span style="color:#329af0;">=&gt; </span><span style="color:#c0c5ce;">JSON.</span><span style="color:#fff3bf;">stringify</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">v</span><span style="color:#c0c5ce;">))\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="72"></td><td class="code"><div><span style="color:#c0c5ce;">    </span><span style="color:#fff3bf;">expect</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">app</span><span style="color:#c0c5ce;">.</span><span style="color:#fff3bf;">join</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#96b5b4;">\\n</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">)).</span><span style="color:#fff3bf;">toMatchSnapshot</span><span style="color:#c0c5ce;">()\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="73"></td><td class="code"><div><span style="color:#c0c5ce;">})\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="74"></td><td class="code"><div><span style="color:#c0c5ce;">\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="75"></td><td class="code"><div><span style="color:#fff3bf;">test</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">cross-lib</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">, </span><span style="color:#329af0;">async </span><span style="color:#bdd4e3;">() </span><span style="color:#329af0;">=&gt; </span><span style="color:#c0c5ce;">{\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="76"></td><td class="code"><div><span style="color:#c0c5ce;">    </span><span style="color:#329af0;">const </span><span style="color:#72c3fc;">lib </span><span style="color:#329af0;">= </span><span style="color:#c0c5ce;">(</span><span style="color:#35a5ff;">await </span><span style="color:#fff3bf;">indexExample</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">cross-lib</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">)).</span><span style="color:#fff3bf;">map</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">v </span><span style="color:#329af0;">=&gt; </span><span style="color:#c0c5ce;">JSON.</span><span style="color:#fff3bf;">stringify</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">v</span><span style="color:#c0c5ce;">))\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="77"></td><td class="code"><div><span style="color:#c0c5ce;">    </span><span style="color:#fff3bf;">expect</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">lib</span><span style="color:#c0c5ce;">.</span><span style="color:#fff3bf;">join</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#96b5b4;">\\n</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">)).</span><span style="color:#fff3bf;">toMatchSnapshot</span><span style="color:#c0c5ce;">()\n</span></div></td></tr>',
-                                          '<tr><td class="line" data-line="78"></td><td class="code"><div><span style="color:#c0c5ce;">})</span></div></td></tr>',
-                                      ],
+                              highlightedLineRanges: [
+                                  [
+                                      '<tr><td class="line" data-line="1"></td><td class="code"><div><span style="color:#35a5ff;">import </span><span style="color:#c0c5ce;">{ </span><span style="color:#72c3fc;">index </span><span style="color:#c0c5ce;">} </span><span style="color:#35a5ff;">from </span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">./index</span><span style="color:#bdd4e3;">&#39;\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="2"></td><td class="code"><div><span style="color:#35a5ff;">import </span><span style="color:#c0c5ce;">{ </span><span style="color:#72c3fc;">Edge</span><span style="color:#c0c5ce;">, </span><span style="color:#72c3fc;">Vertex </span><span style="color:#c0c5ce;">} </span><span style="color:#35a5ff;">from </span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">lsif-protocol</span><span style="color:#bdd4e3;">&#39;\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="3"></td><td class="code"><div><span style="color:#35a5ff;">import </span><span style="color:#72c3fc;">_ </span><span style="color:#35a5ff;">from </span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">lodash</span><span style="color:#bdd4e3;">&#39;\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="4"></td><td class="code"><div><span style="color:#35a5ff;">import </span><span style="color:#329af0;">* </span><span style="color:#35a5ff;">as </span><span style="color:#72c3fc;">path </span><span style="color:#35a5ff;">from </span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">path</span><span style="color:#bdd4e3;">&#39;\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="5"></td><td class="code"><div><span style="color:#35a5ff;">import </span><span style="color:#329af0;">* </span><span style="color:#35a5ff;">as </span><span style="color:#72c3fc;">cp </span><span style="color:#35a5ff;">from </span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">child_process</span><span style="color:#bdd4e3;">&#39;\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="6"></td><td class="code"><div><span style="color:#c0c5ce;">\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="7"></td><td class="code"><div><span style="color:#329af0;">const </span><span style="color:#72c3fc;">GENERATE </span><span style="color:#329af0;">= false\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="8"></td><td class="code"><div><span style="color:#c0c5ce;">\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="9"></td><td class="code"><div><span style="color:#329af0;">function </span><span style="color:#fff3bf;">generate</span><span style="color:#bdd4e3;">(</span><span style="color:#72c3fc;">example</span><span style="color:#329af0;">: </span><span style="color:#c0c5ce;">string</span><span style="color:#bdd4e3;">)</span><span style="color:#329af0;">: </span><span style="color:#c0c5ce;">void {\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="10"></td><td class="code"><div><span style="color:#c0c5ce;">    </span><span style="color:#72c3fc;">cp</span><span style="color:#c0c5ce;">.</span><span style="color:#fff3bf;">execFileSync</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">./generate-csv</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">, [</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">$CXX -c *.cpp</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">], {\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="11"></td><td class="code"><div><span style="color:#c0c5ce;">        env: {\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="12"></td><td class="code"><div><span style="color:#c0c5ce;">            ABSROOTDIR: </span><span style="color:#72c3fc;">path</span><span style="color:#c0c5ce;">.</span><span style="color:#fff3bf;">resolve</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">`</span><span style="color:#ffb0af;">examples/${</span><span style="color:#72c3fc;">example</span><span style="color:#ffb0af;">}/root</span><span style="color:#bdd4e3;">`</span><span style="color:#c0c5ce;">),\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="13"></td><td class="code"><div><span style="color:#c0c5ce;">            ABSOUTDIR: </span><span style="color:#72c3fc;">path</span><span style="color:#c0c5ce;">.</span><span style="color:#fff3bf;">resolve</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">`</span><span style="color:#ffb0af;">examples/${</span><span style="color:#72c3fc;">example</span><span style="color:#ffb0af;">}/output</span><span style="color:#bdd4e3;">`</span><span style="color:#c0c5ce;">),\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="14"></td><td class="code"><div><span style="color:#c0c5ce;">            CLEAN: </span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">true</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">,\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="15"></td><td class="code"><div><span style="color:#c0c5ce;">        },\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="16"></td><td class="code"><div><span style="color:#c0c5ce;">    })\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="17"></td><td class="code"><div><span style="color:#c0c5ce;">}\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="18"></td><td class="code"><div><span style="color:#c0c5ce;">\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="19"></td><td class="code"><div><span style="color:#329af0;">async function </span><span style="color:#fff3bf;">indexExample</span><span style="color:#bdd4e3;">(</span><span style="color:#72c3fc;">example</span><span style="color:#329af0;">: </span><span style="color:#c0c5ce;">string</span><span style="color:#bdd4e3;">)</span><span style="color:#329af0;">: </span><span style="color:#c0c5ce;">Promise&lt;(Edge </span><span style="color:#329af0;">| </span><span style="color:#c0c5ce;">Vertex)[]&gt; {\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="20"></td><td class="code"><div><span style="color:#c0c5ce;">    </span><span style="color:#35a5ff;">if </span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">GENERATE</span><span style="color:#c0c5ce;">) {\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="21"></td><td class="code"><div><span style="color:#c0c5ce;">        </span><span style="color:#fff3bf;">generate</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">example</span><span style="color:#c0c5ce;">)\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="22"></td><td class="code"><div><span style="color:#c0c5ce;">    }\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="23"></td><td class="code"><div><span style="color:#c0c5ce;">\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="24"></td><td class="code"><div><span style="color:#c0c5ce;">    </span><span style="color:#329af0;">const </span><span style="color:#72c3fc;">output</span><span style="color:#329af0;">: </span><span style="color:#c0c5ce;">(Edge </span><span style="color:#329af0;">| </span><span style="color:#c0c5ce;">Vertex)[] </span><span style="color:#329af0;">= </span><span style="color:#c0c5ce;">[]\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="25"></td><td class="code"><div><span style="color:#c0c5ce;">\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="26"></td><td class="code"><div><span style="color:#c0c5ce;">    </span><span style="color:#35a5ff;">await </span><span style="color:#fff3bf;">index</span><span style="color:#c0c5ce;">({\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="27"></td><td class="code"><div><span style="color:#c0c5ce;">        csvFileGlob: </span><span style="color:#bdd4e3;">`</span><span style="color:#ffb0af;">examples/${</span><span style="color:#72c3fc;">example</span><span style="color:#ffb0af;">}/output/*.csv</span><span style="color:#bdd4e3;">`</span><span style="color:#c0c5ce;">,\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="28"></td><td class="code"><div><span style="color:#c0c5ce;">        root: </span><span style="color:#bdd4e3;">`</span><span style="color:#ffb0af;">examples/${</span><span style="color:#72c3fc;">example</span><span style="color:#ffb0af;">}/root</span><span style="color:#bdd4e3;">`</span><span style="color:#c0c5ce;">,\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="29"></td><td class="code"><div><span style="color:#c0c5ce;">        </span><span style="color:#fff3bf;">emit</span><span style="color:#c0c5ce;">: </span><span style="color:#72c3fc;">item </span><span style="color:#329af0;">=&gt;\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="30"></td><td class="code"><div><span style="color:#c0c5ce;">            </span><span style="color:#329af0;">new </span><span style="color:#c0c5ce;">Promise(</span><span style="color:#72c3fc;">resolve </span><span style="color:#329af0;">=&gt; </span><span style="color:#c0c5ce;">{\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="31"></td><td class="code"><div><span style="color:#c0c5ce;">                </span><span style="color:#72c3fc;">output</span><span style="color:#c0c5ce;">.</span><span style="color:#fff3bf;">push</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">item</span><span style="color:#c0c5ce;">)\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="32"></td><td class="code"><div><span style="color:#c0c5ce;">                </span><span style="color:#fff3bf;">resolve</span><span style="color:#c0c5ce;">()\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="33"></td><td class="code"><div><span style="color:#c0c5ce;">            }),\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="34"></td><td class="code"><div><span style="color:#c0c5ce;">    })\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="35"></td><td class="code"><div><span style="color:#c0c5ce;">\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="36"></td><td class="code"><div><span style="color:#c0c5ce;">    </span><span style="color:#35a5ff;">return </span><span style="color:#72c3fc;">output\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="37"></td><td class="code"><div><span style="color:#c0c5ce;">}\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="38"></td><td class="code"><div><span style="color:#c0c5ce;">\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="39"></td><td class="code"><div><span style="color:#fff3bf;">test</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">does not emit items with duplicate IDs</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">, </span><span style="color:#329af0;">async </span><span style="color:#bdd4e3;">() </span><span style="color:#329af0;">=&gt; </span><span style="color:#c0c5ce;">{\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="40"></td><td class="code"><div><span style="color:#c0c5ce;">    </span><span style="color:#329af0;">const </span><span style="color:#72c3fc;">output </span><span style="color:#329af0;">= </span><span style="color:#35a5ff;">await </span><span style="color:#fff3bf;">indexExample</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">five</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">)\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="41"></td><td class="code"><div><span style="color:#c0c5ce;">\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="42"></td><td class="code"><div><span style="color:#c0c5ce;">    </span><span style="color:#329af0;">const </span><span style="color:#72c3fc;">setsOfDupes </span><span style="color:#329af0;">= </span><span style="color:#fff3bf;">_</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">output</span><span style="color:#c0c5ce;">)\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="43"></td><td class="code"><div><span style="color:#c0c5ce;">        .</span><span style="color:#fff3bf;">groupBy</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">item </span><span style="color:#329af0;">=&gt; </span><span style="color:#72c3fc;">item</span><span style="color:#c0c5ce;">.id)\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="44"></td><td class="code"><div><span style="color:#c0c5ce;">        .</span><span style="color:#fff3bf;">values</span><span style="color:#c0c5ce;">()\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="45"></td><td class="code"><div><span style="color:#c0c5ce;">        .</span><span style="color:#fff3bf;">map</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">group </span><span style="color:#329af0;">=&gt; </span><span style="color:#c0c5ce;">({ </span><span style="color:#72c3fc;">group</span><span style="color:#c0c5ce;">, count: </span><span style="color:#72c3fc;">group</span><span style="color:#c0c5ce;">.length }))\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="46"></td><td class="code"><div><span style="color:#c0c5ce;">        .</span><span style="color:#fff3bf;">value</span><span style="color:#c0c5ce;">()\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="47"></td><td class="code"><div><span style="color:#c0c5ce;">        .</span><span style="color:#fff3bf;">filter</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">(</span><span style="color:#c0c5ce;">{ </span><span style="color:#72c3fc;">count </span><span style="color:#c0c5ce;">}</span><span style="color:#bdd4e3;">) </span><span style="color:#329af0;">=&gt; </span><span style="color:#72c3fc;">count </span><span style="color:#329af0;">&gt; </span><span style="color:#d3f9d8;">1</span><span style="color:#c0c5ce;">)\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="48"></td><td class="code"><div><span style="color:#c0c5ce;">        .</span><span style="color:#fff3bf;">map</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">(</span><span style="color:#c0c5ce;">{ </span><span style="color:#72c3fc;">group </span><span style="color:#c0c5ce;">}</span><span style="color:#bdd4e3;">) </span><span style="color:#329af0;">=&gt; </span><span style="color:#72c3fc;">group</span><span style="color:#c0c5ce;">)\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="49"></td><td class="code"><div><span style="color:#c0c5ce;">\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="50"></td><td class="code"><div><span style="color:#c0c5ce;">    </span><span style="color:#35a5ff;">if </span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">setsOfDupes</span><span style="color:#c0c5ce;">.length </span><span style="color:#329af0;">&gt; </span><span style="color:#d3f9d8;">0</span><span style="color:#c0c5ce;">) {\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="51"></td><td class="code"><div><span style="color:#c0c5ce;">        </span><span style="color:#fff3bf;">fail</span><span style="color:#c0c5ce;">(\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="52"></td><td class="code"><div><span style="color:#c0c5ce;">            </span><span style="color:#329af0;">new </span><span style="color:#c0c5ce;">Error(\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="53"></td><td class="code"><div><span style="color:#c0c5ce;">                </span><span style="color:#bdd4e3;">`</span><span style="color:#ffb0af;">Sets of lines with duplicate IDs:</span><span style="color:#96b5b4;">\\n</span><span style="color:#bdd4e3;">` </span><span style="color:#329af0;">+\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="54"></td><td class="code"><div><span style="color:#c0c5ce;">                    </span><span style="color:#72c3fc;">setsOfDupes\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="55"></td><td class="code"><div><span style="color:#c0c5ce;">                        .</span><span style="color:#fff3bf;">map</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">dupes </span><span style="color:#329af0;">=&gt;\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="56"></td><td class="code"><div><span style="color:#c0c5ce;">                            </span><span style="color:#72c3fc;">dupes</span><span style="color:#c0c5ce;">.</span><span style="color:#fff3bf;">map</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">item </span><span style="color:#329af0;">=&gt; </span><span style="color:#c0c5ce;">JSON.</span><span style="color:#fff3bf;">stringify</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">item</span><span style="color:#c0c5ce;">)).</span><span style="color:#fff3bf;">join</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#96b5b4;">\\n</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">)\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="57"></td><td class="code"><div><span style="color:#c0c5ce;">                        )\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="58"></td><td class="code"><div><span style="color:#c0c5ce;">                        .</span><span style="color:#fff3bf;">join</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#96b5b4;">\\n\\n</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">)\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="59"></td><td class="code"><div><span style="color:#c0c5ce;">            )\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="60"></td><td class="code"><div><span style="color:#c0c5ce;">        )\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="61"></td><td class="code"><div><span style="color:#c0c5ce;">    }\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="62"></td><td class="code"><div><span style="color:#c0c5ce;">})\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="63"></td><td class="code"><div><span style="color:#c0c5ce;">\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="64"></td><td class="code"><div><span style="color:#fff3bf;">test</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">five</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">, </span><span style="color:#329af0;">async </span><span style="color:#bdd4e3;">() </span><span style="color:#329af0;">=&gt; </span><span style="color:#c0c5ce;">{\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="65"></td><td class="code"><div><span style="color:#c0c5ce;">    </span><span style="color:#329af0;">const </span><span style="color:#72c3fc;">output </span><span style="color:#329af0;">= </span><span style="color:#c0c5ce;">(</span><span style="color:#35a5ff;">await </span><span style="color:#fff3bf;">indexExample</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">five</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">)).</span><span style="color:#fff3bf;">map</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">v </span><span style="color:#329af0;">=&gt; </span><span style="color:#c0c5ce;">JSON.</span><span style="color:#fff3bf;">stringify</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">v</span><span style="color:#c0c5ce;">))\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="66"></td><td class="code"><div><span style="color:#c0c5ce;">\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="67"></td><td class="code"><div><span style="color:#c0c5ce;">    </span><span style="color:#fff3bf;">expect</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">output</span><span style="color:#c0c5ce;">.</span><span style="color:#fff3bf;">join</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#96b5b4;">\\n</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">)).</span><span style="color:#fff3bf;">toMatchSnapshot</span><span style="color:#c0c5ce;">()\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="68"></td><td class="code"><div><span style="color:#c0c5ce;">})\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="69"></td><td class="code"><div><span style="color:#c0c5ce;">\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="70"></td><td class="code"><div><span style="color:#fff3bf;">test</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">cross-app</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">, </span><span style="color:#329af0;">async </span><span style="color:#bdd4e3;">() </span><span style="color:#329af0;">=&gt; </span><span style="color:#c0c5ce;">{\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="71"></td><td class="code"><div><span style="color:#c0c5ce;">    </span><span style="color:#329af0;">const </span><span style="color:#72c3fc;">app </span><span style="color:#329af0;">= </span><span style="color:#c0c5ce;">(</span><span style="color:#35a5ff;">await </span><span style="color:#fff3bf;">indexExample</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">cross-app</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">)).</span><span style="color:#fff3bf;">map</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">v </span><span style="color:#329af0;">=&gt; </span><span style="color:#c0c5ce;">JSON.</span><span style="color:#fff3bf;">stringify</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">v</span><span style="color:#c0c5ce;">))\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="72"></td><td class="code"><div><span style="color:#c0c5ce;">    </span><span style="color:#fff3bf;">expect</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">app</span><span style="color:#c0c5ce;">.</span><span style="color:#fff3bf;">join</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#96b5b4;">\\n</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">)).</span><span style="color:#fff3bf;">toMatchSnapshot</span><span style="color:#c0c5ce;">()\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="73"></td><td class="code"><div><span style="color:#c0c5ce;">})\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="74"></td><td class="code"><div><span style="color:#c0c5ce;">\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="75"></td><td class="code"><div><span style="color:#fff3bf;">test</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">cross-lib</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">, </span><span style="color:#329af0;">async </span><span style="color:#bdd4e3;">() </span><span style="color:#329af0;">=&gt; </span><span style="color:#c0c5ce;">{\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="76"></td><td class="code"><div><span style="color:#c0c5ce;">    </span><span style="color:#329af0;">const </span><span style="color:#72c3fc;">lib </span><span style="color:#329af0;">= </span><span style="color:#c0c5ce;">(</span><span style="color:#35a5ff;">await </span><span style="color:#fff3bf;">indexExample</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#ffb0af;">cross-lib</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">)).</span><span style="color:#fff3bf;">map</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">v </span><span style="color:#329af0;">=&gt; </span><span style="color:#c0c5ce;">JSON.</span><span style="color:#fff3bf;">stringify</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">v</span><span style="color:#c0c5ce;">))\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="77"></td><td class="code"><div><span style="color:#c0c5ce;">    </span><span style="color:#fff3bf;">expect</span><span style="color:#c0c5ce;">(</span><span style="color:#72c3fc;">lib</span><span style="color:#c0c5ce;">.</span><span style="color:#fff3bf;">join</span><span style="color:#c0c5ce;">(</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#96b5b4;">\\n</span><span style="color:#bdd4e3;">&#39;</span><span style="color:#c0c5ce;">)).</span><span style="color:#fff3bf;">toMatchSnapshot</span><span style="color:#c0c5ce;">()\n</span></div></td></tr>',
+                                      '<tr><td class="line" data-line="78"></td><td class="code"><div><span style="color:#c0c5ce;">})</span></div></td></tr>',
                                   ],
-                              },
+                              ],
                           },
                       },
                   },
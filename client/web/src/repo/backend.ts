@@ -191,10 +191,11 @@ export const fetchHighlightedFileLineRanges = memoizeObservable(
                             file(path: $filePath) {
                                 isDirectory
                                 richHTML
-                                highlight(disableTimeout: $disableTimeout, isLightTheme: $isLightTheme) {
-                                    aborted
-                                    lineRanges(ranges: $ranges)
-                                }
+                                highlightedLineRanges(
+                                    ranges: $ranges
+                                    disableTimeout: $disableTimeout
+                                    isLightTheme: $isLightTheme
+                                )
                             }
                         }
                     }
@@ -203,14 +204,14 @@ export const fetchHighlightedFileLineRanges = memoizeObservable(
             context
         ).pipe(
             map(({ data, errors }) => {
-                if (!data?.repository?.commit?.file?.highlight) {
+                if (!data?.repository?.commit?.file?.highlightedLineRanges) {
                     throw createAggregateError(errors)
                 }
                 const file = data.repository.commit.file
                 if (file.isDirectory) {
                     return []
                 }
-                return file.highlight.lineRanges
+                return file.highlightedLineRanges
             })
         ),
     context =>
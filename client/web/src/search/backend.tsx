@@ -12,6 +12,8 @@ import { queryGraphQL, requestGraphQL } from '../backend/graphql'
 import {
     EventLogsDataResult,
     EventLogsDataVariables,
+    RepoCollectionsResult,
+    RepoCollectionsVariables,
     CreateSavedSearchResult,
     CreateSavedSearchVariables,
     DeleteSavedSearchResult,
@@ -620,3 +622,23 @@ export function fetchRecentSearches(userId: Scalars['ID'], first: number): Obser
 export function fetchRecentFileViews(userId: Scalars['ID'], first: number): Observable<EventLogResult | null> {
     return fetchEvents(userId, first, 'ViewBlob')
 }
+
+export type RepoCollectionFields = RepoCollectionsResult['repoCollections'][number]
+
+export function fetchRepoCollections(): Observable<RepoCollectionFields[]> {
+    return requestGraphQL<RepoCollectionsResult, RepoCollectionsVariables>(
+        gql`
+            query RepoCollections {
+                repoCollections {
+                    id
+                    repoGroupName
+                    description
+                }
+            }
+        `,
+        {}
+    ).pipe(
+        map(dataOrThrowErrors),
+        map(data => data.repoCollections)
+    )
+}
@@ -16,6 +16,7 @@ import { VersionContext } from '../schema/site.schema'
 
 import {
     EventLogResult,
+    RepoCollectionFields,
     isSearchContextAvailable,
     fetchAutoDefinedSearchContexts,
     fetchSearchContexts,
@@ -232,6 +233,7 @@ export interface HomePanelsProps {
     fetchSavedSearches: () => Observable<ISavedSearch[]>
     fetchRecentSearches: (userId: string, first: number) => Observable<EventLogResult | null>
     fetchRecentFileViews: (userId: string, first: number) => Observable<EventLogResult | null>
+    fetchRepoCollections: () => Observable<RepoCollectionFields[]>
 
     /** Function that returns current time (for stability in visual tests). */
     now?: () => Date
@@ -158,10 +158,6 @@ export const Toggles: React.FunctionComponent<TogglesProps> = (props: TogglesPro
                         reason:
                             'Query contains one or more patterntype subexpressions, cannot apply global case-sensitivity',
                     },
-                    {
-                        condition: patternType === SearchPatternType.structural,
-                        reason: 'Structural search is always case sensitive',
-                    },
                 ]}
             />
             <QueryInputToggle
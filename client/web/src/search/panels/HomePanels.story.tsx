@@ -8,7 +8,13 @@ import { WebStory } from '../../components/WebStory'
 import { SearchPatternType } from '../../graphql-operations'
 
 import { HomePanels } from './HomePanels'
-import { _fetchRecentFileViews, _fetchRecentSearches, _fetchSavedSearches, authUser } from './utils'
+import {
+    _fetchRecentFileViews,
+    _fetchRecentSearches,
+    _fetchRepoCollections,
+    _fetchSavedSearches,
+    authUser,
+} from './utils'
 
 const { add } = storiesOf('web/search/panels/HomePanels', module).addParameters({
     design: {
@@ -24,6 +30,7 @@ const props = {
     fetchSavedSearches: _fetchSavedSearches,
     fetchRecentSearches: _fetchRecentSearches,
     fetchRecentFileViews: _fetchRecentFileViews,
+    fetchRepoCollections: _fetchRepoCollections,
     now: () => parseISO('2020-09-16T23:15:01Z'),
     telemetryService: NOOP_TELEMETRY_SERVICE,
     showEnterpriseHomePanels: true,
@@ -7,6 +7,7 @@ import { AuthenticatedUser } from '../../auth'
 
 import { RecentFilesPanel } from './RecentFilesPanel'
 import { RecentSearchesPanel } from './RecentSearchesPanel'
+import { RepoCollectionsPanel } from './RepoCollectionsPanel'
 import { RepogroupPanel } from './RepogroupPanel'
 import { RepositoriesPanel } from './RepositoriesPanel'
 import { SavedSearchesPanel } from './SavedSearchesPanel'
@@ -31,5 +32,10 @@ export const HomePanels: React.FunctionComponent<Props> = (props: Props) => (
                 <SavedSearchesPanel {...props} className="home-panels__panel col-lg-5" />
             )}
         </div>
+        {props.authenticatedUser && (
+            <div className="row">
+                <RepoCollectionsPanel {...props} className="home-panels__panel col-lg-12" />
+            </div>
+        )}
     </div>
 )
@@ -0,0 +1,37 @@
+import { cleanup, render } from '@testing-library/react'
+import React from 'react'
+import { of } from 'rxjs'
+
+import { NOOP_TELEMETRY_SERVICE } from '@sourcegraph/shared/src/telemetry/telemetryService'
+
+import { SearchPatternType } from '../../graphql-operations'
+
+import { RepoCollectionsPanel } from './RepoCollectionsPanel'
+import { _fetchRepoCollections } from './utils'
+
+describe('RepoCollectionsPanel', () => {
+    afterAll(cleanup)
+
+    const defaultProps = {
+        patternType: SearchPatternType.literal,
+        fetchRepoCollections: _fetchRepoCollections,
+        telemetryService: NOOP_TELEMETRY_SERVICE,
+    }
+
+    it('should link each collection to a repogroup: search', () => {
+        const { container } = render(<RepoCollectionsPanel {...defaultProps} />)
+        const links = [...container.querySelectorAll('.test-repo-collection-entry a')].map(link =>
+            link.getAttribute('href')
+        )
+        expect(links).toEqual([
+            '/search?q=repogroup:alice-services&patternType=literal',
+            '/search?q=repogroup:acme/frontend&patternType=literal',
+        ])
+    })
+
+    it('should show the empty state without collections', () => {
+        const { container } = render(<RepoCollectionsPanel {...defaultProps} fetchRepoCollections={() => of([])} />)
+        expect(container.querySelectorAll('.test-repo-collection-entry').length).toBe(0)
+        expect(container.querySelector('.panel-container__empty-container')).not.toBeNull()
+    })
+})
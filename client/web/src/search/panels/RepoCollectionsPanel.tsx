@@ -0,0 +1,84 @@
+import React, { useCallback, useEffect, useMemo } from 'react'
+import { Observable } from 'rxjs'
+
+import { Link } from '@sourcegraph/shared/src/components/Link'
+import { TelemetryProps } from '@sourcegraph/shared/src/telemetry/telemetryService'
+import { buildSearchURLQuery } from '@sourcegraph/shared/src/util/url'
+import { useObservable } from '@sourcegraph/shared/src/util/useObservable'
+
+import { SearchPatternType } from '../../graphql-operations'
+import { RepoCollectionFields } from '../backend'
+
+import { LoadingPanelView } from './LoadingPanelView'
+import { PanelContainer } from './PanelContainer'
+
+interface Props extends TelemetryProps {
+    className?: string
+    fetchRepoCollections: () => Observable<RepoCollectionFields[]>
+    patternType: SearchPatternType
+}
+
+/**
+ * Lists the repository collections of the current user and of their organizations, each linking to a search
+ * of the collection with the repogroup: filter.
+ */
+export const RepoCollectionsPanel: React.FunctionComponent<Props> = ({
+    className,
+    fetchRepoCollections,
+    patternType,
+    telemetryService,
+}) => {
+    const collections = useObservable(useMemo(() => fetchRepoCollections(), [fetchRepoCollections]))
+
+    useEffect(() => {
+        if (collections) {
+            telemetryService.log('RepoCollectionsPanelLoaded', { empty: collections.length === 0 })
+        }
+    }, [collections, telemetryService])
+
+    const logCollectionClicked = useCallback(() => telemetryService.log('RepoCollectionsPanelCollectionClicked'), [
+        telemetryService,
+    ])
+
+    const emptyDisplay = (
+        <div className="panel-container__empty-container text-muted">
+            <small>
+                Group the repositories your team owns into a collection to search them together with the{' '}
+                <strong>repogroup:</strong> filter.
+            </small>
+        </div>
+    )
+
+    const populatedContent = (
+        <dl className="list-group-flush mt-2">
+            {collections?.map(collection => (
+                <dd key={collection.id} className="test-repo-collection-entry">
+                    <small>
+                        <Link
+                            to={
+                                '/search?' +
+                                buildSearchURLQuery(`repogroup:${collection.repoGroupName}`, patternType, false)
+                            }
+                            onClick={logCollectionClicked}
+                            className="text-monospace"
+                        >
+                            {collection.repoGroupName}
+                        </Link>
+                        {collection.description && <span className="text-muted ml-2">{collection.description}</span>}
+                    </small>
+                </dd>
+            ))}
+        </dl>
+    )
+
+    return (
+        <PanelContainer
+            className={className}
+            title="Repository collections"
+            state={collections === undefined ? 'loading' : collections.length > 0 ? 'populated' : 'empty'}
+            loadingContent={<LoadingPanelView text="Loading repository collections" />}
+            emptyContent={emptyDisplay}
+            populatedContent={populatedContent}
+        />
+    )
+}
@@ -3,7 +3,7 @@ import { Observable, of } from 'rxjs'
 import { ISavedSearch, Namespace, IOrg, IUser } from '@sourcegraph/shared/src/graphql/schema'
 
 import { AuthenticatedUser } from '../../auth'
-import { EventLogResult } from '../backend'
+import { EventLogResult, RepoCollectionFields } from '../backend'
 
 export const authUser: AuthenticatedUser = {
     __typename: 'User',
@@ -354,3 +354,9 @@ export const _fetchRecentFileViews = (): Observable<EventLogResult | null> =>
         totalCount: 500,
         pageInfo: { hasNextPage: true, endCursor: null },
     })
+
+export const _fetchRepoCollections = (): Observable<RepoCollectionFields[]> =>
+    of([
+        { id: 'collection-1', repoGroupName: 'alice-services', description: 'Services I maintain' },
+        { id: 'collection-2', repoGroupName: 'acme/frontend', description: '' },
+    ])
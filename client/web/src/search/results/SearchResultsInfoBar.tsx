@@ -200,6 +200,23 @@ export const SearchResultsInfoBar: React.FunctionComponent<SearchResultsInfoBarP
         )
     }, [props.authenticatedUser, props.onSaveQueryClick, props.showSavedQueryButton])
 
+    const runInBackgroundButton = useMemo(() => {
+        if (!props.authenticatedUser || !props.query) {
+            return null
+        }
+        const searchParameters = new URLSearchParams({ q: props.query, patternType: props.patternType })
+        return (
+            <li className="nav-item" data-tooltip="Search all matching repositories without result limits">
+                <ButtonLink
+                    to={`/search/exports?${searchParameters.toString()}`}
+                    className="btn btn-sm btn-outline-secondary mr-2 nav-link text-decoration-none test-run-in-background-link"
+                >
+                    Run in background
+                </ButtonLink>
+            </li>
+        )
+    }, [props.authenticatedUser, props.query, props.patternType])
+
     const extraContext = useMemo(
         () => ({
             searchQuery: props.query || null,
@@ -246,7 +263,7 @@ export const SearchResultsInfoBar: React.FunctionComponent<SearchResultsInfoBarP
                         actionItemClass="btn nav-link btn-outline-secondary mr-2 text-decoration-none btn-sm"
                     />
 
-                    {(createCodeMonitorButton || saveSearchButton) && (
+                    {(createCodeMonitorButton || runInBackgroundButton || saveSearchButton) && (
                         <li className="search-results-info-bar__divider" aria-hidden="true" />
                     )}
                     <CreateCodeInsightButton
@@ -256,6 +273,7 @@ export const SearchResultsInfoBar: React.FunctionComponent<SearchResultsInfoBarP
                         enableCodeInsights={props.enableCodeInsights}
                     />
                     {createCodeMonitorButton}
+                    {runInBackgroundButton}
                     {saveSearchButton}
 
                     {props.resultsFound && (
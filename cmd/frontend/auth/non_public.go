@@ -123,6 +123,12 @@ func AllowAnonymousRequest(req *http.Request) bool {
 		"/.api/github-webhooks",
 		"/.api/gitlab-webhooks",
 		"/.api/bitbucket-server-webhooks",
+		"/.api/bitbucket-cloud-webhooks",
+		// Authentication is performed by the SCIM handler with its own token.
+		"/.api/scim/",
+		// Authentication is performed by the badges handler, which allows
+		// anonymous requests only if badges are public.
+		"/.api/badges/",
 	} {
 		if strings.HasPrefix(req.URL.Path, prefix) {
 			return true
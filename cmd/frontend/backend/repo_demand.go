@@ -0,0 +1,31 @@
+package backend
+
+import (
+	"context"
+	"time"
+
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+)
+
+// RecordRepoDemand records in the background that the given repos were used,
+// so that repo-updater updates them more often. Since demand only affects the
+// update schedule, errors are logged rather than returned.
+func RecordRepoDemand(db dbutil.DB, kind database.RepoDemandKind, ids ...api.RepoID) {
+	if len(ids) == 0 {
+		return
+	}
+
+	goroutine.Go(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		if err := database.RepoDemand(db).Record(ctx, kind, ids...); err != nil {
+			log15.Warn("failed to record repo demand", "kind", kind, "error", err)
+		}
+	})
+}
@@ -12,18 +12,23 @@ import (
 // Services is a bag of HTTP handlers and factory functions that are registered by the
 // enterprise frontend setup hook.
 type Services struct {
-	GitHubWebhook             webhooks.Registerer
-	GitLabWebhook             http.Handler
-	BitbucketServerWebhook    http.Handler
-	NewCodeIntelUploadHandler NewCodeIntelUploadHandler
-	NewExecutorProxyHandler   NewExecutorProxyHandler
-	AuthzResolver             graphqlbackend.AuthzResolver
-	BatchChangesResolver      graphqlbackend.BatchChangesResolver
-	CodeIntelResolver         graphqlbackend.CodeIntelResolver
-	InsightsResolver          graphqlbackend.InsightsResolver
-	CodeMonitorsResolver      graphqlbackend.CodeMonitorsResolver
-	LicenseResolver           graphqlbackend.LicenseResolver
-	DotcomResolver            graphqlbackend.DotcomRootResolver
+	GitHubWebhook               webhooks.Registerer
+	GitLabWebhook               http.Handler
+	BitbucketServerWebhook      http.Handler
+	NewCodeIntelUploadHandler   NewCodeIntelUploadHandler
+	CodeIntelRangesHandler      http.Handler
+	SearchExportDownloadHandler http.Handler
+	NewExecutorProxyHandler     NewExecutorProxyHandler
+	SCIMHandler                 http.Handler
+	BadgesHandler               http.Handler
+	AuthzResolver               graphqlbackend.AuthzResolver
+	BatchChangesResolver        graphqlbackend.BatchChangesResolver
+	CodeIntelResolver           graphqlbackend.CodeIntelResolver
+	InsightsResolver            graphqlbackend.InsightsResolver
+	CodeMonitorsResolver        graphqlbackend.CodeMonitorsResolver
+	LicenseResolver             graphqlbackend.LicenseResolver
+	DotcomResolver              graphqlbackend.DotcomRootResolver
+	SearchExportsResolver       graphqlbackend.SearchExportsResolver
 }
 
 // NewCodeIntelUploadHandler creates a new handler for the LSIF upload endpoint. The
@@ -38,11 +43,15 @@ type NewExecutorProxyHandler func() http.Handler
 // DefaultServices creates a new Services value that has default implementations for all services.
 func DefaultServices() Services {
 	return Services{
-		GitHubWebhook:             registerFunc(func(webhook *webhooks.GitHubWebhook) {}),
-		GitLabWebhook:             makeNotFoundHandler("gitlab webhook"),
-		BitbucketServerWebhook:    makeNotFoundHandler("bitbucket server webhook"),
-		NewCodeIntelUploadHandler: func(_ bool) http.Handler { return makeNotFoundHandler("code intel upload") },
-		NewExecutorProxyHandler:   func() http.Handler { return makeNotFoundHandler("executor proxy") },
+		GitHubWebhook:               registerFunc(func(webhook *webhooks.GitHubWebhook) {}),
+		GitLabWebhook:               makeNotFoundHandler("gitlab webhook"),
+		BitbucketServerWebhook:      makeNotFoundHandler("bitbucket server webhook"),
+		NewCodeIntelUploadHandler:   func(_ bool) http.Handler { return makeNotFoundHandler("code intel upload") },
+		CodeIntelRangesHandler:      makeNotFoundHandler("code intel ranges"),
+		SearchExportDownloadHandler: makeNotFoundHandler("search export download"),
+		NewExecutorProxyHandler:     func() http.Handler { return makeNotFoundHandler("executor proxy") },
+		SCIMHandler:                 makeNotFoundHandler("SCIM"),
+		BadgesHandler:               makeNotFoundHandler("badges"),
 	}
 }
 
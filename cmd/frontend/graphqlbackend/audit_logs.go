@@ -0,0 +1,168 @@
+package graphqlbackend
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+)
+
+type auditLogsArgs struct {
+	graphqlutil.ConnectionArgs
+	After       *string
+	Action      *string
+	SubjectType *string
+	SubjectID   *string
+	Actor       *graphql.ID
+}
+
+func (r *schemaResolver) AuditLogs(ctx context.Context, args *auditLogsArgs) (*auditLogConnectionResolver, error) {
+	// 🚨 SECURITY: Only site admins may view the audit log.
+	if err := backend.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	var opt database.AuditLogsListOptions
+	if args.After != nil {
+		afterID, err := unmarshalAuditLogID(graphql.ID(*args.After))
+		if err != nil {
+			return nil, err
+		}
+		opt.AfterID = afterID
+	}
+	if args.Action != nil {
+		opt.Action = *args.Action
+	}
+	if args.SubjectType != nil {
+		opt.SubjectType = *args.SubjectType
+	}
+	if args.SubjectID != nil {
+		opt.SubjectID = *args.SubjectID
+	}
+	if args.Actor != nil {
+		actorUserID, err := UnmarshalUserID(*args.Actor)
+		if err != nil {
+			return nil, err
+		}
+		opt.ActorUserID = actorUserID
+	}
+	args.ConnectionArgs.Set(&opt.LimitOffset)
+	return &auditLogConnectionResolver{db: r.db, opt: opt}, nil
+}
+
+type auditLogConnectionResolver struct {
+	db  dbutil.DB
+	opt database.AuditLogsListOptions
+
+	// cache results because they are used by multiple fields
+	once sync.Once
+	logs []*database.AuditLog
+	err  error
+}
+
+func (r *auditLogConnectionResolver) compute(ctx context.Context) ([]*database.AuditLog, error) {
+	r.once.Do(func() {
+		r.logs, r.err = database.AuditLogs(r.db).List(ctx, r.opt)
+	})
+	return r.logs, r.err
+}
+
+func (r *auditLogConnectionResolver) Nodes(ctx context.Context) ([]*auditLogResolver, error) {
+	logs, err := r.compute(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resolvers := make([]*auditLogResolver, 0, len(logs))
+	for _, l := range logs {
+		resolvers = append(resolvers, &auditLogResolver{db: r.db, log: l})
+	}
+	return resolvers, nil
+}
+
+func (r *auditLogConnectionResolver) TotalCount(ctx context.Context) (int32, error) {
+	// Reset pagination cursor to get correct total count
+	opt := r.opt
+	opt.AfterID = 0
+	count, err := database.AuditLogs(r.db).Count(ctx, opt)
+	return int32(count), err
+}
+
+func (r *auditLogConnectionResolver) PageInfo(ctx context.Context) (*graphqlutil.PageInfo, error) {
+	logs, err := r.compute(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// We would have had all results when no limit set
+	if r.opt.LimitOffset == nil || len(logs) < r.opt.Limit {
+		return graphqlutil.HasNextPage(false), nil
+	}
+
+	// In case the number of results happens to be the same as the limit,
+	// we need another query to determine if there are more results.
+	count, err := database.AuditLogs(r.db).Count(ctx, r.opt)
+	if err != nil {
+		return nil, err
+	}
+	if count > len(logs) {
+		return graphqlutil.NextPageCursor(string(marshalAuditLogID(logs[len(logs)-1].ID))), nil
+	}
+	return graphqlutil.HasNextPage(false), nil
+}
+
+type auditLogResolver struct {
+	db  dbutil.DB
+	log *database.AuditLog
+}
+
+func marshalAuditLogID(id int64) graphql.ID { return relay.MarshalID("AuditLog", id) }
+
+func unmarshalAuditLogID(id graphql.ID) (auditLogID int64, err error) {
+	err = relay.UnmarshalSpec(id, &auditLogID)
+	return
+}
+
+func (r *auditLogResolver) ID() graphql.ID { return marshalAuditLogID(r.log.ID) }
+
+func (r *auditLogResolver) Actor(ctx context.Context) (*UserResolver, error) {
+	if r.log.ActorUserID == 0 {
+		return nil, nil
+	}
+	user, err := UserByIDInt32(ctx, r.db, r.log.ActorUserID)
+	if errcode.IsNotFound(err) {
+		// The actor may have been deleted since.
+		return nil, nil
+	}
+	return user, err
+}
+
+func (r *auditLogResolver) Action() string      { return r.log.Action }
+func (r *auditLogResolver) SubjectType() string { return r.log.SubjectType }
+func (r *auditLogResolver) SubjectID() string   { return r.log.SubjectID }
+
+func (r *auditLogResolver) IP() *string {
+	if r.log.IP == "" {
+		return nil
+	}
+	return &r.log.IP
+}
+
+func (r *auditLogResolver) Before() *JSONValue { return auditLogState(r.log.Before) }
+func (r *auditLogResolver) After() *JSONValue  { return auditLogState(r.log.After) }
+
+func auditLogState(state json.RawMessage) *JSONValue {
+	if state == nil {
+		return nil
+	}
+	return &JSONValue{Value: state}
+}
+
+func (r *auditLogResolver) CreatedAt() DateTime { return DateTime{Time: r.log.CreatedAt} }
@@ -228,6 +228,11 @@ type MergeChangesetsArgs struct {
 	Squash bool
 }
 
+type CreateBatchSpecExecutionArgs struct {
+	Spec      string
+	Namespace graphql.ID
+}
+
 type BatchChangesResolver interface {
 	//
 	// MUTATIONS
@@ -258,6 +263,7 @@ type BatchChangesResolver interface {
 	CreateChangesetComments(ctx context.Context, args *CreateChangesetCommentsArgs) (BulkOperationResolver, error)
 	ReenqueueChangesets(ctx context.Context, args *ReenqueueChangesetsArgs) (BulkOperationResolver, error)
 	MergeChangesets(ctx context.Context, args *MergeChangesetsArgs) (BulkOperationResolver, error)
+	CreateBatchSpecExecution(ctx context.Context, args *CreateBatchSpecExecutionArgs) (BatchSpecExecutionResolver, error)
 
 	// Queries
 
@@ -292,6 +298,19 @@ type BulkOperationResolver interface {
 	FinishedAt() *DateTime
 }
 
+type BatchSpecExecutionResolver interface {
+	ID() graphql.ID
+	InputSpec() string
+	State() string
+	CreatedAt() DateTime
+	StartedAt() *DateTime
+	FinishedAt() *DateTime
+	Failure() *string
+	BatchSpec(ctx context.Context) (BatchSpecResolver, error)
+	Initiator(ctx context.Context) (*UserResolver, error)
+	Namespace(ctx context.Context) (*NamespaceResolver, error)
+}
+
 type ChangesetJobErrorResolver interface {
 	Changeset() ChangesetResolver
 	Error() *string
@@ -20,6 +20,8 @@ type CodeMonitorsResolver interface {
 	UpdateCodeMonitor(ctx context.Context, args *UpdateCodeMonitorArgs) (MonitorResolver, error)
 	ResetTriggerQueryTimestamps(ctx context.Context, args *ResetTriggerQueryTimestampsArgs) (*EmptyResponse, error)
 	TriggerTestEmailAction(ctx context.Context, args *TriggerTestEmailActionArgs) (*EmptyResponse, error)
+	TriggerTestWebhookAction(ctx context.Context, args *TriggerTestWebhookActionArgs) (*EmptyResponse, error)
+	TriggerTestSlackWebhookAction(ctx context.Context, args *TriggerTestSlackWebhookActionArgs) (*EmptyResponse, error)
 
 	NodeResolvers() map[string]NodeByIDFunc
 }
@@ -73,6 +75,8 @@ type MonitorActionConnectionResolver interface {
 
 type MonitorAction interface {
 	ToMonitorEmail() (MonitorEmailResolver, bool)
+	ToMonitorWebhook() (MonitorWebhookResolver, bool)
+	ToMonitorSlackWebhook() (MonitorSlackWebhookResolver, bool)
 }
 
 type MonitorEmailResolver interface {
@@ -84,6 +88,20 @@ type MonitorEmailResolver interface {
 	Events(ctx context.Context, args *ListEventsArgs) (MonitorActionEventConnectionResolver, error)
 }
 
+type MonitorWebhookResolver interface {
+	ID() graphql.ID
+	Enabled() bool
+	URL() string
+	Events(ctx context.Context, args *ListEventsArgs) (MonitorActionEventConnectionResolver, error)
+}
+
+type MonitorSlackWebhookResolver interface {
+	ID() graphql.ID
+	Enabled() bool
+	URL() string
+	Events(ctx context.Context, args *ListEventsArgs) (MonitorActionEventConnectionResolver, error)
+}
+
 type MonitorEmailRecipient interface {
 	ToUser() (*UserResolver, bool)
 }
@@ -138,7 +156,9 @@ type CreateTriggerArgs struct {
 }
 
 type CreateActionArgs struct {
-	Email *CreateActionEmailArgs
+	Email        *CreateActionEmailArgs
+	Webhook      *CreateActionWebhookArgs
+	SlackWebhook *CreateActionSlackWebhookArgs
 }
 
 type CreateActionEmailArgs struct {
@@ -148,6 +168,16 @@ type CreateActionEmailArgs struct {
 	Header     string
 }
 
+type CreateActionWebhookArgs struct {
+	Enabled bool
+	URL     string
+}
+
+type CreateActionSlackWebhookArgs struct {
+	Enabled bool
+	URL     string
+}
+
 type ToggleCodeMonitorArgs struct {
 	Id      graphql.ID
 	Enabled bool
@@ -167,6 +197,18 @@ type TriggerTestEmailActionArgs struct {
 	Email       *CreateActionEmailArgs
 }
 
+type TriggerTestWebhookActionArgs struct {
+	Namespace   graphql.ID
+	Description string
+	Webhook     *CreateActionWebhookArgs
+}
+
+type TriggerTestSlackWebhookActionArgs struct {
+	Namespace    graphql.ID
+	Description  string
+	SlackWebhook *CreateActionSlackWebhookArgs
+}
+
 type CreateMonitorArgs struct {
 	Namespace   graphql.ID
 	Description string
@@ -178,8 +220,20 @@ type EditActionEmailArgs struct {
 	Update *CreateActionEmailArgs
 }
 
+type EditActionWebhookArgs struct {
+	Id     *graphql.ID
+	Update *CreateActionWebhookArgs
+}
+
+type EditActionSlackWebhookArgs struct {
+	Id     *graphql.ID
+	Update *CreateActionSlackWebhookArgs
+}
+
 type EditActionArgs struct {
-	Email *EditActionEmailArgs
+	Email        *EditActionEmailArgs
+	Webhook      *EditActionWebhookArgs
+	SlackWebhook *EditActionSlackWebhookArgs
 }
 
 type EditTriggerArgs struct {
@@ -15,15 +15,24 @@ type CodeIntelResolver interface {
 	LSIFUploads(ctx context.Context, args *LSIFUploadsQueryArgs) (LSIFUploadConnectionResolver, error)
 	LSIFUploadsByRepo(ctx context.Context, args *LSIFRepositoryUploadsQueryArgs) (LSIFUploadConnectionResolver, error)
 	DeleteLSIFUpload(ctx context.Context, args *struct{ ID graphql.ID }) (*EmptyResponse, error)
+	ReindexLSIFUpload(ctx context.Context, args *struct{ ID graphql.ID }) (*EmptyResponse, error)
 	LSIFIndexByID(ctx context.Context, id graphql.ID) (LSIFIndexResolver, error)
 	LSIFIndexes(ctx context.Context, args *LSIFIndexesQueryArgs) (LSIFIndexConnectionResolver, error)
 	LSIFIndexesByRepo(ctx context.Context, args *LSIFRepositoryIndexesQueryArgs) (LSIFIndexConnectionResolver, error)
 	DeleteLSIFIndex(ctx context.Context, args *struct{ ID graphql.ID }) (*EmptyResponse, error)
+	LSIFDiagnostics(ctx context.Context, args *LSIFDiagnosticsQueryArgs) (AggregatedDiagnosticConnectionResolver, error)
+	LSIFDiagnosticsByRepo(ctx context.Context, args *LSIFRepositoryDiagnosticsQueryArgs) (AggregatedDiagnosticConnectionResolver, error)
 	IndexConfiguration(ctx context.Context, id graphql.ID) (IndexConfigurationResolver, error) // TODO - rename ...ForRepo
 	UpdateRepositoryIndexConfiguration(ctx context.Context, args *UpdateRepositoryIndexConfigurationArgs) (*EmptyResponse, error)
 	CommitGraph(ctx context.Context, id graphql.ID) (CodeIntelligenceCommitGraphResolver, error)
+	CodeIntelligenceCoverage(ctx context.Context, id graphql.ID, args *CodeIntelligenceCoverageArgs) (CodeIntelligenceCoverageResolver, error)
 	QueueAutoIndexJobForRepo(ctx context.Context, args *struct{ Repository graphql.ID }) (*EmptyResponse, error)
 	GitBlobLSIFData(ctx context.Context, args *GitBlobLSIFDataArgs) (GitBlobLSIFDataResolver, error)
+	CodeIntelligenceRetentionPolicies(ctx context.Context) ([]CodeIntelligenceRetentionPolicyResolver, error)
+	CreateCodeIntelligenceRetentionPolicy(ctx context.Context, args *CreateCodeIntelligenceRetentionPolicyArgs) (CodeIntelligenceRetentionPolicyResolver, error)
+	UpdateCodeIntelligenceRetentionPolicy(ctx context.Context, args *UpdateCodeIntelligenceRetentionPolicyArgs) (*EmptyResponse, error)
+	DeleteCodeIntelligenceRetentionPolicy(ctx context.Context, args *struct{ ID graphql.ID }) (*EmptyResponse, error)
+	CodeIntelligenceUsageStatistics(ctx context.Context, args *CodeIntelligenceUsageStatisticsArgs) ([]CodeIntelligenceUsageStatisticsResolver, error)
 
 	NodeResolvers() map[string]NodeByIDFunc
 }
@@ -55,6 +64,15 @@ type LSIFUploadResolver interface {
 	PlaceInQueue() *int32
 	AssociatedIndex(ctx context.Context) (LSIFIndexResolver, error)
 	ProjectRoot(ctx context.Context) (*GitTreeEntryResolver, error)
+	Progress(ctx context.Context) (LSIFUploadProgressResolver, error)
+}
+
+type LSIFUploadProgressResolver interface {
+	Phase() string
+	BytesRead() BigInt
+	TotalBytes() *BigInt
+	DocumentsWritten() int32
+	UpdatedAt() DateTime
 }
 
 type LSIFUploadConnectionResolver interface {
@@ -75,6 +93,18 @@ type LSIFRepositoryIndexesQueryArgs struct {
 	RepositoryID graphql.ID
 }
 
+type LSIFDiagnosticsQueryArgs struct {
+	graphqlutil.ConnectionArgs
+	Severities *[]string
+	GroupBy    *string
+	After      *string
+}
+
+type LSIFRepositoryDiagnosticsQueryArgs struct {
+	*LSIFDiagnosticsQueryArgs
+	RepositoryID graphql.ID
+}
+
 type LSIFIndexResolver interface {
 	ID() graphql.ID
 	InputCommit() string
@@ -140,6 +170,45 @@ type QueueAutoIndexJobArgs struct {
 	Repository graphql.ID
 }
 
+type CreateCodeIntelligenceRetentionPolicyArgs struct {
+	Name                   string
+	RepositoryPattern      string
+	Type                   string
+	Pattern                string
+	RetentionDurationHours *int32
+}
+
+type UpdateCodeIntelligenceRetentionPolicyArgs struct {
+	ID graphql.ID
+	CreateCodeIntelligenceRetentionPolicyArgs
+}
+
+type CodeIntelligenceRetentionPolicyResolver interface {
+	ID() graphql.ID
+	Name() string
+	RepositoryPattern() string
+	Type() string
+	Pattern() string
+	RetentionDurationHours() *int32
+	CreatedAt() DateTime
+}
+
+type CodeIntelligenceUsageStatisticsArgs struct {
+	Since DateTime
+}
+
+type CodeIntelligenceUsageStatisticsResolver interface {
+	Repository(ctx context.Context) (*RepositoryResolver, error)
+	Definitions() CodeIntelligenceUsageCountsResolver
+	Hover() CodeIntelligenceUsageCountsResolver
+	References() CodeIntelligenceUsageCountsResolver
+}
+
+type CodeIntelligenceUsageCountsResolver interface {
+	Precise() int32
+	SearchBased() int32
+}
+
 type GitTreeLSIFDataResolver interface {
 	Diagnostics(ctx context.Context, args *LSIFDiagnosticsArgs) (DiagnosticConnectionResolver, error)
 	DocumentationPage(ctx context.Context, args *LSIFDocumentationPageArgs) (DocumentationPageResolver, error)
@@ -150,6 +219,26 @@ type CodeIntelligenceCommitGraphResolver interface {
 	UpdatedAt(ctx context.Context) (*DateTime, error)
 }
 
+type CodeIntelligenceCoverageArgs struct {
+	Branch  *string
+	Commits *int32
+}
+
+type CodeIntelligenceCoverageResolver interface {
+	Commit() *string
+	CommitCount() int32
+	CoveredCommitCount() int32
+	CommitCoverage() float64
+	Languages() []CodeIntelligenceLanguageCoverageResolver
+}
+
+type CodeIntelligenceLanguageCoverageResolver interface {
+	Language() string
+	FileCount() int32
+	CoveredFileCount() int32
+	FileCoverage() float64
+}
+
 type GitBlobLSIFDataResolver interface {
 	GitTreeLSIFDataResolver
 	ToGitTreeLSIFData() (GitTreeLSIFDataResolver, bool)
@@ -158,7 +247,25 @@ type GitBlobLSIFDataResolver interface {
 	Ranges(ctx context.Context, args *LSIFRangesArgs) (CodeIntelligenceRangeConnectionResolver, error)
 	Definitions(ctx context.Context, args *LSIFQueryPositionArgs) (LocationConnectionResolver, error)
 	References(ctx context.Context, args *LSIFPagedQueryPositionArgs) (LocationConnectionResolver, error)
-	Hover(ctx context.Context, args *LSIFQueryPositionArgs) (HoverResolver, error)
+	Implementations(ctx context.Context, args *LSIFPagedQueryPositionArgs) (LocationConnectionResolver, error)
+	WriteReferences(ctx context.Context, args *LSIFPagedQueryPositionArgs) (LocationConnectionResolver, error)
+	Hover(ctx context.Context, args *LSIFHoverArgs) (HoverResolver, error)
+	DocumentSymbols(ctx context.Context) ([]DocumentSymbolResolver, error)
+	FoldingRanges(ctx context.Context) ([]FoldingRangeResolver, error)
+}
+
+type DocumentSymbolResolver interface {
+	Name() string
+	Detail() *string
+	Kind() string /* enum SymbolKind */
+	Range() RangeResolver
+	FullRange() RangeResolver
+	Children() []DocumentSymbolResolver
+}
+
+type FoldingRangeResolver interface {
+	StartLine() int32
+	EndLine() int32
 }
 
 type GitBlobLSIFDataArgs struct {
@@ -179,6 +286,11 @@ type LSIFQueryPositionArgs struct {
 	Character int32
 }
 
+type LSIFHoverArgs struct {
+	LSIFQueryPositionArgs
+	Format *string
+}
+
 type LSIFPagedQueryPositionArgs struct {
 	LSIFQueryPositionArgs
 	graphqlutil.ConnectionArgs
@@ -187,6 +299,7 @@ type LSIFPagedQueryPositionArgs struct {
 
 type LSIFDiagnosticsArgs struct {
 	graphqlutil.ConnectionArgs
+	After *string
 }
 
 type CodeIntelligenceRangeConnectionResolver interface {
@@ -198,6 +311,14 @@ type CodeIntelligenceRangeResolver interface {
 	Definitions(ctx context.Context) (LocationConnectionResolver, error)
 	References(ctx context.Context) (LocationConnectionResolver, error)
 	Hover(ctx context.Context) (HoverResolver, error)
+	Source() CodeIntelligenceRangeSourceResolver
+}
+
+type CodeIntelligenceRangeSourceResolver interface {
+	UploadID() graphql.ID
+	Indexer() string
+	Commit() string
+	Root() string
 }
 
 type LocationConnectionResolver interface {
@@ -216,6 +337,18 @@ type DiagnosticConnectionResolver interface {
 	PageInfo(ctx context.Context) (*graphqlutil.PageInfo, error)
 }
 
+type AggregatedDiagnosticConnectionResolver interface {
+	Nodes(ctx context.Context) ([]DiagnosticResolver, error)
+	TotalCount(ctx context.Context) (int32, error)
+	Groups(ctx context.Context) ([]DiagnosticGroupResolver, error)
+	PageInfo(ctx context.Context) (*graphqlutil.PageInfo, error)
+}
+
+type DiagnosticGroupResolver interface {
+	Key() string
+	Count() int32
+}
+
 type DiagnosticResolver interface {
 	Severity() (*string, error)
 	Code() (*string, error)
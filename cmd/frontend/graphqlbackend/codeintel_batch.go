@@ -0,0 +1,122 @@
+package graphqlbackend
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+)
+
+// maxCodeIntelBatchRequests is the maximum number of requests in a single
+// codeIntelBatch query.
+const maxCodeIntelBatchRequests = 500
+
+type CodeIntelBatchArgs struct {
+	Repository  graphql.ID
+	Commit      string
+	ToolName    *string
+	HoverFormat *string
+	Requests    []*CodeIntelBatchRequest
+}
+
+type CodeIntelBatchRequest struct {
+	Path      string
+	Line      int32
+	Character int32
+	Kind      string
+}
+
+type codeIntelBatchResultResolver struct {
+	hover       HoverResolver
+	definitions LocationConnectionResolver
+}
+
+func (r *codeIntelBatchResultResolver) Hover() HoverResolver { return r.hover }
+
+func (r *codeIntelBatchResultResolver) Definitions() LocationConnectionResolver {
+	return r.definitions
+}
+
+// CodeIntelBatch answers a list of hover and definitions requests for files of
+// one repository at one commit. The requests are grouped by path, so that the
+// uploads of each path are looked up once, and the results are returned in the
+// order of the requests.
+func (r *schemaResolver) CodeIntelBatch(ctx context.Context, args *CodeIntelBatchArgs) ([]*codeIntelBatchResultResolver, error) {
+	if len(args.Requests) > maxCodeIntelBatchRequests {
+		return nil, errors.Errorf("too many requests: at most %d requests can be batched", maxCodeIntelBatchRequests)
+	}
+	for _, req := range args.Requests {
+		if req.Kind != "HOVER" && req.Kind != "DEFINITIONS" {
+			return nil, errors.Errorf("unknown request kind %q", req.Kind)
+		}
+	}
+
+	// 🚨 SECURITY: repositoryByID only returns repositories the user can access.
+	repoResolver, err := r.repositoryByID(ctx, args.Repository)
+	if err != nil {
+		return nil, err
+	}
+	commit, err := repoResolver.Commit(ctx, &RepositoryCommitArgs{Rev: args.Commit})
+	if err != nil {
+		return nil, err
+	}
+	if commit == nil {
+		return nil, errors.Errorf("revision not found: %s", args.Commit)
+	}
+	repo, err := repoResolver.repo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	codeIntelRequests.WithLabelValues(trace.RequestOrigin(ctx)).Inc()
+	backend.RecordRepoDemand(r.db, database.RepoDemandCodeIntel, repo.ID)
+
+	var toolName string
+	if args.ToolName != nil {
+		toolName = *args.ToolName
+	}
+
+	results := make([]*codeIntelBatchResultResolver, len(args.Requests))
+	lsifDataByPath := map[string]GitBlobLSIFDataResolver{}
+	for i, req := range args.Requests {
+		lsifData, ok := lsifDataByPath[req.Path]
+		if !ok {
+			lsifData, err = EnterpriseResolvers.codeIntelResolver.GitBlobLSIFData(ctx, &GitBlobLSIFDataArgs{
+				Repo:      repo,
+				Commit:    api.CommitID(commit.OID()),
+				Path:      strings.TrimPrefix(req.Path, "/"),
+				ExactPath: true,
+				ToolName:  toolName,
+			})
+			if err != nil {
+				return nil, err
+			}
+			lsifDataByPath[req.Path] = lsifData
+		}
+
+		results[i] = &codeIntelBatchResultResolver{}
+		if lsifData == nil {
+			// No upload can answer queries for this path
+			continue
+		}
+
+		position := LSIFQueryPositionArgs{Line: req.Line, Character: req.Character}
+		switch req.Kind {
+		case "HOVER":
+			results[i].hover, err = lsifData.Hover(ctx, &LSIFHoverArgs{LSIFQueryPositionArgs: position, Format: args.HoverFormat})
+		case "DEFINITIONS":
+			results[i].definitions, err = lsifData.Definitions(ctx, &position)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
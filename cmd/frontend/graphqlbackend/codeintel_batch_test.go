@@ -0,0 +1,118 @@
+package graphqlbackend
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtesting"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+type fakeCodeIntelResolver struct {
+	CodeIntelResolver
+	lsifDataArgs []*GitBlobLSIFDataArgs
+}
+
+func (r *fakeCodeIntelResolver) GitBlobLSIFData(ctx context.Context, args *GitBlobLSIFDataArgs) (GitBlobLSIFDataResolver, error) {
+	r.lsifDataArgs = append(r.lsifDataArgs, args)
+	if args.Path == "unindexed.go" {
+		return nil, nil
+	}
+	return &fakeGitBlobLSIFDataResolver{path: args.Path}, nil
+}
+
+type fakeGitBlobLSIFDataResolver struct {
+	GitBlobLSIFDataResolver
+	path string
+}
+
+func (r *fakeGitBlobLSIFDataResolver) Hover(ctx context.Context, args *LSIFHoverArgs) (HoverResolver, error) {
+	return &fakeHoverResolver{markdown: fmt.Sprintf("%s:%d:%d", r.path, args.Line, args.Character)}, nil
+}
+
+func (r *fakeGitBlobLSIFDataResolver) Definitions(ctx context.Context, args *LSIFQueryPositionArgs) (LocationConnectionResolver, error) {
+	return &fakeLocationConnectionResolver{}, nil
+}
+
+type fakeHoverResolver struct {
+	HoverResolver
+	markdown string
+}
+
+type fakeLocationConnectionResolver struct {
+	LocationConnectionResolver
+}
+
+func TestCodeIntelBatch(t *testing.T) {
+	resetMocks()
+	database.Mocks.Repos.MockGet(t, 2)
+	backend.Mocks.Repos.ResolveRev = func(ctx context.Context, repo *types.Repo, rev string) (api.CommitID, error) {
+		if rev != "main" {
+			t.Errorf("unexpected rev. want=%q have=%q", "main", rev)
+		}
+		return exampleCommitSHA1, nil
+	}
+
+	codeIntel := &fakeCodeIntelResolver{}
+	EnterpriseResolvers.codeIntelResolver = codeIntel
+	defer func() { EnterpriseResolvers.codeIntelResolver = nil }()
+
+	r := &schemaResolver{db: new(dbtesting.MockDB)}
+	results, err := r.CodeIntelBatch(context.Background(), &CodeIntelBatchArgs{
+		Repository: MarshalRepositoryID(2),
+		Commit:     "main",
+		Requests: []*CodeIntelBatchRequest{
+			{Path: "a.go", Line: 1, Character: 2, Kind: "HOVER"},
+			{Path: "unindexed.go", Line: 3, Character: 4, Kind: "HOVER"},
+			{Path: "b.go", Line: 5, Character: 6, Kind: "DEFINITIONS"},
+			{Path: "a.go", Line: 7, Character: 8, Kind: "HOVER"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The uploads of each path are looked up once.
+	var paths []string
+	for _, args := range codeIntel.lsifDataArgs {
+		if args.Commit != exampleCommitSHA1 || !args.ExactPath {
+			t.Errorf("unexpected arguments: %+v", args)
+		}
+		paths = append(paths, args.Path)
+	}
+	if diff := cmp.Diff([]string{"a.go", "unindexed.go", "b.go"}, paths); diff != "" {
+		t.Errorf("unexpected paths (-want +got):\n%s", diff)
+	}
+
+	// The results are in the order of the requests.
+	var summaries []string
+	for _, result := range results {
+		switch {
+		case result.Hover() != nil:
+			summaries = append(summaries, "hover "+result.Hover().(*fakeHoverResolver).markdown)
+		case result.Definitions() != nil:
+			summaries = append(summaries, "definitions")
+		default:
+			summaries = append(summaries, "none")
+		}
+	}
+	if diff := cmp.Diff([]string{"hover a.go:1:2", "none", "definitions", "hover a.go:7:8"}, summaries); diff != "" {
+		t.Errorf("unexpected results (-want +got):\n%s", diff)
+	}
+}
+
+func TestCodeIntelBatchUnknownKind(t *testing.T) {
+	r := &schemaResolver{db: new(dbtesting.MockDB)}
+	_, err := r.CodeIntelBatch(context.Background(), &CodeIntelBatchArgs{
+		Requests: []*CodeIntelBatchRequest{{Path: "a.go", Kind: "REFERENCES"}},
+	})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
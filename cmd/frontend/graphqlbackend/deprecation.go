@@ -0,0 +1,68 @@
+package graphqlbackend
+
+import (
+	"context"
+	"sync"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var deprecatedFieldRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "src_graphql_deprecated_field_requests_total",
+	Help: "Total number of GraphQL requests which resolved a deprecated field.",
+}, []string{"type", "field", "source"})
+
+// deprecatedFields returns the deprecated fields of the schema, keyed by the
+// name of their type and their name.
+func deprecatedFields(schema *graphql.Schema) map[[2]string]struct{} {
+	fields := map[[2]string]struct{}{}
+	for _, t := range schema.Inspect().Types() {
+		typeFields := t.Fields(&struct{ IncludeDeprecated bool }{IncludeDeprecated: true})
+		if t.Name() == nil || typeFields == nil {
+			continue
+		}
+		for _, f := range *typeFields {
+			if f.IsDeprecated() {
+				fields[[2]string{*t.Name(), f.Name()}] = struct{}{}
+			}
+		}
+	}
+	return fields
+}
+
+type deprecatedFieldUsageKey struct{}
+
+// deprecatedFieldUsage collects the deprecated fields resolved by a request, so
+// that each field is counted once per request no matter how many times it is
+// resolved.
+type deprecatedFieldUsage struct {
+	mu     sync.Mutex
+	fields map[[2]string]struct{}
+}
+
+func withDeprecatedFieldUsage(ctx context.Context) (context.Context, *deprecatedFieldUsage) {
+	u := &deprecatedFieldUsage{fields: map[[2]string]struct{}{}}
+	return context.WithValue(ctx, deprecatedFieldUsageKey{}, u), u
+}
+
+// recordDeprecatedFieldUsage records that the request of ctx resolved the
+// deprecated field.
+func recordDeprecatedFieldUsage(ctx context.Context, field [2]string) {
+	u, ok := ctx.Value(deprecatedFieldUsageKey{}).(*deprecatedFieldUsage)
+	if !ok {
+		return
+	}
+	u.mu.Lock()
+	u.fields[field] = struct{}{}
+	u.mu.Unlock()
+}
+
+func (u *deprecatedFieldUsage) report(source string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	for field := range u.fields {
+		deprecatedFieldRequests.WithLabelValues(field[0], field[1], source).Inc()
+	}
+}
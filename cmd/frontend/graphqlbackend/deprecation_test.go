@@ -0,0 +1,58 @@
+package graphqlbackend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/graph-gophers/graphql-go"
+)
+
+func TestDeprecatedFields(t *testing.T) {
+	schema, err := graphql.ParseSchema(`
+schema {
+	query: Query
+}
+
+type Query {
+	user: User
+	oldUser: User @deprecated(reason: "use user")
+}
+
+type User {
+	name: String!
+	login: String! @deprecated
+}
+`, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[[2]string]struct{}{
+		{"Query", "oldUser"}: {},
+		{"User", "login"}:    {},
+	}
+	if diff := cmp.Diff(want, deprecatedFields(schema)); diff != "" {
+		t.Errorf("unexpected deprecated fields (-want +got):\n%s", diff)
+	}
+}
+
+func TestDeprecatedFieldUsage(t *testing.T) {
+	ctx, usage := withDeprecatedFieldUsage(context.Background())
+	for i := 0; i < 3; i++ {
+		recordDeprecatedFieldUsage(ctx, [2]string{"User", "login"})
+	}
+	recordDeprecatedFieldUsage(ctx, [2]string{"Query", "oldUser"})
+
+	// Fields are only counted once per request.
+	want := map[[2]string]struct{}{
+		{"Query", "oldUser"}: {},
+		{"User", "login"}:    {},
+	}
+	if diff := cmp.Diff(want, usage.fields); diff != "" {
+		t.Errorf("unexpected usage (-want +got):\n%s", diff)
+	}
+
+	// Usage outside of a request is ignored.
+	recordDeprecatedFieldUsage(context.Background(), [2]string{"User", "login"})
+}
@@ -3,6 +3,7 @@ package graphqlbackend
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/cockroachdb/errors"
@@ -125,6 +126,10 @@ func (r *externalServiceResolver) WebhookURL() (*string, error) {
 			if len(c.Webhooks) > 0 {
 				r.webhookURL = u
 			}
+		case *schema.BitbucketCloudConnection:
+			if len(c.Webhooks) > 0 {
+				r.webhookURL = u
+			}
 		}
 	})
 	if r.webhookURL == "" {
@@ -169,6 +174,99 @@ func (r *externalServiceResolver) NextSyncAt() *DateTime {
 	return &DateTime{Time: r.externalService.NextSyncAt}
 }
 
+func (r *externalServiceResolver) SyncStats(ctx context.Context) (*externalServiceSyncStatsResolver, error) {
+	stats, err := database.ExternalServices(r.db).GetSyncStats(ctx, r.externalService.ID)
+	if err != nil || stats == nil {
+		return nil, err
+	}
+	return &externalServiceSyncStatsResolver{stats: stats}, nil
+}
+
+type externalServiceSyncStatsResolver struct {
+	stats *types.ExternalServiceSyncStats
+}
+
+func (r *externalServiceSyncStatsResolver) StartedAt() DateTime {
+	return DateTime{Time: r.stats.StartedAt}
+}
+
+func (r *externalServiceSyncStatsResolver) FinishedAt() DateTime {
+	return DateTime{Time: r.stats.FinishedAt}
+}
+
+func (r *externalServiceSyncStatsResolver) DurationSeconds() float64 {
+	return r.stats.FinishedAt.Sub(r.stats.StartedAt).Seconds()
+}
+
+func (r *externalServiceSyncStatsResolver) LastSuccessAt() *DateTime {
+	if r.stats.LastSuccessAt.IsZero() {
+		return nil
+	}
+	return &DateTime{Time: r.stats.LastSuccessAt}
+}
+
+func (r *externalServiceSyncStatsResolver) ReposSourced() int32 { return int32(r.stats.ReposSourced) }
+
+func (r *externalServiceSyncStatsResolver) ReposAdded() int32 { return int32(r.stats.ReposAdded) }
+
+func (r *externalServiceSyncStatsResolver) ReposModified() int32 { return int32(r.stats.ReposModified) }
+
+func (r *externalServiceSyncStatsResolver) ReposRemoved() int32 { return int32(r.stats.ReposRemoved) }
+
+func (r *externalServiceSyncStatsResolver) Error() *string {
+	if r.stats.Error == "" {
+		return nil
+	}
+	return &r.stats.Error
+}
+
+func (r *externalServiceSyncStatsResolver) ErrorClass() *string {
+	if r.stats.ErrorClass == "" {
+		return nil
+	}
+	return &r.stats.ErrorClass
+}
+
+func (r *externalServiceSyncStatsResolver) ConsecutiveFailures() int32 {
+	return int32(r.stats.ConsecutiveFailures)
+}
+
+func (r *externalServiceSyncStatsResolver) ErrorCounts() []*externalServiceSyncErrorCountResolver {
+	counts := make([]*externalServiceSyncErrorCountResolver, 0, len(r.stats.ErrorCounts))
+	for class, count := range r.stats.ErrorCounts {
+		counts = append(counts, &externalServiceSyncErrorCountResolver{class: class, count: int32(count)})
+	}
+	sort.Slice(counts, func(i, j int) bool { return counts[i].class < counts[j].class })
+	return counts
+}
+
+func (r *externalServiceSyncStatsResolver) RateLimit() *externalServiceRateLimitResolver {
+	if !r.stats.RateLimitKnown {
+		return nil
+	}
+	return &externalServiceRateLimitResolver{stats: r.stats}
+}
+
+type externalServiceSyncErrorCountResolver struct {
+	class string
+	count int32
+}
+
+func (r *externalServiceSyncErrorCountResolver) Class() string { return r.class }
+func (r *externalServiceSyncErrorCountResolver) Count() int32  { return r.count }
+
+type externalServiceRateLimitResolver struct {
+	stats *types.ExternalServiceSyncStats
+}
+
+func (r *externalServiceRateLimitResolver) Remaining() int32 {
+	return int32(r.stats.RateLimitRemaining)
+}
+
+func (r *externalServiceRateLimitResolver) ResetAt() DateTime {
+	return DateTime{Time: r.stats.RateLimitResetAt}
+}
+
 var scopeCache = rcache.New("extsvc_token_scope")
 
 func (r *externalServiceResolver) GrantedScopes(ctx context.Context) (*[]string, error) {
@@ -27,15 +27,22 @@ type FileResolver interface {
 }
 
 func richHTML(content, ext string) (string, error) {
-	switch ext {
-	case ".md", ".mdown", ".markdown", ".markdn":
-		break
-	default:
+	if !hasRichHTML(ext) {
 		return "", nil
 	}
 	return markdown.Render(content), nil
 }
 
+// hasRichHTML returns true if files with the extension ext are rendered as rich
+// HTML.
+func hasRichHTML(ext string) bool {
+	switch ext {
+	case ".md", ".mdown", ".markdown", ".markdn":
+		return true
+	}
+	return false
+}
+
 type markdownOptions struct {
 	AlwaysNil *string
 }
@@ -219,6 +219,7 @@ func (r *GitCommitResolver) Blob(ctx context.Context, args *struct {
 	if !stat.Mode().IsRegular() {
 		return nil, fmt.Errorf("not a blob: %q", args.Path)
 	}
+	recordViewedFile(r.repoResolver.IDInt32(), api.CommitID(r.oid), args.Path)
 	return &GitTreeEntryResolver{
 		db:     r.db,
 		commit: r,
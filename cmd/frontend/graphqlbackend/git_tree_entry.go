@@ -16,11 +16,13 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/globals"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/externallink"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/cloneurls"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/highlight"
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
@@ -88,6 +90,11 @@ func (r *GitTreeEntryResolver) Content(ctx context.Context) (string, error) {
 }
 
 func (r *GitTreeEntryResolver) RichHTML(ctx context.Context) (string, error) {
+	// Avoid reading the content of files which are not rendered, since
+	// clients ask for it along with previews of possibly very large files.
+	if !hasRichHTML(path.Ext(r.Path())) {
+		return "", nil
+	}
 	content, err := r.Content(ctx)
 	if err != nil {
 		return "", err
@@ -114,6 +121,63 @@ func (r *GitTreeEntryResolver) Highlight(ctx context.Context, args *HighlightArg
 	})
 }
 
+const (
+	// maxWholeHighlightSize is the size of the largest file which is
+	// highlighted as a whole by HighlightedLineRanges. Only the requested lines
+	// of larger files are read and highlighted.
+	maxWholeHighlightSize = 1 << 20
+
+	// maxLineRangeBytes is the maximum number of bytes read for each line range
+	// of files larger than maxWholeHighlightSize.
+	maxLineRangeBytes = 256 << 10
+)
+
+type highlightedLineRangesArgs struct {
+	Ranges         []highlight.LineRange
+	DisableTimeout bool
+	IsLightTheme   bool
+}
+
+// HighlightedLineRanges returns the specified line ranges of the highlighted
+// file like Highlight followed by LineRanges, but reads and highlights only the
+// requested lines of very large files. It is used to preview search matches.
+func (r *GitTreeEntryResolver) HighlightedLineRanges(ctx context.Context, args *highlightedLineRangesArgs) ([][]string, error) {
+	if r.stat.Size() <= maxWholeHighlightSize {
+		highlighted, err := r.Highlight(ctx, &HighlightArgs{
+			DisableTimeout: args.DisableTimeout,
+			IsLightTheme:   args.IsLightTheme,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return highlighted.LineRanges(&struct{ Ranges []highlight.LineRange }{Ranges: args.Ranges})
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	rc, err := git.NewFileReader(ctx, r.commit.repoResolver.RepoName(), api.CommitID(r.commit.OID()), r.Path())
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	chunks, err := highlight.ReadLineRanges(rc, args.Ranges, maxLineRangeBytes)
+	if err != nil {
+		return nil, err
+	}
+	lineRanges, _, err := highlight.CodeLineRanges(ctx, highlight.Params{
+		Filepath:       r.Path(),
+		DisableTimeout: args.DisableTimeout,
+		IsLightTheme:   args.IsLightTheme,
+		Metadata: highlight.Metadata{
+			RepoName: r.commit.repoResolver.Name(),
+			Revision: string(r.commit.oid),
+		},
+	}, args.Ranges, chunks)
+	return lineRanges, err
+}
+
 func (r *GitTreeEntryResolver) Commit() *GitCommitResolver { return r.commit }
 
 func (r *GitTreeEntryResolver) Repository() *RepositoryResolver { return r.commit.repoResolver }
@@ -229,6 +293,7 @@ func (r *GitTreeEntryResolver) LSIF(ctx context.Context, args *struct{ ToolName
 	if err != nil {
 		return nil, err
 	}
+	backend.RecordRepoDemand(r.db, database.RepoDemandCodeIntel, repo.ID)
 
 	return EnterpriseResolvers.codeIntelResolver.GitBlobLSIFData(ctx, &GitBlobLSIFDataArgs{
 		Repo:      repo,
@@ -48,6 +48,10 @@ var (
 type prometheusTracer struct {
 	db dbutil.DB
 	trace.OpenTracingTracer
+
+	// deprecatedFields are the deprecated fields of the schema, whose usage is
+	// counted per request.
+	deprecatedFields map[[2]string]struct{}
 }
 
 func (t *prometheusTracer) TraceQuery(ctx context.Context, queryString string, operationName string, variables map[string]interface{}, varTypes map[string]*introspection.Type) (context.Context, trace.TraceQueryFinishFunc) {
@@ -58,6 +62,7 @@ func (t *prometheusTracer) TraceQuery(ctx context.Context, queryString string, o
 	}
 
 	ctx = context.WithValue(ctx, sgtrace.GraphQLQueryKey, queryString)
+	ctx, deprecatedFieldUsage := withDeprecatedFieldUsage(ctx)
 
 	_, disableLog := os.LookupEnv("NO_GRAPHQL_LOG")
 
@@ -101,6 +106,7 @@ VARIABLES
 		if finish != nil {
 			finish(err)
 		}
+		deprecatedFieldUsage.report(string(requestSource))
 		d := time.Since(start)
 		if v := conf.Get().ObservabilityLogSlowGraphQLRequests; v != 0 && d.Milliseconds() > int64(v) {
 			encodedVariables, _ := json.Marshal(variables)
@@ -121,8 +127,11 @@ VARIABLES
 	}
 }
 
-func (prometheusTracer) TraceField(ctx context.Context, label, typeName, fieldName string, trivial bool, args map[string]interface{}) (context.Context, trace.TraceFieldFinishFunc) {
+func (t *prometheusTracer) TraceField(ctx context.Context, label, typeName, fieldName string, trivial bool, args map[string]interface{}) (context.Context, trace.TraceFieldFinishFunc) {
 	start := time.Now()
+	if _, ok := t.deprecatedFields[[2]string{typeName, fieldName}]; ok {
+		recordDeprecatedFieldUsage(ctx, [2]string{typeName, fieldName})
+	}
 	return ctx, func(err *gqlerrors.QueryError) {
 		isErrStr := strconv.FormatBool(err != nil)
 		graphqlFieldHistogram.WithLabelValues(
@@ -330,7 +339,7 @@ func prometheusGraphQLRequestName(requestName string) string {
 	return "other"
 }
 
-func NewSchema(db dbutil.DB, batchChanges BatchChangesResolver, codeIntel CodeIntelResolver, insights InsightsResolver, authz AuthzResolver, codeMonitors CodeMonitorsResolver, license LicenseResolver, dotcom DotcomRootResolver) (*graphql.Schema, error) {
+func NewSchema(db dbutil.DB, batchChanges BatchChangesResolver, codeIntel CodeIntelResolver, insights InsightsResolver, authz AuthzResolver, codeMonitors CodeMonitorsResolver, license LicenseResolver, dotcom DotcomRootResolver, searchExports SearchExportsResolver) (*graphql.Schema, error) {
 	resolver := newSchemaResolver(db)
 	schemas := []string{mainSchema}
 
@@ -393,12 +402,28 @@ func NewSchema(db dbutil.DB, batchChanges BatchChangesResolver, codeIntel CodeIn
 		}
 	}
 
-	return graphql.ParseSchema(
+	if searchExports != nil {
+		EnterpriseResolvers.searchExportsResolver = searchExports
+		resolver.SearchExportsResolver = searchExports
+		schemas = append(schemas, searchExportsSchema)
+		// Register NodeByID handlers.
+		for kind, res := range searchExports.NodeResolvers() {
+			resolver.nodeByIDFns[kind] = res
+		}
+	}
+
+	tracer := &prometheusTracer{db: db}
+	schema, err := graphql.ParseSchema(
 		strings.Join(schemas, "\n"),
 		resolver,
-		graphql.Tracer(&prometheusTracer{db: db}),
+		graphql.Tracer(tracer),
 		graphql.UseStringDescriptions(),
 	)
+	if err != nil {
+		return nil, err
+	}
+	tracer.deprecatedFields = deprecatedFields(schema)
+	return schema, nil
 }
 
 // schemaResolver handles all GraphQL queries for Sourcegraph. To do this, it
@@ -412,6 +437,7 @@ type schemaResolver struct {
 	CodeMonitorsResolver
 	LicenseResolver
 	DotcomRootResolver
+	SearchExportsResolver
 
 	db                dbutil.DB
 	repoupdaterClient *repoupdater.Client
@@ -457,6 +483,9 @@ func newSchemaResolver(db dbutil.DB) *schemaResolver {
 		"RegistryExtension": func(ctx context.Context, id graphql.ID) (Node, error) {
 			return RegistryExtensionByID(ctx, db, id)
 		},
+		"RepoCollection": func(ctx context.Context, id graphql.ID) (Node, error) {
+			return r.repoCollectionByID(ctx, id)
+		},
 		"SavedSearch": func(ctx context.Context, id graphql.ID) (Node, error) {
 			return r.savedSearchByID(ctx, id)
 		},
@@ -476,13 +505,14 @@ func newSchemaResolver(db dbutil.DB) *schemaResolver {
 // EnterpriseResolvers holds the instances of resolvers which are enabled only
 // in enterprise mode. These resolver instances are nil when running as OSS.
 var EnterpriseResolvers = struct {
-	codeIntelResolver    CodeIntelResolver
-	insightsResolver     InsightsResolver
-	authzResolver        AuthzResolver
-	batchChangesResolver BatchChangesResolver
-	codeMonitorsResolver CodeMonitorsResolver
-	licenseResolver      LicenseResolver
-	dotcomResolver       DotcomRootResolver
+	codeIntelResolver     CodeIntelResolver
+	insightsResolver      InsightsResolver
+	authzResolver         AuthzResolver
+	batchChangesResolver  BatchChangesResolver
+	codeMonitorsResolver  CodeMonitorsResolver
+	licenseResolver       LicenseResolver
+	dotcomResolver        DotcomRootResolver
+	searchExportsResolver SearchExportsResolver
 }{}
 
 // DEPRECATED
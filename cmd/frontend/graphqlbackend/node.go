@@ -67,6 +67,16 @@ func (r *NodeResolver) ToMonitorEmail() (MonitorEmailResolver, bool) {
 	return n, ok
 }
 
+func (r *NodeResolver) ToMonitorWebhook() (MonitorWebhookResolver, bool) {
+	n, ok := r.Node.(MonitorWebhookResolver)
+	return n, ok
+}
+
+func (r *NodeResolver) ToMonitorSlackWebhook() (MonitorSlackWebhookResolver, bool) {
+	n, ok := r.Node.(MonitorSlackWebhookResolver)
+	return n, ok
+}
+
 func (r *NodeResolver) ToMonitorActionEvent() (MonitorActionEventResolver, bool) {
 	n, ok := r.Node.(MonitorActionEventResolver)
 	return n, ok
@@ -214,6 +224,11 @@ func (r *NodeResolver) ToRegistryExtension() (RegistryExtension, bool) {
 	return NodeToRegistryExtension(r.Node)
 }
 
+func (r *NodeResolver) ToRepoCollection() (*repoCollectionResolver, bool) {
+	n, ok := r.Node.(*repoCollectionResolver)
+	return n, ok
+}
+
 func (r *NodeResolver) ToSavedSearch() (*savedSearchResolver, bool) {
 	n, ok := r.Node.(*savedSearchResolver)
 	return n, ok
@@ -248,3 +263,13 @@ func (r *NodeResolver) ToBulkOperation() (BulkOperationResolver, bool) {
 	n, ok := r.Node.(BulkOperationResolver)
 	return n, ok
 }
+
+func (r *NodeResolver) ToBatchSpecExecution() (BatchSpecExecutionResolver, bool) {
+	n, ok := r.Node.(BatchSpecExecutionResolver)
+	return n, ok
+}
+
+func (r *NodeResolver) ToSearchExport() (SearchExportResolver, bool) {
+	n, ok := r.Node.(SearchExportResolver)
+	return n, ok
+}
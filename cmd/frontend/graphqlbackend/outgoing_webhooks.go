@@ -0,0 +1,243 @@
+package graphqlbackend
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/outgoingwebhooks"
+)
+
+func marshalOutgoingWebhookID(id int32) graphql.ID { return relay.MarshalID("OutgoingWebhook", id) }
+
+func unmarshalOutgoingWebhookID(id graphql.ID) (webhookID int32, err error) {
+	err = relay.UnmarshalSpec(id, &webhookID)
+	return
+}
+
+// marshalOutgoingWebhookEventType converts an event type such as
+// "saved_search.fired" to its GraphQL enum value SAVED_SEARCH_FIRED.
+func marshalOutgoingWebhookEventType(eventType string) string {
+	return strings.ToUpper(strings.ReplaceAll(eventType, ".", "_"))
+}
+
+func unmarshalOutgoingWebhookEventTypes(values []string) ([]string, error) {
+	eventTypes := make([]string, 0, len(values))
+	for _, v := range values {
+		var found bool
+		for _, eventType := range outgoingwebhooks.EventTypes {
+			if marshalOutgoingWebhookEventType(eventType) == v {
+				eventTypes = append(eventTypes, eventType)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.Errorf("unknown event type %q", v)
+		}
+	}
+	return eventTypes, nil
+}
+
+func validateOutgoingWebhookURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.Errorf("invalid outgoing webhook URL %q: must be an absolute http or https URL", rawURL)
+	}
+	return nil
+}
+
+type outgoingWebhookResolver struct {
+	db dbutil.DB
+	w  *database.OutgoingWebhook
+}
+
+func (r *outgoingWebhookResolver) ID() graphql.ID { return marshalOutgoingWebhookID(r.w.ID) }
+
+func (r *outgoingWebhookResolver) URL() string { return r.w.URL }
+
+func (r *outgoingWebhookResolver) EventTypes() []string {
+	eventTypes := make([]string, 0, len(r.w.EventTypes))
+	for _, eventType := range r.w.EventTypes {
+		eventTypes = append(eventTypes, marshalOutgoingWebhookEventType(eventType))
+	}
+	return eventTypes
+}
+
+func (r *outgoingWebhookResolver) Enabled() bool { return r.w.Enabled }
+
+func (r *outgoingWebhookResolver) CreatedAt() DateTime { return DateTime{Time: r.w.CreatedAt} }
+
+func (r *outgoingWebhookResolver) UpdatedAt() DateTime { return DateTime{Time: r.w.UpdatedAt} }
+
+func (r *outgoingWebhookResolver) Deliveries(ctx context.Context, args *struct {
+	First int32
+}) ([]*outgoingWebhookDeliveryResolver, error) {
+	deliveries, err := database.OutgoingWebhooks(r.db).ListDeliveries(ctx, r.w.ID, int(args.First))
+	if err != nil {
+		return nil, err
+	}
+	resolvers := make([]*outgoingWebhookDeliveryResolver, 0, len(deliveries))
+	for _, d := range deliveries {
+		resolvers = append(resolvers, &outgoingWebhookDeliveryResolver{d: d})
+	}
+	return resolvers, nil
+}
+
+type outgoingWebhookDeliveryResolver struct {
+	d *database.OutgoingWebhookDelivery
+}
+
+func (r *outgoingWebhookDeliveryResolver) EventType() string {
+	return marshalOutgoingWebhookEventType(r.d.EventType)
+}
+
+func (r *outgoingWebhookDeliveryResolver) Payload() JSONValue {
+	return JSONValue{Value: r.d.Payload}
+}
+
+func (r *outgoingWebhookDeliveryResolver) State() string { return strings.ToUpper(r.d.State) }
+
+func (r *outgoingWebhookDeliveryResolver) Attempts() int32 { return int32(r.d.Attempts) }
+
+func (r *outgoingWebhookDeliveryResolver) ResponseStatusCode() *int32 {
+	if r.d.ResponseStatusCode == nil {
+		return nil
+	}
+	code := int32(*r.d.ResponseStatusCode)
+	return &code
+}
+
+func (r *outgoingWebhookDeliveryResolver) ResponseBody() *string { return r.d.ResponseBody }
+
+func (r *outgoingWebhookDeliveryResolver) FailureMessage() *string { return r.d.FailureMessage }
+
+func (r *outgoingWebhookDeliveryResolver) CreatedAt() DateTime { return DateTime{Time: r.d.CreatedAt} }
+
+func (r *outgoingWebhookDeliveryResolver) FinishedAt() *DateTime {
+	return DateTimeOrNil(r.d.FinishedAt)
+}
+
+func (r *schemaResolver) OutgoingWebhooks(ctx context.Context) ([]*outgoingWebhookResolver, error) {
+	// 🚨 SECURITY: Only site admins can view outgoing webhooks.
+	if err := backend.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	webhooks, err := database.OutgoingWebhooks(r.db).List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resolvers := make([]*outgoingWebhookResolver, 0, len(webhooks))
+	for _, w := range webhooks {
+		resolvers = append(resolvers, &outgoingWebhookResolver{db: r.db, w: w})
+	}
+	return resolvers, nil
+}
+
+func (r *schemaResolver) CreateOutgoingWebhook(ctx context.Context, args *struct {
+	URL        string
+	Secret     string
+	EventTypes []string
+	Enabled    bool
+}) (*outgoingWebhookResolver, error) {
+	// 🚨 SECURITY: Only site admins can create outgoing webhooks.
+	if err := backend.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := validateOutgoingWebhookURL(args.URL); err != nil {
+		return nil, err
+	}
+	eventTypes, err := unmarshalOutgoingWebhookEventTypes(args.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &database.OutgoingWebhook{
+		URL:             args.URL,
+		Secret:          args.Secret,
+		EventTypes:      eventTypes,
+		Enabled:         args.Enabled,
+		CreatedByUserID: actor.FromContext(ctx).UID,
+	}
+	if err := database.OutgoingWebhooks(r.db).Create(ctx, w); err != nil {
+		return nil, err
+	}
+	return &outgoingWebhookResolver{db: r.db, w: w}, nil
+}
+
+func (r *schemaResolver) UpdateOutgoingWebhook(ctx context.Context, args *struct {
+	ID         graphql.ID
+	URL        *string
+	Secret     *string
+	EventTypes *[]string
+	Enabled    *bool
+}) (*outgoingWebhookResolver, error) {
+	// 🚨 SECURITY: Only site admins can update outgoing webhooks.
+	if err := backend.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	id, err := unmarshalOutgoingWebhookID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	store := database.OutgoingWebhooks(r.db)
+	w, err := store.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if args.URL != nil {
+		if err := validateOutgoingWebhookURL(*args.URL); err != nil {
+			return nil, err
+		}
+		w.URL = *args.URL
+	}
+	if args.Secret != nil {
+		w.Secret = *args.Secret
+	}
+	if args.EventTypes != nil {
+		if w.EventTypes, err = unmarshalOutgoingWebhookEventTypes(*args.EventTypes); err != nil {
+			return nil, err
+		}
+	}
+	if args.Enabled != nil {
+		w.Enabled = *args.Enabled
+	}
+
+	if err := store.Update(ctx, w); err != nil {
+		return nil, err
+	}
+	return &outgoingWebhookResolver{db: r.db, w: w}, nil
+}
+
+func (r *schemaResolver) DeleteOutgoingWebhook(ctx context.Context, args *struct {
+	ID graphql.ID
+}) (*EmptyResponse, error) {
+	// 🚨 SECURITY: Only site admins can delete outgoing webhooks.
+	if err := backend.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	id, err := unmarshalOutgoingWebhookID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := database.OutgoingWebhooks(r.db).Delete(ctx, id); err != nil {
+		return nil, err
+	}
+	return &EmptyResponse{}, nil
+}
@@ -0,0 +1,81 @@
+package graphqlbackend
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+)
+
+// PersistedQueryHash returns the hex-encoded SHA-256 hash by which clients
+// refer to a persisted query.
+func PersistedQueryHash(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
+
+type persistedQueryResolver struct {
+	q *database.PersistedQuery
+}
+
+func (r *persistedQueryResolver) Hash() string { return r.q.Hash }
+
+func (r *persistedQueryResolver) Query() string { return r.q.Query }
+
+func (r *persistedQueryResolver) Allowed() bool { return r.q.Allowed }
+
+func (r *persistedQueryResolver) CreatedAt() DateTime { return DateTime{Time: r.q.CreatedAt} }
+
+func (r *schemaResolver) PersistedQueries(ctx context.Context, args *struct {
+	AllowedOnly bool
+	First       int32
+}) ([]*persistedQueryResolver, error) {
+	// 🚨 SECURITY: Only site admins can view persisted queries.
+	if err := backend.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	queries, err := database.PersistedQueries(r.db).List(ctx, database.PersistedQueriesListOptions{
+		AllowedOnly: args.AllowedOnly,
+		LimitOffset: &database.LimitOffset{Limit: int(args.First)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	resolvers := make([]*persistedQueryResolver, 0, len(queries))
+	for _, q := range queries {
+		resolvers = append(resolvers, &persistedQueryResolver{q: q})
+	}
+	return resolvers, nil
+}
+
+func (r *schemaResolver) AllowPersistedQuery(ctx context.Context, args *struct {
+	Query string
+}) (*persistedQueryResolver, error) {
+	// 🚨 SECURITY: Only site admins can add queries to the allow-list.
+	if err := backend.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	q, err := database.PersistedQueries(r.db).Create(ctx, PersistedQueryHash(args.Query), args.Query, true)
+	if err != nil {
+		return nil, err
+	}
+	return &persistedQueryResolver{q: q}, nil
+}
+
+func (r *schemaResolver) DeletePersistedQuery(ctx context.Context, args *struct {
+	Hash string
+}) (*EmptyResponse, error) {
+	// 🚨 SECURITY: Only site admins can delete persisted queries.
+	if err := backend.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := database.PersistedQueries(r.db).Delete(ctx, args.Hash); err != nil {
+		return nil, err
+	}
+	return &EmptyResponse{}, nil
+}
@@ -0,0 +1,113 @@
+package graphqlbackend
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/repoupdater/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+type previewExternalServiceSyncArgs struct {
+	Input struct {
+		ID     *graphql.ID
+		Kind   *string
+		Config string
+	}
+}
+
+func (r *schemaResolver) PreviewExternalServiceSync(ctx context.Context, args *previewExternalServiceSyncArgs) (*externalServiceSyncPreviewResolver, error) {
+	// 🚨 SECURITY: Only site admins may preview external service syncs, since
+	// the preview lists repositories of the code host.
+	if err := backend.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	svc := &types.ExternalService{Config: args.Input.Config}
+	if args.Input.ID != nil {
+		id, err := unmarshalExternalServiceID(*args.Input.ID)
+		if err != nil {
+			return nil, err
+		}
+		old, err := database.ExternalServices(r.db).GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		svc.ID = old.ID
+		svc.Kind = old.Kind
+		svc.DisplayName = old.DisplayName
+		svc.NamespaceUserID = old.NamespaceUserID
+		// The config of existing external services is shown to admins with
+		// its secrets redacted, so they are sent back redacted too.
+		if err := svc.UnredactConfig(old); err != nil {
+			return nil, errors.Wrap(err, "error unredacting config")
+		}
+	} else if args.Input.Kind != nil {
+		svc.Kind = *args.Input.Kind
+	} else {
+		return nil, errors.New("the kind of a new external service is required")
+	}
+
+	if _, err := database.ExternalServices(r.db).ValidateConfig(ctx, database.ValidateExternalServiceConfigOptions{
+		ExternalServiceID: svc.ID,
+		Kind:              svc.Kind,
+		Config:            svc.Config,
+		AuthProviders:     conf.Get().AuthProviders,
+		NamespaceUserID:   svc.NamespaceUserID,
+	}); err != nil {
+		return nil, err
+	}
+
+	result, err := r.repoupdaterClient.PreviewExternalServiceSync(ctx, api.ExternalService{
+		ID:              svc.ID,
+		Kind:            svc.Kind,
+		DisplayName:     svc.DisplayName,
+		Config:          svc.Config,
+		NamespaceUserID: svc.NamespaceUserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &externalServiceSyncPreviewResolver{result: result}, nil
+}
+
+type externalServiceSyncPreviewResolver struct {
+	result *protocol.ExternalServiceSyncPreviewResult
+}
+
+func (r *externalServiceSyncPreviewResolver) Added() []string {
+	return repoNamesToStrings(r.result.Added)
+}
+
+func (r *externalServiceSyncPreviewResolver) Removed() []string {
+	return repoNamesToStrings(r.result.Removed)
+}
+
+func (r *externalServiceSyncPreviewResolver) Excluded() []string {
+	return repoNamesToStrings(r.result.Excluded)
+}
+
+func (r *externalServiceSyncPreviewResolver) Renamed() []*externalServiceSyncPreviewRenameResolver {
+	renames := make([]*externalServiceSyncPreviewRenameResolver, 0, len(r.result.Renamed))
+	for _, rename := range r.result.Renamed {
+		renames = append(renames, &externalServiceSyncPreviewRenameResolver{rename: rename})
+	}
+	return renames
+}
+
+func (r *externalServiceSyncPreviewResolver) UnmodifiedCount() int32 {
+	return int32(r.result.Unmodified)
+}
+
+type externalServiceSyncPreviewRenameResolver struct {
+	rename protocol.RepoRename
+}
+
+func (r *externalServiceSyncPreviewRenameResolver) From() string { return string(r.rename.From) }
+func (r *externalServiceSyncPreviewRenameResolver) To() string   { return string(r.rename.To) }
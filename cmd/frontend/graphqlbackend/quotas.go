@@ -0,0 +1,127 @@
+package graphqlbackend
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/quota"
+)
+
+type quotaOverrideResolver struct {
+	db dbutil.DB
+	o  *database.QuotaOverride
+}
+
+// unmarshalQuota returns the quota of the given GraphQL Quota enum value.
+func unmarshalQuota(s string) (quota.Quota, error) {
+	q := quota.Quota(strings.ToLower(s))
+	for _, known := range quota.All {
+		if q == known {
+			return q, nil
+		}
+	}
+	return "", errors.Errorf("unknown quota %q", s)
+}
+
+func (r *quotaOverrideResolver) Quota() string { return strings.ToUpper(r.o.Quota) }
+
+func (r *quotaOverrideResolver) Namespace(ctx context.Context) (*NamespaceResolver, error) {
+	id := MarshalUserID(r.o.UserID)
+	if r.o.OrgID != 0 {
+		id = MarshalOrgID(r.o.OrgID)
+	}
+	n, err := NamespaceByID(ctx, r.db, id)
+	if err != nil {
+		return nil, err
+	}
+	return &NamespaceResolver{n}, nil
+}
+
+func (r *quotaOverrideResolver) Limit() *BigInt { return BigIntOrNil(r.o.Limit) }
+
+func (r *quotaOverrideResolver) UpdatedAt() DateTime { return DateTime{Time: r.o.UpdatedAt} }
+
+func (r *schemaResolver) QuotaOverrides(ctx context.Context, args *struct {
+	Namespace *graphql.ID
+}) ([]*quotaOverrideResolver, error) {
+	// 🚨 SECURITY: Only site admins can view quota overrides.
+	if err := backend.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	var opts database.QuotaOverridesListOptions
+	if args.Namespace != nil {
+		if err := UnmarshalNamespaceID(*args.Namespace, &opts.UserID, &opts.OrgID); err != nil {
+			return nil, err
+		}
+	}
+	overrides, err := database.QuotaOverrides(r.db).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resolvers := make([]*quotaOverrideResolver, 0, len(overrides))
+	for _, o := range overrides {
+		resolvers = append(resolvers, &quotaOverrideResolver{db: r.db, o: o})
+	}
+	return resolvers, nil
+}
+
+func (r *schemaResolver) SetQuotaOverride(ctx context.Context, args *struct {
+	Namespace graphql.ID
+	Quota     string
+	Limit     *BigInt
+}) (*quotaOverrideResolver, error) {
+	// 🚨 SECURITY: Only site admins can override quotas.
+	if err := backend.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	q, err := unmarshalQuota(args.Quota)
+	if err != nil {
+		return nil, err
+	}
+	o := &database.QuotaOverride{Quota: string(q)}
+	if err := UnmarshalNamespaceID(args.Namespace, &o.UserID, &o.OrgID); err != nil {
+		return nil, err
+	}
+	if args.Limit != nil {
+		if args.Limit.Int < 0 {
+			return nil, errors.New("quota limit must not be negative")
+		}
+		o.Limit = &args.Limit.Int
+	}
+
+	if err := database.QuotaOverrides(r.db).Upsert(ctx, o); err != nil {
+		return nil, err
+	}
+	return &quotaOverrideResolver{db: r.db, o: o}, nil
+}
+
+func (r *schemaResolver) DeleteQuotaOverride(ctx context.Context, args *struct {
+	Namespace graphql.ID
+	Quota     string
+}) (*EmptyResponse, error) {
+	// 🚨 SECURITY: Only site admins can delete quota overrides.
+	if err := backend.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	q, err := unmarshalQuota(args.Quota)
+	if err != nil {
+		return nil, err
+	}
+	var userID, orgID int32
+	if err := UnmarshalNamespaceID(args.Namespace, &userID, &orgID); err != nil {
+		return nil, err
+	}
+	if err := database.QuotaOverrides(r.db).Delete(ctx, string(q), userID, orgID); err != nil {
+		return nil, err
+	}
+	return &EmptyResponse{}, nil
+}
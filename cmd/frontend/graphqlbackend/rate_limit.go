@@ -141,6 +141,33 @@ func EstimateQueryCost(query string, variables map[string]interface{}) (totalCos
 	return totalCost, nil
 }
 
+// CheckQueryCostLimit returns an error if the cost of a query exceeds the
+// limits of the "api.queryCostLimit" site configuration setting. costErr is
+// the error of estimating the cost, if any.
+//
+// 🚨 SECURITY: If a limit is set and the cost could not be estimated, the
+// query is rejected, so that queries which trip up the estimation can't
+// bypass the limit.
+func CheckQueryCostLimit(cost *QueryCost, costErr error) error {
+	limit := conf.Get().ApiQueryCostLimit
+	if limit == nil || (limit.MaxCost <= 0 && limit.MaxDepth <= 0) {
+		return nil
+	}
+	if costErr != nil {
+		return errors.Errorf("query cost could not be estimated: %s", costErr)
+	}
+	if cost == nil {
+		return nil
+	}
+	if limit.MaxCost > 0 && cost.FieldCount > limit.MaxCost {
+		return errors.Errorf("query cost %d exceeds the limit of %d: request fewer fields or smaller pages", cost.FieldCount, limit.MaxCost)
+	}
+	if limit.MaxDepth > 0 && cost.MaxDepth > limit.MaxDepth {
+		return errors.Errorf("query depth %d exceeds the limit of %d", cost.MaxDepth, limit.MaxDepth)
+	}
+	return nil
+}
+
 func calcNodeCost(def ast.Node, fragmentCosts map[string]int, variables map[string]interface{}) (*QueryCost, error) {
 	// NOTE: When we encounter errors in our visit funcs we return
 	// visitor.ActionBreak to stop walking the tree and set the top level err
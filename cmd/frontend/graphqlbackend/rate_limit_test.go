@@ -5,10 +5,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/google/go-cmp/cmp"
 	"github.com/throttled/throttled/v2"
 	"github.com/throttled/throttled/v2/store/memstore"
 
+	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 	"github.com/sourcegraph/sourcegraph/schema"
 )
@@ -419,6 +421,57 @@ fragment UsableFields on Usable {
 	}
 }
 
+func TestCheckQueryCostLimit(t *testing.T) {
+	conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+		ApiQueryCostLimit: &schema.ApiQueryCostLimit{MaxCost: 100, MaxDepth: 5},
+	}})
+	defer conf.Mock(nil)
+
+	tests := []struct {
+		name    string
+		cost    *QueryCost
+		costErr error
+		wantErr bool
+	}{
+		{name: "no cost", cost: nil},
+		{name: "under limits", cost: &QueryCost{FieldCount: 100, MaxDepth: 5}},
+		{name: "cost over limit", cost: &QueryCost{FieldCount: 101, MaxDepth: 1}, wantErr: true},
+		{name: "depth over limit", cost: &QueryCost{FieldCount: 1, MaxDepth: 6}, wantErr: true},
+		{name: "estimation error", costErr: errors.New("unknown fragment"), wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := CheckQueryCostLimit(tc.cost, tc.costErr); (err != nil) != tc.wantErr {
+				t.Fatalf("unexpected error. want error=%v have=%v", tc.wantErr, err)
+			}
+		})
+	}
+
+	// Estimation errors are only fatal when a limit is set.
+	conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+		ApiQueryCostLimit: &schema.ApiQueryCostLimit{},
+	}})
+	if err := CheckQueryCostLimit(nil, errors.New("unknown fragment")); err != nil {
+		t.Fatalf("unexpected error without limits: %v", err)
+	}
+}
+
+func TestCheckQueryCostLimitEstimationError(t *testing.T) {
+	conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+		ApiQueryCostLimit: &schema.ApiQueryCostLimit{MaxCost: 100},
+	}})
+	defer conf.Mock(nil)
+
+	// A query whose cost can't be estimated must not bypass the limit.
+	cost, costErr := EstimateQueryCost("query { ...Unknown }", nil)
+	if costErr == nil {
+		t.Fatal("expected the cost estimation to fail")
+	}
+	if err := CheckQueryCostLimit(cost, costErr); err == nil {
+		t.Fatal("expected the query to be rejected")
+	}
+}
+
 func TestRatelimitFromConfig(t *testing.T) {
 	testCases := []struct {
 		name   string
@@ -0,0 +1,298 @@
+package graphqlbackend
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+)
+
+// maxRepoCollectionRepos is the maximum number of repositories which can be
+// added to a repo collection at once.
+const maxRepoCollectionRepos = 1000
+
+type repoCollectionResolver struct {
+	db dbutil.DB
+	c  *database.RepoCollection
+}
+
+func marshalRepoCollectionID(id int32) graphql.ID {
+	return relay.MarshalID("RepoCollection", id)
+}
+
+func unmarshalRepoCollectionID(id graphql.ID) (collectionID int32, err error) {
+	err = relay.UnmarshalSpec(id, &collectionID)
+	return
+}
+
+// checkCanManageRepoCollections returns an error if the current user can't
+// view and manage the repo collections of the namespace.
+func checkCanManageRepoCollections(ctx context.Context, db dbutil.DB, userID, orgID int32) error {
+	if userID != 0 {
+		return backend.CheckSiteAdminOrSameUser(ctx, db, userID)
+	}
+	if orgID != 0 {
+		return backend.CheckOrgAccessOrSiteAdmin(ctx, db, orgID)
+	}
+	return errors.New("repo collection has no user or org")
+}
+
+// repoCollectionByID returns the repo collection with the given ID, if the
+// current user can manage it.
+func (r *schemaResolver) repoCollectionByID(ctx context.Context, id graphql.ID) (*repoCollectionResolver, error) {
+	collectionID, err := unmarshalRepoCollectionID(id)
+	if err != nil {
+		return nil, err
+	}
+	c, err := database.RepoCollections(r.db).GetByID(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 🚨 SECURITY: Only the owner of the collection, members of the org which
+	// owns it and site admins can view it.
+	if err := checkCanManageRepoCollections(ctx, r.db, c.UserID, c.OrgID); err != nil {
+		return nil, err
+	}
+	return &repoCollectionResolver{db: r.db, c: c}, nil
+}
+
+func (r *repoCollectionResolver) ID() graphql.ID { return marshalRepoCollectionID(r.c.ID) }
+
+func (r *repoCollectionResolver) Name() string { return r.c.Name }
+
+func (r *repoCollectionResolver) Description() string { return r.c.Description }
+
+func (r *repoCollectionResolver) RepoGroupName(ctx context.Context) (string, error) {
+	if r.c.OrgID == 0 {
+		return r.c.Name, nil
+	}
+	org, err := database.Orgs(r.db).GetByID(ctx, r.c.OrgID)
+	if err != nil {
+		return "", err
+	}
+	return org.Name + "/" + r.c.Name, nil
+}
+
+func (r *repoCollectionResolver) Namespace(ctx context.Context) (*NamespaceResolver, error) {
+	id := MarshalUserID(r.c.UserID)
+	if r.c.OrgID != 0 {
+		id = MarshalOrgID(r.c.OrgID)
+	}
+	n, err := NamespaceByID(ctx, r.db, id)
+	if err != nil {
+		return nil, err
+	}
+	return &NamespaceResolver{n}, nil
+}
+
+func (r *repoCollectionResolver) Repositories(ctx context.Context) ([]*RepositoryResolver, error) {
+	repos, err := database.RepoCollections(r.db).ListRepos(ctx, r.c.ID)
+	if err != nil {
+		return nil, err
+	}
+	resolvers := make([]*RepositoryResolver, 0, len(repos))
+	for _, repo := range repos {
+		resolvers = append(resolvers, NewRepositoryResolver(r.db, repo.ToRepo()))
+	}
+	return resolvers, nil
+}
+
+func (r *repoCollectionResolver) CreatedAt() DateTime { return DateTime{Time: r.c.CreatedAt} }
+
+func (r *repoCollectionResolver) UpdatedAt() DateTime { return DateTime{Time: r.c.UpdatedAt} }
+
+func (r *schemaResolver) RepoCollections(ctx context.Context, args *struct {
+	Namespace *graphql.ID
+}) ([]*repoCollectionResolver, error) {
+	var opts database.RepoCollectionsListOptions
+	if args.Namespace != nil {
+		if err := UnmarshalNamespaceID(*args.Namespace, &opts.UserID, &opts.OrgID); err != nil {
+			return nil, err
+		}
+		// 🚨 SECURITY: Only the user, members of the org and site admins can
+		// view the collections of a namespace.
+		if err := checkCanManageRepoCollections(ctx, r.db, opts.UserID, opts.OrgID); err != nil {
+			return nil, err
+		}
+	} else {
+		a := actor.FromContext(ctx)
+		if !a.IsAuthenticated() {
+			return nil, errors.New("no current user")
+		}
+		opts.AccessibleToUserID = a.UID
+	}
+
+	collections, err := database.RepoCollections(r.db).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resolvers := make([]*repoCollectionResolver, 0, len(collections))
+	for _, c := range collections {
+		resolvers = append(resolvers, &repoCollectionResolver{db: r.db, c: c})
+	}
+	return resolvers, nil
+}
+
+func (r *schemaResolver) CreateRepoCollection(ctx context.Context, args *struct {
+	Namespace    graphql.ID
+	Name         string
+	Description  *string
+	Repositories *[]graphql.ID
+}) (_ *repoCollectionResolver, err error) {
+	c := &database.RepoCollection{Name: args.Name}
+	if args.Description != nil {
+		c.Description = *args.Description
+	}
+	if err := UnmarshalNamespaceID(args.Namespace, &c.UserID, &c.OrgID); err != nil {
+		return nil, err
+	}
+
+	// 🚨 SECURITY: Only the user, members of the org and site admins can
+	// create collections in a namespace.
+	if err := checkCanManageRepoCollections(ctx, r.db, c.UserID, c.OrgID); err != nil {
+		return nil, err
+	}
+
+	var repoIDs []api.RepoID
+	if args.Repositories != nil {
+		if repoIDs, err = r.resolveRepoCollectionRepos(ctx, *args.Repositories); err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := database.RepoCollections(r.db).Transact(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	if err = tx.Create(ctx, c); err != nil {
+		return nil, err
+	}
+	if err = tx.AddRepos(ctx, c.ID, repoIDs...); err != nil {
+		return nil, err
+	}
+	return &repoCollectionResolver{db: r.db, c: c}, nil
+}
+
+func (r *schemaResolver) UpdateRepoCollection(ctx context.Context, args *struct {
+	ID          graphql.ID
+	Name        string
+	Description string
+}) (*repoCollectionResolver, error) {
+	// 🚨 SECURITY: repoCollectionByID checks that the current user can manage
+	// the collection.
+	res, err := r.repoCollectionByID(ctx, args.ID)
+	if err != nil {
+		return nil, err
+	}
+	res.c.Name = args.Name
+	res.c.Description = args.Description
+	if err := database.RepoCollections(r.db).Update(ctx, res.c); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *schemaResolver) DeleteRepoCollection(ctx context.Context, args *struct {
+	ID graphql.ID
+}) (*EmptyResponse, error) {
+	// 🚨 SECURITY: repoCollectionByID checks that the current user can manage
+	// the collection.
+	res, err := r.repoCollectionByID(ctx, args.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := database.RepoCollections(r.db).Delete(ctx, res.c.ID); err != nil {
+		return nil, err
+	}
+	return &EmptyResponse{}, nil
+}
+
+func (r *schemaResolver) AddRepositoriesToRepoCollection(ctx context.Context, args *struct {
+	ID           graphql.ID
+	Repositories []graphql.ID
+}) (*repoCollectionResolver, error) {
+	// 🚨 SECURITY: repoCollectionByID checks that the current user can manage
+	// the collection.
+	res, err := r.repoCollectionByID(ctx, args.ID)
+	if err != nil {
+		return nil, err
+	}
+	repoIDs, err := r.resolveRepoCollectionRepos(ctx, args.Repositories)
+	if err != nil {
+		return nil, err
+	}
+	if err := database.RepoCollections(r.db).AddRepos(ctx, res.c.ID, repoIDs...); err != nil {
+		return nil, err
+	}
+	return r.repoCollectionByID(ctx, args.ID)
+}
+
+func (r *schemaResolver) RemoveRepositoriesFromRepoCollection(ctx context.Context, args *struct {
+	ID           graphql.ID
+	Repositories []graphql.ID
+}) (*repoCollectionResolver, error) {
+	// 🚨 SECURITY: repoCollectionByID checks that the current user can manage
+	// the collection.
+	res, err := r.repoCollectionByID(ctx, args.ID)
+	if err != nil {
+		return nil, err
+	}
+	repoIDs := make([]api.RepoID, 0, len(args.Repositories))
+	for _, id := range args.Repositories {
+		repoID, err := UnmarshalRepositoryID(id)
+		if err != nil {
+			return nil, err
+		}
+		repoIDs = append(repoIDs, repoID)
+	}
+	if err := database.RepoCollections(r.db).RemoveRepos(ctx, res.c.ID, repoIDs...); err != nil {
+		return nil, err
+	}
+	return r.repoCollectionByID(ctx, args.ID)
+}
+
+// resolveRepoCollectionRepos returns the IDs of the repositories to add to a
+// collection. It fails if the current user can't access any of them.
+func (r *schemaResolver) resolveRepoCollectionRepos(ctx context.Context, ids []graphql.ID) ([]api.RepoID, error) {
+	if len(ids) > maxRepoCollectionRepos {
+		return nil, errors.Errorf("too many repositories, please specify %d or fewer", maxRepoCollectionRepos)
+	}
+	repoIDs := make([]api.RepoID, 0, len(ids))
+	for _, id := range ids {
+		repoID, err := UnmarshalRepositoryID(id)
+		if err != nil {
+			return nil, err
+		}
+		repoIDs = append(repoIDs, repoID)
+	}
+	if len(repoIDs) == 0 {
+		return nil, nil
+	}
+
+	// 🚨 SECURITY: GetByIDs only returns the repositories which the current
+	// user can access.
+	repos, err := database.Repos(r.db).GetByIDs(ctx, repoIDs...)
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[api.RepoID]bool, len(repos))
+	for _, repo := range repos {
+		found[repo.ID] = true
+	}
+	for _, id := range repoIDs {
+		if !found[id] {
+			return nil, errors.Errorf("repository %d not found", id)
+		}
+	}
+	return repoIDs, nil
+}
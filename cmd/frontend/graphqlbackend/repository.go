@@ -307,6 +307,41 @@ func (r *RepositoryResolver) Stars(ctx context.Context) (int32, error) {
 	return int32(repo.Stars), nil
 }
 
+func (r *RepositoryResolver) Topics(ctx context.Context) ([]string, error) {
+	repo, err := r.repo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if repo.Topics == nil {
+		return []string{}, nil
+	}
+	return repo.Topics, nil
+}
+
+func (r *RepositoryResolver) PrimaryLanguage(ctx context.Context) (*string, error) {
+	repo, err := r.repo(ctx)
+	if err != nil || repo.PrimaryLanguage == "" {
+		return nil, err
+	}
+	return &repo.PrimaryLanguage, nil
+}
+
+func (r *RepositoryResolver) License(ctx context.Context) (*string, error) {
+	repo, err := r.repo(ctx)
+	if err != nil || repo.License == "" {
+		return nil, err
+	}
+	return &repo.License, nil
+}
+
+func (r *RepositoryResolver) DefaultBranchProtected(ctx context.Context) (bool, error) {
+	repo, err := r.repo(ctx)
+	if err != nil {
+		return false, err
+	}
+	return repo.DefaultBranchProtected, nil
+}
+
 func (r *RepositoryResolver) hydrate(ctx context.Context) error {
 	r.hydration.Do(func() {
 		// Repositories with an empty creation date were created using RepoName.ToRepo(),
@@ -341,6 +376,13 @@ func (r *RepositoryResolver) LSIFIndexes(ctx context.Context, args *LSIFIndexesQ
 	})
 }
 
+func (r *RepositoryResolver) LSIFDiagnostics(ctx context.Context, args *LSIFDiagnosticsQueryArgs) (AggregatedDiagnosticConnectionResolver, error) {
+	return EnterpriseResolvers.codeIntelResolver.LSIFDiagnosticsByRepo(ctx, &LSIFRepositoryDiagnosticsQueryArgs{
+		LSIFDiagnosticsQueryArgs: args,
+		RepositoryID:             r.ID(),
+	})
+}
+
 func (r *RepositoryResolver) IndexConfiguration(ctx context.Context) (IndexConfigurationResolver, error) {
 	return EnterpriseResolvers.codeIntelResolver.IndexConfiguration(ctx, r.ID())
 }
@@ -349,6 +391,10 @@ func (r *RepositoryResolver) CodeIntelligenceCommitGraph(ctx context.Context) (C
 	return EnterpriseResolvers.codeIntelResolver.CommitGraph(ctx, r.ID())
 }
 
+func (r *RepositoryResolver) CodeIntelligenceCoverage(ctx context.Context, args *CodeIntelligenceCoverageArgs) (CodeIntelligenceCoverageResolver, error) {
+	return EnterpriseResolvers.codeIntelResolver.CodeIntelligenceCoverage(ctx, r.ID(), args)
+}
+
 type AuthorizedUserArgs struct {
 	RepositoryID graphql.ID
 	Permission   string
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"html/template"
 	"io"
+	"path"
 	"strconv"
 	"strings"
 	"sync"
@@ -18,13 +19,15 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/highlight"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/inventory"
 	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
 )
 
 type RepositoryComparisonInput struct {
-	Base         *string
-	Head         *string
-	FetchMissing bool
+	Base            *string
+	Head            *string
+	FetchMissing    bool
+	IgnoreMergeBase bool
 }
 
 type FileDiffsConnectionArgs struct {
@@ -57,6 +60,11 @@ type FileDiff interface {
 	NewFile() FileResolver
 	MostRelevantFile() FileResolver
 	InternalID() string
+	IsRename() bool
+	IsCopy() bool
+	IsBinary() bool
+	Similarity() *int32
+	Language() *string
 }
 
 func NewRepositoryComparison(ctx context.Context, db dbutil.DB, r *RepositoryResolver, args *RepositoryComparisonInput) (*RepositoryComparisonResolver, error) {
@@ -96,17 +104,20 @@ func NewRepositoryComparison(ctx context.Context, db dbutil.DB, r *RepositoryRes
 		return nil, err
 	}
 
-	// Find the common merge-base for the diff. That's the revision the diff applies to,
-	// not the baseRevspec.
-	mergeBaseCommit, err := git.MergeBase(ctx, r.RepoName(), api.CommitID(baseRevspec), api.CommitID(headRevspec))
-	if err != nil {
-		return nil, err
-	}
+	baseCommit := baseRevspec
+	if !args.IgnoreMergeBase {
+		// Find the common merge-base for the diff. That's the revision the diff applies to,
+		// not the baseRevspec.
+		mergeBaseCommit, err := git.MergeBase(ctx, r.RepoName(), api.CommitID(baseRevspec), api.CommitID(headRevspec))
+		if err != nil {
+			return nil, err
+		}
 
-	// We use the merge-base as the base commit here, as the diff will only be guaranteed to be
-	// applicable to the file from that revision.
-	commitString := strings.TrimSpace(string(mergeBaseCommit))
-	base, err := getCommit(ctx, r.RepoName(), commitString)
+		// We use the merge-base as the base commit here, as the diff will only be guaranteed to be
+		// applicable to the file from that revision.
+		baseCommit = strings.TrimSpace(string(mergeBaseCommit))
+	}
+	base, err := getCommit(ctx, r.RepoName(), baseCommit)
 	if err != nil {
 		return nil, err
 	}
@@ -118,6 +129,7 @@ func NewRepositoryComparison(ctx context.Context, db dbutil.DB, r *RepositoryRes
 		base:        base,
 		head:        head,
 		repo:        r,
+		directRange: args.IgnoreMergeBase,
 	}, nil
 }
 
@@ -130,6 +142,10 @@ type RepositoryComparisonResolver struct {
 	baseRevspec, headRevspec string
 	base, head               *GitCommitResolver
 	repo                     *RepositoryResolver
+
+	// directRange is whether head is compared to base itself rather than to
+	// the merge base of base and head.
+	directRange bool
 }
 
 // Type guard.
@@ -148,8 +164,12 @@ func (r *RepositoryComparisonResolver) BaseRepository() *RepositoryResolver { re
 func (r *RepositoryComparisonResolver) HeadRepository() *RepositoryResolver { return r.repo }
 
 func (r *RepositoryComparisonResolver) Range() *gitRevisionRange {
+	rangeType := "..."
+	if r.directRange {
+		rangeType = ".."
+	}
 	return &gitRevisionRange{
-		expr:      r.baseRevspec + "..." + r.headRevspec,
+		expr:      r.baseRevspec + rangeType + r.headRevspec,
 		base:      &gitRevSpec{expr: &gitRevSpecExpr{expr: r.baseRevspec, repo: r.repo}},
 		head:      &gitRevSpec{expr: &gitRevSpecExpr{expr: r.headRevspec, repo: r.repo}},
 		mergeBase: nil, // not currently used
@@ -222,9 +242,10 @@ func computeRepositoryComparisonDiff(cmp *RepositoryComparisonResolver) ComputeD
 
 			var iter *git.DiffFileIterator
 			iter, err = git.Diff(ctx, git.DiffOptions{
-				Repo: cmp.repo.RepoName(),
-				Base: base,
-				Head: string(cmp.head.OID()),
+				Repo:        cmp.repo.RepoName(),
+				Base:        base,
+				Head:        string(cmp.head.OID()),
+				DirectRange: cmp.directRange,
 			})
 			if err != nil {
 				return
@@ -384,6 +405,9 @@ type FileDiffResolver struct {
 
 	db      dbutil.DB
 	newFile NewFileFunc
+
+	infoOnce     sync.Once
+	fileDiffInfo git.FileDiffInfo
 }
 
 func (r *FileDiffResolver) OldPath() *string { return diffPathOrNull(r.FileDiff.OrigName) }
@@ -436,6 +460,38 @@ func (r *FileDiffResolver) InternalID() string {
 	return hex.EncodeToString(b[:])[:32]
 }
 
+func (r *FileDiffResolver) IsRename() bool { return r.info().Renamed }
+func (r *FileDiffResolver) IsCopy() bool   { return r.info().Copied }
+func (r *FileDiffResolver) IsBinary() bool { return r.info().Binary }
+
+func (r *FileDiffResolver) Similarity() *int32 {
+	info := r.info()
+	if !info.Renamed && !info.Copied {
+		return nil
+	}
+	similarity := int32(info.Similarity)
+	return &similarity
+}
+
+// Language returns the language of the most relevant file, detected by its
+// name.
+func (r *FileDiffResolver) Language() *string {
+	name := r.FileDiff.NewName
+	if diffPathOrNull(name) == nil {
+		name = r.FileDiff.OrigName
+	}
+	lang, _ := inventory.GetLanguageByFilename(path.Base(name))
+	if lang == "" {
+		return nil
+	}
+	return &lang
+}
+
+func (r *FileDiffResolver) info() git.FileDiffInfo {
+	r.infoOnce.Do(func() { r.fileDiffInfo = git.ParseFileDiffInfo(r.FileDiff) })
+	return r.fileDiffInfo
+}
+
 func diffPathOrNull(path string) *string {
 	if path == "/dev/null" || path == "" {
 		return nil
@@ -518,6 +574,67 @@ func (r *DiffHunk) Section() *string {
 
 func (r *DiffHunk) Body() string { return string(r.hunk.Body) }
 
+// Lines returns the lines of the hunk body with their kind and line numbers.
+func (r *DiffHunk) Lines() ([]*diffHunkLineResolver, error) {
+	body := strings.TrimSuffix(string(r.hunk.Body), "\n")
+	if body == "" {
+		return []*diffHunkLineResolver{}, nil
+	}
+
+	hunkLines := strings.Split(body, "\n")
+	lines := make([]*diffHunkLineResolver, 0, len(hunkLines))
+	oldLine, newLine := r.hunk.OrigStartLine, r.hunk.NewStartLine
+	for _, hunkLine := range hunkLines {
+		if hunkLine == "" {
+			// Unchanged empty lines may be stripped of their leading space.
+			hunkLine = " "
+		}
+
+		line := &diffHunkLineResolver{text: hunkLine[1:]}
+		switch hunkLine[0] {
+		case ' ':
+			line.kind = "UNCHANGED"
+			line.oldLine, line.newLine = oldLine, newLine
+			oldLine++
+			newLine++
+		case '+':
+			line.kind = "ADDED"
+			line.newLine = newLine
+			newLine++
+		case '-':
+			line.kind = "DELETED"
+			line.oldLine = oldLine
+			oldLine++
+		case '\\':
+			// "\ No newline at end of file" is exposed by OldNoNewlineAt.
+			continue
+		default:
+			return nil, fmt.Errorf("expected patch lines to start with ' ', '-', '+', but found %q", hunkLine[0])
+		}
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
+
+type diffHunkLineResolver struct {
+	kind             string
+	text             string
+	oldLine, newLine int32
+}
+
+func (r *diffHunkLineResolver) Kind() string { return r.kind }
+func (r *diffHunkLineResolver) Text() string { return r.text }
+
+func (r *diffHunkLineResolver) OldLine() *int32 { return lineNumberOrNull(r.oldLine) }
+func (r *diffHunkLineResolver) NewLine() *int32 { return lineNumberOrNull(r.newLine) }
+
+func lineNumberOrNull(line int32) *int32 {
+	if line == 0 {
+		return nil
+	}
+	return &line
+}
+
 func (r *DiffHunk) Highlight(ctx context.Context, args *HighlightArgs) (*highlightedDiffHunkBodyResolver, error) {
 	highlightedBase, highlightedHead, aborted, err := r.highlighter.Highlight(ctx, args)
 	if err != nil {
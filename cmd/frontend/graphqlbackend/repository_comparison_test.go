@@ -68,6 +68,9 @@ func TestRepositoryComparison(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	// The copied file of testCopyDiff follows the files of testDiff.
+	const wantFileDiffs = testDiffFiles + 1
+
 	t.Run("BaseRepository", func(t *testing.T) {
 		if have, want := comp.BaseRepository(), repoResolver; have != want {
 			t.Fatalf("BaseRepository wrong. want=%+v, have=%+v", want, have)
@@ -146,7 +149,7 @@ func TestRepositoryComparison(t *testing.T) {
 			if err != nil {
 				t.Fatal(err)
 			}
-			if have, want := rawDiff, testDiff; have != want {
+			if have, want := rawDiff, testDiff+testCopyDiff; have != want {
 				t.Fatalf("rawDiff wrong. want=%q, have=%q", want, have)
 			}
 		})
@@ -179,8 +182,8 @@ func TestRepositoryComparison(t *testing.T) {
 				t.Fatal(err)
 			}
 
-			if len(nodes) != testDiffFiles {
-				t.Fatalf("wrong length of nodes. want=%d, have=%d", testDiffFiles, len(nodes))
+			if len(nodes) != wantFileDiffs {
+				t.Fatalf("wrong length of nodes. want=%d, have=%d", wantFileDiffs, len(nodes))
 			}
 
 			n := nodes[0]
@@ -246,11 +249,39 @@ func TestRepositoryComparison(t *testing.T) {
 					t.Fatalf("len(hunks) wrong. want=%d, have=%d", wantHunkCount, have)
 				}
 			})
+
+			t.Run("Language", func(t *testing.T) {
+				wantLanguage := "Markdown"
+				if diff := cmp.Diff(&wantLanguage, n.Language()); diff != "" {
+					t.Fatalf("wrong Language: %s", diff)
+				}
+			})
+
+			t.Run("Copy", func(t *testing.T) {
+				c := nodes[testDiffFiles]
+				wantOldPath, wantNewPath := "test.txt", "test2.txt"
+				if diff := cmp.Diff(&wantOldPath, c.OldPath()); diff != "" {
+					t.Fatalf("wrong OldPath: %s", diff)
+				}
+				if diff := cmp.Diff(&wantNewPath, c.NewPath()); diff != "" {
+					t.Fatalf("wrong NewPath: %s", diff)
+				}
+				if !c.IsCopy() || c.IsRename() || c.IsBinary() {
+					t.Fatalf("wrong kind of change. isCopy=%t, isRename=%t, isBinary=%t", c.IsCopy(), c.IsRename(), c.IsBinary())
+				}
+				wantSimilarity := int32(100)
+				if diff := cmp.Diff(&wantSimilarity, c.Similarity()); diff != "" {
+					t.Fatalf("wrong Similarity: %s", diff)
+				}
+				if len(c.Hunks()) != 0 {
+					t.Fatalf("unexpected hunks: %+v", c.Hunks())
+				}
+			})
 		})
 
 		t.Run("Pagination", func(t *testing.T) {
-			endCursors := []string{"1", "2"}
-			totalCount := int32(testDiffFiles)
+			endCursors := []string{"1", "2", "3"}
+			totalCount := int32(wantFileDiffs)
 
 			tests := []struct {
 				first int32
@@ -283,14 +314,22 @@ func TestRepositoryComparison(t *testing.T) {
 					first:           1,
 					after:           endCursors[1],
 					wantNodeCount:   1,
+					wantHasNextPage: true,
+					wantEndCursor:   &endCursors[2],
+					wantTotalCount:  nil,
+				},
+				{
+					first:           1,
+					after:           endCursors[2],
+					wantNodeCount:   1,
 					wantHasNextPage: false,
 					wantEndCursor:   nil,
 					wantTotalCount:  &totalCount,
 				},
 				{
-					first:           testDiffFiles + 1,
+					first:           wantFileDiffs + 1,
 					after:           "",
-					wantNodeCount:   testDiffFiles,
+					wantNodeCount:   wantFileDiffs,
 					wantHasNextPage: false,
 					wantEndCursor:   nil,
 					wantTotalCount:  &totalCount,
@@ -385,6 +424,41 @@ func TestDiffHunk(t *testing.T) {
 		}
 	})
 
+	t.Run("Lines", func(t *testing.T) {
+		lines, err := hunk.Lines()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		type line struct {
+			Kind, Text       string
+			OldLine, NewLine *int32
+		}
+		lineNumber := func(n int32) *int32 { return &n }
+		have := make([]line, len(lines))
+		for i, l := range lines {
+			have[i] = line{Kind: l.Kind(), Text: l.Text(), OldLine: l.OldLine(), NewLine: l.NewLine()}
+		}
+		want := []line{
+			{Kind: "UNCHANGED", Text: "Line 1", OldLine: lineNumber(3), NewLine: lineNumber(3)},
+			{Kind: "UNCHANGED", Text: "Line 2", OldLine: lineNumber(4), NewLine: lineNumber(4)},
+			{Kind: "UNCHANGED", Text: "Line 3", OldLine: lineNumber(5), NewLine: lineNumber(5)},
+			{Kind: "DELETED", Text: "Line 4", OldLine: lineNumber(6)},
+			{Kind: "ADDED", Text: "This is cool: Line 4", NewLine: lineNumber(6)},
+			{Kind: "UNCHANGED", Text: "Line 5", OldLine: lineNumber(7), NewLine: lineNumber(7)},
+			{Kind: "UNCHANGED", Text: "Line 6", OldLine: lineNumber(8), NewLine: lineNumber(8)},
+			{Kind: "DELETED", Text: "Line 7", OldLine: lineNumber(9)},
+			{Kind: "DELETED", Text: "Line 8", OldLine: lineNumber(10)},
+			{Kind: "ADDED", Text: "Another Line 7", NewLine: lineNumber(9)},
+			{Kind: "ADDED", Text: "Foobar Line 8", NewLine: lineNumber(10)},
+			{Kind: "UNCHANGED", Text: "Line 9", OldLine: lineNumber(11), NewLine: lineNumber(11)},
+			{Kind: "UNCHANGED", Text: "Line 10", OldLine: lineNumber(12), NewLine: lineNumber(12)},
+		}
+		if diff := cmp.Diff(want, have); diff != "" {
+			t.Fatalf("wrong lines (-want +have):\n%s", diff)
+		}
+	})
+
 	t.Run("Highlight", func(t *testing.T) {
 		hunk.highlighter = &dummyFileHighlighter{
 			highlightedBase: []template.HTML{"B1", "B2", "B3", "B4", "B5", "B6", "B7", "B8", "B9", "B10", "B11", "B12"},
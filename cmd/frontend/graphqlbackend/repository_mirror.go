@@ -11,6 +11,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
@@ -202,6 +203,56 @@ func (r *updateQueueResolver) Total() int32 {
 	return int32(r.queue.Total)
 }
 
+// maxIntegrityFailures is the maximum number of recent integrity failures
+// returned for a repository.
+const maxIntegrityFailures = 10
+
+func (r *repositoryMirrorInfoResolver) IntegrityFailures(ctx context.Context) ([]*repositoryIntegrityFailureResolver, error) {
+	// 🚨 SECURITY: The output of the integrity checks might contain details of
+	// the gitserver file system, so only allow site admins to see it.
+	if err := backend.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	failures, err := database.GitserverRepos(r.db).ListIntegrityFailures(ctx, r.repository.IDInt32(), maxIntegrityFailures)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]*repositoryIntegrityFailureResolver, 0, len(failures))
+	for _, f := range failures {
+		resolvers = append(resolvers, &repositoryIntegrityFailureResolver{failure: f})
+	}
+	return resolvers, nil
+}
+
+type repositoryIntegrityFailureResolver struct {
+	failure *types.GitserverRepoIntegrityFailure
+}
+
+func (r *repositoryIntegrityFailureResolver) Check() string { return r.failure.Check }
+
+func (r *repositoryIntegrityFailureResolver) Output() string { return r.failure.Output }
+
+func (r *repositoryIntegrityFailureResolver) Shard() string { return r.failure.ShardID }
+
+func (r *repositoryIntegrityFailureResolver) Repair() string { return r.failure.Repair }
+
+func (r *repositoryIntegrityFailureResolver) RepairError() *string {
+	if r.failure.RepairError == "" {
+		return nil
+	}
+	return &r.failure.RepairError
+}
+
+func (r *repositoryIntegrityFailureResolver) RepairedAt() *DateTime {
+	return DateTimeOrNil(r.failure.RepairedAt)
+}
+
+func (r *repositoryIntegrityFailureResolver) CreatedAt() DateTime {
+	return DateTime{Time: r.failure.CreatedAt}
+}
+
 func (r *schemaResolver) CheckMirrorRepositoryConnection(ctx context.Context, args *struct {
 	Repository *graphql.ID
 	Name       *string
@@ -268,3 +319,31 @@ func (r *schemaResolver) UpdateMirrorRepository(ctx context.Context, args *struc
 	}
 	return &EmptyResponse{}, nil
 }
+
+func (r *schemaResolver) RestoreRepository(ctx context.Context, args *struct {
+	Repository graphql.ID
+}) (*RepositoryResolver, error) {
+	// 🚨 SECURITY: Only site admins may restore deleted repositories.
+	if err := backend.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	repoID, err := UnmarshalRepositoryID(args.Repository)
+	if err != nil {
+		return nil, err
+	}
+	repos := database.Repos(r.db)
+	if err := repos.Restore(ctx, repoID); err != nil {
+		return nil, err
+	}
+	repo, err := repos.Get(ctx, repoID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The repo may have been removed from gitserver after it was deleted.
+	if _, err := repoupdater.DefaultClient.EnqueueRepoUpdate(ctx, repo.Name); err != nil {
+		return nil, err
+	}
+	return NewRepositoryResolver(r.db, repo), nil
+}
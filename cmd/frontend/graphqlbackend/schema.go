@@ -35,3 +35,7 @@ var insightsSchema string
 // authzSchema is the Authz raw graqhql schema.
 //go:embed authz.graphql
 var authzSchema string
+
+// searchExportsSchema is the Search Exports raw graqhql schema.
+//go:embed search_exports.graphql
+var searchExportsSchema string
@@ -5,14 +5,17 @@ import (
 	"encoding/json"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	otlog "github.com/opentracing/opentracing-go/log"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/internal/endpoint"
+	"github.com/sourcegraph/sourcegraph/internal/quota"
 	"github.com/sourcegraph/sourcegraph/internal/search"
 	searchbackend "github.com/sourcegraph/sourcegraph/internal/search/backend"
 	"github.com/sourcegraph/sourcegraph/internal/search/filter"
@@ -77,7 +80,13 @@ func NewSearchImplementer(ctx context.Context, db dbutil.DB, args *SearchArgs) (
 		}
 	}
 
-	searchType, err := detectSearchType(args.Version, args.PatternType)
+	// The pattern type requested by the client takes precedence over the
+	// default pattern type of the viewer.
+	patternType := args.PatternType
+	if patternType == nil && settings.SearchDefaultPatternType != "" {
+		patternType = &settings.SearchDefaultPatternType
+	}
+	searchType, err := detectSearchType(args.Version, patternType)
 	if err != nil {
 		return nil, err
 	}
@@ -90,10 +99,13 @@ func NewSearchImplementer(ctx context.Context, db dbutil.DB, args *SearchArgs) (
 	var plan query.Plan
 	globbing := getBoolPtr(settings.SearchGlobbing, false)
 	tr.LogFields(otlog.Bool("globbing", globbing))
+	parseTr, _ := trace.New(ctx, "query.Pipeline", args.Query)
 	plan, err = query.Pipeline(
 		query.Init(args.Query, searchType),
 		query.With(globbing, query.Globbing),
 	)
+	parseTr.SetError(err)
+	parseTr.Finish()
 	if err != nil {
 		return alertForQuery(args.Query, err).wrapSearchImplementer(db), nil
 	}
@@ -116,6 +128,18 @@ func NewSearchImplementer(ctx context.Context, db dbutil.DB, args *SearchArgs) (
 		// Set a lower max result count until structural search supports true streaming.
 		defaultLimit = defaultMaxSearchResults
 	}
+	if settings.SearchDefaultCount > 0 {
+		defaultLimit = settings.SearchDefaultCount
+	}
+
+	if cb, _ := plan.ToParseTree().StringValue(query.FieldCountBy); cb != "" {
+		if args.Stream == nil {
+			return alertForQuery(args.Query, errors.New("count-by is only supported by the streaming search API")).wrapSearchImplementer(db), nil
+		}
+		// Invariant: error already checked
+		countBy, _ := filter.CountByFromString(cb)
+		args.Stream = run.WithCountBy(args.Stream, countBy)
+	}
 
 	if sp, _ := plan.ToParseTree().StringValue(query.FieldSelect); sp != "" && args.Stream != nil {
 		// Invariant: error already checked
@@ -134,6 +158,7 @@ func NewSearchImplementer(ctx context.Context, db dbutil.DB, args *SearchArgs) (
 			Pagination:     pagination,
 			PatternType:    searchType,
 			DefaultLimit:   defaultLimit,
+			DefaultTimeout: time.Duration(settings.SearchDefaultTimeoutSeconds) * time.Second,
 		},
 
 		stream: args.Stream,
@@ -146,6 +171,9 @@ func NewSearchImplementer(ctx context.Context, db dbutil.DB, args *SearchArgs) (
 }
 
 func (r *schemaResolver) Search(ctx context.Context, args *SearchArgs) (SearchImplementer, error) {
+	if err := quota.Use(ctx, r.db, quota.Searches, actor.FromContext(ctx).UID, "", 1); err != nil {
+		return nil, err
+	}
 	return NewSearchImplementer(ctx, r.db, args)
 }
 
@@ -329,7 +357,9 @@ func (r *searchResolver) resolveRepositories(ctx context.Context, opts resolveRe
 			return *r.resolved, r.repoErr
 		}
 		defer func() {
-			r.resolved = &resolved
+			// Update the cache in place, since it is shared with forks of r
+			// evaluating other subexpressions of the query.
+			*r.resolved = resolved
 			r.repoErr = err
 		}()
 	}
@@ -340,36 +370,9 @@ func (r *searchResolver) resolveRepositories(ctx context.Context, opts resolveRe
 
 	}
 	repoGroupFilters, _ := r.Query.StringValues(query.FieldRepoGroup)
+	repoTopics, minusRepoTopics := r.Query.StringValues(query.FieldRepoTopic)
 
-	var settingForks, settingArchived bool
-	if v := r.UserSettings.SearchIncludeForks; v != nil {
-		settingForks = *v
-	}
-	if v := r.UserSettings.SearchIncludeArchived; v != nil {
-		settingArchived = *v
-	}
-
-	fork := query.No
-	if searchrepos.ExactlyOneRepo(repoFilters) || settingForks {
-		// fork defaults to No unless either of:
-		// (1) exactly one repo is being searched, or
-		// (2) user/org/global setting includes forks
-		fork = query.Yes
-	}
-	if setFork := r.Query.Fork(); setFork != nil {
-		fork = *setFork
-	}
-
-	archived := query.No
-	if searchrepos.ExactlyOneRepo(repoFilters) || settingArchived {
-		// archived defaults to No unless either of:
-		// (1) exactly one repo is being searched, or
-		// (2) user/org/global setting includes archives in all searches
-		archived = query.Yes
-	}
-	if setArchived := r.Query.Archived(); setArchived != nil {
-		archived = *setArchived
-	}
+	fork, archived := r.forkAndArchived(repoFilters)
 
 	visibilityStr, _ := r.Query.StringValue(query.FieldVisibility)
 	visibility := query.ParseVisibility(visibilityStr)
@@ -389,6 +392,8 @@ func (r *searchResolver) resolveRepositories(ctx context.Context, opts resolveRe
 		RepoFilters:        repoFilters,
 		MinusRepoFilters:   minusRepoFilters,
 		RepoGroupFilters:   repoGroupFilters,
+		RepoTopics:         repoTopics,
+		MinusRepoTopics:    minusRepoTopics,
 		VersionContextName: versionContextName,
 		SearchContextSpec:  searchContextSpec,
 		UserSettings:       r.UserSettings,
@@ -412,6 +417,43 @@ func (r *searchResolver) resolveRepositories(ctx context.Context, opts resolveRe
 	return repositoryResolver.Resolve(ctx, options)
 }
 
+// forkAndArchived returns the effective fork: and archived: options of the
+// query when searching repoFilters, taking the defaults from the user settings
+// into account.
+func (r *searchResolver) forkAndArchived(repoFilters []string) (fork, archived query.YesNoOnly) {
+	var settingForks, settingArchived bool
+	if v := r.UserSettings.SearchIncludeForks; v != nil {
+		settingForks = *v
+	}
+	if v := r.UserSettings.SearchIncludeArchived; v != nil {
+		settingArchived = *v
+	}
+
+	fork = query.No
+	if searchrepos.ExactlyOneRepo(repoFilters) || settingForks {
+		// fork defaults to No unless either of:
+		// (1) exactly one repo is being searched, or
+		// (2) user/org/global setting includes forks
+		fork = query.Yes
+	}
+	if setFork := r.Query.Fork(); setFork != nil {
+		fork = *setFork
+	}
+
+	archived = query.No
+	if searchrepos.ExactlyOneRepo(repoFilters) || settingArchived {
+		// archived defaults to No unless either of:
+		// (1) exactly one repo is being searched, or
+		// (2) user/org/global setting includes archives in all searches
+		archived = query.Yes
+	}
+	if setArchived := r.Query.Archived(); setArchived != nil {
+		archived = *setArchived
+	}
+
+	return fork, archived
+}
+
 func (r *searchResolver) suggestFilePaths(ctx context.Context, limit int) ([]SearchSuggestionResolver, error) {
 	resolved, err := r.resolveRepositories(ctx, resolveRepositoriesOpts{})
 	if err != nil {
@@ -0,0 +1,262 @@
+package graphqlbackend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/envvar"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	searchrepos "github.com/sourcegraph/sourcegraph/internal/search/repos"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+// Backends that evaluate a search query, as reported by explainSearchQuery.
+const (
+	explainBackendRepo         = "repo"
+	explainBackendSymbol       = "symbol"
+	explainBackendZoekt        = "zoekt"
+	explainBackendZoektGlobal  = "zoekt-global"
+	explainBackendSearcher     = "searcher"
+	explainBackendSearcherOnly = "searcher-unindexed"
+	explainBackendCommit       = "commit"
+	explainBackendDiff         = "diff"
+)
+
+// searchQueryExplanation describes how a search query is evaluated without
+// running it.
+type searchQueryExplanation struct {
+	Query       string                  `json:"query"`
+	PatternType string                  `json:"patternType"`
+	Plan        []basicQueryExplanation `json:"plan"`
+}
+
+// basicQueryExplanation describes how one basic query of a query plan is
+// evaluated.
+type basicQueryExplanation struct {
+	Query     string        `json:"query"`
+	ParseTree []interface{} `json:"parseTree"`
+
+	// Repository predicates are evaluated by running subqueries before the
+	// basic query is evaluated. They narrow down the repositories being
+	// searched, so the predicted repositories are an upper bound if
+	// predicates are present.
+	Predicates []string `json:"predicates,omitempty"`
+
+	ResultTypes  []string                `json:"resultTypes"`
+	Backends     []string                `json:"backends"`
+	Repositories repositoriesExplanation `json:"repositories"`
+	Limits       limitsExplanation       `json:"limits"`
+}
+
+type repositoriesExplanation struct {
+	// Global is true if the query is not scoped to repositories, in which
+	// case indexed repositories are searched without resolving them first.
+	Global           bool   `json:"global"`
+	Resolved         int    `json:"resolved"`
+	Missing          int    `json:"missing"`
+	ExcludedForks    int    `json:"excludedForks"`
+	ExcludedArchived int    `json:"excludedArchived"`
+	OverLimit        bool   `json:"overLimit"`
+	Error            string `json:"error,omitempty"`
+}
+
+type limitsExplanation struct {
+	MaxResults         int    `json:"maxResults"`
+	Timeout            string `json:"timeout"`
+	RepoTimeout        string `json:"repoTimeout,omitempty"`
+	FullDeadline       bool   `json:"fullDeadline"`
+	MaxRepos           int    `json:"maxRepos"`
+	CommitDiffMaxRepos int    `json:"commitDiffMaxRepos,omitempty"`
+}
+
+// explainResultTypes lists the result types in a stable order.
+var explainResultTypes = []string{"file", "path", "symbol", "repo", "commit", "diff"}
+
+func (r *schemaResolver) ExplainSearchQuery(ctx context.Context, args *struct {
+	Version        string
+	PatternType    *string
+	Query          string
+	VersionContext *string
+}) (*JSONValue, error) {
+	impl, err := NewSearchImplementer(ctx, r.db, &SearchArgs{
+		Version:        args.Version,
+		PatternType:    args.PatternType,
+		Query:          args.Query,
+		VersionContext: args.VersionContext,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sr, ok := impl.(*searchResolver)
+	if !ok {
+		// The query is invalid, and the implementer only reports an alert.
+		if a, ok := impl.(*alertSearchImplementer); ok {
+			return nil, errors.Errorf("%s %s", a.alert.title, a.alert.description)
+		}
+		return nil, errors.Errorf("unexpected search implementer %T", impl)
+	}
+
+	explanation, err := sr.explain(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(explanation)
+	if err != nil {
+		return nil, err
+	}
+	return &JSONValue{Value: string(b)}, nil
+}
+
+// explain describes how each basic query of the plan would be evaluated. It
+// resolves repositories, but does not search them.
+func (r *searchResolver) explain(ctx context.Context) (*searchQueryExplanation, error) {
+	explanation := &searchQueryExplanation{
+		Query:       r.rawQuery(),
+		PatternType: r.PatternType.String(),
+		Plan:        make([]basicQueryExplanation, 0, len(r.Plan)),
+	}
+
+	// Every basic query resolves its own repositories.
+	r.invalidateRepoCache = true
+
+	for _, q := range r.Plan {
+		e, err := r.explainBasic(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		explanation.Plan = append(explanation.Plan, e)
+	}
+	return explanation, nil
+}
+
+func (r *searchResolver) explainBasic(ctx context.Context, q query.Basic) (basicQueryExplanation, error) {
+	e := basicQueryExplanation{
+		Query: q.String(),
+	}
+	for _, node := range q.ToParseTree() {
+		e.ParseTree = append(e.ParseTree, toJSON(node))
+	}
+
+	// Repository predicates can only be resolved by running subqueries, so
+	// repositories are resolved for the query without them. File predicates
+	// are evaluated by the search backends and stay in the query.
+	parameters := make([]query.Parameter, 0, len(q.Parameters))
+	for _, p := range q.Parameters {
+		if p.Annotation.Labels.IsSet(query.IsPredicate) && p.Field == query.FieldRepo {
+			e.Predicates = append(e.Predicates, fmt.Sprintf("%s:%s", p.Field, p.Value))
+			continue
+		}
+		parameters = append(parameters, p)
+	}
+	q = q.MapParameters(parameters)
+	r.setQuery(q.ToParseTree())
+
+	// The result types and text pattern are determined as in doResults.
+	var forceResultTypes result.Types
+	if r.PatternType == query.SearchTypeStructural {
+		forceResultTypes = result.TypeFile
+	}
+	p := search.ToTextPatternInfo(q, r.protocol(), query.Identity)
+	if r.PatternType == query.SearchTypeStructural && p.Pattern == "" {
+		p.IsStructuralPat = false
+		forceResultTypes = result.Types(0)
+	}
+	args := search.TextParameters{PatternInfo: p, Query: r.Query}
+	if err := args.PatternInfo.Validate(); err != nil {
+		return e, &badRequestError{err}
+	}
+
+	resultTypes := r.determineResultTypes(args, forceResultTypes)
+	for _, name := range explainResultTypes {
+		if resultTypes.Has(result.TypeFromString[name]) {
+			e.ResultTypes = append(e.ResultTypes, name)
+		}
+	}
+
+	global := r.isGlobalSearch()
+	e.Backends = explainBackends(resultTypes, p, global)
+
+	resolved, err := r.resolveRepositories(ctx, resolveRepositoriesOpts{})
+	e.Repositories = repositoriesExplanation{
+		Global:           global,
+		Resolved:         len(resolved.RepoRevs),
+		Missing:          len(resolved.MissingRepoRevs),
+		ExcludedForks:    resolved.ExcludedRepos.Forks,
+		ExcludedArchived: resolved.ExcludedRepos.Archived,
+		OverLimit:        resolved.OverLimit,
+	}
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return e, err
+		}
+		e.Repositories.Error = err.Error()
+	}
+
+	limits := searchrepos.SearchLimits()
+	e.Limits = limitsExplanation{
+		MaxResults:   r.MaxResults(),
+		Timeout:      r.searchTimeout().String(),
+		FullDeadline: r.searchTimeoutFieldSet() || r.stream != nil,
+		MaxRepos:     limits.MaxRepos,
+	}
+	if timeout := r.Query.RepoTimeout(); timeout != nil {
+		e.Limits.RepoTimeout = timeout.String()
+	}
+	if resultTypes.Has(result.TypeCommit | result.TypeDiff) {
+		fields := r.Query.Fields()
+		_, hasAfter := fields[query.FieldAfter]
+		_, hasBefore := fields[query.FieldBefore]
+		if hasAfter || hasBefore {
+			e.Limits.CommitDiffMaxRepos = limits.CommitDiffWithTimeFilterMaxRepos
+		} else {
+			e.Limits.CommitDiffMaxRepos = limits.CommitDiffMaxRepos
+		}
+	}
+
+	return e, nil
+}
+
+// explainBackends returns the backends that doResults and the text search
+// of the run package use to search for the given result types.
+func explainBackends(resultTypes result.Types, p *search.TextPatternInfo, global bool) []string {
+	var backends []string
+	if resultTypes.Has(result.TypeRepo) {
+		backends = append(backends, explainBackendRepo)
+	}
+	if resultTypes.Has(result.TypeSymbol) {
+		backends = append(backends, explainBackendSymbol)
+	}
+	if resultTypes.Has(result.TypeFile | result.TypePath) {
+		switch {
+		case p.Index == query.No:
+			backends = append(backends, explainBackendSearcher)
+		case p.IsStructuralPat || p.IsLookaround():
+			// Zoekt cannot evaluate these patterns, so searcher searches
+			// indexed repositories as well.
+			backends = append(backends, explainBackendSearcher)
+		case p.Index == query.Only && global:
+			backends = append(backends, explainBackendZoektGlobal)
+		case p.Index == query.Only:
+			backends = append(backends, explainBackendZoekt)
+		case global && envvar.SourcegraphDotComMode():
+			backends = append(backends, explainBackendZoektGlobal)
+		case global:
+			backends = append(backends, explainBackendZoektGlobal, explainBackendSearcherOnly)
+		default:
+			backends = append(backends, explainBackendZoekt, explainBackendSearcherOnly)
+		}
+	}
+	if resultTypes.Has(result.TypeCommit) {
+		backends = append(backends, explainBackendCommit)
+	}
+	if resultTypes.Has(result.TypeDiff) {
+		backends = append(backends, explainBackendDiff)
+	}
+	return backends
+}
@@ -0,0 +1,159 @@
+package graphqlbackend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtesting"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	searchrepos "github.com/sourcegraph/sourcegraph/internal/search/repos"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestSearchResolver_explain(t *testing.T) {
+	conf.Mock(&conf.Unified{})
+	defer conf.Mock(nil)
+
+	mockResolveRepositories = func([]string) (searchrepos.Resolved, error) {
+		return searchrepos.Resolved{
+			RepoRevs: []*search.RepositoryRevisions{
+				{Repo: types.RepoName{ID: 1, Name: "foo/one"}},
+				{Repo: types.RepoName{ID: 2, Name: "foo/two"}},
+			},
+			ExcludedRepos: searchrepos.ExcludedRepos{Forks: 3},
+		}, nil
+	}
+	defer func() { mockResolveRepositories = nil }()
+
+	type explained struct {
+		ResultTypes  []string
+		Backends     []string
+		Repositories repositoriesExplanation
+		Limits       limitsExplanation
+		Predicates   []string
+	}
+
+	cases := []struct {
+		query string
+		want  []explained
+	}{
+		{
+			query: "repo:foo bar",
+			want: []explained{{
+				ResultTypes:  []string{"file", "path", "repo"},
+				Backends:     []string{explainBackendRepo, explainBackendZoekt, explainBackendSearcherOnly},
+				Repositories: repositoriesExplanation{Resolved: 2, ExcludedForks: 3},
+				Limits:       limitsExplanation{MaxResults: 30, Timeout: "20s", MaxRepos: 1073741823},
+			}},
+		},
+		{
+			query: "type:commit count:10 bar",
+			want: []explained{{
+				ResultTypes:  []string{"commit"},
+				Backends:     []string{explainBackendCommit},
+				Repositories: repositoriesExplanation{Global: true, Resolved: 2, ExcludedForks: 3},
+				Limits:       limitsExplanation{MaxResults: 10, Timeout: "1m0s", FullDeadline: true, MaxRepos: 1073741823, CommitDiffMaxRepos: 50},
+			}},
+		},
+		{
+			query: "repo:contains.file(README) bar",
+			want: []explained{{
+				ResultTypes:  []string{"file", "path", "repo"},
+				Backends:     []string{explainBackendRepo, explainBackendZoektGlobal, explainBackendSearcherOnly},
+				Repositories: repositoriesExplanation{Global: true, Resolved: 2, ExcludedForks: 3},
+				Limits:       limitsExplanation{MaxResults: 30, Timeout: "20s", MaxRepos: 1073741823},
+				Predicates:   []string{"repo:contains.file(README)"},
+			}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.query, func(t *testing.T) {
+			impl, err := NewSearchImplementer(context.Background(), new(dbtesting.MockDB), &SearchArgs{
+				Version:  "V2",
+				Query:    tc.query,
+				Settings: &schema.Settings{},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			explanation, err := impl.(*searchResolver).explain(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var have []explained
+			for _, e := range explanation.Plan {
+				have = append(have, explained{
+					ResultTypes:  e.ResultTypes,
+					Backends:     e.Backends,
+					Repositories: e.Repositories,
+					Limits:       e.Limits,
+					Predicates:   e.Predicates,
+				})
+			}
+			if diff := cmp.Diff(tc.want, have); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestExplainBackends(t *testing.T) {
+	cases := []struct {
+		name        string
+		resultTypes result.Types
+		pattern     *search.TextPatternInfo
+		global      bool
+		want        []string
+	}{
+		{
+			name:        "unindexed",
+			resultTypes: result.TypeFile,
+			pattern:     &search.TextPatternInfo{Index: query.No},
+			global:      true,
+			want:        []string{explainBackendSearcher},
+		},
+		{
+			name:        "indexed only",
+			resultTypes: result.TypeFile,
+			pattern:     &search.TextPatternInfo{Index: query.Only},
+			want:        []string{explainBackendZoekt},
+		},
+		{
+			name:        "structural",
+			resultTypes: result.TypeFile,
+			pattern:     &search.TextPatternInfo{Index: query.Yes, IsStructuralPat: true},
+			want:        []string{explainBackendSearcher},
+		},
+		{
+			name:        "lookaround",
+			resultTypes: result.TypeFile | result.TypePath,
+			pattern:     &search.TextPatternInfo{Index: query.Yes, IsRegExp: true, Pattern: "foo(?=bar)"},
+			global:      true,
+			want:        []string{explainBackendSearcher},
+		},
+		{
+			name:        "symbols and diffs",
+			resultTypes: result.TypeSymbol | result.TypeDiff,
+			pattern:     &search.TextPatternInfo{Index: query.Yes},
+			want:        []string{explainBackendSymbol, explainBackendDiff},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			have := explainBackends(tc.resultTypes, tc.pattern, tc.global)
+			if diff := cmp.Diff(tc.want, have); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
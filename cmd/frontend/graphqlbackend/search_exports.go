@@ -0,0 +1,49 @@
+package graphqlbackend
+
+import (
+	"context"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
+)
+
+// SearchExportsResolver is the root resolver of search exports. The actual
+// resolvers can be found in enterprise/internal/searchexports/resolvers.
+type SearchExportsResolver interface {
+	// Query
+	SearchExports(ctx context.Context, args *ListSearchExportsArgs) (SearchExportConnectionResolver, error)
+
+	// Mutations
+	CreateSearchExport(ctx context.Context, args *CreateSearchExportArgs) (SearchExportResolver, error)
+
+	NodeResolvers() map[string]NodeByIDFunc
+}
+
+type ListSearchExportsArgs struct {
+	First int32
+}
+
+type CreateSearchExportArgs struct {
+	Query       string
+	PatternType *string
+	Format      string
+}
+
+type SearchExportResolver interface {
+	ID() graphql.ID
+	Query() string
+	Format() string
+	State() string
+	Failure() *string
+	ResultCount() int32
+	CreatedAt() DateTime
+	FinishedAt() *DateTime
+	DownloadURL(ctx context.Context) (*string, error)
+}
+
+type SearchExportConnectionResolver interface {
+	Nodes(ctx context.Context) ([]SearchExportResolver, error)
+	TotalCount(ctx context.Context) (int32, error)
+	PageInfo(ctx context.Context) (*graphqlutil.PageInfo, error)
+}
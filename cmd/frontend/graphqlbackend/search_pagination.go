@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/graph-gophers/graphql-go"
 	"github.com/graph-gophers/graphql-go/relay"
 	"github.com/inconshreveable/log15"
@@ -139,8 +140,27 @@ func (r *searchResolver) paginatedResults(ctx context.Context) (result *SearchRe
 	resultTypes := r.determineResultTypes(args, searchresult.TypeEmpty)
 	tr.LazyPrintf("resultTypes: %v", resultTypes)
 
+	if resultTypes == searchresult.TypeCommit || resultTypes == searchresult.TypeDiff {
+		cursor, results, commitCommon, err := paginatedSearchCommitsInRepos(ctx, r.db, &args, resultTypes == searchresult.TypeDiff, r.Pagination)
+		if err != nil {
+			return nil, err
+		}
+
+		var alert *searchAlert
+		if len(resolved.MissingRepoRevs) > 0 {
+			alert = alertForMissingRepoRevs(resolved.MissingRepoRevs)
+		}
+
+		return &SearchResults{
+			Matches: results,
+			Stats:   *commitCommon,
+			Cursor:  cursor,
+			Alert:   alert,
+		}, nil
+	}
+
 	if resultTypes != searchresult.TypeFile {
-		return nil, fmt.Errorf("experimental paginated search currently only supports 'file' (text match) result types. Found %q", resultTypes)
+		return nil, fmt.Errorf("experimental paginated search currently only supports 'file' (text match), 'commit' and 'diff' result types. Found %q", resultTypes)
 	}
 
 	// Since we're searching a subset of the repositories this query would
@@ -245,6 +265,70 @@ func paginatedSearchFilesInRepos(ctx context.Context, db dbutil.DB, args *search
 	})
 }
 
+// paginatedSearchCommitsInRepos implements result-level pagination of commit
+// and diff search results, ordered by date. Unlike text search, commits cannot
+// be paginated by repository, since the newest commits may be in any
+// repository. Instead, every repository is searched on each request and the
+// results up to and including the cursor position are discarded. This is
+// costly for deep pages, but gives stable pages even if repositories are added
+// or removed between requests.
+func paginatedSearchCommitsInRepos(ctx context.Context, db dbutil.DB, args *search.TextParameters, diff bool, pagination *run.SearchPaginationInfo) (*run.SearchCursor, []result.Match, *streaming.Stats, error) {
+	order := run.SearchCursorOrderDate
+
+	var after *run.SearchCursorPosition
+	if cursor := pagination.Cursor; cursor != nil && cursor.After != nil {
+		if !cursor.Order.Equal(order) {
+			return nil, nil, nil, fmt.Errorf("search cursor with order %q cannot be used to paginate commit search results", cursor.Order)
+		}
+		after = cursor.After
+	} else if cursor != nil && (cursor.RepositoryOffset != 0 || cursor.ResultOffset != 0) {
+		return nil, nil, nil, errors.New("search cursor cannot be used to paginate commit search results")
+	}
+
+	commitArgs, err := run.ResolveCommitParameters(ctx, args)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	matches, common, err := streaming.CollectStream(func(stream streaming.Sender) error {
+		if diff {
+			return run.SearchCommitDiffsInRepos(ctx, db, commitArgs, stream)
+		}
+		return run.SearchCommitLogInRepos(ctx, db, commitArgs, stream)
+	})
+	// Timeouts are reported through Stats so don't report an error for them
+	if err != nil && !(err == context.DeadlineExceeded || err == context.Canceled) {
+		return nil, nil, nil, err
+	}
+
+	// Search backends don't populate Stats.repos, the
+	// repository searcher does. We need to do that here.
+	common.Repos = make(map[api.RepoID]types.RepoName, len(commitArgs.Repos))
+	for _, r := range commitArgs.Repos {
+		common.Repos[r.Repo.ID] = r.Repo
+	}
+	common.IsLimitHit = false // irrelevant in paginated search
+
+	order.Sort(matches)
+	if after != nil {
+		matches = order.MatchesAfter(matches, *after)
+	}
+
+	limit := int(pagination.Limit)
+	nextCursor := &run.SearchCursor{Order: order, Finished: len(matches) <= limit}
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	if len(matches) > 0 {
+		last := run.PositionOf(matches[len(matches)-1])
+		nextCursor.After = &last
+	} else {
+		nextCursor.After = after
+	}
+
+	return nextCursor, matches, &common, nil
+}
+
 func fileMatchesToMatches(fms []*result.FileMatch) []result.Match {
 	matches := make([]result.Match, 0, len(fms))
 	for _, fm := range fms {
@@ -328,7 +412,22 @@ func (p *repoPaginationPlan) execute(ctx context.Context, repoStore *database.Re
 		repos                          = p.repositories
 		repositoryOffset, resultOffset int
 	)
-	if cursor := p.pagination.Cursor; cursor != nil {
+	var after *run.SearchCursorPosition
+	if cursor := p.pagination.Cursor; cursor != nil && cursor.After != nil {
+		if !cursor.Order.Equal(run.SearchCursorOrderRepo) {
+			return nil, nil, nil, fmt.Errorf("search cursor with order %q cannot be used to paginate text search results", cursor.Order)
+		}
+
+		// Resume at the repository of the last result consumed, which we
+		// find by name so that repositories added or removed since the
+		// cursor was created don't shift the results. The result offset
+		// within that repository is determined once it is searched.
+		after = cursor.After
+		repositoryOffset = sort.Search(len(repos), func(i int) bool {
+			return repos[i].Repo.Name >= after.Key.Repo
+		})
+		repos = repos[repositoryOffset:]
+	} else if cursor != nil {
 		resultOffset = int(cursor.ResultOffset)
 
 		// Clamping is required here because the repositories the user has
@@ -364,6 +463,12 @@ func (p *repoPaginationPlan) execute(ctx context.Context, repoStore *database.Re
 			return nil, nil, nil, err
 		}
 
+		// The first batch begins with the repository of the last result
+		// consumed, if it still exists. Skip over the results already consumed.
+		if after != nil && start == 0 {
+			resultOffset = len(batchResults) - len(run.SearchCursorOrderRepo.MatchesAfter(batchResults, *after))
+		}
+
 		// Accumulate the results and stop if we have enough for the user.
 		results = append(results, batchResults...)
 		common.Update(batchCommon)
@@ -375,9 +480,12 @@ func (p *repoPaginationPlan) execute(ctx context.Context, repoStore *database.Re
 	// If we found more results than the user wanted, discard the remaining
 	// ones.
 	sliced := sliceSearchResults(results, common, resultOffset, int(p.pagination.Limit))
-	nextCursor := &run.SearchCursor{ResultOffset: sliced.resultOffset}
+	nextCursor := &run.SearchCursor{ResultOffset: sliced.resultOffset, Order: run.SearchCursorOrderRepo}
 
 	if len(sliced.results) > 0 {
+		last := run.PositionOf(sliced.results[len(sliced.results)-1])
+		nextCursor.After = &last
+
 		// First, identify what repository corresponds to the last result.
 		lastRepoConsumedName := repoOfMatch(sliced.results[len(sliced.results)-1])
 		var lastRepoConsumed types.RepoName
@@ -394,7 +502,7 @@ func (p *repoPaginationPlan) execute(ctx context.Context, repoStore *database.Re
 		// first in the results and we need to know the position for the cursor
 		// RepositoryOffset.
 		potentialLastRepos := []types.RepoName{lastRepoConsumed}
-		sliced.common.Status.Filter(search.RepoStatusCloning|search.RepoStatusMissing, func(id api.RepoID) {
+		sliced.common.Status.Filter(search.RepoStatusCloning|search.RepoStatusMissing|search.RepoStatusRepoLimit, func(id api.RepoID) {
 			potentialLastRepos = append(potentialLastRepos, sliced.common.Repos[id])
 		})
 		sort.Slice(potentialLastRepos, func(i, j int) bool {
@@ -443,7 +551,7 @@ type slicedSearchResults struct {
 // information about the slicing that was performed.
 func sliceSearchResults(results []result.Match, common *streaming.Stats, offset, limit int) (final slicedSearchResults) {
 	firstRepo := ""
-	if len(results[:offset]) > 0 {
+	if len(results[:offset]) > 0 && offset < len(results) {
 		firstRepo = repoOfMatch(results[offset])
 	}
 	// First we handle the case of having few enough results that we do not
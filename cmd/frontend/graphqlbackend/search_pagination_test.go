@@ -270,6 +270,10 @@ func TestSearchPagination_repoPaginationPlan(t *testing.T) {
 			Revs: revs(rev...),
 		}
 	}
+	position := func(match result.Match) *run.SearchCursorPosition {
+		p := run.PositionOf(match)
+		return &p
+	}
 	searchRepos := []*search.RepositoryRevisions{
 		repoRevs("1", "master"),
 		repoRevs("2", "master"),
@@ -300,6 +304,7 @@ func TestSearchPagination_repoPaginationPlan(t *testing.T) {
 	tests := []struct {
 		name                string
 		executor            executor
+		repositories        []*search.RepositoryRevisions
 		request             *run.SearchPaginationInfo
 		wantSearchedBatches [][]*search.RepositoryRevisions
 		wantCursor          *run.SearchCursor
@@ -321,7 +326,12 @@ func TestSearchPagination_repoPaginationPlan(t *testing.T) {
 					repoRevs("4", "master"),
 				},
 			},
-			wantCursor: &run.SearchCursor{RepositoryOffset: 2, ResultOffset: 4},
+			wantCursor: &run.SearchCursor{
+				RepositoryOffset: 2,
+				ResultOffset:     4,
+				Order:            run.SearchCursorOrderRepo,
+				After:            position(matchResult(repoName("3"), "some/file0.go", "feature")),
+			},
 			wantResults: []result.Match{
 				matchResult(repoName("1"), "some/file0.go", "master"),
 				matchResult(repoName("1"), "some/file1.go", "master"),
@@ -351,7 +361,13 @@ func TestSearchPagination_repoPaginationPlan(t *testing.T) {
 					repoRevs("5", "master"),
 				},
 			},
-			wantCursor: &run.SearchCursor{RepositoryOffset: 5, ResultOffset: 0, Finished: true},
+			wantCursor: &run.SearchCursor{
+				RepositoryOffset: 5,
+				ResultOffset:     0,
+				Finished:         true,
+				Order:            run.SearchCursorOrderRepo,
+				After:            position(matchResult(repoName("5"), "some/file2.go", "master")),
+			},
 			wantResults: []result.Match{
 				matchResult(repoName("3"), "some/file1.go", "feature"),
 				matchResult(repoName("3"), "some/file2.go", "feature"),
@@ -380,7 +396,12 @@ func TestSearchPagination_repoPaginationPlan(t *testing.T) {
 					repoRevs("4", "master"),
 				},
 			},
-			wantCursor: &run.SearchCursor{RepositoryOffset: 0, ResultOffset: 1},
+			wantCursor: &run.SearchCursor{
+				RepositoryOffset: 0,
+				ResultOffset:     1,
+				Order:            run.SearchCursorOrderRepo,
+				After:            position(matchResult(repoName("1"), "some/file0.go", "master")),
+			},
 			wantResults: []result.Match{
 				matchResult(repoName("1"), "some/file0.go", "master"),
 			},
@@ -402,7 +423,12 @@ func TestSearchPagination_repoPaginationPlan(t *testing.T) {
 					repoRevs("4", "master"),
 				},
 			},
-			wantCursor: &run.SearchCursor{RepositoryOffset: 0, ResultOffset: 2},
+			wantCursor: &run.SearchCursor{
+				RepositoryOffset: 0,
+				ResultOffset:     2,
+				Order:            run.SearchCursorOrderRepo,
+				After:            position(matchResult(repoName("1"), "some/file1.go", "master")),
+			},
 			wantResults: []result.Match{
 				matchResult(repoName("1"), "some/file1.go", "master"),
 			},
@@ -410,6 +436,62 @@ func TestSearchPagination_repoPaginationPlan(t *testing.T) {
 				Repos: reposMap(repoName("1")),
 			},
 		},
+		{
+			name: "second request resumed by position after a repository was removed",
+			repositories: []*search.RepositoryRevisions{
+				repoRevs("1", "master"),
+				repoRevs("3", "master", "feature"),
+				repoRevs("4", "master"),
+				repoRevs("5", "master"),
+			},
+			request: &run.SearchPaginationInfo{
+				Cursor: &run.SearchCursor{
+					RepositoryOffset: 2,
+					ResultOffset:     4,
+					Order:            run.SearchCursorOrderRepo,
+					After:            position(matchResult(repoName("3"), "some/file0.go", "feature")),
+				},
+				Limit: 10,
+			},
+			wantSearchedBatches: [][]*search.RepositoryRevisions{
+				{
+					repoRevs("3", "master", "feature"),
+					repoRevs("4", "master"),
+					repoRevs("5", "master"),
+				},
+			},
+			wantCursor: &run.SearchCursor{
+				RepositoryOffset: 4,
+				ResultOffset:     0,
+				Finished:         true,
+				Order:            run.SearchCursorOrderRepo,
+				After:            position(matchResult(repoName("5"), "some/file2.go", "master")),
+			},
+			wantResults: []result.Match{
+				matchResult(repoName("3"), "some/file1.go", "feature"),
+				matchResult(repoName("3"), "some/file2.go", "feature"),
+				matchResult(repoName("4"), "some/file0.go", "master"),
+				matchResult(repoName("4"), "some/file1.go", "master"),
+				matchResult(repoName("4"), "some/file2.go", "master"),
+				matchResult(repoName("5"), "some/file0.go", "master"),
+				matchResult(repoName("5"), "some/file1.go", "master"),
+				matchResult(repoName("5"), "some/file2.go", "master"),
+			},
+			wantCommon: &streaming.Stats{
+				Repos: reposMap(repoName("3"), repoName("4"), repoName("5")),
+			},
+		},
+		{
+			name: "cursor with another order",
+			request: &run.SearchPaginationInfo{
+				Cursor: &run.SearchCursor{
+					Order: run.SearchCursorOrderDate,
+					After: position(matchResult(repoName("3"), "some/file0.go", "feature")),
+				},
+				Limit: 10,
+			},
+			wantErr: fmt.Errorf(`search cursor with order "date" cannot be used to paginate text search results`),
+		},
 		{
 			name:     "no results",
 			executor: noResultsExecutor,
@@ -417,7 +499,7 @@ func TestSearchPagination_repoPaginationPlan(t *testing.T) {
 				Cursor: &run.SearchCursor{},
 				Limit:  1,
 			},
-			wantCursor: &run.SearchCursor{RepositoryOffset: 1, ResultOffset: 0, Finished: true},
+			wantCursor: &run.SearchCursor{RepositoryOffset: 1, ResultOffset: 0, Finished: true, Order: run.SearchCursorOrderRepo},
 			wantCommon: &streaming.Stats{
 				Repos: reposMap(),
 			},
@@ -426,13 +508,17 @@ func TestSearchPagination_repoPaginationPlan(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			searchedBatches = nil
+			repositories := searchRepos
+			if test.repositories != nil {
+				repositories = test.repositories
+			}
 			plan := &repoPaginationPlan{
 				pagination:          test.request,
-				repositories:        searchRepos,
+				repositories:        repositories,
 				searchBucketDivisor: 8,
 				searchBucketMin:     4,
 				searchBucketMax:     10,
-				mockNumTotalRepos:   func() int { return len(searchRepos) },
+				mockNumTotalRepos:   func() int { return len(repositories) },
 			}
 			executor := resultsExecutor
 			if test.executor != nil {
@@ -448,8 +534,8 @@ func TestSearchPagination_repoPaginationPlan(t *testing.T) {
 			if diff := cmp.Diff(test.wantCommon, common, cmpopts.EquateEmpty()); diff != "" {
 				t.Error("wantCommon != common", diff)
 			}
-			if !cmp.Equal(test.wantErr, err) {
-				t.Error("wantErr != err", cmp.Diff(test.wantErr, err))
+			if fmt.Sprint(test.wantErr) != fmt.Sprint(err) {
+				t.Errorf("wantErr != err: want=%v have=%v", test.wantErr, err)
 			}
 			if !cmp.Equal(test.wantSearchedBatches, searchedBatches) {
 				t.Error("wantSearchedBatches != searchedBatches", cmp.Diff(test.wantSearchedBatches, searchedBatches))
@@ -478,6 +564,10 @@ func TestSearchPagination_issue_6287(t *testing.T) {
 			Revs: revs(rev...),
 		}
 	}
+	position := func(match result.Match) *run.SearchCursorPosition {
+		p := run.PositionOf(match)
+		return &p
+	}
 	repoResults := map[string][]result.Match{
 		"1": {
 			mkFileMatch(repoName("1"), "a.go"),
@@ -518,7 +608,12 @@ func TestSearchPagination_issue_6287(t *testing.T) {
 				Cursor: &run.SearchCursor{},
 				Limit:  3,
 			},
-			wantCursor: &run.SearchCursor{RepositoryOffset: 1, ResultOffset: 1},
+			wantCursor: &run.SearchCursor{
+				RepositoryOffset: 1,
+				ResultOffset:     1,
+				Order:            run.SearchCursorOrderRepo,
+				After:            position(mkFileMatch(repoName("2"), "a.go")),
+			},
 			wantResults: []result.Match{
 				mkFileMatch(repoName("1"), "a.go"),
 				mkFileMatch(repoName("1"), "b.go"),
@@ -531,7 +626,12 @@ func TestSearchPagination_issue_6287(t *testing.T) {
 				Cursor: &run.SearchCursor{RepositoryOffset: 1, ResultOffset: 1},
 				Limit:  3,
 			},
-			wantCursor: &run.SearchCursor{RepositoryOffset: 1, ResultOffset: 4},
+			wantCursor: &run.SearchCursor{
+				RepositoryOffset: 1,
+				ResultOffset:     4,
+				Order:            run.SearchCursorOrderRepo,
+				After:            position(mkFileMatch(repoName("2"), "d.go")),
+			},
 			wantResults: []result.Match{
 				mkFileMatch(repoName("2"), "b.go"),
 				mkFileMatch(repoName("2"), "c.go"),
@@ -544,7 +644,13 @@ func TestSearchPagination_issue_6287(t *testing.T) {
 				Cursor: &run.SearchCursor{RepositoryOffset: 1, ResultOffset: 4},
 				Limit:  3,
 			},
-			wantCursor: &run.SearchCursor{RepositoryOffset: 2, ResultOffset: 0, Finished: true},
+			wantCursor: &run.SearchCursor{
+				RepositoryOffset: 2,
+				ResultOffset:     0,
+				Finished:         true,
+				Order:            run.SearchCursorOrderRepo,
+				After:            position(mkFileMatch(repoName("2"), "e.go")),
+			},
 			wantResults: []result.Match{
 				mkFileMatch(repoName("2"), "e.go"),
 			},
@@ -597,6 +703,10 @@ func TestSearchPagination_cloning_missing(t *testing.T) {
 			Revs: revs(rev...),
 		}
 	}
+	position := func(match result.Match) *run.SearchCursorPosition {
+		p := run.PositionOf(match)
+		return &p
+	}
 	repoResults := map[string][]result.Match{
 		"a": {
 			mkFileMatch(repoName("a"), "a.go"),
@@ -657,7 +767,12 @@ func TestSearchPagination_cloning_missing(t *testing.T) {
 				Cursor: &run.SearchCursor{},
 				Limit:  1,
 			},
-			wantCursor: &run.SearchCursor{RepositoryOffset: 1, ResultOffset: 0},
+			wantCursor: &run.SearchCursor{
+				RepositoryOffset: 1,
+				ResultOffset:     0,
+				Order:            run.SearchCursorOrderRepo,
+				After:            position(mkFileMatch(repoName("a"), "a.go")),
+			},
 			wantResults: []result.Match{
 				mkFileMatch(repoName("a"), "a.go"),
 			},
@@ -671,7 +786,12 @@ func TestSearchPagination_cloning_missing(t *testing.T) {
 				Cursor: &run.SearchCursor{RepositoryOffset: 1, ResultOffset: 0},
 				Limit:  1,
 			},
-			wantCursor: &run.SearchCursor{RepositoryOffset: 3, ResultOffset: 0},
+			wantCursor: &run.SearchCursor{
+				RepositoryOffset: 3,
+				ResultOffset:     0,
+				Order:            run.SearchCursorOrderRepo,
+				After:            position(mkFileMatch(repoName("c"), "a.go")),
+			},
 			wantResults: []result.Match{
 				mkFileMatch(repoName("c"), "a.go"),
 			},
@@ -689,7 +809,12 @@ func TestSearchPagination_cloning_missing(t *testing.T) {
 				Cursor: &run.SearchCursor{},
 				Limit:  2,
 			},
-			wantCursor: &run.SearchCursor{RepositoryOffset: 3, ResultOffset: 0},
+			wantCursor: &run.SearchCursor{
+				RepositoryOffset: 3,
+				ResultOffset:     0,
+				Order:            run.SearchCursorOrderRepo,
+				After:            position(mkFileMatch(repoName("c"), "a.go")),
+			},
 			wantResults: []result.Match{
 				mkFileMatch(repoName("a"), "a.go"),
 				mkFileMatch(repoName("c"), "a.go"),
@@ -707,7 +832,13 @@ func TestSearchPagination_cloning_missing(t *testing.T) {
 				Cursor: &run.SearchCursor{},
 				Limit:  3,
 			},
-			wantCursor: &run.SearchCursor{RepositoryOffset: 6, ResultOffset: 0, Finished: true},
+			wantCursor: &run.SearchCursor{
+				RepositoryOffset: 6,
+				ResultOffset:     0,
+				Finished:         true,
+				Order:            run.SearchCursorOrderRepo,
+				After:            position(mkFileMatch(repoName("f"), "a.go")),
+			},
 			wantResults: []result.Match{
 				mkFileMatch(repoName("a"), "a.go"),
 				mkFileMatch(repoName("c"), "a.go"),
@@ -21,10 +21,13 @@ import (
 	otlog "github.com/opentracing/opentracing-go/log"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/envvar"
 	searchlogs "github.com/sourcegraph/sourcegraph/cmd/frontend/internal/search/logs"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/internal/featureflag"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
@@ -84,13 +87,59 @@ func (c *SearchResultsResolver) Cloning() []*RepositoryResolver {
 }
 
 func (c *SearchResultsResolver) Missing() []*RepositoryResolver {
-	return c.repositoryResolvers(search.RepoStatusMissing)
+	return c.repositoryResolvers(search.RepoStatusMissing | search.RepoStatusRepoLimit)
 }
 
 func (c *SearchResultsResolver) Timedout() []*RepositoryResolver {
 	return c.repositoryResolvers(search.RepoStatusTimedout)
 }
 
+func (c *SearchResultsResolver) Skipped() []*skippedRepositoryResolver {
+	skipped := c.Stats.Skipped()
+	resolvers := make([]*skippedRepositoryResolver, 0, len(skipped))
+	for _, s := range skipped {
+		resolvers = append(resolvers, &skippedRepositoryResolver{db: c.db, skipped: s})
+	}
+	return resolvers
+}
+
+// skippedRepositoryResolver is a resolver for the GraphQL type `SkippedRepository`
+type skippedRepositoryResolver struct {
+	db      dbutil.DB
+	skipped streaming.SkippedRepo
+}
+
+func (r *skippedRepositoryResolver) Repository() *RepositoryResolver {
+	return NewRepositoryResolver(r.db, r.skipped.Repo.ToRepo())
+}
+
+func (r *skippedRepositoryResolver) Reason() string {
+	switch r.skipped.Reason {
+	case search.RepoStatusCloning:
+		return "CLONING"
+	case search.RepoStatusMissing:
+		return "MISSING"
+	case search.RepoStatusTimedout:
+		return "TIMEDOUT"
+	default:
+		return "REPOSITORY_LIMIT"
+	}
+}
+
+func (r *skippedRepositoryResolver) Message() *string {
+	if r.skipped.Message == "" {
+		return nil
+	}
+	return &r.skipped.Message
+}
+
+func (r *skippedRepositoryResolver) SuggestedQuery() *string {
+	if r.skipped.SuggestedQuery == "" {
+		return nil
+	}
+	return &r.skipped.SuggestedQuery
+}
+
 func (c *SearchResultsResolver) IndexUnavailable() bool {
 	return c.Stats.IsIndexUnavailable
 }
@@ -713,23 +762,176 @@ func (r *searchResolver) evaluateOr(ctx context.Context, q query.Basic) (*Search
 		wantCount, _ = strconv.Atoi(count) // Invariant: count is already validated
 	}
 
-	result := &SearchResults{}
-	for _, term := range operands {
-		new, err := r.evaluatePatternExpression(ctx, q.MapPattern(term))
-		if err != nil {
-			return nil, err
-		}
-		if new != nil {
-			result = union(result, new)
-			// Do not rely on result.Stats.resultCount because it may
-			// count non-content matches and there's no easy way to know.
-			if len(result.Matches) > wantCount {
-				result.Matches = result.Matches[:wantCount]
-				return result, nil
+	result, err := r.evaluateBranches(ctx, len(operands), wantCount, func(ctx context.Context, r *searchResolver, i int) (*SearchResults, error) {
+		return r.evaluatePatternExpression(ctx, q.MapPattern(operands[i]))
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return &SearchResults{}, nil
+	}
+	return result, nil
+}
+
+// maxConcurrentBranches bounds the number of subexpressions of a query that
+// are evaluated concurrently.
+const maxConcurrentBranches = 4
+
+// evaluateBranches evaluates n subexpressions of a query with eval, at most
+// maxConcurrentBranches at a time, and returns the union of their results.
+// Each evaluation is passed its own fork of r (see fork).
+//
+// Results are merged in the order of the subexpressions. Once the results of
+// the first subexpressions exceed wantCount, the remaining evaluations are
+// canceled and the results are truncated, so that the same results are
+// returned as if the subexpressions were evaluated one after the other. The
+// same holds for streamed results, see branchStreams.
+func (r *searchResolver) evaluateBranches(ctx context.Context, n, wantCount int, eval func(ctx context.Context, r *searchResolver, i int) (*SearchResults, error)) (*SearchResults, error) {
+	type branchResult struct {
+		results *SearchResults
+		err     error
+	}
+
+	// Wait for all evaluations to return before returning, so that none of
+	// them sends events to the stream after the search completed.
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	done := make([]chan branchResult, n)
+	for i := range done {
+		done[i] = make(chan branchResult, 1)
+	}
+
+	var streams *branchStreams
+	if r.stream != nil && n > 1 {
+		streams = newBranchStreams(r.stream, n, wantCount)
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		sem := make(chan struct{}, maxConcurrentBranches)
+		for i := 0; i < n; i++ {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				done[i] <- branchResult{err: ctx.Err()}
+				continue
+			}
+
+			forked := r.fork()
+			if streams != nil {
+				forked.stream = streams.branch(i)
 			}
+			wg.Add(1)
+			go func(i int) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				results, err := eval(ctx, forked, i)
+				done[i] <- branchResult{results: results, err: err}
+			}(i)
+		}
+	}()
+
+	var merged *SearchResults
+	for i := 0; i < n; i++ {
+		branch := <-done[i]
+		if branch.err != nil {
+			return nil, branch.err
+		}
+		if streams != nil {
+			streams.advance(i + 1)
+		}
+		if branch.results == nil {
+			continue
+		}
+		merged = union(merged, branch.results)
+		// Do not rely on result.Stats.resultCount because it may
+		// count non-content matches and there's no easy way to know.
+		if len(merged.Matches) > wantCount {
+			merged.Matches = merged.Matches[:wantCount]
+			return merged, nil
 		}
 	}
-	return result, nil
+	return merged, nil
+}
+
+// branchStreams orders the events that concurrently evaluated subexpressions
+// of a query send to a stream. Events of the leading subexpression, the first
+// one which has not completed yet, are passed on to the parent stream. Events
+// of later subexpressions are buffered until they lead. At most wantCount
+// matches are passed on in total, as sequential evaluation stops once the
+// results exceed wantCount.
+type branchStreams struct {
+	mu        sync.Mutex
+	parent    streaming.Sender
+	leader    int
+	buffered  [][]streaming.SearchEvent
+	remaining int
+}
+
+func newBranchStreams(parent streaming.Sender, n, wantCount int) *branchStreams {
+	return &branchStreams{
+		parent:    parent,
+		buffered:  make([][]streaming.SearchEvent, n),
+		remaining: wantCount,
+	}
+}
+
+// branch returns the stream of the i-th subexpression.
+func (s *branchStreams) branch(i int) streaming.Sender {
+	return streaming.StreamFunc(func(event streaming.SearchEvent) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if i != s.leader {
+			s.buffered[i] = append(s.buffered[i], event)
+			return
+		}
+		s.send(event)
+	})
+}
+
+// advance makes the i-th subexpression the leading one once all earlier ones
+// have completed, and passes on the events it buffered so far.
+func (s *branchStreams) advance(i int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.leader = i
+	if i >= len(s.buffered) {
+		return
+	}
+	for _, event := range s.buffered[i] {
+		s.send(event)
+	}
+	s.buffered[i] = nil
+}
+
+func (s *branchStreams) send(event streaming.SearchEvent) {
+	if len(event.Results) > s.remaining {
+		event.Results = event.Results[:s.remaining]
+	}
+	s.remaining -= len(event.Results)
+	s.parent.Send(event)
+}
+
+// fork returns a copy of r that evaluates a subexpression of the query
+// concurrently with other copies. Copies share the cache of resolved
+// repositories, so that repositories are only resolved once for all
+// subexpressions. If the subexpressions resolve different repositories,
+// setQuery gives each copy its own cache instead.
+func (r *searchResolver) fork() *searchResolver {
+	inputs := *r.SearchInputs
+	forked := *r
+	forked.SearchInputs = &inputs
+	return &forked
 }
 
 // setQuery sets a new query in the search resolver, for potentially repeated
@@ -737,8 +939,10 @@ func (r *searchResolver) evaluateOr(ctx context.Context, q query.Basic) (*Search
 // invalidating cached repo info.
 func (r *searchResolver) setQuery(q []query.Node) {
 	if r.invalidateRepoCache {
-		r.resolved.RepoRevs = nil
-		r.resolved.MissingRepoRevs = nil
+		// Start a new cache rather than clearing the current one, which may
+		// be shared with forks of r.
+		r.reposMu = &sync.Mutex{}
+		r.resolved = &searchrepos.Resolved{}
 		r.repoErr = nil
 	}
 	r.Query = q
@@ -873,6 +1077,9 @@ func (r *searchResolver) logBatch(ctx context.Context, srr *SearchResultsResolve
 func (r *searchResolver) resultsBatch(ctx context.Context) (*SearchResultsResolver, error) {
 	start := time.Now()
 	sr, err := r.resultsRecursive(ctx, r.Plan)
+	if sr != nil && r.rankResults() {
+		run.RankMatches(ctx, r.db, sr.Matches)
+	}
 	srr := r.resultsToResolver(sr)
 	r.logBatch(ctx, srr, start, err)
 	return srr, err
@@ -911,11 +1118,40 @@ func (r *searchResolver) resultsToResolver(results *SearchResults) *SearchResult
 	}
 }
 
-func (r *searchResolver) Results(ctx context.Context) (*SearchResultsResolver, error) {
-	if r.stream == nil {
-		return r.resultsBatch(ctx)
+func (r *searchResolver) Results(ctx context.Context) (srr *SearchResultsResolver, err error) {
+	if r.stream != nil {
+		// The demand of streamed results is recorded by the stream handler.
+		return r.resultsStreaming(ctx)
+	}
+	srr, err = r.resultsBatch(ctx)
+	if srr != nil {
+		backend.RecordRepoDemand(r.db, database.RepoDemandSearch, MatchedRepoIDs(srr.Matches)...)
+	}
+	return srr, err
+}
+
+// MatchedRepoIDs returns the IDs of the repos that have matches, in order of
+// their first match.
+func MatchedRepoIDs(matches []result.Match) []api.RepoID {
+	seen := make(map[api.RepoID]struct{})
+	ids := make([]api.RepoID, 0)
+	add := func(id api.RepoID) {
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			ids = append(ids, id)
+		}
+	}
+	for _, m := range matches {
+		switch m := m.(type) {
+		case *result.FileMatch:
+			add(m.Repo.ID)
+		case *result.CommitMatch:
+			add(m.RepoName.ID)
+		case *result.RepoMatch:
+			add(m.ID)
+		}
 	}
-	return r.resultsStreaming(ctx)
+	return ids
 }
 
 // DetermineStatusForLogs determines the final status of a search for logging
@@ -953,7 +1189,8 @@ func (r *searchResolver) resultsRecursive(ctx context.Context, plan query.Plan)
 		wantCount = *count
 	}
 
-	for _, q := range plan {
+	sr, err = r.evaluateBranches(ctx, len(plan), wantCount, func(ctx context.Context, r *searchResolver, i int) (*SearchResults, error) {
+		q := plan[i]
 		predicatePlan, err := substitutePredicates(q, func(pred query.Predicate) (*SearchResults, error) {
 			// Disable streaming for subqueries so we can use
 			// the results rather than sending them back to the caller
@@ -969,7 +1206,7 @@ func (r *searchResolver) resultsRecursive(ctx context.Context, plan query.Plan)
 			return r.resultsRecursive(ctx, plan)
 		})
 		if err != nil && errors.Is(err, ErrPredicateNoResults) {
-			continue
+			return nil, nil
 		}
 		if err != nil {
 			// Fail if predicate processing fails.
@@ -985,15 +1222,13 @@ func (r *searchResolver) resultsRecursive(ctx context.Context, plan query.Plan)
 			// Fail if any subexpression fails.
 			return nil, err
 		}
-
 		if newResult != nil {
 			newResult.Matches = selectResults(newResult.Matches, q)
-			sr = union(sr, newResult)
-			if len(sr.Matches) > wantCount {
-				sr.Matches = sr.Matches[:wantCount]
-				break
-			}
 		}
+		return newResult, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	if sr != nil {
@@ -1063,6 +1298,12 @@ func substitutePredicates(q query.Basic, evaluate func(query.Predicate) (*Search
 			return orig
 		}
 
+		if field == query.FieldFile {
+			// file: predicates are evaluated by the search backends
+			// (see search.TextPatternInfo.IncludeContentPatterns).
+			return orig
+		}
+
 		if topErr != nil {
 			return orig
 		}
@@ -1262,7 +1503,17 @@ func (r *searchResolver) searchTimeoutFieldSet() bool {
 }
 
 func (r *searchResolver) withTimeout(ctx context.Context) (context.Context, context.CancelFunc, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.searchTimeout())
+	return ctx, cancel, nil
+}
+
+// searchTimeout returns the timeout of the current query, taking into account
+// the timeout: and count: fields and the maximum timeout of the site.
+func (r *searchResolver) searchTimeout() time.Duration {
 	d := defaultTimeout
+	if r.DefaultTimeout > 0 {
+		d = r.DefaultTimeout
+	}
 	maxTimeout := time.Duration(searchrepos.SearchLimits().MaxTimeoutSeconds) * time.Second
 	timeout := r.Query.Timeout()
 	if timeout != nil {
@@ -1274,8 +1525,7 @@ func (r *searchResolver) withTimeout(ctx context.Context) (context.Context, cont
 	if d > maxTimeout {
 		d = maxTimeout
 	}
-	ctx, cancel := context.WithTimeout(ctx, d)
-	return ctx, cancel, nil
+	return d
 }
 
 func (r *searchResolver) determineResultTypes(args search.TextParameters, forceTypes result.Types) result.Types {
@@ -1331,6 +1581,10 @@ func (r *searchResolver) isGlobalSearch() bool {
 	if r.PatternType == query.SearchTypeStructural {
 		return false
 	}
+	if r.Query.ContainsLookaround() {
+		// Lookaround is evaluated by searcher, which requires resolved repositories.
+		return false
+	}
 	if r.VersionContext != nil && *r.VersionContext != "" {
 		return false
 	}
@@ -1338,7 +1592,7 @@ func (r *searchResolver) isGlobalSearch() bool {
 	if !searchcontexts.IsGlobalSearchContextSpec(querySearchContextSpec) {
 		return false
 	}
-	return len(r.Query.Values(query.FieldRepo)) == 0 && len(r.Query.Values(query.FieldRepoGroup)) == 0 && len(r.Query.Values(query.FieldRepoHasFile)) == 0
+	return len(r.Query.Values(query.FieldRepo)) == 0 && len(r.Query.Values(query.FieldRepoGroup)) == 0 && len(r.Query.Values(query.FieldRepoHasFile)) == 0 && len(r.Query.Values(query.FieldRepoTopic)) == 0
 }
 
 // doResults is one of the highest level search functions that handles finding results.
@@ -1424,7 +1678,7 @@ func (r *searchResolver) doResults(ctx context.Context, forceResultTypes result.
 		defer cancelOnLimit()
 	}
 
-	agg := run.NewAggregator(r.db, stream)
+	agg := run.NewAggregator(ctx, r.db, stream)
 
 	// This ensures we properly cleanup in the case of an early return. In
 	// particular we want to cancel global searches before returning early.
@@ -1447,6 +1701,15 @@ func (r *searchResolver) doResults(ctx context.Context, forceResultTypes result.
 	if r.isGlobalSearch() && isIndexedSearch && isFileOrPath {
 		argsIndexed := args
 		argsIndexed.Mode = search.ZoektGlobalSearch
+		repoFilters, _ := r.Query.Repositories()
+		argsIndexed.Fork, argsIndexed.Archived = r.forkAndArchived(repoFilters)
+		if privateRepos, err := searchrepos.PrivateReposForActor(ctx, r.db); err != nil {
+			// Fall back to filtering the results of all private repositories
+			// against the resolved repositories.
+			log15.Warn("Failed to list private repositories for global search", "error", err)
+		} else {
+			argsIndexed.UserPrivateRepos = privateRepos
+		}
 		wg := waitGroup(true)
 		wg.Add(1)
 		goroutine.Go(func() {
@@ -1698,6 +1961,20 @@ func (r *searchResolver) sortResults(results []result.Match) {
 	sort.Slice(results, func(i, j int) bool { return compareSearchResults(results[i], results[j], exactPatterns) })
 }
 
+// rankResults returns whether results are ranked by repository activity (see
+// run.RankMatches), as set by the rank: field or else the site configuration.
+// Results of stable searches are never ranked.
+func (r *searchResolver) rankResults() bool {
+	if r.Query.BoolValue(query.FieldStable) {
+		return false
+	}
+	if rank := r.Query.Rank(); rank != nil {
+		return *rank
+	}
+	ef := conf.Get().ExperimentalFeatures
+	return ef != nil && ef.Ranking != nil && ef.Ranking.RankResults
+}
+
 // getExactFilePatterns returns the set of file patterns without glob syntax.
 func (r *searchResolver) getExactFilePatterns() map[string]struct{} {
 	m := map[string]struct{}{}
@@ -1705,6 +1982,9 @@ func (r *searchResolver) getExactFilePatterns() map[string]struct{} {
 		r.Query,
 		query.FieldFile,
 		func(value string, negated bool, annotation query.Annotation) {
+			if annotation.Labels.IsSet(query.IsPredicate) {
+				return
+			}
 			originalValue := r.OriginalQuery[annotation.Range.Start.Column+len(query.FieldFile)+1 : annotation.Range.End.Column]
 			if !negated && query.ContainsNoGlobSyntax(originalValue) {
 				m[originalValue] = struct{}{}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"testing"
@@ -943,6 +944,108 @@ func TestEvaluateAnd(t *testing.T) {
 	}
 }
 
+func TestEvaluateBranches(t *testing.T) {
+	r := &searchResolver{
+		SearchInputs: &run.SearchInputs{},
+		reposMu:      &sync.Mutex{},
+		resolved:     &searchrepos.Resolved{},
+	}
+	names := func(sr *SearchResults) []string {
+		var names []string
+		for _, m := range sr.Matches {
+			names = append(names, string(m.(*result.RepoMatch).Name))
+		}
+		return names
+	}
+
+	t.Run("merges results in order", func(t *testing.T) {
+		sr, err := r.evaluateBranches(context.Background(), 3, 10, func(_ context.Context, _ *searchResolver, i int) (*SearchResults, error) {
+			// Later branches finish first.
+			time.Sleep(time.Duration(3-i) * 10 * time.Millisecond)
+			return &SearchResults{Matches: []result.Match{repoResult(fmt.Sprintf("repo-%d", i))}}, nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff([]string{"repo-0", "repo-1", "repo-2"}, names(sr)); diff != "" {
+			t.Errorf("unexpected results (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("cancels remaining branches once the limit is exceeded", func(t *testing.T) {
+		sr, err := r.evaluateBranches(context.Background(), 3, 2, func(ctx context.Context, _ *searchResolver, i int) (*SearchResults, error) {
+			switch i {
+			case 0:
+				return &SearchResults{Matches: []result.Match{repoResult("a")}}, nil
+			case 1:
+				return &SearchResults{Matches: []result.Match{repoResult("b"), repoResult("c")}}, nil
+			default:
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff([]string{"a", "b"}, names(sr)); diff != "" {
+			t.Errorf("unexpected results (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("streams results in order up to the limit", func(t *testing.T) {
+		var (
+			mu       sync.Mutex
+			streamed []string
+		)
+		stream := streaming.StreamFunc(func(event streaming.SearchEvent) {
+			mu.Lock()
+			defer mu.Unlock()
+			for _, m := range event.Results {
+				streamed = append(streamed, string(m.(*result.RepoMatch).Name))
+			}
+		})
+
+		r := &searchResolver{
+			SearchInputs: &run.SearchInputs{},
+			reposMu:      &sync.Mutex{},
+			resolved:     &searchrepos.Resolved{},
+			stream:       stream,
+		}
+		_, err := r.evaluateBranches(context.Background(), 3, 3, func(_ context.Context, r *searchResolver, i int) (*SearchResults, error) {
+			// Later branches finish first.
+			time.Sleep(time.Duration(3-i) * 10 * time.Millisecond)
+			matches := []result.Match{repoResult(fmt.Sprintf("repo-%d-a", i)), repoResult(fmt.Sprintf("repo-%d-b", i))}
+			for _, m := range matches {
+				r.stream.Send(streaming.SearchEvent{Results: []result.Match{m}})
+			}
+			return &SearchResults{Matches: matches}, nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff([]string{"repo-0-a", "repo-0-b", "repo-1-a"}, streamed); diff != "" {
+			t.Errorf("unexpected streamed results (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("forks do not share the current query", func(t *testing.T) {
+		_, err := r.evaluateBranches(context.Background(), 8, 10, func(_ context.Context, r *searchResolver, i int) (*SearchResults, error) {
+			r.setQuery([]query.Node{query.Parameter{Field: query.FieldRepo, Value: strconv.Itoa(i)}})
+			time.Sleep(time.Millisecond)
+			if want, got := fmt.Sprintf(`"repo:%d"`, i), r.Query.String(); got != want {
+				return nil, fmt.Errorf("want query %s, got %s", want, got)
+			}
+			return nil, nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(r.Query) != 0 {
+			t.Errorf("expected the query of r to be unchanged, got %s", r.Query.String())
+		}
+	})
+}
+
 func TestSearchContext(t *testing.T) {
 	orig := envvar.SourcegraphDotComMode()
 	envvar.MockSourcegraphDotComMode(true)
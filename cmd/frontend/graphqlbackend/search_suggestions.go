@@ -15,6 +15,7 @@ import (
 	"github.com/sourcegraph/go-lsp"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/search"
@@ -144,6 +145,33 @@ func (l languageSuggestionResolver) Key() suggestionKey {
 	}
 }
 
+// filterSubRepoPermsSuggestions removes the file and symbol suggestions in
+// paths hidden from the actor of the context by sub-repository permissions.
+func filterSubRepoPermsSuggestions(ctx context.Context, suggestions []SearchSuggestionResolver) ([]SearchSuggestionResolver, error) {
+	filtered := suggestions[:0]
+	for _, s := range suggestions {
+		var content authz.RepoContent
+		switch s := s.(type) {
+		case gitTreeSuggestionResolver:
+			content = authz.RepoContent{Repo: s.gitTreeEntry.Repository().RepoName(), Path: s.gitTreeEntry.Path()}
+		case symbolSuggestionResolver:
+			content = authz.RepoContent{Repo: s.symbol.File.Repo.Name, Path: s.symbol.File.Path}
+		default:
+			filtered = append(filtered, s)
+			continue
+		}
+
+		perms, err := authz.ActorPermissions(ctx, authz.DefaultSubRepoPermsChecker, content)
+		if err != nil {
+			return nil, err
+		}
+		if perms.Include(authz.Read) {
+			filtered = append(filtered, s)
+		}
+	}
+	return filtered, nil
+}
+
 func sortSearchSuggestions(s []SearchSuggestionResolver) {
 	sort.Slice(s, func(i, j int) bool {
 		// Sort by score
@@ -587,6 +615,11 @@ func (r *searchResolver) Suggestions(ctx context.Context, args *searchSuggestion
 	}
 	allSuggestions = uniqueSuggestions
 
+	allSuggestions, err := filterSubRepoPermsSuggestions(ctx, allSuggestions)
+	if err != nil {
+		return nil, err
+	}
+
 	sortSearchSuggestions(allSuggestions)
 	if len(allSuggestions) > int(*args.First) {
 		allSuggestions = allSuggestions[:*args.First]
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -306,6 +307,88 @@ func TestDetectSearchType(t *testing.T) {
 	}
 }
 
+func TestNewSearchImplementer_settingsDefaults(t *testing.T) {
+	conf.Mock(&conf.Unified{})
+	defer conf.Mock(nil)
+
+	typeRegexp := "regexp"
+	testCases := []struct {
+		name        string
+		patternType *string
+		query       string
+		settings    *schema.Settings
+		wantType    query.SearchType
+		wantLimit   int
+		wantTimeout time.Duration
+	}{
+		{
+			name:        "no defaults",
+			query:       "foo",
+			settings:    &schema.Settings{},
+			wantType:    query.SearchTypeLiteral,
+			wantLimit:   defaultMaxSearchResults,
+			wantTimeout: defaultTimeout,
+		},
+		{
+			name:        "defaults",
+			query:       "foo",
+			settings:    &schema.Settings{SearchDefaultPatternType: "regexp", SearchDefaultCount: 100, SearchDefaultTimeoutSeconds: 30},
+			wantType:    query.SearchTypeRegex,
+			wantLimit:   100,
+			wantTimeout: 30 * time.Second,
+		},
+		{
+			name:        "query overrides defaults",
+			query:       "foo patterntype:literal count:10 timeout:5s",
+			settings:    &schema.Settings{SearchDefaultPatternType: "regexp", SearchDefaultCount: 100, SearchDefaultTimeoutSeconds: 30},
+			wantType:    query.SearchTypeLiteral,
+			wantLimit:   10,
+			wantTimeout: 5 * time.Second,
+		},
+		{
+			name:        "request pattern type overrides default",
+			patternType: &typeRegexp,
+			query:       "foo",
+			settings:    &schema.Settings{SearchDefaultPatternType: "literal"},
+			wantType:    query.SearchTypeRegex,
+			wantLimit:   defaultMaxSearchResults,
+			wantTimeout: defaultTimeout,
+		},
+		{
+			name:        "timeout is capped",
+			query:       "foo",
+			settings:    &schema.Settings{SearchDefaultTimeoutSeconds: 3600},
+			wantType:    query.SearchTypeLiteral,
+			wantLimit:   defaultMaxSearchResults,
+			wantTimeout: time.Minute,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			impl, err := NewSearchImplementer(context.Background(), new(dbtesting.MockDB), &SearchArgs{
+				Version:     "V2",
+				PatternType: test.patternType,
+				Query:       test.query,
+				Settings:    test.settings,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := impl.(*searchResolver)
+			if r.PatternType != test.wantType {
+				t.Errorf("got pattern type %v, want %v", r.PatternType, test.wantType)
+			}
+			if got := r.MaxResults(); got != test.wantLimit {
+				t.Errorf("got limit %d, want %d", got, test.wantLimit)
+			}
+			if got := r.searchTimeout(); got != test.wantTimeout {
+				t.Errorf("got timeout %v, want %v", got, test.wantTimeout)
+			}
+		})
+	}
+}
+
 func TestExactlyOneRepo(t *testing.T) {
 	cases := []struct {
 		repoFilters []string
@@ -12,6 +12,7 @@ import (
 	"github.com/graph-gophers/graphql-go"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	gitserverproto "github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
@@ -68,6 +69,26 @@ func (r *schemaResolver) SetUserPublicRepos(ctx context.Context, args struct {
 	return &EmptyResponse{}, nil
 }
 
+func (r *schemaResolver) SetUserPublicReposAutoRefresh(ctx context.Context, args struct {
+	UserID  graphql.ID
+	Enabled bool
+}) (*EmptyResponse, error) {
+	if !envvar.SourcegraphDotComMode() {
+		return nil, errors.Errorf("SetUserPublicReposAutoRefresh is not supported on instances without SOURCEGRAPHDOTCOM_MODE=true")
+	}
+	userID, err := UnmarshalUserID(args.UserID)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshaling User ID")
+	}
+	if err := backend.CheckSiteAdminOrSameUser(ctx, r.db, userID); err != nil {
+		return nil, err
+	}
+	if err := database.UserPublicRepos(r.db).SetAutoRefresh(ctx, userID, args.Enabled); err != nil {
+		return nil, errors.Wrap(err, "Updating public repos auto refresh")
+	}
+	return &EmptyResponse{}, nil
+}
+
 // getRepo attempts to find a repo in the database by URI, returning the ID if it's found. If it's not found
 // it will use RepoLookup on repo-updater to fetch the repo info from a code host, store it in the repos table,
 // enqueue a clone for that repo, and return the repo ID
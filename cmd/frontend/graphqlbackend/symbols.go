@@ -94,6 +94,23 @@ func (r symbolResolver) ContainerName() *string {
 	return &r.Symbol.Parent
 }
 
+func (r symbolResolver) Parents() []string {
+	parents := r.Symbol.ParentChain()
+	if parents == nil {
+		return []string{}
+	}
+	return parents
+}
+
+func (r symbolResolver) QualifiedName() string { return r.Symbol.QualifiedName() }
+
+func (r symbolResolver) Signature() *string {
+	if r.Symbol.Signature == "" {
+		return nil
+	}
+	return &r.Symbol.Signature
+}
+
 func (r symbolResolver) Kind() string /* enum SymbolKind */ {
 	kind := r.Symbol.LSPKind()
 	if kind == 0 {
@@ -0,0 +1,178 @@
+package graphqlbackend
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/repoupdater"
+	"github.com/sourcegraph/sourcegraph/internal/repoupdater/protocol"
+)
+
+const (
+	// typeaheadTimeout is the time budget of a typeahead request. Typeahead
+	// runs on every keystroke, so it is better to show no suggestions than
+	// stale ones.
+	typeaheadTimeout = 50 * time.Millisecond
+
+	// typeaheadOverfetch is the factor by which more suggestions are requested
+	// from the index than returned, to account for suggestions dropped because
+	// the user cannot access their repository.
+	typeaheadOverfetch = 3
+)
+
+type typeaheadArgs struct {
+	Query string
+	First *int32
+}
+
+func (r *schemaResolver) Typeahead(ctx context.Context, args *typeaheadArgs) ([]SearchSuggestionResolver, error) {
+	first := 10
+	if args.First != nil {
+		first = int(*args.First)
+	}
+	if first <= 0 {
+		return []SearchSuggestionResolver{}, nil
+	}
+	if first > maxSearchSuggestions {
+		first = maxSearchSuggestions
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, typeaheadTimeout)
+	defer cancel()
+
+	result, err := repoupdater.DefaultClient.Typeahead(ctx, protocol.TypeaheadRequest{
+		Query: args.Query,
+		Limit: first * typeaheadOverfetch,
+	})
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return []SearchSuggestionResolver{}, nil
+		}
+		return nil, err
+	}
+	if len(result.Suggestions) == 0 {
+		return []SearchSuggestionResolver{}, nil
+	}
+
+	ids := make([]api.RepoID, 0, len(result.Suggestions))
+	for _, s := range result.Suggestions {
+		ids = append(ids, s.RepoID)
+	}
+
+	// 🚨 SECURITY: The index holds all repositories. Listing the repositories
+	// of the suggestions again filters out those the user cannot access.
+	repos, err := database.Repos(r.db).ListRepoNames(ctx, database.ReposListOptions{IDs: ids})
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return []SearchSuggestionResolver{}, nil
+		}
+		return nil, err
+	}
+	repoResolvers := make(map[api.RepoID]*RepositoryResolver, len(repos))
+	for i := range repos {
+		repoResolvers[repos[i].ID] = NewRepositoryResolver(r.db, repos[i].ToRepo())
+	}
+
+	suggestions := make([]SearchSuggestionResolver, 0, len(result.Suggestions))
+	seen := make(map[suggestionKey]struct{}, len(result.Suggestions))
+	for _, s := range result.Suggestions {
+		repo, ok := repoResolvers[s.RepoID]
+		if !ok {
+			continue
+		}
+
+		var suggestion SearchSuggestionResolver
+		switch s.Kind {
+		case "repo":
+			suggestion = repositorySuggestionResolver{repo: repo, score: s.Score}
+		case "file":
+			commit := toGitCommitResolver(repo, r.db, s.CommitID, nil)
+			entry := NewGitTreeEntryResolver(commit, r.db, CreateFileInfo(s.Path, false))
+			suggestion = gitTreeSuggestionResolver{gitTreeEntry: entry, score: s.Score}
+		default:
+			continue
+		}
+
+		if _, dup := seen[suggestion.Key()]; dup {
+			continue
+		}
+		seen[suggestion.Key()] = struct{}{}
+		suggestions = append(suggestions, suggestion)
+	}
+
+	// 🚨 SECURITY: The index is shared by all users, so files hidden by
+	// sub-repository permissions must be removed here.
+	suggestions, err = filterSubRepoPermsSuggestions(ctx, suggestions)
+	if err != nil {
+		return nil, err
+	}
+
+	sortSearchSuggestions(suggestions)
+	if len(suggestions) > first {
+		suggestions = suggestions[:first]
+	}
+
+	return suggestions, nil
+}
+
+const (
+	// viewedFilesFlushInterval is how often viewed files are sent to the
+	// typeahead index.
+	viewedFilesFlushInterval = 5 * time.Second
+
+	// maxPendingViewedFiles bounds the number of viewed files buffered between
+	// flushes. Files viewed while the buffer is full are dropped.
+	maxPendingViewedFiles = 10000
+)
+
+var viewedFiles = struct {
+	sync.Mutex
+	once    sync.Once
+	pending map[protocol.TypeaheadFile]struct{}
+}{
+	pending: map[protocol.TypeaheadFile]struct{}{},
+}
+
+// recordViewedFile buffers a viewed file, to be added to the typeahead index
+// by the next flush.
+func recordViewedFile(repoID api.RepoID, commitID api.CommitID, path string) {
+	viewedFiles.once.Do(func() {
+		goroutine.Go(func() {
+			for range time.Tick(viewedFilesFlushInterval) {
+				flushViewedFiles(context.Background())
+			}
+		})
+	})
+
+	viewedFiles.Lock()
+	defer viewedFiles.Unlock()
+
+	if len(viewedFiles.pending) < maxPendingViewedFiles {
+		viewedFiles.pending[protocol.TypeaheadFile{RepoID: repoID, CommitID: commitID, Path: path}] = struct{}{}
+	}
+}
+
+func flushViewedFiles(ctx context.Context) {
+	viewedFiles.Lock()
+	pending := viewedFiles.pending
+	viewedFiles.pending = map[protocol.TypeaheadFile]struct{}{}
+	viewedFiles.Unlock()
+
+	if len(pending) == 0 {
+		return
+	}
+
+	files := make([]protocol.TypeaheadFile, 0, len(pending))
+	for f := range pending {
+		files = append(files, f)
+	}
+	if err := repoupdater.DefaultClient.RecordTypeaheadFiles(ctx, files); err != nil {
+		log15.Warn("recording viewed files for typeahead", "error", err)
+	}
+}
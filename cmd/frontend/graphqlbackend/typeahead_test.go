@@ -0,0 +1,57 @@
+package graphqlbackend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtesting"
+	"github.com/sourcegraph/sourcegraph/internal/repoupdater"
+	"github.com/sourcegraph/sourcegraph/internal/repoupdater/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestTypeahead(t *testing.T) {
+	db := new(dbtesting.MockDB)
+
+	var gotRequest protocol.TypeaheadRequest
+	repoupdater.MockTypeahead = func(_ context.Context, args protocol.TypeaheadRequest) (*protocol.TypeaheadResult, error) {
+		gotRequest = args
+		return &protocol.TypeaheadResult{Suggestions: []protocol.TypeaheadSuggestion{
+			{Kind: "repo", RepoID: 1, RepoName: "github.com/foo/mux", Score: 7},
+			{Kind: "repo", RepoID: 2, RepoName: "github.com/private/mux", Score: 7},
+			{Kind: "file", RepoID: 1, RepoName: "github.com/foo/mux", CommitID: "deadbeef", Path: "mux.go", Score: 4},
+			{Kind: "file", RepoID: 1, RepoName: "github.com/foo/mux", CommitID: "deadbeef", Path: "mux_test.go", Score: 4},
+		}}, nil
+	}
+	defer func() { repoupdater.MockTypeahead = nil }()
+
+	// The user cannot access github.com/private/mux.
+	database.Mocks.Repos.ListRepoNames = func(_ context.Context, opt database.ReposListOptions) ([]types.RepoName, error) {
+		if diff := cmp.Diff([]api.RepoID{1, 2, 1, 1}, opt.IDs); diff != "" {
+			t.Errorf("unexpected repository IDs (-want +got):\n%s", diff)
+		}
+		return []types.RepoName{{ID: 1, Name: "github.com/foo/mux"}}, nil
+	}
+	defer func() { database.Mocks = database.MockStores{} }()
+
+	first := int32(2)
+	results, err := (&schemaResolver{db: db}).Typeahead(context.Background(), &typeaheadArgs{Query: "mux", First: &first})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := make([]string, 0, len(results))
+	for _, r := range results {
+		got = append(got, testStringResult(r))
+	}
+	if diff := cmp.Diff([]string{"repo:github.com/foo/mux", "file:mux.go"}, got); diff != "" {
+		t.Errorf("unexpected suggestions (-want +got):\n%s", diff)
+	}
+	if want := (protocol.TypeaheadRequest{Query: "mux", Limit: 6}); gotRequest != want {
+		t.Errorf("unexpected request. want=%+v have=%+v", want, gotRequest)
+	}
+}
@@ -472,7 +472,7 @@ func (r *UserResolver) PublicRepositories(ctx context.Context) ([]*RepositoryRes
 	if err := backend.CheckSiteAdminOrSameUser(ctx, r.db, r.user.ID); err != nil {
 		return nil, err
 	}
-	repos, err := database.UserPublicRepos(r.db).ListByUser(ctx, r.user.ID)
+	repos, err := database.UserPublicRepos(r.db).ListByUserWithMetadata(ctx, r.user.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -481,13 +481,21 @@ func (r *UserResolver) PublicRepositories(ctx context.Context) ([]*RepositoryRes
 		out = append(out, &RepositoryResolver{
 			RepoMatch: result.RepoMatch{
 				ID:   repo.RepoID,
-				Name: api.RepoName(repo.RepoURI),
+				Name: repo.RepoName,
 			},
 			db: r.db,
 			innerRepo: &types.Repo{
-				ID: repo.RepoID,
+				ID:   repo.RepoID,
+				Name: repo.RepoName,
 			},
 		})
 	}
 	return out, nil
 }
+
+func (r *UserResolver) PublicRepositoriesAutoRefresh(ctx context.Context) (bool, error) {
+	if err := backend.CheckSiteAdminOrSameUser(ctx, r.db, r.user.ID); err != nil {
+		return false, err
+	}
+	return database.UserPublicRepos(r.db).AutoRefreshEnabled(ctx, r.user.ID)
+}
@@ -28,6 +28,8 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbconn"
 	"github.com/sourcegraph/sourcegraph/internal/env"
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
@@ -219,6 +221,8 @@ func newCommon(w http.ResponseWriter, r *http.Request, title string, serveError
 				log15.Error("EnqueueRepoUpdate", "error", err)
 			}
 		}()
+		// Record the visit so that the repo is updated more often.
+		backend.RecordRepoDemand(dbconn.Global, database.RepoDemandNavigation, common.Repo.ID)
 	}
 	return common, nil
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/inconshreveable/log15"
 
+	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 )
 
@@ -38,3 +39,13 @@ func DeleteOldSecurityEventLogsInPostgres(ctx context.Context, db dbutil.DB) {
 		time.Sleep(time.Hour)
 	}
 }
+
+func DeleteOldAuditLogsInPostgres(ctx context.Context, db dbutil.DB) {
+	for {
+		err := database.AuditLogs(db).DeleteOlderThan(ctx, time.Now().Add(-database.AuditLogRetention))
+		if err != nil {
+			log15.Error("deleting expired rows from audit_logs table", "error", err)
+		}
+		time.Sleep(time.Hour)
+	}
+}
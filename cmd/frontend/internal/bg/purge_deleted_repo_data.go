@@ -0,0 +1,26 @@
+package bg
+
+import (
+	"context"
+	"time"
+
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+)
+
+// PurgeDeletedRepoData periodically deletes the data which depends on repos
+// that were deleted longer than the restore window ago.
+func PurgeDeletedRepoData(ctx context.Context, db dbutil.DB) {
+	for {
+		deletedBefore := time.Now().Add(-database.DeletedRepoRestoreWindow)
+		n, err := database.Repos(db).PurgeDeleted(ctx, deletedBefore)
+		if err != nil {
+			log15.Error("purging data of deleted repos", "error", err)
+		} else if n > 0 {
+			log15.Info("purged data of deleted repos", "rows", n)
+		}
+		time.Sleep(time.Hour)
+	}
+}
@@ -0,0 +1,274 @@
+package bg
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
+	"github.com/sourcegraph/sourcegraph/internal/repoupdater"
+	"github.com/sourcegraph/sourcegraph/internal/repoupdater/protocol"
+)
+
+const (
+	// maxUserPublicRepos must match the limit of the setUserPublicRepos mutation.
+	maxUserPublicRepos = 100
+
+	userPublicReposRefreshAge       = 24 * time.Hour
+	userPublicReposRefreshBatchSize = 100
+)
+
+var (
+	gitHubDotComURL, _ = url.Parse("https://github.com/")
+	gitLabDotComURL, _ = url.Parse("https://gitlab.com/")
+)
+
+// RefreshUserPublicRepos periodically refreshes the public repos of users on
+// Sourcegraph.com from the GitHub.com and GitLab.com accounts connected to
+// their Sourcegraph accounts, unless they opted out.
+func RefreshUserPublicRepos(ctx context.Context, db dbutil.DB) {
+	for {
+		refreshUserPublicReposBatch(ctx, db, time.Now())
+		time.Sleep(time.Hour)
+	}
+}
+
+func refreshUserPublicReposBatch(ctx context.Context, db dbutil.DB, now time.Time) {
+	store := database.UserPublicRepos(db)
+	userIDs, err := store.ListUsersToRefresh(ctx, now.Add(-userPublicReposRefreshAge), userPublicReposRefreshBatchSize)
+	if err != nil {
+		log15.Error("listing users to refresh public repos of", "error", err)
+		return
+	}
+	for _, userID := range userIDs {
+		var refreshErr string
+		if err := refreshUserPublicRepos(ctx, db, userID); err != nil {
+			log15.Warn("refreshing public repos of user", "user", userID, "error", err)
+			refreshErr = err.Error()
+		}
+		if err := store.MarkRefreshed(ctx, userID, now, refreshErr); err != nil {
+			log15.Error("marking public repos of user as refreshed", "user", userID, "error", err)
+		}
+	}
+}
+
+// codeHostPublicRepos are the public repos owned by a code host account.
+type codeHostPublicRepos struct {
+	// namespace is the prefix of the names of all repos owned by the account,
+	// such as "github.com/alice/".
+	namespace string
+	names     []api.RepoName
+}
+
+// refreshUserPublicRepos replaces the public repos of the user which are owned
+// by their code host accounts with the ones currently on the code hosts.
+func refreshUserPublicRepos(ctx context.Context, db dbutil.DB, userID int32) error {
+	accounts, err := database.ExternalAccounts(db).List(ctx, database.ExternalAccountsListOptions{UserID: userID})
+	if err != nil {
+		return errors.Wrap(err, "listing external accounts")
+	}
+
+	var owned []codeHostPublicRepos
+	for _, acct := range accounts {
+		var (
+			repos codeHostPublicRepos
+			err   error
+		)
+		switch {
+		case acct.ServiceType == extsvc.TypeGitHub && acct.ServiceID == gitHubDotComURL.String():
+			repos, err = listGitHubPublicRepos(ctx, &acct.AccountData)
+		case acct.ServiceType == extsvc.TypeGitLab && acct.ServiceID == gitLabDotComURL.String():
+			repos, err = listGitLabPublicRepos(ctx, &acct.AccountData)
+		default:
+			continue
+		}
+		if err != nil {
+			return errors.Wrapf(err, "listing public repos of %s account", acct.ServiceType)
+		}
+		owned = append(owned, repos)
+	}
+	if len(owned) == 0 {
+		return nil
+	}
+
+	store := database.UserPublicRepos(db)
+	current, err := store.ListByUserWithMetadata(ctx, userID)
+	if err != nil {
+		return errors.Wrap(err, "listing current public repos")
+	}
+	currentNames := make([]api.RepoName, 0, len(current))
+	byName := make(map[api.RepoName]database.UserPublicRepo, len(current))
+	for _, r := range current {
+		currentNames = append(currentNames, r.RepoName)
+		byName[r.RepoName] = r.UserPublicRepo
+	}
+
+	repoStore := database.Repos(db)
+	var repos []database.UserPublicRepo
+	for _, name := range reconcileUserPublicRepos(currentNames, owned, maxUserPublicRepos) {
+		if r, ok := byName[name]; ok {
+			repos = append(repos, r)
+			continue
+		}
+		repoID, err := lookupPublicRepo(ctx, repoStore, name)
+		if err != nil {
+			// The repo may have become private or been deleted since it
+			// was listed, so don't fail the whole refresh.
+			log15.Warn("looking up public repo of user", "user", userID, "repo", name, "error", err)
+			continue
+		}
+		repos = append(repos, database.UserPublicRepo{
+			UserID:  userID,
+			RepoURI: string(name),
+			RepoID:  repoID,
+		})
+	}
+	return store.SetUserRepos(ctx, userID, repos)
+}
+
+// reconcileUserPublicRepos returns the names of the public repos a user should
+// have, given their current repos and the repos owned by their code host
+// accounts. Current repos outside the namespaces of the accounts were added by
+// the user manually and are kept, current repos inside them which are no
+// longer on the code host are dropped, and new repos on the code host are
+// added until the list has max repos.
+func reconcileUserPublicRepos(current []api.RepoName, owned []codeHostPublicRepos, max int) []api.RepoName {
+	onCodeHost := make(map[api.RepoName]bool)
+	for _, repos := range owned {
+		for _, name := range repos.names {
+			onCodeHost[name] = true
+		}
+	}
+	inOwnedNamespace := func(name api.RepoName) bool {
+		for _, repos := range owned {
+			if strings.HasPrefix(strings.ToLower(string(name)), strings.ToLower(repos.namespace)) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var out []api.RepoName
+	seen := make(map[api.RepoName]bool)
+	for _, name := range current {
+		if inOwnedNamespace(name) && !onCodeHost[name] {
+			continue
+		}
+		out = append(out, name)
+		seen[name] = true
+	}
+
+	var added []api.RepoName
+	for name := range onCodeHost {
+		if !seen[name] {
+			added = append(added, name)
+		}
+	}
+	sort.Slice(added, func(i, j int) bool { return added[i] < added[j] })
+	for _, name := range added {
+		if len(out) >= max {
+			break
+		}
+		out = append(out, name)
+	}
+	return out
+}
+
+func listGitHubPublicRepos(ctx context.Context, data *extsvc.AccountData) (codeHostPublicRepos, error) {
+	usr, tok, err := github.GetExternalAccountData(data)
+	if err != nil {
+		return codeHostPublicRepos{}, err
+	}
+	if usr == nil || tok == nil || usr.GetLogin() == "" {
+		return codeHostPublicRepos{}, errors.New("account data is incomplete")
+	}
+
+	apiURL, _ := github.APIRoot(gitHubDotComURL)
+	client := github.NewV3Client(apiURL, &auth.OAuthBearerToken{Token: tok.AccessToken}, nil)
+
+	out := codeHostPublicRepos{namespace: "github.com/" + usr.GetLogin() + "/"}
+	for page := 1; len(out.names) < maxUserPublicRepos; page++ {
+		repos, hasNextPage, _, err := client.ListUserRepositories(ctx, usr.GetLogin(), page)
+		if err != nil {
+			return codeHostPublicRepos{}, err
+		}
+		for _, r := range repos {
+			if !r.IsPrivate {
+				out.names = append(out.names, api.RepoName("github.com/"+r.NameWithOwner))
+			}
+		}
+		if !hasNextPage {
+			break
+		}
+	}
+	return out, nil
+}
+
+func listGitLabPublicRepos(ctx context.Context, data *extsvc.AccountData) (codeHostPublicRepos, error) {
+	usr, tok, err := gitlab.GetExternalAccountData(data)
+	if err != nil {
+		return codeHostPublicRepos{}, err
+	}
+	if usr == nil || tok == nil || usr.Username == "" {
+		return codeHostPublicRepos{}, errors.New("account data is incomplete")
+	}
+
+	client := gitlab.NewClientProvider(gitLabDotComURL, nil).GetOAuthClient(tok.AccessToken)
+
+	out := codeHostPublicRepos{namespace: "gitlab.com/" + usr.Username + "/"}
+	nextPageURL := fmt.Sprintf("users/%d/projects?visibility=public&archived=no&per_page=100", usr.ID)
+	for len(out.names) < maxUserPublicRepos {
+		projects, next, err := client.ListProjects(ctx, nextPageURL)
+		if err != nil {
+			return codeHostPublicRepos{}, err
+		}
+		for _, p := range projects {
+			if p.Visibility == gitlab.Public {
+				out.names = append(out.names, api.RepoName("gitlab.com/"+p.PathWithNamespace))
+			}
+		}
+		if next == nil {
+			break
+		}
+		nextPageURL = *next
+	}
+	return out, nil
+}
+
+// lookupPublicRepo returns the ID of the repo with the given name, adding it
+// from its code host if it isn't in the database yet, and enqueues an update
+// for it.
+func lookupPublicRepo(ctx context.Context, repoStore *database.RepoStore, name api.RepoName) (api.RepoID, error) {
+	repo, err := repoStore.GetByName(ctx, name)
+	if err != nil && !database.IsRepoNotFoundErr(err) {
+		return 0, err
+	}
+	if repo == nil {
+		res, err := repoupdater.DefaultClient.RepoLookup(ctx, protocol.RepoLookupArgs{Repo: name})
+		if err != nil {
+			return 0, errors.Wrap(err, "looking up repo on code host")
+		}
+		if res.Repo == nil {
+			return 0, errors.Errorf("unable to find repo %s", name)
+		}
+		if repo, err = repoStore.GetByName(ctx, res.Repo.Name); err != nil {
+			return 0, err
+		}
+	}
+	if _, err := repoupdater.DefaultClient.EnqueueRepoUpdate(ctx, repo.Name); err != nil {
+		return 0, err
+	}
+	return repo.ID, nil
+}
@@ -0,0 +1,48 @@
+package bg
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+func TestReconcileUserPublicRepos(t *testing.T) {
+	owned := []codeHostPublicRepos{{
+		namespace: "github.com/alice/",
+		names:     []api.RepoName{"github.com/alice/b", "github.com/alice/a", "github.com/alice/c"},
+	}}
+
+	for _, tc := range []struct {
+		name    string
+		current []api.RepoName
+		max     int
+		want    []api.RepoName
+	}{
+		{
+			name: "adds repos from code host",
+			max:  10,
+			want: []api.RepoName{"github.com/alice/a", "github.com/alice/b", "github.com/alice/c"},
+		},
+		{
+			name:    "keeps manually added repos and drops removed ones",
+			current: []api.RepoName{"github.com/bob/x", "github.com/Alice/gone", "github.com/alice/c"},
+			max:     10,
+			want:    []api.RepoName{"github.com/bob/x", "github.com/alice/c", "github.com/alice/a", "github.com/alice/b"},
+		},
+		{
+			name:    "caps the number of repos",
+			current: []api.RepoName{"gitlab.com/bob/x"},
+			max:     2,
+			want:    []api.RepoName{"gitlab.com/bob/x", "github.com/alice/a"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			have := reconcileUserPublicRepos(tc.current, owned, tc.max)
+			if diff := cmp.Diff(tc.want, have); diff != "" {
+				t.Errorf("mismatch (-want +have):\n%s", diff)
+			}
+		})
+	}
+}
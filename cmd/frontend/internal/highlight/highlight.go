@@ -1,12 +1,15 @@
 package highlight
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"fmt"
 	"html/template"
+	"io"
 	"net/http"
 	"path"
+	"strconv"
 	"strings"
 	"time"
 	"unicode/utf8"
@@ -290,14 +293,16 @@ func CodeAsLines(ctx context.Context, p Params) ([]template.HTML, bool, error) {
 	if err != nil {
 		return nil, aborted, err
 	}
-	lines, err := splitHighlightedLines(html, false)
+	lines, err := splitHighlightedLines(html, false, 0)
 	return lines, aborted, err
 }
 
 // splitHighlightedLines takes the highlighted HTML table and returns a slice
 // of highlighted strings, where each string corresponds a single line in the
-// original, highlighted file.
-func splitHighlightedLines(input template.HTML, wholeRow bool) ([]template.HTML, error) {
+// original, highlighted file. If the table only holds a part of the file
+// starting at firstLine, the line numbers of whole rows are adjusted
+// accordingly.
+func splitHighlightedLines(input template.HTML, wholeRow bool, firstLine int32) ([]template.HTML, error) {
 	doc, err := html.Parse(strings.NewReader(string(input)))
 	if err != nil {
 		return nil, err
@@ -317,6 +322,9 @@ func splitHighlightedLines(input template.HTML, wholeRow bool) ([]template.HTML,
 		var render *html.Node
 		if wholeRow {
 			render = tr
+			if firstLine != 0 {
+				offsetLineNumber(tr, firstLine)
+			}
 		} else {
 			render = tr.LastChild.FirstChild // tr > td > div
 		}
@@ -332,6 +340,22 @@ func splitHighlightedLines(input template.HTML, wholeRow bool) ([]template.HTML,
 	return lines, nil
 }
 
+// offsetLineNumber adds offset to the line number of the table row tr.
+func offsetLineNumber(tr *html.Node, offset int32) {
+	td := tr.FirstChild
+	if td == nil {
+		return
+	}
+	for i, attr := range td.Attr {
+		if attr.Key != "data-line" {
+			continue
+		}
+		if line, err := strconv.Atoi(attr.Val); err == nil {
+			td.Attr[i].Val = strconv.Itoa(line + int(offset))
+		}
+	}
+}
+
 // normalizeFilepath ensures that the filepath p has a lowercase extension, i.e. it applies the
 // following transformations:
 //
@@ -368,7 +392,7 @@ type LineRange struct {
 //
 // Input line ranges will automatically be clamped within the bounds of the file.
 func SplitLineRanges(html template.HTML, ranges []LineRange) ([][]string, error) {
-	lines, err := splitHighlightedLines(html, true)
+	lines, err := splitHighlightedLines(html, true, 0)
 	if err != nil {
 		return nil, err
 	}
@@ -392,3 +416,93 @@ func SplitLineRanges(html template.HTML, ranges []LineRange) ([][]string, error)
 	}
 	return lineRanges, nil
 }
+
+// ReadLineRanges reads the specified line ranges from r, returning the content
+// of each line range. At most maxBytes bytes are returned for each line range.
+// Reading stops after the last line of the last line range, so the rest of r is
+// never read.
+func ReadLineRanges(r io.Reader, ranges []LineRange, maxBytes int) ([][]byte, error) {
+	chunks := make([][]byte, len(ranges))
+	var end int32
+	for _, lr := range ranges {
+		if lr.EndLine > end {
+			end = lr.EndLine
+		}
+	}
+
+	br := bufio.NewReader(r)
+	for line := int32(0); line < end; {
+		// ReadSlice returns long lines in several fragments, so that they never
+		// have to be held in memory as a whole.
+		frag, err := br.ReadSlice('\n')
+		for i, lr := range ranges {
+			if line < lr.StartLine || line >= lr.EndLine {
+				continue
+			}
+			n := maxBytes - len(chunks[i])
+			if n > len(frag) {
+				n = len(frag)
+			}
+			if n > 0 {
+				chunks[i] = append(chunks[i], frag[:n]...)
+			}
+		}
+
+		switch err {
+		case nil:
+			line++
+		case bufio.ErrBufferFull:
+		case io.EOF:
+			return chunks, nil
+		default:
+			return nil, err
+		}
+	}
+	return chunks, nil
+}
+
+// CodeLineRanges highlights the specified line ranges of a file, returning HTML
+// table rows `<tr>...</tr>` for each line range like SplitLineRanges. chunks
+// holds the content of each line range, as returned by ReadLineRanges, and the
+// content of p is ignored.
+//
+// Each line range is highlighted on its own, so syntax spanning its boundaries
+// may be highlighted incorrectly. This allows previewing lines of files which
+// are too large to be highlighted as a whole.
+func CodeLineRanges(ctx context.Context, p Params, ranges []LineRange, chunks [][]byte) ([][]string, bool, error) {
+	var (
+		lineRanges = make([][]string, 0, len(ranges))
+		aborted    bool
+	)
+	for i, r := range ranges {
+		if r.StartLine < 0 {
+			r.StartLine = 0
+		}
+		content := bytes.TrimSuffix(chunks[i], []byte("\n"))
+		if r.StartLine >= r.EndLine || len(content) == 0 {
+			lineRanges = append(lineRanges, []string{})
+			continue
+		}
+
+		p.Content = content
+		html, rangeAborted, err := Code(ctx, p)
+		if err != nil {
+			return nil, false, err
+		}
+		aborted = aborted || rangeAborted
+
+		lines, err := splitHighlightedLines(html, true, r.StartLine)
+		if err != nil {
+			return nil, false, err
+		}
+		if n := int(r.EndLine - r.StartLine); len(lines) > n {
+			lines = lines[:n]
+		}
+		tableRows := make([]string, 0, len(lines))
+		for _, line := range lines {
+			tableRows = append(tableRows, string(line))
+		}
+		lineRanges = append(lineRanges, tableRows)
+	}
+	return lineRanges, aborted, nil
+}
@@ -1,8 +1,10 @@
 package highlight
 
 import (
+	"bufio"
 	"context"
 	"html/template"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -68,7 +70,7 @@ func TestSplitHighlightedLines(t *testing.T) {
 		`<div><span style="color:#323232;">
 </span></div>`,
 		`<div></div>`}
-	have, err := splitHighlightedLines(template.HTML(input), false)
+	have, err := splitHighlightedLines(template.HTML(input), false, 0)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -273,3 +275,60 @@ func TestSplitLineRanges(t *testing.T) {
 		})
 	}
 }
+
+func TestReadLineRanges(t *testing.T) {
+	content := "line 0\nline 1\nline 2\n" + strings.Repeat("x", 5000) + "\nline 4\nline 5"
+	ranges := []LineRange{
+		{StartLine: 1, EndLine: 3},
+		{StartLine: 2, EndLine: 5},
+		{StartLine: 5, EndLine: 10},
+		{StartLine: 20, EndLine: 30},
+	}
+
+	// Use a reader smaller than the long line to exercise reading lines in
+	// fragments.
+	chunks, err := ReadLineRanges(bufio.NewReaderSize(strings.NewReader(content), 16), ranges, 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, chunk := range chunks {
+		got = append(got, string(chunk))
+	}
+	want := []string{
+		"line 1\nline 2\n",
+		"line 2\n" + strings.Repeat("x", 23),
+		"line 5",
+		"",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected chunks (-want +got):\n%s", diff)
+	}
+}
+
+func TestCodeLineRanges(t *testing.T) {
+	Mocks.Code = func(p Params) (template.HTML, bool, error) {
+		table, err := generatePlainTable(string(p.Content))
+		return table, false, err
+	}
+	t.Cleanup(ResetMocks)
+
+	ranges := []LineRange{{StartLine: 41, EndLine: 43}, {StartLine: 50, EndLine: 52}}
+	chunks := [][]byte{[]byte("a\nb\n"), nil}
+	got, aborted, err := CodeLineRanges(context.Background(), Params{Filepath: "a.txt"}, ranges, chunks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if aborted {
+		t.Fatal("highlighting aborted")
+	}
+
+	want := [][]string{{
+		`<tr><td class="line" data-line="42"></td><td class="code"><span>a</span></td></tr>`,
+		`<tr><td class="line" data-line="43"></td><td class="code"><span>b</span></td></tr>`,
+	}, {}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected line ranges (-want +got):\n%s", diff)
+	}
+}
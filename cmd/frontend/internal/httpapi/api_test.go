@@ -29,6 +29,10 @@ func newTest() *httptestutil.Client {
 		enterpriseServices.GitLabWebhook,
 		enterpriseServices.BitbucketServerWebhook,
 		enterpriseServices.NewCodeIntelUploadHandler,
+		enterpriseServices.CodeIntelRangesHandler,
+		enterpriseServices.SearchExportDownloadHandler,
+		enterpriseServices.SCIMHandler,
+		enterpriseServices.BadgesHandler,
 		rateLimiter,
 	))
 }
@@ -1,6 +1,8 @@
 package httpapi
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"net/http"
 
 	"github.com/inconshreveable/log15"
@@ -12,6 +14,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/quota"
 )
 
 // AccessTokenAuthMiddleware authenticates the user based on the
@@ -107,6 +110,15 @@ func AccessTokenAuthMiddleware(db dbutil.DB, next http.Handler) http.Handler {
 				log15.Debug("HTTP request used sudo token.", "requestURI", r.URL.RequestURI(), "tokenSubjectUserID", subjectUserID, "actorUserID", actorUserID, "actorUsername", user.Username)
 			}
 
+			// The API calls quota is counted per token, with the limit of the
+			// token's subject.
+			tokenHash := sha256.Sum256([]byte(token))
+			err = quota.Use(r.Context(), db, quota.APICallsPerToken, subjectUserID, hex.EncodeToString(tokenHash[:]), 1)
+			if e, ok := err.(*quota.ExceededError); ok {
+				quota.WriteExceeded(w, e)
+				return
+			}
+
 			r = r.WithContext(actor.WithActor(r.Context(), &actor.Actor{UID: actorUserID}))
 		}
 
@@ -0,0 +1,147 @@
+package httpapi
+
+import (
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/handlerutil"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
+)
+
+const (
+	// blameStreamFlushInterval is how long hunks are batched before they are
+	// sent to the client.
+	blameStreamFlushInterval = 100 * time.Millisecond
+
+	// maxIgnoreRevsFileSize is the size up to which ignore-revs files are
+	// read.
+	maxIgnoreRevsFileSize = 1024 * 1024
+)
+
+// blameHunk is the JSON representation of a hunk in the "hunks" events of the
+// blame stream.
+type blameHunk struct {
+	StartLine int          `json:"startLine"`
+	EndLine   int          `json:"endLine"`
+	CommitID  api.CommitID `json:"commitID"`
+	Author    blameAuthor  `json:"author"`
+	Message   string       `json:"message"`
+	Filename  string       `json:"filename"`
+
+	// Previous links to the revision of the file before the commit of the
+	// hunk, to blame the lines as they were before.
+	Previous *blamePrevious `json:"previous,omitempty"`
+}
+
+type blameAuthor struct {
+	Name  string    `json:"name"`
+	Email string    `json:"email"`
+	Date  time.Time `json:"date"`
+}
+
+type blamePrevious struct {
+	CommitID api.CommitID `json:"commitID"`
+	Filename string       `json:"filename"`
+}
+
+// serveBlameStream streams the blame of a file as server-sent events, so that
+// clients can render large files before git finishes. Batches of hunks are
+// sent in "hunks" events in no particular order, and the stream always ends
+// with a "done" event.
+//
+// The optional query parameters startLine and endLine restrict the blame to a
+// range of lines, and ignoreRevsFile names a file of the repository listing
+// commits to ignore, like .git-blame-ignore-revs.
+func serveBlameStream(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	repo, commitID, err := handlerutil.GetRepoAndRev(ctx, mux.Vars(r))
+	if err != nil {
+		return err
+	}
+	path := mux.Vars(r)["Path"]
+
+	// 🚨 SECURITY: Files hidden by sub-repository permissions must not be blamed
+	perms, err := authz.ActorPermissions(ctx, authz.DefaultSubRepoPermsChecker, authz.RepoContent{Repo: repo.Name, Path: path})
+	if err != nil {
+		return err
+	}
+	if !perms.Include(authz.Read) {
+		return &os.PathError{Op: "open", Path: path, Err: os.ErrNotExist}
+	}
+
+	opt := &git.BlameOptions{NewestCommit: commitID}
+	q := r.URL.Query()
+	for param, v := range map[string]*int{"startLine": &opt.StartLine, "endLine": &opt.EndLine} {
+		if s := q.Get(param); s != "" {
+			if *v, err = strconv.Atoi(s); err != nil {
+				http.Error(w, "invalid "+param, http.StatusBadRequest)
+				return nil
+			}
+		}
+	}
+	if ignoreRevsFile := q.Get("ignoreRevsFile"); ignoreRevsFile != "" {
+		data, err := git.ReadFile(ctx, repo.Name, commitID, ignoreRevsFile, maxIgnoreRevsFileSize)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		opt.IgnoreRevs = git.ParseIgnoreRevs(data)
+	}
+
+	eventWriter, err := streamhttp.NewWriter(w)
+	if err != nil {
+		return err
+	}
+
+	// Always send a final done event so clients know the stream is shutting
+	// down.
+	defer eventWriter.Event("done", map[string]interface{}{})
+
+	var (
+		batch     []blameHunk
+		lastFlush = time.Now()
+	)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		err := eventWriter.Event("hunks", batch)
+		batch, lastFlush = batch[:0], time.Now()
+		return err
+	}
+
+	err = git.StreamBlameFile(ctx, repo.Name, path, opt, func(h *git.Hunk) error {
+		hunk := blameHunk{
+			StartLine: h.StartLine,
+			EndLine:   h.EndLine,
+			CommitID:  h.CommitID,
+			Author:    blameAuthor{Name: h.Author.Name, Email: h.Author.Email, Date: h.Author.Date},
+			Message:   h.Message,
+			Filename:  h.Filename,
+		}
+		if h.PreviousCommit != "" {
+			hunk.Previous = &blamePrevious{CommitID: h.PreviousCommit, Filename: h.PreviousFilename}
+		}
+		batch = append(batch, hunk)
+
+		if time.Since(lastFlush) < blameStreamFlushInterval {
+			return nil
+		}
+		return flush()
+	})
+	if err == nil {
+		err = flush()
+	}
+	if err != nil {
+		// Headers are already written, so errors are reported in the stream.
+		_ = eventWriter.Event("error", streamhttp.EventError{Message: err.Error()})
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package httpapi
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
+)
+
+func TestBlameStream(t *testing.T) {
+	c := newTest()
+
+	backend.Mocks.Repos.GetByName = func(ctx context.Context, name api.RepoName) (*types.Repo, error) {
+		return &types.Repo{ID: 2, Name: name}, nil
+	}
+	backend.Mocks.Repos.ResolveRev = func(ctx context.Context, repo *types.Repo, rev string) (api.CommitID, error) {
+		if rev != "main" {
+			t.Errorf("wrong revision %q", rev)
+		}
+		return "fad406f4fe02c358a09df0d03ec7a36c2c8a20f1", nil
+	}
+	git.Mocks.ReadFile = func(commit api.CommitID, name string) ([]byte, error) {
+		if name != ".git-blame-ignore-revs" {
+			t.Errorf("wrong ignore-revs file %q", name)
+		}
+		return []byte("e6093374dcf5725d8517db0dccbbf69df65dbde0\n"), nil
+	}
+	git.Mocks.ExecReader = func(args []string) (io.ReadCloser, error) {
+		want := "blame -w --incremental --ignore-rev=e6093374dcf5725d8517db0dccbbf69df65dbde0 fad406f4fe02c358a09df0d03ec7a36c2c8a20f1 -- dir/f"
+		if have := strings.Join(args, " "); have != want {
+			t.Errorf("wrong git args:\nhave %s\nwant %s", have, want)
+		}
+		return io.NopCloser(strings.NewReader(`fad406f4fe02c358a09df0d03ec7a36c2c8a20f1 2 2 1
+author a
+author-mail <a@a.com>
+author-time 1136214245
+summary foo
+previous e6093374dcf5725d8517db0dccbbf69df65dbde0 dir/f
+filename dir/f
+`)), nil
+	}
+	defer func() {
+		backend.Mocks = backend.MockServices{}
+		git.ResetMocks()
+	}()
+
+	resp, err := c.Get("/repos/github.com/gorilla/mux@main/-/blame-stream/dir/f?ignoreRevsFile=.git-blame-ignore-revs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `event: hunks
+data: [{"startLine":2,"endLine":3,"commitID":"fad406f4fe02c358a09df0d03ec7a36c2c8a20f1","author":{"name":"a","email":"a@a.com","date":"2006-01-02T15:04:05Z"},"message":"foo","filename":"dir/f","previous":{"commitID":"e6093374dcf5725d8517db0dccbbf69df65dbde0","filename":"dir/f"}}]
+
+event: done
+data: {}
+
+`
+	if have := string(body); have != want {
+		t.Errorf("wrong stream:\nhave %s\nwant %s", have, want)
+	}
+}
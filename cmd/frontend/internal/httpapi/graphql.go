@@ -17,11 +17,13 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/search"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/internal/honey"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 )
 
-func serveGraphQL(schema *graphql.Schema, rlw graphqlbackend.LimitWatcher, isInternal bool) func(w http.ResponseWriter, r *http.Request) (err error) {
+func serveGraphQL(db dbutil.DB, schema *graphql.Schema, rlw graphqlbackend.LimitWatcher, isInternal bool) func(w http.ResponseWriter, r *http.Request) (err error) {
 	return func(w http.ResponseWriter, r *http.Request) (err error) {
 		if r.Method != "POST" {
 			// The URL router should not have routed to this handler if method is not POST, but just in
@@ -57,6 +59,13 @@ func serveGraphQL(schema *graphql.Schema, rlw graphqlbackend.LimitWatcher, isInt
 			return err
 		}
 
+		persist, err := resolvePersistedQuery(r.Context(), db, &params, isInternal)
+		if gqlErr, ok := err.(*graphQLError); ok {
+			return writeGraphQLError(w, gqlErr)
+		} else if err != nil {
+			return err
+		}
+
 		traceData := traceData{
 			queryParams:   params,
 			isInternal:    isInternal,
@@ -77,8 +86,14 @@ func serveGraphQL(schema *graphql.Schema, rlw graphqlbackend.LimitWatcher, isInt
 		var cost *graphqlbackend.QueryCost
 		var costErr error
 
-		// Don't attempt to estimate or rate limit a request that has failed validation
+		// Don't attempt to persist, estimate or rate limit a request that has failed validation
 		if len(validationErrs) == 0 {
+			if persist {
+				if _, err := database.PersistedQueries(db).Create(r.Context(), params.persistedQueryHash(), params.Query, false); err != nil {
+					log15.Error("persisting GraphQL query", "error", err)
+				}
+			}
+
 			cost, costErr = graphqlbackend.EstimateQueryCost(params.Query, params.Variables)
 			if costErr != nil {
 				// We send errors to Honeycomb, no need to spam logs
@@ -87,6 +102,16 @@ func serveGraphQL(schema *graphql.Schema, rlw graphqlbackend.LimitWatcher, isInt
 			traceData.costError = costErr
 			traceData.cost = cost
 
+			if !isInternal {
+				if err := graphqlbackend.CheckQueryCostLimit(cost, costErr); err != nil {
+					traceData.costLimited = true
+					return writeGraphQLError(w, &graphQLError{
+						Message:    err.Error(),
+						Extensions: map[string]string{"code": "QUERY_COST_LIMIT_EXCEEDED"},
+					})
+				}
+			}
+
 			if rl, enabled := rlw.Get(); enabled && cost != nil {
 				limited, result, err := rl.RateLimit(uid, cost.FieldCount, graphqlbackend.LimiterArgs{
 					IsIP:          isIP,
@@ -128,6 +153,28 @@ type graphQLQueryParams struct {
 	Query         string                 `json:"query"`
 	OperationName string                 `json:"operationName"`
 	Variables     map[string]interface{} `json:"variables"`
+	Extensions    *graphQLExtensions     `json:"extensions"`
+}
+
+// graphQLError is an error which rejects a GraphQL request before it runs. It
+// is returned to the client as a GraphQL error.
+type graphQLError struct {
+	Message    string            `json:"message"`
+	Extensions map[string]string `json:"extensions"`
+}
+
+func (e *graphQLError) Error() string { return e.Message }
+
+func writeGraphQLError(w http.ResponseWriter, err *graphQLError) error {
+	responseJSON, marshalErr := json.Marshal(map[string]interface{}{
+		"errors": []*graphQLError{err},
+	})
+	if marshalErr != nil {
+		return marshalErr
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(responseJSON)
+	return nil
 }
 
 type traceData struct {
@@ -140,8 +187,9 @@ type traceData struct {
 	requestSource string
 	queryErrors   []*gqlerrors.QueryError
 
-	cost      *graphqlbackend.QueryCost
-	costError error
+	cost        *graphqlbackend.QueryCost
+	costError   error
+	costLimited bool
 
 	limited     bool
 	limitError  error
@@ -182,6 +230,7 @@ func traceGraphQL(data traceData) {
 		ev.AddField("depth", data.cost.MaxDepth)
 		ev.AddField("costVersion", data.cost.Version)
 	}
+	ev.AddField("costLimited", data.costLimited)
 
 	ev.AddField("rateLimited", data.limited)
 	if data.limitError != nil {
@@ -214,6 +214,8 @@ func serveSearchConfiguration(w http.ResponseWriter, r *http.Request) error {
 			RepoID:     int32(repo.ID),
 			Public:     !repo.Private,
 			Priority:   priority,
+			Fork:       repo.Fork,
+			Archived:   repo.Archived,
 			GetVersion: getVersion,
 		}, nil
 	}
@@ -413,6 +415,24 @@ func serveSavedQueriesDeleteInfo(db dbutil.DB) func(w http.ResponseWriter, r *ht
 	}
 }
 
+func serveSavedQueriesReplaceMatches(db dbutil.DB) func(w http.ResponseWriter, r *http.Request) error {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		var matches api.SavedQueryMatches
+		err := json.NewDecoder(r.Body).Decode(&matches)
+		if err != nil {
+			return errors.Wrap(err, "Decode")
+		}
+		newMatches, err := database.QueryRunnerState(db).ReplaceMatches(r.Context(), matches.Query, matches.Matches)
+		if err != nil {
+			return errors.Wrap(err, "SavedQueries.ReplaceMatches")
+		}
+		if err := json.NewEncoder(w).Encode(newMatches); err != nil {
+			return errors.Wrap(err, "Encode")
+		}
+		return nil
+	}
+}
+
 func serveSettingsGetForSubject(db dbutil.DB) func(w http.ResponseWriter, r *http.Request) error {
 	return func(w http.ResponseWriter, r *http.Request) error {
 		var subject api.SettingsSubject
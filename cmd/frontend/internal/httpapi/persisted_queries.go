@@ -0,0 +1,123 @@
+package httpapi
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+)
+
+// graphQLExtensions are the extensions of a GraphQL request. The persisted
+// query extension follows Apollo's automatic persisted queries protocol.
+type graphQLExtensions struct {
+	PersistedQuery *struct {
+		Version    int    `json:"version"`
+		Sha256Hash string `json:"sha256Hash"`
+	} `json:"persistedQuery"`
+}
+
+// persistedQueryHash returns the hash sent with the persisted query extension,
+// if any.
+func (p *graphQLQueryParams) persistedQueryHash() string {
+	if p.Extensions == nil || p.Extensions.PersistedQuery == nil {
+		return ""
+	}
+	return p.Extensions.PersistedQuery.Sha256Hash
+}
+
+// The persisted query errors have the same message and code as those of Apollo
+// servers, so that Apollo clients send the full query when its hash is not
+// known.
+var (
+	errPersistedQueryNotFound = &graphQLError{
+		Message:    "PersistedQueryNotFound",
+		Extensions: map[string]string{"code": "PERSISTED_QUERY_NOT_FOUND"},
+	}
+	errPersistedQueryHashMismatch = &graphQLError{
+		Message:    "provided sha does not match query",
+		Extensions: map[string]string{"code": "PERSISTED_QUERY_HASH_MISMATCH"},
+	}
+	errPersistedQueryNotAllowed = &graphQLError{
+		Message:    "query is not on the allow-list of persisted queries",
+		Extensions: map[string]string{"code": "PERSISTED_QUERY_NOT_ALLOWED"},
+	}
+)
+
+// resolvePersistedQuery handles the persisted query extension of a GraphQL
+// request and enforces the allow-list of persisted queries. If only the hash of
+// a query is sent, the query of params is set to the persisted query. It
+// returns true if the query of params should be persisted once it passes
+// validation, and a *graphQLError if the request must not run.
+func resolvePersistedQuery(ctx context.Context, db dbutil.DB, params *graphQLQueryParams, isInternal bool) (persist bool, err error) {
+	hash := params.persistedQueryHash()
+	if hash == "" && params.Query == "" {
+		// Nothing to resolve, the request fails validation.
+		return false, nil
+	}
+
+	if hash != "" && params.Query != "" && graphqlbackend.PersistedQueryHash(params.Query) != hash {
+		return false, errPersistedQueryHashMismatch
+	}
+
+	enforce, err := mustBeAllowListed(ctx, db, isInternal)
+	if err != nil {
+		return false, err
+	}
+
+	if params.Query != "" && !enforce {
+		// Clients register queries by sending them together with their hash.
+		return hash != "" && canRegister(ctx, params.Query, isInternal), nil
+	}
+
+	if hash == "" {
+		hash = graphqlbackend.PersistedQueryHash(params.Query)
+	}
+	q, ok, err := database.PersistedQueries(db).GetByHash(ctx, hash)
+	if err != nil {
+		return false, err
+	}
+	if enforce && (!ok || !q.Allowed) {
+		return false, errPersistedQueryNotAllowed
+	}
+	if !ok {
+		return false, errPersistedQueryNotFound
+	}
+	params.Query = q.Query
+	return false, nil
+}
+
+// canRegister returns true if the query may be persisted for the request.
+//
+// 🚨 SECURITY: Anonymous requests can't register queries, and queries are
+// limited in size, so that the table of persisted queries can't be filled by
+// anyone who can reach the API. Anonymous clients can still run the queries
+// registered by others by their hash.
+func canRegister(ctx context.Context, query string, isInternal bool) bool {
+	if len(query) > database.MaxPersistedQuerySize {
+		return false
+	}
+	return isInternal || actor.FromContext(ctx).IsAuthenticated()
+}
+
+// mustBeAllowListed returns true if the allow-list of persisted queries is
+// enforced for the request. Internal requests and site admins are exempt.
+func mustBeAllowListed(ctx context.Context, db dbutil.DB, isInternal bool) (bool, error) {
+	if c := conf.Get().ApiPersistedQueries; c == nil || !c.EnforceAllowList || isInternal {
+		return false, nil
+	}
+	if !actor.FromContext(ctx).IsAuthenticated() {
+		return true, nil
+	}
+	switch err := backend.CheckCurrentUserIsSiteAdmin(ctx, db); err {
+	case nil:
+		return false, nil
+	case backend.ErrMustBeSiteAdmin, backend.ErrNotAuthenticated:
+		return true, nil
+	default:
+		return false, err
+	}
+}
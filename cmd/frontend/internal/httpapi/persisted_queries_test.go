@@ -0,0 +1,101 @@
+package httpapi
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestResolvePersistedQuery(t *testing.T) {
+	const (
+		allowedQuery = "query Allowed { currentUser { username } }"
+		otherQuery   = "query Other { currentUser { id } }"
+		unknownQuery = "query Unknown { site { id } }"
+	)
+	persisted := map[string]*database.PersistedQuery{
+		graphqlbackend.PersistedQueryHash(allowedQuery): {Query: allowedQuery, Allowed: true},
+		graphqlbackend.PersistedQueryHash(otherQuery):   {Query: otherQuery},
+	}
+	database.Mocks.PersistedQueries.GetByHash = func(ctx context.Context, hash string) (*database.PersistedQuery, bool, error) {
+		q, ok := persisted[hash]
+		return q, ok, nil
+	}
+	database.Mocks.Users.GetByCurrentAuthUser = func(ctx context.Context) (*types.User, error) {
+		uid := actor.FromContext(ctx).UID
+		return &types.User{ID: uid, SiteAdmin: uid == 1}, nil
+	}
+	t.Cleanup(func() { database.Mocks = database.MockStores{} })
+
+	params := func(query, hash string) *graphQLQueryParams {
+		p := &graphQLQueryParams{Query: query, Extensions: &graphQLExtensions{}}
+		if hash != "" {
+			p.Extensions.PersistedQuery = &struct {
+				Version    int    `json:"version"`
+				Sha256Hash string `json:"sha256Hash"`
+			}{Version: 1, Sha256Hash: hash}
+		}
+		return p
+	}
+	hash := graphqlbackend.PersistedQueryHash
+	largeQuery := "query Large { site { id } }" + strings.Repeat(" ", database.MaxPersistedQuerySize)
+
+	for _, test := range []struct {
+		name        string
+		enforce     bool
+		internal    bool
+		userID      int32
+		params      *graphQLQueryParams
+		wantQuery   string
+		wantPersist bool
+		wantErr     error
+	}{
+		{name: "query", params: params(unknownQuery, ""), wantQuery: unknownQuery},
+		{name: "hash", params: params("", hash(otherQuery)), wantQuery: otherQuery},
+		{name: "unknown hash", params: params("", hash(unknownQuery)), wantErr: errPersistedQueryNotFound},
+		{name: "register", userID: 2, params: params(unknownQuery, hash(unknownQuery)), wantQuery: unknownQuery, wantPersist: true},
+		{name: "register internal", internal: true, params: params(unknownQuery, hash(unknownQuery)), wantQuery: unknownQuery, wantPersist: true},
+		{name: "register anonymous", params: params(unknownQuery, hash(unknownQuery)), wantQuery: unknownQuery},
+		{name: "register too large", userID: 2, params: params(largeQuery, hash(largeQuery)), wantQuery: largeQuery},
+		{name: "hash mismatch", params: params(unknownQuery, hash(otherQuery)), wantErr: errPersistedQueryHashMismatch},
+
+		{name: "allow-list hash", enforce: true, params: params("", hash(allowedQuery)), wantQuery: allowedQuery},
+		{name: "allow-list query", enforce: true, params: params(allowedQuery, ""), wantQuery: allowedQuery},
+		{name: "allow-list not allowed", enforce: true, params: params("", hash(otherQuery)), wantErr: errPersistedQueryNotAllowed},
+		{name: "allow-list no registration", enforce: true, userID: 2, params: params(unknownQuery, hash(unknownQuery)), wantErr: errPersistedQueryNotAllowed},
+		{name: "allow-list site admin", enforce: true, userID: 1, params: params(unknownQuery, ""), wantQuery: unknownQuery},
+		{name: "allow-list internal", enforce: true, internal: true, params: params(unknownQuery, ""), wantQuery: unknownQuery},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+				ApiPersistedQueries: &schema.ApiPersistedQueries{EnforceAllowList: test.enforce},
+			}})
+			defer conf.Mock(nil)
+
+			ctx := context.Background()
+			if test.userID != 0 {
+				ctx = actor.WithActor(ctx, &actor.Actor{UID: test.userID})
+			}
+
+			persist, err := resolvePersistedQuery(ctx, nil, test.params, test.internal)
+			if err != test.wantErr {
+				t.Fatalf("unexpected error. want=%v have=%v", test.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if test.params.Query != test.wantQuery {
+				t.Errorf("unexpected query. want=%q have=%q", test.wantQuery, test.params.Query)
+			}
+			if persist != test.wantPersist {
+				t.Errorf("unexpected persist. want=%v have=%v", test.wantPersist, persist)
+			}
+		})
+	}
+}
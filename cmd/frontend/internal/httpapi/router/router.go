@@ -9,52 +9,62 @@ import (
 
 const (
 	LSIFUpload = "lsif.upload"
+	LSIFRanges = "lsif.ranges"
 	GraphQL    = "graphql"
 
 	SearchStream = "search.stream"
 
+	SearchExportDownload = "search-exports.download"
+
+	SCIM = "scim"
+
+	Badges = "badges"
+
 	SrcCliVersion  = "src-cli.version"
 	SrcCliDownload = "src-cli.download"
 
 	Registry = "registry"
 
-	RepoShield  = "repo.shield"
-	RepoRefresh = "repo.refresh"
-	Telemetry   = "telemetry"
+	RepoShield      = "repo.shield"
+	RepoRefresh     = "repo.refresh"
+	RepoBlameStream = "repo.blame-stream"
+	Telemetry       = "telemetry"
 
 	GitHubWebhooks          = "github.webhooks"
 	GitLabWebhooks          = "gitlab.webhooks"
 	BitbucketServerWebhooks = "bitbucketServer.webhooks"
-
-	SavedQueriesListAll    = "internal.saved-queries.list-all"
-	SavedQueriesGetInfo    = "internal.saved-queries.get-info"
-	SavedQueriesSetInfo    = "internal.saved-queries.set-info"
-	SavedQueriesDeleteInfo = "internal.saved-queries.delete-info"
-	SettingsGetForSubject  = "internal.settings.get-for-subject"
-	OrgsListUsers          = "internal.orgs.list-users"
-	OrgsGetByName          = "internal.orgs.get-by-name"
-	UsersGetByUsername     = "internal.users.get-by-username"
-	UserEmailsGetEmail     = "internal.user-emails.get-email"
-	ExternalURL            = "internal.app-url"
-	CanSendEmail           = "internal.can-send-email"
-	SendEmail              = "internal.send-email"
-	Extension              = "internal.extension"
-	GitExec                = "internal.git.exec"
-	GitInfoRefs            = "internal.git.info-refs"
-	GitResolveRevision     = "internal.git.resolve-revision"
-	GitTar                 = "internal.git.tar"
-	GitUploadPack          = "internal.git.upload-pack"
-	PhabricatorRepoCreate  = "internal.phabricator.repo.create"
-	ReposGetByName         = "internal.repos.get-by-name"
-	ReposInventoryUncached = "internal.repos.inventory-uncached"
-	ReposInventory         = "internal.repos.inventory"
-	ReposList              = "internal.repos.list"
-	ReposIndex             = "internal.repos.index"
-	ReposListEnabled       = "internal.repos.list-enabled"
-	Configuration          = "internal.configuration"
-	SearchConfiguration    = "internal.search-configuration"
-	ExternalServiceConfigs = "internal.external-services.configs"
-	ExternalServicesList   = "internal.external-services.list"
+	BitbucketCloudWebhooks  = "bitbucketCloud.webhooks"
+
+	SavedQueriesListAll        = "internal.saved-queries.list-all"
+	SavedQueriesGetInfo        = "internal.saved-queries.get-info"
+	SavedQueriesSetInfo        = "internal.saved-queries.set-info"
+	SavedQueriesDeleteInfo     = "internal.saved-queries.delete-info"
+	SavedQueriesReplaceMatches = "internal.saved-queries.replace-matches"
+	SettingsGetForSubject      = "internal.settings.get-for-subject"
+	OrgsListUsers              = "internal.orgs.list-users"
+	OrgsGetByName              = "internal.orgs.get-by-name"
+	UsersGetByUsername         = "internal.users.get-by-username"
+	UserEmailsGetEmail         = "internal.user-emails.get-email"
+	ExternalURL                = "internal.app-url"
+	CanSendEmail               = "internal.can-send-email"
+	SendEmail                  = "internal.send-email"
+	Extension                  = "internal.extension"
+	GitExec                    = "internal.git.exec"
+	GitInfoRefs                = "internal.git.info-refs"
+	GitResolveRevision         = "internal.git.resolve-revision"
+	GitTar                     = "internal.git.tar"
+	GitUploadPack              = "internal.git.upload-pack"
+	PhabricatorRepoCreate      = "internal.phabricator.repo.create"
+	ReposGetByName             = "internal.repos.get-by-name"
+	ReposInventoryUncached     = "internal.repos.inventory-uncached"
+	ReposInventory             = "internal.repos.inventory"
+	ReposList                  = "internal.repos.list"
+	ReposIndex                 = "internal.repos.index"
+	ReposListEnabled           = "internal.repos.list-enabled"
+	Configuration              = "internal.configuration"
+	SearchConfiguration        = "internal.search-configuration"
+	ExternalServiceConfigs     = "internal.external-services.configs"
+	ExternalServicesList       = "internal.external-services.list"
 )
 
 // New creates a new API router with route URL pattern definitions but
@@ -71,8 +81,13 @@ func New(base *mux.Router) *mux.Router {
 	base.Path("/github-webhooks").Methods("POST").Name(GitHubWebhooks)
 	base.Path("/gitlab-webhooks").Methods("POST").Name(GitLabWebhooks)
 	base.Path("/bitbucket-server-webhooks").Methods("POST").Name(BitbucketServerWebhooks)
+	base.Path("/bitbucket-cloud-webhooks").Methods("POST").Name(BitbucketCloudWebhooks)
 	base.Path("/lsif/upload").Methods("POST").Name(LSIFUpload)
+	base.Path("/lsif/ranges").Methods("GET").Name(LSIFRanges)
 	base.Path("/search/stream").Methods("GET").Name(SearchStream)
+	base.Path("/search-exports/{id}/download").Methods("GET").Name(SearchExportDownload)
+	base.PathPrefix("/scim/v2/").Name(SCIM)
+	base.PathPrefix("/badges/").Methods("GET").Name(Badges)
 	base.Path("/src-cli/version").Methods("GET").Name(SrcCliVersion)
 	base.Path("/src-cli/{rest:.*}").Methods("GET").Name(SrcCliDownload)
 
@@ -85,6 +100,10 @@ func New(base *mux.Router) *mux.Router {
 	repo.Path("/shield").Methods("GET").Name(RepoShield)
 	repo.Path("/refresh").Methods("POST").Name(RepoRefresh)
 
+	// repoRev contains routes that are specific to a revision.
+	repoRev := base.PathPrefix(repoPath + routevar.RepoRevSuffix + "/" + routevar.RepoPathDelim + "/").Subrouter()
+	repoRev.Path("/blame-stream/{Path:.*}").Methods("GET").Name(RepoBlameStream)
+
 	return base
 }
 
@@ -100,6 +119,7 @@ func NewInternal(base *mux.Router) *mux.Router {
 	base.Path("/saved-queries/get-info").Methods("POST").Name(SavedQueriesGetInfo)
 	base.Path("/saved-queries/set-info").Methods("POST").Name(SavedQueriesSetInfo)
 	base.Path("/saved-queries/delete-info").Methods("POST").Name(SavedQueriesDeleteInfo)
+	base.Path("/saved-queries/replace-matches").Methods("POST").Name(SavedQueriesReplaceMatches)
 	base.Path("/settings/get-for-subject").Methods("POST").Name(SettingsGetForSubject)
 	base.Path("/orgs/list-users").Methods("POST").Name(OrgsListUsers)
 	base.Path("/orgs/get-by-name").Methods("POST").Name(OrgsGetByName)
@@ -0,0 +1,84 @@
+package webhookhandlers
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/globals"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+	"github.com/sourcegraph/sourcegraph/internal/repoupdater"
+	"github.com/sourcegraph/sourcegraph/internal/repoupdater/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// handleBitbucketCloudRepoAuthzEvent handles any Bitbucket Cloud repository event, and enqueues
+// the contained repo for permissions synchronisation.
+func handleBitbucketCloudRepoAuthzEvent(ctx context.Context, extSvc *types.ExternalService, payload interface{}) error {
+	if !conf.ExperimentalFeatures().EnablePermissionsWebhooks {
+		return nil
+	}
+	if globals.PermissionsUserMapping().Enabled {
+		return nil
+	}
+
+	log15.Debug("handleBitbucketCloudRepoAuthzEvent: Got bitbucket cloud event", "type", fmt.Sprintf("%T", payload))
+
+	e, ok := payload.(*bitbucketcloud.RepoEvent)
+	if !ok {
+		return fmt.Errorf("incorrect event type sent to bitbucket cloud event handler: %T", payload)
+	}
+	if e.Repository == nil {
+		return nil
+	}
+
+	cfg, err := extSvc.Configuration()
+	if err != nil {
+		return err
+	}
+	conn, ok := cfg.(*schema.BitbucketCloudConnection)
+	if !ok {
+		return fmt.Errorf("bitbucket cloud event received for %s external service %d", extSvc.Kind, extSvc.ID)
+	}
+	baseURL, err := url.Parse(conn.Url)
+	if err != nil {
+		return err
+	}
+
+	// 🚨 SECURITY: we want to be able to find any private repo here, so set internal actor
+	ctx = actor.WithInternalActor(ctx)
+	rs, err := database.GlobalRepos.List(ctx, database.ReposListOptions{
+		ExternalRepos: []api.ExternalRepoSpec{{
+			ID:          e.Repository.UUID,
+			ServiceType: extsvc.TypeBitbucketCloud,
+			ServiceID:   extsvc.NormalizeBaseURL(baseURL).String(),
+		}},
+	})
+	if err != nil {
+		return err
+	}
+	if len(rs) == 0 {
+		// this repo is not synced to sourcegraph
+		return nil
+	}
+
+	ids := make([]api.RepoID, 0, len(rs))
+	for _, r := range rs {
+		ids = append(ids, r.ID)
+	}
+
+	log15.Debug("handleBitbucketCloudRepoAuthzEvent: Dispatching permissions update", "repos", e.Repository.FullName)
+
+	c := repoupdater.DefaultClient
+	return c.SchedulePermsSync(ctx, protocol.PermsSyncRequest{
+		RepoIDs: ids,
+	})
+}
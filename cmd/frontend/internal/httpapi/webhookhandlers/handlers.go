@@ -1,6 +1,9 @@
 package webhookhandlers
 
-import "github.com/sourcegraph/sourcegraph/cmd/frontend/webhooks"
+import (
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/webhooks"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+)
 
 func Init(w *webhooks.GitHubWebhook) {
 	w.Register(handleGitHubRepoAuthzEvent, "public")
@@ -12,3 +15,8 @@ func Init(w *webhooks.GitHubWebhook) {
 	w.Register(handleGitHubUserAuthzEvent, "member") // member has both users and repos
 	w.Register(handleGitHubUserAuthzEvent, "membership")
 }
+
+func InitBitbucketCloud(w *webhooks.BitbucketCloudWebhook) {
+	w.Register(handleBitbucketCloudRepoAuthzEvent, bitbucketcloud.EventRepoUpdated)
+	w.Register(handleBitbucketCloudRepoAuthzEvent, bitbucketcloud.EventRepoTransfer) // the new owner's workspace grants access
+}
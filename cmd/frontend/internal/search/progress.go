@@ -39,22 +39,39 @@ func (p *progressAggregator) Update(event streaming.SearchEvent) {
 	}
 }
 
+// skippedRepoStatus is the union of the statuses of repositories that could
+// not be searched.
+const skippedRepoStatus = searchshared.RepoStatusCloning |
+	searchshared.RepoStatusMissing |
+	searchshared.RepoStatusTimedout |
+	searchshared.RepoStatusRepoLimit
+
 func (p *progressAggregator) currentStats() api.ProgressStats {
 	// Suggest the next 1000 after rounding off.
 	suggestedLimit := (p.Limit + 1500) / 1000 * 1000
 
+	var totalRepositories *int
+	if p.Stats.Repos != nil {
+		totalRepositories = intPtr(len(p.Stats.Repos))
+	}
+	searched, skipped := repositoryCounts(p.Stats)
+
 	return api.ProgressStats{
-		MatchCount:          p.MatchCount,
-		ElapsedMilliseconds: int(time.Since(p.Start).Milliseconds()),
-		ExcludedArchived:    p.Stats.ExcludedArchived,
-		ExcludedForks:       p.Stats.ExcludedForks,
-		Timedout:            getNames(p.Stats, searchshared.RepoStatusTimedout),
-		Missing:             getNames(p.Stats, searchshared.RepoStatusMissing),
-		Cloning:             getNames(p.Stats, searchshared.RepoStatusCloning),
-		LimitHit:            p.Stats.IsLimitHit,
-		SuggestedLimit:      suggestedLimit,
-		Trace:               p.Trace,
-		DisplayLimit:        p.DisplayLimit,
+		MatchCount:           p.MatchCount,
+		ElapsedMilliseconds:  int(time.Since(p.Start).Milliseconds()),
+		ExcludedArchived:     p.Stats.ExcludedArchived,
+		ExcludedForks:        p.Stats.ExcludedForks,
+		TotalRepositories:    totalRepositories,
+		SearchedRepositories: searched,
+		SkippedRepositories:  skipped,
+		Timedout:             getNames(p.Stats, searchshared.RepoStatusTimedout),
+		Missing:              getNames(p.Stats, searchshared.RepoStatusMissing),
+		Cloning:              getNames(p.Stats, searchshared.RepoStatusCloning),
+		RepositoryLimit:      getNames(p.Stats, searchshared.RepoStatusRepoLimit),
+		LimitHit:             p.Stats.IsLimitHit,
+		SuggestedLimit:       suggestedLimit,
+		Trace:                p.Trace,
+		DisplayLimit:         p.DisplayLimit,
 	}
 }
 
@@ -75,12 +92,67 @@ func (p *progressAggregator) Final() api.Progress {
 	// We only send RepositoriesCount at the end because the number is
 	// confusing to users to see while searching.
 	s.RepositoriesCount = intPtr(len(p.Stats.Repos))
+	s.TotalRepositories = s.RepositoriesCount
 
 	event := api.BuildProgressEvent(s)
 	event.Done = true
+	event.SkippedRepositories = skippedRepositories(p.Stats)
+
+	// Not every search reports which repositories it has searched (e.g.
+	// repository name matches), so the final event is always complete.
+	event.Repositories.PercentComplete = 100
+	event.Repositories.RemainingMs = nil
+
 	return event
 }
 
+// repositoryCounts returns the number of resolved repositories that have been
+// searched and the number that could not be searched.
+func repositoryCounts(stats streaming.Stats) (searched, skipped int) {
+	stats.Status.Iterate(func(id sgapi.RepoID, status searchshared.RepoStatus) {
+		if _, ok := stats.Repos[id]; !ok {
+			return
+		}
+
+		if status&skippedRepoStatus != 0 {
+			skipped++
+		} else if status&searchshared.RepoStatusSearched != 0 {
+			searched++
+		}
+	})
+	return searched, skipped
+}
+
+// skippedReasons maps the status of a skipped repository to the reason
+// reported to clients.
+var skippedReasons = map[searchshared.RepoStatus]api.SkippedReason{
+	searchshared.RepoStatusCloning:   api.RepositoryCloning,
+	searchshared.RepoStatusMissing:   api.RepositoryMissing,
+	searchshared.RepoStatusTimedout:  api.ShardTimeout,
+	searchshared.RepoStatusRepoLimit: api.RepositoryLimit,
+}
+
+// skippedRepositories returns a description of each repository that could
+// not be searched, including a query to search it on retry where possible.
+func skippedRepositories(stats streaming.Stats) []api.SkippedRepository {
+	var repos []api.SkippedRepository
+	for _, skipped := range stats.Skipped() {
+		repo := api.SkippedRepository{
+			Name:    string(skipped.Repo.Name),
+			Reason:  skippedReasons[skipped.Reason],
+			Message: skipped.Message,
+		}
+		if skipped.SuggestedQuery != "" {
+			repo.Suggested = &api.SkippedSuggested{
+				Title:           "search this repository",
+				QueryExpression: skipped.SuggestedQuery,
+			}
+		}
+		repos = append(repos, repo)
+	}
+	return repos
+}
+
 type namerFunc string
 
 func (n namerFunc) Name() string {
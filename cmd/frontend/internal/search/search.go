@@ -15,6 +15,7 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/inconshreveable/log15"
 	"github.com/opentracing/opentracing-go"
@@ -22,11 +23,14 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
 	searchlogs "github.com/sourcegraph/sourcegraph/cmd/frontend/internal/search/logs"
+	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/internal/honey"
 	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
+	"github.com/sourcegraph/sourcegraph/internal/quota"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/internal/search/run"
 	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
@@ -34,14 +38,15 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 )
 
-// StreamHandler is an http handler which streams back search results.
+// StreamHandler is an http handler which streams back search results. Each
+// request uses the searches quota of the user.
 func StreamHandler(db dbutil.DB) http.Handler {
-	return &streamHandler{
+	return quota.Middleware(db, quota.Searches, nil, &streamHandler{
 		db:                  db,
 		newSearchResolver:   defaultNewSearchResolver,
 		flushTickerInternal: 100 * time.Millisecond,
 		pingTickerInterval:  5 * time.Second,
-	}
+	})
 }
 
 type streamHandler struct {
@@ -159,6 +164,17 @@ func (h *streamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	first := true
 
+	// Time spent collecting search events and marshaling their matches,
+	// logged to the trace once the search is done.
+	var (
+		eventCount      int
+		marshalDuration time.Duration
+	)
+
+	// Matches sent to the client, whose repos are recorded as in demand once
+	// the search is done.
+	var displayed []result.Match
+
 	for {
 		var event streaming.SearchEvent
 		var ok bool
@@ -178,7 +194,9 @@ func (h *streamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 		progress.Update(event)
 		filters.Update(event)
+		eventCount++
 
+		marshalStart := time.Now()
 		for _, match := range event.Results {
 			if display <= 0 {
 				break
@@ -186,12 +204,15 @@ func (h *streamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 			display = match.Limit(display)
 			matchesAppend(fromMatch(match))
+			displayed = append(displayed, match)
 		}
+		marshalDuration += time.Since(marshalStart)
 
 		// Instantly send results if we have not sent any yet.
 		if first && matchesBuf.Len() > 0 {
 			first = false
 			matchesFlush()
+			tr.LogFields(otlog.Int64("first_result_ms", time.Since(start).Milliseconds()))
 
 			metricLatency.WithLabelValues(string(GuessSource(r))).
 				Observe(time.Since(start).Seconds())
@@ -201,6 +222,12 @@ func (h *streamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	matchesFlush()
+	backend.RecordRepoDemand(h.db, database.RepoDemandSearch, graphqlbackend.MatchedRepoIDs(displayed)...)
+	tr.LogFields(
+		otlog.Int("events", eventCount),
+		otlog.Int("matches", progress.MatchCount),
+		otlog.Int64("marshal_ms", marshalDuration.Milliseconds()),
+	)
 
 	// Send dynamic filters once.
 	if filters := filters.Compute(); len(filters) > 0 {
@@ -385,6 +412,8 @@ func fromMatch(match result.Match) streamhttp.EventMatch {
 		return fromRepository(v)
 	case *result.CommitMatch:
 		return fromCommit(v)
+	case *result.CountMatch:
+		return fromCount(v)
 	default:
 		panic(fmt.Sprintf("unknown match type %T", v))
 	}
@@ -394,14 +423,31 @@ func fromFileMatch(fm *result.FileMatch) streamhttp.EventMatch {
 	if syms := fm.Symbols; len(syms) > 0 {
 		return fromSymbolMatch(fm)
 	}
+	if len(fm.Rewrites) > 0 {
+		return fromRewriteMatch(fm)
+	}
 
-	lineMatches := make([]streamhttp.EventLineMatch, 0, len(fm.LineMatches))
+	var (
+		lineMatches = make([]streamhttp.EventLineMatch, 0, len(fm.LineMatches))
+		budget      = maxLinePreviewBytes
+		truncated   bool
+	)
 	for _, lm := range fm.LineMatches {
-		lineMatches = append(lineMatches, streamhttp.EventLineMatch{
+		if budget <= 0 {
+			truncated = true
+			break
+		}
+		lineMatch := streamhttp.EventLineMatch{
 			Line:             lm.Preview,
 			LineNumber:       lm.LineNumber,
 			OffsetAndLengths: lm.OffsetAndLengths,
-		})
+		}
+		if len(lineMatch.Line) > budget {
+			lineMatch = truncateLineMatch(lineMatch, budget)
+			truncated = true
+		}
+		budget -= len(lineMatch.Line)
+		lineMatches = append(lineMatches, lineMatch)
 	}
 
 	var branches []string
@@ -416,6 +462,63 @@ func fromFileMatch(fm *result.FileMatch) streamhttp.EventMatch {
 		Branches:    branches,
 		Version:     string(fm.CommitID),
 		LineMatches: lineMatches,
+		Truncated:   truncated,
+	}
+}
+
+// maxLinePreviewBytes is the maximum number of bytes of line previews streamed
+// for a single file match. Matches in very large generated files, such as
+// minified sources, would otherwise stream huge lines.
+const maxLinePreviewBytes = 64 << 10
+
+// truncateLineMatch shortens the line of lm to at most n bytes, dropping or
+// shortening the ranges of lm which are cut off.
+func truncateLineMatch(lm streamhttp.EventLineMatch, n int) streamhttp.EventLineMatch {
+	for n > 0 && !utf8.RuneStart(lm.Line[n]) {
+		n--
+	}
+	lm.Line = lm.Line[:n]
+
+	// Offsets and lengths count characters, not bytes.
+	runes := int32(utf8.RuneCountInString(lm.Line))
+	offsetAndLengths := make([][2]int32, 0, len(lm.OffsetAndLengths))
+	for _, ol := range lm.OffsetAndLengths {
+		offset, length := ol[0], ol[1]
+		if offset >= runes {
+			continue
+		}
+		if offset+length > runes {
+			length = runes - offset
+		}
+		offsetAndLengths = append(offsetAndLengths, [2]int32{offset, length})
+	}
+	lm.OffsetAndLengths = offsetAndLengths
+	return lm
+}
+
+func fromRewriteMatch(fm *result.FileMatch) *streamhttp.EventRewriteMatch {
+	rewrites := make([]streamhttp.EventRewrite, 0, len(fm.Rewrites))
+	for _, rw := range fm.Rewrites {
+		rewrites = append(rewrites, streamhttp.EventRewrite{
+			StartLine:   rw.StartLine,
+			EndLine:     rw.EndLine,
+			Matched:     rw.Matched,
+			Replacement: rw.Replacement,
+		})
+	}
+
+	var branches []string
+	if fm.InputRev != nil {
+		branches = []string{*fm.InputRev}
+	}
+
+	return &streamhttp.EventRewriteMatch{
+		Type:       streamhttp.RewriteMatchType,
+		Path:       fm.Path,
+		Repository: string(fm.Repo.Name),
+		Branches:   branches,
+		Version:    string(fm.CommitID),
+		Rewrites:   rewrites,
 	}
 }
 
@@ -433,6 +536,9 @@ func fromSymbolMatch(fm *result.FileMatch) *streamhttp.EventSymbolMatch {
 			Name:          sym.Symbol.Name,
 			ContainerName: sym.Symbol.Parent,
 			Kind:          kindString,
+			QualifiedName: sym.Symbol.QualifiedName(),
+			Parents:       sym.Symbol.ParentChain(),
+			Signature:     sym.Symbol.Signature,
 		})
 	}
 
@@ -484,6 +590,17 @@ func fromCommit(commit *result.CommitMatch) *streamhttp.EventCommitMatch {
 	}
 }
 
+func fromCount(count *result.CountMatch) *streamhttp.EventCountMatch {
+	return &streamhttp.EventCountMatch{
+		Type:       streamhttp.CountMatchType,
+		By:         string(count.By),
+		Repository: string(count.Repo.Name),
+		Path:       count.Path,
+		Author:     count.Author,
+		Count:      count.Count,
+	}
+}
+
 // eventStreamOTHook returns a StatHook which logs to log.
 func eventStreamOTHook(log func(...otlog.Field)) func(streamhttp.WriterStat) {
 	return func(stat streamhttp.WriterStat) {
@@ -7,9 +7,11 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
@@ -235,3 +237,48 @@ func (h *mockSearchResolver) Inputs() run.SearchInputs {
 	}
 	return *h.inputs
 }
+
+func TestFromFileMatchTruncated(t *testing.T) {
+	long := strings.Repeat("é", maxLinePreviewBytes)
+	fm := &result.FileMatch{
+		File: result.File{Path: "bundle.min.js"},
+		LineMatches: []*result.LineMatch{{
+			Preview:          "foo",
+			LineNumber:       1,
+			OffsetAndLengths: [][2]int32{{0, 3}},
+		}, {
+			Preview:          long,
+			LineNumber:       2,
+			OffsetAndLengths: [][2]int32{{1, 2}, {maxLinePreviewBytes/2 - 3, 4}, {maxLinePreviewBytes / 2, 1}},
+		}, {
+			Preview:          "foo",
+			LineNumber:       3,
+			OffsetAndLengths: [][2]int32{{0, 3}},
+		}},
+	}
+
+	event := fromFileMatch(fm).(*streamhttp.EventFileMatch)
+	if !event.Truncated {
+		t.Error("expected file match to be truncated")
+	}
+	if len(event.LineMatches) != 3 {
+		t.Fatalf("got %d line matches, want 3", len(event.LineMatches))
+	}
+
+	// The budget left after the first line is odd, so the long line is cut
+	// before the two-byte character which would exceed it.
+	got := event.LineMatches[1]
+	if want := long[:maxLinePreviewBytes-4]; got.Line != want {
+		t.Errorf("got line of %d bytes, want %d", len(got.Line), len(want))
+	}
+	wantOffsets := [][2]int32{{1, 2}, {maxLinePreviewBytes/2 - 3, 1}}
+	if diff := cmp.Diff(wantOffsets, got.OffsetAndLengths); diff != "" {
+		t.Errorf("unexpected offsets (-want +got):\n%s", diff)
+	}
+
+	// The single byte left is spent on the last line.
+	want := streamhttp.EventLineMatch{Line: "f", LineNumber: 3, OffsetAndLengths: [][2]int32{{0, 1}}}
+	if diff := cmp.Diff(want, event.LineMatches[2]); diff != "" {
+		t.Errorf("unexpected last line match (-want +got):\n%s", diff)
+	}
+}
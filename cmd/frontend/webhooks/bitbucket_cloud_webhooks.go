@@ -0,0 +1,132 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/inconshreveable/log15"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// BitbucketCloudWebhook is responsible for handling incoming http requests for Bitbucket
+// Cloud webhooks and routing to any registered WebhookHandlers, events are routed by their
+// event key, passed in the X-Event-Key header
+type BitbucketCloudWebhook struct {
+	ExternalServices *database.ExternalServiceStore
+
+	mu       sync.RWMutex
+	handlers map[string][]WebhookHandler
+}
+
+func (h *BitbucketCloudWebhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		log15.Error("Error parsing bitbucket cloud webhook event", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// get external service and validate webhook payload signature
+	extSvc, err := h.getExternalService(r, body)
+	if err != nil {
+		log15.Error("Could not find valid external service for webhook", "error", err)
+		http.Error(w, "External service not found", http.StatusUnauthorized)
+		return
+	}
+
+	// parse event, ignoring the ones we don't handle
+	eventKey := r.Header.Get("X-Event-Key")
+	e, err := bitbucketcloud.ParseWebhookEvent(eventKey, body)
+	if err != nil {
+		log15.Error("Error parsing bitbucket cloud webhook event", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if e == nil {
+		return
+	}
+
+	// match event handlers
+	err = h.Dispatch(r.Context(), eventKey, extSvc, e)
+	if err != nil {
+		log15.Error("Error handling bitbucket cloud webhook event", "error", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// Dispatch accepts an event for a particular event key and dispatches it
+// to the appropriate stack of handlers, if any are configured.
+func (h *BitbucketCloudWebhook) Dispatch(ctx context.Context, eventKey string, extSvc *types.ExternalService, e interface{}) error {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	g := errgroup.Group{}
+	for _, handler := range h.handlers[eventKey] {
+		// capture the handler variable within this loop
+		handler := handler
+		g.Go(func() error {
+			return handler(ctx, extSvc, e)
+		})
+	}
+	return g.Wait()
+}
+
+// Register associates a given event key(s) with the specified handler.
+func (h *BitbucketCloudWebhook) Register(handler WebhookHandler, eventKeys ...string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.handlers == nil {
+		h.handlers = make(map[string][]WebhookHandler)
+	}
+	for _, eventKey := range eventKeys {
+		h.handlers[eventKey] = append(h.handlers[eventKey], handler)
+	}
+}
+
+func (h *BitbucketCloudWebhook) getExternalService(r *http.Request, body []byte) (*types.ExternalService, error) {
+	var (
+		sig   = r.Header.Get("X-Hub-Signature")
+		rawID = r.FormValue(extsvc.IDParam)
+	)
+
+	externalServiceID, err := strconv.ParseInt(rawID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	e, err := h.ExternalServices.GetByID(r.Context(), externalServiceID)
+	if err != nil {
+		return nil, err
+	}
+	c, err := e.Configuration()
+	if err != nil {
+		return nil, err
+	}
+	bc, ok := c.(*schema.BitbucketCloudConnection)
+	if !ok {
+		return nil, fmt.Errorf("invalid configuration, received bitbucket cloud webhook for non-bitbucket cloud external service: %v", externalServiceID)
+	}
+
+	// 🚨 SECURITY: Try to authenticate the request with any of the stored secrets
+	// If there are no secrets or no secret managed to authenticate the request,
+	// we return an error to the client.
+	for _, hook := range bc.Webhooks {
+		if hook.Secret == "" {
+			continue
+		}
+
+		if err = bitbucketcloud.ValidateSignature(sig, body, []byte(hook.Secret)); err == nil {
+			return e, nil
+		}
+	}
+	return nil, fmt.Errorf("couldn't authenticate webhook for external service %d", externalServiceID)
+}
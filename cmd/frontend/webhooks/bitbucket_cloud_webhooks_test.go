@@ -0,0 +1,101 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestBitbucketCloudWebhook(t *testing.T) {
+	secret := "secret"
+	extSvc := &types.ExternalService{
+		ID:          1,
+		Kind:        extsvc.KindBitbucketCloud,
+		DisplayName: "Bitbucket Cloud",
+		Config: marshalJSON(t, &schema.BitbucketCloudConnection{
+			Url:         "https://bitbucket.org",
+			Username:    "admin",
+			AppPassword: "password",
+			Teams:       []string{"sglocal"},
+			Webhooks:    []*schema.BitbucketCloudWebhook{{Secret: secret}},
+		}),
+	}
+
+	database.Mocks.ExternalServices.GetByID = func(id int64) (*types.ExternalService, error) {
+		return extSvc, nil
+	}
+	defer func() { database.Mocks.ExternalServices.GetByID = nil }()
+
+	var called bool
+	hook := BitbucketCloudWebhook{}
+	hook.Register(func(ctx context.Context, svc *types.ExternalService, payload interface{}) error {
+		evt, ok := payload.(*bitbucketcloud.RepoEvent)
+		if !ok {
+			t.Errorf("Expected *bitbucketcloud.RepoEvent event, got %T", payload)
+		} else if evt.Repository.FullName != "sglocal/mux" {
+			t.Errorf("Expected 'sglocal/mux', got %s", evt.Repository.FullName)
+		}
+		called = true
+		return nil
+	}, bitbucketcloud.EventRepoUpdated)
+
+	payload := []byte(`{"repository":{"uuid":"{e1e75436-05e6-4c38-8543-9c36ec26fad1}","full_name":"sglocal/mux"}}`)
+
+	for _, tc := range []struct {
+		name       string
+		eventKey   string
+		secret     string
+		wantStatus int
+		wantCalled bool
+	}{
+		{
+			name:       "handled event",
+			eventKey:   bitbucketcloud.EventRepoUpdated,
+			secret:     secret,
+			wantStatus: http.StatusOK,
+			wantCalled: true,
+		},
+		{
+			name:       "unhandled event",
+			eventKey:   "pullrequest:created",
+			secret:     secret,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "invalid signature",
+			eventKey:   bitbucketcloud.EventRepoUpdated,
+			secret:     "not-the-secret",
+			wantStatus: http.StatusUnauthorized,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			called = false
+
+			u := extsvc.WebhookURL(extsvc.KindBitbucketCloud, extSvc.ID, "https://example.com")
+			req, err := http.NewRequest("POST", u, bytes.NewReader(payload))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set("X-Event-Key", tc.eventKey)
+			req.Header.Set("X-Hub-Signature", sign(t, payload, []byte(tc.secret)))
+
+			rec := httptest.NewRecorder()
+			hook.ServeHTTP(rec, req)
+
+			if have, want := rec.Result().StatusCode, tc.wantStatus; have != want {
+				t.Fatalf("status: have %d, want %d", have, want)
+			}
+			if called != tc.wantCalled {
+				t.Fatalf("called: have %v, want %v", called, tc.wantCalled)
+			}
+		})
+	}
+}
@@ -41,7 +41,9 @@ var (
 	reposDir                     = env.Get("SRC_REPOS_DIR", "/data/repos", "Root dir containing repos.")
 	wantPctFree                  = env.MustGetInt("SRC_REPOS_DESIRED_PERCENT_FREE", 10, "Target percentage of free space on disk.")
 	janitorInterval              = env.MustGetDuration("SRC_REPOS_JANITOR_INTERVAL", 1*time.Minute, "Interval between cleanup runs")
+	integrityCheckInterval       = env.MustGetDuration("SRC_REPOS_INTEGRITY_CHECK_INTERVAL", 1*time.Hour, "Interval between repository integrity check runs")
 	syncRepoStateInterval        = env.MustGetDuration("SRC_REPOS_SYNC_STATE_INTERVAL", 10*time.Minute, "Interval between state syncs")
+	rebalanceInterval            = env.MustGetDuration("SRC_REPOS_REBALANCE_INTERVAL", 10*time.Minute, "Interval between runs moving repos between gitserver shards, if the gitServerRebalancing experimental feature is enabled")
 	syncRepoStateBatchSize       = env.MustGetInt("SRC_REPOS_SYNC_STATE_BATCH_SIZE", 500, "Number of upserts to perform per batch")
 	syncRepoStateUpsertPerSecond = env.MustGetInt("SRC_REPOS_SYNC_STATE_UPSERT_PER_SEC", 500, "The number of upserted rows allowed per second across all gitserver instances")
 	envHostname                  = env.Get("HOSTNAME", "", "Hostname override")
@@ -137,7 +139,9 @@ func main() {
 					MaxChanges: int(c.MaxChanges),
 				}, nil
 			}
-			return &server.GitRepoSyncer{}, nil
+			return &server.GitRepoSyncer{
+				CloneStrategy: server.CloneStrategyFor(conf.Get(), repo),
+			}, nil
 		},
 		Hostname: hostnameBestEffort(),
 		DB:       db,
@@ -160,7 +164,9 @@ func main() {
 	close(ready)
 	go debugserver.NewServerRoutine(ready).Start()
 	go gitserver.Janitor(janitorInterval)
+	go gitserver.IntegrityChecker(integrityCheckInterval)
 	go gitserver.SyncRepoState(syncRepoStateInterval, syncRepoStateBatchSize, syncRepoStateUpsertPerSecond)
+	go gitserver.Rebalancer(rebalanceInterval)
 
 	port := "3178"
 	host := ""
@@ -0,0 +1,180 @@
+package server
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"regexp"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+)
+
+// The strategies for cloning a repository.
+const (
+	// CloneStrategyFull clones all history.
+	CloneStrategyFull = "full"
+	// CloneStrategyShallow clones only the most recent commits of each ref.
+	CloneStrategyShallow = "shallow"
+	// CloneStrategyBlobless clones all commits and trees, but fetches blobs
+	// on demand.
+	CloneStrategyBlobless = "blobless"
+)
+
+// defaultCloneDepth is the depth of shallow clones if no depth is configured.
+const defaultCloneDepth = 1
+
+const (
+	// initialDeepen is the number of commits a shallow clone is first deepened
+	// by when a requested commit is missing. Each further attempt deepens by
+	// deepenFactor times more commits.
+	initialDeepen = 100
+	deepenFactor  = 10
+
+	// maxDeepenAttempts is the number of times we deepen a shallow clone
+	// before fetching all of its history.
+	maxDeepenAttempts = 3
+)
+
+var deepenCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "src_gitserver_repo_deepened_total",
+	Help: "number of fetches deepening shallow clones to find a missing commit",
+}, []string{"unshallow"})
+
+// CloneStrategy describes how a repository is cloned and fetched.
+type CloneStrategy struct {
+	// Kind is one of CloneStrategyFull, CloneStrategyShallow, or
+	// CloneStrategyBlobless. The empty value is a full clone.
+	Kind string
+	// Depth is the number of commits of each ref in a shallow clone.
+	Depth int
+}
+
+// CloneStrategyFor returns the clone strategy of the first rule in the
+// gitCloneStrategy site configuration matching repo, or a full clone if none
+// match.
+func CloneStrategyFor(c *conf.Unified, repo api.RepoName) CloneStrategy {
+	if c == nil {
+		return CloneStrategy{Kind: CloneStrategyFull}
+	}
+	for _, rule := range c.GitCloneStrategy {
+		re, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			log15.Warn("error compiling GitCloneStrategy pattern", "error", err)
+			continue
+		}
+		if !re.MatchString(string(repo)) {
+			continue
+		}
+		strategy := CloneStrategy{Kind: rule.Strategy, Depth: rule.Depth}
+		if strategy.Kind == CloneStrategyShallow && strategy.Depth <= 0 {
+			strategy.Depth = defaultCloneDepth
+		}
+		return strategy
+	}
+	return CloneStrategy{Kind: CloneStrategyFull}
+}
+
+// cloneArgs returns the extra arguments to git fetch when cloning a
+// repository with the strategy.
+func (s CloneStrategy) cloneArgs() []string {
+	switch s.Kind {
+	case CloneStrategyShallow:
+		return []string{"--depth=" + strconv.Itoa(s.Depth)}
+	case CloneStrategyBlobless:
+		return []string{"--filter=blob:none"}
+	}
+	return nil
+}
+
+// fetchArgs returns the extra arguments to git fetch when updating the
+// repository in dir with the strategy.
+func (s CloneStrategy) fetchArgs(dir GitDir) []string {
+	switch s.Kind {
+	case CloneStrategyShallow:
+		// Fetches of shallow clones only fetch the new commits down to the
+		// existing history. Passing --depth would instead truncate the
+		// history of updated refs, discarding any deepening.
+		return nil
+	case CloneStrategyBlobless:
+		return []string{"--filter=blob:none"}
+	}
+	// Complete the history of repos that were cloned shallow before their
+	// strategy changed.
+	if isShallowClone(dir) {
+		return []string{"--unshallow"}
+	}
+	return nil
+}
+
+// isShallowClone returns true if the repository in dir is a shallow clone.
+func isShallowClone(dir GitDir) bool {
+	_, err := os.Stat(dir.Path("shallow"))
+	return err == nil
+}
+
+// revisionExists returns true if rev resolves to an existing commit in the
+// repository in dir.
+func revisionExists(dir GitDir, rev string) bool {
+	// rev-parse on an OID does not check if the commit actually exists, so it always
+	// works. So we append ^0 to force the check
+	if isAbsoluteRevision(rev) {
+		rev = rev + "^0"
+	}
+	cmd := exec.Command("git", "rev-parse", rev, "--")
+	dir.Set(cmd)
+	return cmd.Run() == nil
+}
+
+// deepenRepo deepens the history of the shallow clone of repo in dir until
+// rev exists, fetching all history after maxDeepenAttempts. It returns
+// whether rev exists afterwards.
+func (s *Server) deepenRepo(ctx context.Context, repo api.RepoName, dir GitDir, rev string) (bool, error) {
+	syncer, err := s.GetVCSSyncer(ctx, repo)
+	if err != nil {
+		return false, errors.Wrap(err, "get VCS syncer")
+	}
+	gitSyncer, ok := syncer.(*GitRepoSyncer)
+	if !ok {
+		// Only git repositories can be shallow clones.
+		return false, nil
+	}
+
+	remoteURL, err := s.getRemoteURL(ctx, repo)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to determine Git remote URL")
+	}
+
+	// Prevent fetching in parallel with updates of the repository.
+	mu := s.repoUpdateLock(repo).mu
+	mu.Lock()
+	defer mu.Unlock()
+
+	deepen := initialDeepen
+	for i := 0; i < maxDeepenAttempts && isShallowClone(dir); i++ {
+		log15.Info("deepening shallow clone", "repo", repo, "rev", rev, "deepen", deepen)
+		deepenCounter.WithLabelValues("false").Inc()
+		if err := gitSyncer.fetchWith(ctx, remoteURL, dir, []string{"--deepen=" + strconv.Itoa(deepen)}); err != nil {
+			return false, err
+		}
+		if revisionExists(dir, rev) {
+			return true, nil
+		}
+		deepen *= deepenFactor
+	}
+
+	if isShallowClone(dir) {
+		log15.Info("fetching all history of shallow clone", "repo", repo, "rev", rev)
+		deepenCounter.WithLabelValues("true").Inc()
+		if err := gitSyncer.fetchWith(ctx, remoteURL, dir, []string{"--unshallow"}); err != nil {
+			return false, err
+		}
+	}
+	return revisionExists(dir, rev), nil
+}
@@ -0,0 +1,140 @@
+package server
+
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestCloneStrategyFor(t *testing.T) {
+	c := &conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+		GitCloneStrategy: []*schema.CloneStrategyRule{
+			{Pattern: "[", Strategy: CloneStrategyBlobless},
+			{Pattern: "^github\\.com/org/monorepo$", Strategy: CloneStrategyBlobless},
+			{Pattern: "^github\\.com/org/", Strategy: CloneStrategyShallow},
+			{Pattern: "^github\\.com/deep/", Strategy: CloneStrategyShallow, Depth: 50},
+		},
+	}}
+
+	for repo, want := range map[api.RepoName]CloneStrategy{
+		"github.com/org/monorepo":  {Kind: CloneStrategyBlobless},
+		"github.com/org/other":     {Kind: CloneStrategyShallow, Depth: defaultCloneDepth},
+		"github.com/deep/repo":     {Kind: CloneStrategyShallow, Depth: 50},
+		"github.com/unrelated/foo": {Kind: CloneStrategyFull},
+	} {
+		if diff := cmp.Diff(want, CloneStrategyFor(c, repo)); diff != "" {
+			t.Errorf("%s: unexpected strategy (-want +got):\n%s", repo, diff)
+		}
+	}
+}
+
+func TestGitRepoSyncer_ShallowClone(t *testing.T) {
+	ctx := context.Background()
+	remote := t.TempDir()
+	remoteCmd := func(name string, arg ...string) string {
+		t.Helper()
+		return strings.TrimSpace(runCmd(t, remote, name, arg...))
+	}
+	first := makeSingleCommitRepo(remoteCmd)
+	remoteCmd("git", "commit", "--allow-empty", "-m", "second")
+	remoteCmd("git", "commit", "--allow-empty", "-m", "third")
+	head := remoteCmd("git", "rev-parse", "HEAD")
+
+	// Shallow clones are only supported over a transport, not local paths.
+	remoteURL, err := vcs.ParseURL("file://" + remote)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpPath := filepath.Join(t.TempDir(), ".git")
+	dir := GitDir(tmpPath)
+	syncer := &GitRepoSyncer{CloneStrategy: CloneStrategy{Kind: CloneStrategyShallow, Depth: 1}}
+	cmd, err := syncer.CloneCommand(ctx, remoteURL, tmpPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out, err := runWithRemoteOpts(ctx, cmd, nil); err != nil {
+		t.Fatalf("clone failed: %s\n%s", err, out)
+	}
+
+	if !isShallowClone(dir) {
+		t.Fatal("expected shallow clone")
+	}
+	if !revisionExists(dir, head) {
+		t.Fatalf("expected HEAD commit %s to exist", head)
+	}
+	if revisionExists(dir, first) {
+		t.Fatalf("expected first commit %s to be missing", first)
+	}
+
+	if err := syncer.fetchWith(ctx, remoteURL, dir, []string{"--deepen=2"}); err != nil {
+		t.Fatal(err)
+	}
+	if !revisionExists(dir, first) {
+		t.Fatalf("expected first commit %s to exist after deepening", first)
+	}
+
+	// Fetching with the full strategy completes the history.
+	syncer.CloneStrategy = CloneStrategy{Kind: CloneStrategyFull}
+	if err := syncer.Fetch(ctx, remoteURL, dir); err != nil {
+		t.Fatal(err)
+	}
+	if isShallowClone(dir) {
+		t.Fatal("expected complete clone after fetching with full strategy")
+	}
+}
+
+func TestGitRepoSyncer_BloblessClone(t *testing.T) {
+	ctx := context.Background()
+	remote := t.TempDir()
+	remoteCmd := func(name string, arg ...string) string {
+		t.Helper()
+		return strings.TrimSpace(runCmd(t, remote, name, arg...))
+	}
+	makeSingleCommitRepo(remoteCmd)
+	remoteCmd("git", "config", "uploadpack.allowFilter", "true")
+
+	remoteURL, err := vcs.ParseURL("file://" + remote)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpPath := filepath.Join(t.TempDir(), ".git")
+	syncer := &GitRepoSyncer{CloneStrategy: CloneStrategy{Kind: CloneStrategyBlobless}}
+	cmd, err := syncer.CloneCommand(ctx, remoteURL, tmpPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out, err := runWithRemoteOpts(ctx, cmd, nil); err != nil {
+		t.Fatalf("clone failed: %s\n%s", err, out)
+	}
+
+	// The blob of hello.txt is only fetched on demand.
+	countObjects := func() string {
+		cmd := exec.Command("git", "rev-list", "--objects", "--all", "--missing=print")
+		GitDir(tmpPath).Set(cmd)
+		out, err := cmd.Output()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(out)
+	}
+	if objects := countObjects(); !strings.Contains(objects, "?") {
+		t.Fatalf("expected missing blobs in blobless clone, got objects:\n%s", objects)
+	}
+
+	cmd = exec.Command("git", "show", "HEAD:hello.txt")
+	GitDir(tmpPath).Set(cmd)
+	if out, err := cmd.CombinedOutput(); err != nil || strings.TrimSpace(string(out)) != "hello world" {
+		t.Fatalf("expected blob to be fetched on demand, got %q (error: %v)", out, err)
+	}
+}
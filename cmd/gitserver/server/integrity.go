@@ -0,0 +1,343 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+const (
+	// repoIntegrityTTL is how often we should check the integrity of a
+	// repository.
+	repoIntegrityTTL = time.Hour * 24 * 7
+
+	// gitConfigIntegrityCheckTimestamp is a key we add to git config to record
+	// when the integrity of a repo was last checked.
+	gitConfigIntegrityCheckTimestamp = "sourcegraph.integrityCheckTimestamp"
+
+	// maxIntegrityCheckOutput is the maximum number of bytes of the output of
+	// a failed check we record.
+	maxIntegrityCheckOutput = 4096
+)
+
+// The integrity checks run on repositories.
+const (
+	integrityCheckFsck      = "fsck"
+	integrityCheckLastFetch = "last-fetch"
+)
+
+// The repairs of corrupted repositories.
+const (
+	integrityRepairReclone = "reclone"
+	integrityRepairRefetch = "refetch"
+	integrityRepairNone    = "none"
+)
+
+var (
+	integrityChecks = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "src_gitserver_repo_integrity_checks_total",
+		Help: "number of repo integrity checks, by the check that failed or ok",
+	}, []string{"result"})
+	integrityRepairs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "src_gitserver_repo_integrity_repairs_total",
+		Help: "number of repairs of corrupted repos",
+	}, []string{"repair", "success"})
+)
+
+// IntegrityChecker periodically checks the integrity of the repos in
+// s.ReposDir and repairs the corrupted ones. It is expected to run in a
+// background goroutine.
+func (s *Server) IntegrityChecker(interval time.Duration) {
+	for {
+		s.checkReposIntegrity()
+		time.Sleep(interval)
+	}
+}
+
+// checkReposIntegrity walks the repos directory and checks the integrity of
+// the repos that were not checked within repoIntegrityTTL:
+//
+// 1. Check the connectivity of all objects reachable from refs (git fsck).
+// 2. Check that all objects fetched by the last fetch exist.
+//
+// Corrupted repos are recorded in the database, and re-cloned or re-fetched
+// respectively.
+func (s *Server) checkReposIntegrity() {
+	ctx, cancel := s.serverContext()
+	defer cancel()
+
+	err := bestEffortWalk(s.ReposDir, func(dir string, fi fs.FileInfo) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if s.ignorePath(dir) {
+			if fi.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		// Look for $GIT_DIR
+		if !fi.IsDir() || fi.Name() != ".git" {
+			return nil
+		}
+
+		if err := s.maybeCheckIntegrity(ctx, GitDir(dir)); err != nil {
+			log15.Error("error checking repo integrity", "repo", dir, "error", err)
+		}
+		return filepath.SkipDir
+	})
+	if err != nil && ctx.Err() == nil {
+		log15.Error("integrity check: error iterating over repositories", "error", err)
+	}
+}
+
+// maybeCheckIntegrity checks the integrity of the repo in dir, unless it was
+// checked within repoIntegrityTTL or is being cloned, and repairs it.
+func (s *Server) maybeCheckIntegrity(ctx context.Context, dir GitDir) error {
+	if _, cloning := s.locker.Status(dir); cloning {
+		return nil
+	}
+
+	checked, err := getIntegrityCheckTime(dir)
+	if err != nil {
+		return err
+	}
+	// Add a jitter to spread out checks of repos cloned at the same time.
+	if time.Since(checked) < repoIntegrityTTL+jitterDuration(string(dir), repoIntegrityTTL/4) {
+		return nil
+	}
+
+	// Record the check before running it, so that we don't constantly check
+	// and repair repos if repairing fails.
+	if err := setIntegrityCheckTime(dir, time.Now()); err != nil {
+		return err
+	}
+
+	check, output, err := checkIntegrity(ctx, dir)
+	if err != nil {
+		return err
+	}
+	if check == "" {
+		integrityChecks.WithLabelValues("ok").Inc()
+		return nil
+	}
+	integrityChecks.WithLabelValues(check).Inc()
+
+	repo := s.name(dir)
+	repair := integrityRepairReclone
+	if check == integrityCheckLastFetch {
+		repair = integrityRepairRefetch
+	}
+	// Repairing kicks off a clone or fetch, which we don't do if
+	// DisableAutoGitUpdates is set.
+	if conf.Get().DisableAutoGitUpdates {
+		repair = integrityRepairNone
+	}
+	log15.Warn("found corrupt repo", "repo", repo, "check", check, "repair", repair, "output", output)
+
+	f := &types.GitserverRepoIntegrityFailure{
+		ShardID: s.Hostname,
+		Check:   check,
+		Output:  output,
+		Repair:  repair,
+	}
+	if err := s.logIntegrityFailure(ctx, repo, f); err != nil {
+		log15.Warn("Logging repo integrity failure in DB", "repo", repo, "error", err)
+	}
+
+	if repair == integrityRepairNone {
+		return nil
+	}
+
+	repairErr := s.repairIntegrity(ctx, repo, dir, repair)
+	integrityRepairs.WithLabelValues(repair, strconv.FormatBool(repairErr == nil)).Inc()
+
+	var repairErrString string
+	if repairErr != nil {
+		repairErrString = repairErr.Error()
+	}
+	if f.ID != 0 {
+		if err := database.GitserverRepos(s.DB).SetIntegrityFailureRepaired(ctx, f.ID, repairErrString); err != nil {
+			log15.Warn("Setting repo integrity failure repaired in DB", "repo", repo, "error", err)
+		}
+	}
+	return errors.Wrapf(repairErr, "failed to %s corrupt repo", repair)
+}
+
+// repairIntegrity repairs the corrupt repo in dir with the given repair.
+func (s *Server) repairIntegrity(ctx context.Context, repo api.RepoName, dir GitDir, repair string) error {
+	ctx, cancel := context.WithTimeout(ctx, longGitCommandTimeout)
+	defer cancel()
+
+	switch repair {
+	case integrityRepairReclone:
+		_, err := s.cloneRepo(ctx, repo, &cloneOptions{Block: true, Overwrite: true})
+		return err
+
+	case integrityRepairRefetch:
+		err := s.doRepoUpdate(ctx, repo)
+		if err == nil {
+			// Make sure the fetch actually repaired the repo.
+			var check, output string
+			if check, output, err = checkIntegrity(ctx, dir); err == nil && check != "" {
+				err = errors.Errorf("%s check still fails: %s", check, output)
+			}
+		}
+		if err != nil {
+			// Fall back to re-cloning the repo on the next janitor run.
+			if err := gitConfigSet(dir, gitConfigMaybeCorrupt, strconv.FormatInt(time.Now().Unix(), 10)); err != nil {
+				log15.Error("failed to set maybeCorruptRepo config", "repo", repo, "error", err)
+			}
+		}
+		return err
+	}
+
+	return errors.Errorf("unknown repair %q", repair)
+}
+
+// checkIntegrity runs the integrity checks on the repo in dir. It returns the
+// name and output of the first check that failed, or an empty check if all
+// succeeded.
+func checkIntegrity(ctx context.Context, dir GitDir) (check, output string, err error) {
+	ctx, cancel := context.WithTimeout(ctx, longGitCommandTimeout)
+	defer cancel()
+
+	// Only check the connectivity of objects, since fully verifying every
+	// object of large repos takes too long.
+	cmd := exec.CommandContext(ctx, "git", "fsck", "--connectivity-only", "--no-dangling", "--no-progress")
+	dir.Set(cmd)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		if ctx.Err() != nil {
+			return "", "", ctx.Err()
+		}
+		if _, ok := err.(*exec.ExitError); !ok {
+			return "", "", errors.Wrap(wrapCmdError(cmd, err), "failed to run git fsck")
+		}
+		return integrityCheckFsck, truncateIntegrityOutput(out), nil
+	}
+
+	missing, err := missingFetchedObjects(ctx, dir)
+	if err != nil {
+		return "", "", err
+	}
+	if len(missing) > 0 {
+		return integrityCheckLastFetch, truncateIntegrityOutput([]byte("missing objects fetched by the last fetch: " + strings.Join(missing, " "))), nil
+	}
+
+	return "", "", nil
+}
+
+// missingFetchedObjects returns the objects listed in the FETCH_HEAD of the
+// repo in dir which don't exist in the repo, i.e. which the last fetch did
+// not complete.
+func missingFetchedObjects(ctx context.Context, dir GitDir) ([]string, error) {
+	fetchHead, err := os.ReadFile(dir.Path("FETCH_HEAD"))
+	if os.IsNotExist(err) {
+		// The repo was never fetched since it was cloned.
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Each line of FETCH_HEAD starts with the object ID of a fetched ref.
+	var objects bytes.Buffer
+	for _, line := range strings.Split(string(fetchHead), "\n") {
+		if fields := strings.Fields(line); len(fields) > 0 {
+			objects.WriteString(fields[0] + "\n")
+		}
+	}
+	if objects.Len() == 0 {
+		return nil, nil
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "cat-file", "--batch-check")
+	dir.Set(cmd)
+	cmd.Stdin = &objects
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, errors.Wrap(wrapCmdError(cmd, err), "failed to check fetched objects")
+	}
+
+	// Missing objects are reported as "<object> missing".
+	var missing []string
+	sc := bufio.NewScanner(bytes.NewReader(out))
+	for sc.Scan() {
+		if object := strings.TrimSuffix(sc.Text(), " missing"); object != sc.Text() {
+			missing = append(missing, object)
+		}
+	}
+	return missing, sc.Err()
+}
+
+func truncateIntegrityOutput(out []byte) string {
+	out = bytes.TrimSpace(out)
+	if len(out) > maxIntegrityCheckOutput {
+		out = out[:maxIntegrityCheckOutput]
+	}
+	return string(out)
+}
+
+// logIntegrityFailure records the integrity failure f of repo in the
+// database, setting its ID.
+func (s *Server) logIntegrityFailure(ctx context.Context, name api.RepoName, f *types.GitserverRepoIntegrityFailure) (err error) {
+	if s.DB == nil {
+		return nil
+	}
+	tx, err := database.Repos(s.DB).Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	repo, err := tx.GetByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	f.RepoID = repo.ID
+	return database.NewGitserverReposWith(tx).LogIntegrityFailure(ctx, f)
+}
+
+// setIntegrityCheckTime sets the time the integrity of a repository was
+// checked.
+func setIntegrityCheckTime(dir GitDir, now time.Time) error {
+	err := gitConfigSet(dir, gitConfigIntegrityCheckTimestamp, strconv.FormatInt(now.Unix(), 10))
+	return errors.Wrap(err, "failed to update integrityCheckTimestamp")
+}
+
+// getIntegrityCheckTime returns the time the integrity of a repository was
+// last checked. If the value is not stored in the repository, it is set to
+// now, since the repository was most likely just cloned.
+func getIntegrityCheckTime(dir GitDir) (time.Time, error) {
+	value, err := gitConfigGet(dir, gitConfigIntegrityCheckTimestamp)
+	if err != nil {
+		return time.Unix(0, 0), errors.Wrap(err, "failed to determine integrity check timestamp")
+	}
+
+	sec, err := strconv.ParseInt(strings.TrimSpace(value), 10, 0)
+	if value == "" || err != nil {
+		now := time.Now()
+		return now, setIntegrityCheckTime(dir, now)
+	}
+
+	return time.Unix(sec, 0), nil
+}
@@ -0,0 +1,87 @@
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCheckIntegrity(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	gitDir := GitDir(filepath.Join(dir, ".git"))
+
+	cmd := func(name string, arg ...string) string {
+		t.Helper()
+		return runCmd(t, dir, name, arg...)
+	}
+	head := strings.TrimSpace(makeSingleCommitRepo(cmd))
+
+	check, output, err := checkIntegrity(ctx, gitDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if check != "" {
+		t.Fatalf("expected healthy repo, got failed check %q: %s", check, output)
+	}
+
+	// A FETCH_HEAD referring to existing objects passes.
+	fetchHead := head + "\t\tbranch 'master' of https://example.com/repo\n"
+	if err := os.WriteFile(gitDir.Path("FETCH_HEAD"), []byte(fetchHead), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if check, output, err = checkIntegrity(ctx, gitDir); err != nil {
+		t.Fatal(err)
+	} else if check != "" {
+		t.Fatalf("expected healthy repo, got failed check %q: %s", check, output)
+	}
+
+	// A FETCH_HEAD referring to a missing object fails the last-fetch check.
+	const missing = "d24d09b8bc5d1ea2c3aa24455f4578db6aa3afda"
+	fetchHead += missing + "\tnot-for-merge\tbranch 'other' of https://example.com/repo\n"
+	if err := os.WriteFile(gitDir.Path("FETCH_HEAD"), []byte(fetchHead), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if check, output, err = checkIntegrity(ctx, gitDir); err != nil {
+		t.Fatal(err)
+	}
+	if check != integrityCheckLastFetch || !strings.Contains(output, missing) {
+		t.Fatalf("expected %q check to fail for %s, got %q: %s", integrityCheckLastFetch, missing, check, output)
+	}
+	if err := os.Remove(gitDir.Path("FETCH_HEAD")); err != nil {
+		t.Fatal(err)
+	}
+
+	// Deleting the object of the commit fails fsck.
+	object := gitDir.Path("objects", head[:2], head[2:])
+	if err := os.Remove(object); err != nil {
+		t.Fatal(err)
+	}
+	if check, _, err = checkIntegrity(ctx, gitDir); err != nil {
+		t.Fatal(err)
+	}
+	if check != integrityCheckFsck {
+		t.Fatalf("expected %q check to fail, got %q", integrityCheckFsck, check)
+	}
+}
+
+func TestGetIntegrityCheckTime(t *testing.T) {
+	dir := t.TempDir()
+	gitDir := GitDir(filepath.Join(dir, ".git"))
+	runCmd(t, dir, "git", "init", ".")
+
+	// The check time is initialized to now when missing.
+	first, err := getIntegrityCheckTime(gitDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := getIntegrityCheckTime(gitDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Unix() != second.Unix() {
+		t.Fatalf("expected check time to be stored, got %v and %v", first, second)
+	}
+}
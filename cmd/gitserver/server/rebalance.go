@@ -0,0 +1,225 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/inconshreveable/log15"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+)
+
+// headerMigrationProxy is set on requests proxied to the shard a repo is
+// cloned on, to prevent proxying them again.
+const headerMigrationProxy = "X-Sourcegraph-Gitserver-Migration-Proxy"
+
+var (
+	repoMigrations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "src_gitserver_repo_migrations_total",
+		Help: "number of repos copied from another shard by rebalancing",
+	}, []string{"success"})
+	repoMigrationsRemoved = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "src_gitserver_repo_migrations_removed_total",
+		Help: "number of repos removed after they were moved to another shard by rebalancing",
+	})
+	repoMigrationsProxied = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "src_gitserver_repo_migrations_proxied_total",
+		Help: "number of requests proxied to the shard a repo is cloned on while it is moved to this shard",
+	})
+)
+
+// Rebalancer periodically moves repos between gitserver shards if the
+// gitServerRebalancing experimental feature is enabled. It is expected to run
+// in a background goroutine.
+//
+// Repos are placed on shards by rendezvous hashing (see gitserver.AddrForRepo),
+// so adding a shard only moves the repos placed on the new shard. Requests for
+// a repo are sent to the shard it is placed on right away. Repos are moved with
+// copy-then-switch semantics:
+//
+// 1. Until it has a copy of the repo, the new shard proxies requests for it.
+// 2. The new shard copies the repo from the shard it is cloned on.
+// 3. The new shard records the repo as cloned on it, and serves it.
+// 4. The old shard removes its clone of the repo.
+//
+// The shard a repo is cloned on is recorded in the gitserver_repos table.
+func (s *Server) Rebalancer(interval time.Duration) {
+	for {
+		if conf.GitServerRebalancingEnabled() && s.DB != nil {
+			if err := s.rebalance(conf.Get().ServiceConnections.GitServers); err != nil {
+				log15.Error("Rebalancing repos", "error", err)
+			}
+		}
+		time.Sleep(interval)
+	}
+}
+
+// rebalance copies the repos placed on this shard which are cloned on
+// another shard, and removes the repos cloned on this shard which were moved
+// to another shard.
+func (s *Server) rebalance(addrs []string) error {
+	ctx, cancel := s.serverContext()
+	defer cancel()
+
+	var toCopy, toRemove []api.RepoName
+	err := database.GitserverRepos(s.DB).IterateRepoGitserverStatus(ctx, func(repo types.RepoGitserverStatus) error {
+		if repo.GitserverRepo == nil {
+			return nil
+		}
+		target := gitserver.AddrForRepo(repo.Name, addrs)
+
+		switch {
+		case s.hostnameMatch(target):
+			if _, ok := s.migrationSourceOf(repo.GitserverRepo, addrs); ok && !repoCloned(s.dir(repo.Name)) {
+				toCopy = append(toCopy, repo.Name)
+			}
+		case repo.CloneStatus == types.CloneStatusCloned && repo.ShardID != s.Hostname && hostnameMatch(repo.ShardID, target):
+			// The repo was copied to the shard it is placed on, which serves
+			// it from now on.
+			if repoCloned(s.dir(repo.Name)) {
+				toRemove = append(toRemove, repo.Name)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, repo := range toCopy {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		log15.Info("copying repo from another shard", "repo", repo)
+		_, err := s.cloneRepo(ctx, repo, &cloneOptions{Block: true})
+		repoMigrations.WithLabelValues(strconv.FormatBool(err == nil)).Inc()
+		if err != nil {
+			log15.Error("failed to copy repo from another shard", "repo", repo, "error", err)
+		}
+	}
+
+	for _, repo := range toRemove {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		log15.Info("removing repo moved to another shard", "repo", repo)
+		// The clone status of the repo belongs to its new shard now.
+		if err := s.removeRepoDirectory(s.dir(repo), false); err != nil {
+			log15.Error("failed to remove repo moved to another shard", "repo", repo, "error", err)
+			continue
+		}
+		repoMigrationsRemoved.Inc()
+	}
+
+	return nil
+}
+
+// migrationSource returns the address of the shard repo is cloned on, if it is
+// being moved to this shard by rebalancing.
+func (s *Server) migrationSource(ctx context.Context, repo api.RepoName) (string, bool) {
+	if s.DB == nil || !conf.GitServerRebalancingEnabled() {
+		return "", false
+	}
+
+	r, err := database.Repos(s.DB).GetByName(ctx, repo)
+	if err != nil {
+		return "", false
+	}
+	gr, err := database.GitserverRepos(s.DB).GetByID(ctx, r.ID)
+	if err != nil {
+		return "", false
+	}
+	return s.migrationSourceOf(gr, conf.Get().ServiceConnections.GitServers)
+}
+
+// migrationSourceOf returns the address of the shard the repo with the
+// gitserver state gr is cloned on, if it is cloned on another shard in addrs.
+func (s *Server) migrationSourceOf(gr *types.GitserverRepo, addrs []string) (string, bool) {
+	if gr.CloneStatus != types.CloneStatusCloned || gr.ShardID == "" || gr.ShardID == s.Hostname {
+		return "", false
+	}
+	for _, addr := range addrs {
+		if hostnameMatch(gr.ShardID, addr) {
+			return addr, true
+		}
+	}
+	// The shard was removed, so we have to clone the repo from the code host.
+	return "", false
+}
+
+// migrationRemoteURL returns the URL to copy repo from the shard at addr.
+func migrationRemoteURL(addr string, repo api.RepoName) (*vcs.URL, error) {
+	return vcs.ParseURL("http://" + addr + "/git/" + string(repo))
+}
+
+// proxyExec proxies the exec request req for a repo which is being moved to
+// this shard to the shard at addr, which the repo is cloned on.
+func (s *Server) proxyExec(w http.ResponseWriter, r *http.Request, addr string, req *protocol.ExecRequest) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	out := r.Clone(r.Context())
+	out.Method = http.MethodPost
+	out.URL.Path = "/exec"
+	out.URL.RawQuery = ""
+	out.Body = io.NopCloser(bytes.NewReader(body))
+	out.ContentLength = int64(len(body))
+	out.Header.Set("Content-Type", "application/json")
+
+	proxyToShard(w, out, addr)
+}
+
+// proxyMigratingRepos wraps the git smart HTTP protocol handler h, proxying
+// requests for repos which are being moved to this shard to the shard they
+// are cloned on.
+func (s *Server) proxyMigratingRepos(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(headerMigrationProxy) == "" {
+			repo := strings.TrimPrefix(r.URL.Path, "/git/")
+			for _, suffix := range []string{"/info/refs", "/git-upload-pack"} {
+				repo = strings.TrimSuffix(repo, suffix)
+			}
+			name := protocol.NormalizeRepo(api.RepoName(repo))
+			if !repoCloned(s.dir(name)) {
+				if addr, ok := s.migrationSource(r.Context(), name); ok {
+					proxyToShard(w, r, addr)
+					return
+				}
+			}
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+// proxyToShard proxies r to the gitserver shard at addr.
+func proxyToShard(w http.ResponseWriter, r *http.Request, addr string) {
+	repoMigrationsProxied.Inc()
+	proxy := &httputil.ReverseProxy{
+		Director: func(req *http.Request) {
+			req.URL.Scheme = "http"
+			req.URL.Host = addr
+			req.Header.Set(headerMigrationProxy, "1")
+		},
+		// Stream responses, such as the output of long running commands.
+		FlushInterval: -1,
+	}
+	proxy.ServeHTTP(w, r)
+}
@@ -0,0 +1,118 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtesting"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestRebalance(t *testing.T) {
+	ctx := context.Background()
+	db := dbtesting.GetDB(t)
+
+	remote := t.TempDir()
+	wantCommit := strings.TrimSpace(makeSingleCommitRepo(func(name string, arg ...string) string {
+		t.Helper()
+		return runCmd(t, remote, name, arg...)
+	}))
+
+	// Shard a has the repo cloned, and shard b was added.
+	a := makeTestServer(ctx, t.TempDir(), remote, db)
+	a.Hostname = "127.0.0.1"
+	srvA := httptest.NewServer(a.Handler())
+	defer srvA.Close()
+	b := makeTestServer(ctx, t.TempDir(), remote, db)
+	b.Hostname = "localhost"
+	h := b.Handler()
+
+	addrA := strings.TrimPrefix(srvA.URL, "http://")
+	addrs := []string{addrA, "localhost:3178"}
+
+	// Pick a repo which is placed on shard b.
+	var repoName api.RepoName
+	for i := 0; ; i++ {
+		repoName = api.RepoName(fmt.Sprintf("example.com/foo/bar%d", i))
+		if gitserver.RendezvousAddrForRepo(repoName, addrs) == addrs[1] {
+			break
+		}
+	}
+	dbRepo := &types.Repo{Name: repoName, Description: "Test"}
+	if err := database.Repos(db).Create(ctx, dbRepo); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := a.cloneRepo(ctx, repoName, &cloneOptions{Block: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	conf.Mock(&conf.Unified{
+		SiteConfiguration: schema.SiteConfiguration{
+			ExperimentalFeatures: &schema.ExperimentalFeatures{GitServerRebalancing: "enabled"},
+		},
+		ServiceConnections: conftypes.ServiceConnections{GitServers: addrs},
+	})
+	defer conf.Mock(nil)
+
+	assertShard := func(shard string) {
+		t.Helper()
+		gr, err := database.GitserverRepos(db).GetByID(ctx, dbRepo.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if gr.ShardID != shard || gr.CloneStatus != types.CloneStatusCloned {
+			t.Fatalf("want repo cloned on %q, got %q on %q", shard, gr.CloneStatus, gr.ShardID)
+		}
+	}
+	assertShard(a.Hostname)
+
+	if addr, ok := b.migrationSource(ctx, repoName); !ok || addr != addrA {
+		t.Fatalf("want migration source %q, got %q", addrA, addr)
+	}
+
+	// Until shard b has a copy of the repo, it proxies requests to shard a.
+	b.skipCloneForTests = true
+	body, err := json.Marshal(protocol.ExecRequest{Repo: repoName, Args: []string{"rev-parse", "HEAD"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest("POST", "/exec", bytes.NewReader(body)))
+	if got := strings.TrimSpace(rr.Body.String()); got != wantCommit {
+		t.Fatalf("want proxied exec to return %q, got %q (status %d)", wantCommit, got, rr.Code)
+	}
+	b.skipCloneForTests = false
+
+	// Shard b copies the repo from shard a and switches it to itself.
+	if err := b.rebalance(addrs); err != nil {
+		t.Fatal(err)
+	}
+	if !repoCloned(b.dir(repoName)) {
+		t.Fatal("expected repo to be copied to shard b")
+	}
+	assertShard(b.Hostname)
+	if got := strings.TrimSpace(runCmd(t, string(b.dir(repoName)), "git", "rev-parse", "HEAD")); got != wantCommit {
+		t.Fatalf("want copied HEAD %q, got %q", wantCommit, got)
+	}
+
+	// Shard a removes its clone of the repo.
+	if err := a.rebalance(addrs); err != nil {
+		t.Fatal(err)
+	}
+	if repoCloned(a.dir(repoName)) {
+		t.Fatal("expected repo to be removed from shard a")
+	}
+	assertShard(b.Hostname)
+}
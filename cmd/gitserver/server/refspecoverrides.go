@@ -21,6 +21,7 @@ func useRefspecOverrides() bool {
 
 // HACK(keegancsmith) workaround to experiment with cloning less in a large
 // monorepo. https://github.com/sourcegraph/customer/issues/19
-func refspecOverridesFetchCmd(ctx context.Context, remoteURL *vcs.URL) *exec.Cmd {
-	return exec.CommandContext(ctx, "git", append([]string{"fetch", "--progress", "--prune", remoteURL.String()}, refspecOverrides...)...)
+func refspecOverridesFetchCmd(ctx context.Context, remoteURL *vcs.URL, args []string) *exec.Cmd {
+	args = append([]string{"fetch", "--progress", "--prune"}, args...)
+	return exec.CommandContext(ctx, "git", append(append(args, remoteURL.String()), refspecOverrides...)...)
 }
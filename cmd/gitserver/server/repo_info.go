@@ -154,5 +154,5 @@ func (s *Server) handleRepoDelete(w http.ResponseWriter, r *http.Request) {
 }
 
 func (s *Server) deleteRepo(repo api.RepoName) error {
-	return s.removeRepoDirectory(s.dir(repo))
+	return s.removeRepoDirectory(s.dir(repo), true)
 }
@@ -292,7 +292,7 @@ func (s *Server) Handler() http.Handler {
 		w.WriteHeader(http.StatusOK)
 	})
 
-	mux.Handle("/git/", http.StripPrefix("/git", s.gitServiceHandler()))
+	mux.Handle("/git/", s.proxyMigratingRepos(http.StripPrefix("/git", s.gitServiceHandler())))
 
 	return mux
 }
@@ -321,15 +321,21 @@ func (s *Server) SyncRepoState(interval time.Duration, batchSize, perSecond int)
 // hostnameMatch checks whether the hostname matches the given address.
 // If we don't find an exact match, we look at the initial prefix.
 func (s *Server) hostnameMatch(addr string) bool {
-	if !strings.HasPrefix(addr, s.Hostname) {
+	return hostnameMatch(s.Hostname, addr)
+}
+
+// hostnameMatch checks whether hostname matches the given address. If we
+// don't find an exact match, we look at the initial prefix.
+func hostnameMatch(hostname, addr string) bool {
+	if !strings.HasPrefix(addr, hostname) {
 		return false
 	}
-	if addr == s.Hostname {
+	if addr == hostname {
 		return true
 	}
-	// We know that s.Hostname is shorter than addr so we can safely check the next
-	// char
-	next := addr[len(s.Hostname)]
+	// We know that hostname is shorter than addr so we can safely check the
+	// next char
+	next := addr[len(hostname)]
 	return next == '.' || next == ':'
 }
 
@@ -423,6 +429,15 @@ func (s *Server) syncRepoState(addrs []string, batchSize, perSecond int) error {
 		cloned := repoCloned(dir)
 		_, cloning := s.locker.Status(dir)
 
+		// Repos which are being moved to this shard by rebalancing stay
+		// recorded on the shard they are cloned on until they are copied.
+		if !cloned && !cloning && repo.GitserverRepo != nil && conf.GitServerRebalancingEnabled() {
+			if _, ok := s.migrationSourceOf(repo.GitserverRepo, addrs); ok {
+				repoSyncStateCounter.WithLabelValues("migrating").Inc()
+				return nil
+			}
+		}
+
 		var shouldUpdate bool
 		if repo.GitserverRepo == nil {
 			repo.GitserverRepo = &types.GitserverRepo{
@@ -853,6 +868,21 @@ func (s *Server) exec(w http.ResponseWriter, r *http.Request, req *protocol.Exec
 
 	dir := s.dir(req.Repo)
 	if !repoCloned(dir) {
+		// Until a repo which is being moved to this shard is copied, the shard
+		// it is cloned on serves it.
+		if r.Header.Get(headerMigrationProxy) == "" {
+			if addr, ok := s.migrationSource(ctx, req.Repo); ok {
+				if !conf.Get().DisableAutoGitUpdates {
+					if _, err := s.cloneRepo(ctx, req.Repo, nil); err != nil {
+						log15.Warn("error starting repo copy", "repo", req.Repo, "err", err)
+					}
+				}
+				status = "proxied"
+				s.proxyExec(w, r, addr, req)
+				return
+			}
+		}
+
 		if conf.Get().DisableAutoGitUpdates {
 			log15.Debug("not cloning on demand as DisableAutoGitUpdates is set")
 			status = "repo-not-found"
@@ -968,7 +998,7 @@ func (s *Server) handleP4Exec(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Make sure the subcommand is explicitly allowed
-	allowlist := []string{"protects", "groups", "users", "group"}
+	allowlist := []string{"protects", "groups", "users", "group", "depots"}
 	allowed := false
 	for _, arg := range allowlist {
 		if req.Args[0] == arg {
@@ -1232,6 +1262,26 @@ func (s *Server) cloneRepo(ctx context.Context, repo api.RepoName, opts *cloneOp
 		return "", err
 	}
 
+	// Git repos which are being moved to this shard by rebalancing are
+	// copied from the shard they are cloned on, rather than cloned from the
+	// code host again. Until the copy is complete, the repo stays recorded on
+	// that shard, which keeps serving it. Other VCSs keep state outside of the
+	// refs we fetch, so they are cloned from the code host.
+	var (
+		cloneSyncer, cloneURL = syncer, remoteURL
+		source                string
+		migrating             bool
+	)
+	if _, ok := syncer.(*GitRepoSyncer); ok {
+		source, migrating = s.migrationSource(ctx, repo)
+	}
+	if migrating {
+		cloneSyncer = &GitRepoSyncer{}
+		if cloneURL, err = migrationRemoteURL(source, repo); err != nil {
+			return "", err
+		}
+	}
+
 	redactor := newURLRedactor(remoteURL)
 
 	// isCloneable causes a network request, so we limit the number that can
@@ -1245,11 +1295,13 @@ func (s *Server) cloneRepo(ctx context.Context, repo api.RepoName, opts *cloneOp
 	}
 	defer cancel()
 
-	if err = s.rpsLimiter.Wait(ctx); err != nil {
-		return "", err
+	if !migrating {
+		if err = s.rpsLimiter.Wait(ctx); err != nil {
+			return "", err
+		}
 	}
 
-	if err := syncer.IsCloneable(ctx, remoteURL); err != nil {
+	if err := cloneSyncer.IsCloneable(ctx, cloneURL); err != nil {
 		return "", fmt.Errorf("error cloning repo: repo %s not cloneable: %s", repo, redactor.redact(err.Error()))
 	}
 
@@ -1280,8 +1332,10 @@ func (s *Server) cloneRepo(ctx context.Context, repo api.RepoName, opts *cloneOp
 		}
 		defer cancel1()
 
-		if err = s.rpsLimiter.Wait(ctx); err != nil {
-			return err
+		if !migrating {
+			if err = s.rpsLimiter.Wait(ctx); err != nil {
+				return err
+			}
 		}
 
 		ctx, cancel2 := context.WithTimeout(ctx, longGitCommandTimeout)
@@ -1310,15 +1364,19 @@ func (s *Server) cloneRepo(ctx context.Context, repo api.RepoName, opts *cloneOp
 		tmp := GitDir(tmpPath)
 
 		// It may already be cloned
-		if !repoCloned(dir) {
+		if !repoCloned(dir) && !migrating {
 			s.setCloneStatusNonFatal(ctx, repo, types.CloneStatusCloning)
 		}
 		defer func() {
-			// Use a background context to ensure we still update the DB even if we time out
-			s.setCloneStatusNonFatal(context.Background(), repo, cloneStatus(repoCloned(dir), false))
+			// A copied repo is switched to this shard by recording it as
+			// cloned here.
+			if cloned := repoCloned(dir); cloned || !migrating {
+				// Use a background context to ensure we still update the DB even if we time out
+				s.setCloneStatusNonFatal(context.Background(), repo, cloneStatus(cloned, false))
+			}
 		}()
 
-		cmd, err := syncer.CloneCommand(ctx, remoteURL, tmpPath)
+		cmd, err := cloneSyncer.CloneCommand(ctx, cloneURL, tmpPath)
 		if err != nil {
 			return errors.Wrap(err, "get clone command")
 		}
@@ -1328,7 +1386,7 @@ func (s *Server) cloneRepo(ctx context.Context, repo api.RepoName, opts *cloneOp
 
 		// see issue #7322: skip LFS content in repositories with Git LFS configured
 		cmd.Env = append(cmd.Env, "GIT_LFS_SKIP_SMUDGE=1")
-		log15.Info("cloning repo", "repo", repo, "tmp", tmpPath, "dst", dstPath)
+		log15.Info("cloning repo", "repo", repo, "tmp", tmpPath, "dst", dstPath, "source", source)
 
 		pr, pw := io.Pipe()
 		defer pw.Close()
@@ -1344,7 +1402,7 @@ func (s *Server) cloneRepo(ctx context.Context, repo api.RepoName, opts *cloneOp
 
 		removeBadRefs(ctx, tmp)
 
-		if err := setHEAD(ctx, tmp, syncer, repo, remoteURL); err != nil {
+		if err := setHEAD(ctx, tmp, cloneSyncer, repo, cloneURL); err != nil {
 			log15.Error("Failed to ensure HEAD exists", "repo", repo, "error", err)
 			return errors.Wrap(err, "failed to ensure HEAD exists")
 		}
@@ -1388,8 +1446,10 @@ func (s *Server) cloneRepo(ctx context.Context, repo api.RepoName, opts *cloneOp
 		// We are blocking, so use the passed in context.
 		err := doClone(ctx)
 		err = errors.Wrapf(err, "failed to clone %s", repo)
-		// Use a background context to ensure we still update the DB even if we time out
-		s.setLastErrorNonFatal(context.Background(), repo, err)
+		if err == nil || !migrating {
+			// Use a background context to ensure we still update the DB even if we time out
+			s.setLastErrorNonFatal(context.Background(), repo, err)
+		}
 		return "", err
 	}
 
@@ -1401,7 +1461,9 @@ func (s *Server) cloneRepo(ctx context.Context, repo api.RepoName, opts *cloneOp
 		if err != nil {
 			log15.Error("failed to clone repo", "repo", repo, "error", err)
 		}
-		s.setLastErrorNonFatal(ctx, repo, err)
+		if err == nil || !migrating {
+			s.setLastErrorNonFatal(ctx, repo, err)
+		}
 	}()
 
 	return "", nil
@@ -1559,15 +1621,8 @@ func (s *Server) doRepoUpdate(ctx context.Context, repo api.RepoName) error {
 	span.SetTag("repo", repo)
 	defer span.Finish()
 
+	l := s.repoUpdateLock(repo)
 	s.repoUpdateLocksMu.Lock()
-	l, ok := s.repoUpdateLocks[repo]
-	if !ok {
-		l = &locks{
-			once: new(sync.Once),
-			mu:   new(sync.Mutex),
-		}
-		s.repoUpdateLocks[repo] = l
-	}
 	once := l.once
 	mu := l.mu
 	s.repoUpdateLocksMu.Unlock()
@@ -1606,6 +1661,21 @@ func (s *Server) doRepoUpdate(ctx context.Context, repo api.RepoName) error {
 	}
 }
 
+// repoUpdateLock returns the locks preventing parallel updates of repo.
+func (s *Server) repoUpdateLock(repo api.RepoName) *locks {
+	s.repoUpdateLocksMu.Lock()
+	defer s.repoUpdateLocksMu.Unlock()
+	l, ok := s.repoUpdateLocks[repo]
+	if !ok {
+		l = &locks{
+			once: new(sync.Once),
+			mu:   new(sync.Mutex),
+		}
+		s.repoUpdateLocks[repo] = l
+	}
+	return l
+}
+
 var doBackgroundRepoUpdateMock func(api.RepoName) error
 
 func (s *Server) doBackgroundRepoUpdate(repo api.RepoName) error {
@@ -1903,18 +1973,19 @@ func (s *Server) ensureRevision(ctx context.Context, repo api.RepoName, rev stri
 	if rev == "" || rev == "HEAD" {
 		return false
 	}
-	// rev-parse on an OID does not check if the commit actually exists, so it always
-	// works. So we append ^0 to force the check
-	if isAbsoluteRevision(rev) {
-		rev = rev + "^0"
-	}
-	cmd := exec.Command("git", "rev-parse", rev, "--")
-	cmd.Dir = string(repoDir)
-	if err := cmd.Run(); err == nil {
+	if revisionExists(repoDir, rev) {
 		return false
 	}
 	// Revision not found, update before returning.
 	_ = s.doRepoUpdate(ctx, repo)
+
+	// Fetches don't deepen shallow clones, so the revision may still be
+	// missing if it is older than the history we have.
+	if isShallowClone(repoDir) && !revisionExists(repoDir, rev) {
+		if _, err := s.deepenRepo(ctx, repo, repoDir, rev); err != nil {
+			log15.Warn("failed to deepen shallow clone", "repo", repo, "rev", rev, "error", err)
+		}
+	}
 	return true
 }
 
@@ -34,7 +34,11 @@ type VCSSyncer interface {
 }
 
 // GitRepoSyncer is a syncer for Git repositories.
-type GitRepoSyncer struct{}
+type GitRepoSyncer struct {
+	// CloneStrategy is how to clone and fetch the repository. The zero value
+	// is a full clone.
+	CloneStrategy CloneStrategy
+}
 
 func (s *GitRepoSyncer) Type() string {
 	return "git"
@@ -79,21 +83,23 @@ func (s *GitRepoSyncer) CloneCommand(ctx context.Context, remoteURL *vcs.URL, tm
 		return nil, errors.Wrapf(err, "clone setup failed")
 	}
 
-	cmd, _ = s.fetchCommand(ctx, remoteURL)
+	cmd, _ = s.fetchCommand(ctx, remoteURL, s.CloneStrategy.cloneArgs())
 	cmd.Dir = tmpPath
 	return cmd, nil
 }
 
-func (s *GitRepoSyncer) fetchCommand(ctx context.Context, remoteURL *vcs.URL) (cmd *exec.Cmd, configRemoteOpts bool) {
+// fetchCommand returns the command to fetch from remoteURL, passing args to
+// git fetch. Custom fetch commands ignore args.
+func (s *GitRepoSyncer) fetchCommand(ctx context.Context, remoteURL *vcs.URL, args []string) (cmd *exec.Cmd, configRemoteOpts bool) {
 	configRemoteOpts = true
 	if customCmd := customFetchCmd(ctx, remoteURL); customCmd != nil {
 		cmd = customCmd
 		configRemoteOpts = false
 	} else if useRefspecOverrides() {
-		cmd = refspecOverridesFetchCmd(ctx, remoteURL)
+		cmd = refspecOverridesFetchCmd(ctx, remoteURL, args)
 	} else {
-		cmd = exec.CommandContext(ctx, "git", "fetch",
-			"--progress", "--prune", remoteURL.String(),
+		args = append([]string{"fetch", "--progress", "--prune"}, args...)
+		cmd = exec.CommandContext(ctx, "git", append(args, remoteURL.String(),
 			// Normal git refs
 			"+refs/heads/*:refs/heads/*", "+refs/tags/*:refs/tags/*",
 			// GitHub pull requests
@@ -105,14 +111,20 @@ func (s *GitRepoSyncer) fetchCommand(ctx context.Context, remoteURL *vcs.URL) (c
 			// Gerrit changesets
 			"+refs/changes/*:refs/changes/*",
 			// Possibly deprecated refs for sourcegraph zap experiment?
-			"+refs/sourcegraph/*:refs/sourcegraph/*")
+			"+refs/sourcegraph/*:refs/sourcegraph/*")...)
 	}
 	return cmd, configRemoteOpts
 }
 
 // Fetch tries to fetch updates of a Git repository.
 func (s *GitRepoSyncer) Fetch(ctx context.Context, remoteURL *vcs.URL, dir GitDir) error {
-	cmd, configRemoteOpts := s.fetchCommand(ctx, remoteURL)
+	return s.fetchWith(ctx, remoteURL, dir, s.CloneStrategy.fetchArgs(dir))
+}
+
+// fetchWith fetches updates of the Git repository in dir, passing args to git
+// fetch.
+func (s *GitRepoSyncer) fetchWith(ctx context.Context, remoteURL *vcs.URL, dir GitDir, args []string) error {
+	cmd, configRemoteOpts := s.fetchCommand(ctx, remoteURL, args)
 	dir.Set(cmd)
 	if output, err := runWith(ctx, cmd, configRemoteOpts, nil); err != nil {
 		return errors.Wrapf(err, "failed to update with output %q", newURLRedactor(remoteURL).redact(string(output)))
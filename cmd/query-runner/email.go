@@ -48,6 +48,7 @@ func (n *notifier) emailNotify(ctx context.Context) {
 			if n.results.Data.Search.Results.ApproximateResultCount != "1" {
 				plural = "s"
 			}
+			matches, moreMatches := n.notifiedMatches()
 			if err := sendEmail(ctx, recipient.spec.userID, "results", newSearchResultsEmailTemplates, struct {
 				URL                    string
 				SavedSearchPageURL     string
@@ -56,6 +57,8 @@ func (n *notifier) emailNotify(ctx context.Context) {
 				ApproximateResultCount string
 				Ownership              string
 				PluralResults          string
+				Matches                []notifiedMatch
+				MoreMatches            int
 			}{
 				URL:                    searchURL(n.newQuery, utmSourceEmail),
 				SavedSearchPageURL:     savedSearchListPageURL(utmSourceEmail),
@@ -64,6 +67,8 @@ func (n *notifier) emailNotify(ctx context.Context) {
 				ApproximateResultCount: n.results.Data.Search.Results.ApproximateResultCount,
 				Ownership:              ownership,
 				PluralResults:          plural,
+				Matches:                matches,
+				MoreMatches:            moreMatches,
 			}); err != nil {
 				log15.Error("Failed to send email notification for new saved search results.", "userID", recipient.spec.userID, "error", err)
 			}
@@ -77,14 +82,28 @@ var newSearchResultsEmailTemplates = txemail.MustValidate(txtypes.Templates{
 {{.ApproximateResultCount}} new search result{{.PluralResults}} found for {{.Ownership}} saved search:
 
   "{{.Description}}"
-
+{{if .Matches}}
+New matches:
+{{range .Matches}}
+  {{.Location}}
+    {{.Preview}}
+{{end}}{{if .MoreMatches}}
+  ...and {{.MoreMatches}} more
+{{end}}{{end}}
 View the new result{{.PluralResults}} on Sourcegraph: {{.URL}}
 `,
 	HTML: `
 <strong>{{.ApproximateResultCount}}</strong> new search result{{.PluralResults}} found for {{.Ownership}} saved search:
 
 <p style="padding-left: 16px">&quot;{{.Description}}&quot;</p>
-
+{{if .Matches}}
+<p>New matches:</p>
+
+<ul>
+{{range .Matches}}<li><code>{{.Location}}</code><br><code>{{.Preview}}</code></li>
+{{end}}</ul>
+{{if .MoreMatches}}<p>...and {{.MoreMatches}} more</p>{{end}}
+{{end}}
 <p><a href="{{.URL}}">View the new result{{.PluralResults}} on Sourcegraph</a></p>
 
 <p><a href="{{.SavedSearchPageURL}}">Edit your saved searches on Sourcegraph</a></p>
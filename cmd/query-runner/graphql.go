@@ -34,6 +34,12 @@ const gqlSearchQuery = `query Search(
 			results {
 				__typename
 				... on FileMatch {
+					repository {
+						name
+					}
+					file {
+						path
+					}
 					limitHit
 					lineMatches {
 						preview
@@ -292,7 +292,27 @@ func notify(ctx context.Context, spec api.SavedQueryIDSpec, query api.ConfigSave
 	if len(results.Data.Search.Results.Results) == 0 {
 		return nil
 	}
-	log15.Info("sending notifications", "new_results", len(results.Data.Search.Results.Results), "description", query.Description)
+
+	// Diff the matches against those of the previous execution, so that the
+	// notifications list the new matches only. If that fails, notifications
+	// are sent without listing matches.
+	var newMatches []*api.SavedQueryMatch
+	matches, err := extractMatches(results.Data.Search.Results.Results)
+	if err == nil && len(matches) > 0 {
+		newMatches, err = api.InternalClient.SavedQueriesReplaceMatches(ctx, &api.SavedQueryMatches{
+			Query:   query.Query,
+			Matches: matches,
+		})
+		if err == nil && len(newMatches) == 0 {
+			// All results were already notified about.
+			return nil
+		}
+	}
+	if err != nil {
+		log15.Error("failed to diff saved search matches", "error", err, "description", query.Description)
+	}
+
+	log15.Info("sending notifications", "new_results", len(results.Data.Search.Results.Results), "new_matches", len(newMatches), "description", query.Description)
 
 	// Determine which users to notify.
 	recipients, err := getNotificationRecipients(ctx, spec, query)
@@ -306,6 +326,7 @@ func notify(ctx context.Context, spec api.SavedQueryIDSpec, query api.ConfigSave
 		query:      query,
 		newQuery:   newQuery,
 		results:    results,
+		matches:    newMatches,
 		recipients: recipients,
 	}
 
@@ -320,6 +341,7 @@ type notifier struct {
 	query      api.ConfigSavedQuery
 	newQuery   string
 	results    *gqlSearchResponse
+	matches    []*api.SavedQueryMatch // new matches since the previous execution
 	recipients recipients
 }
 
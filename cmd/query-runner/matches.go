@@ -0,0 +1,281 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+// maxMatches is the maximum number of matches of a single query execution
+// that are diffed against those of the previous execution.
+const maxMatches = 1000
+
+// maxNotifiedMatches is the maximum number of new matches listed in a
+// notification.
+const maxNotifiedMatches = 10
+
+type gqlHighlightedString struct {
+	Value      string
+	Highlights []struct {
+		Line int
+	}
+}
+
+// gqlSearchResult is the subset of a search result (as queried by
+// gqlSearchQuery) that describes its matches.
+type gqlSearchResult struct {
+	Typename string `json:"__typename"`
+
+	// FileMatch fields.
+	Repository struct {
+		Name string
+	}
+	File struct {
+		Path string
+	}
+	LineMatches []struct {
+		Preview    string
+		LineNumber int
+	}
+
+	// CommitSearchResult fields.
+	Commit struct {
+		Repository struct {
+			Name string
+		}
+		Oid string
+	}
+	MessagePreview *gqlHighlightedString
+	DiffPreview    *gqlHighlightedString
+}
+
+// extractMatches returns the matched lines of the given search results, up to
+// maxMatches.
+func extractMatches(results []interface{}) ([]*api.SavedQueryMatch, error) {
+	var matches []*api.SavedQueryMatch
+	for _, r := range results {
+		b, err := json.Marshal(r)
+		if err != nil {
+			return nil, errors.Wrap(err, "Marshal")
+		}
+		var result gqlSearchResult
+		if err := json.Unmarshal(b, &result); err != nil {
+			return nil, errors.Wrap(err, "Unmarshal")
+		}
+
+		switch result.Typename {
+		case "FileMatch":
+			for _, lineMatch := range result.LineMatches {
+				matches = append(matches, &api.SavedQueryMatch{
+					Repository: result.Repository.Name,
+					Path:       result.File.Path,
+					LineNumber: lineMatch.LineNumber + 1, // 0-based in the GraphQL API
+					Preview:    lineMatch.Preview,
+				})
+			}
+		case "CommitSearchResult":
+			matches = append(matches, commitMatches(&result)...)
+		}
+
+		if len(matches) >= maxMatches {
+			return matches[:maxMatches], nil
+		}
+	}
+	return matches, nil
+}
+
+// commitMatches returns the matched lines of the given commit search result.
+// Lines of a diff are located in the new version of the file, or in the old
+// version for deleted lines.
+func commitMatches(result *gqlSearchResult) []*api.SavedQueryMatch {
+	newMatch := func(path string, lineNumber int, preview string) *api.SavedQueryMatch {
+		return &api.SavedQueryMatch{
+			Repository: result.Commit.Repository.Name,
+			Commit:     result.Commit.Oid,
+			Path:       path,
+			LineNumber: lineNumber,
+			Preview:    preview,
+		}
+	}
+
+	if result.DiffPreview != nil {
+		lines := parseDiffLines(result.DiffPreview.Value)
+
+		var matches []*api.SavedQueryMatch
+		for _, line := range highlightedLines(result.DiffPreview) {
+			// Highlights are 1-based.
+			if line < 1 || line > len(lines) || lines[line-1].path == "" {
+				continue
+			}
+			l := lines[line-1]
+			matches = append(matches, newMatch(l.path, l.lineNumber, l.preview))
+		}
+		return matches
+	}
+
+	if result.MessagePreview != nil {
+		lines := strings.Split(result.MessagePreview.Value, "\n")
+
+		var matches []*api.SavedQueryMatch
+		for _, line := range highlightedLines(result.MessagePreview) {
+			if line < 1 || line > len(lines) {
+				continue
+			}
+			matches = append(matches, newMatch("", line, lines[line-1]))
+		}
+		if len(matches) == 0 {
+			// The commit matched without a message pattern, so its subject
+			// stands for the match.
+			matches = append(matches, newMatch("", 1, lines[0]))
+		}
+		return matches
+	}
+
+	return nil
+}
+
+// highlightedLines returns the distinct lines holding highlights of the given
+// string, in order.
+func highlightedLines(s *gqlHighlightedString) []int {
+	var lines []int
+	for _, highlight := range s.Highlights {
+		if len(lines) == 0 || lines[len(lines)-1] != highlight.Line {
+			lines = append(lines, highlight.Line)
+		}
+	}
+	return lines
+}
+
+// diffLine is a line of a raw diff, located in the file it changes. The path
+// of lines that are not part of a hunk is empty.
+type diffLine struct {
+	path       string
+	lineNumber int
+	preview    string
+}
+
+// parseDiffLines locates the lines of the given raw diff, as produced by git
+// with --no-prefix.
+func parseDiffLines(rawDiff string) []diffLine {
+	rawLines := strings.Split(rawDiff, "\n")
+	lines := make([]diffLine, len(rawLines))
+
+	var (
+		oldPath, newPath   string
+		oldLine, newLine   int
+		inHunk, inFileMeta bool
+	)
+	for i, line := range rawLines {
+		switch {
+		case strings.HasPrefix(line, "diff "):
+			oldPath, newPath = "", ""
+			inHunk, inFileMeta = false, true
+			continue
+		case inFileMeta && strings.HasPrefix(line, "--- "):
+			oldPath = strings.TrimPrefix(line, "--- ")
+			continue
+		case inFileMeta && strings.HasPrefix(line, "+++ "):
+			newPath = strings.TrimPrefix(line, "+++ ")
+			continue
+		case strings.HasPrefix(line, "@@ "):
+			oldLine, newLine, inHunk = parseHunkHeader(line)
+			inFileMeta = false
+			continue
+		case !inHunk || line == "":
+			continue
+		}
+
+		switch line[0] {
+		case '-':
+			lines[i] = diffLine{path: oldPath, lineNumber: oldLine, preview: line[1:]}
+			oldLine++
+		case '+':
+			lines[i] = diffLine{path: newPath, lineNumber: newLine, preview: line[1:]}
+			newLine++
+		case ' ':
+			lines[i] = diffLine{path: newPath, lineNumber: newLine, preview: line[1:]}
+			oldLine++
+			newLine++
+		}
+	}
+	return lines
+}
+
+// parseHunkHeader returns the starting line numbers of a hunk header of the
+// form "@@ -oldStart[,oldCount] +newStart[,newCount] @@".
+func parseHunkHeader(line string) (oldStart, newStart int, ok bool) {
+	fields := strings.Fields(line)
+	if len(fields) < 3 {
+		return 0, 0, false
+	}
+	oldStart, ok1 := parseHunkStart(fields[1], "-")
+	newStart, ok2 := parseHunkStart(fields[2], "+")
+	return oldStart, newStart, ok1 && ok2
+}
+
+func parseHunkStart(field, prefix string) (int, bool) {
+	if !strings.HasPrefix(field, prefix) {
+		return 0, false
+	}
+	field = strings.TrimPrefix(field, prefix)
+	if i := strings.Index(field, ","); i >= 0 {
+		field = field[:i]
+	}
+	n, err := strconv.Atoi(field)
+	return n, err == nil
+}
+
+// maxPreviewLength is the maximum number of characters of a match's preview
+// listed in a notification.
+const maxPreviewLength = 200
+
+// notifiedMatch is a new match as listed in a notification.
+type notifiedMatch struct {
+	Location string
+	Preview  string
+}
+
+// notifiedMatches returns the new matches to list in a notification, and the
+// number of new matches omitted from the list.
+func (n *notifier) notifiedMatches() (matches []notifiedMatch, more int) {
+	for i, match := range n.matches {
+		if i == maxNotifiedMatches {
+			return matches, len(n.matches) - i
+		}
+		matches = append(matches, notifiedMatch{
+			Location: matchLocation(match),
+			Preview:  truncatePreview(match.Preview),
+		})
+	}
+	return matches, 0
+}
+
+// matchLocation describes where the given match is, e.g.
+// "github.com/foo/bar@abcdef1 dir/file.go:12".
+func matchLocation(match *api.SavedQueryMatch) string {
+	location := match.Repository
+	if match.Commit != "" {
+		commit := match.Commit
+		if len(commit) > 7 {
+			commit = commit[:7]
+		}
+		location += "@" + commit
+	}
+	if match.Path == "" {
+		return fmt.Sprintf("%s (commit message line %d)", location, match.LineNumber)
+	}
+	return fmt.Sprintf("%s %s:%d", location, match.Path, match.LineNumber)
+}
+
+func truncatePreview(preview string) string {
+	preview = strings.TrimSpace(preview)
+	if runes := []rune(preview); len(runes) > maxPreviewLength {
+		return string(runes[:maxPreviewLength]) + "…"
+	}
+	return preview
+}
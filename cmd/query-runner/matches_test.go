@@ -0,0 +1,115 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+func TestExtractMatches(t *testing.T) {
+	const rawDiff = `diff --git a.go a.go
+index a29bdeb..c0d0fb4 100644
+--- a.go
++++ a.go
+@@ -10,3 +10,3 @@ func main() {
+ 	foo()
+-	bar()
++	baz()
+ }
+diff --git b.go b.go
+deleted file mode 100644
+index a29bdeb..0000000
+--- b.go
++++ /dev/null
+@@ -1,1 +0,0 @@
+-baz
+`
+
+	rawDiffJSON, err := json.Marshal(rawDiff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var results []interface{}
+	if err := json.Unmarshal([]byte(`[
+		{
+			"__typename": "FileMatch",
+			"repository": {"name": "r1"},
+			"file": {"path": "c.go"},
+			"lineMatches": [{"preview": "baz := 1", "lineNumber": 4}]
+		},
+		{
+			"__typename": "CommitSearchResult",
+			"commit": {"repository": {"name": "r2"}, "oid": "deadbeef"},
+			"diffPreview": {
+				"value": `+string(rawDiffJSON)+`,
+				"highlights": [
+					{"line": 8, "character": 1, "length": 3},
+					{"line": 16, "character": 1, "length": 3}
+				]
+			}
+		},
+		{
+			"__typename": "CommitSearchResult",
+			"commit": {"repository": {"name": "r3"}, "oid": "cafebabe"},
+			"messagePreview": {
+				"value": "subject\n\nbody with baz\nbaz again",
+				"highlights": [
+					{"line": 3, "character": 10, "length": 3},
+					{"line": 4, "character": 0, "length": 3},
+					{"line": 4, "character": 0, "length": 3}
+				]
+			}
+		},
+		{
+			"__typename": "CommitSearchResult",
+			"commit": {"repository": {"name": "r4"}, "oid": "f00"},
+			"messagePreview": {"value": "subject\n\nbody", "highlights": []}
+		}
+	]`), &results); err != nil {
+		t.Fatal(err)
+	}
+
+	matches, err := extractMatches(results)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*api.SavedQueryMatch{
+		{Repository: "r1", Path: "c.go", LineNumber: 5, Preview: "baz := 1"},
+		{Repository: "r2", Commit: "deadbeef", Path: "a.go", LineNumber: 11, Preview: "\tbaz()"},
+		{Repository: "r2", Commit: "deadbeef", Path: "b.go", LineNumber: 1, Preview: "baz"},
+		{Repository: "r3", Commit: "cafebabe", LineNumber: 3, Preview: "body with baz"},
+		{Repository: "r3", Commit: "cafebabe", LineNumber: 4, Preview: "baz again"},
+		{Repository: "r4", Commit: "f00", LineNumber: 1, Preview: "subject"},
+	}
+	if !reflect.DeepEqual(matches, want) {
+		got, _ := json.MarshalIndent(matches, "", "  ")
+		t.Errorf("got %s", got)
+	}
+}
+
+func TestNotifiedMatches(t *testing.T) {
+	n := &notifier{}
+	for i := 0; i < maxNotifiedMatches+3; i++ {
+		n.matches = append(n.matches, &api.SavedQueryMatch{
+			Repository: "r",
+			Commit:     "deadbeefcafe",
+			Path:       "a.go",
+			LineNumber: i + 1,
+			Preview:    "  foo()",
+		})
+	}
+
+	matches, more := n.notifiedMatches()
+	if len(matches) != maxNotifiedMatches {
+		t.Errorf("got %d matches, want %d", len(matches), maxNotifiedMatches)
+	}
+	if more != 3 {
+		t.Errorf("got %d more matches, want %d", more, 3)
+	}
+	if want := (notifiedMatch{Location: "r@deadbee a.go:1", Preview: "foo()"}); matches[0] != want {
+		t.Errorf("got %+v, want %+v", matches[0], want)
+	}
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/inconshreveable/log15"
 
@@ -22,6 +23,13 @@ func (n *notifier) slackNotify(ctx context.Context) {
 		searchURL(n.newQuery, utmSourceSlack),
 		n.query.Description,
 	)
+	matches, moreMatches := n.notifiedMatches()
+	for _, match := range matches {
+		text += fmt.Sprintf("\n• %s\n> `%s`", slackEscape(match.Location), slackEscape(match.Preview))
+	}
+	if moreMatches > 0 {
+		text += fmt.Sprintf("\n…and %d more", moreMatches)
+	}
 	for _, recipient := range n.recipients {
 		if err := slackNotify(ctx, recipient, text, n.query.SlackWebhookURL); err != nil {
 			log15.Error("Failed to post Slack notification message.", "recipient", recipient, "text", text, "error", err)
@@ -57,6 +65,14 @@ func slackNotifyUnsubscribed(ctx context.Context, recipient *recipient, query ap
 	return nil
 }
 
+// slackEscape escapes the characters that Slack interprets as control
+// sequences in message text.
+func slackEscape(s string) string {
+	return slackEscaper.Replace(s)
+}
+
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "`", "'")
+
 func slackNotify(ctx context.Context, recipient *recipient, text string, slackWebhookURL *string) error {
 	if !recipient.slack {
 		return nil
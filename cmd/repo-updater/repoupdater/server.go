@@ -23,6 +23,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/repos"
 	"github.com/sourcegraph/sourcegraph/internal/repoupdater/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/search/typeahead"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 )
@@ -63,6 +64,9 @@ type Server struct {
 		// ScheduleRepos schedules new permissions syncing requests for given repositories.
 		ScheduleRepos(ctx context.Context, repoIDs ...api.RepoID)
 	}
+	// Typeahead indexes repository names and recently viewed files for
+	// typeahead suggestions. It is kept up to date by the syncer.
+	Typeahead *typeahead.Index
 }
 
 // Handler returns the http.Handler that should be used to serve requests.
@@ -75,8 +79,12 @@ func (s *Server) Handler() http.Handler {
 	mux.HandleFunc("/repo-lookup", s.handleRepoLookup)
 	mux.HandleFunc("/enqueue-repo-update", s.handleEnqueueRepoUpdate)
 	mux.HandleFunc("/sync-external-service", s.handleExternalServiceSync)
+	mux.HandleFunc("/preview-external-service-sync", s.handleExternalServiceSyncPreview)
 	mux.HandleFunc("/enqueue-changeset-sync", s.handleEnqueueChangesetSync)
 	mux.HandleFunc("/schedule-perms-sync", s.handleSchedulePermsSync)
+	mux.HandleFunc("/typeahead", s.handleTypeahead)
+	mux.HandleFunc("/typeahead-record-files", s.handleTypeaheadRecordFiles)
+	mux.HandleFunc("/webhooks", s.handleWebhook)
 	return mux
 }
 
@@ -251,6 +259,51 @@ func (s *Server) handleExternalServiceSync(w http.ResponseWriter, r *http.Reques
 	})
 }
 
+func (s *Server) handleExternalServiceSyncPreview(w http.ResponseWriter, r *http.Request) {
+	var req protocol.ExternalServiceSyncPreviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	preview, err := s.Syncer.PreviewExternalServiceSync(r.Context(), &types.ExternalService{
+		ID:              req.ExternalService.ID,
+		Kind:            req.ExternalService.Kind,
+		DisplayName:     req.ExternalService.DisplayName,
+		Config:          req.ExternalService.Config,
+		NamespaceUserID: req.ExternalService.NamespaceUserID,
+	})
+	if err != nil {
+		if r.Context().Err() != nil {
+			http.Error(w, "request canceled", http.StatusGatewayTimeout)
+			return
+		}
+		log15.Error("server.preview-external-service-sync", "kind", req.ExternalService.Kind, "error", err)
+		respond(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	result := &protocol.ExternalServiceSyncPreviewResult{
+		Added:      repoNames(preview.Added),
+		Removed:    repoNames(preview.Removed),
+		Excluded:   repoNames(preview.Excluded),
+		Renamed:    make([]protocol.RepoRename, 0, len(preview.Renamed)),
+		Unmodified: preview.Unmodified,
+	}
+	for _, rename := range preview.Renamed {
+		result.Renamed = append(result.Renamed, protocol.RepoRename{From: rename.From, To: rename.To})
+	}
+	respond(w, http.StatusOK, result)
+}
+
+func repoNames(rs types.Repos) []api.RepoName {
+	names := make([]api.RepoName, 0, len(rs))
+	for _, r := range rs {
+		names = append(names, r.Name)
+	}
+	return names
+}
+
 func externalServiceValidate(ctx context.Context, req protocol.ExternalServiceSyncRequest, src repos.Source) error {
 	if !req.ExternalService.DeletedAt.IsZero() {
 		// We don't need to check deleted services.
@@ -503,6 +556,60 @@ func (s *Server) handleSchedulePermsSync(w http.ResponseWriter, r *http.Request)
 	respond(w, http.StatusOK, nil)
 }
 
+// maxTypeaheadSuggestions is the maximum number of suggestions returned for a
+// typeahead request.
+const maxTypeaheadSuggestions = 500
+
+func (s *Server) handleTypeahead(w http.ResponseWriter, r *http.Request) {
+	if s.Typeahead == nil {
+		respond(w, http.StatusNotFound, errors.New("typeahead index is not available"))
+		return
+	}
+
+	var req protocol.TypeaheadRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respond(w, http.StatusBadRequest, err)
+		return
+	}
+	if req.Limit <= 0 || req.Limit > maxTypeaheadSuggestions {
+		req.Limit = maxTypeaheadSuggestions
+	}
+
+	matches := s.Typeahead.Search(req.Query, req.Limit)
+	result := protocol.TypeaheadResult{Suggestions: make([]protocol.TypeaheadSuggestion, 0, len(matches))}
+	for _, m := range matches {
+		result.Suggestions = append(result.Suggestions, protocol.TypeaheadSuggestion{
+			Kind:     string(m.Kind),
+			RepoID:   m.RepoID,
+			RepoName: m.RepoName,
+			CommitID: m.CommitID,
+			Path:     m.Path,
+			Score:    m.Score,
+		})
+	}
+
+	respond(w, http.StatusOK, result)
+}
+
+func (s *Server) handleTypeaheadRecordFiles(w http.ResponseWriter, r *http.Request) {
+	if s.Typeahead == nil {
+		respond(w, http.StatusNotFound, errors.New("typeahead index is not available"))
+		return
+	}
+
+	var req protocol.TypeaheadRecordFilesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respond(w, http.StatusBadRequest, err)
+		return
+	}
+
+	for _, f := range req.Files {
+		s.Typeahead.AddFile(f.RepoID, f.CommitID, f.Path)
+	}
+
+	respond(w, http.StatusOK, nil)
+}
+
 func newRepoInfo(r *types.Repo) (*protocol.RepoInfo, error) {
 	urls := r.CloneURLs()
 	if len(urls) == 0 {
@@ -30,6 +30,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/repos"
 	"github.com/sourcegraph/sourcegraph/internal/repoupdater"
 	"github.com/sourcegraph/sourcegraph/internal/repoupdater/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/search/typeahead"
 	"github.com/sourcegraph/sourcegraph/internal/timeutil"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 	"github.com/sourcegraph/sourcegraph/internal/types"
@@ -830,6 +831,44 @@ func TestServer_handleSchedulePermsSync(t *testing.T) {
 	}
 }
 
+func TestServer_handleTypeahead(t *testing.T) {
+	s := &Server{Typeahead: typeahead.NewIndex(typeahead.DefaultMaxFilesPerRepo)}
+	s.Typeahead.UpsertRepo(1, "github.com/gorilla/mux")
+	s.Typeahead.UpsertRepo(2, "github.com/sourcegraph/sourcegraph")
+
+	w := httptest.NewRecorder()
+	s.handleTypeaheadRecordFiles(w, httptest.NewRequest("POST", "/typeahead-record-files", strings.NewReader(
+		`{"Files": [{"RepoID": 2, "CommitID": "deadbeef", "Path": "cmd/frontend/mux.go"}, {"RepoID": 3, "Path": "unknown.go"}]}`,
+	)))
+	if w.Code != http.StatusOK {
+		t.Fatalf("Code: want %v but got %v", http.StatusOK, w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	s.handleTypeahead(w, httptest.NewRequest("POST", "/typeahead", strings.NewReader(`{"Query": "mux", "Limit": 10}`)))
+	if w.Code != http.StatusOK {
+		t.Fatalf("Code: want %v but got %v", http.StatusOK, w.Code)
+	}
+
+	var have protocol.TypeaheadResult
+	if err := json.Unmarshal(w.Body.Bytes(), &have); err != nil {
+		t.Fatal(err)
+	}
+	want := protocol.TypeaheadResult{Suggestions: []protocol.TypeaheadSuggestion{
+		{Kind: "repo", RepoID: 1, RepoName: "github.com/gorilla/mux", Score: 5},
+		{Kind: "file", RepoID: 2, RepoName: "github.com/sourcegraph/sourcegraph", CommitID: "deadbeef", Path: "cmd/frontend/mux.go", Score: 4},
+	}}
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Fatalf("Result mismatch (-want +got):\n%s", diff)
+	}
+
+	w = httptest.NewRecorder()
+	(&Server{}).handleTypeahead(w, httptest.NewRequest("POST", "/typeahead", strings.NewReader(`{"Query": "mux"}`)))
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("Code: want %v but got %v", http.StatusNotFound, w.Code)
+	}
+}
+
 func TestExternalServiceValidate_ValidatesToken(t *testing.T) {
 	var (
 		src    repos.Source
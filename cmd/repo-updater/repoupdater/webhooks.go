@@ -0,0 +1,350 @@
+package repoupdater
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	gh "github.com/google/go-github/v28/github"
+	"github.com/inconshreveable/log15"
+	otlog "github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	gitlabwebhooks "github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab/webhooks"
+	"github.com/sourcegraph/sourcegraph/internal/repoupdater/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// errInvalidWebhookSecret is returned for webhook requests that are not
+// authenticated by any of the configured secrets.
+var errInvalidWebhookSecret = errors.New("invalid webhook secret")
+
+// webhookEvent is a code host event about changes to the contents of
+// repositories.
+type webhookEvent struct {
+	// externalIDs are the external IDs of the changed repositories.
+	externalIDs []string
+	// created is true if the repositories were just created, in which case
+	// they are only known after their external service is synced.
+	created bool
+}
+
+// handleWebhook updates repositories as soon as a webhook notifies us of a
+// change, instead of waiting for the next update interval.
+//
+// Requests with an externalServiceID parameter are webhook events of the code
+// host of that external service, and are authenticated with its webhook
+// secrets. Requests without it are generic webhooks with a
+// protocol.WebhookRequest payload, and are authenticated with the
+// repoUpdateWebhookSecret site configuration.
+func (s *Server) handleWebhook(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		respond(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var (
+		result *protocol.WebhookResult
+		status int
+	)
+	if rawID := r.FormValue(extsvc.IDParam); rawID != "" {
+		result, status, err = s.handleCodeHostWebhook(r.Context(), r.Header, rawID, body)
+	} else {
+		result, status, err = s.handleGenericWebhook(r.Context(), r.Header, body)
+	}
+	if err != nil {
+		respond(w, status, err)
+		return
+	}
+	respond(w, status, result)
+}
+
+func (s *Server) handleGenericWebhook(ctx context.Context, header http.Header, body []byte) (*protocol.WebhookResult, int, error) {
+	secret := conf.Get().RepoUpdateWebhookSecret
+	if secret == "" {
+		return nil, http.StatusNotFound, errors.New("generic webhooks are disabled, set repoUpdateWebhookSecret in the site configuration to enable them")
+	}
+	// 🚨 SECURITY: Compare in constant time to not leak the secret.
+	token := strings.TrimPrefix(header.Get("Authorization"), "token ")
+	if subtle.ConstantTimeCompare([]byte(token), []byte(secret)) != 1 {
+		return nil, http.StatusUnauthorized, errInvalidWebhookSecret
+	}
+
+	var req protocol.WebhookRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	names := make([]string, 0, len(req.Repos))
+	for _, name := range req.Repos {
+		names = append(names, string(name))
+	}
+	return s.updateFromWebhook(ctx, database.ReposListOptions{Names: names}, req.ExternalServiceIDs)
+}
+
+func (s *Server) handleCodeHostWebhook(ctx context.Context, header http.Header, rawID string, body []byte) (*protocol.WebhookResult, int, error) {
+	id, err := strconv.ParseInt(rawID, 10, 64)
+	if err != nil {
+		return nil, http.StatusBadRequest, errors.Wrap(err, "invalid external service id")
+	}
+	svc, err := s.Store.ExternalServiceStore.GetByID(ctx, id)
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return nil, http.StatusNotFound, err
+		}
+		return nil, http.StatusInternalServerError, err
+	}
+
+	ev, serviceType, baseURL, err := parseCodeHostWebhook(svc, header, body)
+	if errors.Is(err, errInvalidWebhookSecret) {
+		return nil, http.StatusUnauthorized, err
+	} else if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	if ev == nil {
+		// Events that do not change repositories, like pings, are acknowledged
+		// but ignored.
+		return &protocol.WebhookResult{Repos: []api.RepoName{}, ExternalServiceIDs: []int64{}}, http.StatusOK, nil
+	}
+
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	serviceID := extsvc.NormalizeBaseURL(u).String()
+
+	specs := make([]api.ExternalRepoSpec, 0, len(ev.externalIDs))
+	for _, externalID := range ev.externalIDs {
+		specs = append(specs, api.ExternalRepoSpec{
+			ID:          externalID,
+			ServiceType: serviceType,
+			ServiceID:   serviceID,
+		})
+	}
+
+	var sync []int64
+	if ev.created {
+		sync = append(sync, svc.ID)
+	}
+	result, status, err := s.updateFromWebhook(ctx, database.ReposListOptions{ExternalRepos: specs}, sync)
+	if err != nil || ev.created || len(result.Repos) == len(specs) {
+		return result, status, err
+	}
+
+	// Some repositories are unknown, most likely because they were added to
+	// the code host since the last sync.
+	if err := s.Syncer.TriggerExternalServiceSync(ctx, svc.ID); err != nil {
+		return nil, http.StatusInternalServerError, errors.Wrap(err, "enqueueing external service sync")
+	}
+	result.ExternalServiceIDs = append(result.ExternalServiceIDs, svc.ID)
+	return result, status, nil
+}
+
+// updateFromWebhook schedules an update of the repositories matched by opts,
+// and a sync of the given external services.
+func (s *Server) updateFromWebhook(ctx context.Context, opts database.ReposListOptions, externalServiceIDs []int64) (result *protocol.WebhookResult, status int, err error) {
+	tr, ctx := trace.New(ctx, "updateFromWebhook", "")
+	defer func() {
+		if result != nil {
+			tr.LogFields(
+				otlog.Int("repos", len(result.Repos)),
+				otlog.Int("externalServices", len(result.ExternalServiceIDs)),
+			)
+		}
+		tr.SetError(err)
+		tr.Finish()
+	}()
+
+	result = &protocol.WebhookResult{
+		Repos:              []api.RepoName{},
+		ExternalServiceIDs: []int64{},
+	}
+
+	if len(opts.Names) > 0 || len(opts.ExternalRepos) > 0 {
+		rs, err := s.Store.RepoStore.List(ctx, opts)
+		if err != nil {
+			return nil, http.StatusInternalServerError, errors.Wrap(err, "store.list-repos")
+		}
+		for _, repo := range rs {
+			s.Scheduler.UpdateOnce(repo.ID, repo.Name)
+			result.Repos = append(result.Repos, repo.Name)
+		}
+	}
+
+	for _, id := range externalServiceIDs {
+		if err := s.Syncer.TriggerExternalServiceSync(ctx, id); err != nil {
+			return nil, http.StatusInternalServerError, errors.Wrap(err, "enqueueing external service sync")
+		}
+		result.ExternalServiceIDs = append(result.ExternalServiceIDs, id)
+	}
+
+	log15.Debug("repo-updater webhook", "repos", result.Repos, "externalServices", result.ExternalServiceIDs)
+	return result, http.StatusOK, nil
+}
+
+// parseCodeHostWebhook authenticates a webhook request of the code host of
+// svc, and returns the event it contains along with the service type and
+// base URL of the code host. It returns a nil event for events that do not
+// change repositories.
+func parseCodeHostWebhook(svc *types.ExternalService, header http.Header, body []byte) (_ *webhookEvent, serviceType, baseURL string, err error) {
+	cfg, err := svc.Configuration()
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	switch c := cfg.(type) {
+	case *schema.GitHubConnection:
+		// 🚨 SECURITY: The payload must be signed with one of the secrets of
+		// the external service.
+		var secrets []string
+		for _, hook := range c.Webhooks {
+			secrets = append(secrets, hook.Secret)
+		}
+		if !validSignature(header.Get("X-Hub-Signature"), body, secrets) {
+			return nil, "", "", errInvalidWebhookSecret
+		}
+		ev, err := parseGitHubWebhook(header.Get("X-Github-Event"), body)
+		return ev, extsvc.TypeGitHub, c.Url, err
+
+	case *schema.GitLabConnection:
+		// 🚨 SECURITY: GitLab sends the secret itself, so it must be compared
+		// in constant time to not leak it.
+		token := header.Get(gitlabwebhooks.TokenHeaderName)
+		valid := false
+		for _, hook := range c.Webhooks {
+			if hook.Secret != "" && subtle.ConstantTimeCompare([]byte(token), []byte(hook.Secret)) == 1 {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return nil, "", "", errInvalidWebhookSecret
+		}
+		ev, err := parseGitLabWebhook(body)
+		return ev, extsvc.TypeGitLab, c.Url, err
+
+	case *schema.BitbucketServerConnection:
+		// 🚨 SECURITY: The payload must be signed with the secret of the
+		// external service.
+		if !validSignature(header.Get("X-Hub-Signature"), body, []string{c.WebhookSecret()}) {
+			return nil, "", "", errInvalidWebhookSecret
+		}
+		ev, err := parseBitbucketServerWebhook(header.Get("X-Event-Key"), body)
+		return ev, extsvc.TypeBitbucketServer, c.Url, err
+
+	default:
+		return nil, "", "", errors.Errorf("webhooks are not supported for external services of kind %s", svc.Kind)
+	}
+}
+
+// validSignature returns whether sig is a valid signature of payload with any
+// of the non-empty secrets.
+func validSignature(sig string, payload []byte, secrets []string) bool {
+	for _, secret := range secrets {
+		if secret == "" {
+			continue
+		}
+		if err := gh.ValidateSignature(sig, payload, []byte(secret)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// parseGitHubWebhook parses the push and repository events of GitHub.
+func parseGitHubWebhook(eventType string, body []byte) (*webhookEvent, error) {
+	var payload struct {
+		Action     string `json:"action"`
+		Repository struct {
+			NodeID string `json:"node_id"`
+		} `json:"repository"`
+	}
+
+	switch eventType {
+	case "push", "repository":
+	default:
+		return nil, nil
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	if payload.Repository.NodeID == "" {
+		return nil, errors.New("missing repository in GitHub webhook payload")
+	}
+
+	ev := &webhookEvent{externalIDs: []string{payload.Repository.NodeID}}
+	if eventType == "repository" {
+		switch payload.Action {
+		case "created", "transferred", "renamed", "publicized", "privatized":
+			// Repositories changed in these ways are only picked up by a sync.
+			ev.created = true
+		default:
+			return nil, nil
+		}
+	}
+	return ev, nil
+}
+
+// parseGitLabWebhook parses the push events of GitLab projects, and the push
+// and project creation events of GitLab system hooks.
+func parseGitLabWebhook(body []byte) (*webhookEvent, error) {
+	var payload struct {
+		ObjectKind string `json:"object_kind"`
+		EventName  string `json:"event_name"`
+		ProjectID  int    `json:"project_id"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+
+	ev := &webhookEvent{externalIDs: []string{strconv.Itoa(payload.ProjectID)}}
+	switch {
+	case payload.EventName == "project_create":
+		ev.created = true
+	case payload.ObjectKind == "push", payload.ObjectKind == "tag_push", payload.EventName == "repository_update":
+	default:
+		return nil, nil
+	}
+	if payload.ProjectID == 0 {
+		return nil, errors.New("missing project in GitLab webhook payload")
+	}
+	return ev, nil
+}
+
+// parseBitbucketServerWebhook parses the push events of Bitbucket Server.
+func parseBitbucketServerWebhook(eventType string, body []byte) (*webhookEvent, error) {
+	if eventType != "repo:refs_changed" {
+		return nil, nil
+	}
+
+	var payload struct {
+		Repository struct {
+			ID int `json:"id"`
+		} `json:"repository"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	if payload.Repository.ID == 0 {
+		return nil, errors.New("missing repository in Bitbucket Server webhook payload")
+	}
+	return &webhookEvent{externalIDs: []string{strconv.Itoa(payload.Repository.ID)}}, nil
+}
@@ -0,0 +1,160 @@
+package repoupdater
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/hex"
+	"net/http"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestParseCodeHostWebhook(t *testing.T) {
+	sign := func(secret, payload string) string {
+		mac := hmac.New(sha1.New, []byte(secret))
+		mac.Write([]byte(payload))
+		return "sha1=" + hex.EncodeToString(mac.Sum(nil))
+	}
+
+	githubSvc := &types.ExternalService{
+		Kind:   extsvc.KindGitHub,
+		Config: `{"url": "https://github.com", "token": "abc", "repos": ["owner/name"], "webhooks": [{"org": "owner", "secret": "github-secret"}]}`,
+	}
+	gitlabSvc := &types.ExternalService{
+		Kind:   extsvc.KindGitLab,
+		Config: `{"url": "https://gitlab.com", "token": "abc", "projectQuery": ["none"], "webhooks": [{"secret": "gitlab-secret"}]}`,
+	}
+	bbsSvc := &types.ExternalService{
+		Kind:   extsvc.KindBitbucketServer,
+		Config: `{"url": "https://bitbucket.example.com", "token": "abc", "username": "admin", "repos": ["PROJ/repo"], "plugin": {"webhooks": {"secret": "bbs-secret"}}}`,
+	}
+
+	const (
+		githubPush    = `{"ref": "refs/heads/main", "repository": {"node_id": "MDEwOlJlcG9zaXRvcnkx", "full_name": "owner/name"}}`
+		githubCreated = `{"action": "created", "repository": {"node_id": "MDEwOlJlcG9zaXRvcnky", "full_name": "owner/new"}}`
+		githubDeleted = `{"action": "deleted", "repository": {"node_id": "MDEwOlJlcG9zaXRvcnky", "full_name": "owner/new"}}`
+		gitlabPush    = `{"object_kind": "push", "project_id": 15}`
+		gitlabCreated = `{"event_name": "project_create", "project_id": 74}`
+		bbsPush       = `{"eventKey": "repo:refs_changed", "repository": {"id": 7, "slug": "repo"}}`
+	)
+
+	cases := []struct {
+		name            string
+		svc             *types.ExternalService
+		header          http.Header
+		body            string
+		want            *webhookEvent
+		wantServiceType string
+		wantErr         error
+	}{
+		{
+			name: "GitHub push",
+			svc:  githubSvc,
+			header: http.Header{
+				"X-Github-Event":  {"push"},
+				"X-Hub-Signature": {sign("github-secret", githubPush)},
+			},
+			body:            githubPush,
+			want:            &webhookEvent{externalIDs: []string{"MDEwOlJlcG9zaXRvcnkx"}},
+			wantServiceType: extsvc.TypeGitHub,
+		},
+		{
+			name: "GitHub repository created",
+			svc:  githubSvc,
+			header: http.Header{
+				"X-Github-Event":  {"repository"},
+				"X-Hub-Signature": {sign("github-secret", githubCreated)},
+			},
+			body:            githubCreated,
+			want:            &webhookEvent{externalIDs: []string{"MDEwOlJlcG9zaXRvcnky"}, created: true},
+			wantServiceType: extsvc.TypeGitHub,
+		},
+		{
+			name: "GitHub repository deleted is ignored",
+			svc:  githubSvc,
+			header: http.Header{
+				"X-Github-Event":  {"repository"},
+				"X-Hub-Signature": {sign("github-secret", githubDeleted)},
+			},
+			body:            githubDeleted,
+			wantServiceType: extsvc.TypeGitHub,
+		},
+		{
+			name: "GitHub invalid signature",
+			svc:  githubSvc,
+			header: http.Header{
+				"X-Github-Event":  {"push"},
+				"X-Hub-Signature": {sign("wrong-secret", githubPush)},
+			},
+			body:    githubPush,
+			wantErr: errInvalidWebhookSecret,
+		},
+		{
+			name:            "GitLab push",
+			svc:             gitlabSvc,
+			header:          http.Header{"X-Gitlab-Token": {"gitlab-secret"}},
+			body:            gitlabPush,
+			want:            &webhookEvent{externalIDs: []string{"15"}},
+			wantServiceType: extsvc.TypeGitLab,
+		},
+		{
+			name:            "GitLab project created",
+			svc:             gitlabSvc,
+			header:          http.Header{"X-Gitlab-Token": {"gitlab-secret"}},
+			body:            gitlabCreated,
+			want:            &webhookEvent{externalIDs: []string{"74"}, created: true},
+			wantServiceType: extsvc.TypeGitLab,
+		},
+		{
+			name:    "GitLab invalid token",
+			svc:     gitlabSvc,
+			header:  http.Header{"X-Gitlab-Token": {"wrong-secret"}},
+			body:    gitlabPush,
+			wantErr: errInvalidWebhookSecret,
+		},
+		{
+			name: "Bitbucket Server push",
+			svc:  bbsSvc,
+			header: http.Header{
+				"X-Event-Key":     {"repo:refs_changed"},
+				"X-Hub-Signature": {sign("bbs-secret", bbsPush)},
+			},
+			body:            bbsPush,
+			want:            &webhookEvent{externalIDs: []string{"7"}},
+			wantServiceType: extsvc.TypeBitbucketServer,
+		},
+		{
+			name:    "Bitbucket Server missing signature",
+			svc:     bbsSvc,
+			header:  http.Header{"X-Event-Key": {"repo:refs_changed"}},
+			body:    bbsPush,
+			wantErr: errInvalidWebhookSecret,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ev, serviceType, _, err := parseCodeHostWebhook(tc.svc, tc.header, []byte(tc.body))
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("got error %v, want %v", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if serviceType != tc.wantServiceType {
+				t.Errorf("got service type %q, want %q", serviceType, tc.wantServiceType)
+			}
+			if diff := cmp.Diff(tc.want, ev, cmp.AllowUnexported(webhookEvent{})); diff != "" {
+				t.Errorf("unexpected event (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
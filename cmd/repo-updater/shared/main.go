@@ -40,6 +40,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/profiler"
 	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
 	"github.com/sourcegraph/sourcegraph/internal/repos"
+	"github.com/sourcegraph/sourcegraph/internal/search/typeahead"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 	"github.com/sourcegraph/sourcegraph/internal/trace/ot"
 	"github.com/sourcegraph/sourcegraph/internal/tracer"
@@ -248,7 +249,14 @@ func Main(enterpriseInit EnterpriseInit) {
 		syncer.SubsetSynced = make(chan repos.Diff)
 	}
 
-	go watchSyncer(ctx, syncer, scheduler, gps)
+	// Load the typeahead index before the syncer runs, so that its diffs are
+	// applied on top of the stored repositories.
+	server.Typeahead = typeahead.NewIndex(typeahead.DefaultMaxFilesPerRepo)
+	if err := loadTypeaheadIndex(ctx, store, server.Typeahead); err != nil {
+		log15.Error("Loading typeahead index", "error", err)
+	}
+
+	go watchSyncer(ctx, syncer, scheduler, gps, server.Typeahead)
 	go func() {
 		log.Fatal(syncer.Run(ctx, db, store, repos.RunOptions{
 			EnqueueInterval: repos.ConfRepoListUpdateInterval,
@@ -408,9 +416,12 @@ type scheduler interface {
 
 	// EnsureScheduled ensures that all the repos provided are known to the scheduler
 	EnsureScheduled([]types.RepoName)
+
+	// SetDemand ensures that actively used repos are given priority in the scheduler.
+	SetDemand(map[api.RepoID]float64)
 }
 
-func watchSyncer(ctx context.Context, syncer *repos.Syncer, sched scheduler, gps *repos.GitolitePhabricatorMetadataSyncer) {
+func watchSyncer(ctx context.Context, syncer *repos.Syncer, sched scheduler, gps *repos.GitolitePhabricatorMetadataSyncer, ix *typeahead.Index) {
 	log15.Debug("started new repo syncer updates scheduler relay thread")
 
 	for {
@@ -418,6 +429,7 @@ func watchSyncer(ctx context.Context, syncer *repos.Syncer, sched scheduler, gps
 		case <-ctx.Done():
 			return
 		case diff := <-syncer.Synced:
+			updateTypeaheadIndex(ix, diff)
 			if !conf.Get().DisableAutoGitUpdates {
 				sched.UpdateFromDiff(diff)
 			}
@@ -431,6 +443,7 @@ func watchSyncer(ctx context.Context, syncer *repos.Syncer, sched scheduler, gps
 			}()
 
 		case diff := <-syncer.SubsetSynced:
+			updateTypeaheadIndex(ix, diff)
 			if !conf.Get().DisableAutoGitUpdates {
 				sched.UpdateFromDiff(diff)
 			}
@@ -438,11 +451,40 @@ func watchSyncer(ctx context.Context, syncer *repos.Syncer, sched scheduler, gps
 	}
 }
 
+// loadTypeaheadIndex adds all stored repositories to the typeahead index.
+func loadTypeaheadIndex(ctx context.Context, store *repos.Store, ix *typeahead.Index) error {
+	names, err := database.ReposWith(store).ListRepoNames(ctx, database.ReposListOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, r := range names {
+		ix.UpsertRepo(r.ID, r.Name)
+	}
+	log15.Debug("loaded typeahead index", "repos", len(names))
+	return nil
+}
+
+// updateTypeaheadIndex applies the repositories added, renamed and deleted by
+// a sync to the typeahead index.
+func updateTypeaheadIndex(ix *typeahead.Index, diff repos.Diff) {
+	for _, r := range diff.Deleted {
+		ix.DeleteRepo(r.ID)
+	}
+	for _, rs := range []types.Repos{diff.Added, diff.Modified} {
+		for _, r := range rs {
+			ix.UpsertRepo(r.ID, r.Name)
+		}
+	}
+}
+
 // syncScheduler will periodically list the cloned repositories on gitserver and
 // update the scheduler with the list. It also ensures that if any of our default
-// repos are missing from the cloned list they will be added for cloning ASAP.
+// repos are missing from the cloned list they will be added for cloning ASAP, and
+// updates the scheduler with the demand of repos.
 func syncScheduler(ctx context.Context, sched scheduler, gitserverClient *gitserver.Client, store *repos.Store) {
 	baseRepoStore := database.ReposWith(store)
+	demandStore := database.RepoDemandWith(store)
 
 	doSync := func() {
 		// Don't modify the scheduler if we're not performing auto updates
@@ -464,6 +506,16 @@ func syncScheduler(ctx context.Context, sched scheduler, gitserverClient *gitser
 			sched.EnsureScheduled(u)
 		}
 
+		if err := demandStore.Prune(ctx); err != nil {
+			log15.Warn("failed to prune repo demand", "error", err)
+		}
+		if demand, err := demandStore.Scores(ctx); err != nil {
+			log15.Warn("failed to fetch repo demand", "error", err)
+		} else {
+			// Ensure that actively used repos are updated more often
+			sched.SetDemand(demand)
+		}
+
 		// TODO: Now that we store sync state in the DB maybe we should query from there
 		// instead of gitserver?
 		cloned, err := gitserverClient.ListCloned(ctx)
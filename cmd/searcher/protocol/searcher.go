@@ -93,6 +93,15 @@ type PatternInfo struct {
 	// glob or Go regexp that represents multiple such patterns ANDed together.
 	IncludePatterns []string
 
+	// ExcludeContentPatterns is a list of regular expressions that may not
+	// match the returned files' content. They come from -content: filters.
+	ExcludeContentPatterns []string
+
+	// IncludeContentPatterns is a list of regular expressions that must *all*
+	// match the returned files' content. They come from file:contains()
+	// predicates.
+	IncludeContentPatterns []string
+
 	// IncludeExcludePatternAreRegExps indicates that ExcludePattern, IncludePattern,
 	// and IncludePatterns are regular expressions (not globs).
 	PathPatternsAreRegExps bool
@@ -112,7 +121,9 @@ type PatternInfo struct {
 	// considered a match.
 	PatternMatchesPath bool
 
-	// Languages is the languages passed via the lang filters (e.g., "lang:c")
+	// Languages is the languages passed via the lang filters (e.g., "lang:c").
+	// Files without an extension are matched against them by their content,
+	// in addition to the IncludePatterns derived from them.
 	Languages []string
 
 	// CombyRule is a rule that constrains matching for structural search.
@@ -122,6 +133,11 @@ type PatternInfo struct {
 	// file list in the frontend and passes it to searcher.
 	CombyRule string
 
+	// CombyRewrite is the template that structural matches are rewritten to.
+	// If set, a preview of the rewrite of each match is returned in
+	// FileMatch.Rewrites. It only applies when IsStructuralPat is true.
+	CombyRewrite string
+
 	// Select is the value of the the select field in the query. It is not necessary to
 	// use it since selection is done after the query completes, but exposing it can enable
 	// optimizations.
@@ -139,6 +155,9 @@ func (p *PatternInfo) String() string {
 		} else {
 			args = append(args, "comby")
 		}
+		if p.CombyRewrite != "" {
+			args = append(args, fmt.Sprintf("replace:%q", p.CombyRewrite))
+		}
 	}
 	if p.IsWordMatch {
 		args = append(args, "word")
@@ -175,6 +194,12 @@ func (p *PatternInfo) String() string {
 	for _, inc := range p.IncludePatterns {
 		args = append(args, fmt.Sprintf("%s:%q", path, inc))
 	}
+	for _, exc := range p.ExcludeContentPatterns {
+		args = append(args, fmt.Sprintf("-content:%q", exc))
+	}
+	for _, inc := range p.IncludeContentPatterns {
+		args = append(args, fmt.Sprintf("file:contains(%q)", inc))
+	}
 
 	return fmt.Sprintf("PatternInfo{%s}", strings.Join(args, ","))
 }
@@ -199,6 +224,24 @@ type FileMatch struct {
 
 	// LimitHit is true if LineMatches may not include all LineMatches.
 	LimitHit bool
+
+	// Rewrites are the rewrites of the structural matches in the file, if
+	// PatternInfo.CombyRewrite is set.
+	Rewrites []Rewrite
+}
+
+// Rewrite is a structural match and the content it is rewritten to.
+type Rewrite struct {
+	// StartLine and EndLine are the 0-based lines the match starts and ends
+	// on.
+	StartLine int
+	EndLine   int
+
+	// Matched is the matched content.
+	Matched string
+
+	// Replacement is the content the match is rewritten to.
+	Replacement string
 }
 
 // LineMatch is the struct used by vscode to receive search results for a line.
@@ -0,0 +1,108 @@
+package search
+
+import (
+	"path"
+
+	"github.com/go-enry/go-enry/v2"
+
+	"github.com/sourcegraph/sourcegraph/cmd/searcher/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/pathmatch"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/store"
+)
+
+// maxLanguageDetectionBytes is the number of leading bytes of a file that are
+// inspected to detect its language. Shebangs and modelines are expected near
+// the start of a file, so this bounds the cost of detection without missing
+// them in practice.
+const maxLanguageDetectionBytes = 4 * 1024
+
+// languageMatcher matches files without an extension by their content. The
+// lang: filters of a query are converted to include patterns on file
+// extensions (see search.LangToFileRegexp), which by construction never match
+// scripts such as bin/deploy.
+type languageMatcher struct {
+	// languages are the canonical names of the languages to match.
+	languages []string
+
+	// matchPath is compiled from the include/exclude path patterns, except for
+	// those derived from lang: filters.
+	matchPath pathmatch.PathMatcher
+}
+
+// compileLanguageMatcher returns a languageMatcher for the languages of p, or
+// nil if p has no languages.
+func compileLanguageMatcher(p *protocol.PatternInfo, pathOptions pathmatch.CompileOptions) (*languageMatcher, error) {
+	if len(p.Languages) == 0 || !p.PathPatternsAreRegExps {
+		return nil, nil
+	}
+
+	languages := make([]string, 0, len(p.Languages))
+	languagePatterns := make(map[string]struct{}, len(p.Languages))
+	for _, lang := range p.Languages {
+		name, ok := enry.GetLanguageByAlias(lang)
+		if !ok {
+			continue
+		}
+		languages = append(languages, name)
+		languagePatterns[search.LangToFileRegexp(lang)] = struct{}{}
+	}
+	if len(languages) == 0 {
+		return nil, nil
+	}
+
+	var includePatterns []string
+	for _, pattern := range p.IncludePatterns {
+		if _, ok := languagePatterns[pattern]; !ok {
+			includePatterns = append(includePatterns, pattern)
+		}
+	}
+
+	matchPath, err := pathmatch.CompilePathPatterns(includePatterns, p.ExcludePattern, pathOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &languageMatcher{languages: languages, matchPath: matchPath}, nil
+}
+
+// MatchFile returns true if f has no extension, matches the path patterns not
+// derived from lang: filters, and its content is detected as one of the
+// languages of m. A nil languageMatcher matches no files.
+func (m *languageMatcher) MatchFile(zf *store.ZipFile, f *store.SrcFile) bool {
+	if m == nil || path.Ext(f.Name) != "" || !m.matchPath.MatchPath(f.Name) {
+		return false
+	}
+
+	content := zf.DataFor(f)
+	if len(content) > maxLanguageDetectionBytes {
+		content = content[:maxLanguageDetectionBytes]
+	}
+
+	for _, detected := range detectLanguages(path.Base(f.Name), content) {
+		for _, lang := range m.languages {
+			if detected == lang {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// detectLanguages returns the languages of a file without an extension. Only
+// the deterministic strategies of enry are used (modelines, well-known file
+// names such as Makefile, and shebangs): the classifier would assign some
+// language to any text file.
+func detectLanguages(filename string, content []byte) []string {
+	if enry.IsBinary(content) {
+		return nil
+	}
+
+	if languages := enry.GetLanguagesByModeline(filename, content, nil); len(languages) > 0 {
+		return languages
+	}
+	if languages := enry.GetLanguagesByFilename(filename, content, nil); len(languages) > 0 {
+		return languages
+	}
+	return enry.GetLanguagesByShebang(filename, content, nil)
+}
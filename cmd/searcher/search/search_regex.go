@@ -16,6 +16,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/cmd/searcher/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/pathmatch"
+	"github.com/sourcegraph/sourcegraph/internal/search/backtrack"
 	"github.com/sourcegraph/sourcegraph/internal/store"
 	"github.com/sourcegraph/sourcegraph/internal/trace/ot"
 
@@ -43,6 +44,13 @@ type readerGrep struct {
 	// re is the regexp to match, or nil if empty ("match all files' content").
 	re *regexp.Regexp
 
+	// lookaround is set instead of re if the pattern contains lookahead or
+	// lookbehind assertions, which the stdlib regexp does not support. It is
+	// evaluated by a backtracking matcher whose work per file is bounded. A file
+	// which exceeds the budget is reported with the matches found so far and
+	// LimitHit set.
+	lookaround *backtrack.Regexp
+
 	// ignoreCase if true means we need to do case insensitive matching.
 	ignoreCase bool
 
@@ -56,6 +64,18 @@ type readerGrep struct {
 	// whether a file path matches (and should be searched).
 	matchPath pathmatch.PathMatcher
 
+	// matchLanguage matches files without an extension by their content
+	// against the languages of lang: filters. It is nil if there are none.
+	matchLanguage *languageMatcher
+
+	// excludeContent are compiled from the -content: filters. Files whose
+	// content matches any of them are not searched.
+	excludeContent []*regexp.Regexp
+
+	// includeContent are compiled from the file:contains() predicates. Files
+	// whose content does not match all of them are not searched.
+	includeContent []*regexp.Regexp
+
 	// literalSubstring is used to test if a file is worth considering for
 	// matches. literalSubstring is guaranteed to appear in any match found by
 	// re. It is the output of the longestLiteral function. It is only set if
@@ -69,6 +89,9 @@ func compile(p *protocol.PatternInfo) (*readerGrep, error) {
 		re               *regexp.Regexp
 		literalSubstring []byte
 	)
+	if p.IsRegExp && backtrack.HasLookaround(p.Pattern) {
+		return compileLookaround(p)
+	}
 	if p.Pattern != "" {
 		expr := p.Pattern
 		if !p.IsRegExp {
@@ -120,29 +143,172 @@ func compile(p *protocol.PatternInfo) (*readerGrep, error) {
 	if err != nil {
 		return nil, err
 	}
+	matchLanguage, err := compileLanguageMatcher(p, pathOptions)
+	if err != nil {
+		return nil, err
+	}
+	excludeContent, err := compileContentPatterns(p, p.ExcludeContentPatterns)
+	if err != nil {
+		return nil, err
+	}
+	includeContent, err := compileContentPatterns(p, p.IncludeContentPatterns)
+	if err != nil {
+		return nil, err
+	}
 
 	return &readerGrep{
 		re:               re,
 		ignoreCase:       !p.IsCaseSensitive,
 		matchPath:        matchPath,
+		matchLanguage:    matchLanguage,
+		excludeContent:   excludeContent,
+		includeContent:   includeContent,
 		literalSubstring: literalSubstring,
 	}, nil
 }
 
+// compileLookaround returns a readerGrep for matching p with the backtracking
+// matcher.
+func compileLookaround(p *protocol.PatternInfo) (*readerGrep, error) {
+	expr := p.Pattern
+	if p.IsWordMatch {
+		expr = `\b` + expr + `\b`
+	}
+	// Unlike compile, we let the matcher fold case instead of lowercasing the
+	// input, since lowercasing the pattern is only possible on a parsed
+	// syntax tree.
+	flags := "m"
+	if !p.IsCaseSensitive {
+		flags += "i"
+	}
+
+	lookaround, err := backtrack.Compile("(?" + flags + ":" + expr + ")")
+	if err != nil {
+		return nil, err
+	}
+
+	pathOptions := pathmatch.CompileOptions{
+		RegExp:        p.PathPatternsAreRegExps,
+		CaseSensitive: p.PathPatternsAreCaseSensitive,
+	}
+	matchPath, err := pathmatch.CompilePathPatterns(p.IncludePatterns, p.ExcludePattern, pathOptions)
+	if err != nil {
+		return nil, err
+	}
+	matchLanguage, err := compileLanguageMatcher(p, pathOptions)
+	if err != nil {
+		return nil, err
+	}
+	excludeContent, err := compileContentPatterns(p, p.ExcludeContentPatterns)
+	if err != nil {
+		return nil, err
+	}
+	includeContent, err := compileContentPatterns(p, p.IncludeContentPatterns)
+	if err != nil {
+		return nil, err
+	}
+
+	return &readerGrep{
+		lookaround:     lookaround,
+		matchPath:      matchPath,
+		matchLanguage:  matchLanguage,
+		excludeContent: excludeContent,
+		includeContent: includeContent,
+	}, nil
+}
+
+// compileContentPatterns compiles the given content patterns of p (from
+// -content: filters or file:contains() predicates). Unlike compile, we use
+// the (?i) flag for case insensitive patterns: they are only evaluated on
+// files which are otherwise matched, so the input is not lowercased.
+func compileContentPatterns(p *protocol.PatternInfo, patterns []string) ([]*regexp.Regexp, error) {
+	if len(patterns) == 0 {
+		return nil, nil
+	}
+
+	flags := "m"
+	if !p.IsCaseSensitive {
+		flags += "i"
+	}
+
+	res := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		re, err := regexp.Compile("(?" + flags + ":" + pattern + ")")
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, re)
+	}
+	return res, nil
+}
+
 // Copy returns a copied version of rg that is safe to use from another
 // goroutine.
 func (rg *readerGrep) Copy() *readerGrep {
 	return &readerGrep{
 		re:               rg.re,
+		lookaround:       rg.lookaround,
 		ignoreCase:       rg.ignoreCase,
 		matchPath:        rg.matchPath,
+		matchLanguage:    rg.matchLanguage,
+		excludeContent:   rg.excludeContent,
+		includeContent:   rg.includeContent,
 		literalSubstring: rg.literalSubstring,
 	}
 }
 
+// matchFile returns whether f should be searched, based on its path or, for
+// files without an extension, its language. Files whose content matches a
+// -content: filter, or does not match a file:contains() predicate, are never
+// searched.
+func (rg *readerGrep) matchFile(zf *store.ZipFile, f *store.SrcFile) bool {
+	if !rg.matchPath.MatchPath(f.Name) && !rg.matchLanguage.MatchFile(zf, f) {
+		return false
+	}
+	return !rg.matchExcludedContent(zf, f) && rg.matchIncludedContent(zf, f)
+}
+
+// matchExcludedContent returns whether the content of f matches any of the
+// -content: filters.
+func (rg *readerGrep) matchExcludedContent(zf *store.ZipFile, f *store.SrcFile) bool {
+	if len(rg.excludeContent) == 0 {
+		return false
+	}
+	content := zf.DataFor(f)
+	for _, re := range rg.excludeContent {
+		if re.Match(content) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchIncludedContent returns whether the content of f matches all of the
+// file:contains() predicates.
+func (rg *readerGrep) matchIncludedContent(zf *store.ZipFile, f *store.SrcFile) bool {
+	if len(rg.includeContent) == 0 {
+		return true
+	}
+	content := zf.DataFor(f)
+	for _, re := range rg.includeContent {
+		if !re.Match(content) {
+			return false
+		}
+	}
+	return true
+}
+
+// hasPattern returns false if rg matches all files' content.
+func (rg *readerGrep) hasPattern() bool {
+	return rg.re != nil || rg.lookaround != nil
+}
+
 // matchString returns whether rg's regexp pattern matches s. It is intended to be
 // used to match file paths.
 func (rg *readerGrep) matchString(s string) bool {
+	if rg.lookaround != nil {
+		return rg.lookaround.MatchString(s)
+	}
 	if rg.re == nil {
 		return true
 	}
@@ -185,7 +351,21 @@ func (rg *readerGrep) Find(zf *store.ZipFile, f *store.SrcFile) (matches []proto
 		return nil, false, nil
 	}
 
-	locs := rg.re.FindAllIndex(fileMatchBuf, maxLineMatches+1)
+	var locs [][]int
+	if rg.lookaround != nil {
+		// If the file exceeds the budget of the matcher we still report the
+		// matches found so far.
+		locs, err = rg.lookaround.FindAllIndex(fileMatchBuf, maxLineMatches+1)
+		if err == backtrack.ErrBudgetExceeded {
+			limitHit = true
+			err = nil
+		}
+		if err != nil {
+			return nil, false, err
+		}
+	} else {
+		locs = rg.re.FindAllIndex(fileMatchBuf, maxLineMatches+1)
+	}
 	lastStart := 0
 	lastLineNumber := 0
 	lastMatchIndex := 0
@@ -300,6 +480,9 @@ func regexSearch(ctx context.Context, rg *readerGrep, zf *store.ZipFile, fileMat
 	if rg.re != nil {
 		span.SetTag("re", rg.re.String())
 	}
+	if rg.lookaround != nil {
+		span.SetTag("lookaround", rg.lookaround.String())
+	}
 	span.SetTag("path", rg.matchPath.String())
 	defer func() {
 		if err != nil {
@@ -336,11 +519,12 @@ func regexSearch(ctx context.Context, rg *readerGrep, zf *store.ZipFile, fileMat
 		matches   = []protocol.FileMatch{}
 	)
 
-	if rg.re == nil || (patternMatchesPaths && !patternMatchesContent) {
+	if !rg.hasPattern() || (patternMatchesPaths && !patternMatchesContent) {
 		// Fast path for only matching file paths (or with a nil pattern, which matches all files,
 		// so is effectively matching only on file paths).
-		for _, f := range files {
-			if match := rg.matchPath.MatchPath(f.Name) && rg.matchString(f.Name); match == !isPatternNegated {
+		for i := range files {
+			f := &files[i]
+			if match := rg.matchFile(zf, f) && rg.matchString(f.Name); match == !isPatternNegated {
 				if len(matches) < fileMatchLimit {
 					matches = append(matches, protocol.FileMatch{Path: f.Name})
 				} else {
@@ -386,7 +570,7 @@ func regexSearch(ctx context.Context, rg *readerGrep, zf *store.ZipFile, fileMat
 				filesmu.Unlock()
 
 				// decide whether to process, record that decision
-				if !rg.matchPath.MatchPath(f.Name) {
+				if !rg.matchFile(zf, f) {
 					atomic.AddUint32(&filesSkipped, 1)
 					continue
 				}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/cmd/searcher/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/pathmatch"
+	"github.com/sourcegraph/sourcegraph/internal/search"
 	"github.com/sourcegraph/sourcegraph/internal/store"
 	"github.com/sourcegraph/sourcegraph/internal/testutil"
 )
@@ -522,6 +523,155 @@ func TestPathMatches(t *testing.T) {
 	}
 }
 
+func TestLanguageMatches(t *testing.T) {
+	zipData, err := testutil.CreateZip(map[string]string{
+		"bin/deploy":  "#!/usr/bin/env python3\nprint('deploy')\n",
+		"bin/run":     "#!/bin/sh\necho 'run'\n",
+		"bin/notes":   "print('notes')\n",
+		"bin/tool.py": "print('tool')\n",
+		"src/main":    "#!/usr/bin/env python3\nprint('main')\n",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	zf, err := store.MockZipFile(zipData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Both the path-only fast path and the content search consider the
+	// language of files without an extension.
+	for _, pattern := range []string{"", "print"} {
+		rg, err := compile(&protocol.PatternInfo{
+			Pattern:                pattern,
+			IsRegExp:               true,
+			IncludePatterns:        []string{"^bin/", search.LangToFileRegexp("python")},
+			Languages:              []string{"python"},
+			PathPatternsAreRegExps: true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		fileMatches, _, err := regexSearch(context.Background(), rg, zf, 10, true, false, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := []string{"bin/deploy", "bin/tool.py"}
+		got := make([]string, len(fileMatches))
+		for i, fm := range fileMatches {
+			got[i] = fm.Path
+		}
+		sort.Strings(got)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("pattern %q: got file matches %v, want %v", pattern, got, want)
+		}
+	}
+}
+
+func TestExcludeContentMatches(t *testing.T) {
+	zipData, err := testutil.CreateZip(map[string]string{
+		"a.go": "package a\n\nfunc Foo() {}\n",
+		"b.go": "package b\n\n// Deprecated: use a.Foo\nfunc Foo() {}\n",
+		"c.go": "package c\n\n// DEPRECATED\nfunc Foo() {}\n",
+		"d.go": "package d\n",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	zf, err := store.MockZipFile(zipData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		pattern         string
+		isCaseSensitive bool
+		want            []string
+	}{
+		{pattern: "func Foo", want: []string{"a.go"}},
+		{pattern: "func Foo", isCaseSensitive: true, want: []string{"a.go", "c.go"}},
+		{pattern: "", want: []string{"a.go", "d.go"}},
+	}
+	for _, c := range cases {
+		rg, err := compile(&protocol.PatternInfo{
+			Pattern:                c.pattern,
+			IsRegExp:               true,
+			IsCaseSensitive:        c.isCaseSensitive,
+			ExcludeContentPatterns: []string{"^// Deprecated"},
+			PathPatternsAreRegExps: true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		fileMatches, _, err := regexSearch(context.Background(), rg, zf, 10, true, false, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got := make([]string, len(fileMatches))
+		for i, fm := range fileMatches {
+			got[i] = fm.Path
+		}
+		sort.Strings(got)
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("pattern %q (case sensitive %v): got file matches %v, want %v", c.pattern, c.isCaseSensitive, got, c.want)
+		}
+	}
+}
+
+func TestIncludeContentMatches(t *testing.T) {
+	zipData, err := testutil.CreateZip(map[string]string{
+		"a.go": "package a\n\nimport \"net/http\"\n\nfunc Foo() { http.Get(\"\") }\n",
+		"b.go": "package b\n\nimport \"net/http\"\n\nfunc Bar() {}\n",
+		"c.go": "package c\n\nfunc Foo() { Get(\"\") }\n",
+		"d.go": "package d\n\nimport \"NET/HTTP\"\n",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	zf, err := store.MockZipFile(zipData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		pattern         string
+		isCaseSensitive bool
+		want            []string
+	}{
+		{pattern: `Get\(`, want: []string{"a.go"}},
+		{pattern: "package", want: []string{"a.go", "b.go", "d.go"}},
+		{pattern: "package", isCaseSensitive: true, want: []string{"a.go", "b.go"}},
+		{pattern: "", want: []string{"a.go", "b.go", "d.go"}},
+	}
+	for _, c := range cases {
+		rg, err := compile(&protocol.PatternInfo{
+			Pattern:                c.pattern,
+			IsRegExp:               true,
+			IsCaseSensitive:        c.isCaseSensitive,
+			IncludeContentPatterns: []string{`import "net/http"`},
+			PathPatternsAreRegExps: true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		fileMatches, _, err := regexSearch(context.Background(), rg, zf, 10, true, false, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got := make([]string, len(fileMatches))
+		for i, fm := range fileMatches {
+			got[i] = fm.Path
+		}
+		sort.Strings(got)
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("pattern %q (case sensitive %v): got file matches %v, want %v", c.pattern, c.isCaseSensitive, got, c.want)
+		}
+	}
+}
+
 // githubStore fetches from github and caches across test runs.
 var githubStore = &store.Store{
 	FetchTar: testutil.FetchTarFromGithub,
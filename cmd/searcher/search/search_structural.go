@@ -71,11 +71,22 @@ func highlightMultipleLines(r *comby.Match) (matches []protocol.LineMatch) {
 	return matches
 }
 
-func ToFileMatch(combyMatches []comby.FileMatch) (matches []protocol.FileMatch) {
+// ToFileMatch converts comby matches to file matches. If rewrite is not empty,
+// the rewrite of each match by the rewrite template is included.
+func ToFileMatch(combyMatches []comby.FileMatch, rewrite string) (matches []protocol.FileMatch) {
 	for _, m := range combyMatches {
 		var lineMatches []protocol.LineMatch
+		var rewrites []protocol.Rewrite
 		for _, r := range m.Matches {
 			lineMatches = append(lineMatches, highlightMultipleLines(&r)...)
+			if rewrite != "" {
+				rewrites = append(rewrites, protocol.Rewrite{
+					StartLine:   r.Range.Start.Line - 1,
+					EndLine:     r.Range.End.Line - 1,
+					Matched:     r.Matched,
+					Replacement: comby.Rewrite(rewrite, r.Environment),
+				})
+			}
 		}
 		matches = append(matches,
 			protocol.FileMatch{
@@ -83,6 +94,7 @@ func ToFileMatch(combyMatches []comby.FileMatch) (matches []protocol.FileMatch)
 				LineMatches: lineMatches,
 				MatchCount:  len(m.Matches),
 				LimitHit:    false,
+				Rewrites:    rewrites,
 			})
 	}
 	return matches
@@ -206,7 +218,7 @@ func filteredStructuralSearch(ctx context.Context, zipPath string, zipFile *stor
 		extensionHint = filepath.Ext(matchedPaths[0])
 	}
 
-	return structuralSearch(ctx, zipPath, Subset(matchedPaths), extensionHint, p.Pattern, p.CombyRule, p.Languages, repo)
+	return structuralSearch(ctx, zipPath, Subset(matchedPaths), extensionHint, p.Pattern, p.CombyRule, p.CombyRewrite, p.Languages, p.IsCaseSensitive, repo)
 }
 
 // toMatcher returns the matcher that parameterizes structural search. It
@@ -244,7 +256,7 @@ type Subset []string
 
 var All UniversalSet = struct{}{}
 
-func structuralSearch(ctx context.Context, zipPath string, paths filePatterns, extensionHint, pattern, rule string, languages []string, repo api.RepoName) (matches []protocol.FileMatch, limitHit bool, err error) {
+func structuralSearch(ctx context.Context, zipPath string, paths filePatterns, extensionHint, pattern, rule, rewrite string, languages []string, isCaseSensitive bool, repo api.RepoName) (matches []protocol.FileMatch, limitHit bool, err error) {
 	log15.Info("structural search", "repo", string(repo))
 
 	// Cap the number of forked processes to limit the size of zip contents being mapped to memory. Resolving #7133 could help to lift this restriction.
@@ -258,13 +270,14 @@ func structuralSearch(ctx context.Context, zipPath string, paths filePatterns, e
 	}
 
 	args := comby.Args{
-		Input:         comby.ZipPath(zipPath),
-		Matcher:       matcher,
-		MatchTemplate: pattern,
-		MatchOnly:     true,
-		FilePatterns:  filePatterns,
-		Rule:          rule,
-		NumWorkers:    numWorkers,
+		Input:           comby.ZipPath(zipPath),
+		Matcher:         matcher,
+		MatchTemplate:   pattern,
+		MatchOnly:       true,
+		CaseInsensitive: !isCaseSensitive,
+		FilePatterns:    filePatterns,
+		Rule:            rule,
+		NumWorkers:      numWorkers,
 	}
 
 	combyMatches, err := comby.Matches(ctx, args)
@@ -272,7 +285,7 @@ func structuralSearch(ctx context.Context, zipPath string, paths filePatterns, e
 		return nil, false, err
 	}
 
-	matches = ToFileMatch(combyMatches)
+	matches = ToFileMatch(combyMatches, rewrite)
 	if err != nil {
 		return nil, false, err
 	}
@@ -294,6 +307,7 @@ func structuralSearchWithZoekt(ctx context.Context, p *protocol.Request) (matche
 			IsRegExp:                     p.IsRegExp,
 			IsStructuralPat:              p.IsStructuralPat,
 			CombyRule:                    p.CombyRule,
+			CombyRewrite:                 p.CombyRewrite,
 			IsWordMatch:                  p.IsWordMatch,
 			IsCaseSensitive:              p.IsCaseSensitive,
 			FileMatchLimit:               int32(fileMatchLimit),
@@ -336,7 +350,7 @@ func structuralSearchWithZoekt(ctx context.Context, p *protocol.Request) (matche
 		extensionHint = filepath.Ext(filename)
 	}
 
-	matches, limitHit, err = structuralSearch(ctx, zipFile.Name(), All, extensionHint, p.Pattern, p.CombyRule, p.Languages, p.Repo)
+	matches, limitHit, err = structuralSearch(ctx, zipFile.Name(), All, extensionHint, p.Pattern, p.CombyRule, p.CombyRewrite, p.Languages, p.IsCaseSensitive, p.Repo)
 	return matches, limitHit, false, err
 }
 
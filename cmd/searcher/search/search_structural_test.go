@@ -75,7 +75,7 @@ func foo(go string) {}
 					Languages:       tt.Languages,
 				}
 
-				matches, _, err := structuralSearch(context.Background(), zf, Subset(p.IncludePatterns), "", p.Pattern, p.CombyRule, p.Languages, "repo_foo")
+				matches, _, err := structuralSearch(context.Background(), zf, Subset(p.IncludePatterns), "", p.Pattern, p.CombyRule, p.CombyRewrite, p.Languages, true, "repo_foo")
 				if err != nil {
 					t.Fatal(err)
 				}
@@ -134,7 +134,7 @@ func foo(go.txt) {}
 		}
 
 		extensionHint := filepath.Ext(filename)
-		matches, _, err := structuralSearch(context.Background(), zf, All, extensionHint, "foo(:[args])", "", languages, "repo_foo")
+		matches, _, err := structuralSearch(context.Background(), zf, All, extensionHint, "foo(:[args])", "", "", languages, true, "repo_foo")
 		if err != nil {
 			return "ERROR: " + err.Error()
 		}
@@ -331,7 +331,7 @@ func TestIncludePatterns(t *testing.T) {
 		Pattern:         "",
 		IncludePatterns: includePatterns,
 	}
-	fileMatches, _, err := structuralSearch(context.Background(), zf, Subset(p.IncludePatterns), "", p.Pattern, p.CombyRule, p.Languages, "foo")
+	fileMatches, _, err := structuralSearch(context.Background(), zf, Subset(p.IncludePatterns), "", p.Pattern, p.CombyRule, p.CombyRewrite, p.Languages, true, "foo")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -372,7 +372,7 @@ func TestRule(t *testing.T) {
 		CombyRule:       `where :[args] == "success"`,
 	}
 
-	got, _, err := structuralSearch(context.Background(), zf, Subset(p.IncludePatterns), "", p.Pattern, p.CombyRule, p.Languages, "repo")
+	got, _, err := structuralSearch(context.Background(), zf, Subset(p.IncludePatterns), "", p.Pattern, p.CombyRule, p.CombyRewrite, p.Languages, true, "repo")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -398,6 +398,105 @@ func TestRule(t *testing.T) {
 
 }
 
+func TestRuleHoleEqualityAndRewrite(t *testing.T) {
+	// If we are not on CI skip the test.
+	if os.Getenv("CI") == "" {
+		t.Skip("Not on CI, skipping comby-dependent test")
+	}
+
+	input := map[string]string{
+		"file.go": "foo(x, x)\nfoo(x, y)\n",
+	}
+
+	zipData, err := testutil.CreateZip(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zf, cleanup, err := testutil.TempZipFileOnDisk(zipData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+
+	p := &protocol.PatternInfo{
+		Pattern:         "foo(:[a], :[b])",
+		IncludePatterns: []string{".go"},
+		CombyRule:       ":[a] == :[b]",
+		CombyRewrite:    "bar(:[a])",
+	}
+
+	got, _, err := structuralSearch(context.Background(), zf, Subset(p.IncludePatterns), "", p.Pattern, p.CombyRule, p.CombyRewrite, p.Languages, true, "repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []protocol.FileMatch{
+		{
+			Path:     "file.go",
+			LimitHit: false,
+			LineMatches: []protocol.LineMatch{
+				{
+					LineNumber:       0,
+					OffsetAndLengths: [][2]int{{0, 9}},
+					Preview:          "foo(x, x)",
+				},
+			},
+			MatchCount: 1,
+			Rewrites: []protocol.Rewrite{
+				{
+					StartLine:   0,
+					EndLine:     0,
+					Matched:     "foo(x, x)",
+					Replacement: "bar(x)",
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got file matches %v, want %v", got, want)
+	}
+}
+
+func TestToFileMatchRewrites(t *testing.T) {
+	combyMatches := []comby.FileMatch{
+		{
+			URI: "file.go",
+			Matches: []comby.Match{
+				{
+					Range: comby.Range{
+						Start: comby.Location{Offset: 0, Line: 2, Column: 1},
+						End:   comby.Location{Offset: 22, Line: 3, Column: 13},
+					},
+					Environment: []comby.Environment{
+						{Variable: "err", Value: "err"},
+						{Variable: "msg", Value: `"failed"`},
+					},
+					Matched: "if err != nil {\n\tpanic(\"failed\")",
+				},
+			},
+		},
+	}
+
+	got := ToFileMatch(combyMatches, "if :[err] != nil {\n\treturn errors.New(:[msg])")
+	want := []protocol.Rewrite{
+		{
+			StartLine:   1,
+			EndLine:     2,
+			Matched:     "if err != nil {\n\tpanic(\"failed\")",
+			Replacement: "if err != nil {\n\treturn errors.New(\"failed\")",
+		},
+	}
+	if len(got) != 1 || !reflect.DeepEqual(got[0].Rewrites, want) {
+		t.Fatalf("got file matches %v, want rewrites %v", got, want)
+	}
+
+	// Rewrites are only computed if there is a rewrite template.
+	if got := ToFileMatch(combyMatches, ""); got[0].Rewrites != nil {
+		t.Fatalf("got rewrites %v, want none", got[0].Rewrites)
+	}
+}
+
 func TestHighlightMultipleLines(t *testing.T) {
 	cases := []struct {
 		Name  string
@@ -526,7 +625,7 @@ func bar() {
 	defer cleanup()
 
 	t.Run("Strutural search match count", func(t *testing.T) {
-		matches, _, err := structuralSearch(context.Background(), zf, Subset(p.IncludePatterns), "", p.Pattern, p.CombyRule, p.Languages, "repo_foo")
+		matches, _, err := structuralSearch(context.Background(), zf, Subset(p.IncludePatterns), "", p.Pattern, p.CombyRule, p.CombyRewrite, p.Languages, true, "repo_foo")
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -80,6 +80,18 @@ main.go:5:func main() {
 `},
 		{protocol.PatternInfo{Pattern: "^FuNc", IsRegExp: true}, `
 main.go:5:func main() {
+`},
+
+		// Lookaround is evaluated by the backtracking matcher.
+		{protocol.PatternInfo{Pattern: `world(?=")`, IsRegExp: true}, `
+main.go:6:	fmt.Println("Hello world")
+`},
+		{protocol.PatternInfo{Pattern: `(?<!# )hello WORLD`, IsRegExp: true}, `
+README.md:3:Hello world example in go
+main.go:6:	fmt.Println("Hello world")
+`},
+		{protocol.PatternInfo{Pattern: `(?<=^func )\w+`, IsRegExp: true, IsCaseSensitive: true}, `
+main.go:5:func main() {
 `},
 
 		{protocol.PatternInfo{Pattern: "mai", IsWordMatch: true}, ""},
@@ -282,13 +294,13 @@ func TestSearch_badrequest(t *testing.T) {
 			},
 		},
 
-		// Unsupported regex
+		// Unsupported regex (lookaround is supported, backreferences are not)
 		{
 			Repo:   "foo",
 			URL:    "u",
 			Commit: "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef",
 			PatternInfo: protocol.PatternInfo{
-				Pattern:  `(?!id)entity`,
+				Pattern:  `(?!id)(entity)\1`,
 				IsRegExp: true,
 			},
 		},
@@ -106,7 +106,7 @@ func buildQuery(args *search.TextPatternInfo, repoBranches map[string][]string,
 		filePathPatterns,
 		&zoektquery.Regexp{
 			Regexp:        re,
-			CaseSensitive: true,
+			CaseSensitive: args.IsCaseSensitive,
 			Content:       true,
 		},
 	), nil
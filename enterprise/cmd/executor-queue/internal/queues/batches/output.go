@@ -0,0 +1,71 @@
+package batches
+
+import (
+	"encoding/json"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"github.com/sourcegraph/sourcegraph/internal/workerutil"
+)
+
+// ErrNoBatchSpecRandID is returned by extractBatchSpecRandID if src-cli did
+// not report the creation of a batch spec.
+var ErrNoBatchSpecRandID = errors.New("no batch spec was created by src-cli")
+
+// srcLogLine is a line of the output of src-cli when run with -text-only.
+type srcLogLine struct {
+	Operation string `json:"operation"`
+	Status    string `json:"status"`
+	Metadata  struct {
+		BatchSpecURL string `json:"batchSpecURL"`
+	} `json:"metadata"`
+}
+
+// extractBatchSpecRandID returns the rand ID of the batch spec created by the
+// src-cli step of a job, as reported in its output. The URL of the batch spec
+// ends with the batch spec's GraphQL ID.
+func extractBatchSpecRandID(logs []workerutil.ExecutionLogEntry) (string, error) {
+	for _, entry := range logs {
+		if entry.Key != "step.src.0" {
+			continue
+		}
+
+		for _, line := range strings.Split(entry.Out, "\n") {
+			const prefix = "stdout: "
+			if !strings.HasPrefix(line, prefix) {
+				continue
+			}
+
+			var l srcLogLine
+			if err := json.Unmarshal([]byte(line[len(prefix):]), &l); err != nil {
+				// Not every line of the output is JSON.
+				continue
+			}
+			if l.Operation != "CREATING_BATCH_SPEC" || l.Status != "SUCCESS" {
+				continue
+			}
+
+			u, err := url.Parse(l.Metadata.BatchSpecURL)
+			if err != nil {
+				return "", errors.Wrap(err, "parsing batch spec URL")
+			}
+			id := graphql.ID(path.Base(u.Path))
+			if kind := relay.UnmarshalKind(id); kind != "BatchSpec" {
+				return "", errors.Errorf("unexpected batch spec URL %q", l.Metadata.BatchSpecURL)
+			}
+
+			var randID string
+			if err := relay.UnmarshalSpec(id, &randID); err != nil {
+				return "", errors.Wrap(err, "unmarshalling batch spec ID")
+			}
+			return randID, nil
+		}
+	}
+
+	return "", ErrNoBatchSpecRandID
+}
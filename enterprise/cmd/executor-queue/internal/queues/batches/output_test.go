@@ -0,0 +1,72 @@
+package batches
+
+import (
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/workerutil"
+)
+
+func TestExtractBatchSpecRandID(t *testing.T) {
+	tests := map[string]struct {
+		logs    []workerutil.ExecutionLogEntry
+		want    string
+		wantErr error
+	}{
+		"success": {
+			logs: []workerutil.ExecutionLogEntry{
+				{Key: "setup.firecracker.start", Out: "stdout: {\"operation\":\"CREATING_BATCH_SPEC\"}\n"},
+				{
+					Key: "step.src.0",
+					Out: `stdout: {"operation":"PARSING_BATCH_SPEC","timestamp":"2021-07-06T09:38:51.481Z","status":"STARTED"}
+stderr: not json
+stdout: {"operation":"CREATING_BATCH_SPEC","timestamp":"2021-07-06T09:38:51.535Z","status":"STARTED"}
+stdout: {"operation":"CREATING_BATCH_SPEC","timestamp":"2021-07-06T09:38:51.535Z","status":"SUCCESS","metadata":{"batchSpecURL":"https://sourcegraph.test/users/alice/batch-changes/apply/QmF0Y2hTcGVjOiJhYmMxMjMi"}}
+`,
+				},
+			},
+			want: "abc123",
+		},
+		"no src step": {
+			logs: []workerutil.ExecutionLogEntry{
+				{Key: "setup.firecracker.start", Out: "stdout: started\n"},
+			},
+			wantErr: ErrNoBatchSpecRandID,
+		},
+		"batch spec not created": {
+			logs: []workerutil.ExecutionLogEntry{
+				{
+					Key: "step.src.0",
+					Out: `stdout: {"operation":"CREATING_BATCH_SPEC","timestamp":"2021-07-06T09:38:51.535Z","status":"STARTED"}
+stderr: {"operation":"CREATING_BATCH_SPEC","timestamp":"2021-07-06T09:38:51.535Z","status":"FAILURE"}
+`,
+				},
+			},
+			wantErr: ErrNoBatchSpecRandID,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			have, err := extractBatchSpecRandID(tc.logs)
+			if err != tc.wantErr {
+				t.Fatalf("unexpected error. want=%v have=%v", tc.wantErr, err)
+			}
+			if have != tc.want {
+				t.Fatalf("unexpected rand ID. want=%q have=%q", tc.want, have)
+			}
+		})
+	}
+
+	t.Run("unexpected ID kind", func(t *testing.T) {
+		logs := []workerutil.ExecutionLogEntry{
+			{
+				Key: "step.src.0",
+				// The ID of a batch change instead of a batch spec.
+				Out: `stdout: {"operation":"CREATING_BATCH_SPEC","status":"SUCCESS","metadata":{"batchSpecURL":"https://sourcegraph.test/users/alice/batch-changes/apply/QmF0Y2hDaGFuZ2U6MQ=="}}` + "\n",
+			},
+		}
+		if _, err := extractBatchSpecRandID(logs); err == nil {
+			t.Fatal("expected error")
+		}
+	})
+}
@@ -0,0 +1,61 @@
+package batches
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+
+	apiserver "github.com/sourcegraph/sourcegraph/enterprise/cmd/executor-queue/internal/server"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	apiclient "github.com/sourcegraph/sourcegraph/enterprise/internal/executor"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil"
+	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
+)
+
+// StalledJobMaximumAge is the maximum allowable duration between updating the state of a
+// job as "processing" and locking the record during processing. An unlocked row that is
+// marked as processing likely indicates that the executor that dequeued the job has died.
+// There should be a nearly-zero delay between these states during normal operation.
+const StalledJobMaximumAge = time.Second * 5
+
+// MaximumNumResets is the maximum number of times a job can be reset. If a job's failed
+// attempts counter reaches this threshold, it will be moved into "errored" rather than
+// "queued" on its next reset.
+const MaximumNumResets = 3
+
+// QueueOptions returns the options of the queue of batch spec executions. The
+// executors run src-cli against the frontend at the given URL.
+func QueueOptions(db dbutil.DB, frontendURL string, observationContext *observation.Context) apiserver.QueueOptions {
+	recordTransformer := func(record workerutil.Record) (apiclient.Job, error) {
+		return transformRecord(record.(*executionRecord), frontendURL)
+	}
+
+	return apiserver.QueueOptions{
+		Store:             newExecutionStore(db, newWorkerStore(db, observationContext)),
+		RecordTransformer: recordTransformer,
+	}
+}
+
+// newWorkerStore creates a dbworker store that wraps the batch_spec_executions table.
+func newWorkerStore(db dbutil.DB, observationContext *observation.Context) dbworkerstore.Store {
+	handle := basestore.NewHandleWithDB(db, sql.TxOptions{})
+	options := dbworkerstore.Options{
+		Name:              "batch_spec_executor_worker_store",
+		TableName:         "batch_spec_executions",
+		ColumnExpressions: store.BatchSpecExecutionColumns,
+		Scan:              scanFirstExecutionRecord,
+		OrderByExpression: sqlf.Sprintf("batch_spec_executions.created_at, batch_spec_executions.id"),
+		StalledMaxAge:     StalledJobMaximumAge,
+		MaxNumResets:      MaximumNumResets,
+	}
+
+	return dbworkerstore.NewWithMetrics(handle, options, observationContext)
+}
+
+func scanFirstExecutionRecord(rows *sql.Rows, err error) (workerutil.Record, bool, error) {
+	return store.ScanFirstBatchSpecExecution(rows, err)
+}
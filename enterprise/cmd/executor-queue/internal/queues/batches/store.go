@@ -0,0 +1,156 @@
+package batches
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/go-multierror"
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil"
+	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
+)
+
+// executionRecord is a dequeued batch spec execution together with the values
+// needed to run src-cli on behalf of the user who queued it.
+type executionRecord struct {
+	*btypes.BatchSpecExecution
+
+	// namespace is the name of the user or org in which the batch spec is
+	// created.
+	namespace string
+
+	// accessToken is a temporary access token of the user who queued the
+	// execution. It is deleted once the job's transaction is closed.
+	accessToken string
+}
+
+// executionStore wraps the dbworker store of batch spec executions. On
+// dequeue, it creates a temporary access token for the user who queued the
+// execution. On completion, it records the batch spec created by src-cli on
+// the execution.
+type executionStore struct {
+	dbworkerstore.Store
+
+	// db is used for the access tokens, which must be committed independently
+	// of the job's transaction so that src-cli can use them.
+	db dbutil.DB
+}
+
+func newExecutionStore(db dbutil.DB, workerStore dbworkerstore.Store) *executionStore {
+	return &executionStore{Store: workerStore, db: db}
+}
+
+func (s *executionStore) DequeueWithIndependentTransactionContext(ctx context.Context, conditions []*sqlf.Query) (_ workerutil.Record, _ dbworkerstore.Store, _ bool, err error) {
+	record, tx, dequeued, err := s.Store.DequeueWithIndependentTransactionContext(ctx, conditions)
+	if err != nil || !dequeued {
+		return nil, nil, dequeued, err
+	}
+	defer func() {
+		if err != nil {
+			err = tx.Done(err)
+		}
+	}()
+
+	exec, ok := record.(*btypes.BatchSpecExecution)
+	if !ok {
+		return nil, nil, false, errors.Errorf("unexpected record type %T", record)
+	}
+
+	namespace, err := s.namespaceName(ctx, exec)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	// 🚨 SECURITY: src-cli runs with the permissions of the user who queued the
+	// execution. The token is deleted once the job's transaction is closed.
+	note := fmt.Sprintf("batch spec execution %d", exec.ID)
+	tokenID, token, err := database.AccessTokens(s.db).Create(ctx, exec.UserID, []string{authz.ScopeUserAll}, note, exec.UserID)
+	if err != nil {
+		return nil, nil, false, errors.Wrap(err, "creating access token")
+	}
+
+	wrappedTx := &executionTx{Store: tx, db: s.db, tokenID: tokenID, userID: exec.UserID}
+	return &executionRecord{BatchSpecExecution: exec, namespace: namespace, accessToken: token}, wrappedTx, true, nil
+}
+
+func (s *executionStore) namespaceName(ctx context.Context, exec *btypes.BatchSpecExecution) (string, error) {
+	if exec.NamespaceUserID != 0 {
+		user, err := database.Users(s.db).GetByID(ctx, exec.NamespaceUserID)
+		if err != nil {
+			return "", err
+		}
+		return user.Username, nil
+	}
+
+	org, err := database.Orgs(s.db).GetByID(ctx, exec.NamespaceOrgID)
+	if err != nil {
+		return "", err
+	}
+	return org.Name, nil
+}
+
+// executionTx wraps the transaction holding a dequeued batch spec execution.
+type executionTx struct {
+	dbworkerstore.Store
+
+	db      dbutil.DB
+	tokenID int64
+	userID  int32
+}
+
+// MarkComplete records the batch spec created by src-cli on the execution
+// before marking it as complete. If no batch spec was created, the execution
+// is marked as failed instead.
+func (tx *executionTx) MarkComplete(ctx context.Context, id int) (bool, error) {
+	bstore := store.New(tx.db, nil).With(tx.Store)
+
+	exec, err := bstore.GetBatchSpecExecution(ctx, store.GetBatchSpecExecutionOpts{ID: int64(id)})
+	if err != nil {
+		return false, err
+	}
+
+	randID, err := extractBatchSpecRandID(exec.ExecutionLogs)
+	if err != nil {
+		return tx.Store.MarkFailed(ctx, id, err.Error())
+	}
+
+	spec, err := bstore.GetBatchSpec(ctx, store.GetBatchSpecOpts{RandID: randID})
+	if err != nil {
+		if err == store.ErrNoResults {
+			return tx.Store.MarkFailed(ctx, id, fmt.Sprintf("batch spec %q does not exist", randID))
+		}
+		return false, err
+	}
+
+	// 🚨 SECURITY: Only link batch specs created with the token of the
+	// initiator, which src-cli could not have used for anyone else.
+	if spec.UserID != exec.UserID {
+		return tx.Store.MarkFailed(ctx, id, fmt.Sprintf("batch spec %q was not created by the initiator of the execution", randID))
+	}
+
+	if err := bstore.SetBatchSpecExecutionBatchSpec(ctx, exec.ID, spec.ID); err != nil {
+		return false, err
+	}
+
+	return tx.Store.MarkComplete(ctx, id)
+}
+
+// Done closes the job's transaction and deletes the access token created for
+// the job.
+func (tx *executionTx) Done(err error) error {
+	err = tx.Store.Done(err)
+
+	// The transaction's context may already be canceled at this point.
+	if deleteErr := database.AccessTokens(tx.db).DeleteByID(context.Background(), tx.tokenID, tx.userID); deleteErr != nil {
+		err = multierror.Append(err, errors.Wrap(deleteErr, "deleting access token"))
+	}
+
+	return err
+}
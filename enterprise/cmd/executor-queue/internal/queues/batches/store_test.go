@@ -0,0 +1,69 @@
+package batches
+
+import (
+	"context"
+	"testing"
+
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	workerstoremocks "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store/mocks"
+)
+
+func TestExecutionStoreDequeue(t *testing.T) {
+	defer func() { database.Mocks = database.MockStores{} }()
+
+	database.Mocks.Users.GetByID = func(ctx context.Context, id int32) (*types.User, error) {
+		return &types.User{ID: id, Username: "alice"}, nil
+	}
+	database.Mocks.AccessTokens.Create = func(subjectUserID int32, scopes []string, note string, creatorUserID int32) (int64, string, error) {
+		if subjectUserID != 7 || creatorUserID != 7 {
+			t.Fatalf("unexpected user IDs. subject=%d creator=%d", subjectUserID, creatorUserID)
+		}
+		if len(scopes) != 1 || scopes[0] != authz.ScopeUserAll {
+			t.Fatalf("unexpected scopes %v", scopes)
+		}
+		return 99, "deadbeef", nil
+	}
+	var deletedTokenID int64
+	database.Mocks.AccessTokens.DeleteByID = func(id int64, subjectUserID int32) error {
+		if subjectUserID != 7 {
+			t.Fatalf("unexpected subject user ID %d", subjectUserID)
+		}
+		deletedTokenID = id
+		return nil
+	}
+
+	workerStore := workerstoremocks.NewMockStore()
+	tx := workerstoremocks.NewMockStore()
+	exec := &btypes.BatchSpecExecution{ID: 42, UserID: 7, NamespaceUserID: 7}
+	workerStore.DequeueWithIndependentTransactionContextFunc.SetDefaultReturn(exec, tx, true, nil)
+
+	s := newExecutionStore(nil, workerStore)
+	record, wrappedTx, dequeued, err := s.DequeueWithIndependentTransactionContext(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error dequeueing: %s", err)
+	}
+	if !dequeued {
+		t.Fatal("expected a record to be dequeued")
+	}
+
+	r, ok := record.(*executionRecord)
+	if !ok {
+		t.Fatalf("unexpected record type %T", record)
+	}
+	if r.RecordID() != 42 || r.namespace != "alice" || r.accessToken != "deadbeef" {
+		t.Fatalf("unexpected record %+v", r)
+	}
+
+	if err := wrappedTx.Done(nil); err != nil {
+		t.Fatalf("unexpected error closing transaction: %s", err)
+	}
+	if len(tx.DoneFunc.History()) != 1 {
+		t.Fatalf("expected the transaction to be closed")
+	}
+	if deletedTokenID != 99 {
+		t.Fatalf("expected access token to be deleted. have=%d", deletedTokenID)
+	}
+}
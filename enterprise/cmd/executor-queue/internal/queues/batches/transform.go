@@ -0,0 +1,38 @@
+package batches
+
+import (
+	"fmt"
+
+	apiclient "github.com/sourcegraph/sourcegraph/enterprise/internal/executor"
+)
+
+const specFile = "spec.yml"
+
+func transformRecord(exec *executionRecord, frontendURL string) (apiclient.Job, error) {
+	return apiclient.Job{
+		ID: int(exec.ID),
+		VirtualMachineFiles: map[string]string{
+			specFile: exec.BatchSpec,
+		},
+		CliSteps: []apiclient.CliStep{
+			{
+				Commands: []string{
+					"batch", "preview",
+					"-f", specFile,
+					"-text-only",
+					"-namespace", exec.namespace,
+				},
+				Dir: ".",
+				Env: []string{
+					fmt.Sprintf("SRC_ENDPOINT=%s", frontendURL),
+					fmt.Sprintf("SRC_ACCESS_TOKEN=%s", exec.accessToken),
+				},
+			},
+		},
+		RedactedValues: map[string]string{
+			// 🚨 SECURITY: Catch leak of the temporary access token of the user
+			// who queued the execution.
+			exec.accessToken: "ACCESS_TOKEN_REMOVED",
+		},
+	}, nil
+}
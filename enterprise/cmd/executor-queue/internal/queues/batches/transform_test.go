@@ -0,0 +1,54 @@
+package batches
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	apiclient "github.com/sourcegraph/sourcegraph/enterprise/internal/executor"
+)
+
+func TestTransformRecord(t *testing.T) {
+	record := &executionRecord{
+		BatchSpecExecution: &btypes.BatchSpecExecution{
+			ID:        42,
+			BatchSpec: "name: hello-world",
+		},
+		namespace:   "alice",
+		accessToken: "deadbeef",
+	}
+
+	job, err := transformRecord(record, "https://test.io")
+	if err != nil {
+		t.Fatalf("unexpected error transforming record: %s", err)
+	}
+
+	expected := apiclient.Job{
+		ID: 42,
+		VirtualMachineFiles: map[string]string{
+			"spec.yml": "name: hello-world",
+		},
+		CliSteps: []apiclient.CliStep{
+			{
+				Commands: []string{
+					"batch", "preview",
+					"-f", "spec.yml",
+					"-text-only",
+					"-namespace", "alice",
+				},
+				Dir: ".",
+				Env: []string{
+					"SRC_ENDPOINT=https://test.io",
+					"SRC_ACCESS_TOKEN=deadbeef",
+				},
+			},
+		},
+		RedactedValues: map[string]string{
+			"deadbeef": "ACCESS_TOKEN_REMOVED",
+		},
+	}
+	if diff := cmp.Diff(expected, job); diff != "" {
+		t.Errorf("unexpected job (-want +got):\n%s", diff)
+	}
+}
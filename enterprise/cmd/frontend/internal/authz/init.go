@@ -34,6 +34,7 @@ func Init(ctx context.Context, db dbutil.DB, outOfBandMigrationRunner *oobmigrat
 	// TODO(efritz) - de-globalize assignments in this function
 	database.GlobalExternalServices = edb.NewExternalServicesStore(db)
 	database.GlobalAuthz = edb.NewAuthzStore(db, clock)
+	authz.DefaultSubRepoPermsChecker = authz.NewSubRepoPermsChecker(edb.SubRepoPerms(db))
 
 	// Warn about usage of auth providers that are not enabled by the license.
 	graphqlbackend.AlertFuncs = append(graphqlbackend.AlertFuncs, func(args graphqlbackend.AlertFuncArgs) []*graphqlbackend.Alert {
@@ -67,6 +68,8 @@ func Init(ctx context.Context, db dbutil.DB, outOfBandMigrationRunner *oobmigrat
 				authzNames = append(authzNames, "GitLab")
 			case extsvc.TypeBitbucketServer:
 				authzNames = append(authzNames, "Bitbucket Server")
+			case extsvc.TypeBitbucketCloud:
+				authzNames = append(authzNames, "Bitbucket Cloud")
 			default:
 				authzNames = append(authzNames, t)
 			}
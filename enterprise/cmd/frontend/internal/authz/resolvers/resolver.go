@@ -3,6 +3,7 @@ package resolvers
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -151,12 +152,35 @@ func (r *Resolver) SetRepositoryPermissionsForUsers(ctx context.Context, args *g
 		AccountIDs:  pendingBindIDs,
 	}
 
+	before := &authz.RepoPermissions{RepoID: p.RepoID, Perm: p.Perm, UserIDs: roaring.NewBitmap()}
+	if err = txs.LoadRepoPermissions(ctx, before); err != nil && err != authz.ErrPermsNotFound {
+		return nil, errors.Wrap(err, "load repository permissions")
+	}
+
 	if err = txs.SetRepoPermissions(ctx, p); err != nil {
 		return nil, errors.Wrap(err, "set repository permissions")
 	} else if err = txs.SetRepoPendingPermissions(ctx, accounts, p); err != nil {
 		return nil, errors.Wrap(err, "set repository pending permissions")
 	}
 
+	sort.Strings(pendingBindIDs)
+	if err = database.AuditLogsWith(txs).Record(ctx, &database.AuditLogEvent{
+		Action:      database.AuditLogActionRepoPermissionsUpdated,
+		SubjectType: database.AuditLogSubjectRepo,
+		SubjectID:   repoID,
+		Before: map[string]interface{}{
+			"permission": before.Perm.String(),
+			"userIDs":    before.UserIDs.ToArray(),
+		},
+		After: map[string]interface{}{
+			"permission":     p.Perm.String(),
+			"userIDs":        p.UserIDs.ToArray(),
+			"pendingBindIDs": pendingBindIDs,
+		},
+	}); err != nil {
+		return nil, errors.Wrap(err, "record audit log")
+	}
+
 	return &graphqlbackend.EmptyResponse{}, nil
 }
 
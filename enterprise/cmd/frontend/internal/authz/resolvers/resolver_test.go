@@ -48,7 +48,7 @@ func mustParseGraphQLSchema(t *testing.T, db dbutil.DB) *graphql.Schema {
 	t.Helper()
 
 	parseSchemaOnce.Do(func() {
-		parsedSchema, parseSchemaErr = graphqlbackend.NewSchema(db, nil, nil, nil, NewResolver(db, clock), nil, nil, nil)
+		parsedSchema, parseSchemaErr = graphqlbackend.NewSchema(db, nil, nil, nil, NewResolver(db, clock), nil, nil, nil, nil)
 	})
 	if parseSchemaErr != nil {
 		t.Fatal(parseSchemaErr)
@@ -187,7 +187,10 @@ func TestResolver_SetRepositoryPermissionsForUsers(t *testing.T) {
 				return &types.Repo{ID: id}, nil
 			}
 			edb.Mocks.Perms.Transact = func(_ context.Context) (*edb.PermsStore, error) {
-				return &edb.PermsStore{}, nil
+				return &edb.PermsStore{Store: basestore.NewWithDB(nil, sql.TxOptions{})}, nil
+			}
+			edb.Mocks.Perms.LoadRepoPermissions = func(_ context.Context, _ *authz.RepoPermissions) error {
+				return authz.ErrPermsNotFound
 			}
 			edb.Mocks.Perms.SetRepoPermissions = func(_ context.Context, p *authz.RepoPermissions) error {
 				ids := p.UserIDs.ToArray()
@@ -202,14 +205,24 @@ func TestResolver_SetRepositoryPermissionsForUsers(t *testing.T) {
 				}
 				return nil
 			}
+			var recorded *database.AuditLogEvent
+			database.Mocks.AuditLogs.Record = func(_ context.Context, e *database.AuditLogEvent) error {
+				recorded = e
+				return nil
+			}
 			defer func() {
 				database.Mocks.UserEmails = database.MockUserEmails{}
 				database.Mocks.Users = database.MockUsers{}
 				database.Mocks.Repos = database.MockRepos{}
+				database.Mocks.AuditLogs = database.MockAuditLogs{}
 				edb.Mocks.Perms = edb.MockPerms{}
 			}()
 
 			gqltesting.RunTests(t, test.gqlTests)
+
+			if recorded == nil || recorded.Action != database.AuditLogActionRepoPermissionsUpdated {
+				t.Fatalf("want repository permissions update to be recorded in the audit log, got %+v", recorded)
+			}
 		})
 	}
 }
@@ -0,0 +1,189 @@
+package badges
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
+	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// maxCoverageUploads is the maximum number of uploads visible at the tip of
+// the default branch considered for the code intel badge.
+const maxCoverageUploads = 100
+
+// searchBadge shows the number of results of the saved search given by the
+// savedSearch query parameter in the repository.
+func (h *handler) searchBadge(ctx context.Context, repo *types.Repo, r *http.Request) (badge, error) {
+	id := graphql.ID(r.URL.Query().Get("savedSearch"))
+	if relay.UnmarshalKind(id) != "SavedSearch" {
+		return badge{}, &badgeError{status: http.StatusBadRequest, message: "invalid saved search"}
+	}
+	var savedSearchID int32
+	if err := relay.UnmarshalSpec(id, &savedSearchID); err != nil {
+		return badge{}, &badgeError{status: http.StatusBadRequest, message: "invalid saved search"}
+	}
+
+	ss, err := database.SavedSearches(h.db).GetByID(ctx, savedSearchID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return badge{}, &badgeError{status: http.StatusNotFound, message: "saved search not found"}
+		}
+		return badge{}, err
+	}
+
+	// 🚨 SECURITY: Saved searches of other users and orgs are reported as
+	// missing so that their existence isn't revealed.
+	if !h.canAccessSavedSearch(ctx, savedSearchID, ss) {
+		return badge{}, &badgeError{status: http.StatusNotFound, message: "saved search not found"}
+	}
+
+	// The saved query is parenthesized so that an "or" in it can't escape the
+	// repo filter.
+	count, err := h.search(ctx, fmt.Sprintf("(%s) repo:^%s$", ss.Config.Query, regexp.QuoteMeta(string(repo.Name))))
+	if err != nil {
+		return badge{}, err
+	}
+
+	return badge{Label: "search results", Message: count, Color: "blue"}, nil
+}
+
+// canAccessSavedSearch reports whether the actor may use the saved search:
+// either it is listed in the badges.shareableSavedSearches site setting, or
+// the actor owns it or is a member of the org that owns it.
+func (h *handler) canAccessSavedSearch(ctx context.Context, id int32, ss *api.SavedQuerySpecAndConfig) bool {
+	if isShareableSavedSearch(id) {
+		return true
+	}
+
+	a := actor.FromContext(ctx)
+	if !a.IsAuthenticated() {
+		return false
+	}
+	if ss.Config.UserID != nil {
+		return *ss.Config.UserID == a.UID
+	}
+	if ss.Config.OrgID != nil {
+		return backend.CheckOrgAccess(ctx, h.db, *ss.Config.OrgID) == nil
+	}
+	return false
+}
+
+// isShareableSavedSearch reports whether the site admin listed the saved
+// search in the badges.shareableSavedSearches site setting.
+func isShareableSavedSearch(id int32) bool {
+	cfg := conf.Get().Badges
+	if cfg == nil {
+		return false
+	}
+	for _, s := range cfg.ShareableSavedSearches {
+		var shareableID int32
+		if relay.UnmarshalKind(graphql.ID(s)) != "SavedSearch" {
+			continue
+		}
+		if err := relay.UnmarshalSpec(graphql.ID(s), &shareableID); err == nil && shareableID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// codeIntelBadge shows the indexers of the precise code intelligence uploads
+// visible at the tip of the default branch of the repository.
+func (h *handler) codeIntelBadge(ctx context.Context, repo *types.Repo, r *http.Request) (badge, error) {
+	uploads, _, err := h.dbStore.GetUploads(ctx, store.GetUploadsOptions{
+		RepositoryID: int(repo.ID),
+		State:        "completed",
+		VisibleAtTip: true,
+		Limit:        maxCoverageUploads,
+	})
+	if err != nil {
+		return badge{}, err
+	}
+
+	indexers := map[string]struct{}{}
+	for _, upload := range uploads {
+		indexers[upload.Indexer] = struct{}{}
+	}
+	if len(indexers) == 0 {
+		return badge{Label: "code intel", Message: "search-based", Color: "lightgrey"}, nil
+	}
+
+	names := make([]string, 0, len(indexers))
+	for indexer := range indexers {
+		names = append(names, indexer)
+	}
+	sort.Strings(names)
+
+	return badge{Label: "code intel", Message: "precise (" + strings.Join(names, ", ") + ")", Color: "brightgreen"}, nil
+}
+
+// lastIndexedBadge shows the age of the most recent precise code intelligence
+// upload of the repository.
+func (h *handler) lastIndexedBadge(ctx context.Context, repo *types.Repo, r *http.Request) (badge, error) {
+	uploads, _, err := h.dbStore.GetUploads(ctx, store.GetUploadsOptions{
+		RepositoryID: int(repo.ID),
+		State:        "completed",
+		Limit:        1,
+	})
+	if err != nil {
+		return badge{}, err
+	}
+	if len(uploads) == 0 {
+		return badge{Label: "last indexed", Message: "never", Color: "lightgrey"}, nil
+	}
+
+	indexedAt := uploads[0].UploadedAt
+	if uploads[0].FinishedAt != nil {
+		indexedAt = *uploads[0].FinishedAt
+	}
+	age := h.now().Sub(indexedAt)
+
+	color := "red"
+	switch {
+	case age < 24*time.Hour:
+		color = "brightgreen"
+	case age < 7*24*time.Hour:
+		color = "yellow"
+	case age < 30*24*time.Hour:
+		color = "orange"
+	}
+
+	return badge{Label: "last indexed", Message: formatAge(age), Color: color}, nil
+}
+
+// formatAge formats d as a coarse, human-readable age.
+func formatAge(d time.Duration) string {
+	plural := func(n int, unit string) string {
+		if n == 1 {
+			return fmt.Sprintf("1 %s ago", unit)
+		}
+		return fmt.Sprintf("%d %ss ago", n, unit)
+	}
+
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		return plural(int(d/time.Minute), "minute")
+	case d < 48*time.Hour:
+		return plural(int(d/time.Hour), "hour")
+	default:
+		return plural(int(d/(24*time.Hour)), "day")
+	}
+}
@@ -0,0 +1,188 @@
+// Package badges serves SVG badges describing repositories, which can be
+// embedded in READMEs to show live status such as the number of results of a
+// saved search or the freshness of code intelligence.
+package badges
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/inconshreveable/log15"
+
+	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/rcache"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+const (
+	// basePath is the path of the badges, relative to the external URL.
+	basePath = "/.api/badges"
+
+	// cacheTTL is the duration for which a badge is cached, both by us and by
+	// clients such as the image proxies of code hosts.
+	cacheTTL = 5 * time.Minute
+)
+
+// DBStore is the subset of the code intelligence store used to compute badges.
+type DBStore interface {
+	GetUploads(ctx context.Context, opts store.GetUploadsOptions) ([]store.Upload, int, error)
+}
+
+// SearchFunc returns the approximate number of results of a search query, run
+// as the actor of the given context.
+type SearchFunc func(ctx context.Context, query string) (string, error)
+
+type cache interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, b []byte)
+}
+
+type handler struct {
+	db      dbutil.DB
+	dbStore DBStore
+	search  SearchFunc
+	cache   cache
+	now     func() time.Time
+}
+
+// badgeFunc computes the badge of a repository.
+type badgeFunc func(h *handler, ctx context.Context, repo *types.Repo, r *http.Request) (badge, error)
+
+var badgeFuncs = map[string]badgeFunc{
+	"search":       (*handler).searchBadge,
+	"code-intel":   (*handler).codeIntelBadge,
+	"last-indexed": (*handler).lastIndexedBadge,
+}
+
+func newHandler(db dbutil.DB, dbStore DBStore, search SearchFunc) http.Handler {
+	h := &handler{
+		db:      db,
+		dbStore: dbStore,
+		search:  search,
+		cache:   rcache.NewWithTTL("badges", int(cacheTTL.Seconds())),
+		now:     time.Now,
+	}
+
+	return authMiddleware(h.router())
+}
+
+func (h *handler) router() http.Handler {
+	r := mux.NewRouter().PathPrefix(basePath).Subrouter()
+	r.Path("/{Repo:.+}/-/{Badge}.svg").Methods("GET").HandlerFunc(h.serveBadge)
+	return r
+}
+
+// authMiddleware rejects requests if badges are disabled, and anonymous
+// requests unless badges are public. Anonymous requests keep their
+// unauthenticated actor, so they only see what anonymous users may see.
+func authMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cfg := conf.Get().Badges
+		if cfg == nil || !cfg.Enabled {
+			http.Error(w, "badges are not enabled", http.StatusNotFound)
+			return
+		}
+
+		if !actor.FromContext(r.Context()).IsAuthenticated() && !cfg.Public {
+			http.Error(w, "badges require authentication", http.StatusUnauthorized)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (h *handler) serveBadge(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	fn, ok := badgeFuncs[vars["Badge"]]
+	if !ok {
+		writeError(w, &badgeError{status: http.StatusNotFound, message: "unknown badge"})
+		return
+	}
+
+	// Badges depend on the permissions of the actor, so they are cached per
+	// actor.
+	a := actor.FromContext(ctx)
+	key := fmt.Sprintf("%d:%t:%s:%s?%s", a.UID, a.Internal, vars["Repo"], vars["Badge"], r.URL.RawQuery)
+
+	if data, ok := h.cache.Get(key); ok {
+		var b badge
+		if err := json.Unmarshal(data, &b); err == nil {
+			writeBadge(w, http.StatusOK, b)
+			return
+		}
+	}
+
+	repo, err := database.Repos(h.db).GetByName(ctx, api.RepoName(vars["Repo"]))
+	if err == nil && repo.Private && !a.IsAuthenticated() {
+		// 🚨 SECURITY: Anonymous badges are limited to public repositories,
+		// even if the code host connection of a private repository doesn't
+		// enforce permissions. Private repositories are reported as missing
+		// so that their existence isn't revealed.
+		err = &database.RepoNotFoundErr{Name: repo.Name}
+	}
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			err = &badgeError{status: http.StatusNotFound, message: "repository not found"}
+		}
+		writeError(w, err)
+		return
+	}
+
+	b, err := fn(h, ctx, repo, r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if label := r.URL.Query().Get("label"); label != "" {
+		b.Label = label
+	}
+
+	if data, err := json.Marshal(b); err == nil {
+		h.cache.Set(key, data)
+	}
+	writeBadge(w, http.StatusOK, b)
+}
+
+// badgeError is an error which is rendered as a badge with the given message.
+type badgeError struct {
+	status  int
+	message string
+}
+
+func (e *badgeError) Error() string { return e.message }
+
+func writeError(w http.ResponseWriter, err error) {
+	e, ok := err.(*badgeError)
+	if !ok {
+		log15.Error("computing badge failed", "error", err)
+		e = &badgeError{status: http.StatusInternalServerError, message: "unavailable"}
+	}
+
+	// Errors are not cached, so clients retry them.
+	w.Header().Set("Cache-Control", "no-cache")
+	writeBadge(w, e.status, badge{Label: "sourcegraph", Message: e.message, Color: "lightgrey"})
+}
+
+func writeBadge(w http.ResponseWriter, status int, b badge) {
+	w.Header().Set("Content-Type", "image/svg+xml")
+	if w.Header().Get("Cache-Control") == "" {
+		w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", int(cacheTTL.Seconds())))
+	}
+	w.WriteHeader(status)
+	if _, err := w.Write(renderSVG(b)); err != nil {
+		log15.Warn("writing badge", "error", err)
+	}
+}
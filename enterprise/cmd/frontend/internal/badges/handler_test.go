@@ -0,0 +1,270 @@
+package badges
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/graph-gophers/graphql-go/relay"
+
+	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestAuthMiddleware(t *testing.T) {
+	var gotActor *actor.Actor
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotActor = actor.FromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+	h := authMiddleware(next)
+
+	for _, tc := range []struct {
+		name   string
+		config *schema.Badges
+		actor  *actor.Actor
+		want   int
+	}{
+		{name: "disabled", actor: &actor.Actor{UID: 1}, want: http.StatusNotFound},
+		{name: "anonymous", config: &schema.Badges{Enabled: true}, want: http.StatusUnauthorized},
+		{name: "authenticated", config: &schema.Badges{Enabled: true}, actor: &actor.Actor{UID: 1}, want: http.StatusOK},
+		{name: "anonymous public", config: &schema.Badges{Enabled: true, Public: true}, want: http.StatusOK},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{Badges: tc.config}})
+			defer conf.Mock(nil)
+
+			gotActor = nil
+			req := httptest.NewRequest("GET", basePath+"/github.com/foo/bar/-/code-intel.svg", nil)
+			if tc.actor != nil {
+				req = req.WithContext(actor.WithActor(req.Context(), tc.actor))
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			if rec.Code != tc.want {
+				t.Fatalf("got status %d, want %d", rec.Code, tc.want)
+			}
+			// Anonymous requests must not be elevated to an internal actor.
+			if tc.want == http.StatusOK && (gotActor.Internal || gotActor.IsAuthenticated() != (tc.actor != nil)) {
+				t.Errorf("got actor %+v, want %+v", gotActor, tc.actor)
+			}
+		})
+	}
+}
+
+type mockDBStore struct {
+	uploads []store.Upload
+	opts    []store.GetUploadsOptions
+}
+
+func (s *mockDBStore) GetUploads(ctx context.Context, opts store.GetUploadsOptions) ([]store.Upload, int, error) {
+	s.opts = append(s.opts, opts)
+	return s.uploads, len(s.uploads), nil
+}
+
+type mapCache map[string][]byte
+
+func (c mapCache) Get(key string) ([]byte, bool) { v, ok := c[key]; return v, ok }
+func (c mapCache) Set(key string, b []byte)      { c[key] = b }
+
+func TestServeBadge(t *testing.T) {
+	database.Mocks.Repos.GetByName = func(ctx context.Context, name api.RepoName) (*types.Repo, error) {
+		switch name {
+		case "github.com/foo/bar":
+			return &types.Repo{ID: 42, Name: name}, nil
+		case "github.com/foo/private":
+			return &types.Repo{ID: 43, Name: name, Private: true}, nil
+		}
+		return nil, &database.RepoNotFoundErr{Name: name}
+	}
+	database.Mocks.SavedSearches.GetByID = func(ctx context.Context, id int32) (*api.SavedQuerySpecAndConfig, error) {
+		userID := id
+		return &api.SavedQuerySpecAndConfig{Config: api.ConfigSavedQuery{Query: "TODO patternType:literal", UserID: &userID}}, nil
+	}
+	defer func() { database.Mocks = database.MockStores{} }()
+
+	conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{Badges: &schema.Badges{
+		Enabled:                true,
+		Public:                 true,
+		ShareableSavedSearches: []string{string(relay.MarshalID("SavedSearch", 3))},
+	}}})
+	defer conf.Mock(nil)
+
+	now := time.Unix(1600000000, 0)
+	finishedAt := now.Add(-3 * time.Hour)
+	dbStore := &mockDBStore{uploads: []store.Upload{
+		{ID: 1, Indexer: "lsif-tsc", UploadedAt: now.Add(-4 * time.Hour), FinishedAt: &finishedAt},
+		{ID: 2, Indexer: "lsif-go", UploadedAt: now.Add(-5 * time.Hour)},
+		{ID: 3, Indexer: "lsif-go", UploadedAt: now.Add(-6 * time.Hour)},
+	}}
+
+	var queries []string
+	h := &handler{
+		dbStore: dbStore,
+		search: func(ctx context.Context, query string) (string, error) {
+			queries = append(queries, query)
+			return "12", nil
+		},
+		cache: mapCache{},
+		now:   func() time.Time { return now },
+	}
+	router := h.router()
+
+	for _, tc := range []struct {
+		name       string
+		path       string
+		anonymous  bool
+		want       int
+		wantSVG    string
+		wantQuery  string
+		wantUpload *store.GetUploadsOptions
+	}{
+		{
+			name:       "code intel",
+			path:       "/github.com/foo/bar/-/code-intel.svg",
+			want:       http.StatusOK,
+			wantSVG:    "code intel: precise (lsif-go, lsif-tsc)",
+			wantUpload: &store.GetUploadsOptions{RepositoryID: 42, State: "completed", VisibleAtTip: true, Limit: maxCoverageUploads},
+		},
+		{
+			name:       "last indexed",
+			path:       "/github.com/foo/bar/-/last-indexed.svg?label=indexed",
+			want:       http.StatusOK,
+			wantSVG:    "indexed: 3 hours ago",
+			wantUpload: &store.GetUploadsOptions{RepositoryID: 42, State: "completed", Limit: 1},
+		},
+		{
+			name:      "search",
+			path:      "/github.com/foo/bar/-/search.svg?savedSearch=" + string(relay.MarshalID("SavedSearch", 1)),
+			want:      http.StatusOK,
+			wantSVG:   "search results: 12",
+			wantQuery: `(TODO patternType:literal) repo:^github\.com/foo/bar$`,
+		},
+		{
+			name:      "shareable saved search of other user",
+			path:      "/github.com/foo/bar/-/search.svg?savedSearch=" + string(relay.MarshalID("SavedSearch", 3)),
+			want:      http.StatusOK,
+			wantSVG:   "search results: 12",
+			wantQuery: `(TODO patternType:literal) repo:^github\.com/foo/bar$`,
+		},
+		{
+			name:      "anonymous shareable saved search",
+			path:      "/github.com/foo/bar/-/search.svg?savedSearch=" + string(relay.MarshalID("SavedSearch", 3)),
+			anonymous: true,
+			want:      http.StatusOK,
+			wantSVG:   "search results: 12",
+			wantQuery: `(TODO patternType:literal) repo:^github\.com/foo/bar$`,
+		},
+		{
+			name:      "anonymous saved search",
+			path:      "/github.com/foo/bar/-/search.svg?savedSearch=" + string(relay.MarshalID("SavedSearch", 1)),
+			anonymous: true,
+			want:      http.StatusNotFound,
+			wantSVG:   "saved search not found",
+		},
+		{
+			name:      "anonymous private repository",
+			path:      "/github.com/foo/private/-/code-intel.svg",
+			anonymous: true,
+			want:      http.StatusNotFound,
+			wantSVG:   "repository not found",
+		},
+		{
+			name:       "authenticated private repository",
+			path:       "/github.com/foo/private/-/last-indexed.svg",
+			want:       http.StatusOK,
+			wantSVG:    "last indexed: 3 hours ago",
+			wantUpload: &store.GetUploadsOptions{RepositoryID: 43, State: "completed", Limit: 1},
+		},
+		{
+			name:    "saved search of other user",
+			path:    "/github.com/foo/bar/-/search.svg?savedSearch=" + string(relay.MarshalID("SavedSearch", 2)),
+			want:    http.StatusNotFound,
+			wantSVG: "saved search not found",
+		},
+		{
+			name:    "invalid saved search",
+			path:    "/github.com/foo/bar/-/search.svg?savedSearch=foo",
+			want:    http.StatusBadRequest,
+			wantSVG: "invalid saved search",
+		},
+		{
+			name:    "unknown repository",
+			path:    "/github.com/foo/baz/-/code-intel.svg",
+			want:    http.StatusNotFound,
+			wantSVG: "repository not found",
+		},
+		{
+			name:    "unknown badge",
+			path:    "/github.com/foo/bar/-/stars.svg",
+			want:    http.StatusNotFound,
+			wantSVG: "unknown badge",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			dbStore.opts = nil
+			queries = nil
+
+			req := httptest.NewRequest("GET", basePath+tc.path, nil)
+			if !tc.anonymous {
+				req = req.WithContext(actor.WithActor(req.Context(), &actor.Actor{UID: 1}))
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tc.want {
+				t.Fatalf("got status %d, want %d", rec.Code, tc.want)
+			}
+			if got := rec.Header().Get("Content-Type"); got != "image/svg+xml" {
+				t.Errorf("got content type %q", got)
+			}
+			if !strings.Contains(rec.Body.String(), "<title>"+tc.wantSVG+"</title>") {
+				t.Errorf("got badge %s, want title %q", rec.Body.String(), tc.wantSVG)
+			}
+			if tc.wantQuery != "" && (len(queries) != 1 || queries[0] != tc.wantQuery) {
+				t.Errorf("got queries %q, want %q", queries, tc.wantQuery)
+			}
+			if tc.wantUpload != nil && (len(dbStore.opts) != 1 || dbStore.opts[0] != *tc.wantUpload) {
+				t.Errorf("got GetUploads options %+v, want %+v", dbStore.opts, *tc.wantUpload)
+			}
+		})
+	}
+
+	t.Run("cached", func(t *testing.T) {
+		dbStore.opts = nil
+
+		req := httptest.NewRequest("GET", basePath+"/github.com/foo/bar/-/code-intel.svg", nil)
+		req = req.WithContext(actor.WithActor(req.Context(), &actor.Actor{UID: 1}))
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("got status %d, want %d", rec.Code, http.StatusOK)
+		}
+		if len(dbStore.opts) != 0 {
+			t.Errorf("want cached badge, got %d GetUploads calls", len(dbStore.opts))
+		}
+	})
+}
+
+func TestFormatAge(t *testing.T) {
+	for d, want := range map[time.Duration]string{
+		10 * time.Second: "just now",
+		time.Minute:      "1 minute ago",
+		59 * time.Minute: "59 minutes ago",
+		47 * time.Hour:   "47 hours ago",
+		72 * time.Hour:   "3 days ago",
+	} {
+		if got := formatAge(d); got != want {
+			t.Errorf("formatAge(%s): got %q, want %q", d, got, want)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package badges
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/enterprise"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/oobmigration"
+)
+
+func Init(ctx context.Context, db dbutil.DB, outOfBandMigrationRunner *oobmigration.Runner, enterpriseServices *enterprise.Services) error {
+	dbStore, err := codeintel.DBStore(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	enterpriseServices.BadgesHandler = newHandler(db, dbStore, newSearchFunc(db))
+	return nil
+}
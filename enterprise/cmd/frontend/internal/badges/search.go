@@ -0,0 +1,35 @@
+package badges
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+)
+
+// newSearchFunc returns a function that counts the results of a search query
+// as the actor of the given context.
+func newSearchFunc(db dbutil.DB) SearchFunc {
+	return func(ctx context.Context, query string) (string, error) {
+		impl, err := graphqlbackend.NewSearchImplementer(ctx, db, &graphqlbackend.SearchArgs{
+			Version: "V2",
+			Query:   query,
+		})
+		if err != nil {
+			return "", err
+		}
+
+		results, err := impl.Results(ctx)
+		if err != nil {
+			return "", err
+		}
+		if alert := results.Alert(); alert != nil && len(results.Matches) == 0 {
+			// Invalid queries are reported as alerts rather than errors
+			return "", errors.New(alert.Title())
+		}
+
+		return results.ApproximateResultCount(), nil
+	}
+}
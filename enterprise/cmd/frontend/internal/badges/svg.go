@@ -0,0 +1,90 @@
+package badges
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+)
+
+// badge is the content of a badge. It is cached as JSON.
+type badge struct {
+	Label   string `json:"label"`
+	Message string `json:"message"`
+	Color   string `json:"color"`
+}
+
+// colors are the colors of the messages of badges, named like the colors of
+// shields.io badges.
+var colors = map[string]string{
+	"brightgreen": "#4c1",
+	"green":       "#97ca00",
+	"yellow":      "#dfb317",
+	"orange":      "#fe7d37",
+	"red":         "#e05d44",
+	"blue":        "#007ec6",
+	"lightgrey":   "#9f9f9f",
+}
+
+const (
+	badgeHeight = 20
+
+	// textPadding is the horizontal space on each side of the label and
+	// the message.
+	textPadding = 6
+)
+
+// renderSVG renders b as a flat badge in the style of shields.io.
+func renderSVG(b badge) []byte {
+	color, ok := colors[b.Color]
+	if !ok {
+		color = colors["lightgrey"]
+	}
+
+	labelWidth := textWidth(b.Label) + 2*textPadding
+	messageWidth := textWidth(b.Message) + 2*textPadding
+	width := labelWidth + messageWidth
+
+	label := html.EscapeString(b.Label)
+	message := html.EscapeString(b.Message)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" role="img" aria-label="%s: %s">`, width, badgeHeight, label, message)
+	fmt.Fprintf(&buf, `<title>%s: %s</title>`, label, message)
+	buf.WriteString(`<linearGradient id="s" x2="0" y2="100%"><stop offset="0" stop-color="#bbb" stop-opacity=".1"/><stop offset="1" stop-opacity=".1"/></linearGradient>`)
+	fmt.Fprintf(&buf, `<clipPath id="r"><rect width="%d" height="%d" rx="3" fill="#fff"/></clipPath>`, width, badgeHeight)
+	fmt.Fprintf(&buf, `<g clip-path="url(#r)"><rect width="%d" height="%d" fill="#555"/><rect x="%d" width="%d" height="%d" fill="%s"/><rect width="%d" height="%d" fill="url(#s)"/></g>`, labelWidth, badgeHeight, labelWidth, messageWidth, badgeHeight, color, width, badgeHeight)
+	buf.WriteString(`<g fill="#fff" text-anchor="middle" font-family="Verdana,Geneva,DejaVu Sans,sans-serif" font-size="11">`)
+	for _, text := range []struct {
+		x     float64
+		value string
+	}{
+		{float64(labelWidth) / 2, label},
+		{float64(labelWidth) + float64(messageWidth)/2, message},
+	} {
+		fmt.Fprintf(&buf, `<text x="%.1f" y="15" fill="#010101" fill-opacity=".3">%s</text><text x="%.1f" y="14">%s</text>`, text.x, text.value, text.x, text.value)
+	}
+	buf.WriteString(`</g></svg>`)
+
+	return buf.Bytes()
+}
+
+// textWidth approximates the width in pixels of s rendered in 11px Verdana.
+// Badges are rendered by browsers, so the exact font metrics are unknown.
+func textWidth(s string) int {
+	var width float64
+	for _, r := range s {
+		switch {
+		case r == ' ' || r == 'i' || r == 'j' || r == 'l' || r == '.' || r == ',' || r == ':' || r == '|' || r == '\'':
+			width += 3.5
+		case r == 'f' || r == 'r' || r == 't' || r == '(' || r == ')' || r == '-':
+			width += 5
+		case r == 'm' || r == 'w' || r == 'M' || r == 'W':
+			width += 10.5
+		case r >= 'A' && r <= 'Z':
+			width += 8
+		default:
+			width += 7
+		}
+	}
+	return int(width + 0.5)
+}
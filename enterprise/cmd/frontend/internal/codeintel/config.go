@@ -10,18 +10,28 @@ import (
 type Config struct {
 	env.BaseConfig
 
-	UploadStoreConfig                         *uploadstore.Config
-	HunkCacheSize                             int
-	DiagnosticsCountMigrationBatchSize        int
-	DiagnosticsCountMigrationBatchInterval    time.Duration
-	DefinitionsCountMigrationBatchSize        int
-	DefinitionsCountMigrationBatchInterval    time.Duration
-	ReferencesCountMigrationBatchSize         int
-	ReferencesCountMigrationBatchInterval     time.Duration
-	DocumentColumnSplitMigrationBatchSize     int
-	DocumentColumnSplitMigrationBatchInterval time.Duration
-	CommittedAtMigrationBatchSize             int
-	CommittedAtMigrationBatchInterval         time.Duration
+	UploadStoreConfig                                  *uploadstore.Config
+	HunkCacheSize                                      int
+	HoverMergeStrategy                                 string
+	ReferenceCountLimit                                int
+	DiagnosticsCountMigrationBatchSize                 int
+	DiagnosticsCountMigrationBatchInterval             time.Duration
+	DefinitionsCountMigrationBatchSize                 int
+	DefinitionsCountMigrationBatchInterval             time.Duration
+	ReferencesCountMigrationBatchSize                  int
+	ReferencesCountMigrationBatchInterval              time.Duration
+	DocumentColumnSplitMigrationBatchSize              int
+	DocumentColumnSplitMigrationBatchInterval          time.Duration
+	CommittedAtMigrationBatchSize                      int
+	CommittedAtMigrationBatchInterval                  time.Duration
+	DocumentZstdRecompressionMigrationBatchSize        int
+	DocumentZstdRecompressionMigrationBatchInterval    time.Duration
+	ResultChunkZstdRecompressionMigrationBatchSize     int
+	ResultChunkZstdRecompressionMigrationBatchInterval time.Duration
+	DefinitionsZstdRecompressionMigrationBatchSize     int
+	DefinitionsZstdRecompressionMigrationBatchInterval time.Duration
+	ReferencesZstdRecompressionMigrationBatchSize      int
+	ReferencesZstdRecompressionMigrationBatchInterval  time.Duration
 }
 
 var config = &Config{}
@@ -32,6 +42,8 @@ func init() {
 	config.UploadStoreConfig = uploadStoreConfig
 
 	config.HunkCacheSize = config.GetInt("PRECISE_CODE_INTEL_HUNK_CACHE_SIZE", "1000", "The capacity of the git diff hunk cache.")
+	config.HoverMergeStrategy = config.Get("PRECISE_CODE_INTEL_HOVER_MERGE_STRATEGY", "first", "How hover text from multiple uploads is combined (first, concatenate, or prefer-precise).")
+	config.ReferenceCountLimit = config.GetInt("PRECISE_CODE_INTEL_REFERENCE_COUNT_LIMIT", "1000", "The maximum number of references counted for a single symbol.")
 	config.DiagnosticsCountMigrationBatchSize = config.GetInt("PRECISE_CODE_INTEL_DIAGNOSTICS_COUNT_MIGRATION_BATCH_SIZE", "1000", "The maximum number of document records to migrate at a time.")
 	config.DiagnosticsCountMigrationBatchInterval = config.GetInterval("PRECISE_CODE_INTEL_DIAGNOSTICS_COUNT_MIGRATION_BATCH_INTERVAL", "1s", "The timeout between processing migration batches.")
 	config.DefinitionsCountMigrationBatchSize = config.GetInt("PRECISE_CODE_INTEL_DEFINITIONS_COUNT_MIGRATION_BATCH_SIZE", "1000", "The maximum number of definition records to migrate at once.")
@@ -42,4 +54,12 @@ func init() {
 	config.DocumentColumnSplitMigrationBatchInterval = config.GetInterval("PRECISE_CODE_INTEL_DOCUMENT_COLUMN_SPLIT_MIGRATION_BATCH_INTERVAL", "1s", "The timeout between processing migration batches.")
 	config.CommittedAtMigrationBatchSize = config.GetInt("PRECISE_CODE_INTEL_COMMITTED_AT_MIGRATION_BATCH_SIZE", "100", "The maximum number of upload records to migrate at a time.")
 	config.CommittedAtMigrationBatchInterval = config.GetInterval("PRECISE_CODE_INTEL_COMMITTED_AT_MIGRATION_BATCH_INTERVAL", "1s", "The timeout between processing migration batches.")
+	config.DocumentZstdRecompressionMigrationBatchSize = config.GetInt("PRECISE_CODE_INTEL_DOCUMENT_ZSTD_RECOMPRESSION_MIGRATION_BATCH_SIZE", "100", "The maximum number of document records to migrate at a time.")
+	config.DocumentZstdRecompressionMigrationBatchInterval = config.GetInterval("PRECISE_CODE_INTEL_DOCUMENT_ZSTD_RECOMPRESSION_MIGRATION_BATCH_INTERVAL", "1s", "The timeout between processing migration batches.")
+	config.ResultChunkZstdRecompressionMigrationBatchSize = config.GetInt("PRECISE_CODE_INTEL_RESULT_CHUNK_ZSTD_RECOMPRESSION_MIGRATION_BATCH_SIZE", "100", "The maximum number of result chunk records to migrate at a time.")
+	config.ResultChunkZstdRecompressionMigrationBatchInterval = config.GetInterval("PRECISE_CODE_INTEL_RESULT_CHUNK_ZSTD_RECOMPRESSION_MIGRATION_BATCH_INTERVAL", "1s", "The timeout between processing migration batches.")
+	config.DefinitionsZstdRecompressionMigrationBatchSize = config.GetInt("PRECISE_CODE_INTEL_DEFINITIONS_ZSTD_RECOMPRESSION_MIGRATION_BATCH_SIZE", "1000", "The maximum number of definition records to migrate at a time.")
+	config.DefinitionsZstdRecompressionMigrationBatchInterval = config.GetInterval("PRECISE_CODE_INTEL_DEFINITIONS_ZSTD_RECOMPRESSION_MIGRATION_BATCH_INTERVAL", "1s", "The timeout between processing migration batches.")
+	config.ReferencesZstdRecompressionMigrationBatchSize = config.GetInt("PRECISE_CODE_INTEL_REFERENCES_ZSTD_RECOMPRESSION_MIGRATION_BATCH_SIZE", "1000", "The maximum number of reference records to migrate at a time.")
+	config.ReferencesZstdRecompressionMigrationBatchInterval = config.GetInterval("PRECISE_CODE_INTEL_REFERENCES_ZSTD_RECOMPRESSION_MIGRATION_BATCH_INTERVAL", "1s", "The timeout between processing migration batches.")
 }
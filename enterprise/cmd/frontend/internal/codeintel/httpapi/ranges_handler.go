@@ -0,0 +1,205 @@
+package httpapi
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
+	gql "github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers"
+	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+)
+
+type RangesHandler struct {
+	resolver resolvers.Resolver
+}
+
+// NewRangesHandler creates a new HTTP handler that returns the code intelligence for every range
+// of a single document. The response is identified by an entity tag so that clients (such as the
+// browser extension) can cache entire documents and revalidate them cheaply.
+//
+// This handler is served behind the API gzip middleware, which compresses the (often large) JSON
+// payload for any client that accepts a gzip-encoded response.
+func NewRangesHandler(resolver resolvers.Resolver) http.Handler {
+	handler := &RangesHandler{
+		resolver: resolver,
+	}
+
+	return http.HandlerFunc(handler.handleRanges)
+}
+
+type rangesPayload struct {
+	Ranges []rangePayload `json:"ranges"`
+}
+
+type rangePayload struct {
+	Source      *sourcePayload    `json:"source,omitempty"`
+	Range       rangeJSON         `json:"range"`
+	Definitions []locationPayload `json:"definitions"`
+	References  []locationPayload `json:"references"`
+	HoverText   string            `json:"hoverText"`
+}
+
+// sourcePayload identifies the upload that provided the code intelligence for a range.
+type sourcePayload struct {
+	UploadID int    `json:"uploadId"`
+	Indexer  string `json:"indexer"`
+	Commit   string `json:"commit"`
+	Root     string `json:"root"`
+}
+
+type locationPayload struct {
+	Repository string    `json:"repository"`
+	Commit     string    `json:"commit"`
+	Path       string    `json:"path"`
+	Range      rangeJSON `json:"range"`
+}
+
+type rangeJSON struct {
+	Start positionJSON `json:"start"`
+	End   positionJSON `json:"end"`
+}
+
+type positionJSON struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+}
+
+// GET /ranges
+func (h *RangesHandler) handleRanges(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	repoName := getQuery(r, "repository")
+	commit := getQuery(r, "commit")
+	path := getQuery(r, "path")
+
+	// Only exact commits are accepted so that the response can be cached by the client
+	if !revhashPattern.Match([]byte(commit)) {
+		http.Error(w, "Commit must be a 40-character revhash", http.StatusBadRequest)
+		return
+	}
+	if path == "" {
+		http.Error(w, "No path supplied", http.StatusBadRequest)
+		return
+	}
+
+	// 🚨 SECURITY: The repository is resolved with the actor of the request so that the
+	// user cannot read code intelligence for a repository they are not permitted to see.
+	repo, err := backend.Repos.GetByName(ctx, api.RepoName(repoName))
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			http.Error(w, fmt.Sprintf("unknown repository %q", repoName), http.StatusNotFound)
+			return
+		}
+
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	queryResolver, err := h.resolver.QueryResolver(ctx, &gql.GitBlobLSIFDataArgs{
+		Repo:      repo,
+		Commit:    api.CommitID(commit),
+		Path:      path,
+		ExactPath: true,
+	})
+	if err != nil {
+		log15.Error("Failed to resolve code intelligence for document", "error", err)
+		http.Error(w, fmt.Sprintf("failed to resolve code intelligence: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	var ranges []resolvers.AdjustedCodeIntelligenceRange
+	if queryResolver != nil {
+		if ranges, err = queryResolver.RangesAll(ctx); err != nil {
+			log15.Error("Failed to resolve ranges for document", "error", err)
+			http.Error(w, fmt.Sprintf("failed to resolve ranges: %s", err.Error()), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	data, err := json.Marshal(newRangesPayload(ranges))
+	if err != nil {
+		log15.Error("Failed to serialize result", "error", err)
+		http.Error(w, fmt.Sprintf("failed to serialize result: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	etag := makeETag(data)
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "private, no-cache")
+
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	copyAll(w, bytes.NewReader(data))
+}
+
+// newRangesPayload converts the given ranges into their API representation.
+func newRangesPayload(ranges []resolvers.AdjustedCodeIntelligenceRange) rangesPayload {
+	payload := rangesPayload{Ranges: make([]rangePayload, 0, len(ranges))}
+	for _, rn := range ranges {
+		payload.Ranges = append(payload.Ranges, rangePayload{
+			Source:      newSourcePayload(rn.Dump),
+			Range:       newRangeJSON(rn.Range),
+			Definitions: newLocationPayloads(rn.Definitions),
+			References:  newLocationPayloads(rn.References),
+			HoverText:   rn.HoverText,
+		})
+	}
+
+	return payload
+}
+
+// newSourcePayload converts the given dump into its API representation. This method returns nil if
+// the range has no originating upload.
+func newSourcePayload(dump store.Dump) *sourcePayload {
+	if dump.ID == 0 {
+		return nil
+	}
+
+	return &sourcePayload{
+		UploadID: dump.ID,
+		Indexer:  dump.Indexer,
+		Commit:   dump.Commit,
+		Root:     dump.Root,
+	}
+}
+
+// newLocationPayloads converts the given locations into their API representation.
+func newLocationPayloads(locations []resolvers.AdjustedLocation) []locationPayload {
+	payloads := make([]locationPayload, 0, len(locations))
+	for _, location := range locations {
+		payloads = append(payloads, locationPayload{
+			Repository: location.Dump.RepositoryName,
+			Commit:     location.AdjustedCommit,
+			Path:       location.Path,
+			Range:      newRangeJSON(location.AdjustedRange),
+		})
+	}
+
+	return payloads
+}
+
+func newRangeJSON(r lsifstore.Range) rangeJSON {
+	return rangeJSON{
+		Start: positionJSON{Line: r.Start.Line, Character: r.Start.Character},
+		End:   positionJSON{Line: r.End.Line, Character: r.End.Character},
+	}
+}
+
+// makeETag returns a strong entity tag for the given response payload.
+func makeETag(data []byte) string {
+	sum := sha256.Sum256(data)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
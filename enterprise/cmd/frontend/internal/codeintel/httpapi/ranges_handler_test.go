@@ -0,0 +1,115 @@
+package httpapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	gql "github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers"
+	resolvermocks "github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers/mocks"
+	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+)
+
+func TestHandleRanges(t *testing.T) {
+	setupRepoMocks(t)
+
+	mockResolver := resolvermocks.NewMockResolver()
+	mockQueryResolver := resolvermocks.NewMockQueryResolver()
+	mockResolver.QueryResolverFunc.SetDefaultHook(func(ctx context.Context, args *gql.GitBlobLSIFDataArgs) (resolvers.QueryResolver, error) {
+		if args.Repo.ID != 50 || string(args.Commit) != testCommit || args.Path != "main.go" || !args.ExactPath {
+			t.Errorf("unexpected query resolver args: %+v", args)
+		}
+		return mockQueryResolver, nil
+	})
+
+	testRange := lsifstore.Range{Start: lsifstore.Position{Line: 1, Character: 2}, End: lsifstore.Position{Line: 1, Character: 5}}
+	mockQueryResolver.RangesAllFunc.SetDefaultReturn([]resolvers.AdjustedCodeIntelligenceRange{
+		{
+			Dump:      store.Dump{ID: 42, Commit: testCommit, Root: "sub/", Indexer: "lsif-go"},
+			Range:     testRange,
+			HoverText: "text",
+			Definitions: []resolvers.AdjustedLocation{
+				{Dump: store.Dump{RepositoryName: "github.com/test/test"}, Path: "def.go", AdjustedCommit: testCommit, AdjustedRange: testRange},
+			},
+		},
+	}, nil)
+
+	testURL, err := url.Parse("http://test.com/ranges")
+	if err != nil {
+		t.Fatalf("unexpected error constructing url: %s", err)
+	}
+	testURL.RawQuery = (url.Values{
+		"repository": []string{"github.com/test/test"},
+		"commit":     []string{testCommit},
+		"path":       []string{"main.go"},
+	}).Encode()
+
+	h := &RangesHandler{resolver: mockResolver}
+
+	w := httptest.NewRecorder()
+	r, err := http.NewRequest("GET", testURL.String(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error constructing request: %s", err)
+	}
+	h.handleRanges(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("unexpected status code. want=%d have=%d", http.StatusOK, w.Code)
+	}
+
+	expectedPayload := `{"ranges":[{"source":{"uploadId":42,"indexer":"lsif-go","commit":"` + testCommit + `","root":"sub/"},` +
+		`"range":{"start":{"line":1,"character":2},"end":{"line":1,"character":5}},` +
+		`"definitions":[{"repository":"github.com/test/test","commit":"` + testCommit + `","path":"def.go",` +
+		`"range":{"start":{"line":1,"character":2},"end":{"line":1,"character":5}}}],"references":[],"hoverText":"text"}]}`
+	if diff := cmp.Diff(expectedPayload, w.Body.String()); diff != "" {
+		t.Errorf("unexpected response payload (-want +got):\n%s", diff)
+	}
+
+	etag := w.Header().Get("ETag")
+	if etag == "" {
+		t.Fatalf("expected an entity tag")
+	}
+
+	// Revalidate with the entity tag of the previous response
+	w = httptest.NewRecorder()
+	r.Header.Set("If-None-Match", etag)
+	h.handleRanges(w, r)
+
+	if w.Code != http.StatusNotModified {
+		t.Errorf("unexpected status code. want=%d have=%d", http.StatusNotModified, w.Code)
+	}
+	if w.Body.Len() != 0 {
+		t.Errorf("unexpected response payload: %s", w.Body.String())
+	}
+}
+
+func TestHandleRangesInvalidCommit(t *testing.T) {
+	testURL, err := url.Parse("http://test.com/ranges")
+	if err != nil {
+		t.Fatalf("unexpected error constructing url: %s", err)
+	}
+	testURL.RawQuery = (url.Values{
+		"repository": []string{"github.com/test/test"},
+		"commit":     []string{"main"},
+		"path":       []string{"main.go"},
+	}).Encode()
+
+	w := httptest.NewRecorder()
+	r, err := http.NewRequest("GET", testURL.String(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error constructing request: %s", err)
+	}
+
+	h := &RangesHandler{resolver: resolvermocks.NewMockResolver()}
+	h.handleRanges(w, r)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("unexpected status code. want=%d have=%d", http.StatusBadRequest, w.Code)
+	}
+}
@@ -10,7 +10,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/enterprise"
-	gql "github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	codeintelhttpapi "github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/httpapi"
 	codeintelresolvers "github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers"
 	codeintelgqlresolvers "github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers/graphql"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
@@ -34,7 +34,7 @@ func Init(ctx context.Context, db dbutil.DB, outOfBandMigrationRunner *oobmigrat
 		return err
 	}
 
-	resolver, err := newResolver(ctx, db, observationContext)
+	innerResolver, err := newInnerResolver(observationContext)
 	if err != nil {
 		return err
 	}
@@ -44,28 +44,38 @@ func Init(ctx context.Context, db dbutil.DB, outOfBandMigrationRunner *oobmigrat
 		return err
 	}
 
-	enterpriseServices.CodeIntelResolver = resolver
+	enterpriseServices.CodeIntelResolver = codeintelgqlresolvers.NewResolver(db, innerResolver)
 	enterpriseServices.NewCodeIntelUploadHandler = uploadHandler
+	enterpriseServices.CodeIntelRangesHandler = codeintelhttpapi.NewRangesHandler(innerResolver)
 	return nil
 }
 
-func newResolver(ctx context.Context, db dbutil.DB, observationContext *observation.Context) (gql.CodeIntelResolver, error) {
-	hunkCache, err := codeintelresolvers.NewHunkCache(config.HunkCacheSize)
+func newInnerResolver(observationContext *observation.Context) (codeintelresolvers.Resolver, error) {
+	hunkCache, err := codeintelresolvers.NewHunkCache(config.HunkCacheSize, observationContext)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize hunk cache: %s", err)
 	}
 
+	hoverMergeStrategy, err := codeintelresolvers.ParseHoverMergeStrategy(config.HoverMergeStrategy)
+	if err != nil {
+		return nil, err
+	}
+
 	innerResolver := codeintelresolvers.NewResolver(
 		services.dbStore,
-		services.lsifStore,
+		services.shardedLSIFStore,
 		services.gitserverClient,
 		services.indexEnqueuer,
+		services.searchClient,
 		hunkCache,
+		codeintelresolvers.ResolverOptions{
+			HoverMergeStrategy:  hoverMergeStrategy,
+			ReferenceCountLimit: config.ReferenceCountLimit,
+		},
 		observationContext,
 	)
-	resolver := codeintelgqlresolvers.NewResolver(db, innerResolver)
 
-	return resolver, err
+	return innerResolver, nil
 }
 
 func newUploadHandler(ctx context.Context, db dbutil.DB) (func(internal bool) http.Handler, error) {
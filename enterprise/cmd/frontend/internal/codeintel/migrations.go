@@ -52,5 +52,37 @@ func registerMigrations(ctx context.Context, db dbutil.DB, outOfBandMigrationRun
 		return err
 	}
 
+	if err := outOfBandMigrationRunner.Register(
+		lsifmigrations.DocumentZstdRecompressionMigrationID, // 11
+		lsifmigrations.NewDocumentZstdRecompressionMigrator(services.lsifStore, config.DocumentZstdRecompressionMigrationBatchSize),
+		oobmigration.MigratorOptions{Interval: config.DocumentZstdRecompressionMigrationBatchInterval},
+	); err != nil {
+		return err
+	}
+
+	if err := outOfBandMigrationRunner.Register(
+		lsifmigrations.ResultChunkZstdRecompressionMigrationID, // 12
+		lsifmigrations.NewResultChunkZstdRecompressionMigrator(services.lsifStore, config.ResultChunkZstdRecompressionMigrationBatchSize),
+		oobmigration.MigratorOptions{Interval: config.ResultChunkZstdRecompressionMigrationBatchInterval},
+	); err != nil {
+		return err
+	}
+
+	if err := outOfBandMigrationRunner.Register(
+		lsifmigrations.DefinitionsZstdRecompressionMigrationID, // 13
+		lsifmigrations.NewLocationsZstdRecompressionMigrator(services.lsifStore, "lsif_data_definitions", config.DefinitionsZstdRecompressionMigrationBatchSize),
+		oobmigration.MigratorOptions{Interval: config.DefinitionsZstdRecompressionMigrationBatchInterval},
+	); err != nil {
+		return err
+	}
+
+	if err := outOfBandMigrationRunner.Register(
+		lsifmigrations.ReferencesZstdRecompressionMigrationID, // 14
+		lsifmigrations.NewLocationsZstdRecompressionMigrator(services.lsifStore, "lsif_data_references", config.ReferencesZstdRecompressionMigrationBatchSize),
+		oobmigration.MigratorOptions{Interval: config.ReferencesZstdRecompressionMigrationBatchInterval},
+	); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -0,0 +1,170 @@
+package resolvers
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/inventory"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+const slowCoverageRequestThreshold = 5 * time.Second
+
+// DefaultCoverageCommits is the number of recent commits inspected by Coverage when no
+// explicit number of commits is requested.
+const DefaultCoverageCommits = 100
+
+// Coverage describes how much of a repository branch is covered by precise code intelligence.
+type Coverage struct {
+	// Commit is the commit at the tip of the branch.
+	Commit string
+
+	// NumCommits is the number of recent commits of the branch that were inspected, and
+	// NumCoveredCommits is the number of those commits from which at least one upload is visible.
+	NumCommits        int
+	NumCoveredCommits int
+
+	// Languages describes the files at the tip of the branch grouped by language.
+	Languages []LanguageCoverage
+}
+
+// LanguageCoverage describes how many files of a single language are covered by an upload.
+type LanguageCoverage struct {
+	Language        string
+	NumFiles        int
+	NumCoveredFiles int
+}
+
+// anyFilePattern matches every non-empty path returned by the gitserver client.
+var anyFilePattern = regexp.MustCompile(".")
+
+// Coverage returns the number of recent commits of the given branch of a repository from which an upload
+// is visible, as well as the number of files at the tip of the branch that are covered by at least one
+// visible upload, grouped by language. If no branch is supplied, the default branch is used.
+func (r *resolver) Coverage(ctx context.Context, repositoryID int, branch string, numCommits int) (_ Coverage, err error) {
+	ctx, traceLog, endObservation := observeResolver(ctx, &err, "Coverage", r.operations.coverage, r.resolverOptions().slowRequestThreshold("Coverage", slowCoverageRequestThreshold), observation.Args{
+		LogFields: []log.Field{
+			log.Int("repositoryID", repositoryID),
+			log.String("branch", branch),
+			log.Int("numCommits", numCommits),
+		},
+	})
+	defer endObservation()
+
+	if branch == "" {
+		branch = "HEAD"
+	}
+	if numCommits <= 0 {
+		numCommits = DefaultCoverageCommits
+	}
+
+	graph, err := r.gitserverClient.CommitGraph(ctx, repositoryID, gitserver.CommitGraphOptions{
+		Commit: branch,
+		Limit:  numCommits,
+	})
+	if err != nil {
+		return Coverage{}, errors.Wrap(err, "gitserverClient.CommitGraph")
+	}
+
+	// The commit graph orders parents before children and may be prefixed by the parents of
+	// the oldest requested commits, which are not themselves part of the requested range.
+	commits := graph.Order()
+	if len(commits) > numCommits {
+		commits = commits[len(commits)-numCommits:]
+	}
+	if len(commits) == 0 {
+		return Coverage{}, nil
+	}
+	tip := commits[len(commits)-1]
+	traceLog(log.Int("numCommits", len(commits)), log.String("tip", tip))
+
+	numCoveredCommits, err := r.dbStore.CountCoveredCommits(ctx, repositoryID, commits)
+	if err != nil {
+		return Coverage{}, errors.Wrap(err, "dbStore.CountCoveredCommits")
+	}
+
+	covered, err := r.coveredPaths(ctx, repositoryID, tip)
+	if err != nil {
+		return Coverage{}, err
+	}
+	traceLog(log.Int("numCoveredPaths", len(covered)))
+
+	files, err := r.gitserverClient.ListFiles(ctx, repositoryID, tip, anyFilePattern)
+	if err != nil {
+		return Coverage{}, errors.Wrap(err, "gitserverClient.ListFiles")
+	}
+	traceLog(log.Int("numFiles", len(files)))
+
+	return Coverage{
+		Commit:            tip,
+		NumCommits:        len(commits),
+		NumCoveredCommits: numCoveredCommits,
+		Languages:         languageCoverage(files, covered),
+	}, nil
+}
+
+// coveredPaths returns the set of repository-relative paths that are indexed by an upload visible
+// from the given commit.
+func (r *resolver) coveredPaths(ctx context.Context, repositoryID int, commit string) (map[string]struct{}, error) {
+	dumps, err := r.dbStore.FindClosestDumps(ctx, repositoryID, commit, "", false, "")
+	if err != nil {
+		return nil, errors.Wrap(err, "dbStore.FindClosestDumps")
+	}
+
+	covered := map[string]struct{}{}
+	for _, dump := range dumps {
+		paths, err := r.lsifStore.DocumentPaths(ctx, dump.ID)
+		if err != nil {
+			return nil, errors.Wrap(err, "lsifStore.DocumentPaths")
+		}
+
+		for _, path := range paths {
+			covered[dump.Root+path] = struct{}{}
+		}
+	}
+
+	return covered, nil
+}
+
+// languageCoverage groups the given files by language and counts the number of files of each language
+// that occur in the given covered set. Files without a recognized language are ignored. The resulting
+// slice is ordered by descending number of files.
+func languageCoverage(files []string, covered map[string]struct{}) []LanguageCoverage {
+	byLanguage := map[string]*LanguageCoverage{}
+	for _, file := range files {
+		language, _ := inventory.GetLanguageByFilename(file)
+		if language == "" {
+			continue
+		}
+
+		coverage, ok := byLanguage[language]
+		if !ok {
+			coverage = &LanguageCoverage{Language: language}
+			byLanguage[language] = coverage
+		}
+
+		coverage.NumFiles++
+		if _, ok := covered[file]; ok {
+			coverage.NumCoveredFiles++
+		}
+	}
+
+	languages := make([]LanguageCoverage, 0, len(byLanguage))
+	for _, coverage := range byLanguage {
+		languages = append(languages, *coverage)
+	}
+	sort.Slice(languages, func(i, j int) bool {
+		if languages[i].NumFiles != languages[j].NumFiles {
+			return languages[i].NumFiles > languages[j].NumFiles
+		}
+		return languages[i].Language < languages[j].Language
+	})
+
+	return languages
+}
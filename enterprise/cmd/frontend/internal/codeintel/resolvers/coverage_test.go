@@ -0,0 +1,95 @@
+package resolvers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/gitserver"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestCoverage(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockGitserverClient := NewMockGitserverClient()
+
+	// The oldest requested commit's parent (a) is included in the graph but not in the range
+	mockGitserverClient.CommitGraphFunc.PushReturn(gitserver.ParseCommitGraph([]string{"d c", "c b", "b a"}), nil)
+	mockGitserverClient.ListFilesFunc.PushReturn([]string{
+		"main.go",
+		"sub1/a.go",
+		"sub1/b.go",
+		"sub2/c.ts",
+		"sub2/d.ts",
+		"README",
+	}, nil)
+	mockDBStore.CountCoveredCommitsFunc.PushReturn(2, nil)
+	mockDBStore.FindClosestDumpsFunc.PushReturn([]dbstore.Dump{
+		{ID: 50, Root: "sub1/"},
+		{ID: 51, Root: "sub2/"},
+	}, nil)
+	mockLSIFStore.DocumentPathsFunc.PushReturn([]string{"a.go", "b.go"}, nil)
+	mockLSIFStore.DocumentPathsFunc.PushReturn([]string{"c.ts"}, nil)
+
+	resolver := newResolver(mockDBStore, mockLSIFStore, mockGitserverClient, nil, nil, nil, ResolverOptions{}, &observation.TestContext)
+
+	coverage, err := resolver.Coverage(context.Background(), 42, "main", 3)
+	if err != nil {
+		t.Fatalf("unexpected error computing coverage: %s", err)
+	}
+
+	expectedCoverage := Coverage{
+		Commit:            "d",
+		NumCommits:        3,
+		NumCoveredCommits: 2,
+		Languages: []LanguageCoverage{
+			{Language: "Go", NumFiles: 3, NumCoveredFiles: 2},
+			{Language: "TypeScript", NumFiles: 2, NumCoveredFiles: 1},
+		},
+	}
+	if diff := cmp.Diff(expectedCoverage, coverage); diff != "" {
+		t.Errorf("unexpected coverage (-want +got):\n%s", diff)
+	}
+
+	if history := mockGitserverClient.CommitGraphFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected number of commit graph calls. want=%d have=%d", 1, len(history))
+	} else if history[0].Arg2.Commit != "main" || history[0].Arg2.Limit != 3 {
+		t.Errorf("unexpected commit graph options: %+v", history[0].Arg2)
+	}
+
+	if history := mockDBStore.CountCoveredCommitsFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected number of covered commit queries. want=%d have=%d", 1, len(history))
+	} else if diff := cmp.Diff([]string{"b", "c", "d"}, history[0].Arg2); diff != "" {
+		t.Errorf("unexpected commits (-want +got):\n%s", diff)
+	}
+
+	if history := mockDBStore.FindClosestDumpsFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected number of dump queries. want=%d have=%d", 1, len(history))
+	} else if history[0].Arg2 != "d" {
+		t.Errorf("unexpected commit. want=%s have=%s", "d", history[0].Arg2)
+	}
+}
+
+func TestCoverageDefaultBranch(t *testing.T) {
+	mockGitserverClient := NewMockGitserverClient()
+	mockGitserverClient.CommitGraphFunc.PushReturn(gitserver.ParseCommitGraph(nil), nil)
+
+	resolver := newResolver(NewMockDBStore(), NewMockLSIFStore(), mockGitserverClient, nil, nil, nil, ResolverOptions{}, &observation.TestContext)
+
+	coverage, err := resolver.Coverage(context.Background(), 42, "", 0)
+	if err != nil {
+		t.Fatalf("unexpected error computing coverage: %s", err)
+	}
+	if diff := cmp.Diff(Coverage{}, coverage); diff != "" {
+		t.Errorf("unexpected coverage (-want +got):\n%s", diff)
+	}
+
+	if history := mockGitserverClient.CommitGraphFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected number of commit graph calls. want=%d have=%d", 1, len(history))
+	} else if history[0].Arg2.Commit != "HEAD" || history[0].Arg2.Limit != DefaultCoverageCommits {
+		t.Errorf("unexpected commit graph options: %+v", history[0].Arg2)
+	}
+}
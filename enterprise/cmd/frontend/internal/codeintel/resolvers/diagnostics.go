@@ -0,0 +1,136 @@
+package resolvers
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/opentracing/opentracing-go/log"
+
+	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+const slowAggregateDiagnosticsRequestThreshold = 5 * time.Second
+
+// visibleUploadsPageSize is the number of upload records requested at once while collecting
+// the set of uploads visible at the tip of the default branch.
+const visibleUploadsPageSize = 500
+
+// AggregateDiagnostics returns the diagnostics attached to the uploads visible at the tip of the default branch
+// of the given repository. If no repository identifier is supplied, diagnostics are aggregated over the
+// visible uploads of every repository the current user can access. This method also returns the size of
+// the complete result set to aid in pagination and, if requested by the given options, the number of
+// diagnostics in each group.
+func (r *resolver) AggregateDiagnostics(ctx context.Context, repositoryID int, opts lsifstore.AggregateDiagnosticsOptions) (_ []AdjustedDiagnostic, _ int, _ []lsifstore.DiagnosticGroup, err error) {
+	ctx, traceLog, endObservation := observeResolver(ctx, &err, "AggregateDiagnostics", r.operations.aggregateDiagnostics, r.resolverOptions().slowRequestThreshold("AggregateDiagnostics", slowAggregateDiagnosticsRequestThreshold), observation.Args{
+		LogFields: []log.Field{
+			log.Int("repositoryID", repositoryID),
+			log.String("severities", intsToString(opts.Severities)),
+			log.String("groupBy", string(opts.GroupBy)),
+			log.Int("limit", opts.Limit),
+			log.Int("offset", opts.Offset),
+		},
+	})
+	defer endObservation()
+
+	uploadIDs, err := r.visibleUploadIDs(ctx, repositoryID)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	traceLog(
+		log.Int("numUploads", len(uploadIDs)),
+		log.String("uploads", intsToString(uploadIDs)),
+	)
+
+	if len(uploadIDs) == 0 {
+		return nil, 0, nil, nil
+	}
+
+	diagnostics, totalCount, groups, err := r.lsifStore.AggregateDiagnostics(ctx, uploadIDs, opts)
+	if err != nil {
+		return nil, 0, nil, errors.Wrap(err, "lsifStore.AggregateDiagnostics")
+	}
+
+	dumps, err := r.dbStore.GetDumpsByIDs(ctx, uniqueDiagnosticDumpIDs(diagnostics))
+	if err != nil {
+		return nil, 0, nil, errors.Wrap(err, "dbStore.GetDumpsByIDs")
+	}
+
+	dumpsByID := make(map[int]store.Dump, len(dumps))
+	for _, dump := range dumps {
+		dumpsByID[dump.ID] = dump
+	}
+
+	adjustedDiagnostics := make([]AdjustedDiagnostic, 0, len(diagnostics))
+	for _, diagnostic := range diagnostics {
+		dump, ok := dumpsByID[diagnostic.DumpID]
+		if !ok {
+			// Upload was deleted after the visible set was read
+			continue
+		}
+
+		// Uploads visible at tip are indexed at the commit being viewed, so we can
+		// use the diagnostic ranges without adjusting them against a target commit.
+		diagnostic.Path = dump.Root + diagnostic.Path
+
+		adjustedDiagnostics = append(adjustedDiagnostics, AdjustedDiagnostic{
+			Diagnostic:     diagnostic,
+			Dump:           dump,
+			AdjustedCommit: dump.Commit,
+			AdjustedRange: lsifstore.Range{
+				Start: lsifstore.Position{Line: diagnostic.StartLine, Character: diagnostic.StartCharacter},
+				End:   lsifstore.Position{Line: diagnostic.EndLine, Character: diagnostic.EndCharacter},
+			},
+		})
+	}
+	traceLog(
+		log.Int("totalCount", totalCount),
+		log.Int("numDiagnostics", len(adjustedDiagnostics)),
+	)
+
+	return adjustedDiagnostics, totalCount, groups, nil
+}
+
+// visibleUploadIDs returns the identifiers of all completed uploads visible at the tip of the default
+// branch of the given repository, or of all repositories if no repository identifier is supplied.
+func (r *resolver) visibleUploadIDs(ctx context.Context, repositoryID int) ([]int, error) {
+	var ids []int
+	for {
+		uploads, totalCount, err := r.dbStore.GetUploads(ctx, store.GetUploadsOptions{
+			RepositoryID: repositoryID,
+			State:        "completed",
+			VisibleAtTip: true,
+			Limit:        visibleUploadsPageSize,
+			Offset:       len(ids),
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "dbStore.GetUploads")
+		}
+
+		for _, upload := range uploads {
+			ids = append(ids, upload.ID)
+		}
+
+		if len(uploads) == 0 || len(ids) >= totalCount {
+			return ids, nil
+		}
+	}
+}
+
+// uniqueDiagnosticDumpIDs returns the set of distinct dump identifiers attached to the given diagnostics.
+func uniqueDiagnosticDumpIDs(diagnostics []lsifstore.Diagnostic) []int {
+	seen := map[int]struct{}{}
+	ids := make([]int, 0, len(diagnostics))
+	for _, diagnostic := range diagnostics {
+		if _, ok := seen[diagnostic.DumpID]; ok {
+			continue
+		}
+
+		seen[diagnostic.DumpID] = struct{}{}
+		ids = append(ids, diagnostic.DumpID)
+	}
+
+	return ids
+}
@@ -0,0 +1,89 @@
+package resolvers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
+)
+
+func TestAggregateDiagnostics(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockGitserverClient := NewMockGitserverClient()
+
+	mockDBStore.GetUploadsFunc.PushReturn([]dbstore.Upload{{ID: 50}, {ID: 51}}, 3, nil)
+	mockDBStore.GetUploadsFunc.PushReturn([]dbstore.Upload{{ID: 52}}, 3, nil)
+
+	dumps := []dbstore.Dump{
+		{ID: 50, Commit: "deadbeef1", Root: "sub1/", RepositoryID: 42},
+		{ID: 52, Commit: "deadbeef2", Root: "sub2/", RepositoryID: 43},
+	}
+	mockDBStore.GetDumpsByIDsFunc.PushReturn(dumps, nil)
+
+	diagnostics := []lsifstore.Diagnostic{
+		{DumpID: 50, Path: "a.go", DiagnosticData: semantic.DiagnosticData{Severity: 1, Message: "m1", StartLine: 1, StartCharacter: 2, EndLine: 3, EndCharacter: 4}},
+		{DumpID: 52, Path: "b.go", DiagnosticData: semantic.DiagnosticData{Severity: 1, Message: "m2", StartLine: 5, StartCharacter: 6, EndLine: 7, EndCharacter: 8}},
+	}
+	groups := []lsifstore.DiagnosticGroup{{Key: "1", Count: 7}}
+	mockLSIFStore.AggregateDiagnosticsFunc.PushReturn(diagnostics, 7, groups, nil)
+
+	resolver := newResolver(mockDBStore, mockLSIFStore, mockGitserverClient, nil, nil, nil, ResolverOptions{}, &observation.TestContext)
+
+	opts := lsifstore.AggregateDiagnosticsOptions{
+		Severities: []int{1},
+		GroupBy:    lsifstore.DiagnosticGroupSeverity,
+		Limit:      2,
+	}
+	adjustedDiagnostics, totalCount, adjustedGroups, err := resolver.AggregateDiagnostics(context.Background(), 0, opts)
+	if err != nil {
+		t.Fatalf("unexpected error aggregating diagnostics: %s", err)
+	}
+
+	if totalCount != 7 {
+		t.Errorf("unexpected count. want=%d have=%d", 7, totalCount)
+	}
+	if diff := cmp.Diff(groups, adjustedGroups); diff != "" {
+		t.Errorf("unexpected groups (-want +got):\n%s", diff)
+	}
+
+	expectedDiagnostics := []AdjustedDiagnostic{
+		{
+			Diagnostic:     lsifstore.Diagnostic{DumpID: 50, Path: "sub1/a.go", DiagnosticData: diagnostics[0].DiagnosticData},
+			Dump:           dumps[0],
+			AdjustedCommit: "deadbeef1",
+			AdjustedRange:  lsifstore.Range{Start: lsifstore.Position{Line: 1, Character: 2}, End: lsifstore.Position{Line: 3, Character: 4}},
+		},
+		{
+			Diagnostic:     lsifstore.Diagnostic{DumpID: 52, Path: "sub2/b.go", DiagnosticData: diagnostics[1].DiagnosticData},
+			Dump:           dumps[1],
+			AdjustedCommit: "deadbeef2",
+			AdjustedRange:  lsifstore.Range{Start: lsifstore.Position{Line: 5, Character: 6}, End: lsifstore.Position{Line: 7, Character: 8}},
+		},
+	}
+	if diff := cmp.Diff(expectedDiagnostics, adjustedDiagnostics); diff != "" {
+		t.Errorf("unexpected diagnostics (-want +got):\n%s", diff)
+	}
+
+	if history := mockDBStore.GetUploadsFunc.History(); len(history) != 2 {
+		t.Fatalf("unexpected number of upload queries. want=%d have=%d", 2, len(history))
+	} else if history[1].Arg1.Offset != 2 || !history[1].Arg1.VisibleAtTip || history[1].Arg1.State != "completed" {
+		t.Errorf("unexpected upload query options: %+v", history[1].Arg1)
+	}
+
+	if history := mockLSIFStore.AggregateDiagnosticsFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected number of aggregate queries. want=%d have=%d", 1, len(history))
+	} else {
+		if diff := cmp.Diff([]int{50, 51, 52}, history[0].Arg1); diff != "" {
+			t.Errorf("unexpected bundle ids (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff(opts, history[0].Arg2); diff != "" {
+			t.Errorf("unexpected options (-want +got):\n%s", diff)
+		}
+	}
+}
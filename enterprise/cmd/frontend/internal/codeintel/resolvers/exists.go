@@ -15,9 +15,8 @@ import (
 // numAncestors is the number of ancestors to query from gitserver when trying to find the closest
 // ancestor we have data for. Setting this value too low (relative to a repository's commit rate)
 // will cause requests for an unknown commit return too few results; setting this value too high
-// will raise the latency of requests for an unknown commit.
-//
-// TODO(efritz) - make adjustable via site configuration
+// will raise the latency of requests for an unknown commit. This value is replaced by the configured
+// interpolation window when one is set.
 const numAncestors = 100
 
 // findClosestDumps returns the set of dumps that can most accurately answer code intelligence
@@ -25,7 +24,10 @@ const numAncestors = 100
 // exact document path are returned. Otherwise, dumps containing any document for which the given
 // path is a prefix are returned. These dump IDs should be subsequently passed to invocations of
 // Definitions, References, and Hover.
-func (r *resolver) findClosestDumps(ctx context.Context, cachedCommitChecker *cachedCommitChecker, repositoryID int, commit, path string, exactPath bool, indexer string) (_ []store.Dump, err error) {
+//
+// If window is non-zero and no uploads are visible from the given commit, then the closest dumps
+// within window commits of the given commit in both directions of the commit graph are returned.
+func (r *resolver) findClosestDumps(ctx context.Context, cachedCommitChecker *cachedCommitChecker, repositoryID int, commit, path string, exactPath bool, indexer string, window int) (_ []store.Dump, err error) {
 	ctx, traceLog, endObservation := r.operations.findClosestDumps.WithAndLogger(ctx, &err, observation.Args{
 		LogFields: []log.Field{
 			log.Int("repositoryID", repositoryID),
@@ -33,11 +35,12 @@ func (r *resolver) findClosestDumps(ctx context.Context, cachedCommitChecker *ca
 			log.String("path", path),
 			log.Bool("exactPath", exactPath),
 			log.String("indexer", indexer),
+			log.Int("window", window),
 		},
 	})
 	defer endObservation(1, observation.Args{})
 
-	candidates, err := r.inferClosestUploads(ctx, repositoryID, commit, path, exactPath, indexer)
+	candidates, err := r.inferClosestUploads(ctx, repositoryID, commit, path, exactPath, indexer, window)
 	if err != nil {
 		return nil, err
 	}
@@ -94,8 +97,12 @@ func (r *resolver) findClosestDumps(ctx context.Context, cachedCommitChecker *ca
 // all results while a subsequent request made after the lsif_nearest_uploads has been updated to include
 // this commit will.
 //
+// If window is non-zero, the commit graph is also walked forward (up to window commits) so that the
+// nearest uploads on both sides of the given commit are returned. This allows us to answer queries for
+// an unindexed commit on a branch that has since been indexed further along.
+//
 // TODO(efritz) - show an indication in the GraphQL response and the UI that this repo is refreshing.
-func (r *resolver) inferClosestUploads(ctx context.Context, repositoryID int, commit, path string, exactPath bool, indexer string) ([]store.Dump, error) {
+func (r *resolver) inferClosestUploads(ctx context.Context, repositoryID int, commit, path string, exactPath bool, indexer string, window int) ([]store.Dump, error) {
 	// The parameters exactPath and rootMustEnclosePath align here: if we're looking for dumps
 	// that can answer queries for a directory (e.g. diagnostics), we want any dump that happens
 	// to intersect the target directory. If we're looking for dumps that can answer queries for
@@ -117,7 +124,12 @@ func (r *resolver) inferClosestUploads(ctx context.Context, repositoryID int, co
 	if commitExists, err := r.dbStore.HasCommit(ctx, repositoryID, commit); err != nil {
 		return nil, errors.Wrap(err, "dbstore.HasCommit")
 	} else if commitExists {
-		return nil, nil
+		if window == 0 {
+			return nil, nil
+		}
+
+		// Nothing is visible from an ancestor, but a descendant may have been indexed
+		return r.findClosestDescendantDumps(ctx, repositoryID, commit, path, exactPath, indexer, window)
 	}
 
 	// Otherwise, the repository has LSIF data but we don't know about the commit. This commit
@@ -125,9 +137,14 @@ func (r *resolver) inferClosestUploads(ctx context.Context, repositoryID int, co
 	// and try to link it with what we have in the database. Then mark the repository's commit
 	// graph as dirty so it's updated for subsequent requests.
 
+	limit := numAncestors
+	if window != 0 {
+		limit = window
+	}
+
 	graph, err := r.gitserverClient.CommitGraph(ctx, repositoryID, gitserver.CommitGraphOptions{
 		Commit: commit,
-		Limit:  numAncestors,
+		Limit:  limit,
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "gitserverClient.CommitGraph")
@@ -142,5 +159,14 @@ func (r *resolver) inferClosestUploads(ctx context.Context, repositoryID int, co
 		return nil, errors.Wrap(err, "dbstore.MarkRepositoryAsDirty")
 	}
 
+	if window != 0 {
+		descendantDumps, err := r.findClosestDescendantDumps(ctx, repositoryID, commit, path, exactPath, indexer, window)
+		if err != nil {
+			return nil, err
+		}
+
+		dumps = mergeInterpolatedDumps(dumps, descendantDumps)
+	}
+
 	return dumps, nil
 }
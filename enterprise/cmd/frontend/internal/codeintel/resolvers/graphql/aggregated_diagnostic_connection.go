@@ -0,0 +1,80 @@
+package graphql
+
+import (
+	"context"
+	"strconv"
+
+	gql "github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+)
+
+type AggregatedDiagnosticConnectionResolver struct {
+	diagnostics      []resolvers.AdjustedDiagnostic
+	totalCount       int
+	groups           []lsifstore.DiagnosticGroup
+	groupBy          lsifstore.DiagnosticGroupField
+	nextOffset       *int
+	locationResolver *CachedLocationResolver
+}
+
+func NewAggregatedDiagnosticConnectionResolver(
+	diagnostics []resolvers.AdjustedDiagnostic,
+	totalCount int,
+	groups []lsifstore.DiagnosticGroup,
+	groupBy lsifstore.DiagnosticGroupField,
+	nextOffset *int,
+	locationResolver *CachedLocationResolver,
+) gql.AggregatedDiagnosticConnectionResolver {
+	return &AggregatedDiagnosticConnectionResolver{
+		diagnostics:      diagnostics,
+		totalCount:       totalCount,
+		groups:           groups,
+		groupBy:          groupBy,
+		nextOffset:       nextOffset,
+		locationResolver: locationResolver,
+	}
+}
+
+func (r *AggregatedDiagnosticConnectionResolver) Nodes(ctx context.Context) ([]gql.DiagnosticResolver, error) {
+	resolvers := make([]gql.DiagnosticResolver, 0, len(r.diagnostics))
+	for i := range r.diagnostics {
+		resolvers = append(resolvers, NewDiagnosticResolver(r.diagnostics[i], r.locationResolver))
+	}
+	return resolvers, nil
+}
+
+func (r *AggregatedDiagnosticConnectionResolver) TotalCount(ctx context.Context) (int32, error) {
+	return int32(r.totalCount), nil
+}
+
+func (r *AggregatedDiagnosticConnectionResolver) Groups(ctx context.Context) ([]gql.DiagnosticGroupResolver, error) {
+	resolvers := make([]gql.DiagnosticGroupResolver, 0, len(r.groups))
+	for _, group := range r.groups {
+		key := group.Key
+		if r.groupBy == lsifstore.DiagnosticGroupSeverity {
+			// Display severity groups with the same names used by the DiagnosticSeverity enum
+			if val, err := strconv.Atoi(key); err == nil {
+				if severity, err := toSeverity(val); err == nil {
+					key = *severity
+				}
+			}
+		}
+
+		resolvers = append(resolvers, &DiagnosticGroupResolver{key: key, count: group.Count})
+	}
+	return resolvers, nil
+}
+
+func (r *AggregatedDiagnosticConnectionResolver) PageInfo(ctx context.Context) (*graphqlutil.PageInfo, error) {
+	return encodeIntCursor(toInt32(r.nextOffset)), nil
+}
+
+type DiagnosticGroupResolver struct {
+	key   string
+	count int
+}
+
+func (r *DiagnosticGroupResolver) Key() string  { return r.key }
+func (r *DiagnosticGroupResolver) Count() int32 { return int32(r.count) }
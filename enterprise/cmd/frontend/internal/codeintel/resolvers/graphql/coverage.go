@@ -0,0 +1,58 @@
+package graphql
+
+import (
+	gql "github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers"
+)
+
+type CoverageResolver struct {
+	coverage resolvers.Coverage
+}
+
+func NewCoverageResolver(coverage resolvers.Coverage) gql.CodeIntelligenceCoverageResolver {
+	return &CoverageResolver{coverage: coverage}
+}
+
+func (r *CoverageResolver) Commit() *string {
+	return strPtr(r.coverage.Commit)
+}
+
+func (r *CoverageResolver) CommitCount() int32 { return int32(r.coverage.NumCommits) }
+
+func (r *CoverageResolver) CoveredCommitCount() int32 { return int32(r.coverage.NumCoveredCommits) }
+
+func (r *CoverageResolver) CommitCoverage() float64 {
+	return fraction(r.coverage.NumCoveredCommits, r.coverage.NumCommits)
+}
+
+func (r *CoverageResolver) Languages() []gql.CodeIntelligenceLanguageCoverageResolver {
+	languages := make([]gql.CodeIntelligenceLanguageCoverageResolver, 0, len(r.coverage.Languages))
+	for _, language := range r.coverage.Languages {
+		languages = append(languages, &languageCoverageResolver{coverage: language})
+	}
+
+	return languages
+}
+
+type languageCoverageResolver struct {
+	coverage resolvers.LanguageCoverage
+}
+
+func (r *languageCoverageResolver) Language() string { return r.coverage.Language }
+
+func (r *languageCoverageResolver) FileCount() int32 { return int32(r.coverage.NumFiles) }
+
+func (r *languageCoverageResolver) CoveredFileCount() int32 { return int32(r.coverage.NumCoveredFiles) }
+
+func (r *languageCoverageResolver) FileCoverage() float64 {
+	return fraction(r.coverage.NumCoveredFiles, r.coverage.NumFiles)
+}
+
+// fraction returns numerator/denominator, or zero if the denominator is zero.
+func fraction(numerator, denominator int) float64 {
+	if denominator == 0 {
+		return 0
+	}
+
+	return float64(numerator) / float64(denominator)
+}
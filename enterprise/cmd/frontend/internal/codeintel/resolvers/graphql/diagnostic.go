@@ -53,3 +53,13 @@ func toSeverity(val int) (*string, error) {
 
 	return &severity, nil
 }
+
+func fromSeverity(name string) (int, error) {
+	for val, severity := range severities {
+		if severity == name {
+			return val, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown diagnostic severity %q", name)
+}
@@ -11,13 +11,15 @@ import (
 type DiagnosticConnectionResolver struct {
 	diagnostics      []resolvers.AdjustedDiagnostic
 	totalCount       int
+	cursor           *string
 	locationResolver *CachedLocationResolver
 }
 
-func NewDiagnosticConnectionResolver(diagnostics []resolvers.AdjustedDiagnostic, totalCount int, locationResolver *CachedLocationResolver) gql.DiagnosticConnectionResolver {
+func NewDiagnosticConnectionResolver(diagnostics []resolvers.AdjustedDiagnostic, totalCount int, cursor *string, locationResolver *CachedLocationResolver) gql.DiagnosticConnectionResolver {
 	return &DiagnosticConnectionResolver{
 		diagnostics:      diagnostics,
 		totalCount:       totalCount,
+		cursor:           cursor,
 		locationResolver: locationResolver,
 	}
 }
@@ -35,5 +37,5 @@ func (r *DiagnosticConnectionResolver) TotalCount(ctx context.Context) (int32, e
 }
 
 func (r *DiagnosticConnectionResolver) PageInfo(ctx context.Context) (*graphqlutil.PageInfo, error) {
-	return graphqlutil.HasNextPage(len(r.diagnostics) < r.totalCount), nil
+	return encodeCursor(r.cursor), nil
 }
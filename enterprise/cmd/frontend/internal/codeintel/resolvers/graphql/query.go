@@ -2,16 +2,21 @@ package graphql
 
 import (
 	"context"
+	"strings"
 
 	"github.com/cockroachdb/errors"
 
 	gql "github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
 )
 
 // DefaultReferencesPageSize is the reference result page size when no limit is supplied.
 const DefaultReferencesPageSize = 100
 
+// DefaultImplementationsPageSize is the implementation result page size when no limit is supplied.
+const DefaultImplementationsPageSize = 100
+
 // DefaultDiagnosticsPageSize is the diagnostic result page size when no limit is supplied.
 const DefaultDiagnosticsPageSize = 100
 
@@ -86,8 +91,46 @@ func (r *QueryResolver) References(ctx context.Context, args *gql.LSIFPagedQuery
 	return NewLocationConnectionResolver(locations, strPtr(cursor), r.locationResolver), nil
 }
 
-func (r *QueryResolver) Hover(ctx context.Context, args *gql.LSIFQueryPositionArgs) (gql.HoverResolver, error) {
-	text, rx, exists, err := r.resolver.Hover(ctx, int(args.Line), int(args.Character))
+func (r *QueryResolver) Implementations(ctx context.Context, args *gql.LSIFPagedQueryPositionArgs) (gql.LocationConnectionResolver, error) {
+	limit := derefInt32(args.First, DefaultImplementationsPageSize)
+	if limit <= 0 {
+		return nil, ErrIllegalLimit
+	}
+	cursor, err := decodeCursor(args.After)
+	if err != nil {
+		return nil, err
+	}
+
+	locations, cursor, err := r.resolver.Implementations(ctx, int(args.Line), int(args.Character), limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewLocationConnectionResolver(locations, strPtr(cursor), r.locationResolver), nil
+}
+
+func (r *QueryResolver) WriteReferences(ctx context.Context, args *gql.LSIFPagedQueryPositionArgs) (gql.LocationConnectionResolver, error) {
+	limit := derefInt32(args.First, DefaultReferencesPageSize)
+	if limit <= 0 {
+		return nil, ErrIllegalLimit
+	}
+	cursor, err := decodeCursor(args.After)
+	if err != nil {
+		return nil, err
+	}
+
+	locations, cursor, err := r.resolver.WriteReferences(ctx, int(args.Line), int(args.Character), limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewLocationConnectionResolver(locations, strPtr(cursor), r.locationResolver), nil
+}
+
+func (r *QueryResolver) Hover(ctx context.Context, args *gql.LSIFHoverArgs) (gql.HoverResolver, error) {
+	format := lsifstore.HoverFormat(strings.ToLower(derefString(args.Format, string(lsifstore.HoverFormatMarkdown))))
+
+	text, rx, exists, err := r.resolver.Hover(ctx, int(args.Line), int(args.Character), format)
 	if err != nil || !exists {
 		return nil, err
 	}
@@ -101,10 +144,15 @@ func (r *QueryResolver) Diagnostics(ctx context.Context, args *gql.LSIFDiagnosti
 		return nil, ErrIllegalLimit
 	}
 
-	diagnostics, totalCount, err := r.resolver.Diagnostics(ctx, limit)
+	cursor, err := decodeCursor(args.After)
+	if err != nil {
+		return nil, err
+	}
+
+	diagnostics, totalCount, cursor, err := r.resolver.Diagnostics(ctx, limit, cursor)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewDiagnosticConnectionResolver(diagnostics, totalCount, r.locationResolver), nil
+	return NewDiagnosticConnectionResolver(diagnostics, totalCount, strPtr(cursor), r.locationResolver), nil
 }
@@ -0,0 +1,79 @@
+package graphql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/sourcegraph/go-lsp"
+
+	gql "github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers"
+)
+
+func (r *QueryResolver) DocumentSymbols(ctx context.Context) ([]gql.DocumentSymbolResolver, error) {
+	symbols, err := r.resolver.DocumentSymbols(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return newDocumentSymbolResolvers(symbols), nil
+}
+
+func (r *QueryResolver) FoldingRanges(ctx context.Context) ([]gql.FoldingRangeResolver, error) {
+	ranges, err := r.resolver.FoldingRanges(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]gql.FoldingRangeResolver, 0, len(ranges))
+	for _, foldingRange := range ranges {
+		resolvers = append(resolvers, &foldingRangeResolver{foldingRange: foldingRange})
+	}
+
+	return resolvers, nil
+}
+
+func newDocumentSymbolResolvers(symbols []resolvers.AdjustedDocumentSymbol) []gql.DocumentSymbolResolver {
+	symbolResolvers := make([]gql.DocumentSymbolResolver, 0, len(symbols))
+	for _, symbol := range symbols {
+		symbolResolvers = append(symbolResolvers, &documentSymbolResolver{symbol: symbol})
+	}
+
+	return symbolResolvers
+}
+
+type documentSymbolResolver struct {
+	symbol resolvers.AdjustedDocumentSymbol
+}
+
+func (r *documentSymbolResolver) Name() string { return r.symbol.Name }
+
+func (r *documentSymbolResolver) Detail() *string { return strPtr(r.symbol.Detail) }
+
+func (r *documentSymbolResolver) Kind() string /* enum SymbolKind */ {
+	if r.symbol.Kind == 0 {
+		return "UNKNOWN"
+	}
+
+	// LSIF symbol kinds share the values of LSP symbol kinds
+	return strings.ToUpper(lsp.SymbolKind(r.symbol.Kind).String())
+}
+
+func (r *documentSymbolResolver) Range() gql.RangeResolver {
+	return gql.NewRangeResolver(convertRange(r.symbol.Range))
+}
+
+func (r *documentSymbolResolver) FullRange() gql.RangeResolver {
+	return gql.NewRangeResolver(convertRange(r.symbol.FullRange))
+}
+
+func (r *documentSymbolResolver) Children() []gql.DocumentSymbolResolver {
+	return newDocumentSymbolResolvers(r.symbol.Children)
+}
+
+type foldingRangeResolver struct {
+	foldingRange resolvers.FoldingRange
+}
+
+func (r *foldingRangeResolver) StartLine() int32 { return int32(r.foldingRange.StartLine) }
+func (r *foldingRangeResolver) EndLine() int32   { return int32(r.foldingRange.EndLine) }
@@ -7,9 +7,11 @@ import (
 
 	gql "github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers"
 	resolvermocks "github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers/mocks"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtesting"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol"
 )
 
 func TestRanges(t *testing.T) {
@@ -141,6 +143,104 @@ func TestReferencesDefaultIllegalLimit(t *testing.T) {
 	}
 }
 
+func TestImplementations(t *testing.T) {
+	db := new(dbtesting.MockDB)
+
+	mockResolver := resolvermocks.NewMockQueryResolver()
+	resolver := NewQueryResolver(mockResolver, NewCachedLocationResolver(db))
+
+	offset := int32(25)
+	cursor := base64.StdEncoding.EncodeToString([]byte("test-cursor"))
+
+	args := &gql.LSIFPagedQueryPositionArgs{
+		LSIFQueryPositionArgs: gql.LSIFQueryPositionArgs{
+			Line:      10,
+			Character: 15,
+		},
+		ConnectionArgs: graphqlutil.ConnectionArgs{First: &offset},
+		After:          &cursor,
+	}
+
+	if _, err := resolver.Implementations(context.Background(), args); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(mockResolver.ImplementationsFunc.History()) != 1 {
+		t.Fatalf("unexpected call count. want=%d have=%d", 1, len(mockResolver.ImplementationsFunc.History()))
+	}
+	if val := mockResolver.ImplementationsFunc.History()[0].Arg1; val != 10 {
+		t.Fatalf("unexpected line. want=%d have=%d", 10, val)
+	}
+	if val := mockResolver.ImplementationsFunc.History()[0].Arg2; val != 15 {
+		t.Fatalf("unexpected character. want=%d have=%d", 15, val)
+	}
+	if val := mockResolver.ImplementationsFunc.History()[0].Arg3; val != 25 {
+		t.Fatalf("unexpected limit. want=%d have=%d", 25, val)
+	}
+	if val := mockResolver.ImplementationsFunc.History()[0].Arg4; val != "test-cursor" {
+		t.Fatalf("unexpected cursor. want=%s have=%s", "test-cursor", val)
+	}
+}
+
+func TestImplementationsDefaultIllegalLimit(t *testing.T) {
+	db := new(dbtesting.MockDB)
+
+	mockResolver := resolvermocks.NewMockQueryResolver()
+	resolver := NewQueryResolver(mockResolver, NewCachedLocationResolver(db))
+
+	offset := int32(-1)
+	args := &gql.LSIFPagedQueryPositionArgs{
+		LSIFQueryPositionArgs: gql.LSIFQueryPositionArgs{
+			Line:      10,
+			Character: 15,
+		},
+		ConnectionArgs: graphqlutil.ConnectionArgs{First: &offset},
+	}
+
+	if _, err := resolver.Implementations(context.Background(), args); err != ErrIllegalLimit {
+		t.Fatalf("unexpected error. want=%q have=%q", ErrIllegalLimit, err)
+	}
+}
+
+func TestWriteReferences(t *testing.T) {
+	db := new(dbtesting.MockDB)
+
+	mockResolver := resolvermocks.NewMockQueryResolver()
+	resolver := NewQueryResolver(mockResolver, NewCachedLocationResolver(db))
+
+	offset := int32(25)
+	cursor := base64.StdEncoding.EncodeToString([]byte("test-cursor"))
+
+	args := &gql.LSIFPagedQueryPositionArgs{
+		LSIFQueryPositionArgs: gql.LSIFQueryPositionArgs{
+			Line:      10,
+			Character: 15,
+		},
+		ConnectionArgs: graphqlutil.ConnectionArgs{First: &offset},
+		After:          &cursor,
+	}
+
+	if _, err := resolver.WriteReferences(context.Background(), args); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(mockResolver.WriteReferencesFunc.History()) != 1 {
+		t.Fatalf("unexpected call count. want=%d have=%d", 1, len(mockResolver.WriteReferencesFunc.History()))
+	}
+	if val := mockResolver.WriteReferencesFunc.History()[0].Arg1; val != 10 {
+		t.Fatalf("unexpected line. want=%d have=%d", 10, val)
+	}
+	if val := mockResolver.WriteReferencesFunc.History()[0].Arg2; val != 15 {
+		t.Fatalf("unexpected character. want=%d have=%d", 15, val)
+	}
+	if val := mockResolver.WriteReferencesFunc.History()[0].Arg3; val != 25 {
+		t.Fatalf("unexpected limit. want=%d have=%d", 25, val)
+	}
+	if val := mockResolver.WriteReferencesFunc.History()[0].Arg4; val != "test-cursor" {
+		t.Fatalf("unexpected cursor. want=%s have=%s", "test-cursor", val)
+	}
+}
+
 func TestHover(t *testing.T) {
 	db := new(dbtesting.MockDB)
 
@@ -148,7 +248,10 @@ func TestHover(t *testing.T) {
 	mockResolver.HoverFunc.SetDefaultReturn("text", lsifstore.Range{}, true, nil)
 	resolver := NewQueryResolver(mockResolver, NewCachedLocationResolver(db))
 
-	args := &gql.LSIFQueryPositionArgs{Line: 10, Character: 15}
+	args := &gql.LSIFHoverArgs{
+		LSIFQueryPositionArgs: gql.LSIFQueryPositionArgs{Line: 10, Character: 15},
+		Format:                strPtr("SIGNATURE"),
+	}
 	if _, err := resolver.Hover(context.Background(), args); err != nil {
 		t.Fatalf("unexpected error: %s", err)
 	}
@@ -162,6 +265,9 @@ func TestHover(t *testing.T) {
 	if val := mockResolver.HoverFunc.History()[0].Arg2; val != 15 {
 		t.Fatalf("unexpected character. want=%d have=%d", 15, val)
 	}
+	if val := mockResolver.HoverFunc.History()[0].Arg3; val != lsifstore.HoverFormatSignature {
+		t.Fatalf("unexpected format. want=%q have=%q", lsifstore.HoverFormatSignature, val)
+	}
 }
 
 func TestDiagnostics(t *testing.T) {
@@ -187,6 +293,39 @@ func TestDiagnostics(t *testing.T) {
 	}
 }
 
+func TestDiagnosticsCursor(t *testing.T) {
+	db := new(dbtesting.MockDB)
+
+	mockResolver := resolvermocks.NewMockQueryResolver()
+	mockResolver.DiagnosticsFunc.SetDefaultReturn(nil, 10, "next", nil)
+	resolver := NewQueryResolver(mockResolver, NewCachedLocationResolver(db))
+
+	after := base64.StdEncoding.EncodeToString([]byte("prev"))
+	args := &gql.LSIFDiagnosticsArgs{
+		After: &after,
+	}
+
+	connection, err := resolver.Diagnostics(context.Background(), args)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(mockResolver.DiagnosticsFunc.History()) != 1 {
+		t.Fatalf("unexpected call count. want=%d have=%d", 1, len(mockResolver.DiagnosticsFunc.History()))
+	}
+	if val := mockResolver.DiagnosticsFunc.History()[0].Arg2; val != "prev" {
+		t.Fatalf("unexpected cursor. want=%q have=%q", "prev", val)
+	}
+
+	pageInfo, err := connection.PageInfo(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if pageInfo.EndCursor() == nil || *pageInfo.EndCursor() != base64.StdEncoding.EncodeToString([]byte("next")) {
+		t.Errorf("unexpected end cursor: %v", pageInfo.EndCursor())
+	}
+}
+
 func TestDiagnosticsDefaultLimit(t *testing.T) {
 	db := new(dbtesting.MockDB)
 
@@ -224,3 +363,45 @@ func TestDiagnosticsDefaultIllegalLimit(t *testing.T) {
 		t.Fatalf("unexpected error. want=%q have=%q", ErrIllegalLimit, err)
 	}
 }
+
+func TestDocumentSymbols(t *testing.T) {
+	db := new(dbtesting.MockDB)
+
+	mockResolver := resolvermocks.NewMockQueryResolver()
+	mockResolver.DocumentSymbolsFunc.SetDefaultReturn([]resolvers.AdjustedDocumentSymbol{
+		{Name: "Foo", Kind: protocol.Struct, Children: []resolvers.AdjustedDocumentSymbol{
+			{Name: "bar", Detail: "int", Kind: protocol.Field},
+		}},
+		{Name: "baz"},
+	}, nil)
+	resolver := NewQueryResolver(mockResolver, NewCachedLocationResolver(db))
+
+	symbols, err := resolver.DocumentSymbols(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(symbols) != 2 {
+		t.Fatalf("unexpected number of symbols. want=%d have=%d", 2, len(symbols))
+	}
+	if val := symbols[0].Kind(); val != "STRUCT" {
+		t.Errorf("unexpected kind. want=%q have=%q", "STRUCT", val)
+	}
+	if val := symbols[0].Detail(); val != nil {
+		t.Errorf("unexpected detail. want=nil have=%q", *val)
+	}
+	if val := symbols[1].Kind(); val != "UNKNOWN" {
+		t.Errorf("unexpected kind. want=%q have=%q", "UNKNOWN", val)
+	}
+
+	children := symbols[0].Children()
+	if len(children) != 1 {
+		t.Fatalf("unexpected number of children. want=%d have=%d", 1, len(children))
+	}
+	if val := children[0].Kind(); val != "FIELD" {
+		t.Errorf("unexpected kind. want=%q have=%q", "FIELD", val)
+	}
+	if val := children[0].Detail(); val == nil || *val != "int" {
+		t.Errorf("unexpected detail. want=%q have=%v", "int", val)
+	}
+}
@@ -3,8 +3,11 @@ package graphql
 import (
 	"context"
 
+	"github.com/graph-gophers/graphql-go"
+
 	gql "github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers"
+	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
 )
 
 type CodeIntelligenceRangeResolver struct {
@@ -27,3 +30,25 @@ func (r *CodeIntelligenceRangeResolver) References(ctx context.Context) (gql.Loc
 func (r *CodeIntelligenceRangeResolver) Hover(ctx context.Context) (gql.HoverResolver, error) {
 	return NewHoverResolver(r.r.HoverText, convertRange(r.r.Range)), nil
 }
+
+func (r *CodeIntelligenceRangeResolver) Source() gql.CodeIntelligenceRangeSourceResolver {
+	if r.r.Dump.ID == 0 {
+		return nil
+	}
+
+	return &codeIntelligenceRangeSourceResolver{dump: r.r.Dump}
+}
+
+type codeIntelligenceRangeSourceResolver struct {
+	dump store.Dump
+}
+
+func (r *codeIntelligenceRangeSourceResolver) UploadID() graphql.ID {
+	return marshalLSIFUploadGQLID(int64(r.dump.ID))
+}
+
+func (r *codeIntelligenceRangeSourceResolver) Indexer() string { return r.dump.Indexer }
+
+func (r *codeIntelligenceRangeSourceResolver) Commit() string { return r.dump.Commit }
+
+func (r *codeIntelligenceRangeSourceResolver) Root() string { return r.dump.Root }
@@ -3,6 +3,7 @@ package graphql
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/graph-gophers/graphql-go"
@@ -11,14 +12,17 @@ import (
 	gql "github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers"
 	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbconn"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 )
 
 const (
-	DefaultUploadPageSize = 50
-	DefaultIndexPageSize  = 50
+	DefaultUploadPageSize               = 50
+	DefaultIndexPageSize                = 50
+	DefaultAggregateDiagnosticsPageSize = 100
+	MaxCoverageCommits                  = 1000
 )
 
 var errAutoIndexingNotEnabled = errors.New("precise code intelligence auto indexing is not enabled")
@@ -105,6 +109,54 @@ func (r *Resolver) DeleteLSIFUpload(ctx context.Context, args *struct{ ID graphq
 	return &gql.EmptyResponse{}, nil
 }
 
+func (r *Resolver) ReindexLSIFUpload(ctx context.Context, args *struct{ ID graphql.ID }) (*gql.EmptyResponse, error) {
+	// 🚨 SECURITY: Only site admins may reindex LSIF data for now
+	if err := backend.CheckCurrentUserIsSiteAdmin(ctx, dbconn.Global); err != nil {
+		return nil, err
+	}
+
+	uploadID, err := unmarshalLSIFUploadGQLID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.resolver.ReindexUploadByID(ctx, int(uploadID)); err != nil {
+		return nil, err
+	}
+
+	return &gql.EmptyResponse{}, nil
+}
+
+func (r *Resolver) LSIFDiagnostics(ctx context.Context, args *gql.LSIFDiagnosticsQueryArgs) (gql.AggregatedDiagnosticConnectionResolver, error) {
+	// Delegate behavior to LSIFDiagnosticsByRepo with no specified repository identifier
+	return r.LSIFDiagnosticsByRepo(ctx, &gql.LSIFRepositoryDiagnosticsQueryArgs{LSIFDiagnosticsQueryArgs: args})
+}
+
+func (r *Resolver) LSIFDiagnosticsByRepo(ctx context.Context, args *gql.LSIFRepositoryDiagnosticsQueryArgs) (gql.AggregatedDiagnosticConnectionResolver, error) {
+	repositoryID, err := resolveRepositoryID(ctx, args.RepositoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := makeAggregateDiagnosticsOptions(args.LSIFDiagnosticsQueryArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	diagnostics, totalCount, groups, err := r.resolver.AggregateDiagnostics(ctx, repositoryID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextOffset *int
+	if opts.Offset+len(diagnostics) < totalCount {
+		val := opts.Offset + len(diagnostics)
+		nextOffset = &val
+	}
+
+	return NewAggregatedDiagnosticConnectionResolver(diagnostics, totalCount, groups, opts.GroupBy, nextOffset, r.locationResolver), nil
+}
+
 var autoIndexingEnabled = conf.CodeIntelAutoIndexingEnabled
 
 func (r *Resolver) LSIFIndexByID(ctx context.Context, id graphql.ID) (gql.LSIFIndexResolver, error) {
@@ -226,6 +278,33 @@ func (r *Resolver) CommitGraph(ctx context.Context, id graphql.ID) (gql.CodeInte
 	return r.resolver.CommitGraph(ctx, int(repositoryID))
 }
 
+func (r *Resolver) CodeIntelligenceCoverage(ctx context.Context, id graphql.ID, args *gql.CodeIntelligenceCoverageArgs) (gql.CodeIntelligenceCoverageResolver, error) {
+	repositoryID, err := gql.UnmarshalRepositoryID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var branch string
+	if args.Branch != nil {
+		branch = *args.Branch
+	}
+
+	numCommits := resolvers.DefaultCoverageCommits
+	if args.Commits != nil {
+		if *args.Commits <= 0 || *args.Commits > MaxCoverageCommits {
+			return nil, errors.Errorf("illegal commits: must be between 1 and %d", MaxCoverageCommits)
+		}
+		numCommits = int(*args.Commits)
+	}
+
+	coverage, err := r.resolver.Coverage(ctx, int(repositoryID), branch, numCommits)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCoverageResolver(coverage), nil
+}
+
 func (r *Resolver) QueueAutoIndexJobForRepo(ctx context.Context, args *struct{ Repository graphql.ID }) (*gql.EmptyResponse, error) {
 	if !autoIndexingEnabled() {
 		return nil, errAutoIndexingNotEnabled
@@ -239,6 +318,94 @@ func (r *Resolver) QueueAutoIndexJobForRepo(ctx context.Context, args *struct{ R
 	return &gql.EmptyResponse{}, r.resolver.QueueAutoIndexJobForRepo(ctx, int(repositoryID))
 }
 
+func (r *Resolver) CodeIntelligenceRetentionPolicies(ctx context.Context) ([]gql.CodeIntelligenceRetentionPolicyResolver, error) {
+	// 🚨 SECURITY: Only site admins may view retention policies for now
+	if err := backend.CheckCurrentUserIsSiteAdmin(ctx, dbconn.Global); err != nil {
+		return nil, err
+	}
+
+	policies, err := r.resolver.RetentionPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policyResolvers := make([]gql.CodeIntelligenceRetentionPolicyResolver, 0, len(policies))
+	for _, policy := range policies {
+		policyResolvers = append(policyResolvers, NewRetentionPolicyResolver(policy))
+	}
+
+	return policyResolvers, nil
+}
+
+func (r *Resolver) CreateCodeIntelligenceRetentionPolicy(ctx context.Context, args *gql.CreateCodeIntelligenceRetentionPolicyArgs) (gql.CodeIntelligenceRetentionPolicyResolver, error) {
+	// 🚨 SECURITY: Only site admins may configure retention policies for now
+	if err := backend.CheckCurrentUserIsSiteAdmin(ctx, dbconn.Global); err != nil {
+		return nil, err
+	}
+
+	policy, err := r.resolver.CreateRetentionPolicy(ctx, makeRetentionPolicy(0, args))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRetentionPolicyResolver(policy), nil
+}
+
+func (r *Resolver) UpdateCodeIntelligenceRetentionPolicy(ctx context.Context, args *gql.UpdateCodeIntelligenceRetentionPolicyArgs) (*gql.EmptyResponse, error) {
+	// 🚨 SECURITY: Only site admins may configure retention policies for now
+	if err := backend.CheckCurrentUserIsSiteAdmin(ctx, dbconn.Global); err != nil {
+		return nil, err
+	}
+
+	policyID, err := unmarshalCodeIntelligenceRetentionPolicyGQLID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.resolver.UpdateRetentionPolicy(ctx, makeRetentionPolicy(int(policyID), &args.CreateCodeIntelligenceRetentionPolicyArgs)); err != nil {
+		return nil, err
+	}
+
+	return &gql.EmptyResponse{}, nil
+}
+
+func (r *Resolver) DeleteCodeIntelligenceRetentionPolicy(ctx context.Context, args *struct{ ID graphql.ID }) (*gql.EmptyResponse, error) {
+	// 🚨 SECURITY: Only site admins may configure retention policies for now
+	if err := backend.CheckCurrentUserIsSiteAdmin(ctx, dbconn.Global); err != nil {
+		return nil, err
+	}
+
+	policyID, err := unmarshalCodeIntelligenceRetentionPolicyGQLID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.resolver.DeleteRetentionPolicyByID(ctx, int(policyID)); err != nil {
+		return nil, err
+	}
+
+	return &gql.EmptyResponse{}, nil
+}
+
+func (r *Resolver) CodeIntelligenceUsageStatistics(ctx context.Context, args *gql.CodeIntelligenceUsageStatisticsArgs) ([]gql.CodeIntelligenceUsageStatisticsResolver, error) {
+	// 🚨 SECURITY: Only site admins may view usage statistics for now
+	if err := backend.CheckCurrentUserIsSiteAdmin(ctx, dbconn.Global); err != nil {
+		return nil, err
+	}
+
+	statistics, err := r.resolver.UsageStats(ctx, args.Since.Time)
+	if err != nil {
+		return nil, err
+	}
+
+	statisticsResolvers := make([]gql.CodeIntelligenceUsageStatisticsResolver, 0, len(statistics))
+	for _, s := range statistics {
+		statisticsResolvers = append(statisticsResolvers, NewUsageStatisticsResolver(s, r.locationResolver))
+	}
+
+	return statisticsResolvers, nil
+}
+
 func (r *Resolver) GitBlobLSIFData(ctx context.Context, args *gql.GitBlobLSIFDataArgs) (gql.GitBlobLSIFDataResolver, error) {
 	resolver, err := r.resolver.QueryResolver(ctx, args)
 	if err != nil || resolver == nil {
@@ -293,6 +460,58 @@ func makeGetIndexesOptions(ctx context.Context, args *gql.LSIFRepositoryIndexesQ
 	}, nil
 }
 
+// makeAggregateDiagnosticsOptions translates the given GraphQL arguments into options defined by the
+// lsifstore.AggregateDiagnostics operation.
+func makeAggregateDiagnosticsOptions(args *gql.LSIFDiagnosticsQueryArgs) (lsifstore.AggregateDiagnosticsOptions, error) {
+	limit := derefInt32(args.First, DefaultAggregateDiagnosticsPageSize)
+	if limit <= 0 {
+		return lsifstore.AggregateDiagnosticsOptions{}, ErrIllegalLimit
+	}
+
+	offset, err := decodeIntCursor(args.After)
+	if err != nil {
+		return lsifstore.AggregateDiagnosticsOptions{}, err
+	}
+
+	var severities []int
+	if args.Severities != nil {
+		for _, name := range *args.Severities {
+			severity, err := fromSeverity(name)
+			if err != nil {
+				return lsifstore.AggregateDiagnosticsOptions{}, err
+			}
+
+			severities = append(severities, severity)
+		}
+	}
+
+	return lsifstore.AggregateDiagnosticsOptions{
+		Severities: severities,
+		GroupBy:    lsifstore.DiagnosticGroupField(strings.ToLower(derefString(args.GroupBy, ""))),
+		Limit:      limit,
+		Offset:     offset,
+	}, nil
+}
+
+// makeRetentionPolicy translates the given GraphQL arguments into a retention policy with the
+// given identifier.
+func makeRetentionPolicy(id int, args *gql.CreateCodeIntelligenceRetentionPolicyArgs) store.RetentionPolicy {
+	var retentionDuration *time.Duration
+	if args.RetentionDurationHours != nil {
+		duration := time.Duration(*args.RetentionDurationHours) * time.Hour
+		retentionDuration = &duration
+	}
+
+	return store.RetentionPolicy{
+		ID:                id,
+		Name:              args.Name,
+		RepositoryPattern: args.RepositoryPattern,
+		Type:              store.RetentionPolicyType(args.Type),
+		Pattern:           args.Pattern,
+		RetentionDuration: retentionDuration,
+	}
+}
+
 // resolveRepositoryByID gets a repository's internal identifier from a GraphQL identifier.
 func resolveRepositoryID(ctx context.Context, id graphql.ID) (int, error) {
 	if id == "" {
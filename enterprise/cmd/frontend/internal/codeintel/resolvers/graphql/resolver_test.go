@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/base64"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/graph-gophers/graphql-go"
@@ -11,8 +12,10 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
 	gql "github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers"
 	resolvermocks "github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers/mocks"
 	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtesting"
@@ -59,6 +62,42 @@ func TestDeleteLSIFUploadUnauthenticated(t *testing.T) {
 	}
 }
 
+func TestReindexLSIFUpload(t *testing.T) {
+	db := new(dbtesting.MockDB)
+
+	t.Cleanup(func() {
+		database.Mocks.Users.GetByCurrentAuthUser = nil
+	})
+	database.Mocks.Users.GetByCurrentAuthUser = func(ctx context.Context) (*types.User, error) {
+		return &types.User{SiteAdmin: true}, nil
+	}
+
+	id := graphql.ID(base64.StdEncoding.EncodeToString([]byte("LSIFUpload:42")))
+	mockResolver := resolvermocks.NewMockResolver()
+
+	if _, err := NewResolver(db, mockResolver).ReindexLSIFUpload(context.Background(), &struct{ ID graphql.ID }{id}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(mockResolver.ReindexUploadByIDFunc.History()) != 1 {
+		t.Fatalf("unexpected call count. want=%d have=%d", 1, len(mockResolver.ReindexUploadByIDFunc.History()))
+	}
+	if val := mockResolver.ReindexUploadByIDFunc.History()[0].Arg1; val != 42 {
+		t.Fatalf("unexpected upload id. want=%d have=%d", 42, val)
+	}
+}
+
+func TestReindexLSIFUploadUnauthenticated(t *testing.T) {
+	db := new(dbtesting.MockDB)
+
+	id := graphql.ID(base64.StdEncoding.EncodeToString([]byte("LSIFUpload:42")))
+	mockResolver := resolvermocks.NewMockResolver()
+
+	if _, err := NewResolver(db, mockResolver).ReindexLSIFUpload(context.Background(), &struct{ ID graphql.ID }{id}); err != backend.ErrNotAuthenticated {
+		t.Errorf("unexpected error. want=%q have=%q", backend.ErrNotAuthenticated, err)
+	}
+}
+
 func TestDeleteLSIFIndex(t *testing.T) {
 	db := new(dbtesting.MockDB)
 
@@ -95,6 +134,139 @@ func TestDeleteLSIFIndexUnauthenticated(t *testing.T) {
 	}
 }
 
+func TestCreateCodeIntelligenceRetentionPolicy(t *testing.T) {
+	db := new(dbtesting.MockDB)
+
+	t.Cleanup(func() {
+		database.Mocks.Users.GetByCurrentAuthUser = nil
+	})
+	database.Mocks.Users.GetByCurrentAuthUser = func(ctx context.Context) (*types.User, error) {
+		return &types.User{SiteAdmin: true}, nil
+	}
+
+	mockResolver := resolvermocks.NewMockResolver()
+	mockResolver.CreateRetentionPolicyFunc.SetDefaultHook(func(ctx context.Context, policy store.RetentionPolicy) (store.RetentionPolicy, error) {
+		policy.ID = 42
+		return policy, nil
+	})
+
+	retentionDurationHours := int32(24)
+	policy, err := NewResolver(db, mockResolver).CreateCodeIntelligenceRetentionPolicy(context.Background(), &gql.CreateCodeIntelligenceRetentionPolicyArgs{
+		Name:                   "release branches",
+		RepositoryPattern:      "github.com/sourcegraph/*",
+		Type:                   "GIT_BRANCH",
+		Pattern:                "release/*",
+		RetentionDurationHours: &retentionDurationHours,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if val := policy.ID(); val != marshalCodeIntelligenceRetentionPolicyGQLID(42) {
+		t.Errorf("unexpected policy id. want=%q have=%q", marshalCodeIntelligenceRetentionPolicyGQLID(42), val)
+	}
+
+	if len(mockResolver.CreateRetentionPolicyFunc.History()) != 1 {
+		t.Fatalf("unexpected call count. want=%d have=%d", 1, len(mockResolver.CreateRetentionPolicyFunc.History()))
+	}
+
+	day := time.Hour * 24
+	expected := store.RetentionPolicy{
+		Name:              "release branches",
+		RepositoryPattern: "github.com/sourcegraph/*",
+		Type:              store.RetentionPolicyTypeBranch,
+		Pattern:           "release/*",
+		RetentionDuration: &day,
+	}
+	if diff := cmp.Diff(expected, mockResolver.CreateRetentionPolicyFunc.History()[0].Arg1); diff != "" {
+		t.Errorf("unexpected retention policy (-want +got):\n%s", diff)
+	}
+}
+
+func TestDeleteCodeIntelligenceRetentionPolicy(t *testing.T) {
+	db := new(dbtesting.MockDB)
+
+	t.Cleanup(func() {
+		database.Mocks.Users.GetByCurrentAuthUser = nil
+	})
+	database.Mocks.Users.GetByCurrentAuthUser = func(ctx context.Context) (*types.User, error) {
+		return &types.User{SiteAdmin: true}, nil
+	}
+
+	id := graphql.ID(base64.StdEncoding.EncodeToString([]byte("CodeIntelligenceRetentionPolicy:42")))
+	mockResolver := resolvermocks.NewMockResolver()
+
+	if _, err := NewResolver(db, mockResolver).DeleteCodeIntelligenceRetentionPolicy(context.Background(), &struct{ ID graphql.ID }{id}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(mockResolver.DeleteRetentionPolicyByIDFunc.History()) != 1 {
+		t.Fatalf("unexpected call count. want=%d have=%d", 1, len(mockResolver.DeleteRetentionPolicyByIDFunc.History()))
+	}
+	if val := mockResolver.DeleteRetentionPolicyByIDFunc.History()[0].Arg1; val != 42 {
+		t.Fatalf("unexpected policy id. want=%d have=%d", 42, val)
+	}
+}
+
+func TestDeleteCodeIntelligenceRetentionPolicyUnauthenticated(t *testing.T) {
+	db := new(dbtesting.MockDB)
+
+	id := graphql.ID(base64.StdEncoding.EncodeToString([]byte("CodeIntelligenceRetentionPolicy:42")))
+	mockResolver := resolvermocks.NewMockResolver()
+
+	if _, err := NewResolver(db, mockResolver).DeleteCodeIntelligenceRetentionPolicy(context.Background(), &struct{ ID graphql.ID }{id}); err != backend.ErrNotAuthenticated {
+		t.Errorf("unexpected error. want=%q have=%q", backend.ErrNotAuthenticated, err)
+	}
+}
+
+func TestCodeIntelligenceUsageStatistics(t *testing.T) {
+	db := new(dbtesting.MockDB)
+
+	t.Cleanup(func() {
+		database.Mocks.Users.GetByCurrentAuthUser = nil
+	})
+	database.Mocks.Users.GetByCurrentAuthUser = func(ctx context.Context) (*types.User, error) {
+		return &types.User{SiteAdmin: true}, nil
+	}
+
+	mockResolver := resolvermocks.NewMockResolver()
+	mockResolver.UsageStatsFunc.SetDefaultReturn([]store.UsageStatistics{
+		{RepositoryID: 50, PreciseHoverCount: 3, SearchHoverCount: 5, SearchReferencesCount: 7},
+	}, nil)
+
+	since := time.Unix(1587396557, 0).UTC()
+	statistics, err := NewResolver(db, mockResolver).CodeIntelligenceUsageStatistics(context.Background(), &gql.CodeIntelligenceUsageStatisticsArgs{Since: gql.DateTime{Time: since}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if history := mockResolver.UsageStatsFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected call count. want=%d have=%d", 1, len(history))
+	} else if !history[0].Arg1.Equal(since) {
+		t.Errorf("unexpected since. want=%s have=%s", since, history[0].Arg1)
+	}
+
+	if len(statistics) != 1 {
+		t.Fatalf("unexpected number of statistics. want=%d have=%d", 1, len(statistics))
+	}
+	counts := [][2]int32{
+		{statistics[0].Definitions().Precise(), statistics[0].Definitions().SearchBased()},
+		{statistics[0].Hover().Precise(), statistics[0].Hover().SearchBased()},
+		{statistics[0].References().Precise(), statistics[0].References().SearchBased()},
+	}
+	if diff := cmp.Diff([][2]int32{{0, 0}, {3, 5}, {0, 7}}, counts); diff != "" {
+		t.Errorf("unexpected counts (-want +got):\n%s", diff)
+	}
+}
+
+func TestCodeIntelligenceUsageStatisticsUnauthenticated(t *testing.T) {
+	db := new(dbtesting.MockDB)
+	mockResolver := resolvermocks.NewMockResolver()
+
+	if _, err := NewResolver(db, mockResolver).CodeIntelligenceUsageStatistics(context.Background(), &gql.CodeIntelligenceUsageStatisticsArgs{}); err != backend.ErrNotAuthenticated {
+		t.Errorf("unexpected error. want=%q have=%q", backend.ErrNotAuthenticated, err)
+	}
+}
+
 func TestMakeGetUploadsOptions(t *testing.T) {
 	t.Cleanup(func() {
 		database.Mocks.Repos.Get = nil
@@ -213,3 +385,141 @@ func TestMakeGetIndexesOptionsDefaults(t *testing.T) {
 		t.Errorf("unexpected opts (-want +got):\n%s", diff)
 	}
 }
+
+func TestMakeAggregateDiagnosticsOptions(t *testing.T) {
+	opts, err := makeAggregateDiagnosticsOptions(&gql.LSIFDiagnosticsQueryArgs{
+		ConnectionArgs: graphqlutil.ConnectionArgs{
+			First: intPtr(5),
+		},
+		Severities: &[]string{"ERROR", "HINT"},
+		GroupBy:    strPtr("SOURCE"),
+		After:      encodeIntCursor(intPtr(25)).EndCursor(),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error making options: %s", err)
+	}
+
+	expected := lsifstore.AggregateDiagnosticsOptions{
+		Severities: []int{1, 4},
+		GroupBy:    lsifstore.DiagnosticGroupSource,
+		Limit:      5,
+		Offset:     25,
+	}
+	if diff := cmp.Diff(expected, opts); diff != "" {
+		t.Errorf("unexpected opts (-want +got):\n%s", diff)
+	}
+}
+
+func TestMakeAggregateDiagnosticsOptionsDefaults(t *testing.T) {
+	opts, err := makeAggregateDiagnosticsOptions(&gql.LSIFDiagnosticsQueryArgs{})
+	if err != nil {
+		t.Fatalf("unexpected error making options: %s", err)
+	}
+
+	expected := lsifstore.AggregateDiagnosticsOptions{
+		Limit: DefaultAggregateDiagnosticsPageSize,
+	}
+	if diff := cmp.Diff(expected, opts); diff != "" {
+		t.Errorf("unexpected opts (-want +got):\n%s", diff)
+	}
+}
+
+func TestLSIFDiagnosticsByRepo(t *testing.T) {
+	db := new(dbtesting.MockDB)
+	mockResolver := resolvermocks.NewMockResolver()
+	mockResolver.AggregateDiagnosticsFunc.SetDefaultReturn(nil, 12, []lsifstore.DiagnosticGroup{{Key: "1", Count: 12}}, nil)
+
+	connection, err := NewResolver(db, mockResolver).LSIFDiagnosticsByRepo(context.Background(), &gql.LSIFRepositoryDiagnosticsQueryArgs{
+		LSIFDiagnosticsQueryArgs: &gql.LSIFDiagnosticsQueryArgs{GroupBy: strPtr("SEVERITY")},
+		RepositoryID:             graphql.ID(base64.StdEncoding.EncodeToString([]byte("Repo:50"))),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if history := mockResolver.AggregateDiagnosticsFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected call count. want=%d have=%d", 1, len(history))
+	} else if history[0].Arg1 != 50 {
+		t.Errorf("unexpected repository id. want=%d have=%d", 50, history[0].Arg1)
+	}
+
+	groups, err := connection.Groups(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error resolving groups: %s", err)
+	}
+	if len(groups) != 1 || groups[0].Key() != "ERROR" || groups[0].Count() != 12 {
+		t.Errorf("unexpected groups: %v", groups)
+	}
+}
+
+func TestLSIFDiagnosticsIllegalSeverity(t *testing.T) {
+	if _, err := makeAggregateDiagnosticsOptions(&gql.LSIFDiagnosticsQueryArgs{Severities: &[]string{"FATAL"}}); err == nil {
+		t.Fatalf("expected error")
+	}
+}
+
+func TestCodeIntelligenceCoverage(t *testing.T) {
+	db := new(dbtesting.MockDB)
+	mockResolver := resolvermocks.NewMockResolver()
+	mockResolver.CoverageFunc.SetDefaultReturn(resolvers.Coverage{
+		Commit:            "deadbeef",
+		NumCommits:        4,
+		NumCoveredCommits: 3,
+		Languages: []resolvers.LanguageCoverage{
+			{Language: "Go", NumFiles: 10, NumCoveredFiles: 5},
+			{Language: "Markdown", NumFiles: 2},
+		},
+	}, nil)
+
+	coverage, err := NewResolver(db, mockResolver).CodeIntelligenceCoverage(
+		context.Background(),
+		graphql.ID(base64.StdEncoding.EncodeToString([]byte("Repo:50"))),
+		&gql.CodeIntelligenceCoverageArgs{Branch: strPtr("main"), Commits: intPtr(4)},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if history := mockResolver.CoverageFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected call count. want=%d have=%d", 1, len(history))
+	} else if history[0].Arg1 != 50 || history[0].Arg2 != "main" || history[0].Arg3 != 4 {
+		t.Errorf("unexpected arguments: %v", history[0].Args())
+	}
+
+	if commit := coverage.Commit(); commit == nil || *commit != "deadbeef" {
+		t.Errorf("unexpected commit: %v", commit)
+	}
+	if value := coverage.CommitCoverage(); value != 0.75 {
+		t.Errorf("unexpected commit coverage. want=%f have=%f", 0.75, value)
+	}
+
+	languages := coverage.Languages()
+	if len(languages) != 2 {
+		t.Fatalf("unexpected number of languages. want=%d have=%d", 2, len(languages))
+	}
+	if value := languages[0].FileCoverage(); value != 0.5 {
+		t.Errorf("unexpected file coverage. want=%f have=%f", 0.5, value)
+	}
+	if value := languages[1].FileCoverage(); value != 0 {
+		t.Errorf("unexpected file coverage. want=%f have=%f", 0.0, value)
+	}
+}
+
+func TestCodeIntelligenceCoverageIllegalCommits(t *testing.T) {
+	db := new(dbtesting.MockDB)
+	mockResolver := resolvermocks.NewMockResolver()
+
+	for _, commits := range []int32{0, -1, MaxCoverageCommits + 1} {
+		if _, err := NewResolver(db, mockResolver).CodeIntelligenceCoverage(
+			context.Background(),
+			graphql.ID(base64.StdEncoding.EncodeToString([]byte("Repo:50"))),
+			&gql.CodeIntelligenceCoverageArgs{Commits: intPtr(commits)},
+		); err == nil {
+			t.Errorf("expected error for commits=%d", commits)
+		}
+	}
+
+	if history := mockResolver.CoverageFunc.History(); len(history) != 0 {
+		t.Errorf("unexpected call count. want=%d have=%d", 0, len(history))
+	}
+}
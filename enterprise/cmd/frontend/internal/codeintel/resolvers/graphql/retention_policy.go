@@ -0,0 +1,45 @@
+package graphql
+
+import (
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+
+	gql "github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+)
+
+type RetentionPolicyResolver struct {
+	policy store.RetentionPolicy
+}
+
+func NewRetentionPolicyResolver(policy store.RetentionPolicy) gql.CodeIntelligenceRetentionPolicyResolver {
+	return &RetentionPolicyResolver{
+		policy: policy,
+	}
+}
+
+func (r *RetentionPolicyResolver) ID() graphql.ID {
+	return marshalCodeIntelligenceRetentionPolicyGQLID(int64(r.policy.ID))
+}
+
+func (r *RetentionPolicyResolver) Name() string { return r.policy.Name }
+
+func (r *RetentionPolicyResolver) RepositoryPattern() string { return r.policy.RepositoryPattern }
+
+func (r *RetentionPolicyResolver) Type() string { return string(r.policy.Type) }
+
+func (r *RetentionPolicyResolver) Pattern() string { return r.policy.Pattern }
+
+func (r *RetentionPolicyResolver) RetentionDurationHours() *int32 {
+	if r.policy.RetentionDuration == nil {
+		return nil
+	}
+
+	hours := int32(*r.policy.RetentionDuration / time.Hour)
+	return &hours
+}
+
+func (r *RetentionPolicyResolver) CreatedAt() gql.DateTime {
+	return gql.DateTime{Time: r.policy.CreatedAt}
+}
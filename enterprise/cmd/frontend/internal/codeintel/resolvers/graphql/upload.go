@@ -70,3 +70,16 @@ func (r *UploadResolver) AssociatedIndex(ctx context.Context) (gql.LSIFIndexReso
 func (r *UploadResolver) ProjectRoot(ctx context.Context) (*gql.GitTreeEntryResolver, error) {
 	return r.locationResolver.Path(ctx, api.RepoID(r.upload.RepositoryID), r.upload.Commit, r.upload.Root)
 }
+
+func (r *UploadResolver) Progress(ctx context.Context) (gql.LSIFUploadProgressResolver, error) {
+	if r.upload.State != "processing" {
+		return nil, nil
+	}
+
+	progress, exists, err := r.prefetcher.resolver.GetUploadProgress(ctx, r.upload.ID)
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	return NewUploadProgressResolver(progress, r.upload.UploadSize), nil
+}
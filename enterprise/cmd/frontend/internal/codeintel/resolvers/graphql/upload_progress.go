@@ -0,0 +1,28 @@
+package graphql
+
+import (
+	"strings"
+
+	gql "github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+)
+
+type UploadProgressResolver struct {
+	progress   store.UploadProgress
+	uploadSize *int64
+}
+
+func NewUploadProgressResolver(progress store.UploadProgress, uploadSize *int64) gql.LSIFUploadProgressResolver {
+	return &UploadProgressResolver{
+		progress:   progress,
+		uploadSize: uploadSize,
+	}
+}
+
+func (r *UploadProgressResolver) Phase() string           { return strings.ToUpper(r.progress.Phase) }
+func (r *UploadProgressResolver) BytesRead() gql.BigInt   { return gql.BigInt{Int: r.progress.BytesRead} }
+func (r *UploadProgressResolver) TotalBytes() *gql.BigInt { return gql.BigIntOrNil(r.uploadSize) }
+func (r *UploadProgressResolver) DocumentsWritten() int32 { return int32(r.progress.DocumentsWritten) }
+func (r *UploadProgressResolver) UpdatedAt() gql.DateTime {
+	return gql.DateTime{Time: r.progress.UpdatedAt}
+}
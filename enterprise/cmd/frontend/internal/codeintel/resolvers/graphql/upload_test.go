@@ -0,0 +1,64 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	resolvermocks "github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers/mocks"
+	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+)
+
+func TestUploadProgress(t *testing.T) {
+	mockResolver := resolvermocks.NewMockResolver()
+	updatedAt := time.Unix(1587396557, 0).UTC()
+	mockResolver.GetUploadProgressFunc.SetDefaultReturn(store.UploadProgress{
+		UploadID:         42,
+		Phase:            store.UploadProgressPhaseWriting,
+		BytesRead:        1024,
+		DocumentsWritten: 12,
+		UpdatedAt:        updatedAt,
+	}, true, nil)
+
+	uploadSize := int64(4096)
+	upload := store.Upload{ID: 42, State: "processing", UploadSize: &uploadSize}
+
+	progress, err := NewUploadResolver(upload, NewPrefetcher(mockResolver), nil).Progress(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error fetching progress: %s", err)
+	} else if progress == nil {
+		t.Fatalf("expected progress")
+	}
+
+	if phase := progress.Phase(); phase != "WRITING" {
+		t.Errorf("unexpected phase. want=%s have=%s", "WRITING", phase)
+	}
+	if bytesRead := progress.BytesRead().Int; bytesRead != 1024 {
+		t.Errorf("unexpected bytes read. want=%d have=%d", 1024, bytesRead)
+	}
+	if totalBytes := progress.TotalBytes(); totalBytes == nil || totalBytes.Int != 4096 {
+		t.Errorf("unexpected total bytes. want=%d have=%v", 4096, totalBytes)
+	}
+	if documentsWritten := progress.DocumentsWritten(); documentsWritten != 12 {
+		t.Errorf("unexpected documents written. want=%d have=%d", 12, documentsWritten)
+	}
+	if have := progress.UpdatedAt().Time; !have.Equal(updatedAt) {
+		t.Errorf("unexpected updated at. want=%s have=%s", updatedAt, have)
+	}
+}
+
+func TestUploadProgressNotProcessing(t *testing.T) {
+	mockResolver := resolvermocks.NewMockResolver()
+	upload := store.Upload{ID: 42, State: "completed"}
+
+	progress, err := NewUploadResolver(upload, NewPrefetcher(mockResolver), nil).Progress(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error fetching progress: %s", err)
+	} else if progress != nil {
+		t.Errorf("unexpected progress for completed upload")
+	}
+
+	if callCount := len(mockResolver.GetUploadProgressFunc.History()); callCount != 0 {
+		t.Errorf("unexpected call count. want=%d have=%d", 0, callCount)
+	}
+}
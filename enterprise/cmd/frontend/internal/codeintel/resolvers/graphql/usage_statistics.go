@@ -0,0 +1,46 @@
+package graphql
+
+import (
+	"context"
+
+	gql "github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+type UsageStatisticsResolver struct {
+	statistics       store.UsageStatistics
+	locationResolver *CachedLocationResolver
+}
+
+func NewUsageStatisticsResolver(statistics store.UsageStatistics, locationResolver *CachedLocationResolver) gql.CodeIntelligenceUsageStatisticsResolver {
+	return &UsageStatisticsResolver{
+		statistics:       statistics,
+		locationResolver: locationResolver,
+	}
+}
+
+func (r *UsageStatisticsResolver) Repository(ctx context.Context) (*gql.RepositoryResolver, error) {
+	return r.locationResolver.Repository(ctx, api.RepoID(r.statistics.RepositoryID))
+}
+
+func (r *UsageStatisticsResolver) Definitions() gql.CodeIntelligenceUsageCountsResolver {
+	return &usageCountsResolver{precise: r.statistics.PreciseDefinitionsCount, search: r.statistics.SearchDefinitionsCount}
+}
+
+func (r *UsageStatisticsResolver) Hover() gql.CodeIntelligenceUsageCountsResolver {
+	return &usageCountsResolver{precise: r.statistics.PreciseHoverCount, search: r.statistics.SearchHoverCount}
+}
+
+func (r *UsageStatisticsResolver) References() gql.CodeIntelligenceUsageCountsResolver {
+	return &usageCountsResolver{precise: r.statistics.PreciseReferencesCount, search: r.statistics.SearchReferencesCount}
+}
+
+type usageCountsResolver struct {
+	precise int
+	search  int
+}
+
+func (r *usageCountsResolver) Precise() int32 { return int32(r.precise) }
+
+func (r *usageCountsResolver) SearchBased() int32 { return int32(r.search) }
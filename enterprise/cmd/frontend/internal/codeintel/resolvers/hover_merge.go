@@ -0,0 +1,126 @@
+package resolvers
+
+import (
+	"fmt"
+	"strings"
+
+	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+)
+
+// HoverMergeStrategy determines how hover text is combined when more than one upload
+// has hover text for the symbol at a given position.
+type HoverMergeStrategy string
+
+const (
+	// HoverMergeFirst returns the hover text of the first upload with any text.
+	HoverMergeFirst HoverMergeStrategy = "first"
+
+	// HoverMergeConcatenate returns the distinct hover text of every upload, each section
+	// labeled with the upload it came from.
+	HoverMergeConcatenate HoverMergeStrategy = "concatenate"
+
+	// HoverMergePrecise returns the hover text attached to the innermost range, which is
+	// the range most precisely describing the symbol at the requested position.
+	HoverMergePrecise HoverMergeStrategy = "prefer-precise"
+)
+
+// ParseHoverMergeStrategy returns the merge strategy with the given name. An empty name
+// is interpreted as HoverMergeFirst.
+func ParseHoverMergeStrategy(name string) (HoverMergeStrategy, error) {
+	switch strategy := HoverMergeStrategy(name); strategy {
+	case "":
+		return HoverMergeFirst, nil
+	case HoverMergeFirst, HoverMergeConcatenate, HoverMergePrecise:
+		return strategy, nil
+	}
+
+	return "", fmt.Errorf("unknown hover merge strategy %q", name)
+}
+
+// hoverSectionSeparator is the markdown inserted between concatenated hover sections.
+const hoverSectionSeparator = "\n\n---\n\n"
+
+// plainHoverSectionSeparator is inserted between concatenated hover sections that are not
+// rendered as markdown.
+const plainHoverSectionSeparator = "\n\n"
+
+// hoverSection is the hover text attached to a range within a particular upload.
+type hoverSection struct {
+	Text  string
+	Range lsifstore.Range
+	Dump  store.Dump
+}
+
+// mergeHoverSections combines the given hover sections, ordered by upload preference, into a
+// single hover text according to the given strategy. The sections are assumed to already be
+// rendered in the given format, which determines how concatenated sections are delimited. The
+// range of the section selected first by the strategy is also returned. If there are no sections,
+// a false-valued flag is returned.
+func mergeHoverSections(strategy HoverMergeStrategy, format lsifstore.HoverFormat, sections []hoverSection) (string, lsifstore.Range, bool) {
+	if len(sections) == 0 {
+		return "", lsifstore.Range{}, false
+	}
+
+	switch strategy {
+	case HoverMergeConcatenate:
+		distinct := make([]hoverSection, 0, len(sections))
+		seen := make(map[string]struct{}, len(sections))
+		for _, section := range sections {
+			if _, ok := seen[section.Text]; ok {
+				continue
+			}
+
+			seen[section.Text] = struct{}{}
+			distinct = append(distinct, section)
+		}
+
+		if len(distinct) == 1 {
+			// Provenance is only useful to distinguish sections from one another
+			return distinct[0].Text, distinct[0].Range, true
+		}
+
+		texts := make([]string, 0, len(distinct))
+		for _, section := range distinct {
+			texts = append(texts, hoverProvenanceLabel(section.Dump, format)+"\n\n"+section.Text)
+		}
+
+		separator := hoverSectionSeparator
+		if format == lsifstore.HoverFormatPlaintext || format == lsifstore.HoverFormatSignature {
+			separator = plainHoverSectionSeparator
+		}
+
+		return strings.Join(texts, separator), distinct[0].Range, true
+
+	case HoverMergePrecise:
+		innermost := sections[0]
+		for _, section := range sections[1:] {
+			if rangeContainsRange(innermost.Range, section.Range) && innermost.Range != section.Range {
+				innermost = section
+			}
+		}
+
+		return innermost.Text, innermost.Range, true
+	}
+
+	return sections[0].Text, sections[0].Range, true
+}
+
+// hoverProvenanceLabel returns a label describing the upload a hover section came from. The label
+// is emphasized unless the hover text is rendered without markdown.
+func hoverProvenanceLabel(dump store.Dump, format lsifstore.HoverFormat) string {
+	root := dump.Root
+	if root == "" {
+		root = "/"
+	}
+
+	if format == lsifstore.HoverFormatPlaintext || format == lsifstore.HoverFormatSignature {
+		return fmt.Sprintf("%s (%s)", dump.Indexer, root)
+	}
+	return fmt.Sprintf("_%s (%s)_", dump.Indexer, root)
+}
+
+// rangeContainsRange returns true if the outer range encloses the inner range.
+func rangeContainsRange(outer, inner lsifstore.Range) bool {
+	return rangeContainsPosition(outer, inner.Start) && rangeContainsPosition(outer, inner.End)
+}
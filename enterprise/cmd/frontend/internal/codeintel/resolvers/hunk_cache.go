@@ -1,6 +1,11 @@
 package resolvers
 
-import "github.com/dgraph-io/ristretto"
+import (
+	"github.com/dgraph-io/ristretto"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
 
 // HunkCache is a LRU cache that holds git diff hunks.
 type HunkCache interface {
@@ -13,11 +18,74 @@ type HunkCache interface {
 	Set(key, value interface{}, cost int64) bool
 }
 
-// NewHunkCache creates a data cache instance with the given maximum capacity.
-func NewHunkCache(size int) (HunkCache, error) {
-	return ristretto.NewCache(&ristretto.Config{
+// NewHunkCache creates a data cache instance with the given maximum capacity. The
+// cache is shared by all requests, and the number of cache hits and misses are
+// reported to the registerer of the given observation context.
+func NewHunkCache(size int, observationContext *observation.Context) (HunkCache, error) {
+	cache, err := ristretto.NewCache(&ristretto.Config{
 		NumCounters: int64(size) * 10,
 		MaxCost:     int64(size),
 		BufferItems: 64,
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newObservedHunkCache(cache, newHunkCacheMetrics(observationContext)), nil
+}
+
+type hunkCacheMetrics struct {
+	hits   prometheus.Counter
+	misses prometheus.Counter
+}
+
+func newHunkCacheMetrics(observationContext *observation.Context) *hunkCacheMetrics {
+	counter := func(name, help string) prometheus.Counter {
+		counter := prometheus.NewCounter(prometheus.CounterOpts{
+			Name: name,
+			Help: help,
+		})
+
+		observationContext.Registerer.MustRegister(counter)
+		return counter
+	}
+
+	hits := counter(
+		"src_codeintel_resolvers_hunk_cache_hits_total",
+		"The number of git diff hunk lookups served from the hunk cache.",
+	)
+	misses := counter(
+		"src_codeintel_resolvers_hunk_cache_misses_total",
+		"The number of git diff hunk lookups that required a request to gitserver.",
+	)
+
+	return &hunkCacheMetrics{
+		hits:   hits,
+		misses: misses,
+	}
+}
+
+// observedHunkCache wraps a HunkCache and counts hits and misses.
+type observedHunkCache struct {
+	cache   HunkCache
+	metrics *hunkCacheMetrics
+}
+
+func newObservedHunkCache(cache HunkCache, metrics *hunkCacheMetrics) HunkCache {
+	return &observedHunkCache{cache: cache, metrics: metrics}
+}
+
+func (c *observedHunkCache) Get(key interface{}) (interface{}, bool) {
+	value, ok := c.cache.Get(key)
+	if ok {
+		c.metrics.hits.Inc()
+	} else {
+		c.metrics.misses.Inc()
+	}
+
+	return value, ok
+}
+
+func (c *observedHunkCache) Set(key, value interface{}, cost int64) bool {
+	return c.cache.Set(key, value, cost)
 }
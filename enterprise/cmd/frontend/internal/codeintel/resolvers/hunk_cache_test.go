@@ -0,0 +1,63 @@
+package resolvers
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
+)
+
+type mapHunkCache map[interface{}]interface{}
+
+func (c mapHunkCache) Get(key interface{}) (interface{}, bool) {
+	value, ok := c[key]
+	return value, ok
+}
+
+func (c mapHunkCache) Set(key, value interface{}, cost int64) bool {
+	c[key] = value
+	return true
+}
+
+func TestObservedHunkCache(t *testing.T) {
+	t.Cleanup(func() {
+		git.Mocks.ExecReader = nil
+	})
+
+	numDiffs := 0
+	git.Mocks.ExecReader = func(args []string) (reader io.ReadCloser, err error) {
+		numDiffs++
+		return io.NopCloser(bytes.NewReader([]byte(hugoDiff))), nil
+	}
+
+	metrics := newHunkCacheMetrics(&observation.Context{Registerer: prometheus.NewRegistry()})
+	hunkCache := newObservedHunkCache(mapHunkCache{}, metrics)
+
+	posIn := lsifstore.Position{Line: 302, Character: 15}
+
+	for i := 0; i < 3; i++ {
+		// Each request creates its own adjuster, but the hunk cache is shared
+		adjuster := NewPositionAdjuster(&types.Repo{ID: 50}, "deadbeef1", hunkCache)
+		if _, _, _, err := adjuster.AdjustPosition(context.Background(), "deadbeef2", "/foo/bar.go", posIn, false); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if numDiffs != 1 {
+		t.Errorf("unexpected number of diffs. want=%d have=%d", 1, numDiffs)
+	}
+	if hits := testutil.ToFloat64(metrics.hits); hits != 2 {
+		t.Errorf("unexpected number of hits. want=%d have=%v", 2, hits)
+	}
+	if misses := testutil.ToFloat64(metrics.misses); misses != 1 {
+		t.Errorf("unexpected number of misses. want=%d have=%v", 1, misses)
+	}
+}
@@ -2,8 +2,11 @@ package resolvers
 
 import (
 	"context"
+	"regexp"
 	"time"
 
+	"github.com/sourcegraph/go-diff/diff"
+
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/autoindex/enqueuer"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/gitserver"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
@@ -16,6 +19,8 @@ import (
 type GitserverClient interface {
 	CommitExists(ctx context.Context, repositoryID int, commit string) (bool, error)
 	CommitGraph(ctx context.Context, repositoryID int, options gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error)
+	ListFiles(ctx context.Context, repositoryID int, commit string, pattern *regexp.Regexp) ([]string, error)
+	MultiDiff(ctx context.Context, repositoryID int, requests []gitserver.DiffRequest) ([][]*diff.Hunk, error)
 }
 
 type DBStore interface {
@@ -23,16 +28,21 @@ type DBStore interface {
 
 	GetUploadByID(ctx context.Context, id int) (dbstore.Upload, bool, error)
 	GetUploadsByIDs(ctx context.Context, ids ...int) ([]dbstore.Upload, error)
+	GetUploadProgress(ctx context.Context, uploadID int) (dbstore.UploadProgress, bool, error)
 	GetUploads(ctx context.Context, opts dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error)
 	DeleteUploadByID(ctx context.Context, id int) (bool, error)
+	ReindexUploadByID(ctx context.Context, id int) (bool, error)
 	GetDumpsByIDs(ctx context.Context, ids []int) ([]dbstore.Dump, error)
+	GetDumpGroups(ctx context.Context, ids []int) ([]dbstore.DumpGroup, error)
 	FindClosestDumps(ctx context.Context, repositoryID int, commit, path string, rootMustEnclosePath bool, indexer string) ([]dbstore.Dump, error)
 	FindClosestDumpsFromGraphFragment(ctx context.Context, repositoryID int, commit, path string, rootMustEnclosePath bool, indexer string, graph *gitserver.CommitGraph) ([]dbstore.Dump, error)
-	DefinitionDumps(ctx context.Context, monikers []semantic.QualifiedMonikerData) (_ []dbstore.Dump, err error)
+	GetPackages(ctx context.Context, keys []dbstore.PackageKey) (map[dbstore.PackageKey]dbstore.Dump, error)
 	ReferenceIDsAndFilters(ctx context.Context, repositoryID int, commit string, monikers []semantic.QualifiedMonikerData, limit, offset int) (_ dbstore.PackageReferenceScanner, _ int, err error)
 	HasRepository(ctx context.Context, repositoryID int) (bool, error)
 	HasCommit(ctx context.Context, repositoryID int, commit string) (bool, error)
+	CountCoveredCommits(ctx context.Context, repositoryID int, commits []string) (int, error)
 	MarkRepositoryAsDirty(ctx context.Context, repositoryID int) error
+	InsertDependencySyncingJob(ctx context.Context, scheme, name, version string) (int, bool, error)
 	CommitGraphMetadata(ctx context.Context, repositoryID int) (stale bool, updatedAt *time.Time, _ error)
 	GetIndexByID(ctx context.Context, id int) (dbstore.Index, bool, error)
 	GetIndexesByIDs(ctx context.Context, ids ...int) ([]dbstore.Index, error)
@@ -40,19 +50,44 @@ type DBStore interface {
 	DeleteIndexByID(ctx context.Context, id int) (bool, error)
 	GetIndexConfigurationByRepositoryID(ctx context.Context, repositoryID int) (store.IndexConfiguration, bool, error)
 	UpdateIndexConfigurationByRepositoryID(ctx context.Context, repositoryID int, data []byte) error
+	GetRetentionPolicies(ctx context.Context) ([]dbstore.RetentionPolicy, error)
+	CreateRetentionPolicy(ctx context.Context, policy dbstore.RetentionPolicy) (dbstore.RetentionPolicy, error)
+	UpdateRetentionPolicy(ctx context.Context, policy dbstore.RetentionPolicy) (bool, error)
+	DeleteRetentionPolicyByID(ctx context.Context, id int) (bool, error)
+	UsageStatistics(ctx context.Context, since time.Time) ([]dbstore.UsageStatistics, error)
 }
 
 type LSIFStore interface {
 	Exists(ctx context.Context, bundleID int, path string) (bool, error)
+	DocumentPaths(ctx context.Context, bundleID int) ([]string, error)
+	DocumentSymbols(ctx context.Context, bundleID int, path string) ([]lsifstore.DocumentSymbol, error)
 	Ranges(ctx context.Context, bundleID int, path string, startLine, endLine int) ([]lsifstore.CodeIntelligenceRange, error)
+	BatchRanges(ctx context.Context, keys []lsifstore.DocumentKey, startLine, endLine int) ([][]lsifstore.CodeIntelligenceRange, error)
 	Definitions(ctx context.Context, bundleID int, path string, line, character, limit, offset int) ([]lsifstore.Location, int, error)
+	BatchDefinitions(ctx context.Context, keys []lsifstore.PositionKey, limit int) ([][]lsifstore.Location, error)
 	References(ctx context.Context, bundleID int, path string, line, character, limit, offset int) ([]lsifstore.Location, int, error)
-	Hover(ctx context.Context, bundleID int, path string, line, character int) (string, lsifstore.Range, bool, error)
+	ReferenceCount(ctx context.Context, bundleID int, path string, line, character int) (int, error)
+	Implementations(ctx context.Context, bundleID int, path string, line, character, limit, offset int) ([]lsifstore.Location, int, error)
+	WriteAccessReferences(ctx context.Context, bundleID int, path string, line, character, limit, offset int) ([]lsifstore.Location, int, error)
+	Hover(ctx context.Context, bundleID int, path string, line, character int, format lsifstore.HoverFormat) (string, lsifstore.Range, bool, error)
+	BatchHover(ctx context.Context, keys []lsifstore.PositionKey, format lsifstore.HoverFormat) ([]lsifstore.HoverResult, error)
 	Diagnostics(ctx context.Context, bundleID int, prefix string, limit, offset int) ([]lsifstore.Diagnostic, int, error)
+	BatchDiagnostics(ctx context.Context, keys []lsifstore.DocumentKey, limit int) ([][]lsifstore.Diagnostic, []int, error)
+	AggregateDiagnostics(ctx context.Context, bundleIDs []int, opts lsifstore.AggregateDiagnosticsOptions) ([]lsifstore.Diagnostic, int, []lsifstore.DiagnosticGroup, error)
 	MonikersByPosition(ctx context.Context, bundleID int, path string, line, character int) ([][]semantic.MonikerData, error)
 	BulkMonikerResults(ctx context.Context, tableName string, ids []int, args []semantic.MonikerData, limit, offset int) (_ []lsifstore.Location, _ int, err error)
+	BulkMonikerResultsCount(ctx context.Context, tableName string, ids []int, args []semantic.MonikerData) (int, error)
+	Symbols(ctx context.Context, bundleIDs []int, query string, limit int) ([]lsifstore.Symbol, error)
 	PackageInformation(ctx context.Context, bundleID int, path string, packageInformationID string) (semantic.PackageInformationData, bool, error)
 	DocumentationPage(ctx context.Context, bundleID int, pathID string) (*semantic.DocumentationPageData, error)
+	DocumentationAtPosition(ctx context.Context, bundleID int, path string, line, character int) ([]lsifstore.DocumentationLink, error)
+}
+
+// SearchClient resolves search-based code intelligence, which is used as a fallback for queries
+// that cannot be answered by an upload. Returned locations are not associated with an upload.
+type SearchClient interface {
+	Definitions(ctx context.Context, repositoryID int, commit, path string, line, character, limit int) ([]lsifstore.Location, error)
+	References(ctx context.Context, repositoryID int, commit, path string, line, character, limit int) ([]lsifstore.Location, error)
 }
 
 type IndexEnqueuer interface {
@@ -0,0 +1,126 @@
+package resolvers
+
+import (
+	"context"
+	"sort"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/gitserver"
+	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+)
+
+// numDescendantCandidates is the number of commits (over all refs) to query from gitserver when
+// trying to find the closest descendants of a commit we have data for. Descendants of a commit
+// that are not within this many of the most recent commits of the repository are not found.
+const numDescendantCandidates = 1000
+
+// findClosestDescendantDumps returns the dumps visible from the closest descendants of the given
+// commit that are known to the commit graph in the database. The commit graph is walked forward
+// from the given commit one generation at a time, and the walk stops at the first generation with
+// any visible dumps or once the given commit distance window has been exhausted.
+func (r *resolver) findClosestDescendantDumps(ctx context.Context, repositoryID int, commit, path string, exactPath bool, indexer string, window int) ([]store.Dump, error) {
+	graph, err := r.gitserverClient.CommitGraph(ctx, repositoryID, gitserver.CommitGraphOptions{
+		AllRefs: true,
+		Limit:   numDescendantCandidates,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "gitserverClient.CommitGraph")
+	}
+
+	children := map[string][]string{}
+	for _, child := range graph.Order() {
+		for _, parent := range graph.Graph()[child] {
+			children[parent] = append(children[parent], child)
+		}
+	}
+
+	seen := map[string]struct{}{commit: {}}
+	frontier := []string{commit}
+
+	for distance := 1; distance <= window && len(frontier) > 0; distance++ {
+		var next []string
+		for _, parent := range frontier {
+			for _, child := range children[parent] {
+				if _, ok := seen[child]; ok {
+					continue
+				}
+
+				seen[child] = struct{}{}
+				next = append(next, child)
+			}
+		}
+
+		var dumps []store.Dump
+		for _, descendant := range next {
+			commitExists, err := r.dbStore.HasCommit(ctx, repositoryID, descendant)
+			if err != nil {
+				return nil, errors.Wrap(err, "dbstore.HasCommit")
+			}
+			if !commitExists {
+				continue
+			}
+
+			descendantDumps, err := r.dbStore.FindClosestDumps(ctx, repositoryID, descendant, path, exactPath, indexer)
+			if err != nil {
+				return nil, errors.Wrap(err, "dbstore.FindClosestDumps")
+			}
+			dumps = append(dumps, descendantDumps...)
+		}
+
+		if len(dumps) > 0 {
+			return dumps, nil
+		}
+
+		frontier = next
+	}
+
+	return nil, nil
+}
+
+// mergeInterpolatedDumps returns the union of the dumps found by walking backward and forward
+// from the requested commit. Ancestor dumps are ordered before descendant dumps, and a dump that
+// is visible from both directions occurs only once.
+func mergeInterpolatedDumps(ancestorDumps, descendantDumps []store.Dump) []store.Dump {
+	dumps := make([]store.Dump, 0, len(ancestorDumps)+len(descendantDumps))
+	seen := make(map[int]struct{}, len(ancestorDumps)+len(descendantDumps))
+
+	for _, dumpSet := range [][]store.Dump{ancestorDumps, descendantDumps} {
+		for _, dump := range dumpSet {
+			if _, ok := seen[dump.ID]; ok {
+				continue
+			}
+
+			seen[dump.ID] = struct{}{}
+			dumps = append(dumps, dump)
+		}
+	}
+
+	return dumps
+}
+
+// rankDumpsByDiffOverlap re-orders the given dumps in-place so that dumps whose commit differs the
+// least from the source commit of the given position adjuster (measured as the number of changed
+// lines under the given path) come first. Because results from earlier dumps are preferred when
+// results are merged, this causes results from the dumps that overlap most with the requested
+// commit to be weighted more heavily. Dumps with an equal number of changed lines retain their
+// relative order.
+func rankDumpsByDiffOverlap(ctx context.Context, adjuster *positionAdjuster, path string, dumps []store.Dump) error {
+	changedLines := make(map[int]int, len(dumps))
+	for _, dump := range dumps {
+		hunks, err := adjuster.readHunksCached(ctx, adjuster.repo, adjuster.commit, dump.Commit, path, false)
+		if err != nil {
+			return errors.Wrap(err, "positionAdjuster.readHunksCached")
+		}
+
+		for _, hunk := range hunks {
+			changedLines[dump.ID] += int(hunk.OrigLines + hunk.NewLines)
+		}
+	}
+
+	sort.SliceStable(dumps, func(i, j int) bool {
+		return changedLines[dumps[i].ID] < changedLines[dumps[j].ID]
+	})
+
+	return nil
+}
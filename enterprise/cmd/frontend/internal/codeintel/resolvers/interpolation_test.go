@@ -0,0 +1,105 @@
+package resolvers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/go-diff/diff"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/gitserver"
+	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestFindClosestDumpsInterpolatesDescendants(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockGitserverClient := NewMockGitserverClient()
+	commitChecker := newCachedCommitChecker(mockGitserverClient)
+
+	// has repository, commit unknown but does exist
+	mockDBStore.HasRepositoryFunc.SetDefaultReturn(true, nil)
+	mockGitserverClient.CommitExistsFunc.SetDefaultReturn(true, nil)
+	mockLSIFStore.ExistsFunc.SetDefaultReturn(true, nil)
+
+	mockGitserverClient.CommitGraphFunc.SetDefaultHook(func(ctx context.Context, repositoryID int, options gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error) {
+		if !options.AllRefs {
+			// ancestors of the requested commit
+			return gitserver.ParseCommitGraph([]string{"a b", "b"}), nil
+		}
+
+		// a <- c <- d <- e, where only e is known
+		return gitserver.ParseCommitGraph([]string{"e d", "d c", "c a", "a b", "b"}), nil
+	})
+	mockDBStore.HasCommitFunc.SetDefaultHook(func(ctx context.Context, repositoryID int, commit string) (bool, error) {
+		return commit == "e", nil
+	})
+	mockDBStore.FindClosestDumpsFromGraphFragmentFunc.SetDefaultReturn([]store.Dump{
+		{ID: 50, Commit: "b", Root: "s1/"},
+	}, nil)
+	mockDBStore.FindClosestDumpsFunc.SetDefaultHook(func(ctx context.Context, repositoryID int, commit, path string, rootMustEnclosePath bool, indexer string) ([]store.Dump, error) {
+		if commit != "e" {
+			return nil, nil
+		}
+
+		return []store.Dump{
+			{ID: 50, Commit: "b", Root: "s1/"},
+			{ID: 51, Commit: "e", Root: "s1/"},
+		}, nil
+	})
+
+	resolver := newResolver(mockDBStore, mockLSIFStore, mockGitserverClient, nil, nil, nil, ResolverOptions{}, &observation.TestContext)
+	dumps, err := resolver.findClosestDumps(context.Background(), commitChecker, 42, "a", "s1/main.go", true, "idx", 3)
+	if err != nil {
+		t.Fatalf("unexpected error finding closest dumps: %s", err)
+	}
+
+	expected := []store.Dump{
+		{ID: 50, Commit: "b", Root: "s1/"},
+		{ID: 51, Commit: "e", Root: "s1/"},
+	}
+	if diff := cmp.Diff(expected, dumps); diff != "" {
+		t.Errorf("unexpected dumps (-want +got):\n%s", diff)
+	}
+
+	// Window too narrow to reach e
+	dumps, err = resolver.findClosestDumps(context.Background(), commitChecker, 42, "a", "s1/main.go", true, "idx", 2)
+	if err != nil {
+		t.Fatalf("unexpected error finding closest dumps: %s", err)
+	}
+	if diff := cmp.Diff(expected[:1], dumps); diff != "" {
+		t.Errorf("unexpected dumps (-want +got):\n%s", diff)
+	}
+}
+
+func TestRankDumpsByDiffOverlap(t *testing.T) {
+	hunkCache := mapHunkCache{
+		makeKey("50", "deadbeef", "c1", "main.go"): []*diff.Hunk{{OrigLines: 10, NewLines: 12}},
+		makeKey("50", "deadbeef", "c2", "main.go"): []*diff.Hunk{{OrigLines: 1, NewLines: 1}},
+		makeKey("50", "deadbeef", "c3", "main.go"): nil,
+		makeKey("50", "deadbeef", "c4", "main.go"): []*diff.Hunk{{OrigLines: 1, NewLines: 1}},
+	}
+	adjuster := &positionAdjuster{repo: &types.Repo{ID: 50}, commit: "deadbeef", hunkCache: hunkCache}
+
+	dumps := []store.Dump{
+		{ID: 1, Commit: "c1"},
+		{ID: 2, Commit: "c2"},
+		{ID: 3, Commit: "c3"},
+		{ID: 4, Commit: "c4"},
+	}
+	if err := rankDumpsByDiffOverlap(context.Background(), adjuster, "main.go", dumps); err != nil {
+		t.Fatalf("unexpected error ranking dumps: %s", err)
+	}
+
+	expected := []store.Dump{
+		{ID: 3, Commit: "c3"},
+		{ID: 2, Commit: "c2"},
+		{ID: 4, Commit: "c4"},
+		{ID: 1, Commit: "c1"},
+	}
+	if diff := cmp.Diff(expected, dumps); diff != "" {
+		t.Errorf("unexpected dumps (-want +got):\n%s", diff)
+	}
+}
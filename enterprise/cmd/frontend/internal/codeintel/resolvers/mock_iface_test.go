@@ -8,6 +8,7 @@ import (
 	"sync"
 	"time"
 
+	diff "github.com/sourcegraph/go-diff/diff"
 	enqueuer "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/autoindex/enqueuer"
 	gitserver "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/gitserver"
 	dbstore "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
@@ -27,12 +28,19 @@ type MockDBStore struct {
 	// CommitGraphMetadataFunc is an instance of a mock function object
 	// controlling the behavior of the method CommitGraphMetadata.
 	CommitGraphMetadataFunc *DBStoreCommitGraphMetadataFunc
-	// DefinitionDumpsFunc is an instance of a mock function object
-	// controlling the behavior of the method DefinitionDumps.
-	DefinitionDumpsFunc *DBStoreDefinitionDumpsFunc
+	// CountCoveredCommitsFunc is an instance of a mock function object
+	// controlling the behavior of the method CountCoveredCommits.
+	CountCoveredCommitsFunc *DBStoreCountCoveredCommitsFunc
+	// CreateRetentionPolicyFunc is an instance of a mock function object
+	// controlling the behavior of the method CreateRetentionPolicy.
+	CreateRetentionPolicyFunc *DBStoreCreateRetentionPolicyFunc
 	// DeleteIndexByIDFunc is an instance of a mock function object
 	// controlling the behavior of the method DeleteIndexByID.
 	DeleteIndexByIDFunc *DBStoreDeleteIndexByIDFunc
+	// DeleteRetentionPolicyByIDFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// DeleteRetentionPolicyByID.
+	DeleteRetentionPolicyByIDFunc *DBStoreDeleteRetentionPolicyByIDFunc
 	// DeleteUploadByIDFunc is an instance of a mock function object
 	// controlling the behavior of the method DeleteUploadByID.
 	DeleteUploadByIDFunc *DBStoreDeleteUploadByIDFunc
@@ -43,6 +51,9 @@ type MockDBStore struct {
 	// function object controlling the behavior of the method
 	// FindClosestDumpsFromGraphFragment.
 	FindClosestDumpsFromGraphFragmentFunc *DBStoreFindClosestDumpsFromGraphFragmentFunc
+	// GetDumpGroupsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetDumpGroups.
+	GetDumpGroupsFunc *DBStoreGetDumpGroupsFunc
 	// GetDumpsByIDsFunc is an instance of a mock function object
 	// controlling the behavior of the method GetDumpsByIDs.
 	GetDumpsByIDsFunc *DBStoreGetDumpsByIDsFunc
@@ -59,9 +70,18 @@ type MockDBStore struct {
 	// GetIndexesByIDsFunc is an instance of a mock function object
 	// controlling the behavior of the method GetIndexesByIDs.
 	GetIndexesByIDsFunc *DBStoreGetIndexesByIDsFunc
+	// GetPackagesFunc is an instance of a mock function object controlling
+	// the behavior of the method GetPackages.
+	GetPackagesFunc *DBStoreGetPackagesFunc
+	// GetRetentionPoliciesFunc is an instance of a mock function object
+	// controlling the behavior of the method GetRetentionPolicies.
+	GetRetentionPoliciesFunc *DBStoreGetRetentionPoliciesFunc
 	// GetUploadByIDFunc is an instance of a mock function object
 	// controlling the behavior of the method GetUploadByID.
 	GetUploadByIDFunc *DBStoreGetUploadByIDFunc
+	// GetUploadProgressFunc is an instance of a mock function object
+	// controlling the behavior of the method GetUploadProgress.
+	GetUploadProgressFunc *DBStoreGetUploadProgressFunc
 	// GetUploadsFunc is an instance of a mock function object controlling
 	// the behavior of the method GetUploads.
 	GetUploadsFunc *DBStoreGetUploadsFunc
@@ -74,12 +94,19 @@ type MockDBStore struct {
 	// HasRepositoryFunc is an instance of a mock function object
 	// controlling the behavior of the method HasRepository.
 	HasRepositoryFunc *DBStoreHasRepositoryFunc
+	// InsertDependencySyncingJobFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// InsertDependencySyncingJob.
+	InsertDependencySyncingJobFunc *DBStoreInsertDependencySyncingJobFunc
 	// MarkRepositoryAsDirtyFunc is an instance of a mock function object
 	// controlling the behavior of the method MarkRepositoryAsDirty.
 	MarkRepositoryAsDirtyFunc *DBStoreMarkRepositoryAsDirtyFunc
 	// ReferenceIDsAndFiltersFunc is an instance of a mock function object
 	// controlling the behavior of the method ReferenceIDsAndFilters.
 	ReferenceIDsAndFiltersFunc *DBStoreReferenceIDsAndFiltersFunc
+	// ReindexUploadByIDFunc is an instance of a mock function object
+	// controlling the behavior of the method ReindexUploadByID.
+	ReindexUploadByIDFunc *DBStoreReindexUploadByIDFunc
 	// RepoNameFunc is an instance of a mock function object controlling the
 	// behavior of the method RepoName.
 	RepoNameFunc *DBStoreRepoNameFunc
@@ -87,6 +114,12 @@ type MockDBStore struct {
 	// function object controlling the behavior of the method
 	// UpdateIndexConfigurationByRepositoryID.
 	UpdateIndexConfigurationByRepositoryIDFunc *DBStoreUpdateIndexConfigurationByRepositoryIDFunc
+	// UpdateRetentionPolicyFunc is an instance of a mock function object
+	// controlling the behavior of the method UpdateRetentionPolicy.
+	UpdateRetentionPolicyFunc *DBStoreUpdateRetentionPolicyFunc
+	// UsageStatisticsFunc is an instance of a mock function object
+	// controlling the behavior of the method UsageStatistics.
+	UsageStatisticsFunc *DBStoreUsageStatisticsFunc
 }
 
 // NewMockDBStore creates a new mock of the DBStore interface. All methods
@@ -98,9 +131,14 @@ func NewMockDBStore() *MockDBStore {
 				return false, nil, nil
 			},
 		},
-		DefinitionDumpsFunc: &DBStoreDefinitionDumpsFunc{
-			defaultHook: func(context.Context, []semantic.QualifiedMonikerData) ([]dbstore.Dump, error) {
-				return nil, nil
+		CountCoveredCommitsFunc: &DBStoreCountCoveredCommitsFunc{
+			defaultHook: func(context.Context, int, []string) (int, error) {
+				return 0, nil
+			},
+		},
+		CreateRetentionPolicyFunc: &DBStoreCreateRetentionPolicyFunc{
+			defaultHook: func(context.Context, dbstore.RetentionPolicy) (dbstore.RetentionPolicy, error) {
+				return dbstore.RetentionPolicy{}, nil
 			},
 		},
 		DeleteIndexByIDFunc: &DBStoreDeleteIndexByIDFunc{
@@ -108,6 +146,11 @@ func NewMockDBStore() *MockDBStore {
 				return false, nil
 			},
 		},
+		DeleteRetentionPolicyByIDFunc: &DBStoreDeleteRetentionPolicyByIDFunc{
+			defaultHook: func(context.Context, int) (bool, error) {
+				return false, nil
+			},
+		},
 		DeleteUploadByIDFunc: &DBStoreDeleteUploadByIDFunc{
 			defaultHook: func(context.Context, int) (bool, error) {
 				return false, nil
@@ -123,6 +166,11 @@ func NewMockDBStore() *MockDBStore {
 				return nil, nil
 			},
 		},
+		GetDumpGroupsFunc: &DBStoreGetDumpGroupsFunc{
+			defaultHook: func(context.Context, []int) ([]dbstore.DumpGroup, error) {
+				return nil, nil
+			},
+		},
 		GetDumpsByIDsFunc: &DBStoreGetDumpsByIDsFunc{
 			defaultHook: func(context.Context, []int) ([]dbstore.Dump, error) {
 				return nil, nil
@@ -148,11 +196,26 @@ func NewMockDBStore() *MockDBStore {
 				return nil, nil
 			},
 		},
+		GetPackagesFunc: &DBStoreGetPackagesFunc{
+			defaultHook: func(context.Context, []dbstore.PackageKey) (map[dbstore.PackageKey]dbstore.Dump, error) {
+				return nil, nil
+			},
+		},
+		GetRetentionPoliciesFunc: &DBStoreGetRetentionPoliciesFunc{
+			defaultHook: func(context.Context) ([]dbstore.RetentionPolicy, error) {
+				return nil, nil
+			},
+		},
 		GetUploadByIDFunc: &DBStoreGetUploadByIDFunc{
 			defaultHook: func(context.Context, int) (dbstore.Upload, bool, error) {
 				return dbstore.Upload{}, false, nil
 			},
 		},
+		GetUploadProgressFunc: &DBStoreGetUploadProgressFunc{
+			defaultHook: func(context.Context, int) (dbstore.UploadProgress, bool, error) {
+				return dbstore.UploadProgress{}, false, nil
+			},
+		},
 		GetUploadsFunc: &DBStoreGetUploadsFunc{
 			defaultHook: func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error) {
 				return nil, 0, nil
@@ -173,6 +236,11 @@ func NewMockDBStore() *MockDBStore {
 				return false, nil
 			},
 		},
+		InsertDependencySyncingJobFunc: &DBStoreInsertDependencySyncingJobFunc{
+			defaultHook: func(context.Context, string, string, string) (int, bool, error) {
+				return 0, false, nil
+			},
+		},
 		MarkRepositoryAsDirtyFunc: &DBStoreMarkRepositoryAsDirtyFunc{
 			defaultHook: func(context.Context, int) error {
 				return nil
@@ -183,6 +251,11 @@ func NewMockDBStore() *MockDBStore {
 				return nil, 0, nil
 			},
 		},
+		ReindexUploadByIDFunc: &DBStoreReindexUploadByIDFunc{
+			defaultHook: func(context.Context, int) (bool, error) {
+				return false, nil
+			},
+		},
 		RepoNameFunc: &DBStoreRepoNameFunc{
 			defaultHook: func(context.Context, int) (string, error) {
 				return "", nil
@@ -193,6 +266,16 @@ func NewMockDBStore() *MockDBStore {
 				return nil
 			},
 		},
+		UpdateRetentionPolicyFunc: &DBStoreUpdateRetentionPolicyFunc{
+			defaultHook: func(context.Context, dbstore.RetentionPolicy) (bool, error) {
+				return false, nil
+			},
+		},
+		UsageStatisticsFunc: &DBStoreUsageStatisticsFunc{
+			defaultHook: func(context.Context, time.Time) ([]dbstore.UsageStatistics, error) {
+				return nil, nil
+			},
+		},
 	}
 }
 
@@ -203,12 +286,18 @@ func NewMockDBStoreFrom(i DBStore) *MockDBStore {
 		CommitGraphMetadataFunc: &DBStoreCommitGraphMetadataFunc{
 			defaultHook: i.CommitGraphMetadata,
 		},
-		DefinitionDumpsFunc: &DBStoreDefinitionDumpsFunc{
-			defaultHook: i.DefinitionDumps,
+		CountCoveredCommitsFunc: &DBStoreCountCoveredCommitsFunc{
+			defaultHook: i.CountCoveredCommits,
+		},
+		CreateRetentionPolicyFunc: &DBStoreCreateRetentionPolicyFunc{
+			defaultHook: i.CreateRetentionPolicy,
 		},
 		DeleteIndexByIDFunc: &DBStoreDeleteIndexByIDFunc{
 			defaultHook: i.DeleteIndexByID,
 		},
+		DeleteRetentionPolicyByIDFunc: &DBStoreDeleteRetentionPolicyByIDFunc{
+			defaultHook: i.DeleteRetentionPolicyByID,
+		},
 		DeleteUploadByIDFunc: &DBStoreDeleteUploadByIDFunc{
 			defaultHook: i.DeleteUploadByID,
 		},
@@ -218,6 +307,9 @@ func NewMockDBStoreFrom(i DBStore) *MockDBStore {
 		FindClosestDumpsFromGraphFragmentFunc: &DBStoreFindClosestDumpsFromGraphFragmentFunc{
 			defaultHook: i.FindClosestDumpsFromGraphFragment,
 		},
+		GetDumpGroupsFunc: &DBStoreGetDumpGroupsFunc{
+			defaultHook: i.GetDumpGroups,
+		},
 		GetDumpsByIDsFunc: &DBStoreGetDumpsByIDsFunc{
 			defaultHook: i.GetDumpsByIDs,
 		},
@@ -233,9 +325,18 @@ func NewMockDBStoreFrom(i DBStore) *MockDBStore {
 		GetIndexesByIDsFunc: &DBStoreGetIndexesByIDsFunc{
 			defaultHook: i.GetIndexesByIDs,
 		},
+		GetPackagesFunc: &DBStoreGetPackagesFunc{
+			defaultHook: i.GetPackages,
+		},
+		GetRetentionPoliciesFunc: &DBStoreGetRetentionPoliciesFunc{
+			defaultHook: i.GetRetentionPolicies,
+		},
 		GetUploadByIDFunc: &DBStoreGetUploadByIDFunc{
 			defaultHook: i.GetUploadByID,
 		},
+		GetUploadProgressFunc: &DBStoreGetUploadProgressFunc{
+			defaultHook: i.GetUploadProgress,
+		},
 		GetUploadsFunc: &DBStoreGetUploadsFunc{
 			defaultHook: i.GetUploads,
 		},
@@ -248,18 +349,30 @@ func NewMockDBStoreFrom(i DBStore) *MockDBStore {
 		HasRepositoryFunc: &DBStoreHasRepositoryFunc{
 			defaultHook: i.HasRepository,
 		},
+		InsertDependencySyncingJobFunc: &DBStoreInsertDependencySyncingJobFunc{
+			defaultHook: i.InsertDependencySyncingJob,
+		},
 		MarkRepositoryAsDirtyFunc: &DBStoreMarkRepositoryAsDirtyFunc{
 			defaultHook: i.MarkRepositoryAsDirty,
 		},
 		ReferenceIDsAndFiltersFunc: &DBStoreReferenceIDsAndFiltersFunc{
 			defaultHook: i.ReferenceIDsAndFilters,
 		},
+		ReindexUploadByIDFunc: &DBStoreReindexUploadByIDFunc{
+			defaultHook: i.ReindexUploadByID,
+		},
 		RepoNameFunc: &DBStoreRepoNameFunc{
 			defaultHook: i.RepoName,
 		},
 		UpdateIndexConfigurationByRepositoryIDFunc: &DBStoreUpdateIndexConfigurationByRepositoryIDFunc{
 			defaultHook: i.UpdateIndexConfigurationByRepositoryID,
 		},
+		UpdateRetentionPolicyFunc: &DBStoreUpdateRetentionPolicyFunc{
+			defaultHook: i.UpdateRetentionPolicy,
+		},
+		UsageStatisticsFunc: &DBStoreUsageStatisticsFunc{
+			defaultHook: i.UsageStatistics,
+		},
 	}
 }
 
@@ -375,35 +488,35 @@ func (c DBStoreCommitGraphMetadataFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// DBStoreDefinitionDumpsFunc describes the behavior when the
-// DefinitionDumps method of the parent MockDBStore instance is invoked.
-type DBStoreDefinitionDumpsFunc struct {
-	defaultHook func(context.Context, []semantic.QualifiedMonikerData) ([]dbstore.Dump, error)
-	hooks       []func(context.Context, []semantic.QualifiedMonikerData) ([]dbstore.Dump, error)
-	history     []DBStoreDefinitionDumpsFuncCall
+// DBStoreCountCoveredCommitsFunc describes the behavior when the
+// CountCoveredCommits method of the parent MockDBStore instance is invoked.
+type DBStoreCountCoveredCommitsFunc struct {
+	defaultHook func(context.Context, int, []string) (int, error)
+	hooks       []func(context.Context, int, []string) (int, error)
+	history     []DBStoreCountCoveredCommitsFuncCall
 	mutex       sync.Mutex
 }
 
-// DefinitionDumps delegates to the next hook function in the queue and
+// CountCoveredCommits delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockDBStore) DefinitionDumps(v0 context.Context, v1 []semantic.QualifiedMonikerData) ([]dbstore.Dump, error) {
-	r0, r1 := m.DefinitionDumpsFunc.nextHook()(v0, v1)
-	m.DefinitionDumpsFunc.appendCall(DBStoreDefinitionDumpsFuncCall{v0, v1, r0, r1})
+func (m *MockDBStore) CountCoveredCommits(v0 context.Context, v1 int, v2 []string) (int, error) {
+	r0, r1 := m.CountCoveredCommitsFunc.nextHook()(v0, v1, v2)
+	m.CountCoveredCommitsFunc.appendCall(DBStoreCountCoveredCommitsFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the DefinitionDumps
+// SetDefaultHook sets function that is called when the CountCoveredCommits
 // method of the parent MockDBStore instance is invoked and the hook queue
 // is empty.
-func (f *DBStoreDefinitionDumpsFunc) SetDefaultHook(hook func(context.Context, []semantic.QualifiedMonikerData) ([]dbstore.Dump, error)) {
+func (f *DBStoreCountCoveredCommitsFunc) SetDefaultHook(hook func(context.Context, int, []string) (int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// DefinitionDumps method of the parent MockDBStore instance invokes the
+// CountCoveredCommits method of the parent MockDBStore instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *DBStoreDefinitionDumpsFunc) PushHook(hook func(context.Context, []semantic.QualifiedMonikerData) ([]dbstore.Dump, error)) {
+func (f *DBStoreCountCoveredCommitsFunc) PushHook(hook func(context.Context, int, []string) (int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -411,21 +524,21 @@ func (f *DBStoreDefinitionDumpsFunc) PushHook(hook func(context.Context, []seman
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *DBStoreDefinitionDumpsFunc) SetDefaultReturn(r0 []dbstore.Dump, r1 error) {
-	f.SetDefaultHook(func(context.Context, []semantic.QualifiedMonikerData) ([]dbstore.Dump, error) {
+func (f *DBStoreCountCoveredCommitsFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, []string) (int, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *DBStoreDefinitionDumpsFunc) PushReturn(r0 []dbstore.Dump, r1 error) {
-	f.PushHook(func(context.Context, []semantic.QualifiedMonikerData) ([]dbstore.Dump, error) {
+func (f *DBStoreCountCoveredCommitsFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, int, []string) (int, error) {
 		return r0, r1
 	})
 }
 
-func (f *DBStoreDefinitionDumpsFunc) nextHook() func(context.Context, []semantic.QualifiedMonikerData) ([]dbstore.Dump, error) {
+func (f *DBStoreCountCoveredCommitsFunc) nextHook() func(context.Context, int, []string) (int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -438,35 +551,148 @@ func (f *DBStoreDefinitionDumpsFunc) nextHook() func(context.Context, []semantic
 	return hook
 }
 
-func (f *DBStoreDefinitionDumpsFunc) appendCall(r0 DBStoreDefinitionDumpsFuncCall) {
+func (f *DBStoreCountCoveredCommitsFunc) appendCall(r0 DBStoreCountCoveredCommitsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of DBStoreDefinitionDumpsFuncCall objects
+// History returns a sequence of DBStoreCountCoveredCommitsFuncCall objects
 // describing the invocations of this function.
-func (f *DBStoreDefinitionDumpsFunc) History() []DBStoreDefinitionDumpsFuncCall {
+func (f *DBStoreCountCoveredCommitsFunc) History() []DBStoreCountCoveredCommitsFuncCall {
 	f.mutex.Lock()
-	history := make([]DBStoreDefinitionDumpsFuncCall, len(f.history))
+	history := make([]DBStoreCountCoveredCommitsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// DBStoreDefinitionDumpsFuncCall is an object that describes an invocation
-// of method DefinitionDumps on an instance of MockDBStore.
-type DBStoreDefinitionDumpsFuncCall struct {
+// DBStoreCountCoveredCommitsFuncCall is an object that describes an
+// invocation of method CountCoveredCommits on an instance of MockDBStore.
+type DBStoreCountCoveredCommitsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 []semantic.QualifiedMonikerData
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []dbstore.Dump
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBStoreCountCoveredCommitsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBStoreCountCoveredCommitsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// DBStoreCreateRetentionPolicyFunc describes the behavior when the
+// CreateRetentionPolicy method of the parent MockDBStore instance is
+// invoked.
+type DBStoreCreateRetentionPolicyFunc struct {
+	defaultHook func(context.Context, dbstore.RetentionPolicy) (dbstore.RetentionPolicy, error)
+	hooks       []func(context.Context, dbstore.RetentionPolicy) (dbstore.RetentionPolicy, error)
+	history     []DBStoreCreateRetentionPolicyFuncCall
+	mutex       sync.Mutex
+}
+
+// CreateRetentionPolicy delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockDBStore) CreateRetentionPolicy(v0 context.Context, v1 dbstore.RetentionPolicy) (dbstore.RetentionPolicy, error) {
+	r0, r1 := m.CreateRetentionPolicyFunc.nextHook()(v0, v1)
+	m.CreateRetentionPolicyFunc.appendCall(DBStoreCreateRetentionPolicyFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// CreateRetentionPolicy method of the parent MockDBStore instance is
+// invoked and the hook queue is empty.
+func (f *DBStoreCreateRetentionPolicyFunc) SetDefaultHook(hook func(context.Context, dbstore.RetentionPolicy) (dbstore.RetentionPolicy, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreateRetentionPolicy method of the parent MockDBStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *DBStoreCreateRetentionPolicyFunc) PushHook(hook func(context.Context, dbstore.RetentionPolicy) (dbstore.RetentionPolicy, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *DBStoreCreateRetentionPolicyFunc) SetDefaultReturn(r0 dbstore.RetentionPolicy, r1 error) {
+	f.SetDefaultHook(func(context.Context, dbstore.RetentionPolicy) (dbstore.RetentionPolicy, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *DBStoreCreateRetentionPolicyFunc) PushReturn(r0 dbstore.RetentionPolicy, r1 error) {
+	f.PushHook(func(context.Context, dbstore.RetentionPolicy) (dbstore.RetentionPolicy, error) {
+		return r0, r1
+	})
+}
+
+func (f *DBStoreCreateRetentionPolicyFunc) nextHook() func(context.Context, dbstore.RetentionPolicy) (dbstore.RetentionPolicy, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBStoreCreateRetentionPolicyFunc) appendCall(r0 DBStoreCreateRetentionPolicyFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBStoreCreateRetentionPolicyFuncCall
+// objects describing the invocations of this function.
+func (f *DBStoreCreateRetentionPolicyFunc) History() []DBStoreCreateRetentionPolicyFuncCall {
+	f.mutex.Lock()
+	history := make([]DBStoreCreateRetentionPolicyFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBStoreCreateRetentionPolicyFuncCall is an object that describes an
+// invocation of method CreateRetentionPolicy on an instance of MockDBStore.
+type DBStoreCreateRetentionPolicyFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 dbstore.RetentionPolicy
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 dbstore.RetentionPolicy
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -474,13 +700,13 @@ type DBStoreDefinitionDumpsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c DBStoreDefinitionDumpsFuncCall) Args() []interface{} {
+func (c DBStoreCreateRetentionPolicyFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c DBStoreDefinitionDumpsFuncCall) Results() []interface{} {
+func (c DBStoreCreateRetentionPolicyFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
@@ -593,6 +819,118 @@ func (c DBStoreDeleteIndexByIDFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// DBStoreDeleteRetentionPolicyByIDFunc describes the behavior when the
+// DeleteRetentionPolicyByID method of the parent MockDBStore instance is
+// invoked.
+type DBStoreDeleteRetentionPolicyByIDFunc struct {
+	defaultHook func(context.Context, int) (bool, error)
+	hooks       []func(context.Context, int) (bool, error)
+	history     []DBStoreDeleteRetentionPolicyByIDFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteRetentionPolicyByID delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockDBStore) DeleteRetentionPolicyByID(v0 context.Context, v1 int) (bool, error) {
+	r0, r1 := m.DeleteRetentionPolicyByIDFunc.nextHook()(v0, v1)
+	m.DeleteRetentionPolicyByIDFunc.appendCall(DBStoreDeleteRetentionPolicyByIDFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// DeleteRetentionPolicyByID method of the parent MockDBStore instance is
+// invoked and the hook queue is empty.
+func (f *DBStoreDeleteRetentionPolicyByIDFunc) SetDefaultHook(hook func(context.Context, int) (bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteRetentionPolicyByID method of the parent MockDBStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *DBStoreDeleteRetentionPolicyByIDFunc) PushHook(hook func(context.Context, int) (bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *DBStoreDeleteRetentionPolicyByIDFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) (bool, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *DBStoreDeleteRetentionPolicyByIDFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, int) (bool, error) {
+		return r0, r1
+	})
+}
+
+func (f *DBStoreDeleteRetentionPolicyByIDFunc) nextHook() func(context.Context, int) (bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBStoreDeleteRetentionPolicyByIDFunc) appendCall(r0 DBStoreDeleteRetentionPolicyByIDFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBStoreDeleteRetentionPolicyByIDFuncCall
+// objects describing the invocations of this function.
+func (f *DBStoreDeleteRetentionPolicyByIDFunc) History() []DBStoreDeleteRetentionPolicyByIDFuncCall {
+	f.mutex.Lock()
+	history := make([]DBStoreDeleteRetentionPolicyByIDFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBStoreDeleteRetentionPolicyByIDFuncCall is an object that describes an
+// invocation of method DeleteRetentionPolicyByID on an instance of
+// MockDBStore.
+type DBStoreDeleteRetentionPolicyByIDFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 bool
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBStoreDeleteRetentionPolicyByIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBStoreDeleteRetentionPolicyByIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // DBStoreDeleteUploadByIDFunc describes the behavior when the
 // DeleteUploadByID method of the parent MockDBStore instance is invoked.
 type DBStoreDeleteUploadByIDFunc struct {
@@ -951,35 +1289,35 @@ func (c DBStoreFindClosestDumpsFromGraphFragmentFuncCall) Results() []interface{
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// DBStoreGetDumpsByIDsFunc describes the behavior when the GetDumpsByIDs
+// DBStoreGetDumpGroupsFunc describes the behavior when the GetDumpGroups
 // method of the parent MockDBStore instance is invoked.
-type DBStoreGetDumpsByIDsFunc struct {
-	defaultHook func(context.Context, []int) ([]dbstore.Dump, error)
-	hooks       []func(context.Context, []int) ([]dbstore.Dump, error)
-	history     []DBStoreGetDumpsByIDsFuncCall
+type DBStoreGetDumpGroupsFunc struct {
+	defaultHook func(context.Context, []int) ([]dbstore.DumpGroup, error)
+	hooks       []func(context.Context, []int) ([]dbstore.DumpGroup, error)
+	history     []DBStoreGetDumpGroupsFuncCall
 	mutex       sync.Mutex
 }
 
-// GetDumpsByIDs delegates to the next hook function in the queue and stores
+// GetDumpGroups delegates to the next hook function in the queue and stores
 // the parameter and result values of this invocation.
-func (m *MockDBStore) GetDumpsByIDs(v0 context.Context, v1 []int) ([]dbstore.Dump, error) {
-	r0, r1 := m.GetDumpsByIDsFunc.nextHook()(v0, v1)
-	m.GetDumpsByIDsFunc.appendCall(DBStoreGetDumpsByIDsFuncCall{v0, v1, r0, r1})
+func (m *MockDBStore) GetDumpGroups(v0 context.Context, v1 []int) ([]dbstore.DumpGroup, error) {
+	r0, r1 := m.GetDumpGroupsFunc.nextHook()(v0, v1)
+	m.GetDumpGroupsFunc.appendCall(DBStoreGetDumpGroupsFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetDumpsByIDs method
+// SetDefaultHook sets function that is called when the GetDumpGroups method
 // of the parent MockDBStore instance is invoked and the hook queue is
 // empty.
-func (f *DBStoreGetDumpsByIDsFunc) SetDefaultHook(hook func(context.Context, []int) ([]dbstore.Dump, error)) {
+func (f *DBStoreGetDumpGroupsFunc) SetDefaultHook(hook func(context.Context, []int) ([]dbstore.DumpGroup, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetDumpsByIDs method of the parent MockDBStore instance invokes the hook
+// GetDumpGroups method of the parent MockDBStore instance invokes the hook
 // at the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *DBStoreGetDumpsByIDsFunc) PushHook(hook func(context.Context, []int) ([]dbstore.Dump, error)) {
+func (f *DBStoreGetDumpGroupsFunc) PushHook(hook func(context.Context, []int) ([]dbstore.DumpGroup, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -987,21 +1325,21 @@ func (f *DBStoreGetDumpsByIDsFunc) PushHook(hook func(context.Context, []int) ([
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *DBStoreGetDumpsByIDsFunc) SetDefaultReturn(r0 []dbstore.Dump, r1 error) {
-	f.SetDefaultHook(func(context.Context, []int) ([]dbstore.Dump, error) {
+func (f *DBStoreGetDumpGroupsFunc) SetDefaultReturn(r0 []dbstore.DumpGroup, r1 error) {
+	f.SetDefaultHook(func(context.Context, []int) ([]dbstore.DumpGroup, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *DBStoreGetDumpsByIDsFunc) PushReturn(r0 []dbstore.Dump, r1 error) {
-	f.PushHook(func(context.Context, []int) ([]dbstore.Dump, error) {
+func (f *DBStoreGetDumpGroupsFunc) PushReturn(r0 []dbstore.DumpGroup, r1 error) {
+	f.PushHook(func(context.Context, []int) ([]dbstore.DumpGroup, error) {
 		return r0, r1
 	})
 }
 
-func (f *DBStoreGetDumpsByIDsFunc) nextHook() func(context.Context, []int) ([]dbstore.Dump, error) {
+func (f *DBStoreGetDumpGroupsFunc) nextHook() func(context.Context, []int) ([]dbstore.DumpGroup, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1014,26 +1352,26 @@ func (f *DBStoreGetDumpsByIDsFunc) nextHook() func(context.Context, []int) ([]db
 	return hook
 }
 
-func (f *DBStoreGetDumpsByIDsFunc) appendCall(r0 DBStoreGetDumpsByIDsFuncCall) {
+func (f *DBStoreGetDumpGroupsFunc) appendCall(r0 DBStoreGetDumpGroupsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of DBStoreGetDumpsByIDsFuncCall objects
+// History returns a sequence of DBStoreGetDumpGroupsFuncCall objects
 // describing the invocations of this function.
-func (f *DBStoreGetDumpsByIDsFunc) History() []DBStoreGetDumpsByIDsFuncCall {
+func (f *DBStoreGetDumpGroupsFunc) History() []DBStoreGetDumpGroupsFuncCall {
 	f.mutex.Lock()
-	history := make([]DBStoreGetDumpsByIDsFuncCall, len(f.history))
+	history := make([]DBStoreGetDumpGroupsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// DBStoreGetDumpsByIDsFuncCall is an object that describes an invocation of
-// method GetDumpsByIDs on an instance of MockDBStore.
-type DBStoreGetDumpsByIDsFuncCall struct {
+// DBStoreGetDumpGroupsFuncCall is an object that describes an invocation of
+// method GetDumpGroups on an instance of MockDBStore.
+type DBStoreGetDumpGroupsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -1042,7 +1380,7 @@ type DBStoreGetDumpsByIDsFuncCall struct {
 	Arg1 []int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []dbstore.Dump
+	Result0 []dbstore.DumpGroup
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -1050,26 +1388,135 @@ type DBStoreGetDumpsByIDsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c DBStoreGetDumpsByIDsFuncCall) Args() []interface{} {
+func (c DBStoreGetDumpGroupsFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c DBStoreGetDumpsByIDsFuncCall) Results() []interface{} {
+func (c DBStoreGetDumpGroupsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// DBStoreGetIndexByIDFunc describes the behavior when the GetIndexByID
+// DBStoreGetDumpsByIDsFunc describes the behavior when the GetDumpsByIDs
 // method of the parent MockDBStore instance is invoked.
-type DBStoreGetIndexByIDFunc struct {
-	defaultHook func(context.Context, int) (dbstore.Index, bool, error)
-	hooks       []func(context.Context, int) (dbstore.Index, bool, error)
-	history     []DBStoreGetIndexByIDFuncCall
+type DBStoreGetDumpsByIDsFunc struct {
+	defaultHook func(context.Context, []int) ([]dbstore.Dump, error)
+	hooks       []func(context.Context, []int) ([]dbstore.Dump, error)
+	history     []DBStoreGetDumpsByIDsFuncCall
 	mutex       sync.Mutex
 }
 
-// GetIndexByID delegates to the next hook function in the queue and stores
+// GetDumpsByIDs delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockDBStore) GetDumpsByIDs(v0 context.Context, v1 []int) ([]dbstore.Dump, error) {
+	r0, r1 := m.GetDumpsByIDsFunc.nextHook()(v0, v1)
+	m.GetDumpsByIDsFunc.appendCall(DBStoreGetDumpsByIDsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetDumpsByIDs method
+// of the parent MockDBStore instance is invoked and the hook queue is
+// empty.
+func (f *DBStoreGetDumpsByIDsFunc) SetDefaultHook(hook func(context.Context, []int) ([]dbstore.Dump, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetDumpsByIDs method of the parent MockDBStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *DBStoreGetDumpsByIDsFunc) PushHook(hook func(context.Context, []int) ([]dbstore.Dump, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *DBStoreGetDumpsByIDsFunc) SetDefaultReturn(r0 []dbstore.Dump, r1 error) {
+	f.SetDefaultHook(func(context.Context, []int) ([]dbstore.Dump, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *DBStoreGetDumpsByIDsFunc) PushReturn(r0 []dbstore.Dump, r1 error) {
+	f.PushHook(func(context.Context, []int) ([]dbstore.Dump, error) {
+		return r0, r1
+	})
+}
+
+func (f *DBStoreGetDumpsByIDsFunc) nextHook() func(context.Context, []int) ([]dbstore.Dump, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBStoreGetDumpsByIDsFunc) appendCall(r0 DBStoreGetDumpsByIDsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBStoreGetDumpsByIDsFuncCall objects
+// describing the invocations of this function.
+func (f *DBStoreGetDumpsByIDsFunc) History() []DBStoreGetDumpsByIDsFuncCall {
+	f.mutex.Lock()
+	history := make([]DBStoreGetDumpsByIDsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBStoreGetDumpsByIDsFuncCall is an object that describes an invocation of
+// method GetDumpsByIDs on an instance of MockDBStore.
+type DBStoreGetDumpsByIDsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 []int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []dbstore.Dump
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBStoreGetDumpsByIDsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBStoreGetDumpsByIDsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// DBStoreGetIndexByIDFunc describes the behavior when the GetIndexByID
+// method of the parent MockDBStore instance is invoked.
+type DBStoreGetIndexByIDFunc struct {
+	defaultHook func(context.Context, int) (dbstore.Index, bool, error)
+	hooks       []func(context.Context, int) (dbstore.Index, bool, error)
+	history     []DBStoreGetIndexByIDFuncCall
+	mutex       sync.Mutex
+}
+
+// GetIndexByID delegates to the next hook function in the queue and stores
 // the parameter and result values of this invocation.
 func (m *MockDBStore) GetIndexByID(v0 context.Context, v1 int) (dbstore.Index, bool, error) {
 	r0, r1, r2 := m.GetIndexByIDFunc.nextHook()(v0, v1)
@@ -1516,35 +1963,35 @@ func (c DBStoreGetIndexesByIDsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// DBStoreGetUploadByIDFunc describes the behavior when the GetUploadByID
-// method of the parent MockDBStore instance is invoked.
-type DBStoreGetUploadByIDFunc struct {
-	defaultHook func(context.Context, int) (dbstore.Upload, bool, error)
-	hooks       []func(context.Context, int) (dbstore.Upload, bool, error)
-	history     []DBStoreGetUploadByIDFuncCall
+// DBStoreGetPackagesFunc describes the behavior when the GetPackages method
+// of the parent MockDBStore instance is invoked.
+type DBStoreGetPackagesFunc struct {
+	defaultHook func(context.Context, []dbstore.PackageKey) (map[dbstore.PackageKey]dbstore.Dump, error)
+	hooks       []func(context.Context, []dbstore.PackageKey) (map[dbstore.PackageKey]dbstore.Dump, error)
+	history     []DBStoreGetPackagesFuncCall
 	mutex       sync.Mutex
 }
 
-// GetUploadByID delegates to the next hook function in the queue and stores
+// GetPackages delegates to the next hook function in the queue and stores
 // the parameter and result values of this invocation.
-func (m *MockDBStore) GetUploadByID(v0 context.Context, v1 int) (dbstore.Upload, bool, error) {
-	r0, r1, r2 := m.GetUploadByIDFunc.nextHook()(v0, v1)
-	m.GetUploadByIDFunc.appendCall(DBStoreGetUploadByIDFuncCall{v0, v1, r0, r1, r2})
-	return r0, r1, r2
+func (m *MockDBStore) GetPackages(v0 context.Context, v1 []dbstore.PackageKey) (map[dbstore.PackageKey]dbstore.Dump, error) {
+	r0, r1 := m.GetPackagesFunc.nextHook()(v0, v1)
+	m.GetPackagesFunc.appendCall(DBStoreGetPackagesFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetUploadByID method
+// SetDefaultHook sets function that is called when the GetPackages method
 // of the parent MockDBStore instance is invoked and the hook queue is
 // empty.
-func (f *DBStoreGetUploadByIDFunc) SetDefaultHook(hook func(context.Context, int) (dbstore.Upload, bool, error)) {
+func (f *DBStoreGetPackagesFunc) SetDefaultHook(hook func(context.Context, []dbstore.PackageKey) (map[dbstore.PackageKey]dbstore.Dump, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetUploadByID method of the parent MockDBStore instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
+// GetPackages method of the parent MockDBStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *DBStoreGetUploadByIDFunc) PushHook(hook func(context.Context, int) (dbstore.Upload, bool, error)) {
+func (f *DBStoreGetPackagesFunc) PushHook(hook func(context.Context, []dbstore.PackageKey) (map[dbstore.PackageKey]dbstore.Dump, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1552,21 +1999,21 @@ func (f *DBStoreGetUploadByIDFunc) PushHook(hook func(context.Context, int) (dbs
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *DBStoreGetUploadByIDFunc) SetDefaultReturn(r0 dbstore.Upload, r1 bool, r2 error) {
-	f.SetDefaultHook(func(context.Context, int) (dbstore.Upload, bool, error) {
-		return r0, r1, r2
+func (f *DBStoreGetPackagesFunc) SetDefaultReturn(r0 map[dbstore.PackageKey]dbstore.Dump, r1 error) {
+	f.SetDefaultHook(func(context.Context, []dbstore.PackageKey) (map[dbstore.PackageKey]dbstore.Dump, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *DBStoreGetUploadByIDFunc) PushReturn(r0 dbstore.Upload, r1 bool, r2 error) {
-	f.PushHook(func(context.Context, int) (dbstore.Upload, bool, error) {
-		return r0, r1, r2
+func (f *DBStoreGetPackagesFunc) PushReturn(r0 map[dbstore.PackageKey]dbstore.Dump, r1 error) {
+	f.PushHook(func(context.Context, []dbstore.PackageKey) (map[dbstore.PackageKey]dbstore.Dump, error) {
+		return r0, r1
 	})
 }
 
-func (f *DBStoreGetUploadByIDFunc) nextHook() func(context.Context, int) (dbstore.Upload, bool, error) {
+func (f *DBStoreGetPackagesFunc) nextHook() func(context.Context, []dbstore.PackageKey) (map[dbstore.PackageKey]dbstore.Dump, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1579,83 +2026,82 @@ func (f *DBStoreGetUploadByIDFunc) nextHook() func(context.Context, int) (dbstor
 	return hook
 }
 
-func (f *DBStoreGetUploadByIDFunc) appendCall(r0 DBStoreGetUploadByIDFuncCall) {
+func (f *DBStoreGetPackagesFunc) appendCall(r0 DBStoreGetPackagesFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of DBStoreGetUploadByIDFuncCall objects
+// History returns a sequence of DBStoreGetPackagesFuncCall objects
 // describing the invocations of this function.
-func (f *DBStoreGetUploadByIDFunc) History() []DBStoreGetUploadByIDFuncCall {
+func (f *DBStoreGetPackagesFunc) History() []DBStoreGetPackagesFuncCall {
 	f.mutex.Lock()
-	history := make([]DBStoreGetUploadByIDFuncCall, len(f.history))
+	history := make([]DBStoreGetPackagesFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// DBStoreGetUploadByIDFuncCall is an object that describes an invocation of
-// method GetUploadByID on an instance of MockDBStore.
-type DBStoreGetUploadByIDFuncCall struct {
+// DBStoreGetPackagesFuncCall is an object that describes an invocation of
+// method GetPackages on an instance of MockDBStore.
+type DBStoreGetPackagesFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int
+	Arg1 []dbstore.PackageKey
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 dbstore.Upload
+	Result0 map[dbstore.PackageKey]dbstore.Dump
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 bool
-	// Result2 is the value of the 3rd result returned from this method
-	// invocation.
-	Result2 error
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c DBStoreGetUploadByIDFuncCall) Args() []interface{} {
+func (c DBStoreGetPackagesFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c DBStoreGetUploadByIDFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2}
+func (c DBStoreGetPackagesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// DBStoreGetUploadsFunc describes the behavior when the GetUploads method
-// of the parent MockDBStore instance is invoked.
-type DBStoreGetUploadsFunc struct {
-	defaultHook func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error)
-	hooks       []func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error)
-	history     []DBStoreGetUploadsFuncCall
+// DBStoreGetRetentionPoliciesFunc describes the behavior when the
+// GetRetentionPolicies method of the parent MockDBStore instance is
+// invoked.
+type DBStoreGetRetentionPoliciesFunc struct {
+	defaultHook func(context.Context) ([]dbstore.RetentionPolicy, error)
+	hooks       []func(context.Context) ([]dbstore.RetentionPolicy, error)
+	history     []DBStoreGetRetentionPoliciesFuncCall
 	mutex       sync.Mutex
 }
 
-// GetUploads delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockDBStore) GetUploads(v0 context.Context, v1 dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error) {
-	r0, r1, r2 := m.GetUploadsFunc.nextHook()(v0, v1)
-	m.GetUploadsFunc.appendCall(DBStoreGetUploadsFuncCall{v0, v1, r0, r1, r2})
-	return r0, r1, r2
+// GetRetentionPolicies delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockDBStore) GetRetentionPolicies(v0 context.Context) ([]dbstore.RetentionPolicy, error) {
+	r0, r1 := m.GetRetentionPoliciesFunc.nextHook()(v0)
+	m.GetRetentionPoliciesFunc.appendCall(DBStoreGetRetentionPoliciesFuncCall{v0, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetUploads method of
-// the parent MockDBStore instance is invoked and the hook queue is empty.
-func (f *DBStoreGetUploadsFunc) SetDefaultHook(hook func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error)) {
+// SetDefaultHook sets function that is called when the GetRetentionPolicies
+// method of the parent MockDBStore instance is invoked and the hook queue
+// is empty.
+func (f *DBStoreGetRetentionPoliciesFunc) SetDefaultHook(hook func(context.Context) ([]dbstore.RetentionPolicy, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetUploads method of the parent MockDBStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *DBStoreGetUploadsFunc) PushHook(hook func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error)) {
+// GetRetentionPolicies method of the parent MockDBStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *DBStoreGetRetentionPoliciesFunc) PushHook(hook func(context.Context) ([]dbstore.RetentionPolicy, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1663,21 +2109,21 @@ func (f *DBStoreGetUploadsFunc) PushHook(hook func(context.Context, dbstore.GetU
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *DBStoreGetUploadsFunc) SetDefaultReturn(r0 []dbstore.Upload, r1 int, r2 error) {
-	f.SetDefaultHook(func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error) {
-		return r0, r1, r2
+func (f *DBStoreGetRetentionPoliciesFunc) SetDefaultReturn(r0 []dbstore.RetentionPolicy, r1 error) {
+	f.SetDefaultHook(func(context.Context) ([]dbstore.RetentionPolicy, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *DBStoreGetUploadsFunc) PushReturn(r0 []dbstore.Upload, r1 int, r2 error) {
-	f.PushHook(func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error) {
-		return r0, r1, r2
+func (f *DBStoreGetRetentionPoliciesFunc) PushReturn(r0 []dbstore.RetentionPolicy, r1 error) {
+	f.PushHook(func(context.Context) ([]dbstore.RetentionPolicy, error) {
+		return r0, r1
 	})
 }
 
-func (f *DBStoreGetUploadsFunc) nextHook() func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error) {
+func (f *DBStoreGetRetentionPoliciesFunc) nextHook() func(context.Context) ([]dbstore.RetentionPolicy, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1690,84 +2136,78 @@ func (f *DBStoreGetUploadsFunc) nextHook() func(context.Context, dbstore.GetUplo
 	return hook
 }
 
-func (f *DBStoreGetUploadsFunc) appendCall(r0 DBStoreGetUploadsFuncCall) {
+func (f *DBStoreGetRetentionPoliciesFunc) appendCall(r0 DBStoreGetRetentionPoliciesFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of DBStoreGetUploadsFuncCall objects
+// History returns a sequence of DBStoreGetRetentionPoliciesFuncCall objects
 // describing the invocations of this function.
-func (f *DBStoreGetUploadsFunc) History() []DBStoreGetUploadsFuncCall {
+func (f *DBStoreGetRetentionPoliciesFunc) History() []DBStoreGetRetentionPoliciesFuncCall {
 	f.mutex.Lock()
-	history := make([]DBStoreGetUploadsFuncCall, len(f.history))
+	history := make([]DBStoreGetRetentionPoliciesFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// DBStoreGetUploadsFuncCall is an object that describes an invocation of
-// method GetUploads on an instance of MockDBStore.
-type DBStoreGetUploadsFuncCall struct {
+// DBStoreGetRetentionPoliciesFuncCall is an object that describes an
+// invocation of method GetRetentionPolicies on an instance of MockDBStore.
+type DBStoreGetRetentionPoliciesFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 dbstore.GetUploadsOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []dbstore.Upload
+	Result0 []dbstore.RetentionPolicy
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 int
-	// Result2 is the value of the 3rd result returned from this method
-	// invocation.
-	Result2 error
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c DBStoreGetUploadsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c DBStoreGetRetentionPoliciesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c DBStoreGetUploadsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2}
+func (c DBStoreGetRetentionPoliciesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// DBStoreGetUploadsByIDsFunc describes the behavior when the
-// GetUploadsByIDs method of the parent MockDBStore instance is invoked.
-type DBStoreGetUploadsByIDsFunc struct {
-	defaultHook func(context.Context, ...int) ([]dbstore.Upload, error)
-	hooks       []func(context.Context, ...int) ([]dbstore.Upload, error)
-	history     []DBStoreGetUploadsByIDsFuncCall
+// DBStoreGetUploadByIDFunc describes the behavior when the GetUploadByID
+// method of the parent MockDBStore instance is invoked.
+type DBStoreGetUploadByIDFunc struct {
+	defaultHook func(context.Context, int) (dbstore.Upload, bool, error)
+	hooks       []func(context.Context, int) (dbstore.Upload, bool, error)
+	history     []DBStoreGetUploadByIDFuncCall
 	mutex       sync.Mutex
 }
 
-// GetUploadsByIDs delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockDBStore) GetUploadsByIDs(v0 context.Context, v1 ...int) ([]dbstore.Upload, error) {
-	r0, r1 := m.GetUploadsByIDsFunc.nextHook()(v0, v1...)
-	m.GetUploadsByIDsFunc.appendCall(DBStoreGetUploadsByIDsFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// GetUploadByID delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockDBStore) GetUploadByID(v0 context.Context, v1 int) (dbstore.Upload, bool, error) {
+	r0, r1, r2 := m.GetUploadByIDFunc.nextHook()(v0, v1)
+	m.GetUploadByIDFunc.appendCall(DBStoreGetUploadByIDFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
 }
 
-// SetDefaultHook sets function that is called when the GetUploadsByIDs
-// method of the parent MockDBStore instance is invoked and the hook queue
-// is empty.
-func (f *DBStoreGetUploadsByIDsFunc) SetDefaultHook(hook func(context.Context, ...int) ([]dbstore.Upload, error)) {
+// SetDefaultHook sets function that is called when the GetUploadByID method
+// of the parent MockDBStore instance is invoked and the hook queue is
+// empty.
+func (f *DBStoreGetUploadByIDFunc) SetDefaultHook(hook func(context.Context, int) (dbstore.Upload, bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetUploadsByIDs method of the parent MockDBStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *DBStoreGetUploadsByIDsFunc) PushHook(hook func(context.Context, ...int) ([]dbstore.Upload, error)) {
+// GetUploadByID method of the parent MockDBStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *DBStoreGetUploadByIDFunc) PushHook(hook func(context.Context, int) (dbstore.Upload, bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1775,21 +2215,21 @@ func (f *DBStoreGetUploadsByIDsFunc) PushHook(hook func(context.Context, ...int)
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *DBStoreGetUploadsByIDsFunc) SetDefaultReturn(r0 []dbstore.Upload, r1 error) {
-	f.SetDefaultHook(func(context.Context, ...int) ([]dbstore.Upload, error) {
-		return r0, r1
-	})
+func (f *DBStoreGetUploadByIDFunc) SetDefaultReturn(r0 dbstore.Upload, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, int) (dbstore.Upload, bool, error) {
+		return r0, r1, r2
+	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *DBStoreGetUploadsByIDsFunc) PushReturn(r0 []dbstore.Upload, r1 error) {
-	f.PushHook(func(context.Context, ...int) ([]dbstore.Upload, error) {
-		return r0, r1
+func (f *DBStoreGetUploadByIDFunc) PushReturn(r0 dbstore.Upload, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, int) (dbstore.Upload, bool, error) {
+		return r0, r1, r2
 	})
 }
 
-func (f *DBStoreGetUploadsByIDsFunc) nextHook() func(context.Context, ...int) ([]dbstore.Upload, error) {
+func (f *DBStoreGetUploadByIDFunc) nextHook() func(context.Context, int) (dbstore.Upload, bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1802,87 +2242,84 @@ func (f *DBStoreGetUploadsByIDsFunc) nextHook() func(context.Context, ...int) ([
 	return hook
 }
 
-func (f *DBStoreGetUploadsByIDsFunc) appendCall(r0 DBStoreGetUploadsByIDsFuncCall) {
+func (f *DBStoreGetUploadByIDFunc) appendCall(r0 DBStoreGetUploadByIDFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of DBStoreGetUploadsByIDsFuncCall objects
+// History returns a sequence of DBStoreGetUploadByIDFuncCall objects
 // describing the invocations of this function.
-func (f *DBStoreGetUploadsByIDsFunc) History() []DBStoreGetUploadsByIDsFuncCall {
+func (f *DBStoreGetUploadByIDFunc) History() []DBStoreGetUploadByIDFuncCall {
 	f.mutex.Lock()
-	history := make([]DBStoreGetUploadsByIDsFuncCall, len(f.history))
+	history := make([]DBStoreGetUploadByIDFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// DBStoreGetUploadsByIDsFuncCall is an object that describes an invocation
-// of method GetUploadsByIDs on an instance of MockDBStore.
-type DBStoreGetUploadsByIDsFuncCall struct {
+// DBStoreGetUploadByIDFuncCall is an object that describes an invocation of
+// method GetUploadByID on an instance of MockDBStore.
+type DBStoreGetUploadByIDFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is a slice containing the values of the variadic arguments
-	// passed to this method invocation.
-	Arg1 []int
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []dbstore.Upload
+	Result0 dbstore.Upload
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 error
+	Result1 bool
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation. The variadic slice argument is flattened in this array such
-// that one positional argument and three variadic arguments would result in
-// a slice of four, not two.
-func (c DBStoreGetUploadsByIDsFuncCall) Args() []interface{} {
-	trailing := []interface{}{}
-	for _, val := range c.Arg1 {
-		trailing = append(trailing, val)
-	}
-
-	return append([]interface{}{c.Arg0}, trailing...)
+// invocation.
+func (c DBStoreGetUploadByIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c DBStoreGetUploadsByIDsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c DBStoreGetUploadByIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// DBStoreHasCommitFunc describes the behavior when the HasCommit method of
-// the parent MockDBStore instance is invoked.
-type DBStoreHasCommitFunc struct {
-	defaultHook func(context.Context, int, string) (bool, error)
-	hooks       []func(context.Context, int, string) (bool, error)
-	history     []DBStoreHasCommitFuncCall
+// DBStoreGetUploadProgressFunc describes the behavior when the
+// GetUploadProgress method of the parent MockDBStore instance is invoked.
+type DBStoreGetUploadProgressFunc struct {
+	defaultHook func(context.Context, int) (dbstore.UploadProgress, bool, error)
+	hooks       []func(context.Context, int) (dbstore.UploadProgress, bool, error)
+	history     []DBStoreGetUploadProgressFuncCall
 	mutex       sync.Mutex
 }
 
-// HasCommit delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockDBStore) HasCommit(v0 context.Context, v1 int, v2 string) (bool, error) {
-	r0, r1 := m.HasCommitFunc.nextHook()(v0, v1, v2)
-	m.HasCommitFunc.appendCall(DBStoreHasCommitFuncCall{v0, v1, v2, r0, r1})
-	return r0, r1
+// GetUploadProgress delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockDBStore) GetUploadProgress(v0 context.Context, v1 int) (dbstore.UploadProgress, bool, error) {
+	r0, r1, r2 := m.GetUploadProgressFunc.nextHook()(v0, v1)
+	m.GetUploadProgressFunc.appendCall(DBStoreGetUploadProgressFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
 }
 
-// SetDefaultHook sets function that is called when the HasCommit method of
-// the parent MockDBStore instance is invoked and the hook queue is empty.
-func (f *DBStoreHasCommitFunc) SetDefaultHook(hook func(context.Context, int, string) (bool, error)) {
+// SetDefaultHook sets function that is called when the GetUploadProgress
+// method of the parent MockDBStore instance is invoked and the hook queue
+// is empty.
+func (f *DBStoreGetUploadProgressFunc) SetDefaultHook(hook func(context.Context, int) (dbstore.UploadProgress, bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// HasCommit method of the parent MockDBStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *DBStoreHasCommitFunc) PushHook(hook func(context.Context, int, string) (bool, error)) {
+// GetUploadProgress method of the parent MockDBStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *DBStoreGetUploadProgressFunc) PushHook(hook func(context.Context, int) (dbstore.UploadProgress, bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1890,21 +2327,21 @@ func (f *DBStoreHasCommitFunc) PushHook(hook func(context.Context, int, string)
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *DBStoreHasCommitFunc) SetDefaultReturn(r0 bool, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, string) (bool, error) {
-		return r0, r1
+func (f *DBStoreGetUploadProgressFunc) SetDefaultReturn(r0 dbstore.UploadProgress, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, int) (dbstore.UploadProgress, bool, error) {
+		return r0, r1, r2
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *DBStoreHasCommitFunc) PushReturn(r0 bool, r1 error) {
-	f.PushHook(func(context.Context, int, string) (bool, error) {
-		return r0, r1
+func (f *DBStoreGetUploadProgressFunc) PushReturn(r0 dbstore.UploadProgress, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, int) (dbstore.UploadProgress, bool, error) {
+		return r0, r1, r2
 	})
 }
 
-func (f *DBStoreHasCommitFunc) nextHook() func(context.Context, int, string) (bool, error) {
+func (f *DBStoreGetUploadProgressFunc) nextHook() func(context.Context, int) (dbstore.UploadProgress, bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1917,84 +2354,83 @@ func (f *DBStoreHasCommitFunc) nextHook() func(context.Context, int, string) (bo
 	return hook
 }
 
-func (f *DBStoreHasCommitFunc) appendCall(r0 DBStoreHasCommitFuncCall) {
+func (f *DBStoreGetUploadProgressFunc) appendCall(r0 DBStoreGetUploadProgressFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of DBStoreHasCommitFuncCall objects describing
-// the invocations of this function.
-func (f *DBStoreHasCommitFunc) History() []DBStoreHasCommitFuncCall {
+// History returns a sequence of DBStoreGetUploadProgressFuncCall objects
+// describing the invocations of this function.
+func (f *DBStoreGetUploadProgressFunc) History() []DBStoreGetUploadProgressFuncCall {
 	f.mutex.Lock()
-	history := make([]DBStoreHasCommitFuncCall, len(f.history))
+	history := make([]DBStoreGetUploadProgressFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// DBStoreHasCommitFuncCall is an object that describes an invocation of
-// method HasCommit on an instance of MockDBStore.
-type DBStoreHasCommitFuncCall struct {
+// DBStoreGetUploadProgressFuncCall is an object that describes an
+// invocation of method GetUploadProgress on an instance of MockDBStore.
+type DBStoreGetUploadProgressFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
 	Arg1 int
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 bool
+	Result0 dbstore.UploadProgress
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 error
+	Result1 bool
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c DBStoreHasCommitFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c DBStoreGetUploadProgressFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c DBStoreHasCommitFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c DBStoreGetUploadProgressFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// DBStoreHasRepositoryFunc describes the behavior when the HasRepository
-// method of the parent MockDBStore instance is invoked.
-type DBStoreHasRepositoryFunc struct {
-	defaultHook func(context.Context, int) (bool, error)
-	hooks       []func(context.Context, int) (bool, error)
-	history     []DBStoreHasRepositoryFuncCall
+// DBStoreGetUploadsFunc describes the behavior when the GetUploads method
+// of the parent MockDBStore instance is invoked.
+type DBStoreGetUploadsFunc struct {
+	defaultHook func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error)
+	hooks       []func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error)
+	history     []DBStoreGetUploadsFuncCall
 	mutex       sync.Mutex
 }
 
-// HasRepository delegates to the next hook function in the queue and stores
+// GetUploads delegates to the next hook function in the queue and stores
 // the parameter and result values of this invocation.
-func (m *MockDBStore) HasRepository(v0 context.Context, v1 int) (bool, error) {
-	r0, r1 := m.HasRepositoryFunc.nextHook()(v0, v1)
-	m.HasRepositoryFunc.appendCall(DBStoreHasRepositoryFuncCall{v0, v1, r0, r1})
-	return r0, r1
+func (m *MockDBStore) GetUploads(v0 context.Context, v1 dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error) {
+	r0, r1, r2 := m.GetUploadsFunc.nextHook()(v0, v1)
+	m.GetUploadsFunc.appendCall(DBStoreGetUploadsFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
 }
 
-// SetDefaultHook sets function that is called when the HasRepository method
-// of the parent MockDBStore instance is invoked and the hook queue is
-// empty.
-func (f *DBStoreHasRepositoryFunc) SetDefaultHook(hook func(context.Context, int) (bool, error)) {
+// SetDefaultHook sets function that is called when the GetUploads method of
+// the parent MockDBStore instance is invoked and the hook queue is empty.
+func (f *DBStoreGetUploadsFunc) SetDefaultHook(hook func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// HasRepository method of the parent MockDBStore instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
+// GetUploads method of the parent MockDBStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *DBStoreHasRepositoryFunc) PushHook(hook func(context.Context, int) (bool, error)) {
+func (f *DBStoreGetUploadsFunc) PushHook(hook func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2002,21 +2438,21 @@ func (f *DBStoreHasRepositoryFunc) PushHook(hook func(context.Context, int) (boo
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *DBStoreHasRepositoryFunc) SetDefaultReturn(r0 bool, r1 error) {
-	f.SetDefaultHook(func(context.Context, int) (bool, error) {
-		return r0, r1
+func (f *DBStoreGetUploadsFunc) SetDefaultReturn(r0 []dbstore.Upload, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error) {
+		return r0, r1, r2
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *DBStoreHasRepositoryFunc) PushReturn(r0 bool, r1 error) {
-	f.PushHook(func(context.Context, int) (bool, error) {
-		return r0, r1
+func (f *DBStoreGetUploadsFunc) PushReturn(r0 []dbstore.Upload, r1 int, r2 error) {
+	f.PushHook(func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error) {
+		return r0, r1, r2
 	})
 }
 
-func (f *DBStoreHasRepositoryFunc) nextHook() func(context.Context, int) (bool, error) {
+func (f *DBStoreGetUploadsFunc) nextHook() func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2029,82 +2465,84 @@ func (f *DBStoreHasRepositoryFunc) nextHook() func(context.Context, int) (bool,
 	return hook
 }
 
-func (f *DBStoreHasRepositoryFunc) appendCall(r0 DBStoreHasRepositoryFuncCall) {
+func (f *DBStoreGetUploadsFunc) appendCall(r0 DBStoreGetUploadsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of DBStoreHasRepositoryFuncCall objects
+// History returns a sequence of DBStoreGetUploadsFuncCall objects
 // describing the invocations of this function.
-func (f *DBStoreHasRepositoryFunc) History() []DBStoreHasRepositoryFuncCall {
+func (f *DBStoreGetUploadsFunc) History() []DBStoreGetUploadsFuncCall {
 	f.mutex.Lock()
-	history := make([]DBStoreHasRepositoryFuncCall, len(f.history))
+	history := make([]DBStoreGetUploadsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// DBStoreHasRepositoryFuncCall is an object that describes an invocation of
-// method HasRepository on an instance of MockDBStore.
-type DBStoreHasRepositoryFuncCall struct {
+// DBStoreGetUploadsFuncCall is an object that describes an invocation of
+// method GetUploads on an instance of MockDBStore.
+type DBStoreGetUploadsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int
+	Arg1 dbstore.GetUploadsOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 bool
+	Result0 []dbstore.Upload
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 error
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c DBStoreHasRepositoryFuncCall) Args() []interface{} {
+func (c DBStoreGetUploadsFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c DBStoreHasRepositoryFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c DBStoreGetUploadsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// DBStoreMarkRepositoryAsDirtyFunc describes the behavior when the
-// MarkRepositoryAsDirty method of the parent MockDBStore instance is
-// invoked.
-type DBStoreMarkRepositoryAsDirtyFunc struct {
-	defaultHook func(context.Context, int) error
-	hooks       []func(context.Context, int) error
-	history     []DBStoreMarkRepositoryAsDirtyFuncCall
+// DBStoreGetUploadsByIDsFunc describes the behavior when the
+// GetUploadsByIDs method of the parent MockDBStore instance is invoked.
+type DBStoreGetUploadsByIDsFunc struct {
+	defaultHook func(context.Context, ...int) ([]dbstore.Upload, error)
+	hooks       []func(context.Context, ...int) ([]dbstore.Upload, error)
+	history     []DBStoreGetUploadsByIDsFuncCall
 	mutex       sync.Mutex
 }
 
-// MarkRepositoryAsDirty delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockDBStore) MarkRepositoryAsDirty(v0 context.Context, v1 int) error {
-	r0 := m.MarkRepositoryAsDirtyFunc.nextHook()(v0, v1)
-	m.MarkRepositoryAsDirtyFunc.appendCall(DBStoreMarkRepositoryAsDirtyFuncCall{v0, v1, r0})
-	return r0
+// GetUploadsByIDs delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockDBStore) GetUploadsByIDs(v0 context.Context, v1 ...int) ([]dbstore.Upload, error) {
+	r0, r1 := m.GetUploadsByIDsFunc.nextHook()(v0, v1...)
+	m.GetUploadsByIDsFunc.appendCall(DBStoreGetUploadsByIDsFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// MarkRepositoryAsDirty method of the parent MockDBStore instance is
-// invoked and the hook queue is empty.
-func (f *DBStoreMarkRepositoryAsDirtyFunc) SetDefaultHook(hook func(context.Context, int) error) {
+// SetDefaultHook sets function that is called when the GetUploadsByIDs
+// method of the parent MockDBStore instance is invoked and the hook queue
+// is empty.
+func (f *DBStoreGetUploadsByIDsFunc) SetDefaultHook(hook func(context.Context, ...int) ([]dbstore.Upload, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// MarkRepositoryAsDirty method of the parent MockDBStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *DBStoreMarkRepositoryAsDirtyFunc) PushHook(hook func(context.Context, int) error) {
+// GetUploadsByIDs method of the parent MockDBStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *DBStoreGetUploadsByIDsFunc) PushHook(hook func(context.Context, ...int) ([]dbstore.Upload, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2112,21 +2550,21 @@ func (f *DBStoreMarkRepositoryAsDirtyFunc) PushHook(hook func(context.Context, i
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *DBStoreMarkRepositoryAsDirtyFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int) error {
-		return r0
+func (f *DBStoreGetUploadsByIDsFunc) SetDefaultReturn(r0 []dbstore.Upload, r1 error) {
+	f.SetDefaultHook(func(context.Context, ...int) ([]dbstore.Upload, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *DBStoreMarkRepositoryAsDirtyFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int) error {
-		return r0
+func (f *DBStoreGetUploadsByIDsFunc) PushReturn(r0 []dbstore.Upload, r1 error) {
+	f.PushHook(func(context.Context, ...int) ([]dbstore.Upload, error) {
+		return r0, r1
 	})
 }
 
-func (f *DBStoreMarkRepositoryAsDirtyFunc) nextHook() func(context.Context, int) error {
+func (f *DBStoreGetUploadsByIDsFunc) nextHook() func(context.Context, ...int) ([]dbstore.Upload, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2139,79 +2577,87 @@ func (f *DBStoreMarkRepositoryAsDirtyFunc) nextHook() func(context.Context, int)
 	return hook
 }
 
-func (f *DBStoreMarkRepositoryAsDirtyFunc) appendCall(r0 DBStoreMarkRepositoryAsDirtyFuncCall) {
+func (f *DBStoreGetUploadsByIDsFunc) appendCall(r0 DBStoreGetUploadsByIDsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of DBStoreMarkRepositoryAsDirtyFuncCall
-// objects describing the invocations of this function.
-func (f *DBStoreMarkRepositoryAsDirtyFunc) History() []DBStoreMarkRepositoryAsDirtyFuncCall {
+// History returns a sequence of DBStoreGetUploadsByIDsFuncCall objects
+// describing the invocations of this function.
+func (f *DBStoreGetUploadsByIDsFunc) History() []DBStoreGetUploadsByIDsFuncCall {
 	f.mutex.Lock()
-	history := make([]DBStoreMarkRepositoryAsDirtyFuncCall, len(f.history))
+	history := make([]DBStoreGetUploadsByIDsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// DBStoreMarkRepositoryAsDirtyFuncCall is an object that describes an
-// invocation of method MarkRepositoryAsDirty on an instance of MockDBStore.
-type DBStoreMarkRepositoryAsDirtyFuncCall struct {
+// DBStoreGetUploadsByIDsFuncCall is an object that describes an invocation
+// of method GetUploadsByIDs on an instance of MockDBStore.
+type DBStoreGetUploadsByIDsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 int
+	// Arg1 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg1 []int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 []dbstore.Upload
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation.
-func (c DBStoreMarkRepositoryAsDirtyFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c DBStoreGetUploadsByIDsFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg1 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0}, trailing...)
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c DBStoreMarkRepositoryAsDirtyFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c DBStoreGetUploadsByIDsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// DBStoreReferenceIDsAndFiltersFunc describes the behavior when the
-// ReferenceIDsAndFilters method of the parent MockDBStore instance is
-// invoked.
-type DBStoreReferenceIDsAndFiltersFunc struct {
-	defaultHook func(context.Context, int, string, []semantic.QualifiedMonikerData, int, int) (dbstore.PackageReferenceScanner, int, error)
-	hooks       []func(context.Context, int, string, []semantic.QualifiedMonikerData, int, int) (dbstore.PackageReferenceScanner, int, error)
-	history     []DBStoreReferenceIDsAndFiltersFuncCall
+// DBStoreHasCommitFunc describes the behavior when the HasCommit method of
+// the parent MockDBStore instance is invoked.
+type DBStoreHasCommitFunc struct {
+	defaultHook func(context.Context, int, string) (bool, error)
+	hooks       []func(context.Context, int, string) (bool, error)
+	history     []DBStoreHasCommitFuncCall
 	mutex       sync.Mutex
 }
 
-// ReferenceIDsAndFilters delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockDBStore) ReferenceIDsAndFilters(v0 context.Context, v1 int, v2 string, v3 []semantic.QualifiedMonikerData, v4 int, v5 int) (dbstore.PackageReferenceScanner, int, error) {
-	r0, r1, r2 := m.ReferenceIDsAndFiltersFunc.nextHook()(v0, v1, v2, v3, v4, v5)
-	m.ReferenceIDsAndFiltersFunc.appendCall(DBStoreReferenceIDsAndFiltersFuncCall{v0, v1, v2, v3, v4, v5, r0, r1, r2})
-	return r0, r1, r2
+// HasCommit delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockDBStore) HasCommit(v0 context.Context, v1 int, v2 string) (bool, error) {
+	r0, r1 := m.HasCommitFunc.nextHook()(v0, v1, v2)
+	m.HasCommitFunc.appendCall(DBStoreHasCommitFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// ReferenceIDsAndFilters method of the parent MockDBStore instance is
-// invoked and the hook queue is empty.
-func (f *DBStoreReferenceIDsAndFiltersFunc) SetDefaultHook(hook func(context.Context, int, string, []semantic.QualifiedMonikerData, int, int) (dbstore.PackageReferenceScanner, int, error)) {
+// SetDefaultHook sets function that is called when the HasCommit method of
+// the parent MockDBStore instance is invoked and the hook queue is empty.
+func (f *DBStoreHasCommitFunc) SetDefaultHook(hook func(context.Context, int, string) (bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ReferenceIDsAndFilters method of the parent MockDBStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *DBStoreReferenceIDsAndFiltersFunc) PushHook(hook func(context.Context, int, string, []semantic.QualifiedMonikerData, int, int) (dbstore.PackageReferenceScanner, int, error)) {
+// HasCommit method of the parent MockDBStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *DBStoreHasCommitFunc) PushHook(hook func(context.Context, int, string) (bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2219,21 +2665,21 @@ func (f *DBStoreReferenceIDsAndFiltersFunc) PushHook(hook func(context.Context,
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *DBStoreReferenceIDsAndFiltersFunc) SetDefaultReturn(r0 dbstore.PackageReferenceScanner, r1 int, r2 error) {
-	f.SetDefaultHook(func(context.Context, int, string, []semantic.QualifiedMonikerData, int, int) (dbstore.PackageReferenceScanner, int, error) {
-		return r0, r1, r2
+func (f *DBStoreHasCommitFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string) (bool, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *DBStoreReferenceIDsAndFiltersFunc) PushReturn(r0 dbstore.PackageReferenceScanner, r1 int, r2 error) {
-	f.PushHook(func(context.Context, int, string, []semantic.QualifiedMonikerData, int, int) (dbstore.PackageReferenceScanner, int, error) {
-		return r0, r1, r2
+func (f *DBStoreHasCommitFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, int, string) (bool, error) {
+		return r0, r1
 	})
 }
 
-func (f *DBStoreReferenceIDsAndFiltersFunc) nextHook() func(context.Context, int, string, []semantic.QualifiedMonikerData, int, int) (dbstore.PackageReferenceScanner, int, error) {
+func (f *DBStoreHasCommitFunc) nextHook() func(context.Context, int, string) (bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2246,27 +2692,26 @@ func (f *DBStoreReferenceIDsAndFiltersFunc) nextHook() func(context.Context, int
 	return hook
 }
 
-func (f *DBStoreReferenceIDsAndFiltersFunc) appendCall(r0 DBStoreReferenceIDsAndFiltersFuncCall) {
+func (f *DBStoreHasCommitFunc) appendCall(r0 DBStoreHasCommitFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of DBStoreReferenceIDsAndFiltersFuncCall
-// objects describing the invocations of this function.
-func (f *DBStoreReferenceIDsAndFiltersFunc) History() []DBStoreReferenceIDsAndFiltersFuncCall {
+// History returns a sequence of DBStoreHasCommitFuncCall objects describing
+// the invocations of this function.
+func (f *DBStoreHasCommitFunc) History() []DBStoreHasCommitFuncCall {
 	f.mutex.Lock()
-	history := make([]DBStoreReferenceIDsAndFiltersFuncCall, len(f.history))
+	history := make([]DBStoreHasCommitFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// DBStoreReferenceIDsAndFiltersFuncCall is an object that describes an
-// invocation of method ReferenceIDsAndFilters on an instance of
-// MockDBStore.
-type DBStoreReferenceIDsAndFiltersFuncCall struct {
+// DBStoreHasCommitFuncCall is an object that describes an invocation of
+// method HasCommit on an instance of MockDBStore.
+type DBStoreHasCommitFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -2276,66 +2721,55 @@ type DBStoreReferenceIDsAndFiltersFuncCall struct {
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
 	Arg2 string
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 []semantic.QualifiedMonikerData
-	// Arg4 is the value of the 5th argument passed to this method
-	// invocation.
-	Arg4 int
-	// Arg5 is the value of the 6th argument passed to this method
-	// invocation.
-	Arg5 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 dbstore.PackageReferenceScanner
+	Result0 bool
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 int
-	// Result2 is the value of the 3rd result returned from this method
-	// invocation.
-	Result2 error
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c DBStoreReferenceIDsAndFiltersFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5}
+func (c DBStoreHasCommitFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c DBStoreReferenceIDsAndFiltersFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2}
+func (c DBStoreHasCommitFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// DBStoreRepoNameFunc describes the behavior when the RepoName method of
-// the parent MockDBStore instance is invoked.
-type DBStoreRepoNameFunc struct {
-	defaultHook func(context.Context, int) (string, error)
-	hooks       []func(context.Context, int) (string, error)
-	history     []DBStoreRepoNameFuncCall
+// DBStoreHasRepositoryFunc describes the behavior when the HasRepository
+// method of the parent MockDBStore instance is invoked.
+type DBStoreHasRepositoryFunc struct {
+	defaultHook func(context.Context, int) (bool, error)
+	hooks       []func(context.Context, int) (bool, error)
+	history     []DBStoreHasRepositoryFuncCall
 	mutex       sync.Mutex
 }
 
-// RepoName delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockDBStore) RepoName(v0 context.Context, v1 int) (string, error) {
-	r0, r1 := m.RepoNameFunc.nextHook()(v0, v1)
-	m.RepoNameFunc.appendCall(DBStoreRepoNameFuncCall{v0, v1, r0, r1})
+// HasRepository delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockDBStore) HasRepository(v0 context.Context, v1 int) (bool, error) {
+	r0, r1 := m.HasRepositoryFunc.nextHook()(v0, v1)
+	m.HasRepositoryFunc.appendCall(DBStoreHasRepositoryFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the RepoName method of
-// the parent MockDBStore instance is invoked and the hook queue is empty.
-func (f *DBStoreRepoNameFunc) SetDefaultHook(hook func(context.Context, int) (string, error)) {
+// SetDefaultHook sets function that is called when the HasRepository method
+// of the parent MockDBStore instance is invoked and the hook queue is
+// empty.
+func (f *DBStoreHasRepositoryFunc) SetDefaultHook(hook func(context.Context, int) (bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// RepoName method of the parent MockDBStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
+// HasRepository method of the parent MockDBStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *DBStoreRepoNameFunc) PushHook(hook func(context.Context, int) (string, error)) {
+func (f *DBStoreHasRepositoryFunc) PushHook(hook func(context.Context, int) (bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2343,21 +2777,21 @@ func (f *DBStoreRepoNameFunc) PushHook(hook func(context.Context, int) (string,
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *DBStoreRepoNameFunc) SetDefaultReturn(r0 string, r1 error) {
-	f.SetDefaultHook(func(context.Context, int) (string, error) {
+func (f *DBStoreHasRepositoryFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) (bool, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *DBStoreRepoNameFunc) PushReturn(r0 string, r1 error) {
-	f.PushHook(func(context.Context, int) (string, error) {
+func (f *DBStoreHasRepositoryFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, int) (bool, error) {
 		return r0, r1
 	})
 }
 
-func (f *DBStoreRepoNameFunc) nextHook() func(context.Context, int) (string, error) {
+func (f *DBStoreHasRepositoryFunc) nextHook() func(context.Context, int) (bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2370,26 +2804,26 @@ func (f *DBStoreRepoNameFunc) nextHook() func(context.Context, int) (string, err
 	return hook
 }
 
-func (f *DBStoreRepoNameFunc) appendCall(r0 DBStoreRepoNameFuncCall) {
+func (f *DBStoreHasRepositoryFunc) appendCall(r0 DBStoreHasRepositoryFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of DBStoreRepoNameFuncCall objects describing
-// the invocations of this function.
-func (f *DBStoreRepoNameFunc) History() []DBStoreRepoNameFuncCall {
+// History returns a sequence of DBStoreHasRepositoryFuncCall objects
+// describing the invocations of this function.
+func (f *DBStoreHasRepositoryFunc) History() []DBStoreHasRepositoryFuncCall {
 	f.mutex.Lock()
-	history := make([]DBStoreRepoNameFuncCall, len(f.history))
+	history := make([]DBStoreHasRepositoryFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// DBStoreRepoNameFuncCall is an object that describes an invocation of
-// method RepoName on an instance of MockDBStore.
-type DBStoreRepoNameFuncCall struct {
+// DBStoreHasRepositoryFuncCall is an object that describes an invocation of
+// method HasRepository on an instance of MockDBStore.
+type DBStoreHasRepositoryFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -2398,7 +2832,7 @@ type DBStoreRepoNameFuncCall struct {
 	Arg1 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 string
+	Result0 bool
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -2406,48 +2840,47 @@ type DBStoreRepoNameFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c DBStoreRepoNameFuncCall) Args() []interface{} {
+func (c DBStoreHasRepositoryFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c DBStoreRepoNameFuncCall) Results() []interface{} {
+func (c DBStoreHasRepositoryFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// DBStoreUpdateIndexConfigurationByRepositoryIDFunc describes the behavior
-// when the UpdateIndexConfigurationByRepositoryID method of the parent
-// MockDBStore instance is invoked.
-type DBStoreUpdateIndexConfigurationByRepositoryIDFunc struct {
-	defaultHook func(context.Context, int, []byte) error
-	hooks       []func(context.Context, int, []byte) error
-	history     []DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall
+// DBStoreInsertDependencySyncingJobFunc describes the behavior when the
+// InsertDependencySyncingJob method of the parent MockDBStore instance is
+// invoked.
+type DBStoreInsertDependencySyncingJobFunc struct {
+	defaultHook func(context.Context, string, string, string) (int, bool, error)
+	hooks       []func(context.Context, string, string, string) (int, bool, error)
+	history     []DBStoreInsertDependencySyncingJobFuncCall
 	mutex       sync.Mutex
 }
 
-// UpdateIndexConfigurationByRepositoryID delegates to the next hook
-// function in the queue and stores the parameter and result values of this
-// invocation.
-func (m *MockDBStore) UpdateIndexConfigurationByRepositoryID(v0 context.Context, v1 int, v2 []byte) error {
-	r0 := m.UpdateIndexConfigurationByRepositoryIDFunc.nextHook()(v0, v1, v2)
-	m.UpdateIndexConfigurationByRepositoryIDFunc.appendCall(DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall{v0, v1, v2, r0})
-	return r0
+// InsertDependencySyncingJob delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockDBStore) InsertDependencySyncingJob(v0 context.Context, v1 string, v2 string, v3 string) (int, bool, error) {
+	r0, r1, r2 := m.InsertDependencySyncingJobFunc.nextHook()(v0, v1, v2, v3)
+	m.InsertDependencySyncingJobFunc.appendCall(DBStoreInsertDependencySyncingJobFuncCall{v0, v1, v2, v3, r0, r1, r2})
+	return r0, r1, r2
 }
 
 // SetDefaultHook sets function that is called when the
-// UpdateIndexConfigurationByRepositoryID method of the parent MockDBStore
-// instance is invoked and the hook queue is empty.
-func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) SetDefaultHook(hook func(context.Context, int, []byte) error) {
+// InsertDependencySyncingJob method of the parent MockDBStore instance is
+// invoked and the hook queue is empty.
+func (f *DBStoreInsertDependencySyncingJobFunc) SetDefaultHook(hook func(context.Context, string, string, string) (int, bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// UpdateIndexConfigurationByRepositoryID method of the parent MockDBStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) PushHook(hook func(context.Context, int, []byte) error) {
+// InsertDependencySyncingJob method of the parent MockDBStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *DBStoreInsertDependencySyncingJobFunc) PushHook(hook func(context.Context, string, string, string) (int, bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2455,21 +2888,21 @@ func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) PushHook(hook func(c
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int, []byte) error {
-		return r0
+func (f *DBStoreInsertDependencySyncingJobFunc) SetDefaultReturn(r0 int, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, string, string, string) (int, bool, error) {
+		return r0, r1, r2
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int, []byte) error {
-		return r0
+func (f *DBStoreInsertDependencySyncingJobFunc) PushReturn(r0 int, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, string, string, string) (int, bool, error) {
+		return r0, r1, r2
 	})
 }
 
-func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) nextHook() func(context.Context, int, []byte) error {
+func (f *DBStoreInsertDependencySyncingJobFunc) nextHook() func(context.Context, string, string, string) (int, bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2482,244 +2915,3200 @@ func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) nextHook() func(cont
 	return hook
 }
 
-func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) appendCall(r0 DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall) {
+func (f *DBStoreInsertDependencySyncingJobFunc) appendCall(r0 DBStoreInsertDependencySyncingJobFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall objects describing
-// the invocations of this function.
-func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) History() []DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall {
+// History returns a sequence of DBStoreInsertDependencySyncingJobFuncCall
+// objects describing the invocations of this function.
+func (f *DBStoreInsertDependencySyncingJobFunc) History() []DBStoreInsertDependencySyncingJobFuncCall {
 	f.mutex.Lock()
-	history := make([]DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall, len(f.history))
+	history := make([]DBStoreInsertDependencySyncingJobFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall is an object that
-// describes an invocation of method UpdateIndexConfigurationByRepositoryID
-// on an instance of MockDBStore.
-type DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall struct {
+// DBStoreInsertDependencySyncingJobFuncCall is an object that describes an
+// invocation of method InsertDependencySyncingJob on an instance of
+// MockDBStore.
+type DBStoreInsertDependencySyncingJobFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int
+	Arg1 string
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 []byte
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
-}
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 bool
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBStoreInsertDependencySyncingJobFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBStoreInsertDependencySyncingJobFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// DBStoreMarkRepositoryAsDirtyFunc describes the behavior when the
+// MarkRepositoryAsDirty method of the parent MockDBStore instance is
+// invoked.
+type DBStoreMarkRepositoryAsDirtyFunc struct {
+	defaultHook func(context.Context, int) error
+	hooks       []func(context.Context, int) error
+	history     []DBStoreMarkRepositoryAsDirtyFuncCall
+	mutex       sync.Mutex
+}
+
+// MarkRepositoryAsDirty delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockDBStore) MarkRepositoryAsDirty(v0 context.Context, v1 int) error {
+	r0 := m.MarkRepositoryAsDirtyFunc.nextHook()(v0, v1)
+	m.MarkRepositoryAsDirtyFunc.appendCall(DBStoreMarkRepositoryAsDirtyFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// MarkRepositoryAsDirty method of the parent MockDBStore instance is
+// invoked and the hook queue is empty.
+func (f *DBStoreMarkRepositoryAsDirtyFunc) SetDefaultHook(hook func(context.Context, int) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// MarkRepositoryAsDirty method of the parent MockDBStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *DBStoreMarkRepositoryAsDirtyFunc) PushHook(hook func(context.Context, int) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *DBStoreMarkRepositoryAsDirtyFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *DBStoreMarkRepositoryAsDirtyFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int) error {
+		return r0
+	})
+}
+
+func (f *DBStoreMarkRepositoryAsDirtyFunc) nextHook() func(context.Context, int) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBStoreMarkRepositoryAsDirtyFunc) appendCall(r0 DBStoreMarkRepositoryAsDirtyFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBStoreMarkRepositoryAsDirtyFuncCall
+// objects describing the invocations of this function.
+func (f *DBStoreMarkRepositoryAsDirtyFunc) History() []DBStoreMarkRepositoryAsDirtyFuncCall {
+	f.mutex.Lock()
+	history := make([]DBStoreMarkRepositoryAsDirtyFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBStoreMarkRepositoryAsDirtyFuncCall is an object that describes an
+// invocation of method MarkRepositoryAsDirty on an instance of MockDBStore.
+type DBStoreMarkRepositoryAsDirtyFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBStoreMarkRepositoryAsDirtyFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBStoreMarkRepositoryAsDirtyFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// DBStoreReferenceIDsAndFiltersFunc describes the behavior when the
+// ReferenceIDsAndFilters method of the parent MockDBStore instance is
+// invoked.
+type DBStoreReferenceIDsAndFiltersFunc struct {
+	defaultHook func(context.Context, int, string, []semantic.QualifiedMonikerData, int, int) (dbstore.PackageReferenceScanner, int, error)
+	hooks       []func(context.Context, int, string, []semantic.QualifiedMonikerData, int, int) (dbstore.PackageReferenceScanner, int, error)
+	history     []DBStoreReferenceIDsAndFiltersFuncCall
+	mutex       sync.Mutex
+}
+
+// ReferenceIDsAndFilters delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockDBStore) ReferenceIDsAndFilters(v0 context.Context, v1 int, v2 string, v3 []semantic.QualifiedMonikerData, v4 int, v5 int) (dbstore.PackageReferenceScanner, int, error) {
+	r0, r1, r2 := m.ReferenceIDsAndFiltersFunc.nextHook()(v0, v1, v2, v3, v4, v5)
+	m.ReferenceIDsAndFiltersFunc.appendCall(DBStoreReferenceIDsAndFiltersFuncCall{v0, v1, v2, v3, v4, v5, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the
+// ReferenceIDsAndFilters method of the parent MockDBStore instance is
+// invoked and the hook queue is empty.
+func (f *DBStoreReferenceIDsAndFiltersFunc) SetDefaultHook(hook func(context.Context, int, string, []semantic.QualifiedMonikerData, int, int) (dbstore.PackageReferenceScanner, int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ReferenceIDsAndFilters method of the parent MockDBStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *DBStoreReferenceIDsAndFiltersFunc) PushHook(hook func(context.Context, int, string, []semantic.QualifiedMonikerData, int, int) (dbstore.PackageReferenceScanner, int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *DBStoreReferenceIDsAndFiltersFunc) SetDefaultReturn(r0 dbstore.PackageReferenceScanner, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, int, string, []semantic.QualifiedMonikerData, int, int) (dbstore.PackageReferenceScanner, int, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *DBStoreReferenceIDsAndFiltersFunc) PushReturn(r0 dbstore.PackageReferenceScanner, r1 int, r2 error) {
+	f.PushHook(func(context.Context, int, string, []semantic.QualifiedMonikerData, int, int) (dbstore.PackageReferenceScanner, int, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *DBStoreReferenceIDsAndFiltersFunc) nextHook() func(context.Context, int, string, []semantic.QualifiedMonikerData, int, int) (dbstore.PackageReferenceScanner, int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBStoreReferenceIDsAndFiltersFunc) appendCall(r0 DBStoreReferenceIDsAndFiltersFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBStoreReferenceIDsAndFiltersFuncCall
+// objects describing the invocations of this function.
+func (f *DBStoreReferenceIDsAndFiltersFunc) History() []DBStoreReferenceIDsAndFiltersFuncCall {
+	f.mutex.Lock()
+	history := make([]DBStoreReferenceIDsAndFiltersFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBStoreReferenceIDsAndFiltersFuncCall is an object that describes an
+// invocation of method ReferenceIDsAndFilters on an instance of
+// MockDBStore.
+type DBStoreReferenceIDsAndFiltersFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 []semantic.QualifiedMonikerData
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 int
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 dbstore.PackageReferenceScanner
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBStoreReferenceIDsAndFiltersFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBStoreReferenceIDsAndFiltersFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// DBStoreReindexUploadByIDFunc describes the behavior when the
+// ReindexUploadByID method of the parent MockDBStore instance is invoked.
+type DBStoreReindexUploadByIDFunc struct {
+	defaultHook func(context.Context, int) (bool, error)
+	hooks       []func(context.Context, int) (bool, error)
+	history     []DBStoreReindexUploadByIDFuncCall
+	mutex       sync.Mutex
+}
+
+// ReindexUploadByID delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockDBStore) ReindexUploadByID(v0 context.Context, v1 int) (bool, error) {
+	r0, r1 := m.ReindexUploadByIDFunc.nextHook()(v0, v1)
+	m.ReindexUploadByIDFunc.appendCall(DBStoreReindexUploadByIDFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ReindexUploadByID
+// method of the parent MockDBStore instance is invoked and the hook queue
+// is empty.
+func (f *DBStoreReindexUploadByIDFunc) SetDefaultHook(hook func(context.Context, int) (bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ReindexUploadByID method of the parent MockDBStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *DBStoreReindexUploadByIDFunc) PushHook(hook func(context.Context, int) (bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *DBStoreReindexUploadByIDFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) (bool, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *DBStoreReindexUploadByIDFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, int) (bool, error) {
+		return r0, r1
+	})
+}
+
+func (f *DBStoreReindexUploadByIDFunc) nextHook() func(context.Context, int) (bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBStoreReindexUploadByIDFunc) appendCall(r0 DBStoreReindexUploadByIDFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBStoreReindexUploadByIDFuncCall objects
+// describing the invocations of this function.
+func (f *DBStoreReindexUploadByIDFunc) History() []DBStoreReindexUploadByIDFuncCall {
+	f.mutex.Lock()
+	history := make([]DBStoreReindexUploadByIDFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBStoreReindexUploadByIDFuncCall is an object that describes an
+// invocation of method ReindexUploadByID on an instance of MockDBStore.
+type DBStoreReindexUploadByIDFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 bool
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBStoreReindexUploadByIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBStoreReindexUploadByIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// DBStoreRepoNameFunc describes the behavior when the RepoName method of
+// the parent MockDBStore instance is invoked.
+type DBStoreRepoNameFunc struct {
+	defaultHook func(context.Context, int) (string, error)
+	hooks       []func(context.Context, int) (string, error)
+	history     []DBStoreRepoNameFuncCall
+	mutex       sync.Mutex
+}
+
+// RepoName delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockDBStore) RepoName(v0 context.Context, v1 int) (string, error) {
+	r0, r1 := m.RepoNameFunc.nextHook()(v0, v1)
+	m.RepoNameFunc.appendCall(DBStoreRepoNameFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the RepoName method of
+// the parent MockDBStore instance is invoked and the hook queue is empty.
+func (f *DBStoreRepoNameFunc) SetDefaultHook(hook func(context.Context, int) (string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RepoName method of the parent MockDBStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *DBStoreRepoNameFunc) PushHook(hook func(context.Context, int) (string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *DBStoreRepoNameFunc) SetDefaultReturn(r0 string, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) (string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *DBStoreRepoNameFunc) PushReturn(r0 string, r1 error) {
+	f.PushHook(func(context.Context, int) (string, error) {
+		return r0, r1
+	})
+}
+
+func (f *DBStoreRepoNameFunc) nextHook() func(context.Context, int) (string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBStoreRepoNameFunc) appendCall(r0 DBStoreRepoNameFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBStoreRepoNameFuncCall objects describing
+// the invocations of this function.
+func (f *DBStoreRepoNameFunc) History() []DBStoreRepoNameFuncCall {
+	f.mutex.Lock()
+	history := make([]DBStoreRepoNameFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBStoreRepoNameFuncCall is an object that describes an invocation of
+// method RepoName on an instance of MockDBStore.
+type DBStoreRepoNameFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBStoreRepoNameFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBStoreRepoNameFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// DBStoreUpdateIndexConfigurationByRepositoryIDFunc describes the behavior
+// when the UpdateIndexConfigurationByRepositoryID method of the parent
+// MockDBStore instance is invoked.
+type DBStoreUpdateIndexConfigurationByRepositoryIDFunc struct {
+	defaultHook func(context.Context, int, []byte) error
+	hooks       []func(context.Context, int, []byte) error
+	history     []DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateIndexConfigurationByRepositoryID delegates to the next hook
+// function in the queue and stores the parameter and result values of this
+// invocation.
+func (m *MockDBStore) UpdateIndexConfigurationByRepositoryID(v0 context.Context, v1 int, v2 []byte) error {
+	r0 := m.UpdateIndexConfigurationByRepositoryIDFunc.nextHook()(v0, v1, v2)
+	m.UpdateIndexConfigurationByRepositoryIDFunc.appendCall(DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// UpdateIndexConfigurationByRepositoryID method of the parent MockDBStore
+// instance is invoked and the hook queue is empty.
+func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) SetDefaultHook(hook func(context.Context, int, []byte) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateIndexConfigurationByRepositoryID method of the parent MockDBStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) PushHook(hook func(context.Context, int, []byte) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int, []byte) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int, []byte) error {
+		return r0
+	})
+}
+
+func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) nextHook() func(context.Context, int, []byte) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) appendCall(r0 DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall objects describing
+// the invocations of this function.
+func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) History() []DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall {
+	f.mutex.Lock()
+	history := make([]DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall is an object that
+// describes an invocation of method UpdateIndexConfigurationByRepositoryID
+// on an instance of MockDBStore.
+type DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []byte
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// DBStoreUpdateRetentionPolicyFunc describes the behavior when the
+// UpdateRetentionPolicy method of the parent MockDBStore instance is
+// invoked.
+type DBStoreUpdateRetentionPolicyFunc struct {
+	defaultHook func(context.Context, dbstore.RetentionPolicy) (bool, error)
+	hooks       []func(context.Context, dbstore.RetentionPolicy) (bool, error)
+	history     []DBStoreUpdateRetentionPolicyFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateRetentionPolicy delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockDBStore) UpdateRetentionPolicy(v0 context.Context, v1 dbstore.RetentionPolicy) (bool, error) {
+	r0, r1 := m.UpdateRetentionPolicyFunc.nextHook()(v0, v1)
+	m.UpdateRetentionPolicyFunc.appendCall(DBStoreUpdateRetentionPolicyFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// UpdateRetentionPolicy method of the parent MockDBStore instance is
+// invoked and the hook queue is empty.
+func (f *DBStoreUpdateRetentionPolicyFunc) SetDefaultHook(hook func(context.Context, dbstore.RetentionPolicy) (bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateRetentionPolicy method of the parent MockDBStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *DBStoreUpdateRetentionPolicyFunc) PushHook(hook func(context.Context, dbstore.RetentionPolicy) (bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *DBStoreUpdateRetentionPolicyFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, dbstore.RetentionPolicy) (bool, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *DBStoreUpdateRetentionPolicyFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, dbstore.RetentionPolicy) (bool, error) {
+		return r0, r1
+	})
+}
+
+func (f *DBStoreUpdateRetentionPolicyFunc) nextHook() func(context.Context, dbstore.RetentionPolicy) (bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBStoreUpdateRetentionPolicyFunc) appendCall(r0 DBStoreUpdateRetentionPolicyFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBStoreUpdateRetentionPolicyFuncCall
+// objects describing the invocations of this function.
+func (f *DBStoreUpdateRetentionPolicyFunc) History() []DBStoreUpdateRetentionPolicyFuncCall {
+	f.mutex.Lock()
+	history := make([]DBStoreUpdateRetentionPolicyFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBStoreUpdateRetentionPolicyFuncCall is an object that describes an
+// invocation of method UpdateRetentionPolicy on an instance of MockDBStore.
+type DBStoreUpdateRetentionPolicyFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 dbstore.RetentionPolicy
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 bool
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBStoreUpdateRetentionPolicyFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBStoreUpdateRetentionPolicyFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// DBStoreUsageStatisticsFunc describes the behavior when the
+// UsageStatistics method of the parent MockDBStore instance is invoked.
+type DBStoreUsageStatisticsFunc struct {
+	defaultHook func(context.Context, time.Time) ([]dbstore.UsageStatistics, error)
+	hooks       []func(context.Context, time.Time) ([]dbstore.UsageStatistics, error)
+	history     []DBStoreUsageStatisticsFuncCall
+	mutex       sync.Mutex
+}
+
+// UsageStatistics delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockDBStore) UsageStatistics(v0 context.Context, v1 time.Time) ([]dbstore.UsageStatistics, error) {
+	r0, r1 := m.UsageStatisticsFunc.nextHook()(v0, v1)
+	m.UsageStatisticsFunc.appendCall(DBStoreUsageStatisticsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the UsageStatistics
+// method of the parent MockDBStore instance is invoked and the hook queue
+// is empty.
+func (f *DBStoreUsageStatisticsFunc) SetDefaultHook(hook func(context.Context, time.Time) ([]dbstore.UsageStatistics, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UsageStatistics method of the parent MockDBStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *DBStoreUsageStatisticsFunc) PushHook(hook func(context.Context, time.Time) ([]dbstore.UsageStatistics, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *DBStoreUsageStatisticsFunc) SetDefaultReturn(r0 []dbstore.UsageStatistics, r1 error) {
+	f.SetDefaultHook(func(context.Context, time.Time) ([]dbstore.UsageStatistics, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *DBStoreUsageStatisticsFunc) PushReturn(r0 []dbstore.UsageStatistics, r1 error) {
+	f.PushHook(func(context.Context, time.Time) ([]dbstore.UsageStatistics, error) {
+		return r0, r1
+	})
+}
+
+func (f *DBStoreUsageStatisticsFunc) nextHook() func(context.Context, time.Time) ([]dbstore.UsageStatistics, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBStoreUsageStatisticsFunc) appendCall(r0 DBStoreUsageStatisticsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBStoreUsageStatisticsFuncCall objects
+// describing the invocations of this function.
+func (f *DBStoreUsageStatisticsFunc) History() []DBStoreUsageStatisticsFuncCall {
+	f.mutex.Lock()
+	history := make([]DBStoreUsageStatisticsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBStoreUsageStatisticsFuncCall is an object that describes an invocation
+// of method UsageStatistics on an instance of MockDBStore.
+type DBStoreUsageStatisticsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 time.Time
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []dbstore.UsageStatistics
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBStoreUsageStatisticsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBStoreUsageStatisticsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// MockEnqueuerDBStore is a mock implementation of the EnqueuerDBStore
+// interface (from the package
+// github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers)
+// used for unit testing.
+type MockEnqueuerDBStore struct {
+	// DirtyRepositoriesFunc is an instance of a mock function object
+	// controlling the behavior of the method DirtyRepositories.
+	DirtyRepositoriesFunc *EnqueuerDBStoreDirtyRepositoriesFunc
+	// DoneFunc is an instance of a mock function object controlling the
+	// behavior of the method Done.
+	DoneFunc *EnqueuerDBStoreDoneFunc
+	// GetIndexConfigurationByRepositoryIDFunc is an instance of a mock
+	// function object controlling the behavior of the method
+	// GetIndexConfigurationByRepositoryID.
+	GetIndexConfigurationByRepositoryIDFunc *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc
+	// GetRepositoriesWithIndexConfigurationFunc is an instance of a mock
+	// function object controlling the behavior of the method
+	// GetRepositoriesWithIndexConfiguration.
+	GetRepositoriesWithIndexConfigurationFunc *EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFunc
+	// HandleFunc is an instance of a mock function object controlling the
+	// behavior of the method Handle.
+	HandleFunc *EnqueuerDBStoreHandleFunc
+	// IndexableRepositoriesFunc is an instance of a mock function object
+	// controlling the behavior of the method IndexableRepositories.
+	IndexableRepositoriesFunc *EnqueuerDBStoreIndexableRepositoriesFunc
+	// InsertIndexFunc is an instance of a mock function object controlling
+	// the behavior of the method InsertIndex.
+	InsertIndexFunc *EnqueuerDBStoreInsertIndexFunc
+	// IsQueuedFunc is an instance of a mock function object controlling the
+	// behavior of the method IsQueued.
+	IsQueuedFunc *EnqueuerDBStoreIsQueuedFunc
+	// RepoUsageStatisticsFunc is an instance of a mock function object
+	// controlling the behavior of the method RepoUsageStatistics.
+	RepoUsageStatisticsFunc *EnqueuerDBStoreRepoUsageStatisticsFunc
+	// ResetIndexableRepositoriesFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// ResetIndexableRepositories.
+	ResetIndexableRepositoriesFunc *EnqueuerDBStoreResetIndexableRepositoriesFunc
+	// TransactFunc is an instance of a mock function object controlling the
+	// behavior of the method Transact.
+	TransactFunc *EnqueuerDBStoreTransactFunc
+	// UpdateIndexableRepositoryFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// UpdateIndexableRepository.
+	UpdateIndexableRepositoryFunc *EnqueuerDBStoreUpdateIndexableRepositoryFunc
+	// UpdateInferredIndexConfigurationByRepositoryIDFunc is an instance of
+	// a mock function object controlling the behavior of the method
+	// UpdateInferredIndexConfigurationByRepositoryID.
+	UpdateInferredIndexConfigurationByRepositoryIDFunc *EnqueuerDBStoreUpdateInferredIndexConfigurationByRepositoryIDFunc
+}
+
+// NewMockEnqueuerDBStore creates a new mock of the EnqueuerDBStore
+// interface. All methods return zero values for all results, unless
+// overwritten.
+func NewMockEnqueuerDBStore() *MockEnqueuerDBStore {
+	return &MockEnqueuerDBStore{
+		DirtyRepositoriesFunc: &EnqueuerDBStoreDirtyRepositoriesFunc{
+			defaultHook: func(context.Context) (map[int]int, error) {
+				return nil, nil
+			},
+		},
+		DoneFunc: &EnqueuerDBStoreDoneFunc{
+			defaultHook: func(error) error {
+				return nil
+			},
+		},
+		GetIndexConfigurationByRepositoryIDFunc: &EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc{
+			defaultHook: func(context.Context, int) (dbstore.IndexConfiguration, bool, error) {
+				return dbstore.IndexConfiguration{}, false, nil
+			},
+		},
+		GetRepositoriesWithIndexConfigurationFunc: &EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFunc{
+			defaultHook: func(context.Context) ([]int, error) {
+				return nil, nil
+			},
+		},
+		HandleFunc: &EnqueuerDBStoreHandleFunc{
+			defaultHook: func() *basestore.TransactableHandle {
+				return nil
+			},
+		},
+		IndexableRepositoriesFunc: &EnqueuerDBStoreIndexableRepositoriesFunc{
+			defaultHook: func(context.Context, dbstore.IndexableRepositoryQueryOptions) ([]dbstore.IndexableRepository, error) {
+				return nil, nil
+			},
+		},
+		InsertIndexFunc: &EnqueuerDBStoreInsertIndexFunc{
+			defaultHook: func(context.Context, dbstore.Index) (int, error) {
+				return 0, nil
+			},
+		},
+		IsQueuedFunc: &EnqueuerDBStoreIsQueuedFunc{
+			defaultHook: func(context.Context, int, string) (bool, error) {
+				return false, nil
+			},
+		},
+		RepoUsageStatisticsFunc: &EnqueuerDBStoreRepoUsageStatisticsFunc{
+			defaultHook: func(context.Context) ([]dbstore.RepoUsageStatistics, error) {
+				return nil, nil
+			},
+		},
+		ResetIndexableRepositoriesFunc: &EnqueuerDBStoreResetIndexableRepositoriesFunc{
+			defaultHook: func(context.Context, time.Time) error {
+				return nil
+			},
+		},
+		TransactFunc: &EnqueuerDBStoreTransactFunc{
+			defaultHook: func(context.Context) (enqueuer.DBStore, error) {
+				return nil, nil
+			},
+		},
+		UpdateIndexableRepositoryFunc: &EnqueuerDBStoreUpdateIndexableRepositoryFunc{
+			defaultHook: func(context.Context, dbstore.UpdateableIndexableRepository, time.Time) error {
+				return nil
+			},
+		},
+		UpdateInferredIndexConfigurationByRepositoryIDFunc: &EnqueuerDBStoreUpdateInferredIndexConfigurationByRepositoryIDFunc{
+			defaultHook: func(context.Context, int, []byte) error {
+				return nil
+			},
+		},
+	}
+}
+
+// NewMockEnqueuerDBStoreFrom creates a new mock of the MockEnqueuerDBStore
+// interface. All methods delegate to the given implementation, unless
+// overwritten.
+func NewMockEnqueuerDBStoreFrom(i EnqueuerDBStore) *MockEnqueuerDBStore {
+	return &MockEnqueuerDBStore{
+		DirtyRepositoriesFunc: &EnqueuerDBStoreDirtyRepositoriesFunc{
+			defaultHook: i.DirtyRepositories,
+		},
+		DoneFunc: &EnqueuerDBStoreDoneFunc{
+			defaultHook: i.Done,
+		},
+		GetIndexConfigurationByRepositoryIDFunc: &EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc{
+			defaultHook: i.GetIndexConfigurationByRepositoryID,
+		},
+		GetRepositoriesWithIndexConfigurationFunc: &EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFunc{
+			defaultHook: i.GetRepositoriesWithIndexConfiguration,
+		},
+		HandleFunc: &EnqueuerDBStoreHandleFunc{
+			defaultHook: i.Handle,
+		},
+		IndexableRepositoriesFunc: &EnqueuerDBStoreIndexableRepositoriesFunc{
+			defaultHook: i.IndexableRepositories,
+		},
+		InsertIndexFunc: &EnqueuerDBStoreInsertIndexFunc{
+			defaultHook: i.InsertIndex,
+		},
+		IsQueuedFunc: &EnqueuerDBStoreIsQueuedFunc{
+			defaultHook: i.IsQueued,
+		},
+		RepoUsageStatisticsFunc: &EnqueuerDBStoreRepoUsageStatisticsFunc{
+			defaultHook: i.RepoUsageStatistics,
+		},
+		ResetIndexableRepositoriesFunc: &EnqueuerDBStoreResetIndexableRepositoriesFunc{
+			defaultHook: i.ResetIndexableRepositories,
+		},
+		TransactFunc: &EnqueuerDBStoreTransactFunc{
+			defaultHook: i.Transact,
+		},
+		UpdateIndexableRepositoryFunc: &EnqueuerDBStoreUpdateIndexableRepositoryFunc{
+			defaultHook: i.UpdateIndexableRepository,
+		},
+		UpdateInferredIndexConfigurationByRepositoryIDFunc: &EnqueuerDBStoreUpdateInferredIndexConfigurationByRepositoryIDFunc{
+			defaultHook: i.UpdateInferredIndexConfigurationByRepositoryID,
+		},
+	}
+}
+
+// EnqueuerDBStoreDirtyRepositoriesFunc describes the behavior when the
+// DirtyRepositories method of the parent MockEnqueuerDBStore instance is
+// invoked.
+type EnqueuerDBStoreDirtyRepositoriesFunc struct {
+	defaultHook func(context.Context) (map[int]int, error)
+	hooks       []func(context.Context) (map[int]int, error)
+	history     []EnqueuerDBStoreDirtyRepositoriesFuncCall
+	mutex       sync.Mutex
+}
+
+// DirtyRepositories delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockEnqueuerDBStore) DirtyRepositories(v0 context.Context) (map[int]int, error) {
+	r0, r1 := m.DirtyRepositoriesFunc.nextHook()(v0)
+	m.DirtyRepositoriesFunc.appendCall(EnqueuerDBStoreDirtyRepositoriesFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the DirtyRepositories
+// method of the parent MockEnqueuerDBStore instance is invoked and the hook
+// queue is empty.
+func (f *EnqueuerDBStoreDirtyRepositoriesFunc) SetDefaultHook(hook func(context.Context) (map[int]int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DirtyRepositories method of the parent MockEnqueuerDBStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *EnqueuerDBStoreDirtyRepositoriesFunc) PushHook(hook func(context.Context) (map[int]int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *EnqueuerDBStoreDirtyRepositoriesFunc) SetDefaultReturn(r0 map[int]int, r1 error) {
+	f.SetDefaultHook(func(context.Context) (map[int]int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *EnqueuerDBStoreDirtyRepositoriesFunc) PushReturn(r0 map[int]int, r1 error) {
+	f.PushHook(func(context.Context) (map[int]int, error) {
+		return r0, r1
+	})
+}
+
+func (f *EnqueuerDBStoreDirtyRepositoriesFunc) nextHook() func(context.Context) (map[int]int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnqueuerDBStoreDirtyRepositoriesFunc) appendCall(r0 EnqueuerDBStoreDirtyRepositoriesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of EnqueuerDBStoreDirtyRepositoriesFuncCall
+// objects describing the invocations of this function.
+func (f *EnqueuerDBStoreDirtyRepositoriesFunc) History() []EnqueuerDBStoreDirtyRepositoriesFuncCall {
+	f.mutex.Lock()
+	history := make([]EnqueuerDBStoreDirtyRepositoriesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnqueuerDBStoreDirtyRepositoriesFuncCall is an object that describes an
+// invocation of method DirtyRepositories on an instance of
+// MockEnqueuerDBStore.
+type EnqueuerDBStoreDirtyRepositoriesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 map[int]int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnqueuerDBStoreDirtyRepositoriesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnqueuerDBStoreDirtyRepositoriesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// EnqueuerDBStoreDoneFunc describes the behavior when the Done method of
+// the parent MockEnqueuerDBStore instance is invoked.
+type EnqueuerDBStoreDoneFunc struct {
+	defaultHook func(error) error
+	hooks       []func(error) error
+	history     []EnqueuerDBStoreDoneFuncCall
+	mutex       sync.Mutex
+}
+
+// Done delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockEnqueuerDBStore) Done(v0 error) error {
+	r0 := m.DoneFunc.nextHook()(v0)
+	m.DoneFunc.appendCall(EnqueuerDBStoreDoneFuncCall{v0, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Done method of the
+// parent MockEnqueuerDBStore instance is invoked and the hook queue is
+// empty.
+func (f *EnqueuerDBStoreDoneFunc) SetDefaultHook(hook func(error) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Done method of the parent MockEnqueuerDBStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *EnqueuerDBStoreDoneFunc) PushHook(hook func(error) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *EnqueuerDBStoreDoneFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(error) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *EnqueuerDBStoreDoneFunc) PushReturn(r0 error) {
+	f.PushHook(func(error) error {
+		return r0
+	})
+}
+
+func (f *EnqueuerDBStoreDoneFunc) nextHook() func(error) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnqueuerDBStoreDoneFunc) appendCall(r0 EnqueuerDBStoreDoneFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of EnqueuerDBStoreDoneFuncCall objects
+// describing the invocations of this function.
+func (f *EnqueuerDBStoreDoneFunc) History() []EnqueuerDBStoreDoneFuncCall {
+	f.mutex.Lock()
+	history := make([]EnqueuerDBStoreDoneFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnqueuerDBStoreDoneFuncCall is an object that describes an invocation of
+// method Done on an instance of MockEnqueuerDBStore.
+type EnqueuerDBStoreDoneFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 error
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnqueuerDBStoreDoneFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnqueuerDBStoreDoneFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc describes the
+// behavior when the GetIndexConfigurationByRepositoryID method of the
+// parent MockEnqueuerDBStore instance is invoked.
+type EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc struct {
+	defaultHook func(context.Context, int) (dbstore.IndexConfiguration, bool, error)
+	hooks       []func(context.Context, int) (dbstore.IndexConfiguration, bool, error)
+	history     []EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall
+	mutex       sync.Mutex
+}
+
+// GetIndexConfigurationByRepositoryID delegates to the next hook function
+// in the queue and stores the parameter and result values of this
+// invocation.
+func (m *MockEnqueuerDBStore) GetIndexConfigurationByRepositoryID(v0 context.Context, v1 int) (dbstore.IndexConfiguration, bool, error) {
+	r0, r1, r2 := m.GetIndexConfigurationByRepositoryIDFunc.nextHook()(v0, v1)
+	m.GetIndexConfigurationByRepositoryIDFunc.appendCall(EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the
+// GetIndexConfigurationByRepositoryID method of the parent
+// MockEnqueuerDBStore instance is invoked and the hook queue is empty.
+func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) SetDefaultHook(hook func(context.Context, int) (dbstore.IndexConfiguration, bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetIndexConfigurationByRepositoryID method of the parent
+// MockEnqueuerDBStore instance invokes the hook at the front of the queue
+// and discards it. After the queue is empty, the default hook function is
+// invoked for any future action.
+func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) PushHook(hook func(context.Context, int) (dbstore.IndexConfiguration, bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) SetDefaultReturn(r0 dbstore.IndexConfiguration, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, int) (dbstore.IndexConfiguration, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) PushReturn(r0 dbstore.IndexConfiguration, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, int) (dbstore.IndexConfiguration, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) nextHook() func(context.Context, int) (dbstore.IndexConfiguration, bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) appendCall(r0 EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall objects
+// describing the invocations of this function.
+func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) History() []EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall {
+	f.mutex.Lock()
+	history := make([]EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall is an object
+// that describes an invocation of method
+// GetIndexConfigurationByRepositoryID on an instance of
+// MockEnqueuerDBStore.
+type EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 dbstore.IndexConfiguration
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 bool
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFunc describes the
+// behavior when the GetRepositoriesWithIndexConfiguration method of the
+// parent MockEnqueuerDBStore instance is invoked.
+type EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFunc struct {
+	defaultHook func(context.Context) ([]int, error)
+	hooks       []func(context.Context) ([]int, error)
+	history     []EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFuncCall
+	mutex       sync.Mutex
+}
+
+// GetRepositoriesWithIndexConfiguration delegates to the next hook function
+// in the queue and stores the parameter and result values of this
+// invocation.
+func (m *MockEnqueuerDBStore) GetRepositoriesWithIndexConfiguration(v0 context.Context) ([]int, error) {
+	r0, r1 := m.GetRepositoriesWithIndexConfigurationFunc.nextHook()(v0)
+	m.GetRepositoriesWithIndexConfigurationFunc.appendCall(EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetRepositoriesWithIndexConfiguration method of the parent
+// MockEnqueuerDBStore instance is invoked and the hook queue is empty.
+func (f *EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFunc) SetDefaultHook(hook func(context.Context) ([]int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetRepositoriesWithIndexConfiguration method of the parent
+// MockEnqueuerDBStore instance invokes the hook at the front of the queue
+// and discards it. After the queue is empty, the default hook function is
+// invoked for any future action.
+func (f *EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFunc) PushHook(hook func(context.Context) ([]int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFunc) SetDefaultReturn(r0 []int, r1 error) {
+	f.SetDefaultHook(func(context.Context) ([]int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFunc) PushReturn(r0 []int, r1 error) {
+	f.PushHook(func(context.Context) ([]int, error) {
+		return r0, r1
+	})
+}
+
+func (f *EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFunc) nextHook() func(context.Context) ([]int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFunc) appendCall(r0 EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFuncCall objects
+// describing the invocations of this function.
+func (f *EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFunc) History() []EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFuncCall {
+	f.mutex.Lock()
+	history := make([]EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFuncCall is an object
+// that describes an invocation of method
+// GetRepositoriesWithIndexConfiguration on an instance of
+// MockEnqueuerDBStore.
+type EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// EnqueuerDBStoreHandleFunc describes the behavior when the Handle method
+// of the parent MockEnqueuerDBStore instance is invoked.
+type EnqueuerDBStoreHandleFunc struct {
+	defaultHook func() *basestore.TransactableHandle
+	hooks       []func() *basestore.TransactableHandle
+	history     []EnqueuerDBStoreHandleFuncCall
+	mutex       sync.Mutex
+}
+
+// Handle delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockEnqueuerDBStore) Handle() *basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(EnqueuerDBStoreHandleFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Handle method of the
+// parent MockEnqueuerDBStore instance is invoked and the hook queue is
+// empty.
+func (f *EnqueuerDBStoreHandleFunc) SetDefaultHook(hook func() *basestore.TransactableHandle) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Handle method of the parent MockEnqueuerDBStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *EnqueuerDBStoreHandleFunc) PushHook(hook func() *basestore.TransactableHandle) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *EnqueuerDBStoreHandleFunc) SetDefaultReturn(r0 *basestore.TransactableHandle) {
+	f.SetDefaultHook(func() *basestore.TransactableHandle {
+		return r0
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *EnqueuerDBStoreHandleFunc) PushReturn(r0 *basestore.TransactableHandle) {
+	f.PushHook(func() *basestore.TransactableHandle {
+		return r0
+	})
+}
+
+func (f *EnqueuerDBStoreHandleFunc) nextHook() func() *basestore.TransactableHandle {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnqueuerDBStoreHandleFunc) appendCall(r0 EnqueuerDBStoreHandleFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of EnqueuerDBStoreHandleFuncCall objects
+// describing the invocations of this function.
+func (f *EnqueuerDBStoreHandleFunc) History() []EnqueuerDBStoreHandleFuncCall {
+	f.mutex.Lock()
+	history := make([]EnqueuerDBStoreHandleFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnqueuerDBStoreHandleFuncCall is an object that describes an invocation
+// of method Handle on an instance of MockEnqueuerDBStore.
+type EnqueuerDBStoreHandleFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *basestore.TransactableHandle
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnqueuerDBStoreHandleFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnqueuerDBStoreHandleFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// EnqueuerDBStoreIndexableRepositoriesFunc describes the behavior when the
+// IndexableRepositories method of the parent MockEnqueuerDBStore instance
+// is invoked.
+type EnqueuerDBStoreIndexableRepositoriesFunc struct {
+	defaultHook func(context.Context, dbstore.IndexableRepositoryQueryOptions) ([]dbstore.IndexableRepository, error)
+	hooks       []func(context.Context, dbstore.IndexableRepositoryQueryOptions) ([]dbstore.IndexableRepository, error)
+	history     []EnqueuerDBStoreIndexableRepositoriesFuncCall
+	mutex       sync.Mutex
+}
+
+// IndexableRepositories delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockEnqueuerDBStore) IndexableRepositories(v0 context.Context, v1 dbstore.IndexableRepositoryQueryOptions) ([]dbstore.IndexableRepository, error) {
+	r0, r1 := m.IndexableRepositoriesFunc.nextHook()(v0, v1)
+	m.IndexableRepositoriesFunc.appendCall(EnqueuerDBStoreIndexableRepositoriesFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// IndexableRepositories method of the parent MockEnqueuerDBStore instance
+// is invoked and the hook queue is empty.
+func (f *EnqueuerDBStoreIndexableRepositoriesFunc) SetDefaultHook(hook func(context.Context, dbstore.IndexableRepositoryQueryOptions) ([]dbstore.IndexableRepository, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// IndexableRepositories method of the parent MockEnqueuerDBStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *EnqueuerDBStoreIndexableRepositoriesFunc) PushHook(hook func(context.Context, dbstore.IndexableRepositoryQueryOptions) ([]dbstore.IndexableRepository, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *EnqueuerDBStoreIndexableRepositoriesFunc) SetDefaultReturn(r0 []dbstore.IndexableRepository, r1 error) {
+	f.SetDefaultHook(func(context.Context, dbstore.IndexableRepositoryQueryOptions) ([]dbstore.IndexableRepository, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *EnqueuerDBStoreIndexableRepositoriesFunc) PushReturn(r0 []dbstore.IndexableRepository, r1 error) {
+	f.PushHook(func(context.Context, dbstore.IndexableRepositoryQueryOptions) ([]dbstore.IndexableRepository, error) {
+		return r0, r1
+	})
+}
+
+func (f *EnqueuerDBStoreIndexableRepositoriesFunc) nextHook() func(context.Context, dbstore.IndexableRepositoryQueryOptions) ([]dbstore.IndexableRepository, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnqueuerDBStoreIndexableRepositoriesFunc) appendCall(r0 EnqueuerDBStoreIndexableRepositoriesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// EnqueuerDBStoreIndexableRepositoriesFuncCall objects describing the
+// invocations of this function.
+func (f *EnqueuerDBStoreIndexableRepositoriesFunc) History() []EnqueuerDBStoreIndexableRepositoriesFuncCall {
+	f.mutex.Lock()
+	history := make([]EnqueuerDBStoreIndexableRepositoriesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnqueuerDBStoreIndexableRepositoriesFuncCall is an object that describes
+// an invocation of method IndexableRepositories on an instance of
+// MockEnqueuerDBStore.
+type EnqueuerDBStoreIndexableRepositoriesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 dbstore.IndexableRepositoryQueryOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []dbstore.IndexableRepository
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnqueuerDBStoreIndexableRepositoriesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnqueuerDBStoreIndexableRepositoriesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// EnqueuerDBStoreInsertIndexFunc describes the behavior when the
+// InsertIndex method of the parent MockEnqueuerDBStore instance is invoked.
+type EnqueuerDBStoreInsertIndexFunc struct {
+	defaultHook func(context.Context, dbstore.Index) (int, error)
+	hooks       []func(context.Context, dbstore.Index) (int, error)
+	history     []EnqueuerDBStoreInsertIndexFuncCall
+	mutex       sync.Mutex
+}
+
+// InsertIndex delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockEnqueuerDBStore) InsertIndex(v0 context.Context, v1 dbstore.Index) (int, error) {
+	r0, r1 := m.InsertIndexFunc.nextHook()(v0, v1)
+	m.InsertIndexFunc.appendCall(EnqueuerDBStoreInsertIndexFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the InsertIndex method
+// of the parent MockEnqueuerDBStore instance is invoked and the hook queue
+// is empty.
+func (f *EnqueuerDBStoreInsertIndexFunc) SetDefaultHook(hook func(context.Context, dbstore.Index) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// InsertIndex method of the parent MockEnqueuerDBStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *EnqueuerDBStoreInsertIndexFunc) PushHook(hook func(context.Context, dbstore.Index) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *EnqueuerDBStoreInsertIndexFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, dbstore.Index) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *EnqueuerDBStoreInsertIndexFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, dbstore.Index) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *EnqueuerDBStoreInsertIndexFunc) nextHook() func(context.Context, dbstore.Index) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnqueuerDBStoreInsertIndexFunc) appendCall(r0 EnqueuerDBStoreInsertIndexFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of EnqueuerDBStoreInsertIndexFuncCall objects
+// describing the invocations of this function.
+func (f *EnqueuerDBStoreInsertIndexFunc) History() []EnqueuerDBStoreInsertIndexFuncCall {
+	f.mutex.Lock()
+	history := make([]EnqueuerDBStoreInsertIndexFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnqueuerDBStoreInsertIndexFuncCall is an object that describes an
+// invocation of method InsertIndex on an instance of MockEnqueuerDBStore.
+type EnqueuerDBStoreInsertIndexFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 dbstore.Index
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnqueuerDBStoreInsertIndexFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnqueuerDBStoreInsertIndexFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// EnqueuerDBStoreIsQueuedFunc describes the behavior when the IsQueued
+// method of the parent MockEnqueuerDBStore instance is invoked.
+type EnqueuerDBStoreIsQueuedFunc struct {
+	defaultHook func(context.Context, int, string) (bool, error)
+	hooks       []func(context.Context, int, string) (bool, error)
+	history     []EnqueuerDBStoreIsQueuedFuncCall
+	mutex       sync.Mutex
+}
+
+// IsQueued delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockEnqueuerDBStore) IsQueued(v0 context.Context, v1 int, v2 string) (bool, error) {
+	r0, r1 := m.IsQueuedFunc.nextHook()(v0, v1, v2)
+	m.IsQueuedFunc.appendCall(EnqueuerDBStoreIsQueuedFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the IsQueued method of
+// the parent MockEnqueuerDBStore instance is invoked and the hook queue is
+// empty.
+func (f *EnqueuerDBStoreIsQueuedFunc) SetDefaultHook(hook func(context.Context, int, string) (bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// IsQueued method of the parent MockEnqueuerDBStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *EnqueuerDBStoreIsQueuedFunc) PushHook(hook func(context.Context, int, string) (bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *EnqueuerDBStoreIsQueuedFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string) (bool, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *EnqueuerDBStoreIsQueuedFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, int, string) (bool, error) {
+		return r0, r1
+	})
+}
+
+func (f *EnqueuerDBStoreIsQueuedFunc) nextHook() func(context.Context, int, string) (bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnqueuerDBStoreIsQueuedFunc) appendCall(r0 EnqueuerDBStoreIsQueuedFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of EnqueuerDBStoreIsQueuedFuncCall objects
+// describing the invocations of this function.
+func (f *EnqueuerDBStoreIsQueuedFunc) History() []EnqueuerDBStoreIsQueuedFuncCall {
+	f.mutex.Lock()
+	history := make([]EnqueuerDBStoreIsQueuedFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnqueuerDBStoreIsQueuedFuncCall is an object that describes an invocation
+// of method IsQueued on an instance of MockEnqueuerDBStore.
+type EnqueuerDBStoreIsQueuedFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 bool
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnqueuerDBStoreIsQueuedFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnqueuerDBStoreIsQueuedFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// EnqueuerDBStoreRepoUsageStatisticsFunc describes the behavior when the
+// RepoUsageStatistics method of the parent MockEnqueuerDBStore instance is
+// invoked.
+type EnqueuerDBStoreRepoUsageStatisticsFunc struct {
+	defaultHook func(context.Context) ([]dbstore.RepoUsageStatistics, error)
+	hooks       []func(context.Context) ([]dbstore.RepoUsageStatistics, error)
+	history     []EnqueuerDBStoreRepoUsageStatisticsFuncCall
+	mutex       sync.Mutex
+}
+
+// RepoUsageStatistics delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockEnqueuerDBStore) RepoUsageStatistics(v0 context.Context) ([]dbstore.RepoUsageStatistics, error) {
+	r0, r1 := m.RepoUsageStatisticsFunc.nextHook()(v0)
+	m.RepoUsageStatisticsFunc.appendCall(EnqueuerDBStoreRepoUsageStatisticsFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the RepoUsageStatistics
+// method of the parent MockEnqueuerDBStore instance is invoked and the hook
+// queue is empty.
+func (f *EnqueuerDBStoreRepoUsageStatisticsFunc) SetDefaultHook(hook func(context.Context) ([]dbstore.RepoUsageStatistics, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RepoUsageStatistics method of the parent MockEnqueuerDBStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *EnqueuerDBStoreRepoUsageStatisticsFunc) PushHook(hook func(context.Context) ([]dbstore.RepoUsageStatistics, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *EnqueuerDBStoreRepoUsageStatisticsFunc) SetDefaultReturn(r0 []dbstore.RepoUsageStatistics, r1 error) {
+	f.SetDefaultHook(func(context.Context) ([]dbstore.RepoUsageStatistics, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *EnqueuerDBStoreRepoUsageStatisticsFunc) PushReturn(r0 []dbstore.RepoUsageStatistics, r1 error) {
+	f.PushHook(func(context.Context) ([]dbstore.RepoUsageStatistics, error) {
+		return r0, r1
+	})
+}
+
+func (f *EnqueuerDBStoreRepoUsageStatisticsFunc) nextHook() func(context.Context) ([]dbstore.RepoUsageStatistics, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnqueuerDBStoreRepoUsageStatisticsFunc) appendCall(r0 EnqueuerDBStoreRepoUsageStatisticsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of EnqueuerDBStoreRepoUsageStatisticsFuncCall
+// objects describing the invocations of this function.
+func (f *EnqueuerDBStoreRepoUsageStatisticsFunc) History() []EnqueuerDBStoreRepoUsageStatisticsFuncCall {
+	f.mutex.Lock()
+	history := make([]EnqueuerDBStoreRepoUsageStatisticsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnqueuerDBStoreRepoUsageStatisticsFuncCall is an object that describes an
+// invocation of method RepoUsageStatistics on an instance of
+// MockEnqueuerDBStore.
+type EnqueuerDBStoreRepoUsageStatisticsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []dbstore.RepoUsageStatistics
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnqueuerDBStoreRepoUsageStatisticsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnqueuerDBStoreRepoUsageStatisticsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// EnqueuerDBStoreResetIndexableRepositoriesFunc describes the behavior when
+// the ResetIndexableRepositories method of the parent MockEnqueuerDBStore
+// instance is invoked.
+type EnqueuerDBStoreResetIndexableRepositoriesFunc struct {
+	defaultHook func(context.Context, time.Time) error
+	hooks       []func(context.Context, time.Time) error
+	history     []EnqueuerDBStoreResetIndexableRepositoriesFuncCall
+	mutex       sync.Mutex
+}
+
+// ResetIndexableRepositories delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockEnqueuerDBStore) ResetIndexableRepositories(v0 context.Context, v1 time.Time) error {
+	r0 := m.ResetIndexableRepositoriesFunc.nextHook()(v0, v1)
+	m.ResetIndexableRepositoriesFunc.appendCall(EnqueuerDBStoreResetIndexableRepositoriesFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// ResetIndexableRepositories method of the parent MockEnqueuerDBStore
+// instance is invoked and the hook queue is empty.
+func (f *EnqueuerDBStoreResetIndexableRepositoriesFunc) SetDefaultHook(hook func(context.Context, time.Time) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ResetIndexableRepositories method of the parent MockEnqueuerDBStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *EnqueuerDBStoreResetIndexableRepositoriesFunc) PushHook(hook func(context.Context, time.Time) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *EnqueuerDBStoreResetIndexableRepositoriesFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, time.Time) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *EnqueuerDBStoreResetIndexableRepositoriesFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, time.Time) error {
+		return r0
+	})
+}
+
+func (f *EnqueuerDBStoreResetIndexableRepositoriesFunc) nextHook() func(context.Context, time.Time) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnqueuerDBStoreResetIndexableRepositoriesFunc) appendCall(r0 EnqueuerDBStoreResetIndexableRepositoriesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// EnqueuerDBStoreResetIndexableRepositoriesFuncCall objects describing the
+// invocations of this function.
+func (f *EnqueuerDBStoreResetIndexableRepositoriesFunc) History() []EnqueuerDBStoreResetIndexableRepositoriesFuncCall {
+	f.mutex.Lock()
+	history := make([]EnqueuerDBStoreResetIndexableRepositoriesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnqueuerDBStoreResetIndexableRepositoriesFuncCall is an object that
+// describes an invocation of method ResetIndexableRepositories on an
+// instance of MockEnqueuerDBStore.
+type EnqueuerDBStoreResetIndexableRepositoriesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 time.Time
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnqueuerDBStoreResetIndexableRepositoriesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnqueuerDBStoreResetIndexableRepositoriesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// EnqueuerDBStoreTransactFunc describes the behavior when the Transact
+// method of the parent MockEnqueuerDBStore instance is invoked.
+type EnqueuerDBStoreTransactFunc struct {
+	defaultHook func(context.Context) (enqueuer.DBStore, error)
+	hooks       []func(context.Context) (enqueuer.DBStore, error)
+	history     []EnqueuerDBStoreTransactFuncCall
+	mutex       sync.Mutex
+}
+
+// Transact delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockEnqueuerDBStore) Transact(v0 context.Context) (enqueuer.DBStore, error) {
+	r0, r1 := m.TransactFunc.nextHook()(v0)
+	m.TransactFunc.appendCall(EnqueuerDBStoreTransactFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Transact method of
+// the parent MockEnqueuerDBStore instance is invoked and the hook queue is
+// empty.
+func (f *EnqueuerDBStoreTransactFunc) SetDefaultHook(hook func(context.Context) (enqueuer.DBStore, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Transact method of the parent MockEnqueuerDBStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *EnqueuerDBStoreTransactFunc) PushHook(hook func(context.Context) (enqueuer.DBStore, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *EnqueuerDBStoreTransactFunc) SetDefaultReturn(r0 enqueuer.DBStore, r1 error) {
+	f.SetDefaultHook(func(context.Context) (enqueuer.DBStore, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *EnqueuerDBStoreTransactFunc) PushReturn(r0 enqueuer.DBStore, r1 error) {
+	f.PushHook(func(context.Context) (enqueuer.DBStore, error) {
+		return r0, r1
+	})
+}
+
+func (f *EnqueuerDBStoreTransactFunc) nextHook() func(context.Context) (enqueuer.DBStore, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnqueuerDBStoreTransactFunc) appendCall(r0 EnqueuerDBStoreTransactFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of EnqueuerDBStoreTransactFuncCall objects
+// describing the invocations of this function.
+func (f *EnqueuerDBStoreTransactFunc) History() []EnqueuerDBStoreTransactFuncCall {
+	f.mutex.Lock()
+	history := make([]EnqueuerDBStoreTransactFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnqueuerDBStoreTransactFuncCall is an object that describes an invocation
+// of method Transact on an instance of MockEnqueuerDBStore.
+type EnqueuerDBStoreTransactFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 enqueuer.DBStore
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnqueuerDBStoreTransactFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnqueuerDBStoreTransactFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// EnqueuerDBStoreUpdateIndexableRepositoryFunc describes the behavior when
+// the UpdateIndexableRepository method of the parent MockEnqueuerDBStore
+// instance is invoked.
+type EnqueuerDBStoreUpdateIndexableRepositoryFunc struct {
+	defaultHook func(context.Context, dbstore.UpdateableIndexableRepository, time.Time) error
+	hooks       []func(context.Context, dbstore.UpdateableIndexableRepository, time.Time) error
+	history     []EnqueuerDBStoreUpdateIndexableRepositoryFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateIndexableRepository delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockEnqueuerDBStore) UpdateIndexableRepository(v0 context.Context, v1 dbstore.UpdateableIndexableRepository, v2 time.Time) error {
+	r0 := m.UpdateIndexableRepositoryFunc.nextHook()(v0, v1, v2)
+	m.UpdateIndexableRepositoryFunc.appendCall(EnqueuerDBStoreUpdateIndexableRepositoryFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// UpdateIndexableRepository method of the parent MockEnqueuerDBStore
+// instance is invoked and the hook queue is empty.
+func (f *EnqueuerDBStoreUpdateIndexableRepositoryFunc) SetDefaultHook(hook func(context.Context, dbstore.UpdateableIndexableRepository, time.Time) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateIndexableRepository method of the parent MockEnqueuerDBStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *EnqueuerDBStoreUpdateIndexableRepositoryFunc) PushHook(hook func(context.Context, dbstore.UpdateableIndexableRepository, time.Time) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *EnqueuerDBStoreUpdateIndexableRepositoryFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, dbstore.UpdateableIndexableRepository, time.Time) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *EnqueuerDBStoreUpdateIndexableRepositoryFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, dbstore.UpdateableIndexableRepository, time.Time) error {
+		return r0
+	})
+}
+
+func (f *EnqueuerDBStoreUpdateIndexableRepositoryFunc) nextHook() func(context.Context, dbstore.UpdateableIndexableRepository, time.Time) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnqueuerDBStoreUpdateIndexableRepositoryFunc) appendCall(r0 EnqueuerDBStoreUpdateIndexableRepositoryFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// EnqueuerDBStoreUpdateIndexableRepositoryFuncCall objects describing the
+// invocations of this function.
+func (f *EnqueuerDBStoreUpdateIndexableRepositoryFunc) History() []EnqueuerDBStoreUpdateIndexableRepositoryFuncCall {
+	f.mutex.Lock()
+	history := make([]EnqueuerDBStoreUpdateIndexableRepositoryFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnqueuerDBStoreUpdateIndexableRepositoryFuncCall is an object that
+// describes an invocation of method UpdateIndexableRepository on an
+// instance of MockEnqueuerDBStore.
+type EnqueuerDBStoreUpdateIndexableRepositoryFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 dbstore.UpdateableIndexableRepository
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 time.Time
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnqueuerDBStoreUpdateIndexableRepositoryFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnqueuerDBStoreUpdateIndexableRepositoryFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// EnqueuerDBStoreUpdateInferredIndexConfigurationByRepositoryIDFunc
+// describes the behavior when the
+// UpdateInferredIndexConfigurationByRepositoryID method of the parent
+// MockEnqueuerDBStore instance is invoked.
+type EnqueuerDBStoreUpdateInferredIndexConfigurationByRepositoryIDFunc struct {
+	defaultHook func(context.Context, int, []byte) error
+	hooks       []func(context.Context, int, []byte) error
+	history     []EnqueuerDBStoreUpdateInferredIndexConfigurationByRepositoryIDFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateInferredIndexConfigurationByRepositoryID delegates to the next hook
+// function in the queue and stores the parameter and result values of this
+// invocation.
+func (m *MockEnqueuerDBStore) UpdateInferredIndexConfigurationByRepositoryID(v0 context.Context, v1 int, v2 []byte) error {
+	r0 := m.UpdateInferredIndexConfigurationByRepositoryIDFunc.nextHook()(v0, v1, v2)
+	m.UpdateInferredIndexConfigurationByRepositoryIDFunc.appendCall(EnqueuerDBStoreUpdateInferredIndexConfigurationByRepositoryIDFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// UpdateInferredIndexConfigurationByRepositoryID method of the parent
+// MockEnqueuerDBStore instance is invoked and the hook queue is empty.
+func (f *EnqueuerDBStoreUpdateInferredIndexConfigurationByRepositoryIDFunc) SetDefaultHook(hook func(context.Context, int, []byte) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateInferredIndexConfigurationByRepositoryID method of the parent
+// MockEnqueuerDBStore instance invokes the hook at the front of the queue
+// and discards it. After the queue is empty, the default hook function is
+// invoked for any future action.
+func (f *EnqueuerDBStoreUpdateInferredIndexConfigurationByRepositoryIDFunc) PushHook(hook func(context.Context, int, []byte) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *EnqueuerDBStoreUpdateInferredIndexConfigurationByRepositoryIDFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int, []byte) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *EnqueuerDBStoreUpdateInferredIndexConfigurationByRepositoryIDFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int, []byte) error {
+		return r0
+	})
+}
+
+func (f *EnqueuerDBStoreUpdateInferredIndexConfigurationByRepositoryIDFunc) nextHook() func(context.Context, int, []byte) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnqueuerDBStoreUpdateInferredIndexConfigurationByRepositoryIDFunc) appendCall(r0 EnqueuerDBStoreUpdateInferredIndexConfigurationByRepositoryIDFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// EnqueuerDBStoreUpdateInferredIndexConfigurationByRepositoryIDFuncCall
+// objects describing the invocations of this function.
+func (f *EnqueuerDBStoreUpdateInferredIndexConfigurationByRepositoryIDFunc) History() []EnqueuerDBStoreUpdateInferredIndexConfigurationByRepositoryIDFuncCall {
+	f.mutex.Lock()
+	history := make([]EnqueuerDBStoreUpdateInferredIndexConfigurationByRepositoryIDFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnqueuerDBStoreUpdateInferredIndexConfigurationByRepositoryIDFuncCall is
+// an object that describes an invocation of method
+// UpdateInferredIndexConfigurationByRepositoryID on an instance of
+// MockEnqueuerDBStore.
+type EnqueuerDBStoreUpdateInferredIndexConfigurationByRepositoryIDFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []byte
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnqueuerDBStoreUpdateInferredIndexConfigurationByRepositoryIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnqueuerDBStoreUpdateInferredIndexConfigurationByRepositoryIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// MockEnqueuerGitserverClient is a mock implementation of the
+// EnqueuerGitserverClient interface (from the package
+// github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers)
+// used for unit testing.
+type MockEnqueuerGitserverClient struct {
+	// FileExistsFunc is an instance of a mock function object controlling
+	// the behavior of the method FileExists.
+	FileExistsFunc *EnqueuerGitserverClientFileExistsFunc
+	// HeadFunc is an instance of a mock function object controlling the
+	// behavior of the method Head.
+	HeadFunc *EnqueuerGitserverClientHeadFunc
+	// ListFilesFunc is an instance of a mock function object controlling
+	// the behavior of the method ListFiles.
+	ListFilesFunc *EnqueuerGitserverClientListFilesFunc
+	// RawContentsFunc is an instance of a mock function object controlling
+	// the behavior of the method RawContents.
+	RawContentsFunc *EnqueuerGitserverClientRawContentsFunc
+	// ResolveRevisionFunc is an instance of a mock function object
+	// controlling the behavior of the method ResolveRevision.
+	ResolveRevisionFunc *EnqueuerGitserverClientResolveRevisionFunc
+}
+
+// NewMockEnqueuerGitserverClient creates a new mock of the
+// EnqueuerGitserverClient interface. All methods return zero values for all
+// results, unless overwritten.
+func NewMockEnqueuerGitserverClient() *MockEnqueuerGitserverClient {
+	return &MockEnqueuerGitserverClient{
+		FileExistsFunc: &EnqueuerGitserverClientFileExistsFunc{
+			defaultHook: func(context.Context, int, string, string) (bool, error) {
+				return false, nil
+			},
+		},
+		HeadFunc: &EnqueuerGitserverClientHeadFunc{
+			defaultHook: func(context.Context, int) (string, error) {
+				return "", nil
+			},
+		},
+		ListFilesFunc: &EnqueuerGitserverClientListFilesFunc{
+			defaultHook: func(context.Context, int, string, *regexp.Regexp) ([]string, error) {
+				return nil, nil
+			},
+		},
+		RawContentsFunc: &EnqueuerGitserverClientRawContentsFunc{
+			defaultHook: func(context.Context, int, string, string) ([]byte, error) {
+				return nil, nil
+			},
+		},
+		ResolveRevisionFunc: &EnqueuerGitserverClientResolveRevisionFunc{
+			defaultHook: func(context.Context, int, string) (api.CommitID, error) {
+				return "", nil
+			},
+		},
+	}
+}
+
+// NewMockEnqueuerGitserverClientFrom creates a new mock of the
+// MockEnqueuerGitserverClient interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockEnqueuerGitserverClientFrom(i EnqueuerGitserverClient) *MockEnqueuerGitserverClient {
+	return &MockEnqueuerGitserverClient{
+		FileExistsFunc: &EnqueuerGitserverClientFileExistsFunc{
+			defaultHook: i.FileExists,
+		},
+		HeadFunc: &EnqueuerGitserverClientHeadFunc{
+			defaultHook: i.Head,
+		},
+		ListFilesFunc: &EnqueuerGitserverClientListFilesFunc{
+			defaultHook: i.ListFiles,
+		},
+		RawContentsFunc: &EnqueuerGitserverClientRawContentsFunc{
+			defaultHook: i.RawContents,
+		},
+		ResolveRevisionFunc: &EnqueuerGitserverClientResolveRevisionFunc{
+			defaultHook: i.ResolveRevision,
+		},
+	}
+}
+
+// EnqueuerGitserverClientFileExistsFunc describes the behavior when the
+// FileExists method of the parent MockEnqueuerGitserverClient instance is
+// invoked.
+type EnqueuerGitserverClientFileExistsFunc struct {
+	defaultHook func(context.Context, int, string, string) (bool, error)
+	hooks       []func(context.Context, int, string, string) (bool, error)
+	history     []EnqueuerGitserverClientFileExistsFuncCall
+	mutex       sync.Mutex
+}
+
+// FileExists delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockEnqueuerGitserverClient) FileExists(v0 context.Context, v1 int, v2 string, v3 string) (bool, error) {
+	r0, r1 := m.FileExistsFunc.nextHook()(v0, v1, v2, v3)
+	m.FileExistsFunc.appendCall(EnqueuerGitserverClientFileExistsFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the FileExists method of
+// the parent MockEnqueuerGitserverClient instance is invoked and the hook
+// queue is empty.
+func (f *EnqueuerGitserverClientFileExistsFunc) SetDefaultHook(hook func(context.Context, int, string, string) (bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// FileExists method of the parent MockEnqueuerGitserverClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *EnqueuerGitserverClientFileExistsFunc) PushHook(hook func(context.Context, int, string, string) (bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *EnqueuerGitserverClientFileExistsFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string, string) (bool, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *EnqueuerGitserverClientFileExistsFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, int, string, string) (bool, error) {
+		return r0, r1
+	})
+}
+
+func (f *EnqueuerGitserverClientFileExistsFunc) nextHook() func(context.Context, int, string, string) (bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnqueuerGitserverClientFileExistsFunc) appendCall(r0 EnqueuerGitserverClientFileExistsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of EnqueuerGitserverClientFileExistsFuncCall
+// objects describing the invocations of this function.
+func (f *EnqueuerGitserverClientFileExistsFunc) History() []EnqueuerGitserverClientFileExistsFuncCall {
+	f.mutex.Lock()
+	history := make([]EnqueuerGitserverClientFileExistsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnqueuerGitserverClientFileExistsFuncCall is an object that describes an
+// invocation of method FileExists on an instance of
+// MockEnqueuerGitserverClient.
+type EnqueuerGitserverClientFileExistsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 bool
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnqueuerGitserverClientFileExistsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnqueuerGitserverClientFileExistsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// EnqueuerGitserverClientHeadFunc describes the behavior when the Head
+// method of the parent MockEnqueuerGitserverClient instance is invoked.
+type EnqueuerGitserverClientHeadFunc struct {
+	defaultHook func(context.Context, int) (string, error)
+	hooks       []func(context.Context, int) (string, error)
+	history     []EnqueuerGitserverClientHeadFuncCall
+	mutex       sync.Mutex
+}
+
+// Head delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockEnqueuerGitserverClient) Head(v0 context.Context, v1 int) (string, error) {
+	r0, r1 := m.HeadFunc.nextHook()(v0, v1)
+	m.HeadFunc.appendCall(EnqueuerGitserverClientHeadFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Head method of the
+// parent MockEnqueuerGitserverClient instance is invoked and the hook queue
+// is empty.
+func (f *EnqueuerGitserverClientHeadFunc) SetDefaultHook(hook func(context.Context, int) (string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Head method of the parent MockEnqueuerGitserverClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *EnqueuerGitserverClientHeadFunc) PushHook(hook func(context.Context, int) (string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *EnqueuerGitserverClientHeadFunc) SetDefaultReturn(r0 string, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) (string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *EnqueuerGitserverClientHeadFunc) PushReturn(r0 string, r1 error) {
+	f.PushHook(func(context.Context, int) (string, error) {
+		return r0, r1
+	})
+}
+
+func (f *EnqueuerGitserverClientHeadFunc) nextHook() func(context.Context, int) (string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnqueuerGitserverClientHeadFunc) appendCall(r0 EnqueuerGitserverClientHeadFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of EnqueuerGitserverClientHeadFuncCall objects
+// describing the invocations of this function.
+func (f *EnqueuerGitserverClientHeadFunc) History() []EnqueuerGitserverClientHeadFuncCall {
+	f.mutex.Lock()
+	history := make([]EnqueuerGitserverClientHeadFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnqueuerGitserverClientHeadFuncCall is an object that describes an
+// invocation of method Head on an instance of MockEnqueuerGitserverClient.
+type EnqueuerGitserverClientHeadFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnqueuerGitserverClientHeadFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnqueuerGitserverClientHeadFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// EnqueuerGitserverClientListFilesFunc describes the behavior when the
+// ListFiles method of the parent MockEnqueuerGitserverClient instance is
+// invoked.
+type EnqueuerGitserverClientListFilesFunc struct {
+	defaultHook func(context.Context, int, string, *regexp.Regexp) ([]string, error)
+	hooks       []func(context.Context, int, string, *regexp.Regexp) ([]string, error)
+	history     []EnqueuerGitserverClientListFilesFuncCall
+	mutex       sync.Mutex
+}
+
+// ListFiles delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockEnqueuerGitserverClient) ListFiles(v0 context.Context, v1 int, v2 string, v3 *regexp.Regexp) ([]string, error) {
+	r0, r1 := m.ListFilesFunc.nextHook()(v0, v1, v2, v3)
+	m.ListFilesFunc.appendCall(EnqueuerGitserverClientListFilesFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListFiles method of
+// the parent MockEnqueuerGitserverClient instance is invoked and the hook
+// queue is empty.
+func (f *EnqueuerGitserverClientListFilesFunc) SetDefaultHook(hook func(context.Context, int, string, *regexp.Regexp) ([]string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListFiles method of the parent MockEnqueuerGitserverClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *EnqueuerGitserverClientListFilesFunc) PushHook(hook func(context.Context, int, string, *regexp.Regexp) ([]string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *EnqueuerGitserverClientListFilesFunc) SetDefaultReturn(r0 []string, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string, *regexp.Regexp) ([]string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *EnqueuerGitserverClientListFilesFunc) PushReturn(r0 []string, r1 error) {
+	f.PushHook(func(context.Context, int, string, *regexp.Regexp) ([]string, error) {
+		return r0, r1
+	})
+}
+
+func (f *EnqueuerGitserverClientListFilesFunc) nextHook() func(context.Context, int, string, *regexp.Regexp) ([]string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnqueuerGitserverClientListFilesFunc) appendCall(r0 EnqueuerGitserverClientListFilesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of EnqueuerGitserverClientListFilesFuncCall
+// objects describing the invocations of this function.
+func (f *EnqueuerGitserverClientListFilesFunc) History() []EnqueuerGitserverClientListFilesFuncCall {
+	f.mutex.Lock()
+	history := make([]EnqueuerGitserverClientListFilesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnqueuerGitserverClientListFilesFuncCall is an object that describes an
+// invocation of method ListFiles on an instance of
+// MockEnqueuerGitserverClient.
+type EnqueuerGitserverClientListFilesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 *regexp.Regexp
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnqueuerGitserverClientListFilesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnqueuerGitserverClientListFilesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// EnqueuerGitserverClientRawContentsFunc describes the behavior when the
+// RawContents method of the parent MockEnqueuerGitserverClient instance is
+// invoked.
+type EnqueuerGitserverClientRawContentsFunc struct {
+	defaultHook func(context.Context, int, string, string) ([]byte, error)
+	hooks       []func(context.Context, int, string, string) ([]byte, error)
+	history     []EnqueuerGitserverClientRawContentsFuncCall
+	mutex       sync.Mutex
+}
+
+// RawContents delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockEnqueuerGitserverClient) RawContents(v0 context.Context, v1 int, v2 string, v3 string) ([]byte, error) {
+	r0, r1 := m.RawContentsFunc.nextHook()(v0, v1, v2, v3)
+	m.RawContentsFunc.appendCall(EnqueuerGitserverClientRawContentsFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the RawContents method
+// of the parent MockEnqueuerGitserverClient instance is invoked and the
+// hook queue is empty.
+func (f *EnqueuerGitserverClientRawContentsFunc) SetDefaultHook(hook func(context.Context, int, string, string) ([]byte, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RawContents method of the parent MockEnqueuerGitserverClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *EnqueuerGitserverClientRawContentsFunc) PushHook(hook func(context.Context, int, string, string) ([]byte, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *EnqueuerGitserverClientRawContentsFunc) SetDefaultReturn(r0 []byte, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string, string) ([]byte, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *EnqueuerGitserverClientRawContentsFunc) PushReturn(r0 []byte, r1 error) {
+	f.PushHook(func(context.Context, int, string, string) ([]byte, error) {
+		return r0, r1
+	})
+}
+
+func (f *EnqueuerGitserverClientRawContentsFunc) nextHook() func(context.Context, int, string, string) ([]byte, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnqueuerGitserverClientRawContentsFunc) appendCall(r0 EnqueuerGitserverClientRawContentsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of EnqueuerGitserverClientRawContentsFuncCall
+// objects describing the invocations of this function.
+func (f *EnqueuerGitserverClientRawContentsFunc) History() []EnqueuerGitserverClientRawContentsFuncCall {
+	f.mutex.Lock()
+	history := make([]EnqueuerGitserverClientRawContentsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnqueuerGitserverClientRawContentsFuncCall is an object that describes an
+// invocation of method RawContents on an instance of
+// MockEnqueuerGitserverClient.
+type EnqueuerGitserverClientRawContentsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []byte
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall) Args() []interface{} {
+func (c EnqueuerGitserverClientRawContentsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnqueuerGitserverClientRawContentsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// EnqueuerGitserverClientResolveRevisionFunc describes the behavior when
+// the ResolveRevision method of the parent MockEnqueuerGitserverClient
+// instance is invoked.
+type EnqueuerGitserverClientResolveRevisionFunc struct {
+	defaultHook func(context.Context, int, string) (api.CommitID, error)
+	hooks       []func(context.Context, int, string) (api.CommitID, error)
+	history     []EnqueuerGitserverClientResolveRevisionFuncCall
+	mutex       sync.Mutex
+}
+
+// ResolveRevision delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockEnqueuerGitserverClient) ResolveRevision(v0 context.Context, v1 int, v2 string) (api.CommitID, error) {
+	r0, r1 := m.ResolveRevisionFunc.nextHook()(v0, v1, v2)
+	m.ResolveRevisionFunc.appendCall(EnqueuerGitserverClientResolveRevisionFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ResolveRevision
+// method of the parent MockEnqueuerGitserverClient instance is invoked and
+// the hook queue is empty.
+func (f *EnqueuerGitserverClientResolveRevisionFunc) SetDefaultHook(hook func(context.Context, int, string) (api.CommitID, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ResolveRevision method of the parent MockEnqueuerGitserverClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *EnqueuerGitserverClientResolveRevisionFunc) PushHook(hook func(context.Context, int, string) (api.CommitID, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *EnqueuerGitserverClientResolveRevisionFunc) SetDefaultReturn(r0 api.CommitID, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string) (api.CommitID, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *EnqueuerGitserverClientResolveRevisionFunc) PushReturn(r0 api.CommitID, r1 error) {
+	f.PushHook(func(context.Context, int, string) (api.CommitID, error) {
+		return r0, r1
+	})
+}
+
+func (f *EnqueuerGitserverClientResolveRevisionFunc) nextHook() func(context.Context, int, string) (api.CommitID, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnqueuerGitserverClientResolveRevisionFunc) appendCall(r0 EnqueuerGitserverClientResolveRevisionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// EnqueuerGitserverClientResolveRevisionFuncCall objects describing the
+// invocations of this function.
+func (f *EnqueuerGitserverClientResolveRevisionFunc) History() []EnqueuerGitserverClientResolveRevisionFuncCall {
+	f.mutex.Lock()
+	history := make([]EnqueuerGitserverClientResolveRevisionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnqueuerGitserverClientResolveRevisionFuncCall is an object that
+// describes an invocation of method ResolveRevision on an instance of
+// MockEnqueuerGitserverClient.
+type EnqueuerGitserverClientResolveRevisionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 api.CommitID
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnqueuerGitserverClientResolveRevisionFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c EnqueuerGitserverClientResolveRevisionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// MockEnqueuerDBStore is a mock implementation of the EnqueuerDBStore
+// MockGitserverClient is a mock implementation of the GitserverClient
 // interface (from the package
 // github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers)
 // used for unit testing.
-type MockEnqueuerDBStore struct {
-	// DirtyRepositoriesFunc is an instance of a mock function object
-	// controlling the behavior of the method DirtyRepositories.
-	DirtyRepositoriesFunc *EnqueuerDBStoreDirtyRepositoriesFunc
-	// DoneFunc is an instance of a mock function object controlling the
-	// behavior of the method Done.
-	DoneFunc *EnqueuerDBStoreDoneFunc
-	// GetIndexConfigurationByRepositoryIDFunc is an instance of a mock
-	// function object controlling the behavior of the method
-	// GetIndexConfigurationByRepositoryID.
-	GetIndexConfigurationByRepositoryIDFunc *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc
-	// GetRepositoriesWithIndexConfigurationFunc is an instance of a mock
-	// function object controlling the behavior of the method
-	// GetRepositoriesWithIndexConfiguration.
-	GetRepositoriesWithIndexConfigurationFunc *EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFunc
-	// HandleFunc is an instance of a mock function object controlling the
-	// behavior of the method Handle.
-	HandleFunc *EnqueuerDBStoreHandleFunc
-	// IndexableRepositoriesFunc is an instance of a mock function object
-	// controlling the behavior of the method IndexableRepositories.
-	IndexableRepositoriesFunc *EnqueuerDBStoreIndexableRepositoriesFunc
-	// InsertIndexFunc is an instance of a mock function object controlling
-	// the behavior of the method InsertIndex.
-	InsertIndexFunc *EnqueuerDBStoreInsertIndexFunc
-	// IsQueuedFunc is an instance of a mock function object controlling the
-	// behavior of the method IsQueued.
-	IsQueuedFunc *EnqueuerDBStoreIsQueuedFunc
-	// RepoUsageStatisticsFunc is an instance of a mock function object
-	// controlling the behavior of the method RepoUsageStatistics.
-	RepoUsageStatisticsFunc *EnqueuerDBStoreRepoUsageStatisticsFunc
-	// ResetIndexableRepositoriesFunc is an instance of a mock function
-	// object controlling the behavior of the method
-	// ResetIndexableRepositories.
-	ResetIndexableRepositoriesFunc *EnqueuerDBStoreResetIndexableRepositoriesFunc
-	// TransactFunc is an instance of a mock function object controlling the
-	// behavior of the method Transact.
-	TransactFunc *EnqueuerDBStoreTransactFunc
-	// UpdateIndexableRepositoryFunc is an instance of a mock function
-	// object controlling the behavior of the method
-	// UpdateIndexableRepository.
-	UpdateIndexableRepositoryFunc *EnqueuerDBStoreUpdateIndexableRepositoryFunc
-}
-
-// NewMockEnqueuerDBStore creates a new mock of the EnqueuerDBStore
-// interface. All methods return zero values for all results, unless
-// overwritten.
-func NewMockEnqueuerDBStore() *MockEnqueuerDBStore {
-	return &MockEnqueuerDBStore{
-		DirtyRepositoriesFunc: &EnqueuerDBStoreDirtyRepositoriesFunc{
-			defaultHook: func(context.Context) (map[int]int, error) {
-				return nil, nil
-			},
-		},
-		DoneFunc: &EnqueuerDBStoreDoneFunc{
-			defaultHook: func(error) error {
-				return nil
-			},
-		},
-		GetIndexConfigurationByRepositoryIDFunc: &EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc{
-			defaultHook: func(context.Context, int) (dbstore.IndexConfiguration, bool, error) {
-				return dbstore.IndexConfiguration{}, false, nil
-			},
-		},
-		GetRepositoriesWithIndexConfigurationFunc: &EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFunc{
-			defaultHook: func(context.Context) ([]int, error) {
-				return nil, nil
-			},
-		},
-		HandleFunc: &EnqueuerDBStoreHandleFunc{
-			defaultHook: func() *basestore.TransactableHandle {
-				return nil
-			},
-		},
-		IndexableRepositoriesFunc: &EnqueuerDBStoreIndexableRepositoriesFunc{
-			defaultHook: func(context.Context, dbstore.IndexableRepositoryQueryOptions) ([]dbstore.IndexableRepository, error) {
-				return nil, nil
-			},
-		},
-		InsertIndexFunc: &EnqueuerDBStoreInsertIndexFunc{
-			defaultHook: func(context.Context, dbstore.Index) (int, error) {
-				return 0, nil
-			},
-		},
-		IsQueuedFunc: &EnqueuerDBStoreIsQueuedFunc{
+type MockGitserverClient struct {
+	// CommitExistsFunc is an instance of a mock function object controlling
+	// the behavior of the method CommitExists.
+	CommitExistsFunc *GitserverClientCommitExistsFunc
+	// CommitGraphFunc is an instance of a mock function object controlling
+	// the behavior of the method CommitGraph.
+	CommitGraphFunc *GitserverClientCommitGraphFunc
+	// ListFilesFunc is an instance of a mock function object controlling
+	// the behavior of the method ListFiles.
+	ListFilesFunc *GitserverClientListFilesFunc
+	// MultiDiffFunc is an instance of a mock function object controlling
+	// the behavior of the method MultiDiff.
+	MultiDiffFunc *GitserverClientMultiDiffFunc
+}
+
+// NewMockGitserverClient creates a new mock of the GitserverClient
+// interface. All methods return zero values for all results, unless
+// overwritten.
+func NewMockGitserverClient() *MockGitserverClient {
+	return &MockGitserverClient{
+		CommitExistsFunc: &GitserverClientCommitExistsFunc{
 			defaultHook: func(context.Context, int, string) (bool, error) {
 				return false, nil
 			},
 		},
-		RepoUsageStatisticsFunc: &EnqueuerDBStoreRepoUsageStatisticsFunc{
-			defaultHook: func(context.Context) ([]dbstore.RepoUsageStatistics, error) {
+		CommitGraphFunc: &GitserverClientCommitGraphFunc{
+			defaultHook: func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error) {
 				return nil, nil
 			},
 		},
-		ResetIndexableRepositoriesFunc: &EnqueuerDBStoreResetIndexableRepositoriesFunc{
-			defaultHook: func(context.Context, time.Time) error {
-				return nil
-			},
-		},
-		TransactFunc: &EnqueuerDBStoreTransactFunc{
-			defaultHook: func(context.Context) (enqueuer.DBStore, error) {
+		ListFilesFunc: &GitserverClientListFilesFunc{
+			defaultHook: func(context.Context, int, string, *regexp.Regexp) ([]string, error) {
 				return nil, nil
 			},
 		},
-		UpdateIndexableRepositoryFunc: &EnqueuerDBStoreUpdateIndexableRepositoryFunc{
-			defaultHook: func(context.Context, dbstore.UpdateableIndexableRepository, time.Time) error {
-				return nil
+		MultiDiffFunc: &GitserverClientMultiDiffFunc{
+			defaultHook: func(context.Context, int, []gitserver.DiffRequest) ([][]*diff.Hunk, error) {
+				return nil, nil
 			},
 		},
 	}
 }
 
-// NewMockEnqueuerDBStoreFrom creates a new mock of the MockEnqueuerDBStore
+// NewMockGitserverClientFrom creates a new mock of the MockGitserverClient
 // interface. All methods delegate to the given implementation, unless
 // overwritten.
-func NewMockEnqueuerDBStoreFrom(i EnqueuerDBStore) *MockEnqueuerDBStore {
-	return &MockEnqueuerDBStore{
-		DirtyRepositoriesFunc: &EnqueuerDBStoreDirtyRepositoriesFunc{
-			defaultHook: i.DirtyRepositories,
-		},
-		DoneFunc: &EnqueuerDBStoreDoneFunc{
-			defaultHook: i.Done,
-		},
-		GetIndexConfigurationByRepositoryIDFunc: &EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc{
-			defaultHook: i.GetIndexConfigurationByRepositoryID,
-		},
-		GetRepositoriesWithIndexConfigurationFunc: &EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFunc{
-			defaultHook: i.GetRepositoriesWithIndexConfiguration,
-		},
-		HandleFunc: &EnqueuerDBStoreHandleFunc{
-			defaultHook: i.Handle,
-		},
-		IndexableRepositoriesFunc: &EnqueuerDBStoreIndexableRepositoriesFunc{
-			defaultHook: i.IndexableRepositories,
-		},
-		InsertIndexFunc: &EnqueuerDBStoreInsertIndexFunc{
-			defaultHook: i.InsertIndex,
-		},
-		IsQueuedFunc: &EnqueuerDBStoreIsQueuedFunc{
-			defaultHook: i.IsQueued,
-		},
-		RepoUsageStatisticsFunc: &EnqueuerDBStoreRepoUsageStatisticsFunc{
-			defaultHook: i.RepoUsageStatistics,
+func NewMockGitserverClientFrom(i GitserverClient) *MockGitserverClient {
+	return &MockGitserverClient{
+		CommitExistsFunc: &GitserverClientCommitExistsFunc{
+			defaultHook: i.CommitExists,
 		},
-		ResetIndexableRepositoriesFunc: &EnqueuerDBStoreResetIndexableRepositoriesFunc{
-			defaultHook: i.ResetIndexableRepositories,
+		CommitGraphFunc: &GitserverClientCommitGraphFunc{
+			defaultHook: i.CommitGraph,
 		},
-		TransactFunc: &EnqueuerDBStoreTransactFunc{
-			defaultHook: i.Transact,
+		ListFilesFunc: &GitserverClientListFilesFunc{
+			defaultHook: i.ListFiles,
 		},
-		UpdateIndexableRepositoryFunc: &EnqueuerDBStoreUpdateIndexableRepositoryFunc{
-			defaultHook: i.UpdateIndexableRepository,
+		MultiDiffFunc: &GitserverClientMultiDiffFunc{
+			defaultHook: i.MultiDiff,
 		},
 	}
 }
 
-// EnqueuerDBStoreDirtyRepositoriesFunc describes the behavior when the
-// DirtyRepositories method of the parent MockEnqueuerDBStore instance is
+// GitserverClientCommitExistsFunc describes the behavior when the
+// CommitExists method of the parent MockGitserverClient instance is
 // invoked.
-type EnqueuerDBStoreDirtyRepositoriesFunc struct {
-	defaultHook func(context.Context) (map[int]int, error)
-	hooks       []func(context.Context) (map[int]int, error)
-	history     []EnqueuerDBStoreDirtyRepositoriesFuncCall
+type GitserverClientCommitExistsFunc struct {
+	defaultHook func(context.Context, int, string) (bool, error)
+	hooks       []func(context.Context, int, string) (bool, error)
+	history     []GitserverClientCommitExistsFuncCall
 	mutex       sync.Mutex
 }
 
-// DirtyRepositories delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockEnqueuerDBStore) DirtyRepositories(v0 context.Context) (map[int]int, error) {
-	r0, r1 := m.DirtyRepositoriesFunc.nextHook()(v0)
-	m.DirtyRepositoriesFunc.appendCall(EnqueuerDBStoreDirtyRepositoriesFuncCall{v0, r0, r1})
+// CommitExists delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGitserverClient) CommitExists(v0 context.Context, v1 int, v2 string) (bool, error) {
+	r0, r1 := m.CommitExistsFunc.nextHook()(v0, v1, v2)
+	m.CommitExistsFunc.appendCall(GitserverClientCommitExistsFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the DirtyRepositories
-// method of the parent MockEnqueuerDBStore instance is invoked and the hook
-// queue is empty.
-func (f *EnqueuerDBStoreDirtyRepositoriesFunc) SetDefaultHook(hook func(context.Context) (map[int]int, error)) {
+// SetDefaultHook sets function that is called when the CommitExists method
+// of the parent MockGitserverClient instance is invoked and the hook queue
+// is empty.
+func (f *GitserverClientCommitExistsFunc) SetDefaultHook(hook func(context.Context, int, string) (bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// DirtyRepositories method of the parent MockEnqueuerDBStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *EnqueuerDBStoreDirtyRepositoriesFunc) PushHook(hook func(context.Context) (map[int]int, error)) {
+// CommitExists method of the parent MockGitserverClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GitserverClientCommitExistsFunc) PushHook(hook func(context.Context, int, string) (bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2727,21 +6116,21 @@ func (f *EnqueuerDBStoreDirtyRepositoriesFunc) PushHook(hook func(context.Contex
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *EnqueuerDBStoreDirtyRepositoriesFunc) SetDefaultReturn(r0 map[int]int, r1 error) {
-	f.SetDefaultHook(func(context.Context) (map[int]int, error) {
+func (f *GitserverClientCommitExistsFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string) (bool, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *EnqueuerDBStoreDirtyRepositoriesFunc) PushReturn(r0 map[int]int, r1 error) {
-	f.PushHook(func(context.Context) (map[int]int, error) {
+func (f *GitserverClientCommitExistsFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, int, string) (bool, error) {
 		return r0, r1
 	})
 }
 
-func (f *EnqueuerDBStoreDirtyRepositoriesFunc) nextHook() func(context.Context) (map[int]int, error) {
+func (f *GitserverClientCommitExistsFunc) nextHook() func(context.Context, int, string) (bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2754,33 +6143,150 @@ func (f *EnqueuerDBStoreDirtyRepositoriesFunc) nextHook() func(context.Context)
 	return hook
 }
 
-func (f *EnqueuerDBStoreDirtyRepositoriesFunc) appendCall(r0 EnqueuerDBStoreDirtyRepositoriesFuncCall) {
+func (f *GitserverClientCommitExistsFunc) appendCall(r0 GitserverClientCommitExistsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnqueuerDBStoreDirtyRepositoriesFuncCall
-// objects describing the invocations of this function.
-func (f *EnqueuerDBStoreDirtyRepositoriesFunc) History() []EnqueuerDBStoreDirtyRepositoriesFuncCall {
+// History returns a sequence of GitserverClientCommitExistsFuncCall objects
+// describing the invocations of this function.
+func (f *GitserverClientCommitExistsFunc) History() []GitserverClientCommitExistsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnqueuerDBStoreDirtyRepositoriesFuncCall, len(f.history))
+	history := make([]GitserverClientCommitExistsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnqueuerDBStoreDirtyRepositoriesFuncCall is an object that describes an
-// invocation of method DirtyRepositories on an instance of
-// MockEnqueuerDBStore.
-type EnqueuerDBStoreDirtyRepositoriesFuncCall struct {
+// GitserverClientCommitExistsFuncCall is an object that describes an
+// invocation of method CommitExists on an instance of MockGitserverClient.
+type GitserverClientCommitExistsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 bool
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverClientCommitExistsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverClientCommitExistsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitserverClientCommitGraphFunc describes the behavior when the
+// CommitGraph method of the parent MockGitserverClient instance is invoked.
+type GitserverClientCommitGraphFunc struct {
+	defaultHook func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error)
+	hooks       []func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error)
+	history     []GitserverClientCommitGraphFuncCall
+	mutex       sync.Mutex
+}
+
+// CommitGraph delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGitserverClient) CommitGraph(v0 context.Context, v1 int, v2 gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error) {
+	r0, r1 := m.CommitGraphFunc.nextHook()(v0, v1, v2)
+	m.CommitGraphFunc.appendCall(GitserverClientCommitGraphFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CommitGraph method
+// of the parent MockGitserverClient instance is invoked and the hook queue
+// is empty.
+func (f *GitserverClientCommitGraphFunc) SetDefaultHook(hook func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CommitGraph method of the parent MockGitserverClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GitserverClientCommitGraphFunc) PushHook(hook func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *GitserverClientCommitGraphFunc) SetDefaultReturn(r0 *gitserver.CommitGraph, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *GitserverClientCommitGraphFunc) PushReturn(r0 *gitserver.CommitGraph, r1 error) {
+	f.PushHook(func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverClientCommitGraphFunc) nextHook() func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverClientCommitGraphFunc) appendCall(r0 GitserverClientCommitGraphFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverClientCommitGraphFuncCall objects
+// describing the invocations of this function.
+func (f *GitserverClientCommitGraphFunc) History() []GitserverClientCommitGraphFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverClientCommitGraphFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverClientCommitGraphFuncCall is an object that describes an
+// invocation of method CommitGraph on an instance of MockGitserverClient.
+type GitserverClientCommitGraphFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 context.Context
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 gitserver.CommitGraphOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 map[int]int
+	Result0 *gitserver.CommitGraph
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -2788,45 +6294,45 @@ type EnqueuerDBStoreDirtyRepositoriesFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnqueuerDBStoreDirtyRepositoriesFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c GitserverClientCommitGraphFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnqueuerDBStoreDirtyRepositoriesFuncCall) Results() []interface{} {
+func (c GitserverClientCommitGraphFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// EnqueuerDBStoreDoneFunc describes the behavior when the Done method of
-// the parent MockEnqueuerDBStore instance is invoked.
-type EnqueuerDBStoreDoneFunc struct {
-	defaultHook func(error) error
-	hooks       []func(error) error
-	history     []EnqueuerDBStoreDoneFuncCall
+// GitserverClientListFilesFunc describes the behavior when the ListFiles
+// method of the parent MockGitserverClient instance is invoked.
+type GitserverClientListFilesFunc struct {
+	defaultHook func(context.Context, int, string, *regexp.Regexp) ([]string, error)
+	hooks       []func(context.Context, int, string, *regexp.Regexp) ([]string, error)
+	history     []GitserverClientListFilesFuncCall
 	mutex       sync.Mutex
 }
 
-// Done delegates to the next hook function in the queue and stores the
+// ListFiles delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockEnqueuerDBStore) Done(v0 error) error {
-	r0 := m.DoneFunc.nextHook()(v0)
-	m.DoneFunc.appendCall(EnqueuerDBStoreDoneFuncCall{v0, r0})
-	return r0
+func (m *MockGitserverClient) ListFiles(v0 context.Context, v1 int, v2 string, v3 *regexp.Regexp) ([]string, error) {
+	r0, r1 := m.ListFilesFunc.nextHook()(v0, v1, v2, v3)
+	m.ListFilesFunc.appendCall(GitserverClientListFilesFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Done method of the
-// parent MockEnqueuerDBStore instance is invoked and the hook queue is
+// SetDefaultHook sets function that is called when the ListFiles method of
+// the parent MockGitserverClient instance is invoked and the hook queue is
 // empty.
-func (f *EnqueuerDBStoreDoneFunc) SetDefaultHook(hook func(error) error) {
+func (f *GitserverClientListFilesFunc) SetDefaultHook(hook func(context.Context, int, string, *regexp.Regexp) ([]string, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Done method of the parent MockEnqueuerDBStore instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *EnqueuerDBStoreDoneFunc) PushHook(hook func(error) error) {
+// ListFiles method of the parent MockGitserverClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GitserverClientListFilesFunc) PushHook(hook func(context.Context, int, string, *regexp.Regexp) ([]string, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2834,21 +6340,21 @@ func (f *EnqueuerDBStoreDoneFunc) PushHook(hook func(error) error) {
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *EnqueuerDBStoreDoneFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(error) error {
-		return r0
+func (f *GitserverClientListFilesFunc) SetDefaultReturn(r0 []string, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string, *regexp.Regexp) ([]string, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *EnqueuerDBStoreDoneFunc) PushReturn(r0 error) {
-	f.PushHook(func(error) error {
-		return r0
+func (f *GitserverClientListFilesFunc) PushReturn(r0 []string, r1 error) {
+	f.PushHook(func(context.Context, int, string, *regexp.Regexp) ([]string, error) {
+		return r0, r1
 	})
 }
 
-func (f *EnqueuerDBStoreDoneFunc) nextHook() func(error) error {
+func (f *GitserverClientListFilesFunc) nextHook() func(context.Context, int, string, *regexp.Regexp) ([]string, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2861,78 +6367,87 @@ func (f *EnqueuerDBStoreDoneFunc) nextHook() func(error) error {
 	return hook
 }
 
-func (f *EnqueuerDBStoreDoneFunc) appendCall(r0 EnqueuerDBStoreDoneFuncCall) {
+func (f *GitserverClientListFilesFunc) appendCall(r0 GitserverClientListFilesFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnqueuerDBStoreDoneFuncCall objects
+// History returns a sequence of GitserverClientListFilesFuncCall objects
 // describing the invocations of this function.
-func (f *EnqueuerDBStoreDoneFunc) History() []EnqueuerDBStoreDoneFuncCall {
+func (f *GitserverClientListFilesFunc) History() []GitserverClientListFilesFuncCall {
 	f.mutex.Lock()
-	history := make([]EnqueuerDBStoreDoneFuncCall, len(f.history))
+	history := make([]GitserverClientListFilesFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnqueuerDBStoreDoneFuncCall is an object that describes an invocation of
-// method Done on an instance of MockEnqueuerDBStore.
-type EnqueuerDBStoreDoneFuncCall struct {
+// GitserverClientListFilesFuncCall is an object that describes an
+// invocation of method ListFiles on an instance of MockGitserverClient.
+type GitserverClientListFilesFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 error
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 *regexp.Regexp
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 []string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnqueuerDBStoreDoneFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c GitserverClientListFilesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnqueuerDBStoreDoneFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c GitserverClientListFilesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc describes the
-// behavior when the GetIndexConfigurationByRepositoryID method of the
-// parent MockEnqueuerDBStore instance is invoked.
-type EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc struct {
-	defaultHook func(context.Context, int) (dbstore.IndexConfiguration, bool, error)
-	hooks       []func(context.Context, int) (dbstore.IndexConfiguration, bool, error)
-	history     []EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall
+// GitserverClientMultiDiffFunc describes the behavior when the MultiDiff
+// method of the parent MockGitserverClient instance is invoked.
+type GitserverClientMultiDiffFunc struct {
+	defaultHook func(context.Context, int, []gitserver.DiffRequest) ([][]*diff.Hunk, error)
+	hooks       []func(context.Context, int, []gitserver.DiffRequest) ([][]*diff.Hunk, error)
+	history     []GitserverClientMultiDiffFuncCall
 	mutex       sync.Mutex
 }
 
-// GetIndexConfigurationByRepositoryID delegates to the next hook function
-// in the queue and stores the parameter and result values of this
-// invocation.
-func (m *MockEnqueuerDBStore) GetIndexConfigurationByRepositoryID(v0 context.Context, v1 int) (dbstore.IndexConfiguration, bool, error) {
-	r0, r1, r2 := m.GetIndexConfigurationByRepositoryIDFunc.nextHook()(v0, v1)
-	m.GetIndexConfigurationByRepositoryIDFunc.appendCall(EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall{v0, v1, r0, r1, r2})
-	return r0, r1, r2
+// MultiDiff delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitserverClient) MultiDiff(v0 context.Context, v1 int, v2 []gitserver.DiffRequest) ([][]*diff.Hunk, error) {
+	r0, r1 := m.MultiDiffFunc.nextHook()(v0, v1, v2)
+	m.MultiDiffFunc.appendCall(GitserverClientMultiDiffFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// GetIndexConfigurationByRepositoryID method of the parent
-// MockEnqueuerDBStore instance is invoked and the hook queue is empty.
-func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) SetDefaultHook(hook func(context.Context, int) (dbstore.IndexConfiguration, bool, error)) {
+// SetDefaultHook sets function that is called when the MultiDiff method of
+// the parent MockGitserverClient instance is invoked and the hook queue is
+// empty.
+func (f *GitserverClientMultiDiffFunc) SetDefaultHook(hook func(context.Context, int, []gitserver.DiffRequest) ([][]*diff.Hunk, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetIndexConfigurationByRepositoryID method of the parent
-// MockEnqueuerDBStore instance invokes the hook at the front of the queue
-// and discards it. After the queue is empty, the default hook function is
-// invoked for any future action.
-func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) PushHook(hook func(context.Context, int) (dbstore.IndexConfiguration, bool, error)) {
+// MultiDiff method of the parent MockGitserverClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GitserverClientMultiDiffFunc) PushHook(hook func(context.Context, int, []gitserver.DiffRequest) ([][]*diff.Hunk, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2940,21 +6455,21 @@ func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) PushHook(hook f
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) SetDefaultReturn(r0 dbstore.IndexConfiguration, r1 bool, r2 error) {
-	f.SetDefaultHook(func(context.Context, int) (dbstore.IndexConfiguration, bool, error) {
-		return r0, r1, r2
+func (f *GitserverClientMultiDiffFunc) SetDefaultReturn(r0 [][]*diff.Hunk, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, []gitserver.DiffRequest) ([][]*diff.Hunk, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) PushReturn(r0 dbstore.IndexConfiguration, r1 bool, r2 error) {
-	f.PushHook(func(context.Context, int) (dbstore.IndexConfiguration, bool, error) {
-		return r0, r1, r2
+func (f *GitserverClientMultiDiffFunc) PushReturn(r0 [][]*diff.Hunk, r1 error) {
+	f.PushHook(func(context.Context, int, []gitserver.DiffRequest) ([][]*diff.Hunk, error) {
+		return r0, r1
 	})
 }
 
-func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) nextHook() func(context.Context, int) (dbstore.IndexConfiguration, bool, error) {
+func (f *GitserverClientMultiDiffFunc) nextHook() func(context.Context, int, []gitserver.DiffRequest) ([][]*diff.Hunk, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2967,90 +6482,131 @@ func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) nextHook() func
 	return hook
 }
 
-func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) appendCall(r0 EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall) {
+func (f *GitserverClientMultiDiffFunc) appendCall(r0 GitserverClientMultiDiffFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall objects
+// History returns a sequence of GitserverClientMultiDiffFuncCall objects
 // describing the invocations of this function.
-func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) History() []EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall {
+func (f *GitserverClientMultiDiffFunc) History() []GitserverClientMultiDiffFuncCall {
 	f.mutex.Lock()
-	history := make([]EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall, len(f.history))
+	history := make([]GitserverClientMultiDiffFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall is an object
-// that describes an invocation of method
-// GetIndexConfigurationByRepositoryID on an instance of
-// MockEnqueuerDBStore.
-type EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall struct {
+// GitserverClientMultiDiffFuncCall is an object that describes an
+// invocation of method MultiDiff on an instance of MockGitserverClient.
+type GitserverClientMultiDiffFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
 	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []gitserver.DiffRequest
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 dbstore.IndexConfiguration
+	Result0 [][]*diff.Hunk
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 bool
-	// Result2 is the value of the 3rd result returned from this method
-	// invocation.
-	Result2 error
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c GitserverClientMultiDiffFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2}
+func (c GitserverClientMultiDiffFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFunc describes the
-// behavior when the GetRepositoriesWithIndexConfiguration method of the
-// parent MockEnqueuerDBStore instance is invoked.
-type EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFunc struct {
-	defaultHook func(context.Context) ([]int, error)
-	hooks       []func(context.Context) ([]int, error)
-	history     []EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFuncCall
+// MockIndexEnqueuer is a mock implementation of the IndexEnqueuer interface
+// (from the package
+// github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers)
+// used for unit testing.
+type MockIndexEnqueuer struct {
+	// ForceQueueIndexesForRepositoryFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// ForceQueueIndexesForRepository.
+	ForceQueueIndexesForRepositoryFunc *IndexEnqueuerForceQueueIndexesForRepositoryFunc
+	// InferIndexConfigurationFunc is an instance of a mock function object
+	// controlling the behavior of the method InferIndexConfiguration.
+	InferIndexConfigurationFunc *IndexEnqueuerInferIndexConfigurationFunc
+}
+
+// NewMockIndexEnqueuer creates a new mock of the IndexEnqueuer interface.
+// All methods return zero values for all results, unless overwritten.
+func NewMockIndexEnqueuer() *MockIndexEnqueuer {
+	return &MockIndexEnqueuer{
+		ForceQueueIndexesForRepositoryFunc: &IndexEnqueuerForceQueueIndexesForRepositoryFunc{
+			defaultHook: func(context.Context, int) error {
+				return nil
+			},
+		},
+		InferIndexConfigurationFunc: &IndexEnqueuerInferIndexConfigurationFunc{
+			defaultHook: func(context.Context, int) (*config.IndexConfiguration, error) {
+				return nil, nil
+			},
+		},
+	}
+}
+
+// NewMockIndexEnqueuerFrom creates a new mock of the MockIndexEnqueuer
+// interface. All methods delegate to the given implementation, unless
+// overwritten.
+func NewMockIndexEnqueuerFrom(i IndexEnqueuer) *MockIndexEnqueuer {
+	return &MockIndexEnqueuer{
+		ForceQueueIndexesForRepositoryFunc: &IndexEnqueuerForceQueueIndexesForRepositoryFunc{
+			defaultHook: i.ForceQueueIndexesForRepository,
+		},
+		InferIndexConfigurationFunc: &IndexEnqueuerInferIndexConfigurationFunc{
+			defaultHook: i.InferIndexConfiguration,
+		},
+	}
+}
+
+// IndexEnqueuerForceQueueIndexesForRepositoryFunc describes the behavior
+// when the ForceQueueIndexesForRepository method of the parent
+// MockIndexEnqueuer instance is invoked.
+type IndexEnqueuerForceQueueIndexesForRepositoryFunc struct {
+	defaultHook func(context.Context, int) error
+	hooks       []func(context.Context, int) error
+	history     []IndexEnqueuerForceQueueIndexesForRepositoryFuncCall
 	mutex       sync.Mutex
 }
 
-// GetRepositoriesWithIndexConfiguration delegates to the next hook function
-// in the queue and stores the parameter and result values of this
-// invocation.
-func (m *MockEnqueuerDBStore) GetRepositoriesWithIndexConfiguration(v0 context.Context) ([]int, error) {
-	r0, r1 := m.GetRepositoriesWithIndexConfigurationFunc.nextHook()(v0)
-	m.GetRepositoriesWithIndexConfigurationFunc.appendCall(EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFuncCall{v0, r0, r1})
-	return r0, r1
+// ForceQueueIndexesForRepository delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockIndexEnqueuer) ForceQueueIndexesForRepository(v0 context.Context, v1 int) error {
+	r0 := m.ForceQueueIndexesForRepositoryFunc.nextHook()(v0, v1)
+	m.ForceQueueIndexesForRepositoryFunc.appendCall(IndexEnqueuerForceQueueIndexesForRepositoryFuncCall{v0, v1, r0})
+	return r0
 }
 
 // SetDefaultHook sets function that is called when the
-// GetRepositoriesWithIndexConfiguration method of the parent
-// MockEnqueuerDBStore instance is invoked and the hook queue is empty.
-func (f *EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFunc) SetDefaultHook(hook func(context.Context) ([]int, error)) {
+// ForceQueueIndexesForRepository method of the parent MockIndexEnqueuer
+// instance is invoked and the hook queue is empty.
+func (f *IndexEnqueuerForceQueueIndexesForRepositoryFunc) SetDefaultHook(hook func(context.Context, int) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetRepositoriesWithIndexConfiguration method of the parent
-// MockEnqueuerDBStore instance invokes the hook at the front of the queue
-// and discards it. After the queue is empty, the default hook function is
-// invoked for any future action.
-func (f *EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFunc) PushHook(hook func(context.Context) ([]int, error)) {
+// ForceQueueIndexesForRepository method of the parent MockIndexEnqueuer
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *IndexEnqueuerForceQueueIndexesForRepositoryFunc) PushHook(hook func(context.Context, int) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3058,21 +6614,21 @@ func (f *EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFunc) PushHook(hook
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFunc) SetDefaultReturn(r0 []int, r1 error) {
-	f.SetDefaultHook(func(context.Context) ([]int, error) {
-		return r0, r1
+func (f *IndexEnqueuerForceQueueIndexesForRepositoryFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFunc) PushReturn(r0 []int, r1 error) {
-	f.PushHook(func(context.Context) ([]int, error) {
-		return r0, r1
+func (f *IndexEnqueuerForceQueueIndexesForRepositoryFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int) error {
+		return r0
 	})
 }
 
-func (f *EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFunc) nextHook() func(context.Context) ([]int, error) {
+func (f *IndexEnqueuerForceQueueIndexesForRepositoryFunc) nextHook() func(context.Context, int) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3085,81 +6641,82 @@ func (f *EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFunc) nextHook() fu
 	return hook
 }
 
-func (f *EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFunc) appendCall(r0 EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFuncCall) {
+func (f *IndexEnqueuerForceQueueIndexesForRepositoryFunc) appendCall(r0 IndexEnqueuerForceQueueIndexesForRepositoryFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFuncCall objects
-// describing the invocations of this function.
-func (f *EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFunc) History() []EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFuncCall {
+// IndexEnqueuerForceQueueIndexesForRepositoryFuncCall objects describing
+// the invocations of this function.
+func (f *IndexEnqueuerForceQueueIndexesForRepositoryFunc) History() []IndexEnqueuerForceQueueIndexesForRepositoryFuncCall {
 	f.mutex.Lock()
-	history := make([]EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFuncCall, len(f.history))
+	history := make([]IndexEnqueuerForceQueueIndexesForRepositoryFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFuncCall is an object
-// that describes an invocation of method
-// GetRepositoriesWithIndexConfiguration on an instance of
-// MockEnqueuerDBStore.
-type EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFuncCall struct {
+// IndexEnqueuerForceQueueIndexesForRepositoryFuncCall is an object that
+// describes an invocation of method ForceQueueIndexesForRepository on an
+// instance of MockIndexEnqueuer.
+type IndexEnqueuerForceQueueIndexesForRepositoryFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Result0 is the value of the 1st result returned from this method
+	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Result0 []int
-	// Result1 is the value of the 2nd result returned from this method
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c IndexEnqueuerForceQueueIndexesForRepositoryFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnqueuerDBStoreGetRepositoriesWithIndexConfigurationFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c IndexEnqueuerForceQueueIndexesForRepositoryFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// EnqueuerDBStoreHandleFunc describes the behavior when the Handle method
-// of the parent MockEnqueuerDBStore instance is invoked.
-type EnqueuerDBStoreHandleFunc struct {
-	defaultHook func() *basestore.TransactableHandle
-	hooks       []func() *basestore.TransactableHandle
-	history     []EnqueuerDBStoreHandleFuncCall
+// IndexEnqueuerInferIndexConfigurationFunc describes the behavior when the
+// InferIndexConfiguration method of the parent MockIndexEnqueuer instance
+// is invoked.
+type IndexEnqueuerInferIndexConfigurationFunc struct {
+	defaultHook func(context.Context, int) (*config.IndexConfiguration, error)
+	hooks       []func(context.Context, int) (*config.IndexConfiguration, error)
+	history     []IndexEnqueuerInferIndexConfigurationFuncCall
 	mutex       sync.Mutex
 }
 
-// Handle delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockEnqueuerDBStore) Handle() *basestore.TransactableHandle {
-	r0 := m.HandleFunc.nextHook()()
-	m.HandleFunc.appendCall(EnqueuerDBStoreHandleFuncCall{r0})
-	return r0
+// InferIndexConfiguration delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockIndexEnqueuer) InferIndexConfiguration(v0 context.Context, v1 int) (*config.IndexConfiguration, error) {
+	r0, r1 := m.InferIndexConfigurationFunc.nextHook()(v0, v1)
+	m.InferIndexConfigurationFunc.appendCall(IndexEnqueuerInferIndexConfigurationFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Handle method of the
-// parent MockEnqueuerDBStore instance is invoked and the hook queue is
-// empty.
-func (f *EnqueuerDBStoreHandleFunc) SetDefaultHook(hook func() *basestore.TransactableHandle) {
+// SetDefaultHook sets function that is called when the
+// InferIndexConfiguration method of the parent MockIndexEnqueuer instance
+// is invoked and the hook queue is empty.
+func (f *IndexEnqueuerInferIndexConfigurationFunc) SetDefaultHook(hook func(context.Context, int) (*config.IndexConfiguration, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Handle method of the parent MockEnqueuerDBStore instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *EnqueuerDBStoreHandleFunc) PushHook(hook func() *basestore.TransactableHandle) {
+// InferIndexConfiguration method of the parent MockIndexEnqueuer instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *IndexEnqueuerInferIndexConfigurationFunc) PushHook(hook func(context.Context, int) (*config.IndexConfiguration, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3167,21 +6724,21 @@ func (f *EnqueuerDBStoreHandleFunc) PushHook(hook func() *basestore.Transactable
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *EnqueuerDBStoreHandleFunc) SetDefaultReturn(r0 *basestore.TransactableHandle) {
-	f.SetDefaultHook(func() *basestore.TransactableHandle {
-		return r0
+func (f *IndexEnqueuerInferIndexConfigurationFunc) SetDefaultReturn(r0 *config.IndexConfiguration, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) (*config.IndexConfiguration, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *EnqueuerDBStoreHandleFunc) PushReturn(r0 *basestore.TransactableHandle) {
-	f.PushHook(func() *basestore.TransactableHandle {
-		return r0
+func (f *IndexEnqueuerInferIndexConfigurationFunc) PushReturn(r0 *config.IndexConfiguration, r1 error) {
+	f.PushHook(func(context.Context, int) (*config.IndexConfiguration, error) {
+		return r0, r1
 	})
 }
 
-func (f *EnqueuerDBStoreHandleFunc) nextHook() func() *basestore.TransactableHandle {
+func (f *IndexEnqueuerInferIndexConfigurationFunc) nextHook() func(context.Context, int) (*config.IndexConfiguration, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3194,74 +6751,358 @@ func (f *EnqueuerDBStoreHandleFunc) nextHook() func() *basestore.TransactableHan
 	return hook
 }
 
-func (f *EnqueuerDBStoreHandleFunc) appendCall(r0 EnqueuerDBStoreHandleFuncCall) {
+func (f *IndexEnqueuerInferIndexConfigurationFunc) appendCall(r0 IndexEnqueuerInferIndexConfigurationFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnqueuerDBStoreHandleFuncCall objects
-// describing the invocations of this function.
-func (f *EnqueuerDBStoreHandleFunc) History() []EnqueuerDBStoreHandleFuncCall {
+// History returns a sequence of
+// IndexEnqueuerInferIndexConfigurationFuncCall objects describing the
+// invocations of this function.
+func (f *IndexEnqueuerInferIndexConfigurationFunc) History() []IndexEnqueuerInferIndexConfigurationFuncCall {
 	f.mutex.Lock()
-	history := make([]EnqueuerDBStoreHandleFuncCall, len(f.history))
+	history := make([]IndexEnqueuerInferIndexConfigurationFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnqueuerDBStoreHandleFuncCall is an object that describes an invocation
-// of method Handle on an instance of MockEnqueuerDBStore.
-type EnqueuerDBStoreHandleFuncCall struct {
+// IndexEnqueuerInferIndexConfigurationFuncCall is an object that describes
+// an invocation of method InferIndexConfiguration on an instance of
+// MockIndexEnqueuer.
+type IndexEnqueuerInferIndexConfigurationFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *basestore.TransactableHandle
+	Result0 *config.IndexConfiguration
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnqueuerDBStoreHandleFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c IndexEnqueuerInferIndexConfigurationFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnqueuerDBStoreHandleFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c IndexEnqueuerInferIndexConfigurationFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// MockLSIFStore is a mock implementation of the LSIFStore interface (from
+// the package
+// github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers)
+// used for unit testing.
+type MockLSIFStore struct {
+	// AggregateDiagnosticsFunc is an instance of a mock function object
+	// controlling the behavior of the method AggregateDiagnostics.
+	AggregateDiagnosticsFunc *LSIFStoreAggregateDiagnosticsFunc
+	// BatchDefinitionsFunc is an instance of a mock function object
+	// controlling the behavior of the method BatchDefinitions.
+	BatchDefinitionsFunc *LSIFStoreBatchDefinitionsFunc
+	// BatchDiagnosticsFunc is an instance of a mock function object
+	// controlling the behavior of the method BatchDiagnostics.
+	BatchDiagnosticsFunc *LSIFStoreBatchDiagnosticsFunc
+	// BatchHoverFunc is an instance of a mock function object controlling
+	// the behavior of the method BatchHover.
+	BatchHoverFunc *LSIFStoreBatchHoverFunc
+	// BatchRangesFunc is an instance of a mock function object controlling
+	// the behavior of the method BatchRanges.
+	BatchRangesFunc *LSIFStoreBatchRangesFunc
+	// BulkMonikerResultsFunc is an instance of a mock function object
+	// controlling the behavior of the method BulkMonikerResults.
+	BulkMonikerResultsFunc *LSIFStoreBulkMonikerResultsFunc
+	// BulkMonikerResultsCountFunc is an instance of a mock function object
+	// controlling the behavior of the method BulkMonikerResultsCount.
+	BulkMonikerResultsCountFunc *LSIFStoreBulkMonikerResultsCountFunc
+	// DefinitionsFunc is an instance of a mock function object controlling
+	// the behavior of the method Definitions.
+	DefinitionsFunc *LSIFStoreDefinitionsFunc
+	// DiagnosticsFunc is an instance of a mock function object controlling
+	// the behavior of the method Diagnostics.
+	DiagnosticsFunc *LSIFStoreDiagnosticsFunc
+	// DocumentPathsFunc is an instance of a mock function object
+	// controlling the behavior of the method DocumentPaths.
+	DocumentPathsFunc *LSIFStoreDocumentPathsFunc
+	// DocumentSymbolsFunc is an instance of a mock function object
+	// controlling the behavior of the method DocumentSymbols.
+	DocumentSymbolsFunc *LSIFStoreDocumentSymbolsFunc
+	// DocumentationAtPositionFunc is an instance of a mock function object
+	// controlling the behavior of the method DocumentationAtPosition.
+	DocumentationAtPositionFunc *LSIFStoreDocumentationAtPositionFunc
+	// DocumentationPageFunc is an instance of a mock function object
+	// controlling the behavior of the method DocumentationPage.
+	DocumentationPageFunc *LSIFStoreDocumentationPageFunc
+	// ExistsFunc is an instance of a mock function object controlling the
+	// behavior of the method Exists.
+	ExistsFunc *LSIFStoreExistsFunc
+	// HoverFunc is an instance of a mock function object controlling the
+	// behavior of the method Hover.
+	HoverFunc *LSIFStoreHoverFunc
+	// ImplementationsFunc is an instance of a mock function object
+	// controlling the behavior of the method Implementations.
+	ImplementationsFunc *LSIFStoreImplementationsFunc
+	// MonikersByPositionFunc is an instance of a mock function object
+	// controlling the behavior of the method MonikersByPosition.
+	MonikersByPositionFunc *LSIFStoreMonikersByPositionFunc
+	// PackageInformationFunc is an instance of a mock function object
+	// controlling the behavior of the method PackageInformation.
+	PackageInformationFunc *LSIFStorePackageInformationFunc
+	// RangesFunc is an instance of a mock function object controlling the
+	// behavior of the method Ranges.
+	RangesFunc *LSIFStoreRangesFunc
+	// ReferenceCountFunc is an instance of a mock function object
+	// controlling the behavior of the method ReferenceCount.
+	ReferenceCountFunc *LSIFStoreReferenceCountFunc
+	// ReferencesFunc is an instance of a mock function object controlling
+	// the behavior of the method References.
+	ReferencesFunc *LSIFStoreReferencesFunc
+	// SymbolsFunc is an instance of a mock function object controlling the
+	// behavior of the method Symbols.
+	SymbolsFunc *LSIFStoreSymbolsFunc
+	// WriteAccessReferencesFunc is an instance of a mock function object
+	// controlling the behavior of the method WriteAccessReferences.
+	WriteAccessReferencesFunc *LSIFStoreWriteAccessReferencesFunc
+}
+
+// NewMockLSIFStore creates a new mock of the LSIFStore interface. All
+// methods return zero values for all results, unless overwritten.
+func NewMockLSIFStore() *MockLSIFStore {
+	return &MockLSIFStore{
+		AggregateDiagnosticsFunc: &LSIFStoreAggregateDiagnosticsFunc{
+			defaultHook: func(context.Context, []int, lsifstore.AggregateDiagnosticsOptions) ([]lsifstore.Diagnostic, int, []lsifstore.DiagnosticGroup, error) {
+				return nil, 0, nil, nil
+			},
+		},
+		BatchDefinitionsFunc: &LSIFStoreBatchDefinitionsFunc{
+			defaultHook: func(context.Context, []lsifstore.PositionKey, int) ([][]lsifstore.Location, error) {
+				return nil, nil
+			},
+		},
+		BatchDiagnosticsFunc: &LSIFStoreBatchDiagnosticsFunc{
+			defaultHook: func(context.Context, []lsifstore.DocumentKey, int) ([][]lsifstore.Diagnostic, []int, error) {
+				return nil, nil, nil
+			},
+		},
+		BatchHoverFunc: &LSIFStoreBatchHoverFunc{
+			defaultHook: func(context.Context, []lsifstore.PositionKey, lsifstore.HoverFormat) ([]lsifstore.HoverResult, error) {
+				return nil, nil
+			},
+		},
+		BatchRangesFunc: &LSIFStoreBatchRangesFunc{
+			defaultHook: func(context.Context, []lsifstore.DocumentKey, int, int) ([][]lsifstore.CodeIntelligenceRange, error) {
+				return nil, nil
+			},
+		},
+		BulkMonikerResultsFunc: &LSIFStoreBulkMonikerResultsFunc{
+			defaultHook: func(context.Context, string, []int, []semantic.MonikerData, int, int) ([]lsifstore.Location, int, error) {
+				return nil, 0, nil
+			},
+		},
+		BulkMonikerResultsCountFunc: &LSIFStoreBulkMonikerResultsCountFunc{
+			defaultHook: func(context.Context, string, []int, []semantic.MonikerData) (int, error) {
+				return 0, nil
+			},
+		},
+		DefinitionsFunc: &LSIFStoreDefinitionsFunc{
+			defaultHook: func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+				return nil, 0, nil
+			},
+		},
+		DiagnosticsFunc: &LSIFStoreDiagnosticsFunc{
+			defaultHook: func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error) {
+				return nil, 0, nil
+			},
+		},
+		DocumentPathsFunc: &LSIFStoreDocumentPathsFunc{
+			defaultHook: func(context.Context, int) ([]string, error) {
+				return nil, nil
+			},
+		},
+		DocumentSymbolsFunc: &LSIFStoreDocumentSymbolsFunc{
+			defaultHook: func(context.Context, int, string) ([]lsifstore.DocumentSymbol, error) {
+				return nil, nil
+			},
+		},
+		DocumentationAtPositionFunc: &LSIFStoreDocumentationAtPositionFunc{
+			defaultHook: func(context.Context, int, string, int, int) ([]lsifstore.DocumentationLink, error) {
+				return nil, nil
+			},
+		},
+		DocumentationPageFunc: &LSIFStoreDocumentationPageFunc{
+			defaultHook: func(context.Context, int, string) (*semantic.DocumentationPageData, error) {
+				return nil, nil
+			},
+		},
+		ExistsFunc: &LSIFStoreExistsFunc{
+			defaultHook: func(context.Context, int, string) (bool, error) {
+				return false, nil
+			},
+		},
+		HoverFunc: &LSIFStoreHoverFunc{
+			defaultHook: func(context.Context, int, string, int, int, lsifstore.HoverFormat) (string, lsifstore.Range, bool, error) {
+				return "", lsifstore.Range{}, false, nil
+			},
+		},
+		ImplementationsFunc: &LSIFStoreImplementationsFunc{
+			defaultHook: func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+				return nil, 0, nil
+			},
+		},
+		MonikersByPositionFunc: &LSIFStoreMonikersByPositionFunc{
+			defaultHook: func(context.Context, int, string, int, int) ([][]semantic.MonikerData, error) {
+				return nil, nil
+			},
+		},
+		PackageInformationFunc: &LSIFStorePackageInformationFunc{
+			defaultHook: func(context.Context, int, string, string) (semantic.PackageInformationData, bool, error) {
+				return semantic.PackageInformationData{}, false, nil
+			},
+		},
+		RangesFunc: &LSIFStoreRangesFunc{
+			defaultHook: func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error) {
+				return nil, nil
+			},
+		},
+		ReferenceCountFunc: &LSIFStoreReferenceCountFunc{
+			defaultHook: func(context.Context, int, string, int, int) (int, error) {
+				return 0, nil
+			},
+		},
+		ReferencesFunc: &LSIFStoreReferencesFunc{
+			defaultHook: func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+				return nil, 0, nil
+			},
+		},
+		SymbolsFunc: &LSIFStoreSymbolsFunc{
+			defaultHook: func(context.Context, []int, string, int) ([]lsifstore.Symbol, error) {
+				return nil, nil
+			},
+		},
+		WriteAccessReferencesFunc: &LSIFStoreWriteAccessReferencesFunc{
+			defaultHook: func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+				return nil, 0, nil
+			},
+		},
+	}
+}
+
+// NewMockLSIFStoreFrom creates a new mock of the MockLSIFStore interface.
+// All methods delegate to the given implementation, unless overwritten.
+func NewMockLSIFStoreFrom(i LSIFStore) *MockLSIFStore {
+	return &MockLSIFStore{
+		AggregateDiagnosticsFunc: &LSIFStoreAggregateDiagnosticsFunc{
+			defaultHook: i.AggregateDiagnostics,
+		},
+		BatchDefinitionsFunc: &LSIFStoreBatchDefinitionsFunc{
+			defaultHook: i.BatchDefinitions,
+		},
+		BatchDiagnosticsFunc: &LSIFStoreBatchDiagnosticsFunc{
+			defaultHook: i.BatchDiagnostics,
+		},
+		BatchHoverFunc: &LSIFStoreBatchHoverFunc{
+			defaultHook: i.BatchHover,
+		},
+		BatchRangesFunc: &LSIFStoreBatchRangesFunc{
+			defaultHook: i.BatchRanges,
+		},
+		BulkMonikerResultsFunc: &LSIFStoreBulkMonikerResultsFunc{
+			defaultHook: i.BulkMonikerResults,
+		},
+		BulkMonikerResultsCountFunc: &LSIFStoreBulkMonikerResultsCountFunc{
+			defaultHook: i.BulkMonikerResultsCount,
+		},
+		DefinitionsFunc: &LSIFStoreDefinitionsFunc{
+			defaultHook: i.Definitions,
+		},
+		DiagnosticsFunc: &LSIFStoreDiagnosticsFunc{
+			defaultHook: i.Diagnostics,
+		},
+		DocumentPathsFunc: &LSIFStoreDocumentPathsFunc{
+			defaultHook: i.DocumentPaths,
+		},
+		DocumentSymbolsFunc: &LSIFStoreDocumentSymbolsFunc{
+			defaultHook: i.DocumentSymbols,
+		},
+		DocumentationAtPositionFunc: &LSIFStoreDocumentationAtPositionFunc{
+			defaultHook: i.DocumentationAtPosition,
+		},
+		DocumentationPageFunc: &LSIFStoreDocumentationPageFunc{
+			defaultHook: i.DocumentationPage,
+		},
+		ExistsFunc: &LSIFStoreExistsFunc{
+			defaultHook: i.Exists,
+		},
+		HoverFunc: &LSIFStoreHoverFunc{
+			defaultHook: i.Hover,
+		},
+		ImplementationsFunc: &LSIFStoreImplementationsFunc{
+			defaultHook: i.Implementations,
+		},
+		MonikersByPositionFunc: &LSIFStoreMonikersByPositionFunc{
+			defaultHook: i.MonikersByPosition,
+		},
+		PackageInformationFunc: &LSIFStorePackageInformationFunc{
+			defaultHook: i.PackageInformation,
+		},
+		RangesFunc: &LSIFStoreRangesFunc{
+			defaultHook: i.Ranges,
+		},
+		ReferenceCountFunc: &LSIFStoreReferenceCountFunc{
+			defaultHook: i.ReferenceCount,
+		},
+		ReferencesFunc: &LSIFStoreReferencesFunc{
+			defaultHook: i.References,
+		},
+		SymbolsFunc: &LSIFStoreSymbolsFunc{
+			defaultHook: i.Symbols,
+		},
+		WriteAccessReferencesFunc: &LSIFStoreWriteAccessReferencesFunc{
+			defaultHook: i.WriteAccessReferences,
+		},
+	}
 }
 
-// EnqueuerDBStoreIndexableRepositoriesFunc describes the behavior when the
-// IndexableRepositories method of the parent MockEnqueuerDBStore instance
-// is invoked.
-type EnqueuerDBStoreIndexableRepositoriesFunc struct {
-	defaultHook func(context.Context, dbstore.IndexableRepositoryQueryOptions) ([]dbstore.IndexableRepository, error)
-	hooks       []func(context.Context, dbstore.IndexableRepositoryQueryOptions) ([]dbstore.IndexableRepository, error)
-	history     []EnqueuerDBStoreIndexableRepositoriesFuncCall
+// LSIFStoreAggregateDiagnosticsFunc describes the behavior when the
+// AggregateDiagnostics method of the parent MockLSIFStore instance is
+// invoked.
+type LSIFStoreAggregateDiagnosticsFunc struct {
+	defaultHook func(context.Context, []int, lsifstore.AggregateDiagnosticsOptions) ([]lsifstore.Diagnostic, int, []lsifstore.DiagnosticGroup, error)
+	hooks       []func(context.Context, []int, lsifstore.AggregateDiagnosticsOptions) ([]lsifstore.Diagnostic, int, []lsifstore.DiagnosticGroup, error)
+	history     []LSIFStoreAggregateDiagnosticsFuncCall
 	mutex       sync.Mutex
 }
 
-// IndexableRepositories delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockEnqueuerDBStore) IndexableRepositories(v0 context.Context, v1 dbstore.IndexableRepositoryQueryOptions) ([]dbstore.IndexableRepository, error) {
-	r0, r1 := m.IndexableRepositoriesFunc.nextHook()(v0, v1)
-	m.IndexableRepositoriesFunc.appendCall(EnqueuerDBStoreIndexableRepositoriesFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// AggregateDiagnostics delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockLSIFStore) AggregateDiagnostics(v0 context.Context, v1 []int, v2 lsifstore.AggregateDiagnosticsOptions) ([]lsifstore.Diagnostic, int, []lsifstore.DiagnosticGroup, error) {
+	r0, r1, r2, r3 := m.AggregateDiagnosticsFunc.nextHook()(v0, v1, v2)
+	m.AggregateDiagnosticsFunc.appendCall(LSIFStoreAggregateDiagnosticsFuncCall{v0, v1, v2, r0, r1, r2, r3})
+	return r0, r1, r2, r3
 }
 
-// SetDefaultHook sets function that is called when the
-// IndexableRepositories method of the parent MockEnqueuerDBStore instance
-// is invoked and the hook queue is empty.
-func (f *EnqueuerDBStoreIndexableRepositoriesFunc) SetDefaultHook(hook func(context.Context, dbstore.IndexableRepositoryQueryOptions) ([]dbstore.IndexableRepository, error)) {
+// SetDefaultHook sets function that is called when the AggregateDiagnostics
+// method of the parent MockLSIFStore instance is invoked and the hook queue
+// is empty.
+func (f *LSIFStoreAggregateDiagnosticsFunc) SetDefaultHook(hook func(context.Context, []int, lsifstore.AggregateDiagnosticsOptions) ([]lsifstore.Diagnostic, int, []lsifstore.DiagnosticGroup, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// IndexableRepositories method of the parent MockEnqueuerDBStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *EnqueuerDBStoreIndexableRepositoriesFunc) PushHook(hook func(context.Context, dbstore.IndexableRepositoryQueryOptions) ([]dbstore.IndexableRepository, error)) {
+// AggregateDiagnostics method of the parent MockLSIFStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *LSIFStoreAggregateDiagnosticsFunc) PushHook(hook func(context.Context, []int, lsifstore.AggregateDiagnosticsOptions) ([]lsifstore.Diagnostic, int, []lsifstore.DiagnosticGroup, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3269,21 +7110,21 @@ func (f *EnqueuerDBStoreIndexableRepositoriesFunc) PushHook(hook func(context.Co
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *EnqueuerDBStoreIndexableRepositoriesFunc) SetDefaultReturn(r0 []dbstore.IndexableRepository, r1 error) {
-	f.SetDefaultHook(func(context.Context, dbstore.IndexableRepositoryQueryOptions) ([]dbstore.IndexableRepository, error) {
-		return r0, r1
+func (f *LSIFStoreAggregateDiagnosticsFunc) SetDefaultReturn(r0 []lsifstore.Diagnostic, r1 int, r2 []lsifstore.DiagnosticGroup, r3 error) {
+	f.SetDefaultHook(func(context.Context, []int, lsifstore.AggregateDiagnosticsOptions) ([]lsifstore.Diagnostic, int, []lsifstore.DiagnosticGroup, error) {
+		return r0, r1, r2, r3
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *EnqueuerDBStoreIndexableRepositoriesFunc) PushReturn(r0 []dbstore.IndexableRepository, r1 error) {
-	f.PushHook(func(context.Context, dbstore.IndexableRepositoryQueryOptions) ([]dbstore.IndexableRepository, error) {
-		return r0, r1
+func (f *LSIFStoreAggregateDiagnosticsFunc) PushReturn(r0 []lsifstore.Diagnostic, r1 int, r2 []lsifstore.DiagnosticGroup, r3 error) {
+	f.PushHook(func(context.Context, []int, lsifstore.AggregateDiagnosticsOptions) ([]lsifstore.Diagnostic, int, []lsifstore.DiagnosticGroup, error) {
+		return r0, r1, r2, r3
 	})
 }
 
-func (f *EnqueuerDBStoreIndexableRepositoriesFunc) nextHook() func(context.Context, dbstore.IndexableRepositoryQueryOptions) ([]dbstore.IndexableRepository, error) {
+func (f *LSIFStoreAggregateDiagnosticsFunc) nextHook() func(context.Context, []int, lsifstore.AggregateDiagnosticsOptions) ([]lsifstore.Diagnostic, int, []lsifstore.DiagnosticGroup, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3296,83 +7137,91 @@ func (f *EnqueuerDBStoreIndexableRepositoriesFunc) nextHook() func(context.Conte
 	return hook
 }
 
-func (f *EnqueuerDBStoreIndexableRepositoriesFunc) appendCall(r0 EnqueuerDBStoreIndexableRepositoriesFuncCall) {
+func (f *LSIFStoreAggregateDiagnosticsFunc) appendCall(r0 LSIFStoreAggregateDiagnosticsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// EnqueuerDBStoreIndexableRepositoriesFuncCall objects describing the
-// invocations of this function.
-func (f *EnqueuerDBStoreIndexableRepositoriesFunc) History() []EnqueuerDBStoreIndexableRepositoriesFuncCall {
+// History returns a sequence of LSIFStoreAggregateDiagnosticsFuncCall
+// objects describing the invocations of this function.
+func (f *LSIFStoreAggregateDiagnosticsFunc) History() []LSIFStoreAggregateDiagnosticsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnqueuerDBStoreIndexableRepositoriesFuncCall, len(f.history))
+	history := make([]LSIFStoreAggregateDiagnosticsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnqueuerDBStoreIndexableRepositoriesFuncCall is an object that describes
-// an invocation of method IndexableRepositories on an instance of
-// MockEnqueuerDBStore.
-type EnqueuerDBStoreIndexableRepositoriesFuncCall struct {
+// LSIFStoreAggregateDiagnosticsFuncCall is an object that describes an
+// invocation of method AggregateDiagnostics on an instance of
+// MockLSIFStore.
+type LSIFStoreAggregateDiagnosticsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 dbstore.IndexableRepositoryQueryOptions
+	Arg1 []int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 lsifstore.AggregateDiagnosticsOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []dbstore.IndexableRepository
+	Result0 []lsifstore.Diagnostic
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 error
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 []lsifstore.DiagnosticGroup
+	// Result3 is the value of the 4th result returned from this method
+	// invocation.
+	Result3 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnqueuerDBStoreIndexableRepositoriesFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c LSIFStoreAggregateDiagnosticsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnqueuerDBStoreIndexableRepositoriesFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c LSIFStoreAggregateDiagnosticsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2, c.Result3}
 }
 
-// EnqueuerDBStoreInsertIndexFunc describes the behavior when the
-// InsertIndex method of the parent MockEnqueuerDBStore instance is invoked.
-type EnqueuerDBStoreInsertIndexFunc struct {
-	defaultHook func(context.Context, dbstore.Index) (int, error)
-	hooks       []func(context.Context, dbstore.Index) (int, error)
-	history     []EnqueuerDBStoreInsertIndexFuncCall
+// LSIFStoreBatchDefinitionsFunc describes the behavior when the
+// BatchDefinitions method of the parent MockLSIFStore instance is invoked.
+type LSIFStoreBatchDefinitionsFunc struct {
+	defaultHook func(context.Context, []lsifstore.PositionKey, int) ([][]lsifstore.Location, error)
+	hooks       []func(context.Context, []lsifstore.PositionKey, int) ([][]lsifstore.Location, error)
+	history     []LSIFStoreBatchDefinitionsFuncCall
 	mutex       sync.Mutex
 }
 
-// InsertIndex delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockEnqueuerDBStore) InsertIndex(v0 context.Context, v1 dbstore.Index) (int, error) {
-	r0, r1 := m.InsertIndexFunc.nextHook()(v0, v1)
-	m.InsertIndexFunc.appendCall(EnqueuerDBStoreInsertIndexFuncCall{v0, v1, r0, r1})
+// BatchDefinitions delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockLSIFStore) BatchDefinitions(v0 context.Context, v1 []lsifstore.PositionKey, v2 int) ([][]lsifstore.Location, error) {
+	r0, r1 := m.BatchDefinitionsFunc.nextHook()(v0, v1, v2)
+	m.BatchDefinitionsFunc.appendCall(LSIFStoreBatchDefinitionsFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the InsertIndex method
-// of the parent MockEnqueuerDBStore instance is invoked and the hook queue
+// SetDefaultHook sets function that is called when the BatchDefinitions
+// method of the parent MockLSIFStore instance is invoked and the hook queue
 // is empty.
-func (f *EnqueuerDBStoreInsertIndexFunc) SetDefaultHook(hook func(context.Context, dbstore.Index) (int, error)) {
+func (f *LSIFStoreBatchDefinitionsFunc) SetDefaultHook(hook func(context.Context, []lsifstore.PositionKey, int) ([][]lsifstore.Location, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// InsertIndex method of the parent MockEnqueuerDBStore instance invokes the
+// BatchDefinitions method of the parent MockLSIFStore instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *EnqueuerDBStoreInsertIndexFunc) PushHook(hook func(context.Context, dbstore.Index) (int, error)) {
+func (f *LSIFStoreBatchDefinitionsFunc) PushHook(hook func(context.Context, []lsifstore.PositionKey, int) ([][]lsifstore.Location, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3380,21 +7229,21 @@ func (f *EnqueuerDBStoreInsertIndexFunc) PushHook(hook func(context.Context, dbs
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *EnqueuerDBStoreInsertIndexFunc) SetDefaultReturn(r0 int, r1 error) {
-	f.SetDefaultHook(func(context.Context, dbstore.Index) (int, error) {
+func (f *LSIFStoreBatchDefinitionsFunc) SetDefaultReturn(r0 [][]lsifstore.Location, r1 error) {
+	f.SetDefaultHook(func(context.Context, []lsifstore.PositionKey, int) ([][]lsifstore.Location, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *EnqueuerDBStoreInsertIndexFunc) PushReturn(r0 int, r1 error) {
-	f.PushHook(func(context.Context, dbstore.Index) (int, error) {
+func (f *LSIFStoreBatchDefinitionsFunc) PushReturn(r0 [][]lsifstore.Location, r1 error) {
+	f.PushHook(func(context.Context, []lsifstore.PositionKey, int) ([][]lsifstore.Location, error) {
 		return r0, r1
 	})
 }
 
-func (f *EnqueuerDBStoreInsertIndexFunc) nextHook() func(context.Context, dbstore.Index) (int, error) {
+func (f *LSIFStoreBatchDefinitionsFunc) nextHook() func(context.Context, []lsifstore.PositionKey, int) ([][]lsifstore.Location, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3407,35 +7256,38 @@ func (f *EnqueuerDBStoreInsertIndexFunc) nextHook() func(context.Context, dbstor
 	return hook
 }
 
-func (f *EnqueuerDBStoreInsertIndexFunc) appendCall(r0 EnqueuerDBStoreInsertIndexFuncCall) {
+func (f *LSIFStoreBatchDefinitionsFunc) appendCall(r0 LSIFStoreBatchDefinitionsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnqueuerDBStoreInsertIndexFuncCall objects
+// History returns a sequence of LSIFStoreBatchDefinitionsFuncCall objects
 // describing the invocations of this function.
-func (f *EnqueuerDBStoreInsertIndexFunc) History() []EnqueuerDBStoreInsertIndexFuncCall {
+func (f *LSIFStoreBatchDefinitionsFunc) History() []LSIFStoreBatchDefinitionsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnqueuerDBStoreInsertIndexFuncCall, len(f.history))
+	history := make([]LSIFStoreBatchDefinitionsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnqueuerDBStoreInsertIndexFuncCall is an object that describes an
-// invocation of method InsertIndex on an instance of MockEnqueuerDBStore.
-type EnqueuerDBStoreInsertIndexFuncCall struct {
+// LSIFStoreBatchDefinitionsFuncCall is an object that describes an
+// invocation of method BatchDefinitions on an instance of MockLSIFStore.
+type LSIFStoreBatchDefinitionsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 dbstore.Index
+	Arg1 []lsifstore.PositionKey
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 int
+	Result0 [][]lsifstore.Location
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -3443,45 +7295,45 @@ type EnqueuerDBStoreInsertIndexFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnqueuerDBStoreInsertIndexFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c LSIFStoreBatchDefinitionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnqueuerDBStoreInsertIndexFuncCall) Results() []interface{} {
+func (c LSIFStoreBatchDefinitionsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// EnqueuerDBStoreIsQueuedFunc describes the behavior when the IsQueued
-// method of the parent MockEnqueuerDBStore instance is invoked.
-type EnqueuerDBStoreIsQueuedFunc struct {
-	defaultHook func(context.Context, int, string) (bool, error)
-	hooks       []func(context.Context, int, string) (bool, error)
-	history     []EnqueuerDBStoreIsQueuedFuncCall
+// LSIFStoreBatchDiagnosticsFunc describes the behavior when the
+// BatchDiagnostics method of the parent MockLSIFStore instance is invoked.
+type LSIFStoreBatchDiagnosticsFunc struct {
+	defaultHook func(context.Context, []lsifstore.DocumentKey, int) ([][]lsifstore.Diagnostic, []int, error)
+	hooks       []func(context.Context, []lsifstore.DocumentKey, int) ([][]lsifstore.Diagnostic, []int, error)
+	history     []LSIFStoreBatchDiagnosticsFuncCall
 	mutex       sync.Mutex
 }
 
-// IsQueued delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockEnqueuerDBStore) IsQueued(v0 context.Context, v1 int, v2 string) (bool, error) {
-	r0, r1 := m.IsQueuedFunc.nextHook()(v0, v1, v2)
-	m.IsQueuedFunc.appendCall(EnqueuerDBStoreIsQueuedFuncCall{v0, v1, v2, r0, r1})
-	return r0, r1
+// BatchDiagnostics delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockLSIFStore) BatchDiagnostics(v0 context.Context, v1 []lsifstore.DocumentKey, v2 int) ([][]lsifstore.Diagnostic, []int, error) {
+	r0, r1, r2 := m.BatchDiagnosticsFunc.nextHook()(v0, v1, v2)
+	m.BatchDiagnosticsFunc.appendCall(LSIFStoreBatchDiagnosticsFuncCall{v0, v1, v2, r0, r1, r2})
+	return r0, r1, r2
 }
 
-// SetDefaultHook sets function that is called when the IsQueued method of
-// the parent MockEnqueuerDBStore instance is invoked and the hook queue is
-// empty.
-func (f *EnqueuerDBStoreIsQueuedFunc) SetDefaultHook(hook func(context.Context, int, string) (bool, error)) {
+// SetDefaultHook sets function that is called when the BatchDiagnostics
+// method of the parent MockLSIFStore instance is invoked and the hook queue
+// is empty.
+func (f *LSIFStoreBatchDiagnosticsFunc) SetDefaultHook(hook func(context.Context, []lsifstore.DocumentKey, int) ([][]lsifstore.Diagnostic, []int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// IsQueued method of the parent MockEnqueuerDBStore instance invokes the
+// BatchDiagnostics method of the parent MockLSIFStore instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *EnqueuerDBStoreIsQueuedFunc) PushHook(hook func(context.Context, int, string) (bool, error)) {
+func (f *LSIFStoreBatchDiagnosticsFunc) PushHook(hook func(context.Context, []lsifstore.DocumentKey, int) ([][]lsifstore.Diagnostic, []int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3489,21 +7341,21 @@ func (f *EnqueuerDBStoreIsQueuedFunc) PushHook(hook func(context.Context, int, s
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *EnqueuerDBStoreIsQueuedFunc) SetDefaultReturn(r0 bool, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, string) (bool, error) {
-		return r0, r1
+func (f *LSIFStoreBatchDiagnosticsFunc) SetDefaultReturn(r0 [][]lsifstore.Diagnostic, r1 []int, r2 error) {
+	f.SetDefaultHook(func(context.Context, []lsifstore.DocumentKey, int) ([][]lsifstore.Diagnostic, []int, error) {
+		return r0, r1, r2
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *EnqueuerDBStoreIsQueuedFunc) PushReturn(r0 bool, r1 error) {
-	f.PushHook(func(context.Context, int, string) (bool, error) {
-		return r0, r1
+func (f *LSIFStoreBatchDiagnosticsFunc) PushReturn(r0 [][]lsifstore.Diagnostic, r1 []int, r2 error) {
+	f.PushHook(func(context.Context, []lsifstore.DocumentKey, int) ([][]lsifstore.Diagnostic, []int, error) {
+		return r0, r1, r2
 	})
 }
 
-func (f *EnqueuerDBStoreIsQueuedFunc) nextHook() func(context.Context, int, string) (bool, error) {
+func (f *LSIFStoreBatchDiagnosticsFunc) nextHook() func(context.Context, []lsifstore.DocumentKey, int) ([][]lsifstore.Diagnostic, []int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3516,86 +7368,86 @@ func (f *EnqueuerDBStoreIsQueuedFunc) nextHook() func(context.Context, int, stri
 	return hook
 }
 
-func (f *EnqueuerDBStoreIsQueuedFunc) appendCall(r0 EnqueuerDBStoreIsQueuedFuncCall) {
+func (f *LSIFStoreBatchDiagnosticsFunc) appendCall(r0 LSIFStoreBatchDiagnosticsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnqueuerDBStoreIsQueuedFuncCall objects
+// History returns a sequence of LSIFStoreBatchDiagnosticsFuncCall objects
 // describing the invocations of this function.
-func (f *EnqueuerDBStoreIsQueuedFunc) History() []EnqueuerDBStoreIsQueuedFuncCall {
+func (f *LSIFStoreBatchDiagnosticsFunc) History() []LSIFStoreBatchDiagnosticsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnqueuerDBStoreIsQueuedFuncCall, len(f.history))
+	history := make([]LSIFStoreBatchDiagnosticsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnqueuerDBStoreIsQueuedFuncCall is an object that describes an invocation
-// of method IsQueued on an instance of MockEnqueuerDBStore.
-type EnqueuerDBStoreIsQueuedFuncCall struct {
+// LSIFStoreBatchDiagnosticsFuncCall is an object that describes an
+// invocation of method BatchDiagnostics on an instance of MockLSIFStore.
+type LSIFStoreBatchDiagnosticsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int
+	Arg1 []lsifstore.DocumentKey
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 string
+	Arg2 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 bool
+	Result0 [][]lsifstore.Diagnostic
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 error
+	Result1 []int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnqueuerDBStoreIsQueuedFuncCall) Args() []interface{} {
+func (c LSIFStoreBatchDiagnosticsFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnqueuerDBStoreIsQueuedFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c LSIFStoreBatchDiagnosticsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// EnqueuerDBStoreRepoUsageStatisticsFunc describes the behavior when the
-// RepoUsageStatistics method of the parent MockEnqueuerDBStore instance is
-// invoked.
-type EnqueuerDBStoreRepoUsageStatisticsFunc struct {
-	defaultHook func(context.Context) ([]dbstore.RepoUsageStatistics, error)
-	hooks       []func(context.Context) ([]dbstore.RepoUsageStatistics, error)
-	history     []EnqueuerDBStoreRepoUsageStatisticsFuncCall
+// LSIFStoreBatchHoverFunc describes the behavior when the BatchHover method
+// of the parent MockLSIFStore instance is invoked.
+type LSIFStoreBatchHoverFunc struct {
+	defaultHook func(context.Context, []lsifstore.PositionKey, lsifstore.HoverFormat) ([]lsifstore.HoverResult, error)
+	hooks       []func(context.Context, []lsifstore.PositionKey, lsifstore.HoverFormat) ([]lsifstore.HoverResult, error)
+	history     []LSIFStoreBatchHoverFuncCall
 	mutex       sync.Mutex
 }
 
-// RepoUsageStatistics delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockEnqueuerDBStore) RepoUsageStatistics(v0 context.Context) ([]dbstore.RepoUsageStatistics, error) {
-	r0, r1 := m.RepoUsageStatisticsFunc.nextHook()(v0)
-	m.RepoUsageStatisticsFunc.appendCall(EnqueuerDBStoreRepoUsageStatisticsFuncCall{v0, r0, r1})
+// BatchHover delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockLSIFStore) BatchHover(v0 context.Context, v1 []lsifstore.PositionKey, v2 lsifstore.HoverFormat) ([]lsifstore.HoverResult, error) {
+	r0, r1 := m.BatchHoverFunc.nextHook()(v0, v1, v2)
+	m.BatchHoverFunc.appendCall(LSIFStoreBatchHoverFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the RepoUsageStatistics
-// method of the parent MockEnqueuerDBStore instance is invoked and the hook
-// queue is empty.
-func (f *EnqueuerDBStoreRepoUsageStatisticsFunc) SetDefaultHook(hook func(context.Context) ([]dbstore.RepoUsageStatistics, error)) {
+// SetDefaultHook sets function that is called when the BatchHover method of
+// the parent MockLSIFStore instance is invoked and the hook queue is empty.
+func (f *LSIFStoreBatchHoverFunc) SetDefaultHook(hook func(context.Context, []lsifstore.PositionKey, lsifstore.HoverFormat) ([]lsifstore.HoverResult, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// RepoUsageStatistics method of the parent MockEnqueuerDBStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *EnqueuerDBStoreRepoUsageStatisticsFunc) PushHook(hook func(context.Context) ([]dbstore.RepoUsageStatistics, error)) {
+// BatchHover method of the parent MockLSIFStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *LSIFStoreBatchHoverFunc) PushHook(hook func(context.Context, []lsifstore.PositionKey, lsifstore.HoverFormat) ([]lsifstore.HoverResult, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3603,21 +7455,21 @@ func (f *EnqueuerDBStoreRepoUsageStatisticsFunc) PushHook(hook func(context.Cont
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *EnqueuerDBStoreRepoUsageStatisticsFunc) SetDefaultReturn(r0 []dbstore.RepoUsageStatistics, r1 error) {
-	f.SetDefaultHook(func(context.Context) ([]dbstore.RepoUsageStatistics, error) {
+func (f *LSIFStoreBatchHoverFunc) SetDefaultReturn(r0 []lsifstore.HoverResult, r1 error) {
+	f.SetDefaultHook(func(context.Context, []lsifstore.PositionKey, lsifstore.HoverFormat) ([]lsifstore.HoverResult, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *EnqueuerDBStoreRepoUsageStatisticsFunc) PushReturn(r0 []dbstore.RepoUsageStatistics, r1 error) {
-	f.PushHook(func(context.Context) ([]dbstore.RepoUsageStatistics, error) {
+func (f *LSIFStoreBatchHoverFunc) PushReturn(r0 []lsifstore.HoverResult, r1 error) {
+	f.PushHook(func(context.Context, []lsifstore.PositionKey, lsifstore.HoverFormat) ([]lsifstore.HoverResult, error) {
 		return r0, r1
 	})
 }
 
-func (f *EnqueuerDBStoreRepoUsageStatisticsFunc) nextHook() func(context.Context) ([]dbstore.RepoUsageStatistics, error) {
+func (f *LSIFStoreBatchHoverFunc) nextHook() func(context.Context, []lsifstore.PositionKey, lsifstore.HoverFormat) ([]lsifstore.HoverResult, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3630,33 +7482,38 @@ func (f *EnqueuerDBStoreRepoUsageStatisticsFunc) nextHook() func(context.Context
 	return hook
 }
 
-func (f *EnqueuerDBStoreRepoUsageStatisticsFunc) appendCall(r0 EnqueuerDBStoreRepoUsageStatisticsFuncCall) {
+func (f *LSIFStoreBatchHoverFunc) appendCall(r0 LSIFStoreBatchHoverFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnqueuerDBStoreRepoUsageStatisticsFuncCall
-// objects describing the invocations of this function.
-func (f *EnqueuerDBStoreRepoUsageStatisticsFunc) History() []EnqueuerDBStoreRepoUsageStatisticsFuncCall {
+// History returns a sequence of LSIFStoreBatchHoverFuncCall objects
+// describing the invocations of this function.
+func (f *LSIFStoreBatchHoverFunc) History() []LSIFStoreBatchHoverFuncCall {
 	f.mutex.Lock()
-	history := make([]EnqueuerDBStoreRepoUsageStatisticsFuncCall, len(f.history))
+	history := make([]LSIFStoreBatchHoverFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnqueuerDBStoreRepoUsageStatisticsFuncCall is an object that describes an
-// invocation of method RepoUsageStatistics on an instance of
-// MockEnqueuerDBStore.
-type EnqueuerDBStoreRepoUsageStatisticsFuncCall struct {
+// LSIFStoreBatchHoverFuncCall is an object that describes an invocation of
+// method BatchHover on an instance of MockLSIFStore.
+type LSIFStoreBatchHoverFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 []lsifstore.PositionKey
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 lsifstore.HoverFormat
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []dbstore.RepoUsageStatistics
+	Result0 []lsifstore.HoverResult
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -3664,47 +7521,45 @@ type EnqueuerDBStoreRepoUsageStatisticsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnqueuerDBStoreRepoUsageStatisticsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
-}
-
-// Results returns an interface slice containing the results of this
-// invocation.
-func (c EnqueuerDBStoreRepoUsageStatisticsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c LSIFStoreBatchHoverFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
-
-// EnqueuerDBStoreResetIndexableRepositoriesFunc describes the behavior when
-// the ResetIndexableRepositories method of the parent MockEnqueuerDBStore
-// instance is invoked.
-type EnqueuerDBStoreResetIndexableRepositoriesFunc struct {
-	defaultHook func(context.Context, time.Time) error
-	hooks       []func(context.Context, time.Time) error
-	history     []EnqueuerDBStoreResetIndexableRepositoriesFuncCall
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c LSIFStoreBatchHoverFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// LSIFStoreBatchRangesFunc describes the behavior when the BatchRanges
+// method of the parent MockLSIFStore instance is invoked.
+type LSIFStoreBatchRangesFunc struct {
+	defaultHook func(context.Context, []lsifstore.DocumentKey, int, int) ([][]lsifstore.CodeIntelligenceRange, error)
+	hooks       []func(context.Context, []lsifstore.DocumentKey, int, int) ([][]lsifstore.CodeIntelligenceRange, error)
+	history     []LSIFStoreBatchRangesFuncCall
 	mutex       sync.Mutex
 }
 
-// ResetIndexableRepositories delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockEnqueuerDBStore) ResetIndexableRepositories(v0 context.Context, v1 time.Time) error {
-	r0 := m.ResetIndexableRepositoriesFunc.nextHook()(v0, v1)
-	m.ResetIndexableRepositoriesFunc.appendCall(EnqueuerDBStoreResetIndexableRepositoriesFuncCall{v0, v1, r0})
-	return r0
+// BatchRanges delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockLSIFStore) BatchRanges(v0 context.Context, v1 []lsifstore.DocumentKey, v2 int, v3 int) ([][]lsifstore.CodeIntelligenceRange, error) {
+	r0, r1 := m.BatchRangesFunc.nextHook()(v0, v1, v2, v3)
+	m.BatchRangesFunc.appendCall(LSIFStoreBatchRangesFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// ResetIndexableRepositories method of the parent MockEnqueuerDBStore
-// instance is invoked and the hook queue is empty.
-func (f *EnqueuerDBStoreResetIndexableRepositoriesFunc) SetDefaultHook(hook func(context.Context, time.Time) error) {
+// SetDefaultHook sets function that is called when the BatchRanges method
+// of the parent MockLSIFStore instance is invoked and the hook queue is
+// empty.
+func (f *LSIFStoreBatchRangesFunc) SetDefaultHook(hook func(context.Context, []lsifstore.DocumentKey, int, int) ([][]lsifstore.CodeIntelligenceRange, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ResetIndexableRepositories method of the parent MockEnqueuerDBStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *EnqueuerDBStoreResetIndexableRepositoriesFunc) PushHook(hook func(context.Context, time.Time) error) {
+// BatchRanges method of the parent MockLSIFStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *LSIFStoreBatchRangesFunc) PushHook(hook func(context.Context, []lsifstore.DocumentKey, int, int) ([][]lsifstore.CodeIntelligenceRange, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3712,21 +7567,21 @@ func (f *EnqueuerDBStoreResetIndexableRepositoriesFunc) PushHook(hook func(conte
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *EnqueuerDBStoreResetIndexableRepositoriesFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, time.Time) error {
-		return r0
+func (f *LSIFStoreBatchRangesFunc) SetDefaultReturn(r0 [][]lsifstore.CodeIntelligenceRange, r1 error) {
+	f.SetDefaultHook(func(context.Context, []lsifstore.DocumentKey, int, int) ([][]lsifstore.CodeIntelligenceRange, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *EnqueuerDBStoreResetIndexableRepositoriesFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, time.Time) error {
-		return r0
+func (f *LSIFStoreBatchRangesFunc) PushReturn(r0 [][]lsifstore.CodeIntelligenceRange, r1 error) {
+	f.PushHook(func(context.Context, []lsifstore.DocumentKey, int, int) ([][]lsifstore.CodeIntelligenceRange, error) {
+		return r0, r1
 	})
 }
 
-func (f *EnqueuerDBStoreResetIndexableRepositoriesFunc) nextHook() func(context.Context, time.Time) error {
+func (f *LSIFStoreBatchRangesFunc) nextHook() func(context.Context, []lsifstore.DocumentKey, int, int) ([][]lsifstore.CodeIntelligenceRange, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3739,80 +7594,88 @@ func (f *EnqueuerDBStoreResetIndexableRepositoriesFunc) nextHook() func(context.
 	return hook
 }
 
-func (f *EnqueuerDBStoreResetIndexableRepositoriesFunc) appendCall(r0 EnqueuerDBStoreResetIndexableRepositoriesFuncCall) {
+func (f *LSIFStoreBatchRangesFunc) appendCall(r0 LSIFStoreBatchRangesFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// EnqueuerDBStoreResetIndexableRepositoriesFuncCall objects describing the
-// invocations of this function.
-func (f *EnqueuerDBStoreResetIndexableRepositoriesFunc) History() []EnqueuerDBStoreResetIndexableRepositoriesFuncCall {
+// History returns a sequence of LSIFStoreBatchRangesFuncCall objects
+// describing the invocations of this function.
+func (f *LSIFStoreBatchRangesFunc) History() []LSIFStoreBatchRangesFuncCall {
 	f.mutex.Lock()
-	history := make([]EnqueuerDBStoreResetIndexableRepositoriesFuncCall, len(f.history))
+	history := make([]LSIFStoreBatchRangesFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnqueuerDBStoreResetIndexableRepositoriesFuncCall is an object that
-// describes an invocation of method ResetIndexableRepositories on an
-// instance of MockEnqueuerDBStore.
-type EnqueuerDBStoreResetIndexableRepositoriesFuncCall struct {
+// LSIFStoreBatchRangesFuncCall is an object that describes an invocation of
+// method BatchRanges on an instance of MockLSIFStore.
+type LSIFStoreBatchRangesFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 time.Time
+	Arg1 []lsifstore.DocumentKey
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 [][]lsifstore.CodeIntelligenceRange
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnqueuerDBStoreResetIndexableRepositoriesFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c LSIFStoreBatchRangesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnqueuerDBStoreResetIndexableRepositoriesFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c LSIFStoreBatchRangesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// EnqueuerDBStoreTransactFunc describes the behavior when the Transact
-// method of the parent MockEnqueuerDBStore instance is invoked.
-type EnqueuerDBStoreTransactFunc struct {
-	defaultHook func(context.Context) (enqueuer.DBStore, error)
-	hooks       []func(context.Context) (enqueuer.DBStore, error)
-	history     []EnqueuerDBStoreTransactFuncCall
+// LSIFStoreBulkMonikerResultsFunc describes the behavior when the
+// BulkMonikerResults method of the parent MockLSIFStore instance is
+// invoked.
+type LSIFStoreBulkMonikerResultsFunc struct {
+	defaultHook func(context.Context, string, []int, []semantic.MonikerData, int, int) ([]lsifstore.Location, int, error)
+	hooks       []func(context.Context, string, []int, []semantic.MonikerData, int, int) ([]lsifstore.Location, int, error)
+	history     []LSIFStoreBulkMonikerResultsFuncCall
 	mutex       sync.Mutex
 }
 
-// Transact delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockEnqueuerDBStore) Transact(v0 context.Context) (enqueuer.DBStore, error) {
-	r0, r1 := m.TransactFunc.nextHook()(v0)
-	m.TransactFunc.appendCall(EnqueuerDBStoreTransactFuncCall{v0, r0, r1})
-	return r0, r1
+// BulkMonikerResults delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockLSIFStore) BulkMonikerResults(v0 context.Context, v1 string, v2 []int, v3 []semantic.MonikerData, v4 int, v5 int) ([]lsifstore.Location, int, error) {
+	r0, r1, r2 := m.BulkMonikerResultsFunc.nextHook()(v0, v1, v2, v3, v4, v5)
+	m.BulkMonikerResultsFunc.appendCall(LSIFStoreBulkMonikerResultsFuncCall{v0, v1, v2, v3, v4, v5, r0, r1, r2})
+	return r0, r1, r2
 }
 
-// SetDefaultHook sets function that is called when the Transact method of
-// the parent MockEnqueuerDBStore instance is invoked and the hook queue is
-// empty.
-func (f *EnqueuerDBStoreTransactFunc) SetDefaultHook(hook func(context.Context) (enqueuer.DBStore, error)) {
+// SetDefaultHook sets function that is called when the BulkMonikerResults
+// method of the parent MockLSIFStore instance is invoked and the hook queue
+// is empty.
+func (f *LSIFStoreBulkMonikerResultsFunc) SetDefaultHook(hook func(context.Context, string, []int, []semantic.MonikerData, int, int) ([]lsifstore.Location, int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Transact method of the parent MockEnqueuerDBStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *EnqueuerDBStoreTransactFunc) PushHook(hook func(context.Context) (enqueuer.DBStore, error)) {
+// BulkMonikerResults method of the parent MockLSIFStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *LSIFStoreBulkMonikerResultsFunc) PushHook(hook func(context.Context, string, []int, []semantic.MonikerData, int, int) ([]lsifstore.Location, int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3820,21 +7683,21 @@ func (f *EnqueuerDBStoreTransactFunc) PushHook(hook func(context.Context) (enque
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *EnqueuerDBStoreTransactFunc) SetDefaultReturn(r0 enqueuer.DBStore, r1 error) {
-	f.SetDefaultHook(func(context.Context) (enqueuer.DBStore, error) {
-		return r0, r1
+func (f *LSIFStoreBulkMonikerResultsFunc) SetDefaultReturn(r0 []lsifstore.Location, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, string, []int, []semantic.MonikerData, int, int) ([]lsifstore.Location, int, error) {
+		return r0, r1, r2
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *EnqueuerDBStoreTransactFunc) PushReturn(r0 enqueuer.DBStore, r1 error) {
-	f.PushHook(func(context.Context) (enqueuer.DBStore, error) {
-		return r0, r1
+func (f *LSIFStoreBulkMonikerResultsFunc) PushReturn(r0 []lsifstore.Location, r1 int, r2 error) {
+	f.PushHook(func(context.Context, string, []int, []semantic.MonikerData, int, int) ([]lsifstore.Location, int, error) {
+		return r0, r1, r2
 	})
 }
 
-func (f *EnqueuerDBStoreTransactFunc) nextHook() func(context.Context) (enqueuer.DBStore, error) {
+func (f *LSIFStoreBulkMonikerResultsFunc) nextHook() func(context.Context, string, []int, []semantic.MonikerData, int, int) ([]lsifstore.Location, int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3847,80 +7710,98 @@ func (f *EnqueuerDBStoreTransactFunc) nextHook() func(context.Context) (enqueuer
 	return hook
 }
 
-func (f *EnqueuerDBStoreTransactFunc) appendCall(r0 EnqueuerDBStoreTransactFuncCall) {
+func (f *LSIFStoreBulkMonikerResultsFunc) appendCall(r0 LSIFStoreBulkMonikerResultsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnqueuerDBStoreTransactFuncCall objects
+// History returns a sequence of LSIFStoreBulkMonikerResultsFuncCall objects
 // describing the invocations of this function.
-func (f *EnqueuerDBStoreTransactFunc) History() []EnqueuerDBStoreTransactFuncCall {
+func (f *LSIFStoreBulkMonikerResultsFunc) History() []LSIFStoreBulkMonikerResultsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnqueuerDBStoreTransactFuncCall, len(f.history))
+	history := make([]LSIFStoreBulkMonikerResultsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnqueuerDBStoreTransactFuncCall is an object that describes an invocation
-// of method Transact on an instance of MockEnqueuerDBStore.
-type EnqueuerDBStoreTransactFuncCall struct {
+// LSIFStoreBulkMonikerResultsFuncCall is an object that describes an
+// invocation of method BulkMonikerResults on an instance of MockLSIFStore.
+type LSIFStoreBulkMonikerResultsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []int
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 []semantic.MonikerData
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 int
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 enqueuer.DBStore
+	Result0 []lsifstore.Location
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 error
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnqueuerDBStoreTransactFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c LSIFStoreBulkMonikerResultsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnqueuerDBStoreTransactFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c LSIFStoreBulkMonikerResultsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// EnqueuerDBStoreUpdateIndexableRepositoryFunc describes the behavior when
-// the UpdateIndexableRepository method of the parent MockEnqueuerDBStore
-// instance is invoked.
-type EnqueuerDBStoreUpdateIndexableRepositoryFunc struct {
-	defaultHook func(context.Context, dbstore.UpdateableIndexableRepository, time.Time) error
-	hooks       []func(context.Context, dbstore.UpdateableIndexableRepository, time.Time) error
-	history     []EnqueuerDBStoreUpdateIndexableRepositoryFuncCall
+// LSIFStoreBulkMonikerResultsCountFunc describes the behavior when the
+// BulkMonikerResultsCount method of the parent MockLSIFStore instance is
+// invoked.
+type LSIFStoreBulkMonikerResultsCountFunc struct {
+	defaultHook func(context.Context, string, []int, []semantic.MonikerData) (int, error)
+	hooks       []func(context.Context, string, []int, []semantic.MonikerData) (int, error)
+	history     []LSIFStoreBulkMonikerResultsCountFuncCall
 	mutex       sync.Mutex
 }
 
-// UpdateIndexableRepository delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockEnqueuerDBStore) UpdateIndexableRepository(v0 context.Context, v1 dbstore.UpdateableIndexableRepository, v2 time.Time) error {
-	r0 := m.UpdateIndexableRepositoryFunc.nextHook()(v0, v1, v2)
-	m.UpdateIndexableRepositoryFunc.appendCall(EnqueuerDBStoreUpdateIndexableRepositoryFuncCall{v0, v1, v2, r0})
-	return r0
+// BulkMonikerResultsCount delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockLSIFStore) BulkMonikerResultsCount(v0 context.Context, v1 string, v2 []int, v3 []semantic.MonikerData) (int, error) {
+	r0, r1 := m.BulkMonikerResultsCountFunc.nextHook()(v0, v1, v2, v3)
+	m.BulkMonikerResultsCountFunc.appendCall(LSIFStoreBulkMonikerResultsCountFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the
-// UpdateIndexableRepository method of the parent MockEnqueuerDBStore
-// instance is invoked and the hook queue is empty.
-func (f *EnqueuerDBStoreUpdateIndexableRepositoryFunc) SetDefaultHook(hook func(context.Context, dbstore.UpdateableIndexableRepository, time.Time) error) {
+// BulkMonikerResultsCount method of the parent MockLSIFStore instance is
+// invoked and the hook queue is empty.
+func (f *LSIFStoreBulkMonikerResultsCountFunc) SetDefaultHook(hook func(context.Context, string, []int, []semantic.MonikerData) (int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// UpdateIndexableRepository method of the parent MockEnqueuerDBStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *EnqueuerDBStoreUpdateIndexableRepositoryFunc) PushHook(hook func(context.Context, dbstore.UpdateableIndexableRepository, time.Time) error) {
+// BulkMonikerResultsCount method of the parent MockLSIFStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *LSIFStoreBulkMonikerResultsCountFunc) PushHook(hook func(context.Context, string, []int, []semantic.MonikerData) (int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3928,21 +7809,21 @@ func (f *EnqueuerDBStoreUpdateIndexableRepositoryFunc) PushHook(hook func(contex
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *EnqueuerDBStoreUpdateIndexableRepositoryFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, dbstore.UpdateableIndexableRepository, time.Time) error {
-		return r0
+func (f *LSIFStoreBulkMonikerResultsCountFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, []int, []semantic.MonikerData) (int, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *EnqueuerDBStoreUpdateIndexableRepositoryFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, dbstore.UpdateableIndexableRepository, time.Time) error {
-		return r0
+func (f *LSIFStoreBulkMonikerResultsCountFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, string, []int, []semantic.MonikerData) (int, error) {
+		return r0, r1
 	})
 }
 
-func (f *EnqueuerDBStoreUpdateIndexableRepositoryFunc) nextHook() func(context.Context, dbstore.UpdateableIndexableRepository, time.Time) error {
+func (f *LSIFStoreBulkMonikerResultsCountFunc) nextHook() func(context.Context, string, []int, []semantic.MonikerData) (int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3955,163 +7836,88 @@ func (f *EnqueuerDBStoreUpdateIndexableRepositoryFunc) nextHook() func(context.C
 	return hook
 }
 
-func (f *EnqueuerDBStoreUpdateIndexableRepositoryFunc) appendCall(r0 EnqueuerDBStoreUpdateIndexableRepositoryFuncCall) {
+func (f *LSIFStoreBulkMonikerResultsCountFunc) appendCall(r0 LSIFStoreBulkMonikerResultsCountFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// EnqueuerDBStoreUpdateIndexableRepositoryFuncCall objects describing the
-// invocations of this function.
-func (f *EnqueuerDBStoreUpdateIndexableRepositoryFunc) History() []EnqueuerDBStoreUpdateIndexableRepositoryFuncCall {
+// History returns a sequence of LSIFStoreBulkMonikerResultsCountFuncCall
+// objects describing the invocations of this function.
+func (f *LSIFStoreBulkMonikerResultsCountFunc) History() []LSIFStoreBulkMonikerResultsCountFuncCall {
 	f.mutex.Lock()
-	history := make([]EnqueuerDBStoreUpdateIndexableRepositoryFuncCall, len(f.history))
+	history := make([]LSIFStoreBulkMonikerResultsCountFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnqueuerDBStoreUpdateIndexableRepositoryFuncCall is an object that
-// describes an invocation of method UpdateIndexableRepository on an
-// instance of MockEnqueuerDBStore.
-type EnqueuerDBStoreUpdateIndexableRepositoryFuncCall struct {
+// LSIFStoreBulkMonikerResultsCountFuncCall is an object that describes an
+// invocation of method BulkMonikerResultsCount on an instance of
+// MockLSIFStore.
+type LSIFStoreBulkMonikerResultsCountFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 dbstore.UpdateableIndexableRepository
+	Arg1 string
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 time.Time
+	Arg2 []int
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 []semantic.MonikerData
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
-}
-
-// Args returns an interface slice containing the arguments of this
-// invocation.
-func (c EnqueuerDBStoreUpdateIndexableRepositoryFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
-}
-
-// Results returns an interface slice containing the results of this
-// invocation.
-func (c EnqueuerDBStoreUpdateIndexableRepositoryFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
-}
-
-// MockEnqueuerGitserverClient is a mock implementation of the
-// EnqueuerGitserverClient interface (from the package
-// github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers)
-// used for unit testing.
-type MockEnqueuerGitserverClient struct {
-	// FileExistsFunc is an instance of a mock function object controlling
-	// the behavior of the method FileExists.
-	FileExistsFunc *EnqueuerGitserverClientFileExistsFunc
-	// HeadFunc is an instance of a mock function object controlling the
-	// behavior of the method Head.
-	HeadFunc *EnqueuerGitserverClientHeadFunc
-	// ListFilesFunc is an instance of a mock function object controlling
-	// the behavior of the method ListFiles.
-	ListFilesFunc *EnqueuerGitserverClientListFilesFunc
-	// RawContentsFunc is an instance of a mock function object controlling
-	// the behavior of the method RawContents.
-	RawContentsFunc *EnqueuerGitserverClientRawContentsFunc
-	// ResolveRevisionFunc is an instance of a mock function object
-	// controlling the behavior of the method ResolveRevision.
-	ResolveRevisionFunc *EnqueuerGitserverClientResolveRevisionFunc
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
-// NewMockEnqueuerGitserverClient creates a new mock of the
-// EnqueuerGitserverClient interface. All methods return zero values for all
-// results, unless overwritten.
-func NewMockEnqueuerGitserverClient() *MockEnqueuerGitserverClient {
-	return &MockEnqueuerGitserverClient{
-		FileExistsFunc: &EnqueuerGitserverClientFileExistsFunc{
-			defaultHook: func(context.Context, int, string, string) (bool, error) {
-				return false, nil
-			},
-		},
-		HeadFunc: &EnqueuerGitserverClientHeadFunc{
-			defaultHook: func(context.Context, int) (string, error) {
-				return "", nil
-			},
-		},
-		ListFilesFunc: &EnqueuerGitserverClientListFilesFunc{
-			defaultHook: func(context.Context, int, string, *regexp.Regexp) ([]string, error) {
-				return nil, nil
-			},
-		},
-		RawContentsFunc: &EnqueuerGitserverClientRawContentsFunc{
-			defaultHook: func(context.Context, int, string, string) ([]byte, error) {
-				return nil, nil
-			},
-		},
-		ResolveRevisionFunc: &EnqueuerGitserverClientResolveRevisionFunc{
-			defaultHook: func(context.Context, int, string) (api.CommitID, error) {
-				return "", nil
-			},
-		},
-	}
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c LSIFStoreBulkMonikerResultsCountFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
-// NewMockEnqueuerGitserverClientFrom creates a new mock of the
-// MockEnqueuerGitserverClient interface. All methods delegate to the given
-// implementation, unless overwritten.
-func NewMockEnqueuerGitserverClientFrom(i EnqueuerGitserverClient) *MockEnqueuerGitserverClient {
-	return &MockEnqueuerGitserverClient{
-		FileExistsFunc: &EnqueuerGitserverClientFileExistsFunc{
-			defaultHook: i.FileExists,
-		},
-		HeadFunc: &EnqueuerGitserverClientHeadFunc{
-			defaultHook: i.Head,
-		},
-		ListFilesFunc: &EnqueuerGitserverClientListFilesFunc{
-			defaultHook: i.ListFiles,
-		},
-		RawContentsFunc: &EnqueuerGitserverClientRawContentsFunc{
-			defaultHook: i.RawContents,
-		},
-		ResolveRevisionFunc: &EnqueuerGitserverClientResolveRevisionFunc{
-			defaultHook: i.ResolveRevision,
-		},
-	}
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c LSIFStoreBulkMonikerResultsCountFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// EnqueuerGitserverClientFileExistsFunc describes the behavior when the
-// FileExists method of the parent MockEnqueuerGitserverClient instance is
-// invoked.
-type EnqueuerGitserverClientFileExistsFunc struct {
-	defaultHook func(context.Context, int, string, string) (bool, error)
-	hooks       []func(context.Context, int, string, string) (bool, error)
-	history     []EnqueuerGitserverClientFileExistsFuncCall
+// LSIFStoreDefinitionsFunc describes the behavior when the Definitions
+// method of the parent MockLSIFStore instance is invoked.
+type LSIFStoreDefinitionsFunc struct {
+	defaultHook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)
+	hooks       []func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)
+	history     []LSIFStoreDefinitionsFuncCall
 	mutex       sync.Mutex
 }
 
-// FileExists delegates to the next hook function in the queue and stores
+// Definitions delegates to the next hook function in the queue and stores
 // the parameter and result values of this invocation.
-func (m *MockEnqueuerGitserverClient) FileExists(v0 context.Context, v1 int, v2 string, v3 string) (bool, error) {
-	r0, r1 := m.FileExistsFunc.nextHook()(v0, v1, v2, v3)
-	m.FileExistsFunc.appendCall(EnqueuerGitserverClientFileExistsFuncCall{v0, v1, v2, v3, r0, r1})
-	return r0, r1
+func (m *MockLSIFStore) Definitions(v0 context.Context, v1 int, v2 string, v3 int, v4 int, v5 int, v6 int) ([]lsifstore.Location, int, error) {
+	r0, r1, r2 := m.DefinitionsFunc.nextHook()(v0, v1, v2, v3, v4, v5, v6)
+	m.DefinitionsFunc.appendCall(LSIFStoreDefinitionsFuncCall{v0, v1, v2, v3, v4, v5, v6, r0, r1, r2})
+	return r0, r1, r2
 }
 
-// SetDefaultHook sets function that is called when the FileExists method of
-// the parent MockEnqueuerGitserverClient instance is invoked and the hook
-// queue is empty.
-func (f *EnqueuerGitserverClientFileExistsFunc) SetDefaultHook(hook func(context.Context, int, string, string) (bool, error)) {
+// SetDefaultHook sets function that is called when the Definitions method
+// of the parent MockLSIFStore instance is invoked and the hook queue is
+// empty.
+func (f *LSIFStoreDefinitionsFunc) SetDefaultHook(hook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// FileExists method of the parent MockEnqueuerGitserverClient instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *EnqueuerGitserverClientFileExistsFunc) PushHook(hook func(context.Context, int, string, string) (bool, error)) {
+// Definitions method of the parent MockLSIFStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *LSIFStoreDefinitionsFunc) PushHook(hook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4119,21 +7925,21 @@ func (f *EnqueuerGitserverClientFileExistsFunc) PushHook(hook func(context.Conte
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *EnqueuerGitserverClientFileExistsFunc) SetDefaultReturn(r0 bool, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, string, string) (bool, error) {
-		return r0, r1
+func (f *LSIFStoreDefinitionsFunc) SetDefaultReturn(r0 []lsifstore.Location, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+		return r0, r1, r2
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *EnqueuerGitserverClientFileExistsFunc) PushReturn(r0 bool, r1 error) {
-	f.PushHook(func(context.Context, int, string, string) (bool, error) {
-		return r0, r1
+func (f *LSIFStoreDefinitionsFunc) PushReturn(r0 []lsifstore.Location, r1 int, r2 error) {
+	f.PushHook(func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+		return r0, r1, r2
 	})
 }
 
-func (f *EnqueuerGitserverClientFileExistsFunc) nextHook() func(context.Context, int, string, string) (bool, error) {
+func (f *LSIFStoreDefinitionsFunc) nextHook() func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4146,27 +7952,26 @@ func (f *EnqueuerGitserverClientFileExistsFunc) nextHook() func(context.Context,
 	return hook
 }
 
-func (f *EnqueuerGitserverClientFileExistsFunc) appendCall(r0 EnqueuerGitserverClientFileExistsFuncCall) {
+func (f *LSIFStoreDefinitionsFunc) appendCall(r0 LSIFStoreDefinitionsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnqueuerGitserverClientFileExistsFuncCall
-// objects describing the invocations of this function.
-func (f *EnqueuerGitserverClientFileExistsFunc) History() []EnqueuerGitserverClientFileExistsFuncCall {
+// History returns a sequence of LSIFStoreDefinitionsFuncCall objects
+// describing the invocations of this function.
+func (f *LSIFStoreDefinitionsFunc) History() []LSIFStoreDefinitionsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnqueuerGitserverClientFileExistsFuncCall, len(f.history))
+	history := make([]LSIFStoreDefinitionsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnqueuerGitserverClientFileExistsFuncCall is an object that describes an
-// invocation of method FileExists on an instance of
-// MockEnqueuerGitserverClient.
-type EnqueuerGitserverClientFileExistsFuncCall struct {
+// LSIFStoreDefinitionsFuncCall is an object that describes an invocation of
+// method Definitions on an instance of MockLSIFStore.
+type LSIFStoreDefinitionsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -4178,56 +7983,68 @@ type EnqueuerGitserverClientFileExistsFuncCall struct {
 	Arg2 string
 	// Arg3 is the value of the 4th argument passed to this method
 	// invocation.
-	Arg3 string
+	Arg3 int
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 int
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 int
+	// Arg6 is the value of the 7th argument passed to this method
+	// invocation.
+	Arg6 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 bool
+	Result0 []lsifstore.Location
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 error
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnqueuerGitserverClientFileExistsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c LSIFStoreDefinitionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5, c.Arg6}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnqueuerGitserverClientFileExistsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c LSIFStoreDefinitionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// EnqueuerGitserverClientHeadFunc describes the behavior when the Head
-// method of the parent MockEnqueuerGitserverClient instance is invoked.
-type EnqueuerGitserverClientHeadFunc struct {
-	defaultHook func(context.Context, int) (string, error)
-	hooks       []func(context.Context, int) (string, error)
-	history     []EnqueuerGitserverClientHeadFuncCall
+// LSIFStoreDiagnosticsFunc describes the behavior when the Diagnostics
+// method of the parent MockLSIFStore instance is invoked.
+type LSIFStoreDiagnosticsFunc struct {
+	defaultHook func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error)
+	hooks       []func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error)
+	history     []LSIFStoreDiagnosticsFuncCall
 	mutex       sync.Mutex
 }
 
-// Head delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockEnqueuerGitserverClient) Head(v0 context.Context, v1 int) (string, error) {
-	r0, r1 := m.HeadFunc.nextHook()(v0, v1)
-	m.HeadFunc.appendCall(EnqueuerGitserverClientHeadFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// Diagnostics delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockLSIFStore) Diagnostics(v0 context.Context, v1 int, v2 string, v3 int, v4 int) ([]lsifstore.Diagnostic, int, error) {
+	r0, r1, r2 := m.DiagnosticsFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.DiagnosticsFunc.appendCall(LSIFStoreDiagnosticsFuncCall{v0, v1, v2, v3, v4, r0, r1, r2})
+	return r0, r1, r2
 }
 
-// SetDefaultHook sets function that is called when the Head method of the
-// parent MockEnqueuerGitserverClient instance is invoked and the hook queue
-// is empty.
-func (f *EnqueuerGitserverClientHeadFunc) SetDefaultHook(hook func(context.Context, int) (string, error)) {
+// SetDefaultHook sets function that is called when the Diagnostics method
+// of the parent MockLSIFStore instance is invoked and the hook queue is
+// empty.
+func (f *LSIFStoreDiagnosticsFunc) SetDefaultHook(hook func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Head method of the parent MockEnqueuerGitserverClient instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *EnqueuerGitserverClientHeadFunc) PushHook(hook func(context.Context, int) (string, error)) {
+// Diagnostics method of the parent MockLSIFStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *LSIFStoreDiagnosticsFunc) PushHook(hook func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4235,21 +8052,21 @@ func (f *EnqueuerGitserverClientHeadFunc) PushHook(hook func(context.Context, in
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *EnqueuerGitserverClientHeadFunc) SetDefaultReturn(r0 string, r1 error) {
-	f.SetDefaultHook(func(context.Context, int) (string, error) {
-		return r0, r1
+func (f *LSIFStoreDiagnosticsFunc) SetDefaultReturn(r0 []lsifstore.Diagnostic, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error) {
+		return r0, r1, r2
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *EnqueuerGitserverClientHeadFunc) PushReturn(r0 string, r1 error) {
-	f.PushHook(func(context.Context, int) (string, error) {
-		return r0, r1
+func (f *LSIFStoreDiagnosticsFunc) PushReturn(r0 []lsifstore.Diagnostic, r1 int, r2 error) {
+	f.PushHook(func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error) {
+		return r0, r1, r2
 	})
 }
 
-func (f *EnqueuerGitserverClientHeadFunc) nextHook() func(context.Context, int) (string, error) {
+func (f *LSIFStoreDiagnosticsFunc) nextHook() func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4262,83 +8079,93 @@ func (f *EnqueuerGitserverClientHeadFunc) nextHook() func(context.Context, int)
 	return hook
 }
 
-func (f *EnqueuerGitserverClientHeadFunc) appendCall(r0 EnqueuerGitserverClientHeadFuncCall) {
+func (f *LSIFStoreDiagnosticsFunc) appendCall(r0 LSIFStoreDiagnosticsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnqueuerGitserverClientHeadFuncCall objects
+// History returns a sequence of LSIFStoreDiagnosticsFuncCall objects
 // describing the invocations of this function.
-func (f *EnqueuerGitserverClientHeadFunc) History() []EnqueuerGitserverClientHeadFuncCall {
+func (f *LSIFStoreDiagnosticsFunc) History() []LSIFStoreDiagnosticsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnqueuerGitserverClientHeadFuncCall, len(f.history))
+	history := make([]LSIFStoreDiagnosticsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnqueuerGitserverClientHeadFuncCall is an object that describes an
-// invocation of method Head on an instance of MockEnqueuerGitserverClient.
-type EnqueuerGitserverClientHeadFuncCall struct {
+// LSIFStoreDiagnosticsFuncCall is an object that describes an invocation of
+// method Diagnostics on an instance of MockLSIFStore.
+type LSIFStoreDiagnosticsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
 	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 string
+	Result0 []lsifstore.Diagnostic
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 error
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnqueuerGitserverClientHeadFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c LSIFStoreDiagnosticsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnqueuerGitserverClientHeadFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c LSIFStoreDiagnosticsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
-
-// EnqueuerGitserverClientListFilesFunc describes the behavior when the
-// ListFiles method of the parent MockEnqueuerGitserverClient instance is
-// invoked.
-type EnqueuerGitserverClientListFilesFunc struct {
-	defaultHook func(context.Context, int, string, *regexp.Regexp) ([]string, error)
-	hooks       []func(context.Context, int, string, *regexp.Regexp) ([]string, error)
-	history     []EnqueuerGitserverClientListFilesFuncCall
+
+// LSIFStoreDocumentPathsFunc describes the behavior when the DocumentPaths
+// method of the parent MockLSIFStore instance is invoked.
+type LSIFStoreDocumentPathsFunc struct {
+	defaultHook func(context.Context, int) ([]string, error)
+	hooks       []func(context.Context, int) ([]string, error)
+	history     []LSIFStoreDocumentPathsFuncCall
 	mutex       sync.Mutex
 }
 
-// ListFiles delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockEnqueuerGitserverClient) ListFiles(v0 context.Context, v1 int, v2 string, v3 *regexp.Regexp) ([]string, error) {
-	r0, r1 := m.ListFilesFunc.nextHook()(v0, v1, v2, v3)
-	m.ListFilesFunc.appendCall(EnqueuerGitserverClientListFilesFuncCall{v0, v1, v2, v3, r0, r1})
+// DocumentPaths delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockLSIFStore) DocumentPaths(v0 context.Context, v1 int) ([]string, error) {
+	r0, r1 := m.DocumentPathsFunc.nextHook()(v0, v1)
+	m.DocumentPathsFunc.appendCall(LSIFStoreDocumentPathsFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the ListFiles method of
-// the parent MockEnqueuerGitserverClient instance is invoked and the hook
-// queue is empty.
-func (f *EnqueuerGitserverClientListFilesFunc) SetDefaultHook(hook func(context.Context, int, string, *regexp.Regexp) ([]string, error)) {
+// SetDefaultHook sets function that is called when the DocumentPaths method
+// of the parent MockLSIFStore instance is invoked and the hook queue is
+// empty.
+func (f *LSIFStoreDocumentPathsFunc) SetDefaultHook(hook func(context.Context, int) ([]string, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ListFiles method of the parent MockEnqueuerGitserverClient instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *EnqueuerGitserverClientListFilesFunc) PushHook(hook func(context.Context, int, string, *regexp.Regexp) ([]string, error)) {
+// DocumentPaths method of the parent MockLSIFStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *LSIFStoreDocumentPathsFunc) PushHook(hook func(context.Context, int) ([]string, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4346,21 +8173,21 @@ func (f *EnqueuerGitserverClientListFilesFunc) PushHook(hook func(context.Contex
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *EnqueuerGitserverClientListFilesFunc) SetDefaultReturn(r0 []string, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, string, *regexp.Regexp) ([]string, error) {
+func (f *LSIFStoreDocumentPathsFunc) SetDefaultReturn(r0 []string, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) ([]string, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *EnqueuerGitserverClientListFilesFunc) PushReturn(r0 []string, r1 error) {
-	f.PushHook(func(context.Context, int, string, *regexp.Regexp) ([]string, error) {
+func (f *LSIFStoreDocumentPathsFunc) PushReturn(r0 []string, r1 error) {
+	f.PushHook(func(context.Context, int) ([]string, error) {
 		return r0, r1
 	})
 }
 
-func (f *EnqueuerGitserverClientListFilesFunc) nextHook() func(context.Context, int, string, *regexp.Regexp) ([]string, error) {
+func (f *LSIFStoreDocumentPathsFunc) nextHook() func(context.Context, int) ([]string, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4373,39 +8200,32 @@ func (f *EnqueuerGitserverClientListFilesFunc) nextHook() func(context.Context,
 	return hook
 }
 
-func (f *EnqueuerGitserverClientListFilesFunc) appendCall(r0 EnqueuerGitserverClientListFilesFuncCall) {
+func (f *LSIFStoreDocumentPathsFunc) appendCall(r0 LSIFStoreDocumentPathsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnqueuerGitserverClientListFilesFuncCall
-// objects describing the invocations of this function.
-func (f *EnqueuerGitserverClientListFilesFunc) History() []EnqueuerGitserverClientListFilesFuncCall {
+// History returns a sequence of LSIFStoreDocumentPathsFuncCall objects
+// describing the invocations of this function.
+func (f *LSIFStoreDocumentPathsFunc) History() []LSIFStoreDocumentPathsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnqueuerGitserverClientListFilesFuncCall, len(f.history))
+	history := make([]LSIFStoreDocumentPathsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnqueuerGitserverClientListFilesFuncCall is an object that describes an
-// invocation of method ListFiles on an instance of
-// MockEnqueuerGitserverClient.
-type EnqueuerGitserverClientListFilesFuncCall struct {
+// LSIFStoreDocumentPathsFuncCall is an object that describes an invocation
+// of method DocumentPaths on an instance of MockLSIFStore.
+type LSIFStoreDocumentPathsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
 	Arg1 int
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 string
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 *regexp.Regexp
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 []string
@@ -4416,47 +8236,45 @@ type EnqueuerGitserverClientListFilesFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnqueuerGitserverClientListFilesFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c LSIFStoreDocumentPathsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnqueuerGitserverClientListFilesFuncCall) Results() []interface{} {
+func (c LSIFStoreDocumentPathsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// EnqueuerGitserverClientRawContentsFunc describes the behavior when the
-// RawContents method of the parent MockEnqueuerGitserverClient instance is
-// invoked.
-type EnqueuerGitserverClientRawContentsFunc struct {
-	defaultHook func(context.Context, int, string, string) ([]byte, error)
-	hooks       []func(context.Context, int, string, string) ([]byte, error)
-	history     []EnqueuerGitserverClientRawContentsFuncCall
+// LSIFStoreDocumentSymbolsFunc describes the behavior when the
+// DocumentSymbols method of the parent MockLSIFStore instance is invoked.
+type LSIFStoreDocumentSymbolsFunc struct {
+	defaultHook func(context.Context, int, string) ([]lsifstore.DocumentSymbol, error)
+	hooks       []func(context.Context, int, string) ([]lsifstore.DocumentSymbol, error)
+	history     []LSIFStoreDocumentSymbolsFuncCall
 	mutex       sync.Mutex
 }
 
-// RawContents delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockEnqueuerGitserverClient) RawContents(v0 context.Context, v1 int, v2 string, v3 string) ([]byte, error) {
-	r0, r1 := m.RawContentsFunc.nextHook()(v0, v1, v2, v3)
-	m.RawContentsFunc.appendCall(EnqueuerGitserverClientRawContentsFuncCall{v0, v1, v2, v3, r0, r1})
+// DocumentSymbols delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockLSIFStore) DocumentSymbols(v0 context.Context, v1 int, v2 string) ([]lsifstore.DocumentSymbol, error) {
+	r0, r1 := m.DocumentSymbolsFunc.nextHook()(v0, v1, v2)
+	m.DocumentSymbolsFunc.appendCall(LSIFStoreDocumentSymbolsFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the RawContents method
-// of the parent MockEnqueuerGitserverClient instance is invoked and the
-// hook queue is empty.
-func (f *EnqueuerGitserverClientRawContentsFunc) SetDefaultHook(hook func(context.Context, int, string, string) ([]byte, error)) {
+// SetDefaultHook sets function that is called when the DocumentSymbols
+// method of the parent MockLSIFStore instance is invoked and the hook queue
+// is empty.
+func (f *LSIFStoreDocumentSymbolsFunc) SetDefaultHook(hook func(context.Context, int, string) ([]lsifstore.DocumentSymbol, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// RawContents method of the parent MockEnqueuerGitserverClient instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *EnqueuerGitserverClientRawContentsFunc) PushHook(hook func(context.Context, int, string, string) ([]byte, error)) {
+// DocumentSymbols method of the parent MockLSIFStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *LSIFStoreDocumentSymbolsFunc) PushHook(hook func(context.Context, int, string) ([]lsifstore.DocumentSymbol, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4464,21 +8282,21 @@ func (f *EnqueuerGitserverClientRawContentsFunc) PushHook(hook func(context.Cont
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *EnqueuerGitserverClientRawContentsFunc) SetDefaultReturn(r0 []byte, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, string, string) ([]byte, error) {
+func (f *LSIFStoreDocumentSymbolsFunc) SetDefaultReturn(r0 []lsifstore.DocumentSymbol, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string) ([]lsifstore.DocumentSymbol, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *EnqueuerGitserverClientRawContentsFunc) PushReturn(r0 []byte, r1 error) {
-	f.PushHook(func(context.Context, int, string, string) ([]byte, error) {
+func (f *LSIFStoreDocumentSymbolsFunc) PushReturn(r0 []lsifstore.DocumentSymbol, r1 error) {
+	f.PushHook(func(context.Context, int, string) ([]lsifstore.DocumentSymbol, error) {
 		return r0, r1
 	})
 }
 
-func (f *EnqueuerGitserverClientRawContentsFunc) nextHook() func(context.Context, int, string, string) ([]byte, error) {
+func (f *LSIFStoreDocumentSymbolsFunc) nextHook() func(context.Context, int, string) ([]lsifstore.DocumentSymbol, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4491,27 +8309,26 @@ func (f *EnqueuerGitserverClientRawContentsFunc) nextHook() func(context.Context
 	return hook
 }
 
-func (f *EnqueuerGitserverClientRawContentsFunc) appendCall(r0 EnqueuerGitserverClientRawContentsFuncCall) {
+func (f *LSIFStoreDocumentSymbolsFunc) appendCall(r0 LSIFStoreDocumentSymbolsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnqueuerGitserverClientRawContentsFuncCall
-// objects describing the invocations of this function.
-func (f *EnqueuerGitserverClientRawContentsFunc) History() []EnqueuerGitserverClientRawContentsFuncCall {
+// History returns a sequence of LSIFStoreDocumentSymbolsFuncCall objects
+// describing the invocations of this function.
+func (f *LSIFStoreDocumentSymbolsFunc) History() []LSIFStoreDocumentSymbolsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnqueuerGitserverClientRawContentsFuncCall, len(f.history))
+	history := make([]LSIFStoreDocumentSymbolsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnqueuerGitserverClientRawContentsFuncCall is an object that describes an
-// invocation of method RawContents on an instance of
-// MockEnqueuerGitserverClient.
-type EnqueuerGitserverClientRawContentsFuncCall struct {
+// LSIFStoreDocumentSymbolsFuncCall is an object that describes an
+// invocation of method DocumentSymbols on an instance of MockLSIFStore.
+type LSIFStoreDocumentSymbolsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -4521,12 +8338,9 @@ type EnqueuerGitserverClientRawContentsFuncCall struct {
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
 	Arg2 string
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []byte
+	Result0 []lsifstore.DocumentSymbol
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -4534,47 +8348,47 @@ type EnqueuerGitserverClientRawContentsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnqueuerGitserverClientRawContentsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c LSIFStoreDocumentSymbolsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnqueuerGitserverClientRawContentsFuncCall) Results() []interface{} {
+func (c LSIFStoreDocumentSymbolsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// EnqueuerGitserverClientResolveRevisionFunc describes the behavior when
-// the ResolveRevision method of the parent MockEnqueuerGitserverClient
-// instance is invoked.
-type EnqueuerGitserverClientResolveRevisionFunc struct {
-	defaultHook func(context.Context, int, string) (api.CommitID, error)
-	hooks       []func(context.Context, int, string) (api.CommitID, error)
-	history     []EnqueuerGitserverClientResolveRevisionFuncCall
+// LSIFStoreDocumentationAtPositionFunc describes the behavior when the
+// DocumentationAtPosition method of the parent MockLSIFStore instance is
+// invoked.
+type LSIFStoreDocumentationAtPositionFunc struct {
+	defaultHook func(context.Context, int, string, int, int) ([]lsifstore.DocumentationLink, error)
+	hooks       []func(context.Context, int, string, int, int) ([]lsifstore.DocumentationLink, error)
+	history     []LSIFStoreDocumentationAtPositionFuncCall
 	mutex       sync.Mutex
 }
 
-// ResolveRevision delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockEnqueuerGitserverClient) ResolveRevision(v0 context.Context, v1 int, v2 string) (api.CommitID, error) {
-	r0, r1 := m.ResolveRevisionFunc.nextHook()(v0, v1, v2)
-	m.ResolveRevisionFunc.appendCall(EnqueuerGitserverClientResolveRevisionFuncCall{v0, v1, v2, r0, r1})
+// DocumentationAtPosition delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockLSIFStore) DocumentationAtPosition(v0 context.Context, v1 int, v2 string, v3 int, v4 int) ([]lsifstore.DocumentationLink, error) {
+	r0, r1 := m.DocumentationAtPositionFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.DocumentationAtPositionFunc.appendCall(LSIFStoreDocumentationAtPositionFuncCall{v0, v1, v2, v3, v4, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the ResolveRevision
-// method of the parent MockEnqueuerGitserverClient instance is invoked and
-// the hook queue is empty.
-func (f *EnqueuerGitserverClientResolveRevisionFunc) SetDefaultHook(hook func(context.Context, int, string) (api.CommitID, error)) {
+// SetDefaultHook sets function that is called when the
+// DocumentationAtPosition method of the parent MockLSIFStore instance is
+// invoked and the hook queue is empty.
+func (f *LSIFStoreDocumentationAtPositionFunc) SetDefaultHook(hook func(context.Context, int, string, int, int) ([]lsifstore.DocumentationLink, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ResolveRevision method of the parent MockEnqueuerGitserverClient instance
+// DocumentationAtPosition method of the parent MockLSIFStore instance
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *EnqueuerGitserverClientResolveRevisionFunc) PushHook(hook func(context.Context, int, string) (api.CommitID, error)) {
+func (f *LSIFStoreDocumentationAtPositionFunc) PushHook(hook func(context.Context, int, string, int, int) ([]lsifstore.DocumentationLink, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4582,21 +8396,21 @@ func (f *EnqueuerGitserverClientResolveRevisionFunc) PushHook(hook func(context.
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *EnqueuerGitserverClientResolveRevisionFunc) SetDefaultReturn(r0 api.CommitID, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, string) (api.CommitID, error) {
+func (f *LSIFStoreDocumentationAtPositionFunc) SetDefaultReturn(r0 []lsifstore.DocumentationLink, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string, int, int) ([]lsifstore.DocumentationLink, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *EnqueuerGitserverClientResolveRevisionFunc) PushReturn(r0 api.CommitID, r1 error) {
-	f.PushHook(func(context.Context, int, string) (api.CommitID, error) {
+func (f *LSIFStoreDocumentationAtPositionFunc) PushReturn(r0 []lsifstore.DocumentationLink, r1 error) {
+	f.PushHook(func(context.Context, int, string, int, int) ([]lsifstore.DocumentationLink, error) {
 		return r0, r1
 	})
 }
 
-func (f *EnqueuerGitserverClientResolveRevisionFunc) nextHook() func(context.Context, int, string) (api.CommitID, error) {
+func (f *LSIFStoreDocumentationAtPositionFunc) nextHook() func(context.Context, int, string, int, int) ([]lsifstore.DocumentationLink, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4609,28 +8423,27 @@ func (f *EnqueuerGitserverClientResolveRevisionFunc) nextHook() func(context.Con
 	return hook
 }
 
-func (f *EnqueuerGitserverClientResolveRevisionFunc) appendCall(r0 EnqueuerGitserverClientResolveRevisionFuncCall) {
+func (f *LSIFStoreDocumentationAtPositionFunc) appendCall(r0 LSIFStoreDocumentationAtPositionFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// EnqueuerGitserverClientResolveRevisionFuncCall objects describing the
-// invocations of this function.
-func (f *EnqueuerGitserverClientResolveRevisionFunc) History() []EnqueuerGitserverClientResolveRevisionFuncCall {
+// History returns a sequence of LSIFStoreDocumentationAtPositionFuncCall
+// objects describing the invocations of this function.
+func (f *LSIFStoreDocumentationAtPositionFunc) History() []LSIFStoreDocumentationAtPositionFuncCall {
 	f.mutex.Lock()
-	history := make([]EnqueuerGitserverClientResolveRevisionFuncCall, len(f.history))
+	history := make([]LSIFStoreDocumentationAtPositionFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnqueuerGitserverClientResolveRevisionFuncCall is an object that
-// describes an invocation of method ResolveRevision on an instance of
-// MockEnqueuerGitserverClient.
-type EnqueuerGitserverClientResolveRevisionFuncCall struct {
+// LSIFStoreDocumentationAtPositionFuncCall is an object that describes an
+// invocation of method DocumentationAtPosition on an instance of
+// MockLSIFStore.
+type LSIFStoreDocumentationAtPositionFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -4640,9 +8453,15 @@ type EnqueuerGitserverClientResolveRevisionFuncCall struct {
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
 	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 api.CommitID
+	Result0 []lsifstore.DocumentationLink
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -4650,91 +8469,45 @@ type EnqueuerGitserverClientResolveRevisionFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnqueuerGitserverClientResolveRevisionFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c LSIFStoreDocumentationAtPositionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnqueuerGitserverClientResolveRevisionFuncCall) Results() []interface{} {
+func (c LSIFStoreDocumentationAtPositionFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// MockGitserverClient is a mock implementation of the GitserverClient
-// interface (from the package
-// github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers)
-// used for unit testing.
-type MockGitserverClient struct {
-	// CommitExistsFunc is an instance of a mock function object controlling
-	// the behavior of the method CommitExists.
-	CommitExistsFunc *GitserverClientCommitExistsFunc
-	// CommitGraphFunc is an instance of a mock function object controlling
-	// the behavior of the method CommitGraph.
-	CommitGraphFunc *GitserverClientCommitGraphFunc
-}
-
-// NewMockGitserverClient creates a new mock of the GitserverClient
-// interface. All methods return zero values for all results, unless
-// overwritten.
-func NewMockGitserverClient() *MockGitserverClient {
-	return &MockGitserverClient{
-		CommitExistsFunc: &GitserverClientCommitExistsFunc{
-			defaultHook: func(context.Context, int, string) (bool, error) {
-				return false, nil
-			},
-		},
-		CommitGraphFunc: &GitserverClientCommitGraphFunc{
-			defaultHook: func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error) {
-				return nil, nil
-			},
-		},
-	}
-}
-
-// NewMockGitserverClientFrom creates a new mock of the MockGitserverClient
-// interface. All methods delegate to the given implementation, unless
-// overwritten.
-func NewMockGitserverClientFrom(i GitserverClient) *MockGitserverClient {
-	return &MockGitserverClient{
-		CommitExistsFunc: &GitserverClientCommitExistsFunc{
-			defaultHook: i.CommitExists,
-		},
-		CommitGraphFunc: &GitserverClientCommitGraphFunc{
-			defaultHook: i.CommitGraph,
-		},
-	}
-}
-
-// GitserverClientCommitExistsFunc describes the behavior when the
-// CommitExists method of the parent MockGitserverClient instance is
-// invoked.
-type GitserverClientCommitExistsFunc struct {
-	defaultHook func(context.Context, int, string) (bool, error)
-	hooks       []func(context.Context, int, string) (bool, error)
-	history     []GitserverClientCommitExistsFuncCall
+// LSIFStoreDocumentationPageFunc describes the behavior when the
+// DocumentationPage method of the parent MockLSIFStore instance is invoked.
+type LSIFStoreDocumentationPageFunc struct {
+	defaultHook func(context.Context, int, string) (*semantic.DocumentationPageData, error)
+	hooks       []func(context.Context, int, string) (*semantic.DocumentationPageData, error)
+	history     []LSIFStoreDocumentationPageFuncCall
 	mutex       sync.Mutex
 }
 
-// CommitExists delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockGitserverClient) CommitExists(v0 context.Context, v1 int, v2 string) (bool, error) {
-	r0, r1 := m.CommitExistsFunc.nextHook()(v0, v1, v2)
-	m.CommitExistsFunc.appendCall(GitserverClientCommitExistsFuncCall{v0, v1, v2, r0, r1})
+// DocumentationPage delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockLSIFStore) DocumentationPage(v0 context.Context, v1 int, v2 string) (*semantic.DocumentationPageData, error) {
+	r0, r1 := m.DocumentationPageFunc.nextHook()(v0, v1, v2)
+	m.DocumentationPageFunc.appendCall(LSIFStoreDocumentationPageFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the CommitExists method
-// of the parent MockGitserverClient instance is invoked and the hook queue
+// SetDefaultHook sets function that is called when the DocumentationPage
+// method of the parent MockLSIFStore instance is invoked and the hook queue
 // is empty.
-func (f *GitserverClientCommitExistsFunc) SetDefaultHook(hook func(context.Context, int, string) (bool, error)) {
+func (f *LSIFStoreDocumentationPageFunc) SetDefaultHook(hook func(context.Context, int, string) (*semantic.DocumentationPageData, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// CommitExists method of the parent MockGitserverClient instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *GitserverClientCommitExistsFunc) PushHook(hook func(context.Context, int, string) (bool, error)) {
+// DocumentationPage method of the parent MockLSIFStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *LSIFStoreDocumentationPageFunc) PushHook(hook func(context.Context, int, string) (*semantic.DocumentationPageData, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4742,21 +8515,21 @@ func (f *GitserverClientCommitExistsFunc) PushHook(hook func(context.Context, in
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *GitserverClientCommitExistsFunc) SetDefaultReturn(r0 bool, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, string) (bool, error) {
+func (f *LSIFStoreDocumentationPageFunc) SetDefaultReturn(r0 *semantic.DocumentationPageData, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string) (*semantic.DocumentationPageData, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *GitserverClientCommitExistsFunc) PushReturn(r0 bool, r1 error) {
-	f.PushHook(func(context.Context, int, string) (bool, error) {
+func (f *LSIFStoreDocumentationPageFunc) PushReturn(r0 *semantic.DocumentationPageData, r1 error) {
+	f.PushHook(func(context.Context, int, string) (*semantic.DocumentationPageData, error) {
 		return r0, r1
 	})
 }
 
-func (f *GitserverClientCommitExistsFunc) nextHook() func(context.Context, int, string) (bool, error) {
+func (f *LSIFStoreDocumentationPageFunc) nextHook() func(context.Context, int, string) (*semantic.DocumentationPageData, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4769,26 +8542,26 @@ func (f *GitserverClientCommitExistsFunc) nextHook() func(context.Context, int,
 	return hook
 }
 
-func (f *GitserverClientCommitExistsFunc) appendCall(r0 GitserverClientCommitExistsFuncCall) {
+func (f *LSIFStoreDocumentationPageFunc) appendCall(r0 LSIFStoreDocumentationPageFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of GitserverClientCommitExistsFuncCall objects
+// History returns a sequence of LSIFStoreDocumentationPageFuncCall objects
 // describing the invocations of this function.
-func (f *GitserverClientCommitExistsFunc) History() []GitserverClientCommitExistsFuncCall {
+func (f *LSIFStoreDocumentationPageFunc) History() []LSIFStoreDocumentationPageFuncCall {
 	f.mutex.Lock()
-	history := make([]GitserverClientCommitExistsFuncCall, len(f.history))
+	history := make([]LSIFStoreDocumentationPageFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// GitserverClientCommitExistsFuncCall is an object that describes an
-// invocation of method CommitExists on an instance of MockGitserverClient.
-type GitserverClientCommitExistsFuncCall struct {
+// LSIFStoreDocumentationPageFuncCall is an object that describes an
+// invocation of method DocumentationPage on an instance of MockLSIFStore.
+type LSIFStoreDocumentationPageFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -4800,7 +8573,7 @@ type GitserverClientCommitExistsFuncCall struct {
 	Arg2 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 bool
+	Result0 *semantic.DocumentationPageData
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -4808,45 +8581,44 @@ type GitserverClientCommitExistsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c GitserverClientCommitExistsFuncCall) Args() []interface{} {
+func (c LSIFStoreDocumentationPageFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c GitserverClientCommitExistsFuncCall) Results() []interface{} {
+func (c LSIFStoreDocumentationPageFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// GitserverClientCommitGraphFunc describes the behavior when the
-// CommitGraph method of the parent MockGitserverClient instance is invoked.
-type GitserverClientCommitGraphFunc struct {
-	defaultHook func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error)
-	hooks       []func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error)
-	history     []GitserverClientCommitGraphFuncCall
+// LSIFStoreExistsFunc describes the behavior when the Exists method of the
+// parent MockLSIFStore instance is invoked.
+type LSIFStoreExistsFunc struct {
+	defaultHook func(context.Context, int, string) (bool, error)
+	hooks       []func(context.Context, int, string) (bool, error)
+	history     []LSIFStoreExistsFuncCall
 	mutex       sync.Mutex
 }
 
-// CommitGraph delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockGitserverClient) CommitGraph(v0 context.Context, v1 int, v2 gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error) {
-	r0, r1 := m.CommitGraphFunc.nextHook()(v0, v1, v2)
-	m.CommitGraphFunc.appendCall(GitserverClientCommitGraphFuncCall{v0, v1, v2, r0, r1})
+// Exists delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockLSIFStore) Exists(v0 context.Context, v1 int, v2 string) (bool, error) {
+	r0, r1 := m.ExistsFunc.nextHook()(v0, v1, v2)
+	m.ExistsFunc.appendCall(LSIFStoreExistsFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the CommitGraph method
-// of the parent MockGitserverClient instance is invoked and the hook queue
-// is empty.
-func (f *GitserverClientCommitGraphFunc) SetDefaultHook(hook func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error)) {
+// SetDefaultHook sets function that is called when the Exists method of the
+// parent MockLSIFStore instance is invoked and the hook queue is empty.
+func (f *LSIFStoreExistsFunc) SetDefaultHook(hook func(context.Context, int, string) (bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// CommitGraph method of the parent MockGitserverClient instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *GitserverClientCommitGraphFunc) PushHook(hook func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error)) {
+// Exists method of the parent MockLSIFStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *LSIFStoreExistsFunc) PushHook(hook func(context.Context, int, string) (bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4854,21 +8626,21 @@ func (f *GitserverClientCommitGraphFunc) PushHook(hook func(context.Context, int
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *GitserverClientCommitGraphFunc) SetDefaultReturn(r0 *gitserver.CommitGraph, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error) {
+func (f *LSIFStoreExistsFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string) (bool, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *GitserverClientCommitGraphFunc) PushReturn(r0 *gitserver.CommitGraph, r1 error) {
-	f.PushHook(func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error) {
+func (f *LSIFStoreExistsFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, int, string) (bool, error) {
 		return r0, r1
 	})
 }
 
-func (f *GitserverClientCommitGraphFunc) nextHook() func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error) {
+func (f *LSIFStoreExistsFunc) nextHook() func(context.Context, int, string) (bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4881,26 +8653,26 @@ func (f *GitserverClientCommitGraphFunc) nextHook() func(context.Context, int, g
 	return hook
 }
 
-func (f *GitserverClientCommitGraphFunc) appendCall(r0 GitserverClientCommitGraphFuncCall) {
+func (f *LSIFStoreExistsFunc) appendCall(r0 LSIFStoreExistsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of GitserverClientCommitGraphFuncCall objects
-// describing the invocations of this function.
-func (f *GitserverClientCommitGraphFunc) History() []GitserverClientCommitGraphFuncCall {
+// History returns a sequence of LSIFStoreExistsFuncCall objects describing
+// the invocations of this function.
+func (f *LSIFStoreExistsFunc) History() []LSIFStoreExistsFuncCall {
 	f.mutex.Lock()
-	history := make([]GitserverClientCommitGraphFuncCall, len(f.history))
+	history := make([]LSIFStoreExistsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// GitserverClientCommitGraphFuncCall is an object that describes an
-// invocation of method CommitGraph on an instance of MockGitserverClient.
-type GitserverClientCommitGraphFuncCall struct {
+// LSIFStoreExistsFuncCall is an object that describes an invocation of
+// method Exists on an instance of MockLSIFStore.
+type LSIFStoreExistsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -4909,10 +8681,10 @@ type GitserverClientCommitGraphFuncCall struct {
 	Arg1 int
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 gitserver.CommitGraphOptions
+	Arg2 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *gitserver.CommitGraph
+	Result0 bool
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -4920,92 +8692,44 @@ type GitserverClientCommitGraphFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c GitserverClientCommitGraphFuncCall) Args() []interface{} {
+func (c LSIFStoreExistsFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c GitserverClientCommitGraphFuncCall) Results() []interface{} {
+func (c LSIFStoreExistsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// MockIndexEnqueuer is a mock implementation of the IndexEnqueuer interface
-// (from the package
-// github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers)
-// used for unit testing.
-type MockIndexEnqueuer struct {
-	// ForceQueueIndexesForRepositoryFunc is an instance of a mock function
-	// object controlling the behavior of the method
-	// ForceQueueIndexesForRepository.
-	ForceQueueIndexesForRepositoryFunc *IndexEnqueuerForceQueueIndexesForRepositoryFunc
-	// InferIndexConfigurationFunc is an instance of a mock function object
-	// controlling the behavior of the method InferIndexConfiguration.
-	InferIndexConfigurationFunc *IndexEnqueuerInferIndexConfigurationFunc
-}
-
-// NewMockIndexEnqueuer creates a new mock of the IndexEnqueuer interface.
-// All methods return zero values for all results, unless overwritten.
-func NewMockIndexEnqueuer() *MockIndexEnqueuer {
-	return &MockIndexEnqueuer{
-		ForceQueueIndexesForRepositoryFunc: &IndexEnqueuerForceQueueIndexesForRepositoryFunc{
-			defaultHook: func(context.Context, int) error {
-				return nil
-			},
-		},
-		InferIndexConfigurationFunc: &IndexEnqueuerInferIndexConfigurationFunc{
-			defaultHook: func(context.Context, int) (*config.IndexConfiguration, error) {
-				return nil, nil
-			},
-		},
-	}
-}
-
-// NewMockIndexEnqueuerFrom creates a new mock of the MockIndexEnqueuer
-// interface. All methods delegate to the given implementation, unless
-// overwritten.
-func NewMockIndexEnqueuerFrom(i IndexEnqueuer) *MockIndexEnqueuer {
-	return &MockIndexEnqueuer{
-		ForceQueueIndexesForRepositoryFunc: &IndexEnqueuerForceQueueIndexesForRepositoryFunc{
-			defaultHook: i.ForceQueueIndexesForRepository,
-		},
-		InferIndexConfigurationFunc: &IndexEnqueuerInferIndexConfigurationFunc{
-			defaultHook: i.InferIndexConfiguration,
-		},
-	}
-}
-
-// IndexEnqueuerForceQueueIndexesForRepositoryFunc describes the behavior
-// when the ForceQueueIndexesForRepository method of the parent
-// MockIndexEnqueuer instance is invoked.
-type IndexEnqueuerForceQueueIndexesForRepositoryFunc struct {
-	defaultHook func(context.Context, int) error
-	hooks       []func(context.Context, int) error
-	history     []IndexEnqueuerForceQueueIndexesForRepositoryFuncCall
+// LSIFStoreHoverFunc describes the behavior when the Hover method of the
+// parent MockLSIFStore instance is invoked.
+type LSIFStoreHoverFunc struct {
+	defaultHook func(context.Context, int, string, int, int, lsifstore.HoverFormat) (string, lsifstore.Range, bool, error)
+	hooks       []func(context.Context, int, string, int, int, lsifstore.HoverFormat) (string, lsifstore.Range, bool, error)
+	history     []LSIFStoreHoverFuncCall
 	mutex       sync.Mutex
 }
 
-// ForceQueueIndexesForRepository delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockIndexEnqueuer) ForceQueueIndexesForRepository(v0 context.Context, v1 int) error {
-	r0 := m.ForceQueueIndexesForRepositoryFunc.nextHook()(v0, v1)
-	m.ForceQueueIndexesForRepositoryFunc.appendCall(IndexEnqueuerForceQueueIndexesForRepositoryFuncCall{v0, v1, r0})
-	return r0
+// Hover delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockLSIFStore) Hover(v0 context.Context, v1 int, v2 string, v3 int, v4 int, v5 lsifstore.HoverFormat) (string, lsifstore.Range, bool, error) {
+	r0, r1, r2, r3 := m.HoverFunc.nextHook()(v0, v1, v2, v3, v4, v5)
+	m.HoverFunc.appendCall(LSIFStoreHoverFuncCall{v0, v1, v2, v3, v4, v5, r0, r1, r2, r3})
+	return r0, r1, r2, r3
 }
 
-// SetDefaultHook sets function that is called when the
-// ForceQueueIndexesForRepository method of the parent MockIndexEnqueuer
-// instance is invoked and the hook queue is empty.
-func (f *IndexEnqueuerForceQueueIndexesForRepositoryFunc) SetDefaultHook(hook func(context.Context, int) error) {
+// SetDefaultHook sets function that is called when the Hover method of the
+// parent MockLSIFStore instance is invoked and the hook queue is empty.
+func (f *LSIFStoreHoverFunc) SetDefaultHook(hook func(context.Context, int, string, int, int, lsifstore.HoverFormat) (string, lsifstore.Range, bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ForceQueueIndexesForRepository method of the parent MockIndexEnqueuer
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *IndexEnqueuerForceQueueIndexesForRepositoryFunc) PushHook(hook func(context.Context, int) error) {
+// Hover method of the parent MockLSIFStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *LSIFStoreHoverFunc) PushHook(hook func(context.Context, int, string, int, int, lsifstore.HoverFormat) (string, lsifstore.Range, bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -5013,21 +8737,21 @@ func (f *IndexEnqueuerForceQueueIndexesForRepositoryFunc) PushHook(hook func(con
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *IndexEnqueuerForceQueueIndexesForRepositoryFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int) error {
-		return r0
+func (f *LSIFStoreHoverFunc) SetDefaultReturn(r0 string, r1 lsifstore.Range, r2 bool, r3 error) {
+	f.SetDefaultHook(func(context.Context, int, string, int, int, lsifstore.HoverFormat) (string, lsifstore.Range, bool, error) {
+		return r0, r1, r2, r3
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *IndexEnqueuerForceQueueIndexesForRepositoryFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int) error {
-		return r0
+func (f *LSIFStoreHoverFunc) PushReturn(r0 string, r1 lsifstore.Range, r2 bool, r3 error) {
+	f.PushHook(func(context.Context, int, string, int, int, lsifstore.HoverFormat) (string, lsifstore.Range, bool, error) {
+		return r0, r1, r2, r3
 	})
 }
 
-func (f *IndexEnqueuerForceQueueIndexesForRepositoryFunc) nextHook() func(context.Context, int) error {
+func (f *LSIFStoreHoverFunc) nextHook() func(context.Context, int, string, int, int, lsifstore.HoverFormat) (string, lsifstore.Range, bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -5040,82 +8764,99 @@ func (f *IndexEnqueuerForceQueueIndexesForRepositoryFunc) nextHook() func(contex
 	return hook
 }
 
-func (f *IndexEnqueuerForceQueueIndexesForRepositoryFunc) appendCall(r0 IndexEnqueuerForceQueueIndexesForRepositoryFuncCall) {
+func (f *LSIFStoreHoverFunc) appendCall(r0 LSIFStoreHoverFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// IndexEnqueuerForceQueueIndexesForRepositoryFuncCall objects describing
+// History returns a sequence of LSIFStoreHoverFuncCall objects describing
 // the invocations of this function.
-func (f *IndexEnqueuerForceQueueIndexesForRepositoryFunc) History() []IndexEnqueuerForceQueueIndexesForRepositoryFuncCall {
+func (f *LSIFStoreHoverFunc) History() []LSIFStoreHoverFuncCall {
 	f.mutex.Lock()
-	history := make([]IndexEnqueuerForceQueueIndexesForRepositoryFuncCall, len(f.history))
+	history := make([]LSIFStoreHoverFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// IndexEnqueuerForceQueueIndexesForRepositoryFuncCall is an object that
-// describes an invocation of method ForceQueueIndexesForRepository on an
-// instance of MockIndexEnqueuer.
-type IndexEnqueuerForceQueueIndexesForRepositoryFuncCall struct {
+// LSIFStoreHoverFuncCall is an object that describes an invocation of
+// method Hover on an instance of MockLSIFStore.
+type LSIFStoreHoverFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
 	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 int
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 lsifstore.HoverFormat
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 lsifstore.Range
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 bool
+	// Result3 is the value of the 4th result returned from this method
+	// invocation.
+	Result3 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c IndexEnqueuerForceQueueIndexesForRepositoryFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c LSIFStoreHoverFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c IndexEnqueuerForceQueueIndexesForRepositoryFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c LSIFStoreHoverFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2, c.Result3}
 }
 
-// IndexEnqueuerInferIndexConfigurationFunc describes the behavior when the
-// InferIndexConfiguration method of the parent MockIndexEnqueuer instance
-// is invoked.
-type IndexEnqueuerInferIndexConfigurationFunc struct {
-	defaultHook func(context.Context, int) (*config.IndexConfiguration, error)
-	hooks       []func(context.Context, int) (*config.IndexConfiguration, error)
-	history     []IndexEnqueuerInferIndexConfigurationFuncCall
+// LSIFStoreImplementationsFunc describes the behavior when the
+// Implementations method of the parent MockLSIFStore instance is invoked.
+type LSIFStoreImplementationsFunc struct {
+	defaultHook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)
+	hooks       []func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)
+	history     []LSIFStoreImplementationsFuncCall
 	mutex       sync.Mutex
 }
 
-// InferIndexConfiguration delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockIndexEnqueuer) InferIndexConfiguration(v0 context.Context, v1 int) (*config.IndexConfiguration, error) {
-	r0, r1 := m.InferIndexConfigurationFunc.nextHook()(v0, v1)
-	m.InferIndexConfigurationFunc.appendCall(IndexEnqueuerInferIndexConfigurationFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// Implementations delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockLSIFStore) Implementations(v0 context.Context, v1 int, v2 string, v3 int, v4 int, v5 int, v6 int) ([]lsifstore.Location, int, error) {
+	r0, r1, r2 := m.ImplementationsFunc.nextHook()(v0, v1, v2, v3, v4, v5, v6)
+	m.ImplementationsFunc.appendCall(LSIFStoreImplementationsFuncCall{v0, v1, v2, v3, v4, v5, v6, r0, r1, r2})
+	return r0, r1, r2
 }
 
-// SetDefaultHook sets function that is called when the
-// InferIndexConfiguration method of the parent MockIndexEnqueuer instance
-// is invoked and the hook queue is empty.
-func (f *IndexEnqueuerInferIndexConfigurationFunc) SetDefaultHook(hook func(context.Context, int) (*config.IndexConfiguration, error)) {
+// SetDefaultHook sets function that is called when the Implementations
+// method of the parent MockLSIFStore instance is invoked and the hook queue
+// is empty.
+func (f *LSIFStoreImplementationsFunc) SetDefaultHook(hook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// InferIndexConfiguration method of the parent MockIndexEnqueuer instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *IndexEnqueuerInferIndexConfigurationFunc) PushHook(hook func(context.Context, int) (*config.IndexConfiguration, error)) {
+// Implementations method of the parent MockLSIFStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *LSIFStoreImplementationsFunc) PushHook(hook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -5123,21 +8864,21 @@ func (f *IndexEnqueuerInferIndexConfigurationFunc) PushHook(hook func(context.Co
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *IndexEnqueuerInferIndexConfigurationFunc) SetDefaultReturn(r0 *config.IndexConfiguration, r1 error) {
-	f.SetDefaultHook(func(context.Context, int) (*config.IndexConfiguration, error) {
-		return r0, r1
+func (f *LSIFStoreImplementationsFunc) SetDefaultReturn(r0 []lsifstore.Location, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+		return r0, r1, r2
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *IndexEnqueuerInferIndexConfigurationFunc) PushReturn(r0 *config.IndexConfiguration, r1 error) {
-	f.PushHook(func(context.Context, int) (*config.IndexConfiguration, error) {
-		return r0, r1
+func (f *LSIFStoreImplementationsFunc) PushReturn(r0 []lsifstore.Location, r1 int, r2 error) {
+	f.PushHook(func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+		return r0, r1, r2
 	})
 }
 
-func (f *IndexEnqueuerInferIndexConfigurationFunc) nextHook() func(context.Context, int) (*config.IndexConfiguration, error) {
+func (f *LSIFStoreImplementationsFunc) nextHook() func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -5150,215 +8891,100 @@ func (f *IndexEnqueuerInferIndexConfigurationFunc) nextHook() func(context.Conte
 	return hook
 }
 
-func (f *IndexEnqueuerInferIndexConfigurationFunc) appendCall(r0 IndexEnqueuerInferIndexConfigurationFuncCall) {
+func (f *LSIFStoreImplementationsFunc) appendCall(r0 LSIFStoreImplementationsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
-	f.mutex.Unlock()
-}
-
-// History returns a sequence of
-// IndexEnqueuerInferIndexConfigurationFuncCall objects describing the
-// invocations of this function.
-func (f *IndexEnqueuerInferIndexConfigurationFunc) History() []IndexEnqueuerInferIndexConfigurationFuncCall {
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of LSIFStoreImplementationsFuncCall objects
+// describing the invocations of this function.
+func (f *LSIFStoreImplementationsFunc) History() []LSIFStoreImplementationsFuncCall {
 	f.mutex.Lock()
-	history := make([]IndexEnqueuerInferIndexConfigurationFuncCall, len(f.history))
+	history := make([]LSIFStoreImplementationsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// IndexEnqueuerInferIndexConfigurationFuncCall is an object that describes
-// an invocation of method InferIndexConfiguration on an instance of
-// MockIndexEnqueuer.
-type IndexEnqueuerInferIndexConfigurationFuncCall struct {
+// LSIFStoreImplementationsFuncCall is an object that describes an
+// invocation of method Implementations on an instance of MockLSIFStore.
+type LSIFStoreImplementationsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
 	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 int
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 int
+	// Arg6 is the value of the 7th argument passed to this method
+	// invocation.
+	Arg6 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *config.IndexConfiguration
+	Result0 []lsifstore.Location
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 error
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c IndexEnqueuerInferIndexConfigurationFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c LSIFStoreImplementationsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5, c.Arg6}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c IndexEnqueuerInferIndexConfigurationFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
-}
-
-// MockLSIFStore is a mock implementation of the LSIFStore interface (from
-// the package
-// github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers)
-// used for unit testing.
-type MockLSIFStore struct {
-	// BulkMonikerResultsFunc is an instance of a mock function object
-	// controlling the behavior of the method BulkMonikerResults.
-	BulkMonikerResultsFunc *LSIFStoreBulkMonikerResultsFunc
-	// DefinitionsFunc is an instance of a mock function object controlling
-	// the behavior of the method Definitions.
-	DefinitionsFunc *LSIFStoreDefinitionsFunc
-	// DiagnosticsFunc is an instance of a mock function object controlling
-	// the behavior of the method Diagnostics.
-	DiagnosticsFunc *LSIFStoreDiagnosticsFunc
-	// DocumentationPageFunc is an instance of a mock function object
-	// controlling the behavior of the method DocumentationPage.
-	DocumentationPageFunc *LSIFStoreDocumentationPageFunc
-	// ExistsFunc is an instance of a mock function object controlling the
-	// behavior of the method Exists.
-	ExistsFunc *LSIFStoreExistsFunc
-	// HoverFunc is an instance of a mock function object controlling the
-	// behavior of the method Hover.
-	HoverFunc *LSIFStoreHoverFunc
-	// MonikersByPositionFunc is an instance of a mock function object
-	// controlling the behavior of the method MonikersByPosition.
-	MonikersByPositionFunc *LSIFStoreMonikersByPositionFunc
-	// PackageInformationFunc is an instance of a mock function object
-	// controlling the behavior of the method PackageInformation.
-	PackageInformationFunc *LSIFStorePackageInformationFunc
-	// RangesFunc is an instance of a mock function object controlling the
-	// behavior of the method Ranges.
-	RangesFunc *LSIFStoreRangesFunc
-	// ReferencesFunc is an instance of a mock function object controlling
-	// the behavior of the method References.
-	ReferencesFunc *LSIFStoreReferencesFunc
-}
-
-// NewMockLSIFStore creates a new mock of the LSIFStore interface. All
-// methods return zero values for all results, unless overwritten.
-func NewMockLSIFStore() *MockLSIFStore {
-	return &MockLSIFStore{
-		BulkMonikerResultsFunc: &LSIFStoreBulkMonikerResultsFunc{
-			defaultHook: func(context.Context, string, []int, []semantic.MonikerData, int, int) ([]lsifstore.Location, int, error) {
-				return nil, 0, nil
-			},
-		},
-		DefinitionsFunc: &LSIFStoreDefinitionsFunc{
-			defaultHook: func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
-				return nil, 0, nil
-			},
-		},
-		DiagnosticsFunc: &LSIFStoreDiagnosticsFunc{
-			defaultHook: func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error) {
-				return nil, 0, nil
-			},
-		},
-		DocumentationPageFunc: &LSIFStoreDocumentationPageFunc{
-			defaultHook: func(context.Context, int, string) (*semantic.DocumentationPageData, error) {
-				return nil, nil
-			},
-		},
-		ExistsFunc: &LSIFStoreExistsFunc{
-			defaultHook: func(context.Context, int, string) (bool, error) {
-				return false, nil
-			},
-		},
-		HoverFunc: &LSIFStoreHoverFunc{
-			defaultHook: func(context.Context, int, string, int, int) (string, lsifstore.Range, bool, error) {
-				return "", lsifstore.Range{}, false, nil
-			},
-		},
-		MonikersByPositionFunc: &LSIFStoreMonikersByPositionFunc{
-			defaultHook: func(context.Context, int, string, int, int) ([][]semantic.MonikerData, error) {
-				return nil, nil
-			},
-		},
-		PackageInformationFunc: &LSIFStorePackageInformationFunc{
-			defaultHook: func(context.Context, int, string, string) (semantic.PackageInformationData, bool, error) {
-				return semantic.PackageInformationData{}, false, nil
-			},
-		},
-		RangesFunc: &LSIFStoreRangesFunc{
-			defaultHook: func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error) {
-				return nil, nil
-			},
-		},
-		ReferencesFunc: &LSIFStoreReferencesFunc{
-			defaultHook: func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
-				return nil, 0, nil
-			},
-		},
-	}
-}
-
-// NewMockLSIFStoreFrom creates a new mock of the MockLSIFStore interface.
-// All methods delegate to the given implementation, unless overwritten.
-func NewMockLSIFStoreFrom(i LSIFStore) *MockLSIFStore {
-	return &MockLSIFStore{
-		BulkMonikerResultsFunc: &LSIFStoreBulkMonikerResultsFunc{
-			defaultHook: i.BulkMonikerResults,
-		},
-		DefinitionsFunc: &LSIFStoreDefinitionsFunc{
-			defaultHook: i.Definitions,
-		},
-		DiagnosticsFunc: &LSIFStoreDiagnosticsFunc{
-			defaultHook: i.Diagnostics,
-		},
-		DocumentationPageFunc: &LSIFStoreDocumentationPageFunc{
-			defaultHook: i.DocumentationPage,
-		},
-		ExistsFunc: &LSIFStoreExistsFunc{
-			defaultHook: i.Exists,
-		},
-		HoverFunc: &LSIFStoreHoverFunc{
-			defaultHook: i.Hover,
-		},
-		MonikersByPositionFunc: &LSIFStoreMonikersByPositionFunc{
-			defaultHook: i.MonikersByPosition,
-		},
-		PackageInformationFunc: &LSIFStorePackageInformationFunc{
-			defaultHook: i.PackageInformation,
-		},
-		RangesFunc: &LSIFStoreRangesFunc{
-			defaultHook: i.Ranges,
-		},
-		ReferencesFunc: &LSIFStoreReferencesFunc{
-			defaultHook: i.References,
-		},
-	}
+func (c LSIFStoreImplementationsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// LSIFStoreBulkMonikerResultsFunc describes the behavior when the
-// BulkMonikerResults method of the parent MockLSIFStore instance is
+// LSIFStoreMonikersByPositionFunc describes the behavior when the
+// MonikersByPosition method of the parent MockLSIFStore instance is
 // invoked.
-type LSIFStoreBulkMonikerResultsFunc struct {
-	defaultHook func(context.Context, string, []int, []semantic.MonikerData, int, int) ([]lsifstore.Location, int, error)
-	hooks       []func(context.Context, string, []int, []semantic.MonikerData, int, int) ([]lsifstore.Location, int, error)
-	history     []LSIFStoreBulkMonikerResultsFuncCall
+type LSIFStoreMonikersByPositionFunc struct {
+	defaultHook func(context.Context, int, string, int, int) ([][]semantic.MonikerData, error)
+	hooks       []func(context.Context, int, string, int, int) ([][]semantic.MonikerData, error)
+	history     []LSIFStoreMonikersByPositionFuncCall
 	mutex       sync.Mutex
 }
 
-// BulkMonikerResults delegates to the next hook function in the queue and
+// MonikersByPosition delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockLSIFStore) BulkMonikerResults(v0 context.Context, v1 string, v2 []int, v3 []semantic.MonikerData, v4 int, v5 int) ([]lsifstore.Location, int, error) {
-	r0, r1, r2 := m.BulkMonikerResultsFunc.nextHook()(v0, v1, v2, v3, v4, v5)
-	m.BulkMonikerResultsFunc.appendCall(LSIFStoreBulkMonikerResultsFuncCall{v0, v1, v2, v3, v4, v5, r0, r1, r2})
-	return r0, r1, r2
+func (m *MockLSIFStore) MonikersByPosition(v0 context.Context, v1 int, v2 string, v3 int, v4 int) ([][]semantic.MonikerData, error) {
+	r0, r1 := m.MonikersByPositionFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.MonikersByPositionFunc.appendCall(LSIFStoreMonikersByPositionFuncCall{v0, v1, v2, v3, v4, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the BulkMonikerResults
+// SetDefaultHook sets function that is called when the MonikersByPosition
 // method of the parent MockLSIFStore instance is invoked and the hook queue
 // is empty.
-func (f *LSIFStoreBulkMonikerResultsFunc) SetDefaultHook(hook func(context.Context, string, []int, []semantic.MonikerData, int, int) ([]lsifstore.Location, int, error)) {
+func (f *LSIFStoreMonikersByPositionFunc) SetDefaultHook(hook func(context.Context, int, string, int, int) ([][]semantic.MonikerData, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// BulkMonikerResults method of the parent MockLSIFStore instance invokes
+// MonikersByPosition method of the parent MockLSIFStore instance invokes
 // the hook at the front of the queue and discards it. After the queue is
 // empty, the default hook function is invoked for any future action.
-func (f *LSIFStoreBulkMonikerResultsFunc) PushHook(hook func(context.Context, string, []int, []semantic.MonikerData, int, int) ([]lsifstore.Location, int, error)) {
+func (f *LSIFStoreMonikersByPositionFunc) PushHook(hook func(context.Context, int, string, int, int) ([][]semantic.MonikerData, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -5366,21 +8992,21 @@ func (f *LSIFStoreBulkMonikerResultsFunc) PushHook(hook func(context.Context, st
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *LSIFStoreBulkMonikerResultsFunc) SetDefaultReturn(r0 []lsifstore.Location, r1 int, r2 error) {
-	f.SetDefaultHook(func(context.Context, string, []int, []semantic.MonikerData, int, int) ([]lsifstore.Location, int, error) {
-		return r0, r1, r2
+func (f *LSIFStoreMonikersByPositionFunc) SetDefaultReturn(r0 [][]semantic.MonikerData, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string, int, int) ([][]semantic.MonikerData, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *LSIFStoreBulkMonikerResultsFunc) PushReturn(r0 []lsifstore.Location, r1 int, r2 error) {
-	f.PushHook(func(context.Context, string, []int, []semantic.MonikerData, int, int) ([]lsifstore.Location, int, error) {
-		return r0, r1, r2
+func (f *LSIFStoreMonikersByPositionFunc) PushReturn(r0 [][]semantic.MonikerData, r1 error) {
+	f.PushHook(func(context.Context, int, string, int, int) ([][]semantic.MonikerData, error) {
+		return r0, r1
 	})
 }
 
-func (f *LSIFStoreBulkMonikerResultsFunc) nextHook() func(context.Context, string, []int, []semantic.MonikerData, int, int) ([]lsifstore.Location, int, error) {
+func (f *LSIFStoreMonikersByPositionFunc) nextHook() func(context.Context, int, string, int, int) ([][]semantic.MonikerData, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -5393,96 +9019,91 @@ func (f *LSIFStoreBulkMonikerResultsFunc) nextHook() func(context.Context, strin
 	return hook
 }
 
-func (f *LSIFStoreBulkMonikerResultsFunc) appendCall(r0 LSIFStoreBulkMonikerResultsFuncCall) {
+func (f *LSIFStoreMonikersByPositionFunc) appendCall(r0 LSIFStoreMonikersByPositionFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of LSIFStoreBulkMonikerResultsFuncCall objects
+// History returns a sequence of LSIFStoreMonikersByPositionFuncCall objects
 // describing the invocations of this function.
-func (f *LSIFStoreBulkMonikerResultsFunc) History() []LSIFStoreBulkMonikerResultsFuncCall {
+func (f *LSIFStoreMonikersByPositionFunc) History() []LSIFStoreMonikersByPositionFuncCall {
 	f.mutex.Lock()
-	history := make([]LSIFStoreBulkMonikerResultsFuncCall, len(f.history))
+	history := make([]LSIFStoreMonikersByPositionFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// LSIFStoreBulkMonikerResultsFuncCall is an object that describes an
-// invocation of method BulkMonikerResults on an instance of MockLSIFStore.
-type LSIFStoreBulkMonikerResultsFuncCall struct {
+// LSIFStoreMonikersByPositionFuncCall is an object that describes an
+// invocation of method MonikersByPosition on an instance of MockLSIFStore.
+type LSIFStoreMonikersByPositionFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 string
+	Arg1 int
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 []int
+	Arg2 string
 	// Arg3 is the value of the 4th argument passed to this method
 	// invocation.
-	Arg3 []semantic.MonikerData
+	Arg3 int
 	// Arg4 is the value of the 5th argument passed to this method
 	// invocation.
 	Arg4 int
-	// Arg5 is the value of the 6th argument passed to this method
-	// invocation.
-	Arg5 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []lsifstore.Location
+	Result0 [][]semantic.MonikerData
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 int
-	// Result2 is the value of the 3rd result returned from this method
-	// invocation.
-	Result2 error
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c LSIFStoreBulkMonikerResultsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5}
+func (c LSIFStoreMonikersByPositionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c LSIFStoreBulkMonikerResultsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2}
-}
-
-// LSIFStoreDefinitionsFunc describes the behavior when the Definitions
-// method of the parent MockLSIFStore instance is invoked.
-type LSIFStoreDefinitionsFunc struct {
-	defaultHook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)
-	hooks       []func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)
-	history     []LSIFStoreDefinitionsFuncCall
+func (c LSIFStoreMonikersByPositionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// LSIFStorePackageInformationFunc describes the behavior when the
+// PackageInformation method of the parent MockLSIFStore instance is
+// invoked.
+type LSIFStorePackageInformationFunc struct {
+	defaultHook func(context.Context, int, string, string) (semantic.PackageInformationData, bool, error)
+	hooks       []func(context.Context, int, string, string) (semantic.PackageInformationData, bool, error)
+	history     []LSIFStorePackageInformationFuncCall
 	mutex       sync.Mutex
 }
 
-// Definitions delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockLSIFStore) Definitions(v0 context.Context, v1 int, v2 string, v3 int, v4 int, v5 int, v6 int) ([]lsifstore.Location, int, error) {
-	r0, r1, r2 := m.DefinitionsFunc.nextHook()(v0, v1, v2, v3, v4, v5, v6)
-	m.DefinitionsFunc.appendCall(LSIFStoreDefinitionsFuncCall{v0, v1, v2, v3, v4, v5, v6, r0, r1, r2})
+// PackageInformation delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockLSIFStore) PackageInformation(v0 context.Context, v1 int, v2 string, v3 string) (semantic.PackageInformationData, bool, error) {
+	r0, r1, r2 := m.PackageInformationFunc.nextHook()(v0, v1, v2, v3)
+	m.PackageInformationFunc.appendCall(LSIFStorePackageInformationFuncCall{v0, v1, v2, v3, r0, r1, r2})
 	return r0, r1, r2
 }
 
-// SetDefaultHook sets function that is called when the Definitions method
-// of the parent MockLSIFStore instance is invoked and the hook queue is
-// empty.
-func (f *LSIFStoreDefinitionsFunc) SetDefaultHook(hook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)) {
+// SetDefaultHook sets function that is called when the PackageInformation
+// method of the parent MockLSIFStore instance is invoked and the hook queue
+// is empty.
+func (f *LSIFStorePackageInformationFunc) SetDefaultHook(hook func(context.Context, int, string, string) (semantic.PackageInformationData, bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Definitions method of the parent MockLSIFStore instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *LSIFStoreDefinitionsFunc) PushHook(hook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)) {
+// PackageInformation method of the parent MockLSIFStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *LSIFStorePackageInformationFunc) PushHook(hook func(context.Context, int, string, string) (semantic.PackageInformationData, bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -5490,21 +9111,21 @@ func (f *LSIFStoreDefinitionsFunc) PushHook(hook func(context.Context, int, stri
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *LSIFStoreDefinitionsFunc) SetDefaultReturn(r0 []lsifstore.Location, r1 int, r2 error) {
-	f.SetDefaultHook(func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+func (f *LSIFStorePackageInformationFunc) SetDefaultReturn(r0 semantic.PackageInformationData, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, int, string, string) (semantic.PackageInformationData, bool, error) {
 		return r0, r1, r2
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *LSIFStoreDefinitionsFunc) PushReturn(r0 []lsifstore.Location, r1 int, r2 error) {
-	f.PushHook(func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+func (f *LSIFStorePackageInformationFunc) PushReturn(r0 semantic.PackageInformationData, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, int, string, string) (semantic.PackageInformationData, bool, error) {
 		return r0, r1, r2
 	})
 }
 
-func (f *LSIFStoreDefinitionsFunc) nextHook() func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+func (f *LSIFStorePackageInformationFunc) nextHook() func(context.Context, int, string, string) (semantic.PackageInformationData, bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -5517,26 +9138,26 @@ func (f *LSIFStoreDefinitionsFunc) nextHook() func(context.Context, int, string,
 	return hook
 }
 
-func (f *LSIFStoreDefinitionsFunc) appendCall(r0 LSIFStoreDefinitionsFuncCall) {
+func (f *LSIFStorePackageInformationFunc) appendCall(r0 LSIFStorePackageInformationFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of LSIFStoreDefinitionsFuncCall objects
+// History returns a sequence of LSIFStorePackageInformationFuncCall objects
 // describing the invocations of this function.
-func (f *LSIFStoreDefinitionsFunc) History() []LSIFStoreDefinitionsFuncCall {
+func (f *LSIFStorePackageInformationFunc) History() []LSIFStorePackageInformationFuncCall {
 	f.mutex.Lock()
-	history := make([]LSIFStoreDefinitionsFuncCall, len(f.history))
+	history := make([]LSIFStorePackageInformationFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// LSIFStoreDefinitionsFuncCall is an object that describes an invocation of
-// method Definitions on an instance of MockLSIFStore.
-type LSIFStoreDefinitionsFuncCall struct {
+// LSIFStorePackageInformationFuncCall is an object that describes an
+// invocation of method PackageInformation on an instance of MockLSIFStore.
+type LSIFStorePackageInformationFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -5548,22 +9169,13 @@ type LSIFStoreDefinitionsFuncCall struct {
 	Arg2 string
 	// Arg3 is the value of the 4th argument passed to this method
 	// invocation.
-	Arg3 int
-	// Arg4 is the value of the 5th argument passed to this method
-	// invocation.
-	Arg4 int
-	// Arg5 is the value of the 6th argument passed to this method
-	// invocation.
-	Arg5 int
-	// Arg6 is the value of the 7th argument passed to this method
-	// invocation.
-	Arg6 int
+	Arg3 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []lsifstore.Location
+	Result0 semantic.PackageInformationData
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 int
+	Result1 bool
 	// Result2 is the value of the 3rd result returned from this method
 	// invocation.
 	Result2 error
@@ -5571,45 +9183,44 @@ type LSIFStoreDefinitionsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c LSIFStoreDefinitionsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5, c.Arg6}
+func (c LSIFStorePackageInformationFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c LSIFStoreDefinitionsFuncCall) Results() []interface{} {
+func (c LSIFStorePackageInformationFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// LSIFStoreDiagnosticsFunc describes the behavior when the Diagnostics
-// method of the parent MockLSIFStore instance is invoked.
-type LSIFStoreDiagnosticsFunc struct {
-	defaultHook func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error)
-	hooks       []func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error)
-	history     []LSIFStoreDiagnosticsFuncCall
+// LSIFStoreRangesFunc describes the behavior when the Ranges method of the
+// parent MockLSIFStore instance is invoked.
+type LSIFStoreRangesFunc struct {
+	defaultHook func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error)
+	hooks       []func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error)
+	history     []LSIFStoreRangesFuncCall
 	mutex       sync.Mutex
 }
 
-// Diagnostics delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockLSIFStore) Diagnostics(v0 context.Context, v1 int, v2 string, v3 int, v4 int) ([]lsifstore.Diagnostic, int, error) {
-	r0, r1, r2 := m.DiagnosticsFunc.nextHook()(v0, v1, v2, v3, v4)
-	m.DiagnosticsFunc.appendCall(LSIFStoreDiagnosticsFuncCall{v0, v1, v2, v3, v4, r0, r1, r2})
-	return r0, r1, r2
+// Ranges delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockLSIFStore) Ranges(v0 context.Context, v1 int, v2 string, v3 int, v4 int) ([]lsifstore.CodeIntelligenceRange, error) {
+	r0, r1 := m.RangesFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.RangesFunc.appendCall(LSIFStoreRangesFuncCall{v0, v1, v2, v3, v4, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Diagnostics method
-// of the parent MockLSIFStore instance is invoked and the hook queue is
-// empty.
-func (f *LSIFStoreDiagnosticsFunc) SetDefaultHook(hook func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error)) {
+// SetDefaultHook sets function that is called when the Ranges method of the
+// parent MockLSIFStore instance is invoked and the hook queue is empty.
+func (f *LSIFStoreRangesFunc) SetDefaultHook(hook func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Diagnostics method of the parent MockLSIFStore instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
+// Ranges method of the parent MockLSIFStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *LSIFStoreDiagnosticsFunc) PushHook(hook func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error)) {
+func (f *LSIFStoreRangesFunc) PushHook(hook func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -5617,21 +9228,21 @@ func (f *LSIFStoreDiagnosticsFunc) PushHook(hook func(context.Context, int, stri
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *LSIFStoreDiagnosticsFunc) SetDefaultReturn(r0 []lsifstore.Diagnostic, r1 int, r2 error) {
-	f.SetDefaultHook(func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error) {
-		return r0, r1, r2
+func (f *LSIFStoreRangesFunc) SetDefaultReturn(r0 []lsifstore.CodeIntelligenceRange, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *LSIFStoreDiagnosticsFunc) PushReturn(r0 []lsifstore.Diagnostic, r1 int, r2 error) {
-	f.PushHook(func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error) {
-		return r0, r1, r2
+func (f *LSIFStoreRangesFunc) PushReturn(r0 []lsifstore.CodeIntelligenceRange, r1 error) {
+	f.PushHook(func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error) {
+		return r0, r1
 	})
 }
 
-func (f *LSIFStoreDiagnosticsFunc) nextHook() func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error) {
+func (f *LSIFStoreRangesFunc) nextHook() func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -5644,26 +9255,26 @@ func (f *LSIFStoreDiagnosticsFunc) nextHook() func(context.Context, int, string,
 	return hook
 }
 
-func (f *LSIFStoreDiagnosticsFunc) appendCall(r0 LSIFStoreDiagnosticsFuncCall) {
+func (f *LSIFStoreRangesFunc) appendCall(r0 LSIFStoreRangesFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of LSIFStoreDiagnosticsFuncCall objects
-// describing the invocations of this function.
-func (f *LSIFStoreDiagnosticsFunc) History() []LSIFStoreDiagnosticsFuncCall {
+// History returns a sequence of LSIFStoreRangesFuncCall objects describing
+// the invocations of this function.
+func (f *LSIFStoreRangesFunc) History() []LSIFStoreRangesFuncCall {
 	f.mutex.Lock()
-	history := make([]LSIFStoreDiagnosticsFuncCall, len(f.history))
+	history := make([]LSIFStoreRangesFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// LSIFStoreDiagnosticsFuncCall is an object that describes an invocation of
-// method Diagnostics on an instance of MockLSIFStore.
-type LSIFStoreDiagnosticsFuncCall struct {
+// LSIFStoreRangesFuncCall is an object that describes an invocation of
+// method Ranges on an instance of MockLSIFStore.
+type LSIFStoreRangesFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -5681,56 +9292,53 @@ type LSIFStoreDiagnosticsFuncCall struct {
 	Arg4 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []lsifstore.Diagnostic
+	Result0 []lsifstore.CodeIntelligenceRange
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 int
-	// Result2 is the value of the 3rd result returned from this method
-	// invocation.
-	Result2 error
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c LSIFStoreDiagnosticsFuncCall) Args() []interface{} {
+func (c LSIFStoreRangesFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c LSIFStoreDiagnosticsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2}
+func (c LSIFStoreRangesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// LSIFStoreDocumentationPageFunc describes the behavior when the
-// DocumentationPage method of the parent MockLSIFStore instance is invoked.
-type LSIFStoreDocumentationPageFunc struct {
-	defaultHook func(context.Context, int, string) (*semantic.DocumentationPageData, error)
-	hooks       []func(context.Context, int, string) (*semantic.DocumentationPageData, error)
-	history     []LSIFStoreDocumentationPageFuncCall
+// LSIFStoreReferenceCountFunc describes the behavior when the
+// ReferenceCount method of the parent MockLSIFStore instance is invoked.
+type LSIFStoreReferenceCountFunc struct {
+	defaultHook func(context.Context, int, string, int, int) (int, error)
+	hooks       []func(context.Context, int, string, int, int) (int, error)
+	history     []LSIFStoreReferenceCountFuncCall
 	mutex       sync.Mutex
 }
 
-// DocumentationPage delegates to the next hook function in the queue and
+// ReferenceCount delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockLSIFStore) DocumentationPage(v0 context.Context, v1 int, v2 string) (*semantic.DocumentationPageData, error) {
-	r0, r1 := m.DocumentationPageFunc.nextHook()(v0, v1, v2)
-	m.DocumentationPageFunc.appendCall(LSIFStoreDocumentationPageFuncCall{v0, v1, v2, r0, r1})
+func (m *MockLSIFStore) ReferenceCount(v0 context.Context, v1 int, v2 string, v3 int, v4 int) (int, error) {
+	r0, r1 := m.ReferenceCountFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.ReferenceCountFunc.appendCall(LSIFStoreReferenceCountFuncCall{v0, v1, v2, v3, v4, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the DocumentationPage
+// SetDefaultHook sets function that is called when the ReferenceCount
 // method of the parent MockLSIFStore instance is invoked and the hook queue
 // is empty.
-func (f *LSIFStoreDocumentationPageFunc) SetDefaultHook(hook func(context.Context, int, string) (*semantic.DocumentationPageData, error)) {
+func (f *LSIFStoreReferenceCountFunc) SetDefaultHook(hook func(context.Context, int, string, int, int) (int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// DocumentationPage method of the parent MockLSIFStore instance invokes the
+// ReferenceCount method of the parent MockLSIFStore instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *LSIFStoreDocumentationPageFunc) PushHook(hook func(context.Context, int, string) (*semantic.DocumentationPageData, error)) {
+func (f *LSIFStoreReferenceCountFunc) PushHook(hook func(context.Context, int, string, int, int) (int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -5738,21 +9346,21 @@ func (f *LSIFStoreDocumentationPageFunc) PushHook(hook func(context.Context, int
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *LSIFStoreDocumentationPageFunc) SetDefaultReturn(r0 *semantic.DocumentationPageData, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, string) (*semantic.DocumentationPageData, error) {
+func (f *LSIFStoreReferenceCountFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string, int, int) (int, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *LSIFStoreDocumentationPageFunc) PushReturn(r0 *semantic.DocumentationPageData, r1 error) {
-	f.PushHook(func(context.Context, int, string) (*semantic.DocumentationPageData, error) {
+func (f *LSIFStoreReferenceCountFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, int, string, int, int) (int, error) {
 		return r0, r1
 	})
 }
 
-func (f *LSIFStoreDocumentationPageFunc) nextHook() func(context.Context, int, string) (*semantic.DocumentationPageData, error) {
+func (f *LSIFStoreReferenceCountFunc) nextHook() func(context.Context, int, string, int, int) (int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -5765,26 +9373,26 @@ func (f *LSIFStoreDocumentationPageFunc) nextHook() func(context.Context, int, s
 	return hook
 }
 
-func (f *LSIFStoreDocumentationPageFunc) appendCall(r0 LSIFStoreDocumentationPageFuncCall) {
+func (f *LSIFStoreReferenceCountFunc) appendCall(r0 LSIFStoreReferenceCountFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of LSIFStoreDocumentationPageFuncCall objects
+// History returns a sequence of LSIFStoreReferenceCountFuncCall objects
 // describing the invocations of this function.
-func (f *LSIFStoreDocumentationPageFunc) History() []LSIFStoreDocumentationPageFuncCall {
+func (f *LSIFStoreReferenceCountFunc) History() []LSIFStoreReferenceCountFuncCall {
 	f.mutex.Lock()
-	history := make([]LSIFStoreDocumentationPageFuncCall, len(f.history))
+	history := make([]LSIFStoreReferenceCountFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// LSIFStoreDocumentationPageFuncCall is an object that describes an
-// invocation of method DocumentationPage on an instance of MockLSIFStore.
-type LSIFStoreDocumentationPageFuncCall struct {
+// LSIFStoreReferenceCountFuncCall is an object that describes an invocation
+// of method ReferenceCount on an instance of MockLSIFStore.
+type LSIFStoreReferenceCountFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -5794,9 +9402,15 @@ type LSIFStoreDocumentationPageFuncCall struct {
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
 	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *semantic.DocumentationPageData
+	Result0 int
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -5804,44 +9418,44 @@ type LSIFStoreDocumentationPageFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c LSIFStoreDocumentationPageFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c LSIFStoreReferenceCountFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c LSIFStoreDocumentationPageFuncCall) Results() []interface{} {
+func (c LSIFStoreReferenceCountFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// LSIFStoreExistsFunc describes the behavior when the Exists method of the
-// parent MockLSIFStore instance is invoked.
-type LSIFStoreExistsFunc struct {
-	defaultHook func(context.Context, int, string) (bool, error)
-	hooks       []func(context.Context, int, string) (bool, error)
-	history     []LSIFStoreExistsFuncCall
+// LSIFStoreReferencesFunc describes the behavior when the References method
+// of the parent MockLSIFStore instance is invoked.
+type LSIFStoreReferencesFunc struct {
+	defaultHook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)
+	hooks       []func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)
+	history     []LSIFStoreReferencesFuncCall
 	mutex       sync.Mutex
 }
 
-// Exists delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockLSIFStore) Exists(v0 context.Context, v1 int, v2 string) (bool, error) {
-	r0, r1 := m.ExistsFunc.nextHook()(v0, v1, v2)
-	m.ExistsFunc.appendCall(LSIFStoreExistsFuncCall{v0, v1, v2, r0, r1})
-	return r0, r1
+// References delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockLSIFStore) References(v0 context.Context, v1 int, v2 string, v3 int, v4 int, v5 int, v6 int) ([]lsifstore.Location, int, error) {
+	r0, r1, r2 := m.ReferencesFunc.nextHook()(v0, v1, v2, v3, v4, v5, v6)
+	m.ReferencesFunc.appendCall(LSIFStoreReferencesFuncCall{v0, v1, v2, v3, v4, v5, v6, r0, r1, r2})
+	return r0, r1, r2
 }
 
-// SetDefaultHook sets function that is called when the Exists method of the
-// parent MockLSIFStore instance is invoked and the hook queue is empty.
-func (f *LSIFStoreExistsFunc) SetDefaultHook(hook func(context.Context, int, string) (bool, error)) {
+// SetDefaultHook sets function that is called when the References method of
+// the parent MockLSIFStore instance is invoked and the hook queue is empty.
+func (f *LSIFStoreReferencesFunc) SetDefaultHook(hook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Exists method of the parent MockLSIFStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
+// References method of the parent MockLSIFStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *LSIFStoreExistsFunc) PushHook(hook func(context.Context, int, string) (bool, error)) {
+func (f *LSIFStoreReferencesFunc) PushHook(hook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -5849,21 +9463,21 @@ func (f *LSIFStoreExistsFunc) PushHook(hook func(context.Context, int, string) (
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *LSIFStoreExistsFunc) SetDefaultReturn(r0 bool, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, string) (bool, error) {
-		return r0, r1
+func (f *LSIFStoreReferencesFunc) SetDefaultReturn(r0 []lsifstore.Location, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+		return r0, r1, r2
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *LSIFStoreExistsFunc) PushReturn(r0 bool, r1 error) {
-	f.PushHook(func(context.Context, int, string) (bool, error) {
-		return r0, r1
+func (f *LSIFStoreReferencesFunc) PushReturn(r0 []lsifstore.Location, r1 int, r2 error) {
+	f.PushHook(func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+		return r0, r1, r2
 	})
 }
 
-func (f *LSIFStoreExistsFunc) nextHook() func(context.Context, int, string) (bool, error) {
+func (f *LSIFStoreReferencesFunc) nextHook() func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -5876,26 +9490,26 @@ func (f *LSIFStoreExistsFunc) nextHook() func(context.Context, int, string) (boo
 	return hook
 }
 
-func (f *LSIFStoreExistsFunc) appendCall(r0 LSIFStoreExistsFuncCall) {
+func (f *LSIFStoreReferencesFunc) appendCall(r0 LSIFStoreReferencesFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
-}
-
-// History returns a sequence of LSIFStoreExistsFuncCall objects describing
-// the invocations of this function.
-func (f *LSIFStoreExistsFunc) History() []LSIFStoreExistsFuncCall {
+}
+
+// History returns a sequence of LSIFStoreReferencesFuncCall objects
+// describing the invocations of this function.
+func (f *LSIFStoreReferencesFunc) History() []LSIFStoreReferencesFuncCall {
 	f.mutex.Lock()
-	history := make([]LSIFStoreExistsFuncCall, len(f.history))
+	history := make([]LSIFStoreReferencesFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// LSIFStoreExistsFuncCall is an object that describes an invocation of
-// method Exists on an instance of MockLSIFStore.
-type LSIFStoreExistsFuncCall struct {
+// LSIFStoreReferencesFuncCall is an object that describes an invocation of
+// method References on an instance of MockLSIFStore.
+type LSIFStoreReferencesFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -5905,54 +9519,69 @@ type LSIFStoreExistsFuncCall struct {
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
 	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 int
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 int
+	// Arg6 is the value of the 7th argument passed to this method
+	// invocation.
+	Arg6 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 bool
+	Result0 []lsifstore.Location
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 error
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c LSIFStoreExistsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c LSIFStoreReferencesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5, c.Arg6}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c LSIFStoreExistsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c LSIFStoreReferencesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// LSIFStoreHoverFunc describes the behavior when the Hover method of the
-// parent MockLSIFStore instance is invoked.
-type LSIFStoreHoverFunc struct {
-	defaultHook func(context.Context, int, string, int, int) (string, lsifstore.Range, bool, error)
-	hooks       []func(context.Context, int, string, int, int) (string, lsifstore.Range, bool, error)
-	history     []LSIFStoreHoverFuncCall
+// LSIFStoreSymbolsFunc describes the behavior when the Symbols method of
+// the parent MockLSIFStore instance is invoked.
+type LSIFStoreSymbolsFunc struct {
+	defaultHook func(context.Context, []int, string, int) ([]lsifstore.Symbol, error)
+	hooks       []func(context.Context, []int, string, int) ([]lsifstore.Symbol, error)
+	history     []LSIFStoreSymbolsFuncCall
 	mutex       sync.Mutex
 }
 
-// Hover delegates to the next hook function in the queue and stores the
+// Symbols delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockLSIFStore) Hover(v0 context.Context, v1 int, v2 string, v3 int, v4 int) (string, lsifstore.Range, bool, error) {
-	r0, r1, r2, r3 := m.HoverFunc.nextHook()(v0, v1, v2, v3, v4)
-	m.HoverFunc.appendCall(LSIFStoreHoverFuncCall{v0, v1, v2, v3, v4, r0, r1, r2, r3})
-	return r0, r1, r2, r3
+func (m *MockLSIFStore) Symbols(v0 context.Context, v1 []int, v2 string, v3 int) ([]lsifstore.Symbol, error) {
+	r0, r1 := m.SymbolsFunc.nextHook()(v0, v1, v2, v3)
+	m.SymbolsFunc.appendCall(LSIFStoreSymbolsFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Hover method of the
-// parent MockLSIFStore instance is invoked and the hook queue is empty.
-func (f *LSIFStoreHoverFunc) SetDefaultHook(hook func(context.Context, int, string, int, int) (string, lsifstore.Range, bool, error)) {
+// SetDefaultHook sets function that is called when the Symbols method of
+// the parent MockLSIFStore instance is invoked and the hook queue is empty.
+func (f *LSIFStoreSymbolsFunc) SetDefaultHook(hook func(context.Context, []int, string, int) ([]lsifstore.Symbol, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Hover method of the parent MockLSIFStore instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *LSIFStoreHoverFunc) PushHook(hook func(context.Context, int, string, int, int) (string, lsifstore.Range, bool, error)) {
+// Symbols method of the parent MockLSIFStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *LSIFStoreSymbolsFunc) PushHook(hook func(context.Context, []int, string, int) ([]lsifstore.Symbol, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -5960,21 +9589,21 @@ func (f *LSIFStoreHoverFunc) PushHook(hook func(context.Context, int, string, in
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *LSIFStoreHoverFunc) SetDefaultReturn(r0 string, r1 lsifstore.Range, r2 bool, r3 error) {
-	f.SetDefaultHook(func(context.Context, int, string, int, int) (string, lsifstore.Range, bool, error) {
-		return r0, r1, r2, r3
+func (f *LSIFStoreSymbolsFunc) SetDefaultReturn(r0 []lsifstore.Symbol, r1 error) {
+	f.SetDefaultHook(func(context.Context, []int, string, int) ([]lsifstore.Symbol, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *LSIFStoreHoverFunc) PushReturn(r0 string, r1 lsifstore.Range, r2 bool, r3 error) {
-	f.PushHook(func(context.Context, int, string, int, int) (string, lsifstore.Range, bool, error) {
-		return r0, r1, r2, r3
+func (f *LSIFStoreSymbolsFunc) PushReturn(r0 []lsifstore.Symbol, r1 error) {
+	f.PushHook(func(context.Context, []int, string, int) ([]lsifstore.Symbol, error) {
+		return r0, r1
 	})
 }
 
-func (f *LSIFStoreHoverFunc) nextHook() func(context.Context, int, string, int, int) (string, lsifstore.Range, bool, error) {
+func (f *LSIFStoreSymbolsFunc) nextHook() func(context.Context, []int, string, int) ([]lsifstore.Symbol, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -5987,97 +9616,88 @@ func (f *LSIFStoreHoverFunc) nextHook() func(context.Context, int, string, int,
 	return hook
 }
 
-func (f *LSIFStoreHoverFunc) appendCall(r0 LSIFStoreHoverFuncCall) {
+func (f *LSIFStoreSymbolsFunc) appendCall(r0 LSIFStoreSymbolsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of LSIFStoreHoverFuncCall objects describing
+// History returns a sequence of LSIFStoreSymbolsFuncCall objects describing
 // the invocations of this function.
-func (f *LSIFStoreHoverFunc) History() []LSIFStoreHoverFuncCall {
+func (f *LSIFStoreSymbolsFunc) History() []LSIFStoreSymbolsFuncCall {
 	f.mutex.Lock()
-	history := make([]LSIFStoreHoverFuncCall, len(f.history))
+	history := make([]LSIFStoreSymbolsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// LSIFStoreHoverFuncCall is an object that describes an invocation of
-// method Hover on an instance of MockLSIFStore.
-type LSIFStoreHoverFuncCall struct {
+// LSIFStoreSymbolsFuncCall is an object that describes an invocation of
+// method Symbols on an instance of MockLSIFStore.
+type LSIFStoreSymbolsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int
+	Arg1 []int
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
 	Arg2 string
 	// Arg3 is the value of the 4th argument passed to this method
 	// invocation.
 	Arg3 int
-	// Arg4 is the value of the 5th argument passed to this method
-	// invocation.
-	Arg4 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 string
+	Result0 []lsifstore.Symbol
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 lsifstore.Range
-	// Result2 is the value of the 3rd result returned from this method
-	// invocation.
-	Result2 bool
-	// Result3 is the value of the 4th result returned from this method
-	// invocation.
-	Result3 error
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c LSIFStoreHoverFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+func (c LSIFStoreSymbolsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c LSIFStoreHoverFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2, c.Result3}
+func (c LSIFStoreSymbolsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// LSIFStoreMonikersByPositionFunc describes the behavior when the
-// MonikersByPosition method of the parent MockLSIFStore instance is
+// LSIFStoreWriteAccessReferencesFunc describes the behavior when the
+// WriteAccessReferences method of the parent MockLSIFStore instance is
 // invoked.
-type LSIFStoreMonikersByPositionFunc struct {
-	defaultHook func(context.Context, int, string, int, int) ([][]semantic.MonikerData, error)
-	hooks       []func(context.Context, int, string, int, int) ([][]semantic.MonikerData, error)
-	history     []LSIFStoreMonikersByPositionFuncCall
+type LSIFStoreWriteAccessReferencesFunc struct {
+	defaultHook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)
+	hooks       []func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)
+	history     []LSIFStoreWriteAccessReferencesFuncCall
 	mutex       sync.Mutex
 }
 
-// MonikersByPosition delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockLSIFStore) MonikersByPosition(v0 context.Context, v1 int, v2 string, v3 int, v4 int) ([][]semantic.MonikerData, error) {
-	r0, r1 := m.MonikersByPositionFunc.nextHook()(v0, v1, v2, v3, v4)
-	m.MonikersByPositionFunc.appendCall(LSIFStoreMonikersByPositionFuncCall{v0, v1, v2, v3, v4, r0, r1})
-	return r0, r1
+// WriteAccessReferences delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockLSIFStore) WriteAccessReferences(v0 context.Context, v1 int, v2 string, v3 int, v4 int, v5 int, v6 int) ([]lsifstore.Location, int, error) {
+	r0, r1, r2 := m.WriteAccessReferencesFunc.nextHook()(v0, v1, v2, v3, v4, v5, v6)
+	m.WriteAccessReferencesFunc.appendCall(LSIFStoreWriteAccessReferencesFuncCall{v0, v1, v2, v3, v4, v5, v6, r0, r1, r2})
+	return r0, r1, r2
 }
 
-// SetDefaultHook sets function that is called when the MonikersByPosition
-// method of the parent MockLSIFStore instance is invoked and the hook queue
-// is empty.
-func (f *LSIFStoreMonikersByPositionFunc) SetDefaultHook(hook func(context.Context, int, string, int, int) ([][]semantic.MonikerData, error)) {
+// SetDefaultHook sets function that is called when the
+// WriteAccessReferences method of the parent MockLSIFStore instance is
+// invoked and the hook queue is empty.
+func (f *LSIFStoreWriteAccessReferencesFunc) SetDefaultHook(hook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// MonikersByPosition method of the parent MockLSIFStore instance invokes
+// WriteAccessReferences method of the parent MockLSIFStore instance invokes
 // the hook at the front of the queue and discards it. After the queue is
 // empty, the default hook function is invoked for any future action.
-func (f *LSIFStoreMonikersByPositionFunc) PushHook(hook func(context.Context, int, string, int, int) ([][]semantic.MonikerData, error)) {
+func (f *LSIFStoreWriteAccessReferencesFunc) PushHook(hook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -6085,21 +9705,21 @@ func (f *LSIFStoreMonikersByPositionFunc) PushHook(hook func(context.Context, in
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *LSIFStoreMonikersByPositionFunc) SetDefaultReturn(r0 [][]semantic.MonikerData, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, string, int, int) ([][]semantic.MonikerData, error) {
-		return r0, r1
+func (f *LSIFStoreWriteAccessReferencesFunc) SetDefaultReturn(r0 []lsifstore.Location, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+		return r0, r1, r2
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *LSIFStoreMonikersByPositionFunc) PushReturn(r0 [][]semantic.MonikerData, r1 error) {
-	f.PushHook(func(context.Context, int, string, int, int) ([][]semantic.MonikerData, error) {
-		return r0, r1
+func (f *LSIFStoreWriteAccessReferencesFunc) PushReturn(r0 []lsifstore.Location, r1 int, r2 error) {
+	f.PushHook(func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+		return r0, r1, r2
 	})
 }
 
-func (f *LSIFStoreMonikersByPositionFunc) nextHook() func(context.Context, int, string, int, int) ([][]semantic.MonikerData, error) {
+func (f *LSIFStoreWriteAccessReferencesFunc) nextHook() func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -6112,26 +9732,27 @@ func (f *LSIFStoreMonikersByPositionFunc) nextHook() func(context.Context, int,
 	return hook
 }
 
-func (f *LSIFStoreMonikersByPositionFunc) appendCall(r0 LSIFStoreMonikersByPositionFuncCall) {
+func (f *LSIFStoreWriteAccessReferencesFunc) appendCall(r0 LSIFStoreWriteAccessReferencesFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of LSIFStoreMonikersByPositionFuncCall objects
-// describing the invocations of this function.
-func (f *LSIFStoreMonikersByPositionFunc) History() []LSIFStoreMonikersByPositionFuncCall {
+// History returns a sequence of LSIFStoreWriteAccessReferencesFuncCall
+// objects describing the invocations of this function.
+func (f *LSIFStoreWriteAccessReferencesFunc) History() []LSIFStoreWriteAccessReferencesFuncCall {
 	f.mutex.Lock()
-	history := make([]LSIFStoreMonikersByPositionFuncCall, len(f.history))
+	history := make([]LSIFStoreWriteAccessReferencesFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// LSIFStoreMonikersByPositionFuncCall is an object that describes an
-// invocation of method MonikersByPosition on an instance of MockLSIFStore.
-type LSIFStoreMonikersByPositionFuncCall struct {
+// LSIFStoreWriteAccessReferencesFuncCall is an object that describes an
+// invocation of method WriteAccessReferences on an instance of
+// MockLSIFStore.
+type LSIFStoreWriteAccessReferencesFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -6147,56 +9768,111 @@ type LSIFStoreMonikersByPositionFuncCall struct {
 	// Arg4 is the value of the 5th argument passed to this method
 	// invocation.
 	Arg4 int
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 int
+	// Arg6 is the value of the 7th argument passed to this method
+	// invocation.
+	Arg6 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 [][]semantic.MonikerData
+	Result0 []lsifstore.Location
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 error
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c LSIFStoreMonikersByPositionFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+func (c LSIFStoreWriteAccessReferencesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5, c.Arg6}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c LSIFStoreMonikersByPositionFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c LSIFStoreWriteAccessReferencesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// LSIFStorePackageInformationFunc describes the behavior when the
-// PackageInformation method of the parent MockLSIFStore instance is
+// MockRepoUpdaterClient is a mock implementation of the RepoUpdaterClient
+// interface (from the package
+// github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers)
+// used for unit testing.
+type MockRepoUpdaterClient struct {
+	// EnqueueRepoUpdateFunc is an instance of a mock function object
+	// controlling the behavior of the method EnqueueRepoUpdate.
+	EnqueueRepoUpdateFunc *RepoUpdaterClientEnqueueRepoUpdateFunc
+	// RepoLookupFunc is an instance of a mock function object controlling
+	// the behavior of the method RepoLookup.
+	RepoLookupFunc *RepoUpdaterClientRepoLookupFunc
+}
+
+// NewMockRepoUpdaterClient creates a new mock of the RepoUpdaterClient
+// interface. All methods return zero values for all results, unless
+// overwritten.
+func NewMockRepoUpdaterClient() *MockRepoUpdaterClient {
+	return &MockRepoUpdaterClient{
+		EnqueueRepoUpdateFunc: &RepoUpdaterClientEnqueueRepoUpdateFunc{
+			defaultHook: func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error) {
+				return nil, nil
+			},
+		},
+		RepoLookupFunc: &RepoUpdaterClientRepoLookupFunc{
+			defaultHook: func(context.Context, protocol.RepoLookupArgs) (*protocol.RepoLookupResult, error) {
+				return nil, nil
+			},
+		},
+	}
+}
+
+// NewMockRepoUpdaterClientFrom creates a new mock of the
+// MockRepoUpdaterClient interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockRepoUpdaterClientFrom(i RepoUpdaterClient) *MockRepoUpdaterClient {
+	return &MockRepoUpdaterClient{
+		EnqueueRepoUpdateFunc: &RepoUpdaterClientEnqueueRepoUpdateFunc{
+			defaultHook: i.EnqueueRepoUpdate,
+		},
+		RepoLookupFunc: &RepoUpdaterClientRepoLookupFunc{
+			defaultHook: i.RepoLookup,
+		},
+	}
+}
+
+// RepoUpdaterClientEnqueueRepoUpdateFunc describes the behavior when the
+// EnqueueRepoUpdate method of the parent MockRepoUpdaterClient instance is
 // invoked.
-type LSIFStorePackageInformationFunc struct {
-	defaultHook func(context.Context, int, string, string) (semantic.PackageInformationData, bool, error)
-	hooks       []func(context.Context, int, string, string) (semantic.PackageInformationData, bool, error)
-	history     []LSIFStorePackageInformationFuncCall
+type RepoUpdaterClientEnqueueRepoUpdateFunc struct {
+	defaultHook func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error)
+	hooks       []func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error)
+	history     []RepoUpdaterClientEnqueueRepoUpdateFuncCall
 	mutex       sync.Mutex
 }
 
-// PackageInformation delegates to the next hook function in the queue and
+// EnqueueRepoUpdate delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockLSIFStore) PackageInformation(v0 context.Context, v1 int, v2 string, v3 string) (semantic.PackageInformationData, bool, error) {
-	r0, r1, r2 := m.PackageInformationFunc.nextHook()(v0, v1, v2, v3)
-	m.PackageInformationFunc.appendCall(LSIFStorePackageInformationFuncCall{v0, v1, v2, v3, r0, r1, r2})
-	return r0, r1, r2
+func (m *MockRepoUpdaterClient) EnqueueRepoUpdate(v0 context.Context, v1 api.RepoName) (*protocol.RepoUpdateResponse, error) {
+	r0, r1 := m.EnqueueRepoUpdateFunc.nextHook()(v0, v1)
+	m.EnqueueRepoUpdateFunc.appendCall(RepoUpdaterClientEnqueueRepoUpdateFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the PackageInformation
-// method of the parent MockLSIFStore instance is invoked and the hook queue
-// is empty.
-func (f *LSIFStorePackageInformationFunc) SetDefaultHook(hook func(context.Context, int, string, string) (semantic.PackageInformationData, bool, error)) {
+// SetDefaultHook sets function that is called when the EnqueueRepoUpdate
+// method of the parent MockRepoUpdaterClient instance is invoked and the
+// hook queue is empty.
+func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// PackageInformation method of the parent MockLSIFStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *LSIFStorePackageInformationFunc) PushHook(hook func(context.Context, int, string, string) (semantic.PackageInformationData, bool, error)) {
+// EnqueueRepoUpdate method of the parent MockRepoUpdaterClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) PushHook(hook func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -6204,21 +9880,21 @@ func (f *LSIFStorePackageInformationFunc) PushHook(hook func(context.Context, in
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *LSIFStorePackageInformationFunc) SetDefaultReturn(r0 semantic.PackageInformationData, r1 bool, r2 error) {
-	f.SetDefaultHook(func(context.Context, int, string, string) (semantic.PackageInformationData, bool, error) {
-		return r0, r1, r2
+func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) SetDefaultReturn(r0 *protocol.RepoUpdateResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *LSIFStorePackageInformationFunc) PushReturn(r0 semantic.PackageInformationData, r1 bool, r2 error) {
-	f.PushHook(func(context.Context, int, string, string) (semantic.PackageInformationData, bool, error) {
-		return r0, r1, r2
+func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) PushReturn(r0 *protocol.RepoUpdateResponse, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error) {
+		return r0, r1
 	})
 }
 
-func (f *LSIFStorePackageInformationFunc) nextHook() func(context.Context, int, string, string) (semantic.PackageInformationData, bool, error) {
+func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) nextHook() func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -6231,89 +9907,83 @@ func (f *LSIFStorePackageInformationFunc) nextHook() func(context.Context, int,
 	return hook
 }
 
-func (f *LSIFStorePackageInformationFunc) appendCall(r0 LSIFStorePackageInformationFuncCall) {
+func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) appendCall(r0 RepoUpdaterClientEnqueueRepoUpdateFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of LSIFStorePackageInformationFuncCall objects
-// describing the invocations of this function.
-func (f *LSIFStorePackageInformationFunc) History() []LSIFStorePackageInformationFuncCall {
+// History returns a sequence of RepoUpdaterClientEnqueueRepoUpdateFuncCall
+// objects describing the invocations of this function.
+func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) History() []RepoUpdaterClientEnqueueRepoUpdateFuncCall {
 	f.mutex.Lock()
-	history := make([]LSIFStorePackageInformationFuncCall, len(f.history))
+	history := make([]RepoUpdaterClientEnqueueRepoUpdateFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// LSIFStorePackageInformationFuncCall is an object that describes an
-// invocation of method PackageInformation on an instance of MockLSIFStore.
-type LSIFStorePackageInformationFuncCall struct {
+// RepoUpdaterClientEnqueueRepoUpdateFuncCall is an object that describes an
+// invocation of method EnqueueRepoUpdate on an instance of
+// MockRepoUpdaterClient.
+type RepoUpdaterClientEnqueueRepoUpdateFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 string
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 string
+	Arg1 api.RepoName
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 semantic.PackageInformationData
+	Result0 *protocol.RepoUpdateResponse
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 bool
-	// Result2 is the value of the 3rd result returned from this method
-	// invocation.
-	Result2 error
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c LSIFStorePackageInformationFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c RepoUpdaterClientEnqueueRepoUpdateFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c LSIFStorePackageInformationFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2}
+func (c RepoUpdaterClientEnqueueRepoUpdateFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// LSIFStoreRangesFunc describes the behavior when the Ranges method of the
-// parent MockLSIFStore instance is invoked.
-type LSIFStoreRangesFunc struct {
-	defaultHook func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error)
-	hooks       []func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error)
-	history     []LSIFStoreRangesFuncCall
+// RepoUpdaterClientRepoLookupFunc describes the behavior when the
+// RepoLookup method of the parent MockRepoUpdaterClient instance is
+// invoked.
+type RepoUpdaterClientRepoLookupFunc struct {
+	defaultHook func(context.Context, protocol.RepoLookupArgs) (*protocol.RepoLookupResult, error)
+	hooks       []func(context.Context, protocol.RepoLookupArgs) (*protocol.RepoLookupResult, error)
+	history     []RepoUpdaterClientRepoLookupFuncCall
 	mutex       sync.Mutex
 }
 
-// Ranges delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockLSIFStore) Ranges(v0 context.Context, v1 int, v2 string, v3 int, v4 int) ([]lsifstore.CodeIntelligenceRange, error) {
-	r0, r1 := m.RangesFunc.nextHook()(v0, v1, v2, v3, v4)
-	m.RangesFunc.appendCall(LSIFStoreRangesFuncCall{v0, v1, v2, v3, v4, r0, r1})
+// RepoLookup delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockRepoUpdaterClient) RepoLookup(v0 context.Context, v1 protocol.RepoLookupArgs) (*protocol.RepoLookupResult, error) {
+	r0, r1 := m.RepoLookupFunc.nextHook()(v0, v1)
+	m.RepoLookupFunc.appendCall(RepoUpdaterClientRepoLookupFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Ranges method of the
-// parent MockLSIFStore instance is invoked and the hook queue is empty.
-func (f *LSIFStoreRangesFunc) SetDefaultHook(hook func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error)) {
+// SetDefaultHook sets function that is called when the RepoLookup method of
+// the parent MockRepoUpdaterClient instance is invoked and the hook queue
+// is empty.
+func (f *RepoUpdaterClientRepoLookupFunc) SetDefaultHook(hook func(context.Context, protocol.RepoLookupArgs) (*protocol.RepoLookupResult, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Ranges method of the parent MockLSIFStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *LSIFStoreRangesFunc) PushHook(hook func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error)) {
+// RepoLookup method of the parent MockRepoUpdaterClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *RepoUpdaterClientRepoLookupFunc) PushHook(hook func(context.Context, protocol.RepoLookupArgs) (*protocol.RepoLookupResult, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -6321,21 +9991,21 @@ func (f *LSIFStoreRangesFunc) PushHook(hook func(context.Context, int, string, i
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *LSIFStoreRangesFunc) SetDefaultReturn(r0 []lsifstore.CodeIntelligenceRange, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error) {
+func (f *RepoUpdaterClientRepoLookupFunc) SetDefaultReturn(r0 *protocol.RepoLookupResult, r1 error) {
+	f.SetDefaultHook(func(context.Context, protocol.RepoLookupArgs) (*protocol.RepoLookupResult, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *LSIFStoreRangesFunc) PushReturn(r0 []lsifstore.CodeIntelligenceRange, r1 error) {
-	f.PushHook(func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error) {
+func (f *RepoUpdaterClientRepoLookupFunc) PushReturn(r0 *protocol.RepoLookupResult, r1 error) {
+	f.PushHook(func(context.Context, protocol.RepoLookupArgs) (*protocol.RepoLookupResult, error) {
 		return r0, r1
 	})
 }
 
-func (f *LSIFStoreRangesFunc) nextHook() func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error) {
+func (f *RepoUpdaterClientRepoLookupFunc) nextHook() func(context.Context, protocol.RepoLookupArgs) (*protocol.RepoLookupResult, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -6348,44 +10018,35 @@ func (f *LSIFStoreRangesFunc) nextHook() func(context.Context, int, string, int,
 	return hook
 }
 
-func (f *LSIFStoreRangesFunc) appendCall(r0 LSIFStoreRangesFuncCall) {
+func (f *RepoUpdaterClientRepoLookupFunc) appendCall(r0 RepoUpdaterClientRepoLookupFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of LSIFStoreRangesFuncCall objects describing
-// the invocations of this function.
-func (f *LSIFStoreRangesFunc) History() []LSIFStoreRangesFuncCall {
+// History returns a sequence of RepoUpdaterClientRepoLookupFuncCall objects
+// describing the invocations of this function.
+func (f *RepoUpdaterClientRepoLookupFunc) History() []RepoUpdaterClientRepoLookupFuncCall {
 	f.mutex.Lock()
-	history := make([]LSIFStoreRangesFuncCall, len(f.history))
+	history := make([]RepoUpdaterClientRepoLookupFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// LSIFStoreRangesFuncCall is an object that describes an invocation of
-// method Ranges on an instance of MockLSIFStore.
-type LSIFStoreRangesFuncCall struct {
+// RepoUpdaterClientRepoLookupFuncCall is an object that describes an
+// invocation of method RepoLookup on an instance of MockRepoUpdaterClient.
+type RepoUpdaterClientRepoLookupFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 string
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 int
-	// Arg4 is the value of the 5th argument passed to this method
-	// invocation.
-	Arg4 int
+	Arg1 protocol.RepoLookupArgs
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []lsifstore.CodeIntelligenceRange
+	Result0 *protocol.RepoLookupResult
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -6393,44 +10054,89 @@ type LSIFStoreRangesFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c LSIFStoreRangesFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+func (c RepoUpdaterClientRepoLookupFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c LSIFStoreRangesFuncCall) Results() []interface{} {
+func (c RepoUpdaterClientRepoLookupFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// LSIFStoreReferencesFunc describes the behavior when the References method
-// of the parent MockLSIFStore instance is invoked.
-type LSIFStoreReferencesFunc struct {
-	defaultHook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)
-	hooks       []func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)
-	history     []LSIFStoreReferencesFuncCall
+// MockSearchClient is a mock implementation of the SearchClient interface
+// (from the package
+// github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers)
+// used for unit testing.
+type MockSearchClient struct {
+	// DefinitionsFunc is an instance of a mock function object controlling
+	// the behavior of the method Definitions.
+	DefinitionsFunc *SearchClientDefinitionsFunc
+	// ReferencesFunc is an instance of a mock function object controlling
+	// the behavior of the method References.
+	ReferencesFunc *SearchClientReferencesFunc
+}
+
+// NewMockSearchClient creates a new mock of the SearchClient interface. All
+// methods return zero values for all results, unless overwritten.
+func NewMockSearchClient() *MockSearchClient {
+	return &MockSearchClient{
+		DefinitionsFunc: &SearchClientDefinitionsFunc{
+			defaultHook: func(context.Context, int, string, string, int, int, int) ([]lsifstore.Location, error) {
+				return nil, nil
+			},
+		},
+		ReferencesFunc: &SearchClientReferencesFunc{
+			defaultHook: func(context.Context, int, string, string, int, int, int) ([]lsifstore.Location, error) {
+				return nil, nil
+			},
+		},
+	}
+}
+
+// NewMockSearchClientFrom creates a new mock of the MockSearchClient
+// interface. All methods delegate to the given implementation, unless
+// overwritten.
+func NewMockSearchClientFrom(i SearchClient) *MockSearchClient {
+	return &MockSearchClient{
+		DefinitionsFunc: &SearchClientDefinitionsFunc{
+			defaultHook: i.Definitions,
+		},
+		ReferencesFunc: &SearchClientReferencesFunc{
+			defaultHook: i.References,
+		},
+	}
+}
+
+// SearchClientDefinitionsFunc describes the behavior when the Definitions
+// method of the parent MockSearchClient instance is invoked.
+type SearchClientDefinitionsFunc struct {
+	defaultHook func(context.Context, int, string, string, int, int, int) ([]lsifstore.Location, error)
+	hooks       []func(context.Context, int, string, string, int, int, int) ([]lsifstore.Location, error)
+	history     []SearchClientDefinitionsFuncCall
 	mutex       sync.Mutex
 }
 
-// References delegates to the next hook function in the queue and stores
+// Definitions delegates to the next hook function in the queue and stores
 // the parameter and result values of this invocation.
-func (m *MockLSIFStore) References(v0 context.Context, v1 int, v2 string, v3 int, v4 int, v5 int, v6 int) ([]lsifstore.Location, int, error) {
-	r0, r1, r2 := m.ReferencesFunc.nextHook()(v0, v1, v2, v3, v4, v5, v6)
-	m.ReferencesFunc.appendCall(LSIFStoreReferencesFuncCall{v0, v1, v2, v3, v4, v5, v6, r0, r1, r2})
-	return r0, r1, r2
+func (m *MockSearchClient) Definitions(v0 context.Context, v1 int, v2 string, v3 string, v4 int, v5 int, v6 int) ([]lsifstore.Location, error) {
+	r0, r1 := m.DefinitionsFunc.nextHook()(v0, v1, v2, v3, v4, v5, v6)
+	m.DefinitionsFunc.appendCall(SearchClientDefinitionsFuncCall{v0, v1, v2, v3, v4, v5, v6, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the References method of
-// the parent MockLSIFStore instance is invoked and the hook queue is empty.
-func (f *LSIFStoreReferencesFunc) SetDefaultHook(hook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)) {
+// SetDefaultHook sets function that is called when the Definitions method
+// of the parent MockSearchClient instance is invoked and the hook queue is
+// empty.
+func (f *SearchClientDefinitionsFunc) SetDefaultHook(hook func(context.Context, int, string, string, int, int, int) ([]lsifstore.Location, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// References method of the parent MockLSIFStore instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *LSIFStoreReferencesFunc) PushHook(hook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)) {
+// Definitions method of the parent MockSearchClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SearchClientDefinitionsFunc) PushHook(hook func(context.Context, int, string, string, int, int, int) ([]lsifstore.Location, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -6438,21 +10144,21 @@ func (f *LSIFStoreReferencesFunc) PushHook(hook func(context.Context, int, strin
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *LSIFStoreReferencesFunc) SetDefaultReturn(r0 []lsifstore.Location, r1 int, r2 error) {
-	f.SetDefaultHook(func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
-		return r0, r1, r2
+func (f *SearchClientDefinitionsFunc) SetDefaultReturn(r0 []lsifstore.Location, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string, string, int, int, int) ([]lsifstore.Location, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *LSIFStoreReferencesFunc) PushReturn(r0 []lsifstore.Location, r1 int, r2 error) {
-	f.PushHook(func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
-		return r0, r1, r2
+func (f *SearchClientDefinitionsFunc) PushReturn(r0 []lsifstore.Location, r1 error) {
+	f.PushHook(func(context.Context, int, string, string, int, int, int) ([]lsifstore.Location, error) {
+		return r0, r1
 	})
 }
 
-func (f *LSIFStoreReferencesFunc) nextHook() func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+func (f *SearchClientDefinitionsFunc) nextHook() func(context.Context, int, string, string, int, int, int) ([]lsifstore.Location, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -6465,26 +10171,26 @@ func (f *LSIFStoreReferencesFunc) nextHook() func(context.Context, int, string,
 	return hook
 }
 
-func (f *LSIFStoreReferencesFunc) appendCall(r0 LSIFStoreReferencesFuncCall) {
+func (f *SearchClientDefinitionsFunc) appendCall(r0 SearchClientDefinitionsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of LSIFStoreReferencesFuncCall objects
+// History returns a sequence of SearchClientDefinitionsFuncCall objects
 // describing the invocations of this function.
-func (f *LSIFStoreReferencesFunc) History() []LSIFStoreReferencesFuncCall {
+func (f *SearchClientDefinitionsFunc) History() []SearchClientDefinitionsFuncCall {
 	f.mutex.Lock()
-	history := make([]LSIFStoreReferencesFuncCall, len(f.history))
+	history := make([]SearchClientDefinitionsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// LSIFStoreReferencesFuncCall is an object that describes an invocation of
-// method References on an instance of MockLSIFStore.
-type LSIFStoreReferencesFuncCall struct {
+// SearchClientDefinitionsFuncCall is an object that describes an invocation
+// of method Definitions on an instance of MockSearchClient.
+type SearchClientDefinitionsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -6496,7 +10202,7 @@ type LSIFStoreReferencesFuncCall struct {
 	Arg2 string
 	// Arg3 is the value of the 4th argument passed to this method
 	// invocation.
-	Arg3 int
+	Arg3 string
 	// Arg4 is the value of the 5th argument passed to this method
 	// invocation.
 	Arg4 int
@@ -6511,89 +10217,50 @@ type LSIFStoreReferencesFuncCall struct {
 	Result0 []lsifstore.Location
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 int
-	// Result2 is the value of the 3rd result returned from this method
-	// invocation.
-	Result2 error
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c LSIFStoreReferencesFuncCall) Args() []interface{} {
+func (c SearchClientDefinitionsFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5, c.Arg6}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c LSIFStoreReferencesFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2}
-}
-
-// MockRepoUpdaterClient is a mock implementation of the RepoUpdaterClient
-// interface (from the package
-// github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers)
-// used for unit testing.
-type MockRepoUpdaterClient struct {
-	// EnqueueRepoUpdateFunc is an instance of a mock function object
-	// controlling the behavior of the method EnqueueRepoUpdate.
-	EnqueueRepoUpdateFunc *RepoUpdaterClientEnqueueRepoUpdateFunc
-}
-
-// NewMockRepoUpdaterClient creates a new mock of the RepoUpdaterClient
-// interface. All methods return zero values for all results, unless
-// overwritten.
-func NewMockRepoUpdaterClient() *MockRepoUpdaterClient {
-	return &MockRepoUpdaterClient{
-		EnqueueRepoUpdateFunc: &RepoUpdaterClientEnqueueRepoUpdateFunc{
-			defaultHook: func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error) {
-				return nil, nil
-			},
-		},
-	}
-}
-
-// NewMockRepoUpdaterClientFrom creates a new mock of the
-// MockRepoUpdaterClient interface. All methods delegate to the given
-// implementation, unless overwritten.
-func NewMockRepoUpdaterClientFrom(i RepoUpdaterClient) *MockRepoUpdaterClient {
-	return &MockRepoUpdaterClient{
-		EnqueueRepoUpdateFunc: &RepoUpdaterClientEnqueueRepoUpdateFunc{
-			defaultHook: i.EnqueueRepoUpdate,
-		},
-	}
+func (c SearchClientDefinitionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoUpdaterClientEnqueueRepoUpdateFunc describes the behavior when the
-// EnqueueRepoUpdate method of the parent MockRepoUpdaterClient instance is
-// invoked.
-type RepoUpdaterClientEnqueueRepoUpdateFunc struct {
-	defaultHook func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error)
-	hooks       []func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error)
-	history     []RepoUpdaterClientEnqueueRepoUpdateFuncCall
+// SearchClientReferencesFunc describes the behavior when the References
+// method of the parent MockSearchClient instance is invoked.
+type SearchClientReferencesFunc struct {
+	defaultHook func(context.Context, int, string, string, int, int, int) ([]lsifstore.Location, error)
+	hooks       []func(context.Context, int, string, string, int, int, int) ([]lsifstore.Location, error)
+	history     []SearchClientReferencesFuncCall
 	mutex       sync.Mutex
 }
 
-// EnqueueRepoUpdate delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockRepoUpdaterClient) EnqueueRepoUpdate(v0 context.Context, v1 api.RepoName) (*protocol.RepoUpdateResponse, error) {
-	r0, r1 := m.EnqueueRepoUpdateFunc.nextHook()(v0, v1)
-	m.EnqueueRepoUpdateFunc.appendCall(RepoUpdaterClientEnqueueRepoUpdateFuncCall{v0, v1, r0, r1})
+// References delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockSearchClient) References(v0 context.Context, v1 int, v2 string, v3 string, v4 int, v5 int, v6 int) ([]lsifstore.Location, error) {
+	r0, r1 := m.ReferencesFunc.nextHook()(v0, v1, v2, v3, v4, v5, v6)
+	m.ReferencesFunc.appendCall(SearchClientReferencesFuncCall{v0, v1, v2, v3, v4, v5, v6, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the EnqueueRepoUpdate
-// method of the parent MockRepoUpdaterClient instance is invoked and the
-// hook queue is empty.
-func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error)) {
+// SetDefaultHook sets function that is called when the References method of
+// the parent MockSearchClient instance is invoked and the hook queue is
+// empty.
+func (f *SearchClientReferencesFunc) SetDefaultHook(hook func(context.Context, int, string, string, int, int, int) ([]lsifstore.Location, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// EnqueueRepoUpdate method of the parent MockRepoUpdaterClient instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) PushHook(hook func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error)) {
+// References method of the parent MockSearchClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SearchClientReferencesFunc) PushHook(hook func(context.Context, int, string, string, int, int, int) ([]lsifstore.Location, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -6601,21 +10268,21 @@ func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) PushHook(hook func(context.Cont
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) SetDefaultReturn(r0 *protocol.RepoUpdateResponse, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error) {
+func (f *SearchClientReferencesFunc) SetDefaultReturn(r0 []lsifstore.Location, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string, string, int, int, int) ([]lsifstore.Location, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) PushReturn(r0 *protocol.RepoUpdateResponse, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error) {
+func (f *SearchClientReferencesFunc) PushReturn(r0 []lsifstore.Location, r1 error) {
+	f.PushHook(func(context.Context, int, string, string, int, int, int) ([]lsifstore.Location, error) {
 		return r0, r1
 	})
 }
 
-func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) nextHook() func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error) {
+func (f *SearchClientReferencesFunc) nextHook() func(context.Context, int, string, string, int, int, int) ([]lsifstore.Location, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -6628,36 +10295,50 @@ func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) nextHook() func(context.Context
 	return hook
 }
 
-func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) appendCall(r0 RepoUpdaterClientEnqueueRepoUpdateFuncCall) {
+func (f *SearchClientReferencesFunc) appendCall(r0 SearchClientReferencesFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoUpdaterClientEnqueueRepoUpdateFuncCall
-// objects describing the invocations of this function.
-func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) History() []RepoUpdaterClientEnqueueRepoUpdateFuncCall {
+// History returns a sequence of SearchClientReferencesFuncCall objects
+// describing the invocations of this function.
+func (f *SearchClientReferencesFunc) History() []SearchClientReferencesFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoUpdaterClientEnqueueRepoUpdateFuncCall, len(f.history))
+	history := make([]SearchClientReferencesFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoUpdaterClientEnqueueRepoUpdateFuncCall is an object that describes an
-// invocation of method EnqueueRepoUpdate on an instance of
-// MockRepoUpdaterClient.
-type RepoUpdaterClientEnqueueRepoUpdateFuncCall struct {
+// SearchClientReferencesFuncCall is an object that describes an invocation
+// of method References on an instance of MockSearchClient.
+type SearchClientReferencesFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 api.RepoName
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 int
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 int
+	// Arg6 is the value of the 7th argument passed to this method
+	// invocation.
+	Arg6 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *protocol.RepoUpdateResponse
+	Result0 []lsifstore.Location
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -6665,12 +10346,12 @@ type RepoUpdaterClientEnqueueRepoUpdateFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoUpdaterClientEnqueueRepoUpdateFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c SearchClientReferencesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5, c.Arg6}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoUpdaterClientEnqueueRepoUpdateFuncCall) Results() []interface{} {
+func (c SearchClientReferencesFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
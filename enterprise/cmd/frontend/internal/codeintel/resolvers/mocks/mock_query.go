@@ -22,18 +22,45 @@ type MockQueryResolver struct {
 	// DiagnosticsFunc is an instance of a mock function object controlling
 	// the behavior of the method Diagnostics.
 	DiagnosticsFunc *QueryResolverDiagnosticsFunc
+	// DocumentSymbolsFunc is an instance of a mock function object
+	// controlling the behavior of the method DocumentSymbols.
+	DocumentSymbolsFunc *QueryResolverDocumentSymbolsFunc
+	// DocumentationFunc is an instance of a mock function object
+	// controlling the behavior of the method Documentation.
+	DocumentationFunc *QueryResolverDocumentationFunc
 	// DocumentationPageFunc is an instance of a mock function object
 	// controlling the behavior of the method DocumentationPage.
 	DocumentationPageFunc *QueryResolverDocumentationPageFunc
+	// FoldingRangesFunc is an instance of a mock function object controlling
+	// the behavior of the method FoldingRanges.
+	FoldingRangesFunc *QueryResolverFoldingRangesFunc
 	// HoverFunc is an instance of a mock function object controlling the
 	// behavior of the method Hover.
 	HoverFunc *QueryResolverHoverFunc
+	// ImplementationsFunc is an instance of a mock function object
+	// controlling the behavior of the method Implementations.
+	ImplementationsFunc *QueryResolverImplementationsFunc
 	// RangesFunc is an instance of a mock function object controlling the
 	// behavior of the method Ranges.
 	RangesFunc *QueryResolverRangesFunc
+	// RangesAllFunc is an instance of a mock function object controlling
+	// the behavior of the method RangesAll.
+	RangesAllFunc *QueryResolverRangesAllFunc
+	// ReferenceCountFunc is an instance of a mock function object
+	// controlling the behavior of the method ReferenceCount.
+	ReferenceCountFunc *QueryResolverReferenceCountFunc
 	// ReferencesFunc is an instance of a mock function object controlling
 	// the behavior of the method References.
 	ReferencesFunc *QueryResolverReferencesFunc
+	// ReferencesStreamFunc is an instance of a mock function object
+	// controlling the behavior of the method ReferencesStream.
+	ReferencesStreamFunc *QueryResolverReferencesStreamFunc
+	// SymbolsFunc is an instance of a mock function object controlling the
+	// behavior of the method Symbols.
+	SymbolsFunc *QueryResolverSymbolsFunc
+	// WriteReferencesFunc is an instance of a mock function object
+	// controlling the behavior of the method WriteReferences.
+	WriteReferencesFunc *QueryResolverWriteReferencesFunc
 }
 
 // NewMockQueryResolver creates a new mock of the QueryResolver interface.
@@ -46,8 +73,18 @@ func NewMockQueryResolver() *MockQueryResolver {
 			},
 		},
 		DiagnosticsFunc: &QueryResolverDiagnosticsFunc{
-			defaultHook: func(context.Context, int) ([]resolvers.AdjustedDiagnostic, int, error) {
-				return nil, 0, nil
+			defaultHook: func(context.Context, int, string) ([]resolvers.AdjustedDiagnostic, int, string, error) {
+				return nil, 0, "", nil
+			},
+		},
+		DocumentSymbolsFunc: &QueryResolverDocumentSymbolsFunc{
+			defaultHook: func(context.Context) ([]resolvers.AdjustedDocumentSymbol, error) {
+				return nil, nil
+			},
+		},
+		DocumentationFunc: &QueryResolverDocumentationFunc{
+			defaultHook: func(context.Context, int, int) ([]*semantic.DocumentationNode, error) {
+				return nil, nil
 			},
 		},
 		DocumentationPageFunc: &QueryResolverDocumentationPageFunc{
@@ -55,21 +92,56 @@ func NewMockQueryResolver() *MockQueryResolver {
 				return nil, nil
 			},
 		},
+		FoldingRangesFunc: &QueryResolverFoldingRangesFunc{
+			defaultHook: func(context.Context) ([]resolvers.FoldingRange, error) {
+				return nil, nil
+			},
+		},
 		HoverFunc: &QueryResolverHoverFunc{
-			defaultHook: func(context.Context, int, int) (string, lsifstore.Range, bool, error) {
+			defaultHook: func(context.Context, int, int, lsifstore.HoverFormat) (string, lsifstore.Range, bool, error) {
 				return "", lsifstore.Range{}, false, nil
 			},
 		},
+		ImplementationsFunc: &QueryResolverImplementationsFunc{
+			defaultHook: func(context.Context, int, int, int, string) ([]resolvers.AdjustedLocation, string, error) {
+				return nil, "", nil
+			},
+		},
 		RangesFunc: &QueryResolverRangesFunc{
 			defaultHook: func(context.Context, int, int) ([]resolvers.AdjustedCodeIntelligenceRange, error) {
 				return nil, nil
 			},
 		},
+		RangesAllFunc: &QueryResolverRangesAllFunc{
+			defaultHook: func(context.Context) ([]resolvers.AdjustedCodeIntelligenceRange, error) {
+				return nil, nil
+			},
+		},
+		ReferenceCountFunc: &QueryResolverReferenceCountFunc{
+			defaultHook: func(context.Context, int, int) (int, bool, error) {
+				return 0, false, nil
+			},
+		},
 		ReferencesFunc: &QueryResolverReferencesFunc{
 			defaultHook: func(context.Context, int, int, int, string) ([]resolvers.AdjustedLocation, string, error) {
 				return nil, "", nil
 			},
 		},
+		ReferencesStreamFunc: &QueryResolverReferencesStreamFunc{
+			defaultHook: func(context.Context, int, int, func(resolvers.AdjustedLocation) error) error {
+				return nil
+			},
+		},
+		SymbolsFunc: &QueryResolverSymbolsFunc{
+			defaultHook: func(context.Context, string, int) ([]resolvers.AdjustedSymbol, error) {
+				return nil, nil
+			},
+		},
+		WriteReferencesFunc: &QueryResolverWriteReferencesFunc{
+			defaultHook: func(context.Context, int, int, int, string) ([]resolvers.AdjustedLocation, string, error) {
+				return nil, "", nil
+			},
+		},
 	}
 }
 
@@ -84,18 +156,45 @@ func NewMockQueryResolverFrom(i resolvers.QueryResolver) *MockQueryResolver {
 		DiagnosticsFunc: &QueryResolverDiagnosticsFunc{
 			defaultHook: i.Diagnostics,
 		},
+		DocumentSymbolsFunc: &QueryResolverDocumentSymbolsFunc{
+			defaultHook: i.DocumentSymbols,
+		},
+		DocumentationFunc: &QueryResolverDocumentationFunc{
+			defaultHook: i.Documentation,
+		},
 		DocumentationPageFunc: &QueryResolverDocumentationPageFunc{
 			defaultHook: i.DocumentationPage,
 		},
+		FoldingRangesFunc: &QueryResolverFoldingRangesFunc{
+			defaultHook: i.FoldingRanges,
+		},
 		HoverFunc: &QueryResolverHoverFunc{
 			defaultHook: i.Hover,
 		},
+		ImplementationsFunc: &QueryResolverImplementationsFunc{
+			defaultHook: i.Implementations,
+		},
 		RangesFunc: &QueryResolverRangesFunc{
 			defaultHook: i.Ranges,
 		},
+		RangesAllFunc: &QueryResolverRangesAllFunc{
+			defaultHook: i.RangesAll,
+		},
+		ReferenceCountFunc: &QueryResolverReferenceCountFunc{
+			defaultHook: i.ReferenceCount,
+		},
 		ReferencesFunc: &QueryResolverReferencesFunc{
 			defaultHook: i.References,
 		},
+		ReferencesStreamFunc: &QueryResolverReferencesStreamFunc{
+			defaultHook: i.ReferencesStream,
+		},
+		SymbolsFunc: &QueryResolverSymbolsFunc{
+			defaultHook: i.Symbols,
+		},
+		WriteReferencesFunc: &QueryResolverWriteReferencesFunc{
+			defaultHook: i.WriteReferences,
+		},
 	}
 }
 
@@ -214,24 +313,24 @@ func (c QueryResolverDefinitionsFuncCall) Results() []interface{} {
 // QueryResolverDiagnosticsFunc describes the behavior when the Diagnostics
 // method of the parent MockQueryResolver instance is invoked.
 type QueryResolverDiagnosticsFunc struct {
-	defaultHook func(context.Context, int) ([]resolvers.AdjustedDiagnostic, int, error)
-	hooks       []func(context.Context, int) ([]resolvers.AdjustedDiagnostic, int, error)
+	defaultHook func(context.Context, int, string) ([]resolvers.AdjustedDiagnostic, int, string, error)
+	hooks       []func(context.Context, int, string) ([]resolvers.AdjustedDiagnostic, int, string, error)
 	history     []QueryResolverDiagnosticsFuncCall
 	mutex       sync.Mutex
 }
 
 // Diagnostics delegates to the next hook function in the queue and stores
 // the parameter and result values of this invocation.
-func (m *MockQueryResolver) Diagnostics(v0 context.Context, v1 int) ([]resolvers.AdjustedDiagnostic, int, error) {
-	r0, r1, r2 := m.DiagnosticsFunc.nextHook()(v0, v1)
-	m.DiagnosticsFunc.appendCall(QueryResolverDiagnosticsFuncCall{v0, v1, r0, r1, r2})
-	return r0, r1, r2
+func (m *MockQueryResolver) Diagnostics(v0 context.Context, v1 int, v2 string) ([]resolvers.AdjustedDiagnostic, int, string, error) {
+	r0, r1, r2, r3 := m.DiagnosticsFunc.nextHook()(v0, v1, v2)
+	m.DiagnosticsFunc.appendCall(QueryResolverDiagnosticsFuncCall{v0, v1, v2, r0, r1, r2, r3})
+	return r0, r1, r2, r3
 }
 
 // SetDefaultHook sets function that is called when the Diagnostics method
 // of the parent MockQueryResolver instance is invoked and the hook queue is
 // empty.
-func (f *QueryResolverDiagnosticsFunc) SetDefaultHook(hook func(context.Context, int) ([]resolvers.AdjustedDiagnostic, int, error)) {
+func (f *QueryResolverDiagnosticsFunc) SetDefaultHook(hook func(context.Context, int, string) ([]resolvers.AdjustedDiagnostic, int, string, error)) {
 	f.defaultHook = hook
 }
 
@@ -239,7 +338,7 @@ func (f *QueryResolverDiagnosticsFunc) SetDefaultHook(hook func(context.Context,
 // Diagnostics method of the parent MockQueryResolver instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *QueryResolverDiagnosticsFunc) PushHook(hook func(context.Context, int) ([]resolvers.AdjustedDiagnostic, int, error)) {
+func (f *QueryResolverDiagnosticsFunc) PushHook(hook func(context.Context, int, string) ([]resolvers.AdjustedDiagnostic, int, string, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -247,21 +346,21 @@ func (f *QueryResolverDiagnosticsFunc) PushHook(hook func(context.Context, int)
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *QueryResolverDiagnosticsFunc) SetDefaultReturn(r0 []resolvers.AdjustedDiagnostic, r1 int, r2 error) {
-	f.SetDefaultHook(func(context.Context, int) ([]resolvers.AdjustedDiagnostic, int, error) {
-		return r0, r1, r2
+func (f *QueryResolverDiagnosticsFunc) SetDefaultReturn(r0 []resolvers.AdjustedDiagnostic, r1 int, r2 string, r3 error) {
+	f.SetDefaultHook(func(context.Context, int, string) ([]resolvers.AdjustedDiagnostic, int, string, error) {
+		return r0, r1, r2, r3
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *QueryResolverDiagnosticsFunc) PushReturn(r0 []resolvers.AdjustedDiagnostic, r1 int, r2 error) {
-	f.PushHook(func(context.Context, int) ([]resolvers.AdjustedDiagnostic, int, error) {
-		return r0, r1, r2
+func (f *QueryResolverDiagnosticsFunc) PushReturn(r0 []resolvers.AdjustedDiagnostic, r1 int, r2 string, r3 error) {
+	f.PushHook(func(context.Context, int, string) ([]resolvers.AdjustedDiagnostic, int, string, error) {
+		return r0, r1, r2, r3
 	})
 }
 
-func (f *QueryResolverDiagnosticsFunc) nextHook() func(context.Context, int) ([]resolvers.AdjustedDiagnostic, int, error) {
+func (f *QueryResolverDiagnosticsFunc) nextHook() func(context.Context, int, string) ([]resolvers.AdjustedDiagnostic, int, string, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -300,6 +399,9 @@ type QueryResolverDiagnosticsFuncCall struct {
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
 	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 []resolvers.AdjustedDiagnostic
@@ -308,51 +410,54 @@ type QueryResolverDiagnosticsFuncCall struct {
 	Result1 int
 	// Result2 is the value of the 3rd result returned from this method
 	// invocation.
-	Result2 error
+	Result2 string
+	// Result3 is the value of the 4th result returned from this method
+	// invocation.
+	Result3 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
 func (c QueryResolverDiagnosticsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
 func (c QueryResolverDiagnosticsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2}
+	return []interface{}{c.Result0, c.Result1, c.Result2, c.Result3}
 }
 
-// QueryResolverDocumentationPageFunc describes the behavior when the
-// DocumentationPage method of the parent MockQueryResolver instance is
+// QueryResolverDocumentSymbolsFunc describes the behavior when the
+// DocumentSymbols method of the parent MockQueryResolver instance is
 // invoked.
-type QueryResolverDocumentationPageFunc struct {
-	defaultHook func(context.Context, string) (*semantic.DocumentationPageData, error)
-	hooks       []func(context.Context, string) (*semantic.DocumentationPageData, error)
-	history     []QueryResolverDocumentationPageFuncCall
+type QueryResolverDocumentSymbolsFunc struct {
+	defaultHook func(context.Context) ([]resolvers.AdjustedDocumentSymbol, error)
+	hooks       []func(context.Context) ([]resolvers.AdjustedDocumentSymbol, error)
+	history     []QueryResolverDocumentSymbolsFuncCall
 	mutex       sync.Mutex
 }
 
-// DocumentationPage delegates to the next hook function in the queue and
+// DocumentSymbols delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockQueryResolver) DocumentationPage(v0 context.Context, v1 string) (*semantic.DocumentationPageData, error) {
-	r0, r1 := m.DocumentationPageFunc.nextHook()(v0, v1)
-	m.DocumentationPageFunc.appendCall(QueryResolverDocumentationPageFuncCall{v0, v1, r0, r1})
+func (m *MockQueryResolver) DocumentSymbols(v0 context.Context) ([]resolvers.AdjustedDocumentSymbol, error) {
+	r0, r1 := m.DocumentSymbolsFunc.nextHook()(v0)
+	m.DocumentSymbolsFunc.appendCall(QueryResolverDocumentSymbolsFuncCall{v0, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the DocumentationPage
+// SetDefaultHook sets function that is called when the DocumentSymbols
 // method of the parent MockQueryResolver instance is invoked and the hook
 // queue is empty.
-func (f *QueryResolverDocumentationPageFunc) SetDefaultHook(hook func(context.Context, string) (*semantic.DocumentationPageData, error)) {
+func (f *QueryResolverDocumentSymbolsFunc) SetDefaultHook(hook func(context.Context) ([]resolvers.AdjustedDocumentSymbol, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// DocumentationPage method of the parent MockQueryResolver instance invokes
+// DocumentSymbols method of the parent MockQueryResolver instance invokes
 // the hook at the front of the queue and discards it. After the queue is
 // empty, the default hook function is invoked for any future action.
-func (f *QueryResolverDocumentationPageFunc) PushHook(hook func(context.Context, string) (*semantic.DocumentationPageData, error)) {
+func (f *QueryResolverDocumentSymbolsFunc) PushHook(hook func(context.Context) ([]resolvers.AdjustedDocumentSymbol, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -360,21 +465,21 @@ func (f *QueryResolverDocumentationPageFunc) PushHook(hook func(context.Context,
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *QueryResolverDocumentationPageFunc) SetDefaultReturn(r0 *semantic.DocumentationPageData, r1 error) {
-	f.SetDefaultHook(func(context.Context, string) (*semantic.DocumentationPageData, error) {
+func (f *QueryResolverDocumentSymbolsFunc) SetDefaultReturn(r0 []resolvers.AdjustedDocumentSymbol, r1 error) {
+	f.SetDefaultHook(func(context.Context) ([]resolvers.AdjustedDocumentSymbol, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *QueryResolverDocumentationPageFunc) PushReturn(r0 *semantic.DocumentationPageData, r1 error) {
-	f.PushHook(func(context.Context, string) (*semantic.DocumentationPageData, error) {
+func (f *QueryResolverDocumentSymbolsFunc) PushReturn(r0 []resolvers.AdjustedDocumentSymbol, r1 error) {
+	f.PushHook(func(context.Context) ([]resolvers.AdjustedDocumentSymbol, error) {
 		return r0, r1
 	})
 }
 
-func (f *QueryResolverDocumentationPageFunc) nextHook() func(context.Context, string) (*semantic.DocumentationPageData, error) {
+func (f *QueryResolverDocumentSymbolsFunc) nextHook() func(context.Context) ([]resolvers.AdjustedDocumentSymbol, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -387,36 +492,32 @@ func (f *QueryResolverDocumentationPageFunc) nextHook() func(context.Context, st
 	return hook
 }
 
-func (f *QueryResolverDocumentationPageFunc) appendCall(r0 QueryResolverDocumentationPageFuncCall) {
+func (f *QueryResolverDocumentSymbolsFunc) appendCall(r0 QueryResolverDocumentSymbolsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of QueryResolverDocumentationPageFuncCall
+// History returns a sequence of QueryResolverDocumentSymbolsFuncCall
 // objects describing the invocations of this function.
-func (f *QueryResolverDocumentationPageFunc) History() []QueryResolverDocumentationPageFuncCall {
+func (f *QueryResolverDocumentSymbolsFunc) History() []QueryResolverDocumentSymbolsFuncCall {
 	f.mutex.Lock()
-	history := make([]QueryResolverDocumentationPageFuncCall, len(f.history))
+	history := make([]QueryResolverDocumentSymbolsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// QueryResolverDocumentationPageFuncCall is an object that describes an
-// invocation of method DocumentationPage on an instance of
-// MockQueryResolver.
-type QueryResolverDocumentationPageFuncCall struct {
+// QueryResolverDocumentSymbolsFuncCall is an object that describes an
+// invocation of method DocumentSymbols on an instance of MockQueryResolver.
+type QueryResolverDocumentSymbolsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *semantic.DocumentationPageData
+	Result0 []resolvers.AdjustedDocumentSymbol
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -424,44 +525,45 @@ type QueryResolverDocumentationPageFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c QueryResolverDocumentationPageFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c QueryResolverDocumentSymbolsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c QueryResolverDocumentationPageFuncCall) Results() []interface{} {
+func (c QueryResolverDocumentSymbolsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// QueryResolverHoverFunc describes the behavior when the Hover method of
-// the parent MockQueryResolver instance is invoked.
-type QueryResolverHoverFunc struct {
-	defaultHook func(context.Context, int, int) (string, lsifstore.Range, bool, error)
-	hooks       []func(context.Context, int, int) (string, lsifstore.Range, bool, error)
-	history     []QueryResolverHoverFuncCall
+// QueryResolverDocumentationFunc describes the behavior when the
+// Documentation method of the parent MockQueryResolver instance is invoked.
+type QueryResolverDocumentationFunc struct {
+	defaultHook func(context.Context, int, int) ([]*semantic.DocumentationNode, error)
+	hooks       []func(context.Context, int, int) ([]*semantic.DocumentationNode, error)
+	history     []QueryResolverDocumentationFuncCall
 	mutex       sync.Mutex
 }
 
-// Hover delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockQueryResolver) Hover(v0 context.Context, v1 int, v2 int) (string, lsifstore.Range, bool, error) {
-	r0, r1, r2, r3 := m.HoverFunc.nextHook()(v0, v1, v2)
-	m.HoverFunc.appendCall(QueryResolverHoverFuncCall{v0, v1, v2, r0, r1, r2, r3})
-	return r0, r1, r2, r3
+// Documentation delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockQueryResolver) Documentation(v0 context.Context, v1 int, v2 int) ([]*semantic.DocumentationNode, error) {
+	r0, r1 := m.DocumentationFunc.nextHook()(v0, v1, v2)
+	m.DocumentationFunc.appendCall(QueryResolverDocumentationFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Hover method of the
-// parent MockQueryResolver instance is invoked and the hook queue is empty.
-func (f *QueryResolverHoverFunc) SetDefaultHook(hook func(context.Context, int, int) (string, lsifstore.Range, bool, error)) {
+// SetDefaultHook sets function that is called when the Documentation method
+// of the parent MockQueryResolver instance is invoked and the hook queue is
+// empty.
+func (f *QueryResolverDocumentationFunc) SetDefaultHook(hook func(context.Context, int, int) ([]*semantic.DocumentationNode, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Hover method of the parent MockQueryResolver instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *QueryResolverHoverFunc) PushHook(hook func(context.Context, int, int) (string, lsifstore.Range, bool, error)) {
+// Documentation method of the parent MockQueryResolver instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *QueryResolverDocumentationFunc) PushHook(hook func(context.Context, int, int) ([]*semantic.DocumentationNode, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -469,21 +571,21 @@ func (f *QueryResolverHoverFunc) PushHook(hook func(context.Context, int, int) (
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *QueryResolverHoverFunc) SetDefaultReturn(r0 string, r1 lsifstore.Range, r2 bool, r3 error) {
-	f.SetDefaultHook(func(context.Context, int, int) (string, lsifstore.Range, bool, error) {
-		return r0, r1, r2, r3
+func (f *QueryResolverDocumentationFunc) SetDefaultReturn(r0 []*semantic.DocumentationNode, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, int) ([]*semantic.DocumentationNode, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *QueryResolverHoverFunc) PushReturn(r0 string, r1 lsifstore.Range, r2 bool, r3 error) {
-	f.PushHook(func(context.Context, int, int) (string, lsifstore.Range, bool, error) {
-		return r0, r1, r2, r3
+func (f *QueryResolverDocumentationFunc) PushReturn(r0 []*semantic.DocumentationNode, r1 error) {
+	f.PushHook(func(context.Context, int, int) ([]*semantic.DocumentationNode, error) {
+		return r0, r1
 	})
 }
 
-func (f *QueryResolverHoverFunc) nextHook() func(context.Context, int, int) (string, lsifstore.Range, bool, error) {
+func (f *QueryResolverDocumentationFunc) nextHook() func(context.Context, int, int) ([]*semantic.DocumentationNode, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -496,26 +598,26 @@ func (f *QueryResolverHoverFunc) nextHook() func(context.Context, int, int) (str
 	return hook
 }
 
-func (f *QueryResolverHoverFunc) appendCall(r0 QueryResolverHoverFuncCall) {
+func (f *QueryResolverDocumentationFunc) appendCall(r0 QueryResolverDocumentationFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of QueryResolverHoverFuncCall objects
+// History returns a sequence of QueryResolverDocumentationFuncCall objects
 // describing the invocations of this function.
-func (f *QueryResolverHoverFunc) History() []QueryResolverHoverFuncCall {
+func (f *QueryResolverDocumentationFunc) History() []QueryResolverDocumentationFuncCall {
 	f.mutex.Lock()
-	history := make([]QueryResolverHoverFuncCall, len(f.history))
+	history := make([]QueryResolverDocumentationFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// QueryResolverHoverFuncCall is an object that describes an invocation of
-// method Hover on an instance of MockQueryResolver.
-type QueryResolverHoverFuncCall struct {
+// QueryResolverDocumentationFuncCall is an object that describes an
+// invocation of method Documentation on an instance of MockQueryResolver.
+type QueryResolverDocumentationFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -527,58 +629,54 @@ type QueryResolverHoverFuncCall struct {
 	Arg2 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 string
+	Result0 []*semantic.DocumentationNode
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 lsifstore.Range
-	// Result2 is the value of the 3rd result returned from this method
-	// invocation.
-	Result2 bool
-	// Result3 is the value of the 4th result returned from this method
-	// invocation.
-	Result3 error
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c QueryResolverHoverFuncCall) Args() []interface{} {
+func (c QueryResolverDocumentationFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c QueryResolverHoverFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2, c.Result3}
+func (c QueryResolverDocumentationFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// QueryResolverRangesFunc describes the behavior when the Ranges method of
-// the parent MockQueryResolver instance is invoked.
-type QueryResolverRangesFunc struct {
-	defaultHook func(context.Context, int, int) ([]resolvers.AdjustedCodeIntelligenceRange, error)
-	hooks       []func(context.Context, int, int) ([]resolvers.AdjustedCodeIntelligenceRange, error)
-	history     []QueryResolverRangesFuncCall
+// QueryResolverDocumentationPageFunc describes the behavior when the
+// DocumentationPage method of the parent MockQueryResolver instance is
+// invoked.
+type QueryResolverDocumentationPageFunc struct {
+	defaultHook func(context.Context, string) (*semantic.DocumentationPageData, error)
+	hooks       []func(context.Context, string) (*semantic.DocumentationPageData, error)
+	history     []QueryResolverDocumentationPageFuncCall
 	mutex       sync.Mutex
 }
 
-// Ranges delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockQueryResolver) Ranges(v0 context.Context, v1 int, v2 int) ([]resolvers.AdjustedCodeIntelligenceRange, error) {
-	r0, r1 := m.RangesFunc.nextHook()(v0, v1, v2)
-	m.RangesFunc.appendCall(QueryResolverRangesFuncCall{v0, v1, v2, r0, r1})
+// DocumentationPage delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockQueryResolver) DocumentationPage(v0 context.Context, v1 string) (*semantic.DocumentationPageData, error) {
+	r0, r1 := m.DocumentationPageFunc.nextHook()(v0, v1)
+	m.DocumentationPageFunc.appendCall(QueryResolverDocumentationPageFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Ranges method of the
-// parent MockQueryResolver instance is invoked and the hook queue is empty.
-func (f *QueryResolverRangesFunc) SetDefaultHook(hook func(context.Context, int, int) ([]resolvers.AdjustedCodeIntelligenceRange, error)) {
+// SetDefaultHook sets function that is called when the DocumentationPage
+// method of the parent MockQueryResolver instance is invoked and the hook
+// queue is empty.
+func (f *QueryResolverDocumentationPageFunc) SetDefaultHook(hook func(context.Context, string) (*semantic.DocumentationPageData, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Ranges method of the parent MockQueryResolver instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *QueryResolverRangesFunc) PushHook(hook func(context.Context, int, int) ([]resolvers.AdjustedCodeIntelligenceRange, error)) {
+// DocumentationPage method of the parent MockQueryResolver instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *QueryResolverDocumentationPageFunc) PushHook(hook func(context.Context, string) (*semantic.DocumentationPageData, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -586,21 +684,21 @@ func (f *QueryResolverRangesFunc) PushHook(hook func(context.Context, int, int)
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *QueryResolverRangesFunc) SetDefaultReturn(r0 []resolvers.AdjustedCodeIntelligenceRange, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, int) ([]resolvers.AdjustedCodeIntelligenceRange, error) {
+func (f *QueryResolverDocumentationPageFunc) SetDefaultReturn(r0 *semantic.DocumentationPageData, r1 error) {
+	f.SetDefaultHook(func(context.Context, string) (*semantic.DocumentationPageData, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *QueryResolverRangesFunc) PushReturn(r0 []resolvers.AdjustedCodeIntelligenceRange, r1 error) {
-	f.PushHook(func(context.Context, int, int) ([]resolvers.AdjustedCodeIntelligenceRange, error) {
+func (f *QueryResolverDocumentationPageFunc) PushReturn(r0 *semantic.DocumentationPageData, r1 error) {
+	f.PushHook(func(context.Context, string) (*semantic.DocumentationPageData, error) {
 		return r0, r1
 	})
 }
 
-func (f *QueryResolverRangesFunc) nextHook() func(context.Context, int, int) ([]resolvers.AdjustedCodeIntelligenceRange, error) {
+func (f *QueryResolverDocumentationPageFunc) nextHook() func(context.Context, string) (*semantic.DocumentationPageData, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -613,38 +711,36 @@ func (f *QueryResolverRangesFunc) nextHook() func(context.Context, int, int) ([]
 	return hook
 }
 
-func (f *QueryResolverRangesFunc) appendCall(r0 QueryResolverRangesFuncCall) {
+func (f *QueryResolverDocumentationPageFunc) appendCall(r0 QueryResolverDocumentationPageFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of QueryResolverRangesFuncCall objects
-// describing the invocations of this function.
-func (f *QueryResolverRangesFunc) History() []QueryResolverRangesFuncCall {
+// History returns a sequence of QueryResolverDocumentationPageFuncCall
+// objects describing the invocations of this function.
+func (f *QueryResolverDocumentationPageFunc) History() []QueryResolverDocumentationPageFuncCall {
 	f.mutex.Lock()
-	history := make([]QueryResolverRangesFuncCall, len(f.history))
+	history := make([]QueryResolverDocumentationPageFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// QueryResolverRangesFuncCall is an object that describes an invocation of
-// method Ranges on an instance of MockQueryResolver.
-type QueryResolverRangesFuncCall struct {
+// QueryResolverDocumentationPageFuncCall is an object that describes an
+// invocation of method DocumentationPage on an instance of
+// MockQueryResolver.
+type QueryResolverDocumentationPageFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 int
+	Arg1 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []resolvers.AdjustedCodeIntelligenceRange
+	Result0 *semantic.DocumentationPageData
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -652,45 +748,45 @@ type QueryResolverRangesFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c QueryResolverRangesFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c QueryResolverDocumentationPageFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c QueryResolverRangesFuncCall) Results() []interface{} {
+func (c QueryResolverDocumentationPageFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// QueryResolverReferencesFunc describes the behavior when the References
-// method of the parent MockQueryResolver instance is invoked.
-type QueryResolverReferencesFunc struct {
-	defaultHook func(context.Context, int, int, int, string) ([]resolvers.AdjustedLocation, string, error)
-	hooks       []func(context.Context, int, int, int, string) ([]resolvers.AdjustedLocation, string, error)
-	history     []QueryResolverReferencesFuncCall
+// QueryResolverFoldingRangesFunc describes the behavior when the
+// FoldingRanges method of the parent MockQueryResolver instance is invoked.
+type QueryResolverFoldingRangesFunc struct {
+	defaultHook func(context.Context) ([]resolvers.FoldingRange, error)
+	hooks       []func(context.Context) ([]resolvers.FoldingRange, error)
+	history     []QueryResolverFoldingRangesFuncCall
 	mutex       sync.Mutex
 }
 
-// References delegates to the next hook function in the queue and stores
+// FoldingRanges delegates to the next hook function in the queue and stores
 // the parameter and result values of this invocation.
-func (m *MockQueryResolver) References(v0 context.Context, v1 int, v2 int, v3 int, v4 string) ([]resolvers.AdjustedLocation, string, error) {
-	r0, r1, r2 := m.ReferencesFunc.nextHook()(v0, v1, v2, v3, v4)
-	m.ReferencesFunc.appendCall(QueryResolverReferencesFuncCall{v0, v1, v2, v3, v4, r0, r1, r2})
-	return r0, r1, r2
+func (m *MockQueryResolver) FoldingRanges(v0 context.Context) ([]resolvers.FoldingRange, error) {
+	r0, r1 := m.FoldingRangesFunc.nextHook()(v0)
+	m.FoldingRangesFunc.appendCall(QueryResolverFoldingRangesFuncCall{v0, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the References method of
-// the parent MockQueryResolver instance is invoked and the hook queue is
+// SetDefaultHook sets function that is called when the FoldingRanges method
+// of the parent MockQueryResolver instance is invoked and the hook queue is
 // empty.
-func (f *QueryResolverReferencesFunc) SetDefaultHook(hook func(context.Context, int, int, int, string) ([]resolvers.AdjustedLocation, string, error)) {
+func (f *QueryResolverFoldingRangesFunc) SetDefaultHook(hook func(context.Context) ([]resolvers.FoldingRange, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// References method of the parent MockQueryResolver instance invokes the
+// FoldingRanges method of the parent MockQueryResolver instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *QueryResolverReferencesFunc) PushHook(hook func(context.Context, int, int, int, string) ([]resolvers.AdjustedLocation, string, error)) {
+func (f *QueryResolverFoldingRangesFunc) PushHook(hook func(context.Context) ([]resolvers.FoldingRange, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -698,21 +794,21 @@ func (f *QueryResolverReferencesFunc) PushHook(hook func(context.Context, int, i
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *QueryResolverReferencesFunc) SetDefaultReturn(r0 []resolvers.AdjustedLocation, r1 string, r2 error) {
-	f.SetDefaultHook(func(context.Context, int, int, int, string) ([]resolvers.AdjustedLocation, string, error) {
-		return r0, r1, r2
+func (f *QueryResolverFoldingRangesFunc) SetDefaultReturn(r0 []resolvers.FoldingRange, r1 error) {
+	f.SetDefaultHook(func(context.Context) ([]resolvers.FoldingRange, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *QueryResolverReferencesFunc) PushReturn(r0 []resolvers.AdjustedLocation, r1 string, r2 error) {
-	f.PushHook(func(context.Context, int, int, int, string) ([]resolvers.AdjustedLocation, string, error) {
-		return r0, r1, r2
+func (f *QueryResolverFoldingRangesFunc) PushReturn(r0 []resolvers.FoldingRange, r1 error) {
+	f.PushHook(func(context.Context) ([]resolvers.FoldingRange, error) {
+		return r0, r1
 	})
 }
 
-func (f *QueryResolverReferencesFunc) nextHook() func(context.Context, int, int, int, string) ([]resolvers.AdjustedLocation, string, error) {
+func (f *QueryResolverFoldingRangesFunc) nextHook() func(context.Context) ([]resolvers.FoldingRange, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -725,47 +821,1076 @@ func (f *QueryResolverReferencesFunc) nextHook() func(context.Context, int, int,
 	return hook
 }
 
-func (f *QueryResolverReferencesFunc) appendCall(r0 QueryResolverReferencesFuncCall) {
+func (f *QueryResolverFoldingRangesFunc) appendCall(r0 QueryResolverFoldingRangesFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of QueryResolverReferencesFuncCall objects
+// History returns a sequence of QueryResolverFoldingRangesFuncCall objects
 // describing the invocations of this function.
-func (f *QueryResolverReferencesFunc) History() []QueryResolverReferencesFuncCall {
+func (f *QueryResolverFoldingRangesFunc) History() []QueryResolverFoldingRangesFuncCall {
 	f.mutex.Lock()
-	history := make([]QueryResolverReferencesFuncCall, len(f.history))
+	history := make([]QueryResolverFoldingRangesFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// QueryResolverReferencesFuncCall is an object that describes an invocation
-// of method References on an instance of MockQueryResolver.
-type QueryResolverReferencesFuncCall struct {
+// QueryResolverFoldingRangesFuncCall is an object that describes an
+// invocation of method FoldingRanges on an instance of MockQueryResolver.
+type QueryResolverFoldingRangesFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 int
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 int
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 int
-	// Arg4 is the value of the 5th argument passed to this method
-	// invocation.
-	Arg4 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []resolvers.AdjustedLocation
+	Result0 []resolvers.FoldingRange
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 string
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c QueryResolverFoldingRangesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c QueryResolverFoldingRangesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// QueryResolverHoverFunc describes the behavior when the Hover method of
+// the parent MockQueryResolver instance is invoked.
+type QueryResolverHoverFunc struct {
+	defaultHook func(context.Context, int, int, lsifstore.HoverFormat) (string, lsifstore.Range, bool, error)
+	hooks       []func(context.Context, int, int, lsifstore.HoverFormat) (string, lsifstore.Range, bool, error)
+	history     []QueryResolverHoverFuncCall
+	mutex       sync.Mutex
+}
+
+// Hover delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockQueryResolver) Hover(v0 context.Context, v1 int, v2 int, v3 lsifstore.HoverFormat) (string, lsifstore.Range, bool, error) {
+	r0, r1, r2, r3 := m.HoverFunc.nextHook()(v0, v1, v2, v3)
+	m.HoverFunc.appendCall(QueryResolverHoverFuncCall{v0, v1, v2, v3, r0, r1, r2, r3})
+	return r0, r1, r2, r3
+}
+
+// SetDefaultHook sets function that is called when the Hover method of the
+// parent MockQueryResolver instance is invoked and the hook queue is empty.
+func (f *QueryResolverHoverFunc) SetDefaultHook(hook func(context.Context, int, int, lsifstore.HoverFormat) (string, lsifstore.Range, bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Hover method of the parent MockQueryResolver instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *QueryResolverHoverFunc) PushHook(hook func(context.Context, int, int, lsifstore.HoverFormat) (string, lsifstore.Range, bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *QueryResolverHoverFunc) SetDefaultReturn(r0 string, r1 lsifstore.Range, r2 bool, r3 error) {
+	f.SetDefaultHook(func(context.Context, int, int, lsifstore.HoverFormat) (string, lsifstore.Range, bool, error) {
+		return r0, r1, r2, r3
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *QueryResolverHoverFunc) PushReturn(r0 string, r1 lsifstore.Range, r2 bool, r3 error) {
+	f.PushHook(func(context.Context, int, int, lsifstore.HoverFormat) (string, lsifstore.Range, bool, error) {
+		return r0, r1, r2, r3
+	})
+}
+
+func (f *QueryResolverHoverFunc) nextHook() func(context.Context, int, int, lsifstore.HoverFormat) (string, lsifstore.Range, bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *QueryResolverHoverFunc) appendCall(r0 QueryResolverHoverFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of QueryResolverHoverFuncCall objects
+// describing the invocations of this function.
+func (f *QueryResolverHoverFunc) History() []QueryResolverHoverFuncCall {
+	f.mutex.Lock()
+	history := make([]QueryResolverHoverFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// QueryResolverHoverFuncCall is an object that describes an invocation of
+// method Hover on an instance of MockQueryResolver.
+type QueryResolverHoverFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 lsifstore.HoverFormat
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 lsifstore.Range
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 bool
+	// Result3 is the value of the 4th result returned from this method
+	// invocation.
+	Result3 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c QueryResolverHoverFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c QueryResolverHoverFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2, c.Result3}
+}
+
+// QueryResolverImplementationsFunc describes the behavior when the
+// Implementations method of the parent MockQueryResolver instance is
+// invoked.
+type QueryResolverImplementationsFunc struct {
+	defaultHook func(context.Context, int, int, int, string) ([]resolvers.AdjustedLocation, string, error)
+	hooks       []func(context.Context, int, int, int, string) ([]resolvers.AdjustedLocation, string, error)
+	history     []QueryResolverImplementationsFuncCall
+	mutex       sync.Mutex
+}
+
+// Implementations delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockQueryResolver) Implementations(v0 context.Context, v1 int, v2 int, v3 int, v4 string) ([]resolvers.AdjustedLocation, string, error) {
+	r0, r1, r2 := m.ImplementationsFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.ImplementationsFunc.appendCall(QueryResolverImplementationsFuncCall{v0, v1, v2, v3, v4, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the Implementations
+// method of the parent MockQueryResolver instance is invoked and the hook
+// queue is empty.
+func (f *QueryResolverImplementationsFunc) SetDefaultHook(hook func(context.Context, int, int, int, string) ([]resolvers.AdjustedLocation, string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Implementations method of the parent MockQueryResolver instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *QueryResolverImplementationsFunc) PushHook(hook func(context.Context, int, int, int, string) ([]resolvers.AdjustedLocation, string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *QueryResolverImplementationsFunc) SetDefaultReturn(r0 []resolvers.AdjustedLocation, r1 string, r2 error) {
+	f.SetDefaultHook(func(context.Context, int, int, int, string) ([]resolvers.AdjustedLocation, string, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *QueryResolverImplementationsFunc) PushReturn(r0 []resolvers.AdjustedLocation, r1 string, r2 error) {
+	f.PushHook(func(context.Context, int, int, int, string) ([]resolvers.AdjustedLocation, string, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *QueryResolverImplementationsFunc) nextHook() func(context.Context, int, int, int, string) ([]resolvers.AdjustedLocation, string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *QueryResolverImplementationsFunc) appendCall(r0 QueryResolverImplementationsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of QueryResolverImplementationsFuncCall
+// objects describing the invocations of this function.
+func (f *QueryResolverImplementationsFunc) History() []QueryResolverImplementationsFuncCall {
+	f.mutex.Lock()
+	history := make([]QueryResolverImplementationsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// QueryResolverImplementationsFuncCall is an object that describes an
+// invocation of method Implementations on an instance of MockQueryResolver.
+type QueryResolverImplementationsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []resolvers.AdjustedLocation
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 string
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c QueryResolverImplementationsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c QueryResolverImplementationsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// QueryResolverRangesFunc describes the behavior when the Ranges method of
+// the parent MockQueryResolver instance is invoked.
+type QueryResolverRangesFunc struct {
+	defaultHook func(context.Context, int, int) ([]resolvers.AdjustedCodeIntelligenceRange, error)
+	hooks       []func(context.Context, int, int) ([]resolvers.AdjustedCodeIntelligenceRange, error)
+	history     []QueryResolverRangesFuncCall
+	mutex       sync.Mutex
+}
+
+// Ranges delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockQueryResolver) Ranges(v0 context.Context, v1 int, v2 int) ([]resolvers.AdjustedCodeIntelligenceRange, error) {
+	r0, r1 := m.RangesFunc.nextHook()(v0, v1, v2)
+	m.RangesFunc.appendCall(QueryResolverRangesFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Ranges method of the
+// parent MockQueryResolver instance is invoked and the hook queue is empty.
+func (f *QueryResolverRangesFunc) SetDefaultHook(hook func(context.Context, int, int) ([]resolvers.AdjustedCodeIntelligenceRange, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Ranges method of the parent MockQueryResolver instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *QueryResolverRangesFunc) PushHook(hook func(context.Context, int, int) ([]resolvers.AdjustedCodeIntelligenceRange, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *QueryResolverRangesFunc) SetDefaultReturn(r0 []resolvers.AdjustedCodeIntelligenceRange, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, int) ([]resolvers.AdjustedCodeIntelligenceRange, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *QueryResolverRangesFunc) PushReturn(r0 []resolvers.AdjustedCodeIntelligenceRange, r1 error) {
+	f.PushHook(func(context.Context, int, int) ([]resolvers.AdjustedCodeIntelligenceRange, error) {
+		return r0, r1
+	})
+}
+
+func (f *QueryResolverRangesFunc) nextHook() func(context.Context, int, int) ([]resolvers.AdjustedCodeIntelligenceRange, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *QueryResolverRangesFunc) appendCall(r0 QueryResolverRangesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of QueryResolverRangesFuncCall objects
+// describing the invocations of this function.
+func (f *QueryResolverRangesFunc) History() []QueryResolverRangesFuncCall {
+	f.mutex.Lock()
+	history := make([]QueryResolverRangesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// QueryResolverRangesFuncCall is an object that describes an invocation of
+// method Ranges on an instance of MockQueryResolver.
+type QueryResolverRangesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []resolvers.AdjustedCodeIntelligenceRange
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c QueryResolverRangesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c QueryResolverRangesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// QueryResolverRangesAllFunc describes the behavior when the RangesAll
+// method of the parent MockQueryResolver instance is invoked.
+type QueryResolverRangesAllFunc struct {
+	defaultHook func(context.Context) ([]resolvers.AdjustedCodeIntelligenceRange, error)
+	hooks       []func(context.Context) ([]resolvers.AdjustedCodeIntelligenceRange, error)
+	history     []QueryResolverRangesAllFuncCall
+	mutex       sync.Mutex
+}
+
+// RangesAll delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockQueryResolver) RangesAll(v0 context.Context) ([]resolvers.AdjustedCodeIntelligenceRange, error) {
+	r0, r1 := m.RangesAllFunc.nextHook()(v0)
+	m.RangesAllFunc.appendCall(QueryResolverRangesAllFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the RangesAll method of
+// the parent MockQueryResolver instance is invoked and the hook queue is
+// empty.
+func (f *QueryResolverRangesAllFunc) SetDefaultHook(hook func(context.Context) ([]resolvers.AdjustedCodeIntelligenceRange, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RangesAll method of the parent MockQueryResolver instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *QueryResolverRangesAllFunc) PushHook(hook func(context.Context) ([]resolvers.AdjustedCodeIntelligenceRange, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *QueryResolverRangesAllFunc) SetDefaultReturn(r0 []resolvers.AdjustedCodeIntelligenceRange, r1 error) {
+	f.SetDefaultHook(func(context.Context) ([]resolvers.AdjustedCodeIntelligenceRange, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *QueryResolverRangesAllFunc) PushReturn(r0 []resolvers.AdjustedCodeIntelligenceRange, r1 error) {
+	f.PushHook(func(context.Context) ([]resolvers.AdjustedCodeIntelligenceRange, error) {
+		return r0, r1
+	})
+}
+
+func (f *QueryResolverRangesAllFunc) nextHook() func(context.Context) ([]resolvers.AdjustedCodeIntelligenceRange, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *QueryResolverRangesAllFunc) appendCall(r0 QueryResolverRangesAllFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of QueryResolverRangesAllFuncCall objects
+// describing the invocations of this function.
+func (f *QueryResolverRangesAllFunc) History() []QueryResolverRangesAllFuncCall {
+	f.mutex.Lock()
+	history := make([]QueryResolverRangesAllFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// QueryResolverRangesAllFuncCall is an object that describes an invocation
+// of method RangesAll on an instance of MockQueryResolver.
+type QueryResolverRangesAllFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []resolvers.AdjustedCodeIntelligenceRange
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c QueryResolverRangesAllFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c QueryResolverRangesAllFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// QueryResolverReferenceCountFunc describes the behavior when the
+// ReferenceCount method of the parent MockQueryResolver instance is
+// invoked.
+type QueryResolverReferenceCountFunc struct {
+	defaultHook func(context.Context, int, int) (int, bool, error)
+	hooks       []func(context.Context, int, int) (int, bool, error)
+	history     []QueryResolverReferenceCountFuncCall
+	mutex       sync.Mutex
+}
+
+// ReferenceCount delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockQueryResolver) ReferenceCount(v0 context.Context, v1 int, v2 int) (int, bool, error) {
+	r0, r1, r2 := m.ReferenceCountFunc.nextHook()(v0, v1, v2)
+	m.ReferenceCountFunc.appendCall(QueryResolverReferenceCountFuncCall{v0, v1, v2, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the ReferenceCount
+// method of the parent MockQueryResolver instance is invoked and the hook
+// queue is empty.
+func (f *QueryResolverReferenceCountFunc) SetDefaultHook(hook func(context.Context, int, int) (int, bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ReferenceCount method of the parent MockQueryResolver instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *QueryResolverReferenceCountFunc) PushHook(hook func(context.Context, int, int) (int, bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *QueryResolverReferenceCountFunc) SetDefaultReturn(r0 int, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, int, int) (int, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *QueryResolverReferenceCountFunc) PushReturn(r0 int, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, int, int) (int, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *QueryResolverReferenceCountFunc) nextHook() func(context.Context, int, int) (int, bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *QueryResolverReferenceCountFunc) appendCall(r0 QueryResolverReferenceCountFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of QueryResolverReferenceCountFuncCall objects
+// describing the invocations of this function.
+func (f *QueryResolverReferenceCountFunc) History() []QueryResolverReferenceCountFuncCall {
+	f.mutex.Lock()
+	history := make([]QueryResolverReferenceCountFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// QueryResolverReferenceCountFuncCall is an object that describes an
+// invocation of method ReferenceCount on an instance of MockQueryResolver.
+type QueryResolverReferenceCountFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 bool
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c QueryResolverReferenceCountFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c QueryResolverReferenceCountFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// QueryResolverReferencesFunc describes the behavior when the References
+// method of the parent MockQueryResolver instance is invoked.
+type QueryResolverReferencesFunc struct {
+	defaultHook func(context.Context, int, int, int, string) ([]resolvers.AdjustedLocation, string, error)
+	hooks       []func(context.Context, int, int, int, string) ([]resolvers.AdjustedLocation, string, error)
+	history     []QueryResolverReferencesFuncCall
+	mutex       sync.Mutex
+}
+
+// References delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockQueryResolver) References(v0 context.Context, v1 int, v2 int, v3 int, v4 string) ([]resolvers.AdjustedLocation, string, error) {
+	r0, r1, r2 := m.ReferencesFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.ReferencesFunc.appendCall(QueryResolverReferencesFuncCall{v0, v1, v2, v3, v4, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the References method of
+// the parent MockQueryResolver instance is invoked and the hook queue is
+// empty.
+func (f *QueryResolverReferencesFunc) SetDefaultHook(hook func(context.Context, int, int, int, string) ([]resolvers.AdjustedLocation, string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// References method of the parent MockQueryResolver instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *QueryResolverReferencesFunc) PushHook(hook func(context.Context, int, int, int, string) ([]resolvers.AdjustedLocation, string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *QueryResolverReferencesFunc) SetDefaultReturn(r0 []resolvers.AdjustedLocation, r1 string, r2 error) {
+	f.SetDefaultHook(func(context.Context, int, int, int, string) ([]resolvers.AdjustedLocation, string, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *QueryResolverReferencesFunc) PushReturn(r0 []resolvers.AdjustedLocation, r1 string, r2 error) {
+	f.PushHook(func(context.Context, int, int, int, string) ([]resolvers.AdjustedLocation, string, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *QueryResolverReferencesFunc) nextHook() func(context.Context, int, int, int, string) ([]resolvers.AdjustedLocation, string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *QueryResolverReferencesFunc) appendCall(r0 QueryResolverReferencesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of QueryResolverReferencesFuncCall objects
+// describing the invocations of this function.
+func (f *QueryResolverReferencesFunc) History() []QueryResolverReferencesFuncCall {
+	f.mutex.Lock()
+	history := make([]QueryResolverReferencesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// QueryResolverReferencesFuncCall is an object that describes an invocation
+// of method References on an instance of MockQueryResolver.
+type QueryResolverReferencesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []resolvers.AdjustedLocation
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 string
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c QueryResolverReferencesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c QueryResolverReferencesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// QueryResolverReferencesStreamFunc describes the behavior when the
+// ReferencesStream method of the parent MockQueryResolver instance is
+// invoked.
+type QueryResolverReferencesStreamFunc struct {
+	defaultHook func(context.Context, int, int, func(resolvers.AdjustedLocation) error) error
+	hooks       []func(context.Context, int, int, func(resolvers.AdjustedLocation) error) error
+	history     []QueryResolverReferencesStreamFuncCall
+	mutex       sync.Mutex
+}
+
+// ReferencesStream delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockQueryResolver) ReferencesStream(v0 context.Context, v1 int, v2 int, v3 func(resolvers.AdjustedLocation) error) error {
+	r0 := m.ReferencesStreamFunc.nextHook()(v0, v1, v2, v3)
+	m.ReferencesStreamFunc.appendCall(QueryResolverReferencesStreamFuncCall{v0, v1, v2, v3, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the ReferencesStream
+// method of the parent MockQueryResolver instance is invoked and the hook
+// queue is empty.
+func (f *QueryResolverReferencesStreamFunc) SetDefaultHook(hook func(context.Context, int, int, func(resolvers.AdjustedLocation) error) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ReferencesStream method of the parent MockQueryResolver instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *QueryResolverReferencesStreamFunc) PushHook(hook func(context.Context, int, int, func(resolvers.AdjustedLocation) error) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *QueryResolverReferencesStreamFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int, int, func(resolvers.AdjustedLocation) error) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *QueryResolverReferencesStreamFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int, int, func(resolvers.AdjustedLocation) error) error {
+		return r0
+	})
+}
+
+func (f *QueryResolverReferencesStreamFunc) nextHook() func(context.Context, int, int, func(resolvers.AdjustedLocation) error) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *QueryResolverReferencesStreamFunc) appendCall(r0 QueryResolverReferencesStreamFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of QueryResolverReferencesStreamFuncCall
+// objects describing the invocations of this function.
+func (f *QueryResolverReferencesStreamFunc) History() []QueryResolverReferencesStreamFuncCall {
+	f.mutex.Lock()
+	history := make([]QueryResolverReferencesStreamFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// QueryResolverReferencesStreamFuncCall is an object that describes an
+// invocation of method ReferencesStream on an instance of
+// MockQueryResolver.
+type QueryResolverReferencesStreamFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 func(resolvers.AdjustedLocation) error
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c QueryResolverReferencesStreamFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c QueryResolverReferencesStreamFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// QueryResolverSymbolsFunc describes the behavior when the Symbols method
+// of the parent MockQueryResolver instance is invoked.
+type QueryResolverSymbolsFunc struct {
+	defaultHook func(context.Context, string, int) ([]resolvers.AdjustedSymbol, error)
+	hooks       []func(context.Context, string, int) ([]resolvers.AdjustedSymbol, error)
+	history     []QueryResolverSymbolsFuncCall
+	mutex       sync.Mutex
+}
+
+// Symbols delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockQueryResolver) Symbols(v0 context.Context, v1 string, v2 int) ([]resolvers.AdjustedSymbol, error) {
+	r0, r1 := m.SymbolsFunc.nextHook()(v0, v1, v2)
+	m.SymbolsFunc.appendCall(QueryResolverSymbolsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Symbols method of
+// the parent MockQueryResolver instance is invoked and the hook queue is
+// empty.
+func (f *QueryResolverSymbolsFunc) SetDefaultHook(hook func(context.Context, string, int) ([]resolvers.AdjustedSymbol, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Symbols method of the parent MockQueryResolver instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *QueryResolverSymbolsFunc) PushHook(hook func(context.Context, string, int) ([]resolvers.AdjustedSymbol, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *QueryResolverSymbolsFunc) SetDefaultReturn(r0 []resolvers.AdjustedSymbol, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, int) ([]resolvers.AdjustedSymbol, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *QueryResolverSymbolsFunc) PushReturn(r0 []resolvers.AdjustedSymbol, r1 error) {
+	f.PushHook(func(context.Context, string, int) ([]resolvers.AdjustedSymbol, error) {
+		return r0, r1
+	})
+}
+
+func (f *QueryResolverSymbolsFunc) nextHook() func(context.Context, string, int) ([]resolvers.AdjustedSymbol, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *QueryResolverSymbolsFunc) appendCall(r0 QueryResolverSymbolsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of QueryResolverSymbolsFuncCall objects
+// describing the invocations of this function.
+func (f *QueryResolverSymbolsFunc) History() []QueryResolverSymbolsFuncCall {
+	f.mutex.Lock()
+	history := make([]QueryResolverSymbolsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// QueryResolverSymbolsFuncCall is an object that describes an invocation of
+// method Symbols on an instance of MockQueryResolver.
+type QueryResolverSymbolsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []resolvers.AdjustedSymbol
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c QueryResolverSymbolsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c QueryResolverSymbolsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// QueryResolverWriteReferencesFunc describes the behavior when the
+// WriteReferences method of the parent MockQueryResolver instance is
+// invoked.
+type QueryResolverWriteReferencesFunc struct {
+	defaultHook func(context.Context, int, int, int, string) ([]resolvers.AdjustedLocation, string, error)
+	hooks       []func(context.Context, int, int, int, string) ([]resolvers.AdjustedLocation, string, error)
+	history     []QueryResolverWriteReferencesFuncCall
+	mutex       sync.Mutex
+}
+
+// WriteReferences delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockQueryResolver) WriteReferences(v0 context.Context, v1 int, v2 int, v3 int, v4 string) ([]resolvers.AdjustedLocation, string, error) {
+	r0, r1, r2 := m.WriteReferencesFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.WriteReferencesFunc.appendCall(QueryResolverWriteReferencesFuncCall{v0, v1, v2, v3, v4, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the WriteReferences
+// method of the parent MockQueryResolver instance is invoked and the hook
+// queue is empty.
+func (f *QueryResolverWriteReferencesFunc) SetDefaultHook(hook func(context.Context, int, int, int, string) ([]resolvers.AdjustedLocation, string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// WriteReferences method of the parent MockQueryResolver instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *QueryResolverWriteReferencesFunc) PushHook(hook func(context.Context, int, int, int, string) ([]resolvers.AdjustedLocation, string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *QueryResolverWriteReferencesFunc) SetDefaultReturn(r0 []resolvers.AdjustedLocation, r1 string, r2 error) {
+	f.SetDefaultHook(func(context.Context, int, int, int, string) ([]resolvers.AdjustedLocation, string, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *QueryResolverWriteReferencesFunc) PushReturn(r0 []resolvers.AdjustedLocation, r1 string, r2 error) {
+	f.PushHook(func(context.Context, int, int, int, string) ([]resolvers.AdjustedLocation, string, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *QueryResolverWriteReferencesFunc) nextHook() func(context.Context, int, int, int, string) ([]resolvers.AdjustedLocation, string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *QueryResolverWriteReferencesFunc) appendCall(r0 QueryResolverWriteReferencesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of QueryResolverWriteReferencesFuncCall
+// objects describing the invocations of this function.
+func (f *QueryResolverWriteReferencesFunc) History() []QueryResolverWriteReferencesFuncCall {
+	f.mutex.Lock()
+	history := make([]QueryResolverWriteReferencesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// QueryResolverWriteReferencesFuncCall is an object that describes an
+// invocation of method WriteReferences on an instance of MockQueryResolver.
+type QueryResolverWriteReferencesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []resolvers.AdjustedLocation
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 string
 	// Result2 is the value of the 3rd result returned from this method
 	// invocation.
 	Result2 error
@@ -773,12 +1898,12 @@ type QueryResolverReferencesFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c QueryResolverReferencesFuncCall) Args() []interface{} {
+func (c QueryResolverWriteReferencesFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c QueryResolverReferencesFuncCall) Results() []interface{} {
+func (c QueryResolverWriteReferencesFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
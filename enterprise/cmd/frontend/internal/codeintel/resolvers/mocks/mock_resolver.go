@@ -5,10 +5,12 @@ package mocks
 import (
 	"context"
 	"sync"
+	"time"
 
 	graphqlbackend "github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
 	resolvers "github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers"
 	dbstore "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	lsifstore "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
 )
 
 // MockResolver is a mock implementation of the Resolver interface (from the
@@ -16,12 +18,25 @@ import (
 // github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers)
 // used for unit testing.
 type MockResolver struct {
+	// AggregateDiagnosticsFunc is an instance of a mock function object
+	// controlling the behavior of the method AggregateDiagnostics.
+	AggregateDiagnosticsFunc *ResolverAggregateDiagnosticsFunc
 	// CommitGraphFunc is an instance of a mock function object controlling
 	// the behavior of the method CommitGraph.
 	CommitGraphFunc *ResolverCommitGraphFunc
+	// CoverageFunc is an instance of a mock function object controlling the
+	// behavior of the method Coverage.
+	CoverageFunc *ResolverCoverageFunc
+	// CreateRetentionPolicyFunc is an instance of a mock function object
+	// controlling the behavior of the method CreateRetentionPolicy.
+	CreateRetentionPolicyFunc *ResolverCreateRetentionPolicyFunc
 	// DeleteIndexByIDFunc is an instance of a mock function object
 	// controlling the behavior of the method DeleteIndexByID.
 	DeleteIndexByIDFunc *ResolverDeleteIndexByIDFunc
+	// DeleteRetentionPolicyByIDFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// DeleteRetentionPolicyByID.
+	DeleteRetentionPolicyByIDFunc *ResolverDeleteRetentionPolicyByIDFunc
 	// DeleteUploadByIDFunc is an instance of a mock function object
 	// controlling the behavior of the method DeleteUploadByID.
 	DeleteUploadByIDFunc *ResolverDeleteUploadByIDFunc
@@ -34,6 +49,9 @@ type MockResolver struct {
 	// GetUploadByIDFunc is an instance of a mock function object
 	// controlling the behavior of the method GetUploadByID.
 	GetUploadByIDFunc *ResolverGetUploadByIDFunc
+	// GetUploadProgressFunc is an instance of a mock function object
+	// controlling the behavior of the method GetUploadProgress.
+	GetUploadProgressFunc *ResolverGetUploadProgressFunc
 	// GetUploadsByIDsFunc is an instance of a mock function object
 	// controlling the behavior of the method GetUploadsByIDs.
 	GetUploadsByIDsFunc *ResolverGetUploadsByIDsFunc
@@ -49,29 +67,61 @@ type MockResolver struct {
 	// QueueAutoIndexJobForRepoFunc is an instance of a mock function object
 	// controlling the behavior of the method QueueAutoIndexJobForRepo.
 	QueueAutoIndexJobForRepoFunc *ResolverQueueAutoIndexJobForRepoFunc
+	// ReindexUploadByIDFunc is an instance of a mock function object
+	// controlling the behavior of the method ReindexUploadByID.
+	ReindexUploadByIDFunc *ResolverReindexUploadByIDFunc
+	// RetentionPoliciesFunc is an instance of a mock function object
+	// controlling the behavior of the method RetentionPolicies.
+	RetentionPoliciesFunc *ResolverRetentionPoliciesFunc
 	// UpdateIndexConfigurationByRepositoryIDFunc is an instance of a mock
 	// function object controlling the behavior of the method
 	// UpdateIndexConfigurationByRepositoryID.
 	UpdateIndexConfigurationByRepositoryIDFunc *ResolverUpdateIndexConfigurationByRepositoryIDFunc
+	// UpdateRetentionPolicyFunc is an instance of a mock function object
+	// controlling the behavior of the method UpdateRetentionPolicy.
+	UpdateRetentionPolicyFunc *ResolverUpdateRetentionPolicyFunc
 	// UploadConnectionResolverFunc is an instance of a mock function object
 	// controlling the behavior of the method UploadConnectionResolver.
 	UploadConnectionResolverFunc *ResolverUploadConnectionResolverFunc
+	// UsageStatsFunc is an instance of a mock function object controlling
+	// the behavior of the method UsageStats.
+	UsageStatsFunc *ResolverUsageStatsFunc
 }
 
 // NewMockResolver creates a new mock of the Resolver interface. All methods
 // return zero values for all results, unless overwritten.
 func NewMockResolver() *MockResolver {
 	return &MockResolver{
+		AggregateDiagnosticsFunc: &ResolverAggregateDiagnosticsFunc{
+			defaultHook: func(context.Context, int, lsifstore.AggregateDiagnosticsOptions) ([]resolvers.AdjustedDiagnostic, int, []lsifstore.DiagnosticGroup, error) {
+				return nil, 0, nil, nil
+			},
+		},
 		CommitGraphFunc: &ResolverCommitGraphFunc{
 			defaultHook: func(context.Context, int) (graphqlbackend.CodeIntelligenceCommitGraphResolver, error) {
 				return nil, nil
 			},
 		},
+		CoverageFunc: &ResolverCoverageFunc{
+			defaultHook: func(context.Context, int, string, int) (resolvers.Coverage, error) {
+				return resolvers.Coverage{}, nil
+			},
+		},
+		CreateRetentionPolicyFunc: &ResolverCreateRetentionPolicyFunc{
+			defaultHook: func(context.Context, dbstore.RetentionPolicy) (dbstore.RetentionPolicy, error) {
+				return dbstore.RetentionPolicy{}, nil
+			},
+		},
 		DeleteIndexByIDFunc: &ResolverDeleteIndexByIDFunc{
 			defaultHook: func(context.Context, int) error {
 				return nil
 			},
 		},
+		DeleteRetentionPolicyByIDFunc: &ResolverDeleteRetentionPolicyByIDFunc{
+			defaultHook: func(context.Context, int) error {
+				return nil
+			},
+		},
 		DeleteUploadByIDFunc: &ResolverDeleteUploadByIDFunc{
 			defaultHook: func(context.Context, int) error {
 				return nil
@@ -92,6 +142,11 @@ func NewMockResolver() *MockResolver {
 				return dbstore.Upload{}, false, nil
 			},
 		},
+		GetUploadProgressFunc: &ResolverGetUploadProgressFunc{
+			defaultHook: func(context.Context, int) (dbstore.UploadProgress, bool, error) {
+				return dbstore.UploadProgress{}, false, nil
+			},
+		},
 		GetUploadsByIDsFunc: &ResolverGetUploadsByIDsFunc{
 			defaultHook: func(context.Context, ...int) ([]dbstore.Upload, error) {
 				return nil, nil
@@ -117,16 +172,36 @@ func NewMockResolver() *MockResolver {
 				return nil
 			},
 		},
+		ReindexUploadByIDFunc: &ResolverReindexUploadByIDFunc{
+			defaultHook: func(context.Context, int) error {
+				return nil
+			},
+		},
+		RetentionPoliciesFunc: &ResolverRetentionPoliciesFunc{
+			defaultHook: func(context.Context) ([]dbstore.RetentionPolicy, error) {
+				return nil, nil
+			},
+		},
 		UpdateIndexConfigurationByRepositoryIDFunc: &ResolverUpdateIndexConfigurationByRepositoryIDFunc{
 			defaultHook: func(context.Context, int, string) error {
 				return nil
 			},
 		},
+		UpdateRetentionPolicyFunc: &ResolverUpdateRetentionPolicyFunc{
+			defaultHook: func(context.Context, dbstore.RetentionPolicy) error {
+				return nil
+			},
+		},
 		UploadConnectionResolverFunc: &ResolverUploadConnectionResolverFunc{
 			defaultHook: func(dbstore.GetUploadsOptions) *resolvers.UploadsResolver {
 				return nil
 			},
 		},
+		UsageStatsFunc: &ResolverUsageStatsFunc{
+			defaultHook: func(context.Context, time.Time) ([]dbstore.UsageStatistics, error) {
+				return nil, nil
+			},
+		},
 	}
 }
 
@@ -134,12 +209,24 @@ func NewMockResolver() *MockResolver {
 // methods delegate to the given implementation, unless overwritten.
 func NewMockResolverFrom(i resolvers.Resolver) *MockResolver {
 	return &MockResolver{
+		AggregateDiagnosticsFunc: &ResolverAggregateDiagnosticsFunc{
+			defaultHook: i.AggregateDiagnostics,
+		},
 		CommitGraphFunc: &ResolverCommitGraphFunc{
 			defaultHook: i.CommitGraph,
 		},
+		CoverageFunc: &ResolverCoverageFunc{
+			defaultHook: i.Coverage,
+		},
+		CreateRetentionPolicyFunc: &ResolverCreateRetentionPolicyFunc{
+			defaultHook: i.CreateRetentionPolicy,
+		},
 		DeleteIndexByIDFunc: &ResolverDeleteIndexByIDFunc{
 			defaultHook: i.DeleteIndexByID,
 		},
+		DeleteRetentionPolicyByIDFunc: &ResolverDeleteRetentionPolicyByIDFunc{
+			defaultHook: i.DeleteRetentionPolicyByID,
+		},
 		DeleteUploadByIDFunc: &ResolverDeleteUploadByIDFunc{
 			defaultHook: i.DeleteUploadByID,
 		},
@@ -152,6 +239,9 @@ func NewMockResolverFrom(i resolvers.Resolver) *MockResolver {
 		GetUploadByIDFunc: &ResolverGetUploadByIDFunc{
 			defaultHook: i.GetUploadByID,
 		},
+		GetUploadProgressFunc: &ResolverGetUploadProgressFunc{
+			defaultHook: i.GetUploadProgress,
+		},
 		GetUploadsByIDsFunc: &ResolverGetUploadsByIDsFunc{
 			defaultHook: i.GetUploadsByIDs,
 		},
@@ -167,13 +257,144 @@ func NewMockResolverFrom(i resolvers.Resolver) *MockResolver {
 		QueueAutoIndexJobForRepoFunc: &ResolverQueueAutoIndexJobForRepoFunc{
 			defaultHook: i.QueueAutoIndexJobForRepo,
 		},
+		ReindexUploadByIDFunc: &ResolverReindexUploadByIDFunc{
+			defaultHook: i.ReindexUploadByID,
+		},
+		RetentionPoliciesFunc: &ResolverRetentionPoliciesFunc{
+			defaultHook: i.RetentionPolicies,
+		},
 		UpdateIndexConfigurationByRepositoryIDFunc: &ResolverUpdateIndexConfigurationByRepositoryIDFunc{
 			defaultHook: i.UpdateIndexConfigurationByRepositoryID,
 		},
+		UpdateRetentionPolicyFunc: &ResolverUpdateRetentionPolicyFunc{
+			defaultHook: i.UpdateRetentionPolicy,
+		},
 		UploadConnectionResolverFunc: &ResolverUploadConnectionResolverFunc{
 			defaultHook: i.UploadConnectionResolver,
 		},
+		UsageStatsFunc: &ResolverUsageStatsFunc{
+			defaultHook: i.UsageStats,
+		},
+	}
+}
+
+// ResolverAggregateDiagnosticsFunc describes the behavior when the
+// AggregateDiagnostics method of the parent MockResolver instance is
+// invoked.
+type ResolverAggregateDiagnosticsFunc struct {
+	defaultHook func(context.Context, int, lsifstore.AggregateDiagnosticsOptions) ([]resolvers.AdjustedDiagnostic, int, []lsifstore.DiagnosticGroup, error)
+	hooks       []func(context.Context, int, lsifstore.AggregateDiagnosticsOptions) ([]resolvers.AdjustedDiagnostic, int, []lsifstore.DiagnosticGroup, error)
+	history     []ResolverAggregateDiagnosticsFuncCall
+	mutex       sync.Mutex
+}
+
+// AggregateDiagnostics delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockResolver) AggregateDiagnostics(v0 context.Context, v1 int, v2 lsifstore.AggregateDiagnosticsOptions) ([]resolvers.AdjustedDiagnostic, int, []lsifstore.DiagnosticGroup, error) {
+	r0, r1, r2, r3 := m.AggregateDiagnosticsFunc.nextHook()(v0, v1, v2)
+	m.AggregateDiagnosticsFunc.appendCall(ResolverAggregateDiagnosticsFuncCall{v0, v1, v2, r0, r1, r2, r3})
+	return r0, r1, r2, r3
+}
+
+// SetDefaultHook sets function that is called when the AggregateDiagnostics
+// method of the parent MockResolver instance is invoked and the hook queue
+// is empty.
+func (f *ResolverAggregateDiagnosticsFunc) SetDefaultHook(hook func(context.Context, int, lsifstore.AggregateDiagnosticsOptions) ([]resolvers.AdjustedDiagnostic, int, []lsifstore.DiagnosticGroup, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// AggregateDiagnostics method of the parent MockResolver instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *ResolverAggregateDiagnosticsFunc) PushHook(hook func(context.Context, int, lsifstore.AggregateDiagnosticsOptions) ([]resolvers.AdjustedDiagnostic, int, []lsifstore.DiagnosticGroup, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *ResolverAggregateDiagnosticsFunc) SetDefaultReturn(r0 []resolvers.AdjustedDiagnostic, r1 int, r2 []lsifstore.DiagnosticGroup, r3 error) {
+	f.SetDefaultHook(func(context.Context, int, lsifstore.AggregateDiagnosticsOptions) ([]resolvers.AdjustedDiagnostic, int, []lsifstore.DiagnosticGroup, error) {
+		return r0, r1, r2, r3
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *ResolverAggregateDiagnosticsFunc) PushReturn(r0 []resolvers.AdjustedDiagnostic, r1 int, r2 []lsifstore.DiagnosticGroup, r3 error) {
+	f.PushHook(func(context.Context, int, lsifstore.AggregateDiagnosticsOptions) ([]resolvers.AdjustedDiagnostic, int, []lsifstore.DiagnosticGroup, error) {
+		return r0, r1, r2, r3
+	})
+}
+
+func (f *ResolverAggregateDiagnosticsFunc) nextHook() func(context.Context, int, lsifstore.AggregateDiagnosticsOptions) ([]resolvers.AdjustedDiagnostic, int, []lsifstore.DiagnosticGroup, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
 	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ResolverAggregateDiagnosticsFunc) appendCall(r0 ResolverAggregateDiagnosticsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ResolverAggregateDiagnosticsFuncCall
+// objects describing the invocations of this function.
+func (f *ResolverAggregateDiagnosticsFunc) History() []ResolverAggregateDiagnosticsFuncCall {
+	f.mutex.Lock()
+	history := make([]ResolverAggregateDiagnosticsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ResolverAggregateDiagnosticsFuncCall is an object that describes an
+// invocation of method AggregateDiagnostics on an instance of MockResolver.
+type ResolverAggregateDiagnosticsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 lsifstore.AggregateDiagnosticsOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []resolvers.AdjustedDiagnostic
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 []lsifstore.DiagnosticGroup
+	// Result3 is the value of the 4th result returned from this method
+	// invocation.
+	Result3 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ResolverAggregateDiagnosticsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ResolverAggregateDiagnosticsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2, c.Result3}
 }
 
 // ResolverCommitGraphFunc describes the behavior when the CommitGraph
@@ -285,35 +506,34 @@ func (c ResolverCommitGraphFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// ResolverDeleteIndexByIDFunc describes the behavior when the
-// DeleteIndexByID method of the parent MockResolver instance is invoked.
-type ResolverDeleteIndexByIDFunc struct {
-	defaultHook func(context.Context, int) error
-	hooks       []func(context.Context, int) error
-	history     []ResolverDeleteIndexByIDFuncCall
+// ResolverCoverageFunc describes the behavior when the Coverage method of
+// the parent MockResolver instance is invoked.
+type ResolverCoverageFunc struct {
+	defaultHook func(context.Context, int, string, int) (resolvers.Coverage, error)
+	hooks       []func(context.Context, int, string, int) (resolvers.Coverage, error)
+	history     []ResolverCoverageFuncCall
 	mutex       sync.Mutex
 }
 
-// DeleteIndexByID delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockResolver) DeleteIndexByID(v0 context.Context, v1 int) error {
-	r0 := m.DeleteIndexByIDFunc.nextHook()(v0, v1)
-	m.DeleteIndexByIDFunc.appendCall(ResolverDeleteIndexByIDFuncCall{v0, v1, r0})
-	return r0
+// Coverage delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockResolver) Coverage(v0 context.Context, v1 int, v2 string, v3 int) (resolvers.Coverage, error) {
+	r0, r1 := m.CoverageFunc.nextHook()(v0, v1, v2, v3)
+	m.CoverageFunc.appendCall(ResolverCoverageFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the DeleteIndexByID
-// method of the parent MockResolver instance is invoked and the hook queue
-// is empty.
-func (f *ResolverDeleteIndexByIDFunc) SetDefaultHook(hook func(context.Context, int) error) {
+// SetDefaultHook sets function that is called when the Coverage method of
+// the parent MockResolver instance is invoked and the hook queue is empty.
+func (f *ResolverCoverageFunc) SetDefaultHook(hook func(context.Context, int, string, int) (resolvers.Coverage, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// DeleteIndexByID method of the parent MockResolver instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *ResolverDeleteIndexByIDFunc) PushHook(hook func(context.Context, int) error) {
+// Coverage method of the parent MockResolver instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ResolverCoverageFunc) PushHook(hook func(context.Context, int, string, int) (resolvers.Coverage, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -321,21 +541,21 @@ func (f *ResolverDeleteIndexByIDFunc) PushHook(hook func(context.Context, int) e
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *ResolverDeleteIndexByIDFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int) error {
-		return r0
+func (f *ResolverCoverageFunc) SetDefaultReturn(r0 resolvers.Coverage, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string, int) (resolvers.Coverage, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *ResolverDeleteIndexByIDFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int) error {
-		return r0
+func (f *ResolverCoverageFunc) PushReturn(r0 resolvers.Coverage, r1 error) {
+	f.PushHook(func(context.Context, int, string, int) (resolvers.Coverage, error) {
+		return r0, r1
 	})
 }
 
-func (f *ResolverDeleteIndexByIDFunc) nextHook() func(context.Context, int) error {
+func (f *ResolverCoverageFunc) nextHook() func(context.Context, int, string, int) (resolvers.Coverage, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -348,78 +568,88 @@ func (f *ResolverDeleteIndexByIDFunc) nextHook() func(context.Context, int) erro
 	return hook
 }
 
-func (f *ResolverDeleteIndexByIDFunc) appendCall(r0 ResolverDeleteIndexByIDFuncCall) {
+func (f *ResolverCoverageFunc) appendCall(r0 ResolverCoverageFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ResolverDeleteIndexByIDFuncCall objects
-// describing the invocations of this function.
-func (f *ResolverDeleteIndexByIDFunc) History() []ResolverDeleteIndexByIDFuncCall {
+// History returns a sequence of ResolverCoverageFuncCall objects describing
+// the invocations of this function.
+func (f *ResolverCoverageFunc) History() []ResolverCoverageFuncCall {
 	f.mutex.Lock()
-	history := make([]ResolverDeleteIndexByIDFuncCall, len(f.history))
+	history := make([]ResolverCoverageFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ResolverDeleteIndexByIDFuncCall is an object that describes an invocation
-// of method DeleteIndexByID on an instance of MockResolver.
-type ResolverDeleteIndexByIDFuncCall struct {
+// ResolverCoverageFuncCall is an object that describes an invocation of
+// method Coverage on an instance of MockResolver.
+type ResolverCoverageFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
 	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 resolvers.Coverage
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ResolverDeleteIndexByIDFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c ResolverCoverageFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ResolverDeleteIndexByIDFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c ResolverCoverageFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// ResolverDeleteUploadByIDFunc describes the behavior when the
-// DeleteUploadByID method of the parent MockResolver instance is invoked.
-type ResolverDeleteUploadByIDFunc struct {
-	defaultHook func(context.Context, int) error
-	hooks       []func(context.Context, int) error
-	history     []ResolverDeleteUploadByIDFuncCall
+// ResolverCreateRetentionPolicyFunc describes the behavior when the
+// CreateRetentionPolicy method of the parent MockResolver instance is
+// invoked.
+type ResolverCreateRetentionPolicyFunc struct {
+	defaultHook func(context.Context, dbstore.RetentionPolicy) (dbstore.RetentionPolicy, error)
+	hooks       []func(context.Context, dbstore.RetentionPolicy) (dbstore.RetentionPolicy, error)
+	history     []ResolverCreateRetentionPolicyFuncCall
 	mutex       sync.Mutex
 }
 
-// DeleteUploadByID delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockResolver) DeleteUploadByID(v0 context.Context, v1 int) error {
-	r0 := m.DeleteUploadByIDFunc.nextHook()(v0, v1)
-	m.DeleteUploadByIDFunc.appendCall(ResolverDeleteUploadByIDFuncCall{v0, v1, r0})
-	return r0
+// CreateRetentionPolicy delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockResolver) CreateRetentionPolicy(v0 context.Context, v1 dbstore.RetentionPolicy) (dbstore.RetentionPolicy, error) {
+	r0, r1 := m.CreateRetentionPolicyFunc.nextHook()(v0, v1)
+	m.CreateRetentionPolicyFunc.appendCall(ResolverCreateRetentionPolicyFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the DeleteUploadByID
-// method of the parent MockResolver instance is invoked and the hook queue
-// is empty.
-func (f *ResolverDeleteUploadByIDFunc) SetDefaultHook(hook func(context.Context, int) error) {
+// SetDefaultHook sets function that is called when the
+// CreateRetentionPolicy method of the parent MockResolver instance is
+// invoked and the hook queue is empty.
+func (f *ResolverCreateRetentionPolicyFunc) SetDefaultHook(hook func(context.Context, dbstore.RetentionPolicy) (dbstore.RetentionPolicy, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// DeleteUploadByID method of the parent MockResolver instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *ResolverDeleteUploadByIDFunc) PushHook(hook func(context.Context, int) error) {
+// CreateRetentionPolicy method of the parent MockResolver instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *ResolverCreateRetentionPolicyFunc) PushHook(hook func(context.Context, dbstore.RetentionPolicy) (dbstore.RetentionPolicy, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -427,21 +657,21 @@ func (f *ResolverDeleteUploadByIDFunc) PushHook(hook func(context.Context, int)
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *ResolverDeleteUploadByIDFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int) error {
-		return r0
+func (f *ResolverCreateRetentionPolicyFunc) SetDefaultReturn(r0 dbstore.RetentionPolicy, r1 error) {
+	f.SetDefaultHook(func(context.Context, dbstore.RetentionPolicy) (dbstore.RetentionPolicy, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *ResolverDeleteUploadByIDFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int) error {
-		return r0
+func (f *ResolverCreateRetentionPolicyFunc) PushReturn(r0 dbstore.RetentionPolicy, r1 error) {
+	f.PushHook(func(context.Context, dbstore.RetentionPolicy) (dbstore.RetentionPolicy, error) {
+		return r0, r1
 	})
 }
 
-func (f *ResolverDeleteUploadByIDFunc) nextHook() func(context.Context, int) error {
+func (f *ResolverCreateRetentionPolicyFunc) nextHook() func(context.Context, dbstore.RetentionPolicy) (dbstore.RetentionPolicy, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -454,79 +684,404 @@ func (f *ResolverDeleteUploadByIDFunc) nextHook() func(context.Context, int) err
 	return hook
 }
 
-func (f *ResolverDeleteUploadByIDFunc) appendCall(r0 ResolverDeleteUploadByIDFuncCall) {
+func (f *ResolverCreateRetentionPolicyFunc) appendCall(r0 ResolverCreateRetentionPolicyFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ResolverDeleteUploadByIDFuncCall objects
-// describing the invocations of this function.
-func (f *ResolverDeleteUploadByIDFunc) History() []ResolverDeleteUploadByIDFuncCall {
+// History returns a sequence of ResolverCreateRetentionPolicyFuncCall
+// objects describing the invocations of this function.
+func (f *ResolverCreateRetentionPolicyFunc) History() []ResolverCreateRetentionPolicyFuncCall {
 	f.mutex.Lock()
-	history := make([]ResolverDeleteUploadByIDFuncCall, len(f.history))
+	history := make([]ResolverCreateRetentionPolicyFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ResolverDeleteUploadByIDFuncCall is an object that describes an
-// invocation of method DeleteUploadByID on an instance of MockResolver.
-type ResolverDeleteUploadByIDFuncCall struct {
+// ResolverCreateRetentionPolicyFuncCall is an object that describes an
+// invocation of method CreateRetentionPolicy on an instance of
+// MockResolver.
+type ResolverCreateRetentionPolicyFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int
+	Arg1 dbstore.RetentionPolicy
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 dbstore.RetentionPolicy
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ResolverDeleteUploadByIDFuncCall) Args() []interface{} {
+func (c ResolverCreateRetentionPolicyFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ResolverDeleteUploadByIDFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c ResolverCreateRetentionPolicyFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// ResolverGetIndexByIDFunc describes the behavior when the GetIndexByID
-// method of the parent MockResolver instance is invoked.
-type ResolverGetIndexByIDFunc struct {
-	defaultHook func(context.Context, int) (dbstore.Index, bool, error)
-	hooks       []func(context.Context, int) (dbstore.Index, bool, error)
-	history     []ResolverGetIndexByIDFuncCall
+// ResolverDeleteIndexByIDFunc describes the behavior when the
+// DeleteIndexByID method of the parent MockResolver instance is invoked.
+type ResolverDeleteIndexByIDFunc struct {
+	defaultHook func(context.Context, int) error
+	hooks       []func(context.Context, int) error
+	history     []ResolverDeleteIndexByIDFuncCall
 	mutex       sync.Mutex
 }
 
-// GetIndexByID delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockResolver) GetIndexByID(v0 context.Context, v1 int) (dbstore.Index, bool, error) {
-	r0, r1, r2 := m.GetIndexByIDFunc.nextHook()(v0, v1)
-	m.GetIndexByIDFunc.appendCall(ResolverGetIndexByIDFuncCall{v0, v1, r0, r1, r2})
-	return r0, r1, r2
+// DeleteIndexByID delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockResolver) DeleteIndexByID(v0 context.Context, v1 int) error {
+	r0 := m.DeleteIndexByIDFunc.nextHook()(v0, v1)
+	m.DeleteIndexByIDFunc.appendCall(ResolverDeleteIndexByIDFuncCall{v0, v1, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the GetIndexByID method
-// of the parent MockResolver instance is invoked and the hook queue is
-// empty.
-func (f *ResolverGetIndexByIDFunc) SetDefaultHook(hook func(context.Context, int) (dbstore.Index, bool, error)) {
+// SetDefaultHook sets function that is called when the DeleteIndexByID
+// method of the parent MockResolver instance is invoked and the hook queue
+// is empty.
+func (f *ResolverDeleteIndexByIDFunc) SetDefaultHook(hook func(context.Context, int) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetIndexByID method of the parent MockResolver instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *ResolverGetIndexByIDFunc) PushHook(hook func(context.Context, int) (dbstore.Index, bool, error)) {
-	f.mutex.Lock()
+// DeleteIndexByID method of the parent MockResolver instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ResolverDeleteIndexByIDFunc) PushHook(hook func(context.Context, int) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *ResolverDeleteIndexByIDFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *ResolverDeleteIndexByIDFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int) error {
+		return r0
+	})
+}
+
+func (f *ResolverDeleteIndexByIDFunc) nextHook() func(context.Context, int) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ResolverDeleteIndexByIDFunc) appendCall(r0 ResolverDeleteIndexByIDFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ResolverDeleteIndexByIDFuncCall objects
+// describing the invocations of this function.
+func (f *ResolverDeleteIndexByIDFunc) History() []ResolverDeleteIndexByIDFuncCall {
+	f.mutex.Lock()
+	history := make([]ResolverDeleteIndexByIDFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ResolverDeleteIndexByIDFuncCall is an object that describes an invocation
+// of method DeleteIndexByID on an instance of MockResolver.
+type ResolverDeleteIndexByIDFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ResolverDeleteIndexByIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ResolverDeleteIndexByIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// ResolverDeleteRetentionPolicyByIDFunc describes the behavior when the
+// DeleteRetentionPolicyByID method of the parent MockResolver instance is
+// invoked.
+type ResolverDeleteRetentionPolicyByIDFunc struct {
+	defaultHook func(context.Context, int) error
+	hooks       []func(context.Context, int) error
+	history     []ResolverDeleteRetentionPolicyByIDFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteRetentionPolicyByID delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockResolver) DeleteRetentionPolicyByID(v0 context.Context, v1 int) error {
+	r0 := m.DeleteRetentionPolicyByIDFunc.nextHook()(v0, v1)
+	m.DeleteRetentionPolicyByIDFunc.appendCall(ResolverDeleteRetentionPolicyByIDFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// DeleteRetentionPolicyByID method of the parent MockResolver instance is
+// invoked and the hook queue is empty.
+func (f *ResolverDeleteRetentionPolicyByIDFunc) SetDefaultHook(hook func(context.Context, int) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteRetentionPolicyByID method of the parent MockResolver instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *ResolverDeleteRetentionPolicyByIDFunc) PushHook(hook func(context.Context, int) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *ResolverDeleteRetentionPolicyByIDFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *ResolverDeleteRetentionPolicyByIDFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int) error {
+		return r0
+	})
+}
+
+func (f *ResolverDeleteRetentionPolicyByIDFunc) nextHook() func(context.Context, int) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ResolverDeleteRetentionPolicyByIDFunc) appendCall(r0 ResolverDeleteRetentionPolicyByIDFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ResolverDeleteRetentionPolicyByIDFuncCall
+// objects describing the invocations of this function.
+func (f *ResolverDeleteRetentionPolicyByIDFunc) History() []ResolverDeleteRetentionPolicyByIDFuncCall {
+	f.mutex.Lock()
+	history := make([]ResolverDeleteRetentionPolicyByIDFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ResolverDeleteRetentionPolicyByIDFuncCall is an object that describes an
+// invocation of method DeleteRetentionPolicyByID on an instance of
+// MockResolver.
+type ResolverDeleteRetentionPolicyByIDFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ResolverDeleteRetentionPolicyByIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ResolverDeleteRetentionPolicyByIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// ResolverDeleteUploadByIDFunc describes the behavior when the
+// DeleteUploadByID method of the parent MockResolver instance is invoked.
+type ResolverDeleteUploadByIDFunc struct {
+	defaultHook func(context.Context, int) error
+	hooks       []func(context.Context, int) error
+	history     []ResolverDeleteUploadByIDFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteUploadByID delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockResolver) DeleteUploadByID(v0 context.Context, v1 int) error {
+	r0 := m.DeleteUploadByIDFunc.nextHook()(v0, v1)
+	m.DeleteUploadByIDFunc.appendCall(ResolverDeleteUploadByIDFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the DeleteUploadByID
+// method of the parent MockResolver instance is invoked and the hook queue
+// is empty.
+func (f *ResolverDeleteUploadByIDFunc) SetDefaultHook(hook func(context.Context, int) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteUploadByID method of the parent MockResolver instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ResolverDeleteUploadByIDFunc) PushHook(hook func(context.Context, int) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *ResolverDeleteUploadByIDFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *ResolverDeleteUploadByIDFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int) error {
+		return r0
+	})
+}
+
+func (f *ResolverDeleteUploadByIDFunc) nextHook() func(context.Context, int) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ResolverDeleteUploadByIDFunc) appendCall(r0 ResolverDeleteUploadByIDFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ResolverDeleteUploadByIDFuncCall objects
+// describing the invocations of this function.
+func (f *ResolverDeleteUploadByIDFunc) History() []ResolverDeleteUploadByIDFuncCall {
+	f.mutex.Lock()
+	history := make([]ResolverDeleteUploadByIDFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ResolverDeleteUploadByIDFuncCall is an object that describes an
+// invocation of method DeleteUploadByID on an instance of MockResolver.
+type ResolverDeleteUploadByIDFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ResolverDeleteUploadByIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ResolverDeleteUploadByIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// ResolverGetIndexByIDFunc describes the behavior when the GetIndexByID
+// method of the parent MockResolver instance is invoked.
+type ResolverGetIndexByIDFunc struct {
+	defaultHook func(context.Context, int) (dbstore.Index, bool, error)
+	hooks       []func(context.Context, int) (dbstore.Index, bool, error)
+	history     []ResolverGetIndexByIDFuncCall
+	mutex       sync.Mutex
+}
+
+// GetIndexByID delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockResolver) GetIndexByID(v0 context.Context, v1 int) (dbstore.Index, bool, error) {
+	r0, r1, r2 := m.GetIndexByIDFunc.nextHook()(v0, v1)
+	m.GetIndexByIDFunc.appendCall(ResolverGetIndexByIDFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the GetIndexByID method
+// of the parent MockResolver instance is invoked and the hook queue is
+// empty.
+func (f *ResolverGetIndexByIDFunc) SetDefaultHook(hook func(context.Context, int) (dbstore.Index, bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetIndexByID method of the parent MockResolver instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ResolverGetIndexByIDFunc) PushHook(hook func(context.Context, int) (dbstore.Index, bool, error)) {
+	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
 }
@@ -716,44 +1271,156 @@ func (c ResolverGetIndexesByIDsFuncCall) Args() []interface{} {
 		trailing = append(trailing, val)
 	}
 
-	return append([]interface{}{c.Arg0}, trailing...)
+	return append([]interface{}{c.Arg0}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ResolverGetIndexesByIDsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ResolverGetUploadByIDFunc describes the behavior when the GetUploadByID
+// method of the parent MockResolver instance is invoked.
+type ResolverGetUploadByIDFunc struct {
+	defaultHook func(context.Context, int) (dbstore.Upload, bool, error)
+	hooks       []func(context.Context, int) (dbstore.Upload, bool, error)
+	history     []ResolverGetUploadByIDFuncCall
+	mutex       sync.Mutex
+}
+
+// GetUploadByID delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockResolver) GetUploadByID(v0 context.Context, v1 int) (dbstore.Upload, bool, error) {
+	r0, r1, r2 := m.GetUploadByIDFunc.nextHook()(v0, v1)
+	m.GetUploadByIDFunc.appendCall(ResolverGetUploadByIDFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the GetUploadByID method
+// of the parent MockResolver instance is invoked and the hook queue is
+// empty.
+func (f *ResolverGetUploadByIDFunc) SetDefaultHook(hook func(context.Context, int) (dbstore.Upload, bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetUploadByID method of the parent MockResolver instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ResolverGetUploadByIDFunc) PushHook(hook func(context.Context, int) (dbstore.Upload, bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *ResolverGetUploadByIDFunc) SetDefaultReturn(r0 dbstore.Upload, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, int) (dbstore.Upload, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *ResolverGetUploadByIDFunc) PushReturn(r0 dbstore.Upload, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, int) (dbstore.Upload, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *ResolverGetUploadByIDFunc) nextHook() func(context.Context, int) (dbstore.Upload, bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ResolverGetUploadByIDFunc) appendCall(r0 ResolverGetUploadByIDFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ResolverGetUploadByIDFuncCall objects
+// describing the invocations of this function.
+func (f *ResolverGetUploadByIDFunc) History() []ResolverGetUploadByIDFuncCall {
+	f.mutex.Lock()
+	history := make([]ResolverGetUploadByIDFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ResolverGetUploadByIDFuncCall is an object that describes an invocation
+// of method GetUploadByID on an instance of MockResolver.
+type ResolverGetUploadByIDFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 dbstore.Upload
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 bool
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ResolverGetUploadByIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ResolverGetIndexesByIDsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c ResolverGetUploadByIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// ResolverGetUploadByIDFunc describes the behavior when the GetUploadByID
-// method of the parent MockResolver instance is invoked.
-type ResolverGetUploadByIDFunc struct {
-	defaultHook func(context.Context, int) (dbstore.Upload, bool, error)
-	hooks       []func(context.Context, int) (dbstore.Upload, bool, error)
-	history     []ResolverGetUploadByIDFuncCall
+// ResolverGetUploadProgressFunc describes the behavior when the
+// GetUploadProgress method of the parent MockResolver instance is invoked.
+type ResolverGetUploadProgressFunc struct {
+	defaultHook func(context.Context, int) (dbstore.UploadProgress, bool, error)
+	hooks       []func(context.Context, int) (dbstore.UploadProgress, bool, error)
+	history     []ResolverGetUploadProgressFuncCall
 	mutex       sync.Mutex
 }
 
-// GetUploadByID delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockResolver) GetUploadByID(v0 context.Context, v1 int) (dbstore.Upload, bool, error) {
-	r0, r1, r2 := m.GetUploadByIDFunc.nextHook()(v0, v1)
-	m.GetUploadByIDFunc.appendCall(ResolverGetUploadByIDFuncCall{v0, v1, r0, r1, r2})
+// GetUploadProgress delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockResolver) GetUploadProgress(v0 context.Context, v1 int) (dbstore.UploadProgress, bool, error) {
+	r0, r1, r2 := m.GetUploadProgressFunc.nextHook()(v0, v1)
+	m.GetUploadProgressFunc.appendCall(ResolverGetUploadProgressFuncCall{v0, v1, r0, r1, r2})
 	return r0, r1, r2
 }
 
-// SetDefaultHook sets function that is called when the GetUploadByID method
-// of the parent MockResolver instance is invoked and the hook queue is
-// empty.
-func (f *ResolverGetUploadByIDFunc) SetDefaultHook(hook func(context.Context, int) (dbstore.Upload, bool, error)) {
+// SetDefaultHook sets function that is called when the GetUploadProgress
+// method of the parent MockResolver instance is invoked and the hook queue
+// is empty.
+func (f *ResolverGetUploadProgressFunc) SetDefaultHook(hook func(context.Context, int) (dbstore.UploadProgress, bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetUploadByID method of the parent MockResolver instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *ResolverGetUploadByIDFunc) PushHook(hook func(context.Context, int) (dbstore.Upload, bool, error)) {
+// GetUploadProgress method of the parent MockResolver instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ResolverGetUploadProgressFunc) PushHook(hook func(context.Context, int) (dbstore.UploadProgress, bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -761,21 +1428,21 @@ func (f *ResolverGetUploadByIDFunc) PushHook(hook func(context.Context, int) (db
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *ResolverGetUploadByIDFunc) SetDefaultReturn(r0 dbstore.Upload, r1 bool, r2 error) {
-	f.SetDefaultHook(func(context.Context, int) (dbstore.Upload, bool, error) {
+func (f *ResolverGetUploadProgressFunc) SetDefaultReturn(r0 dbstore.UploadProgress, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, int) (dbstore.UploadProgress, bool, error) {
 		return r0, r1, r2
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *ResolverGetUploadByIDFunc) PushReturn(r0 dbstore.Upload, r1 bool, r2 error) {
-	f.PushHook(func(context.Context, int) (dbstore.Upload, bool, error) {
+func (f *ResolverGetUploadProgressFunc) PushReturn(r0 dbstore.UploadProgress, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, int) (dbstore.UploadProgress, bool, error) {
 		return r0, r1, r2
 	})
 }
 
-func (f *ResolverGetUploadByIDFunc) nextHook() func(context.Context, int) (dbstore.Upload, bool, error) {
+func (f *ResolverGetUploadProgressFunc) nextHook() func(context.Context, int) (dbstore.UploadProgress, bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -788,26 +1455,26 @@ func (f *ResolverGetUploadByIDFunc) nextHook() func(context.Context, int) (dbsto
 	return hook
 }
 
-func (f *ResolverGetUploadByIDFunc) appendCall(r0 ResolverGetUploadByIDFuncCall) {
+func (f *ResolverGetUploadProgressFunc) appendCall(r0 ResolverGetUploadProgressFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ResolverGetUploadByIDFuncCall objects
+// History returns a sequence of ResolverGetUploadProgressFuncCall objects
 // describing the invocations of this function.
-func (f *ResolverGetUploadByIDFunc) History() []ResolverGetUploadByIDFuncCall {
+func (f *ResolverGetUploadProgressFunc) History() []ResolverGetUploadProgressFuncCall {
 	f.mutex.Lock()
-	history := make([]ResolverGetUploadByIDFuncCall, len(f.history))
+	history := make([]ResolverGetUploadProgressFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ResolverGetUploadByIDFuncCall is an object that describes an invocation
-// of method GetUploadByID on an instance of MockResolver.
-type ResolverGetUploadByIDFuncCall struct {
+// ResolverGetUploadProgressFuncCall is an object that describes an
+// invocation of method GetUploadProgress on an instance of MockResolver.
+type ResolverGetUploadProgressFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -816,7 +1483,7 @@ type ResolverGetUploadByIDFuncCall struct {
 	Arg1 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 dbstore.Upload
+	Result0 dbstore.UploadProgress
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 bool
@@ -827,13 +1494,13 @@ type ResolverGetUploadByIDFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ResolverGetUploadByIDFuncCall) Args() []interface{} {
+func (c ResolverGetUploadProgressFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ResolverGetUploadByIDFuncCall) Results() []interface{} {
+func (c ResolverGetUploadProgressFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
@@ -1185,18 +1852,236 @@ func (m *MockResolver) QueryResolver(v0 context.Context, v1 *graphqlbackend.GitB
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the QueryResolver method
-// of the parent MockResolver instance is invoked and the hook queue is
-// empty.
-func (f *ResolverQueryResolverFunc) SetDefaultHook(hook func(context.Context, *graphqlbackend.GitBlobLSIFDataArgs) (resolvers.QueryResolver, error)) {
+// SetDefaultHook sets function that is called when the QueryResolver method
+// of the parent MockResolver instance is invoked and the hook queue is
+// empty.
+func (f *ResolverQueryResolverFunc) SetDefaultHook(hook func(context.Context, *graphqlbackend.GitBlobLSIFDataArgs) (resolvers.QueryResolver, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// QueryResolver method of the parent MockResolver instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ResolverQueryResolverFunc) PushHook(hook func(context.Context, *graphqlbackend.GitBlobLSIFDataArgs) (resolvers.QueryResolver, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *ResolverQueryResolverFunc) SetDefaultReturn(r0 resolvers.QueryResolver, r1 error) {
+	f.SetDefaultHook(func(context.Context, *graphqlbackend.GitBlobLSIFDataArgs) (resolvers.QueryResolver, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *ResolverQueryResolverFunc) PushReturn(r0 resolvers.QueryResolver, r1 error) {
+	f.PushHook(func(context.Context, *graphqlbackend.GitBlobLSIFDataArgs) (resolvers.QueryResolver, error) {
+		return r0, r1
+	})
+}
+
+func (f *ResolverQueryResolverFunc) nextHook() func(context.Context, *graphqlbackend.GitBlobLSIFDataArgs) (resolvers.QueryResolver, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ResolverQueryResolverFunc) appendCall(r0 ResolverQueryResolverFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ResolverQueryResolverFuncCall objects
+// describing the invocations of this function.
+func (f *ResolverQueryResolverFunc) History() []ResolverQueryResolverFuncCall {
+	f.mutex.Lock()
+	history := make([]ResolverQueryResolverFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ResolverQueryResolverFuncCall is an object that describes an invocation
+// of method QueryResolver on an instance of MockResolver.
+type ResolverQueryResolverFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *graphqlbackend.GitBlobLSIFDataArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 resolvers.QueryResolver
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ResolverQueryResolverFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ResolverQueryResolverFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ResolverQueueAutoIndexJobForRepoFunc describes the behavior when the
+// QueueAutoIndexJobForRepo method of the parent MockResolver instance is
+// invoked.
+type ResolverQueueAutoIndexJobForRepoFunc struct {
+	defaultHook func(context.Context, int) error
+	hooks       []func(context.Context, int) error
+	history     []ResolverQueueAutoIndexJobForRepoFuncCall
+	mutex       sync.Mutex
+}
+
+// QueueAutoIndexJobForRepo delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockResolver) QueueAutoIndexJobForRepo(v0 context.Context, v1 int) error {
+	r0 := m.QueueAutoIndexJobForRepoFunc.nextHook()(v0, v1)
+	m.QueueAutoIndexJobForRepoFunc.appendCall(ResolverQueueAutoIndexJobForRepoFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// QueueAutoIndexJobForRepo method of the parent MockResolver instance is
+// invoked and the hook queue is empty.
+func (f *ResolverQueueAutoIndexJobForRepoFunc) SetDefaultHook(hook func(context.Context, int) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// QueueAutoIndexJobForRepo method of the parent MockResolver instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *ResolverQueueAutoIndexJobForRepoFunc) PushHook(hook func(context.Context, int) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *ResolverQueueAutoIndexJobForRepoFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *ResolverQueueAutoIndexJobForRepoFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int) error {
+		return r0
+	})
+}
+
+func (f *ResolverQueueAutoIndexJobForRepoFunc) nextHook() func(context.Context, int) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ResolverQueueAutoIndexJobForRepoFunc) appendCall(r0 ResolverQueueAutoIndexJobForRepoFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ResolverQueueAutoIndexJobForRepoFuncCall
+// objects describing the invocations of this function.
+func (f *ResolverQueueAutoIndexJobForRepoFunc) History() []ResolverQueueAutoIndexJobForRepoFuncCall {
+	f.mutex.Lock()
+	history := make([]ResolverQueueAutoIndexJobForRepoFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ResolverQueueAutoIndexJobForRepoFuncCall is an object that describes an
+// invocation of method QueueAutoIndexJobForRepo on an instance of
+// MockResolver.
+type ResolverQueueAutoIndexJobForRepoFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ResolverQueueAutoIndexJobForRepoFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ResolverQueueAutoIndexJobForRepoFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// ResolverReindexUploadByIDFunc describes the behavior when the
+// ReindexUploadByID method of the parent MockResolver instance is invoked.
+type ResolverReindexUploadByIDFunc struct {
+	defaultHook func(context.Context, int) error
+	hooks       []func(context.Context, int) error
+	history     []ResolverReindexUploadByIDFuncCall
+	mutex       sync.Mutex
+}
+
+// ReindexUploadByID delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockResolver) ReindexUploadByID(v0 context.Context, v1 int) error {
+	r0 := m.ReindexUploadByIDFunc.nextHook()(v0, v1)
+	m.ReindexUploadByIDFunc.appendCall(ResolverReindexUploadByIDFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the ReindexUploadByID
+// method of the parent MockResolver instance is invoked and the hook queue
+// is empty.
+func (f *ResolverReindexUploadByIDFunc) SetDefaultHook(hook func(context.Context, int) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// QueryResolver method of the parent MockResolver instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *ResolverQueryResolverFunc) PushHook(hook func(context.Context, *graphqlbackend.GitBlobLSIFDataArgs) (resolvers.QueryResolver, error)) {
+// ReindexUploadByID method of the parent MockResolver instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ResolverReindexUploadByIDFunc) PushHook(hook func(context.Context, int) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1204,21 +2089,21 @@ func (f *ResolverQueryResolverFunc) PushHook(hook func(context.Context, *graphql
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *ResolverQueryResolverFunc) SetDefaultReturn(r0 resolvers.QueryResolver, r1 error) {
-	f.SetDefaultHook(func(context.Context, *graphqlbackend.GitBlobLSIFDataArgs) (resolvers.QueryResolver, error) {
-		return r0, r1
+func (f *ResolverReindexUploadByIDFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *ResolverQueryResolverFunc) PushReturn(r0 resolvers.QueryResolver, r1 error) {
-	f.PushHook(func(context.Context, *graphqlbackend.GitBlobLSIFDataArgs) (resolvers.QueryResolver, error) {
-		return r0, r1
+func (f *ResolverReindexUploadByIDFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int) error {
+		return r0
 	})
 }
 
-func (f *ResolverQueryResolverFunc) nextHook() func(context.Context, *graphqlbackend.GitBlobLSIFDataArgs) (resolvers.QueryResolver, error) {
+func (f *ResolverReindexUploadByIDFunc) nextHook() func(context.Context, int) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1231,83 +2116,78 @@ func (f *ResolverQueryResolverFunc) nextHook() func(context.Context, *graphqlbac
 	return hook
 }
 
-func (f *ResolverQueryResolverFunc) appendCall(r0 ResolverQueryResolverFuncCall) {
+func (f *ResolverReindexUploadByIDFunc) appendCall(r0 ResolverReindexUploadByIDFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ResolverQueryResolverFuncCall objects
+// History returns a sequence of ResolverReindexUploadByIDFuncCall objects
 // describing the invocations of this function.
-func (f *ResolverQueryResolverFunc) History() []ResolverQueryResolverFuncCall {
+func (f *ResolverReindexUploadByIDFunc) History() []ResolverReindexUploadByIDFuncCall {
 	f.mutex.Lock()
-	history := make([]ResolverQueryResolverFuncCall, len(f.history))
+	history := make([]ResolverReindexUploadByIDFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ResolverQueryResolverFuncCall is an object that describes an invocation
-// of method QueryResolver on an instance of MockResolver.
-type ResolverQueryResolverFuncCall struct {
+// ResolverReindexUploadByIDFuncCall is an object that describes an
+// invocation of method ReindexUploadByID on an instance of MockResolver.
+type ResolverReindexUploadByIDFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *graphqlbackend.GitBlobLSIFDataArgs
+	Arg1 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 resolvers.QueryResolver
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ResolverQueryResolverFuncCall) Args() []interface{} {
+func (c ResolverReindexUploadByIDFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ResolverQueryResolverFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c ResolverReindexUploadByIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// ResolverQueueAutoIndexJobForRepoFunc describes the behavior when the
-// QueueAutoIndexJobForRepo method of the parent MockResolver instance is
-// invoked.
-type ResolverQueueAutoIndexJobForRepoFunc struct {
-	defaultHook func(context.Context, int) error
-	hooks       []func(context.Context, int) error
-	history     []ResolverQueueAutoIndexJobForRepoFuncCall
+// ResolverRetentionPoliciesFunc describes the behavior when the
+// RetentionPolicies method of the parent MockResolver instance is invoked.
+type ResolverRetentionPoliciesFunc struct {
+	defaultHook func(context.Context) ([]dbstore.RetentionPolicy, error)
+	hooks       []func(context.Context) ([]dbstore.RetentionPolicy, error)
+	history     []ResolverRetentionPoliciesFuncCall
 	mutex       sync.Mutex
 }
 
-// QueueAutoIndexJobForRepo delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockResolver) QueueAutoIndexJobForRepo(v0 context.Context, v1 int) error {
-	r0 := m.QueueAutoIndexJobForRepoFunc.nextHook()(v0, v1)
-	m.QueueAutoIndexJobForRepoFunc.appendCall(ResolverQueueAutoIndexJobForRepoFuncCall{v0, v1, r0})
-	return r0
+// RetentionPolicies delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockResolver) RetentionPolicies(v0 context.Context) ([]dbstore.RetentionPolicy, error) {
+	r0, r1 := m.RetentionPoliciesFunc.nextHook()(v0)
+	m.RetentionPoliciesFunc.appendCall(ResolverRetentionPoliciesFuncCall{v0, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// QueueAutoIndexJobForRepo method of the parent MockResolver instance is
-// invoked and the hook queue is empty.
-func (f *ResolverQueueAutoIndexJobForRepoFunc) SetDefaultHook(hook func(context.Context, int) error) {
+// SetDefaultHook sets function that is called when the RetentionPolicies
+// method of the parent MockResolver instance is invoked and the hook queue
+// is empty.
+func (f *ResolverRetentionPoliciesFunc) SetDefaultHook(hook func(context.Context) ([]dbstore.RetentionPolicy, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// QueueAutoIndexJobForRepo method of the parent MockResolver instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *ResolverQueueAutoIndexJobForRepoFunc) PushHook(hook func(context.Context, int) error) {
+// RetentionPolicies method of the parent MockResolver instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ResolverRetentionPoliciesFunc) PushHook(hook func(context.Context) ([]dbstore.RetentionPolicy, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1315,21 +2195,21 @@ func (f *ResolverQueueAutoIndexJobForRepoFunc) PushHook(hook func(context.Contex
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *ResolverQueueAutoIndexJobForRepoFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int) error {
-		return r0
+func (f *ResolverRetentionPoliciesFunc) SetDefaultReturn(r0 []dbstore.RetentionPolicy, r1 error) {
+	f.SetDefaultHook(func(context.Context) ([]dbstore.RetentionPolicy, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *ResolverQueueAutoIndexJobForRepoFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int) error {
-		return r0
+func (f *ResolverRetentionPoliciesFunc) PushReturn(r0 []dbstore.RetentionPolicy, r1 error) {
+	f.PushHook(func(context.Context) ([]dbstore.RetentionPolicy, error) {
+		return r0, r1
 	})
 }
 
-func (f *ResolverQueueAutoIndexJobForRepoFunc) nextHook() func(context.Context, int) error {
+func (f *ResolverRetentionPoliciesFunc) nextHook() func(context.Context) ([]dbstore.RetentionPolicy, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1342,48 +2222,47 @@ func (f *ResolverQueueAutoIndexJobForRepoFunc) nextHook() func(context.Context,
 	return hook
 }
 
-func (f *ResolverQueueAutoIndexJobForRepoFunc) appendCall(r0 ResolverQueueAutoIndexJobForRepoFuncCall) {
+func (f *ResolverRetentionPoliciesFunc) appendCall(r0 ResolverRetentionPoliciesFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ResolverQueueAutoIndexJobForRepoFuncCall
-// objects describing the invocations of this function.
-func (f *ResolverQueueAutoIndexJobForRepoFunc) History() []ResolverQueueAutoIndexJobForRepoFuncCall {
+// History returns a sequence of ResolverRetentionPoliciesFuncCall objects
+// describing the invocations of this function.
+func (f *ResolverRetentionPoliciesFunc) History() []ResolverRetentionPoliciesFuncCall {
 	f.mutex.Lock()
-	history := make([]ResolverQueueAutoIndexJobForRepoFuncCall, len(f.history))
+	history := make([]ResolverRetentionPoliciesFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ResolverQueueAutoIndexJobForRepoFuncCall is an object that describes an
-// invocation of method QueueAutoIndexJobForRepo on an instance of
-// MockResolver.
-type ResolverQueueAutoIndexJobForRepoFuncCall struct {
+// ResolverRetentionPoliciesFuncCall is an object that describes an
+// invocation of method RetentionPolicies on an instance of MockResolver.
+type ResolverRetentionPoliciesFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 []dbstore.RetentionPolicy
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ResolverQueueAutoIndexJobForRepoFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c ResolverRetentionPoliciesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ResolverQueueAutoIndexJobForRepoFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c ResolverRetentionPoliciesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
 // ResolverUpdateIndexConfigurationByRepositoryIDFunc describes the behavior
@@ -1500,6 +2379,114 @@ func (c ResolverUpdateIndexConfigurationByRepositoryIDFuncCall) Results() []inte
 	return []interface{}{c.Result0}
 }
 
+// ResolverUpdateRetentionPolicyFunc describes the behavior when the
+// UpdateRetentionPolicy method of the parent MockResolver instance is
+// invoked.
+type ResolverUpdateRetentionPolicyFunc struct {
+	defaultHook func(context.Context, dbstore.RetentionPolicy) error
+	hooks       []func(context.Context, dbstore.RetentionPolicy) error
+	history     []ResolverUpdateRetentionPolicyFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateRetentionPolicy delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockResolver) UpdateRetentionPolicy(v0 context.Context, v1 dbstore.RetentionPolicy) error {
+	r0 := m.UpdateRetentionPolicyFunc.nextHook()(v0, v1)
+	m.UpdateRetentionPolicyFunc.appendCall(ResolverUpdateRetentionPolicyFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// UpdateRetentionPolicy method of the parent MockResolver instance is
+// invoked and the hook queue is empty.
+func (f *ResolverUpdateRetentionPolicyFunc) SetDefaultHook(hook func(context.Context, dbstore.RetentionPolicy) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateRetentionPolicy method of the parent MockResolver instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *ResolverUpdateRetentionPolicyFunc) PushHook(hook func(context.Context, dbstore.RetentionPolicy) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *ResolverUpdateRetentionPolicyFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, dbstore.RetentionPolicy) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *ResolverUpdateRetentionPolicyFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, dbstore.RetentionPolicy) error {
+		return r0
+	})
+}
+
+func (f *ResolverUpdateRetentionPolicyFunc) nextHook() func(context.Context, dbstore.RetentionPolicy) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ResolverUpdateRetentionPolicyFunc) appendCall(r0 ResolverUpdateRetentionPolicyFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ResolverUpdateRetentionPolicyFuncCall
+// objects describing the invocations of this function.
+func (f *ResolverUpdateRetentionPolicyFunc) History() []ResolverUpdateRetentionPolicyFuncCall {
+	f.mutex.Lock()
+	history := make([]ResolverUpdateRetentionPolicyFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ResolverUpdateRetentionPolicyFuncCall is an object that describes an
+// invocation of method UpdateRetentionPolicy on an instance of
+// MockResolver.
+type ResolverUpdateRetentionPolicyFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 dbstore.RetentionPolicy
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ResolverUpdateRetentionPolicyFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ResolverUpdateRetentionPolicyFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // ResolverUploadConnectionResolverFunc describes the behavior when the
 // UploadConnectionResolver method of the parent MockResolver instance is
 // invoked.
@@ -1605,3 +2592,111 @@ func (c ResolverUploadConnectionResolverFuncCall) Args() []interface{} {
 func (c ResolverUploadConnectionResolverFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
+
+// ResolverUsageStatsFunc describes the behavior when the UsageStats method
+// of the parent MockResolver instance is invoked.
+type ResolverUsageStatsFunc struct {
+	defaultHook func(context.Context, time.Time) ([]dbstore.UsageStatistics, error)
+	hooks       []func(context.Context, time.Time) ([]dbstore.UsageStatistics, error)
+	history     []ResolverUsageStatsFuncCall
+	mutex       sync.Mutex
+}
+
+// UsageStats delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockResolver) UsageStats(v0 context.Context, v1 time.Time) ([]dbstore.UsageStatistics, error) {
+	r0, r1 := m.UsageStatsFunc.nextHook()(v0, v1)
+	m.UsageStatsFunc.appendCall(ResolverUsageStatsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the UsageStats method of
+// the parent MockResolver instance is invoked and the hook queue is empty.
+func (f *ResolverUsageStatsFunc) SetDefaultHook(hook func(context.Context, time.Time) ([]dbstore.UsageStatistics, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UsageStats method of the parent MockResolver instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ResolverUsageStatsFunc) PushHook(hook func(context.Context, time.Time) ([]dbstore.UsageStatistics, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *ResolverUsageStatsFunc) SetDefaultReturn(r0 []dbstore.UsageStatistics, r1 error) {
+	f.SetDefaultHook(func(context.Context, time.Time) ([]dbstore.UsageStatistics, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *ResolverUsageStatsFunc) PushReturn(r0 []dbstore.UsageStatistics, r1 error) {
+	f.PushHook(func(context.Context, time.Time) ([]dbstore.UsageStatistics, error) {
+		return r0, r1
+	})
+}
+
+func (f *ResolverUsageStatsFunc) nextHook() func(context.Context, time.Time) ([]dbstore.UsageStatistics, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ResolverUsageStatsFunc) appendCall(r0 ResolverUsageStatsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ResolverUsageStatsFuncCall objects
+// describing the invocations of this function.
+func (f *ResolverUsageStatsFunc) History() []ResolverUsageStatsFuncCall {
+	f.mutex.Lock()
+	history := make([]ResolverUsageStatsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ResolverUsageStatsFuncCall is an object that describes an invocation of
+// method UsageStats on an instance of MockResolver.
+type ResolverUsageStatsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 time.Time
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []dbstore.UsageStatistics
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ResolverUsageStatsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ResolverUsageStatsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
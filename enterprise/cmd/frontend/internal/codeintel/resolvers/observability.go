@@ -15,13 +15,24 @@ import (
 )
 
 type operations struct {
-	queryResolver     *observation.Operation
-	definitions       *observation.Operation
-	diagnostics       *observation.Operation
-	hover             *observation.Operation
-	ranges            *observation.Operation
-	references        *observation.Operation
-	documentationPage *observation.Operation
+	queryResolver        *observation.Operation
+	aggregateDiagnostics *observation.Operation
+	coverage             *observation.Operation
+	definitions          *observation.Operation
+	diagnostics          *observation.Operation
+	hover                *observation.Operation
+	implementations      *observation.Operation
+	ranges               *observation.Operation
+	rangesAll            *observation.Operation
+	referenceCount       *observation.Operation
+	references           *observation.Operation
+	referencesStream     *observation.Operation
+	symbols              *observation.Operation
+	documentationPage    *observation.Operation
+	documentation        *observation.Operation
+	documentSymbols      *observation.Operation
+	foldingRanges        *observation.Operation
+	writeReferences      *observation.Operation
 
 	findClosestDumps *observation.Operation
 }
@@ -52,13 +63,24 @@ func newOperations(observationContext *observation.Context) *operations {
 	}
 
 	return &operations{
-		queryResolver:     op("QueryResolver"),
-		definitions:       op("Definitions"),
-		diagnostics:       op("Diagnostics"),
-		hover:             op("Hover"),
-		ranges:            op("Ranges"),
-		references:        op("References"),
-		documentationPage: op("DocumentationPage"),
+		queryResolver:        op("QueryResolver"),
+		aggregateDiagnostics: op("AggregateDiagnostics"),
+		coverage:             op("Coverage"),
+		definitions:          op("Definitions"),
+		diagnostics:          op("Diagnostics"),
+		hover:                op("Hover"),
+		implementations:      op("Implementations"),
+		ranges:               op("Ranges"),
+		rangesAll:            op("RangesAll"),
+		referenceCount:       op("ReferenceCount"),
+		references:           op("References"),
+		referencesStream:     op("ReferencesStream"),
+		symbols:              op("Symbols"),
+		documentationPage:    op("DocumentationPage"),
+		documentation:        op("Documentation"),
+		documentSymbols:      op("DocumentSymbols"),
+		foldingRanges:        op("FoldingRanges"),
+		writeReferences:      op("WriteReferences"),
 
 		findClosestDumps: subOp("findClosestDumps"),
 	}
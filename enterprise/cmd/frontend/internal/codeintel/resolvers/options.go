@@ -0,0 +1,119 @@
+package resolvers
+
+import (
+	"time"
+
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// ResolverOptions configures the limits and thresholds used by a query resolver. Any zero-valued
+// field is replaced by its default value when the query resolver is constructed.
+type ResolverOptions struct {
+	// HoverMergeStrategy determines how hover text from multiple uploads is combined.
+	HoverMergeStrategy HoverMergeStrategy
+
+	// ReferenceCountLimit is the maximum number of references counted for a single symbol.
+	ReferenceCountLimit int
+
+	// MaximumIndexesPerMonikerSearch is the maximum number of remote uploads that are searched
+	// in a single moniker search query.
+	MaximumIndexesPerMonikerSearch int
+
+	// MonikerLimit is the maximum number of monikers used to search remote uploads.
+	MonikerLimit int
+
+	// InterpolationWindow is the maximum commit distance walked in either direction from a commit
+	// without uploads to find the nearest uploads to blend results from. A zero value disables the
+	// forward walk and uses the default number of ancestors for the backward walk.
+	InterpolationWindow int
+
+	// SearchBasedFallback enables the hybrid mode, in which definitions and references are
+	// supplemented by search-based results when precise results are missing or incomplete. In
+	// this mode, queries for paths not covered by any upload are answered as well.
+	SearchBasedFallback bool
+
+	// SlowRequestThresholds overrides the duration after which a request is logged as slow,
+	// keyed by the name of the request.
+	SlowRequestThresholds map[string]time.Duration
+}
+
+const (
+	// defaultReferenceCountLimit is the default value of ResolverOptions.ReferenceCountLimit.
+	defaultReferenceCountLimit = 1000
+
+	// defaultMaximumIndexesPerMonikerSearch is the default value of
+	// ResolverOptions.MaximumIndexesPerMonikerSearch.
+	defaultMaximumIndexesPerMonikerSearch = 50
+
+	// defaultMonikerLimit is the default value of ResolverOptions.MonikerLimit.
+	defaultMonikerLimit = 10
+)
+
+// withDefaults returns a copy of the options with each zero-valued field replaced by its default.
+func (o ResolverOptions) withDefaults() ResolverOptions {
+	if o.HoverMergeStrategy == "" {
+		o.HoverMergeStrategy = HoverMergeFirst
+	}
+	if o.ReferenceCountLimit <= 0 {
+		o.ReferenceCountLimit = defaultReferenceCountLimit
+	}
+	if o.MaximumIndexesPerMonikerSearch <= 0 {
+		o.MaximumIndexesPerMonikerSearch = defaultMaximumIndexesPerMonikerSearch
+	}
+	if o.MonikerLimit <= 0 {
+		o.MonikerLimit = defaultMonikerLimit
+	}
+
+	return o
+}
+
+// withSiteConfig returns a copy of the options overridden by the code intelligence values set in
+// the given site configuration. Slow request thresholds that cannot be parsed are ignored.
+func (o ResolverOptions) withSiteConfig(siteConfig schema.SiteConfiguration) ResolverOptions {
+	if siteConfig.CodeIntelMaximumIndexesPerMonikerSearch > 0 {
+		o.MaximumIndexesPerMonikerSearch = siteConfig.CodeIntelMaximumIndexesPerMonikerSearch
+	}
+	if siteConfig.CodeIntelMonikerLimit > 0 {
+		o.MonikerLimit = siteConfig.CodeIntelMonikerLimit
+	}
+	if siteConfig.CodeIntelInterpolationWindow > 0 {
+		o.InterpolationWindow = siteConfig.CodeIntelInterpolationWindow
+	}
+	if siteConfig.CodeIntelSearchBasedFallback {
+		o.SearchBasedFallback = true
+	}
+
+	if len(siteConfig.CodeIntelSlowRequestThresholds) > 0 {
+		// Do not modify the map shared with the receiver
+		thresholds := make(map[string]time.Duration, len(o.SlowRequestThresholds)+len(siteConfig.CodeIntelSlowRequestThresholds))
+		for name, threshold := range o.SlowRequestThresholds {
+			thresholds[name] = threshold
+		}
+
+		for name, rawThreshold := range siteConfig.CodeIntelSlowRequestThresholds {
+			threshold, err := time.ParseDuration(rawThreshold)
+			if err != nil {
+				log15.Warn("Ignoring invalid slow request threshold in site configuration", "name", name, "threshold", rawThreshold, "error", err)
+				continue
+			}
+
+			thresholds[name] = threshold
+		}
+
+		o.SlowRequestThresholds = thresholds
+	}
+
+	return o
+}
+
+// slowRequestThreshold returns the duration after which the request with the given name is logged
+// as slow. The given default is returned if the threshold for this request has not been overridden.
+func (o ResolverOptions) slowRequestThreshold(name string, defaultThreshold time.Duration) time.Duration {
+	if threshold, ok := o.SlowRequestThresholds[name]; ok {
+		return threshold
+	}
+
+	return defaultThreshold
+}
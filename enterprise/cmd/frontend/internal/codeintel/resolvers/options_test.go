@@ -0,0 +1,78 @@
+package resolvers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestResolverOptionsWithDefaults(t *testing.T) {
+	expected := ResolverOptions{
+		HoverMergeStrategy:             HoverMergeFirst,
+		ReferenceCountLimit:            defaultReferenceCountLimit,
+		MaximumIndexesPerMonikerSearch: defaultMaximumIndexesPerMonikerSearch,
+		MonikerLimit:                   defaultMonikerLimit,
+	}
+	if diff := cmp.Diff(expected, ResolverOptions{}.withDefaults()); diff != "" {
+		t.Errorf("unexpected options (-want +got):\n%s", diff)
+	}
+
+	options := ResolverOptions{
+		HoverMergeStrategy:             HoverMergeConcatenate,
+		ReferenceCountLimit:            25,
+		MaximumIndexesPerMonikerSearch: 5,
+		MonikerLimit:                   3,
+	}
+	if diff := cmp.Diff(options, options.withDefaults()); diff != "" {
+		t.Errorf("unexpected options (-want +got):\n%s", diff)
+	}
+}
+
+func TestResolverOptionsWithSiteConfig(t *testing.T) {
+	options := ResolverOptions{
+		HoverMergeStrategy:             HoverMergeFirst,
+		MaximumIndexesPerMonikerSearch: 50,
+		MonikerLimit:                   10,
+		SlowRequestThresholds:          map[string]time.Duration{"Hover": time.Second},
+	}
+
+	overridden := options.withSiteConfig(schema.SiteConfiguration{
+		CodeIntelMaximumIndexesPerMonikerSearch: 20,
+		CodeIntelMonikerLimit:                   5,
+		CodeIntelInterpolationWindow:            30,
+		CodeIntelSearchBasedFallback:            true,
+		CodeIntelSlowRequestThresholds: map[string]string{
+			"References":  "2s",
+			"Definitions": "invalid",
+		},
+	})
+
+	expected := ResolverOptions{
+		HoverMergeStrategy:             HoverMergeFirst,
+		MaximumIndexesPerMonikerSearch: 20,
+		MonikerLimit:                   5,
+		InterpolationWindow:            30,
+		SearchBasedFallback:            true,
+		SlowRequestThresholds: map[string]time.Duration{
+			"Hover":      time.Second,
+			"References": 2 * time.Second,
+		},
+	}
+	if diff := cmp.Diff(expected, overridden); diff != "" {
+		t.Errorf("unexpected options (-want +got):\n%s", diff)
+	}
+
+	if len(options.SlowRequestThresholds) != 1 {
+		t.Errorf("unexpected modification of receiver thresholds: %v", options.SlowRequestThresholds)
+	}
+
+	if threshold := overridden.slowRequestThreshold("References", time.Second); threshold != 2*time.Second {
+		t.Errorf("unexpected threshold. want=%s have=%s", 2*time.Second, threshold)
+	}
+	if threshold := overridden.slowRequestThreshold("Definitions", time.Second); threshold != time.Second {
+		t.Errorf("unexpected threshold. want=%s have=%s", time.Second, threshold)
+	}
+}
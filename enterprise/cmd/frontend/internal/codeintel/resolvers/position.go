@@ -6,9 +6,12 @@ import (
 	"io"
 	"strconv"
 	"strings"
+	"sync"
 
+	"github.com/cockroachdb/errors"
 	"github.com/sourcegraph/go-diff/diff"
 
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/gitserver"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
@@ -36,9 +39,15 @@ type PositionAdjuster interface {
 }
 
 type positionAdjuster struct {
-	repo      *types.Repo
-	commit    string
-	hunkCache HunkCache
+	repo            *types.Repo
+	commit          string
+	hunkCache       HunkCache
+	gitserverClient GitserverClient
+
+	// prefetched holds the hunks fetched by prefetchHunks, keyed identically to the hunk cache.
+	// This is consulted before the hunk cache, which may evict entries or be disabled entirely.
+	prefetched      map[string][]*diff.Hunk
+	prefetchedMutex sync.RWMutex
 }
 
 // NewPositionAdjuster creates a new PositionAdjuster with the given repository and source commit.
@@ -86,9 +95,9 @@ func (p *positionAdjuster) AdjustRange(ctx context.Context, commit, path string,
 
 // readHunksCached returns a position-ordered slice of changes (additions or deletions) of
 // the given path between the given source and target commits. If revese is true, then the
-// source and target commits are swapped. If the position adjuster has a hunk cache, it
-// will read from it before attempting to contact a remote server, and populate the cache
-// with new results
+// source and target commits are swapped. Hunks fetched by prefetchHunks are returned
+// first. If the position adjuster has a hunk cache, it will read from it before attempting
+// to contact a remote server, and populate the cache with new results
 func (p *positionAdjuster) readHunksCached(ctx context.Context, repo *types.Repo, sourceCommit, targetCommit, path string, reverse bool) ([]*diff.Hunk, error) {
 	if sourceCommit == targetCommit {
 		return nil, nil
@@ -97,11 +106,19 @@ func (p *positionAdjuster) readHunksCached(ctx context.Context, repo *types.Repo
 		sourceCommit, targetCommit = targetCommit, sourceCommit
 	}
 
+	key := makeKey(strconv.FormatInt(int64(repo.ID), 10), sourceCommit, targetCommit, path)
+
+	p.prefetchedMutex.RLock()
+	hunks, ok := p.prefetched[key]
+	p.prefetchedMutex.RUnlock()
+	if ok {
+		return hunks, nil
+	}
+
 	if p.hunkCache == nil {
 		return p.readHunks(ctx, repo, sourceCommit, targetCommit, path)
 	}
 
-	key := makeKey(strconv.FormatInt(int64(repo.ID), 10), sourceCommit, targetCommit, path)
 	if hunks, ok := p.hunkCache.Get(key); ok {
 		if hunks == nil {
 			return nil, nil
@@ -115,11 +132,97 @@ func (p *positionAdjuster) readHunksCached(ctx context.Context, repo *types.Repo
 		return nil, err
 	}
 
-	p.hunkCache.Set(key, hunks, int64(len(hunks)))
+	// An unchanged file has no hunks, but is by far the most common (and most frequently
+	// requested) entry. Charge every entry at least a unit cost so that these entries count
+	// against the capacity of the cache like any other.
+	cost := int64(len(hunks))
+	if cost == 0 {
+		cost = 1
+	}
+	p.hunkCache.Set(key, hunks, cost)
 
 	return hunks, nil
 }
 
+// prefetchHunks fetches the changes of the given path between the source commit and each of the given
+// commits with a single batched gitserver request, so that subsequent calls to AdjustPosition and
+// AdjustRange with the same path and a commit from the given list (in the given direction) do not each
+// issue a diff of their own. This method is a no-op if the position adjuster has no gitserver client.
+func (p *positionAdjuster) prefetchHunks(ctx context.Context, commits []string, path string, reverse bool) error {
+	if p.gitserverClient == nil {
+		return nil
+	}
+
+	repositoryID := strconv.FormatInt(int64(p.repo.ID), 10)
+
+	var keys []string
+	var requests []gitserver.DiffRequest
+	seen := map[string]struct{}{}
+
+	p.prefetchedMutex.RLock()
+	for _, commit := range commits {
+		sourceCommit, targetCommit := p.commit, commit
+		if sourceCommit == targetCommit {
+			continue
+		}
+		if reverse {
+			sourceCommit, targetCommit = targetCommit, sourceCommit
+		}
+
+		key := makeKey(repositoryID, sourceCommit, targetCommit, path)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		if _, ok := p.prefetched[key]; ok {
+			continue
+		}
+		if p.hunkCache != nil {
+			if _, ok := p.hunkCache.Get(key); ok {
+				continue
+			}
+		}
+
+		keys = append(keys, key)
+		requests = append(requests, gitserver.DiffRequest{
+			SourceCommit: sourceCommit,
+			TargetCommit: targetCommit,
+			Path:         path,
+		})
+	}
+	p.prefetchedMutex.RUnlock()
+
+	if len(requests) == 0 {
+		return nil
+	}
+
+	hunksByRequest, err := p.gitserverClient.MultiDiff(ctx, int(p.repo.ID), requests)
+	if err != nil {
+		return errors.Wrap(err, "gitserverClient.MultiDiff")
+	}
+
+	p.prefetchedMutex.Lock()
+	defer p.prefetchedMutex.Unlock()
+
+	if p.prefetched == nil {
+		p.prefetched = make(map[string][]*diff.Hunk, len(keys))
+	}
+	for i, key := range keys {
+		p.prefetched[key] = hunksByRequest[i]
+
+		if p.hunkCache != nil {
+			cost := int64(len(hunksByRequest[i]))
+			if cost == 0 {
+				cost = 1
+			}
+			p.hunkCache.Set(key, hunksByRequest[i], cost)
+		}
+	}
+
+	return nil
+}
+
 // readHunks returns a position-ordered slice of changes (additions or deletions) of
 // the given path between the given source and target commits.
 func (p *positionAdjuster) readHunks(ctx context.Context, repo *types.Repo, sourceCommit, targetCommit, path string) ([]*diff.Hunk, error) {
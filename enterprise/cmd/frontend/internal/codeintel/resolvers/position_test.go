@@ -10,6 +10,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/sourcegraph/go-diff/diff"
 
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/gitserver"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
@@ -64,6 +65,64 @@ func TestAdjustPosition(t *testing.T) {
 	}
 }
 
+func TestAdjustPositionPrefetched(t *testing.T) {
+	t.Cleanup(func() {
+		git.Mocks.ExecReader = nil
+	})
+	git.Mocks.ExecReader = func(args []string) (reader io.ReadCloser, err error) {
+		t.Fatalf("unexpected diff: %v", args)
+		return nil, nil
+	}
+
+	fileDiff, err := diff.NewFileDiffReader(bytes.NewReader([]byte(hugoDiff))).Read()
+	if err != nil {
+		t.Fatalf("unexpected error parsing diff: %s", err)
+	}
+
+	mockGitserverClient := NewMockGitserverClient()
+	mockGitserverClient.MultiDiffFunc.PushReturn([][]*diff.Hunk{fileDiff.Hunks, nil}, nil)
+
+	adjuster := &positionAdjuster{
+		repo:            &types.Repo{ID: 50},
+		commit:          "deadbeef1",
+		gitserverClient: mockGitserverClient,
+	}
+	if err := adjuster.prefetchHunks(context.Background(), []string{"deadbeef1", "deadbeef2", "deadbeef3", "deadbeef2"}, "/foo/bar.go", false); err != nil {
+		t.Fatalf("unexpected error prefetching hunks: %s", err)
+	}
+
+	if history := mockGitserverClient.MultiDiffFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected number of diff requests. want=%d have=%d", 1, len(history))
+	} else {
+		expectedRequests := []gitserver.DiffRequest{
+			{SourceCommit: "deadbeef1", TargetCommit: "deadbeef2", Path: "/foo/bar.go"},
+			{SourceCommit: "deadbeef1", TargetCommit: "deadbeef3", Path: "/foo/bar.go"},
+		}
+		if diff := cmp.Diff(expectedRequests, history[0].Arg2); diff != "" {
+			t.Errorf("unexpected diff requests (-want +got):\n%s", diff)
+		}
+	}
+
+	posIn := lsifstore.Position{Line: 302, Character: 15}
+
+	for commit, expectedPos := range map[string]lsifstore.Position{
+		"deadbeef2": {Line: 294, Character: 15},
+		"deadbeef3": posIn,
+	} {
+		_, posOut, ok, err := adjuster.AdjustPosition(context.Background(), commit, "/foo/bar.go", posIn, false)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if !ok {
+			t.Errorf("expected translation to succeed")
+		}
+		if diff := cmp.Diff(expectedPos, posOut); diff != "" {
+			t.Errorf("unexpected position (-want +got):\n%s", diff)
+		}
+	}
+}
+
 func TestAdjustPositionEmptyDiff(t *testing.T) {
 	t.Cleanup(func() {
 		git.Mocks.ExecReader = nil
@@ -6,20 +6,30 @@ import (
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
 )
 
+// qualifiedMonikerSet is an ordered set of qualified monikers. Two qualified monikers are
+// considered equal if they share a package name, package version, scheme, and identifier.
+// The first occurrence of a moniker determines its position in the set.
 type qualifiedMonikerSet struct {
 	monikers       []semantic.QualifiedMonikerData
 	monikerHashMap map[string]struct{}
 }
 
-func newQualifiedMonikerSet() *qualifiedMonikerSet {
-	return &qualifiedMonikerSet{
+// newQualifiedMonikerSet creates a set containing the distinct elements of the given monikers.
+func newQualifiedMonikerSet(monikers ...semantic.QualifiedMonikerData) *qualifiedMonikerSet {
+	s := &qualifiedMonikerSet{
 		monikerHashMap: map[string]struct{}{},
 	}
+
+	for _, moniker := range monikers {
+		s.add(moniker)
+	}
+
+	return s
 }
 
 // add the given qualified moniker to the set if it is distinct from all elements
-// currently in the set.
-func (s *qualifiedMonikerSet) add(qualifiedMoniker semantic.QualifiedMonikerData) {
+// currently in the set. This method returns true if the moniker was inserted.
+func (s *qualifiedMonikerSet) add(qualifiedMoniker semantic.QualifiedMonikerData) bool {
 	monikerHash := strings.Join([]string{
 		qualifiedMoniker.PackageInformationData.Name,
 		qualifiedMoniker.PackageInformationData.Version,
@@ -28,9 +38,34 @@ func (s *qualifiedMonikerSet) add(qualifiedMoniker semantic.QualifiedMonikerData
 	}, ":")
 
 	if _, ok := s.monikerHashMap[monikerHash]; ok {
-		return
+		return false
 	}
 
 	s.monikerHashMap[monikerHash] = struct{}{}
 	s.monikers = append(s.monikers, qualifiedMoniker)
+	return true
+}
+
+// len returns the number of distinct monikers in the set.
+func (s *qualifiedMonikerSet) len() int {
+	return len(s.monikers)
+}
+
+// monikerData returns the distinct scheme and identifier pairs of the monikers in the set, in
+// set order. Monikers from different packages may share a scheme and identifier, but moniker
+// searches over the lsifstore do not distinguish between packages.
+func (s *qualifiedMonikerSet) monikerData() []semantic.MonikerData {
+	seen := make(map[string]struct{}, len(s.monikers))
+	monikers := make([]semantic.MonikerData, 0, len(s.monikers))
+	for _, moniker := range s.monikers {
+		key := moniker.Scheme + ":" + moniker.Identifier
+		if _, ok := seen[key]; ok {
+			continue
+		}
+
+		seen[key] = struct{}{}
+		monikers = append(monikers, moniker.MonikerData)
+	}
+
+	return monikers
 }
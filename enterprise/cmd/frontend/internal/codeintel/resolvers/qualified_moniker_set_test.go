@@ -0,0 +1,39 @@
+package resolvers
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
+)
+
+func TestQualifiedMonikerSet(t *testing.T) {
+	leftpad1 := semantic.PackageInformationData{Name: "leftpad", Version: "0.1.0"}
+	leftpad2 := semantic.PackageInformationData{Name: "leftpad", Version: "0.2.0"}
+
+	monikers := []semantic.QualifiedMonikerData{
+		{MonikerData: semantic.MonikerData{Kind: "import", Scheme: "tsc", Identifier: "padLeft"}, PackageInformationData: leftpad1},
+		{MonikerData: semantic.MonikerData{Kind: "import", Scheme: "tsc", Identifier: "padRight"}, PackageInformationData: leftpad1},
+		{MonikerData: semantic.MonikerData{Kind: "export", Scheme: "tsc", Identifier: "padLeft"}, PackageInformationData: leftpad1}, // duplicate of [0]
+		{MonikerData: semantic.MonikerData{Kind: "import", Scheme: "tsc", Identifier: "padLeft"}, PackageInformationData: leftpad2},
+	}
+
+	set := newQualifiedMonikerSet(monikers...)
+	if set.len() != 3 {
+		t.Errorf("unexpected set size. want=%d have=%d", 3, set.len())
+	}
+	if set.add(monikers[1]) {
+		t.Errorf("expected duplicate moniker to be rejected")
+	}
+
+	expectedMonikers := []semantic.QualifiedMonikerData{monikers[0], monikers[1], monikers[3]}
+	if diff := cmp.Diff(expectedMonikers, set.monikers); diff != "" {
+		t.Errorf("unexpected monikers (-want +got):\n%s", diff)
+	}
+
+	expectedMonikerData := []semantic.MonikerData{monikers[0].MonikerData, monikers[1].MonikerData}
+	if diff := cmp.Diff(expectedMonikerData, set.monikerData()); diff != "" {
+		t.Errorf("unexpected moniker data (-want +got):\n%s", diff)
+	}
+}
@@ -5,16 +5,20 @@ import (
 
 	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
 )
 
 // AdjustedLocation is a path and range pair from within a particular upload. The adjusted commit
 // denotes the target commit for which the location was adjusted (the originally requested commit).
+// Imprecise locations are found by search rather than read from an upload; their dump identifies
+// only the repository and commit that were searched.
 type AdjustedLocation struct {
 	Dump           store.Dump
 	Path           string
 	AdjustedCommit string
 	AdjustedRange  lsifstore.Range
+	Imprecise      bool
 }
 
 // AdjustedDiagnostic is a diagnostic from within a particular upload. The adjusted commit denotes
@@ -28,25 +32,63 @@ type AdjustedDiagnostic struct {
 
 // AdjustedCodeIntelligenceRange stores definition, reference, and hover information for all ranges
 // within a block of lines. The definition and reference locations have been adjusted to fit the
-// target (originally requested) commit.
+// target (originally requested) commit. The dump is the upload from which the range and all of its
+// definitions and references were read, and is the zero value if the range has no originating upload.
 type AdjustedCodeIntelligenceRange struct {
+	Dump        store.Dump
 	Range       lsifstore.Range
 	Definitions []AdjustedLocation
 	References  []AdjustedLocation
 	HoverText   string
 }
 
+// AdjustedSymbol is a symbol identified by a moniker, along with the locations defining the symbol.
+// The locations have been adjusted to fit the target (originally requested) commit.
+type AdjustedSymbol struct {
+	Scheme     string
+	Identifier string
+	Locations  []AdjustedLocation
+}
+
+// AdjustedDocumentSymbol is a symbol defined or declared within the current document, along with
+// the symbols nested within it. The range covering the symbol's name and the full range covering
+// its entire extent have been adjusted to fit the target (originally requested) commit.
+type AdjustedDocumentSymbol struct {
+	Dump      store.Dump
+	Name      string
+	Detail    string
+	Kind      protocol.SymbolKind
+	Range     lsifstore.Range
+	FullRange lsifstore.Range
+	Children  []AdjustedDocumentSymbol
+}
+
+// FoldingRange is an inclusive span of lines of the current document that can be folded.
+type FoldingRange struct {
+	StartLine int
+	EndLine   int
+}
+
 // QueryResolver is the main interface to bundle-related operations exposed to the GraphQL API. This
 // resolver consolidates the logic for bundle operations and is not itself concerned with GraphQL/API
 // specifics (auth, validation, marshaling, etc.). This resolver is wrapped by a symmetrics resolver
 // in this package's graphql subpackage, which is exposed directly by the API.
 type QueryResolver interface {
 	Ranges(ctx context.Context, startLine, endLine int) ([]AdjustedCodeIntelligenceRange, error)
+	RangesAll(ctx context.Context) ([]AdjustedCodeIntelligenceRange, error)
 	Definitions(ctx context.Context, line, character int) ([]AdjustedLocation, error)
 	References(ctx context.Context, line, character, limit int, rawCursor string) ([]AdjustedLocation, string, error)
-	Hover(ctx context.Context, line, character int) (string, lsifstore.Range, bool, error)
-	Diagnostics(ctx context.Context, limit int) ([]AdjustedDiagnostic, int, error)
+	ReferencesStream(ctx context.Context, line, character int, fn func(AdjustedLocation) error) error
+	Implementations(ctx context.Context, line, character, limit int, rawCursor string) ([]AdjustedLocation, string, error)
+	WriteReferences(ctx context.Context, line, character, limit int, rawCursor string) ([]AdjustedLocation, string, error)
+	Hover(ctx context.Context, line, character int, format lsifstore.HoverFormat) (string, lsifstore.Range, bool, error)
+	Diagnostics(ctx context.Context, limit int, rawCursor string) ([]AdjustedDiagnostic, int, string, error)
+	Symbols(ctx context.Context, query string, limit int) ([]AdjustedSymbol, error)
+	ReferenceCount(ctx context.Context, line, character int) (int, bool, error)
 	DocumentationPage(ctx context.Context, pathID string) (*semantic.DocumentationPageData, error)
+	Documentation(ctx context.Context, line, character int) ([]*semantic.DocumentationNode, error)
+	DocumentSymbols(ctx context.Context) ([]AdjustedDocumentSymbol, error)
+	FoldingRanges(ctx context.Context) ([]FoldingRange, error)
 }
 
 type queryResolver struct {
@@ -54,10 +96,12 @@ type queryResolver struct {
 	lsifStore           LSIFStore
 	cachedCommitChecker *cachedCommitChecker
 	positionAdjuster    PositionAdjuster
+	searchClient        SearchClient
 	repositoryID        int
 	commit              string
 	path                string
 	uploads             []store.Dump
+	options             ResolverOptions
 	operations          *operations
 }
 
@@ -69,13 +113,15 @@ func NewQueryResolver(
 	lsifStore LSIFStore,
 	cachedCommitChecker *cachedCommitChecker,
 	positionAdjuster PositionAdjuster,
+	searchClient SearchClient,
 	repositoryID int,
 	commit string,
 	path string,
 	uploads []store.Dump,
+	options ResolverOptions,
 	operations *operations,
 ) QueryResolver {
-	return newQueryResolver(dbStore, lsifStore, cachedCommitChecker, positionAdjuster, repositoryID, commit, path, uploads, operations)
+	return newQueryResolver(dbStore, lsifStore, cachedCommitChecker, positionAdjuster, searchClient, repositoryID, commit, path, uploads, options, operations)
 }
 
 func newQueryResolver(
@@ -83,10 +129,12 @@ func newQueryResolver(
 	lsifStore LSIFStore,
 	cachedCommitChecker *cachedCommitChecker,
 	positionAdjuster PositionAdjuster,
+	searchClient SearchClient,
 	repositoryID int,
 	commit string,
 	path string,
 	uploads []store.Dump,
+	options ResolverOptions,
 	operations *operations,
 ) *queryResolver {
 	return &queryResolver{
@@ -94,10 +142,12 @@ func newQueryResolver(
 		lsifStore:           lsifStore,
 		cachedCommitChecker: cachedCommitChecker,
 		positionAdjuster:    positionAdjuster,
+		searchClient:        searchClient,
 		operations:          operations,
 		repositoryID:        repositoryID,
 		commit:              commit,
 		path:                path,
 		uploads:             uploads,
+		options:             options.withDefaults(),
 	}
 }
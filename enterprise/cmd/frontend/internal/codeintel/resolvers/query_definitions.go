@@ -5,10 +5,13 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
 	"github.com/opentracing/opentracing-go/log"
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
 )
 
 const slowDefinitionsRequestThreshold = time.Second
@@ -16,9 +19,10 @@ const slowDefinitionsRequestThreshold = time.Second
 // DefinitionsLimit is maximum the number of locations returned from Definitions.
 const DefinitionsLimit = 100
 
-// Definitions returns the list of source locations that define the symbol at the given position.
+// Definitions returns the list of source locations that define the symbol at the given position. Candidate
+// definitions from every searched upload are merged, deduplicated, and ranked by rankDefinitions.
 func (r *queryResolver) Definitions(ctx context.Context, line, character int) (_ []AdjustedLocation, err error) {
-	ctx, traceLog, endObservation := observeResolver(ctx, &err, "Definitions", r.operations.definitions, slowDefinitionsRequestThreshold, observation.Args{
+	ctx, traceLog, endObservation := observeResolver(ctx, &err, "Definitions", r.operations.definitions, r.options.slowRequestThreshold("Definitions", slowDefinitionsRequestThreshold), observation.Args{
 		LogFields: []log.Field{
 			log.Int("repositoryID", r.repositoryID),
 			log.String("commit", r.commit),
@@ -39,34 +43,34 @@ func (r *queryResolver) Definitions(ctx context.Context, line, character int) (_
 		return nil, err
 	}
 
-	// Gather the "local" reference locations that are reachable via a referenceResult vertex.
-	// If the definition exists within the index, it should be reachable via an LSIF graph
-	// traversal and should not require an additional moniker search in the same index.
+	// Gather the "local" definition locations that are reachable via a definitionResult vertex
+	// in every visible upload. If the definition exists within an index, it should be reachable
+	// via an LSIF graph traversal and should not require an additional moniker search in the
+	// same index. Results from all uploads are ranked together below, so the outcome does not
+	// depend on the order in which the visible uploads are returned.
 
+	locationsByUpload, err := r.lsifStore.BatchDefinitions(ctx, positionKeys(adjustedUploads), DefinitionsLimit)
+	if err != nil {
+		return nil, errors.Wrap(err, "lsifStore.BatchDefinitions")
+	}
+
+	uploadsByID := make(map[int]dbstore.Dump, len(adjustedUploads))
 	for i := range adjustedUploads {
-		traceLog(log.Int("uploadID", adjustedUploads[i].Upload.ID))
-
-		locations, _, err := r.lsifStore.Definitions(
-			ctx,
-			adjustedUploads[i].Upload.ID,
-			adjustedUploads[i].AdjustedPathInBundle,
-			adjustedUploads[i].AdjustedPosition.Line,
-			adjustedUploads[i].AdjustedPosition.Character,
-			DefinitionsLimit,
-			0,
-		)
+		uploadsByID[adjustedUploads[i].Upload.ID] = adjustedUploads[i].Upload
+	}
+
+	var candidates []definitionCandidate
+	for _, locations := range locationsByUpload {
+		adjustedLocations, err := r.adjustLocations(ctx, uploadsByID, locations)
 		if err != nil {
-			return nil, errors.Wrap(err, "lsifStore.Definitions")
+			return nil, err
 		}
-		if len(locations) > 0 {
-			uploadsByID := map[int]dbstore.Dump{
-				adjustedUploads[i].Upload.ID: adjustedUploads[i].Upload,
-			}
 
-			// If we have a local definition, we won't find a better one and can exit early
-			return r.adjustLocations(ctx, uploadsByID, locations)
+		for _, location := range adjustedLocations {
+			candidates = append(candidates, definitionCandidate{location: location, precise: true})
 		}
 	}
+	traceLog(log.Int("numPreciseLocations", len(candidates)))
 
 	// Gather all import monikers attached to the ranges enclosing the requested position
 	orderedMonikers, err := r.orderedMonikers(ctx, adjustedUploads, "import")
@@ -78,6 +82,47 @@ func (r *queryResolver) Definitions(ctx context.Context, line, character int) (_
 		log.String("monikers", monikersToString(orderedMonikers)),
 	)
 
+	// Search the sibling uploads of the visible uploads before falling back to a package-based
+	// moniker search. Sibling uploads share a commit and indexer with a visible upload but have
+	// a distinct root; together they form a single logical index, so a definition in a sibling
+	// root is preferred over a definition found in a remote package. Sibling results are ranked
+	// along with any precise results, which always take precedence.
+	siblingUploads, err := r.siblingUploads(ctx, adjustedUploads)
+	if err != nil {
+		return nil, err
+	}
+	traceLog(
+		log.Int("numSiblingUploads", len(siblingUploads)),
+		log.String("siblingUploads", uploadIDsToString(siblingUploads)),
+	)
+
+	if len(siblingUploads) > 0 && len(orderedMonikers) > 0 {
+		locations, _, err := r.monikerLocations(ctx, siblingUploads, orderedMonikers, "definitions", DefinitionsLimit, 0)
+		if err != nil {
+			return nil, err
+		}
+		traceLog(log.Int("numSiblingLocations", len(locations)))
+
+		siblingUploadsByID := make(map[int]dbstore.Dump, len(siblingUploads))
+		for i := range siblingUploads {
+			siblingUploadsByID[siblingUploads[i].ID] = siblingUploads[i]
+		}
+
+		adjustedLocations, err := r.adjustLocations(ctx, siblingUploadsByID, locations)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, location := range adjustedLocations {
+			candidates = append(candidates, definitionCandidate{location: location})
+		}
+	}
+
+	if len(candidates) > 0 {
+		// If we have a local definition, we won't find a better one in a remote package
+		return r.appendSearchDefinitions(ctx, r.rankDefinitions(candidates, DefinitionsLimit), line, character, DefinitionsLimit)
+	}
+
 	// Determine the set of uploads over which we need to perform a moniker search. This will
 	// include all all indexes which define one of the ordered monikers. This should not include
 	// any of the indexes we have already performed an LSIF graph traversal in above.
@@ -90,6 +135,13 @@ func (r *queryResolver) Definitions(ctx context.Context, line, character int) (_
 		log.String("definitionUploads", uploadIDsToString(uploads)),
 	)
 
+	if len(uploads) == 0 && dependencySyncingEnabled() {
+		// None of the imported packages are indexed on this instance. Queue the repositories of
+		// the packages to be cloned and auto-indexed so that a subsequent request can jump to the
+		// remote definition.
+		r.queueDependencySyncing(ctx, orderedMonikers)
+	}
+
 	// Perform the moniker search
 	locations, _, err := r.monikerLocations(ctx, uploads, orderedMonikers, "definitions", DefinitionsLimit, 0)
 	if err != nil {
@@ -101,7 +153,7 @@ func (r *queryResolver) Definitions(ctx context.Context, line, character int) (_
 	// locations within the repository the user is browsing so that it appears all definitions
 	// are occurring at the same commit they are looking at.
 
-	uploadsByID := make(map[int]dbstore.Dump, len(uploads))
+	uploadsByID = make(map[int]dbstore.Dump, len(uploads))
 	for i := range uploads {
 		uploadsByID[uploads[i].ID] = uploads[i]
 	}
@@ -112,5 +164,32 @@ func (r *queryResolver) Definitions(ctx context.Context, line, character int) (_
 	}
 	traceLog(log.Int("numAdjustedLocations", len(adjustedLocations)))
 
-	return adjustedLocations, nil
+	candidates = make([]definitionCandidate, 0, len(adjustedLocations))
+	for _, location := range adjustedLocations {
+		candidates = append(candidates, definitionCandidate{location: location})
+	}
+
+	// In hybrid mode, search-based definitions are listed after any precise definitions
+	return r.appendSearchDefinitions(ctx, r.rankDefinitions(candidates, DefinitionsLimit), line, character, DefinitionsLimit)
+}
+
+// dependencySyncingEnabled returns true if the repositories of imported packages that are not
+// indexed on this instance should be cloned and auto-indexed.
+var dependencySyncingEnabled = conf.CodeIntelAutoIndexingEnabled
+
+// queueDependencySyncing inserts a dependency syncing job for the package of each of the given
+// monikers. Failures are logged rather than returned, as they do not affect the current request.
+func (r *queryResolver) queueDependencySyncing(ctx context.Context, orderedMonikers []semantic.QualifiedMonikerData) {
+	seen := make(map[dbstore.PackageKey]struct{}, len(orderedMonikers))
+	for _, moniker := range orderedMonikers {
+		key := dbstore.PackageKey{Scheme: moniker.Scheme, Name: moniker.Name, Version: moniker.Version}
+		if _, ok := seen[key]; ok || key.Name == "" {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		if _, _, err := r.dbStore.InsertDependencySyncingJob(ctx, key.Scheme, key.Name, key.Version); err != nil {
+			log15.Warn("Failed to queue dependency syncing job", "scheme", key.Scheme, "name", key.Name, "version", key.Version, "error", err)
+		}
+	}
 }
@@ -0,0 +1,96 @@
+package resolvers
+
+import (
+	"sort"
+	"strings"
+)
+
+// definitionCandidate is a definition location found in one of the uploads searched by
+// Definitions. Precise candidates are reachable from the target position via an LSIF graph
+// traversal; all other candidates were found via a moniker search.
+type definitionCandidate struct {
+	location AdjustedLocation
+	precise  bool
+}
+
+// definitionRank is the sort key of a definition candidate. Lower values are preferred.
+type definitionRank struct {
+	imprecise      int
+	distinctRoot   int
+	commitDistance int
+}
+
+func (r definitionRank) less(other definitionRank) bool {
+	if r.imprecise != other.imprecise {
+		return r.imprecise < other.imprecise
+	}
+	if r.distinctRoot != other.distinctRoot {
+		return r.distinctRoot < other.distinctRoot
+	}
+	return r.commitDistance < other.commitDistance
+}
+
+// rankDefinitions orders the given candidates so that the most likely definitions come first and
+// returns at most limit deduplicated locations. Candidates are preferred in the following order:
+//
+//   - precise candidates over moniker-derived candidates;
+//   - candidates in an upload sharing the root of a visible upload enclosing the target path;
+//   - candidates in an upload at the target commit, then at the commit of a visible upload, then
+//     at any other commit.
+//
+// Candidates of equal rank retain their relative order. When the same location is found more
+// than once, only the best-ranked occurrence is kept.
+func (r *queryResolver) rankDefinitions(candidates []definitionCandidate, limit int) []AdjustedLocation {
+	targetRoots := map[string]struct{}{}
+	visibleCommits := map[string]struct{}{}
+	for _, upload := range r.uploads {
+		if strings.HasPrefix(r.path, upload.Root) {
+			targetRoots[upload.Root] = struct{}{}
+		}
+		visibleCommits[upload.Commit] = struct{}{}
+	}
+
+	ranks := make([]definitionRank, len(candidates))
+	for i, candidate := range candidates {
+		dump := candidate.location.Dump
+		rank := definitionRank{}
+
+		if !candidate.precise {
+			rank.imprecise = 1
+		}
+		if _, ok := targetRoots[dump.Root]; !ok || dump.RepositoryID != r.repositoryID {
+			rank.distinctRoot = 1
+		}
+		if dump.RepositoryID != r.repositoryID {
+			rank.commitDistance = 2
+		} else if dump.Commit != r.commit {
+			if _, ok := visibleCommits[dump.Commit]; ok {
+				rank.commitDistance = 1
+			} else {
+				rank.commitDistance = 2
+			}
+		}
+
+		ranks[i] = rank
+	}
+
+	indexes := make([]int, len(candidates))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return ranks[indexes[i]].less(ranks[indexes[j]])
+	})
+
+	locations := make([]AdjustedLocation, 0, len(candidates))
+	for _, i := range indexes {
+		locations = append(locations, candidates[i].location)
+	}
+
+	locations = deduplicateLocations(locations)
+	if len(locations) > limit {
+		locations = locations[:limit]
+	}
+
+	return locations
+}
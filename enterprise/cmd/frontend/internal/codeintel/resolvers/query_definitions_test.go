@@ -8,6 +8,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
 )
@@ -25,8 +26,7 @@ func TestDefinitions(t *testing.T) {
 		{DumpID: 51, Path: "b.go", Range: testRange4},
 		{DumpID: 51, Path: "c.go", Range: testRange5},
 	}
-	mockLSIFStore.DefinitionsFunc.PushReturn(nil, 0, nil)
-	mockLSIFStore.DefinitionsFunc.PushReturn(locations, len(locations), nil)
+	mockLSIFStore.BatchDefinitionsFunc.PushReturn([][]lsifstore.Location{nil, locations, nil, nil}, nil)
 
 	uploads := []dbstore.Dump{
 		{ID: 50, Commit: "deadbeef", Root: "sub1/"},
@@ -39,10 +39,12 @@ func TestDefinitions(t *testing.T) {
 		mockLSIFStore,
 		newCachedCommitChecker(mockGitserverClient),
 		mockPositionAdjuster,
+		nil,
 		42,
 		"deadbeef",
 		"s1/main.go",
 		uploads,
+		ResolverOptions{},
 		newOperations(&observation.TestContext),
 	)
 	adjustedLocations, err := resolver.Definitions(context.Background(), 10, 20)
@@ -71,10 +73,13 @@ func TestDefinitionsRemote(t *testing.T) {
 	remoteUploads := []dbstore.Dump{
 		{ID: 150, Commit: "deadbeef1", Root: "sub1/"},
 		{ID: 151, Commit: "deadbeef2", Root: "sub2/"},
-		{ID: 152, Commit: "deadbeef3", Root: "sub3/"},
-		{ID: 153, Commit: "deadbeef4", Root: "sub4/"},
 	}
-	mockDBStore.DefinitionDumpsFunc.PushReturn(remoteUploads, nil)
+	packageKey1 := dbstore.PackageKey{Scheme: "tsc", Name: "leftpad", Version: "0.1.0"}
+	packageKey2 := dbstore.PackageKey{Scheme: "tsc", Name: "leftpad", Version: "0.2.0"}
+	mockDBStore.GetPackagesFunc.PushReturn(map[dbstore.PackageKey]dbstore.Dump{
+		packageKey1: remoteUploads[0],
+		packageKey2: remoteUploads[1],
+	}, nil)
 
 	// upload #150's commit no longer exists; all others do
 	mockGitserverClient.CommitExistsFunc.PushReturn(false, nil)
@@ -117,10 +122,12 @@ func TestDefinitionsRemote(t *testing.T) {
 		mockLSIFStore,
 		newCachedCommitChecker(mockGitserverClient),
 		mockPositionAdjuster,
+		nil,
 		42,
 		"deadbeef",
 		"s1/main.go",
 		uploads,
+		ResolverOptions{},
 		newOperations(&observation.TestContext),
 	)
 	adjustedLocations, err := resolver.Definitions(context.Background(), 10, 20)
@@ -129,32 +136,28 @@ func TestDefinitionsRemote(t *testing.T) {
 	}
 
 	expectedLocations := []AdjustedLocation{
-		{Dump: remoteUploads[0], Path: "sub2/a.go", AdjustedCommit: "deadbeef2", AdjustedRange: testRange1},
-		{Dump: remoteUploads[0], Path: "sub2/b.go", AdjustedCommit: "deadbeef2", AdjustedRange: testRange2},
-		{Dump: remoteUploads[0], Path: "sub2/a.go", AdjustedCommit: "deadbeef2", AdjustedRange: testRange3},
-		{Dump: remoteUploads[0], Path: "sub2/b.go", AdjustedCommit: "deadbeef2", AdjustedRange: testRange4},
-		{Dump: remoteUploads[0], Path: "sub2/c.go", AdjustedCommit: "deadbeef2", AdjustedRange: testRange5},
+		{Dump: remoteUploads[1], Path: "sub2/a.go", AdjustedCommit: "deadbeef2", AdjustedRange: testRange1},
+		{Dump: remoteUploads[1], Path: "sub2/b.go", AdjustedCommit: "deadbeef2", AdjustedRange: testRange2},
+		{Dump: remoteUploads[1], Path: "sub2/a.go", AdjustedCommit: "deadbeef2", AdjustedRange: testRange3},
+		{Dump: remoteUploads[1], Path: "sub2/b.go", AdjustedCommit: "deadbeef2", AdjustedRange: testRange4},
+		{Dump: remoteUploads[1], Path: "sub2/c.go", AdjustedCommit: "deadbeef2", AdjustedRange: testRange5},
 	}
 	if diff := cmp.Diff(expectedLocations, adjustedLocations); diff != "" {
 		t.Errorf("unexpected locations (-want +got):\n%s", diff)
 	}
 
-	if history := mockDBStore.DefinitionDumpsFunc.History(); len(history) != 1 {
-		t.Fatalf("unexpected call count for dbstore.DefinitionDump. want=%d have=%d", 1, len(history))
+	if history := mockDBStore.GetPackagesFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected call count for dbstore.GetPackages. want=%d have=%d", 1, len(history))
 	} else {
-		expectedMonikers := []semantic.QualifiedMonikerData{
-			{MonikerData: monikers[0], PackageInformationData: packageInformation1},
-			{MonikerData: monikers[2], PackageInformationData: packageInformation2},
-		}
-		if diff := cmp.Diff(expectedMonikers, history[0].Arg1); diff != "" {
-			t.Errorf("unexpected monikers (-want +got):\n%s", diff)
+		if diff := cmp.Diff([]dbstore.PackageKey{packageKey1, packageKey2}, history[0].Arg1); diff != "" {
+			t.Errorf("unexpected package keys (-want +got):\n%s", diff)
 		}
 	}
 
 	if history := mockLSIFStore.BulkMonikerResultsFunc.History(); len(history) != 1 {
 		t.Fatalf("unexpected call count for lsifstore.BulkMonikerResults. want=%d have=%d", 1, len(history))
 	} else {
-		if diff := cmp.Diff([]int{151, 152, 153}, history[0].Arg2); diff != "" {
+		if diff := cmp.Diff([]int{151}, history[0].Arg2); diff != "" {
 			t.Errorf("unexpected ids (-want +got):\n%s", diff)
 		}
 
@@ -167,3 +170,244 @@ func TestDefinitionsRemote(t *testing.T) {
 		}
 	}
 }
+
+func TestDefinitionsRemoteDuplicateMonikers(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockGitserverClient := NewMockGitserverClient()
+	mockPositionAdjuster := noopPositionAdjuster()
+
+	remoteUploads := []dbstore.Dump{
+		{ID: 150, Commit: "deadbeef1", Root: "sub1/"},
+	}
+	mockDBStore.GetPackagesFunc.PushReturn(map[dbstore.PackageKey]dbstore.Dump{
+		{Scheme: "tsc", Name: "leftpad", Version: "0.1.0"}: remoteUploads[0],
+	}, nil)
+	mockGitserverClient.CommitExistsFunc.SetDefaultReturn(true, nil)
+
+	// The same moniker is attached to the range and to an enclosing range
+	moniker := semantic.MonikerData{Kind: "import", Scheme: "tsc", Identifier: "padLeft", PackageInformationID: "51"}
+	mockLSIFStore.MonikersByPositionFunc.PushReturn([][]semantic.MonikerData{{moniker}, {moniker}}, nil)
+	mockLSIFStore.PackageInformationFunc.SetDefaultReturn(semantic.PackageInformationData{Name: "leftpad", Version: "0.1.0"}, true, nil)
+	mockLSIFStore.BulkMonikerResultsFunc.PushReturn(nil, 0, nil)
+
+	uploads := []dbstore.Dump{
+		{ID: 50, Commit: "deadbeef", Root: "sub1/"},
+	}
+	resolver := newQueryResolver(
+		mockDBStore,
+		mockLSIFStore,
+		newCachedCommitChecker(mockGitserverClient),
+		mockPositionAdjuster,
+		nil,
+		42,
+		"deadbeef",
+		"s1/main.go",
+		uploads,
+		ResolverOptions{},
+		newOperations(&observation.TestContext),
+	)
+	if _, err := resolver.Definitions(context.Background(), 10, 20); err != nil {
+		t.Fatalf("unexpected error querying definitions: %s", err)
+	}
+
+	if history := mockLSIFStore.PackageInformationFunc.History(); len(history) != 1 {
+		t.Errorf("unexpected number of package information queries. want=%d have=%d", 1, len(history))
+	}
+
+	if history := mockDBStore.GetPackagesFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected number of package queries. want=%d have=%d", 1, len(history))
+	} else if len(history[0].Arg1) != 1 {
+		t.Errorf("unexpected number of package keys. want=%d have=%d", 1, len(history[0].Arg1))
+	}
+
+	if history := mockLSIFStore.BulkMonikerResultsFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected number of moniker searches. want=%d have=%d", 1, len(history))
+	} else if diff := cmp.Diff([]semantic.MonikerData{moniker}, history[0].Arg3); diff != "" {
+		t.Errorf("unexpected monikers (-want +got):\n%s", diff)
+	}
+}
+
+func TestDefinitionsQueuesDependencySyncing(t *testing.T) {
+	dependencySyncingEnabled = func() bool { return true }
+	defer func() { dependencySyncingEnabled = conf.CodeIntelAutoIndexingEnabled }()
+
+	mockDBStore := NewMockDBStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockGitserverClient := NewMockGitserverClient()
+	mockPositionAdjuster := noopPositionAdjuster()
+
+	// None of the imported packages are indexed on this instance
+	mockDBStore.GetPackagesFunc.SetDefaultReturn(map[dbstore.PackageKey]dbstore.Dump{}, nil)
+	mockGitserverClient.CommitExistsFunc.SetDefaultReturn(true, nil)
+
+	monikers := []semantic.MonikerData{
+		{Kind: "import", Scheme: "gomod", Identifier: "yaml:Marshal", PackageInformationID: "51"},
+		{Kind: "import", Scheme: "gomod", Identifier: "yaml:Unmarshal", PackageInformationID: "52"},
+		{Kind: "import", Scheme: "gomod", Identifier: "local"},
+	}
+	mockLSIFStore.MonikersByPositionFunc.PushReturn([][]semantic.MonikerData{monikers}, nil)
+	mockLSIFStore.PackageInformationFunc.SetDefaultReturn(semantic.PackageInformationData{Name: "https://gopkg.in/yaml.v2", Version: "v2.4.0"}, true, nil)
+
+	uploads := []dbstore.Dump{
+		{ID: 50, Commit: "deadbeef", Root: "sub1/"},
+	}
+	resolver := newQueryResolver(
+		mockDBStore,
+		mockLSIFStore,
+		newCachedCommitChecker(mockGitserverClient),
+		mockPositionAdjuster,
+		nil,
+		42,
+		"deadbeef",
+		"s1/main.go",
+		uploads,
+		ResolverOptions{},
+		newOperations(&observation.TestContext),
+	)
+	if _, err := resolver.Definitions(context.Background(), 10, 20); err != nil {
+		t.Fatalf("unexpected error querying definitions: %s", err)
+	}
+
+	if history := mockDBStore.InsertDependencySyncingJobFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected number of dependency syncing jobs. want=%d have=%d", 1, len(history))
+	} else if call := history[0]; call.Arg1 != "gomod" || call.Arg2 != "https://gopkg.in/yaml.v2" || call.Arg3 != "v2.4.0" {
+		t.Errorf("unexpected package. want=%v have=%v", []string{"gomod", "https://gopkg.in/yaml.v2", "v2.4.0"}, []string{call.Arg1, call.Arg2, call.Arg3})
+	}
+}
+
+func TestDefinitionsSiblingUploads(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockGitserverClient := NewMockGitserverClient()
+	mockPositionAdjuster := noopPositionAdjuster()
+
+	uploads := []dbstore.Dump{
+		{ID: 50, Commit: "deadbeef", Root: "sub1/", Indexer: "lsif-tsc"},
+	}
+	siblingUploads := []dbstore.Dump{
+		{ID: 51, Commit: "deadbeef", Root: "sub2/", Indexer: "lsif-tsc"},
+		{ID: 52, Commit: "deadbeef", Root: "", Indexer: "lsif-tsc"},
+	}
+	mockDBStore.GetDumpGroupsFunc.PushReturn([]dbstore.DumpGroup{
+		{Commit: "deadbeef", Indexer: "lsif-tsc", Dumps: append(append([]dbstore.Dump(nil), uploads...), siblingUploads...)},
+	}, nil)
+	mockGitserverClient.CommitExistsFunc.SetDefaultReturn(true, nil)
+
+	moniker := semantic.MonikerData{Kind: "import", Scheme: "tsc", Identifier: "padLeft", PackageInformationID: "51"}
+	mockLSIFStore.MonikersByPositionFunc.PushReturn([][]semantic.MonikerData{{moniker}}, nil)
+	mockLSIFStore.PackageInformationFunc.SetDefaultReturn(semantic.PackageInformationData{Name: "leftpad", Version: "0.1.0"}, true, nil)
+
+	// Both sibling uploads index the same file
+	locations := []lsifstore.Location{
+		{DumpID: 51, Path: "a.go", Range: testRange1},
+		{DumpID: 52, Path: "sub2/a.go", Range: testRange1},
+		{DumpID: 52, Path: "sub2/b.go", Range: testRange2},
+	}
+	mockLSIFStore.BulkMonikerResultsFunc.PushReturn(locations, len(locations), nil)
+
+	resolver := newQueryResolver(
+		mockDBStore,
+		mockLSIFStore,
+		newCachedCommitChecker(mockGitserverClient),
+		mockPositionAdjuster,
+		nil,
+		42,
+		"deadbeef",
+		"sub1/main.go",
+		uploads,
+		ResolverOptions{},
+		newOperations(&observation.TestContext),
+	)
+	adjustedLocations, err := resolver.Definitions(context.Background(), 10, 20)
+	if err != nil {
+		t.Fatalf("unexpected error querying definitions: %s", err)
+	}
+
+	expectedLocations := []AdjustedLocation{
+		{Dump: siblingUploads[0], Path: "sub2/a.go", AdjustedCommit: "deadbeef", AdjustedRange: testRange1},
+		{Dump: siblingUploads[1], Path: "sub2/b.go", AdjustedCommit: "deadbeef", AdjustedRange: testRange2},
+	}
+	if diff := cmp.Diff(expectedLocations, adjustedLocations); diff != "" {
+		t.Errorf("unexpected locations (-want +got):\n%s", diff)
+	}
+
+	if history := mockLSIFStore.BulkMonikerResultsFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected number of moniker searches. want=%d have=%d", 1, len(history))
+	} else if diff := cmp.Diff([]int{51, 52}, history[0].Arg2); diff != "" {
+		t.Errorf("unexpected ids (-want +got):\n%s", diff)
+	}
+
+	if history := mockDBStore.GetPackagesFunc.History(); len(history) != 0 {
+		t.Errorf("unexpected number of package queries. want=%d have=%d", 0, len(history))
+	}
+}
+
+func TestDefinitionsRanked(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockGitserverClient := NewMockGitserverClient()
+	mockPositionAdjuster := noopPositionAdjuster()
+
+	uploads := []dbstore.Dump{
+		{ID: 50, RepositoryID: 42, Commit: "cafebabe", Root: "sub1/", Indexer: "lsif-go"},
+		{ID: 51, RepositoryID: 42, Commit: "deadbeef", Root: "sub2/", Indexer: "lsif-go"},
+		{ID: 52, RepositoryID: 42, Commit: "deadbeef", Root: "s1/", Indexer: "lsif-tsc"},
+	}
+	siblingUploads := []dbstore.Dump{
+		{ID: 53, RepositoryID: 42, Commit: "deadbeef", Root: "s1/", Indexer: "lsif-go"},
+	}
+	mockDBStore.GetDumpGroupsFunc.PushReturn([]dbstore.DumpGroup{
+		{Commit: "deadbeef", Indexer: "lsif-go", Dumps: append([]dbstore.Dump{uploads[1]}, siblingUploads...)},
+	}, nil)
+	mockGitserverClient.CommitExistsFunc.SetDefaultReturn(true, nil)
+
+	// Every visible upload has a precise definition
+	mockLSIFStore.BatchDefinitionsFunc.PushReturn([][]lsifstore.Location{
+		{{DumpID: 50, Path: "c.go", Range: testRange3}},
+		{{DumpID: 51, Path: "b.go", Range: testRange2}},
+		{{DumpID: 52, Path: "a.go", Range: testRange1}},
+	}, nil)
+
+	moniker := semantic.MonikerData{Kind: "import", Scheme: "gomod", Identifier: "pad", PackageInformationID: "51"}
+	mockLSIFStore.MonikersByPositionFunc.PushReturn([][]semantic.MonikerData{{moniker}}, nil)
+	mockLSIFStore.PackageInformationFunc.SetDefaultReturn(semantic.PackageInformationData{Name: "pad", Version: "0.1.0"}, true, nil)
+
+	// The sibling upload duplicates the precise definition of upload #52
+	mockLSIFStore.BulkMonikerResultsFunc.PushReturn([]lsifstore.Location{
+		{DumpID: 53, Path: "a.go", Range: testRange1},
+		{DumpID: 53, Path: "d.go", Range: testRange4},
+	}, 2, nil)
+
+	resolver := newQueryResolver(
+		mockDBStore,
+		mockLSIFStore,
+		newCachedCommitChecker(mockGitserverClient),
+		mockPositionAdjuster,
+		nil,
+		42,
+		"deadbeef",
+		"s1/main.go",
+		uploads,
+		ResolverOptions{},
+		newOperations(&observation.TestContext),
+	)
+	adjustedLocations, err := resolver.Definitions(context.Background(), 10, 20)
+	if err != nil {
+		t.Fatalf("unexpected error querying definitions: %s", err)
+	}
+
+	expectedLocations := []AdjustedLocation{
+		{Dump: uploads[2], Path: "s1/a.go", AdjustedCommit: "deadbeef", AdjustedRange: testRange1},
+		{Dump: uploads[1], Path: "sub2/b.go", AdjustedCommit: "deadbeef", AdjustedRange: testRange2},
+		{Dump: uploads[0], Path: "sub1/c.go", AdjustedCommit: "cafebabe", AdjustedRange: testRange3},
+		{Dump: siblingUploads[0], Path: "s1/d.go", AdjustedCommit: "deadbeef", AdjustedRange: testRange4},
+	}
+	if diff := cmp.Diff(expectedLocations, adjustedLocations); diff != "" {
+		t.Errorf("unexpected locations (-want +got):\n%s", diff)
+	}
+
+	if history := mockDBStore.GetPackagesFunc.History(); len(history) != 0 {
+		t.Errorf("unexpected number of package queries. want=%d have=%d", 0, len(history))
+	}
+}
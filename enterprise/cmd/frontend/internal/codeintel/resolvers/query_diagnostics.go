@@ -2,6 +2,7 @@ package resolvers
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"time"
 
@@ -14,9 +15,11 @@ import (
 
 const slowDiagnosticsRequestThreshold = time.Second
 
-// Diagnostics returns the diagnostics for documents with the given path prefix.
-func (r *queryResolver) Diagnostics(ctx context.Context, limit int) (adjustedDiagnostics []AdjustedDiagnostic, _ int, err error) {
-	ctx, traceLog, endObservation := observeResolver(ctx, &err, "Diagnostics", r.operations.diagnostics, slowDiagnosticsRequestThreshold, observation.Args{
+// Diagnostics returns the diagnostics for documents with the given path prefix. This method also returns
+// the total number of diagnostics over all visible uploads and a cursor that can be supplied to a subsequent
+// request to fetch the next page of results. The returned cursor is empty when no results remain.
+func (r *queryResolver) Diagnostics(ctx context.Context, limit int, rawCursor string) (adjustedDiagnostics []AdjustedDiagnostic, _ int, _ string, err error) {
+	ctx, traceLog, endObservation := observeResolver(ctx, &err, "Diagnostics", r.operations.diagnostics, r.options.slowRequestThreshold("Diagnostics", slowDiagnosticsRequestThreshold), observation.Args{
 		LogFields: []log.Field{
 			log.Int("repositoryID", r.repositoryID),
 			log.String("commit", r.commit),
@@ -28,48 +31,81 @@ func (r *queryResolver) Diagnostics(ctx context.Context, limit int) (adjustedDia
 	})
 	defer endObservation()
 
+	cursor, err := decodeDiagnosticsCursor(rawCursor)
+	if err != nil {
+		return nil, 0, "", errors.Wrap(err, fmt.Sprintf("invalid cursor: %q", rawCursor))
+	}
+	traceLog(
+		log.Int("cursor.uploadID", cursor.UploadID),
+		log.Int("cursor.offset", cursor.Offset),
+	)
+
 	adjustedUploads, err := r.adjustUploadPaths(ctx)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
+	}
+
+	// Uploads preceding the one referenced by the cursor have already been paged through. If the
+	// cursor references an upload that is no longer visible, there is no stable place to resume.
+	start := 0
+	if cursor.UploadID != 0 {
+		start = len(adjustedUploads)
+		for i, adjustedUpload := range adjustedUploads {
+			if adjustedUpload.Upload.ID == cursor.UploadID {
+				start = i
+				break
+			}
+		}
+	}
+
+	// Every upload is read from its first diagnostic, so the upload referenced by the cursor
+	// must return enough diagnostics to skip the cursor offset and still fill the page.
+	diagnosticsByUpload, counts, err := r.lsifStore.BatchDiagnostics(ctx, documentKeys(adjustedUploads), cursor.Offset+limit)
+	if err != nil {
+		return nil, 0, "", errors.Wrap(err, "lsifStore.BatchDiagnostics")
 	}
 
 	totalCount := 0
+	for _, count := range counts {
+		totalCount += count
+	}
 
-	for i := range adjustedUploads {
+	i, j := start, cursor.Offset
+	for i < len(adjustedUploads) && len(adjustedDiagnostics) < limit {
 		traceLog(log.Int("uploadID", adjustedUploads[i].Upload.ID))
 
-		diagnostics, count, err := r.lsifStore.Diagnostics(
-			ctx,
-			adjustedUploads[i].Upload.ID,
-			adjustedUploads[i].AdjustedPathInBundle,
-			limit-len(adjustedDiagnostics),
-			0,
-		)
-		if err != nil {
-			return nil, 0, errors.Wrap(err, "lsifStore.Diagnostics")
-		}
-
-		for _, diagnostic := range diagnostics {
-			adjustedDiagnostic, err := r.adjustDiagnostic(ctx, adjustedUploads[i], diagnostic)
+		for ; j < len(diagnosticsByUpload[i]) && len(adjustedDiagnostics) < limit; j++ {
+			adjustedDiagnostic, err := r.adjustDiagnostic(ctx, adjustedUploads[i], diagnosticsByUpload[i][j])
 			if err != nil {
-				return nil, 0, err
+				return nil, 0, "", err
 			}
 
 			adjustedDiagnostics = append(adjustedDiagnostics, adjustedDiagnostic)
 		}
 
-		totalCount += count
+		if j < counts[i] {
+			// Page was filled before exhausting this upload
+			break
+		}
+
+		i, j = i+1, 0
+	}
+
+	// Skip exhausted uploads so that we only return a cursor when results remain
+	for i < len(adjustedUploads) && j >= counts[i] {
+		i, j = i+1, 0
 	}
 
-	if len(adjustedDiagnostics) > limit {
-		adjustedDiagnostics = adjustedDiagnostics[:limit]
+	nextCursor := ""
+	if i < len(adjustedUploads) {
+		nextCursor = encodeDiagnosticsCursor(diagnosticsCursor{UploadID: adjustedUploads[i].Upload.ID, Offset: j})
 	}
 	traceLog(
 		log.Int("totalCount", totalCount),
 		log.Int("numDiagnostics", len(adjustedDiagnostics)),
 	)
 
-	return adjustedDiagnostics, totalCount, nil
+	return adjustedDiagnostics, totalCount, nextCursor, nil
 }
 
 // adjustUploadPaths adjusts the current target path for each upload visible from the current target
@@ -0,0 +1,36 @@
+package resolvers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+)
+
+// diagnosticsCursor stores the state of a previous Diagnostics request used to calculate the
+// offset into the result set to be returned by the current request.
+type diagnosticsCursor struct {
+	UploadID int `json:"uploadID"`
+	Offset   int `json:"offset"`
+}
+
+// decodeDiagnosticsCursor is the inverse of encodeDiagnosticsCursor. If the given encoded string
+// is empty, then a fresh cursor is returned.
+func decodeDiagnosticsCursor(rawEncoded string) (diagnosticsCursor, error) {
+	if rawEncoded == "" {
+		return diagnosticsCursor{}, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(rawEncoded)
+	if err != nil {
+		return diagnosticsCursor{}, err
+	}
+
+	var cursor diagnosticsCursor
+	err = json.Unmarshal(raw, &cursor)
+	return cursor, err
+}
+
+// encodeDiagnosticsCursor returns an encoding of the given cursor suitable for a URL or a GraphQL token.
+func encodeDiagnosticsCursor(cursor diagnosticsCursor) string {
+	rawEncoded, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(rawEncoded)
+}
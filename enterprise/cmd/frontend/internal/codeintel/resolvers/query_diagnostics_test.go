@@ -26,9 +26,7 @@ func TestDiagnostics(t *testing.T) {
 		{DiagnosticData: semantic.DiagnosticData{Code: "c5"}},
 	}
 
-	mockLSIFStore.DiagnosticsFunc.PushReturn(diagnostics[0:1], 1, nil)
-	mockLSIFStore.DiagnosticsFunc.PushReturn(diagnostics[1:4], 3, nil)
-	mockLSIFStore.DiagnosticsFunc.PushReturn(diagnostics[4:], 26, nil)
+	mockLSIFStore.BatchDiagnosticsFunc.PushReturn([][]lsifstore.Diagnostic{diagnostics[0:1], diagnostics[1:4], diagnostics[4:], nil}, []int{1, 3, 26, 0}, nil)
 
 	uploads := []dbstore.Dump{
 		{ID: 50, Commit: "deadbeef", Root: "sub1/"},
@@ -41,13 +39,15 @@ func TestDiagnostics(t *testing.T) {
 		mockLSIFStore,
 		newCachedCommitChecker(mockGitserverClient),
 		mockPositionAdjuster,
+		nil,
 		42,
 		"deadbeef",
 		"s1/main.go",
 		uploads,
+		ResolverOptions{},
 		newOperations(&observation.TestContext),
 	)
-	adjustedDiagnostics, totalCount, err := resolver.Diagnostics(context.Background(), 5)
+	adjustedDiagnostics, totalCount, cursor, err := resolver.Diagnostics(context.Background(), 5, "")
 	if err != nil {
 		t.Fatalf("unexpected error querying diagnostics: %s", err)
 	}
@@ -67,11 +67,137 @@ func TestDiagnostics(t *testing.T) {
 		t.Errorf("unexpected diagnostics (-want +got):\n%s", diff)
 	}
 
-	var limits []int
-	for _, call := range mockLSIFStore.DiagnosticsFunc.History() {
-		limits = append(limits, call.Arg3)
+	if decoded, err := decodeDiagnosticsCursor(cursor); err != nil {
+		t.Fatalf("unexpected error decoding cursor: %s", err)
+	} else if expectedCursor := (diagnosticsCursor{UploadID: 52, Offset: 1}); decoded != expectedCursor {
+		t.Errorf("unexpected cursor. want=%+v have=%+v", expectedCursor, decoded)
 	}
-	if diff := cmp.Diff([]int{5, 4, 1, 0}, limits); diff != "" {
-		t.Errorf("unexpected limits (-want +got):\n%s", diff)
+
+	if history := mockLSIFStore.BatchDiagnosticsFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected number of calls to BatchDiagnostics. want=%d have=%d", 1, len(history))
+	} else {
+		expectedKeys := []lsifstore.DocumentKey{
+			{DumpID: 50, Path: "deadbeef"},
+			{DumpID: 51, Path: "deadbeef"},
+			{DumpID: 52, Path: "deadbeef"},
+			{DumpID: 53, Path: "deadbeef"},
+		}
+		if diff := cmp.Diff(expectedKeys, history[0].Arg1); diff != "" {
+			t.Errorf("unexpected keys (-want +got):\n%s", diff)
+		}
+		if history[0].Arg2 != 5 {
+			t.Errorf("unexpected limit. want=%d have=%d", 5, history[0].Arg2)
+		}
+	}
+}
+
+func TestDiagnosticsCursor(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockGitserverClient := NewMockGitserverClient()
+	mockPositionAdjuster := noopPositionAdjuster()
+
+	diagnostics := []lsifstore.Diagnostic{
+		{DiagnosticData: semantic.DiagnosticData{Code: "c1"}},
+		{DiagnosticData: semantic.DiagnosticData{Code: "c2"}},
+		{DiagnosticData: semantic.DiagnosticData{Code: "c3"}},
+		{DiagnosticData: semantic.DiagnosticData{Code: "c4"}},
+		{DiagnosticData: semantic.DiagnosticData{Code: "c5"}},
+		{DiagnosticData: semantic.DiagnosticData{Code: "c6"}},
+	}
+
+	// Diagnostics are read from the start of each upload
+	mockLSIFStore.BatchDiagnosticsFunc.PushReturn([][]lsifstore.Diagnostic{diagnostics[0:1], diagnostics[1:5], nil, diagnostics[5:]}, []int{1, 4, 0, 1}, nil)
+
+	uploads := []dbstore.Dump{
+		{ID: 50, Commit: "deadbeef", Root: "sub1/"},
+		{ID: 51, Commit: "deadbeef", Root: "sub2/"},
+		{ID: 52, Commit: "deadbeef", Root: "sub3/"},
+		{ID: 53, Commit: "deadbeef", Root: "sub4/"},
+	}
+	resolver := newQueryResolver(
+		mockDBStore,
+		mockLSIFStore,
+		newCachedCommitChecker(mockGitserverClient),
+		mockPositionAdjuster,
+		nil,
+		42,
+		"deadbeef",
+		"s1/main.go",
+		uploads,
+		ResolverOptions{},
+		newOperations(&observation.TestContext),
+	)
+	adjustedDiagnostics, totalCount, cursor, err := resolver.Diagnostics(context.Background(), 3, encodeDiagnosticsCursor(diagnosticsCursor{UploadID: 51, Offset: 1}))
+	if err != nil {
+		t.Fatalf("unexpected error querying diagnostics: %s", err)
+	}
+
+	if totalCount != 6 {
+		t.Errorf("unexpected count. want=%d have=%d", 6, totalCount)
+	}
+
+	expectedDiagnostics := []AdjustedDiagnostic{
+		{Dump: uploads[1], AdjustedCommit: "deadbeef", Diagnostic: lsifstore.Diagnostic{Path: "sub2/", DiagnosticData: semantic.DiagnosticData{Code: "c3"}}},
+		{Dump: uploads[1], AdjustedCommit: "deadbeef", Diagnostic: lsifstore.Diagnostic{Path: "sub2/", DiagnosticData: semantic.DiagnosticData{Code: "c4"}}},
+		{Dump: uploads[1], AdjustedCommit: "deadbeef", Diagnostic: lsifstore.Diagnostic{Path: "sub2/", DiagnosticData: semantic.DiagnosticData{Code: "c5"}}},
+	}
+	if diff := cmp.Diff(expectedDiagnostics, adjustedDiagnostics); diff != "" {
+		t.Errorf("unexpected diagnostics (-want +got):\n%s", diff)
+	}
+
+	// The page ends at the end of upload 51, and upload 52 has no diagnostics
+	if decoded, err := decodeDiagnosticsCursor(cursor); err != nil {
+		t.Fatalf("unexpected error decoding cursor: %s", err)
+	} else if expectedCursor := (diagnosticsCursor{UploadID: 53, Offset: 0}); decoded != expectedCursor {
+		t.Errorf("unexpected cursor. want=%+v have=%+v", expectedCursor, decoded)
+	}
+
+	if history := mockLSIFStore.BatchDiagnosticsFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected number of calls to BatchDiagnostics. want=%d have=%d", 1, len(history))
+	} else if history[0].Arg2 != 4 {
+		t.Errorf("unexpected limit. want=%d have=%d", 4, history[0].Arg2)
+	}
+}
+
+func TestDiagnosticsLastPage(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockGitserverClient := NewMockGitserverClient()
+	mockPositionAdjuster := noopPositionAdjuster()
+
+	diagnostics := []lsifstore.Diagnostic{
+		{DiagnosticData: semantic.DiagnosticData{Code: "c1"}},
+		{DiagnosticData: semantic.DiagnosticData{Code: "c2"}},
+	}
+	mockLSIFStore.BatchDiagnosticsFunc.PushReturn([][]lsifstore.Diagnostic{diagnostics, nil}, []int{2, 0}, nil)
+
+	uploads := []dbstore.Dump{
+		{ID: 50, Commit: "deadbeef", Root: "sub1/"},
+		{ID: 51, Commit: "deadbeef", Root: "sub2/"},
+	}
+	resolver := newQueryResolver(
+		mockDBStore,
+		mockLSIFStore,
+		newCachedCommitChecker(mockGitserverClient),
+		mockPositionAdjuster,
+		nil,
+		42,
+		"deadbeef",
+		"s1/main.go",
+		uploads,
+		ResolverOptions{},
+		newOperations(&observation.TestContext),
+	)
+	adjustedDiagnostics, _, cursor, err := resolver.Diagnostics(context.Background(), 2, "")
+	if err != nil {
+		t.Fatalf("unexpected error querying diagnostics: %s", err)
+	}
+
+	if len(adjustedDiagnostics) != 2 {
+		t.Errorf("unexpected number of diagnostics. want=%d have=%d", 2, len(adjustedDiagnostics))
+	}
+	if cursor != "" {
+		t.Errorf("unexpected cursor. want=%q have=%q", "", cursor)
 	}
 }
@@ -0,0 +1,167 @@
+package resolvers
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+const slowDocumentSymbolsRequestThreshold = time.Second
+
+const slowFoldingRangesRequestThreshold = time.Second
+
+// DocumentSymbols returns the outline of the current document: the symbols defined or declared
+// within it, nested by the containment of their full ranges. Symbols are read from the first
+// upload visible from the current target commit that has any for the document, as merging the
+// outlines of distinct uploads would duplicate symbols indexed by both.
+func (r *queryResolver) DocumentSymbols(ctx context.Context) (_ []AdjustedDocumentSymbol, err error) {
+	ctx, traceLog, endObservation := observeResolver(ctx, &err, "DocumentSymbols", r.operations.documentSymbols, r.options.slowRequestThreshold("DocumentSymbols", slowDocumentSymbolsRequestThreshold), observation.Args{
+		LogFields: []log.Field{
+			log.Int("repositoryID", r.repositoryID),
+			log.String("commit", r.commit),
+			log.String("path", r.path),
+			log.Int("numUploads", len(r.uploads)),
+			log.String("uploads", uploadIDsToString(r.uploads)),
+		},
+	})
+	defer endObservation()
+
+	symbols, err := r.documentSymbols(ctx, traceLog)
+	if err != nil {
+		return nil, err
+	}
+
+	return nestDocumentSymbols(symbols), nil
+}
+
+// FoldingRanges returns the line spans of the current document that can be folded. Each symbol
+// whose full range spans multiple lines yields a folding range.
+func (r *queryResolver) FoldingRanges(ctx context.Context) (_ []FoldingRange, err error) {
+	ctx, traceLog, endObservation := observeResolver(ctx, &err, "FoldingRanges", r.operations.foldingRanges, r.options.slowRequestThreshold("FoldingRanges", slowFoldingRangesRequestThreshold), observation.Args{
+		LogFields: []log.Field{
+			log.Int("repositoryID", r.repositoryID),
+			log.String("commit", r.commit),
+			log.String("path", r.path),
+			log.Int("numUploads", len(r.uploads)),
+			log.String("uploads", uploadIDsToString(r.uploads)),
+		},
+	})
+	defer endObservation()
+
+	symbols, err := r.documentSymbols(ctx, traceLog)
+	if err != nil {
+		return nil, err
+	}
+
+	return foldingRanges(symbols), nil
+}
+
+// documentSymbols returns the symbols of the current document, adjusted to fit the target commit,
+// as a flat list ordered by the start of their full range. Symbols whose range or full range cannot
+// be adjusted are omitted.
+func (r *queryResolver) documentSymbols(ctx context.Context, traceLog observation.TraceLogger) ([]AdjustedDocumentSymbol, error) {
+	adjustedUploads, err := r.adjustUploadPaths(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range adjustedUploads {
+		traceLog(log.Int("uploadID", adjustedUploads[i].Upload.ID))
+
+		symbols, err := r.lsifStore.DocumentSymbols(ctx, adjustedUploads[i].Upload.ID, adjustedUploads[i].AdjustedPathInBundle)
+		if err != nil {
+			return nil, errors.Wrap(err, "lsifStore.DocumentSymbols")
+		}
+		if len(symbols) == 0 {
+			continue
+		}
+
+		adjustedSymbols := make([]AdjustedDocumentSymbol, 0, len(symbols))
+		for _, symbol := range symbols {
+			_, adjustedRange, ok, err := r.adjustRange(ctx, adjustedUploads[i].Upload.RepositoryID, adjustedUploads[i].Upload.Commit, adjustedUploads[i].AdjustedPath, symbol.Range)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+
+			_, adjustedFullRange, ok, err := r.adjustRange(ctx, adjustedUploads[i].Upload.RepositoryID, adjustedUploads[i].Upload.Commit, adjustedUploads[i].AdjustedPath, symbol.FullRange)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+
+			adjustedSymbols = append(adjustedSymbols, AdjustedDocumentSymbol{
+				Dump:      adjustedUploads[i].Upload,
+				Name:      symbol.Name,
+				Detail:    symbol.Detail,
+				Kind:      symbol.Kind,
+				Range:     adjustedRange,
+				FullRange: adjustedFullRange,
+			})
+		}
+		traceLog(log.Int("numSymbols", len(adjustedSymbols)))
+
+		return adjustedSymbols, nil
+	}
+
+	return nil, nil
+}
+
+// nestDocumentSymbols arranges the given symbols, ordered by the start of their full range (with
+// enclosing symbols first on ties), into a tree in which each symbol is a child of the inner-most
+// symbol whose full range encloses its own.
+func nestDocumentSymbols(symbols []AdjustedDocumentSymbol) []AdjustedDocumentSymbol {
+	var nested []AdjustedDocumentSymbol
+	for i := 0; i < len(symbols); {
+		// Enclosed symbols directly follow their parent
+		j := i + 1
+		for j < len(symbols) && rangeContainsRange(symbols[i].FullRange, symbols[j].FullRange) {
+			j++
+		}
+
+		symbol := symbols[i]
+		symbol.Children = nestDocumentSymbols(symbols[i+1 : j])
+		nested = append(nested, symbol)
+		i = j
+	}
+
+	return nested
+}
+
+// foldingRanges returns the distinct multi-line spans of the full ranges of the given symbols,
+// ordered by start line and then by end line.
+func foldingRanges(symbols []AdjustedDocumentSymbol) []FoldingRange {
+	seen := map[FoldingRange]struct{}{}
+	ranges := make([]FoldingRange, 0, len(symbols))
+	for _, symbol := range symbols {
+		foldingRange := FoldingRange{StartLine: symbol.FullRange.Start.Line, EndLine: symbol.FullRange.End.Line}
+		if foldingRange.StartLine >= foldingRange.EndLine {
+			continue
+		}
+		if _, ok := seen[foldingRange]; ok {
+			continue
+		}
+
+		seen[foldingRange] = struct{}{}
+		ranges = append(ranges, foldingRange)
+	}
+
+	sort.Slice(ranges, func(i, j int) bool {
+		if ranges[i].StartLine != ranges[j].StartLine {
+			return ranges[i].StartLine < ranges[j].StartLine
+		}
+
+		return ranges[i].EndLine < ranges[j].EndLine
+	})
+
+	return ranges
+}
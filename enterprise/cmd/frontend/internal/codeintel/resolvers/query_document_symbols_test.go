@@ -0,0 +1,102 @@
+package resolvers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol"
+)
+
+func TestDocumentSymbols(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockGitserverClient := NewMockGitserverClient()
+	mockPositionAdjuster := noopPositionAdjuster()
+
+	symbols := []lsifstore.DocumentSymbol{
+		{Name: "Foo", Kind: protocol.Struct, Range: lineRange(2, 5, 8), FullRange: spanRange(2, 0, 5, 1)},
+		{Name: "bar", Kind: protocol.Field, Range: lineRange(3, 1, 4), FullRange: lineRange(3, 1, 8)},
+		{Name: "baz", Kind: protocol.Field, Range: lineRange(4, 1, 4), FullRange: lineRange(4, 1, 8)},
+		{Name: "Quux", Detail: "func()", Kind: protocol.Function, Range: lineRange(7, 5, 9), FullRange: spanRange(7, 0, 9, 1)},
+	}
+
+	// The first upload has no symbols for the document
+	mockLSIFStore.DocumentSymbolsFunc.PushReturn(nil, nil)
+	mockLSIFStore.DocumentSymbolsFunc.PushReturn(symbols, nil)
+
+	uploads := []dbstore.Dump{
+		{ID: 50, Commit: "deadbeef", Root: "s1/"},
+		{ID: 51, Commit: "deadbeef", Root: "s1/"},
+		{ID: 52, Commit: "deadbeef", Root: "s1/"},
+	}
+	resolver := newQueryResolver(
+		mockDBStore,
+		mockLSIFStore,
+		newCachedCommitChecker(mockGitserverClient),
+		mockPositionAdjuster,
+		nil,
+		42,
+		"deadbeef",
+		"s1/main.go",
+		uploads,
+		ResolverOptions{},
+		newOperations(&observation.TestContext),
+	)
+
+	adjustedSymbols, err := resolver.DocumentSymbols(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error querying document symbols: %s", err)
+	}
+
+	expectedSymbols := []AdjustedDocumentSymbol{
+		{Dump: uploads[1], Name: "Foo", Kind: protocol.Struct, Range: lineRange(2, 5, 8), FullRange: spanRange(2, 0, 5, 1), Children: []AdjustedDocumentSymbol{
+			{Dump: uploads[1], Name: "bar", Kind: protocol.Field, Range: lineRange(3, 1, 4), FullRange: lineRange(3, 1, 8)},
+			{Dump: uploads[1], Name: "baz", Kind: protocol.Field, Range: lineRange(4, 1, 4), FullRange: lineRange(4, 1, 8)},
+		}},
+		{Dump: uploads[1], Name: "Quux", Detail: "func()", Kind: protocol.Function, Range: lineRange(7, 5, 9), FullRange: spanRange(7, 0, 9, 1)},
+	}
+	if diff := cmp.Diff(expectedSymbols, adjustedSymbols); diff != "" {
+		t.Errorf("unexpected document symbols (-want +got):\n%s", diff)
+	}
+
+	if history := mockLSIFStore.DocumentSymbolsFunc.History(); len(history) != 2 {
+		t.Errorf("unexpected number of DocumentSymbols calls. want=%d have=%d", 2, len(history))
+	} else if history[1].Arg1 != 51 || history[1].Arg2 != "main.go" {
+		t.Errorf("unexpected DocumentSymbols arguments. want=(%d, %q) have=(%d, %q)", 51, "main.go", history[1].Arg1, history[1].Arg2)
+	}
+}
+
+func TestFoldingRanges(t *testing.T) {
+	symbols := []AdjustedDocumentSymbol{
+		{Name: "Foo", FullRange: spanRange(2, 0, 5, 1)},
+		{Name: "bar", FullRange: lineRange(3, 1, 8)},
+		{Name: "Bar", FullRange: spanRange(7, 0, 9, 1)},
+		{Name: "Baz", FullRange: spanRange(7, 0, 12, 1)},
+		{Name: "baz", FullRange: spanRange(7, 4, 9, 0)},
+	}
+
+	expectedRanges := []FoldingRange{
+		{StartLine: 2, EndLine: 5},
+		{StartLine: 7, EndLine: 9},
+		{StartLine: 7, EndLine: 12},
+	}
+	if diff := cmp.Diff(expectedRanges, foldingRanges(symbols)); diff != "" {
+		t.Errorf("unexpected folding ranges (-want +got):\n%s", diff)
+	}
+}
+
+func lineRange(line, startCharacter, endCharacter int) lsifstore.Range {
+	return spanRange(line, startCharacter, line, endCharacter)
+}
+
+func spanRange(startLine, startCharacter, endLine, endCharacter int) lsifstore.Range {
+	return lsifstore.Range{
+		Start: lsifstore.Position{Line: startLine, Character: startCharacter},
+		End:   lsifstore.Position{Line: endLine, Character: endCharacter},
+	}
+}
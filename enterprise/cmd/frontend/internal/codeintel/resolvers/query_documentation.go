@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/opentracing/opentracing-go/log"
 
 	"github.com/sourcegraph/sourcegraph/internal/observation"
@@ -12,11 +13,13 @@ import (
 
 const slowDocumentationPageRequestThreshold = time.Second
 
+const slowDocumentationRequestThreshold = time.Second
+
 // DocumentationPage returns the DocumentationPage for the given PathID.
 //
 // nil, nil is returned if the page does not exist.
 func (r *queryResolver) DocumentationPage(ctx context.Context, pathID string) (_ *semantic.DocumentationPageData, err error) {
-	ctx, traceLog, endObservation := observeResolver(ctx, &err, "DocumentationPage", r.operations.documentationPage, slowDocumentationPageRequestThreshold, observation.Args{
+	ctx, traceLog, endObservation := observeResolver(ctx, &err, "DocumentationPage", r.operations.documentationPage, r.options.slowRequestThreshold("DocumentationPage", slowDocumentationPageRequestThreshold), observation.Args{
 		LogFields: []log.Field{
 			log.Int("repositoryID", r.repositoryID),
 			log.String("commit", r.commit),
@@ -42,3 +45,81 @@ func (r *queryResolver) DocumentationPage(ctx context.Context, pathID string) (_
 
 	return nil, err
 }
+
+// Documentation returns the documentation nodes attached to the symbol at the given position. At
+// most one node (attached to the inner-most range enclosing the position) is returned for each
+// upload visible from the current target commit. Each node carries its own documentation, label,
+// detail, and children as they appear on the documentation page that contains it.
+func (r *queryResolver) Documentation(ctx context.Context, line, character int) (_ []*semantic.DocumentationNode, err error) {
+	ctx, traceLog, endObservation := observeResolver(ctx, &err, "Documentation", r.operations.documentation, r.options.slowRequestThreshold("Documentation", slowDocumentationRequestThreshold), observation.Args{
+		LogFields: []log.Field{
+			log.Int("repositoryID", r.repositoryID),
+			log.String("commit", r.commit),
+			log.String("path", r.path),
+			log.Int("numUploads", len(r.uploads)),
+			log.String("uploads", uploadIDsToString(r.uploads)),
+			log.Int("line", line),
+			log.Int("character", character),
+		},
+	})
+	defer endObservation()
+
+	adjustedUploads, err := r.adjustUploads(ctx, line, character)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*semantic.DocumentationNode, 0, len(adjustedUploads))
+	for i := range adjustedUploads {
+		traceLog(log.Int("uploadID", adjustedUploads[i].Upload.ID))
+
+		links, err := r.lsifStore.DocumentationAtPosition(
+			ctx,
+			adjustedUploads[i].Upload.ID,
+			adjustedUploads[i].AdjustedPathInBundle,
+			adjustedUploads[i].AdjustedPosition.Line,
+			adjustedUploads[i].AdjustedPosition.Character,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "lsifStore.DocumentationAtPosition")
+		}
+		if len(links) == 0 {
+			continue
+		}
+
+		// Links are ordered from the inner-most enclosing range outward
+		page, err := r.lsifStore.DocumentationPage(ctx, adjustedUploads[i].Upload.ID, links[0].PagePathID)
+		if err != nil {
+			return nil, errors.Wrap(err, "lsifStore.DocumentationPage")
+		}
+		if page == nil {
+			continue
+		}
+
+		if node := findDocumentationNode(page.Tree, links[0].PathID); node != nil {
+			nodes = append(nodes, node)
+		}
+	}
+	traceLog(log.Int("numNodes", len(nodes)))
+
+	return nodes, nil
+}
+
+// findDocumentationNode returns the node with the given path ID within the given tree. Children
+// that are themselves new pages are not part of the tree and are not searched.
+func findDocumentationNode(node *semantic.DocumentationNode, pathID string) *semantic.DocumentationNode {
+	if node == nil {
+		return nil
+	}
+	if node.PathID == pathID {
+		return node
+	}
+
+	for _, child := range node.Children {
+		if found := findDocumentationNode(child.Node, pathID); found != nil {
+			return found
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package resolvers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
+)
+
+func TestDocumentation(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockGitserverClient := NewMockGitserverClient()
+	mockPositionAdjuster := noopPositionAdjuster()
+
+	node := &semantic.DocumentationNode{
+		PathID:        "/pkg#Foo",
+		Documentation: protocol.Documentation{Slug: "#Foo", Tags: []protocol.DocumentationTag{protocol.DocumentationExported}},
+		Label:         protocol.NewMarkupContent("func Foo()", protocol.PlainText),
+	}
+	page := &semantic.DocumentationPageData{
+		Tree: &semantic.DocumentationNode{
+			PathID: "/pkg",
+			Children: []semantic.DocumentationNodeChild{
+				{PathID: "/pkg/sub"},
+				{Node: node},
+			},
+		},
+	}
+
+	mockLSIFStore.DocumentationAtPositionFunc.PushReturn(nil, nil)
+	mockLSIFStore.DocumentationAtPositionFunc.PushReturn([]lsifstore.DocumentationLink{
+		{PathID: "/pkg#Foo", PagePathID: "/pkg"},
+		{PathID: "/pkg", PagePathID: "/pkg"},
+	}, nil)
+	mockLSIFStore.DocumentationPageFunc.SetDefaultHook(func(ctx context.Context, bundleID int, pathID string) (*semantic.DocumentationPageData, error) {
+		if bundleID != 51 || pathID != "/pkg" {
+			t.Errorf("unexpected documentation page request: bundleID=%d pathID=%q", bundleID, pathID)
+		}
+		return page, nil
+	})
+
+	uploads := []dbstore.Dump{
+		{ID: 50, Commit: "deadbeef", Root: "sub1/"},
+		{ID: 51, Commit: "deadbeef", Root: "sub2/"},
+	}
+	resolver := newQueryResolver(
+		mockDBStore,
+		mockLSIFStore,
+		newCachedCommitChecker(mockGitserverClient),
+		mockPositionAdjuster,
+		nil,
+		42,
+		"deadbeef",
+		"s1/main.go",
+		uploads,
+		ResolverOptions{},
+		newOperations(&observation.TestContext),
+	)
+	nodes, err := resolver.Documentation(context.Background(), 10, 20)
+	if err != nil {
+		t.Fatalf("unexpected error querying documentation: %s", err)
+	}
+
+	expectedNodes := []*semantic.DocumentationNode{node}
+	if diff := cmp.Diff(expectedNodes, nodes); diff != "" {
+		t.Errorf("unexpected nodes (-want +got):\n%s", diff)
+	}
+}
@@ -7,15 +7,17 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/opentracing/opentracing-go/log"
 
+	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 )
 
 const slowHoverRequestThreshold = time.Second
 
-// Hover returns the hover text and range for the symbol at the given position.
-func (r *queryResolver) Hover(ctx context.Context, line, character int) (_ string, _ lsifstore.Range, _ bool, err error) {
-	ctx, traceLog, endObservation := observeResolver(ctx, &err, "Hover", r.operations.hover, slowHoverRequestThreshold, observation.Args{
+// Hover returns the hover text and range for the symbol at the given position. The hover text is
+// rendered in the given format.
+func (r *queryResolver) Hover(ctx context.Context, line, character int, format lsifstore.HoverFormat) (_ string, _ lsifstore.Range, _ bool, err error) {
+	ctx, traceLog, endObservation := observeResolver(ctx, &err, "Hover", r.operations.hover, r.options.slowRequestThreshold("Hover", slowHoverRequestThreshold), observation.Args{
 		LogFields: []log.Field{
 			log.Int("repositoryID", r.repositoryID),
 			log.String("commit", r.commit),
@@ -24,6 +26,7 @@ func (r *queryResolver) Hover(ctx context.Context, line, character int) (_ strin
 			log.String("uploads", uploadIDsToString(r.uploads)),
 			log.Int("line", line),
 			log.Int("character", character),
+			log.String("format", string(format)),
 		},
 	})
 	defer endObservation()
@@ -41,38 +44,44 @@ func (r *queryResolver) Hover(ctx context.Context, line, character int) (_ strin
 	// as a hint to highlight a range in the current document.
 	adjustedRanges := make([]lsifstore.Range, 0, len(adjustedUploads))
 
-	for i := range adjustedUploads {
-		adjustedUpload := adjustedUploads[i]
-		traceLog(log.Int("uploadID", adjustedUpload.Upload.ID))
-
-		// Fetch hover text from the index
-		text, rn, exists, err := r.lsifStore.Hover(
-			ctx,
-			adjustedUpload.Upload.ID,
-			adjustedUpload.AdjustedPathInBundle,
-			adjustedUpload.AdjustedPosition.Line,
-			adjustedUpload.AdjustedPosition.Character,
-		)
-		if err != nil {
-			return "", lsifstore.Range{}, false, errors.Wrap(err, "lsifStore.Hover")
-		}
-		if !exists {
+	// Collect the hover text attached to the source range in each upload. Unless the merge
+	// strategy needs to inspect every upload, we stop at the first upload with text.
+	sections := make([]hoverSection, 0, len(adjustedUploads))
+
+	hoverResults, err := r.lsifStore.BatchHover(ctx, positionKeys(adjustedUploads), format)
+	if err != nil {
+		return "", lsifstore.Range{}, false, errors.Wrap(err, "lsifStore.BatchHover")
+	}
+
+	for i, hoverResult := range hoverResults {
+		if !hoverResult.Exists {
 			continue
 		}
+		traceLog(log.Int("uploadID", adjustedUploads[i].Upload.ID))
 
 		// Adjust the highlighted range back to the appropriate range in the target commit
-		_, adjustedRange, _, err := r.adjustRange(ctx, r.uploads[i].RepositoryID, r.uploads[i].Commit, r.path, rn)
+		_, adjustedRange, _, err := r.adjustRange(ctx, adjustedUploads[i].Upload.RepositoryID, adjustedUploads[i].Upload.Commit, r.path, hoverResult.Range)
 		if err != nil {
 			return "", lsifstore.Range{}, false, err
 		}
-		if text != "" {
+		if hoverResult.Text != "" {
 			// Text attached to source range
-			return text, adjustedRange, true, nil
+			sections = append(sections, hoverSection{Text: hoverResult.Text, Range: adjustedRange, Dump: adjustedUploads[i].Upload})
+
+			if r.options.HoverMergeStrategy == HoverMergeFirst {
+				break
+			}
+			continue
 		}
 
 		adjustedRanges = append(adjustedRanges, adjustedRange)
 	}
 
+	if text, adjustedRange, ok := mergeHoverSections(r.options.HoverMergeStrategy, format, sections); ok {
+		traceLog(log.Int("numSections", len(sections)))
+		return text, adjustedRange, true, nil
+	}
+
 	// The Slow path:
 	//
 	// The indexes we searched in doesn't attach hover text to externally defined symbols.
@@ -118,24 +127,43 @@ func (r *queryResolver) Hover(ctx context.Context, line, character int) (_ strin
 	}
 	traceLog(log.Int("numLocations", len(locations)))
 
+	definitionKeys := make([]lsifstore.PositionKey, 0, len(locations))
 	for i := range locations {
-		// Fetch hover text attached to a definition in the defining index
-		text, _, exists, err := r.lsifStore.Hover(
-			ctx,
-			locations[i].DumpID,
-			locations[i].Path,
-			locations[i].Range.Start.Line,
-			locations[i].Range.Start.Character,
-		)
-		if err != nil {
-			return "", lsifstore.Range{}, false, errors.Wrap(err, "lsifStore.Hover")
-		}
-		if exists && text != "" {
+		definitionKeys = append(definitionKeys, lsifstore.PositionKey{
+			DocumentKey: lsifstore.DocumentKey{DumpID: locations[i].DumpID, Path: locations[i].Path},
+			Line:        locations[i].Range.Start.Line,
+			Character:   locations[i].Range.Start.Character,
+		})
+	}
+
+	// Fetch hover text attached to a definition in the defining index
+	definitionHoverResults, err := r.lsifStore.BatchHover(ctx, definitionKeys, format)
+	if err != nil {
+		return "", lsifstore.Range{}, false, errors.Wrap(err, "lsifStore.BatchHover")
+	}
+
+	uploadsByID := make(map[int]store.Dump, len(uploads))
+	for i := range uploads {
+		uploadsByID[uploads[i].ID] = uploads[i]
+	}
+
+	for i, hoverResult := range definitionHoverResults {
+		if hoverResult.Exists && hoverResult.Text != "" {
 			// Text attached to definition
-			return text, adjustedRange, true, nil
+			sections = append(sections, hoverSection{Text: hoverResult.Text, Range: hoverResult.Range, Dump: uploadsByID[locations[i].DumpID]})
+
+			if r.options.HoverMergeStrategy == HoverMergeFirst {
+				break
+			}
 		}
 	}
 
+	if text, _, ok := mergeHoverSections(r.options.HoverMergeStrategy, format, sections); ok {
+		// The ranges of the definition sections are relative to the definition's document
+		traceLog(log.Int("numSections", len(sections)))
+		return text, adjustedRange, true, nil
+	}
+
 	// No text available
 	return "", lsifstore.Range{}, false, nil
 }
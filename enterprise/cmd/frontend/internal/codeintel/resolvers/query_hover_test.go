@@ -22,8 +22,12 @@ func TestHover(t *testing.T) {
 		Start: lsifstore.Position{Line: 10, Character: 10},
 		End:   lsifstore.Position{Line: 15, Character: 25},
 	}
-	mockLSIFStore.HoverFunc.PushReturn("", lsifstore.Range{}, false, nil)
-	mockLSIFStore.HoverFunc.PushReturn("doctext", expectedRange, true, nil)
+	mockLSIFStore.BatchHoverFunc.PushReturn([]lsifstore.HoverResult{
+		{},
+		{Text: "doctext", Range: expectedRange, Exists: true},
+		{},
+		{},
+	}, nil)
 
 	uploads := []dbstore.Dump{
 		{ID: 50, Commit: "deadbeef", Root: "sub1/"},
@@ -36,13 +40,15 @@ func TestHover(t *testing.T) {
 		mockLSIFStore,
 		newCachedCommitChecker(mockGitserverClient),
 		mockPositionAdjuster,
+		nil,
 		42,
 		"deadbeef",
 		"s1/main.go",
 		uploads,
+		ResolverOptions{},
 		newOperations(&observation.TestContext),
 	)
-	text, rn, exists, err := resolver.Hover(context.Background(), 10, 20)
+	text, rn, exists, err := resolver.Hover(context.Background(), 10, 20, lsifstore.HoverFormatMarkdown)
 	if err != nil {
 		t.Fatalf("unexpected error querying hover: %s", err)
 	}
@@ -68,21 +74,22 @@ func TestHoverRemote(t *testing.T) {
 		Start: lsifstore.Position{Line: 10, Character: 10},
 		End:   lsifstore.Position{Line: 15, Character: 25},
 	}
-	mockLSIFStore.HoverFunc.PushReturn("", expectedRange, true, nil)
+	mockLSIFStore.BatchHoverFunc.PushReturn([]lsifstore.HoverResult{{Range: expectedRange, Exists: true}}, nil)
 
 	remoteRange := lsifstore.Range{
 		Start: lsifstore.Position{Line: 30, Character: 30},
 		End:   lsifstore.Position{Line: 35, Character: 45},
 	}
-	mockLSIFStore.HoverFunc.PushReturn("doctext", remoteRange, true, nil)
+	mockLSIFStore.BatchHoverFunc.PushReturn([]lsifstore.HoverResult{{Text: "doctext", Range: remoteRange, Exists: true}}, nil)
 
 	remoteUploads := []dbstore.Dump{
 		{ID: 150, Commit: "deadbeef1", Root: "sub1/"},
 		{ID: 151, Commit: "deadbeef2", Root: "sub2/"},
-		{ID: 152, Commit: "deadbeef3", Root: "sub3/"},
-		{ID: 153, Commit: "deadbeef4", Root: "sub4/"},
 	}
-	mockDBStore.DefinitionDumpsFunc.PushReturn(remoteUploads, nil)
+	mockDBStore.GetPackagesFunc.PushReturn(map[dbstore.PackageKey]dbstore.Dump{
+		{Scheme: "tsc", Name: "leftpad", Version: "0.1.0"}: remoteUploads[0],
+		{Scheme: "tsc", Name: "leftpad", Version: "0.2.0"}: remoteUploads[1],
+	}, nil)
 
 	monikers := []semantic.MonikerData{
 		{Kind: "import", Scheme: "tsc", Identifier: "padLeft", PackageInformationID: "51"},
@@ -118,13 +125,15 @@ func TestHoverRemote(t *testing.T) {
 		mockLSIFStore,
 		newCachedCommitChecker(mockGitserverClient),
 		mockPositionAdjuster,
+		nil,
 		42,
 		"deadbeef",
 		"s1/main.go",
 		uploads,
+		ResolverOptions{},
 		newOperations(&observation.TestContext),
 	)
-	text, rn, exists, err := resolver.Hover(context.Background(), 10, 20)
+	text, rn, exists, err := resolver.Hover(context.Background(), 10, 20, lsifstore.HoverFormatMarkdown)
 	if err != nil {
 		t.Fatalf("unexpected error querying hover: %s", err)
 	}
@@ -139,3 +148,81 @@ func TestHoverRemote(t *testing.T) {
 		t.Errorf("unexpected range (-want +got):\n%s", diff)
 	}
 }
+
+func TestHoverMergeConcatenate(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockGitserverClient := NewMockGitserverClient()
+	mockPositionAdjuster := noopPositionAdjuster()
+
+	outerRange := lsifstore.Range{
+		Start: lsifstore.Position{Line: 10, Character: 10},
+		End:   lsifstore.Position{Line: 15, Character: 25},
+	}
+	innerRange := lsifstore.Range{
+		Start: lsifstore.Position{Line: 10, Character: 15},
+		End:   lsifstore.Position{Line: 10, Character: 20},
+	}
+	mockLSIFStore.BatchHoverFunc.SetDefaultReturn([]lsifstore.HoverResult{
+		{Text: "doctext", Range: outerRange, Exists: true},
+		{},
+		{Text: "gotext", Range: innerRange, Exists: true},
+		{Text: "doctext", Range: outerRange, Exists: true},
+	}, nil)
+
+	uploads := []dbstore.Dump{
+		{ID: 50, Commit: "deadbeef", Root: "sub1/", Indexer: "lsif-docs"},
+		{ID: 51, Commit: "deadbeef", Root: "sub2/", Indexer: "lsif-go"},
+		{ID: 52, Commit: "deadbeef", Root: "", Indexer: "lsif-go"},
+		{ID: 53, Commit: "deadbeef", Root: "sub4/", Indexer: "lsif-docs"},
+	}
+
+	testCases := []struct {
+		strategy      HoverMergeStrategy
+		format        lsifstore.HoverFormat
+		expectedText  string
+		expectedRange lsifstore.Range
+	}{
+		{HoverMergeFirst, lsifstore.HoverFormatMarkdown, "doctext", outerRange},
+		{HoverMergeConcatenate, lsifstore.HoverFormatMarkdown, "_lsif-docs (sub1/)_\n\ndoctext\n\n---\n\n_lsif-go (/)_\n\ngotext", outerRange},
+		{HoverMergeConcatenate, lsifstore.HoverFormatPlaintext, "lsif-docs (sub1/)\n\ndoctext\n\nlsif-go (/)\n\ngotext", outerRange},
+		{HoverMergePrecise, lsifstore.HoverFormatMarkdown, "gotext", innerRange},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(string(testCase.strategy)+"/"+string(testCase.format), func(t *testing.T) {
+			resolver := newQueryResolver(
+				mockDBStore,
+				mockLSIFStore,
+				newCachedCommitChecker(mockGitserverClient),
+				mockPositionAdjuster,
+				nil,
+				42,
+				"deadbeef",
+				"s1/main.go",
+				uploads,
+				ResolverOptions{HoverMergeStrategy: testCase.strategy},
+				newOperations(&observation.TestContext),
+			)
+			text, rn, exists, err := resolver.Hover(context.Background(), 10, 20, testCase.format)
+			if err != nil {
+				t.Fatalf("unexpected error querying hover: %s", err)
+			}
+			if !exists {
+				t.Fatalf("expected hover to exist")
+			}
+
+			history := mockLSIFStore.BatchHoverFunc.History()
+			if format := history[len(history)-1].Arg2; format != testCase.format {
+				t.Errorf("unexpected hover format. want=%q have=%q", testCase.format, format)
+			}
+
+			if text != testCase.expectedText {
+				t.Errorf("unexpected text. want=%q have=%q", testCase.expectedText, text)
+			}
+			if diff := cmp.Diff(testCase.expectedRange, rn); diff != "" {
+				t.Errorf("unexpected range (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+const slowImplementationsRequestThreshold = time.Second
+
+// Implementations returns the list of source locations that implement the symbol at the given position.
+//
+// Implementations are first gathered from the indexes visible from the target commit via an LSIF graph
+// traversal. Once these are exhausted, we resolve the definition of the symbol via its import monikers
+// and gather the implementations from the index that defines the symbol. This allows us to find the
+// implementations of an interface that is defined in another repository.
+func (r *queryResolver) Implementations(ctx context.Context, line, character, limit int, rawCursor string) (_ []AdjustedLocation, _ string, err error) {
+	ctx, traceLog, endObservation := observeResolver(ctx, &err, "Implementations", r.operations.implementations, r.options.slowRequestThreshold("Implementations", slowImplementationsRequestThreshold), observation.Args{
+		LogFields: []log.Field{
+			log.Int("repositoryID", r.repositoryID),
+			log.String("commit", r.commit),
+			log.String("path", r.path),
+			log.Int("numUploads", len(r.uploads)),
+			log.String("uploads", uploadIDsToString(r.uploads)),
+			log.Int("line", line),
+			log.Int("character", character),
+		},
+	})
+	defer endObservation()
+
+	// Decode cursor given from previous response or create a new one with default values.
+	// This cursor will be modified in-place to become the cursor used to fetch the subsequent
+	// page of results in this result set.
+	cursor, err := decodeImplementationsCursor(rawCursor)
+	if err != nil {
+		return nil, "", errors.Wrap(err, fmt.Sprintf("invalid cursor: %q", rawCursor))
+	}
+
+	uploadsByID := make(map[int]dbstore.Dump, len(r.uploads))
+	for i := range r.uploads {
+		uploadsByID[r.uploads[i].ID] = r.uploads[i]
+	}
+
+	// Adjust the path and position for each visible upload based on its git difference to
+	// the target commit.
+
+	adjustedUploads, err := r.adjustUploads(ctx, line, character)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var locations []lsifstore.Location
+	hasMore := true
+
+	if !cursor.RemotePhase {
+		localLocations, exhausted, err := r.pageImplementations(ctx, positionKeys(adjustedUploads), &cursor, limit)
+		if err != nil {
+			return nil, "", err
+		}
+		traceLog(log.Int("numLocalLocations", len(localLocations)))
+
+		locations = append(locations, localLocations...)
+
+		if exhausted {
+			cursor = implementationsCursor{RemotePhase: true}
+		}
+	}
+
+	if cursor.RemotePhase && len(locations) < limit {
+		definitionKeys, definitionUploads, err := r.remoteImplementationKeys(ctx, adjustedUploads)
+		if err != nil {
+			return nil, "", err
+		}
+		traceLog(log.Int("numDefinitionUploads", len(definitionUploads)))
+
+		for i := range definitionUploads {
+			uploadsByID[definitionUploads[i].ID] = definitionUploads[i]
+		}
+
+		remoteLocations, exhausted, err := r.pageImplementations(ctx, definitionKeys, &cursor, limit-len(locations))
+		if err != nil {
+			return nil, "", err
+		}
+		traceLog(log.Int("numRemoteLocations", len(remoteLocations)))
+
+		locations = append(locations, remoteLocations...)
+
+		hasMore = !exhausted
+	}
+
+	// Adjust the locations back to the appropriate range in the target commits. This adjusts
+	// locations within the repository the user is browsing so that it appears all implementations
+	// are occurring at the same commit they are looking at.
+
+	adjustedLocations, err := r.adjustLocations(ctx, uploadsByID, locations)
+	if err != nil {
+		return nil, "", err
+	}
+	traceLog(log.Int("numAdjustedLocations", len(adjustedLocations)))
+
+	nextCursor := ""
+	if hasMore {
+		nextCursor = encodeImplementationsCursor(cursor)
+	}
+
+	return adjustedLocations, nextCursor, nil
+}
+
+// pageImplementations returns a page of implementation locations for the symbols at the given positions.
+// The given cursor is advanced in-place past the returned locations. If the result sets of all positions
+// have been consumed, a true-valued flag is returned.
+func (r *queryResolver) pageImplementations(ctx context.Context, keys []lsifstore.PositionKey, cursor *implementationsCursor, limit int) ([]lsifstore.Location, bool, error) {
+	var locations []lsifstore.Location
+	for cursor.KeyOffset < len(keys) && len(locations) < limit {
+		key := keys[cursor.KeyOffset]
+
+		page, totalCount, err := r.lsifStore.Implementations(
+			ctx,
+			key.DumpID,
+			key.Path,
+			key.Line,
+			key.Character,
+			limit-len(locations),
+			cursor.Offset,
+		)
+		if err != nil {
+			return nil, false, errors.Wrap(err, "lsifStore.Implementations")
+		}
+		locations = append(locations, page...)
+
+		if cursor.Offset += len(page); len(page) == 0 || cursor.Offset >= totalCount {
+			// Move on to the next position
+			cursor.KeyOffset++
+			cursor.Offset = 0
+		}
+	}
+
+	return locations, cursor.KeyOffset >= len(keys), nil
+}
+
+// remoteImplementationKeys returns the positions of the definitions of the symbol at the given position
+// within each adjusted upload. Definitions are resolved via import monikers, and definitions occurring in
+// one of the given adjusted uploads are omitted as those were already traversed in the local phase. The
+// upload records for each returned position are also returned.
+func (r *queryResolver) remoteImplementationKeys(ctx context.Context, adjustedUploads []adjustedUpload) ([]lsifstore.PositionKey, []dbstore.Dump, error) {
+	orderedMonikers, err := r.orderedMonikers(ctx, adjustedUploads, "import")
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(orderedMonikers) == 0 {
+		return nil, nil, nil
+	}
+
+	candidateUploads, err := r.definitionUploads(ctx, orderedMonikers)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	uploads := make([]dbstore.Dump, 0, len(candidateUploads))
+outer:
+	for i := range candidateUploads {
+		for j := range adjustedUploads {
+			if candidateUploads[i].ID == adjustedUploads[j].Upload.ID {
+				continue outer
+			}
+		}
+
+		uploads = append(uploads, candidateUploads[i])
+	}
+	if len(uploads) == 0 {
+		return nil, nil, nil
+	}
+
+	locations, _, err := r.monikerLocations(ctx, uploads, orderedMonikers, "definitions", DefinitionsLimit, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys := make([]lsifstore.PositionKey, 0, len(locations))
+	for i := range locations {
+		keys = append(keys, lsifstore.PositionKey{
+			DocumentKey: lsifstore.DocumentKey{DumpID: locations[i].DumpID, Path: locations[i].Path},
+			Line:        locations[i].Range.Start.Line,
+			Character:   locations[i].Range.Start.Character,
+		})
+	}
+
+	return keys, uploads, nil
+}
@@ -0,0 +1,37 @@
+package resolvers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+)
+
+// implementationsCursor stores the state of a previous Implementations request used to calculate
+// the offset into the result set to be returned by the current request.
+type implementationsCursor struct {
+	RemotePhase bool `json:"remotePhase"`
+	KeyOffset   int  `json:"keyOffset"`
+	Offset      int  `json:"offset"`
+}
+
+// decodeImplementationsCursor is the inverse of encodeImplementationsCursor. If the given encoded
+// string is empty, then a fresh cursor is returned.
+func decodeImplementationsCursor(rawEncoded string) (implementationsCursor, error) {
+	if rawEncoded == "" {
+		return implementationsCursor{}, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(rawEncoded)
+	if err != nil {
+		return implementationsCursor{}, err
+	}
+
+	var cursor implementationsCursor
+	err = json.Unmarshal(raw, &cursor)
+	return cursor, err
+}
+
+// encodeImplementationsCursor returns an encoding of the given cursor suitable for a URL or a GraphQL token.
+func encodeImplementationsCursor(cursor implementationsCursor) string {
+	rawEncoded, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(rawEncoded)
+}
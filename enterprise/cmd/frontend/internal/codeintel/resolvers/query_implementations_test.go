@@ -0,0 +1,140 @@
+package resolvers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
+)
+
+func TestImplementations(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockGitserverClient := NewMockGitserverClient()
+	mockPositionAdjuster := noopPositionAdjuster()
+
+	locations := []lsifstore.Location{
+		{DumpID: 50, Path: "a.go", Range: testRange1},
+		{DumpID: 50, Path: "b.go", Range: testRange2},
+		{DumpID: 51, Path: "c.go", Range: testRange3},
+	}
+	mockLSIFStore.ImplementationsFunc.PushReturn(locations[:2], 2, nil)
+	mockLSIFStore.ImplementationsFunc.PushReturn(locations[2:], 1, nil)
+
+	uploads := []dbstore.Dump{
+		{ID: 50, Commit: "deadbeef", Root: "sub1/"},
+		{ID: 51, Commit: "deadbeef", Root: "sub2/"},
+	}
+	resolver := newQueryResolver(
+		mockDBStore,
+		mockLSIFStore,
+		newCachedCommitChecker(mockGitserverClient),
+		mockPositionAdjuster,
+		nil,
+		42,
+		"deadbeef",
+		"s1/main.go",
+		uploads,
+		ResolverOptions{},
+		newOperations(&observation.TestContext),
+	)
+	adjustedLocations, cursor, err := resolver.Implementations(context.Background(), 10, 20, 3, "")
+	if err != nil {
+		t.Fatalf("unexpected error querying implementations: %s", err)
+	}
+
+	expectedLocations := []AdjustedLocation{
+		{Dump: uploads[0], Path: "sub1/a.go", AdjustedCommit: "deadbeef", AdjustedRange: testRange1},
+		{Dump: uploads[0], Path: "sub1/b.go", AdjustedCommit: "deadbeef", AdjustedRange: testRange2},
+		{Dump: uploads[1], Path: "sub2/c.go", AdjustedCommit: "deadbeef", AdjustedRange: testRange3},
+	}
+	if diff := cmp.Diff(expectedLocations, adjustedLocations); diff != "" {
+		t.Errorf("unexpected locations (-want +got):\n%s", diff)
+	}
+
+	// The local phase is exhausted, but the page is full; the next page starts the remote phase
+	if decoded, err := decodeImplementationsCursor(cursor); err != nil {
+		t.Fatalf("unexpected error decoding cursor: %s", err)
+	} else if diff := cmp.Diff(implementationsCursor{RemotePhase: true}, decoded); diff != "" {
+		t.Errorf("unexpected cursor (-want +got):\n%s", diff)
+	}
+}
+
+func TestImplementationsRemote(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockGitserverClient := NewMockGitserverClient()
+	mockPositionAdjuster := noopPositionAdjuster()
+
+	remoteUploads := []dbstore.Dump{
+		{ID: 50, Commit: "deadbeef", Root: "sub1/"},
+		{ID: 151, Commit: "deadbeef2", Root: "sub2/"},
+	}
+	mockDBStore.GetPackagesFunc.PushReturn(map[dbstore.PackageKey]dbstore.Dump{
+		{Scheme: "gomod", Name: "pkg", Version: "0.1.0"}: remoteUploads[0],
+		{Scheme: "gomod", Name: "pkg", Version: "0.2.0"}: remoteUploads[1],
+	}, nil)
+	mockGitserverClient.CommitExistsFunc.SetDefaultReturn(true, nil)
+
+	moniker1 := semantic.MonikerData{Kind: "import", Scheme: "gomod", Identifier: "pkg.Iface", PackageInformationID: "51"}
+	moniker2 := semantic.MonikerData{Kind: "import", Scheme: "gomod", Identifier: "pkg.Iface", PackageInformationID: "52"}
+	mockLSIFStore.MonikersByPositionFunc.PushReturn([][]semantic.MonikerData{{moniker1, moniker2}}, nil)
+	mockLSIFStore.PackageInformationFunc.PushReturn(semantic.PackageInformationData{Name: "pkg", Version: "0.1.0"}, true, nil)
+	mockLSIFStore.PackageInformationFunc.PushReturn(semantic.PackageInformationData{Name: "pkg", Version: "0.2.0"}, true, nil)
+
+	definition := lsifstore.Location{DumpID: 151, Path: "iface.go", Range: testRange1}
+	mockLSIFStore.BulkMonikerResultsFunc.PushReturn([]lsifstore.Location{definition}, 1, nil)
+
+	implementation := lsifstore.Location{DumpID: 151, Path: "impl.go", Range: testRange2}
+	mockLSIFStore.ImplementationsFunc.PushReturn(nil, 0, nil)
+	mockLSIFStore.ImplementationsFunc.PushReturn([]lsifstore.Location{implementation}, 1, nil)
+
+	uploads := []dbstore.Dump{
+		{ID: 50, Commit: "deadbeef", Root: "sub1/"},
+	}
+	resolver := newQueryResolver(
+		mockDBStore,
+		mockLSIFStore,
+		newCachedCommitChecker(mockGitserverClient),
+		mockPositionAdjuster,
+		nil,
+		42,
+		"deadbeef",
+		"s1/main.go",
+		uploads,
+		ResolverOptions{},
+		newOperations(&observation.TestContext),
+	)
+	adjustedLocations, cursor, err := resolver.Implementations(context.Background(), 10, 20, 50, "")
+	if err != nil {
+		t.Fatalf("unexpected error querying implementations: %s", err)
+	}
+
+	expectedLocations := []AdjustedLocation{
+		{Dump: remoteUploads[1], Path: "sub2/impl.go", AdjustedCommit: "deadbeef2", AdjustedRange: testRange2},
+	}
+	if diff := cmp.Diff(expectedLocations, adjustedLocations); diff != "" {
+		t.Errorf("unexpected locations (-want +got):\n%s", diff)
+	}
+	if cursor != "" {
+		t.Errorf("unexpected cursor. want=%q have=%q", "", cursor)
+	}
+
+	// Upload 50 is visible from the target commit and must not be searched a second time
+	if history := mockLSIFStore.BulkMonikerResultsFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected number of moniker searches. want=%d have=%d", 1, len(history))
+	} else if diff := cmp.Diff([]int{151}, history[0].Arg2); diff != "" {
+		t.Errorf("unexpected upload ids (-want +got):\n%s", diff)
+	}
+
+	if history := mockLSIFStore.ImplementationsFunc.History(); len(history) != 2 {
+		t.Fatalf("unexpected number of implementation queries. want=%d have=%d", 2, len(history))
+	} else if history[1].Arg1 != 151 || history[1].Arg2 != "iface.go" {
+		t.Errorf("unexpected remote implementation query. want=%d:%s have=%d:%s", 151, "iface.go", history[1].Arg1, history[1].Arg2)
+	}
+}
@@ -2,6 +2,7 @@ package resolvers
 
 import (
 	"context"
+	"math"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -14,11 +15,29 @@ import (
 
 const slowRangesRequestThreshold = time.Second
 
+// slowRangesAllRequestThreshold is higher than the threshold for windowed requests as the entire
+// document is read and adjusted at once.
+const slowRangesAllRequestThreshold = 5 * time.Second
+
 // Ranges returns code intelligence for the ranges that fall within the given range of lines. These
 // results are partial and do not include references outside the current file, or any location that
 // requires cross-linking of bundles (cross-repo or cross-root).
 func (r *queryResolver) Ranges(ctx context.Context, startLine, endLine int) (adjustedRanges []AdjustedCodeIntelligenceRange, err error) {
-	ctx, traceLog, endObservation := observeResolver(ctx, &err, "Ranges", r.operations.ranges, slowRangesRequestThreshold, observation.Args{
+	return r.ranges(ctx, "Ranges", r.operations.ranges, slowRangesRequestThreshold, startLine, endLine)
+}
+
+// RangesAll returns code intelligence for every range of the document. This allows a client to
+// fetch (and cache) the data for an entire document in a single request rather than requesting a
+// new window of lines as the document is scrolled. These results are partial in the same way as
+// the results of Ranges.
+func (r *queryResolver) RangesAll(ctx context.Context) (adjustedRanges []AdjustedCodeIntelligenceRange, err error) {
+	return r.ranges(ctx, "RangesAll", r.operations.rangesAll, slowRangesAllRequestThreshold, 0, math.MaxInt32)
+}
+
+// ranges returns code intelligence for the ranges that fall within the given range of lines. The
+// given name, operation, and threshold are used to observe the request.
+func (r *queryResolver) ranges(ctx context.Context, name string, operation *observation.Operation, threshold time.Duration, startLine, endLine int) (adjustedRanges []AdjustedCodeIntelligenceRange, err error) {
+	ctx, traceLog, endObservation := observeResolver(ctx, &err, name, operation, r.options.slowRequestThreshold(name, threshold), observation.Args{
 		LogFields: []log.Field{
 			log.Int("repositoryID", r.repositoryID),
 			log.String("commit", r.commit),
@@ -36,19 +55,18 @@ func (r *queryResolver) Ranges(ctx context.Context, startLine, endLine int) (adj
 		return nil, err
 	}
 
-	for i := range adjustedUploads {
-		traceLog(log.Int("uploadID", adjustedUploads[i].Upload.ID))
+	rangesByUpload, err := r.lsifStore.BatchRanges(
+		ctx,
+		documentKeys(adjustedUploads),
+		startLine, // TODO - adjust these as well
+		endLine,   // TODO - adjust these as well
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "lsifStore.BatchRanges")
+	}
 
-		ranges, err := r.lsifStore.Ranges(
-			ctx,
-			adjustedUploads[i].Upload.ID,
-			adjustedUploads[i].AdjustedPathInBundle,
-			startLine, // TODO - adjust these as well
-			endLine,   // TODO - adjust these as well
-		)
-		if err != nil {
-			return nil, errors.Wrap(err, "lsifStore.Ranges")
-		}
+	for i, ranges := range rangesByUpload {
+		traceLog(log.Int("uploadID", adjustedUploads[i].Upload.ID))
 
 		for _, rn := range ranges {
 			adjustedRange, ok, err := r.adjustCodeIntelligenceRange(ctx, adjustedUploads[i], rn)
@@ -91,6 +109,7 @@ func (r *queryResolver) adjustCodeIntelligenceRange(ctx context.Context, upload
 	}
 
 	return AdjustedCodeIntelligenceRange{
+		Dump:        upload.Upload,
 		Range:       adjustedRange,
 		Definitions: adjustedDefinitions,
 		References:  adjustedReferences,
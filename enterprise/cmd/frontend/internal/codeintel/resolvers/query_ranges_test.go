@@ -2,6 +2,7 @@ package resolvers
 
 import (
 	"context"
+	"math"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -34,9 +35,7 @@ func TestRanges(t *testing.T) {
 		{Range: testRange5, HoverText: "text5", Definitions: []lsifstore.Location{testLocation8}, References: nil},
 	}
 
-	mockLSIFStore.RangesFunc.PushReturn(ranges[0:1], nil)
-	mockLSIFStore.RangesFunc.PushReturn(ranges[1:4], nil)
-	mockLSIFStore.RangesFunc.PushReturn(ranges[4:], nil)
+	mockLSIFStore.BatchRangesFunc.PushReturn([][]lsifstore.CodeIntelligenceRange{ranges[0:1], ranges[1:4], ranges[4:], nil}, nil)
 
 	uploads := []dbstore.Dump{
 		{ID: 50, Commit: "deadbeef", Root: "sub1/"},
@@ -49,10 +48,12 @@ func TestRanges(t *testing.T) {
 		mockLSIFStore,
 		newCachedCommitChecker(mockGitserverClient),
 		mockPositionAdjuster,
+		nil,
 		42,
 		"deadbeef",
 		"s1/main.go",
 		uploads,
+		ResolverOptions{},
 		newOperations(&observation.TestContext),
 	)
 	adjustedRanges, err := resolver.Ranges(context.Background(), 10, 20)
@@ -70,13 +71,61 @@ func TestRanges(t *testing.T) {
 	adjustedLocation8 := AdjustedLocation{Dump: uploads[2], Path: "sub3/a.go", AdjustedCommit: "deadbeef", AdjustedRange: testRange4}
 
 	expectedRanges := []AdjustedCodeIntelligenceRange{
-		{Range: testRange1, HoverText: "text1", Definitions: []AdjustedLocation{}, References: []AdjustedLocation{adjustedLocation1}},
-		{Range: testRange2, HoverText: "text2", Definitions: []AdjustedLocation{adjustedLocation2}, References: []AdjustedLocation{adjustedLocation3}},
-		{Range: testRange3, HoverText: "text3", Definitions: []AdjustedLocation{adjustedLocation4}, References: []AdjustedLocation{adjustedLocation5}},
-		{Range: testRange4, HoverText: "text4", Definitions: []AdjustedLocation{adjustedLocation6}, References: []AdjustedLocation{adjustedLocation7}},
-		{Range: testRange5, HoverText: "text5", Definitions: []AdjustedLocation{adjustedLocation8}, References: []AdjustedLocation{}},
+		{Dump: uploads[0], Range: testRange1, HoverText: "text1", Definitions: []AdjustedLocation{}, References: []AdjustedLocation{adjustedLocation1}},
+		{Dump: uploads[1], Range: testRange2, HoverText: "text2", Definitions: []AdjustedLocation{adjustedLocation2}, References: []AdjustedLocation{adjustedLocation3}},
+		{Dump: uploads[1], Range: testRange3, HoverText: "text3", Definitions: []AdjustedLocation{adjustedLocation4}, References: []AdjustedLocation{adjustedLocation5}},
+		{Dump: uploads[1], Range: testRange4, HoverText: "text4", Definitions: []AdjustedLocation{adjustedLocation6}, References: []AdjustedLocation{adjustedLocation7}},
+		{Dump: uploads[2], Range: testRange5, HoverText: "text5", Definitions: []AdjustedLocation{adjustedLocation8}, References: []AdjustedLocation{}},
 	}
 	if diff := cmp.Diff(expectedRanges, adjustedRanges); diff != "" {
 		t.Errorf("unexpected ranges (-want +got):\n%s", diff)
 	}
 }
+
+func TestRangesAll(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockGitserverClient := NewMockGitserverClient()
+	mockPositionAdjuster := noopPositionAdjuster()
+
+	ranges := []lsifstore.CodeIntelligenceRange{
+		{Range: testRange1, HoverText: "text1"},
+		{Range: testRange2, HoverText: "text2"},
+	}
+	mockLSIFStore.BatchRangesFunc.PushReturn([][]lsifstore.CodeIntelligenceRange{ranges}, nil)
+
+	uploads := []dbstore.Dump{
+		{ID: 50, Commit: "deadbeef", Root: "sub1/"},
+	}
+	resolver := newQueryResolver(
+		mockDBStore,
+		mockLSIFStore,
+		newCachedCommitChecker(mockGitserverClient),
+		mockPositionAdjuster,
+		nil,
+		42,
+		"deadbeef",
+		"s1/main.go",
+		uploads,
+		ResolverOptions{},
+		newOperations(&observation.TestContext),
+	)
+	adjustedRanges, err := resolver.RangesAll(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error querying ranges: %s", err)
+	}
+
+	expectedRanges := []AdjustedCodeIntelligenceRange{
+		{Dump: uploads[0], Range: testRange1, HoverText: "text1", Definitions: []AdjustedLocation{}, References: []AdjustedLocation{}},
+		{Dump: uploads[0], Range: testRange2, HoverText: "text2", Definitions: []AdjustedLocation{}, References: []AdjustedLocation{}},
+	}
+	if diff := cmp.Diff(expectedRanges, adjustedRanges); diff != "" {
+		t.Errorf("unexpected ranges (-want +got):\n%s", diff)
+	}
+
+	if history := mockLSIFStore.BatchRangesFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected call count for lsifstore.BatchRanges. want=%d have=%d", 1, len(history))
+	} else if history[0].Arg2 != 0 || history[0].Arg3 != math.MaxInt32 {
+		t.Errorf("unexpected line window. want=[%d, %d) have=[%d, %d)", 0, math.MaxInt32, history[0].Arg2, history[0].Arg3)
+	}
+}
@@ -0,0 +1,125 @@
+package resolvers
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+const slowReferenceCountRequestThreshold = time.Second
+
+// ReferenceCount returns the number of source locations that reference the symbol at the given position.
+// Locations are counted within each visible upload and within each remote upload that defines or imports
+// a moniker attached to the symbol, without reading the locations themselves. Counting stops once the
+// configured limit is reached, in which case the limit is returned along with a true-valued flag.
+//
+// Unlike References, the returned count includes the moniker matches enclosing the source position and
+// should therefore be treated as an upper bound on the size of the references result set.
+func (r *queryResolver) ReferenceCount(ctx context.Context, line, character int) (_ int, _ bool, err error) {
+	ctx, traceLog, endObservation := observeResolver(ctx, &err, "ReferenceCount", r.operations.referenceCount, r.options.slowRequestThreshold("ReferenceCount", slowReferenceCountRequestThreshold), observation.Args{
+		LogFields: []log.Field{
+			log.Int("repositoryID", r.repositoryID),
+			log.String("commit", r.commit),
+			log.String("path", r.path),
+			log.Int("numUploads", len(r.uploads)),
+			log.String("uploads", uploadIDsToString(r.uploads)),
+			log.Int("line", line),
+			log.Int("character", character),
+			log.Int("limit", r.options.ReferenceCountLimit),
+		},
+	})
+	defer endObservation()
+
+	uploadsByID := make(map[int]dbstore.Dump, len(r.uploads))
+	for i := range r.uploads {
+		uploadsByID[r.uploads[i].ID] = r.uploads[i]
+	}
+
+	// The cursor is never serialized here; it only holds the state shared with References.
+	var cursor referencesCursor
+
+	adjustedUploads, orderedMonikers, definitionUploadIDs, err := r.referencesState(ctx, line, character, uploadsByID, &cursor, traceLog)
+	if err != nil {
+		return 0, false, err
+	}
+
+	count := 0
+	defer func() { traceLog(log.Int("count", count)) }()
+
+	// Phase 1: Count all "local" locations via the result chunks of each visible upload
+
+	for i := range adjustedUploads {
+		localCount, err := r.lsifStore.ReferenceCount(
+			ctx,
+			adjustedUploads[i].Upload.ID,
+			adjustedUploads[i].AdjustedPathInBundle,
+			adjustedUploads[i].AdjustedPosition.Line,
+			adjustedUploads[i].AdjustedPosition.Character,
+		)
+		if err != nil {
+			return 0, false, errors.Wrap(err, "lsifStore.ReferenceCount")
+		}
+
+		if count += localCount; count >= r.options.ReferenceCountLimit {
+			return r.options.ReferenceCountLimit, true, nil
+		}
+	}
+
+	if len(orderedMonikers) == 0 {
+		return count, false, nil
+	}
+
+	// Phase 2: Count all "remote" locations via the moniker location counts of each batch of
+	// remote uploads. The uploads that define one of the monikers make up the first batch.
+
+	args := newQualifiedMonikerSet(orderedMonikers...).monikerData()
+	batchIDs := definitionUploadIDs
+	offset := 0
+
+	for {
+		if len(batchIDs) > 0 {
+			uploads, err := r.uploadsByIDs(ctx, batchIDs, uploadsByID)
+			if err != nil {
+				return 0, false, err
+			}
+
+			ids := make([]int, 0, len(uploads))
+			for i := range uploads {
+				ids = append(ids, uploads[i].ID)
+			}
+
+			remoteCount, err := r.lsifStore.BulkMonikerResultsCount(ctx, "references", ids, args)
+			if err != nil {
+				return 0, false, errors.Wrap(err, "lsifStore.BulkMonikerResultsCount")
+			}
+
+			if count += remoteCount; count >= r.options.ReferenceCountLimit {
+				return r.options.ReferenceCountLimit, true, nil
+			}
+		}
+
+		if offset < 0 {
+			// No more batches
+			return count, false, nil
+		}
+
+		// Find the next batch of indexes to count over
+		referenceUploadIDs, recordsScanned, totalCount, err := r.uploadIDsWithReferences(ctx, orderedMonikers, definitionUploadIDs, r.options.MaximumIndexesPerMonikerSearch, offset)
+		if err != nil {
+			return 0, false, err
+		}
+
+		batchIDs = referenceUploadIDs
+		offset += recordsScanned
+
+		if offset >= totalCount {
+			// Signal no batches remaining
+			offset = -1
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package resolvers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/bloomfilter"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
+)
+
+func TestReferenceCount(t *testing.T) {
+	uploads := []dbstore.Dump{
+		{ID: 50, Commit: "deadbeef", Root: "sub1/"},
+		{ID: 51, Commit: "deadbeef", Root: "sub2/"},
+		{ID: 52, Commit: "deadbeef", Root: "sub3/"},
+		{ID: 53, Commit: "deadbeef", Root: "sub4/"},
+	}
+
+	testCases := []struct {
+		limit             int
+		expectedCount     int
+		expectedTruncated bool
+		expectedCalls     int
+	}{
+		{1000, 5, false, 4},
+		{4, 4, true, 2},
+	}
+
+	for _, testCase := range testCases {
+		mockDBStore := NewMockDBStore()
+		mockLSIFStore := NewMockLSIFStore()
+		mockGitserverClient := NewMockGitserverClient()
+		mockPositionAdjuster := noopPositionAdjuster()
+
+		mockLSIFStore.ReferenceCountFunc.PushReturn(1, nil)
+		mockLSIFStore.ReferenceCountFunc.PushReturn(3, nil)
+		mockLSIFStore.ReferenceCountFunc.PushReturn(1, nil)
+
+		resolver := newQueryResolver(
+			mockDBStore,
+			mockLSIFStore,
+			newCachedCommitChecker(mockGitserverClient),
+			mockPositionAdjuster,
+			nil,
+			42,
+			"deadbeef",
+			"s1/main.go",
+			uploads,
+			ResolverOptions{ReferenceCountLimit: testCase.limit},
+			newOperations(&observation.TestContext),
+		)
+		count, truncated, err := resolver.ReferenceCount(context.Background(), 10, 20)
+		if err != nil {
+			t.Fatalf("unexpected error counting references: %s", err)
+		}
+
+		if count != testCase.expectedCount {
+			t.Errorf("unexpected count. want=%d have=%d", testCase.expectedCount, count)
+		}
+		if truncated != testCase.expectedTruncated {
+			t.Errorf("unexpected truncated flag. want=%v have=%v", testCase.expectedTruncated, truncated)
+		}
+		if history := mockLSIFStore.ReferenceCountFunc.History(); len(history) != testCase.expectedCalls {
+			t.Errorf("unexpected number of calls to ReferenceCount. want=%d have=%d", testCase.expectedCalls, len(history))
+		}
+		if history := mockLSIFStore.BulkMonikerResultsCountFunc.History(); len(history) != 0 {
+			t.Errorf("unexpected number of calls to BulkMonikerResultsCount. want=%d have=%d", 0, len(history))
+		}
+	}
+}
+
+func TestReferenceCountRemote(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockGitserverClient := NewMockGitserverClient()
+	mockPositionAdjuster := noopPositionAdjuster()
+
+	mockGitserverClient.CommitExistsFunc.SetDefaultReturn(true, nil)
+
+	definitionUploads := []dbstore.Dump{
+		{ID: 150, Commit: "deadbeef1", Root: "sub1/"},
+	}
+	mockDBStore.GetPackagesFunc.PushReturn(map[dbstore.PackageKey]dbstore.Dump{
+		{Scheme: "tsc", Name: "leftpad", Version: "0.1.0"}: definitionUploads[0],
+	}, nil)
+
+	referenceUploads := []dbstore.Dump{
+		{ID: 250, Commit: "deadbeef2", Root: "sub2/"},
+		{ID: 251, Commit: "deadbeef3", Root: "sub3/"},
+	}
+	mockDBStore.GetDumpsByIDsFunc.PushReturn(nil, nil) // empty
+	mockDBStore.GetDumpsByIDsFunc.PushReturn(referenceUploads, nil)
+
+	filter, err := bloomfilter.CreateFilter([]string{"padLeft"})
+	if err != nil {
+		t.Fatalf("unexpected error encoding bloom filter: %s", err)
+	}
+	scanner := dbstore.PackageReferenceScannerFromSlice(
+		lsifstore.PackageReference{Package: lsifstore.Package{DumpID: 250}, Filter: filter},
+		lsifstore.PackageReference{Package: lsifstore.Package{DumpID: 251}, Filter: filter},
+	)
+	mockDBStore.ReferenceIDsAndFiltersFunc.PushReturn(scanner, 2, nil)
+
+	moniker := semantic.MonikerData{Kind: "import", Scheme: "tsc", Identifier: "padLeft", PackageInformationID: "51"}
+	mockLSIFStore.MonikersByPositionFunc.PushReturn([][]semantic.MonikerData{{moniker}}, nil)
+	mockLSIFStore.PackageInformationFunc.PushReturn(semantic.PackageInformationData{Name: "leftpad", Version: "0.1.0"}, true, nil)
+	mockLSIFStore.ReferenceCountFunc.PushReturn(3, nil)
+	mockLSIFStore.BulkMonikerResultsCountFunc.PushReturn(10, nil)
+	mockLSIFStore.BulkMonikerResultsCountFunc.PushReturn(20, nil)
+
+	uploads := []dbstore.Dump{
+		{ID: 50, Commit: "deadbeef", Root: "sub1/"},
+	}
+	resolver := newQueryResolver(
+		mockDBStore,
+		mockLSIFStore,
+		newCachedCommitChecker(mockGitserverClient),
+		mockPositionAdjuster,
+		nil,
+		42,
+		"deadbeef",
+		"s1/main.go",
+		uploads,
+		ResolverOptions{},
+		newOperations(&observation.TestContext),
+	)
+	count, truncated, err := resolver.ReferenceCount(context.Background(), 10, 20)
+	if err != nil {
+		t.Fatalf("unexpected error counting references: %s", err)
+	}
+
+	if count != 33 {
+		t.Errorf("unexpected count. want=%d have=%d", 33, count)
+	}
+	if truncated {
+		t.Errorf("unexpected truncated flag")
+	}
+
+	if history := mockLSIFStore.BulkMonikerResultsCountFunc.History(); len(history) != 2 {
+		t.Fatalf("unexpected number of calls to BulkMonikerResultsCount. want=%d have=%d", 2, len(history))
+	} else {
+		expectedMonikers := []semantic.MonikerData{moniker}
+
+		if diff := cmp.Diff([]int{150}, history[0].Arg2); diff != "" {
+			t.Errorf("unexpected ids (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff(expectedMonikers, history[0].Arg3); diff != "" {
+			t.Errorf("unexpected monikers (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff([]int{250, 251}, history[1].Arg2); diff != "" {
+			t.Errorf("unexpected ids (-want +got):\n%s", diff)
+		}
+	}
+}
@@ -22,7 +22,7 @@ const slowReferencesRequestThreshold = time.Second
 
 // References returns the list of source locations that reference the symbol at the given position.
 func (r *queryResolver) References(ctx context.Context, line, character, limit int, rawCursor string) (_ []AdjustedLocation, _ string, err error) {
-	ctx, traceLog, endObservation := observeResolver(ctx, &err, "References", r.operations.references, slowReferencesRequestThreshold, observation.Args{
+	ctx, traceLog, endObservation := observeResolver(ctx, &err, "References", r.operations.references, r.options.slowRequestThreshold("References", slowReferencesRequestThreshold), observation.Args{
 		LogFields: []log.Field{
 			log.Int("repositoryID", r.repositoryID),
 			log.String("commit", r.commit),
@@ -53,22 +53,66 @@ func (r *queryResolver) References(ctx context.Context, line, character, limit i
 		return nil, "", errors.Wrap(err, fmt.Sprintf("invalid cursor: %q", rawCursor))
 	}
 
+	adjustedUploads, orderedMonikers, definitionUploadIDs, err := r.referencesState(ctx, line, character, uploadsByID, &cursor, traceLog)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Query a single page of location results
+	locations, hasMore, err := r.pageReferences(ctx, adjustedUploads, orderedMonikers, definitionUploadIDs, uploadsByID, &cursor, limit)
+	if err != nil {
+		return nil, "", err
+	}
+	traceLog(log.Int("numLocations", len(locations)))
+
+	// Adjust the locations back to the appropriate range in the target commits. This adjusts
+	// locations within the repository the user is browsing so that it appears all references
+	// are occurring at the same commit they are looking at.
+
+	adjustedLocations, err := r.adjustLocations(ctx, uploadsByID, locations)
+	if err != nil {
+		return nil, "", err
+	}
+	adjustedLocations = deduplicateLocations(adjustedLocations)
+	traceLog(log.Int("numAdjustedLocations", len(adjustedLocations)))
+
+	nextCursor := ""
+	if hasMore {
+		nextCursor = encodeCursor(cursor)
+	} else if rawCursor == "" {
+		// In hybrid mode, search-based references are listed after the precise references. This
+		// is only done when all precise references fit on the first page, as the search-based
+		// references can then be deduplicated against every precise reference.
+		adjustedLocations, err = r.appendSearchReferences(ctx, adjustedLocations, line, character, limit)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	return adjustedLocations, nextCursor, nil
+}
+
+// referencesState returns the adjusted uploads, ordered monikers, and definition upload identifiers used
+// to resolve each page of a references result set. Each value is read from the given cursor when present,
+// and is otherwise calculated and stashed on the cursor. Any upload records pulled back from the database
+// are added to the given map.
+func (r *queryResolver) referencesState(ctx context.Context, line, character int, uploadsByID map[int]dbstore.Dump, cursor *referencesCursor, traceLog observation.TraceLogger) ([]adjustedUpload, []semantic.QualifiedMonikerData, []int, error) {
 	// Adjust the path and position for each visible upload based on its git difference to
-	// the target commit. This data may already be stashed in the cursor decoded above, in
+	// the target commit. This data may already be stashed in the given cursor, in
 	// which case we don't need to hit the database.
 
-	adjustedUploads, err := r.adjustedUploadsFromCursor(ctx, line, character, uploadsByID, &cursor)
+	adjustedUploads, err := r.adjustedUploadsFromCursor(ctx, line, character, uploadsByID, cursor)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, nil, err
 	}
 
 	// Gather allmonikers attached to the ranges enclosing the requested position. This data
-	// may already be stashed in the cursor decoded above, in which case we don't need to hit
+	// may already be stashed in the given cursor, in which case we don't need to hit
 	// the database.
 
-	orderedMonikers, err := r.orderedMonikersFromCursor(ctx, adjustedUploads, &cursor)
+	orderedMonikers, err := r.orderedMonikersFromCursor(ctx, adjustedUploads, cursor)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, nil, err
 	}
 	traceLog(
 		log.Int("numMonikers", len(orderedMonikers)),
@@ -76,12 +120,12 @@ func (r *queryResolver) References(ctx context.Context, line, character, limit i
 	)
 
 	// Determine the set of uploads that define one of the ordered monikers. This may include
-	// one of the adjusted indexes. This data may already be stashed in the cursor decoded above,
+	// one of the adjusted indexes. This data may already be stashed in the given cursor,
 	// in which case we don't need to hit the database.
 
-	definitionUploadIDs, definitionUploads, err := r.definitionUploadIDsFromCursor(ctx, adjustedUploads, orderedMonikers, &cursor)
+	definitionUploadIDs, definitionUploads, err := r.definitionUploadIDsFromCursor(ctx, adjustedUploads, orderedMonikers, cursor)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, nil, err
 	}
 	traceLog(
 		log.Int("numDefinitionUploads", len(definitionUploadIDs)),
@@ -95,29 +139,7 @@ func (r *queryResolver) References(ctx context.Context, line, character, limit i
 		uploadsByID[definitionUploads[i].ID] = definitionUploads[i]
 	}
 
-	// Query a single page of location results
-	locations, hasMore, err := r.pageReferences(ctx, adjustedUploads, orderedMonikers, definitionUploadIDs, uploadsByID, &cursor, limit)
-	if err != nil {
-		return nil, "", err
-	}
-	traceLog(log.Int("numLocations", len(locations)))
-
-	// Adjust the locations back to the appropriate range in the target commits. This adjusts
-	// locations within the repository the user is browsing so that it appears all references
-	// are occurring at the same commit they are looking at.
-
-	adjustedLocations, err := r.adjustLocations(ctx, uploadsByID, locations)
-	if err != nil {
-		return nil, "", err
-	}
-	traceLog(log.Int("numAdjustedLocations", len(adjustedLocations)))
-
-	nextCursor := ""
-	if hasMore {
-		nextCursor = encodeCursor(cursor)
-	}
-
-	return adjustedLocations, nextCursor, nil
+	return adjustedUploads, orderedMonikers, definitionUploadIDs, nil
 }
 
 // ErrConcurrentModification occurs when a page of a references request cannot be resolved as
@@ -324,10 +346,6 @@ func (r *queryResolver) pageLocalReferences(ctx context.Context, adjustedUploads
 	return allLocations, cursor.LocalBatchOffset < len(adjustedUploads), nil
 }
 
-// maximumIndexesPerMonikerSearch configures the maximum number of reference upload identifiers
-// that can be passed to a single moniker search query.
-const maximumIndexesPerMonikerSearch = 50
-
 // pageRemoteReferences returns a slice of the (remote) result set denoted by the given cursor fulfilled by
 // performing a moniker search over a group of indexes. The given cursor will be adjusted to reflect the
 // offsets required to resolve the next page of results. If there are no more pages left in the result set,
@@ -340,7 +358,7 @@ func (r *queryResolver) pageRemoteReferences(ctx context.Context, adjustedUpload
 		}
 
 		// Find the next batch of indexes to perform a moniker search over
-		referenceUploadIDs, recordScanned, totalCount, err := r.uploadIDsWithReferences(ctx, orderedMonikers, definitionUploadIDs, maximumIndexesPerMonikerSearch, cursor.RemoteBatchOffset)
+		referenceUploadIDs, recordScanned, totalCount, err := r.uploadIDsWithReferences(ctx, orderedMonikers, definitionUploadIDs, r.options.MaximumIndexesPerMonikerSearch, cursor.RemoteBatchOffset)
 		if err != nil {
 			return nil, false, err
 		}
@@ -4,23 +4,49 @@ import (
 	"encoding/base64"
 	"encoding/json"
 
+	"github.com/cockroachdb/errors"
+
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
 )
 
+// referencesCursorVersion is the version of the referencesCursor schema written by encodeCursor.
+// This value must be bumped whenever the meaning of an existing field changes, and decodeCursor
+// must continue to accept every previous version still in circulation.
+//
+// Version history:
+//   - 0: a bare JSON-encoded referencesCursor with no envelope (legacy, read-only)
+//   - 1: a referencesCursor wrapped in a versioned envelope
+const referencesCursorVersion = 1
+
 // referencesCursor stores (enough of) the state of a previous References request used to
 // calculate the offset into the result set to be returned by the current request.
 type referencesCursor struct {
-	AdjustedUploads           []cursorAdjustedUpload          `json:"adjustedUploads"`
-	DefinitionUploadIDs       []int                           `json:"definitionUploadIDs"`
-	DefinitionUploadIDsCached bool                            `json:"definitionUploadIDsCached"`
-	OrderedMonikers           []semantic.QualifiedMonikerData `json:"orderedMonikers"`
-	RemotePhase               bool                            `json:"remotePhase"`
-	LocalOffset               int                             `json:"localOffset"`
-	LocalBatchOffset          int                             `json:"localBatchOffset"`
-	BatchIDs                  []int                           `json:"batchIDs"`
-	RemoteOffset              int                             `json:"remoteOffset"`
-	RemoteBatchOffset         int                             `json:"remoteBatchOffset"`
+	// AdjustedUploads are the uploads visible from the target commit along with the target
+	// path and position adjusted to each upload's indexed commit.
+	AdjustedUploads []cursorAdjustedUpload `json:"adjustedUploads"`
+
+	// DefinitionUploadIDs are the uploads defining one of the OrderedMonikers. This list is only
+	// meaningful when DefinitionUploadIDsCached is true, as an empty list is a valid value.
+	DefinitionUploadIDs       []int `json:"definitionUploadIDs"`
+	DefinitionUploadIDsCached bool  `json:"definitionUploadIDsCached"`
+
+	// OrderedMonikers are the monikers attached to the target range in each adjusted upload.
+	OrderedMonikers []semantic.QualifiedMonikerData `json:"orderedMonikers"`
+
+	// RemotePhase is true once all local results (from the adjusted uploads) have been returned.
+	RemotePhase bool `json:"remotePhase"`
+
+	// LocalOffset is the offset into the results of the adjusted upload at LocalBatchOffset.
+	LocalOffset      int `json:"localOffset"`
+	LocalBatchOffset int `json:"localBatchOffset"`
+
+	// BatchIDs are the uploads of the current moniker search. RemoteOffset is the offset into the
+	// results of that search, and RemoteBatchOffset is the offset of the next batch of uploads, or
+	// -1 if no batches remain.
+	BatchIDs          []int `json:"batchIDs"`
+	RemoteOffset      int   `json:"remoteOffset"`
+	RemoteBatchOffset int   `json:"remoteBatchOffset"`
 }
 
 type cursorAdjustedUpload struct {
@@ -30,8 +56,15 @@ type cursorAdjustedUpload struct {
 	AdjustedPathInBundle string             `json:"adjustedPathInBundle"`
 }
 
+// referencesCursorEnvelope is the serialized form of a referencesCursor.
+type referencesCursorEnvelope struct {
+	Version int              `json:"version"`
+	Cursor  referencesCursor `json:"cursor"`
+}
+
 // decodeCursor is the inverse of encodeCursor. If the given encoded string is empty, then
-// a fresh cursor is returned.
+// a fresh cursor is returned. Cursors written before the introduction of versioning are
+// also accepted. Every decoded cursor is validated before being returned.
 func decodeCursor(rawEncoded string) (referencesCursor, error) {
 	if rawEncoded == "" {
 		return referencesCursor{}, nil
@@ -42,13 +75,74 @@ func decodeCursor(rawEncoded string) (referencesCursor, error) {
 		return referencesCursor{}, err
 	}
 
+	var header struct {
+		Version *int `json:"version"`
+	}
+	if err := json.Unmarshal(raw, &header); err != nil {
+		return referencesCursor{}, err
+	}
+
 	var cursor referencesCursor
-	err = json.Unmarshal(raw, &cursor)
-	return cursor, err
+	switch {
+	case header.Version == nil:
+		// Legacy cursors are a bare referencesCursor payload
+		if err := json.Unmarshal(raw, &cursor); err != nil {
+			return referencesCursor{}, err
+		}
+
+	case *header.Version == referencesCursorVersion:
+		var envelope referencesCursorEnvelope
+		if err := json.Unmarshal(raw, &envelope); err != nil {
+			return referencesCursor{}, err
+		}
+		cursor = envelope.Cursor
+
+	default:
+		return referencesCursor{}, errors.Errorf("unsupported cursor version %d", *header.Version)
+	}
+
+	if err := cursor.validate(); err != nil {
+		return referencesCursor{}, err
+	}
+
+	return cursor, nil
 }
 
 // encodeCursor returns an encoding of the given cursor suitable for a URL or a GraphQL token.
 func encodeCursor(cursor referencesCursor) string {
-	rawEncoded, _ := json.Marshal(cursor)
+	rawEncoded, _ := json.Marshal(referencesCursorEnvelope{
+		Version: referencesCursorVersion,
+		Cursor:  cursor,
+	})
+
 	return base64.RawURLEncoding.EncodeToString(rawEncoded)
 }
+
+// validate returns an error if the cursor holds a state that cannot be produced by a References
+// request. This guards the resolver against cursors that are corrupt or have been tampered with.
+func (c referencesCursor) validate() error {
+	if c.LocalOffset < 0 || c.LocalBatchOffset < 0 || c.RemoteOffset < 0 || c.RemoteBatchOffset < -1 {
+		return errors.New("invalid cursor: negative offset")
+	}
+	if c.AdjustedUploads != nil && c.LocalBatchOffset > len(c.AdjustedUploads) {
+		return errors.New("invalid cursor: local batch offset out of range")
+	}
+	if !c.DefinitionUploadIDsCached && len(c.DefinitionUploadIDs) > 0 {
+		return errors.New("invalid cursor: definition uploads are not marked as cached")
+	}
+
+	for _, upload := range c.AdjustedUploads {
+		if upload.DumpID <= 0 {
+			return errors.New("invalid cursor: illegal upload identifier")
+		}
+	}
+	for _, ids := range [][]int{c.DefinitionUploadIDs, c.BatchIDs} {
+		for _, id := range ids {
+			if id <= 0 {
+				return errors.New("invalid cursor: illegal upload identifier")
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package resolvers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
+)
+
+var testReferencesCursor = referencesCursor{
+	AdjustedUploads: []cursorAdjustedUpload{
+		{DumpID: 50, AdjustedPath: "sub/main.go", AdjustedPosition: lsifstore.Position{Line: 10, Character: 20}, AdjustedPathInBundle: "main.go"},
+	},
+	DefinitionUploadIDs:       []int{51, 52},
+	DefinitionUploadIDsCached: true,
+	OrderedMonikers: []semantic.QualifiedMonikerData{
+		{MonikerData: semantic.MonikerData{Kind: "import", Scheme: "gomod", Identifier: "pad"}},
+	},
+	RemotePhase:       true,
+	LocalBatchOffset:  1,
+	BatchIDs:          []int{53},
+	RemoteOffset:      25,
+	RemoteBatchOffset: -1,
+}
+
+func TestReferencesCursorRoundTrip(t *testing.T) {
+	cursor, err := decodeCursor(encodeCursor(testReferencesCursor))
+	if err != nil {
+		t.Fatalf("unexpected error decoding cursor: %s", err)
+	}
+	if diff := cmp.Diff(testReferencesCursor, cursor); diff != "" {
+		t.Errorf("unexpected cursor (-want +got):\n%s", diff)
+	}
+}
+
+func TestReferencesCursorLegacy(t *testing.T) {
+	raw, err := json.Marshal(testReferencesCursor)
+	if err != nil {
+		t.Fatalf("unexpected error encoding cursor: %s", err)
+	}
+
+	cursor, err := decodeCursor(base64.RawURLEncoding.EncodeToString(raw))
+	if err != nil {
+		t.Fatalf("unexpected error decoding cursor: %s", err)
+	}
+	if diff := cmp.Diff(testReferencesCursor, cursor); diff != "" {
+		t.Errorf("unexpected cursor (-want +got):\n%s", diff)
+	}
+}
+
+func TestReferencesCursorInvalid(t *testing.T) {
+	testCases := map[string]string{
+		"malformed": "not a cursor!",
+		"version":   `{"version": 99, "cursor": {}}`,
+		"offset":    `{"version": 1, "cursor": {"localOffset": -1}}`,
+		"batch":     `{"version": 1, "cursor": {"adjustedUploads": [{"dumpID": 50}], "localBatchOffset": 2}}`,
+		"cached":    `{"version": 1, "cursor": {"definitionUploadIDs": [50]}}`,
+		"upload":    `{"version": 1, "cursor": {"batchIDs": [0]}}`,
+		"legacy":    `{"remoteBatchOffset": -2}`,
+	}
+
+	for name, raw := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := decodeCursor(base64.RawURLEncoding.EncodeToString([]byte(raw))); err == nil {
+				t.Fatalf("expected error decoding cursor")
+			}
+		})
+	}
+}
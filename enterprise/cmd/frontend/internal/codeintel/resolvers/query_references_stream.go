@@ -0,0 +1,99 @@
+package resolvers
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
+)
+
+const slowReferencesStreamRequestThreshold = 10 * time.Second
+
+// referencesStreamPageSize is the number of locations requested from the underlying stores
+// on each iteration of a references stream. Locations are emitted after each page has been
+// resolved, so this also bounds the number of locations held in memory at once.
+const referencesStreamPageSize = 100
+
+// ReferencesStream invokes the given function with each source location that references the symbol
+// at the given position. Locations are emitted as each page of results is resolved from the visible
+// and remote uploads, rather than after the entire result set has been materialized. If the given
+// function returns an error, the stream is halted and that error is returned.
+func (r *queryResolver) ReferencesStream(ctx context.Context, line, character int, fn func(AdjustedLocation) error) (err error) {
+	ctx, traceLog, endObservation := observeResolver(ctx, &err, "ReferencesStream", r.operations.referencesStream, r.options.slowRequestThreshold("ReferencesStream", slowReferencesStreamRequestThreshold), observation.Args{
+		LogFields: []log.Field{
+			log.Int("repositoryID", r.repositoryID),
+			log.String("commit", r.commit),
+			log.String("path", r.path),
+			log.Int("numUploads", len(r.uploads)),
+			log.String("uploads", uploadIDsToString(r.uploads)),
+			log.Int("line", line),
+			log.Int("character", character),
+		},
+	})
+	defer endObservation()
+
+	uploadsByID := make(map[int]dbstore.Dump, len(r.uploads))
+	for i := range r.uploads {
+		uploadsByID[r.uploads[i].ID] = r.uploads[i]
+	}
+
+	// The cursor is never serialized here; it only tracks our offsets into the result set
+	// between successive pages of the same stream.
+	var cursor referencesCursor
+
+	adjustedUploads, orderedMonikers, definitionUploadIDs, err := r.referencesState(ctx, line, character, uploadsByID, &cursor, traceLog)
+	if err != nil {
+		return err
+	}
+
+	numLocations := 0
+	defer func() { traceLog(log.Int("numLocations", numLocations)) }()
+
+	for hasMore := true; hasMore; {
+		var locations []AdjustedLocation
+		locations, hasMore, err = r.nextReferencesStreamPage(ctx, adjustedUploads, orderedMonikers, definitionUploadIDs, uploadsByID, &cursor)
+		if err != nil {
+			return err
+		}
+
+		for _, location := range locations {
+			if err := fn(location); err != nil {
+				return err
+			}
+		}
+		numLocations += len(locations)
+	}
+
+	return nil
+}
+
+// nextReferencesStreamPage resolves and adjusts the next page of a references stream. The given cursor
+// is modified in-place to reflect the offsets required to resolve the subsequent page.
+func (r *queryResolver) nextReferencesStreamPage(
+	ctx context.Context,
+	adjustedUploads []adjustedUpload,
+	orderedMonikers []semantic.QualifiedMonikerData,
+	definitionUploadIDs []int,
+	uploadsByID map[int]dbstore.Dump,
+	cursor *referencesCursor,
+) ([]AdjustedLocation, bool, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, false, err
+	}
+
+	locations, hasMore, err := r.pageReferences(ctx, adjustedUploads, orderedMonikers, definitionUploadIDs, uploadsByID, cursor, referencesStreamPageSize)
+	if err != nil {
+		return nil, false, err
+	}
+
+	adjustedLocations, err := r.adjustLocations(ctx, uploadsByID, locations)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return adjustedLocations, hasMore, nil
+}
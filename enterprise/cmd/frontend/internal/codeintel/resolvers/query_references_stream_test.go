@@ -0,0 +1,113 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestReferencesStream(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockGitserverClient := NewMockGitserverClient()
+	mockPositionAdjuster := noopPositionAdjuster()
+
+	// Empty result set (prevents nil pointer as scanner is always non-nil)
+	mockDBStore.ReferenceIDsAndFiltersFunc.PushReturn(dbstore.PackageReferenceScannerFromSlice(), 0, nil)
+
+	locations := []lsifstore.Location{
+		{DumpID: 50, Path: "a.go", Range: testRange1},
+		{DumpID: 51, Path: "b.go", Range: testRange2},
+		{DumpID: 51, Path: "c.go", Range: testRange3},
+	}
+	mockLSIFStore.ReferencesFunc.PushReturn(locations[:1], 1, nil)
+	mockLSIFStore.ReferencesFunc.PushReturn(locations[1:], 2, nil)
+
+	uploads := []dbstore.Dump{
+		{ID: 50, Commit: "deadbeef", Root: "sub1/"},
+		{ID: 51, Commit: "deadbeef", Root: "sub2/"},
+	}
+	resolver := newQueryResolver(
+		mockDBStore,
+		mockLSIFStore,
+		newCachedCommitChecker(mockGitserverClient),
+		mockPositionAdjuster,
+		nil,
+		42,
+		"deadbeef",
+		"s1/main.go",
+		uploads,
+		ResolverOptions{},
+		newOperations(&observation.TestContext),
+	)
+
+	var adjustedLocations []AdjustedLocation
+	if err := resolver.ReferencesStream(context.Background(), 10, 20, func(location AdjustedLocation) error {
+		adjustedLocations = append(adjustedLocations, location)
+		return nil
+	}); err != nil {
+		t.Fatalf("unexpected error streaming references: %s", err)
+	}
+
+	expectedLocations := []AdjustedLocation{
+		{Dump: uploads[0], Path: "sub1/a.go", AdjustedCommit: "deadbeef", AdjustedRange: testRange1},
+		{Dump: uploads[1], Path: "sub2/b.go", AdjustedCommit: "deadbeef", AdjustedRange: testRange2},
+		{Dump: uploads[1], Path: "sub2/c.go", AdjustedCommit: "deadbeef", AdjustedRange: testRange3},
+	}
+	if diff := cmp.Diff(expectedLocations, adjustedLocations); diff != "" {
+		t.Errorf("unexpected locations (-want +got):\n%s", diff)
+	}
+}
+
+func TestReferencesStreamCallbackError(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockGitserverClient := NewMockGitserverClient()
+	mockPositionAdjuster := noopPositionAdjuster()
+
+	// Empty result set (prevents nil pointer as scanner is always non-nil)
+	mockDBStore.ReferenceIDsAndFiltersFunc.PushReturn(dbstore.PackageReferenceScannerFromSlice(), 0, nil)
+
+	locations := []lsifstore.Location{
+		{DumpID: 50, Path: "a.go", Range: testRange1},
+		{DumpID: 50, Path: "b.go", Range: testRange2},
+	}
+	mockLSIFStore.ReferencesFunc.PushReturn(locations, 2, nil)
+
+	uploads := []dbstore.Dump{
+		{ID: 50, Commit: "deadbeef", Root: "sub1/"},
+	}
+	resolver := newQueryResolver(
+		mockDBStore,
+		mockLSIFStore,
+		newCachedCommitChecker(mockGitserverClient),
+		mockPositionAdjuster,
+		nil,
+		42,
+		"deadbeef",
+		"s1/main.go",
+		uploads,
+		ResolverOptions{},
+		newOperations(&observation.TestContext),
+	)
+
+	expectedErr := errors.New("client went away")
+
+	numCalls := 0
+	err := resolver.ReferencesStream(context.Background(), 10, 20, func(location AdjustedLocation) error {
+		numCalls++
+		return expectedErr
+	})
+	if err != expectedErr {
+		t.Fatalf("unexpected error. want=%q have=%q", expectedErr, err)
+	}
+	if numCalls != 1 {
+		t.Errorf("unexpected number of callback invocations. want=%d have=%d", 1, numCalls)
+	}
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/bloomfilter"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
@@ -44,10 +46,12 @@ func TestReferences(t *testing.T) {
 		mockLSIFStore,
 		newCachedCommitChecker(mockGitserverClient),
 		mockPositionAdjuster,
+		nil,
 		42,
 		"deadbeef",
 		"s1/main.go",
 		uploads,
+		ResolverOptions{},
 		newOperations(&observation.TestContext),
 	)
 	adjustedLocations, _, err := resolver.References(context.Background(), 10, 20, 50, "")
@@ -67,6 +71,68 @@ func TestReferences(t *testing.T) {
 	}
 }
 
+type subRepoPermsCheckerFunc func(ctx context.Context, userID int32, content authz.RepoContent) (authz.Perms, error)
+
+func (f subRepoPermsCheckerFunc) Permissions(ctx context.Context, userID int32, content authz.RepoContent) (authz.Perms, error) {
+	return f(ctx, userID, content)
+}
+
+func TestReferencesSubRepoPermissions(t *testing.T) {
+	old := authz.DefaultSubRepoPermsChecker
+	authz.DefaultSubRepoPermsChecker = subRepoPermsCheckerFunc(func(ctx context.Context, userID int32, content authz.RepoContent) (authz.Perms, error) {
+		if content.Repo == "perforce/depot" && content.Path == "sub2/b.go" {
+			return authz.None, nil
+		}
+		return authz.Read, nil
+	})
+	defer func() { authz.DefaultSubRepoPermsChecker = old }()
+
+	mockDBStore := NewMockDBStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockGitserverClient := NewMockGitserverClient()
+	mockPositionAdjuster := noopPositionAdjuster()
+
+	// Empty result set (prevents nil pointer as scanner is always non-nil)
+	mockDBStore.ReferenceIDsAndFiltersFunc.PushReturn(dbstore.PackageReferenceScannerFromSlice(), 0, nil)
+
+	locations := []lsifstore.Location{
+		{DumpID: 51, Path: "a.go", Range: testRange1},
+		{DumpID: 51, Path: "b.go", Range: testRange2},
+		{DumpID: 51, Path: "c.go", Range: testRange3},
+	}
+	mockLSIFStore.ReferencesFunc.PushReturn(locations, 3, nil)
+
+	uploads := []dbstore.Dump{
+		{ID: 51, Commit: "deadbeef", Root: "sub2/", RepositoryName: "perforce/depot"},
+	}
+	resolver := newQueryResolver(
+		mockDBStore,
+		mockLSIFStore,
+		newCachedCommitChecker(mockGitserverClient),
+		mockPositionAdjuster,
+		nil,
+		42,
+		"deadbeef",
+		"s1/main.go",
+		uploads,
+		ResolverOptions{},
+		newOperations(&observation.TestContext),
+	)
+	ctx := actor.WithActor(context.Background(), &actor.Actor{UID: 1})
+	adjustedLocations, _, err := resolver.References(ctx, 10, 20, 50, "")
+	if err != nil {
+		t.Fatalf("unexpected error querying references: %s", err)
+	}
+
+	expectedLocations := []AdjustedLocation{
+		{Dump: uploads[0], Path: "sub2/a.go", AdjustedCommit: "deadbeef", AdjustedRange: testRange1},
+		{Dump: uploads[0], Path: "sub2/c.go", AdjustedCommit: "deadbeef", AdjustedRange: testRange3},
+	}
+	if diff := cmp.Diff(expectedLocations, adjustedLocations); diff != "" {
+		t.Errorf("unexpected locations (-want +got):\n%s", diff)
+	}
+}
+
 func TestReferencesRemote(t *testing.T) {
 	mockDBStore := NewMockDBStore()
 	mockLSIFStore := NewMockLSIFStore()
@@ -77,9 +143,15 @@ func TestReferencesRemote(t *testing.T) {
 		{ID: 150, Commit: "deadbeef1", Root: "sub1/"},
 		{ID: 151, Commit: "deadbeef2", Root: "sub2/"},
 		{ID: 152, Commit: "deadbeef3", Root: "sub3/"},
-		{ID: 153, Commit: "deadbeef4", Root: "sub4/"},
 	}
-	mockDBStore.DefinitionDumpsFunc.PushReturn(definitionUploads, nil)
+	packageKey1 := dbstore.PackageKey{Scheme: "tsc", Name: "leftpad", Version: "0.1.0"}
+	packageKey2 := dbstore.PackageKey{Scheme: "tsc", Name: "leftpad", Version: "0.2.0"}
+	packageKey3 := dbstore.PackageKey{Scheme: "tsc", Name: "leftpad", Version: "0.3.0"}
+	mockDBStore.GetPackagesFunc.PushReturn(map[dbstore.PackageKey]dbstore.Dump{
+		packageKey1: definitionUploads[0],
+		packageKey2: definitionUploads[1],
+		packageKey3: definitionUploads[2],
+	}, nil)
 
 	referenceUploads := []dbstore.Dump{
 		{ID: 250, Commit: "deadbeef1", Root: "sub1/"},
@@ -110,7 +182,6 @@ func TestReferencesRemote(t *testing.T) {
 	mockGitserverClient.CommitExistsFunc.PushReturn(false, nil) // #150
 	mockGitserverClient.CommitExistsFunc.PushReturn(true, nil)  // #151
 	mockGitserverClient.CommitExistsFunc.PushReturn(true, nil)  // #152
-	mockGitserverClient.CommitExistsFunc.PushReturn(true, nil)  // #153
 	mockGitserverClient.CommitExistsFunc.PushReturn(false, nil) // #250
 	mockGitserverClient.CommitExistsFunc.SetDefaultReturn(true, nil)
 
@@ -165,10 +236,12 @@ func TestReferencesRemote(t *testing.T) {
 		mockLSIFStore,
 		newCachedCommitChecker(mockGitserverClient),
 		mockPositionAdjuster,
+		nil,
 		42,
 		"deadbeef",
 		"s1/main.go",
 		uploads,
+		ResolverOptions{},
 		newOperations(&observation.TestContext),
 	)
 	adjustedLocations, _, err := resolver.References(context.Background(), 10, 20, 50, "")
@@ -192,23 +265,18 @@ func TestReferencesRemote(t *testing.T) {
 		t.Errorf("unexpected locations (-want +got):\n%s", diff)
 	}
 
-	if history := mockDBStore.DefinitionDumpsFunc.History(); len(history) != 1 {
-		t.Fatalf("unexpected call count for dbstore.DefinitionDump. want=%d have=%d", 1, len(history))
+	if history := mockDBStore.GetPackagesFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected call count for dbstore.GetPackages. want=%d have=%d", 1, len(history))
 	} else {
-		expectedMonikers := []semantic.QualifiedMonikerData{
-			{MonikerData: monikers[0], PackageInformationData: packageInformation1},
-			{MonikerData: monikers[1], PackageInformationData: packageInformation2},
-			{MonikerData: monikers[2], PackageInformationData: packageInformation3},
-		}
-		if diff := cmp.Diff(expectedMonikers, history[0].Arg1); diff != "" {
-			t.Errorf("unexpected monikers (-want +got):\n%s", diff)
+		if diff := cmp.Diff([]dbstore.PackageKey{packageKey1, packageKey2, packageKey3}, history[0].Arg1); diff != "" {
+			t.Errorf("unexpected package keys (-want +got):\n%s", diff)
 		}
 	}
 
 	if history := mockLSIFStore.BulkMonikerResultsFunc.History(); len(history) != 3 {
 		t.Fatalf("unexpected call count for lsifstore.BulkMonikerResults. want=%d have=%d", 3, len(history))
 	} else {
-		if diff := cmp.Diff([]int{151, 152, 153}, history[0].Arg2); diff != "" {
+		if diff := cmp.Diff([]int{151, 152}, history[0].Arg2); diff != "" {
 			t.Errorf("unexpected ids (-want +got):\n%s", diff)
 		}
 
@@ -0,0 +1,96 @@
+package resolvers
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+
+	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+)
+
+// searchBasedFallbackEnabled returns true if precise results may be supplemented by search-based
+// results (the hybrid mode).
+func (r *queryResolver) searchBasedFallbackEnabled() bool {
+	return r.options.SearchBasedFallback && r.searchClient != nil
+}
+
+// appendSearchDefinitions appends search-based definitions of the symbol at the given position to
+// the given precise definitions, up to the given limit.
+func (r *queryResolver) appendSearchDefinitions(ctx context.Context, locations []AdjustedLocation, line, character, limit int) ([]AdjustedLocation, error) {
+	if !r.searchBasedFallbackEnabled() || len(locations) >= limit {
+		return locations, nil
+	}
+
+	searchLocations, err := r.searchClient.Definitions(ctx, r.repositoryID, r.commit, r.path, line, character, limit)
+	if err != nil {
+		return r.searchFallbackError(locations, errors.Wrap(err, "searchClient.Definitions"))
+	}
+
+	return r.appendSearchLocations(locations, searchLocations, limit), nil
+}
+
+// appendSearchReferences appends search-based references of the symbol at the given position to
+// the given precise references, up to the given limit.
+func (r *queryResolver) appendSearchReferences(ctx context.Context, locations []AdjustedLocation, line, character, limit int) ([]AdjustedLocation, error) {
+	if !r.searchBasedFallbackEnabled() || len(locations) >= limit {
+		return locations, nil
+	}
+
+	searchLocations, err := r.searchClient.References(ctx, r.repositoryID, r.commit, r.path, line, character, limit)
+	if err != nil {
+		return r.searchFallbackError(locations, errors.Wrap(err, "searchClient.References"))
+	}
+
+	return r.appendSearchLocations(locations, searchLocations, limit), nil
+}
+
+// searchFallbackError returns the given error if there are no precise locations to return instead.
+// Otherwise, the error is logged and the precise locations are returned as-is, so that a failing
+// search does not hide precise results.
+func (r *queryResolver) searchFallbackError(locations []AdjustedLocation, err error) ([]AdjustedLocation, error) {
+	if len(locations) == 0 {
+		return nil, err
+	}
+
+	log15.Warn("Failed to resolve search-based code intelligence", "repositoryID", r.repositoryID, "commit", r.commit, "path", r.path, "error", err)
+	return locations, nil
+}
+
+// appendSearchLocations appends the given search-based locations, which are relative to the target
+// commit, to the given precise locations until the given limit is reached. Search-based locations
+// on a line that already holds a precise location in the same file are skipped, as they most likely
+// denote the same symbol.
+func (r *queryResolver) appendSearchLocations(locations []AdjustedLocation, searchLocations []lsifstore.Location, limit int) []AdjustedLocation {
+	type lineKey struct {
+		path string
+		line int
+	}
+
+	preciseLines := make(map[lineKey]struct{}, len(locations))
+	for _, location := range locations {
+		if location.Dump.RepositoryID == r.repositoryID && location.AdjustedCommit == r.commit {
+			preciseLines[lineKey{location.Path, location.AdjustedRange.Start.Line}] = struct{}{}
+		}
+	}
+
+	for _, location := range searchLocations {
+		if len(locations) >= limit {
+			break
+		}
+		if _, ok := preciseLines[lineKey{location.Path, location.Range.Start.Line}]; ok {
+			continue
+		}
+
+		locations = append(locations, AdjustedLocation{
+			Dump:           store.Dump{RepositoryID: r.repositoryID, Commit: r.commit},
+			Path:           location.Path,
+			AdjustedCommit: r.commit,
+			AdjustedRange:  location.Range,
+			Imprecise:      true,
+		})
+	}
+
+	return locations
+}
@@ -0,0 +1,236 @@
+package resolvers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestDefinitionsSearchBasedFallback(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockGitserverClient := NewMockGitserverClient()
+	mockPositionAdjuster := noopPositionAdjuster()
+	mockSearchClient := NewMockSearchClient()
+
+	mockSearchClient.DefinitionsFunc.PushReturn([]lsifstore.Location{
+		{Path: "a.go", Range: testRange1},
+		{Path: "b.go", Range: testRange2},
+	}, nil)
+
+	// No upload covers the path
+	resolver := newQueryResolver(
+		mockDBStore,
+		mockLSIFStore,
+		newCachedCommitChecker(mockGitserverClient),
+		mockPositionAdjuster,
+		mockSearchClient,
+		42,
+		"deadbeef",
+		"s1/main.go",
+		nil,
+		ResolverOptions{SearchBasedFallback: true},
+		newOperations(&observation.TestContext),
+	)
+	adjustedLocations, err := resolver.Definitions(context.Background(), 10, 20)
+	if err != nil {
+		t.Fatalf("unexpected error querying definitions: %s", err)
+	}
+
+	dump := dbstore.Dump{RepositoryID: 42, Commit: "deadbeef"}
+	expectedLocations := []AdjustedLocation{
+		{Dump: dump, Path: "a.go", AdjustedCommit: "deadbeef", AdjustedRange: testRange1, Imprecise: true},
+		{Dump: dump, Path: "b.go", AdjustedCommit: "deadbeef", AdjustedRange: testRange2, Imprecise: true},
+	}
+	if diff := cmp.Diff(expectedLocations, adjustedLocations); diff != "" {
+		t.Errorf("unexpected locations (-want +got):\n%s", diff)
+	}
+
+	if history := mockSearchClient.DefinitionsFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected call count. want=%d have=%d", 1, len(history))
+	} else if call := history[0]; call.Arg1 != 42 || call.Arg2 != "deadbeef" || call.Arg3 != "s1/main.go" || call.Arg4 != 10 || call.Arg5 != 20 || call.Arg6 != DefinitionsLimit {
+		t.Errorf("unexpected search arguments: %v", call.Args()[1:])
+	}
+}
+
+func TestDefinitionsSearchBasedFallbackMerged(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockGitserverClient := NewMockGitserverClient()
+	mockPositionAdjuster := noopPositionAdjuster()
+	mockSearchClient := NewMockSearchClient()
+
+	mockLSIFStore.BatchDefinitionsFunc.PushReturn([][]lsifstore.Location{
+		{{DumpID: 50, Path: "a.go", Range: testRange1}},
+	}, nil)
+
+	// The first search result denotes the precise definition
+	mockSearchClient.DefinitionsFunc.PushReturn([]lsifstore.Location{
+		{Path: "sub1/a.go", Range: lsifstore.Range{Start: testRange1.Start, End: testRange1.Start}},
+		{Path: "sub1/b.go", Range: testRange2},
+	}, nil)
+
+	uploads := []dbstore.Dump{
+		{ID: 50, RepositoryID: 42, Commit: "deadbeef", Root: "sub1/"},
+	}
+	resolver := newQueryResolver(
+		mockDBStore,
+		mockLSIFStore,
+		newCachedCommitChecker(mockGitserverClient),
+		mockPositionAdjuster,
+		mockSearchClient,
+		42,
+		"deadbeef",
+		"s1/main.go",
+		uploads,
+		ResolverOptions{SearchBasedFallback: true},
+		newOperations(&observation.TestContext),
+	)
+	adjustedLocations, err := resolver.Definitions(context.Background(), 10, 20)
+	if err != nil {
+		t.Fatalf("unexpected error querying definitions: %s", err)
+	}
+
+	expectedLocations := []AdjustedLocation{
+		{Dump: uploads[0], Path: "sub1/a.go", AdjustedCommit: "deadbeef", AdjustedRange: testRange1},
+		{Dump: dbstore.Dump{RepositoryID: 42, Commit: "deadbeef"}, Path: "sub1/b.go", AdjustedCommit: "deadbeef", AdjustedRange: testRange2, Imprecise: true},
+	}
+	if diff := cmp.Diff(expectedLocations, adjustedLocations); diff != "" {
+		t.Errorf("unexpected locations (-want +got):\n%s", diff)
+	}
+}
+
+func TestDefinitionsSearchBasedFallbackError(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockGitserverClient := NewMockGitserverClient()
+	mockPositionAdjuster := noopPositionAdjuster()
+	mockSearchClient := NewMockSearchClient()
+
+	mockLSIFStore.BatchDefinitionsFunc.PushReturn([][]lsifstore.Location{
+		{{DumpID: 50, Path: "a.go", Range: testRange1}},
+	}, nil)
+	mockSearchClient.DefinitionsFunc.SetDefaultReturn(nil, errors.New("symbols unavailable"))
+
+	uploads := []dbstore.Dump{
+		{ID: 50, RepositoryID: 42, Commit: "deadbeef", Root: "sub1/"},
+	}
+	resolver := newQueryResolver(
+		mockDBStore,
+		mockLSIFStore,
+		newCachedCommitChecker(mockGitserverClient),
+		mockPositionAdjuster,
+		mockSearchClient,
+		42,
+		"deadbeef",
+		"s1/main.go",
+		uploads,
+		ResolverOptions{SearchBasedFallback: true},
+		newOperations(&observation.TestContext),
+	)
+
+	// Precise results are returned despite the failing search
+	adjustedLocations, err := resolver.Definitions(context.Background(), 10, 20)
+	if err != nil {
+		t.Fatalf("unexpected error querying definitions: %s", err)
+	}
+
+	expectedLocations := []AdjustedLocation{
+		{Dump: uploads[0], Path: "sub1/a.go", AdjustedCommit: "deadbeef", AdjustedRange: testRange1},
+	}
+	if diff := cmp.Diff(expectedLocations, adjustedLocations); diff != "" {
+		t.Errorf("unexpected locations (-want +got):\n%s", diff)
+	}
+}
+
+func TestReferencesSearchBasedFallback(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockGitserverClient := NewMockGitserverClient()
+	mockPositionAdjuster := noopPositionAdjuster()
+	mockSearchClient := NewMockSearchClient()
+
+	// Empty result set (prevents nil pointer as scanner is always non-nil)
+	mockDBStore.ReferenceIDsAndFiltersFunc.PushReturn(dbstore.PackageReferenceScannerFromSlice(), 0, nil)
+
+	mockSearchClient.ReferencesFunc.PushReturn([]lsifstore.Location{
+		{Path: "a.go", Range: testRange1},
+		{Path: "b.go", Range: testRange2},
+		{Path: "c.go", Range: testRange3},
+	}, nil)
+
+	// No upload covers the path
+	resolver := newQueryResolver(
+		mockDBStore,
+		mockLSIFStore,
+		newCachedCommitChecker(mockGitserverClient),
+		mockPositionAdjuster,
+		mockSearchClient,
+		42,
+		"deadbeef",
+		"s1/main.go",
+		nil,
+		ResolverOptions{SearchBasedFallback: true},
+		newOperations(&observation.TestContext),
+	)
+	adjustedLocations, cursor, err := resolver.References(context.Background(), 10, 20, 2, "")
+	if err != nil {
+		t.Fatalf("unexpected error querying references: %s", err)
+	}
+
+	dump := dbstore.Dump{RepositoryID: 42, Commit: "deadbeef"}
+	expectedLocations := []AdjustedLocation{
+		{Dump: dump, Path: "a.go", AdjustedCommit: "deadbeef", AdjustedRange: testRange1, Imprecise: true},
+		{Dump: dump, Path: "b.go", AdjustedCommit: "deadbeef", AdjustedRange: testRange2, Imprecise: true},
+	}
+	if diff := cmp.Diff(expectedLocations, adjustedLocations); diff != "" {
+		t.Errorf("unexpected locations (-want +got):\n%s", diff)
+	}
+
+	// Search-based results are not paginated
+	if cursor != "" {
+		t.Errorf("unexpected cursor: %q", cursor)
+	}
+}
+
+func TestReferencesSearchBasedFallbackDisabled(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockGitserverClient := NewMockGitserverClient()
+	mockPositionAdjuster := noopPositionAdjuster()
+	mockSearchClient := NewMockSearchClient()
+
+	// Empty result set (prevents nil pointer as scanner is always non-nil)
+	mockDBStore.ReferenceIDsAndFiltersFunc.PushReturn(dbstore.PackageReferenceScannerFromSlice(), 0, nil)
+
+	resolver := newQueryResolver(
+		mockDBStore,
+		mockLSIFStore,
+		newCachedCommitChecker(mockGitserverClient),
+		mockPositionAdjuster,
+		mockSearchClient,
+		42,
+		"deadbeef",
+		"s1/main.go",
+		nil,
+		ResolverOptions{},
+		newOperations(&observation.TestContext),
+	)
+	adjustedLocations, _, err := resolver.References(context.Background(), 10, 20, 50, "")
+	if err != nil {
+		t.Fatalf("unexpected error querying references: %s", err)
+	}
+	if len(adjustedLocations) != 0 {
+		t.Errorf("unexpected locations: %v", adjustedLocations)
+	}
+
+	if history := mockSearchClient.ReferencesFunc.History(); len(history) != 0 {
+		t.Errorf("unexpected call count. want=%d have=%d", 0, len(history))
+	}
+}
@@ -0,0 +1,77 @@
+package resolvers
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+const slowSymbolsRequestThreshold = time.Second
+
+// Symbols returns the symbols defined in the uploads covering the current repository and commit
+// whose identifier matches the given query. Symbols defined by more than one upload are returned
+// once, with the definitions from every upload. At most limit symbols are returned.
+func (r *queryResolver) Symbols(ctx context.Context, query string, limit int) (_ []AdjustedSymbol, err error) {
+	ctx, traceLog, endObservation := observeResolver(ctx, &err, "Symbols", r.operations.symbols, r.options.slowRequestThreshold("Symbols", slowSymbolsRequestThreshold), observation.Args{
+		LogFields: []log.Field{
+			log.Int("repositoryID", r.repositoryID),
+			log.String("commit", r.commit),
+			log.String("path", r.path),
+			log.Int("numUploads", len(r.uploads)),
+			log.String("uploads", uploadIDsToString(r.uploads)),
+			log.String("query", query),
+			log.Int("limit", limit),
+		},
+	})
+	defer endObservation()
+
+	uploadsByID := make(map[int]dbstore.Dump, len(r.uploads))
+	ids := make([]int, 0, len(r.uploads))
+	for i := range r.uploads {
+		uploadsByID[r.uploads[i].ID] = r.uploads[i]
+		ids = append(ids, r.uploads[i].ID)
+	}
+
+	// Request enough rows to fill the page even if every symbol is defined in every upload
+	symbols, err := r.lsifStore.Symbols(ctx, ids, query, limit*len(ids))
+	if err != nil {
+		return nil, errors.Wrap(err, "lsifStore.Symbols")
+	}
+	traceLog(log.Int("numSymbols", len(symbols)))
+
+	type symbolKey struct{ scheme, identifier string }
+	indexes := map[symbolKey]int{}
+
+	var adjustedSymbols []AdjustedSymbol
+	for _, symbol := range symbols {
+		// Adjust the locations back to the appropriate range in the target commit
+		adjustedLocations, err := r.adjustLocations(ctx, uploadsByID, symbol.Locations)
+		if err != nil {
+			return nil, err
+		}
+
+		key := symbolKey{symbol.Scheme, symbol.Identifier}
+		if i, ok := indexes[key]; ok {
+			adjustedSymbols[i].Locations = append(adjustedSymbols[i].Locations, adjustedLocations...)
+			continue
+		}
+		if len(adjustedSymbols) >= limit {
+			continue
+		}
+
+		indexes[key] = len(adjustedSymbols)
+		adjustedSymbols = append(adjustedSymbols, AdjustedSymbol{
+			Scheme:     symbol.Scheme,
+			Identifier: symbol.Identifier,
+			Locations:  adjustedLocations,
+		})
+	}
+	traceLog(log.Int("numAdjustedSymbols", len(adjustedSymbols)))
+
+	return adjustedSymbols, nil
+}
@@ -0,0 +1,84 @@
+package resolvers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestSymbols(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockGitserverClient := NewMockGitserverClient()
+	mockPositionAdjuster := noopPositionAdjuster()
+
+	symbols := []lsifstore.Symbol{
+		{DumpID: 50, Scheme: "gomod", Identifier: "pkg.Foo", Locations: []lsifstore.Location{{DumpID: 50, Path: "a.go", Range: testRange1}}},
+		{DumpID: 51, Scheme: "gomod", Identifier: "pkg.Foo", Locations: []lsifstore.Location{{DumpID: 51, Path: "b.go", Range: testRange2}}},
+		{DumpID: 51, Scheme: "gomod", Identifier: "pkg.FooBar", Locations: []lsifstore.Location{{DumpID: 51, Path: "c.go", Range: testRange3}}},
+		{DumpID: 50, Scheme: "gomod", Identifier: "pkg.FooBaz", Locations: []lsifstore.Location{{DumpID: 50, Path: "d.go", Range: testRange4}}},
+	}
+	mockLSIFStore.SymbolsFunc.PushReturn(symbols, nil)
+
+	uploads := []dbstore.Dump{
+		{ID: 50, Commit: "deadbeef", Root: "sub1/"},
+		{ID: 51, Commit: "deadbeef", Root: "sub2/"},
+	}
+	resolver := newQueryResolver(
+		mockDBStore,
+		mockLSIFStore,
+		newCachedCommitChecker(mockGitserverClient),
+		mockPositionAdjuster,
+		nil,
+		42,
+		"deadbeef",
+		"s1/main.go",
+		uploads,
+		ResolverOptions{},
+		newOperations(&observation.TestContext),
+	)
+	adjustedSymbols, err := resolver.Symbols(context.Background(), "Foo", 2)
+	if err != nil {
+		t.Fatalf("unexpected error querying symbols: %s", err)
+	}
+
+	expectedSymbols := []AdjustedSymbol{
+		{
+			Scheme:     "gomod",
+			Identifier: "pkg.Foo",
+			Locations: []AdjustedLocation{
+				{Dump: uploads[0], Path: "sub1/a.go", AdjustedCommit: "deadbeef", AdjustedRange: testRange1},
+				{Dump: uploads[1], Path: "sub2/b.go", AdjustedCommit: "deadbeef", AdjustedRange: testRange2},
+			},
+		},
+		{
+			Scheme:     "gomod",
+			Identifier: "pkg.FooBar",
+			Locations: []AdjustedLocation{
+				{Dump: uploads[1], Path: "sub2/c.go", AdjustedCommit: "deadbeef", AdjustedRange: testRange3},
+			},
+		},
+	}
+	if diff := cmp.Diff(expectedSymbols, adjustedSymbols); diff != "" {
+		t.Errorf("unexpected symbols (-want +got):\n%s", diff)
+	}
+
+	if history := mockLSIFStore.SymbolsFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected number of calls to Symbols. want=%d have=%d", 1, len(history))
+	} else {
+		if diff := cmp.Diff([]int{50, 51}, history[0].Arg1); diff != "" {
+			t.Errorf("unexpected bundle ids (-want +got):\n%s", diff)
+		}
+		if history[0].Arg2 != "Foo" {
+			t.Errorf("unexpected query. want=%q have=%q", "Foo", history[0].Arg2)
+		}
+		if history[0].Arg3 != 4 {
+			t.Errorf("unexpected limit. want=%d have=%d", 4, history[0].Arg3)
+		}
+	}
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
 	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
 )
 
@@ -63,29 +65,123 @@ func (r *queryResolver) adjustUpload(ctx context.Context, line, character int, u
 	}, true, nil
 }
 
-// definitionUploads returns the set of uploads that provide any of the given monikers. This method will
-// not return uploads for commits which are unknown to gitserver.
+// documentKeys returns the keys of the adjusted target path within each of the given uploads.
+func documentKeys(adjustedUploads []adjustedUpload) []lsifstore.DocumentKey {
+	keys := make([]lsifstore.DocumentKey, 0, len(adjustedUploads))
+	for i := range adjustedUploads {
+		keys = append(keys, lsifstore.DocumentKey{
+			DumpID: adjustedUploads[i].Upload.ID,
+			Path:   adjustedUploads[i].AdjustedPathInBundle,
+		})
+	}
+
+	return keys
+}
+
+// positionKeys returns the keys of the adjusted target path and position within each of the
+// given uploads.
+func positionKeys(adjustedUploads []adjustedUpload) []lsifstore.PositionKey {
+	keys := make([]lsifstore.PositionKey, 0, len(adjustedUploads))
+	for i, key := range documentKeys(adjustedUploads) {
+		keys = append(keys, lsifstore.PositionKey{
+			DocumentKey: key,
+			Line:        adjustedUploads[i].AdjustedPosition.Line,
+			Character:   adjustedUploads[i].AdjustedPosition.Character,
+		})
+	}
+
+	return keys
+}
+
+// definitionUploadsLimit is the maximum number of uploads returned from definitionUploads.
+const definitionUploadsLimit = 10
+
+// definitionUploads returns the set of uploads that provide any of the given monikers. Uploads are
+// ordered by the first moniker they provide, and at most definitionUploadsLimit uploads are returned.
+// This method will not return uploads for commits which are unknown to gitserver.
 func (r *queryResolver) definitionUploads(ctx context.Context, orderedMonikers []semantic.QualifiedMonikerData) ([]store.Dump, error) {
-	uploads, err := r.dbStore.DefinitionDumps(ctx, orderedMonikers)
+	keys := make([]dbstore.PackageKey, 0, len(orderedMonikers))
+	seenKeys := make(map[dbstore.PackageKey]struct{}, len(orderedMonikers))
+	for _, moniker := range orderedMonikers {
+		key := dbstore.PackageKey{Scheme: moniker.Scheme, Name: moniker.Name, Version: moniker.Version}
+		if _, ok := seenKeys[key]; ok {
+			continue
+		}
+
+		seenKeys[key] = struct{}{}
+		keys = append(keys, key)
+	}
+
+	// Resolve the providing upload of every package in a single query rather than one per moniker
+	dumpsByPackage, err := r.dbStore.GetPackages(ctx, keys)
 	if err != nil {
-		return nil, errors.Wrap(err, "dbstore.DefinitionDumps")
+		return nil, errors.Wrap(err, "dbstore.GetPackages")
+	}
+
+	uploads := make([]store.Dump, 0, len(dumpsByPackage))
+	seenUploads := make(map[int]struct{}, len(dumpsByPackage))
+	for _, key := range keys {
+		dump, ok := dumpsByPackage[key]
+		if !ok {
+			continue
+		}
+		if _, ok := seenUploads[dump.ID]; ok {
+			continue
+		}
+
+		seenUploads[dump.ID] = struct{}{}
+		uploads = append(uploads, dump)
+
+		if len(uploads) >= definitionUploadsLimit {
+			break
+		}
 	}
 
 	return filterUploadsWithCommits(ctx, r.cachedCommitChecker, uploads)
 }
 
-// monikerLimit is the maximum number of monikers that can be returned from orderedMonikers.
-const monikerLimit = 10
+// siblingUploads returns the uploads that belong to the same dump group as one of the given adjusted
+// uploads but are not themselves part of the given slice. These are the uploads of the same repository,
+// commit, and indexer with a distinct root, which together with the visible uploads form a single logical
+// index. This method will not return uploads for commits which are unknown to gitserver.
+func (r *queryResolver) siblingUploads(ctx context.Context, adjustedUploads []adjustedUpload) ([]store.Dump, error) {
+	ids := make([]int, 0, len(adjustedUploads))
+	visible := make(map[int]struct{}, len(adjustedUploads))
+	for i := range adjustedUploads {
+		ids = append(ids, adjustedUploads[i].Upload.ID)
+		visible[adjustedUploads[i].Upload.ID] = struct{}{}
+	}
+
+	groups, err := r.dbStore.GetDumpGroups(ctx, ids)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbstore.GetDumpGroups")
+	}
+
+	var uploads []store.Dump
+	for _, group := range groups {
+		for _, dump := range group.Dumps {
+			if _, ok := visible[dump.ID]; !ok {
+				uploads = append(uploads, dump)
+			}
+		}
+	}
+
+	return filterUploadsWithCommits(ctx, r.cachedCommitChecker, uploads)
+}
 
 // orderedMonikers returns the set of monikers attached to the ranges specified by the given upload list.
 // If kind is a non-empty string, monikers with a distinct kind are ignored.
 //
 // The return slice is ordered by visible upload, then by specificity, i.e., monikers attached to enclosed
 // ranges before before monikers attached to enclosing ranges. Monikers are de-duplicated, such that the
-// second (third, ...) occurrences are removed.
+// second (third, ...) occurrences are removed. At most the configured moniker limit are returned.
 func (r *queryResolver) orderedMonikers(ctx context.Context, adjustedUploads []adjustedUpload, kind string) ([]semantic.QualifiedMonikerData, error) {
 	monikerSet := newQualifiedMonikerSet()
 
+	// Multiple ranges enclosing the target position frequently share the same package. Remember
+	// each package we've resolved so that we fetch the package information only once per upload.
+	packageInformation := map[packageInformationKey]semantic.PackageInformationData{}
+
 	for i := range adjustedUploads {
 		rangeMonikers, err := r.lsifStore.MonikersByPosition(
 			ctx,
@@ -104,14 +200,20 @@ func (r *queryResolver) orderedMonikers(ctx context.Context, adjustedUploads []a
 					continue
 				}
 
-				packageInformationData, _, err := r.lsifStore.PackageInformation(
-					ctx,
-					adjustedUploads[i].Upload.ID,
-					adjustedUploads[i].AdjustedPathInBundle,
-					string(moniker.PackageInformationID),
-				)
-				if err != nil {
-					return nil, errors.Wrap(err, "lsifStore.PackageInformation")
+				key := packageInformationKey{uploadID: adjustedUploads[i].Upload.ID, id: string(moniker.PackageInformationID)}
+				packageInformationData, ok := packageInformation[key]
+				if !ok {
+					packageInformationData, _, err = r.lsifStore.PackageInformation(
+						ctx,
+						adjustedUploads[i].Upload.ID,
+						adjustedUploads[i].AdjustedPathInBundle,
+						string(moniker.PackageInformationID),
+					)
+					if err != nil {
+						return nil, errors.Wrap(err, "lsifStore.PackageInformation")
+					}
+
+					packageInformation[key] = packageInformationData
 				}
 
 				monikerSet.add(semantic.QualifiedMonikerData{
@@ -119,7 +221,7 @@ func (r *queryResolver) orderedMonikers(ctx context.Context, adjustedUploads []a
 					PackageInformationData: packageInformationData,
 				})
 
-				if len(monikerSet.monikers) >= monikerLimit {
+				if monikerSet.len() >= r.options.MonikerLimit {
 					return monikerSet.monikers, nil
 				}
 			}
@@ -129,6 +231,12 @@ func (r *queryResolver) orderedMonikers(ctx context.Context, adjustedUploads []a
 	return monikerSet.monikers, nil
 }
 
+// packageInformationKey identifies a package information record within a particular upload.
+type packageInformationKey struct {
+	uploadID int
+	id       string
+}
+
 // monikerLocations returns the set of locations defined by any of the given uploads tagged with any of
 // the given monikers.
 func (r *queryResolver) monikerLocations(ctx context.Context, uploads []dbstore.Dump, orderedMonikers []semantic.QualifiedMonikerData, tableName string, limit, offset int) ([]lsifstore.Location, int, error) {
@@ -137,10 +245,9 @@ func (r *queryResolver) monikerLocations(ctx context.Context, uploads []dbstore.
 		ids = append(ids, uploads[i].ID)
 	}
 
-	args := make([]semantic.MonikerData, 0, len(orderedMonikers))
-	for _, moniker := range orderedMonikers {
-		args = append(args, moniker.MonikerData)
-	}
+	// Monikers from distinct packages may share a scheme and identifier. Searching for the same
+	// pair more than once does not change the result set, so we send each pair only once.
+	args := newQualifiedMonikerSet(orderedMonikers...).monikerData()
 
 	locations, totalCount, err := r.lsifStore.BulkMonikerResults(ctx, tableName, ids, args, limit, offset)
 	if err != nil {
@@ -155,7 +262,21 @@ func (r *queryResolver) monikerLocations(ctx context.Context, uploads []dbstore.
 func (r *queryResolver) adjustLocations(ctx context.Context, uploadsByID map[int]dbstore.Dump, locations []lsifstore.Location) ([]AdjustedLocation, error) {
 	adjustedLocations := make([]AdjustedLocation, 0, len(locations))
 	for _, location := range locations {
-		adjustedLocation, err := r.adjustLocation(ctx, uploadsByID[location.DumpID], location)
+		dump := uploadsByID[location.DumpID]
+
+		// 🚨 SECURITY: Omit locations in paths hidden by sub-repository permissions
+		perms, err := authz.ActorPermissions(ctx, authz.DefaultSubRepoPermsChecker, authz.RepoContent{
+			Repo: api.RepoName(dump.RepositoryName),
+			Path: dump.Root + location.Path,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !perms.Include(authz.Read) {
+			continue
+		}
+
+		adjustedLocation, err := r.adjustLocation(ctx, dump, location)
 		if err != nil {
 			return nil, err
 		}
@@ -201,6 +322,39 @@ func (r *queryResolver) adjustRange(ctx context.Context, repositoryID int, commi
 	return commit, rn, false, nil
 }
 
+// locationKey identifies an adjusted location independently of the upload it was read from.
+type locationKey struct {
+	repositoryID int
+	commit       string
+	path         string
+	rn           lsifstore.Range
+}
+
+// deduplicateLocations removes the adjusted locations that occur earlier in the given slice. Sibling
+// uploads of the same dump group may index the same file, in which case the same location is reported
+// once per upload. The slice is filtered in-place and returned (to update the slice length).
+func deduplicateLocations(locations []AdjustedLocation) []AdjustedLocation {
+	seen := make(map[locationKey]struct{}, len(locations))
+	filtered := locations[:0]
+
+	for _, location := range locations {
+		key := locationKey{
+			repositoryID: location.Dump.RepositoryID,
+			commit:       location.AdjustedCommit,
+			path:         location.Path,
+			rn:           location.AdjustedRange,
+		}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+
+		seen[key] = struct{}{}
+		filtered = append(filtered, location)
+	}
+
+	return filtered
+}
+
 // filterUploadsWithCommits removes the uploads for commits which are unknown to gitserver from the given
 // lice. The slice is filtered in-place and returned (to update the slice length).
 func filterUploadsWithCommits(ctx context.Context, cachedCommitChecker *cachedCommitChecker, uploads []dbstore.Dump) ([]dbstore.Dump, error) {
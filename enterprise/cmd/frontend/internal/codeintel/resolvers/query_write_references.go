@@ -0,0 +1,113 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+const slowWriteReferencesRequestThreshold = time.Second
+
+// WriteReferences returns the list of source locations at which the symbol at the given position is
+// written or assigned. This is the subset of References reported as write accesses by the indexer.
+//
+// Write references are gathered only from the indexes visible from the target commit. The moniker
+// location tables used to resolve references across indexes do not record the access kind of each
+// location, so references to the symbol from other repositories are not included.
+func (r *queryResolver) WriteReferences(ctx context.Context, line, character, limit int, rawCursor string) (_ []AdjustedLocation, _ string, err error) {
+	ctx, traceLog, endObservation := observeResolver(ctx, &err, "WriteReferences", r.operations.writeReferences, r.options.slowRequestThreshold("WriteReferences", slowWriteReferencesRequestThreshold), observation.Args{
+		LogFields: []log.Field{
+			log.Int("repositoryID", r.repositoryID),
+			log.String("commit", r.commit),
+			log.String("path", r.path),
+			log.Int("numUploads", len(r.uploads)),
+			log.String("uploads", uploadIDsToString(r.uploads)),
+			log.Int("line", line),
+			log.Int("character", character),
+		},
+	})
+	defer endObservation()
+
+	// Decode cursor given from previous response or create a new one with default values.
+	// This cursor will be modified in-place to become the cursor used to fetch the subsequent
+	// page of results in this result set.
+	cursor, err := decodeWriteReferencesCursor(rawCursor)
+	if err != nil {
+		return nil, "", errors.Wrap(err, fmt.Sprintf("invalid cursor: %q", rawCursor))
+	}
+
+	uploadsByID := make(map[int]dbstore.Dump, len(r.uploads))
+	for i := range r.uploads {
+		uploadsByID[r.uploads[i].ID] = r.uploads[i]
+	}
+
+	// Adjust the path and position for each visible upload based on its git difference to
+	// the target commit.
+
+	adjustedUploads, err := r.adjustUploads(ctx, line, character)
+	if err != nil {
+		return nil, "", err
+	}
+
+	locations, exhausted, err := r.pageWriteReferences(ctx, positionKeys(adjustedUploads), &cursor, limit)
+	if err != nil {
+		return nil, "", err
+	}
+	traceLog(log.Int("numLocations", len(locations)))
+
+	// Adjust the locations back to the appropriate range in the target commits. This adjusts
+	// locations within the repository the user is browsing so that it appears all write references
+	// are occurring at the same commit they are looking at.
+
+	adjustedLocations, err := r.adjustLocations(ctx, uploadsByID, locations)
+	if err != nil {
+		return nil, "", err
+	}
+	traceLog(log.Int("numAdjustedLocations", len(adjustedLocations)))
+
+	nextCursor := ""
+	if !exhausted {
+		nextCursor = encodeWriteReferencesCursor(cursor)
+	}
+
+	return adjustedLocations, nextCursor, nil
+}
+
+// pageWriteReferences returns a page of write reference locations for the symbols at the given positions.
+// The given cursor is advanced in-place past the returned locations. If the result sets of all positions
+// have been consumed, a true-valued flag is returned.
+func (r *queryResolver) pageWriteReferences(ctx context.Context, keys []lsifstore.PositionKey, cursor *writeReferencesCursor, limit int) ([]lsifstore.Location, bool, error) {
+	var locations []lsifstore.Location
+	for cursor.KeyOffset < len(keys) && len(locations) < limit {
+		key := keys[cursor.KeyOffset]
+
+		page, totalCount, err := r.lsifStore.WriteAccessReferences(
+			ctx,
+			key.DumpID,
+			key.Path,
+			key.Line,
+			key.Character,
+			limit-len(locations),
+			cursor.Offset,
+		)
+		if err != nil {
+			return nil, false, errors.Wrap(err, "lsifStore.WriteAccessReferences")
+		}
+		locations = append(locations, page...)
+
+		if cursor.Offset += len(page); len(page) == 0 || cursor.Offset >= totalCount {
+			// Move on to the next position
+			cursor.KeyOffset++
+			cursor.Offset = 0
+		}
+	}
+
+	return locations, cursor.KeyOffset >= len(keys), nil
+}
@@ -0,0 +1,36 @@
+package resolvers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+)
+
+// writeReferencesCursor stores the state of a previous WriteReferences request used to calculate
+// the offset into the result set to be returned by the current request.
+type writeReferencesCursor struct {
+	KeyOffset int `json:"keyOffset"`
+	Offset    int `json:"offset"`
+}
+
+// decodeWriteReferencesCursor is the inverse of encodeWriteReferencesCursor. If the given encoded
+// string is empty, then a fresh cursor is returned.
+func decodeWriteReferencesCursor(rawEncoded string) (writeReferencesCursor, error) {
+	if rawEncoded == "" {
+		return writeReferencesCursor{}, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(rawEncoded)
+	if err != nil {
+		return writeReferencesCursor{}, err
+	}
+
+	var cursor writeReferencesCursor
+	err = json.Unmarshal(raw, &cursor)
+	return cursor, err
+}
+
+// encodeWriteReferencesCursor returns an encoding of the given cursor suitable for a URL or a GraphQL token.
+func encodeWriteReferencesCursor(cursor writeReferencesCursor) string {
+	rawEncoded, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(rawEncoded)
+}
@@ -0,0 +1,71 @@
+package resolvers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestWriteReferences(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockGitserverClient := NewMockGitserverClient()
+	mockPositionAdjuster := noopPositionAdjuster()
+
+	locations := []lsifstore.Location{
+		{DumpID: 50, Path: "a.go", Range: testRange1},
+		{DumpID: 50, Path: "b.go", Range: testRange2},
+		{DumpID: 51, Path: "c.go", Range: testRange3},
+	}
+	mockLSIFStore.WriteAccessReferencesFunc.PushReturn(locations[:2], 2, nil)
+	mockLSIFStore.WriteAccessReferencesFunc.PushReturn(locations[2:], 4, nil)
+
+	uploads := []dbstore.Dump{
+		{ID: 50, Commit: "deadbeef", Root: "sub1/"},
+		{ID: 51, Commit: "deadbeef", Root: "sub2/"},
+	}
+	resolver := newQueryResolver(
+		mockDBStore,
+		mockLSIFStore,
+		newCachedCommitChecker(mockGitserverClient),
+		mockPositionAdjuster,
+		nil,
+		42,
+		"deadbeef",
+		"s1/main.go",
+		uploads,
+		ResolverOptions{},
+		newOperations(&observation.TestContext),
+	)
+	adjustedLocations, cursor, err := resolver.WriteReferences(context.Background(), 10, 20, 3, "")
+	if err != nil {
+		t.Fatalf("unexpected error querying write references: %s", err)
+	}
+
+	expectedLocations := []AdjustedLocation{
+		{Dump: uploads[0], Path: "sub1/a.go", AdjustedCommit: "deadbeef", AdjustedRange: testRange1},
+		{Dump: uploads[0], Path: "sub1/b.go", AdjustedCommit: "deadbeef", AdjustedRange: testRange2},
+		{Dump: uploads[1], Path: "sub2/c.go", AdjustedCommit: "deadbeef", AdjustedRange: testRange3},
+	}
+	if diff := cmp.Diff(expectedLocations, adjustedLocations); diff != "" {
+		t.Errorf("unexpected locations (-want +got):\n%s", diff)
+	}
+
+	if history := mockLSIFStore.WriteAccessReferencesFunc.History(); len(history) != 2 {
+		t.Fatalf("unexpected number of calls to WriteAccessReferences. want=%d have=%d", 2, len(history))
+	} else if history[1].Arg5 != 1 {
+		t.Errorf("unexpected limit. want=%d have=%d", 1, history[1].Arg5)
+	}
+
+	// The second upload has more write references; the next page resumes within it
+	if decoded, err := decodeWriteReferencesCursor(cursor); err != nil {
+		t.Fatalf("unexpected error decoding cursor: %s", err)
+	} else if diff := cmp.Diff(writeReferencesCursor{KeyOffset: 1, Offset: 1}, decoded); diff != "" {
+		t.Errorf("unexpected cursor (-want +got):\n%s", diff)
+	}
+}
@@ -6,10 +6,14 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/opentracing/opentracing-go/log"
 
 	gql "github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
 	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
 )
@@ -23,16 +27,25 @@ type Resolver interface {
 	GetUploadByID(ctx context.Context, id int) (store.Upload, bool, error)
 	GetIndexByID(ctx context.Context, id int) (store.Index, bool, error)
 	GetUploadsByIDs(ctx context.Context, ids ...int) ([]store.Upload, error)
+	GetUploadProgress(ctx context.Context, uploadID int) (store.UploadProgress, bool, error)
 	GetIndexesByIDs(ctx context.Context, ids ...int) ([]store.Index, error)
 	UploadConnectionResolver(opts store.GetUploadsOptions) *UploadsResolver
 	IndexConnectionResolver(opts store.GetIndexesOptions) *IndexesResolver
 	DeleteUploadByID(ctx context.Context, uploadID int) error
+	ReindexUploadByID(ctx context.Context, uploadID int) error
 	DeleteIndexByID(ctx context.Context, id int) error
 	IndexConfiguration(ctx context.Context, repositoryID int) ([]byte, error)
 	UpdateIndexConfigurationByRepositoryID(ctx context.Context, repositoryID int, configuration string) error
+	RetentionPolicies(ctx context.Context) ([]store.RetentionPolicy, error)
+	CreateRetentionPolicy(ctx context.Context, policy store.RetentionPolicy) (store.RetentionPolicy, error)
+	UpdateRetentionPolicy(ctx context.Context, policy store.RetentionPolicy) error
+	DeleteRetentionPolicyByID(ctx context.Context, id int) error
+	UsageStats(ctx context.Context, since time.Time) ([]store.UsageStatistics, error)
 	CommitGraph(ctx context.Context, repositoryID int) (gql.CodeIntelligenceCommitGraphResolver, error)
 	QueueAutoIndexJobForRepo(ctx context.Context, repositoryID int) error
 	QueryResolver(ctx context.Context, args *gql.GitBlobLSIFDataArgs) (QueryResolver, error)
+	AggregateDiagnostics(ctx context.Context, repositoryID int, opts lsifstore.AggregateDiagnosticsOptions) ([]AdjustedDiagnostic, int, []lsifstore.DiagnosticGroup, error)
+	Coverage(ctx context.Context, repositoryID int, branch string, numCommits int) (Coverage, error)
 }
 
 type resolver struct {
@@ -40,7 +53,9 @@ type resolver struct {
 	lsifStore       LSIFStore
 	gitserverClient GitserverClient
 	indexEnqueuer   IndexEnqueuer
+	searchClient    SearchClient
 	hunkCache       HunkCache
+	options         ResolverOptions
 	operations      *operations
 }
 
@@ -50,10 +65,12 @@ func NewResolver(
 	lsifStore LSIFStore,
 	gitserverClient GitserverClient,
 	indexEnqueuer IndexEnqueuer,
+	searchClient SearchClient,
 	hunkCache HunkCache,
+	options ResolverOptions,
 	observationContext *observation.Context,
 ) Resolver {
-	return newResolver(dbStore, lsifStore, gitserverClient, indexEnqueuer, hunkCache, observationContext)
+	return newResolver(dbStore, lsifStore, gitserverClient, indexEnqueuer, searchClient, hunkCache, options, observationContext)
 }
 
 func newResolver(
@@ -61,7 +78,9 @@ func newResolver(
 	lsifStore LSIFStore,
 	gitserverClient GitserverClient,
 	indexEnqueuer IndexEnqueuer,
+	searchClient SearchClient,
 	hunkCache HunkCache,
+	options ResolverOptions,
 	observationContext *observation.Context,
 ) *resolver {
 	return &resolver{
@@ -69,7 +88,9 @@ func newResolver(
 		lsifStore:       lsifStore,
 		gitserverClient: gitserverClient,
 		indexEnqueuer:   indexEnqueuer,
+		searchClient:    searchClient,
 		hunkCache:       hunkCache,
+		options:         options,
 		operations:      newOperations(observationContext),
 	}
 }
@@ -86,6 +107,10 @@ func (r *resolver) GetUploadsByIDs(ctx context.Context, ids ...int) ([]store.Upl
 	return r.dbStore.GetUploadsByIDs(ctx, ids...)
 }
 
+func (r *resolver) GetUploadProgress(ctx context.Context, uploadID int) (store.UploadProgress, bool, error) {
+	return r.dbStore.GetUploadProgress(ctx, uploadID)
+}
+
 func (r *resolver) GetIndexesByIDs(ctx context.Context, ids ...int) ([]store.Index, error) {
 	return r.dbStore.GetIndexesByIDs(ctx, ids...)
 }
@@ -103,6 +128,21 @@ func (r *resolver) DeleteUploadByID(ctx context.Context, uploadID int) error {
 	return err
 }
 
+// ErrIllegalReindex occurs when reindexing an upload that does not exist or has not errored.
+var ErrIllegalReindex = errors.New("only errored uploads can be reindexed")
+
+func (r *resolver) ReindexUploadByID(ctx context.Context, uploadID int) error {
+	requeued, err := r.dbStore.ReindexUploadByID(ctx, uploadID)
+	if err != nil {
+		return err
+	}
+	if !requeued {
+		return ErrIllegalReindex
+	}
+
+	return nil
+}
+
 func (r *resolver) DeleteIndexByID(ctx context.Context, id int) error {
 	_, err := r.dbStore.DeleteIndexByID(ctx, id)
 	return err
@@ -142,6 +182,36 @@ func (r *resolver) UpdateIndexConfigurationByRepositoryID(ctx context.Context, r
 	return r.dbStore.UpdateIndexConfigurationByRepositoryID(ctx, repositoryID, []byte(configuration))
 }
 
+func (r *resolver) RetentionPolicies(ctx context.Context) ([]store.RetentionPolicy, error) {
+	return r.dbStore.GetRetentionPolicies(ctx)
+}
+
+func (r *resolver) CreateRetentionPolicy(ctx context.Context, policy store.RetentionPolicy) (store.RetentionPolicy, error) {
+	if err := validateRetentionPolicy(policy); err != nil {
+		return store.RetentionPolicy{}, err
+	}
+
+	return r.dbStore.CreateRetentionPolicy(ctx, policy)
+}
+
+func (r *resolver) UpdateRetentionPolicy(ctx context.Context, policy store.RetentionPolicy) error {
+	if err := validateRetentionPolicy(policy); err != nil {
+		return err
+	}
+
+	_, err := r.dbStore.UpdateRetentionPolicy(ctx, policy)
+	return err
+}
+
+func (r *resolver) DeleteRetentionPolicyByID(ctx context.Context, id int) error {
+	_, err := r.dbStore.DeleteRetentionPolicyByID(ctx, id)
+	return err
+}
+
+func (r *resolver) UsageStats(ctx context.Context, since time.Time) ([]store.UsageStatistics, error) {
+	return r.dbStore.UsageStatistics(ctx, since)
+}
+
 func (r *resolver) CommitGraph(ctx context.Context, repositoryID int) (gql.CodeIntelligenceCommitGraphResolver, error) {
 	stale, updatedAt, err := r.dbStore.CommitGraphMetadata(ctx, repositoryID)
 	if err != nil {
@@ -157,11 +227,20 @@ func (r *resolver) QueueAutoIndexJobForRepo(ctx context.Context, repositoryID in
 
 const slowQueryResolverRequestThreshold = time.Second
 
+// resolverOptions returns the options given to the resolver overridden by the current site configuration.
+func (r *resolver) resolverOptions() ResolverOptions {
+	return r.options.withSiteConfig(conf.Get().SiteConfiguration)
+}
+
 // QueryResolver determines the set of dumps that can answer code intel queries for the
 // given repository, commit, and path, then constructs a new query resolver instance which
 // can be used to answer subsequent queries.
 func (r *resolver) QueryResolver(ctx context.Context, args *gql.GitBlobLSIFDataArgs) (_ QueryResolver, err error) {
-	ctx, _, endObservation := observeResolver(ctx, &err, "QueryResolver", r.operations.queryResolver, slowQueryResolverRequestThreshold, observation.Args{
+	// Read the options once so that every query made through the returned resolver is
+	// answered with the same limits, even if the site configuration changes meanwhile.
+	options := r.resolverOptions()
+
+	ctx, _, endObservation := observeResolver(ctx, &err, "QueryResolver", r.operations.queryResolver, options.slowRequestThreshold("QueryResolver", slowQueryResolverRequestThreshold), observation.Args{
 		LogFields: []log.Field{
 			log.Int("repositoryID", int(args.Repo.ID)),
 			log.String("commit", string(args.Commit)),
@@ -172,6 +251,15 @@ func (r *resolver) QueryResolver(ctx context.Context, args *gql.GitBlobLSIFDataA
 	})
 	defer endObservation()
 
+	// 🚨 SECURITY: Paths hidden by sub-repository permissions have no code intelligence
+	perms, err := authz.ActorPermissions(ctx, authz.DefaultSubRepoPermsChecker, authz.RepoContent{Repo: args.Repo.Name, Path: args.Path})
+	if err != nil {
+		return nil, err
+	}
+	if !perms.Include(authz.Read) {
+		return nil, nil
+	}
+
 	cachedCommitChecker := newCachedCommitChecker(r.gitserverClient)
 	cachedCommitChecker.set(int(args.Repo.ID), string(args.Commit))
 
@@ -183,20 +271,51 @@ func (r *resolver) QueryResolver(ctx context.Context, args *gql.GitBlobLSIFDataA
 		args.Path,
 		args.ExactPath,
 		args.ToolName,
+		options.InterpolationWindow,
 	)
-	if err != nil || len(dumps) == 0 {
+	if err != nil {
 		return nil, err
 	}
+	if len(dumps) == 0 && !(options.SearchBasedFallback && r.searchClient != nil) {
+		// Without uploads, only search-based code intelligence can answer queries
+		return nil, nil
+	}
+
+	adjuster := &positionAdjuster{
+		repo:            args.Repo,
+		commit:          string(args.Commit),
+		hunkCache:       r.hunkCache,
+		gitserverClient: r.gitserverClient,
+	}
+
+	// Every query adjusts the target path from the requested commit into the commit of each
+	// dump. Fetch all of these diffs in one batch rather than one gitserver request per dump.
+	commits := make([]string, 0, len(dumps))
+	for _, dump := range dumps {
+		commits = append(commits, dump.Commit)
+	}
+	if err := adjuster.prefetchHunks(ctx, commits, args.Path, false); err != nil {
+		return nil, err
+	}
+
+	if options.InterpolationWindow != 0 {
+		// Prefer results from the interpolated dumps that differ least from the requested commit
+		if err := rankDumpsByDiffOverlap(ctx, adjuster, args.Path, dumps); err != nil {
+			return nil, err
+		}
+	}
 
 	return NewQueryResolver(
 		r.dbStore,
 		r.lsifStore,
 		cachedCommitChecker,
-		NewPositionAdjuster(args.Repo, string(args.Commit), r.hunkCache),
+		adjuster,
+		r.searchClient,
 		int(args.Repo.ID),
 		string(args.Commit),
 		args.Path,
 		dumps,
+		options,
 		r.operations,
 	), nil
 }
@@ -0,0 +1,29 @@
+package resolvers
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/gobwas/glob"
+
+	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+)
+
+// validateRetentionPolicy returns an error if the given retention policy has an unknown type, an
+// invalid glob pattern, or a negative retention duration.
+func validateRetentionPolicy(policy store.RetentionPolicy) error {
+	if policy.Type != store.RetentionPolicyTypeBranch && policy.Type != store.RetentionPolicyTypeTag {
+		return errors.Errorf("illegal retention policy type %q", policy.Type)
+	}
+
+	if _, err := glob.Compile(policy.RepositoryPattern); err != nil {
+		return errors.Wrap(err, "illegal repository pattern")
+	}
+	if _, err := glob.Compile(policy.Pattern); err != nil {
+		return errors.Wrap(err, "illegal pattern")
+	}
+
+	if policy.RetentionDuration != nil && *policy.RetentionDuration < 0 {
+		return errors.New("illegal retention duration")
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"reflect"
 	"sync"
 
 	"github.com/inconshreveable/log15"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/autoindex/enqueuer"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/gitserver"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/searchclient"
 	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/uploadstore"
@@ -26,13 +28,15 @@ import (
 )
 
 var services struct {
-	dbStore         *store.Store
-	locker          *locker.Locker
-	lsifStore       *lsifstore.Store
-	uploadStore     uploadstore.Store
-	gitserverClient *gitserver.Client
-	indexEnqueuer   *enqueuer.IndexEnqueuer
-	err             error
+	dbStore          *store.Store
+	locker           *locker.Locker
+	lsifStore        *lsifstore.Store
+	shardedLSIFStore *lsifstore.ShardedStore
+	uploadStore      uploadstore.Store
+	gitserverClient  *gitserver.Client
+	searchClient     *searchclient.Client
+	indexEnqueuer    *enqueuer.IndexEnqueuer
+	err              error
 }
 
 var once sync.Once
@@ -53,11 +57,13 @@ func initServices(ctx context.Context, db dbutil.DB) error {
 
 		// Connect to database
 		codeIntelDB := mustInitializeCodeIntelDB()
+		codeIntelShardDBs := mustInitializeCodeIntelShardDBs()
 
 		// Initialize stores
 		dbStore := store.NewWithDB(db, observationContext)
 		locker := locker.NewWithDB(db, "codeintel")
 		lsifStore := lsifstore.NewStore(codeIntelDB, observationContext)
+		shardedLSIFStore := newShardedLSIFStore(lsifStore, codeIntelShardDBs, observationContext)
 		uploadStore, err := uploadstore.CreateLazy(context.Background(), config.UploadStoreConfig, observationContext)
 		if err != nil {
 			log.Fatalf("Failed to initialize upload store: %s", err)
@@ -66,20 +72,44 @@ func initServices(ctx context.Context, db dbutil.DB) error {
 		// Initialize gitserver client
 		gitserverClient := gitserver.New(dbStore, observationContext)
 
+		// Initialize search client
+		searchClient := searchclient.New(dbStore, observationContext)
+
 		// Initialize the index enqueuer
 		indexEnqueuer := enqueuer.NewIndexEnqueuer(&enqueuer.DBStoreShim{dbStore}, gitserverClient, repoupdater.DefaultClient, observationContext)
 
 		services.dbStore = dbStore
 		services.locker = locker
 		services.lsifStore = lsifStore
+		services.shardedLSIFStore = shardedLSIFStore
 		services.uploadStore = uploadStore
 		services.gitserverClient = gitserverClient
+		services.searchClient = searchClient
 		services.indexEnqueuer = indexEnqueuer
 	})
 
 	return services.err
 }
 
+// UploadStore returns the blob store holding precise code intelligence uploads. Other features
+// share this store to persist large files rather than configuring a bucket of their own.
+func UploadStore(ctx context.Context, db dbutil.DB) (uploadstore.Store, error) {
+	if err := initServices(ctx, db); err != nil {
+		return nil, err
+	}
+
+	return services.uploadStore, nil
+}
+
+// DBStore returns the store of code intelligence uploads and indexes in the frontend database.
+func DBStore(ctx context.Context, db dbutil.DB) (*store.Store, error) {
+	if err := initServices(ctx, db); err != nil {
+		return nil, err
+	}
+
+	return services.dbStore, nil
+}
+
 func mustInitializeCodeIntelDB() *sql.DB {
 	postgresDSN := conf.Get().ServiceConnections.CodeIntelPostgresDSN
 	conf.Watch(func() {
@@ -99,3 +129,41 @@ func mustInitializeCodeIntelDB() *sql.DB {
 
 	return db
 }
+
+// mustInitializeCodeIntelShardDBs connects to the additional codeintel databases configured in the
+// site configuration. The primary codeintel database is not included in the result.
+func mustInitializeCodeIntelShardDBs() []*sql.DB {
+	postgresDSNs := conf.Get().CodeIntelDatabaseShards
+	conf.Watch(func() {
+		if newDSNs := conf.Get().CodeIntelDatabaseShards; !reflect.DeepEqual(postgresDSNs, newDSNs) {
+			log.Fatalf("Detected codeintel database shards change, restarting to take effect")
+		}
+	})
+
+	dbs := make([]*sql.DB, 0, len(postgresDSNs))
+	for i, postgresDSN := range postgresDSNs {
+		db, err := dbconn.New(postgresDSN, fmt.Sprintf("_codeintel_shard%d", i+1))
+		if err != nil {
+			log.Fatalf("Failed to connect to codeintel database shard %d: %s", i+1, err)
+		}
+
+		if err := dbconn.MigrateDB(db, dbconn.CodeIntel); err != nil {
+			log.Fatalf("Failed to perform codeintel database migration on shard %d: %s", i+1, err)
+		}
+
+		dbs = append(dbs, db)
+	}
+
+	return dbs
+}
+
+// newShardedLSIFStore creates a store that routes reads across the primary codeintel database and
+// the given additional shards.
+func newShardedLSIFStore(primary *lsifstore.Store, shardDBs []*sql.DB, observationContext *observation.Context) *lsifstore.ShardedStore {
+	shards := []*lsifstore.Store{primary}
+	for _, db := range shardDBs {
+		shards = append(shards, lsifstore.NewStore(db, observationContext))
+	}
+
+	return lsifstore.NewShardedStore(shards...)
+}
@@ -0,0 +1,367 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/auth"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// groupResource is the SCIM representation of an org.
+type groupResource struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id,omitempty"`
+	ExternalID  string      `json:"externalId,omitempty"`
+	DisplayName string      `json:"displayName"`
+	Members     []memberRef `json:"members,omitempty"`
+	Meta        *meta       `json:"meta,omitempty"`
+}
+
+func (h *handler) toGroupResource(ctx context.Context, org *types.Org) (*groupResource, error) {
+	m := newMeta("Group", org.ID, org.CreatedAt, org.UpdatedAt)
+	res := &groupResource{
+		Schemas:     []string{schemaGroup},
+		ID:          strconv.Itoa(int(org.ID)),
+		DisplayName: org.Name,
+		Meta:        &m,
+	}
+	if org.DisplayName != nil && *org.DisplayName != "" {
+		res.DisplayName = *org.DisplayName
+	}
+
+	members, err := database.OrgMembers(h.db).GetByOrgID(ctx, org.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range members {
+		res.Members = append(res.Members, memberRef{Value: strconv.Itoa(int(member.UserID))})
+	}
+	return res, nil
+}
+
+func (h *handler) getGroup(r *http.Request) (int, interface{}, error) {
+	id, err := resourceID(r, "Group")
+	if err != nil {
+		return 0, nil, err
+	}
+	org, err := database.Orgs(h.db).GetByID(r.Context(), id)
+	if err != nil {
+		return 0, nil, mapNotFound(err, "Group", id)
+	}
+	res, err := h.toGroupResource(r.Context(), org)
+	return http.StatusOK, res, err
+}
+
+func (h *handler) listGroups(r *http.Request) (int, interface{}, error) {
+	ctx := r.Context()
+	f, err := parseFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		return 0, nil, err
+	}
+	startIndex, count := pagination(r)
+
+	var orgs []*types.Org
+	var total int
+	if f != nil {
+		if f.attribute != "displayname" {
+			return 0, nil, badRequest("invalidFilter", "filtering groups by %q is not supported", f.attribute)
+		}
+		org, err := h.orgByDisplayName(ctx, f.value)
+		if err != nil {
+			return 0, nil, err
+		}
+		if org != nil {
+			total = 1
+			if startIndex == 1 && count > 0 {
+				orgs = []*types.Org{org}
+			}
+		}
+	} else {
+		if total, err = database.Orgs(h.db).Count(ctx, database.OrgsListOptions{}); err != nil {
+			return 0, nil, err
+		}
+		if orgs, err = database.Orgs(h.db).List(ctx, &database.OrgsListOptions{
+			LimitOffset: &database.LimitOffset{Limit: count, Offset: startIndex - 1},
+		}); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	resources := make([]*groupResource, 0, len(orgs))
+	for _, org := range orgs {
+		res, err := h.toGroupResource(ctx, org)
+		if err != nil {
+			return 0, nil, err
+		}
+		resources = append(resources, res)
+	}
+	return http.StatusOK, &listResponse{
+		Schemas:      []string{schemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}, nil
+}
+
+// orgByDisplayName returns the org whose display name is displayName, or
+// whose name is its normalized form. It returns nil if there is none.
+func (h *handler) orgByDisplayName(ctx context.Context, displayName string) (*types.Org, error) {
+	if name, err := auth.NormalizeUsername(displayName); err == nil {
+		org, err := database.Orgs(h.db).GetByName(ctx, name)
+		if err == nil {
+			return org, nil
+		}
+		if !errcode.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	orgs, err := database.Orgs(h.db).List(ctx, &database.OrgsListOptions{Query: displayName})
+	if err != nil {
+		return nil, err
+	}
+	for _, org := range orgs {
+		if org.DisplayName != nil && strings.EqualFold(*org.DisplayName, displayName) {
+			return org, nil
+		}
+	}
+	return nil, nil
+}
+
+func (h *handler) createGroup(r *http.Request) (int, interface{}, error) {
+	ctx := r.Context()
+	var req groupResource
+	if err := readJSON(r, &req); err != nil {
+		return 0, nil, err
+	}
+	name, err := auth.NormalizeUsername(req.DisplayName)
+	if err != nil {
+		return 0, nil, badRequest("invalidValue", "invalid displayName: %s", err)
+	}
+
+	if _, err := database.Orgs(h.db).GetByName(ctx, name); err == nil {
+		return 0, nil, &scimError{status: http.StatusConflict, scimType: "uniqueness", detail: "organization name is already taken"}
+	} else if !errcode.IsNotFound(err) {
+		return 0, nil, err
+	}
+
+	org, err := database.Orgs(h.db).Create(ctx, name, &req.DisplayName)
+	if err != nil {
+		return 0, nil, err
+	}
+	if err := h.setMembers(ctx, org.ID, req.Members); err != nil {
+		return 0, nil, err
+	}
+	res, err := h.toGroupResource(ctx, org)
+	return http.StatusCreated, res, err
+}
+
+func (h *handler) replaceGroup(r *http.Request) (int, interface{}, error) {
+	ctx := r.Context()
+	id, err := resourceID(r, "Group")
+	if err != nil {
+		return 0, nil, err
+	}
+	var req groupResource
+	if err := readJSON(r, &req); err != nil {
+		return 0, nil, err
+	}
+
+	org, err := database.Orgs(h.db).Update(ctx, id, &req.DisplayName)
+	if err != nil {
+		return 0, nil, mapNotFound(err, "Group", id)
+	}
+	if err := h.setMembers(ctx, id, req.Members); err != nil {
+		return 0, nil, err
+	}
+	res, err := h.toGroupResource(ctx, org)
+	return http.StatusOK, res, err
+}
+
+func (h *handler) patchGroup(r *http.Request) (int, interface{}, error) {
+	ctx := r.Context()
+	id, err := resourceID(r, "Group")
+	if err != nil {
+		return 0, nil, err
+	}
+	var req patchRequest
+	if err := readJSON(r, &req); err != nil {
+		return 0, nil, err
+	}
+	if _, err := database.Orgs(h.db).GetByID(ctx, id); err != nil {
+		return 0, nil, mapNotFound(err, "Group", id)
+	}
+
+	for _, op := range req.Operations {
+		switch op.op() {
+		case "add", "replace":
+			attrs, err := op.attributes()
+			if err != nil {
+				return 0, nil, err
+			}
+			for path, value := range attrs {
+				if err := h.applyGroupAttribute(ctx, id, op.op(), path, value); err != nil {
+					return 0, nil, err
+				}
+			}
+
+		case "remove":
+			if userID, ok := memberPathValue(op.Path); ok {
+				if err := h.removeMembers(ctx, id, []memberRef{{Value: userID}}); err != nil {
+					return 0, nil, err
+				}
+				continue
+			}
+			if !strings.EqualFold(op.Path, "members") {
+				return 0, nil, badRequest("noTarget", "unsupported path %q for remove operation on group", op.Path)
+			}
+			var members []memberRef
+			if len(op.Value) > 0 {
+				if err := json.Unmarshal(op.Value, &members); err != nil {
+					return 0, nil, badRequest("invalidValue", "invalid members %s", op.Value)
+				}
+			}
+			if len(members) == 0 {
+				// Removing members without a value removes all of them.
+				if err := h.setMembers(ctx, id, nil); err != nil {
+					return 0, nil, err
+				}
+				continue
+			}
+			if err := h.removeMembers(ctx, id, members); err != nil {
+				return 0, nil, err
+			}
+
+		default:
+			return 0, nil, badRequest("invalidValue", "unsupported operation %q on group", op.Op)
+		}
+	}
+
+	org, err := database.Orgs(h.db).GetByID(ctx, id)
+	if err != nil {
+		return 0, nil, err
+	}
+	res, err := h.toGroupResource(ctx, org)
+	return http.StatusOK, res, err
+}
+
+// applyGroupAttribute applies an add or replace operation on the attribute
+// at path. Unsupported attributes are ignored.
+func (h *handler) applyGroupAttribute(ctx context.Context, orgID int32, op, path string, value json.RawMessage) error {
+	switch path {
+	case "displayname":
+		displayName, err := parseString(value)
+		if err != nil {
+			return err
+		}
+		_, err = database.Orgs(h.db).Update(ctx, orgID, &displayName)
+		return err
+	case "members":
+		var members []memberRef
+		if err := json.Unmarshal(value, &members); err != nil {
+			return badRequest("invalidValue", "invalid members %s", value)
+		}
+		if op == "replace" {
+			return h.setMembers(ctx, orgID, members)
+		}
+		return h.addMembers(ctx, orgID, members)
+	}
+	return nil
+}
+
+// parseMemberIDs parses the user IDs of members.
+func parseMemberIDs(members []memberRef) ([]int32, error) {
+	ids := make([]int32, 0, len(members))
+	for _, m := range members {
+		id, err := strconv.ParseInt(m.Value, 10, 32)
+		if err != nil {
+			return nil, badRequest("invalidValue", "invalid member %q", m.Value)
+		}
+		ids = append(ids, int32(id))
+	}
+	return ids, nil
+}
+
+// addMembers adds the users in members to the org, skipping the ones which
+// are already members.
+func (h *handler) addMembers(ctx context.Context, orgID int32, members []memberRef) error {
+	userIDs, err := parseMemberIDs(members)
+	if err != nil {
+		return err
+	}
+	for _, userID := range userIDs {
+		if _, err := database.OrgMembers(h.db).GetByOrgIDAndUserID(ctx, orgID, userID); err == nil {
+			continue
+		} else if !errcode.IsNotFound(err) {
+			return err
+		}
+		if _, err := database.Users(h.db).GetByID(ctx, userID); err != nil {
+			if errcode.IsNotFound(err) {
+				return badRequest("invalidValue", "member %d is not a user", userID)
+			}
+			return err
+		}
+		if _, err := database.OrgMembers(h.db).Create(ctx, orgID, userID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *handler) removeMembers(ctx context.Context, orgID int32, members []memberRef) error {
+	userIDs, err := parseMemberIDs(members)
+	if err != nil {
+		return err
+	}
+	for _, userID := range userIDs {
+		if err := database.OrgMembers(h.db).Remove(ctx, orgID, userID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setMembers makes the users in members the only members of the org.
+func (h *handler) setMembers(ctx context.Context, orgID int32, members []memberRef) error {
+	userIDs, err := parseMemberIDs(members)
+	if err != nil {
+		return err
+	}
+	want := make(map[int32]bool, len(userIDs))
+	for _, id := range userIDs {
+		want[id] = true
+	}
+
+	current, err := database.OrgMembers(h.db).GetByOrgID(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	var remove []memberRef
+	for _, m := range current {
+		if !want[m.UserID] {
+			remove = append(remove, memberRef{Value: strconv.Itoa(int(m.UserID))})
+		}
+	}
+	if err := h.removeMembers(ctx, orgID, remove); err != nil {
+		return err
+	}
+	return h.addMembers(ctx, orgID, members)
+}
+
+func (h *handler) deleteGroup(r *http.Request) (int, interface{}, error) {
+	id, err := resourceID(r, "Group")
+	if err != nil {
+		return 0, nil, err
+	}
+	if err := database.Orgs(h.db).Delete(r.Context(), id); err != nil {
+		return 0, nil, mapNotFound(err, "Group", id)
+	}
+	return http.StatusNoContent, nil, nil
+}
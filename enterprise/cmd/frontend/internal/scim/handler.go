@@ -0,0 +1,235 @@
+// Package scim implements the SCIM 2.0 provisioning API (RFC 7643 and RFC 7644), which
+// enables identity providers to manage Sourcegraph users and orgs. Orgs are exposed as
+// SCIM groups.
+package scim
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/globals"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+)
+
+const (
+	schemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	schemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	schemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	schemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	schemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	schemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+
+	// basePath is the path of the API, relative to the external URL.
+	basePath = "/.api/scim/v2"
+
+	// maxResults is the maximum number of resources returned by list requests.
+	maxResults = 100
+)
+
+type handler struct {
+	db dbutil.DB
+}
+
+func newHandler(db dbutil.DB) http.Handler {
+	h := &handler{db: db}
+
+	r := mux.NewRouter().PathPrefix(basePath).Subrouter()
+	r.Path("/ServiceProviderConfig").Methods("GET").HandlerFunc(serveServiceProviderConfig)
+	r.Path("/Users").Methods("GET").Handler(h.serve(h.listUsers))
+	r.Path("/Users").Methods("POST").Handler(h.serve(h.createUser))
+	r.Path("/Users/{id}").Methods("GET").Handler(h.serve(h.getUser))
+	r.Path("/Users/{id}").Methods("PUT").Handler(h.serve(h.replaceUser))
+	r.Path("/Users/{id}").Methods("PATCH").Handler(h.serve(h.patchUser))
+	r.Path("/Users/{id}").Methods("DELETE").Handler(h.serve(h.deleteUser))
+	r.Path("/Groups").Methods("GET").Handler(h.serve(h.listGroups))
+	r.Path("/Groups").Methods("POST").Handler(h.serve(h.createGroup))
+	r.Path("/Groups/{id}").Methods("GET").Handler(h.serve(h.getGroup))
+	r.Path("/Groups/{id}").Methods("PUT").Handler(h.serve(h.replaceGroup))
+	r.Path("/Groups/{id}").Methods("PATCH").Handler(h.serve(h.patchGroup))
+	r.Path("/Groups/{id}").Methods("DELETE").Handler(h.serve(h.deleteGroup))
+	r.NotFoundHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, &scimError{status: http.StatusNotFound, detail: "no such endpoint"})
+	})
+
+	return authMiddleware(r)
+}
+
+// authMiddleware rejects requests which don't have the bearer token from the
+// site configuration.
+func authMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cfg := conf.Get().AuthScim
+		if cfg == nil || cfg.AuthToken == "" {
+			writeError(w, &scimError{status: http.StatusNotFound, detail: "SCIM is not enabled"})
+			return
+		}
+
+		// 🚨 SECURITY: Only accept the token as a bearer token, and use a
+		// constant-time comparison to avoid leaking it through timing.
+		token, ok := bearerToken(r)
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(cfg.AuthToken)) != 1 {
+			writeError(w, &scimError{status: http.StatusUnauthorized, detail: "invalid bearer token"})
+			return
+		}
+
+		// 🚨 SECURITY: The identity provider manages all users and orgs, so
+		// its requests run with the privileges of Sourcegraph itself.
+		next.ServeHTTP(w, r.WithContext(actor.WithInternalActor(r.Context())))
+	})
+}
+
+// bearerToken returns the token of the bearer authorization header of r, and
+// false if r has no such header. The auth scheme is case-insensitive (RFC 7235).
+func bearerToken(r *http.Request) (string, bool) {
+	parts := strings.SplitN(r.Header.Get("Authorization"), " ", 2)
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") || parts[1] == "" {
+		return "", false
+	}
+	return parts[1], true
+}
+
+// serve adapts a handler function which returns a resource to render as JSON.
+func (h *handler) serve(fn func(r *http.Request) (status int, resource interface{}, err error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status, resource, err := fn(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if resource == nil {
+			w.WriteHeader(status)
+			return
+		}
+		writeJSON(w, status, resource)
+	})
+}
+
+// scimError is an error response of the API.
+type scimError struct {
+	status   int
+	scimType string
+	detail   string
+}
+
+func (e *scimError) Error() string { return e.detail }
+
+func badRequest(scimType, format string, args ...interface{}) error {
+	return &scimError{status: http.StatusBadRequest, scimType: scimType, detail: fmt.Sprintf(format, args...)}
+}
+
+func notFound(resourceType, id string) error {
+	return &scimError{status: http.StatusNotFound, detail: fmt.Sprintf("%s %s not found", resourceType, id)}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	e, ok := err.(*scimError)
+	if !ok {
+		log15.Error("SCIM request failed", "error", err)
+		e = &scimError{status: http.StatusInternalServerError, detail: "internal error"}
+	}
+	writeJSON(w, e.status, map[string]interface{}{
+		"schemas":  []string{schemaError},
+		"status":   strconv.Itoa(e.status),
+		"scimType": e.scimType,
+		"detail":   e.detail,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log15.Warn("writing SCIM response", "error", err)
+	}
+}
+
+func readJSON(r *http.Request, v interface{}) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return badRequest("invalidSyntax", "invalid request body: %s", err)
+	}
+	return nil
+}
+
+// resourceID parses the ID in the URL of a resource.
+func resourceID(r *http.Request, resourceType string) (int32, error) {
+	raw := mux.Vars(r)["id"]
+	id, err := strconv.ParseInt(raw, 10, 32)
+	if err != nil {
+		return 0, notFound(resourceType, raw)
+	}
+	return int32(id), nil
+}
+
+// mapNotFound turns not found errors of the database into SCIM errors.
+func mapNotFound(err error, resourceType string, id int32) error {
+	if errcode.IsNotFound(err) {
+		return notFound(resourceType, strconv.Itoa(int(id)))
+	}
+	return err
+}
+
+type meta struct {
+	ResourceType string    `json:"resourceType"`
+	Created      time.Time `json:"created"`
+	LastModified time.Time `json:"lastModified"`
+	Location     string    `json:"location"`
+}
+
+func newMeta(resourceType string, id int32, created, lastModified time.Time) meta {
+	return meta{
+		ResourceType: resourceType,
+		Created:      created,
+		LastModified: lastModified,
+		Location:     fmt.Sprintf("%s%s/%ss/%d", strings.TrimSuffix(globals.ExternalURL().String(), "/"), basePath, resourceType, id),
+	}
+}
+
+type listResponse struct {
+	Schemas      []string    `json:"schemas"`
+	TotalResults int         `json:"totalResults"`
+	StartIndex   int         `json:"startIndex"`
+	ItemsPerPage int         `json:"itemsPerPage"`
+	Resources    interface{} `json:"Resources"`
+}
+
+// pagination parses the 1-based startIndex and count query parameters of
+// list requests.
+func pagination(r *http.Request) (startIndex, count int) {
+	startIndex, count = 1, maxResults
+	if v, err := strconv.Atoi(r.URL.Query().Get("startIndex")); err == nil && v > 1 {
+		startIndex = v
+	}
+	if v, err := strconv.Atoi(r.URL.Query().Get("count")); err == nil && v >= 0 && v < maxResults {
+		count = v
+	}
+	return startIndex, count
+}
+
+func serveServiceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	supported := func(ok bool) map[string]bool { return map[string]bool{"supported": ok} }
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"schemas":        []string{schemaServiceProviderConfig},
+		"patch":          supported(true),
+		"bulk":           map[string]interface{}{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]interface{}{"supported": true, "maxResults": maxResults},
+		"changePassword": supported(false),
+		"sort":           supported(false),
+		"etag":           supported(false),
+		"authenticationSchemes": []map[string]interface{}{{
+			"type":        "oauthbearertoken",
+			"name":        "OAuth Bearer Token",
+			"description": "Authentication with the bearer token from the auth.scim site configuration",
+		}},
+	})
+}
@@ -0,0 +1,118 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestAuthMiddleware(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	h := authMiddleware(next)
+
+	for _, tc := range []struct {
+		name   string
+		config *schema.AuthScim
+		header string
+		want   int
+	}{
+		{name: "disabled", header: "Bearer t0ken", want: http.StatusNotFound},
+		{name: "no token", config: &schema.AuthScim{AuthToken: "t0ken"}, want: http.StatusUnauthorized},
+		{name: "wrong token", config: &schema.AuthScim{AuthToken: "t0ken"}, header: "Bearer other", want: http.StatusUnauthorized},
+		{name: "wrong scheme", config: &schema.AuthScim{AuthToken: "t0ken"}, header: "token t0ken", want: http.StatusUnauthorized},
+		{name: "missing scheme", config: &schema.AuthScim{AuthToken: "t0ken"}, header: "t0ken", want: http.StatusUnauthorized},
+		{name: "valid token", config: &schema.AuthScim{AuthToken: "t0ken"}, header: "Bearer t0ken", want: http.StatusOK},
+		{name: "lowercase scheme", config: &schema.AuthScim{AuthToken: "t0ken"}, header: "bearer t0ken", want: http.StatusOK},
+		{name: "uppercase scheme", config: &schema.AuthScim{AuthToken: "t0ken"}, header: "BEARER t0ken", want: http.StatusOK},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{AuthScim: tc.config}})
+			defer conf.Mock(nil)
+
+			req := httptest.NewRequest("GET", basePath+"/Users", nil)
+			if tc.header != "" {
+				req.Header.Set("Authorization", tc.header)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			if rec.Code != tc.want {
+				t.Errorf("got status %d, want %d", rec.Code, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseFilter(t *testing.T) {
+	for _, tc := range []struct {
+		raw     string
+		want    *filter
+		wantErr bool
+	}{
+		{raw: "", want: nil},
+		{raw: `userName eq "alice@example.com"`, want: &filter{attribute: "username", value: "alice@example.com"}},
+		{raw: `emails.value EQ "a\"b"`, want: &filter{attribute: "emails.value", value: `a"b`}},
+		{raw: `userName sw "a"`, wantErr: true},
+		{raw: `userName eq "a" and active eq true`, wantErr: true},
+	} {
+		got, err := parseFilter(tc.raw)
+		if (err != nil) != tc.wantErr {
+			t.Fatalf("%q: got error %v, want error %v", tc.raw, err, tc.wantErr)
+		}
+		if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(filter{})); diff != "" {
+			t.Errorf("%q: unexpected filter (-want +got):\n%s", tc.raw, diff)
+		}
+	}
+}
+
+func TestApplyUserAttribute(t *testing.T) {
+	var req patchRequest
+	if err := json.Unmarshal([]byte(`{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [
+			{"op": "Replace", "path": "active", "value": "False"},
+			{"op": "replace", "value": {"userName": "alice", "displayName": "Alice"}},
+			{"op": "add", "path": "emails[type eq \"work\"].value", "value": "alice@example.com"}
+		]
+	}`), &req); err != nil {
+		t.Fatal(err)
+	}
+
+	var state userState
+	for _, op := range req.Operations {
+		attrs, err := op.attributes()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for path, value := range attrs {
+			if err := applyUserAttribute(&state, path, value); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	if state.active == nil || *state.active {
+		t.Errorf("got active %v, want false", state.active)
+	}
+	for name, got := range map[string]*string{"alice": state.username, "Alice": state.displayName, "alice@example.com": state.email} {
+		if got == nil || *got != name {
+			t.Errorf("got %v, want %q", got, name)
+		}
+	}
+}
+
+func TestMemberPathValue(t *testing.T) {
+	if got, ok := memberPathValue(`members[value eq "42"]`); !ok || got != "42" {
+		t.Errorf("got %q, %v, want 42", got, ok)
+	}
+	if _, ok := memberPathValue("members"); ok {
+		t.Error("want no member for path without filter")
+	}
+}
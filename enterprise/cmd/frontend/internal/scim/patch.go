@@ -0,0 +1,106 @@
+package scim
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// filter is a parsed SCIM filter expression. Only the equality filters which
+// identity providers use to look up existing resources are supported.
+type filter struct {
+	attribute string // lower-cased
+	value     string
+}
+
+var filterPattern = regexp.MustCompile(`^\s*([A-Za-z][\w.]*)\s+(?i:eq)\s+"((?:[^"\\]|\\.)*)"\s*$`)
+
+// parseFilter parses a filter of the form `attribute eq "value"`. It returns
+// nil if raw is empty.
+func parseFilter(raw string) (*filter, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	m := filterPattern.FindStringSubmatch(raw)
+	if m == nil {
+		return nil, badRequest("invalidFilter", "unsupported filter %q, only `attribute eq \"value\"` is supported", raw)
+	}
+	value, err := strconv.Unquote(`"` + m[2] + `"`)
+	if err != nil {
+		return nil, badRequest("invalidFilter", "invalid value in filter %q", raw)
+	}
+	return &filter{attribute: strings.ToLower(m[1]), value: value}, nil
+}
+
+// patchRequest is the body of a PATCH request.
+type patchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []patchOperation `json:"Operations"`
+}
+
+type patchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+// op returns the lower-cased operation, since some identity providers (Azure
+// AD) send "Replace" instead of "replace".
+func (o patchOperation) op() string {
+	return strings.ToLower(o.Op)
+}
+
+// attributes returns the attributes set by an add or replace operation, keyed
+// by their lower-cased path. Operations without a path set the attributes of
+// the JSON object in their value.
+func (o patchOperation) attributes() (map[string]json.RawMessage, error) {
+	if o.Path != "" {
+		return map[string]json.RawMessage{strings.ToLower(o.Path): o.Value}, nil
+	}
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(o.Value, &values); err != nil {
+		return nil, badRequest("invalidValue", "value of %s operation without path must be an object", o.Op)
+	}
+	attrs := make(map[string]json.RawMessage, len(values))
+	for k, v := range values {
+		attrs[strings.ToLower(k)] = v
+	}
+	return attrs, nil
+}
+
+var memberPathPattern = regexp.MustCompile(`^(?i:members)\[\s*(?i:value)\s+(?i:eq)\s+"([^"]*)"\s*\]$`)
+
+// memberPathValue returns the member ID in a path of the form
+// `members[value eq "id"]`, which is used to remove single members.
+func memberPathValue(path string) (string, bool) {
+	m := memberPathPattern.FindStringSubmatch(path)
+	if m == nil {
+		return "", false
+	}
+	return m[1], true
+}
+
+// parseBool parses a boolean attribute. Azure AD sends booleans as the strings
+// "True" and "False".
+func parseBool(raw json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(raw, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		if b, err := strconv.ParseBool(s); err == nil {
+			return b, nil
+		}
+	}
+	return false, badRequest("invalidValue", "invalid boolean %s", raw)
+}
+
+func parseString(raw json.RawMessage) (string, error) {
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return "", badRequest("invalidValue", "invalid string %s", raw)
+	}
+	return s, nil
+}
@@ -0,0 +1,487 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/auth"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// userResource is the SCIM representation of a user. The externalId of the
+// identity provider is accepted but not stored.
+type userResource struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id,omitempty"`
+	ExternalID  string      `json:"externalId,omitempty"`
+	UserName    string      `json:"userName"`
+	Name        *userName   `json:"name,omitempty"`
+	DisplayName string      `json:"displayName,omitempty"`
+	Active      *bool       `json:"active,omitempty"`
+	Emails      []userEmail `json:"emails,omitempty"`
+	Groups      []memberRef `json:"groups,omitempty"`
+	Meta        *meta       `json:"meta,omitempty"`
+}
+
+type userName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type userEmail struct {
+	Value   string `json:"value"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// memberRef references a user in a group, or a group of a user.
+type memberRef struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+// displayName returns the display name of the user, falling back to their
+// name if the identity provider doesn't set one.
+func (u *userResource) displayName() string {
+	if u.DisplayName != "" || u.Name == nil {
+		return u.DisplayName
+	}
+	if u.Name.Formatted != "" {
+		return u.Name.Formatted
+	}
+	return strings.TrimSpace(u.Name.GivenName + " " + u.Name.FamilyName)
+}
+
+// primaryEmail returns the primary email of the user, or their first one if
+// none is marked as primary.
+func (u *userResource) primaryEmail() string {
+	for _, e := range u.Emails {
+		if e.Primary {
+			return e.Value
+		}
+	}
+	if len(u.Emails) > 0 {
+		return u.Emails[0].Value
+	}
+	return ""
+}
+
+// userState is the state of a user requested by a PUT or PATCH request. Nil
+// fields are left unchanged.
+type userState struct {
+	username    *string
+	displayName *string
+	email       *string
+	active      *bool
+}
+
+// lookupUser returns the user with the given ID, including deactivated users,
+// and whether they are active.
+func (h *handler) lookupUser(ctx context.Context, id int32) (*types.User, bool, error) {
+	user, err := database.Users(h.db).GetByID(ctx, id)
+	if err == nil || !errcode.IsNotFound(err) {
+		return user, err == nil, err
+	}
+
+	users, listErr := database.Users(h.db).List(ctx, &database.UsersListOptions{UserIDs: []int32{id}, IncludeDeactivated: true})
+	if listErr != nil {
+		return nil, false, listErr
+	}
+	if len(users) == 0 {
+		return nil, false, err
+	}
+	return users[0], false, nil
+}
+
+func (h *handler) toUserResource(ctx context.Context, user *types.User, active bool) (*userResource, error) {
+	m := newMeta("User", user.ID, user.CreatedAt, user.UpdatedAt)
+	res := &userResource{
+		Schemas:     []string{schemaUser},
+		ID:          strconv.Itoa(int(user.ID)),
+		UserName:    user.Username,
+		DisplayName: user.DisplayName,
+		Active:      &active,
+		Meta:        &m,
+	}
+
+	email, _, err := database.UserEmails(h.db).GetPrimaryEmail(ctx, user.ID)
+	if err != nil && !errcode.IsNotFound(err) {
+		return nil, err
+	}
+	if email != "" {
+		res.Emails = []userEmail{{Value: email, Primary: true}}
+	}
+
+	orgs, err := database.Orgs(h.db).GetByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, org := range orgs {
+		res.Groups = append(res.Groups, memberRef{Value: strconv.Itoa(int(org.ID)), Display: org.Name})
+	}
+	return res, nil
+}
+
+func (h *handler) getUser(r *http.Request) (int, interface{}, error) {
+	id, err := resourceID(r, "User")
+	if err != nil {
+		return 0, nil, err
+	}
+	user, active, err := h.lookupUser(r.Context(), id)
+	if err != nil {
+		return 0, nil, mapNotFound(err, "User", id)
+	}
+	res, err := h.toUserResource(r.Context(), user, active)
+	return http.StatusOK, res, err
+}
+
+func (h *handler) listUsers(r *http.Request) (int, interface{}, error) {
+	ctx := r.Context()
+	f, err := parseFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		return 0, nil, err
+	}
+	startIndex, count := pagination(r)
+
+	var users []*types.User
+	var total int
+	active := map[int32]bool{}
+	if f != nil {
+		var user *types.User
+		switch f.attribute {
+		case "username":
+			username, err := auth.NormalizeUsername(f.value)
+			if err != nil {
+				break
+			}
+			if user, err = h.userByUsername(ctx, username); err != nil {
+				return 0, nil, err
+			}
+		case "emails", "emails.value":
+			if user, err = h.userByEmail(ctx, f.value); err != nil {
+				return 0, nil, err
+			}
+		default:
+			return 0, nil, badRequest("invalidFilter", "filtering users by %q is not supported", f.attribute)
+		}
+		if user != nil {
+			total = 1
+			if startIndex == 1 && count > 0 {
+				users = []*types.User{user}
+			}
+		}
+	} else {
+		opt := &database.UsersListOptions{IncludeDeactivated: true}
+		if total, err = database.Users(h.db).Count(ctx, opt); err != nil {
+			return 0, nil, err
+		}
+		opt.LimitOffset = &database.LimitOffset{Limit: count, Offset: startIndex - 1}
+		if users, err = database.Users(h.db).List(ctx, opt); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	// Deactivated users are listed too, so look up which users are active.
+	ids := make([]int32, 0, len(users))
+	for _, user := range users {
+		ids = append(ids, user.ID)
+	}
+	activeUsers, err := database.Users(h.db).List(ctx, &database.UsersListOptions{UserIDs: ids})
+	if err != nil {
+		return 0, nil, err
+	}
+	for _, user := range activeUsers {
+		active[user.ID] = true
+	}
+
+	resources := make([]*userResource, 0, len(users))
+	for _, user := range users {
+		res, err := h.toUserResource(ctx, user, active[user.ID])
+		if err != nil {
+			return 0, nil, err
+		}
+		resources = append(resources, res)
+	}
+	return http.StatusOK, &listResponse{
+		Schemas:      []string{schemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}, nil
+}
+
+// userByUsername returns the user with the given username, including
+// deactivated users, or nil if there is none.
+func (h *handler) userByUsername(ctx context.Context, username string) (*types.User, error) {
+	users, err := database.Users(h.db).List(ctx, &database.UsersListOptions{Query: username, IncludeDeactivated: true})
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		if strings.EqualFold(user.Username, username) {
+			return user, nil
+		}
+	}
+	return nil, nil
+}
+
+// userByEmail returns the user with the given verified email address,
+// including deactivated users, or nil if there is none.
+func (h *handler) userByEmail(ctx context.Context, email string) (*types.User, error) {
+	emails, err := database.UserEmails(h.db).GetVerifiedEmails(ctx, email)
+	if err != nil || len(emails) == 0 {
+		return nil, err
+	}
+	user, _, err := h.lookupUser(ctx, emails[0].UserID)
+	if errcode.IsNotFound(err) {
+		return nil, nil
+	}
+	return user, err
+}
+
+func (h *handler) createUser(r *http.Request) (int, interface{}, error) {
+	ctx := r.Context()
+	var req userResource
+	if err := readJSON(r, &req); err != nil {
+		return 0, nil, err
+	}
+	username, err := auth.NormalizeUsername(req.UserName)
+	if err != nil {
+		return 0, nil, badRequest("invalidValue", "invalid userName: %s", err)
+	}
+
+	// 🚨 SECURITY: The identity provider has verified the email address.
+	user, err := database.Users(h.db).Create(ctx, database.NewUser{
+		Username:        username,
+		DisplayName:     req.displayName(),
+		Email:           req.primaryEmail(),
+		EmailIsVerified: true,
+	})
+	if err != nil {
+		if database.IsUsernameExists(err) || database.IsEmailExists(err) {
+			return 0, nil, &scimError{status: http.StatusConflict, scimType: "uniqueness", detail: err.Error()}
+		}
+		return 0, nil, err
+	}
+
+	active := req.Active == nil || *req.Active
+	if !active {
+		if err := database.Users(h.db).Deactivate(ctx, user.ID); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	res, err := h.toUserResource(ctx, user, active)
+	return http.StatusCreated, res, err
+}
+
+func (h *handler) replaceUser(r *http.Request) (int, interface{}, error) {
+	var req userResource
+	if err := readJSON(r, &req); err != nil {
+		return 0, nil, err
+	}
+	displayName := req.displayName()
+	state := userState{
+		username:    &req.UserName,
+		displayName: &displayName,
+		active:      req.Active,
+	}
+	if email := req.primaryEmail(); email != "" {
+		state.email = &email
+	}
+	return h.updateUser(r, state)
+}
+
+func (h *handler) patchUser(r *http.Request) (int, interface{}, error) {
+	var req patchRequest
+	if err := readJSON(r, &req); err != nil {
+		return 0, nil, err
+	}
+
+	var state userState
+	for _, op := range req.Operations {
+		if op.op() != "add" && op.op() != "replace" {
+			return 0, nil, badRequest("invalidValue", "unsupported operation %q on user", op.Op)
+		}
+		attrs, err := op.attributes()
+		if err != nil {
+			return 0, nil, err
+		}
+		for path, value := range attrs {
+			if err := applyUserAttribute(&state, path, value); err != nil {
+				return 0, nil, err
+			}
+		}
+	}
+	return h.updateUser(r, state)
+}
+
+// applyUserAttribute sets the attribute at path in state. Unsupported
+// attributes are ignored, since identity providers send many attributes which
+// Sourcegraph doesn't store.
+func applyUserAttribute(state *userState, path string, value json.RawMessage) error {
+	switch path {
+	case "active":
+		active, err := parseBool(value)
+		if err != nil {
+			return err
+		}
+		state.active = &active
+	case "username":
+		username, err := parseString(value)
+		if err != nil {
+			return err
+		}
+		state.username = &username
+	case "displayname", "name.formatted":
+		displayName, err := parseString(value)
+		if err != nil {
+			return err
+		}
+		if path == "displayname" || state.displayName == nil {
+			state.displayName = &displayName
+		}
+	case "emails", `emails[type eq "work"].value`:
+		var email string
+		if path == "emails" {
+			var emails []userEmail
+			if err := json.Unmarshal(value, &emails); err != nil {
+				return badRequest("invalidValue", "invalid emails %s", value)
+			}
+			email = (&userResource{Emails: emails}).primaryEmail()
+		} else {
+			var err error
+			if email, err = parseString(value); err != nil {
+				return err
+			}
+		}
+		if email != "" {
+			state.email = &email
+		}
+	}
+	return nil
+}
+
+// updateUser applies state to the user in the URL of r. Deactivating a user
+// deactivates them, and activating a deactivated user restores them.
+func (h *handler) updateUser(r *http.Request, state userState) (int, interface{}, error) {
+	ctx := r.Context()
+	id, err := resourceID(r, "User")
+	if err != nil {
+		return 0, nil, err
+	}
+	users := database.Users(h.db)
+
+	if state.active != nil && !*state.active {
+		return h.deactivateUser(r, id)
+	}
+
+	user, active, err := h.lookupUser(ctx, id)
+	if err == nil && !active {
+		if state.active == nil {
+			return 0, nil, badRequest("mutability", "user %d is deactivated, activate them to change their attributes", id)
+		}
+		if err = users.Restore(ctx, id); err == nil {
+			user, err = users.GetByID(ctx, id)
+		}
+	}
+	if err != nil {
+		if database.IsUsernameExists(err) {
+			return 0, nil, &scimError{status: http.StatusConflict, scimType: "uniqueness", detail: err.Error()}
+		}
+		return 0, nil, mapNotFound(err, "User", id)
+	}
+
+	var update database.UserUpdate
+	if state.username != nil {
+		username, err := auth.NormalizeUsername(*state.username)
+		if err != nil {
+			return 0, nil, badRequest("invalidValue", "invalid userName: %s", err)
+		}
+		if username != user.Username {
+			update.Username = username
+		}
+	}
+	if state.displayName != nil && *state.displayName != user.DisplayName {
+		update.DisplayName = state.displayName
+	}
+	if update != (database.UserUpdate{}) {
+		if err := users.Update(ctx, id, update); err != nil {
+			if database.IsUsernameExists(err) {
+				return 0, nil, &scimError{status: http.StatusConflict, scimType: "uniqueness", detail: err.Error()}
+			}
+			return 0, nil, err
+		}
+	}
+
+	if state.email != nil && *state.email != "" {
+		if err := h.setPrimaryEmail(ctx, id, *state.email); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	if user, err = users.GetByID(ctx, id); err != nil {
+		return 0, nil, err
+	}
+	res, err := h.toUserResource(ctx, user, true)
+	return http.StatusOK, res, err
+}
+
+// deactivateUser deactivates the user with the given ID, keeping their email
+// addresses, external accounts and org memberships, and returns their
+// resource marked as inactive. Deactivating a deactivated user succeeds
+// without changes. Other attributes of the request are not applied, as
+// deactivated users can't be changed until they are activated again.
+func (h *handler) deactivateUser(r *http.Request, id int32) (int, interface{}, error) {
+	ctx := r.Context()
+
+	if err := database.Users(h.db).Deactivate(ctx, id); err != nil {
+		return 0, nil, mapNotFound(err, "User", id)
+	}
+	user, _, err := h.lookupUser(ctx, id)
+	if err != nil {
+		return 0, nil, mapNotFound(err, "User", id)
+	}
+	res, err := h.toUserResource(ctx, user, false)
+	return http.StatusOK, res, err
+}
+
+// setPrimaryEmail adds email to the user if needed, and makes it their
+// verified primary email.
+func (h *handler) setPrimaryEmail(ctx context.Context, userID int32, email string) error {
+	emails := database.UserEmails(h.db)
+	canonical, verified, err := emails.Get(ctx, userID, email)
+	if errcode.IsNotFound(err) {
+		if err := emails.Add(ctx, userID, email, nil); err != nil {
+			return err
+		}
+		canonical, verified = email, false
+	} else if err != nil {
+		return err
+	}
+
+	// 🚨 SECURITY: The identity provider has verified the email address.
+	if !verified {
+		if err := emails.SetVerified(ctx, userID, canonical, true); err != nil {
+			return err
+		}
+	}
+	return emails.SetPrimaryEmail(ctx, userID, canonical)
+}
+
+func (h *handler) deleteUser(r *http.Request) (int, interface{}, error) {
+	id, err := resourceID(r, "User")
+	if err != nil {
+		return 0, nil, err
+	}
+	if err := database.Users(h.db).Delete(r.Context(), id); err != nil {
+		return 0, nil, mapNotFound(err, "User", id)
+	}
+	return http.StatusNoContent, nil, nil
+}
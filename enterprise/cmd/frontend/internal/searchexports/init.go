@@ -0,0 +1,28 @@
+package searchexports
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/enterprise"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel"
+	exports "github.com/sourcegraph/sourcegraph/enterprise/internal/searchexports"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/searchexports/background"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/searchexports/resolvers"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/oobmigration"
+)
+
+func Init(ctx context.Context, db dbutil.DB, outOfBandMigrationRunner *oobmigration.Runner, enterpriseServices *enterprise.Services) error {
+	// Exported files are written to the bucket of precise code intelligence uploads
+	uploadStore, err := codeintel.UploadStore(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	store := exports.NewStore(db)
+	enterpriseServices.SearchExportsResolver = resolvers.NewResolver(store)
+	enterpriseServices.SearchExportDownloadHandler = exports.NewDownloadHandler(store, uploadStore)
+
+	background.StartBackgroundJobs(ctx, store, uploadStore, newSearchFunc(db))
+	return nil
+}
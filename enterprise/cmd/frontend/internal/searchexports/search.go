@@ -0,0 +1,55 @@
+package searchexports
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/searchexports/background"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+// searchPageSize is the number of results requested per page. Paginated
+// searches consume the entire result set of each repository they visit (there
+// is no result limit), so pages only bound the size of a single response.
+const searchPageSize = 5000
+
+// newSearchFunc returns a function that pages through the results of a search
+// query as the actor of the given context.
+func newSearchFunc(db dbutil.DB) background.SearchFunc {
+	return func(ctx context.Context, query, patternType string, after *string) ([]result.Match, *string, error) {
+		var patternTypeArg *string
+		if patternType != "" {
+			patternTypeArg = &patternType
+		}
+		first := int32(searchPageSize)
+
+		impl, err := graphqlbackend.NewSearchImplementer(ctx, db, &graphqlbackend.SearchArgs{
+			Version:     "V2",
+			PatternType: patternTypeArg,
+			Query:       query,
+			After:       after,
+			First:       &first,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		results, err := impl.Results(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		if alert := results.Alert(); alert != nil && len(results.Matches) == 0 {
+			// Invalid queries are reported as alerts rather than errors
+			return nil, nil, errors.New(alert.Title())
+		}
+
+		pageInfo := results.PageInfo()
+		if !pageInfo.HasNextPage() {
+			return results.Matches, nil, nil
+		}
+		return results.Matches, pageInfo.EndCursor(), nil
+	}
+}
@@ -16,12 +16,15 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/shared"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/auth"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/authz"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/badges"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codemonitors"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/dotcom"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/executor"
 	licensing "github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/licensing/init"
 	_ "github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/registry"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/scim"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/searchexports"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
@@ -37,14 +40,17 @@ func init() {
 }
 
 var initFunctions = map[string]func(ctx context.Context, db dbutil.DB, outOfBandMigrationRunner *oobmigration.Runner, enterpriseServices *enterprise.Services) error{
-	"authz":        authz.Init,
-	"licensing":    licensing.Init,
-	"executor":     executor.Init,
-	"codeintel":    codeintel.Init,
-	"insights":     insights.Init,
-	"batches":      batches.InitFrontend,
-	"codemonitors": codemonitors.Init,
-	"dotcom":       dotcom.Init,
+	"authz":         authz.Init,
+	"licensing":     licensing.Init,
+	"executor":      executor.Init,
+	"codeintel":     codeintel.Init,
+	"insights":      insights.Init,
+	"batches":       batches.InitFrontend,
+	"codemonitors":  codemonitors.Init,
+	"dotcom":        dotcom.Init,
+	"searchexports": searchexports.Init,
+	"scim":          scim.Init,
+	"badges":        badges.Init,
 }
 
 func enterpriseSetupHook(db dbutil.DB, outOfBandMigrationRunner *oobmigration.Runner) enterprise.Services {
@@ -3,6 +3,7 @@ package main
 import (
 	"time"
 
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/uploadstore"
 	"github.com/sourcegraph/sourcegraph/internal/env"
 )
@@ -14,6 +15,7 @@ type Config struct {
 	WorkerPollInterval time.Duration
 	WorkerConcurrency  int
 	WorkerBudget       int64
+	UploadQueueOptions dbstore.UploadQueueOptions
 }
 
 func (c *Config) Load() {
@@ -24,4 +26,6 @@ func (c *Config) Load() {
 	c.WorkerPollInterval = c.GetInterval("PRECISE_CODE_INTEL_WORKER_POLL_INTERVAL", "1s", "Interval between queries to the upload queue.")
 	c.WorkerConcurrency = c.GetInt("PRECISE_CODE_INTEL_WORKER_CONCURRENCY", "1", "The maximum number of indexes that can be processed concurrently.")
 	c.WorkerBudget = int64(c.GetInt("PRECISE_CODE_INTEL_WORKER_BUDGET", "0", "The amount of compressed input data (in bytes) a worker can process concurrently. Zero acts as an infinite budget."))
+	c.UploadQueueOptions.PriorityLanes = c.GetBool("PRECISE_CODE_INTEL_WORKER_PRIORITY_LANES", "true", "Whether to process uploads of user-requested index jobs first, then uploads from users or CI, then uploads of auto-indexing jobs.")
+	c.UploadQueueOptions.RepositoryFairness = c.GetBool("PRECISE_CODE_INTEL_WORKER_REPOSITORY_FAIRNESS", "true", "Whether to prefer uploads of repositories with fewer uploads currently being processed.")
 }
@@ -97,12 +97,23 @@ func (h *handler) handle(ctx context.Context, workerStore dbworkerstore.Store, d
 		return directoryChildren, nil
 	}
 
-	return false, withUploadData(ctx, h.uploadStore, upload.ID, func(r io.Reader) (err error) {
+	// Record progress outside of the transaction holding the lock on the upload record so that it
+	// is visible while the upload is being processed.
+	progress := newProgressReporter(h.dbStore, upload.ID)
+	progress.start(ctx)
+	defer progress.stop(ctx)
+
+	return false, withUploadData(ctx, h.uploadStore, upload.ID, progress.countingReader, func(r io.Reader) (err error) {
+		progress.setPhase(ctx, store.UploadProgressPhaseCorrelating)
+
 		groupedBundleData, err := conversion.Correlate(ctx, r, upload.Root, getChildren)
 		if err != nil {
 			return errors.Wrap(err, "conversion.Correlate")
 		}
 
+		progress.setPhase(ctx, store.UploadProgressPhaseWriting)
+		groupedBundleData.Documents = progress.countingDocuments(groupedBundleData.Documents)
+
 		// Note: this is writing to a different database than the block below, so we need to use a
 		// different transaction context (managed by the writeData function).
 		if err := writeData(ctx, h.lsifStore, upload.ID, groupedBundleData); err != nil {
@@ -117,6 +128,8 @@ func (h *handler) handle(ctx context.Context, workerStore dbworkerstore.Store, d
 			}
 		}
 
+		progress.setPhase(ctx, store.UploadProgressPhaseFinalizing)
+
 		// Start a nested transaction with Postgres savepoints. In the event that something after this
 		// point fails, we want to update the upload record with an error message but do not want to
 		// alter any other data in the database. Rolling back to this savepoint will allow us to discard
@@ -165,6 +178,12 @@ func (h *handler) handle(ctx context.Context, workerStore dbworkerstore.Store, d
 				return errors.Wrap(err, "store.MarkRepositoryDirty")
 			}
 
+			// Notify outbox subscribers that the data of this upload is available. The event is
+			// only delivered if this transaction commits.
+			if err := tx.PublishUploadCompleted(ctx, upload); err != nil {
+				return errors.Wrap(err, "store.PublishUploadCompleted")
+			}
+
 			return nil
 		}); err != nil {
 			return err
@@ -213,9 +232,10 @@ func requeueIfCloning(ctx context.Context, workerStore dbworkerstore.Store, uplo
 }
 
 // withUploadData will invoke the given function with a reader of the upload's raw data. The
-// consumer should expect raw newline-delimited JSON content. If the function returns without
-// an error, the upload file will be deleted.
-func withUploadData(ctx context.Context, uploadStore uploadstore.Store, id int, fn func(r io.Reader) error) error {
+// consumer should expect raw newline-delimited JSON content. The given wrap function is applied
+// to the reader of the compressed data. If the function returns without an error, the upload
+// file will be deleted.
+func withUploadData(ctx context.Context, uploadStore uploadstore.Store, id int, wrap func(r io.Reader) io.Reader, fn func(r io.Reader) error) error {
 	uploadFilename := fmt.Sprintf("upload-%d.lsif.gz", id)
 
 	// Pull raw uploaded data from bucket
@@ -225,7 +245,7 @@ func withUploadData(ctx context.Context, uploadStore uploadstore.Store, id int,
 	}
 	defer rc.Close()
 
-	rc, err = gzip.NewReader(rc)
+	rc, err = gzip.NewReader(wrap(rc))
 	if err != nil {
 		return errors.Wrap(err, "gzip.NewReader")
 	}
@@ -58,6 +58,7 @@ func TestHandle(t *testing.T) {
 	gitserverClient.CommitDateFunc.SetDefaultReturn(expectedCommitDate, nil)
 
 	handler := &handler{
+		dbStore:         mockDBStore,
 		lsifStore:       mockLSIFStore,
 		uploadStore:     mockUploadStore,
 		gitserverClient: gitserverClient,
@@ -141,9 +142,41 @@ func TestHandle(t *testing.T) {
 		t.Errorf("unexpected value for repository id. want=%d have=%d", 50, mockDBStore.MarkRepositoryAsDirtyFunc.History()[0].Arg1)
 	}
 
+	if len(mockDBStore.PublishUploadCompletedFunc.History()) != 1 {
+		t.Errorf("unexpected number of PublishUploadCompleted calls. want=%d have=%d", 1, len(mockDBStore.PublishUploadCompletedFunc.History()))
+	} else if mockDBStore.PublishUploadCompletedFunc.History()[0].Arg1.ID != 42 {
+		t.Errorf("unexpected value for upload id. want=%d have=%d", 42, mockDBStore.PublishUploadCompletedFunc.History()[0].Arg1.ID)
+	}
+
 	if len(mockUploadStore.DeleteFunc.History()) != 1 {
 		t.Errorf("unexpected number of Delete calls. want=%d have=%d", 1, len(mockUploadStore.DeleteFunc.History()))
 	}
+
+	var phases []string
+	for _, call := range mockDBStore.UpdateUploadProgressFunc.History() {
+		if call.Arg1.UploadID != 42 {
+			t.Errorf("unexpected UpdateUploadProgress upload id. want=%d have=%d", 42, call.Arg1.UploadID)
+		}
+		if len(phases) == 0 || phases[len(phases)-1] != call.Arg1.Phase {
+			phases = append(phases, call.Arg1.Phase)
+		}
+	}
+	expectedPhases := []string{
+		dbstore.UploadProgressPhaseCorrelating,
+		dbstore.UploadProgressPhaseWriting,
+		dbstore.UploadProgressPhaseFinalizing,
+	}
+	if diff := cmp.Diff(expectedPhases, phases); diff != "" {
+		t.Errorf("unexpected upload progress phases (-want +got):\n%s", diff)
+	}
+
+	if calls := mockDBStore.UpdateUploadProgressFunc.History(); len(calls) > 0 && calls[len(calls)-1].Arg1.BytesRead == 0 {
+		t.Errorf("expected bytes read to be reported")
+	}
+
+	if len(mockDBStore.DeleteUploadProgressFunc.History()) != 1 {
+		t.Errorf("unexpected number of DeleteUploadProgress calls. want=%d have=%d", 1, len(mockDBStore.DeleteUploadProgressFunc.History()))
+	}
 }
 
 func TestHandleError(t *testing.T) {
@@ -178,6 +211,7 @@ func TestHandleError(t *testing.T) {
 	mockDBStore.MarkRepositoryAsDirtyFunc.SetDefaultReturn(fmt.Errorf("uh-oh!"))
 
 	handler := &handler{
+		dbStore:         mockDBStore,
 		lsifStore:       mockLSIFStore,
 		uploadStore:     mockUploadStore,
 		gitserverClient: gitserverClient,
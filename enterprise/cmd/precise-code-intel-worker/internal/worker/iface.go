@@ -26,6 +26,9 @@ type DBStore interface {
 	DeleteOverlappingDumps(ctx context.Context, repositoryID int, commit, root, indexer string) error
 	InsertDependencyIndexingJob(ctx context.Context, uploadID int) (int, error)
 	UpdateCommitedAt(ctx context.Context, dumpID int, committedAt time.Time) error
+	UpdateUploadProgress(ctx context.Context, progress dbstore.UploadProgress) error
+	DeleteUploadProgress(ctx context.Context, uploadID int) error
+	PublishUploadCompleted(ctx context.Context, upload dbstore.Upload) error
 }
 
 type DBStoreShim struct {
@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	dbstore "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
 	api "github.com/sourcegraph/sourcegraph/internal/api"
 	basestore "github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	semantic "github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
@@ -20,6 +21,9 @@ type MockDBStore struct {
 	// DeleteOverlappingDumpsFunc is an instance of a mock function object
 	// controlling the behavior of the method DeleteOverlappingDumps.
 	DeleteOverlappingDumpsFunc *DBStoreDeleteOverlappingDumpsFunc
+	// DeleteUploadProgressFunc is an instance of a mock function object
+	// controlling the behavior of the method DeleteUploadProgress.
+	DeleteUploadProgressFunc *DBStoreDeleteUploadProgressFunc
 	// DoneFunc is an instance of a mock function object controlling the
 	// behavior of the method Done.
 	DoneFunc *DBStoreDoneFunc
@@ -33,6 +37,9 @@ type MockDBStore struct {
 	// MarkRepositoryAsDirtyFunc is an instance of a mock function object
 	// controlling the behavior of the method MarkRepositoryAsDirty.
 	MarkRepositoryAsDirtyFunc *DBStoreMarkRepositoryAsDirtyFunc
+	// PublishUploadCompletedFunc is an instance of a mock function object
+	// controlling the behavior of the method PublishUploadCompleted.
+	PublishUploadCompletedFunc *DBStorePublishUploadCompletedFunc
 	// RepoNameFunc is an instance of a mock function object controlling the
 	// behavior of the method RepoName.
 	RepoNameFunc *DBStoreRepoNameFunc
@@ -48,6 +55,9 @@ type MockDBStore struct {
 	// UpdatePackagesFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdatePackages.
 	UpdatePackagesFunc *DBStoreUpdatePackagesFunc
+	// UpdateUploadProgressFunc is an instance of a mock function object
+	// controlling the behavior of the method UpdateUploadProgress.
+	UpdateUploadProgressFunc *DBStoreUpdateUploadProgressFunc
 	// WithFunc is an instance of a mock function object controlling the
 	// behavior of the method With.
 	WithFunc *DBStoreWithFunc
@@ -62,6 +72,11 @@ func NewMockDBStore() *MockDBStore {
 				return nil
 			},
 		},
+		DeleteUploadProgressFunc: &DBStoreDeleteUploadProgressFunc{
+			defaultHook: func(context.Context, int) error {
+				return nil
+			},
+		},
 		DoneFunc: &DBStoreDoneFunc{
 			defaultHook: func(error) error {
 				return nil
@@ -82,6 +97,11 @@ func NewMockDBStore() *MockDBStore {
 				return nil
 			},
 		},
+		PublishUploadCompletedFunc: &DBStorePublishUploadCompletedFunc{
+			defaultHook: func(context.Context, dbstore.Upload) error {
+				return nil
+			},
+		},
 		RepoNameFunc: &DBStoreRepoNameFunc{
 			defaultHook: func(context.Context, int) (string, error) {
 				return "", nil
@@ -107,6 +127,11 @@ func NewMockDBStore() *MockDBStore {
 				return nil
 			},
 		},
+		UpdateUploadProgressFunc: &DBStoreUpdateUploadProgressFunc{
+			defaultHook: func(context.Context, dbstore.UploadProgress) error {
+				return nil
+			},
+		},
 		WithFunc: &DBStoreWithFunc{
 			defaultHook: func(basestore.ShareableStore) DBStore {
 				return nil
@@ -122,6 +147,9 @@ func NewMockDBStoreFrom(i DBStore) *MockDBStore {
 		DeleteOverlappingDumpsFunc: &DBStoreDeleteOverlappingDumpsFunc{
 			defaultHook: i.DeleteOverlappingDumps,
 		},
+		DeleteUploadProgressFunc: &DBStoreDeleteUploadProgressFunc{
+			defaultHook: i.DeleteUploadProgress,
+		},
 		DoneFunc: &DBStoreDoneFunc{
 			defaultHook: i.Done,
 		},
@@ -134,6 +162,9 @@ func NewMockDBStoreFrom(i DBStore) *MockDBStore {
 		MarkRepositoryAsDirtyFunc: &DBStoreMarkRepositoryAsDirtyFunc{
 			defaultHook: i.MarkRepositoryAsDirty,
 		},
+		PublishUploadCompletedFunc: &DBStorePublishUploadCompletedFunc{
+			defaultHook: i.PublishUploadCompleted,
+		},
 		RepoNameFunc: &DBStoreRepoNameFunc{
 			defaultHook: i.RepoName,
 		},
@@ -149,6 +180,9 @@ func NewMockDBStoreFrom(i DBStore) *MockDBStore {
 		UpdatePackagesFunc: &DBStoreUpdatePackagesFunc{
 			defaultHook: i.UpdatePackages,
 		},
+		UpdateUploadProgressFunc: &DBStoreUpdateUploadProgressFunc{
+			defaultHook: i.UpdateUploadProgress,
+		},
 		WithFunc: &DBStoreWithFunc{
 			defaultHook: i.With,
 		},
@@ -272,6 +306,113 @@ func (c DBStoreDeleteOverlappingDumpsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// DBStoreDeleteUploadProgressFunc describes the behavior when the
+// DeleteUploadProgress method of the parent MockDBStore instance is
+// invoked.
+type DBStoreDeleteUploadProgressFunc struct {
+	defaultHook func(context.Context, int) error
+	hooks       []func(context.Context, int) error
+	history     []DBStoreDeleteUploadProgressFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteUploadProgress delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockDBStore) DeleteUploadProgress(v0 context.Context, v1 int) error {
+	r0 := m.DeleteUploadProgressFunc.nextHook()(v0, v1)
+	m.DeleteUploadProgressFunc.appendCall(DBStoreDeleteUploadProgressFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the DeleteUploadProgress
+// method of the parent MockDBStore instance is invoked and the hook queue
+// is empty.
+func (f *DBStoreDeleteUploadProgressFunc) SetDefaultHook(hook func(context.Context, int) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteUploadProgress method of the parent MockDBStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *DBStoreDeleteUploadProgressFunc) PushHook(hook func(context.Context, int) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *DBStoreDeleteUploadProgressFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *DBStoreDeleteUploadProgressFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int) error {
+		return r0
+	})
+}
+
+func (f *DBStoreDeleteUploadProgressFunc) nextHook() func(context.Context, int) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBStoreDeleteUploadProgressFunc) appendCall(r0 DBStoreDeleteUploadProgressFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBStoreDeleteUploadProgressFuncCall objects
+// describing the invocations of this function.
+func (f *DBStoreDeleteUploadProgressFunc) History() []DBStoreDeleteUploadProgressFuncCall {
+	f.mutex.Lock()
+	history := make([]DBStoreDeleteUploadProgressFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBStoreDeleteUploadProgressFuncCall is an object that describes an
+// invocation of method DeleteUploadProgress on an instance of MockDBStore.
+type DBStoreDeleteUploadProgressFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBStoreDeleteUploadProgressFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBStoreDeleteUploadProgressFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // DBStoreDoneFunc describes the behavior when the Done method of the parent
 // MockDBStore instance is invoked.
 type DBStoreDoneFunc struct {
@@ -692,6 +833,114 @@ func (c DBStoreMarkRepositoryAsDirtyFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// DBStorePublishUploadCompletedFunc describes the behavior when the
+// PublishUploadCompleted method of the parent MockDBStore instance is
+// invoked.
+type DBStorePublishUploadCompletedFunc struct {
+	defaultHook func(context.Context, dbstore.Upload) error
+	hooks       []func(context.Context, dbstore.Upload) error
+	history     []DBStorePublishUploadCompletedFuncCall
+	mutex       sync.Mutex
+}
+
+// PublishUploadCompleted delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockDBStore) PublishUploadCompleted(v0 context.Context, v1 dbstore.Upload) error {
+	r0 := m.PublishUploadCompletedFunc.nextHook()(v0, v1)
+	m.PublishUploadCompletedFunc.appendCall(DBStorePublishUploadCompletedFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// PublishUploadCompleted method of the parent MockDBStore instance is
+// invoked and the hook queue is empty.
+func (f *DBStorePublishUploadCompletedFunc) SetDefaultHook(hook func(context.Context, dbstore.Upload) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// PublishUploadCompleted method of the parent MockDBStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *DBStorePublishUploadCompletedFunc) PushHook(hook func(context.Context, dbstore.Upload) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *DBStorePublishUploadCompletedFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, dbstore.Upload) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *DBStorePublishUploadCompletedFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, dbstore.Upload) error {
+		return r0
+	})
+}
+
+func (f *DBStorePublishUploadCompletedFunc) nextHook() func(context.Context, dbstore.Upload) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBStorePublishUploadCompletedFunc) appendCall(r0 DBStorePublishUploadCompletedFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBStorePublishUploadCompletedFuncCall
+// objects describing the invocations of this function.
+func (f *DBStorePublishUploadCompletedFunc) History() []DBStorePublishUploadCompletedFuncCall {
+	f.mutex.Lock()
+	history := make([]DBStorePublishUploadCompletedFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBStorePublishUploadCompletedFuncCall is an object that describes an
+// invocation of method PublishUploadCompleted on an instance of
+// MockDBStore.
+type DBStorePublishUploadCompletedFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 dbstore.Upload
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBStorePublishUploadCompletedFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBStorePublishUploadCompletedFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // DBStoreRepoNameFunc describes the behavior when the RepoName method of
 // the parent MockDBStore instance is invoked.
 type DBStoreRepoNameFunc struct {
@@ -1234,6 +1483,113 @@ func (c DBStoreUpdatePackagesFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// DBStoreUpdateUploadProgressFunc describes the behavior when the
+// UpdateUploadProgress method of the parent MockDBStore instance is
+// invoked.
+type DBStoreUpdateUploadProgressFunc struct {
+	defaultHook func(context.Context, dbstore.UploadProgress) error
+	hooks       []func(context.Context, dbstore.UploadProgress) error
+	history     []DBStoreUpdateUploadProgressFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateUploadProgress delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockDBStore) UpdateUploadProgress(v0 context.Context, v1 dbstore.UploadProgress) error {
+	r0 := m.UpdateUploadProgressFunc.nextHook()(v0, v1)
+	m.UpdateUploadProgressFunc.appendCall(DBStoreUpdateUploadProgressFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the UpdateUploadProgress
+// method of the parent MockDBStore instance is invoked and the hook queue
+// is empty.
+func (f *DBStoreUpdateUploadProgressFunc) SetDefaultHook(hook func(context.Context, dbstore.UploadProgress) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateUploadProgress method of the parent MockDBStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *DBStoreUpdateUploadProgressFunc) PushHook(hook func(context.Context, dbstore.UploadProgress) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *DBStoreUpdateUploadProgressFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, dbstore.UploadProgress) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *DBStoreUpdateUploadProgressFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, dbstore.UploadProgress) error {
+		return r0
+	})
+}
+
+func (f *DBStoreUpdateUploadProgressFunc) nextHook() func(context.Context, dbstore.UploadProgress) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBStoreUpdateUploadProgressFunc) appendCall(r0 DBStoreUpdateUploadProgressFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBStoreUpdateUploadProgressFuncCall objects
+// describing the invocations of this function.
+func (f *DBStoreUpdateUploadProgressFunc) History() []DBStoreUpdateUploadProgressFuncCall {
+	f.mutex.Lock()
+	history := make([]DBStoreUpdateUploadProgressFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBStoreUpdateUploadProgressFuncCall is an object that describes an
+// invocation of method UpdateUploadProgress on an instance of MockDBStore.
+type DBStoreUpdateUploadProgressFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 dbstore.UploadProgress
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBStoreUpdateUploadProgressFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBStoreUpdateUploadProgressFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // DBStoreWithFunc describes the behavior when the With method of the parent
 // MockDBStore instance is invoked.
 type DBStoreWithFunc struct {
@@ -0,0 +1,138 @@
+package worker
+
+import (
+	"context"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/inconshreveable/log15"
+
+	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
+)
+
+// ProgressReportInterval is the interval at which the progress of an upload is recorded while
+// the upload is being processed. Progress is also recorded whenever the processing phase changes.
+const ProgressReportInterval = time.Second * 5
+
+// progressReporter records the progress of an upload so that it can be displayed while the upload
+// is processing. Progress is reported on a best-effort basis: failures to record progress are logged
+// and do not affect processing.
+//
+// The given store must not be the transaction holding the lock on the upload record, as progress
+// written within that transaction would not be visible until processing has finished.
+type progressReporter struct {
+	bytesRead        int64 // accessed atomically
+	documentsWritten int64 // accessed atomically
+	dbStore          DBStore
+	uploadID         int
+
+	mu    sync.Mutex
+	phase string
+
+	done     chan struct{}
+	finished chan struct{}
+}
+
+func newProgressReporter(dbStore DBStore, uploadID int) *progressReporter {
+	return &progressReporter{
+		dbStore:  dbStore,
+		uploadID: uploadID,
+		done:     make(chan struct{}),
+		finished: make(chan struct{}),
+	}
+}
+
+// start periodically records the progress of the upload in the background until stop is called.
+func (p *progressReporter) start(ctx context.Context) {
+	go func() {
+		defer close(p.finished)
+
+		ticker := time.NewTicker(ProgressReportInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				p.flush(ctx)
+			case <-p.done:
+				return
+			}
+		}
+	}()
+}
+
+// stop halts the periodic reporting and removes the recorded progress of the upload, which is only
+// meaningful while the upload is processing.
+func (p *progressReporter) stop(ctx context.Context) {
+	close(p.done)
+	<-p.finished
+
+	if err := p.dbStore.DeleteUploadProgress(ctx, p.uploadID); err != nil {
+		log15.Warn("Failed to delete upload progress", "uploadID", p.uploadID, "err", err)
+	}
+}
+
+// setPhase updates the processing phase of the upload and records the progress immediately.
+func (p *progressReporter) setPhase(ctx context.Context, phase string) {
+	p.mu.Lock()
+	p.phase = phase
+	p.mu.Unlock()
+
+	p.flush(ctx)
+}
+
+// flush records the current progress of the upload.
+func (p *progressReporter) flush(ctx context.Context) {
+	p.mu.Lock()
+	phase := p.phase
+	p.mu.Unlock()
+
+	if phase == "" {
+		return
+	}
+
+	if err := p.dbStore.UpdateUploadProgress(ctx, store.UploadProgress{
+		UploadID:         p.uploadID,
+		Phase:            phase,
+		BytesRead:        atomic.LoadInt64(&p.bytesRead),
+		DocumentsWritten: int(atomic.LoadInt64(&p.documentsWritten)),
+	}); err != nil {
+		log15.Warn("Failed to update upload progress", "uploadID", p.uploadID, "err", err)
+	}
+}
+
+// countingReader returns a reader that counts the bytes read from the given reader as progress.
+func (p *progressReporter) countingReader(r io.Reader) io.Reader {
+	return &progressReader{r: r, n: &p.bytesRead}
+}
+
+// countingDocuments returns a channel that forwards the documents of the given channel and counts
+// each document received by the consumer as progress.
+func (p *progressReporter) countingDocuments(documents chan semantic.KeyedDocumentData) chan semantic.KeyedDocumentData {
+	ch := make(chan semantic.KeyedDocumentData)
+
+	go func() {
+		defer close(ch)
+
+		for document := range documents {
+			ch <- document
+			atomic.AddInt64(&p.documentsWritten, 1)
+		}
+	}()
+
+	return ch
+}
+
+type progressReader struct {
+	r io.Reader
+	n *int64
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	atomic.AddInt64(r.n, int64(n))
+	return n, err
+}
@@ -0,0 +1,138 @@
+package worker
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
+)
+
+// ShardedLSIFStoreShim writes the data of each bundle into the codeintel database shard from
+// which it will be read by the frontend.
+type ShardedLSIFStoreShim struct {
+	*lsifstore.ShardedStore
+}
+
+func (s *ShardedLSIFStoreShim) Transact(ctx context.Context) (LSIFStore, error) {
+	return &shardedLSIFStoreTx{store: s.ShardedStore, txs: map[int]*lsifstore.Store{}}, nil
+}
+
+func (s *ShardedLSIFStoreShim) Done(err error) error {
+	return errors.New("lsifstore: sharded store is not in a transaction")
+}
+
+func (s *ShardedLSIFStoreShim) WriteMeta(ctx context.Context, bundleID int, meta semantic.MetaData) error {
+	return s.Shard(bundleID).WriteMeta(ctx, bundleID, meta)
+}
+
+func (s *ShardedLSIFStoreShim) WriteDocuments(ctx context.Context, bundleID int, documents chan semantic.KeyedDocumentData) error {
+	return s.Shard(bundleID).WriteDocuments(ctx, bundleID, documents)
+}
+
+func (s *ShardedLSIFStoreShim) WriteResultChunks(ctx context.Context, bundleID int, resultChunks chan semantic.IndexedResultChunkData) error {
+	return s.Shard(bundleID).WriteResultChunks(ctx, bundleID, resultChunks)
+}
+
+func (s *ShardedLSIFStoreShim) WriteDefinitions(ctx context.Context, bundleID int, monikerLocations chan semantic.MonikerLocations) error {
+	return s.Shard(bundleID).WriteDefinitions(ctx, bundleID, monikerLocations)
+}
+
+func (s *ShardedLSIFStoreShim) WriteReferences(ctx context.Context, bundleID int, monikerLocations chan semantic.MonikerLocations) error {
+	return s.Shard(bundleID).WriteReferences(ctx, bundleID, monikerLocations)
+}
+
+func (s *ShardedLSIFStoreShim) WriteDocumentationPages(ctx context.Context, bundleID int, documentation chan *semantic.DocumentationPageData) error {
+	return s.Shard(bundleID).WriteDocumentationPages(ctx, bundleID, documentation)
+}
+
+// shardedLSIFStoreTx opens a transaction in the shard of a bundle the first time the data of
+// that bundle is written. Done commits or rolls back every transaction opened this way.
+type shardedLSIFStoreTx struct {
+	store *lsifstore.ShardedStore
+	txs   map[int]*lsifstore.Store
+}
+
+func (s *shardedLSIFStoreTx) Transact(ctx context.Context) (LSIFStore, error) {
+	return nil, errors.New("lsifstore: nested transactions are not supported by the sharded store")
+}
+
+func (s *shardedLSIFStoreTx) Done(err error) error {
+	for _, tx := range s.txs {
+		err = tx.Done(err)
+	}
+
+	return err
+}
+
+func (s *shardedLSIFStoreTx) WriteMeta(ctx context.Context, bundleID int, meta semantic.MetaData) error {
+	tx, err := s.shardTx(ctx, bundleID)
+	if err != nil {
+		return err
+	}
+
+	return tx.WriteMeta(ctx, bundleID, meta)
+}
+
+func (s *shardedLSIFStoreTx) WriteDocuments(ctx context.Context, bundleID int, documents chan semantic.KeyedDocumentData) error {
+	tx, err := s.shardTx(ctx, bundleID)
+	if err != nil {
+		return err
+	}
+
+	return tx.WriteDocuments(ctx, bundleID, documents)
+}
+
+func (s *shardedLSIFStoreTx) WriteResultChunks(ctx context.Context, bundleID int, resultChunks chan semantic.IndexedResultChunkData) error {
+	tx, err := s.shardTx(ctx, bundleID)
+	if err != nil {
+		return err
+	}
+
+	return tx.WriteResultChunks(ctx, bundleID, resultChunks)
+}
+
+func (s *shardedLSIFStoreTx) WriteDefinitions(ctx context.Context, bundleID int, monikerLocations chan semantic.MonikerLocations) error {
+	tx, err := s.shardTx(ctx, bundleID)
+	if err != nil {
+		return err
+	}
+
+	return tx.WriteDefinitions(ctx, bundleID, monikerLocations)
+}
+
+func (s *shardedLSIFStoreTx) WriteReferences(ctx context.Context, bundleID int, monikerLocations chan semantic.MonikerLocations) error {
+	tx, err := s.shardTx(ctx, bundleID)
+	if err != nil {
+		return err
+	}
+
+	return tx.WriteReferences(ctx, bundleID, monikerLocations)
+}
+
+func (s *shardedLSIFStoreTx) WriteDocumentationPages(ctx context.Context, bundleID int, documentation chan *semantic.DocumentationPageData) error {
+	tx, err := s.shardTx(ctx, bundleID)
+	if err != nil {
+		return err
+	}
+
+	return tx.WriteDocumentationPages(ctx, bundleID, documentation)
+}
+
+// shardTx returns the transaction opened in the shard holding the given bundle, opening it
+// if this is the first write to that shard.
+func (s *shardedLSIFStoreTx) shardTx(ctx context.Context, bundleID int) (*lsifstore.Store, error) {
+	index := lsifstore.ShardIndex(bundleID, len(s.store.Shards()))
+	if tx, ok := s.txs[index]; ok {
+		return tx, nil
+	}
+
+	tx, err := s.store.Shards()[index].Transact(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.txs[index] = tx
+	return tx, nil
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"log"
 	"net/http"
+	"reflect"
 	"time"
 
 	smithyhttp "github.com/aws/smithy-go/transport/http"
@@ -70,6 +72,7 @@ func main() {
 	// Connect to databases
 	db := mustInitializeDB()
 	codeIntelDB := mustInitializeCodeIntelDB()
+	codeIntelShardDBs := mustInitializeCodeIntelShardDBs()
 
 	// Migrations may take a while, but after they're done we'll immediately
 	// spin up a server and can accept traffic. Inform external clients we'll
@@ -78,8 +81,12 @@ func main() {
 
 	// Initialize stores
 	dbStore := dbstore.NewWithDB(db, observationContext)
-	workerStore := dbstore.WorkerutilUploadStore(dbStore, observationContext)
-	lsifStore := lsifstore.NewStore(codeIntelDB, observationContext)
+	workerStore := dbstore.WorkerutilUploadStoreWithQueueOptions(dbStore, config.UploadQueueOptions, observationContext)
+	lsifStores := []*lsifstore.Store{lsifstore.NewStore(codeIntelDB, observationContext)}
+	for _, codeIntelShardDB := range codeIntelShardDBs {
+		lsifStores = append(lsifStores, lsifstore.NewStore(codeIntelShardDB, observationContext))
+	}
+	lsifStore := lsifstore.NewShardedStore(lsifStores...)
 	gitserverClient := gitserver.New(dbStore, observationContext)
 
 	uploadStore, err := uploadstore.CreateLazy(context.Background(), config.UploadStoreConfig, observationContext)
@@ -97,7 +104,7 @@ func main() {
 	worker := worker.NewWorker(
 		&worker.DBStoreShim{Store: dbStore},
 		workerStore,
-		&worker.LSIFStoreShim{Store: lsifStore},
+		&worker.ShardedLSIFStoreShim{ShardedStore: lsifStore},
 		uploadStore,
 		gitserverClient,
 		config.WorkerPollInterval,
@@ -166,6 +173,33 @@ func mustInitializeCodeIntelDB() *sql.DB {
 	return db
 }
 
+// mustInitializeCodeIntelShardDBs connects to the additional codeintel databases configured in the
+// site configuration. The primary codeintel database is not included in the result.
+func mustInitializeCodeIntelShardDBs() []*sql.DB {
+	postgresDSNs := conf.Get().CodeIntelDatabaseShards
+	conf.Watch(func() {
+		if newDSNs := conf.Get().CodeIntelDatabaseShards; !reflect.DeepEqual(postgresDSNs, newDSNs) {
+			log.Fatalf("Detected codeintel database shards change, restarting to take effect")
+		}
+	})
+
+	dbs := make([]*sql.DB, 0, len(postgresDSNs))
+	for i, postgresDSN := range postgresDSNs {
+		db, err := dbconn.New(postgresDSN, fmt.Sprintf("_codeintel_shard%d", i+1))
+		if err != nil {
+			log.Fatalf("Failed to connect to codeintel database shard %d: %s", i+1, err)
+		}
+
+		if err := dbconn.MigrateDB(db, dbconn.CodeIntel); err != nil {
+			log.Fatalf("Failed to perform codeintel database migration on shard %d: %s", i+1, err)
+		}
+
+		dbs = append(dbs, db)
+	}
+
+	return dbs
+}
+
 func mustRegisterQueueMetric(observationContext *observation.Context, workerStore dbworkerstore.Store) {
 	observationContext.Registerer.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
 		Name: "src_upload_queue_uploads_total",
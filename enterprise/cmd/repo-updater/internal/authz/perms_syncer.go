@@ -251,6 +251,7 @@ func (s *PermsSyncer) syncUserPerms(ctx context.Context, userID int32, noPerms b
 	}
 
 	var repoSpecs, includePrefixSpecs, excludePrefixSpecs []api.ExternalRepoSpec
+	subRepoPerms := make(map[api.ExternalRepoSpec]*authz.SubRepoPermissions)
 	for _, acct := range accts {
 		provider := providers[acct.ServiceID]
 		if provider == nil {
@@ -332,6 +333,13 @@ func (s *PermsSyncer) syncUserPerms(ctx context.Context, userID int32, noPerms b
 				)
 			}
 		}
+		for repoID, perms := range extIDs.SubRepoPermissions {
+			subRepoPerms[api.ExternalRepoSpec{
+				ID:          string(repoID),
+				ServiceType: provider.ServiceType(),
+				ServiceID:   provider.ServiceID(),
+			}] = perms
+		}
 	}
 
 	// Get corresponding internal database IDs
@@ -380,10 +388,39 @@ func (s *PermsSyncer) syncUserPerms(ctx context.Context, userID int32, noPerms b
 		return errors.Wrap(err, "set user permissions")
 	}
 
+	err = s.saveUserSubRepoPerms(ctx, user.ID, subRepoPerms)
+	if err != nil {
+		return errors.Wrap(err, "set user sub-repository permissions")
+	}
+
 	log15.Debug("PermsSyncer.syncUserPerms.synced", "userID", user.ID)
 	return nil
 }
 
+// saveUserSubRepoPerms replaces the sub-repository permissions of the given
+// user with the given permissions, keyed by external repository.
+func (s *PermsSyncer) saveUserSubRepoPerms(ctx context.Context, userID int32, perms map[api.ExternalRepoSpec]*authz.SubRepoPermissions) error {
+	byRepoID := make(map[api.RepoID]authz.SubRepoPermissions, len(perms))
+	if len(perms) > 0 {
+		specs := make([]api.ExternalRepoSpec, 0, len(perms))
+		for spec := range perms {
+			specs = append(specs, spec)
+		}
+
+		rs, err := s.reposStore.RepoStore.List(ctx, database.ReposListOptions{ExternalRepos: specs})
+		if err != nil {
+			return errors.Wrap(err, "list external repositories with sub-repository permissions")
+		}
+		for _, r := range rs {
+			if p, ok := perms[r.ExternalRepo]; ok {
+				byRepoID[r.ID] = *p
+			}
+		}
+	}
+
+	return edb.SubRepoPermsWith(s.permsStore).SetUserPermissions(ctx, userID, byRepoID)
+}
+
 // syncRepoPerms processes permissions syncing request in repository-centric way.
 // When `noPerms` is true, the method will use partial results to update permissions
 // tables even when error occurs.
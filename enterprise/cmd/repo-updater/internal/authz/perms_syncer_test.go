@@ -112,6 +112,9 @@ func TestPermsSyncer_syncUserPerms(t *testing.T) {
 	edb.Mocks.Perms.ListExternalAccounts = func(context.Context, int32) ([]*extsvc.Account, error) {
 		return []*extsvc.Account{&extAccount}, nil
 	}
+	edb.Mocks.SubRepoPerms.SetUserPermissions = func(context.Context, int32, map[api.RepoID]authz.SubRepoPermissions) error {
+		return nil
+	}
 	edb.Mocks.Perms.SetUserPermissions = func(_ context.Context, p *authz.UserPermissions) error {
 		if p.UserID != 1 {
 			return fmt.Errorf("UserID: want 1 but got %d", p.UserID)
@@ -135,6 +138,7 @@ func TestPermsSyncer_syncUserPerms(t *testing.T) {
 	defer func() {
 		database.Mocks = database.MockStores{}
 		edb.Mocks.Perms = edb.MockPerms{}
+		edb.Mocks.SubRepoPerms = edb.MockSubRepoPerms{}
 	}()
 
 	permsStore := edb.Perms(nil, timeutil.Now)
@@ -192,6 +196,9 @@ func TestPermsSyncer_syncUserPerms_tokenExpire(t *testing.T) {
 	edb.Mocks.Perms.ListExternalAccounts = func(context.Context, int32) ([]*extsvc.Account, error) {
 		return []*extsvc.Account{&extAccount}, nil
 	}
+	edb.Mocks.SubRepoPerms.SetUserPermissions = func(context.Context, int32, map[api.RepoID]authz.SubRepoPermissions) error {
+		return nil
+	}
 	edb.Mocks.Perms.SetUserPermissions = func(_ context.Context, p *authz.UserPermissions) error {
 		return nil
 	}
@@ -207,6 +214,7 @@ func TestPermsSyncer_syncUserPerms_tokenExpire(t *testing.T) {
 	defer func() {
 		database.Mocks = database.MockStores{}
 		edb.Mocks.Perms = edb.MockPerms{}
+		edb.Mocks.SubRepoPerms = edb.MockSubRepoPerms{}
 	}()
 
 	permsStore := edb.Perms(nil, timeutil.Now)
@@ -283,6 +291,9 @@ func TestPermsSyncer_syncUserPerms_prefixSpecs(t *testing.T) {
 	edb.Mocks.Perms.ListExternalAccounts = func(context.Context, int32) ([]*extsvc.Account, error) {
 		return []*extsvc.Account{&extAccount}, nil
 	}
+	edb.Mocks.SubRepoPerms.SetUserPermissions = func(context.Context, int32, map[api.RepoID]authz.SubRepoPermissions) error {
+		return nil
+	}
 	edb.Mocks.Perms.SetUserPermissions = func(_ context.Context, p *authz.UserPermissions) error {
 		return nil
 	}
@@ -302,6 +313,7 @@ func TestPermsSyncer_syncUserPerms_prefixSpecs(t *testing.T) {
 	defer func() {
 		database.Mocks = database.MockStores{}
 		edb.Mocks.Perms = edb.MockPerms{}
+		edb.Mocks.SubRepoPerms = edb.MockSubRepoPerms{}
 	}()
 
 	permsStore := edb.Perms(nil, timeutil.Now)
@@ -320,6 +332,86 @@ func TestPermsSyncer_syncUserPerms_prefixSpecs(t *testing.T) {
 	}
 }
 
+func TestPermsSyncer_syncUserPerms_subRepoPerms(t *testing.T) {
+	p := &mockProvider{
+		serviceType: extsvc.TypePerforce,
+		serviceID:   "ssl:111.222.333.444:1666",
+	}
+	authz.SetProviders(false, []authz.Provider{p})
+	defer authz.SetProviders(true, nil)
+
+	extAccount := extsvc.Account{
+		AccountSpec: extsvc.AccountSpec{
+			ServiceType: p.ServiceType(),
+			ServiceID:   p.ServiceID(),
+		},
+	}
+	engineering := api.ExternalRepoSpec{
+		ID:          "//Engineering/",
+		ServiceType: p.ServiceType(),
+		ServiceID:   p.ServiceID(),
+	}
+
+	database.Mocks.Users.GetByID = func(ctx context.Context, id int32) (*types.User, error) {
+		return &types.User{ID: id}, nil
+	}
+	database.Mocks.ExternalAccounts.TouchLastValid = func(ctx context.Context, id int32) error {
+		return nil
+	}
+	edb.Mocks.Perms.ListExternalAccounts = func(context.Context, int32) ([]*extsvc.Account, error) {
+		return []*extsvc.Account{&extAccount}, nil
+	}
+	edb.Mocks.Perms.SetUserPermissions = func(_ context.Context, p *authz.UserPermissions) error {
+		return nil
+	}
+	database.Mocks.Repos.ListRepoNames = func(v0 context.Context, args database.ReposListOptions) ([]types.RepoName, error) {
+		return []types.RepoName{{ID: 1}}, nil
+	}
+	database.Mocks.Repos.List = func(v0 context.Context, args database.ReposListOptions) ([]*types.Repo, error) {
+		if diff := cmp.Diff([]api.ExternalRepoSpec{engineering}, args.ExternalRepos); diff != "" {
+			return nil, fmt.Errorf("ExternalRepos mismatch (-want +got):\n%s", diff)
+		}
+		return []*types.Repo{{ID: 1, ExternalRepo: engineering}}, nil
+	}
+	database.Mocks.UserEmails.ListByUser = func(ctx context.Context, opt database.UserEmailsListOptions) ([]*database.UserEmail, error) {
+		return nil, nil
+	}
+	var gotSubRepoPerms map[api.RepoID]authz.SubRepoPermissions
+	edb.Mocks.SubRepoPerms.SetUserPermissions = func(_ context.Context, userID int32, perms map[api.RepoID]authz.SubRepoPermissions) error {
+		gotSubRepoPerms = perms
+		return nil
+	}
+	defer func() {
+		database.Mocks = database.MockStores{}
+		edb.Mocks.Perms = edb.MockPerms{}
+		edb.Mocks.SubRepoPerms = edb.MockSubRepoPerms{}
+	}()
+
+	permsStore := edb.Perms(nil, timeutil.Now)
+	s := NewPermsSyncer(repos.NewStore(&dbtesting.MockDB{}, sql.TxOptions{}), permsStore, timeutil.Now, nil)
+
+	p.fetchUserPerms = func(context.Context, *extsvc.Account) (*authz.ExternalUserPermissions, error) {
+		return &authz.ExternalUserPermissions{
+			Exacts: []extsvc.RepoID{"//Engineering/"},
+			SubRepoPermissions: map[extsvc.RepoID]*authz.SubRepoPermissions{
+				"//Engineering/": {PathRules: []string{"**", "-Security/**"}},
+			},
+		}, nil
+	}
+
+	err := s.syncUserPerms(context.Background(), 1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[api.RepoID]authz.SubRepoPermissions{
+		1: {PathRules: []string{"**", "-Security/**"}},
+	}
+	if diff := cmp.Diff(want, gotSubRepoPerms); diff != "" {
+		t.Fatalf("sub-repository permissions mismatch (-want +got):\n%s", diff)
+	}
+}
+
 func TestPermsSyncer_syncRepoPerms(t *testing.T) {
 	newPermsSyncer := func(store *repos.Store) *PermsSyncer {
 		return NewPermsSyncer(store, edb.Perms(nil, timeutil.Now), timeutil.Now, nil)
@@ -3,8 +3,11 @@ package codeintel
 import (
 	"database/sql"
 	"fmt"
+	"log"
+	"reflect"
 
 	"github.com/sourcegraph/sourcegraph/cmd/worker/shared"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbconn"
 )
@@ -31,3 +34,35 @@ var initCodeIntelDatabaseMemo = shared.NewMemoizedConstructor(func() (interface{
 
 	return db, nil
 })
+
+// InitCodeIntelShardDatabases initializes and returns connections to the additional codeintel
+// databases configured in the site configuration. The primary codeintel db is not included.
+func InitCodeIntelShardDatabases() ([]*sql.DB, error) {
+	conns, err := initCodeIntelShardDatabasesMemo.Init()
+	return conns.([]*sql.DB), err
+}
+
+var initCodeIntelShardDatabasesMemo = shared.NewMemoizedConstructor(func() (interface{}, error) {
+	postgresDSNs := conf.Get().CodeIntelDatabaseShards
+	conf.Watch(func() {
+		if newDSNs := conf.Get().CodeIntelDatabaseShards; !reflect.DeepEqual(postgresDSNs, newDSNs) {
+			log.Fatalf("Detected codeintel database shards change, restarting to take effect")
+		}
+	})
+
+	dbs := make([]*sql.DB, 0, len(postgresDSNs))
+	for i, postgresDSN := range postgresDSNs {
+		db, err := dbconn.New(postgresDSN, fmt.Sprintf("_codeintel_shard%d", i+1))
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to codeintel database shard %d: %s", i+1, err)
+		}
+
+		if err := dbconn.MigrateDB(db, dbconn.CodeIntel); err != nil {
+			return nil, fmt.Errorf("failed to perform codeintel database migration on shard %d: %s", i+1, err)
+		}
+
+		dbs = append(dbs, db)
+	}
+
+	return dbs, nil
+})
@@ -0,0 +1,206 @@
+package indexing
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/go-multierror"
+	"github.com/inconshreveable/log15"
+	"github.com/opentracing/opentracing-go/log"
+	"golang.org/x/time/rate"
+
+	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// maximumCommitsBehind is the number of commits between the tip of the default branch and the
+// most recent upload of a repository after which the repository is considered entirely stale.
+const maximumCommitsBehind = 100
+
+// ActivityScheduler periodically enqueues auto-index jobs for the tip of the default branch of the
+// repositories that would benefit the most from precise code intelligence. Candidates are chosen by
+// search-based code intelligence traffic and ranked by how far their existing precise coverage lags
+// behind recent commits. Repositories whose tip commit is already covered are skipped.
+type ActivityScheduler struct {
+	dbStore                     DBStore
+	gitserverClient             GitserverClient
+	indexEnqueuer               IndexEnqueuer
+	operations                  *operations
+	batchSize                   int
+	candidateBatchSize          int
+	minimumTimeSinceLastEnqueue time.Duration
+	minimumSearchCount          int
+	minimumSearchRatio          float64
+	minimumPreciseCount         int
+	limiter                     *rate.Limiter
+}
+
+var _ goroutine.Handler = &ActivityScheduler{}
+
+func NewActivityScheduler(
+	dbStore DBStore,
+	gitserverClient GitserverClient,
+	indexEnqueuer IndexEnqueuer,
+	batchSize int,
+	candidateBatchSize int,
+	minimumTimeSinceLastEnqueue time.Duration,
+	minimumSearchCount int,
+	minimumSearchRatio float64,
+	minimumPreciseCount int,
+	interval time.Duration,
+	observationContext *observation.Context,
+) goroutine.BackgroundRoutine {
+	scheduler := &ActivityScheduler{
+		dbStore:                     dbStore,
+		gitserverClient:             gitserverClient,
+		indexEnqueuer:               indexEnqueuer,
+		operations:                  newOperations(observationContext),
+		batchSize:                   batchSize,
+		candidateBatchSize:          candidateBatchSize,
+		minimumTimeSinceLastEnqueue: minimumTimeSinceLastEnqueue,
+		minimumSearchCount:          minimumSearchCount,
+		minimumSearchRatio:          minimumSearchRatio,
+		minimumPreciseCount:         minimumPreciseCount,
+		limiter:                     rate.NewLimiter(MaxGitserverRequestsPerSecond, 1),
+	}
+
+	return goroutine.NewPeriodicGoroutineWithMetrics(
+		context.Background(),
+		interval,
+		scheduler,
+		scheduler.operations.HandleActivityScheduler,
+	)
+}
+
+// indexCandidate is a repository that may be enqueued for auto-indexing.
+type indexCandidate struct {
+	RepositoryID int
+	Score        float64
+}
+
+func (s *ActivityScheduler) Handle(ctx context.Context) error {
+	if !indexSchedulerEnabled() {
+		return nil
+	}
+
+	indexableRepositories, err := s.dbStore.IndexableRepositories(ctx, store.IndexableRepositoryQueryOptions{
+		Limit:                       s.candidateBatchSize,
+		MinimumTimeSinceLastEnqueue: s.minimumTimeSinceLastEnqueue,
+		MinimumSearchCount:          s.minimumSearchCount,
+		MinimumPreciseCount:         s.minimumPreciseCount,
+		MinimumSearchRatio:          s.minimumSearchRatio,
+	})
+	if err != nil {
+		return errors.Wrap(err, "dbstore.IndexableRepositories")
+	}
+
+	candidates := make([]indexCandidate, 0, len(indexableRepositories))
+	for _, indexableRepository := range indexableRepositories {
+		score, ok, err := s.scoreRepository(ctx, indexableRepository)
+		if err != nil {
+			if isRepoNotExist(err) {
+				continue
+			}
+
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		candidates = append(candidates, indexCandidate{RepositoryID: indexableRepository.RepositoryID, Score: score})
+	}
+
+	var queueErr error
+	for _, candidate := range rankIndexCandidates(candidates, s.batchSize) {
+		if err := s.indexEnqueuer.QueueIndexesForRepository(ctx, candidate.RepositoryID); err != nil {
+			if isRepoNotExist(err) {
+				continue
+			}
+
+			queueErr = multierror.Append(queueErr, err)
+		}
+	}
+
+	return queueErr
+}
+
+func (s *ActivityScheduler) HandleError(err error) {
+	log15.Error("Failed to schedule auto-index jobs for active repositories", "err", err)
+}
+
+// scoreRepository determines the value of indexing the tip of the default branch of the given
+// repository. This method returns a false-valued flag if the tip is already covered by an upload.
+func (s *ActivityScheduler) scoreRepository(ctx context.Context, indexableRepository store.IndexableRepository) (_ float64, _ bool, err error) {
+	ctx, traceLog, endObservation := s.operations.ScoreRepository.WithAndLogger(ctx, &err, observation.Args{
+		LogFields: []log.Field{
+			log.Int("repositoryID", indexableRepository.RepositoryID),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	uploads, _, err := s.dbStore.GetUploads(ctx, store.GetUploadsOptions{
+		RepositoryID: indexableRepository.RepositoryID,
+		VisibleAtTip: true,
+		Limit:        1,
+	})
+	if err != nil {
+		return 0, false, errors.Wrap(err, "dbstore.GetUploads")
+	}
+
+	if err := s.limiter.Wait(ctx); err != nil {
+		return 0, false, err
+	}
+
+	commit, err := s.gitserverClient.Head(ctx, indexableRepository.RepositoryID)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "gitserver.Head")
+	}
+	traceLog(log.String("commit", commit))
+
+	commitsBehind := maximumCommitsBehind
+	if len(uploads) > 0 {
+		if uploads[0].Commit == commit {
+			traceLog(log.Event("tip commit already covered"))
+			return 0, false, nil
+		}
+
+		if err := s.limiter.Wait(ctx); err != nil {
+			return 0, false, err
+		}
+
+		if commitsBehind, err = s.gitserverClient.CommitCount(ctx, indexableRepository.RepositoryID, uploads[0].Commit, commit); err != nil {
+			return 0, false, errors.Wrap(err, "gitserver.CommitCount")
+		}
+	}
+	traceLog(log.Int("commitsBehind", commitsBehind))
+
+	return candidateScore(indexableRepository.SearchCount, commitsBehind), true, nil
+}
+
+// candidateScore weights the search-based code intelligence traffic of a repository by the staleness
+// of its precise coverage. A repository without any precise coverage at the tip of the default branch
+// is considered to be maximumCommitsBehind commits behind.
+func candidateScore(searchCount, commitsBehind int) float64 {
+	if commitsBehind > maximumCommitsBehind {
+		commitsBehind = maximumCommitsBehind
+	}
+
+	return float64(searchCount+1) * (1 + float64(commitsBehind)/maximumCommitsBehind)
+}
+
+// rankIndexCandidates returns at most limit of the given candidates with the highest score.
+func rankIndexCandidates(candidates []indexCandidate, limit int) []indexCandidate {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Score > candidates[j].Score
+	})
+
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+
+	return candidates
+}
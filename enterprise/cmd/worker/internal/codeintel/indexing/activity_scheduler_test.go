@@ -0,0 +1,96 @@
+package indexing
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/time/rate"
+
+	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestActivitySchedulerUpdate(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	mockDBStore.IndexableRepositoriesFunc.SetDefaultReturn([]store.IndexableRepository{
+		{RepositoryID: 41, SearchCount: 10},  // no uploads
+		{RepositoryID: 42, SearchCount: 100}, // tip already covered
+		{RepositoryID: 43, SearchCount: 20},  // 10 commits behind
+		{RepositoryID: 44, SearchCount: 15},  // 50 commits behind
+	}, nil)
+	mockDBStore.GetUploadsFunc.SetDefaultHook(func(ctx context.Context, opts store.GetUploadsOptions) ([]store.Upload, int, error) {
+		switch opts.RepositoryID {
+		case 42:
+			return []store.Upload{{Commit: "c42"}}, 1, nil
+		case 43, 44:
+			return []store.Upload{{Commit: "old"}}, 1, nil
+		}
+
+		return nil, 0, nil
+	})
+
+	mockGitserverClient := NewMockGitserverClient()
+	mockGitserverClient.HeadFunc.SetDefaultHook(func(ctx context.Context, repositoryID int) (string, error) {
+		return fmt.Sprintf("c%d", repositoryID), nil
+	})
+	mockGitserverClient.CommitCountFunc.SetDefaultHook(func(ctx context.Context, repositoryID int, from, to string) (int, error) {
+		if repositoryID == 43 {
+			return 10, nil
+		}
+		return 50, nil
+	})
+
+	indexEnqueuer := NewMockIndexEnqueuer()
+
+	scheduler := &ActivityScheduler{
+		dbStore:         mockDBStore,
+		gitserverClient: mockGitserverClient,
+		indexEnqueuer:   indexEnqueuer,
+		operations:      newOperations(&observation.TestContext),
+		batchSize:       2,
+		limiter:         rate.NewLimiter(rate.Inf, 1),
+	}
+
+	if err := scheduler.Handle(context.Background()); err != nil {
+		t.Fatalf("unexpected error performing update: %s", err)
+	}
+
+	var repositoryIDs []int
+	for _, call := range indexEnqueuer.QueueIndexesForRepositoryFunc.History() {
+		repositoryIDs = append(repositoryIDs, call.Arg1)
+	}
+
+	// 41: 11 * 2.0 = 22.0; 43: 21 * 1.1 = 23.1; 44: 16 * 1.5 = 24.0
+	if diff := cmp.Diff([]int{44, 43}, repositoryIDs); diff != "" {
+		t.Errorf("unexpected repository IDs (-want +got):\n%s", diff)
+	}
+
+	if len(mockGitserverClient.CommitCountFunc.History()) != 2 {
+		t.Errorf("unexpected number of calls to CommitCount. want=%d have=%d", 2, len(mockGitserverClient.CommitCountFunc.History()))
+	}
+}
+
+func TestCandidateScore(t *testing.T) {
+	testCases := []struct {
+		searchCount   int
+		commitsBehind int
+		expected      float64
+	}{
+		{searchCount: 0, commitsBehind: 0, expected: 1},
+		{searchCount: 9, commitsBehind: 50, expected: 15},
+		{searchCount: 9, commitsBehind: maximumCommitsBehind, expected: 20},
+		{searchCount: 9, commitsBehind: maximumCommitsBehind * 10, expected: 20},
+	}
+
+	for _, testCase := range testCases {
+		name := fmt.Sprintf("searchCount=%d commitsBehind=%d", testCase.searchCount, testCase.commitsBehind)
+
+		t.Run(name, func(t *testing.T) {
+			if score := candidateScore(testCase.searchCount, testCase.commitsBehind); score != testCase.expected {
+				t.Errorf("unexpected score. want=%.2f have=%.2f", testCase.expected, score)
+			}
+		})
+	}
+}
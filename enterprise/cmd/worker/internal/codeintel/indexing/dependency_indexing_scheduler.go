@@ -7,6 +7,7 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/hashicorp/go-multierror"
 
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/autoindex/enqueuer"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
@@ -84,7 +85,9 @@ func (h *dependencyIndexingSchedulerHandler) Handle(ctx context.Context, tx dbwo
 			Name:    packageReference.Package.Name,
 			Version: packageReference.Package.Version,
 		}
-		if err := h.indexEnqueuer.QueueIndexesForPackage(ctx, pkg); err != nil {
+		// Dependencies whose repositories are still cloning are picked up by the dependency
+		// syncing scheduler once requested by a code intelligence query
+		if err := h.indexEnqueuer.QueueIndexesForPackage(ctx, pkg); err != nil && !errors.Is(err, enqueuer.ErrCloneInProgress) {
 			errs = append(errs, errors.Wrap(err, "enqueuer.QueueIndexesForPackage"))
 		}
 	}
@@ -0,0 +1,74 @@
+package indexing
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/autoindex/enqueuer"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker"
+	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
+)
+
+// NewDependencySyncingScheduler returns a new worker instance that processes
+// records from lsif_dependency_syncing_jobs.
+func NewDependencySyncingScheduler(
+	workerStore dbworkerstore.Store,
+	enqueuer IndexEnqueuer,
+	pollInterval time.Duration,
+	numProcessorRoutines int,
+	workerMetrics workerutil.WorkerMetrics,
+) *workerutil.Worker {
+	rootContext := actor.WithActor(context.Background(), &actor.Actor{Internal: true})
+
+	handler := &dependencySyncingSchedulerHandler{
+		indexEnqueuer: enqueuer,
+	}
+
+	return dbworker.NewWorker(rootContext, workerStore, handler, workerutil.WorkerOptions{
+		Name:        "precise_code_intel_dependency_syncing_scheduler_worker",
+		NumHandlers: numProcessorRoutines,
+		Interval:    pollInterval,
+		Metrics:     workerMetrics,
+	})
+}
+
+type dependencySyncingSchedulerHandler struct {
+	indexEnqueuer IndexEnqueuer
+}
+
+var _ dbworker.Handler = &dependencySyncingSchedulerHandler{}
+
+// CloneInProgressDelay is the delay between processing attempts of a dependency
+// syncing job while the repository of its package is being cloned.
+const CloneInProgressDelay = time.Minute
+
+// Handle maps the package of a dependency syncing job to its repository, adds and
+// clones the repository if it is not yet on the instance, and enqueues index jobs for
+// the commit of the package version. The job is requeued while the repository is
+// being cloned. This does not count against the job as a failed attempt.
+func (h *dependencySyncingSchedulerHandler) Handle(ctx context.Context, tx dbworkerstore.Store, record workerutil.Record) error {
+	job := record.(dbstore.DependencySyncingJob)
+
+	pkg := semantic.Package{
+		Scheme:  job.Scheme,
+		Name:    job.Name,
+		Version: job.Version,
+	}
+	if err := h.indexEnqueuer.QueueIndexesForPackage(ctx, pkg); err != nil {
+		if !errors.Is(err, enqueuer.ErrCloneInProgress) {
+			return errors.Wrap(err, "enqueuer.QueueIndexesForPackage")
+		}
+
+		if err := tx.Requeue(ctx, job.ID, time.Now().UTC().Add(CloneInProgressDelay)); err != nil {
+			return errors.Wrap(err, "store.Requeue")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package indexing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/autoindex/enqueuer"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	workerstoremocks "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store/mocks"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
+)
+
+func TestDependencySyncingSchedulerHandler(t *testing.T) {
+	indexEnqueuer := NewMockIndexEnqueuer()
+	workerStore := workerstoremocks.NewMockStore()
+
+	handler := &dependencySyncingSchedulerHandler{
+		indexEnqueuer: indexEnqueuer,
+	}
+
+	job := dbstore.DependencySyncingJob{
+		ID:      23,
+		Scheme:  "gomod",
+		Name:    "https://github.com/sourcegraph/sourcegraph",
+		Version: "v3.31.0",
+	}
+	if err := handler.Handle(context.Background(), workerStore, job); err != nil {
+		t.Fatalf("unexpected error performing update: %s", err)
+	}
+
+	if len(indexEnqueuer.QueueIndexesForPackageFunc.History()) != 1 {
+		t.Fatalf("unexpected number of calls to QueueIndexesForPackage. want=%d have=%d", 1, len(indexEnqueuer.QueueIndexesForPackageFunc.History()))
+	}
+	expectedPackage := semantic.Package{Scheme: "gomod", Name: "https://github.com/sourcegraph/sourcegraph", Version: "v3.31.0"}
+	if diff := cmp.Diff(expectedPackage, indexEnqueuer.QueueIndexesForPackageFunc.History()[0].Arg1); diff != "" {
+		t.Errorf("unexpected package (-want +got):\n%s", diff)
+	}
+
+	if len(workerStore.RequeueFunc.History()) != 0 {
+		t.Errorf("unexpected number of calls to Requeue. want=%d have=%d", 0, len(workerStore.RequeueFunc.History()))
+	}
+}
+
+func TestDependencySyncingSchedulerHandlerCloneInProgress(t *testing.T) {
+	indexEnqueuer := NewMockIndexEnqueuer()
+	indexEnqueuer.QueueIndexesForPackageFunc.SetDefaultReturn(enqueuer.ErrCloneInProgress)
+	workerStore := workerstoremocks.NewMockStore()
+
+	handler := &dependencySyncingSchedulerHandler{
+		indexEnqueuer: indexEnqueuer,
+	}
+
+	job := dbstore.DependencySyncingJob{
+		ID:      23,
+		Scheme:  "gomod",
+		Name:    "https://github.com/sourcegraph/sourcegraph",
+		Version: "v3.31.0",
+	}
+	if err := handler.Handle(context.Background(), workerStore, job); err != nil {
+		t.Fatalf("unexpected error performing update: %s", err)
+	}
+
+	if len(workerStore.RequeueFunc.History()) != 1 {
+		t.Fatalf("unexpected number of calls to Requeue. want=%d have=%d", 1, len(workerStore.RequeueFunc.History()))
+	}
+	if id := workerStore.RequeueFunc.History()[0].Arg1; id != 23 {
+		t.Errorf("unexpected requeued job. want=%d have=%d", 23, id)
+	}
+}
@@ -18,6 +18,7 @@ type DBStore interface {
 	RepoUsageStatistics(ctx context.Context) ([]dbstore.RepoUsageStatistics, error)
 	ResetIndexableRepositories(ctx context.Context, lastUpdatedBefore time.Time) error
 	UpdateIndexableRepository(ctx context.Context, indexableRepository dbstore.UpdateableIndexableRepository, now time.Time) error
+	UpdateUsageStatistics(ctx context.Context, since time.Time) error
 	GetUploads(ctx context.Context, opts dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error)
 	GetUploadByID(ctx context.Context, id int) (dbstore.Upload, bool, error)
 	ReferencesForUpload(ctx context.Context, uploadID int) (dbstore.PackageReferenceScanner, error)
@@ -33,6 +34,7 @@ func (s *DBStoreShim) With(other basestore.ShareableStore) DBStore {
 
 type GitserverClient interface {
 	Head(ctx context.Context, repositoryID int) (string, error)
+	CommitCount(ctx context.Context, repositoryID int, from, to string) (int, error)
 	ListFiles(ctx context.Context, repositoryID int, commit string, pattern *regexp.Regexp) ([]string, error)
 	FileExists(ctx context.Context, repositoryID int, commit, file string) (bool, error)
 	RawContents(ctx context.Context, repositoryID int, commit, file string) ([]byte, error)
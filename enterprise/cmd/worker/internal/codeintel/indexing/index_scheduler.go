@@ -8,22 +8,19 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/inconshreveable/log15"
 
-	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/vcs"
 )
 
+// IndexScheduler periodically enqueues auto-index jobs for the tip of the default branch of each
+// repository with an explicit index configuration. Repositories without explicit configuration are
+// scheduled by the ActivityScheduler.
 type IndexScheduler struct {
-	dbStore                     DBStore
-	indexEnqueuer               IndexEnqueuer
-	operations                  *operations
-	batchSize                   int
-	minimumTimeSinceLastEnqueue time.Duration
-	minimumSearchCount          int
-	minimumSearchRatio          float64
-	minimumPreciseCount         int
+	dbStore       DBStore
+	indexEnqueuer IndexEnqueuer
+	operations    *operations
 }
 
 var _ goroutine.Handler = &IndexScheduler{}
@@ -31,23 +28,13 @@ var _ goroutine.Handler = &IndexScheduler{}
 func NewIndexScheduler(
 	dbStore DBStore,
 	indexEnqueuer IndexEnqueuer,
-	batchSize int,
-	minimumTimeSinceLastEnqueue time.Duration,
-	minimumSearchCount int,
-	minimumSearchRatio float64,
-	minimumPreciseCount int,
 	interval time.Duration,
 	observationContext *observation.Context,
 ) goroutine.BackgroundRoutine {
 	scheduler := &IndexScheduler{
-		dbStore:                     dbStore,
-		indexEnqueuer:               indexEnqueuer,
-		batchSize:                   batchSize,
-		minimumTimeSinceLastEnqueue: minimumTimeSinceLastEnqueue,
-		minimumSearchCount:          minimumSearchCount,
-		minimumSearchRatio:          minimumSearchRatio,
-		minimumPreciseCount:         minimumPreciseCount,
-		operations:                  newOperations(observationContext),
+		dbStore:       dbStore,
+		indexEnqueuer: indexEnqueuer,
+		operations:    newOperations(observationContext),
 	}
 
 	return goroutine.NewPeriodicGoroutineWithMetrics(
@@ -71,24 +58,8 @@ func (s *IndexScheduler) Handle(ctx context.Context) error {
 		return errors.Wrap(err, "dbstore.GetRepositoriesWithIndexConfiguration")
 	}
 
-	indexableRepositories, err := s.dbStore.IndexableRepositories(ctx, store.IndexableRepositoryQueryOptions{
-		Limit:                       s.batchSize,
-		MinimumTimeSinceLastEnqueue: s.minimumTimeSinceLastEnqueue,
-		MinimumSearchCount:          s.minimumSearchCount,
-		MinimumPreciseCount:         s.minimumPreciseCount,
-		MinimumSearchRatio:          s.minimumSearchRatio,
-	})
-	if err != nil {
-		return errors.Wrap(err, "dbstore.IndexableRepositories")
-	}
-
-	var indexableRepositoryIDs []int
-	for _, indexableRepository := range indexableRepositories {
-		indexableRepositoryIDs = append(indexableRepositoryIDs, indexableRepository.RepositoryID)
-	}
-
 	var queueErr error
-	for _, repositoryID := range deduplicateRepositoryIDs(configuredRepositoryIDs, indexableRepositoryIDs) {
+	for _, repositoryID := range deduplicateRepositoryIDs(configuredRepositoryIDs) {
 		if err := s.indexEnqueuer.QueueIndexesForRepository(ctx, repositoryID); err != nil {
 			if isRepoNotExist(err) {
 				continue
@@ -7,7 +7,6 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 
-	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 )
 
@@ -18,12 +17,6 @@ func init() {
 func TestIndexSchedulerUpdate(t *testing.T) {
 	mockDBStore := NewMockDBStore()
 	mockDBStore.GetRepositoriesWithIndexConfigurationFunc.SetDefaultReturn([]int{43, 44, 45, 46}, nil)
-	mockDBStore.IndexableRepositoriesFunc.SetDefaultReturn([]store.IndexableRepository{
-		{RepositoryID: 41},
-		{RepositoryID: 42},
-		{RepositoryID: 43},
-		{RepositoryID: 44},
-	}, nil)
 
 	indexEnqueuer := NewMockIndexEnqueuer()
 
@@ -37,8 +30,8 @@ func TestIndexSchedulerUpdate(t *testing.T) {
 		t.Fatalf("unexpected error performing update: %s", err)
 	}
 
-	if len(indexEnqueuer.QueueIndexesForRepositoryFunc.History()) != 6 {
-		t.Errorf("unexpected number of calls to QueueIndexesForRepository. want=%d have=%d", 6, len(indexEnqueuer.QueueIndexesForRepositoryFunc.History()))
+	if len(indexEnqueuer.QueueIndexesForRepositoryFunc.History()) != 4 {
+		t.Errorf("unexpected number of calls to QueueIndexesForRepository. want=%d have=%d", 4, len(indexEnqueuer.QueueIndexesForRepositoryFunc.History()))
 	} else {
 		var repositoryIDs []int
 		for _, call := range indexEnqueuer.QueueIndexesForRepositoryFunc.History() {
@@ -46,7 +39,7 @@ func TestIndexSchedulerUpdate(t *testing.T) {
 		}
 		sort.Ints(repositoryIDs)
 
-		if diff := cmp.Diff([]int{41, 42, 43, 44, 45, 46}, repositoryIDs); diff != "" {
+		if diff := cmp.Diff([]int{43, 44, 45, 46}, repositoryIDs); diff != "" {
 			t.Errorf("unexpected repository IDs (-want +got):\n%s", diff)
 		}
 	}
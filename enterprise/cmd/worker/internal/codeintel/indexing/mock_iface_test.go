@@ -46,6 +46,9 @@ type MockDBStore struct {
 	// object controlling the behavior of the method
 	// UpdateIndexableRepository.
 	UpdateIndexableRepositoryFunc *DBStoreUpdateIndexableRepositoryFunc
+	// UpdateUsageStatisticsFunc is an instance of a mock function object
+	// controlling the behavior of the method UpdateUsageStatistics.
+	UpdateUsageStatisticsFunc *DBStoreUpdateUsageStatisticsFunc
 	// WithFunc is an instance of a mock function object controlling the
 	// behavior of the method With.
 	WithFunc *DBStoreWithFunc
@@ -95,6 +98,11 @@ func NewMockDBStore() *MockDBStore {
 				return nil
 			},
 		},
+		UpdateUsageStatisticsFunc: &DBStoreUpdateUsageStatisticsFunc{
+			defaultHook: func(context.Context, time.Time) error {
+				return nil
+			},
+		},
 		WithFunc: &DBStoreWithFunc{
 			defaultHook: func(basestore.ShareableStore) DBStore {
 				return nil
@@ -131,6 +139,9 @@ func NewMockDBStoreFrom(i DBStore) *MockDBStore {
 		UpdateIndexableRepositoryFunc: &DBStoreUpdateIndexableRepositoryFunc{
 			defaultHook: i.UpdateIndexableRepository,
 		},
+		UpdateUsageStatisticsFunc: &DBStoreUpdateUsageStatisticsFunc{
+			defaultHook: i.UpdateUsageStatistics,
+		},
 		WithFunc: &DBStoreWithFunc{
 			defaultHook: i.With,
 		},
@@ -1017,6 +1028,113 @@ func (c DBStoreUpdateIndexableRepositoryFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// DBStoreUpdateUsageStatisticsFunc describes the behavior when the
+// UpdateUsageStatistics method of the parent MockDBStore instance is
+// invoked.
+type DBStoreUpdateUsageStatisticsFunc struct {
+	defaultHook func(context.Context, time.Time) error
+	hooks       []func(context.Context, time.Time) error
+	history     []DBStoreUpdateUsageStatisticsFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateUsageStatistics delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockDBStore) UpdateUsageStatistics(v0 context.Context, v1 time.Time) error {
+	r0 := m.UpdateUsageStatisticsFunc.nextHook()(v0, v1)
+	m.UpdateUsageStatisticsFunc.appendCall(DBStoreUpdateUsageStatisticsFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// UpdateUsageStatistics method of the parent MockDBStore instance is
+// invoked and the hook queue is empty.
+func (f *DBStoreUpdateUsageStatisticsFunc) SetDefaultHook(hook func(context.Context, time.Time) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateUsageStatistics method of the parent MockDBStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *DBStoreUpdateUsageStatisticsFunc) PushHook(hook func(context.Context, time.Time) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *DBStoreUpdateUsageStatisticsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, time.Time) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *DBStoreUpdateUsageStatisticsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, time.Time) error {
+		return r0
+	})
+}
+
+func (f *DBStoreUpdateUsageStatisticsFunc) nextHook() func(context.Context, time.Time) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBStoreUpdateUsageStatisticsFunc) appendCall(r0 DBStoreUpdateUsageStatisticsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBStoreUpdateUsageStatisticsFuncCall
+// objects describing the invocations of this function.
+func (f *DBStoreUpdateUsageStatisticsFunc) History() []DBStoreUpdateUsageStatisticsFuncCall {
+	f.mutex.Lock()
+	history := make([]DBStoreUpdateUsageStatisticsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBStoreUpdateUsageStatisticsFuncCall is an object that describes an
+// invocation of method UpdateUsageStatistics on an instance of MockDBStore.
+type DBStoreUpdateUsageStatisticsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 time.Time
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBStoreUpdateUsageStatisticsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBStoreUpdateUsageStatisticsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // DBStoreWithFunc describes the behavior when the With method of the parent
 // MockDBStore instance is invoked.
 type DBStoreWithFunc struct {
@@ -1124,6 +1242,9 @@ func (c DBStoreWithFuncCall) Results() []interface{} {
 // github.com/sourcegraph/sourcegraph/enterprise/cmd/worker/internal/codeintel/indexing)
 // used for unit testing.
 type MockGitserverClient struct {
+	// CommitCountFunc is an instance of a mock function object controlling
+	// the behavior of the method CommitCount.
+	CommitCountFunc *GitserverClientCommitCountFunc
 	// FileExistsFunc is an instance of a mock function object controlling
 	// the behavior of the method FileExists.
 	FileExistsFunc *GitserverClientFileExistsFunc
@@ -1146,6 +1267,11 @@ type MockGitserverClient struct {
 // overwritten.
 func NewMockGitserverClient() *MockGitserverClient {
 	return &MockGitserverClient{
+		CommitCountFunc: &GitserverClientCommitCountFunc{
+			defaultHook: func(context.Context, int, string, string) (int, error) {
+				return 0, nil
+			},
+		},
 		FileExistsFunc: &GitserverClientFileExistsFunc{
 			defaultHook: func(context.Context, int, string, string) (bool, error) {
 				return false, nil
@@ -1179,6 +1305,9 @@ func NewMockGitserverClient() *MockGitserverClient {
 // overwritten.
 func NewMockGitserverClientFrom(i GitserverClient) *MockGitserverClient {
 	return &MockGitserverClient{
+		CommitCountFunc: &GitserverClientCommitCountFunc{
+			defaultHook: i.CommitCount,
+		},
 		FileExistsFunc: &GitserverClientFileExistsFunc{
 			defaultHook: i.FileExists,
 		},
@@ -1197,6 +1326,121 @@ func NewMockGitserverClientFrom(i GitserverClient) *MockGitserverClient {
 	}
 }
 
+// GitserverClientCommitCountFunc describes the behavior when the
+// CommitCount method of the parent MockGitserverClient instance is invoked.
+type GitserverClientCommitCountFunc struct {
+	defaultHook func(context.Context, int, string, string) (int, error)
+	hooks       []func(context.Context, int, string, string) (int, error)
+	history     []GitserverClientCommitCountFuncCall
+	mutex       sync.Mutex
+}
+
+// CommitCount delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGitserverClient) CommitCount(v0 context.Context, v1 int, v2 string, v3 string) (int, error) {
+	r0, r1 := m.CommitCountFunc.nextHook()(v0, v1, v2, v3)
+	m.CommitCountFunc.appendCall(GitserverClientCommitCountFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CommitCount method
+// of the parent MockGitserverClient instance is invoked and the hook queue
+// is empty.
+func (f *GitserverClientCommitCountFunc) SetDefaultHook(hook func(context.Context, int, string, string) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CommitCount method of the parent MockGitserverClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GitserverClientCommitCountFunc) PushHook(hook func(context.Context, int, string, string) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *GitserverClientCommitCountFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string, string) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *GitserverClientCommitCountFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, int, string, string) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverClientCommitCountFunc) nextHook() func(context.Context, int, string, string) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverClientCommitCountFunc) appendCall(r0 GitserverClientCommitCountFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverClientCommitCountFuncCall objects
+// describing the invocations of this function.
+func (f *GitserverClientCommitCountFunc) History() []GitserverClientCommitCountFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverClientCommitCountFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverClientCommitCountFuncCall is an object that describes an
+// invocation of method CommitCount on an instance of MockGitserverClient.
+type GitserverClientCommitCountFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverClientCommitCountFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverClientCommitCountFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // GitserverClientFileExistsFunc describes the behavior when the FileExists
 // method of the parent MockGitserverClient instance is invoked.
 type GitserverClientFileExistsFunc struct {
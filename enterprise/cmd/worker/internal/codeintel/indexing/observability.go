@@ -9,9 +9,12 @@ import (
 )
 
 type operations struct {
-	HandleIndexabilityUpdater *observation.Operation
-	HandleIndexScheduler      *observation.Operation
-	QueueRepository           *observation.Operation
+	HandleActivityScheduler      *observation.Operation
+	HandleIndexabilityUpdater    *observation.Operation
+	HandleIndexScheduler         *observation.Operation
+	HandleUsageStatisticsUpdater *observation.Operation
+	QueueRepository              *observation.Operation
+	ScoreRepository              *observation.Operation
 }
 
 var (
@@ -37,9 +40,12 @@ func newOperations(observationContext *observation.Context) *operations {
 		}
 
 		singletonOperations = &operations{
-			HandleIndexabilityUpdater: op("HandleIndexabilityUpdate"),
-			HandleIndexScheduler:      op("HandleIndexSchedule"),
-			QueueRepository:           op("QueueRepository"),
+			HandleActivityScheduler:      op("HandleActivitySchedule"),
+			HandleIndexabilityUpdater:    op("HandleIndexabilityUpdate"),
+			HandleIndexScheduler:         op("HandleIndexSchedule"),
+			HandleUsageStatisticsUpdater: op("HandleUsageStatisticsUpdate"),
+			QueueRepository:              op("QueueRepository"),
+			ScoreRepository:              op("ScoreRepository"),
 		}
 	})
 	return singletonOperations
@@ -0,0 +1,58 @@
+package indexing
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/derision-test/glock"
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// usageStatisticsLookback is the age of the oldest event log records re-aggregated on each update.
+// Records older than this are aggregated by a previous update and are not revisited.
+const usageStatisticsLookback = time.Hour * 24
+
+// UsageStatisticsUpdater periodically aggregates code intelligence event log records into daily
+// per-repository counts of the precise and search-based code intelligence results served.
+type UsageStatisticsUpdater struct {
+	dbStore    DBStore
+	operations *operations
+	clock      glock.Clock
+}
+
+var _ goroutine.Handler = &UsageStatisticsUpdater{}
+
+func NewUsageStatisticsUpdater(
+	dbStore DBStore,
+	interval time.Duration,
+	observationContext *observation.Context,
+) goroutine.BackgroundRoutine {
+	updater := &UsageStatisticsUpdater{
+		dbStore:    dbStore,
+		operations: newOperations(observationContext),
+		clock:      glock.NewRealClock(),
+	}
+
+	return goroutine.NewPeriodicGoroutineWithMetrics(
+		context.Background(),
+		interval,
+		updater,
+		updater.operations.HandleUsageStatisticsUpdater,
+	)
+}
+
+func (u *UsageStatisticsUpdater) Handle(ctx context.Context) error {
+	if err := u.dbStore.UpdateUsageStatistics(ctx, u.clock.Now().Add(-usageStatisticsLookback)); err != nil {
+		return errors.Wrap(err, "dbstore.UpdateUsageStatistics")
+	}
+
+	return nil
+}
+
+func (u *UsageStatisticsUpdater) HandleError(err error) {
+	log15.Error("Failed to update code intelligence usage statistics", "err", err)
+}
@@ -0,0 +1,31 @@
+package indexing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derision-test/glock"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestUsageStatisticsUpdater(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	clock := glock.NewMockClock()
+
+	updater := &UsageStatisticsUpdater{
+		dbStore:    mockDBStore,
+		operations: newOperations(&observation.TestContext),
+		clock:      clock,
+	}
+
+	if err := updater.Handle(context.Background()); err != nil {
+		t.Fatalf("unexpected error performing update: %s", err)
+	}
+
+	if history := mockDBStore.UpdateUsageStatisticsFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected number of calls to UpdateUsageStatistics. want=%d have=%d", 1, len(history))
+	} else if expected := clock.Now().Add(-usageStatisticsLookback); !history[0].Arg1.Equal(expected) {
+		t.Errorf("unexpected since argument. want=%s have=%s", expected, history[0].Arg1)
+	}
+}
@@ -50,11 +50,15 @@ func (j *indexingJob) Routines(ctx context.Context) ([]goroutine.BackgroundRouti
 	enqueuerDBStoreShim := &enqueuer.DBStoreShim{Store: dbStore}
 	indexEnqueuer := enqueuer.NewIndexEnqueuer(enqueuerDBStoreShim, gitserverClient, repoupdater.DefaultClient, observationContext)
 	metrics := workerutil.NewMetrics(observationContext, "codeintel_dependency_indexing_processor", nil)
+	syncingMetrics := workerutil.NewMetrics(observationContext, "codeintel_dependency_syncing_processor", nil)
 
 	routines := []goroutine.BackgroundRoutine{
-		indexing.NewIndexScheduler(dbStoreShim, indexEnqueuer, indexingConfigInst.IndexBatchSize, indexingConfigInst.MinimumTimeSinceLastEnqueue, indexingConfigInst.MinimumSearchCount, float64(indexingConfigInst.MinimumSearchRatio)/100, indexingConfigInst.MinimumPreciseCount, indexingConfigInst.AutoIndexingTaskInterval, observationContext),
+		indexing.NewIndexScheduler(dbStoreShim, indexEnqueuer, indexingConfigInst.AutoIndexingTaskInterval, observationContext),
+		indexing.NewActivityScheduler(dbStoreShim, gitserverClient, indexEnqueuer, indexingConfigInst.IndexBatchSize, indexingConfigInst.IndexCandidateBatchSize, indexingConfigInst.MinimumTimeSinceLastEnqueue, indexingConfigInst.MinimumSearchCount, float64(indexingConfigInst.MinimumSearchRatio)/100, indexingConfigInst.MinimumPreciseCount, indexingConfigInst.AutoIndexingTaskInterval, observationContext),
 		indexing.NewIndexabilityUpdater(dbStoreShim, gitserverClient, indexingConfigInst.MinimumSearchCount, float64(indexingConfigInst.MinimumSearchRatio)/100, indexingConfigInst.MinimumPreciseCount, indexingConfigInst.AutoIndexingSkipManualInterval, indexingConfigInst.AutoIndexingTaskInterval, observationContext),
+		indexing.NewUsageStatisticsUpdater(dbStoreShim, indexingConfigInst.UsageStatisticsUpdateInterval, observationContext),
 		indexing.NewDependencyIndexingScheduler(dbStoreShim, dbstore.WorkerutilDependencyIndexingJobStore(dbStore, observationContext), indexEnqueuer, indexingConfigInst.DependencyIndexerSchedulerPollInterval, indexingConfigInst.DependencyIndexerSchedulerConcurrency, metrics),
+		indexing.NewDependencySyncingScheduler(dbstore.WorkerutilDependencySyncingJobStore(dbStore, observationContext), indexEnqueuer, indexingConfigInst.DependencySyncerSchedulerPollInterval, indexingConfigInst.DependencySyncerSchedulerConcurrency, syncingMetrics),
 	}
 
 	return routines, nil
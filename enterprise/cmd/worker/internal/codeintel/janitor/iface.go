@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/gitserver"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
 )
@@ -22,9 +23,16 @@ type DBStore interface {
 	DeleteOldIndexes(ctx context.Context, maxAge time.Duration, now time.Time) (int, error)
 	DirtyRepositories(ctx context.Context) (map[int]int, error)
 	DeleteIndexesWithoutRepository(ctx context.Context, now time.Time) (map[int]int, error)
+	DeleteIndexesWithMissingRepository(ctx context.Context, limit int) (map[int]int, error)
 	DeleteUploadsStuckUploading(ctx context.Context, uploadedBefore time.Time) (int, error)
+	FailUploadsStuckProcessing(ctx context.Context, startedBefore time.Time, limit int) (int, int, error)
+	GetOrphanedUploadIDs(ctx context.Context, ids []int) ([]int, error)
 	StaleSourcedCommits(ctx context.Context, threshold time.Duration, limit int, now time.Time) ([]dbstore.SourcedCommits, error)
 	RefreshCommitResolvability(ctx context.Context, repositoryID int, commit string, delete bool, now time.Time) (int, int, error)
+	RepoName(ctx context.Context, repositoryID int) (string, error)
+	GetRetentionPolicies(ctx context.Context) ([]dbstore.RetentionPolicy, error)
+	GetRepositoriesWithCompletedUploads(ctx context.Context) ([]int, error)
+	UpdateUploadRetention(ctx context.Context, repositoryID int, protectedIDs, expiredIDs []int) (int, error)
 }
 
 type DBStoreShim struct {
@@ -42,4 +50,10 @@ func (s *DBStoreShim) Transact(ctx context.Context) (DBStore, error) {
 
 type LSIFStore interface {
 	Clear(ctx context.Context, bundleIDs ...int) error
+	BundleIDs(ctx context.Context, afterBundleID, limit int) ([]int, error)
+	BundleSize(ctx context.Context, bundleIDs []int) (int, int64, error)
+}
+
+type GitserverClient interface {
+	RefDescriptions(ctx context.Context, repositoryID int) (map[string][]gitserver.RefDescription, error)
 }
@@ -0,0 +1,54 @@
+package janitor
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+)
+
+type missingRepositoryJanitor struct {
+	dbStore   DBStore
+	batchSize int
+	metrics   *metrics
+}
+
+var _ goroutine.Handler = &missingRepositoryJanitor{}
+
+// NewMissingRepositoryJanitor returns a background routine that periodically deletes
+// index records whose repository row no longer exists. Records of soft-deleted
+// repositories are handled by the deleted repository janitor.
+func NewMissingRepositoryJanitor(dbStore DBStore, batchSize int, interval time.Duration, metrics *metrics) goroutine.BackgroundRoutine {
+	return goroutine.NewPeriodicGoroutine(context.Background(), interval, &missingRepositoryJanitor{
+		dbStore:   dbStore,
+		batchSize: batchSize,
+		metrics:   metrics,
+	})
+}
+
+func (j *missingRepositoryJanitor) Handle(ctx context.Context) error {
+	indexesCounts, err := j.dbStore.DeleteIndexesWithMissingRepository(ctx, j.batchSize)
+	if err != nil {
+		return errors.Wrap(err, "DeleteIndexesWithMissingRepository")
+	}
+
+	for _, counts := range gatherCounts(nil, indexesCounts) {
+		log15.Debug(
+			"Deleted codeintel index records with a missing repository",
+			"repository_id", counts.repoID,
+			"indexes_count", counts.indexesCount,
+		)
+
+		j.metrics.numIndexRecordsRemoved.Add(float64(counts.indexesCount))
+	}
+
+	return nil
+}
+
+func (j *missingRepositoryJanitor) HandleError(err error) {
+	j.metrics.numErrors.Inc()
+	log15.Error("Failed to delete codeintel index records with a missing repository", "error", err)
+}
@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	gitserver "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/gitserver"
 	dbstore "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
 	basestore "github.com/sourcegraph/sourcegraph/internal/database/basestore"
 )
@@ -16,6 +17,10 @@ import (
 // github.com/sourcegraph/sourcegraph/enterprise/cmd/worker/internal/codeintel/janitor)
 // used for unit testing.
 type MockDBStore struct {
+	// DeleteIndexesWithMissingRepositoryFunc is an instance of a mock
+	// function object controlling the behavior of the method
+	// DeleteIndexesWithMissingRepository.
+	DeleteIndexesWithMissingRepositoryFunc *DBStoreDeleteIndexesWithMissingRepositoryFunc
 	// DeleteIndexesWithoutRepositoryFunc is an instance of a mock function
 	// object controlling the behavior of the method
 	// DeleteIndexesWithoutRepository.
@@ -37,6 +42,20 @@ type MockDBStore struct {
 	// DoneFunc is an instance of a mock function object controlling the
 	// behavior of the method Done.
 	DoneFunc *DBStoreDoneFunc
+	// FailUploadsStuckProcessingFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// FailUploadsStuckProcessing.
+	FailUploadsStuckProcessingFunc *DBStoreFailUploadsStuckProcessingFunc
+	// GetOrphanedUploadIDsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetOrphanedUploadIDs.
+	GetOrphanedUploadIDsFunc *DBStoreGetOrphanedUploadIDsFunc
+	// GetRepositoriesWithCompletedUploadsFunc is an instance of a mock
+	// function object controlling the behavior of the method
+	// GetRepositoriesWithCompletedUploads.
+	GetRepositoriesWithCompletedUploadsFunc *DBStoreGetRepositoriesWithCompletedUploadsFunc
+	// GetRetentionPoliciesFunc is an instance of a mock function object
+	// controlling the behavior of the method GetRetentionPolicies.
+	GetRetentionPoliciesFunc *DBStoreGetRetentionPoliciesFunc
 	// GetUploadsFunc is an instance of a mock function object controlling
 	// the behavior of the method GetUploads.
 	GetUploadsFunc *DBStoreGetUploadsFunc
@@ -50,6 +69,9 @@ type MockDBStore struct {
 	// object controlling the behavior of the method
 	// RefreshCommitResolvability.
 	RefreshCommitResolvabilityFunc *DBStoreRefreshCommitResolvabilityFunc
+	// RepoNameFunc is an instance of a mock function object controlling the
+	// behavior of the method RepoName.
+	RepoNameFunc *DBStoreRepoNameFunc
 	// SoftDeleteOldUploadsFunc is an instance of a mock function object
 	// controlling the behavior of the method SoftDeleteOldUploads.
 	SoftDeleteOldUploadsFunc *DBStoreSoftDeleteOldUploadsFunc
@@ -59,12 +81,20 @@ type MockDBStore struct {
 	// TransactFunc is an instance of a mock function object controlling the
 	// behavior of the method Transact.
 	TransactFunc *DBStoreTransactFunc
+	// UpdateUploadRetentionFunc is an instance of a mock function object
+	// controlling the behavior of the method UpdateUploadRetention.
+	UpdateUploadRetentionFunc *DBStoreUpdateUploadRetentionFunc
 }
 
 // NewMockDBStore creates a new mock of the DBStore interface. All methods
 // return zero values for all results, unless overwritten.
 func NewMockDBStore() *MockDBStore {
 	return &MockDBStore{
+		DeleteIndexesWithMissingRepositoryFunc: &DBStoreDeleteIndexesWithMissingRepositoryFunc{
+			defaultHook: func(context.Context, int) (map[int]int, error) {
+				return nil, nil
+			},
+		},
 		DeleteIndexesWithoutRepositoryFunc: &DBStoreDeleteIndexesWithoutRepositoryFunc{
 			defaultHook: func(context.Context, time.Time) (map[int]int, error) {
 				return nil, nil
@@ -95,6 +125,26 @@ func NewMockDBStore() *MockDBStore {
 				return nil
 			},
 		},
+		FailUploadsStuckProcessingFunc: &DBStoreFailUploadsStuckProcessingFunc{
+			defaultHook: func(context.Context, time.Time, int) (int, int, error) {
+				return 0, 0, nil
+			},
+		},
+		GetOrphanedUploadIDsFunc: &DBStoreGetOrphanedUploadIDsFunc{
+			defaultHook: func(context.Context, []int) ([]int, error) {
+				return nil, nil
+			},
+		},
+		GetRepositoriesWithCompletedUploadsFunc: &DBStoreGetRepositoriesWithCompletedUploadsFunc{
+			defaultHook: func(context.Context) ([]int, error) {
+				return nil, nil
+			},
+		},
+		GetRetentionPoliciesFunc: &DBStoreGetRetentionPoliciesFunc{
+			defaultHook: func(context.Context) ([]dbstore.RetentionPolicy, error) {
+				return nil, nil
+			},
+		},
 		GetUploadsFunc: &DBStoreGetUploadsFunc{
 			defaultHook: func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error) {
 				return nil, 0, nil
@@ -115,6 +165,11 @@ func NewMockDBStore() *MockDBStore {
 				return 0, 0, nil
 			},
 		},
+		RepoNameFunc: &DBStoreRepoNameFunc{
+			defaultHook: func(context.Context, int) (string, error) {
+				return "", nil
+			},
+		},
 		SoftDeleteOldUploadsFunc: &DBStoreSoftDeleteOldUploadsFunc{
 			defaultHook: func(context.Context, time.Duration, time.Time) (int, error) {
 				return 0, nil
@@ -130,6 +185,11 @@ func NewMockDBStore() *MockDBStore {
 				return nil, nil
 			},
 		},
+		UpdateUploadRetentionFunc: &DBStoreUpdateUploadRetentionFunc{
+			defaultHook: func(context.Context, int, []int, []int) (int, error) {
+				return 0, nil
+			},
+		},
 	}
 }
 
@@ -137,6 +197,9 @@ func NewMockDBStore() *MockDBStore {
 // methods delegate to the given implementation, unless overwritten.
 func NewMockDBStoreFrom(i DBStore) *MockDBStore {
 	return &MockDBStore{
+		DeleteIndexesWithMissingRepositoryFunc: &DBStoreDeleteIndexesWithMissingRepositoryFunc{
+			defaultHook: i.DeleteIndexesWithMissingRepository,
+		},
 		DeleteIndexesWithoutRepositoryFunc: &DBStoreDeleteIndexesWithoutRepositoryFunc{
 			defaultHook: i.DeleteIndexesWithoutRepository,
 		},
@@ -155,6 +218,18 @@ func NewMockDBStoreFrom(i DBStore) *MockDBStore {
 		DoneFunc: &DBStoreDoneFunc{
 			defaultHook: i.Done,
 		},
+		FailUploadsStuckProcessingFunc: &DBStoreFailUploadsStuckProcessingFunc{
+			defaultHook: i.FailUploadsStuckProcessing,
+		},
+		GetOrphanedUploadIDsFunc: &DBStoreGetOrphanedUploadIDsFunc{
+			defaultHook: i.GetOrphanedUploadIDs,
+		},
+		GetRepositoriesWithCompletedUploadsFunc: &DBStoreGetRepositoriesWithCompletedUploadsFunc{
+			defaultHook: i.GetRepositoriesWithCompletedUploads,
+		},
+		GetRetentionPoliciesFunc: &DBStoreGetRetentionPoliciesFunc{
+			defaultHook: i.GetRetentionPolicies,
+		},
 		GetUploadsFunc: &DBStoreGetUploadsFunc{
 			defaultHook: i.GetUploads,
 		},
@@ -167,6 +242,9 @@ func NewMockDBStoreFrom(i DBStore) *MockDBStore {
 		RefreshCommitResolvabilityFunc: &DBStoreRefreshCommitResolvabilityFunc{
 			defaultHook: i.RefreshCommitResolvability,
 		},
+		RepoNameFunc: &DBStoreRepoNameFunc{
+			defaultHook: i.RepoName,
+		},
 		SoftDeleteOldUploadsFunc: &DBStoreSoftDeleteOldUploadsFunc{
 			defaultHook: i.SoftDeleteOldUploads,
 		},
@@ -176,7 +254,123 @@ func NewMockDBStoreFrom(i DBStore) *MockDBStore {
 		TransactFunc: &DBStoreTransactFunc{
 			defaultHook: i.Transact,
 		},
+		UpdateUploadRetentionFunc: &DBStoreUpdateUploadRetentionFunc{
+			defaultHook: i.UpdateUploadRetention,
+		},
+	}
+}
+
+// DBStoreDeleteIndexesWithMissingRepositoryFunc describes the behavior when
+// the DeleteIndexesWithMissingRepository method of the parent MockDBStore
+// instance is invoked.
+type DBStoreDeleteIndexesWithMissingRepositoryFunc struct {
+	defaultHook func(context.Context, int) (map[int]int, error)
+	hooks       []func(context.Context, int) (map[int]int, error)
+	history     []DBStoreDeleteIndexesWithMissingRepositoryFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteIndexesWithMissingRepository delegates to the next hook function in
+// the queue and stores the parameter and result values of this invocation.
+func (m *MockDBStore) DeleteIndexesWithMissingRepository(v0 context.Context, v1 int) (map[int]int, error) {
+	r0, r1 := m.DeleteIndexesWithMissingRepositoryFunc.nextHook()(v0, v1)
+	m.DeleteIndexesWithMissingRepositoryFunc.appendCall(DBStoreDeleteIndexesWithMissingRepositoryFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// DeleteIndexesWithMissingRepository method of the parent MockDBStore
+// instance is invoked and the hook queue is empty.
+func (f *DBStoreDeleteIndexesWithMissingRepositoryFunc) SetDefaultHook(hook func(context.Context, int) (map[int]int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteIndexesWithMissingRepository method of the parent MockDBStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *DBStoreDeleteIndexesWithMissingRepositoryFunc) PushHook(hook func(context.Context, int) (map[int]int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *DBStoreDeleteIndexesWithMissingRepositoryFunc) SetDefaultReturn(r0 map[int]int, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) (map[int]int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *DBStoreDeleteIndexesWithMissingRepositoryFunc) PushReturn(r0 map[int]int, r1 error) {
+	f.PushHook(func(context.Context, int) (map[int]int, error) {
+		return r0, r1
+	})
+}
+
+func (f *DBStoreDeleteIndexesWithMissingRepositoryFunc) nextHook() func(context.Context, int) (map[int]int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
 	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBStoreDeleteIndexesWithMissingRepositoryFunc) appendCall(r0 DBStoreDeleteIndexesWithMissingRepositoryFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// DBStoreDeleteIndexesWithMissingRepositoryFuncCall objects describing the
+// invocations of this function.
+func (f *DBStoreDeleteIndexesWithMissingRepositoryFunc) History() []DBStoreDeleteIndexesWithMissingRepositoryFuncCall {
+	f.mutex.Lock()
+	history := make([]DBStoreDeleteIndexesWithMissingRepositoryFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBStoreDeleteIndexesWithMissingRepositoryFuncCall is an object that
+// describes an invocation of method DeleteIndexesWithMissingRepository on
+// an instance of MockDBStore.
+type DBStoreDeleteIndexesWithMissingRepositoryFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 map[int]int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBStoreDeleteIndexesWithMissingRepositoryFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBStoreDeleteIndexesWithMissingRepositoryFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
 // DBStoreDeleteIndexesWithoutRepositoryFunc describes the behavior when the
@@ -837,34 +1031,37 @@ func (c DBStoreDoneFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// DBStoreGetUploadsFunc describes the behavior when the GetUploads method
-// of the parent MockDBStore instance is invoked.
-type DBStoreGetUploadsFunc struct {
-	defaultHook func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error)
-	hooks       []func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error)
-	history     []DBStoreGetUploadsFuncCall
+// DBStoreFailUploadsStuckProcessingFunc describes the behavior when the
+// FailUploadsStuckProcessing method of the parent MockDBStore instance is
+// invoked.
+type DBStoreFailUploadsStuckProcessingFunc struct {
+	defaultHook func(context.Context, time.Time, int) (int, int, error)
+	hooks       []func(context.Context, time.Time, int) (int, int, error)
+	history     []DBStoreFailUploadsStuckProcessingFuncCall
 	mutex       sync.Mutex
 }
 
-// GetUploads delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockDBStore) GetUploads(v0 context.Context, v1 dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error) {
-	r0, r1, r2 := m.GetUploadsFunc.nextHook()(v0, v1)
-	m.GetUploadsFunc.appendCall(DBStoreGetUploadsFuncCall{v0, v1, r0, r1, r2})
+// FailUploadsStuckProcessing delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockDBStore) FailUploadsStuckProcessing(v0 context.Context, v1 time.Time, v2 int) (int, int, error) {
+	r0, r1, r2 := m.FailUploadsStuckProcessingFunc.nextHook()(v0, v1, v2)
+	m.FailUploadsStuckProcessingFunc.appendCall(DBStoreFailUploadsStuckProcessingFuncCall{v0, v1, v2, r0, r1, r2})
 	return r0, r1, r2
 }
 
-// SetDefaultHook sets function that is called when the GetUploads method of
-// the parent MockDBStore instance is invoked and the hook queue is empty.
-func (f *DBStoreGetUploadsFunc) SetDefaultHook(hook func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error)) {
+// SetDefaultHook sets function that is called when the
+// FailUploadsStuckProcessing method of the parent MockDBStore instance is
+// invoked and the hook queue is empty.
+func (f *DBStoreFailUploadsStuckProcessingFunc) SetDefaultHook(hook func(context.Context, time.Time, int) (int, int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetUploads method of the parent MockDBStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *DBStoreGetUploadsFunc) PushHook(hook func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error)) {
+// FailUploadsStuckProcessing method of the parent MockDBStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *DBStoreFailUploadsStuckProcessingFunc) PushHook(hook func(context.Context, time.Time, int) (int, int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -872,21 +1069,21 @@ func (f *DBStoreGetUploadsFunc) PushHook(hook func(context.Context, dbstore.GetU
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *DBStoreGetUploadsFunc) SetDefaultReturn(r0 []dbstore.Upload, r1 int, r2 error) {
-	f.SetDefaultHook(func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error) {
+func (f *DBStoreFailUploadsStuckProcessingFunc) SetDefaultReturn(r0 int, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, time.Time, int) (int, int, error) {
 		return r0, r1, r2
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *DBStoreGetUploadsFunc) PushReturn(r0 []dbstore.Upload, r1 int, r2 error) {
-	f.PushHook(func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error) {
+func (f *DBStoreFailUploadsStuckProcessingFunc) PushReturn(r0 int, r1 int, r2 error) {
+	f.PushHook(func(context.Context, time.Time, int) (int, int, error) {
 		return r0, r1, r2
 	})
 }
 
-func (f *DBStoreGetUploadsFunc) nextHook() func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error) {
+func (f *DBStoreFailUploadsStuckProcessingFunc) nextHook() func(context.Context, time.Time, int) (int, int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -899,35 +1096,39 @@ func (f *DBStoreGetUploadsFunc) nextHook() func(context.Context, dbstore.GetUplo
 	return hook
 }
 
-func (f *DBStoreGetUploadsFunc) appendCall(r0 DBStoreGetUploadsFuncCall) {
+func (f *DBStoreFailUploadsStuckProcessingFunc) appendCall(r0 DBStoreFailUploadsStuckProcessingFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of DBStoreGetUploadsFuncCall objects
-// describing the invocations of this function.
-func (f *DBStoreGetUploadsFunc) History() []DBStoreGetUploadsFuncCall {
+// History returns a sequence of DBStoreFailUploadsStuckProcessingFuncCall
+// objects describing the invocations of this function.
+func (f *DBStoreFailUploadsStuckProcessingFunc) History() []DBStoreFailUploadsStuckProcessingFuncCall {
 	f.mutex.Lock()
-	history := make([]DBStoreGetUploadsFuncCall, len(f.history))
+	history := make([]DBStoreFailUploadsStuckProcessingFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// DBStoreGetUploadsFuncCall is an object that describes an invocation of
-// method GetUploads on an instance of MockDBStore.
-type DBStoreGetUploadsFuncCall struct {
+// DBStoreFailUploadsStuckProcessingFuncCall is an object that describes an
+// invocation of method FailUploadsStuckProcessing on an instance of
+// MockDBStore.
+type DBStoreFailUploadsStuckProcessingFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 dbstore.GetUploadsOptions
+	Arg1 time.Time
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []dbstore.Upload
+	Result0 int
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 int
@@ -938,44 +1139,46 @@ type DBStoreGetUploadsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c DBStoreGetUploadsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c DBStoreFailUploadsStuckProcessingFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c DBStoreGetUploadsFuncCall) Results() []interface{} {
+func (c DBStoreFailUploadsStuckProcessingFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// DBStoreHandleFunc describes the behavior when the Handle method of the
-// parent MockDBStore instance is invoked.
-type DBStoreHandleFunc struct {
-	defaultHook func() *basestore.TransactableHandle
-	hooks       []func() *basestore.TransactableHandle
-	history     []DBStoreHandleFuncCall
+// DBStoreGetOrphanedUploadIDsFunc describes the behavior when the
+// GetOrphanedUploadIDs method of the parent MockDBStore instance is
+// invoked.
+type DBStoreGetOrphanedUploadIDsFunc struct {
+	defaultHook func(context.Context, []int) ([]int, error)
+	hooks       []func(context.Context, []int) ([]int, error)
+	history     []DBStoreGetOrphanedUploadIDsFuncCall
 	mutex       sync.Mutex
 }
 
-// Handle delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockDBStore) Handle() *basestore.TransactableHandle {
-	r0 := m.HandleFunc.nextHook()()
-	m.HandleFunc.appendCall(DBStoreHandleFuncCall{r0})
-	return r0
+// GetOrphanedUploadIDs delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockDBStore) GetOrphanedUploadIDs(v0 context.Context, v1 []int) ([]int, error) {
+	r0, r1 := m.GetOrphanedUploadIDsFunc.nextHook()(v0, v1)
+	m.GetOrphanedUploadIDsFunc.appendCall(DBStoreGetOrphanedUploadIDsFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Handle method of the
-// parent MockDBStore instance is invoked and the hook queue is empty.
-func (f *DBStoreHandleFunc) SetDefaultHook(hook func() *basestore.TransactableHandle) {
+// SetDefaultHook sets function that is called when the GetOrphanedUploadIDs
+// method of the parent MockDBStore instance is invoked and the hook queue
+// is empty.
+func (f *DBStoreGetOrphanedUploadIDsFunc) SetDefaultHook(hook func(context.Context, []int) ([]int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Handle method of the parent MockDBStore instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *DBStoreHandleFunc) PushHook(hook func() *basestore.TransactableHandle) {
+// GetOrphanedUploadIDs method of the parent MockDBStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *DBStoreGetOrphanedUploadIDsFunc) PushHook(hook func(context.Context, []int) ([]int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -983,21 +1186,21 @@ func (f *DBStoreHandleFunc) PushHook(hook func() *basestore.TransactableHandle)
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *DBStoreHandleFunc) SetDefaultReturn(r0 *basestore.TransactableHandle) {
-	f.SetDefaultHook(func() *basestore.TransactableHandle {
-		return r0
+func (f *DBStoreGetOrphanedUploadIDsFunc) SetDefaultReturn(r0 []int, r1 error) {
+	f.SetDefaultHook(func(context.Context, []int) ([]int, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *DBStoreHandleFunc) PushReturn(r0 *basestore.TransactableHandle) {
-	f.PushHook(func() *basestore.TransactableHandle {
-		return r0
+func (f *DBStoreGetOrphanedUploadIDsFunc) PushReturn(r0 []int, r1 error) {
+	f.PushHook(func(context.Context, []int) ([]int, error) {
+		return r0, r1
 	})
 }
 
-func (f *DBStoreHandleFunc) nextHook() func() *basestore.TransactableHandle {
+func (f *DBStoreGetOrphanedUploadIDsFunc) nextHook() func(context.Context, []int) ([]int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1010,73 +1213,84 @@ func (f *DBStoreHandleFunc) nextHook() func() *basestore.TransactableHandle {
 	return hook
 }
 
-func (f *DBStoreHandleFunc) appendCall(r0 DBStoreHandleFuncCall) {
+func (f *DBStoreGetOrphanedUploadIDsFunc) appendCall(r0 DBStoreGetOrphanedUploadIDsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of DBStoreHandleFuncCall objects describing
-// the invocations of this function.
-func (f *DBStoreHandleFunc) History() []DBStoreHandleFuncCall {
+// History returns a sequence of DBStoreGetOrphanedUploadIDsFuncCall objects
+// describing the invocations of this function.
+func (f *DBStoreGetOrphanedUploadIDsFunc) History() []DBStoreGetOrphanedUploadIDsFuncCall {
 	f.mutex.Lock()
-	history := make([]DBStoreHandleFuncCall, len(f.history))
+	history := make([]DBStoreGetOrphanedUploadIDsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// DBStoreHandleFuncCall is an object that describes an invocation of method
-// Handle on an instance of MockDBStore.
-type DBStoreHandleFuncCall struct {
+// DBStoreGetOrphanedUploadIDsFuncCall is an object that describes an
+// invocation of method GetOrphanedUploadIDs on an instance of MockDBStore.
+type DBStoreGetOrphanedUploadIDsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 []int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *basestore.TransactableHandle
+	Result0 []int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c DBStoreHandleFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c DBStoreGetOrphanedUploadIDsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c DBStoreHandleFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c DBStoreGetOrphanedUploadIDsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// DBStoreHardDeleteUploadByIDFunc describes the behavior when the
-// HardDeleteUploadByID method of the parent MockDBStore instance is
-// invoked.
-type DBStoreHardDeleteUploadByIDFunc struct {
-	defaultHook func(context.Context, ...int) error
-	hooks       []func(context.Context, ...int) error
-	history     []DBStoreHardDeleteUploadByIDFuncCall
+// DBStoreGetRepositoriesWithCompletedUploadsFunc describes the behavior
+// when the GetRepositoriesWithCompletedUploads method of the parent
+// MockDBStore instance is invoked.
+type DBStoreGetRepositoriesWithCompletedUploadsFunc struct {
+	defaultHook func(context.Context) ([]int, error)
+	hooks       []func(context.Context) ([]int, error)
+	history     []DBStoreGetRepositoriesWithCompletedUploadsFuncCall
 	mutex       sync.Mutex
 }
 
-// HardDeleteUploadByID delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockDBStore) HardDeleteUploadByID(v0 context.Context, v1 ...int) error {
-	r0 := m.HardDeleteUploadByIDFunc.nextHook()(v0, v1...)
-	m.HardDeleteUploadByIDFunc.appendCall(DBStoreHardDeleteUploadByIDFuncCall{v0, v1, r0})
-	return r0
+// GetRepositoriesWithCompletedUploads delegates to the next hook function
+// in the queue and stores the parameter and result values of this
+// invocation.
+func (m *MockDBStore) GetRepositoriesWithCompletedUploads(v0 context.Context) ([]int, error) {
+	r0, r1 := m.GetRepositoriesWithCompletedUploadsFunc.nextHook()(v0)
+	m.GetRepositoriesWithCompletedUploadsFunc.appendCall(DBStoreGetRepositoriesWithCompletedUploadsFuncCall{v0, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the HardDeleteUploadByID
-// method of the parent MockDBStore instance is invoked and the hook queue
-// is empty.
-func (f *DBStoreHardDeleteUploadByIDFunc) SetDefaultHook(hook func(context.Context, ...int) error) {
+// SetDefaultHook sets function that is called when the
+// GetRepositoriesWithCompletedUploads method of the parent MockDBStore
+// instance is invoked and the hook queue is empty.
+func (f *DBStoreGetRepositoriesWithCompletedUploadsFunc) SetDefaultHook(hook func(context.Context) ([]int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// HardDeleteUploadByID method of the parent MockDBStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *DBStoreHardDeleteUploadByIDFunc) PushHook(hook func(context.Context, ...int) error) {
+// GetRepositoriesWithCompletedUploads method of the parent MockDBStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *DBStoreGetRepositoriesWithCompletedUploadsFunc) PushHook(hook func(context.Context) ([]int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1084,21 +1298,21 @@ func (f *DBStoreHardDeleteUploadByIDFunc) PushHook(hook func(context.Context, ..
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *DBStoreHardDeleteUploadByIDFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, ...int) error {
-		return r0
+func (f *DBStoreGetRepositoriesWithCompletedUploadsFunc) SetDefaultReturn(r0 []int, r1 error) {
+	f.SetDefaultHook(func(context.Context) ([]int, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *DBStoreHardDeleteUploadByIDFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, ...int) error {
-		return r0
+func (f *DBStoreGetRepositoriesWithCompletedUploadsFunc) PushReturn(r0 []int, r1 error) {
+	f.PushHook(func(context.Context) ([]int, error) {
+		return r0, r1
 	})
 }
 
-func (f *DBStoreHardDeleteUploadByIDFunc) nextHook() func(context.Context, ...int) error {
+func (f *DBStoreGetRepositoriesWithCompletedUploadsFunc) nextHook() func(context.Context) ([]int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1111,16 +1325,442 @@ func (f *DBStoreHardDeleteUploadByIDFunc) nextHook() func(context.Context, ...in
 	return hook
 }
 
-func (f *DBStoreHardDeleteUploadByIDFunc) appendCall(r0 DBStoreHardDeleteUploadByIDFuncCall) {
+func (f *DBStoreGetRepositoriesWithCompletedUploadsFunc) appendCall(r0 DBStoreGetRepositoriesWithCompletedUploadsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of DBStoreHardDeleteUploadByIDFuncCall objects
-// describing the invocations of this function.
-func (f *DBStoreHardDeleteUploadByIDFunc) History() []DBStoreHardDeleteUploadByIDFuncCall {
-	f.mutex.Lock()
+// History returns a sequence of
+// DBStoreGetRepositoriesWithCompletedUploadsFuncCall objects describing the
+// invocations of this function.
+func (f *DBStoreGetRepositoriesWithCompletedUploadsFunc) History() []DBStoreGetRepositoriesWithCompletedUploadsFuncCall {
+	f.mutex.Lock()
+	history := make([]DBStoreGetRepositoriesWithCompletedUploadsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBStoreGetRepositoriesWithCompletedUploadsFuncCall is an object that
+// describes an invocation of method GetRepositoriesWithCompletedUploads on
+// an instance of MockDBStore.
+type DBStoreGetRepositoriesWithCompletedUploadsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBStoreGetRepositoriesWithCompletedUploadsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBStoreGetRepositoriesWithCompletedUploadsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// DBStoreGetRetentionPoliciesFunc describes the behavior when the
+// GetRetentionPolicies method of the parent MockDBStore instance is
+// invoked.
+type DBStoreGetRetentionPoliciesFunc struct {
+	defaultHook func(context.Context) ([]dbstore.RetentionPolicy, error)
+	hooks       []func(context.Context) ([]dbstore.RetentionPolicy, error)
+	history     []DBStoreGetRetentionPoliciesFuncCall
+	mutex       sync.Mutex
+}
+
+// GetRetentionPolicies delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockDBStore) GetRetentionPolicies(v0 context.Context) ([]dbstore.RetentionPolicy, error) {
+	r0, r1 := m.GetRetentionPoliciesFunc.nextHook()(v0)
+	m.GetRetentionPoliciesFunc.appendCall(DBStoreGetRetentionPoliciesFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetRetentionPolicies
+// method of the parent MockDBStore instance is invoked and the hook queue
+// is empty.
+func (f *DBStoreGetRetentionPoliciesFunc) SetDefaultHook(hook func(context.Context) ([]dbstore.RetentionPolicy, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetRetentionPolicies method of the parent MockDBStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *DBStoreGetRetentionPoliciesFunc) PushHook(hook func(context.Context) ([]dbstore.RetentionPolicy, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *DBStoreGetRetentionPoliciesFunc) SetDefaultReturn(r0 []dbstore.RetentionPolicy, r1 error) {
+	f.SetDefaultHook(func(context.Context) ([]dbstore.RetentionPolicy, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *DBStoreGetRetentionPoliciesFunc) PushReturn(r0 []dbstore.RetentionPolicy, r1 error) {
+	f.PushHook(func(context.Context) ([]dbstore.RetentionPolicy, error) {
+		return r0, r1
+	})
+}
+
+func (f *DBStoreGetRetentionPoliciesFunc) nextHook() func(context.Context) ([]dbstore.RetentionPolicy, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBStoreGetRetentionPoliciesFunc) appendCall(r0 DBStoreGetRetentionPoliciesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBStoreGetRetentionPoliciesFuncCall objects
+// describing the invocations of this function.
+func (f *DBStoreGetRetentionPoliciesFunc) History() []DBStoreGetRetentionPoliciesFuncCall {
+	f.mutex.Lock()
+	history := make([]DBStoreGetRetentionPoliciesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBStoreGetRetentionPoliciesFuncCall is an object that describes an
+// invocation of method GetRetentionPolicies on an instance of MockDBStore.
+type DBStoreGetRetentionPoliciesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []dbstore.RetentionPolicy
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBStoreGetRetentionPoliciesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBStoreGetRetentionPoliciesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// DBStoreGetUploadsFunc describes the behavior when the GetUploads method
+// of the parent MockDBStore instance is invoked.
+type DBStoreGetUploadsFunc struct {
+	defaultHook func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error)
+	hooks       []func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error)
+	history     []DBStoreGetUploadsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetUploads delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockDBStore) GetUploads(v0 context.Context, v1 dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error) {
+	r0, r1, r2 := m.GetUploadsFunc.nextHook()(v0, v1)
+	m.GetUploadsFunc.appendCall(DBStoreGetUploadsFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the GetUploads method of
+// the parent MockDBStore instance is invoked and the hook queue is empty.
+func (f *DBStoreGetUploadsFunc) SetDefaultHook(hook func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetUploads method of the parent MockDBStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *DBStoreGetUploadsFunc) PushHook(hook func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *DBStoreGetUploadsFunc) SetDefaultReturn(r0 []dbstore.Upload, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *DBStoreGetUploadsFunc) PushReturn(r0 []dbstore.Upload, r1 int, r2 error) {
+	f.PushHook(func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *DBStoreGetUploadsFunc) nextHook() func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBStoreGetUploadsFunc) appendCall(r0 DBStoreGetUploadsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBStoreGetUploadsFuncCall objects
+// describing the invocations of this function.
+func (f *DBStoreGetUploadsFunc) History() []DBStoreGetUploadsFuncCall {
+	f.mutex.Lock()
+	history := make([]DBStoreGetUploadsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBStoreGetUploadsFuncCall is an object that describes an invocation of
+// method GetUploads on an instance of MockDBStore.
+type DBStoreGetUploadsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 dbstore.GetUploadsOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []dbstore.Upload
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBStoreGetUploadsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBStoreGetUploadsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// DBStoreHandleFunc describes the behavior when the Handle method of the
+// parent MockDBStore instance is invoked.
+type DBStoreHandleFunc struct {
+	defaultHook func() *basestore.TransactableHandle
+	hooks       []func() *basestore.TransactableHandle
+	history     []DBStoreHandleFuncCall
+	mutex       sync.Mutex
+}
+
+// Handle delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockDBStore) Handle() *basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(DBStoreHandleFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Handle method of the
+// parent MockDBStore instance is invoked and the hook queue is empty.
+func (f *DBStoreHandleFunc) SetDefaultHook(hook func() *basestore.TransactableHandle) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Handle method of the parent MockDBStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *DBStoreHandleFunc) PushHook(hook func() *basestore.TransactableHandle) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *DBStoreHandleFunc) SetDefaultReturn(r0 *basestore.TransactableHandle) {
+	f.SetDefaultHook(func() *basestore.TransactableHandle {
+		return r0
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *DBStoreHandleFunc) PushReturn(r0 *basestore.TransactableHandle) {
+	f.PushHook(func() *basestore.TransactableHandle {
+		return r0
+	})
+}
+
+func (f *DBStoreHandleFunc) nextHook() func() *basestore.TransactableHandle {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBStoreHandleFunc) appendCall(r0 DBStoreHandleFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBStoreHandleFuncCall objects describing
+// the invocations of this function.
+func (f *DBStoreHandleFunc) History() []DBStoreHandleFuncCall {
+	f.mutex.Lock()
+	history := make([]DBStoreHandleFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBStoreHandleFuncCall is an object that describes an invocation of method
+// Handle on an instance of MockDBStore.
+type DBStoreHandleFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *basestore.TransactableHandle
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBStoreHandleFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBStoreHandleFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// DBStoreHardDeleteUploadByIDFunc describes the behavior when the
+// HardDeleteUploadByID method of the parent MockDBStore instance is
+// invoked.
+type DBStoreHardDeleteUploadByIDFunc struct {
+	defaultHook func(context.Context, ...int) error
+	hooks       []func(context.Context, ...int) error
+	history     []DBStoreHardDeleteUploadByIDFuncCall
+	mutex       sync.Mutex
+}
+
+// HardDeleteUploadByID delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockDBStore) HardDeleteUploadByID(v0 context.Context, v1 ...int) error {
+	r0 := m.HardDeleteUploadByIDFunc.nextHook()(v0, v1...)
+	m.HardDeleteUploadByIDFunc.appendCall(DBStoreHardDeleteUploadByIDFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the HardDeleteUploadByID
+// method of the parent MockDBStore instance is invoked and the hook queue
+// is empty.
+func (f *DBStoreHardDeleteUploadByIDFunc) SetDefaultHook(hook func(context.Context, ...int) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// HardDeleteUploadByID method of the parent MockDBStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *DBStoreHardDeleteUploadByIDFunc) PushHook(hook func(context.Context, ...int) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *DBStoreHardDeleteUploadByIDFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, ...int) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *DBStoreHardDeleteUploadByIDFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, ...int) error {
+		return r0
+	})
+}
+
+func (f *DBStoreHardDeleteUploadByIDFunc) nextHook() func(context.Context, ...int) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBStoreHardDeleteUploadByIDFunc) appendCall(r0 DBStoreHardDeleteUploadByIDFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBStoreHardDeleteUploadByIDFuncCall objects
+// describing the invocations of this function.
+func (f *DBStoreHardDeleteUploadByIDFunc) History() []DBStoreHardDeleteUploadByIDFuncCall {
+	f.mutex.Lock()
 	history := make([]DBStoreHardDeleteUploadByIDFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
@@ -1128,70 +1768,634 @@ func (f *DBStoreHardDeleteUploadByIDFunc) History() []DBStoreHardDeleteUploadByI
 	return history
 }
 
-// DBStoreHardDeleteUploadByIDFuncCall is an object that describes an
-// invocation of method HardDeleteUploadByID on an instance of MockDBStore.
-type DBStoreHardDeleteUploadByIDFuncCall struct {
+// DBStoreHardDeleteUploadByIDFuncCall is an object that describes an
+// invocation of method HardDeleteUploadByID on an instance of MockDBStore.
+type DBStoreHardDeleteUploadByIDFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg1 []int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c DBStoreHardDeleteUploadByIDFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg1 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBStoreHardDeleteUploadByIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// DBStoreRefreshCommitResolvabilityFunc describes the behavior when the
+// RefreshCommitResolvability method of the parent MockDBStore instance is
+// invoked.
+type DBStoreRefreshCommitResolvabilityFunc struct {
+	defaultHook func(context.Context, int, string, bool, time.Time) (int, int, error)
+	hooks       []func(context.Context, int, string, bool, time.Time) (int, int, error)
+	history     []DBStoreRefreshCommitResolvabilityFuncCall
+	mutex       sync.Mutex
+}
+
+// RefreshCommitResolvability delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockDBStore) RefreshCommitResolvability(v0 context.Context, v1 int, v2 string, v3 bool, v4 time.Time) (int, int, error) {
+	r0, r1, r2 := m.RefreshCommitResolvabilityFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.RefreshCommitResolvabilityFunc.appendCall(DBStoreRefreshCommitResolvabilityFuncCall{v0, v1, v2, v3, v4, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the
+// RefreshCommitResolvability method of the parent MockDBStore instance is
+// invoked and the hook queue is empty.
+func (f *DBStoreRefreshCommitResolvabilityFunc) SetDefaultHook(hook func(context.Context, int, string, bool, time.Time) (int, int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RefreshCommitResolvability method of the parent MockDBStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *DBStoreRefreshCommitResolvabilityFunc) PushHook(hook func(context.Context, int, string, bool, time.Time) (int, int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *DBStoreRefreshCommitResolvabilityFunc) SetDefaultReturn(r0 int, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, int, string, bool, time.Time) (int, int, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *DBStoreRefreshCommitResolvabilityFunc) PushReturn(r0 int, r1 int, r2 error) {
+	f.PushHook(func(context.Context, int, string, bool, time.Time) (int, int, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *DBStoreRefreshCommitResolvabilityFunc) nextHook() func(context.Context, int, string, bool, time.Time) (int, int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBStoreRefreshCommitResolvabilityFunc) appendCall(r0 DBStoreRefreshCommitResolvabilityFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBStoreRefreshCommitResolvabilityFuncCall
+// objects describing the invocations of this function.
+func (f *DBStoreRefreshCommitResolvabilityFunc) History() []DBStoreRefreshCommitResolvabilityFuncCall {
+	f.mutex.Lock()
+	history := make([]DBStoreRefreshCommitResolvabilityFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBStoreRefreshCommitResolvabilityFuncCall is an object that describes an
+// invocation of method RefreshCommitResolvability on an instance of
+// MockDBStore.
+type DBStoreRefreshCommitResolvabilityFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 bool
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 time.Time
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBStoreRefreshCommitResolvabilityFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBStoreRefreshCommitResolvabilityFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// DBStoreRepoNameFunc describes the behavior when the RepoName method of
+// the parent MockDBStore instance is invoked.
+type DBStoreRepoNameFunc struct {
+	defaultHook func(context.Context, int) (string, error)
+	hooks       []func(context.Context, int) (string, error)
+	history     []DBStoreRepoNameFuncCall
+	mutex       sync.Mutex
+}
+
+// RepoName delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockDBStore) RepoName(v0 context.Context, v1 int) (string, error) {
+	r0, r1 := m.RepoNameFunc.nextHook()(v0, v1)
+	m.RepoNameFunc.appendCall(DBStoreRepoNameFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the RepoName method of
+// the parent MockDBStore instance is invoked and the hook queue is empty.
+func (f *DBStoreRepoNameFunc) SetDefaultHook(hook func(context.Context, int) (string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RepoName method of the parent MockDBStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *DBStoreRepoNameFunc) PushHook(hook func(context.Context, int) (string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *DBStoreRepoNameFunc) SetDefaultReturn(r0 string, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) (string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *DBStoreRepoNameFunc) PushReturn(r0 string, r1 error) {
+	f.PushHook(func(context.Context, int) (string, error) {
+		return r0, r1
+	})
+}
+
+func (f *DBStoreRepoNameFunc) nextHook() func(context.Context, int) (string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBStoreRepoNameFunc) appendCall(r0 DBStoreRepoNameFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBStoreRepoNameFuncCall objects describing
+// the invocations of this function.
+func (f *DBStoreRepoNameFunc) History() []DBStoreRepoNameFuncCall {
+	f.mutex.Lock()
+	history := make([]DBStoreRepoNameFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBStoreRepoNameFuncCall is an object that describes an invocation of
+// method RepoName on an instance of MockDBStore.
+type DBStoreRepoNameFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBStoreRepoNameFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBStoreRepoNameFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// DBStoreSoftDeleteOldUploadsFunc describes the behavior when the
+// SoftDeleteOldUploads method of the parent MockDBStore instance is
+// invoked.
+type DBStoreSoftDeleteOldUploadsFunc struct {
+	defaultHook func(context.Context, time.Duration, time.Time) (int, error)
+	hooks       []func(context.Context, time.Duration, time.Time) (int, error)
+	history     []DBStoreSoftDeleteOldUploadsFuncCall
+	mutex       sync.Mutex
+}
+
+// SoftDeleteOldUploads delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockDBStore) SoftDeleteOldUploads(v0 context.Context, v1 time.Duration, v2 time.Time) (int, error) {
+	r0, r1 := m.SoftDeleteOldUploadsFunc.nextHook()(v0, v1, v2)
+	m.SoftDeleteOldUploadsFunc.appendCall(DBStoreSoftDeleteOldUploadsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the SoftDeleteOldUploads
+// method of the parent MockDBStore instance is invoked and the hook queue
+// is empty.
+func (f *DBStoreSoftDeleteOldUploadsFunc) SetDefaultHook(hook func(context.Context, time.Duration, time.Time) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SoftDeleteOldUploads method of the parent MockDBStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *DBStoreSoftDeleteOldUploadsFunc) PushHook(hook func(context.Context, time.Duration, time.Time) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *DBStoreSoftDeleteOldUploadsFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, time.Duration, time.Time) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *DBStoreSoftDeleteOldUploadsFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, time.Duration, time.Time) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *DBStoreSoftDeleteOldUploadsFunc) nextHook() func(context.Context, time.Duration, time.Time) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBStoreSoftDeleteOldUploadsFunc) appendCall(r0 DBStoreSoftDeleteOldUploadsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBStoreSoftDeleteOldUploadsFuncCall objects
+// describing the invocations of this function.
+func (f *DBStoreSoftDeleteOldUploadsFunc) History() []DBStoreSoftDeleteOldUploadsFuncCall {
+	f.mutex.Lock()
+	history := make([]DBStoreSoftDeleteOldUploadsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBStoreSoftDeleteOldUploadsFuncCall is an object that describes an
+// invocation of method SoftDeleteOldUploads on an instance of MockDBStore.
+type DBStoreSoftDeleteOldUploadsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 time.Duration
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 time.Time
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBStoreSoftDeleteOldUploadsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBStoreSoftDeleteOldUploadsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// DBStoreStaleSourcedCommitsFunc describes the behavior when the
+// StaleSourcedCommits method of the parent MockDBStore instance is invoked.
+type DBStoreStaleSourcedCommitsFunc struct {
+	defaultHook func(context.Context, time.Duration, int, time.Time) ([]dbstore.SourcedCommits, error)
+	hooks       []func(context.Context, time.Duration, int, time.Time) ([]dbstore.SourcedCommits, error)
+	history     []DBStoreStaleSourcedCommitsFuncCall
+	mutex       sync.Mutex
+}
+
+// StaleSourcedCommits delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockDBStore) StaleSourcedCommits(v0 context.Context, v1 time.Duration, v2 int, v3 time.Time) ([]dbstore.SourcedCommits, error) {
+	r0, r1 := m.StaleSourcedCommitsFunc.nextHook()(v0, v1, v2, v3)
+	m.StaleSourcedCommitsFunc.appendCall(DBStoreStaleSourcedCommitsFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the StaleSourcedCommits
+// method of the parent MockDBStore instance is invoked and the hook queue
+// is empty.
+func (f *DBStoreStaleSourcedCommitsFunc) SetDefaultHook(hook func(context.Context, time.Duration, int, time.Time) ([]dbstore.SourcedCommits, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// StaleSourcedCommits method of the parent MockDBStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *DBStoreStaleSourcedCommitsFunc) PushHook(hook func(context.Context, time.Duration, int, time.Time) ([]dbstore.SourcedCommits, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *DBStoreStaleSourcedCommitsFunc) SetDefaultReturn(r0 []dbstore.SourcedCommits, r1 error) {
+	f.SetDefaultHook(func(context.Context, time.Duration, int, time.Time) ([]dbstore.SourcedCommits, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *DBStoreStaleSourcedCommitsFunc) PushReturn(r0 []dbstore.SourcedCommits, r1 error) {
+	f.PushHook(func(context.Context, time.Duration, int, time.Time) ([]dbstore.SourcedCommits, error) {
+		return r0, r1
+	})
+}
+
+func (f *DBStoreStaleSourcedCommitsFunc) nextHook() func(context.Context, time.Duration, int, time.Time) ([]dbstore.SourcedCommits, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBStoreStaleSourcedCommitsFunc) appendCall(r0 DBStoreStaleSourcedCommitsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBStoreStaleSourcedCommitsFuncCall objects
+// describing the invocations of this function.
+func (f *DBStoreStaleSourcedCommitsFunc) History() []DBStoreStaleSourcedCommitsFuncCall {
+	f.mutex.Lock()
+	history := make([]DBStoreStaleSourcedCommitsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBStoreStaleSourcedCommitsFuncCall is an object that describes an
+// invocation of method StaleSourcedCommits on an instance of MockDBStore.
+type DBStoreStaleSourcedCommitsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is a slice containing the values of the variadic arguments
-	// passed to this method invocation.
-	Arg1 []int
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 time.Duration
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 time.Time
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 []dbstore.SourcedCommits
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation. The variadic slice argument is flattened in this array such
-// that one positional argument and three variadic arguments would result in
-// a slice of four, not two.
-func (c DBStoreHardDeleteUploadByIDFuncCall) Args() []interface{} {
-	trailing := []interface{}{}
-	for _, val := range c.Arg1 {
-		trailing = append(trailing, val)
+// invocation.
+func (c DBStoreStaleSourcedCommitsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBStoreStaleSourcedCommitsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// DBStoreTransactFunc describes the behavior when the Transact method of
+// the parent MockDBStore instance is invoked.
+type DBStoreTransactFunc struct {
+	defaultHook func(context.Context) (DBStore, error)
+	hooks       []func(context.Context) (DBStore, error)
+	history     []DBStoreTransactFuncCall
+	mutex       sync.Mutex
+}
+
+// Transact delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockDBStore) Transact(v0 context.Context) (DBStore, error) {
+	r0, r1 := m.TransactFunc.nextHook()(v0)
+	m.TransactFunc.appendCall(DBStoreTransactFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Transact method of
+// the parent MockDBStore instance is invoked and the hook queue is empty.
+func (f *DBStoreTransactFunc) SetDefaultHook(hook func(context.Context) (DBStore, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Transact method of the parent MockDBStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *DBStoreTransactFunc) PushHook(hook func(context.Context) (DBStore, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *DBStoreTransactFunc) SetDefaultReturn(r0 DBStore, r1 error) {
+	f.SetDefaultHook(func(context.Context) (DBStore, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *DBStoreTransactFunc) PushReturn(r0 DBStore, r1 error) {
+	f.PushHook(func(context.Context) (DBStore, error) {
+		return r0, r1
+	})
+}
+
+func (f *DBStoreTransactFunc) nextHook() func(context.Context) (DBStore, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
 	}
 
-	return append([]interface{}{c.Arg0}, trailing...)
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBStoreTransactFunc) appendCall(r0 DBStoreTransactFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBStoreTransactFuncCall objects describing
+// the invocations of this function.
+func (f *DBStoreTransactFunc) History() []DBStoreTransactFuncCall {
+	f.mutex.Lock()
+	history := make([]DBStoreTransactFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBStoreTransactFuncCall is an object that describes an invocation of
+// method Transact on an instance of MockDBStore.
+type DBStoreTransactFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 DBStore
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBStoreTransactFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c DBStoreHardDeleteUploadByIDFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c DBStoreTransactFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// DBStoreRefreshCommitResolvabilityFunc describes the behavior when the
-// RefreshCommitResolvability method of the parent MockDBStore instance is
+// DBStoreUpdateUploadRetentionFunc describes the behavior when the
+// UpdateUploadRetention method of the parent MockDBStore instance is
 // invoked.
-type DBStoreRefreshCommitResolvabilityFunc struct {
-	defaultHook func(context.Context, int, string, bool, time.Time) (int, int, error)
-	hooks       []func(context.Context, int, string, bool, time.Time) (int, int, error)
-	history     []DBStoreRefreshCommitResolvabilityFuncCall
+type DBStoreUpdateUploadRetentionFunc struct {
+	defaultHook func(context.Context, int, []int, []int) (int, error)
+	hooks       []func(context.Context, int, []int, []int) (int, error)
+	history     []DBStoreUpdateUploadRetentionFuncCall
 	mutex       sync.Mutex
 }
 
-// RefreshCommitResolvability delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockDBStore) RefreshCommitResolvability(v0 context.Context, v1 int, v2 string, v3 bool, v4 time.Time) (int, int, error) {
-	r0, r1, r2 := m.RefreshCommitResolvabilityFunc.nextHook()(v0, v1, v2, v3, v4)
-	m.RefreshCommitResolvabilityFunc.appendCall(DBStoreRefreshCommitResolvabilityFuncCall{v0, v1, v2, v3, v4, r0, r1, r2})
-	return r0, r1, r2
+// UpdateUploadRetention delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockDBStore) UpdateUploadRetention(v0 context.Context, v1 int, v2 []int, v3 []int) (int, error) {
+	r0, r1 := m.UpdateUploadRetentionFunc.nextHook()(v0, v1, v2, v3)
+	m.UpdateUploadRetentionFunc.appendCall(DBStoreUpdateUploadRetentionFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the
-// RefreshCommitResolvability method of the parent MockDBStore instance is
+// UpdateUploadRetention method of the parent MockDBStore instance is
 // invoked and the hook queue is empty.
-func (f *DBStoreRefreshCommitResolvabilityFunc) SetDefaultHook(hook func(context.Context, int, string, bool, time.Time) (int, int, error)) {
+func (f *DBStoreUpdateUploadRetentionFunc) SetDefaultHook(hook func(context.Context, int, []int, []int) (int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// RefreshCommitResolvability method of the parent MockDBStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *DBStoreRefreshCommitResolvabilityFunc) PushHook(hook func(context.Context, int, string, bool, time.Time) (int, int, error)) {
+// UpdateUploadRetention method of the parent MockDBStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *DBStoreUpdateUploadRetentionFunc) PushHook(hook func(context.Context, int, []int, []int) (int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1199,21 +2403,21 @@ func (f *DBStoreRefreshCommitResolvabilityFunc) PushHook(hook func(context.Conte
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *DBStoreRefreshCommitResolvabilityFunc) SetDefaultReturn(r0 int, r1 int, r2 error) {
-	f.SetDefaultHook(func(context.Context, int, string, bool, time.Time) (int, int, error) {
-		return r0, r1, r2
+func (f *DBStoreUpdateUploadRetentionFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, []int, []int) (int, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *DBStoreRefreshCommitResolvabilityFunc) PushReturn(r0 int, r1 int, r2 error) {
-	f.PushHook(func(context.Context, int, string, bool, time.Time) (int, int, error) {
-		return r0, r1, r2
+func (f *DBStoreUpdateUploadRetentionFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, int, []int, []int) (int, error) {
+		return r0, r1
 	})
 }
 
-func (f *DBStoreRefreshCommitResolvabilityFunc) nextHook() func(context.Context, int, string, bool, time.Time) (int, int, error) {
+func (f *DBStoreUpdateUploadRetentionFunc) nextHook() func(context.Context, int, []int, []int) (int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1226,27 +2430,26 @@ func (f *DBStoreRefreshCommitResolvabilityFunc) nextHook() func(context.Context,
 	return hook
 }
 
-func (f *DBStoreRefreshCommitResolvabilityFunc) appendCall(r0 DBStoreRefreshCommitResolvabilityFuncCall) {
+func (f *DBStoreUpdateUploadRetentionFunc) appendCall(r0 DBStoreUpdateUploadRetentionFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of DBStoreRefreshCommitResolvabilityFuncCall
+// History returns a sequence of DBStoreUpdateUploadRetentionFuncCall
 // objects describing the invocations of this function.
-func (f *DBStoreRefreshCommitResolvabilityFunc) History() []DBStoreRefreshCommitResolvabilityFuncCall {
+func (f *DBStoreUpdateUploadRetentionFunc) History() []DBStoreUpdateUploadRetentionFuncCall {
 	f.mutex.Lock()
-	history := make([]DBStoreRefreshCommitResolvabilityFuncCall, len(f.history))
+	history := make([]DBStoreUpdateUploadRetentionFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// DBStoreRefreshCommitResolvabilityFuncCall is an object that describes an
-// invocation of method RefreshCommitResolvability on an instance of
-// MockDBStore.
-type DBStoreRefreshCommitResolvabilityFuncCall struct {
+// DBStoreUpdateUploadRetentionFuncCall is an object that describes an
+// invocation of method UpdateUploadRetention on an instance of MockDBStore.
+type DBStoreUpdateUploadRetentionFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -1255,66 +2458,94 @@ type DBStoreRefreshCommitResolvabilityFuncCall struct {
 	Arg1 int
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 string
+	Arg2 []int
 	// Arg3 is the value of the 4th argument passed to this method
 	// invocation.
-	Arg3 bool
-	// Arg4 is the value of the 5th argument passed to this method
-	// invocation.
-	Arg4 time.Time
+	Arg3 []int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 int
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 int
-	// Result2 is the value of the 3rd result returned from this method
-	// invocation.
-	Result2 error
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c DBStoreRefreshCommitResolvabilityFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+func (c DBStoreUpdateUploadRetentionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c DBStoreRefreshCommitResolvabilityFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2}
+func (c DBStoreUpdateUploadRetentionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// DBStoreSoftDeleteOldUploadsFunc describes the behavior when the
-// SoftDeleteOldUploads method of the parent MockDBStore instance is
+// MockGitserverClient is a mock implementation of the GitserverClient
+// interface (from the package
+// github.com/sourcegraph/sourcegraph/enterprise/cmd/worker/internal/codeintel/janitor)
+// used for unit testing.
+type MockGitserverClient struct {
+	// RefDescriptionsFunc is an instance of a mock function object
+	// controlling the behavior of the method RefDescriptions.
+	RefDescriptionsFunc *GitserverClientRefDescriptionsFunc
+}
+
+// NewMockGitserverClient creates a new mock of the GitserverClient
+// interface. All methods return zero values for all results, unless
+// overwritten.
+func NewMockGitserverClient() *MockGitserverClient {
+	return &MockGitserverClient{
+		RefDescriptionsFunc: &GitserverClientRefDescriptionsFunc{
+			defaultHook: func(context.Context, int) (map[string][]gitserver.RefDescription, error) {
+				return nil, nil
+			},
+		},
+	}
+}
+
+// NewMockGitserverClientFrom creates a new mock of the MockGitserverClient
+// interface. All methods delegate to the given implementation, unless
+// overwritten.
+func NewMockGitserverClientFrom(i GitserverClient) *MockGitserverClient {
+	return &MockGitserverClient{
+		RefDescriptionsFunc: &GitserverClientRefDescriptionsFunc{
+			defaultHook: i.RefDescriptions,
+		},
+	}
+}
+
+// GitserverClientRefDescriptionsFunc describes the behavior when the
+// RefDescriptions method of the parent MockGitserverClient instance is
 // invoked.
-type DBStoreSoftDeleteOldUploadsFunc struct {
-	defaultHook func(context.Context, time.Duration, time.Time) (int, error)
-	hooks       []func(context.Context, time.Duration, time.Time) (int, error)
-	history     []DBStoreSoftDeleteOldUploadsFuncCall
+type GitserverClientRefDescriptionsFunc struct {
+	defaultHook func(context.Context, int) (map[string][]gitserver.RefDescription, error)
+	hooks       []func(context.Context, int) (map[string][]gitserver.RefDescription, error)
+	history     []GitserverClientRefDescriptionsFuncCall
 	mutex       sync.Mutex
 }
 
-// SoftDeleteOldUploads delegates to the next hook function in the queue and
+// RefDescriptions delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockDBStore) SoftDeleteOldUploads(v0 context.Context, v1 time.Duration, v2 time.Time) (int, error) {
-	r0, r1 := m.SoftDeleteOldUploadsFunc.nextHook()(v0, v1, v2)
-	m.SoftDeleteOldUploadsFunc.appendCall(DBStoreSoftDeleteOldUploadsFuncCall{v0, v1, v2, r0, r1})
+func (m *MockGitserverClient) RefDescriptions(v0 context.Context, v1 int) (map[string][]gitserver.RefDescription, error) {
+	r0, r1 := m.RefDescriptionsFunc.nextHook()(v0, v1)
+	m.RefDescriptionsFunc.appendCall(GitserverClientRefDescriptionsFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the SoftDeleteOldUploads
-// method of the parent MockDBStore instance is invoked and the hook queue
-// is empty.
-func (f *DBStoreSoftDeleteOldUploadsFunc) SetDefaultHook(hook func(context.Context, time.Duration, time.Time) (int, error)) {
+// SetDefaultHook sets function that is called when the RefDescriptions
+// method of the parent MockGitserverClient instance is invoked and the hook
+// queue is empty.
+func (f *GitserverClientRefDescriptionsFunc) SetDefaultHook(hook func(context.Context, int) (map[string][]gitserver.RefDescription, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// SoftDeleteOldUploads method of the parent MockDBStore instance invokes
+// RefDescriptions method of the parent MockGitserverClient instance invokes
 // the hook at the front of the queue and discards it. After the queue is
 // empty, the default hook function is invoked for any future action.
-func (f *DBStoreSoftDeleteOldUploadsFunc) PushHook(hook func(context.Context, time.Duration, time.Time) (int, error)) {
+func (f *GitserverClientRefDescriptionsFunc) PushHook(hook func(context.Context, int) (map[string][]gitserver.RefDescription, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1322,21 +2553,21 @@ func (f *DBStoreSoftDeleteOldUploadsFunc) PushHook(hook func(context.Context, ti
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *DBStoreSoftDeleteOldUploadsFunc) SetDefaultReturn(r0 int, r1 error) {
-	f.SetDefaultHook(func(context.Context, time.Duration, time.Time) (int, error) {
+func (f *GitserverClientRefDescriptionsFunc) SetDefaultReturn(r0 map[string][]gitserver.RefDescription, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) (map[string][]gitserver.RefDescription, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *DBStoreSoftDeleteOldUploadsFunc) PushReturn(r0 int, r1 error) {
-	f.PushHook(func(context.Context, time.Duration, time.Time) (int, error) {
+func (f *GitserverClientRefDescriptionsFunc) PushReturn(r0 map[string][]gitserver.RefDescription, r1 error) {
+	f.PushHook(func(context.Context, int) (map[string][]gitserver.RefDescription, error) {
 		return r0, r1
 	})
 }
 
-func (f *DBStoreSoftDeleteOldUploadsFunc) nextHook() func(context.Context, time.Duration, time.Time) (int, error) {
+func (f *GitserverClientRefDescriptionsFunc) nextHook() func(context.Context, int) (map[string][]gitserver.RefDescription, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1349,38 +2580,36 @@ func (f *DBStoreSoftDeleteOldUploadsFunc) nextHook() func(context.Context, time.
 	return hook
 }
 
-func (f *DBStoreSoftDeleteOldUploadsFunc) appendCall(r0 DBStoreSoftDeleteOldUploadsFuncCall) {
+func (f *GitserverClientRefDescriptionsFunc) appendCall(r0 GitserverClientRefDescriptionsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of DBStoreSoftDeleteOldUploadsFuncCall objects
-// describing the invocations of this function.
-func (f *DBStoreSoftDeleteOldUploadsFunc) History() []DBStoreSoftDeleteOldUploadsFuncCall {
+// History returns a sequence of GitserverClientRefDescriptionsFuncCall
+// objects describing the invocations of this function.
+func (f *GitserverClientRefDescriptionsFunc) History() []GitserverClientRefDescriptionsFuncCall {
 	f.mutex.Lock()
-	history := make([]DBStoreSoftDeleteOldUploadsFuncCall, len(f.history))
+	history := make([]GitserverClientRefDescriptionsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// DBStoreSoftDeleteOldUploadsFuncCall is an object that describes an
-// invocation of method SoftDeleteOldUploads on an instance of MockDBStore.
-type DBStoreSoftDeleteOldUploadsFuncCall struct {
+// GitserverClientRefDescriptionsFuncCall is an object that describes an
+// invocation of method RefDescriptions on an instance of
+// MockGitserverClient.
+type GitserverClientRefDescriptionsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 time.Duration
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 time.Time
+	Arg1 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 int
+	Result0 map[string][]gitserver.RefDescription
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -1388,45 +2617,98 @@ type DBStoreSoftDeleteOldUploadsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c DBStoreSoftDeleteOldUploadsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c GitserverClientRefDescriptionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c DBStoreSoftDeleteOldUploadsFuncCall) Results() []interface{} {
+func (c GitserverClientRefDescriptionsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// DBStoreStaleSourcedCommitsFunc describes the behavior when the
-// StaleSourcedCommits method of the parent MockDBStore instance is invoked.
-type DBStoreStaleSourcedCommitsFunc struct {
-	defaultHook func(context.Context, time.Duration, int, time.Time) ([]dbstore.SourcedCommits, error)
-	hooks       []func(context.Context, time.Duration, int, time.Time) ([]dbstore.SourcedCommits, error)
-	history     []DBStoreStaleSourcedCommitsFuncCall
+// MockLSIFStore is a mock implementation of the LSIFStore interface (from
+// the package
+// github.com/sourcegraph/sourcegraph/enterprise/cmd/worker/internal/codeintel/janitor)
+// used for unit testing.
+type MockLSIFStore struct {
+	// BundleIDsFunc is an instance of a mock function object controlling the
+	// behavior of the method BundleIDs.
+	BundleIDsFunc *LSIFStoreBundleIDsFunc
+	// BundleSizeFunc is an instance of a mock function object controlling
+	// the behavior of the method BundleSize.
+	BundleSizeFunc *LSIFStoreBundleSizeFunc
+	// ClearFunc is an instance of a mock function object controlling the
+	// behavior of the method Clear.
+	ClearFunc *LSIFStoreClearFunc
+}
+
+// NewMockLSIFStore creates a new mock of the LSIFStore interface. All
+// methods return zero values for all results, unless overwritten.
+func NewMockLSIFStore() *MockLSIFStore {
+	return &MockLSIFStore{
+		BundleIDsFunc: &LSIFStoreBundleIDsFunc{
+			defaultHook: func(context.Context, int, int) ([]int, error) {
+				return nil, nil
+			},
+		},
+		BundleSizeFunc: &LSIFStoreBundleSizeFunc{
+			defaultHook: func(context.Context, []int) (int, int64, error) {
+				return 0, 0, nil
+			},
+		},
+		ClearFunc: &LSIFStoreClearFunc{
+			defaultHook: func(context.Context, ...int) error {
+				return nil
+			},
+		},
+	}
+}
+
+// NewMockLSIFStoreFrom creates a new mock of the MockLSIFStore interface.
+// All methods delegate to the given implementation, unless overwritten.
+func NewMockLSIFStoreFrom(i LSIFStore) *MockLSIFStore {
+	return &MockLSIFStore{
+		BundleIDsFunc: &LSIFStoreBundleIDsFunc{
+			defaultHook: i.BundleIDs,
+		},
+		BundleSizeFunc: &LSIFStoreBundleSizeFunc{
+			defaultHook: i.BundleSize,
+		},
+		ClearFunc: &LSIFStoreClearFunc{
+			defaultHook: i.Clear,
+		},
+	}
+}
+
+// LSIFStoreBundleIDsFunc describes the behavior when the BundleIDs method
+// of the parent MockLSIFStore instance is invoked.
+type LSIFStoreBundleIDsFunc struct {
+	defaultHook func(context.Context, int, int) ([]int, error)
+	hooks       []func(context.Context, int, int) ([]int, error)
+	history     []LSIFStoreBundleIDsFuncCall
 	mutex       sync.Mutex
 }
 
-// StaleSourcedCommits delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockDBStore) StaleSourcedCommits(v0 context.Context, v1 time.Duration, v2 int, v3 time.Time) ([]dbstore.SourcedCommits, error) {
-	r0, r1 := m.StaleSourcedCommitsFunc.nextHook()(v0, v1, v2, v3)
-	m.StaleSourcedCommitsFunc.appendCall(DBStoreStaleSourcedCommitsFuncCall{v0, v1, v2, v3, r0, r1})
+// BundleIDs delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockLSIFStore) BundleIDs(v0 context.Context, v1 int, v2 int) ([]int, error) {
+	r0, r1 := m.BundleIDsFunc.nextHook()(v0, v1, v2)
+	m.BundleIDsFunc.appendCall(LSIFStoreBundleIDsFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the StaleSourcedCommits
-// method of the parent MockDBStore instance is invoked and the hook queue
-// is empty.
-func (f *DBStoreStaleSourcedCommitsFunc) SetDefaultHook(hook func(context.Context, time.Duration, int, time.Time) ([]dbstore.SourcedCommits, error)) {
+// SetDefaultHook sets function that is called when the BundleIDs method of
+// the parent MockLSIFStore instance is invoked and the hook queue is empty.
+func (f *LSIFStoreBundleIDsFunc) SetDefaultHook(hook func(context.Context, int, int) ([]int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// StaleSourcedCommits method of the parent MockDBStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *DBStoreStaleSourcedCommitsFunc) PushHook(hook func(context.Context, time.Duration, int, time.Time) ([]dbstore.SourcedCommits, error)) {
+// BundleIDs method of the parent MockLSIFStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *LSIFStoreBundleIDsFunc) PushHook(hook func(context.Context, int, int) ([]int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1434,21 +2716,21 @@ func (f *DBStoreStaleSourcedCommitsFunc) PushHook(hook func(context.Context, tim
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *DBStoreStaleSourcedCommitsFunc) SetDefaultReturn(r0 []dbstore.SourcedCommits, r1 error) {
-	f.SetDefaultHook(func(context.Context, time.Duration, int, time.Time) ([]dbstore.SourcedCommits, error) {
+func (f *LSIFStoreBundleIDsFunc) SetDefaultReturn(r0 []int, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, int) ([]int, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *DBStoreStaleSourcedCommitsFunc) PushReturn(r0 []dbstore.SourcedCommits, r1 error) {
-	f.PushHook(func(context.Context, time.Duration, int, time.Time) ([]dbstore.SourcedCommits, error) {
+func (f *LSIFStoreBundleIDsFunc) PushReturn(r0 []int, r1 error) {
+	f.PushHook(func(context.Context, int, int) ([]int, error) {
 		return r0, r1
 	})
 }
 
-func (f *DBStoreStaleSourcedCommitsFunc) nextHook() func(context.Context, time.Duration, int, time.Time) ([]dbstore.SourcedCommits, error) {
+func (f *LSIFStoreBundleIDsFunc) nextHook() func(context.Context, int, int) ([]int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1461,41 +2743,38 @@ func (f *DBStoreStaleSourcedCommitsFunc) nextHook() func(context.Context, time.D
 	return hook
 }
 
-func (f *DBStoreStaleSourcedCommitsFunc) appendCall(r0 DBStoreStaleSourcedCommitsFuncCall) {
+func (f *LSIFStoreBundleIDsFunc) appendCall(r0 LSIFStoreBundleIDsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of DBStoreStaleSourcedCommitsFuncCall objects
+// History returns a sequence of LSIFStoreBundleIDsFuncCall objects
 // describing the invocations of this function.
-func (f *DBStoreStaleSourcedCommitsFunc) History() []DBStoreStaleSourcedCommitsFuncCall {
+func (f *LSIFStoreBundleIDsFunc) History() []LSIFStoreBundleIDsFuncCall {
 	f.mutex.Lock()
-	history := make([]DBStoreStaleSourcedCommitsFuncCall, len(f.history))
+	history := make([]LSIFStoreBundleIDsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// DBStoreStaleSourcedCommitsFuncCall is an object that describes an
-// invocation of method StaleSourcedCommits on an instance of MockDBStore.
-type DBStoreStaleSourcedCommitsFuncCall struct {
+// LSIFStoreBundleIDsFuncCall is an object that describes an invocation of
+// method BundleIDs on an instance of MockLSIFStore.
+type LSIFStoreBundleIDsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 time.Duration
+	Arg1 int
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
 	Arg2 int
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 time.Time
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []dbstore.SourcedCommits
+	Result0 []int
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -1503,44 +2782,44 @@ type DBStoreStaleSourcedCommitsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c DBStoreStaleSourcedCommitsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c LSIFStoreBundleIDsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c DBStoreStaleSourcedCommitsFuncCall) Results() []interface{} {
+func (c LSIFStoreBundleIDsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// DBStoreTransactFunc describes the behavior when the Transact method of
-// the parent MockDBStore instance is invoked.
-type DBStoreTransactFunc struct {
-	defaultHook func(context.Context) (DBStore, error)
-	hooks       []func(context.Context) (DBStore, error)
-	history     []DBStoreTransactFuncCall
+// LSIFStoreBundleSizeFunc describes the behavior when the BundleSize method
+// of the parent MockLSIFStore instance is invoked.
+type LSIFStoreBundleSizeFunc struct {
+	defaultHook func(context.Context, []int) (int, int64, error)
+	hooks       []func(context.Context, []int) (int, int64, error)
+	history     []LSIFStoreBundleSizeFuncCall
 	mutex       sync.Mutex
 }
 
-// Transact delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockDBStore) Transact(v0 context.Context) (DBStore, error) {
-	r0, r1 := m.TransactFunc.nextHook()(v0)
-	m.TransactFunc.appendCall(DBStoreTransactFuncCall{v0, r0, r1})
-	return r0, r1
+// BundleSize delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockLSIFStore) BundleSize(v0 context.Context, v1 []int) (int, int64, error) {
+	r0, r1, r2 := m.BundleSizeFunc.nextHook()(v0, v1)
+	m.BundleSizeFunc.appendCall(LSIFStoreBundleSizeFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
 }
 
-// SetDefaultHook sets function that is called when the Transact method of
-// the parent MockDBStore instance is invoked and the hook queue is empty.
-func (f *DBStoreTransactFunc) SetDefaultHook(hook func(context.Context) (DBStore, error)) {
+// SetDefaultHook sets function that is called when the BundleSize method of
+// the parent MockLSIFStore instance is invoked and the hook queue is empty.
+func (f *LSIFStoreBundleSizeFunc) SetDefaultHook(hook func(context.Context, []int) (int, int64, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Transact method of the parent MockDBStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
+// BundleSize method of the parent MockLSIFStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *DBStoreTransactFunc) PushHook(hook func(context.Context) (DBStore, error)) {
+func (f *LSIFStoreBundleSizeFunc) PushHook(hook func(context.Context, []int) (int, int64, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1548,21 +2827,21 @@ func (f *DBStoreTransactFunc) PushHook(hook func(context.Context) (DBStore, erro
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *DBStoreTransactFunc) SetDefaultReturn(r0 DBStore, r1 error) {
-	f.SetDefaultHook(func(context.Context) (DBStore, error) {
-		return r0, r1
+func (f *LSIFStoreBundleSizeFunc) SetDefaultReturn(r0 int, r1 int64, r2 error) {
+	f.SetDefaultHook(func(context.Context, []int) (int, int64, error) {
+		return r0, r1, r2
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *DBStoreTransactFunc) PushReturn(r0 DBStore, r1 error) {
-	f.PushHook(func(context.Context) (DBStore, error) {
-		return r0, r1
+func (f *LSIFStoreBundleSizeFunc) PushReturn(r0 int, r1 int64, r2 error) {
+	f.PushHook(func(context.Context, []int) (int, int64, error) {
+		return r0, r1, r2
 	})
 }
 
-func (f *DBStoreTransactFunc) nextHook() func(context.Context) (DBStore, error) {
+func (f *LSIFStoreBundleSizeFunc) nextHook() func(context.Context, []int) (int, int64, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1575,79 +2854,53 @@ func (f *DBStoreTransactFunc) nextHook() func(context.Context) (DBStore, error)
 	return hook
 }
 
-func (f *DBStoreTransactFunc) appendCall(r0 DBStoreTransactFuncCall) {
+func (f *LSIFStoreBundleSizeFunc) appendCall(r0 LSIFStoreBundleSizeFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of DBStoreTransactFuncCall objects describing
-// the invocations of this function.
-func (f *DBStoreTransactFunc) History() []DBStoreTransactFuncCall {
+// History returns a sequence of LSIFStoreBundleSizeFuncCall objects
+// describing the invocations of this function.
+func (f *LSIFStoreBundleSizeFunc) History() []LSIFStoreBundleSizeFuncCall {
 	f.mutex.Lock()
-	history := make([]DBStoreTransactFuncCall, len(f.history))
+	history := make([]LSIFStoreBundleSizeFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// DBStoreTransactFuncCall is an object that describes an invocation of
-// method Transact on an instance of MockDBStore.
-type DBStoreTransactFuncCall struct {
+// LSIFStoreBundleSizeFuncCall is an object that describes an invocation of
+// method BundleSize on an instance of MockLSIFStore.
+type LSIFStoreBundleSizeFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 []int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 DBStore
+	Result0 int
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 error
+	Result1 int64
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c DBStoreTransactFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c LSIFStoreBundleSizeFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c DBStoreTransactFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
-}
-
-// MockLSIFStore is a mock implementation of the LSIFStore interface (from
-// the package
-// github.com/sourcegraph/sourcegraph/enterprise/cmd/worker/internal/codeintel/janitor)
-// used for unit testing.
-type MockLSIFStore struct {
-	// ClearFunc is an instance of a mock function object controlling the
-	// behavior of the method Clear.
-	ClearFunc *LSIFStoreClearFunc
-}
-
-// NewMockLSIFStore creates a new mock of the LSIFStore interface. All
-// methods return zero values for all results, unless overwritten.
-func NewMockLSIFStore() *MockLSIFStore {
-	return &MockLSIFStore{
-		ClearFunc: &LSIFStoreClearFunc{
-			defaultHook: func(context.Context, ...int) error {
-				return nil
-			},
-		},
-	}
-}
-
-// NewMockLSIFStoreFrom creates a new mock of the MockLSIFStore interface.
-// All methods delegate to the given implementation, unless overwritten.
-func NewMockLSIFStoreFrom(i LSIFStore) *MockLSIFStore {
-	return &MockLSIFStore{
-		ClearFunc: &LSIFStoreClearFunc{
-			defaultHook: i.Clear,
-		},
-	}
+func (c LSIFStoreBundleSizeFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
 // LSIFStoreClearFunc describes the behavior when the Clear method of the
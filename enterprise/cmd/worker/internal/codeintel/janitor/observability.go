@@ -14,6 +14,10 @@ type metrics struct {
 	numUploadResetFailures  prometheus.Counter
 	numIndexResets          prometheus.Counter
 	numIndexResetFailures   prometheus.Counter
+	numOrphanedUploads      prometheus.Counter
+	numOrphanedRowsRemoved  prometheus.Counter
+	numOrphanedBytes        prometheus.Counter
+	numStuckUploadsFailed   prometheus.Counter
 	numErrors               prometheus.Counter
 }
 
@@ -58,6 +62,22 @@ func newMetrics(observationContext *observation.Context) *metrics {
 		"src_codeintel_background_index_reset_failures_total",
 		"The number of index reset failures.",
 	)
+	numOrphanedUploads := counter(
+		"src_codeintel_background_orphaned_uploads_purged_total",
+		"The number of uploads without a live upload record whose data was removed from the codeintel database.",
+	)
+	numOrphanedRowsRemoved := counter(
+		"src_codeintel_background_orphaned_rows_removed_total",
+		"The number of rows of orphaned upload data removed from the codeintel database.",
+	)
+	numOrphanedBytes := counter(
+		"src_codeintel_background_orphaned_bytes_reclaimed_total",
+		"The approximate number of bytes of orphaned upload data removed from the codeintel database.",
+	)
+	numStuckUploadsFailed := counter(
+		"src_codeintel_background_stuck_uploads_failed_total",
+		"The number of upload records marked as errored after processing for too long.",
+	)
 	numErrors := counter(
 		"src_codeintel_background_errors_total",
 		"The number of errors that occur during a codeintel background job.",
@@ -71,6 +91,10 @@ func newMetrics(observationContext *observation.Context) *metrics {
 		numUploadResetFailures:  numUploadResetFailures,
 		numIndexResets:          numIndexResets,
 		numIndexResetFailures:   numIndexResetFailures,
+		numOrphanedUploads:      numOrphanedUploads,
+		numOrphanedRowsRemoved:  numOrphanedRowsRemoved,
+		numOrphanedBytes:        numOrphanedBytes,
+		numStuckUploadsFailed:   numStuckUploadsFailed,
 		numErrors:               numErrors,
 	}
 }
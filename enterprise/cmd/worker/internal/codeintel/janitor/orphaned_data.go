@@ -0,0 +1,85 @@
+package janitor
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+)
+
+type orphanedDataJanitor struct {
+	dbStore       DBStore
+	lsifStore     LSIFStore
+	batchSize     int
+	afterBundleID int
+	metrics       *metrics
+}
+
+var _ goroutine.Handler = &orphanedDataJanitor{}
+
+// NewOrphanedDataJanitor returns a background routine that periodically removes data
+// from the codeintel database that no longer belongs to a live upload record. This can
+// happen when an upload record is removed without going through the hard deleter, or
+// when an upload fails after its data has been written.
+//
+// Each invocation inspects a single page of bundles with data in the codeintel database.
+// The routine remembers its position between invocations and wraps around once the last
+// page has been inspected, so that the entire codeintel database is eventually scanned.
+func NewOrphanedDataJanitor(dbStore DBStore, lsifStore LSIFStore, batchSize int, interval time.Duration, metrics *metrics) goroutine.BackgroundRoutine {
+	return goroutine.NewPeriodicGoroutine(context.Background(), interval, &orphanedDataJanitor{
+		dbStore:   dbStore,
+		lsifStore: lsifStore,
+		batchSize: batchSize,
+		metrics:   metrics,
+	})
+}
+
+func (j *orphanedDataJanitor) Handle(ctx context.Context) error {
+	bundleIDs, err := j.lsifStore.BundleIDs(ctx, j.afterBundleID, j.batchSize)
+	if err != nil {
+		return errors.Wrap(err, "BundleIDs")
+	}
+	if len(bundleIDs) == 0 {
+		// Start again from the beginning on the next invocation
+		j.afterBundleID = 0
+		return nil
+	}
+
+	orphanedIDs, err := j.dbStore.GetOrphanedUploadIDs(ctx, bundleIDs)
+	if err != nil {
+		return errors.Wrap(err, "GetOrphanedUploadIDs")
+	}
+
+	if len(orphanedIDs) > 0 {
+		numRows, numBytes, err := j.lsifStore.BundleSize(ctx, orphanedIDs)
+		if err != nil {
+			return errors.Wrap(err, "BundleSize")
+		}
+
+		if err := j.lsifStore.Clear(ctx, orphanedIDs...); err != nil {
+			return errors.Wrap(err, "Clear")
+		}
+
+		log15.Debug(
+			"Removed orphaned data from the codeintel database",
+			"upload_count", len(orphanedIDs),
+			"row_count", numRows,
+			"byte_count", numBytes,
+		)
+
+		j.metrics.numOrphanedUploads.Add(float64(len(orphanedIDs)))
+		j.metrics.numOrphanedRowsRemoved.Add(float64(numRows))
+		j.metrics.numOrphanedBytes.Add(float64(numBytes))
+	}
+
+	j.afterBundleID = bundleIDs[len(bundleIDs)-1]
+	return nil
+}
+
+func (j *orphanedDataJanitor) HandleError(err error) {
+	j.metrics.numErrors.Inc()
+	log15.Error("Failed to remove orphaned codeintel data", "error", err)
+}
@@ -0,0 +1,58 @@
+package janitor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestOrphanedDataJanitor(t *testing.T) {
+	dbStore := NewMockDBStore()
+	dbStore.GetOrphanedUploadIDsFunc.SetDefaultHook(func(ctx context.Context, ids []int) (orphaned []int, _ error) {
+		for _, id := range ids {
+			if id%2 == 0 {
+				orphaned = append(orphaned, id)
+			}
+		}
+		return orphaned, nil
+	})
+
+	lsifStore := NewMockLSIFStore()
+	lsifStore.BundleIDsFunc.PushReturn([]int{1, 2, 3}, nil)
+	lsifStore.BundleIDsFunc.PushReturn([]int{4, 5}, nil)
+	lsifStore.BundleIDsFunc.PushReturn(nil, nil)
+	lsifStore.BundleIDsFunc.PushReturn([]int{1}, nil)
+	lsifStore.BundleSizeFunc.SetDefaultReturn(10, 1000, nil)
+
+	janitor := &orphanedDataJanitor{
+		dbStore:   dbStore,
+		lsifStore: lsifStore,
+		batchSize: 3,
+		metrics:   newMetrics(&observation.TestContext),
+	}
+
+	for i := 0; i < 4; i++ {
+		if err := janitor.Handle(context.Background()); err != nil {
+			t.Fatalf("unexpected error running janitor: %s", err)
+		}
+	}
+
+	var afterBundleIDs []int
+	for _, call := range lsifStore.BundleIDsFunc.History() {
+		afterBundleIDs = append(afterBundleIDs, call.Arg1)
+	}
+	if diff := cmp.Diff([]int{0, 3, 5, 0}, afterBundleIDs); diff != "" {
+		t.Errorf("unexpected bundle id cursors (-want +got):\n%s", diff)
+	}
+
+	var clearedIDs [][]int
+	for _, call := range lsifStore.ClearFunc.History() {
+		clearedIDs = append(clearedIDs, call.Arg1)
+	}
+	if diff := cmp.Diff([][]int{{2}, {4}}, clearedIDs); diff != "" {
+		t.Errorf("unexpected cleared bundles (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,208 @@
+package janitor
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/derision-test/glock"
+	"github.com/gobwas/glob"
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/gitserver"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+)
+
+// retentionUploadsPageSize is the number of uploads fetched at once while evaluating the retention
+// policies that apply to a single repository.
+const retentionUploadsPageSize = 100
+
+type retentionPolicyJanitor struct {
+	dbStore         DBStore
+	gitserverClient GitserverClient
+	metrics         *metrics
+	clock           glock.Clock
+}
+
+var _ goroutine.Handler = &retentionPolicyJanitor{}
+
+// NewRetentionPolicyJanitor returns a background routine that periodically evaluates the configured
+// retention policies against the completed uploads of each repository. An upload is protected if it
+// is visible at the tip of the default branch, or if its commit is the tip of a branch or tag matched
+// by a policy that applies to its repository and the upload is younger than the policy's retention
+// duration. The remaining uploads of a repository governed by at least one policy are expired.
+// Uploads of repositories that are not governed by any policy are left to the record expirer.
+func NewRetentionPolicyJanitor(dbStore DBStore, gitserverClient GitserverClient, interval time.Duration, metrics *metrics) goroutine.BackgroundRoutine {
+	return goroutine.NewPeriodicGoroutine(context.Background(), interval, &retentionPolicyJanitor{
+		dbStore:         dbStore,
+		gitserverClient: gitserverClient,
+		metrics:         metrics,
+		clock:           glock.NewRealClock(),
+	})
+}
+
+func (j *retentionPolicyJanitor) Handle(ctx context.Context) error {
+	policies, err := j.dbStore.GetRetentionPolicies(ctx)
+	if err != nil {
+		return errors.Wrap(err, "dbstore.GetRetentionPolicies")
+	}
+
+	repositoryIDs, err := j.dbStore.GetRepositoriesWithCompletedUploads(ctx)
+	if err != nil {
+		return errors.Wrap(err, "dbstore.GetRepositoriesWithCompletedUploads")
+	}
+
+	for _, repositoryID := range repositoryIDs {
+		if err := j.handleRepository(ctx, repositoryID, policies); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (j *retentionPolicyJanitor) HandleError(err error) {
+	j.metrics.numErrors.Inc()
+	log15.Error("Failed to apply codeintel retention policies", "error", err)
+}
+
+func (j *retentionPolicyJanitor) handleRepository(ctx context.Context, repositoryID int, policies []dbstore.RetentionPolicy) error {
+	repositoryName, err := j.dbStore.RepoName(ctx, repositoryID)
+	if err != nil {
+		return errors.Wrap(err, "dbstore.RepoName")
+	}
+
+	matchers := compileRetentionPolicies(policiesForRepository(policies, repositoryName))
+	if len(matchers) == 0 {
+		// Clear the protection of uploads previously protected by a policy that no longer
+		// applies to this repository so that they can be expired by the record expirer.
+		if _, err := j.dbStore.UpdateUploadRetention(ctx, repositoryID, nil, nil); err != nil {
+			return errors.Wrap(err, "dbstore.UpdateUploadRetention")
+		}
+
+		return nil
+	}
+
+	refDescriptions, err := j.gitserverClient.RefDescriptions(ctx, repositoryID)
+	if err != nil {
+		return errors.Wrap(err, "gitserver.RefDescriptions")
+	}
+
+	var uploads []dbstore.Upload
+	for {
+		page, totalCount, err := j.dbStore.GetUploads(ctx, dbstore.GetUploadsOptions{
+			RepositoryID: repositoryID,
+			State:        "completed",
+			Limit:        retentionUploadsPageSize,
+			Offset:       len(uploads),
+		})
+		if err != nil {
+			return errors.Wrap(err, "dbstore.GetUploads")
+		}
+
+		uploads = append(uploads, page...)
+		if len(page) == 0 || len(uploads) >= totalCount {
+			break
+		}
+	}
+
+	protectedIDs, expiredIDs := evaluateRetentionPolicies(matchers, refDescriptions, uploads, j.clock.Now())
+
+	count, err := j.dbStore.UpdateUploadRetention(ctx, repositoryID, protectedIDs, expiredIDs)
+	if err != nil {
+		return errors.Wrap(err, "dbstore.UpdateUploadRetention")
+	}
+	if count > 0 {
+		log15.Debug("Deleted upload records expired by retention policies", "repositoryID", repositoryID, "count", count)
+		j.metrics.numUploadRecordsRemoved.Add(float64(count))
+	}
+
+	return nil
+}
+
+// retentionPolicyMatcher is a retention policy with a compiled ref pattern.
+type retentionPolicyMatcher struct {
+	policy  dbstore.RetentionPolicy
+	pattern glob.Glob
+}
+
+// policiesForRepository returns the subset of the given policies whose repository pattern matches
+// the given repository name. Policies with an invalid repository pattern are ignored.
+func policiesForRepository(policies []dbstore.RetentionPolicy, repositoryName string) []dbstore.RetentionPolicy {
+	var filtered []dbstore.RetentionPolicy
+	for _, policy := range policies {
+		pattern, err := glob.Compile(policy.RepositoryPattern)
+		if err != nil {
+			log15.Warn("Ignoring retention policy with invalid repository pattern", "id", policy.ID, "error", err)
+			continue
+		}
+
+		if pattern.Match(repositoryName) {
+			filtered = append(filtered, policy)
+		}
+	}
+
+	return filtered
+}
+
+// compileRetentionPolicies compiles the ref pattern of each of the given policies. Policies with an
+// invalid ref pattern are ignored.
+func compileRetentionPolicies(policies []dbstore.RetentionPolicy) []retentionPolicyMatcher {
+	matchers := make([]retentionPolicyMatcher, 0, len(policies))
+	for _, policy := range policies {
+		pattern, err := glob.Compile(policy.Pattern)
+		if err != nil {
+			log15.Warn("Ignoring retention policy with invalid pattern", "id", policy.ID, "error", err)
+			continue
+		}
+
+		matchers = append(matchers, retentionPolicyMatcher{policy: policy, pattern: pattern})
+	}
+
+	return matchers
+}
+
+// evaluateRetentionPolicies partitions the identifiers of the given uploads into the set of uploads
+// protected by one of the given policies (or by being visible at the tip of the default branch) and
+// the set of expired uploads.
+func evaluateRetentionPolicies(matchers []retentionPolicyMatcher, refDescriptions map[string][]gitserver.RefDescription, uploads []dbstore.Upload, now time.Time) (protectedIDs, expiredIDs []int) {
+	for _, upload := range uploads {
+		if upload.VisibleAtTip || isProtected(matchers, refDescriptions[upload.Commit], upload, now) {
+			protectedIDs = append(protectedIDs, upload.ID)
+		} else {
+			expiredIDs = append(expiredIDs, upload.ID)
+		}
+	}
+
+	return protectedIDs, expiredIDs
+}
+
+// isProtected returns true if one of the given policies matches one of the given refs (all of which
+// point to the upload's commit) and the upload is within the retention duration of that policy.
+func isProtected(matchers []retentionPolicyMatcher, refDescriptions []gitserver.RefDescription, upload dbstore.Upload, now time.Time) bool {
+	age := now.Sub(upload.UploadedAt)
+	if upload.FinishedAt != nil {
+		age = now.Sub(*upload.FinishedAt)
+	}
+
+	for _, matcher := range matchers {
+		if matcher.policy.RetentionDuration != nil && age > *matcher.policy.RetentionDuration {
+			continue
+		}
+
+		for _, refDescription := range refDescriptions {
+			if refDescription.Type == refTypes[matcher.policy.Type] && matcher.pattern.Match(refDescription.Name) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// refTypes maps retention policy types to the type of git ref they match.
+var refTypes = map[dbstore.RetentionPolicyType]gitserver.RefType{
+	dbstore.RetentionPolicyTypeBranch: gitserver.RefTypeBranch,
+	dbstore.RetentionPolicyTypeTag:    gitserver.RefTypeTag,
+}
@@ -0,0 +1,112 @@
+package janitor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/derision-test/glock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/gitserver"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestEvaluateRetentionPolicies(t *testing.T) {
+	now := time.Unix(1587396557, 0).UTC()
+	day := time.Hour * 24
+	t1 := now.Add(-day * 2)
+	t2 := now.Add(-day * 10)
+
+	matchers := compileRetentionPolicies([]dbstore.RetentionPolicy{
+		{ID: 1, Type: dbstore.RetentionPolicyTypeTag, Pattern: "v*"},
+		{ID: 2, Type: dbstore.RetentionPolicyTypeBranch, Pattern: "release/*", RetentionDuration: &[]time.Duration{day * 7}[0]},
+		{ID: 3, Type: dbstore.RetentionPolicyTypeBranch, Pattern: "[invalid"},
+	})
+
+	refDescriptions := map[string][]gitserver.RefDescription{
+		"c1": {{Name: "v1.0.0", Type: gitserver.RefTypeTag}},
+		"c2": {{Name: "release/1.0", Type: gitserver.RefTypeBranch}},
+		"c3": {{Name: "release/2.0", Type: gitserver.RefTypeBranch}},
+		"c4": {{Name: "v2.0.0", Type: gitserver.RefTypeBranch}},
+	}
+
+	uploads := []dbstore.Upload{
+		{ID: 1, Commit: "c1", FinishedAt: &t2},                     // matching tag
+		{ID: 2, Commit: "c2", FinishedAt: &t1},                     // matching branch within duration
+		{ID: 3, Commit: "c3", FinishedAt: &t2},                     // matching branch outside of duration
+		{ID: 4, Commit: "c4", FinishedAt: &t1},                     // branch matching a tag pattern
+		{ID: 5, Commit: "c5", FinishedAt: &t1},                     // no refs
+		{ID: 6, Commit: "c6", FinishedAt: &t2, VisibleAtTip: true}, // visible at tip
+	}
+
+	protectedIDs, expiredIDs := evaluateRetentionPolicies(matchers, refDescriptions, uploads, now)
+	if diff := cmp.Diff([]int{1, 2, 6}, protectedIDs); diff != "" {
+		t.Errorf("unexpected protected ids (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]int{3, 4, 5}, expiredIDs); diff != "" {
+		t.Errorf("unexpected expired ids (-want +got):\n%s", diff)
+	}
+}
+
+func TestRetentionPolicyJanitor(t *testing.T) {
+	dbStore := NewMockDBStore()
+	dbStore.GetRetentionPoliciesFunc.SetDefaultReturn([]dbstore.RetentionPolicy{
+		{ID: 1, RepositoryPattern: "github.com/sourcegraph/*", Type: dbstore.RetentionPolicyTypeTag, Pattern: "*"},
+	}, nil)
+	dbStore.GetRepositoriesWithCompletedUploadsFunc.SetDefaultReturn([]int{50, 51}, nil)
+	dbStore.RepoNameFunc.SetDefaultHook(func(ctx context.Context, repositoryID int) (string, error) {
+		if repositoryID == 50 {
+			return "github.com/sourcegraph/sourcegraph", nil
+		}
+		return "github.com/other/other", nil
+	})
+	dbStore.GetUploadsFunc.SetDefaultReturn([]dbstore.Upload{
+		{ID: 1, Commit: "c1"},
+		{ID: 2, Commit: "c2"},
+	}, 2, nil)
+
+	gitserverClient := NewMockGitserverClient()
+	gitserverClient.RefDescriptionsFunc.SetDefaultReturn(map[string][]gitserver.RefDescription{
+		"c1": {{Name: "v1.0.0", Type: gitserver.RefTypeTag}},
+	}, nil)
+
+	janitor := &retentionPolicyJanitor{
+		dbStore:         dbStore,
+		gitserverClient: gitserverClient,
+		metrics:         newMetrics(&observation.TestContext),
+		clock:           glock.NewMockClock(),
+	}
+
+	if err := janitor.Handle(context.Background()); err != nil {
+		t.Fatalf("unexpected error running janitor: %s", err)
+	}
+
+	if len(gitserverClient.RefDescriptionsFunc.History()) != 1 {
+		t.Fatalf("unexpected number of calls to RefDescriptions. want=%d have=%d", 1, len(gitserverClient.RefDescriptionsFunc.History()))
+	}
+
+	type updateUploadRetentionFuncInvocation struct {
+		RepositoryID int
+		ProtectedIDs []int
+		ExpiredIDs   []int
+	}
+
+	var calls []updateUploadRetentionFuncInvocation
+	for _, call := range dbStore.UpdateUploadRetentionFunc.History() {
+		calls = append(calls, updateUploadRetentionFuncInvocation{
+			RepositoryID: call.Arg1,
+			ProtectedIDs: call.Arg2,
+			ExpiredIDs:   call.Arg3,
+		})
+	}
+
+	expectedCalls := []updateUploadRetentionFuncInvocation{
+		{RepositoryID: 50, ProtectedIDs: []int{1}, ExpiredIDs: []int{2}},
+		{RepositoryID: 51},
+	}
+	if diff := cmp.Diff(expectedCalls, calls); diff != "" {
+		t.Errorf("unexpected calls to UpdateUploadRetention (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,53 @@
+package janitor
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+)
+
+type stuckUploadJanitor struct {
+	dbStore   DBStore
+	timeout   time.Duration
+	batchSize int
+	metrics   *metrics
+}
+
+var _ goroutine.Handler = &stuckUploadJanitor{}
+
+// NewStuckUploadJanitor returns a background routine that periodically marks upload
+// records as errored when they have been in the processing state for longer than the
+// given timeout. Records still locked by a live worker are left alone and reported.
+func NewStuckUploadJanitor(dbStore DBStore, timeout time.Duration, batchSize int, interval time.Duration, metrics *metrics) goroutine.BackgroundRoutine {
+	return goroutine.NewPeriodicGoroutine(context.Background(), interval, &stuckUploadJanitor{
+		dbStore:   dbStore,
+		timeout:   timeout,
+		batchSize: batchSize,
+		metrics:   metrics,
+	})
+}
+
+func (j *stuckUploadJanitor) Handle(ctx context.Context) error {
+	failed, locked, err := j.dbStore.FailUploadsStuckProcessing(ctx, time.Now().UTC().Add(-j.timeout), j.batchSize)
+	if err != nil {
+		return errors.Wrap(err, "FailUploadsStuckProcessing")
+	}
+	if failed > 0 {
+		log15.Debug("Failed upload records stuck in processing", "count", failed)
+		j.metrics.numStuckUploadsFailed.Add(float64(failed))
+	}
+	if locked > 0 {
+		log15.Warn("Upload records have been processing beyond the timeout but are still held by a worker", "count", locked, "timeout", j.timeout)
+	}
+
+	return nil
+}
+
+func (j *stuckUploadJanitor) HandleError(err error) {
+	j.metrics.numErrors.Inc()
+	log15.Error("Failed to fail uploads stuck in processing", "error", err)
+}
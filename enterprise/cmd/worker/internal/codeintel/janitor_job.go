@@ -43,6 +43,11 @@ func (j *janitorJob) Routines(ctx context.Context) ([]goroutine.BackgroundRoutin
 		return nil, err
 	}
 
+	gitserverClient, err := InitGitserverClient()
+	if err != nil {
+		return nil, err
+	}
+
 	dbStoreShim := &janitor.DBStoreShim{Store: dbStore}
 	uploadWorkerStore := dbstore.WorkerutilUploadStore(dbStoreShim, observationContext)
 	indexWorkerStore := dbstore.WorkerutilIndexStore(dbStoreShim, observationContext)
@@ -52,7 +57,11 @@ func (j *janitorJob) Routines(ctx context.Context) ([]goroutine.BackgroundRoutin
 		janitor.NewAbandonedUploadJanitor(dbStoreShim, janitorConfigInst.UploadTimeout, janitorConfigInst.CleanupTaskInterval, metrics),
 		janitor.NewDeletedRepositoryJanitor(dbStoreShim, janitorConfigInst.CleanupTaskInterval, metrics),
 		janitor.NewHardDeleter(dbStoreShim, lsifStore, janitorConfigInst.CleanupTaskInterval, metrics),
+		janitor.NewMissingRepositoryJanitor(dbStoreShim, janitorConfigInst.CleanupBatchSize, janitorConfigInst.CleanupTaskInterval, metrics),
+		janitor.NewOrphanedDataJanitor(dbStoreShim, lsifStore, janitorConfigInst.CleanupBatchSize, janitorConfigInst.CleanupTaskInterval, metrics),
 		janitor.NewRecordExpirer(dbStoreShim, janitorConfigInst.DataTTL, janitorConfigInst.CleanupTaskInterval, metrics),
+		janitor.NewRetentionPolicyJanitor(dbStoreShim, gitserverClient, janitorConfigInst.CleanupTaskInterval, metrics),
+		janitor.NewStuckUploadJanitor(dbStoreShim, janitorConfigInst.UploadProcessingTimeout, janitorConfigInst.CleanupBatchSize, janitorConfigInst.CleanupTaskInterval, metrics),
 		janitor.NewUploadResetter(uploadWorkerStore, janitorConfigInst.CleanupTaskInterval, metrics, observationContext),
 		janitor.NewIndexResetter(indexWorkerStore, janitorConfigInst.CleanupTaskInterval, metrics, observationContext),
 		janitor.NewUnknownCommitJanitor(dbStoreShim, janitorConfigInst.CommitResolverMinimumTimeSinceLastCheck, janitorConfigInst.CommitResolverBatchSize, janitorConfigInst.CommitResolverTaskInterval, metrics),
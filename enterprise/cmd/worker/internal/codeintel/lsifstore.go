@@ -11,10 +11,11 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 )
 
-// InitLSIFStore initializes and returns an LSIF store instance.
-func InitLSIFStore() (*lsifstore.Store, error) {
+// InitLSIFStore initializes and returns an LSIF store instance routing bundles across the
+// primary codeintel db and any configured codeintel database shards.
+func InitLSIFStore() (*lsifstore.ShardedStore, error) {
 	conn, err := initLSFIStore.Init()
-	return conn.(*lsifstore.Store), err
+	return conn.(*lsifstore.ShardedStore), err
 }
 
 var initLSFIStore = shared.NewMemoizedConstructor(func() (interface{}, error) {
@@ -29,5 +30,15 @@ var initLSFIStore = shared.NewMemoizedConstructor(func() (interface{}, error) {
 		return nil, err
 	}
 
-	return lsifstore.NewStore(db, observationContext), nil
+	shardDBs, err := InitCodeIntelShardDatabases()
+	if err != nil {
+		return nil, err
+	}
+
+	shards := []*lsifstore.Store{lsifstore.NewStore(db, observationContext)}
+	for _, shardDB := range shardDBs {
+		shards = append(shards, lsifstore.NewStore(shardDB, observationContext))
+	}
+
+	return lsifstore.NewShardedStore(shards...), nil
 })
@@ -10,6 +10,7 @@ import (
 	"github.com/inconshreveable/log15"
 
 	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/authz/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/authz/bitbucketserver"
 	"github.com/sourcegraph/sourcegraph/internal/authz/github"
 	"github.com/sourcegraph/sourcegraph/internal/authz/gitlab"
@@ -52,6 +53,7 @@ func ProvidersFromConfig(
 			extsvc.KindGitHub,
 			extsvc.KindGitLab,
 			extsvc.KindBitbucketServer,
+			extsvc.KindBitbucketCloud,
 			extsvc.KindPerforce,
 		},
 		LimitOffset: &database.LimitOffset{
@@ -63,6 +65,7 @@ func ProvidersFromConfig(
 		gitHubConns          []*types.GitHubConnection
 		gitLabConns          []*types.GitLabConnection
 		bitbucketServerConns []*types.BitbucketServerConnection
+		bitbucketCloudConns  []*types.BitbucketCloudConnection
 		perforceConns        []*types.PerforceConnection
 	)
 	for {
@@ -99,6 +102,11 @@ func ProvidersFromConfig(
 					URN:                       svc.URN(),
 					BitbucketServerConnection: c,
 				})
+			case *schema.BitbucketCloudConnection:
+				bitbucketCloudConns = append(bitbucketCloudConns, &types.BitbucketCloudConnection{
+					URN:                      svc.URN(),
+					BitbucketCloudConnection: c,
+				})
 			case *schema.PerforceConnection:
 				perforceConns = append(perforceConns, &types.PerforceConnection{
 					URN:                svc.URN(),
@@ -137,6 +145,13 @@ func ProvidersFromConfig(
 		warnings = append(warnings, bbsWarnings...)
 	}
 
+	if len(bitbucketCloudConns) > 0 {
+		bbcProviders, bbcProblems, bbcWarnings := bitbucketcloud.NewAuthzProviders(bitbucketCloudConns)
+		providers = append(providers, bbcProviders...)
+		seriousProblems = append(seriousProblems, bbcProblems...)
+		warnings = append(warnings, bbcWarnings...)
+	}
+
 	if len(perforceConns) > 0 {
 		pfProviders, pfProblems, pfWarnings := perforce.NewAuthzProviders(perforceConns)
 		providers = append(providers, pfProviders...)
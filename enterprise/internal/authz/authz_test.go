@@ -79,6 +79,7 @@ func TestAuthzProvidersFromConfig(t *testing.T) {
 		cfg                          conf.Unified
 		gitlabConnections            []*schema.GitLabConnection
 		bitbucketServerConnections   []*schema.BitbucketServerConnection
+		bitbucketCloudConnections    []*schema.BitbucketCloudConnection
 		expAuthzAllowAccessByDefault bool
 		expAuthzProviders            func(*testing.T, []authz.Provider)
 		expSeriousProblems           []string
@@ -390,6 +391,60 @@ func TestAuthzProvidersFromConfig(t *testing.T) {
 				}
 			},
 		},
+		{
+			description: "1 BitbucketCloud connection with authz disabled",
+			bitbucketCloudConnections: []*schema.BitbucketCloudConnection{
+				{
+					Authorization: nil,
+					Url:           "https://bitbucket.org",
+					Username:      "admin",
+					AppPassword:   "secret-password",
+					Teams:         []string{"mycorp"},
+				},
+			},
+			expAuthzAllowAccessByDefault: true,
+			expAuthzProviders:            providersEqual(),
+		},
+		{
+			description: "Bitbucket Cloud authz without teams",
+			bitbucketCloudConnections: []*schema.BitbucketCloudConnection{
+				{
+					Authorization: &schema.BitbucketCloudAuthorization{},
+					Url:           "https://bitbucket.org",
+					Username:      "admin",
+					AppPassword:   "secret-password",
+				},
+			},
+			expAuthzAllowAccessByDefault: false,
+			expSeriousProblems:           []string{`"authorization" requires at least one workspace in "teams"`},
+		},
+		{
+			description: "Bitbucket Cloud workspace permissions",
+			bitbucketCloudConnections: []*schema.BitbucketCloudConnection{
+				{
+					Authorization: &schema.BitbucketCloudAuthorization{},
+					Url:           "https://bitbucket.org",
+					ApiURL:        "https://api.bitbucket.mycorp.org",
+					Username:      "admin",
+					AppPassword:   "secret-password",
+					Teams:         []string{"mycorp"},
+				},
+			},
+			expAuthzAllowAccessByDefault: true,
+			expAuthzProviders: func(t *testing.T, have []authz.Provider) {
+				if len(have) == 0 {
+					t.Fatalf("no providers")
+				}
+
+				if have[0].ServiceType() != extsvc.TypeBitbucketCloud {
+					t.Fatalf("no Bitbucket Cloud authz provider returned")
+				}
+
+				if have, want := have[0].ServiceID(), "https://bitbucket.org/"; have != want {
+					t.Fatalf("ServiceID: have %q, want %q", have, want)
+				}
+			},
+		},
 
 		// For Sourcegraph authz provider
 		{
@@ -462,6 +517,7 @@ func TestAuthzProvidersFromConfig(t *testing.T) {
 		store := fakeStore{
 			gitlabs:          test.gitlabConnections,
 			bitbucketServers: test.bitbucketServerConnections,
+			bitbucketClouds:  test.bitbucketCloudConnections,
 		}
 
 		allowAccessByDefault, authzProviders, seriousProblems, _ := ProvidersFromConfig(
@@ -501,6 +557,7 @@ type fakeStore struct {
 	gitlabs          []*schema.GitLabConnection
 	githubs          []*schema.GitHubConnection
 	bitbucketServers []*schema.BitbucketServerConnection
+	bitbucketClouds  []*schema.BitbucketCloudConnection
 	perforces        []*schema.PerforceConnection
 }
 
@@ -537,6 +594,13 @@ func (s fakeStore) List(ctx context.Context, opt database.ExternalServicesListOp
 					Config: mustMarshalJSONString(bbs),
 				})
 			}
+		case extsvc.KindBitbucketCloud:
+			for _, bbc := range s.bitbucketClouds {
+				svcs = append(svcs, &types.ExternalService{
+					Kind:   kind,
+					Config: mustMarshalJSONString(bbc),
+				})
+			}
 		case extsvc.KindPerforce:
 			for _, p := range s.perforces {
 				svcs = append(svcs, &types.ExternalService{
@@ -357,6 +357,19 @@ type BulkOperation struct {
 	FinishedAt string
 }
 
+type BatchSpecExecution struct {
+	ID         string
+	InputSpec  string
+	State      string
+	CreatedAt  string
+	StartedAt  string
+	FinishedAt string
+	Failure    *string
+	BatchSpec  *BatchSpec
+	Initiator  User
+	Namespace  UserOrg
+}
+
 type ChangesetJobError struct {
 	Changeset *Changeset
 	Error     *string
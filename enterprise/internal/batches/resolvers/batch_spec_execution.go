@@ -0,0 +1,107 @@
+package resolvers
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+)
+
+const batchSpecExecutionIDKind = "BatchSpecExecution"
+
+func marshalBatchSpecExecutionRandID(id string) graphql.ID {
+	return relay.MarshalID(batchSpecExecutionIDKind, id)
+}
+
+func unmarshalBatchSpecExecutionID(id graphql.ID) (batchSpecExecutionRandID string, err error) {
+	err = relay.UnmarshalSpec(id, &batchSpecExecutionRandID)
+	return
+}
+
+var _ graphqlbackend.BatchSpecExecutionResolver = &batchSpecExecutionResolver{}
+
+type batchSpecExecutionResolver struct {
+	store *store.Store
+	exec  *btypes.BatchSpecExecution
+}
+
+func (r *batchSpecExecutionResolver) ID() graphql.ID {
+	// 🚨 SECURITY: This needs to be the RandID! We can't expose the
+	// sequential, guessable ID.
+	return marshalBatchSpecExecutionRandID(r.exec.RandID)
+}
+
+func (r *batchSpecExecutionResolver) InputSpec() string {
+	return r.exec.BatchSpec
+}
+
+func (r *batchSpecExecutionResolver) State() string {
+	return string(r.exec.State)
+}
+
+func (r *batchSpecExecutionResolver) CreatedAt() graphqlbackend.DateTime {
+	return graphqlbackend.DateTime{Time: r.exec.CreatedAt}
+}
+
+func (r *batchSpecExecutionResolver) StartedAt() *graphqlbackend.DateTime {
+	if r.exec.StartedAt.IsZero() {
+		return nil
+	}
+	return &graphqlbackend.DateTime{Time: r.exec.StartedAt}
+}
+
+func (r *batchSpecExecutionResolver) FinishedAt() *graphqlbackend.DateTime {
+	if r.exec.FinishedAt.IsZero() {
+		return nil
+	}
+	return &graphqlbackend.DateTime{Time: r.exec.FinishedAt}
+}
+
+func (r *batchSpecExecutionResolver) Failure() *string {
+	return r.exec.FailureMessage
+}
+
+func (r *batchSpecExecutionResolver) BatchSpec(ctx context.Context) (graphqlbackend.BatchSpecResolver, error) {
+	if r.exec.BatchSpecID == 0 {
+		return nil, nil
+	}
+
+	batchSpec, err := r.store.GetBatchSpec(ctx, store.GetBatchSpecOpts{ID: r.exec.BatchSpecID})
+	if err != nil {
+		if err == store.ErrNoResults {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &batchSpecResolver{store: r.store, batchSpec: batchSpec}, nil
+}
+
+func (r *batchSpecExecutionResolver) Initiator(ctx context.Context) (*graphqlbackend.UserResolver, error) {
+	return graphqlbackend.UserByIDInt32(ctx, r.store.DB(), r.exec.UserID)
+}
+
+func (r *batchSpecExecutionResolver) Namespace(ctx context.Context) (*graphqlbackend.NamespaceResolver, error) {
+	var (
+		err error
+		n   = &graphqlbackend.NamespaceResolver{}
+	)
+
+	if r.exec.NamespaceUserID != 0 {
+		n.Namespace, err = graphqlbackend.UserByIDInt32(ctx, r.store.DB(), r.exec.NamespaceUserID)
+	} else {
+		n.Namespace, err = graphqlbackend.OrgByIDInt32(ctx, r.store.DB(), r.exec.NamespaceOrgID)
+	}
+
+	if errcode.IsNotFound(err) {
+		return nil, errors.New("namespace of batch spec execution has been deleted")
+	}
+
+	return n, err
+}
@@ -144,6 +144,9 @@ func (r *Resolver) NodeResolvers() map[string]graphqlbackend.NodeByIDFunc {
 		bulkOperationIDKind: func(ctx context.Context, id graphql.ID) (graphqlbackend.Node, error) {
 			return r.bulkOperationByID(ctx, id)
 		},
+		batchSpecExecutionIDKind: func(ctx context.Context, id graphql.ID) (graphqlbackend.Node, error) {
+			return r.batchSpecExecutionByID(ctx, id)
+		},
 	}
 }
 
@@ -361,6 +364,37 @@ func (r *Resolver) bulkOperationByIDString(ctx context.Context, id string) (grap
 	return &bulkOperationResolver{store: r.store, bulkOperation: bulkOperation}, nil
 }
 
+func (r *Resolver) batchSpecExecutionByID(ctx context.Context, id graphql.ID) (graphqlbackend.BatchSpecExecutionResolver, error) {
+	if err := batchChangesEnabled(ctx, r.store.DB()); err != nil {
+		return nil, err
+	}
+
+	randID, err := unmarshalBatchSpecExecutionID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if randID == "" {
+		return nil, nil
+	}
+
+	exec, err := r.store.GetBatchSpecExecution(ctx, store.GetBatchSpecExecutionOpts{RandID: randID})
+	if err != nil {
+		if err == store.ErrNoResults {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// 🚨 SECURITY: Only site admins and the initiator of the execution can
+	// view it, since the input spec may reference secrets.
+	if ok, err := checkSiteAdminOrSameUser(ctx, r.store.DB(), exec.UserID); err != nil || !ok {
+		return nil, err
+	}
+
+	return &batchSpecExecutionResolver{store: r.store, exec: exec}, nil
+}
+
 func (r *Resolver) CreateBatchChange(ctx context.Context, args *graphqlbackend.CreateBatchChangeArgs) (graphqlbackend.BatchChangeResolver, error) {
 	var err error
 	tr, _ := trace.New(ctx, "Resolver.CreateBatchChange", fmt.Sprintf("BatchSpec %s", args.BatchSpec))
@@ -1288,6 +1322,47 @@ func (r *Resolver) MergeChangesets(ctx context.Context, args *graphqlbackend.Mer
 	return r.bulkOperationByIDString(ctx, bulkGroupID)
 }
 
+func (r *Resolver) CreateBatchSpecExecution(ctx context.Context, args *graphqlbackend.CreateBatchSpecExecutionArgs) (_ graphqlbackend.BatchSpecExecutionResolver, err error) {
+	tr, ctx := trace.New(ctx, "Resolver.CreateBatchSpecExecution", fmt.Sprintf("Namespace: %s", args.Namespace))
+	defer func() {
+		tr.SetError(err)
+		tr.Finish()
+	}()
+
+	if err := batchChangesEnabled(ctx, r.store.DB()); err != nil {
+		return nil, err
+	}
+
+	if err := batchChangesCreateAccess(ctx); err != nil {
+		return nil, err
+	}
+
+	// Server-side execution is not available in the free tier, since the
+	// number of changesets it creates is only known once it completed.
+	if err := checkLicense(); err != nil {
+		if licensing.IsFeatureNotActivated(err) {
+			return nil, ErrBatchChangesUnlicensed{err}
+		}
+		return nil, err
+	}
+
+	opts := service.CreateBatchSpecExecutionOpts{RawSpec: args.Spec}
+	err = graphqlbackend.UnmarshalNamespaceID(args.Namespace, &opts.NamespaceUserID, &opts.NamespaceOrgID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 🚨 SECURITY: CreateBatchSpecExecution checks whether current user has
+	// access to the namespace.
+	svc := service.New(r.store)
+	exec, err := svc.CreateBatchSpecExecution(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &batchSpecExecutionResolver{store: r.store, exec: exec}, nil
+}
+
 func parseBatchChangeState(s *string) (btypes.BatchChangeState, error) {
 	if s == nil {
 		return btypes.BatchChangeStateAny, nil
@@ -38,7 +38,7 @@ func TestNullIDResilience(t *testing.T) {
 	db := dbtest.NewDB(t, "")
 	sr := New(store.New(db, nil))
 
-	s, err := graphqlbackend.NewSchema(db, sr, nil, nil, nil, nil, nil, nil)
+	s, err := graphqlbackend.NewSchema(db, sr, nil, nil, nil, nil, nil, nil, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -53,6 +53,7 @@ func TestNullIDResilience(t *testing.T) {
 		marshalBatchChangesCredentialID(0, false),
 		marshalBatchChangesCredentialID(0, true),
 		marshalBulkOperationID(""),
+		marshalBatchSpecExecutionRandID(""),
 	}
 
 	for _, id := range ids {
@@ -135,7 +136,7 @@ func TestCreateBatchSpec(t *testing.T) {
 	}
 
 	r := &Resolver{store: cstore}
-	s, err := graphqlbackend.NewSchema(db, r, nil, nil, nil, nil, nil, nil)
+	s, err := graphqlbackend.NewSchema(db, r, nil, nil, nil, nil, nil, nil, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -280,6 +281,144 @@ mutation($namespace: ID!, $batchSpec: String!, $changesetSpecs: [ID!]!){
 }
 `
 
+func TestCreateBatchSpecExecution(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+	db := dbtest.NewDB(t, "")
+
+	user := ct.CreateTestUser(t, db, true)
+	userID := user.ID
+
+	cstore := store.New(db, nil)
+
+	r := &Resolver{store: cstore}
+	s, err := graphqlbackend.NewSchema(db, r, nil, nil, nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	userAPIID := string(graphqlbackend.MarshalUserID(userID))
+	input := map[string]interface{}{
+		"namespace": userAPIID,
+		"spec":      ct.TestRawBatchSpecYAML,
+	}
+
+	for name, tc := range map[string]struct {
+		hasLicenseFor map[licensing.Feature]struct{}
+		wantErr       bool
+	}{
+		"batch changes license": {
+			hasLicenseFor: map[licensing.Feature]struct{}{
+				licensing.FeatureBatchChanges: {},
+			},
+			wantErr: false,
+		},
+		"no licence": {
+			hasLicenseFor: map[licensing.Feature]struct{}{},
+			wantErr:       true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			oldMock := licensing.MockCheckFeature
+			licensing.MockCheckFeature = func(feature licensing.Feature) error {
+				if _, ok := tc.hasLicenseFor[feature]; !ok {
+					return licensing.NewFeatureNotActivatedError("no batch changes for you!")
+				}
+				return nil
+			}
+
+			defer func() {
+				licensing.MockCheckFeature = oldMock
+			}()
+
+			var response struct{ CreateBatchSpecExecution apitest.BatchSpecExecution }
+
+			actorCtx := actor.WithActor(ctx, actor.FromUser(userID))
+			errs := apitest.Exec(actorCtx, t, s, input, &response, mutationCreateBatchSpecExecution)
+			if tc.wantErr {
+				if errs == nil {
+					t.Error("unexpected lack of errors")
+				}
+				return
+			}
+			if errs != nil {
+				t.Fatalf("unexpected error(s): %+v", errs)
+			}
+
+			have := response.CreateBatchSpecExecution
+			want := apitest.BatchSpecExecution{
+				ID:        have.ID,
+				InputSpec: ct.TestRawBatchSpecYAML,
+				State:     "QUEUED",
+				CreatedAt: have.CreatedAt,
+				Initiator: apitest.User{ID: userAPIID, DatabaseID: userID, SiteAdmin: true},
+				Namespace: apitest.UserOrg{ID: userAPIID, DatabaseID: userID, SiteAdmin: true},
+			}
+			if diff := cmp.Diff(want, have); diff != "" {
+				t.Fatalf("unexpected response (-want +got):\n%s", diff)
+			}
+
+			// The execution can be queried as a node by its initiator.
+			var nodeResponse struct{ Node apitest.BatchSpecExecution }
+			apitest.MustExec(actorCtx, t, s, map[string]interface{}{"id": have.ID}, &nodeResponse, queryBatchSpecExecutionNode)
+			if diff := cmp.Diff(want, nodeResponse.Node); diff != "" {
+				t.Fatalf("unexpected node (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+const mutationCreateBatchSpecExecution = `
+fragment u on User { id, databaseID, siteAdmin }
+fragment o on Org  { id, name }
+
+mutation($namespace: ID!, $spec: String!){
+  createBatchSpecExecution(namespace: $namespace, spec: $spec) {
+    id
+    inputSpec
+    state
+    createdAt
+    startedAt
+    finishedAt
+    failure
+    batchSpec { id }
+    initiator { ...u }
+    namespace {
+      ... on User { ...u }
+      ... on Org  { ...o }
+    }
+  }
+}
+`
+
+const queryBatchSpecExecutionNode = `
+fragment u on User { id, databaseID, siteAdmin }
+fragment o on Org  { id, name }
+
+query($id: ID!){
+  node(id: $id) {
+    ... on BatchSpecExecution {
+      id
+      inputSpec
+      state
+      createdAt
+      startedAt
+      finishedAt
+      failure
+      batchSpec { id }
+      initiator { ...u }
+      namespace {
+        ... on User { ...u }
+        ... on Org  { ...o }
+      }
+    }
+  }
+}
+`
+
 func TestCreateChangesetSpec(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
@@ -300,7 +439,7 @@ func TestCreateChangesetSpec(t *testing.T) {
 	}
 
 	r := &Resolver{store: cstore}
-	s, err := graphqlbackend.NewSchema(db, r, nil, nil, nil, nil, nil, nil)
+	s, err := graphqlbackend.NewSchema(db, r, nil, nil, nil, nil, nil, nil, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -415,7 +554,7 @@ func TestApplyBatchChange(t *testing.T) {
 	}
 
 	r := &Resolver{store: cstore}
-	s, err := graphqlbackend.NewSchema(db, r, nil, nil, nil, nil, nil, nil)
+	s, err := graphqlbackend.NewSchema(db, r, nil, nil, nil, nil, nil, nil, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -540,7 +679,7 @@ func TestCreateBatchChange(t *testing.T) {
 	}
 
 	r := &Resolver{store: cstore}
-	s, err := graphqlbackend.NewSchema(db, r, nil, nil, nil, nil, nil, nil)
+	s, err := graphqlbackend.NewSchema(db, r, nil, nil, nil, nil, nil, nil, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -614,7 +753,7 @@ func TestMoveBatchChange(t *testing.T) {
 	}
 
 	r := &Resolver{store: cstore}
-	s, err := graphqlbackend.NewSchema(db, r, nil, nil, nil, nil, nil, nil)
+	s, err := graphqlbackend.NewSchema(db, r, nil, nil, nil, nil, nil, nil, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -856,7 +995,7 @@ func TestCreateBatchChangesCredential(t *testing.T) {
 	cstore := store.New(db, nil)
 
 	r := &Resolver{store: cstore}
-	s, err := graphqlbackend.NewSchema(db, r, nil, nil, nil, nil, nil, nil)
+	s, err := graphqlbackend.NewSchema(db, r, nil, nil, nil, nil, nil, nil, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1004,7 +1143,7 @@ func TestDeleteBatchChangesCredential(t *testing.T) {
 	}
 
 	r := &Resolver{store: cstore}
-	s, err := graphqlbackend.NewSchema(db, r, nil, nil, nil, nil, nil, nil)
+	s, err := graphqlbackend.NewSchema(db, r, nil, nil, nil, nil, nil, nil, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1088,7 +1227,7 @@ func TestCreateChangesetComments(t *testing.T) {
 	})
 
 	r := &Resolver{store: cstore}
-	s, err := graphqlbackend.NewSchema(db, r, nil, nil, nil, nil, nil, nil)
+	s, err := graphqlbackend.NewSchema(db, r, nil, nil, nil, nil, nil, nil, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1197,7 +1336,7 @@ func TestReenqueueChangesets(t *testing.T) {
 	})
 
 	r := &Resolver{store: cstore}
-	s, err := graphqlbackend.NewSchema(db, r, nil, nil, nil, nil, nil, nil)
+	s, err := graphqlbackend.NewSchema(db, r, nil, nil, nil, nil, nil, nil, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1308,7 +1447,7 @@ func TestMergeChangesets(t *testing.T) {
 	})
 
 	r := &Resolver{store: cstore}
-	s, err := graphqlbackend.NewSchema(db, r, nil, nil, nil, nil, nil, nil)
+	s, err := graphqlbackend.NewSchema(db, r, nil, nil, nil, nil, nil, nil, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
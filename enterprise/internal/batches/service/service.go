@@ -137,6 +137,45 @@ func (s *Service) CreateBatchSpec(ctx context.Context, opts CreateBatchSpecOpts)
 	return spec, nil
 }
 
+type CreateBatchSpecExecutionOpts struct {
+	RawSpec string
+
+	NamespaceUserID int32
+	NamespaceOrgID  int32
+}
+
+// CreateBatchSpecExecution validates the given raw spec and queues it for
+// server-side execution by an executor. Once executed, the resulting batch spec
+// is created in the given namespace on behalf of the current user.
+func (s *Service) CreateBatchSpecExecution(ctx context.Context, opts CreateBatchSpecExecutionOpts) (exec *btypes.BatchSpecExecution, err error) {
+	actor := actor.FromContext(ctx)
+	tr, ctx := trace.New(ctx, "Service.CreateBatchSpecExecution", fmt.Sprintf("Actor %s", actor))
+	defer func() {
+		tr.SetError(err)
+		tr.Finish()
+	}()
+
+	// Validate the spec now, so that users don't have to wait for an executor
+	// to find out that the spec is invalid.
+	if _, err := btypes.NewBatchSpecFromRaw(opts.RawSpec); err != nil {
+		return nil, err
+	}
+
+	// Check whether the current user has access to either one of the namespaces.
+	err = checkNamespaceAccess(ctx, s.store.DB(), opts.NamespaceUserID, opts.NamespaceOrgID)
+	if err != nil {
+		return nil, err
+	}
+
+	exec = &btypes.BatchSpecExecution{
+		BatchSpec:       opts.RawSpec,
+		UserID:          actor.UID,
+		NamespaceUserID: opts.NamespaceUserID,
+		NamespaceOrgID:  opts.NamespaceOrgID,
+	}
+	return exec, s.store.CreateBatchSpecExecution(ctx, exec)
+}
+
 // CreateChangesetSpec validates the given raw spec input and creates the ChangesetSpec.
 func (s *Service) CreateChangesetSpec(ctx context.Context, rawSpec string, userID int32) (spec *btypes.ChangesetSpec, err error) {
 	tr, ctx := trace.New(ctx, "Service.CreateChangesetSpec", fmt.Sprintf("User %d", userID))
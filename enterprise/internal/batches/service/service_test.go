@@ -549,6 +549,55 @@ func TestService(t *testing.T) {
 		})
 	})
 
+	t.Run("CreateBatchSpecExecution", func(t *testing.T) {
+		t.Run("success", func(t *testing.T) {
+			opts := CreateBatchSpecExecutionOpts{
+				NamespaceUserID: user.ID,
+				RawSpec:         ct.TestRawBatchSpecYAML,
+			}
+
+			exec, err := svc.CreateBatchSpecExecution(userCtx, opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if exec.ID == 0 {
+				t.Fatalf("BatchSpecExecution ID is 0")
+			}
+
+			if have, want := exec.UserID, user.ID; have != want {
+				t.Fatalf("UserID is %d, want %d", have, want)
+			}
+
+			if have, want := exec.State, btypes.BatchSpecExecutionStateQueued; have != want {
+				t.Fatalf("State is %q, want %q", have, want)
+			}
+		})
+
+		t.Run("invalid spec", func(t *testing.T) {
+			opts := CreateBatchSpecExecutionOpts{
+				NamespaceUserID: user.ID,
+				RawSpec:         `{"name": "bad name with spaces"}`,
+			}
+
+			if _, err := svc.CreateBatchSpecExecution(userCtx, opts); err == nil {
+				t.Fatal("expected error but got none")
+			}
+		})
+
+		t.Run("namespace user is not admin and not creator", func(t *testing.T) {
+			opts := CreateBatchSpecExecutionOpts{
+				NamespaceUserID: admin.ID,
+				RawSpec:         ct.TestRawBatchSpecYAML,
+			}
+
+			_, err := svc.CreateBatchSpecExecution(userCtx, opts)
+			if !errcode.IsUnauthorized(err) {
+				t.Fatalf("expected unauthorized error but got %s", err)
+			}
+		})
+	})
+
 	t.Run("CreateChangesetSpec", func(t *testing.T) {
 		repo := rs[0]
 		rawSpec := ct.NewRawChangesetSpecGitBranch(graphqlbackend.MarshalRepositoryID(repo.ID), "d34db33f")
@@ -0,0 +1,229 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil"
+	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
+)
+
+// BatchSpecExecutionColumns are used by the batch spec execution related Store
+// methods and by the executor queue to query batch spec executions.
+var BatchSpecExecutionColumns = []*sqlf.Query{
+	sqlf.Sprintf("batch_spec_executions.id"),
+	sqlf.Sprintf("batch_spec_executions.rand_id"),
+	sqlf.Sprintf("batch_spec_executions.state"),
+	sqlf.Sprintf("batch_spec_executions.failure_message"),
+	sqlf.Sprintf("batch_spec_executions.started_at"),
+	sqlf.Sprintf("batch_spec_executions.finished_at"),
+	sqlf.Sprintf("batch_spec_executions.process_after"),
+	sqlf.Sprintf("batch_spec_executions.num_resets"),
+	sqlf.Sprintf("batch_spec_executions.num_failures"),
+	sqlf.Sprintf("batch_spec_executions.execution_logs"),
+	sqlf.Sprintf("batch_spec_executions.batch_spec"),
+	sqlf.Sprintf("batch_spec_executions.batch_spec_id"),
+	sqlf.Sprintf("batch_spec_executions.user_id"),
+	sqlf.Sprintf("batch_spec_executions.namespace_user_id"),
+	sqlf.Sprintf("batch_spec_executions.namespace_org_id"),
+	sqlf.Sprintf("batch_spec_executions.created_at"),
+	sqlf.Sprintf("batch_spec_executions.updated_at"),
+}
+
+// batchSpecExecutionInsertColumns is the list of batch_spec_executions columns
+// that are set when inserting batch spec executions.
+var batchSpecExecutionInsertColumns = []*sqlf.Query{
+	sqlf.Sprintf("rand_id"),
+	sqlf.Sprintf("batch_spec"),
+	sqlf.Sprintf("user_id"),
+	sqlf.Sprintf("namespace_user_id"),
+	sqlf.Sprintf("namespace_org_id"),
+	sqlf.Sprintf("created_at"),
+	sqlf.Sprintf("updated_at"),
+}
+
+// CreateBatchSpecExecution creates the given BatchSpecExecution. The execution
+// is queued for processing by an executor.
+func (s *Store) CreateBatchSpecExecution(ctx context.Context, b *btypes.BatchSpecExecution) error {
+	q, err := s.createBatchSpecExecutionQuery(b)
+	if err != nil {
+		return err
+	}
+	return s.query(ctx, q, func(sc scanner) error { return scanBatchSpecExecution(b, sc) })
+}
+
+var createBatchSpecExecutionQueryFmtstr = `
+-- source: enterprise/internal/batches/store/batch_spec_executions.go:CreateBatchSpecExecution
+INSERT INTO batch_spec_executions (%s)
+VALUES (%s, %s, %s, %s, %s, %s, %s)
+RETURNING %s`
+
+func (s *Store) createBatchSpecExecutionQuery(b *btypes.BatchSpecExecution) (*sqlf.Query, error) {
+	if b.CreatedAt.IsZero() {
+		b.CreatedAt = s.now()
+	}
+
+	if b.UpdatedAt.IsZero() {
+		b.UpdatedAt = b.CreatedAt
+	}
+
+	if b.RandID == "" {
+		var err error
+		if b.RandID, err = RandomID(); err != nil {
+			return nil, errors.Wrap(err, "creating RandID failed")
+		}
+	}
+
+	return sqlf.Sprintf(
+		createBatchSpecExecutionQueryFmtstr,
+		sqlf.Join(batchSpecExecutionInsertColumns, ", "),
+		b.RandID,
+		b.BatchSpec,
+		b.UserID,
+		nullInt32Column(b.NamespaceUserID),
+		nullInt32Column(b.NamespaceOrgID),
+		b.CreatedAt,
+		b.UpdatedAt,
+		sqlf.Join(BatchSpecExecutionColumns, ", "),
+	), nil
+}
+
+// GetBatchSpecExecutionOpts captures the query options needed for getting a
+// BatchSpecExecution.
+type GetBatchSpecExecutionOpts struct {
+	ID     int64
+	RandID string
+}
+
+// GetBatchSpecExecution gets a BatchSpecExecution matching the given options.
+func (s *Store) GetBatchSpecExecution(ctx context.Context, opts GetBatchSpecExecutionOpts) (*btypes.BatchSpecExecution, error) {
+	q := getBatchSpecExecutionQuery(&opts)
+
+	var b btypes.BatchSpecExecution
+	err := s.query(ctx, q, func(sc scanner) (err error) {
+		return scanBatchSpecExecution(&b, sc)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if b.ID == 0 {
+		return nil, ErrNoResults
+	}
+
+	return &b, nil
+}
+
+var getBatchSpecExecutionQueryFmtstr = `
+-- source: enterprise/internal/batches/store/batch_spec_executions.go:GetBatchSpecExecution
+SELECT %s FROM batch_spec_executions
+WHERE %s
+LIMIT 1
+`
+
+func getBatchSpecExecutionQuery(opts *GetBatchSpecExecutionOpts) *sqlf.Query {
+	var preds []*sqlf.Query
+	if opts.ID != 0 {
+		preds = append(preds, sqlf.Sprintf("batch_spec_executions.id = %s", opts.ID))
+	}
+
+	if opts.RandID != "" {
+		preds = append(preds, sqlf.Sprintf("batch_spec_executions.rand_id = %s", opts.RandID))
+	}
+
+	if len(preds) == 0 {
+		preds = append(preds, sqlf.Sprintf("TRUE"))
+	}
+
+	return sqlf.Sprintf(
+		getBatchSpecExecutionQueryFmtstr,
+		sqlf.Join(BatchSpecExecutionColumns, ", "),
+		sqlf.Join(preds, "\n AND "),
+	)
+}
+
+// SetBatchSpecExecutionBatchSpec sets the batch spec created by the batch spec
+// execution with the given ID.
+func (s *Store) SetBatchSpecExecutionBatchSpec(ctx context.Context, id, batchSpecID int64) error {
+	return s.Store.Exec(ctx, sqlf.Sprintf(setBatchSpecExecutionBatchSpecQueryFmtstr, batchSpecID, s.now(), id))
+}
+
+var setBatchSpecExecutionBatchSpecQueryFmtstr = `
+-- source: enterprise/internal/batches/store/batch_spec_executions.go:SetBatchSpecExecutionBatchSpec
+UPDATE batch_spec_executions
+SET batch_spec_id = %s, updated_at = %s
+WHERE id = %s
+`
+
+func scanBatchSpecExecution(b *btypes.BatchSpecExecution, sc scanner) error {
+	var (
+		state         string
+		executionLogs []dbworkerstore.ExecutionLogEntry
+	)
+
+	if err := sc.Scan(
+		&b.ID,
+		&b.RandID,
+		&state,
+		&b.FailureMessage,
+		&dbutil.NullTime{Time: &b.StartedAt},
+		&dbutil.NullTime{Time: &b.FinishedAt},
+		&dbutil.NullTime{Time: &b.ProcessAfter},
+		&b.NumResets,
+		&b.NumFailures,
+		pq.Array(&executionLogs),
+		&b.BatchSpec,
+		&dbutil.NullInt64{N: &b.BatchSpecID},
+		&b.UserID,
+		&dbutil.NullInt32{N: &b.NamespaceUserID},
+		&dbutil.NullInt32{N: &b.NamespaceOrgID},
+		&b.CreatedAt,
+		&b.UpdatedAt,
+	); err != nil {
+		return errors.Wrap(err, "scanning batch spec execution")
+	}
+
+	b.State = btypes.BatchSpecExecutionState(strings.ToUpper(state))
+
+	b.ExecutionLogs = nil
+	for _, entry := range executionLogs {
+		b.ExecutionLogs = append(b.ExecutionLogs, workerutil.ExecutionLogEntry(entry))
+	}
+
+	return nil
+}
+
+// ScanFirstBatchSpecExecution scans a slice of batch spec executions from the
+// given rows and returns the first.
+func ScanFirstBatchSpecExecution(rows *sql.Rows, err error) (*btypes.BatchSpecExecution, bool, error) {
+	execs, err := scanBatchSpecExecutions(rows, err)
+	if err != nil || len(execs) == 0 {
+		return nil, false, err
+	}
+	return execs[0], true, nil
+}
+
+func scanBatchSpecExecutions(rows *sql.Rows, queryErr error) ([]*btypes.BatchSpecExecution, error) {
+	if queryErr != nil {
+		return nil, queryErr
+	}
+
+	var execs []*btypes.BatchSpecExecution
+
+	err := scanAll(rows, func(sc scanner) (err error) {
+		var b btypes.BatchSpecExecution
+		if err = scanBatchSpecExecution(&b, sc); err != nil {
+			return err
+		}
+		execs = append(execs, &b)
+		return nil
+	})
+	return execs, err
+}
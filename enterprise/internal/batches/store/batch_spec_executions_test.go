@@ -0,0 +1,117 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	ct "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/testing"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+)
+
+func testStoreBatchSpecExecutions(t *testing.T, ctx context.Context, s *Store, clock ct.Clock) {
+	execs := make([]*btypes.BatchSpecExecution, 0, 2)
+	for i := 0; i < cap(execs); i++ {
+		e := &btypes.BatchSpecExecution{
+			BatchSpec: `name: foobar`,
+			UserID:    int32(i + 123),
+		}
+		if i%2 == 0 {
+			e.NamespaceOrgID = 23
+		} else {
+			e.NamespaceUserID = e.UserID
+		}
+		execs = append(execs, e)
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		for _, e := range execs {
+			if err := s.CreateBatchSpecExecution(ctx, e); err != nil {
+				t.Fatal(err)
+			}
+
+			if e.ID == 0 {
+				t.Fatal("ID should not be zero")
+			}
+			if e.RandID == "" {
+				t.Fatal("RandID should not be empty")
+			}
+
+			want := &btypes.BatchSpecExecution{
+				ID:              e.ID,
+				RandID:          e.RandID,
+				BatchSpec:       `name: foobar`,
+				UserID:          e.UserID,
+				NamespaceUserID: e.NamespaceUserID,
+				NamespaceOrgID:  e.NamespaceOrgID,
+				State:           btypes.BatchSpecExecutionStateQueued,
+				CreatedAt:       clock.Now(),
+				UpdatedAt:       clock.Now(),
+			}
+			if diff := cmp.Diff(want, e); diff != "" {
+				t.Fatal(diff)
+			}
+		}
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		t.Run("GetByID", func(t *testing.T) {
+			for i, want := range execs {
+				have, err := s.GetBatchSpecExecution(ctx, GetBatchSpecExecutionOpts{ID: want.ID})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if diff := cmp.Diff(have, want); diff != "" {
+					t.Fatalf("exec %d: %s", i, diff)
+				}
+			}
+		})
+
+		t.Run("GetByRandID", func(t *testing.T) {
+			for i, want := range execs {
+				have, err := s.GetBatchSpecExecution(ctx, GetBatchSpecExecutionOpts{RandID: want.RandID})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if diff := cmp.Diff(have, want); diff != "" {
+					t.Fatalf("exec %d: %s", i, diff)
+				}
+			}
+		})
+
+		t.Run("NoResults", func(t *testing.T) {
+			opts := GetBatchSpecExecutionOpts{ID: 0xdeadbeef}
+
+			_, have := s.GetBatchSpecExecution(ctx, opts)
+			want := ErrNoResults
+
+			if have != want {
+				t.Fatalf("have err %v, want %v", have, want)
+			}
+		})
+	})
+
+	t.Run("SetBatchSpec", func(t *testing.T) {
+		clock.Add(1 * time.Second)
+
+		e := execs[0]
+		if err := s.SetBatchSpecExecutionBatchSpec(ctx, e.ID, 4567); err != nil {
+			t.Fatal(err)
+		}
+
+		have, err := s.GetBatchSpecExecution(ctx, GetBatchSpecExecutionOpts{ID: e.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have.BatchSpecID != 4567 {
+			t.Fatalf("unexpected batch spec id: have %d, want %d", have.BatchSpecID, 4567)
+		}
+		if !have.UpdatedAt.Equal(clock.Now()) {
+			t.Fatalf("unexpected updated at: have %s, want %s", have.UpdatedAt, clock.Now())
+		}
+	})
+}
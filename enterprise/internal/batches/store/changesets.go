@@ -591,7 +591,7 @@ func listChangesetsQuery(opts *ListChangesetsOpts, authzConds *sqlf.Query) *sqlf
 }
 
 // UpdateChangeset updates the given Changeset.
-func (s *Store) UpdateChangeset(ctx context.Context, cs *btypes.Changeset) error {
+func (s *Store) UpdateChangeset(ctx context.Context, cs *btypes.Changeset) (err error) {
 	cs.UpdatedAt = s.now()
 
 	q, err := s.changesetWriteQuery(updateChangesetQueryFmtstr, true, cs)
@@ -599,13 +599,67 @@ func (s *Store) UpdateChangeset(ctx context.Context, cs *btypes.Changeset) error
 		return err
 	}
 
-	return s.query(ctx, q, func(sc scanner) (err error) {
+	scan := func(sc scanner) (err error) {
 		return scanChangeset(cs, sc)
-	})
+	}
+	if cs.ExternalState != btypes.ChangesetExternalStateMerged {
+		return s.query(ctx, q, scan)
+	}
+
+	// The changeset may have just been merged, in which case we publish an
+	// outbox event in the same transaction as the update.
+	tx, err := s.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	previousState, _, err := basestore.ScanFirstString(tx.Query(ctx, sqlf.Sprintf(lockChangesetExternalStateQueryFmtstr, cs.ID)))
+	if err != nil {
+		return err
+	}
+	if err := tx.query(ctx, q, scan); err != nil {
+		return err
+	}
+	if previousState == string(btypes.ChangesetExternalStateMerged) {
+		return nil
+	}
+	return tx.publishChangesetMerged(ctx, cs)
+}
+
+const lockChangesetExternalStateQueryFmtstr = `
+-- source: enterprise/internal/batches/store.go:UpdateChangeset
+SELECT external_state FROM changesets WHERE id = %s FOR UPDATE
+`
+
+// changesetMergedPayload is the payload of the changeset.merged outbox event.
+type changesetMergedPayload struct {
+	ID             int64   `json:"id"`
+	RepositoryID   int32   `json:"repositoryId"`
+	ExternalID     string  `json:"externalId"`
+	ExternalURL    string  `json:"externalUrl,omitempty"`
+	BatchChangeIDs []int64 `json:"batchChangeIds"`
+}
+
+func (s *Store) publishChangesetMerged(ctx context.Context, cs *btypes.Changeset) error {
+	payload := changesetMergedPayload{
+		ID:             cs.ID,
+		RepositoryID:   int32(cs.RepoID),
+		ExternalID:     cs.ExternalID,
+		BatchChangeIDs: []int64{},
+	}
+	// The URL is only known once the changeset was synced.
+	if url, err := cs.URL(); err == nil {
+		payload.ExternalURL = url
+	}
+	for _, assoc := range cs.BatchChanges {
+		payload.BatchChangeIDs = append(payload.BatchChangeIDs, assoc.BatchChangeID)
+	}
+	return database.OutboxEventsWith(s).Publish(ctx, database.OutboxTopicChangesetMerged, cs.ID, payload)
 }
 
 var updateChangesetQueryFmtstr = `
--- source: enterprise/internal/batches/store_changesets.go:UpdateChangeset
+-- source: enterprise/internal/batches/store.go:UpdateChangeset
 UPDATE changesets
 SET (%s) = (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
 WHERE id = %s
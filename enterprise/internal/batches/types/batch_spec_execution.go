@@ -0,0 +1,74 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/workerutil"
+)
+
+// BatchSpecExecutionState defines the possible states of a batch spec
+// execution.
+type BatchSpecExecutionState string
+
+// BatchSpecExecutionState constants.
+const (
+	BatchSpecExecutionStateQueued     BatchSpecExecutionState = "QUEUED"
+	BatchSpecExecutionStateProcessing BatchSpecExecutionState = "PROCESSING"
+	BatchSpecExecutionStateErrored    BatchSpecExecutionState = "ERRORED"
+	BatchSpecExecutionStateFailed     BatchSpecExecutionState = "FAILED"
+	BatchSpecExecutionStateCompleted  BatchSpecExecutionState = "COMPLETED"
+)
+
+// Valid returns true if the given BatchSpecExecutionState is valid.
+func (s BatchSpecExecutionState) Valid() bool {
+	switch s {
+	case BatchSpecExecutionStateQueued,
+		BatchSpecExecutionStateProcessing,
+		BatchSpecExecutionStateErrored,
+		BatchSpecExecutionStateFailed,
+		BatchSpecExecutionStateCompleted:
+		return true
+	default:
+		return false
+	}
+}
+
+// ToDB returns the database representation of the worker state. That's
+// needed because we want to use UPPERCASE in the application and GraphQL layer,
+// but need to use lowercase in the database to make it work with workerutil.Worker.
+func (s BatchSpecExecutionState) ToDB() string { return strings.ToLower(string(s)) }
+
+// BatchSpecExecution is the server-side execution of a batch spec by an
+// executor. Once the execution completed, BatchSpecID references the batch
+// spec that was created by it.
+type BatchSpecExecution struct {
+	ID     int64
+	RandID string
+
+	// BatchSpec is the raw batch spec that is executed.
+	BatchSpec   string
+	BatchSpecID int64
+
+	UserID          int32
+	NamespaceUserID int32
+	NamespaceOrgID  int32
+
+	// workerutil fields
+
+	State          BatchSpecExecutionState
+	FailureMessage *string
+	StartedAt      time.Time
+	FinishedAt     time.Time
+	ProcessAfter   time.Time
+	NumResets      int64
+	NumFailures    int64
+	ExecutionLogs  []workerutil.ExecutionLogEntry
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (e *BatchSpecExecution) RecordID() int {
+	return int(e.ID)
+}
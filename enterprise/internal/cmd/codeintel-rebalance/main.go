@@ -0,0 +1,92 @@
+// Command codeintel-rebalance moves precise code intelligence data between codeintel database
+// shards so that the data of every upload is held by the shard assigned to it. This command must
+// be run after the codeIntel.databaseShards site configuration setting is changed.
+//
+// The databases are given by repeated -dsn flags: the first is the primary codeintel database and
+// the remaining are the shards listed in codeIntel.databaseShards, in the same order.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/inconshreveable/log15"
+	"github.com/opentracing/opentracing-go"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbconn"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+)
+
+// dsnFlag is a flag that may be supplied multiple times.
+type dsnFlag []string
+
+func (f *dsnFlag) String() string {
+	return strings.Join(*f, ", ")
+}
+
+func (f *dsnFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+var (
+	// Flags
+	dsns    dsnFlag
+	migrate bool
+)
+
+func main() {
+	flag.Var(&dsns, "dsn", "The connection string of a codeintel database shard (repeatable, primary first)")
+	flag.BoolVar(&migrate, "migrate", true, "Whether to migrate each database to the current schema before moving data")
+	flag.Parse()
+
+	if err := rebalance(context.Background()); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func rebalance(ctx context.Context) error {
+	if len(dsns) == 0 {
+		return fmt.Errorf("at least one -dsn flag is required")
+	}
+
+	observationContext := &observation.Context{
+		Logger:     log15.Root(),
+		Tracer:     &trace.Tracer{Tracer: opentracing.GlobalTracer()},
+		Registerer: prometheus.DefaultRegisterer,
+	}
+
+	shards := make([]*lsifstore.Store, 0, len(dsns))
+	for i, dsn := range dsns {
+		db, err := dbconn.NewRaw(dsn)
+		if err != nil {
+			return fmt.Errorf("failed to connect to shard %d: %s", i, err)
+		}
+		defer db.Close()
+
+		if migrate {
+			if err := dbconn.MigrateDB(db, dbconn.CodeIntel); err != nil {
+				return fmt.Errorf("failed to migrate shard %d: %s", i, err)
+			}
+		}
+
+		shards = append(shards, lsifstore.NewStore(db, observationContext))
+	}
+
+	count, err := lsifstore.NewShardedStore(shards...).Rebalance(ctx, func(bundleID, sourceShard, targetShard int) {
+		fmt.Printf("moved upload %d from shard %d to shard %d\n", bundleID, sourceShard, targetShard)
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("moved %d uploads across %d shards\n", count, len(shards))
+	return nil
+}
@@ -11,7 +11,10 @@ import (
 	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/repoupdater/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/inference"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
@@ -21,6 +24,7 @@ type IndexEnqueuer struct {
 	dbStore          DBStore
 	gitserverClient  GitserverClient
 	repoUpdater      RepoUpdaterClient
+	httpClient       httpcli.Doer
 	maxJobsPerCommit int
 	operations       *operations
 }
@@ -32,11 +36,16 @@ func NewIndexEnqueuer(dbStore DBStore, gitClient GitserverClient, repoUpdater Re
 		dbStore:          dbStore,
 		gitserverClient:  gitClient,
 		repoUpdater:      repoUpdater,
+		httpClient:       httpcli.ExternalDoer(),
 		maxJobsPerCommit: defaultMaxJobsPerCommit,
 		operations:       newOperations(observationContext),
 	}
 }
 
+// ErrCloneInProgress is returned by QueueIndexesForPackage when the repository of the
+// package is still being cloned. The caller should retry once the clone has finished.
+var ErrCloneInProgress = errors.New("repository clone in progress")
+
 // QueueIndexesForRepository attempts to queue an index for the lastest commit on the default branch of the given
 // repository. If this repository and commit already has an index or upload record associated with it, this method
 // does nothing.
@@ -78,7 +87,9 @@ func (s *IndexEnqueuer) InferIndexConfiguration(ctx context.Context, repositoryI
 }
 
 // QueueIndexesForPackage enqueues index jobs for a dependency of a recently-processed precise code intelligence
-// index. Currently we only support recognition of "gomod" import monikers.
+// index. Currently we only support recognition of "gomod" import monikers. Repositories that are not yet known to
+// the instance are added if the code host permits it, and ErrCloneInProgress is returned while the repository is
+// being cloned.
 func (s *IndexEnqueuer) QueueIndexesForPackage(ctx context.Context, pkg semantic.Package) (err error) {
 	ctx, traceLog, endObservation := s.operations.QueueIndexForPackage.WithAndLogger(ctx, &err, observation.Args{
 		LogFields: []log.Field{
@@ -91,11 +102,23 @@ func (s *IndexEnqueuer) QueueIndexesForPackage(ctx context.Context, pkg semantic
 
 	repoName, revision, ok := InferGoRepositoryAndRevision(pkg)
 	if !ok {
-		return nil
+		if repoName, revision, ok, err = InferGoVanityRepositoryAndRevision(ctx, s.httpClient, pkg); err != nil || !ok {
+			return err
+		}
 	}
 	traceLog(log.String("repoName", repoName))
 	traceLog(log.String("revision", revision))
 
+	// Look up the repository before scheduling an update so that a repository which is not yet
+	// on the instance is added from its code host (e.g. public repositories on Sourcegraph.com).
+	lookup, err := s.repoUpdater.RepoLookup(ctx, protocol.RepoLookupArgs{Repo: api.RepoName(repoName)})
+	if err != nil {
+		return errors.Wrap(err, "repoUpdater.RepoLookup")
+	}
+	if lookup == nil || lookup.Repo == nil {
+		return nil
+	}
+
 	resp, err := s.repoUpdater.EnqueueRepoUpdate(ctx, api.RepoName(repoName))
 	if err != nil {
 		if isNotFoundError(err) {
@@ -107,6 +130,9 @@ func (s *IndexEnqueuer) QueueIndexesForPackage(ctx context.Context, pkg semantic
 
 	commit, err := s.gitserverClient.ResolveRevision(ctx, int(resp.ID), revision)
 	if err != nil {
+		if isCloneInProgressError(err) {
+			return ErrCloneInProgress
+		}
 		if isNotFoundError(err) {
 			return nil
 		}
@@ -165,6 +191,13 @@ func (s *IndexEnqueuer) queueIndexForRepositoryAndCommit(ctx context.Context, re
 	}
 	traceLog(log.Int("numIndexes", len(indexes)))
 
+	if force {
+		// Index jobs forced by a user are processed ahead of scheduled index jobs
+		for i := range indexes {
+			indexes[i].Interactive = true
+		}
+	}
+
 	return s.queueIndexes(ctx, repositoryID, commit, indexes)
 }
 
@@ -240,3 +273,13 @@ func isNotFoundError(err error) bool {
 
 	return false
 }
+
+func isCloneInProgressError(err error) bool {
+	for ex := err; ex != nil; ex = errors.Unwrap(ex) {
+		if vcs.IsCloneInProgress(ex) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -7,12 +7,14 @@ import (
 	"sort"
 	"testing"
 
+	"github.com/cockroachdb/errors"
 	"github.com/google/go-cmp/cmp"
 
 	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/repoupdater/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
 )
 
@@ -173,6 +175,63 @@ index_jobs:
     outfile: lsif.dump
 `)
 
+func TestForceQueueIndexesForRepository(t *testing.T) {
+	indexConfiguration := store.IndexConfiguration{
+		ID:           1,
+		RepositoryID: 42,
+		Data: []byte(`{
+			"index_jobs": [
+				{
+					"indexer": "lsif-go",
+					"indexer_args": ["--no-animation"],
+				},
+			]
+		}`),
+	}
+
+	mockDBStore := NewMockDBStore()
+	mockDBStore.TransactFunc.SetDefaultReturn(mockDBStore, nil)
+	mockDBStore.DoneFunc.SetDefaultHook(func(err error) error { return err })
+	mockDBStore.IsQueuedFunc.SetDefaultReturn(true, nil)
+	mockDBStore.GetIndexConfigurationByRepositoryIDFunc.SetDefaultReturn(indexConfiguration, true, nil)
+
+	mockGitserverClient := NewMockGitserverClient()
+	mockGitserverClient.HeadFunc.SetDefaultHook(func(ctx context.Context, repositoryID int) (string, error) {
+		return fmt.Sprintf("c%d", repositoryID), nil
+	})
+
+	scheduler := &IndexEnqueuer{
+		dbStore:          mockDBStore,
+		gitserverClient:  mockGitserverClient,
+		maxJobsPerCommit: defaultMaxJobsPerCommit,
+		operations:       newOperations(&observation.TestContext),
+	}
+
+	if err := scheduler.ForceQueueIndexesForRepository(context.Background(), 42); err != nil {
+		t.Fatalf("unexpected error queueing indexes: %s", err)
+	}
+
+	if len(mockDBStore.IsQueuedFunc.History()) != 0 {
+		t.Errorf("unexpected number of calls to IsQueued. want=%d have=%d", 0, len(mockDBStore.IsQueuedFunc.History()))
+	}
+
+	if len(mockDBStore.InsertIndexFunc.History()) != 1 {
+		t.Errorf("unexpected number of calls to InsertIndex. want=%d have=%d", 1, len(mockDBStore.InsertIndexFunc.History()))
+	} else {
+		expectedIndex := store.Index{
+			RepositoryID: 42,
+			Commit:       "c42",
+			State:        "queued",
+			Indexer:      "lsif-go",
+			IndexerArgs:  []string{"--no-animation"},
+			Interactive:  true,
+		}
+		if diff := cmp.Diff(expectedIndex, mockDBStore.InsertIndexFunc.History()[0].Arg1); diff != "" {
+			t.Errorf("unexpected index (-want +got):\n%s", diff)
+		}
+	}
+}
+
 func TestQueueIndexesForRepositoryInRepository(t *testing.T) {
 	mockDBStore := NewMockDBStore()
 	mockDBStore.TransactFunc.SetDefaultReturn(mockDBStore, nil)
@@ -273,6 +332,8 @@ func TestQueueIndexesForRepositoryInferred(t *testing.T) {
 		{RepositoryID: 43},
 		{RepositoryID: 44},
 	}, nil)
+	// Previously inferred configuration is re-inferred
+	mockDBStore.GetIndexConfigurationByRepositoryIDFunc.SetDefaultReturn(store.IndexConfiguration{Data: []byte(`{"index_jobs": []}`), Inferred: true}, true, nil)
 
 	mockGitserverClient := NewMockGitserverClient()
 	mockGitserverClient.HeadFunc.SetDefaultHook(func(ctx context.Context, repositoryID int) (string, error) {
@@ -336,6 +397,16 @@ func TestQueueIndexesForRepositoryInferred(t *testing.T) {
 	if len(mockDBStore.UpdateIndexableRepositoryFunc.History()) != 2 {
 		t.Errorf("unexpected number of calls to UpdateIndexableRepository. want=%d have=%d", 2, len(mockDBStore.UpdateIndexableRepositoryFunc.History()))
 	}
+
+	var inferredRepositoryIDs []int
+	for _, call := range mockDBStore.UpdateInferredIndexConfigurationByRepositoryIDFunc.History() {
+		inferredRepositoryIDs = append(inferredRepositoryIDs, call.Arg1)
+	}
+	sort.Ints(inferredRepositoryIDs)
+
+	if diff := cmp.Diff([]int{42, 44}, inferredRepositoryIDs); diff != "" {
+		t.Errorf("unexpected repositories with inferred index configuration (-want +got):\n%s", diff)
+	}
 }
 
 func TestQueueIndexesForRepositoryInferredTooLarge(t *testing.T) {
@@ -396,6 +467,12 @@ func TestQueueIndexesForPackage(t *testing.T) {
 	mockGitserverClient.ListFilesFunc.SetDefaultReturn([]string{"go.mod"}, nil)
 
 	mockRepoUpdater := NewMockRepoUpdaterClient()
+	mockRepoUpdater.RepoLookupFunc.SetDefaultHook(func(ctx context.Context, args protocol.RepoLookupArgs) (*protocol.RepoLookupResult, error) {
+		if args.Repo != "github.com/sourcegraph/sourcegraph" {
+			t.Errorf("unexpected repo %v supplied to RepoLookup", args.Repo)
+		}
+		return &protocol.RepoLookupResult{Repo: &protocol.RepoInfo{Name: args.Repo}}, nil
+	})
 	mockRepoUpdater.EnqueueRepoUpdateFunc.SetDefaultHook(func(ctx context.Context, repoName api.RepoName) (*protocol.RepoUpdateResponse, error) {
 		if repoName != "github.com/sourcegraph/sourcegraph" {
 			t.Errorf("unexpected repo %v supplied to EnqueueRepoUpdate", repoName)
@@ -459,3 +536,60 @@ func TestQueueIndexesForPackage(t *testing.T) {
 		}
 	}
 }
+
+func TestQueueIndexesForPackageUnknownRepository(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	mockGitserverClient := NewMockGitserverClient()
+	mockRepoUpdater := NewMockRepoUpdaterClient()
+	mockRepoUpdater.RepoLookupFunc.SetDefaultReturn(&protocol.RepoLookupResult{ErrorNotFound: true}, nil)
+
+	scheduler := &IndexEnqueuer{
+		dbStore:          mockDBStore,
+		gitserverClient:  mockGitserverClient,
+		repoUpdater:      mockRepoUpdater,
+		maxJobsPerCommit: defaultMaxJobsPerCommit,
+		operations:       newOperations(&observation.TestContext),
+	}
+
+	if err := scheduler.QueueIndexesForPackage(context.Background(), semantic.Package{
+		Scheme:  "gomod",
+		Name:    "https://github.com/sourcegraph/sourcegraph",
+		Version: "v3.26.0",
+	}); err != nil {
+		t.Fatalf("unexpected error queueing indexes: %s", err)
+	}
+
+	if len(mockRepoUpdater.EnqueueRepoUpdateFunc.History()) != 0 {
+		t.Errorf("unexpected number of calls to EnqueueRepoUpdate. want=%d have=%d", 0, len(mockRepoUpdater.EnqueueRepoUpdateFunc.History()))
+	}
+}
+
+func TestQueueIndexesForPackageCloneInProgress(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	mockGitserverClient := NewMockGitserverClient()
+	mockGitserverClient.ResolveRevisionFunc.SetDefaultReturn("", errors.Wrap(&vcs.RepoNotExistError{Repo: "github.com/sourcegraph/sourcegraph", CloneInProgress: true}, "git.ResolveRevision"))
+	mockRepoUpdater := NewMockRepoUpdaterClient()
+	mockRepoUpdater.RepoLookupFunc.SetDefaultReturn(&protocol.RepoLookupResult{Repo: &protocol.RepoInfo{Name: "github.com/sourcegraph/sourcegraph"}}, nil)
+	mockRepoUpdater.EnqueueRepoUpdateFunc.SetDefaultReturn(&protocol.RepoUpdateResponse{ID: 42}, nil)
+
+	scheduler := &IndexEnqueuer{
+		dbStore:          mockDBStore,
+		gitserverClient:  mockGitserverClient,
+		repoUpdater:      mockRepoUpdater,
+		maxJobsPerCommit: defaultMaxJobsPerCommit,
+		operations:       newOperations(&observation.TestContext),
+	}
+
+	err := scheduler.QueueIndexesForPackage(context.Background(), semantic.Package{
+		Scheme:  "gomod",
+		Name:    "https://github.com/sourcegraph/sourcegraph",
+		Version: "v3.26.0",
+	})
+	if !errors.Is(err, ErrCloneInProgress) {
+		t.Fatalf("unexpected error queueing indexes. want=%q have=%q", ErrCloneInProgress, err)
+	}
+
+	if len(mockDBStore.InsertIndexFunc.History()) != 0 {
+		t.Errorf("unexpected number of calls to InsertIndex. want=%d have=%d", 0, len(mockDBStore.InsertIndexFunc.History()))
+	}
+}
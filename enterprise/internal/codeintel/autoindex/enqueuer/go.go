@@ -1,8 +1,15 @@
 package enqueuer
 
 import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
 )
@@ -11,6 +18,10 @@ const GitHubScheme = "https://"
 
 var goVersionPattern = lazyregexp.New(`^v?[\d\.]+-([a-f0-9]+)`)
 
+// goPseudoVersionPattern matches module pseudo-versions such as v0.0.0-20210601163436-de0123456789
+// or v1.38.1-0.20210601163436-de0123456789, capturing the abbreviated commit.
+var goPseudoVersionPattern = lazyregexp.New(`^v\d+\.\d+\.\d+-(?:[0-9A-Za-z.]+\.)?\d{14}-([a-f0-9]{12})(?:\+incompatible)?$`)
+
 func InferGoRepositoryAndRevision(pkg semantic.Package) (repoName, gitTagOrCommit string, ok bool) {
 	if pkg.Scheme != "gomod" || !strings.HasPrefix(pkg.Name, GitHubScheme+"github.com/") {
 		return "", "", false
@@ -21,10 +32,77 @@ func InferGoRepositoryAndRevision(pkg semantic.Package) (repoName, gitTagOrCommi
 		repoParts = repoParts[:3]
 	}
 
-	version := pkg.Version
+	return strings.Join(repoParts, "/"), inferGoRevision(pkg.Version), true
+}
+
+// maxGoImportResponseSize is the number of bytes of a go-get response that are searched
+// for go-import meta tags.
+const maxGoImportResponseSize = 1024 * 1024
+
+// goImportMetaPattern matches the go-import meta tags served to `go get`, which contain
+// the import prefix, the VCS, and the URL of the repository root.
+var goImportMetaPattern = lazyregexp.New(`<meta\s+name=["']go-import["']\s+content=["']([^"']+)["']`)
+
+// InferGoVanityRepositoryAndRevision determines the repository of a gomod package that is
+// served from a vanity import path (e.g. gopkg.in/yaml.v2 or google.golang.org/grpc) by
+// requesting its go-import meta tag the same way `go get` does. The returned repository
+// name is the host and path of the git repository root.
+func InferGoVanityRepositoryAndRevision(ctx context.Context, doer httpcli.Doer, pkg semantic.Package) (repoName, gitTagOrCommit string, ok bool, err error) {
+	if pkg.Scheme != "gomod" || !strings.HasPrefix(pkg.Name, GitHubScheme) {
+		return "", "", false, nil
+	}
+	importPath := pkg.Name[len(GitHubScheme):]
+
+	req, err := http.NewRequest("GET", pkg.Name+"?go-get=1", nil)
+	if err != nil {
+		return "", "", false, nil
+	}
+
+	resp, err := doer.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", "", false, errors.Wrap(err, "requesting go-import meta tag")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", "", false, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxGoImportResponseSize))
+	if err != nil {
+		return "", "", false, errors.Wrap(err, "reading go-import meta tag")
+	}
+
+	for _, match := range goImportMetaPattern.FindAllStringSubmatch(string(body), -1) {
+		fields := strings.Fields(match[1])
+		if len(fields) != 3 || fields[1] != "git" {
+			continue
+		}
+		if prefix := fields[0]; importPath != prefix && !strings.HasPrefix(importPath, prefix+"/") {
+			continue
+		}
+
+		repoURL, err := url.Parse(fields[2])
+		if err != nil || repoURL.Host == "" {
+			continue
+		}
+
+		repoName := repoURL.Host + strings.TrimSuffix(strings.TrimSuffix(repoURL.Path, "/"), ".git")
+		return repoName, inferGoRevision(pkg.Version), true, nil
+	}
+
+	return "", "", false, nil
+}
+
+// inferGoRevision returns the commit of a Go pseudo-version, or the given version if it
+// names a tag.
+func inferGoRevision(version string) string {
+	if match := goPseudoVersionPattern.FindStringSubmatch(version); len(match) > 0 {
+		return match[1]
+	}
 	if match := goVersionPattern.FindAllStringSubmatch(version, 1); len(match) > 0 {
-		version = match[0][1]
+		return match[0][1]
 	}
 
-	return strings.Join(repoParts, "/"), version, true
+	return version
 }
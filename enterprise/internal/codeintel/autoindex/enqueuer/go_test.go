@@ -1,8 +1,13 @@
 package enqueuer
 
 import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
 	"testing"
 
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
 )
 
@@ -39,6 +44,24 @@ func TestInferGoRepositoryAndRevision(t *testing.T) {
 			repoName: "github.com/sourcegraph/sourcegraph",
 			revision: "de0123456789",
 		},
+		{
+			pkg: semantic.Package{
+				Scheme:  "gomod",
+				Name:    "https://github.com/sourcegraph/sourcegraph",
+				Version: "v0.0.0-20210601163436-de0123456789",
+			},
+			repoName: "github.com/sourcegraph/sourcegraph",
+			revision: "de0123456789",
+		},
+		{
+			pkg: semantic.Package{
+				Scheme:  "gomod",
+				Name:    "https://github.com/sourcegraph/sourcegraph",
+				Version: "v3.31.1-0.20210601163436-de0123456789+incompatible",
+			},
+			repoName: "github.com/sourcegraph/sourcegraph",
+			revision: "de0123456789",
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -55,3 +78,90 @@ func TestInferGoRepositoryAndRevision(t *testing.T) {
 		}
 	}
 }
+
+func TestInferGoVanityRepositoryAndRevision(t *testing.T) {
+	pages := map[string]string{
+		"https://gopkg.in/yaml.v2?go-get=1": `<html><head>
+<meta name="go-import" content="gopkg.in/yaml.v2 git https://gopkg.in/yaml.v2">
+<meta name="go-source" content="gopkg.in/yaml.v2 _ https://github.com/go-yaml/yaml/tree/v2.4.0{/dir} https://github.com/go-yaml/yaml/blob/v2.4.0{/dir}/{file}#L{line}">
+</head></html>`,
+		"https://google.golang.org/grpc/codes?go-get=1": `<html><head>
+<meta name="go-import" content="google.golang.org/grpc git https://github.com/grpc/grpc-go.git">
+</head></html>`,
+		"https://example.com/hg?go-get=1": `<meta name="go-import" content="example.com/hg hg https://example.com/hg">`,
+	}
+
+	doer := httpcli.DoerFunc(func(req *http.Request) (*http.Response, error) {
+		page, ok := pages[req.URL.String()]
+		if !ok {
+			return &http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(strings.NewReader(""))}, nil
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(page))}, nil
+	})
+
+	testCases := []struct {
+		pkg      semantic.Package
+		ok       bool
+		repoName string
+		revision string
+	}{
+		{
+			pkg: semantic.Package{
+				Scheme:  "gomod",
+				Name:    "https://gopkg.in/yaml.v2",
+				Version: "v2.4.0",
+			},
+			ok:       true,
+			repoName: "gopkg.in/yaml.v2",
+			revision: "v2.4.0",
+		},
+		{
+			pkg: semantic.Package{
+				Scheme:  "gomod",
+				Name:    "https://google.golang.org/grpc/codes",
+				Version: "v1.38.1-0.20210601163436-de0123456789",
+			},
+			ok:       true,
+			repoName: "github.com/grpc/grpc-go",
+			revision: "de0123456789",
+		},
+		{
+			pkg: semantic.Package{
+				Scheme:  "gomod",
+				Name:    "https://example.com/hg",
+				Version: "v1.0.0",
+			},
+		},
+		{
+			pkg: semantic.Package{
+				Scheme:  "gomod",
+				Name:    "https://example.com/missing",
+				Version: "v1.0.0",
+			},
+		},
+		{
+			pkg: semantic.Package{
+				Scheme:  "npm",
+				Name:    "https://gopkg.in/yaml.v2",
+				Version: "v2.4.0",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		repoName, revision, ok, err := InferGoVanityRepositoryAndRevision(context.Background(), doer, testCase.pkg)
+		if err != nil {
+			t.Fatalf("unexpected error inferring repository: %s", err)
+		}
+		if ok != testCase.ok {
+			t.Fatalf("unexpected inference result for %q. want=%v have=%v", testCase.pkg.Name, testCase.ok, ok)
+		}
+
+		if repoName != testCase.repoName {
+			t.Errorf("unexpected repo name. want=%q have=%q", testCase.repoName, repoName)
+		}
+		if revision != testCase.revision {
+			t.Errorf("unexpected revision. want=%q have=%q", testCase.revision, revision)
+		}
+	}
+}
@@ -27,6 +27,7 @@ type DBStore interface {
 	RepoUsageStatistics(ctx context.Context) ([]dbstore.RepoUsageStatistics, error)
 	GetRepositoriesWithIndexConfiguration(ctx context.Context) ([]int, error)
 	GetIndexConfigurationByRepositoryID(ctx context.Context, repositoryID int) (dbstore.IndexConfiguration, bool, error)
+	UpdateInferredIndexConfigurationByRepositoryID(ctx context.Context, repositoryID int, data []byte) error
 }
 
 type DBStoreShim struct {
@@ -45,6 +46,7 @@ var _ DBStore = &DBStoreShim{}
 
 type RepoUpdaterClient interface {
 	EnqueueRepoUpdate(ctx context.Context, repo api.RepoName) (*protocol.RepoUpdateResponse, error)
+	RepoLookup(ctx context.Context, args protocol.RepoLookupArgs) (*protocol.RepoLookupResult, error)
 }
 
 type GitserverClient interface {
@@ -36,12 +36,14 @@ func (s *IndexEnqueuer) getIndexRecords(ctx context.Context, repositoryID int, c
 
 // getIndexRecordsFromConfigurationInDatabase returns a set of index jobs configured via the UI for
 // the given repository. If no jobs are configured via the UI then a false valued flag is returned.
+// Configuration previously inferred by the auto-indexer is ignored so that it is re-inferred from
+// the repository structure at the given commit.
 func (s *IndexEnqueuer) getIndexRecordsFromConfigurationInDatabase(ctx context.Context, repositoryID int, commit string) ([]store.Index, bool, error) {
 	indexConfigurationRecord, ok, err := s.dbStore.GetIndexConfigurationByRepositoryID(ctx, repositoryID)
 	if err != nil {
 		return nil, false, errors.Wrap(err, "dbstore.GetIndexConfigurationByRepositoryID")
 	}
-	if !ok {
+	if !ok || indexConfigurationRecord.Inferred {
 		return nil, false, nil
 	}
 
@@ -88,13 +90,23 @@ func (s *IndexEnqueuer) getIndexRecordsFromConfigurationInRepository(ctx context
 
 // inferIndexRecordsFromRepositoryStructure looks at the repository contents at the given commit and
 // determines a set of index jobs that are likely to succeed. If no jobs could be inferred then a
-// false valued flag is returned.
+// false valued flag is returned. The inferred configuration is recorded in the database so that it
+// can be inspected (and replaced) by a user.
 func (s *IndexEnqueuer) inferIndexRecordsFromRepositoryStructure(ctx context.Context, repositoryID int, commit string) ([]store.Index, bool, error) {
 	indexJobs, err := s.inferIndexJobsFromRepositoryStructure(ctx, repositoryID, commit)
 	if err != nil || len(indexJobs) == 0 {
 		return nil, false, err
 	}
 
+	data, err := config.MarshalJSON(config.IndexConfiguration{IndexJobs: indexJobs})
+	if err != nil {
+		return nil, false, err
+	}
+
+	if err := s.dbStore.UpdateInferredIndexConfigurationByRepositoryID(ctx, repositoryID, data); err != nil {
+		return nil, false, errors.Wrap(err, "dbstore.UpdateInferredIndexConfigurationByRepositoryID")
+	}
+
 	return convertInferredConfiguration(repositoryID, commit, indexJobs), true, nil
 }
 
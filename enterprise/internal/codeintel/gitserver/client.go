@@ -6,11 +6,14 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/opentracing/opentracing-go/log"
+	"github.com/sourcegraph/go-diff/diff"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
@@ -77,6 +80,29 @@ func (c *Client) CommitDate(ctx context.Context, repositoryID int, commit string
 	return time.Parse(time.RFC3339, strings.TrimSpace(out))
 }
 
+// CommitCount returns the number of commits reachable from the commit to but not reachable from
+// the commit from. If from is empty, all ancestors of the commit to are counted.
+func (c *Client) CommitCount(ctx context.Context, repositoryID int, from, to string) (_ int, err error) {
+	ctx, endObservation := c.operations.commitCount.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("repositoryID", repositoryID),
+		log.String("from", from),
+		log.String("to", to),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	revisionRange := to
+	if from != "" {
+		revisionRange = fmt.Sprintf("%s..%s", from, to)
+	}
+
+	out, err := c.execResolveRevGitCommand(ctx, repositoryID, to, "rev-list", "--count", revisionRange)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.Atoi(strings.TrimSpace(out))
+}
+
 type CommitGraph struct {
 	graph map[string][]string
 	order []string
@@ -174,6 +200,70 @@ func ParseCommitGraph(pair []string) *CommitGraph {
 	}
 }
 
+// RefType describes the type of a git ref.
+type RefType int
+
+const (
+	RefTypeUnknown RefType = iota
+	RefTypeBranch
+	RefTypeTag
+)
+
+// RefDescription describes a branch or tag of a repository.
+type RefDescription struct {
+	Name string
+	Type RefType
+}
+
+// RefDescriptions returns a map from commits to descriptions of the branches and tags whose tip
+// is that commit for the given repository. Annotated tags are mapped to the commit they reference.
+func (c *Client) RefDescriptions(ctx context.Context, repositoryID int) (_ map[string][]RefDescription, err error) {
+	ctx, endObservation := c.operations.refDescriptions.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("repositoryID", repositoryID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	out, err := c.execGitCommand(ctx, repositoryID, "for-each-ref", "--format=%(refname) %(objectname) %(*objectname)", "refs/heads", "refs/tags")
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseRefDescriptions(strings.Split(out, "\n")), nil
+}
+
+// ParseRefDescriptions converts the output of git for-each-ref (formatted as the ref name, the
+// object name, and the peeled object name of annotated tags) into a map from commits to the
+// descriptions of the branches and tags whose tip is that commit.
+func ParseRefDescriptions(lines []string) map[string][]RefDescription {
+	refDescriptions := map[string][]RefDescription{}
+	for _, line := range lines {
+		parts := strings.Fields(line)
+		if len(parts) < 2 {
+			continue
+		}
+
+		refName, commit := parts[0], parts[1]
+		if len(parts) > 2 {
+			// Use the commit an annotated tag points to rather than the tag object
+			commit = parts[2]
+		}
+
+		var refDescription RefDescription
+		switch {
+		case strings.HasPrefix(refName, "refs/heads/"):
+			refDescription = RefDescription{Name: strings.TrimPrefix(refName, "refs/heads/"), Type: RefTypeBranch}
+		case strings.HasPrefix(refName, "refs/tags/"):
+			refDescription = RefDescription{Name: strings.TrimPrefix(refName, "refs/tags/"), Type: RefTypeTag}
+		default:
+			continue
+		}
+
+		refDescriptions[commit] = append(refDescriptions[commit], refDescription)
+	}
+
+	return refDescriptions
+}
+
 // RawContents returns the contents of a file in a particular commit of a repository.
 func (c *Client) RawContents(ctx context.Context, repositoryID int, commit, file string) (_ []byte, err error) {
 	ctx, endObservation := c.operations.rawContents.With(ctx, &err, observation.Args{LogFields: []log.Field{
@@ -264,6 +354,108 @@ func (c *Client) ListFiles(ctx context.Context, repositoryID int, commit string,
 	return matching, nil
 }
 
+// DiffRequest identifies the changes made to a single path between two commits.
+type DiffRequest struct {
+	SourceCommit string
+	TargetCommit string
+	Path         string
+}
+
+// MultiDiff returns the position-ordered hunks of each of the given requests, in the order of the
+// requests. A request for a path that did not change between its commits has no hunks. Requests that
+// share a source and target commit are answered by a single git diff invocation, and the invocations
+// for distinct pairs of commits are issued concurrently, so that all of the diffs required to answer
+// a code intelligence request are fetched in approximately one round trip to gitserver.
+func (c *Client) MultiDiff(ctx context.Context, repositoryID int, requests []DiffRequest) (_ [][]*diff.Hunk, err error) {
+	ctx, endObservation := c.operations.multiDiff.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("repositoryID", repositoryID),
+		log.Int("numRequests", len(requests)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	type commitPair struct {
+		sourceCommit string
+		targetCommit string
+	}
+
+	pairIndexes := map[commitPair]int{}
+	var pairs []commitPair
+	var pathsByPair [][]string
+	for _, request := range requests {
+		pair := commitPair{sourceCommit: request.SourceCommit, targetCommit: request.TargetCommit}
+
+		index, ok := pairIndexes[pair]
+		if !ok {
+			index = len(pairs)
+			pairIndexes[pair] = index
+			pairs = append(pairs, pair)
+			pathsByPair = append(pathsByPair, nil)
+		}
+
+		pathsByPair[index] = append(pathsByPair[index], request.Path)
+	}
+
+	hunksByPair := make([]map[string][]*diff.Hunk, len(pairs))
+	g, gctx := errgroup.WithContext(ctx)
+	for i, pair := range pairs {
+		i, pair := i, pair
+
+		g.Go(func() error {
+			args := append([]string{"diff", pair.sourceCommit, pair.targetCommit, "--"}, pathsByPair[i]...)
+
+			out, err := c.execResolveRevGitCommand(gctx, repositoryID, pair.targetCommit, args...)
+			if err != nil {
+				return err
+			}
+
+			hunks, err := ParseDiffHunks(out)
+			if err != nil {
+				return err
+			}
+
+			hunksByPair[i] = hunks
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	hunks := make([][]*diff.Hunk, 0, len(requests))
+	for _, request := range requests {
+		index := pairIndexes[commitPair{sourceCommit: request.SourceCommit, targetCommit: request.TargetCommit}]
+		hunks = append(hunks, hunksByPair[index][request.Path])
+	}
+
+	return hunks, nil
+}
+
+// ParseDiffHunks parses the output of a git diff invocation spanning any number of files into a map
+// from the path of each changed file to its position-ordered hunks.
+func ParseDiffHunks(out string) (map[string][]*diff.Hunk, error) {
+	if out == "" {
+		return nil, nil
+	}
+
+	// Output is trimmed by execGitCommand, but the parser expects every line to be terminated
+	fileDiffs, err := diff.ParseMultiFileDiff([]byte(out + "\n"))
+	if err != nil {
+		return nil, errors.Wrap(err, "diff.ParseMultiFileDiff")
+	}
+
+	hunks := make(map[string][]*diff.Hunk, len(fileDiffs))
+	for _, fileDiff := range fileDiffs {
+		name := fileDiff.NewName
+		if name == "/dev/null" {
+			name = fileDiff.OrigName
+		}
+
+		hunks[strings.TrimPrefix(strings.TrimPrefix(name, "a/"), "b/")] = fileDiff.Hunks
+	}
+
+	return hunks, nil
+}
+
 // ResolveRevision returns the absolute commit for a commit-ish spec.
 func (c *Client) ResolveRevision(ctx context.Context, repositoryID int, versionString string) (commitID api.CommitID, err error) {
 	ctx, endObservation := c.operations.resolveRevision.With(ctx, &err, observation.Args{LogFields: []log.Field{
@@ -1,6 +1,7 @@
 package gitserver
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -115,3 +116,71 @@ func TestParseCommitGraphPartial(t *testing.T) {
 		t.Errorf("unexpected commit order (-want +got):\n%s", diff)
 	}
 }
+
+func TestParseRefDescriptions(t *testing.T) {
+	refDescriptions := ParseRefDescriptions([]string{
+		"refs/heads/main 9ad62c7ec68e377b41a8b8dd846e573b76634172",
+		"refs/heads/release/1.0 683cafd122632142bda6e36563f5719e5b0fa37d",
+		"refs/tags/v1.0.0 683cafd122632142bda6e36563f5719e5b0fa37d",
+		"refs/tags/v0.9.0 1afa9c06d8bb8b2c5746e539ed4eb80c23b21db3 02f41985f46b400b7a673c3dfb6bab8fd1ac6a6d",
+		"refs/remotes/origin/main a94fb112d1f2e70f55851c6c569916a9e31caee1",
+		"",
+	})
+
+	expectedRefDescriptions := map[string][]RefDescription{
+		"9ad62c7ec68e377b41a8b8dd846e573b76634172": {
+			{Name: "main", Type: RefTypeBranch},
+		},
+		"683cafd122632142bda6e36563f5719e5b0fa37d": {
+			{Name: "release/1.0", Type: RefTypeBranch},
+			{Name: "v1.0.0", Type: RefTypeTag},
+		},
+		"02f41985f46b400b7a673c3dfb6bab8fd1ac6a6d": {
+			{Name: "v0.9.0", Type: RefTypeTag},
+		},
+	}
+	if diff := cmp.Diff(expectedRefDescriptions, refDescriptions); diff != "" {
+		t.Errorf("unexpected ref descriptions (-want +got):\n%s", diff)
+	}
+}
+
+func TestParseDiffHunks(t *testing.T) {
+	hunks, err := ParseDiffHunks(strings.Join([]string{
+		"diff --git a/foo.go b/foo.go",
+		"index 2ab32b4..b5f6b39 100644",
+		"--- a/foo.go",
+		"+++ b/foo.go",
+		"@@ -1,2 +1,3 @@ package foo",
+		" package foo",
+		"+",
+		" func Foo() {}",
+		"diff --git a/sub/bar.go b/sub/bar.go",
+		"deleted file mode 100644",
+		"index 65e2d20..0000000",
+		"--- a/sub/bar.go",
+		"+++ /dev/null",
+		"@@ -1 +0,0 @@",
+		"-package bar",
+	}, "\n"))
+	if err != nil {
+		t.Fatalf("unexpected error parsing diff: %s", err)
+	}
+
+	type hunkSummary struct {
+		OrigStartLine, OrigLines, NewStartLine, NewLines int32
+	}
+	summaries := map[string][]hunkSummary{}
+	for path, fileHunks := range hunks {
+		for _, hunk := range fileHunks {
+			summaries[path] = append(summaries[path], hunkSummary{hunk.OrigStartLine, hunk.OrigLines, hunk.NewStartLine, hunk.NewLines})
+		}
+	}
+
+	expectedSummaries := map[string][]hunkSummary{
+		"foo.go":     {{1, 2, 1, 3}},
+		"sub/bar.go": {{1, 1, 0, 0}},
+	}
+	if diff := cmp.Diff(expectedSummaries, summaries); diff != "" {
+		t.Errorf("unexpected hunks (-want +got):\n%s", diff)
+	}
+}
@@ -10,6 +10,7 @@ import (
 )
 
 type operations struct {
+	commitCount       *observation.Operation
 	commitDate        *observation.Operation
 	commitGraph       *observation.Operation
 	directoryChildren *observation.Operation
@@ -17,7 +18,9 @@ type operations struct {
 	commitExists      *observation.Operation
 	head              *observation.Operation
 	listFiles         *observation.Operation
+	multiDiff         *observation.Operation
 	rawContents       *observation.Operation
+	refDescriptions   *observation.Operation
 	resolveRevision   *observation.Operation
 }
 
@@ -47,6 +50,7 @@ func newOperations(observationContext *observation.Context) *operations {
 	}
 
 	return &operations{
+		commitCount:       op("CommitCount"),
 		commitDate:        op("CommitDate"),
 		commitGraph:       op("CommitGraph"),
 		directoryChildren: op("DirectoryChildren"),
@@ -54,7 +58,9 @@ func newOperations(observationContext *observation.Context) *operations {
 		commitExists:      op("CommitExists"),
 		head:              op("Head"),
 		listFiles:         op("ListFiles"),
+		multiDiff:         op("MultiDiff"),
 		rawContents:       op("RawContents"),
+		refDescriptions:   op("RefDescriptions"),
 		resolveRevision:   op("ResolveRevision"),
 	}
 }
@@ -0,0 +1,190 @@
+package searchclient
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+	"unicode"
+
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/searcher"
+	"github.com/sourcegraph/sourcegraph/internal/symbols"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
+)
+
+// Client resolves search-based code intelligence. Locations are found by searching for the
+// identifier at the requested position, so they are imprecise: they may include unrelated
+// symbols that share the identifier's name.
+type Client struct {
+	dbStore    DBStore
+	operations *operations
+}
+
+func New(dbStore DBStore, observationContext *observation.Context) *Client {
+	return &Client{
+		dbStore:    dbStore,
+		operations: newOperations(observationContext),
+	}
+}
+
+// maxFileSize is the maximum number of bytes read from the file containing the requested
+// position. Identifiers beyond this offset are not resolved.
+const maxFileSize = 1024 * 1024
+
+// searcherFetchTimeout is the time searcher waits for the repository archive to be fetched.
+const searcherFetchTimeout = 2 * time.Second
+
+// Definitions returns the locations of the symbols named by the identifier at the given position,
+// as found by the symbols service.
+func (c *Client) Definitions(ctx context.Context, repositoryID int, commit, path string, line, character, limit int) (_ []lsifstore.Location, err error) {
+	ctx, endObservation := c.operations.definitions.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("repositoryID", repositoryID),
+		log.String("commit", commit),
+		log.String("path", path),
+		log.Int("line", line),
+		log.Int("character", character),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	repo, identifier, err := c.identifier(ctx, repositoryID, commit, path, line, character)
+	if err != nil || identifier == "" {
+		return nil, err
+	}
+
+	symbols, err := symbols.DefaultClient.Search(ctx, search.SymbolsParameters{
+		Repo:            repo,
+		CommitID:        api.CommitID(commit),
+		Query:           fmt.Sprintf("^%s$", regexp.QuoteMeta(identifier)),
+		IsRegExp:        true,
+		IsCaseSensitive: true,
+		First:           limit,
+	})
+	if err != nil || symbols == nil {
+		return nil, err
+	}
+
+	locations := make([]lsifstore.Location, 0, len(*symbols))
+	for _, symbol := range *symbols {
+		rn := symbol.Range()
+
+		locations = append(locations, lsifstore.Location{
+			Path: symbol.Path,
+			Range: lsifstore.Range{
+				Start: lsifstore.Position{Line: rn.Start.Line, Character: rn.Start.Character},
+				End:   lsifstore.Position{Line: rn.End.Line, Character: rn.End.Character},
+			},
+		})
+	}
+
+	return locations, nil
+}
+
+// References returns the locations of the whole-word occurrences of the identifier at the given
+// position in the repository, as found by searcher.
+func (c *Client) References(ctx context.Context, repositoryID int, commit, path string, line, character, limit int) (_ []lsifstore.Location, err error) {
+	ctx, endObservation := c.operations.references.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("repositoryID", repositoryID),
+		log.String("commit", commit),
+		log.String("path", path),
+		log.Int("line", line),
+		log.Int("character", character),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	repo, identifier, err := c.identifier(ctx, repositoryID, commit, path, line, character)
+	if err != nil || identifier == "" {
+		return nil, err
+	}
+
+	matches, _, err := searcher.Search(ctx, search.SearcherURLs(), repo, "", api.CommitID(commit), false, &search.TextPatternInfo{
+		Pattern:               fmt.Sprintf(`\b%s\b`, regexp.QuoteMeta(identifier)),
+		IsRegExp:              true,
+		IsCaseSensitive:       true,
+		FileMatchLimit:        int32(limit),
+		PatternMatchesContent: true,
+	}, searcherFetchTimeout, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var locations []lsifstore.Location
+outer:
+	for _, match := range matches {
+		for _, lineMatch := range match.LineMatches {
+			for _, offsetAndLength := range lineMatch.OffsetAndLengths {
+				if len(locations) >= limit {
+					break outer
+				}
+
+				locations = append(locations, lsifstore.Location{
+					Path: match.Path,
+					Range: lsifstore.Range{
+						Start: lsifstore.Position{Line: lineMatch.LineNumber, Character: offsetAndLength[0]},
+						End:   lsifstore.Position{Line: lineMatch.LineNumber, Character: offsetAndLength[0] + offsetAndLength[1]},
+					},
+				})
+			}
+		}
+	}
+
+	return locations, nil
+}
+
+// identifier returns the name of the given repository and the identifier at the given position
+// of the file. An empty identifier is returned if the position does not fall on an identifier.
+func (c *Client) identifier(ctx context.Context, repositoryID int, commit, path string, line, character int) (api.RepoName, string, error) {
+	repoName, err := c.dbStore.RepoName(ctx, repositoryID)
+	if err != nil {
+		return "", "", err
+	}
+	repo := api.RepoName(repoName)
+
+	content, err := git.ReadFile(ctx, repo, api.CommitID(commit), path, maxFileSize)
+	if err != nil {
+		return "", "", err
+	}
+
+	return repo, identifierAtPosition(content, line, character), nil
+}
+
+// identifierAtPosition returns the identifier enclosing the given zero-based line and character
+// (measured in runes) of the given content, or an empty string if there is no such identifier.
+func identifierAtPosition(content []byte, line, character int) string {
+	lines := bytes.Split(content, []byte("\n"))
+	if line < 0 || line >= len(lines) {
+		return ""
+	}
+
+	runes := []rune(string(bytes.TrimSuffix(lines[line], []byte("\r"))))
+	if character < 0 || character >= len(runes) || !isIdentifierRune(runes[character]) {
+		return ""
+	}
+
+	start := character
+	for start > 0 && isIdentifierRune(runes[start-1]) {
+		start--
+	}
+	end := character + 1
+	for end < len(runes) && isIdentifierRune(runes[end]) {
+		end++
+	}
+
+	identifier := runes[start:end]
+	if unicode.IsDigit(identifier[0]) {
+		// Numeric literals are not identifiers
+		return ""
+	}
+
+	return string(identifier)
+}
+
+func isIdentifierRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,34 @@
+package searchclient
+
+import (
+	"testing"
+)
+
+func TestIdentifierAtPosition(t *testing.T) {
+	content := []byte("package main\r\n\nfunc main() {\n\tfmt.Println(héllo_wörld, 42)\n}\n")
+
+	testCases := []struct {
+		line      int
+		character int
+		expected  string
+	}{
+		{0, 0, "package"},
+		{0, 10, "main"},
+		{0, 7, ""},
+		{3, 1, "fmt"},
+		{3, 5, "Println"},
+		{3, 13, "héllo_wörld"},
+		{3, 23, "héllo_wörld"},
+		{3, 27, ""},
+		{1, 0, ""},
+		{3, 100, ""},
+		{10, 0, ""},
+		{-1, 0, ""},
+	}
+
+	for _, testCase := range testCases {
+		if identifier := identifierAtPosition(content, testCase.line, testCase.character); identifier != testCase.expected {
+			t.Errorf("unexpected identifier at %d:%d. want=%q have=%q", testCase.line, testCase.character, testCase.expected, identifier)
+		}
+	}
+}
@@ -0,0 +1,7 @@
+package searchclient
+
+import "context"
+
+type DBStore interface {
+	RepoName(ctx context.Context, repositoryID int) (string, error)
+}
@@ -0,0 +1,35 @@
+package searchclient
+
+import (
+	"fmt"
+
+	"github.com/sourcegraph/sourcegraph/internal/metrics"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+type operations struct {
+	definitions *observation.Operation
+	references  *observation.Operation
+}
+
+func newOperations(observationContext *observation.Context) *operations {
+	metrics := metrics.NewOperationMetrics(
+		observationContext.Registerer,
+		"codeintel_searchclient",
+		metrics.WithLabels("op"),
+		metrics.WithCountHelp("Total number of method invocations."),
+	)
+
+	op := func(name string) *observation.Operation {
+		return observationContext.Operation(observation.Op{
+			Name:         fmt.Sprintf("codeintel.searchclient.%s", name),
+			MetricLabels: []string{name},
+			Metrics:      metrics,
+		})
+	}
+
+	return &operations{
+		definitions: op("Definitions"),
+		references:  op("References"),
+	}
+}
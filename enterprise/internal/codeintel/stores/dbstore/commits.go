@@ -85,6 +85,43 @@ SELECT
 	(SELECT COUNT(*) FROM lsif_nearest_uploads_links WHERE repository_id = %s AND commit_bytea = %s)
 `
 
+// CountCoveredCommits returns the number of the given commits of the given repository from which
+// at least one upload is visible.
+func (s *Store) CountCoveredCommits(ctx context.Context, repositoryID int, commits []string) (_ int, err error) {
+	ctx, endObservation := s.operations.countCoveredCommits.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("repositoryID", repositoryID),
+		log.Int("numCommits", len(commits)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if len(commits) == 0 {
+		return 0, nil
+	}
+
+	commitQueries := make([]*sqlf.Query, 0, len(commits))
+	for _, commit := range commits {
+		commitQueries = append(commitQueries, sqlf.Sprintf("%s", dbutil.CommitBytea(commit)))
+	}
+
+	count, _, err := basestore.ScanFirstInt(s.Store.Query(
+		ctx,
+		sqlf.Sprintf(
+			countCoveredCommitsQuery,
+			repositoryID, sqlf.Join(commitQueries, ", "),
+			repositoryID, sqlf.Join(commitQueries, ", "),
+		),
+	))
+
+	return count, err
+}
+
+const countCoveredCommitsQuery = `
+-- source: enterprise/internal/codeintel/stores/dbstore/commits.go:CountCoveredCommits
+SELECT
+	(SELECT COUNT(DISTINCT commit_bytea) FROM lsif_nearest_uploads WHERE repository_id = %s AND commit_bytea IN (%s)) +
+	(SELECT COUNT(DISTINCT commit_bytea) FROM lsif_nearest_uploads_links WHERE repository_id = %s AND commit_bytea IN (%s))
+`
+
 // MarkRepositoryAsDirty marks the given repository's commit graph as out of date.
 func (s *Store) MarkRepositoryAsDirty(ctx context.Context, repositoryID int) (err error) {
 	ctx, endObservation := s.operations.markRepositoryAsDirty.With(ctx, &err, observation.Args{LogFields: []log.Field{
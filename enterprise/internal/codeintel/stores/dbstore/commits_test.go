@@ -91,6 +91,48 @@ func TestHasCommit(t *testing.T) {
 	}
 }
 
+func TestCountCoveredCommits(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	db := dbtesting.GetDB(t)
+	store := testStore(db)
+
+	insertNearestUploads(t, db, 50, map[string][]commitgraph.UploadMeta{
+		makeCommit(1): {{UploadID: 42, Distance: 0}},
+		makeCommit(2): {{UploadID: 42, Distance: 1}},
+	})
+	insertLinks(t, db, 50, map[string]commitgraph.LinkRelationship{
+		makeCommit(3): {Commit: makeCommit(3), AncestorCommit: makeCommit(2), Distance: 1},
+	})
+	insertNearestUploads(t, db, 51, map[string][]commitgraph.UploadMeta{makeCommit(4): {{UploadID: 43, Distance: 0}}})
+
+	testCases := []struct {
+		repositoryID int
+		commits      []string
+		expected     int
+	}{
+		{50, nil, 0},
+		{50, []string{makeCommit(1), makeCommit(2), makeCommit(3), makeCommit(4)}, 3},
+		{50, []string{makeCommit(3), makeCommit(5)}, 1},
+		{51, []string{makeCommit(1), makeCommit(4)}, 1},
+	}
+
+	for _, testCase := range testCases {
+		name := fmt.Sprintf("repositoryID=%d commits=%d", testCase.repositoryID, len(testCase.commits))
+
+		t.Run(name, func(t *testing.T) {
+			count, err := store.CountCoveredCommits(context.Background(), testCase.repositoryID, testCase.commits)
+			if err != nil {
+				t.Fatalf("unexpected error counting covered commits: %s", err)
+			}
+			if count != testCase.expected {
+				t.Errorf("unexpected count. want=%d have=%d", testCase.expected, count)
+			}
+		})
+	}
+}
+
 func TestMarkRepositoryAsDirty(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
@@ -0,0 +1,140 @@
+package dbstore
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil"
+)
+
+// DependencySyncingJob is a subset of the lsif_dependency_syncing_jobs table and acts as the
+// queue and execution record for cloning and indexing the repository of a package that was
+// referenced by a code intelligence query but is not yet indexed on the instance.
+type DependencySyncingJob struct {
+	ID             int        `json:"id"`
+	State          string     `json:"state"`
+	FailureMessage *string    `json:"failureMessage"`
+	StartedAt      *time.Time `json:"startedAt"`
+	FinishedAt     *time.Time `json:"finishedAt"`
+	ProcessAfter   *time.Time `json:"processAfter"`
+	NumResets      int        `json:"numResets"`
+	NumFailures    int        `json:"numFailures"`
+	Scheme         string     `json:"scheme"`
+	Name           string     `json:"name"`
+	Version        string     `json:"version"`
+}
+
+func (u DependencySyncingJob) RecordID() int {
+	return u.ID
+}
+
+// scanDependencySyncingJobs scans a slice of dependency syncing jobs from the return value of
+// `*Store.query`.
+func scanDependencySyncingJobs(rows *sql.Rows, queryErr error) (_ []DependencySyncingJob, err error) {
+	if queryErr != nil {
+		return nil, queryErr
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var jobs []DependencySyncingJob
+	for rows.Next() {
+		var job DependencySyncingJob
+		if err := rows.Scan(
+			&job.ID,
+			&job.State,
+			&job.FailureMessage,
+			&job.StartedAt,
+			&job.FinishedAt,
+			&job.ProcessAfter,
+			&job.NumResets,
+			&job.NumFailures,
+			&job.Scheme,
+			&job.Name,
+			&job.Version,
+		); err != nil {
+			return nil, err
+		}
+
+		jobs = append(jobs, job)
+	}
+
+	return jobs, nil
+}
+
+var dependencySyncingJobColumns = []*sqlf.Query{
+	sqlf.Sprintf("j.id"),
+	sqlf.Sprintf("j.state"),
+	sqlf.Sprintf("j.failure_message"),
+	sqlf.Sprintf("j.started_at"),
+	sqlf.Sprintf("j.finished_at"),
+	sqlf.Sprintf("j.process_after"),
+	sqlf.Sprintf("j.num_resets"),
+	sqlf.Sprintf("j.num_failures"),
+	sqlf.Sprintf("j.scheme"),
+	sqlf.Sprintf("j.name"),
+	sqlf.Sprintf("j.version"),
+}
+
+// scanFirstDependencySyncingJob scans a slice of dependency syncing jobs from the return
+// value of `*Store.query` and returns the first.
+func scanFirstDependencySyncingJob(rows *sql.Rows, err error) (DependencySyncingJob, bool, error) {
+	jobs, err := scanDependencySyncingJobs(rows, err)
+	if err != nil || len(jobs) == 0 {
+		return DependencySyncingJob{}, false, err
+	}
+	return jobs[0], true, nil
+}
+
+// scanFirstDependencySyncingJobRecord scans a slice of dependency syncing jobs from the
+// return value of `*Store.query` and returns the first.
+func scanFirstDependencySyncingJobRecord(rows *sql.Rows, err error) (workerutil.Record, bool, error) {
+	return scanFirstDependencySyncingJob(rows, err)
+}
+
+// DependencySyncingJobCooldown is the duration after a dependency syncing job for a package
+// finishes during which no new job is inserted for the same package. Definitions requests
+// for a package that is still being indexed would otherwise queue a job each time.
+const DependencySyncingJobCooldown = time.Hour
+
+// InsertDependencySyncingJob inserts a new dependency syncing job for the given package and
+// returns its identifier. No job is inserted if a job for the same package is queued, is being
+// processed, or has finished within DependencySyncingJobCooldown; the returned flag is false
+// in this case.
+func (s *Store) InsertDependencySyncingJob(ctx context.Context, scheme, name, version string) (id int, inserted bool, err error) {
+	ctx, endObservation := s.operations.insertDependencySyncingJob.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("scheme", scheme),
+		log.String("name", name),
+		log.String("version", version),
+	}})
+	defer func() {
+		endObservation(1, observation.Args{LogFields: []log.Field{
+			log.Int("id", id),
+		}})
+	}()
+
+	return basestore.ScanFirstInt(s.Store.Query(ctx, sqlf.Sprintf(
+		insertDependencySyncingJobQuery,
+		scheme, name, version,
+		scheme, name, version,
+		DependencySyncingJobCooldown/time.Second,
+	)))
+}
+
+const insertDependencySyncingJobQuery = `
+-- source: enterprise/internal/codeintel/stores/dbstore/dependency_sync.go:InsertDependencySyncingJob
+INSERT INTO lsif_dependency_syncing_jobs (scheme, name, version)
+SELECT %s, %s, %s
+WHERE NOT EXISTS (
+	SELECT 1 FROM lsif_dependency_syncing_jobs j
+	WHERE
+		j.scheme = %s AND j.name = %s AND j.version = %s AND
+		(j.state IN ('queued', 'processing') OR j.finished_at > NOW() - (%s * interval '1 second'))
+)
+RETURNING id
+`
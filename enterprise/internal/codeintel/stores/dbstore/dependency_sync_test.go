@@ -0,0 +1,63 @@
+package dbstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtesting"
+)
+
+func TestInsertDependencySyncingJob(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	db := dbtesting.GetDB(t)
+	store := testStore(db)
+	ctx := context.Background()
+
+	id1, inserted, err := store.InsertDependencySyncingJob(ctx, "gomod", "https://github.com/sourcegraph/sourcegraph", "v3.31.0")
+	if err != nil {
+		t.Fatalf("unexpected error enqueueing dependency syncing job: %s", err)
+	}
+	if !inserted {
+		t.Fatalf("expected dependency syncing job to be inserted")
+	}
+
+	// Duplicate of a queued job
+	if _, inserted, err := store.InsertDependencySyncingJob(ctx, "gomod", "https://github.com/sourcegraph/sourcegraph", "v3.31.0"); err != nil {
+		t.Fatalf("unexpected error enqueueing dependency syncing job: %s", err)
+	} else if inserted {
+		t.Fatalf("expected duplicate dependency syncing job to be skipped")
+	}
+
+	// Distinct version
+	if _, inserted, err := store.InsertDependencySyncingJob(ctx, "gomod", "https://github.com/sourcegraph/sourcegraph", "v3.32.0"); err != nil {
+		t.Fatalf("unexpected error enqueueing dependency syncing job: %s", err)
+	} else if !inserted {
+		t.Fatalf("expected dependency syncing job to be inserted")
+	}
+
+	// Duplicate of a recently completed job
+	if _, err := db.ExecContext(ctx, "UPDATE lsif_dependency_syncing_jobs SET state = 'completed', finished_at = NOW() WHERE id = $1", id1); err != nil {
+		t.Fatalf("unexpected error updating dependency syncing job: %s", err)
+	}
+	if _, inserted, err := store.InsertDependencySyncingJob(ctx, "gomod", "https://github.com/sourcegraph/sourcegraph", "v3.31.0"); err != nil {
+		t.Fatalf("unexpected error enqueueing dependency syncing job: %s", err)
+	} else if inserted {
+		t.Fatalf("expected duplicate dependency syncing job to be skipped")
+	}
+
+	// Duplicate of a job completed before the cooldown
+	query := sqlf.Sprintf("UPDATE lsif_dependency_syncing_jobs SET finished_at = NOW() - (%s * interval '1 second') WHERE id = %s", 2*DependencySyncingJobCooldown/time.Second, id1)
+	if _, err := db.ExecContext(ctx, query.Query(sqlf.PostgresBindVar), query.Args()...); err != nil {
+		t.Fatalf("unexpected error updating dependency syncing job: %s", err)
+	}
+	if _, inserted, err := store.InsertDependencySyncingJob(ctx, "gomod", "https://github.com/sourcegraph/sourcegraph", "v3.31.0"); err != nil {
+		t.Fatalf("unexpected error enqueueing dependency syncing job: %s", err)
+	} else if !inserted {
+		t.Fatalf("expected dependency syncing job to be inserted")
+	}
+}
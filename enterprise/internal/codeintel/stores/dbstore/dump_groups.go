@@ -0,0 +1,105 @@
+package dbstore
+
+import (
+	"context"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// DumpGroup is a set of dumps of the same repository and commit produced by the same indexer with
+// distinct (sibling) roots. This is commonly the result of a monorepo uploading one index per service.
+// The dumps of a group are treated together as a single logical index.
+type DumpGroup struct {
+	RepositoryID int
+	Commit       string
+	Indexer      string
+	Dumps        []Dump
+}
+
+// dumpGroupKey identifies the dump group to which a dump belongs.
+type dumpGroupKey struct {
+	repositoryID int
+	commit       string
+	indexer      string
+}
+
+// GroupDumps partitions the given dumps into dump groups. Groups are returned in the order in which
+// their first dump occurs in the given slice, and the order of dumps within a group is preserved.
+func GroupDumps(dumps []Dump) []DumpGroup {
+	var groups []DumpGroup
+	indexes := map[dumpGroupKey]int{}
+
+	for _, dump := range dumps {
+		key := dumpGroupKey{repositoryID: dump.RepositoryID, commit: dump.Commit, indexer: dump.Indexer}
+
+		index, ok := indexes[key]
+		if !ok {
+			index = len(groups)
+			indexes[key] = index
+			groups = append(groups, DumpGroup{RepositoryID: dump.RepositoryID, Commit: dump.Commit, Indexer: dump.Indexer})
+		}
+
+		groups[index].Dumps = append(groups[index].Dumps, dump)
+	}
+
+	return groups
+}
+
+// GetDumpGroups returns the dump groups containing the dumps with the given identifiers. Each group
+// contains every completed dump sharing the repository, commit, and indexer of one of the given dumps,
+// including the given dumps themselves.
+func (s *Store) GetDumpGroups(ctx context.Context, ids []int) (_ []DumpGroup, err error) {
+	ctx, traceLog, endObservation := s.operations.getDumpGroups.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("numIDs", len(ids)),
+		log.String("ids", intsToString(ids)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	dumps, err := scanDumps(s.Store.Query(ctx, sqlf.Sprintf(getDumpGroupsQuery, pq.Array(ids))))
+	if err != nil {
+		return nil, err
+	}
+	traceLog(log.Int("numDumps", len(dumps)))
+
+	return GroupDumps(dumps), nil
+}
+
+const getDumpGroupsQuery = `
+-- source: enterprise/internal/codeintel/stores/dbstore/dump_groups.go:GetDumpGroups
+SELECT
+	d.id,
+	d.commit,
+	d.root,
+	` + visibleAtTipFragment + ` AS visible_at_tip,
+	d.uploaded_at,
+	d.state,
+	d.failure_message,
+	d.started_at,
+	d.finished_at,
+	d.process_after,
+	d.num_resets,
+	d.num_failures,
+	d.repository_id,
+	d.repository_name,
+	d.indexer,
+	d.associated_index_id
+FROM lsif_dumps_with_repository_name d
+WHERE EXISTS (
+	SELECT 1
+	FROM lsif_dumps s
+	WHERE
+		s.id = ANY(%s) AND
+		s.repository_id = d.repository_id AND
+		s.commit = d.commit AND
+		s.indexer = d.indexer
+)
+ORDER BY d.repository_id, d.commit, d.indexer, d.root
+`
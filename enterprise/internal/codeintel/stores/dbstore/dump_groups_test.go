@@ -0,0 +1,67 @@
+package dbstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtesting"
+)
+
+func TestGroupDumps(t *testing.T) {
+	dumps := []Dump{
+		{ID: 1, RepositoryID: 50, Commit: makeCommit(1), Root: "a/", Indexer: "lsif-go"},
+		{ID: 2, RepositoryID: 50, Commit: makeCommit(2), Root: "a/", Indexer: "lsif-go"},
+		{ID: 3, RepositoryID: 50, Commit: makeCommit(1), Root: "b/", Indexer: "lsif-go"},
+		{ID: 4, RepositoryID: 50, Commit: makeCommit(1), Root: "c/", Indexer: "lsif-tsc"},
+		{ID: 5, RepositoryID: 51, Commit: makeCommit(1), Root: "a/", Indexer: "lsif-go"},
+	}
+
+	expected := []DumpGroup{
+		{RepositoryID: 50, Commit: makeCommit(1), Indexer: "lsif-go", Dumps: []Dump{dumps[0], dumps[2]}},
+		{RepositoryID: 50, Commit: makeCommit(2), Indexer: "lsif-go", Dumps: []Dump{dumps[1]}},
+		{RepositoryID: 50, Commit: makeCommit(1), Indexer: "lsif-tsc", Dumps: []Dump{dumps[3]}},
+		{RepositoryID: 51, Commit: makeCommit(1), Indexer: "lsif-go", Dumps: []Dump{dumps[4]}},
+	}
+	if diff := cmp.Diff(expected, GroupDumps(dumps)); diff != "" {
+		t.Errorf("unexpected dump groups (-want +got):\n%s", diff)
+	}
+}
+
+func TestGetDumpGroups(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	db := dbtesting.GetDB(t)
+	store := testStore(db)
+
+	insertUploads(t, db,
+		Upload{ID: 1, Commit: makeCommit(1), Root: "a/"},
+		Upload{ID: 2, Commit: makeCommit(1), Root: "b/"},
+		Upload{ID: 3, Commit: makeCommit(1), Root: "c/"},
+		Upload{ID: 4, Commit: makeCommit(1), Root: "d/", State: "errored"},
+		Upload{ID: 5, Commit: makeCommit(1), Root: "e/", Indexer: "lsif-tsc"},
+		Upload{ID: 6, Commit: makeCommit(2), Root: "a/"},
+		Upload{ID: 7, Commit: makeCommit(3), Root: "a/"},
+	)
+
+	groups, err := store.GetDumpGroups(context.Background(), []int{2, 6})
+	if err != nil {
+		t.Fatalf("unexpected error getting dump groups: %s", err)
+	}
+
+	var groupIDs [][]int
+	for _, group := range groups {
+		var ids []int
+		for _, dump := range group.Dumps {
+			ids = append(ids, dump.ID)
+		}
+
+		groupIDs = append(groupIDs, ids)
+	}
+
+	if diff := cmp.Diff([][]int{{1, 2, 3}, {6}}, groupIDs); diff != "" {
+		t.Errorf("unexpected dump groups (-want +got):\n%s", diff)
+	}
+}
@@ -148,8 +148,9 @@ func insertIndexes(t testing.TB, db *sql.DB, indexes ...Index) {
 				indexer_args,
 				outfile,
 				execution_logs,
-				local_steps
-			) VALUES (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
+				local_steps,
+				interactive
+			) VALUES (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
 		`,
 			index.ID,
 			index.Commit,
@@ -169,6 +170,7 @@ func insertIndexes(t testing.TB, db *sql.DB, indexes ...Index) {
 			index.Outfile,
 			pq.Array(dbworkerstore.ExecutionLogEntries(index.ExecutionLogs)),
 			pq.Array(index.LocalSteps),
+			index.Interactive,
 		)
 
 		if _, err := db.ExecContext(context.Background(), query.Query(sqlf.PostgresBindVar), query.Args()...); err != nil {
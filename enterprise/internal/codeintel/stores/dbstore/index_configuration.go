@@ -11,11 +11,13 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 )
 
-// IndexConfiguration stores the index configuration for a repository.
+// IndexConfiguration stores the index configuration for a repository. Inferred configurations are
+// written by the auto-indexer and are replaced by any configuration supplied by a user.
 type IndexConfiguration struct {
 	ID           int    `json:"id"`
 	RepositoryID int    `json:"repository_id"`
 	Data         []byte `json:"data"`
+	Inferred     bool   `json:"inferred"`
 }
 
 // scanIndexConfigurations scans a slice of index configurations from the return value of `*Store.query`.
@@ -32,6 +34,7 @@ func scanIndexConfigurations(rows *sql.Rows, queryErr error) (_ []IndexConfigura
 			&indexConfiguration.ID,
 			&indexConfiguration.RepositoryID,
 			&indexConfiguration.Data,
+			&indexConfiguration.Inferred,
 		); err != nil {
 			return nil, err
 		}
@@ -53,6 +56,7 @@ func scanFirstIndexConfiguration(rows *sql.Rows, err error) (IndexConfiguration,
 }
 
 // GetRepositoriesWithIndexConfiguration returns the ids of repositories explicit index configuration.
+// Repositories with only an inferred index configuration are not included.
 func (s *Store) GetRepositoriesWithIndexConfiguration(ctx context.Context) (_ []int, err error) {
 	ctx, traceLog, endObservation := s.operations.getRepositoriesWithIndexConfiguration.WithAndLogger(ctx, &err, observation.Args{})
 	defer endObservation(1, observation.Args{})
@@ -68,7 +72,7 @@ func (s *Store) GetRepositoriesWithIndexConfiguration(ctx context.Context) (_ []
 
 const getRepositoriesWithIndexConfigurationQuery = `
 -- source: enterprise/internal/codeintel/stores/dbstore/index_configuration.go:GetRepositoriesWithIndexConfiguration
-SELECT c.repository_id FROM lsif_index_configuration c
+SELECT c.repository_id FROM lsif_index_configuration c WHERE NOT c.inferred
 `
 
 // GetIndexConfigurationByRepositoryID returns the index configuration for a repository.
@@ -86,11 +90,13 @@ const getIndexConfigurationByRepositoryIDQuery = `
 SELECT
 	c.id,
 	c.repository_id,
-	c.data
+	c.data,
+	c.inferred
 FROM lsif_index_configuration c WHERE c.repository_id = %s
 `
 
-// UpdateIndexConfigurationByRepositoryID updates the index configuration for a repository.
+// UpdateIndexConfigurationByRepositoryID updates the index configuration for a repository. This
+// replaces any previously inferred configuration for the repository.
 func (s *Store) UpdateIndexConfigurationByRepositoryID(ctx context.Context, repositoryID int, data []byte) (err error) {
 	ctx, endObservation := s.operations.updateIndexConfigurationByRepositoryID.With(ctx, &err, observation.Args{LogFields: []log.Field{
 		log.Int("repositoryID", repositoryID),
@@ -102,6 +108,23 @@ func (s *Store) UpdateIndexConfigurationByRepositoryID(ctx context.Context, repo
 
 const updateIndexConfigurationByRepositoryIDQuery = `
 -- source: enterprise/internal/codeintel/stores/dbstore/index_configuration.go:UpdateIndexConfigurationByRepositoryID
-INSERT INTO lsif_index_configuration (repository_id, data) VALUES (%s, %s)
-	ON CONFLICT (repository_id) DO UPDATE SET data = %s
+INSERT INTO lsif_index_configuration (repository_id, data, inferred) VALUES (%s, %s, false)
+	ON CONFLICT (repository_id) DO UPDATE SET data = %s, inferred = false
+`
+
+// UpdateInferredIndexConfigurationByRepositoryID records the index configuration inferred from the
+// structure of a repository. This method does not overwrite a configuration supplied by a user.
+func (s *Store) UpdateInferredIndexConfigurationByRepositoryID(ctx context.Context, repositoryID int, data []byte) (err error) {
+	ctx, endObservation := s.operations.updateInferredIndexConfigurationByRepositoryID.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("repositoryID", repositoryID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return s.Store.Exec(ctx, sqlf.Sprintf(updateInferredIndexConfigurationByRepositoryIDQuery, repositoryID, data, data))
+}
+
+const updateInferredIndexConfigurationByRepositoryIDQuery = `
+-- source: enterprise/internal/codeintel/stores/dbstore/index_configuration.go:UpdateInferredIndexConfigurationByRepositoryID
+INSERT INTO lsif_index_configuration (repository_id, data, inferred) VALUES (%s, %s, true)
+	ON CONFLICT (repository_id) DO UPDATE SET data = %s WHERE lsif_index_configuration.inferred
 `
@@ -99,3 +99,61 @@ func TestGetIndexConfigurationByRepositoryID(t *testing.T) {
 		t.Errorf("unexpected configuration payload (-want +got):\n%s", diff)
 	}
 }
+
+func TestUpdateInferredIndexConfigurationByRepositoryID(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	db := dbtesting.GetDB(t)
+	store := testStore(db)
+
+	for _, repositoryID := range []int{42, 43} {
+		query := sqlf.Sprintf(
+			`INSERT INTO repo (id, name) VALUES (%s, %s)`,
+			repositoryID,
+			fmt.Sprintf("github.com/baz/honk%2d", repositoryID),
+		)
+		if _, err := db.Exec(query.Query(sqlf.PostgresBindVar), query.Args()...); err != nil {
+			t.Fatalf("unexpected error inserting repo: %s", err)
+		}
+	}
+
+	if err := store.UpdateIndexConfigurationByRepositoryID(context.Background(), 42, []byte(`explicit`)); err != nil {
+		t.Fatalf("unexpected error updating index configuration: %s", err)
+	}
+	for _, repositoryID := range []int{42, 43} {
+		if err := store.UpdateInferredIndexConfigurationByRepositoryID(context.Background(), repositoryID, []byte(`inferred`)); err != nil {
+			t.Fatalf("unexpected error updating inferred index configuration: %s", err)
+		}
+	}
+
+	// Explicit configuration is not overwritten
+	if indexConfiguration, _, err := store.GetIndexConfigurationByRepositoryID(context.Background(), 42); err != nil {
+		t.Fatalf("unexpected error while fetching index configuration: %s", err)
+	} else if diff := cmp.Diff(IndexConfiguration{ID: indexConfiguration.ID, RepositoryID: 42, Data: []byte(`explicit`)}, indexConfiguration); diff != "" {
+		t.Errorf("unexpected index configuration (-want +got):\n%s", diff)
+	}
+
+	if indexConfiguration, _, err := store.GetIndexConfigurationByRepositoryID(context.Background(), 43); err != nil {
+		t.Fatalf("unexpected error while fetching index configuration: %s", err)
+	} else if diff := cmp.Diff(IndexConfiguration{ID: indexConfiguration.ID, RepositoryID: 43, Data: []byte(`inferred`), Inferred: true}, indexConfiguration); diff != "" {
+		t.Errorf("unexpected index configuration (-want +got):\n%s", diff)
+	}
+
+	// Inferred configuration is not explicit
+	if repositoryIDs, err := store.GetRepositoriesWithIndexConfiguration(context.Background()); err != nil {
+		t.Fatalf("unexpected error while fetching repositories with index configuration: %s", err)
+	} else if diff := cmp.Diff([]int{42}, repositoryIDs); diff != "" {
+		t.Errorf("unexpected repository identifiers (-want +got):\n%s", diff)
+	}
+
+	// Inferred configuration is replaced by explicit configuration
+	if err := store.UpdateIndexConfigurationByRepositoryID(context.Background(), 43, []byte(`explicit`)); err != nil {
+		t.Fatalf("unexpected error updating index configuration: %s", err)
+	}
+	if indexConfiguration, _, err := store.GetIndexConfigurationByRepositoryID(context.Background(), 43); err != nil {
+		t.Fatalf("unexpected error while fetching index configuration: %s", err)
+	} else if indexConfiguration.Inferred {
+		t.Errorf("expected explicit index configuration")
+	}
+}
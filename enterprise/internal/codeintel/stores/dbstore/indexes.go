@@ -32,6 +32,7 @@ type Index struct {
 	NumFailures        int                            `json:"numFailures"`
 	RepositoryID       int                            `json:"repositoryId"`
 	LocalSteps         []string                       `json:"local_steps"`
+	Interactive        bool                           `json:"interactive"`
 	RepositoryName     string                         `json:"repositoryName"`
 	DockerSteps        []DockerStep                   `json:"docker_steps"`
 	Root               string                         `json:"root"`
@@ -80,6 +81,7 @@ func scanIndexes(rows *sql.Rows, queryErr error) (_ []Index, err error) {
 			pq.Array(&executionLogs),
 			&index.Rank,
 			pq.Array(&index.LocalSteps),
+			&index.Interactive,
 			&index.AssociatedUploadID,
 		); err != nil {
 			return nil, err
@@ -163,6 +165,7 @@ SELECT
 	u.execution_logs,
 	s.rank,
 	u.local_steps,
+	u.interactive,
 	` + indexAssociatedUploadIDQueryFragment + `
 FROM lsif_indexes_with_repository_name u
 LEFT JOIN (` + indexRankQueryFragment + `) s
@@ -219,6 +222,7 @@ SELECT
 	u.execution_logs,
 	s.rank,
 	u.local_steps,
+	u.interactive,
 	` + indexAssociatedUploadIDQueryFragment + `
 FROM lsif_indexes_with_repository_name u
 LEFT JOIN (` + indexRankQueryFragment + `) s
@@ -318,6 +322,7 @@ SELECT
 	u.execution_logs,
 	s.rank,
 	u.local_steps,
+	u.interactive,
 	` + indexAssociatedUploadIDQueryFragment + `
 FROM lsif_indexes_with_repository_name u
 LEFT JOIN (` + indexRankQueryFragment + `) s
@@ -399,6 +404,7 @@ func (s *Store) InsertIndex(ctx context.Context, index Index) (id int, err error
 			pq.Array(index.IndexerArgs),
 			index.Outfile,
 			pq.Array(dbworkerstore.ExecutionLogEntries(index.ExecutionLogs)),
+			index.Interactive,
 		),
 	))
 
@@ -417,8 +423,9 @@ INSERT INTO lsif_indexes (
 	indexer,
 	indexer_args,
 	outfile,
-	execution_logs
-) VALUES (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
+	execution_logs,
+	interactive
+) VALUES (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
 RETURNING id
 `
 
@@ -443,6 +450,7 @@ var indexColumnsWithNullRank = []*sqlf.Query{
 	sqlf.Sprintf(`u.execution_logs`),
 	sqlf.Sprintf("NULL"),
 	sqlf.Sprintf(`u.local_steps`),
+	sqlf.Sprintf(`u.interactive`),
 	sqlf.Sprintf(indexAssociatedUploadIDQueryFragment),
 }
 
@@ -512,6 +520,49 @@ deleted_uploads AS (
 SELECT d.repository_id, COUNT(*) FROM deleted_uploads d GROUP BY d.repository_id
 `
 
+// DeleteIndexesWithMissingRepository deletes at most limit indexes associated with repositories that
+// no longer have a record in the repo table. Indexes of soft-deleted repositories are removed by
+// DeleteIndexesWithoutRepository. This returns the repository identifier mapped to the number of
+// indexes that were removed for that repository.
+func (s *Store) DeleteIndexesWithMissingRepository(ctx context.Context, limit int) (_ map[int]int, err error) {
+	ctx, traceLog, endObservation := s.operations.deleteIndexesWithMissingRepository.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("limit", limit),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	repositories, err := scanCounts(s.Store.Query(ctx, sqlf.Sprintf(deleteIndexesWithMissingRepositoryQuery, limit)))
+	if err != nil {
+		return nil, err
+	}
+
+	count := 0
+	for _, numDeleted := range repositories {
+		count += numDeleted
+	}
+	traceLog(
+		log.Int("count", count),
+		log.Int("numRepositories", len(repositories)),
+	)
+
+	return repositories, nil
+}
+
+const deleteIndexesWithMissingRepositoryQuery = `
+-- source: enterprise/internal/codeintel/stores/dbstore/indexes.go:DeleteIndexesWithMissingRepository
+WITH candidates AS (
+	SELECT i.id FROM lsif_indexes i
+	WHERE NOT EXISTS (SELECT 1 FROM repo r WHERE r.id = i.repository_id)
+	ORDER BY i.id
+	LIMIT %s
+	FOR UPDATE SKIP LOCKED
+),
+deleted_indexes AS (
+	DELETE FROM lsif_indexes i WHERE i.id IN (SELECT id FROM candidates)
+	RETURNING i.id, i.repository_id
+)
+SELECT d.repository_id, COUNT(*) FROM deleted_indexes d GROUP BY d.repository_id
+`
+
 // DeleteOldIndexes deletes indexes older than the given age.
 func (s *Store) DeleteOldIndexes(ctx context.Context, maxAge time.Duration, now time.Time) (count int, err error) {
 	ctx, traceLog, endObservation := s.operations.deleteOldIndexes.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
@@ -532,6 +532,49 @@ func TestDeleteIndexesWithoutRepository(t *testing.T) {
 	}
 }
 
+func TestDeleteIndexesWithMissingRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	db := dbtesting.GetDB(t)
+	store := testStore(db)
+
+	var indexes []Index
+	for i := 0; i < 5; i++ {
+		for j := 0; j < 10; j++ {
+			indexes = append(indexes, Index{ID: len(indexes) + 1, RepositoryID: 50 + i})
+		}
+	}
+	insertIndexes(t, db, indexes...)
+
+	for _, repositoryID := range []int{51, 53} {
+		query := sqlf.Sprintf(`DELETE FROM repo WHERE id = %s`, repositoryID)
+
+		if _, err := db.Exec(query.Query(sqlf.PostgresBindVar), query.Args()...); err != nil {
+			t.Fatalf("Failed to delete repository: %s", err)
+		}
+	}
+
+	// Soft-deleted repositories are handled by DeleteIndexesWithoutRepository
+	deleteRepo(t, db, 52, time.Unix(1587396557, 0).UTC())
+
+	ids, err := store.DeleteIndexesWithMissingRepository(context.Background(), 15)
+	if err != nil {
+		t.Fatalf("unexpected error deleting indexes: %s", err)
+	}
+	if diff := cmp.Diff(map[int]int{51: 10, 53: 5}, ids); diff != "" {
+		t.Errorf("unexpected ids (-want +got):\n%s", diff)
+	}
+
+	ids, err = store.DeleteIndexesWithMissingRepository(context.Background(), 15)
+	if err != nil {
+		t.Fatalf("unexpected error deleting indexes: %s", err)
+	}
+	if diff := cmp.Diff(map[int]int{53: 5}, ids); diff != "" {
+		t.Errorf("unexpected ids (-want +got):\n%s", diff)
+	}
+}
+
 func TestDeleteOldIndexes(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
@@ -8,64 +8,85 @@ import (
 )
 
 type operations struct {
-	addUploadPart                          *observation.Operation
-	calculateVisibleUploads                *observation.Operation
-	commitGraphMetadata                    *observation.Operation
-	definitionDumps                        *observation.Operation
-	deleteIndexByID                        *observation.Operation
-	deleteIndexesWithoutRepository         *observation.Operation
-	deleteOldIndexes                       *observation.Operation
-	deleteOverlappingDumps                 *observation.Operation
-	deleteUploadByID                       *observation.Operation
-	deleteUploadsStuckUploading            *observation.Operation
-	deleteUploadsWithoutRepository         *observation.Operation
-	dequeue                                *observation.Operation
-	dequeueIndex                           *observation.Operation
-	dirtyRepositories                      *observation.Operation
-	findClosestDumps                       *observation.Operation
-	findClosestDumpsFromGraphFragment      *observation.Operation
-	getDumpsByIDs                          *observation.Operation
-	getIndexByID                           *observation.Operation
-	getIndexConfigurationByRepositoryID    *observation.Operation
-	getIndexes                             *observation.Operation
-	getIndexesByIDs                        *observation.Operation
-	getOldestCommitDate                    *observation.Operation
-	getRepositoriesWithIndexConfiguration  *observation.Operation
-	getUploadByID                          *observation.Operation
-	getUploads                             *observation.Operation
-	getUploadsByIDs                        *observation.Operation
-	hardDeleteUploadByID                   *observation.Operation
-	hasCommit                              *observation.Operation
-	hasRepository                          *observation.Operation
-	indexableRepositories                  *observation.Operation
-	indexQueueSize                         *observation.Operation
-	insertDependencyIndexingJob            *observation.Operation
-	insertIndex                            *observation.Operation
-	insertUpload                           *observation.Operation
-	isQueued                               *observation.Operation
-	markComplete                           *observation.Operation
-	markErrored                            *observation.Operation
-	markFailed                             *observation.Operation
-	markIndexComplete                      *observation.Operation
-	markIndexErrored                       *observation.Operation
-	markQueued                             *observation.Operation
-	markRepositoryAsDirty                  *observation.Operation
-	queueSize                              *observation.Operation
-	referenceIDsAndFilters                 *observation.Operation
-	referencesForUpload                    *observation.Operation
-	refreshCommitResolvability             *observation.Operation
-	repoName                               *observation.Operation
-	repoUsageStatistics                    *observation.Operation
-	requeue                                *observation.Operation
-	requeueIndex                           *observation.Operation
-	resetIndexableRepositories             *observation.Operation
-	softDeleteOldUploads                   *observation.Operation
-	staleSourcedCommits                    *observation.Operation
-	updateCommitedAt                       *observation.Operation
-	updateIndexableRepository              *observation.Operation
-	updateIndexConfigurationByRepositoryID *observation.Operation
-	updatePackageReferences                *observation.Operation
-	updatePackages                         *observation.Operation
+	addUploadPart                                  *observation.Operation
+	calculateVisibleUploads                        *observation.Operation
+	commitGraphMetadata                            *observation.Operation
+	countCoveredCommits                            *observation.Operation
+	createRetentionPolicy                          *observation.Operation
+	deleteIndexByID                                *observation.Operation
+	deleteIndexesWithMissingRepository             *observation.Operation
+	deleteIndexesWithoutRepository                 *observation.Operation
+	deleteOldIndexes                               *observation.Operation
+	deleteOverlappingDumps                         *observation.Operation
+	deleteRetentionPolicyByID                      *observation.Operation
+	deleteUploadByID                               *observation.Operation
+	deleteUploadProgress                           *observation.Operation
+	deleteUploadsStuckUploading                    *observation.Operation
+	deleteUploadsWithoutRepository                 *observation.Operation
+	dequeue                                        *observation.Operation
+	dequeueIndex                                   *observation.Operation
+	dirtyRepositories                              *observation.Operation
+	failUploadsStuckProcessing                     *observation.Operation
+	findClosestDumps                               *observation.Operation
+	findClosestDumpsFromGraphFragment              *observation.Operation
+	getDumpGroups                                  *observation.Operation
+	getDumpsByIDs                                  *observation.Operation
+	getIndexByID                                   *observation.Operation
+	getIndexConfigurationByRepositoryID            *observation.Operation
+	getIndexes                                     *observation.Operation
+	getIndexesByIDs                                *observation.Operation
+	getOldestCommitDate                            *observation.Operation
+	getOrphanedUploadIDs                           *observation.Operation
+	getPackages                                    *observation.Operation
+	getRepositoriesWithCompletedUploads            *observation.Operation
+	getRepositoriesWithIndexConfiguration          *observation.Operation
+	getRetentionPolicies                           *observation.Operation
+	getRetentionPolicyByID                         *observation.Operation
+	getUploadByID                                  *observation.Operation
+	getUploadProgress                              *observation.Operation
+	getUploads                                     *observation.Operation
+	getUploadsByIDs                                *observation.Operation
+	hardDeleteUploadByID                           *observation.Operation
+	hasCommit                                      *observation.Operation
+	hasRepository                                  *observation.Operation
+	indexableRepositories                          *observation.Operation
+	indexQueueSize                                 *observation.Operation
+	insertDependencyIndexingJob                    *observation.Operation
+	insertDependencySyncingJob                     *observation.Operation
+	insertIndex                                    *observation.Operation
+	insertUpload                                   *observation.Operation
+	isQueued                                       *observation.Operation
+	markComplete                                   *observation.Operation
+	markErrored                                    *observation.Operation
+	markFailed                                     *observation.Operation
+	markIndexComplete                              *observation.Operation
+	markIndexErrored                               *observation.Operation
+	markQueued                                     *observation.Operation
+	markRepositoryAsDirty                          *observation.Operation
+	publishUploadCompleted                         *observation.Operation
+	queueSize                                      *observation.Operation
+	referenceIDsAndFilters                         *observation.Operation
+	referencesForUpload                            *observation.Operation
+	refreshCommitResolvability                     *observation.Operation
+	reindexUploadByID                              *observation.Operation
+	repoName                                       *observation.Operation
+	repoUsageStatistics                            *observation.Operation
+	requeue                                        *observation.Operation
+	requeueIndex                                   *observation.Operation
+	resetIndexableRepositories                     *observation.Operation
+	softDeleteOldUploads                           *observation.Operation
+	staleSourcedCommits                            *observation.Operation
+	updateCommitedAt                               *observation.Operation
+	updateIndexableRepository                      *observation.Operation
+	updateIndexConfigurationByRepositoryID         *observation.Operation
+	updateInferredIndexConfigurationByRepositoryID *observation.Operation
+	updatePackageReferences                        *observation.Operation
+	updatePackages                                 *observation.Operation
+	updateRetentionPolicy                          *observation.Operation
+	updateUploadProgress                           *observation.Operation
+	updateUploadRetention                          *observation.Operation
+	updateUsageStatistics                          *observation.Operation
+	usageStatistics                                *observation.Operation
 
 	writeVisibleUploads        *observation.Operation
 	persistNearestUploads      *observation.Operation
@@ -102,27 +123,39 @@ func newOperations(observationContext *observation.Context) *operations {
 		addUploadPart:                          op("AddUploadPart"),
 		calculateVisibleUploads:                op("CalculateVisibleUploads"),
 		commitGraphMetadata:                    op("CommitGraphMetadata"),
-		definitionDumps:                        op("DefinitionDumps"),
+		countCoveredCommits:                    op("CountCoveredCommits"),
+		createRetentionPolicy:                  op("CreateRetentionPolicy"),
 		deleteIndexByID:                        op("DeleteIndexByID"),
+		deleteIndexesWithMissingRepository:     op("DeleteIndexesWithMissingRepository"),
 		deleteIndexesWithoutRepository:         op("DeleteIndexesWithoutRepository"),
 		deleteOldIndexes:                       op("DeleteOldIndexes"),
 		deleteOverlappingDumps:                 op("DeleteOverlappingDumps"),
+		deleteRetentionPolicyByID:              op("DeleteRetentionPolicyByID"),
 		deleteUploadByID:                       op("DeleteUploadByID"),
+		deleteUploadProgress:                   op("DeleteUploadProgress"),
 		deleteUploadsStuckUploading:            op("DeleteUploadsStuckUploading"),
 		deleteUploadsWithoutRepository:         op("DeleteUploadsWithoutRepository"),
 		dequeue:                                op("Dequeue"),
 		dequeueIndex:                           op("DequeueIndex"),
 		dirtyRepositories:                      op("DirtyRepositories"),
+		failUploadsStuckProcessing:             op("FailUploadsStuckProcessing"),
 		findClosestDumps:                       op("FindClosestDumps"),
 		findClosestDumpsFromGraphFragment:      op("FindClosestDumpsFromGraphFragment"),
+		getDumpGroups:                          op("GetDumpGroups"),
 		getDumpsByIDs:                          op("GetDumpsByIDs"),
 		getIndexByID:                           op("GetIndexByID"),
 		getIndexConfigurationByRepositoryID:    op("GetIndexConfigurationByRepositoryID"),
 		getIndexes:                             op("GetIndexes"),
 		getIndexesByIDs:                        op("GetIndexesByIDs"),
 		getOldestCommitDate:                    op("GetOldestCommitDate"),
+		getOrphanedUploadIDs:                   op("GetOrphanedUploadIDs"),
+		getPackages:                            op("GetPackages"),
+		getRepositoriesWithCompletedUploads:    op("GetRepositoriesWithCompletedUploads"),
 		getRepositoriesWithIndexConfiguration:  op("GetRepositoriesWithIndexConfiguration"),
+		getRetentionPolicies:                   op("GetRetentionPolicies"),
+		getRetentionPolicyByID:                 op("GetRetentionPolicyByID"),
 		getUploadByID:                          op("GetUploadByID"),
+		getUploadProgress:                      op("GetUploadProgress"),
 		getUploads:                             op("GetUploads"),
 		getUploadsByIDs:                        op("GetUploadsByIDs"),
 		hardDeleteUploadByID:                   op("HardDeleteUploadByID"),
@@ -131,6 +164,7 @@ func newOperations(observationContext *observation.Context) *operations {
 		indexableRepositories:                  op("IndexableRepositories"),
 		indexQueueSize:                         op("IndexQueueSize"),
 		insertDependencyIndexingJob:            op("InsertDependencyIndexingJob"),
+		insertDependencySyncingJob:             op("InsertDependencySyncingJob"),
 		insertIndex:                            op("InsertIndex"),
 		insertUpload:                           op("InsertUpload"),
 		isQueued:                               op("IsQueued"),
@@ -141,10 +175,12 @@ func newOperations(observationContext *observation.Context) *operations {
 		markIndexErrored:                       op("MarkIndexErrored"),
 		markQueued:                             op("MarkQueued"),
 		markRepositoryAsDirty:                  op("MarkRepositoryAsDirty"),
+		publishUploadCompleted:                 op("PublishUploadCompleted"),
 		queueSize:                              op("QueueSize"),
 		referenceIDsAndFilters:                 op("ReferenceIDsAndFilters"),
 		referencesForUpload:                    op("ReferencesForUpload"),
 		refreshCommitResolvability:             op("RefreshCommitResolvability"),
+		reindexUploadByID:                      op("ReindexUploadByID"),
 		repoName:                               op("RepoName"),
 		repoUsageStatistics:                    op("RepoUsageStatistics"),
 		requeue:                                op("Requeue"),
@@ -155,8 +191,14 @@ func newOperations(observationContext *observation.Context) *operations {
 		updateCommitedAt:                       op("UpdateCommitedAt"),
 		updateIndexableRepository:              op("UpdateIndexableRepository"),
 		updateIndexConfigurationByRepositoryID: op("UpdateIndexConfigurationByRepositoryID"),
-		updatePackageReferences:                op("UpdatePackageReferences"),
-		updatePackages:                         op("UpdatePackages"),
+		updateInferredIndexConfigurationByRepositoryID: op("UpdateInferredIndexConfigurationByRepositoryID"),
+		updatePackageReferences:                        op("UpdatePackageReferences"),
+		updatePackages:                                 op("UpdatePackages"),
+		updateRetentionPolicy:                          op("UpdateRetentionPolicy"),
+		updateUploadProgress:                           op("UpdateUploadProgress"),
+		updateUploadRetention:                          op("UpdateUploadRetention"),
+		updateUsageStatistics:                          op("UpdateUsageStatistics"),
+		usageStatistics:                                op("UsageStatistics"),
 
 		writeVisibleUploads:        subOp("writeVisibleUploads"),
 		persistNearestUploads:      subOp("persistNearestUploads"),
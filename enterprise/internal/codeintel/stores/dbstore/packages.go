@@ -2,15 +2,125 @@ package dbstore
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/keegancsmith/sqlf"
 	"github.com/opentracing/opentracing-go/log"
 
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	"github.com/sourcegraph/sourcegraph/internal/database/batch"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
 )
 
+// PackageKey identifies a package by its scheme, name, and version.
+type PackageKey struct {
+	Scheme  string
+	Name    string
+	Version string
+}
+
+// GetPackages returns the dump that provides each of the given packages. Packages which are not
+// provided by any dump are absent from the result. If more than one dump provides the same package,
+// the most recent one is returned.
+func (s *Store) GetPackages(ctx context.Context, keys []PackageKey) (_ map[PackageKey]Dump, err error) {
+	ctx, traceLog, endObservation := s.operations.getPackages.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("numKeys", len(keys)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	qs := make([]*sqlf.Query, 0, len(keys))
+	for _, key := range keys {
+		qs = append(qs, sqlf.Sprintf("(%s, %s, %s)", key.Scheme, key.Name, key.Version))
+	}
+
+	dumps, err := scanPackageDumps(s.Query(ctx, sqlf.Sprintf(getPackagesQuery, sqlf.Join(qs, ", "))))
+	if err != nil {
+		return nil, err
+	}
+	traceLog(log.Int("numDumps", len(dumps)))
+
+	return dumps, nil
+}
+
+const getPackagesQuery = `
+-- source: enterprise/internal/codeintel/stores/dbstore/packages.go:GetPackages
+WITH matching_packages AS (
+	SELECT p.scheme, p.name, p.version, MAX(p.dump_id) AS dump_id
+	FROM lsif_packages p
+	JOIN (VALUES %s) AS k(scheme, name, version) ON k.scheme = p.scheme AND k.name = p.name AND k.version = p.version
+	GROUP BY p.scheme, p.name, p.version
+)
+SELECT
+	mp.scheme,
+	mp.name,
+	mp.version,
+	d.id,
+	d.commit,
+	d.root,
+	` + visibleAtTipFragment + ` AS visible_at_tip,
+	d.uploaded_at,
+	d.state,
+	d.failure_message,
+	d.started_at,
+	d.finished_at,
+	d.process_after,
+	d.num_resets,
+	d.num_failures,
+	d.repository_id,
+	d.repository_name,
+	d.indexer,
+	d.associated_index_id
+FROM matching_packages mp
+JOIN lsif_dumps_with_repository_name d ON d.id = mp.dump_id
+`
+
+// scanPackageDumps scans a map of package keys to the dump providing that package from the
+// return value of `*Store.query`.
+func scanPackageDumps(rows *sql.Rows, queryErr error) (_ map[PackageKey]Dump, err error) {
+	if queryErr != nil {
+		return nil, queryErr
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	dumps := map[PackageKey]Dump{}
+	for rows.Next() {
+		var key PackageKey
+		var dump Dump
+		if err := rows.Scan(
+			&key.Scheme,
+			&key.Name,
+			&key.Version,
+			&dump.ID,
+			&dump.Commit,
+			&dump.Root,
+			&dump.VisibleAtTip,
+			&dump.UploadedAt,
+			&dump.State,
+			&dump.FailureMessage,
+			&dump.StartedAt,
+			&dump.FinishedAt,
+			&dump.ProcessAfter,
+			&dump.NumResets,
+			&dump.NumFailures,
+			&dump.RepositoryID,
+			&dump.RepositoryName,
+			&dump.Indexer,
+			&dump.AssociatedIndexID,
+		); err != nil {
+			return nil, err
+		}
+
+		dumps[key] = dump
+	}
+
+	return dumps, nil
+}
+
 // UpdatePackages upserts package data tied to the given upload.
 func (s *Store) UpdatePackages(ctx context.Context, dumpID int, packages []semantic.Package) (err error) {
 	ctx, endObservation := s.operations.updatePackages.With(ctx, &err, observation.Args{LogFields: []log.Field{
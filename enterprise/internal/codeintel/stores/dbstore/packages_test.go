@@ -3,6 +3,9 @@ package dbstore
 import (
 	"context"
 	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
 
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtesting"
@@ -62,3 +65,106 @@ func TestUpdatePackagesEmpty(t *testing.T) {
 		t.Errorf("unexpected package count. want=%d have=%d", 0, count)
 	}
 }
+
+func TestGetPackages(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	db := dbtesting.GetDB(t)
+	store := testStore(db)
+
+	key1 := PackageKey{Scheme: "gomod", Name: "leftpad", Version: "0.1.0"}
+	key2 := PackageKey{Scheme: "npm", Name: "north-pad", Version: "0.2.0"}
+	key3 := PackageKey{Scheme: "npm", Name: "north-pad", Version: "0.3.0"}
+
+	// Package does not exist initially
+	if dumps, err := store.GetPackages(context.Background(), []PackageKey{key1}); err != nil {
+		t.Fatalf("unexpected error getting packages: %s", err)
+	} else if len(dumps) != 0 {
+		t.Fatal("unexpected record")
+	}
+
+	uploadedAt := time.Unix(1587396557, 0).UTC()
+	startedAt := uploadedAt.Add(time.Minute)
+	finishedAt := uploadedAt.Add(time.Minute * 2)
+	expected1 := Dump{
+		ID:             1,
+		Commit:         makeCommit(1),
+		Root:           "sub/",
+		VisibleAtTip:   true,
+		UploadedAt:     uploadedAt,
+		State:          "completed",
+		FailureMessage: nil,
+		StartedAt:      &startedAt,
+		FinishedAt:     &finishedAt,
+		RepositoryID:   50,
+		RepositoryName: "n-50",
+		Indexer:        "lsif-go",
+	}
+	expected2 := Dump{
+		ID:                2,
+		Commit:            makeCommit(2),
+		Root:              "other/",
+		VisibleAtTip:      false,
+		UploadedAt:        uploadedAt,
+		State:             "completed",
+		FailureMessage:    nil,
+		StartedAt:         &startedAt,
+		FinishedAt:        &finishedAt,
+		RepositoryID:      50,
+		RepositoryName:    "n-50",
+		Indexer:           "lsif-tsc",
+		AssociatedIndexID: nil,
+	}
+	expected3 := Dump{
+		ID:             3,
+		Commit:         makeCommit(3),
+		Root:           "third/",
+		VisibleAtTip:   false,
+		UploadedAt:     uploadedAt,
+		State:          "completed",
+		FailureMessage: nil,
+		StartedAt:      &startedAt,
+		FinishedAt:     &finishedAt,
+		RepositoryID:   50,
+		RepositoryName: "n-50",
+		Indexer:        "lsif-go",
+	}
+
+	insertUploads(t, db, dumpToUpload(expected1), dumpToUpload(expected2))
+	insertVisibleAtTip(t, db, 50, 1)
+
+	if err := store.UpdatePackages(context.Background(), 1, []semantic.Package{
+		{Scheme: "gomod", Name: "leftpad", Version: "0.1.0"},
+		{Scheme: "gomod", Name: "leftpad", Version: "0.1.0"},
+	}); err != nil {
+		t.Fatalf("unexpected error updating packages: %s", err)
+	}
+
+	if err := store.UpdatePackages(context.Background(), 2, []semantic.Package{
+		{Scheme: "npm", Name: "north-pad", Version: "0.2.0"},
+	}); err != nil {
+		t.Fatalf("unexpected error updating packages: %s", err)
+	}
+
+	if dumps, err := store.GetPackages(context.Background(), []PackageKey{key1, key2, key3}); err != nil {
+		t.Fatalf("unexpected error getting packages: %s", err)
+	} else if diff := cmp.Diff(map[PackageKey]Dump{key1: expected1, key2: expected2}, dumps); diff != "" {
+		t.Errorf("unexpected dumps (-want +got):\n%s", diff)
+	}
+
+	// The most recent dump providing a package wins
+	insertUploads(t, db, dumpToUpload(expected3))
+
+	if err := store.UpdatePackages(context.Background(), 3, []semantic.Package{
+		{Scheme: "gomod", Name: "leftpad", Version: "0.1.0"},
+	}); err != nil {
+		t.Fatalf("unexpected error updating packages: %s", err)
+	}
+
+	if dumps, err := store.GetPackages(context.Background(), []PackageKey{key1}); err != nil {
+		t.Fatalf("unexpected error getting packages: %s", err)
+	} else if diff := cmp.Diff(map[PackageKey]Dump{key1: expected3}, dumps); diff != "" {
+		t.Errorf("unexpected dumps (-want +got):\n%s", diff)
+	}
+}
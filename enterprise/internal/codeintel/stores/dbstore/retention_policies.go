@@ -0,0 +1,295 @@
+package dbstore
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// RetentionPolicyType is the type of git ref matched by a retention policy.
+type RetentionPolicyType string
+
+const (
+	RetentionPolicyTypeBranch RetentionPolicyType = "GIT_BRANCH"
+	RetentionPolicyTypeTag    RetentionPolicyType = "GIT_TAG"
+)
+
+// RetentionPolicy protects uploads of repositories matching RepositoryPattern whose commit is the
+// tip of a branch or tag (depending on Type) matching Pattern. Protected uploads are not expired
+// until they are older than RetentionDuration. A nil RetentionDuration protects uploads forever.
+type RetentionPolicy struct {
+	ID                int                 `json:"id"`
+	Name              string              `json:"name"`
+	RepositoryPattern string              `json:"repositoryPattern"`
+	Type              RetentionPolicyType `json:"type"`
+	Pattern           string              `json:"pattern"`
+	RetentionDuration *time.Duration      `json:"retentionDuration"`
+	CreatedAt         time.Time           `json:"createdAt"`
+}
+
+// scanRetentionPolicies scans a slice of retention policies from the return value of `*Store.query`.
+func scanRetentionPolicies(rows *sql.Rows, queryErr error) (_ []RetentionPolicy, err error) {
+	if queryErr != nil {
+		return nil, queryErr
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var policies []RetentionPolicy
+	for rows.Next() {
+		var policy RetentionPolicy
+		var retentionDurationHours *int
+		if err := rows.Scan(
+			&policy.ID,
+			&policy.Name,
+			&policy.RepositoryPattern,
+			&policy.Type,
+			&policy.Pattern,
+			&retentionDurationHours,
+			&policy.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		if retentionDurationHours != nil {
+			duration := time.Duration(*retentionDurationHours) * time.Hour
+			policy.RetentionDuration = &duration
+		}
+
+		policies = append(policies, policy)
+	}
+
+	return policies, nil
+}
+
+// scanFirstRetentionPolicy scans a slice of retention policies from the return value of `*Store.query`
+// and returns the first.
+func scanFirstRetentionPolicy(rows *sql.Rows, err error) (RetentionPolicy, bool, error) {
+	policies, err := scanRetentionPolicies(rows, err)
+	if err != nil || len(policies) == 0 {
+		return RetentionPolicy{}, false, err
+	}
+	return policies[0], true, nil
+}
+
+// GetRetentionPolicies returns all retention policies ordered by identifier.
+func (s *Store) GetRetentionPolicies(ctx context.Context) (_ []RetentionPolicy, err error) {
+	ctx, traceLog, endObservation := s.operations.getRetentionPolicies.WithAndLogger(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	policies, err := scanRetentionPolicies(s.Store.Query(ctx, sqlf.Sprintf(getRetentionPoliciesQuery)))
+	if err != nil {
+		return nil, err
+	}
+	traceLog(log.Int("numPolicies", len(policies)))
+
+	return policies, nil
+}
+
+const getRetentionPoliciesQuery = `
+-- source: enterprise/internal/codeintel/stores/dbstore/retention_policies.go:GetRetentionPolicies
+SELECT
+	p.id,
+	p.name,
+	p.repository_pattern,
+	p.type,
+	p.pattern,
+	p.retention_duration_hours,
+	p.created_at
+FROM lsif_retention_policies p
+ORDER BY p.id
+`
+
+// GetRetentionPolicyByID returns the retention policy with the given identifier.
+func (s *Store) GetRetentionPolicyByID(ctx context.Context, id int) (_ RetentionPolicy, _ bool, err error) {
+	ctx, endObservation := s.operations.getRetentionPolicyByID.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("id", id),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return scanFirstRetentionPolicy(s.Store.Query(ctx, sqlf.Sprintf(getRetentionPolicyByIDQuery, id)))
+}
+
+const getRetentionPolicyByIDQuery = `
+-- source: enterprise/internal/codeintel/stores/dbstore/retention_policies.go:GetRetentionPolicyByID
+SELECT
+	p.id,
+	p.name,
+	p.repository_pattern,
+	p.type,
+	p.pattern,
+	p.retention_duration_hours,
+	p.created_at
+FROM lsif_retention_policies p
+WHERE p.id = %s
+`
+
+// CreateRetentionPolicy creates a retention policy with the given fields and returns the
+// newly created record. The identifier and creation time of the given policy are ignored.
+func (s *Store) CreateRetentionPolicy(ctx context.Context, policy RetentionPolicy) (_ RetentionPolicy, err error) {
+	ctx, endObservation := s.operations.createRetentionPolicy.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("name", policy.Name),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	policy, _, err = scanFirstRetentionPolicy(s.Store.Query(ctx, sqlf.Sprintf(
+		createRetentionPolicyQuery,
+		policy.Name,
+		policy.RepositoryPattern,
+		policy.Type,
+		policy.Pattern,
+		retentionDurationHours(policy.RetentionDuration),
+	)))
+	return policy, err
+}
+
+const createRetentionPolicyQuery = `
+-- source: enterprise/internal/codeintel/stores/dbstore/retention_policies.go:CreateRetentionPolicy
+INSERT INTO lsif_retention_policies (name, repository_pattern, type, pattern, retention_duration_hours)
+VALUES (%s, %s, %s, %s, %s)
+RETURNING id, name, repository_pattern, type, pattern, retention_duration_hours, created_at
+`
+
+// UpdateRetentionPolicy updates the fields of the retention policy with the same identifier as the
+// given policy. This method returns false if no such policy exists.
+func (s *Store) UpdateRetentionPolicy(ctx context.Context, policy RetentionPolicy) (_ bool, err error) {
+	ctx, endObservation := s.operations.updateRetentionPolicy.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("id", policy.ID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	_, exists, err := basestore.ScanFirstInt(s.Store.Query(ctx, sqlf.Sprintf(
+		updateRetentionPolicyQuery,
+		policy.Name,
+		policy.RepositoryPattern,
+		policy.Type,
+		policy.Pattern,
+		retentionDurationHours(policy.RetentionDuration),
+		policy.ID,
+	)))
+	return exists, err
+}
+
+const updateRetentionPolicyQuery = `
+-- source: enterprise/internal/codeintel/stores/dbstore/retention_policies.go:UpdateRetentionPolicy
+UPDATE lsif_retention_policies
+SET
+	name = %s,
+	repository_pattern = %s,
+	type = %s,
+	pattern = %s,
+	retention_duration_hours = %s
+WHERE id = %s
+RETURNING id
+`
+
+// DeleteRetentionPolicyByID deletes the retention policy with the given identifier. This method
+// returns false if no such policy exists.
+func (s *Store) DeleteRetentionPolicyByID(ctx context.Context, id int) (_ bool, err error) {
+	ctx, endObservation := s.operations.deleteRetentionPolicyByID.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("id", id),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	_, exists, err := basestore.ScanFirstInt(s.Store.Query(ctx, sqlf.Sprintf(deleteRetentionPolicyByIDQuery, id)))
+	return exists, err
+}
+
+const deleteRetentionPolicyByIDQuery = `
+-- source: enterprise/internal/codeintel/stores/dbstore/retention_policies.go:DeleteRetentionPolicyByID
+DELETE FROM lsif_retention_policies WHERE id = %s RETURNING id
+`
+
+// GetRepositoriesWithCompletedUploads returns the identifiers of repositories with at least one
+// completed upload.
+func (s *Store) GetRepositoriesWithCompletedUploads(ctx context.Context) (_ []int, err error) {
+	ctx, traceLog, endObservation := s.operations.getRepositoriesWithCompletedUploads.WithAndLogger(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	repositories, err := basestore.ScanInts(s.Store.Query(ctx, sqlf.Sprintf(getRepositoriesWithCompletedUploadsQuery)))
+	if err != nil {
+		return nil, err
+	}
+	traceLog(log.Int("numRepositories", len(repositories)))
+
+	return repositories, nil
+}
+
+const getRepositoriesWithCompletedUploadsQuery = `
+-- source: enterprise/internal/codeintel/stores/dbstore/retention_policies.go:GetRepositoriesWithCompletedUploads
+SELECT DISTINCT u.repository_id FROM lsif_uploads u WHERE u.state = 'completed' ORDER BY u.repository_id
+`
+
+// UpdateUploadRetention marks the given uploads of the given repository as protected (and all other
+// completed uploads of the repository as unprotected), then soft-deletes the given expired uploads.
+// Protected uploads are never deleted by this method. This method returns the number of uploads that
+// were deleted.
+func (s *Store) UpdateUploadRetention(ctx context.Context, repositoryID int, protectedIDs, expiredIDs []int) (count int, err error) {
+	ctx, traceLog, endObservation := s.operations.updateUploadRetention.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("repositoryID", repositoryID),
+		log.Int("numProtectedIDs", len(protectedIDs)),
+		log.Int("numExpiredIDs", len(expiredIDs)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	tx, err := s.transact(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	if err := tx.Store.Exec(ctx, sqlf.Sprintf(updateUploadsProtectedQuery, pq.Array(protectedIDs), repositoryID)); err != nil {
+		return 0, err
+	}
+
+	if len(expiredIDs) == 0 {
+		return 0, nil
+	}
+
+	ids, err := basestore.ScanInts(tx.Store.Query(ctx, sqlf.Sprintf(softDeleteExpiredUploadsQuery, repositoryID, pq.Array(expiredIDs))))
+	if err != nil {
+		return 0, err
+	}
+	count = len(ids)
+	traceLog(log.Int("count", count))
+
+	if count > 0 {
+		if err := tx.MarkRepositoryAsDirty(ctx, repositoryID); err != nil {
+			return 0, err
+		}
+	}
+
+	return count, nil
+}
+
+const updateUploadsProtectedQuery = `
+-- source: enterprise/internal/codeintel/stores/dbstore/retention_policies.go:UpdateUploadRetention
+UPDATE lsif_uploads u
+SET protected = u.id = ANY(%s)
+WHERE u.repository_id = %s AND u.state = 'completed'
+`
+
+const softDeleteExpiredUploadsQuery = `
+-- source: enterprise/internal/codeintel/stores/dbstore/retention_policies.go:UpdateUploadRetention
+UPDATE lsif_uploads u
+SET state = 'deleted'
+WHERE u.repository_id = %s AND u.id = ANY(%s) AND u.state = 'completed' AND NOT u.protected
+RETURNING u.id
+`
+
+// retentionDurationHours converts the given retention duration into the nullable number of hours
+// stored in the lsif_retention_policies table.
+func retentionDurationHours(duration *time.Duration) *int {
+	if duration == nil {
+		return nil
+	}
+
+	hours := int(*duration / time.Hour)
+	return &hours
+}
@@ -0,0 +1,134 @@
+package dbstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtesting"
+)
+
+func TestRetentionPolicies(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	db := dbtesting.GetDB(t)
+	store := testStore(db)
+
+	week := time.Hour * 24 * 7
+	policy1, err := store.CreateRetentionPolicy(context.Background(), RetentionPolicy{
+		Name:              "release tags",
+		RepositoryPattern: "github.com/sourcegraph/*",
+		Type:              RetentionPolicyTypeTag,
+		Pattern:           "v*",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating retention policy: %s", err)
+	}
+	policy2, err := store.CreateRetentionPolicy(context.Background(), RetentionPolicy{
+		Name:              "main",
+		RepositoryPattern: "*",
+		Type:              RetentionPolicyTypeBranch,
+		Pattern:           "main",
+		RetentionDuration: &week,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating retention policy: %s", err)
+	}
+
+	expectedPolicies := []RetentionPolicy{
+		{ID: policy1.ID, Name: "release tags", RepositoryPattern: "github.com/sourcegraph/*", Type: RetentionPolicyTypeTag, Pattern: "v*"},
+		{ID: policy2.ID, Name: "main", RepositoryPattern: "*", Type: RetentionPolicyTypeBranch, Pattern: "main", RetentionDuration: &week},
+	}
+	ignoreCreatedAt := cmpopts.IgnoreFields(RetentionPolicy{}, "CreatedAt")
+
+	if policies, err := store.GetRetentionPolicies(context.Background()); err != nil {
+		t.Fatalf("unexpected error getting retention policies: %s", err)
+	} else if diff := cmp.Diff(expectedPolicies, policies, ignoreCreatedAt); diff != "" {
+		t.Errorf("unexpected retention policies (-want +got):\n%s", diff)
+	}
+
+	updatedPolicy := expectedPolicies[1]
+	updatedPolicy.Pattern = "release/*"
+	updatedPolicy.RetentionDuration = nil
+	if exists, err := store.UpdateRetentionPolicy(context.Background(), updatedPolicy); err != nil {
+		t.Fatalf("unexpected error updating retention policy: %s", err)
+	} else if !exists {
+		t.Fatalf("expected retention policy to exist")
+	}
+
+	if policy, exists, err := store.GetRetentionPolicyByID(context.Background(), policy2.ID); err != nil {
+		t.Fatalf("unexpected error getting retention policy: %s", err)
+	} else if !exists {
+		t.Fatalf("expected retention policy to exist")
+	} else if diff := cmp.Diff(updatedPolicy, policy, ignoreCreatedAt); diff != "" {
+		t.Errorf("unexpected retention policy (-want +got):\n%s", diff)
+	}
+
+	if exists, err := store.DeleteRetentionPolicyByID(context.Background(), policy1.ID); err != nil {
+		t.Fatalf("unexpected error deleting retention policy: %s", err)
+	} else if !exists {
+		t.Fatalf("expected retention policy to exist")
+	}
+
+	if _, exists, err := store.GetRetentionPolicyByID(context.Background(), policy1.ID); err != nil {
+		t.Fatalf("unexpected error getting retention policy: %s", err)
+	} else if exists {
+		t.Fatalf("unexpected retention policy")
+	}
+}
+
+func TestUpdateUploadRetention(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	db := dbtesting.GetDB(t)
+	store := testStore(db)
+
+	insertUploads(t, db,
+		Upload{ID: 1, State: "completed"},
+		Upload{ID: 2, State: "completed"},
+		Upload{ID: 3, State: "completed"},
+		Upload{ID: 4, State: "errored"},
+		Upload{ID: 5, State: "completed", RepositoryID: 51},
+	)
+
+	// Upload 2 is protected and expired; protection wins
+	if count, err := store.UpdateUploadRetention(context.Background(), 50, []int{2}, []int{2, 3, 4, 5}); err != nil {
+		t.Fatalf("unexpected error updating upload retention: %s", err)
+	} else if count != 1 {
+		t.Fatalf("unexpected number of uploads deleted: want=%d have=%d", 1, count)
+	}
+
+	expectedStates := map[int]string{
+		1: "completed",
+		2: "completed",
+		3: "deleted",
+		4: "errored",
+		5: "completed",
+	}
+	if states, err := getUploadStates(db, 1, 2, 3, 4, 5); err != nil {
+		t.Fatalf("unexpected error getting states: %s", err)
+	} else if diff := cmp.Diff(expectedStates, states); diff != "" {
+		t.Errorf("unexpected upload states (-want +got):\n%s", diff)
+	}
+
+	if repositoryIDs, err := store.GetRepositoriesWithCompletedUploads(context.Background()); err != nil {
+		t.Fatalf("unexpected error getting repositories: %s", err)
+	} else if diff := cmp.Diff([]int{50, 51}, repositoryIDs); diff != "" {
+		t.Errorf("unexpected repository identifiers (-want +got):\n%s", diff)
+	}
+
+	// Protected uploads are not expired by age
+	if _, err := store.SoftDeleteOldUploads(context.Background(), time.Minute, time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("unexpected error pruning uploads: %s", err)
+	}
+	if states, err := getUploadStates(db, 1, 2); err != nil {
+		t.Fatalf("unexpected error getting states: %s", err)
+	} else if diff := cmp.Diff(map[int]string{1: "deleted", 2: "completed"}, states); diff != "" {
+		t.Errorf("unexpected upload states (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,130 @@
+package dbstore
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+const (
+	// UploadProgressPhaseCorrelating is the phase in which the raw upload is read and correlated.
+	UploadProgressPhaseCorrelating = "correlating"
+
+	// UploadProgressPhaseWriting is the phase in which the correlated data is written to the
+	// codeintel database.
+	UploadProgressPhaseWriting = "writing"
+
+	// UploadProgressPhaseFinalizing is the phase in which the upload record and its package data
+	// are updated in the frontend database.
+	UploadProgressPhaseFinalizing = "finalizing"
+)
+
+// UploadProgress describes how far the worker has gotten processing an upload.
+type UploadProgress struct {
+	UploadID         int
+	Phase            string
+	BytesRead        int64
+	DocumentsWritten int
+	UpdatedAt        time.Time
+}
+
+// scanUploadProgress scans a slice of upload progress records from the return value of `*Store.query`.
+func scanUploadProgress(rows *sql.Rows, queryErr error) (_ []UploadProgress, err error) {
+	if queryErr != nil {
+		return nil, queryErr
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var progress []UploadProgress
+	for rows.Next() {
+		var p UploadProgress
+		if err := rows.Scan(&p.UploadID, &p.Phase, &p.BytesRead, &p.DocumentsWritten, &p.UpdatedAt); err != nil {
+			return nil, err
+		}
+
+		progress = append(progress, p)
+	}
+
+	return progress, nil
+}
+
+// scanFirstUploadProgress scans a slice of upload progress records from the return value of `*Store.query`
+// and returns the first.
+func scanFirstUploadProgress(rows *sql.Rows, err error) (UploadProgress, bool, error) {
+	progress, err := scanUploadProgress(rows, err)
+	if err != nil || len(progress) == 0 {
+		return UploadProgress{}, false, err
+	}
+	return progress[0], true, nil
+}
+
+// GetUploadProgress returns the last progress reported for the upload with the given identifier and
+// a boolean flag indicating its existence.
+func (s *Store) GetUploadProgress(ctx context.Context, uploadID int) (_ UploadProgress, _ bool, err error) {
+	ctx, endObservation := s.operations.getUploadProgress.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("uploadID", uploadID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return scanFirstUploadProgress(s.Store.Query(ctx, sqlf.Sprintf(getUploadProgressQuery, uploadID)))
+}
+
+const getUploadProgressQuery = `
+-- source: enterprise/internal/codeintel/stores/dbstore/upload_progress.go:GetUploadProgress
+SELECT upload_id, phase, bytes_read, documents_written, updated_at
+FROM lsif_upload_progress
+WHERE upload_id = %s
+`
+
+// UpdateUploadProgress records the given progress of an upload, replacing any progress previously
+// reported for the same upload. This method must not be called within the transaction that holds
+// the lock on the upload record, otherwise the progress is not visible until processing ends.
+func (s *Store) UpdateUploadProgress(ctx context.Context, progress UploadProgress) (err error) {
+	ctx, endObservation := s.operations.updateUploadProgress.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("uploadID", progress.UploadID),
+		log.String("phase", progress.Phase),
+		log.Int64("bytesRead", progress.BytesRead),
+		log.Int("documentsWritten", progress.DocumentsWritten),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return s.Store.Exec(ctx, sqlf.Sprintf(
+		updateUploadProgressQuery,
+		progress.UploadID,
+		progress.Phase,
+		progress.BytesRead,
+		progress.DocumentsWritten,
+	))
+}
+
+const updateUploadProgressQuery = `
+-- source: enterprise/internal/codeintel/stores/dbstore/upload_progress.go:UpdateUploadProgress
+INSERT INTO lsif_upload_progress (upload_id, phase, bytes_read, documents_written, updated_at)
+VALUES (%s, %s, %s, %s, NOW())
+ON CONFLICT (upload_id) DO UPDATE SET
+	phase = EXCLUDED.phase,
+	bytes_read = EXCLUDED.bytes_read,
+	documents_written = EXCLUDED.documents_written,
+	updated_at = EXCLUDED.updated_at
+`
+
+// DeleteUploadProgress removes the progress reported for the upload with the given identifier.
+func (s *Store) DeleteUploadProgress(ctx context.Context, uploadID int) (err error) {
+	ctx, endObservation := s.operations.deleteUploadProgress.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("uploadID", uploadID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return s.Store.Exec(ctx, sqlf.Sprintf(deleteUploadProgressQuery, uploadID))
+}
+
+const deleteUploadProgressQuery = `
+-- source: enterprise/internal/codeintel/stores/dbstore/upload_progress.go:DeleteUploadProgress
+DELETE FROM lsif_upload_progress WHERE upload_id = %s
+`
@@ -0,0 +1,55 @@
+package dbstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtesting"
+)
+
+func TestUploadProgress(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	db := dbtesting.GetDB(t)
+	store := testStore(db)
+
+	insertUploads(t, db, Upload{ID: 1, State: "processing"})
+
+	// Progress does not exist initially
+	if _, exists, err := store.GetUploadProgress(context.Background(), 1); err != nil {
+		t.Fatalf("unexpected error getting upload progress: %s", err)
+	} else if exists {
+		t.Fatal("unexpected record")
+	}
+
+	for _, progress := range []UploadProgress{
+		{UploadID: 1, Phase: UploadProgressPhaseCorrelating, BytesRead: 1024},
+		{UploadID: 1, Phase: UploadProgressPhaseWriting, BytesRead: 2048, DocumentsWritten: 15},
+	} {
+		if err := store.UpdateUploadProgress(context.Background(), progress); err != nil {
+			t.Fatalf("unexpected error updating upload progress: %s", err)
+		}
+	}
+
+	expected := UploadProgress{UploadID: 1, Phase: UploadProgressPhaseWriting, BytesRead: 2048, DocumentsWritten: 15}
+	if progress, exists, err := store.GetUploadProgress(context.Background(), 1); err != nil {
+		t.Fatalf("unexpected error getting upload progress: %s", err)
+	} else if !exists {
+		t.Fatal("expected record to exist")
+	} else if diff := cmp.Diff(expected, progress, cmpopts.IgnoreFields(UploadProgress{}, "UpdatedAt")); diff != "" {
+		t.Errorf("unexpected upload progress (-want +got):\n%s", diff)
+	}
+
+	if err := store.DeleteUploadProgress(context.Background(), 1); err != nil {
+		t.Fatalf("unexpected error deleting upload progress: %s", err)
+	}
+	if _, exists, err := store.GetUploadProgress(context.Background(), 1); err != nil {
+		t.Fatalf("unexpected error getting upload progress: %s", err)
+	} else if exists {
+		t.Fatal("unexpected record")
+	}
+}
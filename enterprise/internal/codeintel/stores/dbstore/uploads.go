@@ -264,6 +264,99 @@ WITH deleted AS (
 SELECT count(*) FROM deleted
 `
 
+// uploadProcessingTimeoutMessage is the failure message of uploads marked as errored by
+// FailUploadsStuckProcessing.
+const uploadProcessingTimeoutMessage = "upload processing exceeded the processing deadline"
+
+// FailUploadsStuckProcessing marks at most limit upload records that have been processing since
+// before the given time as errored. Records that are still locked by the worker processing them
+// are skipped. This method returns the number of records marked as errored and the number of
+// candidate records that were skipped as they are locked.
+func (s *Store) FailUploadsStuckProcessing(ctx context.Context, startedBefore time.Time, limit int) (failed, locked int, err error) {
+	ctx, traceLog, endObservation := s.operations.failUploadsStuckProcessing.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("startedBefore", startedBefore.Format(time.RFC3339)),
+		log.Int("limit", limit),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	rows, err := s.Store.Query(ctx, sqlf.Sprintf(failUploadsStuckProcessingQuery, startedBefore, limit, time.Now().UTC(), uploadProcessingTimeoutMessage))
+	if err != nil {
+		return 0, 0, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	if !rows.Next() {
+		return 0, 0, nil
+	}
+
+	if err := rows.Scan(&failed, &locked); err != nil {
+		return 0, 0, err
+	}
+	traceLog(
+		log.Int("failed", failed),
+		log.Int("locked", locked),
+	)
+
+	return failed, locked, nil
+}
+
+const failUploadsStuckProcessingQuery = `
+-- source: enterprise/internal/codeintel/stores/dbstore/uploads.go:FailUploadsStuckProcessing
+WITH
+candidates AS (
+	SELECT id FROM lsif_uploads
+	WHERE state = 'processing' AND started_at < %s
+	ORDER BY started_at, id
+	LIMIT %s
+),
+unlocked AS (
+	-- The worker processing an upload holds a lock on the upload record for the
+	-- duration of processing. We skip these records rather than blocking on them.
+	SELECT id FROM lsif_uploads
+	WHERE id IN (SELECT id FROM candidates)
+	FOR UPDATE SKIP LOCKED
+),
+failed AS (
+	UPDATE lsif_uploads u
+	SET state = 'errored', finished_at = %s, failure_message = %s
+	WHERE u.id IN (SELECT id FROM unlocked) AND u.state = 'processing'
+	RETURNING 1
+)
+SELECT
+	(SELECT COUNT(*) FROM failed) AS num_failed,
+	(SELECT COUNT(*) FROM candidates) - (SELECT COUNT(*) FROM unlocked) AS num_locked
+`
+
+// GetOrphanedUploadIDs returns the subset of the given upload identifiers that have no upload
+// record, or whose upload record is in the errored state. Data in the codeintel database that
+// belongs to one of these identifiers can no longer be reached and can be removed.
+func (s *Store) GetOrphanedUploadIDs(ctx context.Context, ids []int) (_ []int, err error) {
+	ctx, traceLog, endObservation := s.operations.getOrphanedUploadIDs.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("numIDs", len(ids)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	orphanedIDs, err := basestore.ScanInts(s.Store.Query(ctx, sqlf.Sprintf(getOrphanedUploadIDsQuery, pq.Array(ids))))
+	if err != nil {
+		return nil, err
+	}
+	traceLog(log.Int("numOrphanedIDs", len(orphanedIDs)))
+
+	return orphanedIDs, nil
+}
+
+const getOrphanedUploadIDsQuery = `
+-- source: enterprise/internal/codeintel/stores/dbstore/uploads.go:GetOrphanedUploadIDs
+SELECT t.id
+FROM unnest(%s::integer[]) AS t(id)
+WHERE NOT EXISTS (SELECT 1 FROM lsif_uploads u WHERE u.id = t.id AND u.state != 'errored')
+ORDER BY t.id
+`
+
 type GetUploadsOptions struct {
 	RepositoryID   int
 	State          string
@@ -569,6 +662,33 @@ const deleteUploadByIDQuery = `
 UPDATE lsif_uploads SET state = 'deleted' WHERE id = %s RETURNING repository_id
 `
 
+// ReindexUploadByID resets the processing state of an errored upload so that its stored bundle is
+// processed again by the worker. The original upload metadata (commit, root, indexer, upload time, and
+// associated index) is preserved. This method returns a true-valued flag if a record was requeued.
+func (s *Store) ReindexUploadByID(ctx context.Context, id int) (_ bool, err error) {
+	ctx, endObservation := s.operations.reindexUploadByID.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("id", id),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	_, requeued, err := basestore.ScanFirstInt(s.Store.Query(ctx, sqlf.Sprintf(reindexUploadByIDQuery, id)))
+	return requeued, err
+}
+
+const reindexUploadByIDQuery = `
+-- source: enterprise/internal/codeintel/stores/dbstore/uploads.go:ReindexUploadByID
+UPDATE lsif_uploads SET
+	state = 'queued',
+	failure_message = NULL,
+	started_at = NULL,
+	finished_at = NULL,
+	process_after = NULL,
+	num_resets = 0,
+	num_failures = 0
+WHERE id = %s AND state = 'errored'
+RETURNING id
+`
+
 // DeletedRepositoryGracePeriod is the minimum allowable duration between a repo deletion
 // and the upload and index records for that repository being deleted.
 const DeletedRepositoryGracePeriod = time.Minute * 30
@@ -634,17 +754,20 @@ func (s *Store) HardDeleteUploadByID(ctx context.Context, ids ...int) (err error
 		idQueries = append(idQueries, sqlf.Sprintf("%s", id))
 	}
 
-	return s.Store.Exec(ctx, sqlf.Sprintf(hardDeleteUploadByIDQuery, sqlf.Join(idQueries, ", ")))
+	return s.Store.Exec(ctx, sqlf.Sprintf(hardDeleteUploadByIDQuery, sqlf.Join(idQueries, ", "), sqlf.Join(idQueries, ", ")))
 }
 
 const hardDeleteUploadByIDQuery = `
 -- source: enterprise/internal/codeintel/stores/dbstore/uploads.go:HardDeleteUploadByID
+WITH deleted_progress AS (
+	DELETE FROM lsif_upload_progress WHERE upload_id IN (%s)
+)
 DELETE FROM lsif_uploads WHERE id IN (%s)
 `
 
 // SoftDeleteOldUploads marks uploads older than the given age that are not visible at the tip of the default branch
-// as deleted. The associated repositories will be marked as dirty so that their commit graphs are updated in the
-// background.
+// (and are not protected by a retention policy) as deleted. The associated repositories will be marked as dirty so
+// that their commit graphs are updated in the background.
 func (s *Store) SoftDeleteOldUploads(ctx context.Context, maxAge time.Duration, now time.Time) (count int, err error) {
 	ctx, traceLog, endObservation := s.operations.softDeleteOldUploads.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
 		log.String("maxAge", maxAge.String()),
@@ -690,6 +813,7 @@ WITH u AS (
 				%s - u.finished_at > (%s || ' second')::interval OR
 				(u.finished_at IS NULL AND %s - u.uploaded_at > (%s || ' second')::interval)
 			) AND
+				NOT u.protected AND
 				u.id NOT IN (SELECT uv.upload_id FROM lsif_uploads_visible_at_tip uv WHERE uv.repository_id = u.repository_id)
 		RETURNING id, repository_id
 )
@@ -746,3 +870,29 @@ func nilTimeToString(t *time.Time) string {
 
 	return t.String()
 }
+
+// uploadCompletedPayload is the payload of the upload.completed outbox event.
+type uploadCompletedPayload struct {
+	ID           int    `json:"id"`
+	RepositoryID int    `json:"repositoryId"`
+	Commit       string `json:"commit"`
+	Root         string `json:"root"`
+	Indexer      string `json:"indexer"`
+}
+
+// PublishUploadCompleted publishes an upload.completed outbox event for the given upload. This
+// should be called in the same transaction which writes the processed data of the upload.
+func (s *Store) PublishUploadCompleted(ctx context.Context, upload Upload) (err error) {
+	ctx, endObservation := s.operations.publishUploadCompleted.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("id", upload.ID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return database.OutboxEventsWith(s.Store).Publish(ctx, database.OutboxTopicUploadCompleted, upload.ID, uploadCompletedPayload{
+		ID:           upload.ID,
+		RepositoryID: upload.RepositoryID,
+		Commit:       upload.Commit,
+		Root:         upload.Root,
+		Indexer:      upload.Indexer,
+	})
+}
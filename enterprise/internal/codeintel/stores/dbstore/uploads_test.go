@@ -282,6 +282,80 @@ func TestDeleteUploadsStuckUploading(t *testing.T) {
 	}
 }
 
+func TestFailUploadsStuckProcessing(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	db := dbtesting.GetDB(t)
+	store := testStore(db)
+
+	t1 := time.Unix(1587396557, 0).UTC()
+	t2 := t1.Add(time.Minute * 1)
+	t3 := t1.Add(time.Minute * 2)
+	t4 := t1.Add(time.Minute * 3)
+
+	insertUploads(t, db,
+		Upload{ID: 1, Commit: makeCommit(1111), StartedAt: &t1, State: "processing"}, // failed (first batch)
+		Upload{ID: 2, Commit: makeCommit(1112), StartedAt: &t2, State: "processing"}, // failed (second batch)
+		Upload{ID: 3, Commit: makeCommit(1113), StartedAt: &t1, State: "completed"},  // not processing
+		Upload{ID: 4, Commit: makeCommit(1114), StartedAt: &t4, State: "processing"}, // recent
+	)
+
+	for _, expectedFailed := range []int{1, 1, 0} {
+		failed, locked, err := store.FailUploadsStuckProcessing(context.Background(), t3, 1)
+		if err != nil {
+			t.Fatalf("unexpected error failing uploads stuck processing: %s", err)
+		}
+		if failed != expectedFailed {
+			t.Errorf("unexpected failed count. want=%d have=%d", expectedFailed, failed)
+		}
+		if locked != 0 {
+			t.Errorf("unexpected locked count. want=%d have=%d", 0, locked)
+		}
+	}
+
+	states, err := getUploadStates(db, 1, 2, 3, 4)
+	if err != nil {
+		t.Fatalf("unexpected error getting states: %s", err)
+	}
+
+	expected := map[int]string{
+		1: "errored",
+		2: "errored",
+		3: "completed",
+		4: "processing",
+	}
+	if diff := cmp.Diff(expected, states); diff != "" {
+		t.Errorf("unexpected upload states (-want +got):\n%s", diff)
+	}
+}
+
+func TestGetOrphanedUploadIDs(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	db := dbtesting.GetDB(t)
+	store := testStore(db)
+
+	insertUploads(t, db,
+		Upload{ID: 1, State: "completed"},
+		Upload{ID: 2, State: "errored"},
+		Upload{ID: 3, State: "processing"},
+		Upload{ID: 4, State: "deleted"},
+	)
+
+	ids, err := store.GetOrphanedUploadIDs(context.Background(), []int{1, 2, 3, 4, 5, 6})
+	if err != nil {
+		t.Fatalf("unexpected error getting orphaned upload ids: %s", err)
+	}
+
+	// Deleted uploads have their data removed by the hard deleter
+	expectedIDs := []int{2, 5, 6}
+	if diff := cmp.Diff(expectedIDs, ids); diff != "" {
+		t.Errorf("unexpected upload ids (-want +got):\n%s", diff)
+	}
+}
+
 func TestGetUploads(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
@@ -721,6 +795,52 @@ func TestDeleteUploadByIDMissingRow(t *testing.T) {
 	}
 }
 
+func TestReindexUploadByID(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	db := dbtesting.GetDB(t)
+	store := testStore(db)
+
+	t1 := time.Unix(1587396557, 0).UTC()
+	t2 := t1.Add(time.Minute)
+	failureMessage := "failed to correlate"
+
+	insertUploads(t, db,
+		Upload{ID: 1, Root: "sub/", UploadedAt: t1, State: "errored", FailureMessage: &failureMessage, StartedAt: &t1, FinishedAt: &t2, NumFailures: 3},
+		Upload{ID: 2, State: "completed"},
+	)
+
+	if requeued, err := store.ReindexUploadByID(context.Background(), 1); err != nil {
+		t.Fatalf("unexpected error reindexing upload: %s", err)
+	} else if !requeued {
+		t.Fatalf("expected record to be requeued")
+	}
+
+	// Only errored uploads can be reindexed
+	for _, id := range []int{2, 3} {
+		if requeued, err := store.ReindexUploadByID(context.Background(), id); err != nil {
+			t.Fatalf("unexpected error reindexing upload: %s", err)
+		} else if requeued {
+			t.Fatalf("unexpected record %d requeued", id)
+		}
+	}
+
+	upload, exists, err := store.GetUploadByID(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("unexpected error getting upload: %s", err)
+	} else if !exists {
+		t.Fatalf("expected record to exist")
+	}
+
+	if upload.State != "queued" || upload.FailureMessage != nil || upload.StartedAt != nil || upload.FinishedAt != nil || upload.NumFailures != 0 {
+		t.Errorf("unexpected processing state: %+v", upload)
+	}
+	if upload.Commit != makeCommit(1) || upload.Root != "sub/" || !upload.UploadedAt.Equal(t1) {
+		t.Errorf("unexpected upload metadata: %+v", upload)
+	}
+}
+
 func TestDeleteUploadsWithoutRepository(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
@@ -0,0 +1,153 @@
+package dbstore
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// UsageStatistics is a per-repository count of the precise and search-based code intelligence results
+// served for each type of code intelligence request.
+type UsageStatistics struct {
+	RepositoryID            int
+	RepositoryName          string
+	PreciseDefinitionsCount int
+	PreciseHoverCount       int
+	PreciseReferencesCount  int
+	SearchDefinitionsCount  int
+	SearchHoverCount        int
+	SearchReferencesCount   int
+}
+
+// scanUsageStatistics scans a slice of usage statistics from the return value of `*Store.query`.
+func scanUsageStatistics(rows *sql.Rows, queryErr error) (_ []UsageStatistics, err error) {
+	if queryErr != nil {
+		return nil, queryErr
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var statistics []UsageStatistics
+	for rows.Next() {
+		var s UsageStatistics
+		if err := rows.Scan(
+			&s.RepositoryID,
+			&s.RepositoryName,
+			&s.PreciseDefinitionsCount,
+			&s.PreciseHoverCount,
+			&s.PreciseReferencesCount,
+			&s.SearchDefinitionsCount,
+			&s.SearchHoverCount,
+			&s.SearchReferencesCount,
+		); err != nil {
+			return nil, err
+		}
+
+		statistics = append(statistics, s)
+	}
+
+	return statistics, nil
+}
+
+// usageEventNames are the names of the event log records counted in the lsif_usage_statistics table.
+// Cross-repository events are logged in addition to one of these events and are not counted again.
+var usageEventNames = []string{
+	"codeintel.lsifDefinitions",
+	"codeintel.lsifHover",
+	"codeintel.lsifReferences",
+	"codeintel.searchDefinitions",
+	"codeintel.searchHover",
+	"codeintel.searchReferences",
+}
+
+// UpdateUsageStatistics aggregates the code intelligence event log records into daily per-repository
+// counts. Counts are recalculated for every day from the (UTC) day containing the given time onwards,
+// so that days partially aggregated by a previous invocation are brought up to date.
+func (s *Store) UpdateUsageStatistics(ctx context.Context, since time.Time) (err error) {
+	ctx, endObservation := s.operations.updateUsageStatistics.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("since", since.String()),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return s.Store.Exec(ctx, sqlf.Sprintf(updateUsageStatisticsQuery, pq.Array(usageEventNames), since.UTC().Truncate(time.Hour*24)))
+}
+
+const updateUsageStatisticsQuery = `
+-- source: enterprise/internal/codeintel/stores/dbstore/usage_statistics.go:UpdateUsageStatistics
+INSERT INTO lsif_usage_statistics (repository_id, date, action, precise_count, search_count)
+SELECT
+	(e.argument->>'repositoryId')::int AS repository_id,
+	(e.timestamp AT TIME ZONE 'UTC')::date AS date,
+	CASE
+		WHEN e.name LIKE '%%%%Definitions' THEN 'definitions'
+		WHEN e.name LIKE '%%%%Hover' THEN 'hover'
+		ELSE 'references'
+	END AS action,
+	COUNT(*) FILTER (WHERE e.name LIKE 'codeintel.lsif%%%%') AS precise_count,
+	COUNT(*) FILTER (WHERE e.name LIKE 'codeintel.search%%%%') AS search_count
+FROM event_logs e
+WHERE
+	e.name = ANY(%s) AND
+	e.timestamp >= %s AND
+	e.argument->>'repositoryId' IS NOT NULL
+GROUP BY 1, 2, 3
+ON CONFLICT (repository_id, date, action) DO UPDATE SET
+	precise_count = EXCLUDED.precise_count,
+	search_count = EXCLUDED.search_count
+`
+
+// UsageStatistics returns the number of precise and search-based code intelligence results served for
+// each repository since the (UTC) day containing the given time. The resulting slice is ordered by search
+// then precise counts, so that the repositories that would benefit most from precise code intelligence
+// occur first.
+func (s *Store) UsageStatistics(ctx context.Context, since time.Time) (_ []UsageStatistics, err error) {
+	ctx, traceLog, endObservation := s.operations.usageStatistics.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("since", since.String()),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	statistics, err := scanUsageStatistics(s.Store.Query(ctx, sqlf.Sprintf(usageStatisticsQuery, since.UTC().Truncate(time.Hour*24))))
+	if err != nil {
+		return nil, err
+	}
+	traceLog(log.Int("numStatistics", len(statistics)))
+
+	return statistics, nil
+}
+
+const usageStatisticsQuery = `
+-- source: enterprise/internal/codeintel/stores/dbstore/usage_statistics.go:UsageStatistics
+SELECT
+	r.id,
+	r.name,
+	counts.precise_definitions_count,
+	counts.precise_hover_count,
+	counts.precise_references_count,
+	counts.search_definitions_count,
+	counts.search_hover_count,
+	counts.search_references_count
+FROM (
+	SELECT
+		u.repository_id,
+		COALESCE(SUM(u.precise_count) FILTER (WHERE u.action = 'definitions'), 0) AS precise_definitions_count,
+		COALESCE(SUM(u.precise_count) FILTER (WHERE u.action = 'hover'), 0) AS precise_hover_count,
+		COALESCE(SUM(u.precise_count) FILTER (WHERE u.action = 'references'), 0) AS precise_references_count,
+		COALESCE(SUM(u.search_count) FILTER (WHERE u.action = 'definitions'), 0) AS search_definitions_count,
+		COALESCE(SUM(u.search_count) FILTER (WHERE u.action = 'hover'), 0) AS search_hover_count,
+		COALESCE(SUM(u.search_count) FILTER (WHERE u.action = 'references'), 0) AS search_references_count,
+		SUM(u.precise_count) AS precise_count,
+		SUM(u.search_count) AS search_count
+	FROM lsif_usage_statistics u
+	WHERE u.date >= %s::date
+	GROUP BY u.repository_id
+) counts
+JOIN repo r ON r.id = counts.repository_id
+WHERE r.deleted_at IS NULL
+ORDER BY counts.search_count DESC, counts.precise_count DESC, r.id
+`
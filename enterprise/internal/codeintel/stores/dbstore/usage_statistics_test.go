@@ -0,0 +1,89 @@
+package dbstore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtesting"
+)
+
+func TestUsageStatistics(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	db := dbtesting.GetDB(t)
+	store := testStore(db)
+
+	now := time.Now().UTC()
+	yesterday := now.Add(-time.Hour * 24)
+	lastMonth := now.Add(-time.Hour * 24 * 30)
+
+	insertEvent := func(name string, count, repoID int, timestamp time.Time) {
+		json := fmt.Sprintf(`{"repositoryId": %d}`, repoID)
+		query := sqlf.Sprintf(`
+			INSERT INTO event_logs (user_id, anonymous_user_id, source, argument, version, timestamp, name, url)
+			VALUES (1, '', 'test', %s, 'dev', %s, %s, '')
+		`, json, timestamp, name)
+
+		for i := 0; i < count; i++ {
+			if _, err := db.Exec(query.Query(sqlf.PostgresBindVar), query.Args()...); err != nil {
+				t.Fatalf("unexpected error inserting event record: %s", err)
+			}
+		}
+	}
+
+	insertRepo(t, db, 50, "github.com/foo/bar")
+	insertRepo(t, db, 51, "github.com/foo/baz")
+	insertRepo(t, db, 52, "github.com/foo/bonk")
+	deleteRepo(t, db, 52, now)
+
+	insertEvent("codeintel.lsifDefinitions", 3, 50, now)
+	insertEvent("codeintel.lsifDefinitions.xrepo", 3, 50, now) // not counted
+	insertEvent("codeintel.lsifHover", 2, 50, yesterday)
+	insertEvent("codeintel.searchReferences", 4, 50, now)
+	insertEvent("codeintel.searchHover", 5, 51, now)
+	insertEvent("codeintel.searchHover", 7, 51, yesterday)
+	insertEvent("codeintel.searchDefinitions", 9, 51, lastMonth) // not aggregated
+	insertEvent("codeintel.searchHover", 10, 52, now)            // deleted repo
+
+	if err := store.UpdateUsageStatistics(context.Background(), yesterday); err != nil {
+		t.Fatalf("unexpected error updating usage statistics: %s", err)
+	}
+
+	// Re-aggregating the same window must not double count
+	insertEvent("codeintel.lsifReferences", 1, 51, now)
+	if err := store.UpdateUsageStatistics(context.Background(), yesterday); err != nil {
+		t.Fatalf("unexpected error updating usage statistics: %s", err)
+	}
+
+	statistics, err := store.UsageStatistics(context.Background(), lastMonth)
+	if err != nil {
+		t.Fatalf("unexpected error getting usage statistics: %s", err)
+	}
+
+	expectedStatistics := []UsageStatistics{
+		{RepositoryID: 51, RepositoryName: "github.com/foo/baz", SearchHoverCount: 12, PreciseReferencesCount: 1},
+		{RepositoryID: 50, RepositoryName: "github.com/foo/bar", SearchReferencesCount: 4, PreciseDefinitionsCount: 3, PreciseHoverCount: 2},
+	}
+	if diff := cmp.Diff(expectedStatistics, statistics); diff != "" {
+		t.Errorf("unexpected usage statistics (-want +got):\n%s", diff)
+	}
+
+	statistics, err = store.UsageStatistics(context.Background(), now)
+	if err != nil {
+		t.Fatalf("unexpected error getting usage statistics: %s", err)
+	}
+
+	expectedStatistics = []UsageStatistics{
+		{RepositoryID: 51, RepositoryName: "github.com/foo/baz", SearchHoverCount: 5, PreciseReferencesCount: 1},
+		{RepositoryID: 50, RepositoryName: "github.com/foo/bar", SearchReferencesCount: 4, PreciseDefinitionsCount: 3},
+	}
+	if diff := cmp.Diff(expectedStatistics, statistics); diff != "" {
+		t.Errorf("unexpected usage statistics (-want +got):\n%s", diff)
+	}
+}
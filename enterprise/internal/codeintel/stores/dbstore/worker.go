@@ -21,19 +21,73 @@ const StalledUploadMaxAge = time.Second * 5
 // "queued" on its next reset.
 const UploadMaxNumResets = 3
 
-var uploadWorkerStoreOptions = dbworkerstore.Options{
-	Name:              "precise_code_intel_upload_worker_store",
-	TableName:         "lsif_uploads",
-	ViewName:          "lsif_uploads_with_repository_name u",
-	ColumnExpressions: uploadColumnsWithNullRank,
-	Scan:              scanFirstUploadRecord,
-	OrderByExpression: sqlf.Sprintf("u.uploaded_at, u.id"),
-	StalledMaxAge:     StalledUploadMaxAge,
-	MaxNumResets:      UploadMaxNumResets,
+// UploadQueueOptions control the order in which queued uploads are dequeued for processing.
+// Uploads that are not distinguished by any enabled option are processed in the order in
+// which they were uploaded.
+type UploadQueueOptions struct {
+	// PriorityLanes processes uploads produced by index jobs requested interactively by a user
+	// first, then uploads sent directly by users or CI, and finally uploads produced by index
+	// jobs scheduled by the auto-indexer.
+	PriorityLanes bool
+
+	// RepositoryFairness prefers, within a lane, uploads of repositories with fewer uploads
+	// currently being processed. This stops a single repository with many queued uploads
+	// from starving the uploads of other repositories.
+	RepositoryFairness bool
+}
+
+// DefaultUploadQueueOptions enables every upload queue ordering option.
+var DefaultUploadQueueOptions = UploadQueueOptions{
+	PriorityLanes:      true,
+	RepositoryFairness: true,
+}
+
+const uploadPriorityLaneExpression = `
+CASE
+	WHEN u.associated_index_id IS NULL THEN 1
+	WHEN EXISTS (SELECT 1 FROM lsif_indexes i WHERE i.id = u.associated_index_id AND i.interactive) THEN 0
+	ELSE 2
+END
+`
+
+const uploadRepositoryFairnessExpression = `
+(SELECT COUNT(*) FROM lsif_uploads p WHERE p.repository_id = u.repository_id AND p.state = 'processing')
+`
+
+// uploadOrderByExpression returns the expression used to order queued uploads under the
+// given options.
+func uploadOrderByExpression(queueOptions UploadQueueOptions) *sqlf.Query {
+	var orders []*sqlf.Query
+	if queueOptions.PriorityLanes {
+		orders = append(orders, sqlf.Sprintf(uploadPriorityLaneExpression))
+	}
+	if queueOptions.RepositoryFairness {
+		orders = append(orders, sqlf.Sprintf(uploadRepositoryFairnessExpression))
+	}
+	orders = append(orders, sqlf.Sprintf("u.uploaded_at"), sqlf.Sprintf("u.id"))
+
+	return sqlf.Join(orders, ", ")
+}
+
+func uploadWorkerStoreOptions(queueOptions UploadQueueOptions) dbworkerstore.Options {
+	return dbworkerstore.Options{
+		Name:              "precise_code_intel_upload_worker_store",
+		TableName:         "lsif_uploads",
+		ViewName:          "lsif_uploads_with_repository_name u",
+		ColumnExpressions: uploadColumnsWithNullRank,
+		Scan:              scanFirstUploadRecord,
+		OrderByExpression: uploadOrderByExpression(queueOptions),
+		StalledMaxAge:     StalledUploadMaxAge,
+		MaxNumResets:      UploadMaxNumResets,
+	}
 }
 
 func WorkerutilUploadStore(s basestore.ShareableStore, observationContext *observation.Context) dbworkerstore.Store {
-	return dbworkerstore.NewWithMetrics(s.Handle(), uploadWorkerStoreOptions, observationContext)
+	return WorkerutilUploadStoreWithQueueOptions(s, DefaultUploadQueueOptions, observationContext)
+}
+
+func WorkerutilUploadStoreWithQueueOptions(s basestore.ShareableStore, queueOptions UploadQueueOptions, observationContext *observation.Context) dbworkerstore.Store {
+	return dbworkerstore.NewWithMetrics(s.Handle(), uploadWorkerStoreOptions(queueOptions), observationContext)
 }
 
 // StalledIndexMaxAge is the maximum allowable duration between updating the state of an
@@ -53,7 +107,7 @@ var indexWorkerStoreOptions = dbworkerstore.Options{
 	ViewName:          "lsif_indexes_with_repository_name u",
 	ColumnExpressions: indexColumnsWithNullRank,
 	Scan:              scanFirstIndexRecord,
-	OrderByExpression: sqlf.Sprintf("u.queued_at, u.id"),
+	OrderByExpression: sqlf.Sprintf("u.interactive DESC, u.queued_at, u.id"),
 	StalledMaxAge:     StalledIndexMaxAge,
 	MaxNumResets:      IndexMaxNumResets,
 }
@@ -87,3 +141,29 @@ var dependencyIndexingJobWorkerStoreOptions = dbworkerstore.Options{
 func WorkerutilDependencyIndexingJobStore(s basestore.ShareableStore, observationContext *observation.Context) dbworkerstore.Store {
 	return dbworkerstore.NewWithMetrics(s.Handle(), dependencyIndexingJobWorkerStoreOptions, observationContext)
 }
+
+// StalledDependencySyncingJobMaxAge is the maximum allowable duration between updating
+// the state of a dependency syncing job as "processing" and locking the job row during
+// processing. An unlocked row that is marked as processing likely indicates that the worker
+// that dequeued the job has died. There should be a nearly-zero delay between these states
+// during normal operation.
+const StalledDependencySyncingJobMaxAge = time.Second * 5
+
+// DependencySyncingJobMaxNumResets is the maximum number of times a dependency syncing
+// job can be reset. If an job's failed attempts counter reaches this threshold, it will be
+// moved into "errored" rather than "queued" on its next reset.
+const DependencySyncingJobMaxNumResets = 3
+
+var dependencySyncingJobWorkerStoreOptions = dbworkerstore.Options{
+	Name:              "precise_code_intel_dependency_syncing_scheduler_worker_store",
+	TableName:         "lsif_dependency_syncing_jobs j",
+	ColumnExpressions: dependencySyncingJobColumns,
+	Scan:              scanFirstDependencySyncingJobRecord,
+	OrderByExpression: sqlf.Sprintf("j.queued_at, j.id"),
+	StalledMaxAge:     StalledDependencySyncingJobMaxAge,
+	MaxNumResets:      DependencySyncingJobMaxNumResets,
+}
+
+func WorkerutilDependencySyncingJobStore(s basestore.ShareableStore, observationContext *observation.Context) dbworkerstore.Store {
+	return dbworkerstore.NewWithMetrics(s.Handle(), dependencySyncingJobWorkerStoreOptions, observationContext)
+}
@@ -0,0 +1,63 @@
+package dbstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtesting"
+)
+
+func TestUploadOrderByExpression(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	db := dbtesting.GetDB(t)
+	store := testStore(db)
+
+	t1 := time.Unix(1587396557, 0).UTC()
+	interactiveIndexID := 10
+	scheduledIndexID := 11
+
+	insertIndexes(t, db,
+		Index{ID: interactiveIndexID, Interactive: true},
+		Index{ID: scheduledIndexID},
+	)
+	insertUploads(t, db,
+		Upload{ID: 1, State: "queued", UploadedAt: t1.Add(time.Minute * 1), AssociatedIndexID: &scheduledIndexID},
+		Upload{ID: 2, State: "queued", UploadedAt: t1.Add(time.Minute * 2)},
+		Upload{ID: 3, State: "queued", UploadedAt: t1.Add(time.Minute * 3), AssociatedIndexID: &interactiveIndexID},
+		Upload{ID: 4, State: "queued", UploadedAt: t1.Add(time.Minute * 4)},
+		Upload{ID: 5, State: "queued", UploadedAt: t1.Add(time.Minute * 5), RepositoryID: 51},
+		Upload{ID: 6, State: "processing", UploadedAt: t1},
+	)
+
+	testCases := []struct {
+		queueOptions UploadQueueOptions
+		expectedIDs  []int
+	}{
+		{UploadQueueOptions{}, []int{1, 2, 3, 4, 5}},
+		{UploadQueueOptions{PriorityLanes: true}, []int{3, 2, 4, 5, 1}},
+		{UploadQueueOptions{RepositoryFairness: true}, []int{5, 1, 2, 3, 4}},
+		{DefaultUploadQueueOptions, []int{3, 5, 2, 4, 1}},
+	}
+
+	for _, testCase := range testCases {
+		query := sqlf.Sprintf(
+			`SELECT u.id FROM lsif_uploads_with_repository_name u WHERE u.state = 'queued' ORDER BY %s`,
+			uploadOrderByExpression(testCase.queueOptions),
+		)
+
+		ids, err := basestore.ScanInts(store.Query(context.Background(), query))
+		if err != nil {
+			t.Fatalf("unexpected error ordering uploads: %s", err)
+		}
+		if diff := cmp.Diff(testCase.expectedIDs, ids); diff != "" {
+			t.Errorf("unexpected upload order for %+v (-want +got):\n%s", testCase.queueOptions, diff)
+		}
+	}
+}
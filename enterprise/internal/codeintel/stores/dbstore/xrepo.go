@@ -13,59 +13,6 @@ import (
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
 )
 
-// DefinitionDumpsLimit is the maximum number of records that can be returned from DefinitionDumps.
-const DefinitionDumpsLimit = 10
-
-// DefinitionDumps returns the set of dumps that define at least one of the given monikers.
-func (s *Store) DefinitionDumps(ctx context.Context, monikers []semantic.QualifiedMonikerData) (_ []Dump, err error) {
-	ctx, traceLog, endObservation := s.operations.definitionDumps.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
-		log.Int("numMonikers", len(monikers)),
-		log.String("monikers", monikersToString(monikers)),
-	}})
-	defer endObservation(1, observation.Args{})
-
-	if len(monikers) == 0 {
-		return nil, nil
-	}
-
-	qs := make([]*sqlf.Query, 0, len(monikers))
-	for _, moniker := range monikers {
-		qs = append(qs, sqlf.Sprintf("(%s, %s, %s)", moniker.Scheme, moniker.Name, moniker.Version))
-	}
-
-	dumps, err := scanDumps(s.Query(ctx, sqlf.Sprintf(definitionDumpsQuery, sqlf.Join(qs, ", "), DefinitionDumpsLimit)))
-	if err != nil {
-		return nil, err
-	}
-	traceLog(log.Int("numDumps", len(dumps)))
-
-	return dumps, nil
-}
-
-const definitionDumpsQuery = `
--- source: enterprise/internal/codeintel/stores/dbstore/xrepo.go:DefinitionDumps
-SELECT
-	d.id,
-	d.commit,
-	d.root,
-	` + visibleAtTipFragment + ` AS visible_at_tip,
-	d.uploaded_at,
-	d.state,
-	d.failure_message,
-	d.started_at,
-	d.finished_at,
-	d.process_after,
-	d.num_resets,
-	d.num_failures,
-	d.repository_id,
-	d.repository_name,
-	d.indexer,
-	d.associated_index_id
-FROM lsif_dumps_with_repository_name d WHERE d.id IN (
-	SELECT MAX(p.dump_id) FROM lsif_packages p WHERE (p.scheme, p.name, p.version) IN (%s) GROUP BY p.scheme, p.name, p.version LIMIT %s
-)
-`
-
 // ReferenceIDsAndFilters returns the total count of visible uploads that may refer to one of the given
 // monikers. Each upload identifier in the result set is paired with one or more compressed bloom filters
 // that encode more precisely the set of identifiers imported from dependent packages.
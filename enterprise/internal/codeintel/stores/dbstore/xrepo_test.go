@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"testing"
-	"time"
 
 	"github.com/google/go-cmp/cmp"
 
@@ -14,108 +13,6 @@ import (
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
 )
 
-func TestDefinitionDumps(t *testing.T) {
-	if testing.Short() {
-		t.Skip()
-	}
-	db := dbtesting.GetDB(t)
-	store := testStore(db)
-
-	moniker1 := semantic.QualifiedMonikerData{
-		MonikerData: semantic.MonikerData{
-			Scheme: "gomod",
-		},
-		PackageInformationData: semantic.PackageInformationData{
-			Name:    "leftpad",
-			Version: "0.1.0",
-		},
-	}
-
-	moniker2 := semantic.QualifiedMonikerData{
-		MonikerData: semantic.MonikerData{
-			Scheme: "npm",
-		},
-		PackageInformationData: semantic.PackageInformationData{
-			Name:    "north-pad",
-			Version: "0.2.0",
-		},
-	}
-
-	// Package does not exist initially
-	if dumps, err := store.DefinitionDumps(context.Background(), []semantic.QualifiedMonikerData{moniker1}); err != nil {
-		t.Fatalf("unexpected error getting package: %s", err)
-	} else if len(dumps) != 0 {
-		t.Fatal("unexpected record")
-	}
-
-	uploadedAt := time.Unix(1587396557, 0).UTC()
-	startedAt := uploadedAt.Add(time.Minute)
-	finishedAt := uploadedAt.Add(time.Minute * 2)
-	expected1 := Dump{
-		ID:             1,
-		Commit:         makeCommit(1),
-		Root:           "sub/",
-		VisibleAtTip:   true,
-		UploadedAt:     uploadedAt,
-		State:          "completed",
-		FailureMessage: nil,
-		StartedAt:      &startedAt,
-		FinishedAt:     &finishedAt,
-		RepositoryID:   50,
-		RepositoryName: "n-50",
-		Indexer:        "lsif-go",
-	}
-	expected2 := Dump{
-		ID:                2,
-		Commit:            makeCommit(2),
-		Root:              "other/",
-		VisibleAtTip:      false,
-		UploadedAt:        uploadedAt,
-		State:             "completed",
-		FailureMessage:    nil,
-		StartedAt:         &startedAt,
-		FinishedAt:        &finishedAt,
-		RepositoryID:      50,
-		RepositoryName:    "n-50",
-		Indexer:           "lsif-tsc",
-		AssociatedIndexID: nil,
-	}
-
-	insertUploads(t, db, dumpToUpload(expected1), dumpToUpload(expected2))
-	insertVisibleAtTip(t, db, 50, 1)
-
-	if err := store.UpdatePackages(context.Background(), 1, []semantic.Package{
-		{Scheme: "gomod", Name: "leftpad", Version: "0.1.0"},
-		{Scheme: "gomod", Name: "leftpad", Version: "0.1.0"},
-	}); err != nil {
-		t.Fatalf("unexpected error updating packages: %s", err)
-	}
-
-	if err := store.UpdatePackages(context.Background(), 2, []semantic.Package{
-		{Scheme: "npm", Name: "north-pad", Version: "0.2.0"},
-	}); err != nil {
-		t.Fatalf("unexpected error updating packages: %s", err)
-	}
-
-	if dumps, err := store.DefinitionDumps(context.Background(), []semantic.QualifiedMonikerData{moniker1}); err != nil {
-		t.Fatalf("unexpected error getting package: %s", err)
-	} else if len(dumps) != 1 {
-		t.Fatal("expected one record")
-	} else if diff := cmp.Diff(expected1, dumps[0]); diff != "" {
-		t.Errorf("unexpected dump (-want +got):\n%s", diff)
-	}
-
-	if dumps, err := store.DefinitionDumps(context.Background(), []semantic.QualifiedMonikerData{moniker1, moniker2}); err != nil {
-		t.Fatalf("unexpected error getting package: %s", err)
-	} else if len(dumps) != 2 {
-		t.Fatal("expected two records")
-	} else if diff := cmp.Diff(expected1, dumps[0]); diff != "" {
-		t.Errorf("unexpected dump (-want +got):\n%s", diff)
-	} else if diff := cmp.Diff(expected2, dumps[1]); diff != "" {
-		t.Errorf("unexpected dump (-want +got):\n%s", diff)
-	}
-}
-
 func TestReferenceIDsAndFilters(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
@@ -0,0 +1,540 @@
+package lsifstore
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
+)
+
+// BatchDefinitions returns the set of locations defining the symbol at each of the given positions. The
+// documents enclosing every position, the result chunks of every definition result, and the documents
+// containing every definition are each fetched together, so the number of round trips does not grow with
+// the number of positions. The returned slice is parallel to the given keys.
+func (s *Store) BatchDefinitions(ctx context.Context, keys []PositionKey, limit int) (_ [][]Location, err error) {
+	ctx, traceLog, endObservation := s.operations.batchDefinitions.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("numKeys", len(keys)),
+		log.String("keys", positionKeysToString(keys)),
+		log.Int("limit", limit),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	documents, err := s.readDocumentsByKeys(ctx, batchLocationsDocumentQuery, documentKeysFromPositionKeys(keys))
+	if err != nil {
+		return nil, err
+	}
+	traceLog(log.Int("numDocuments", len(documents)))
+
+	extractor := func(r semantic.RangeData) semantic.ID { return r.DefinitionResultID }
+
+	// Determine the definition results attached to the ranges enclosing each position
+	resultIDsByKey := make([][]semantic.ID, len(keys))
+	resultIDsByDumpID := map[int][]semantic.ID{}
+	for i, key := range keys {
+		document, ok := documents[key.DocumentKey]
+		if !ok {
+			continue
+		}
+
+		resultIDsByKey[i] = extractResultIDs(semantic.FindRanges(document.Ranges, key.Line, key.Character), extractor)
+		resultIDsByDumpID[key.DumpID] = append(resultIDsByDumpID[key.DumpID], resultIDsByKey[i]...)
+	}
+
+	rangeIDsByResultIDByDumpID, err := s.readLocationsFromResultChunksByDumpID(ctx, resultIDsByDumpID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Page the results of each position and determine the documents we need to open to resolve
+	// range identifiers into actual offsets in a document
+	limitedRangeIDsByKey := make([]map[semantic.ID]map[string][]semantic.ID, len(keys))
+	pathsByKey := make([][]string, len(keys))
+	var definitionDocumentKeys []DocumentKey
+	for i, key := range keys {
+		if len(resultIDsByKey[i]) == 0 {
+			continue
+		}
+
+		rangeIDsByResultID := make(map[semantic.ID]map[string][]semantic.ID, len(resultIDsByKey[i]))
+		for _, id := range resultIDsByKey[i] {
+			if rangeIDsByDocument, ok := rangeIDsByResultIDByDumpID[key.DumpID][id]; ok {
+				rangeIDsByResultID[id] = rangeIDsByDocument
+			}
+		}
+
+		limitedRangeIDsByKey[i], pathsByKey[i] = limitResultMap(resultIDsByKey[i], rangeIDsByResultID, limit, 0)
+		for _, path := range pathsByKey[i] {
+			definitionDocumentKeys = append(definitionDocumentKeys, DocumentKey{DumpID: key.DumpID, Path: path})
+		}
+	}
+
+	definitionDocuments, err := s.readDocumentsByKeys(ctx, batchLocationsDocumentQuery, definitionDocumentKeys)
+	if err != nil {
+		return nil, err
+	}
+	traceLog(log.Int("numDefinitionDocuments", len(definitionDocuments)))
+
+	locationsByKey := make([][]Location, len(keys))
+	for i, key := range keys {
+		if len(pathsByKey[i]) == 0 {
+			continue
+		}
+
+		locationsByResultID := make(map[semantic.ID][]Location, len(resultIDsByKey[i]))
+		for _, path := range pathsByKey[i] {
+			if document, ok := definitionDocuments[DocumentKey{DumpID: key.DumpID, Path: path}]; ok {
+				s.readRangesFromDocument(key.DumpID, limitedRangeIDsByKey[i], locationsByResultID, path, document, traceLog)
+			}
+		}
+
+		locations := make([]Location, 0, limit)
+		for _, resultID := range resultIDsByKey[i] {
+			locations = append(locations, locationsByResultID[resultID]...)
+		}
+		locationsByKey[i] = locations
+	}
+
+	return locationsByKey, nil
+}
+
+// readLocationsFromResultChunksByDumpID reads the result chunks covering the given result set identifiers
+// of each dump. The result chunks of all dumps are fetched together. This method returns a map from dump
+// identifiers to a map from result set identifiers to the range identifiers composing that result set,
+// keyed by document path.
+func (s *Store) readLocationsFromResultChunksByDumpID(ctx context.Context, idsByDumpID map[int][]semantic.ID) (map[int]map[semantic.ID]map[string][]semantic.ID, error) {
+	rangeIDsByResultIDByDumpID := make(map[int]map[semantic.ID]map[string][]semantic.ID, len(idsByDumpID))
+	if len(idsByDumpID) == 0 {
+		return rangeIDsByResultIDByDumpID, nil
+	}
+
+	dumpIDQueries := make([]*sqlf.Query, 0, len(idsByDumpID))
+	for dumpID := range idsByDumpID {
+		dumpIDQueries = append(dumpIDQueries, sqlf.Sprintf("%s", dumpID))
+	}
+	numResultChunksByDumpID, err := scanIntPairs(s.Store.Query(ctx, sqlf.Sprintf(batchNumResultChunksQuery, sqlf.Join(dumpIDQueries, ","))))
+	if err != nil {
+		return nil, err
+	}
+
+	// Hash each identifier to its parent result chunk in the same way as translateIDsToResultChunkIndexes
+	keys := make([]resultChunkKey, 0, len(idsByDumpID))
+	seen := map[resultChunkKey]struct{}{}
+	for dumpID, ids := range idsByDumpID {
+		numResultChunks, ok := numResultChunksByDumpID[dumpID]
+		if !ok {
+			return nil, ErrNoMetadata
+		}
+
+		for _, id := range ids {
+			key := resultChunkKey{dumpID: dumpID, index: semantic.HashKey(id, numResultChunks)}
+			if _, ok := seen[key]; !ok {
+				seen[key] = struct{}{}
+				keys = append(keys, key)
+			}
+		}
+
+		rangeIDsByResultIDByDumpID[dumpID] = make(map[semantic.ID]map[string][]semantic.ID, len(ids))
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].dumpID == keys[j].dumpID {
+			return keys[i].index < keys[j].index
+		}
+		return keys[i].dumpID < keys[j].dumpID
+	})
+
+	// In order to limit the number of parameters we send to Postgres in the result chunk
+	// fetch query, we process the keys in chunks of maximum size, as in readLocationsFromResultChunks.
+
+	for len(keys) > 0 {
+		var batch []resultChunkKey
+		if len(keys) <= resultChunkBatchSize {
+			batch, keys = keys, nil
+		} else {
+			batch, keys = keys[:resultChunkBatchSize], keys[resultChunkBatchSize:]
+		}
+
+		pairQueries := make([]*sqlf.Query, 0, len(batch))
+		for _, key := range batch {
+			pairQueries = append(pairQueries, sqlf.Sprintf("(%s, %s)", key.dumpID, key.index))
+		}
+		visitResultChunks := s.makeQualifiedResultChunkVisitor(s.Store.Query(ctx, sqlf.Sprintf(batchResultChunksQuery, sqlf.Join(pairQueries, ","))))
+
+		if err := visitResultChunks(func(dumpID, index int, resultChunkData semantic.ResultChunkData) {
+			readLocationsFromResultChunk(resultChunkData, idsByDumpID[dumpID], "", false, rangeIDsByResultIDByDumpID[dumpID])
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return rangeIDsByResultIDByDumpID, nil
+}
+
+type resultChunkKey struct {
+	dumpID int
+	index  int
+}
+
+const batchNumResultChunksQuery = `
+-- source: enterprise/internal/codeintel/stores/lsifstore/batch.go:readLocationsFromResultChunksByDumpID
+SELECT dump_id, num_result_chunks FROM lsif_data_metadata WHERE dump_id IN (%s)
+`
+
+const batchResultChunksQuery = `
+-- source: enterprise/internal/codeintel/stores/lsifstore/batch.go:readLocationsFromResultChunksByDumpID
+SELECT dump_id, idx, data FROM lsif_data_result_chunks WHERE (dump_id, idx) IN (%s)
+`
+
+const batchLocationsDocumentQuery = `
+-- source: enterprise/internal/codeintel/stores/lsifstore/batch.go:BatchDefinitions
+SELECT
+	dump_id,
+	path,
+	data,
+	ranges,
+	NULL AS hovers,
+	NULL AS monikers,
+	NULL AS packages,
+	NULL AS diagnostics
+FROM
+	lsif_data_documents
+WHERE
+	(dump_id, path) IN (%s)
+`
+
+// BatchHover returns the hover text of the symbol at each of the given positions rendered in the given
+// format. The documents enclosing every position are fetched together in a single query. The returned
+// slice is parallel to the given keys.
+func (s *Store) BatchHover(ctx context.Context, keys []PositionKey, format HoverFormat) (_ []HoverResult, err error) {
+	ctx, traceLog, endObservation := s.operations.batchHover.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("numKeys", len(keys)),
+		log.String("keys", positionKeysToString(keys)),
+		log.String("format", string(format)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	documents, err := s.readDocumentsByKeys(ctx, batchHoverDocumentQuery, documentKeysFromPositionKeys(keys))
+	if err != nil {
+		return nil, err
+	}
+	traceLog(log.Int("numDocuments", len(documents)))
+
+	results := make([]HoverResult, len(keys))
+	for i, key := range keys {
+		if document, ok := documents[key.DocumentKey]; ok {
+			results[i] = hoverAtPosition(document, key.Line, key.Character, format, traceLog)
+		}
+	}
+
+	return results, nil
+}
+
+const batchHoverDocumentQuery = `
+-- source: enterprise/internal/codeintel/stores/lsifstore/batch.go:BatchHover
+SELECT
+	dump_id,
+	path,
+	data,
+	ranges,
+	hovers,
+	NULL AS monikers,
+	NULL AS packages,
+	NULL AS diagnostics
+FROM
+	lsif_data_documents
+WHERE
+	(dump_id, path) IN (%s)
+`
+
+// BatchRanges returns definition, reference, and hover data for each range within the given span of lines
+// of each of the given documents. The documents, the result chunks of every definition and reference result,
+// and the documents containing every definition are each fetched together, as in BatchDefinitions, so the
+// number of round trips does not grow with the number of keys. The returned slice is parallel to the given
+// keys.
+func (s *Store) BatchRanges(ctx context.Context, keys []DocumentKey, startLine, endLine int) (_ [][]CodeIntelligenceRange, err error) {
+	ctx, traceLog, endObservation := s.operations.batchRanges.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("numKeys", len(keys)),
+		log.String("keys", documentKeysToString(keys)),
+		log.Int("startLine", startLine),
+		log.Int("endLine", endLine),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	documents, err := s.readDocumentsByKeys(ctx, batchRangesDocumentQuery, keys)
+	if err != nil {
+		return nil, err
+	}
+	traceLog(log.Int("numDocuments", len(documents)))
+
+	// Determine the ranges within the window of each document, and the definition and reference
+	// results attached to them
+	rangesByKey := make([][]semantic.RangeData, len(keys))
+	definitionResultIDsByKey := make([][]semantic.ID, len(keys))
+	referenceResultIDsByKey := make([][]semantic.ID, len(keys))
+	resultIDsByDumpID := map[int][]semantic.ID{}
+	for i, key := range keys {
+		document, ok := documents[key]
+		if !ok {
+			continue
+		}
+
+		rangesByKey[i] = semantic.FindRangesInWindow(document.Ranges, startLine, endLine)
+		definitionResultIDsByKey[i] = extractResultIDs(rangesByKey[i], func(r semantic.RangeData) semantic.ID { return r.DefinitionResultID })
+		referenceResultIDsByKey[i] = extractResultIDs(rangesByKey[i], func(r semantic.RangeData) semantic.ID { return r.ReferenceResultID })
+		resultIDsByDumpID[key.DumpID] = append(resultIDsByDumpID[key.DumpID], definitionResultIDsByKey[i]...)
+		resultIDsByDumpID[key.DumpID] = append(resultIDsByDumpID[key.DumpID], referenceResultIDsByKey[i]...)
+	}
+	traceLog(log.Int("numIntersectingRanges", numRanges(rangesByKey)))
+
+	rangeIDsByResultIDByDumpID, err := s.readLocationsFromResultChunksByDumpID(ctx, resultIDsByDumpID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Page the definitions of each document and determine the documents we need to open to resolve
+	// range identifiers into actual offsets in a document. References are only resolved within the
+	// document itself, which we've already read.
+	limitedRangeIDsByKey := make([]map[semantic.ID]map[string][]semantic.ID, len(keys))
+	pathsByKey := make([][]string, len(keys))
+	var definitionDocumentKeys []DocumentKey
+	for i, key := range keys {
+		if len(definitionResultIDsByKey[i]) == 0 {
+			continue
+		}
+
+		rangeIDsByResultID := make(map[semantic.ID]map[string][]semantic.ID, len(definitionResultIDsByKey[i]))
+		for _, id := range definitionResultIDsByKey[i] {
+			if rangeIDsByDocument, ok := rangeIDsByResultIDByDumpID[key.DumpID][id]; ok {
+				rangeIDsByResultID[id] = rangeIDsByDocument
+			}
+		}
+
+		limitedRangeIDsByKey[i], pathsByKey[i] = limitResultMap(definitionResultIDsByKey[i], rangeIDsByResultID, MaximumRangesDefinitionLocations, 0)
+		for _, path := range pathsByKey[i] {
+			definitionDocumentKeys = append(definitionDocumentKeys, DocumentKey{DumpID: key.DumpID, Path: path})
+		}
+	}
+
+	definitionDocuments, err := s.readDocumentsByKeys(ctx, batchLocationsDocumentQuery, definitionDocumentKeys)
+	if err != nil {
+		return nil, err
+	}
+	traceLog(log.Int("numDefinitionDocuments", len(definitionDocuments)))
+
+	codeintelRangesByKey := make([][]CodeIntelligenceRange, len(keys))
+	for i, key := range keys {
+		document, ok := documents[key]
+		if !ok {
+			continue
+		}
+
+		definitionLocations := make(map[semantic.ID][]Location, len(definitionResultIDsByKey[i]))
+		for _, path := range pathsByKey[i] {
+			if definitionDocument, ok := definitionDocuments[DocumentKey{DumpID: key.DumpID, Path: path}]; ok {
+				s.readRangesFromDocument(key.DumpID, limitedRangeIDsByKey[i], definitionLocations, path, definitionDocument, traceLog)
+			}
+		}
+
+		referenceRangeIDsByResultID := make(map[semantic.ID]map[string][]semantic.ID, len(referenceResultIDsByKey[i]))
+		for _, id := range referenceResultIDsByKey[i] {
+			if rangeIDsByDocument, ok := rangeIDsByResultIDByDumpID[key.DumpID][id]; ok {
+				referenceRangeIDsByResultID[id] = rangeIDsByDocument
+			}
+		}
+		referenceLocations := make(map[semantic.ID][]Location, len(referenceResultIDsByKey[i]))
+		s.readRangesFromDocument(key.DumpID, referenceRangeIDsByResultID, referenceLocations, key.Path, document, traceLog)
+
+		codeintelRanges := make([]CodeIntelligenceRange, 0, len(rangesByKey[i]))
+		for _, r := range rangesByKey[i] {
+			codeintelRanges = append(codeintelRanges, CodeIntelligenceRange{
+				Range:       newRange(r.StartLine, r.StartCharacter, r.EndLine, r.EndCharacter),
+				Definitions: definitionLocations[r.DefinitionResultID],
+				References:  referenceLocations[r.ReferenceResultID],
+				HoverText:   document.HoverResults[r.HoverResultID],
+			})
+		}
+		sort.Slice(codeintelRanges, func(i, j int) bool {
+			return compareBundleRanges(codeintelRanges[i].Range, codeintelRanges[j].Range)
+		})
+		codeintelRangesByKey[i] = codeintelRanges
+	}
+
+	return codeintelRangesByKey, nil
+}
+
+// numRanges returns the total number of ranges in the given slices.
+func numRanges(rangesByKey [][]semantic.RangeData) int {
+	n := 0
+	for _, ranges := range rangesByKey {
+		n += len(ranges)
+	}
+	return n
+}
+
+const batchRangesDocumentQuery = `
+-- source: enterprise/internal/codeintel/stores/lsifstore/batch.go:BatchRanges
+SELECT
+	dump_id,
+	path,
+	data,
+	ranges,
+	hovers,
+	NULL AS monikers,
+	NULL AS packages,
+	NULL AS diagnostics
+FROM
+	lsif_data_documents
+WHERE
+	(dump_id, path) IN (%s)
+`
+
+// BatchDiagnostics returns the diagnostics for the documents that have the given path prefix within each
+// of the given dumps. The path of each key is interpreted as a prefix. The documents are fetched together
+// in a single query. The returned slices are parallel to the given keys. The second slice contains the
+// size of the complete result set for each key to aid in pagination.
+func (s *Store) BatchDiagnostics(ctx context.Context, keys []DocumentKey, limit int) (_ [][]Diagnostic, _ []int, err error) {
+	ctx, traceLog, endObservation := s.operations.batchDiagnostics.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("numKeys", len(keys)),
+		log.String("keys", documentKeysToString(keys)),
+		log.Int("limit", limit),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if len(keys) == 0 {
+		return nil, nil, nil
+	}
+
+	conds := make([]*sqlf.Query, 0, len(keys))
+	for _, key := range keys {
+		// The prefix is escaped so that paths containing % or _ only match themselves
+		conds = append(conds, sqlf.Sprintf("(dump_id = %s AND path LIKE %s)", key.DumpID, escapeLikePattern(key.Path)+"%"))
+	}
+
+	documentData, err := s.scanDocumentData(s.Store.Query(ctx, sqlf.Sprintf(batchDiagnosticsQuery, sqlf.Join(conds, " OR "))))
+	if err != nil {
+		return nil, nil, err
+	}
+	traceLog(log.Int("numDocuments", len(documentData)))
+
+	diagnosticsByKey := make([][]Diagnostic, len(keys))
+	totalCounts := make([]int, len(keys))
+	for i, key := range keys {
+		// A single document may match the prefix of multiple keys, so we partition the
+		// documents for each key independently rather than assigning each row once.
+		var matching []QualifiedDocumentData
+		for _, documentData := range documentData {
+			if documentData.UploadID == key.DumpID && strings.HasPrefix(documentData.Path, key.Path) {
+				matching = append(matching, documentData)
+			}
+		}
+
+		diagnosticsByKey[i], totalCounts[i] = pageDiagnostics(key.DumpID, matching, limit, 0)
+	}
+
+	return diagnosticsByKey, totalCounts, nil
+}
+
+const batchDiagnosticsQuery = `
+-- source: enterprise/internal/codeintel/stores/lsifstore/batch.go:BatchDiagnostics
+SELECT
+	dump_id,
+	path,
+	data,
+	NULL AS ranges,
+	NULL AS hovers,
+	NULL AS monikers,
+	NULL AS packages,
+	diagnostics
+FROM
+	lsif_data_documents
+WHERE
+	%s
+ORDER BY dump_id, path
+`
+
+// readDocumentsByKeys reads the documents identified by the given keys. The given query must select the
+// columns expected by scanSingleDocumentDataObject and contain a single placeholder for a list of (dump_id,
+// path) pairs. Documents that do not exist are absent from the returned map.
+func (s *Store) readDocumentsByKeys(ctx context.Context, query string, keys []DocumentKey) (map[DocumentKey]semantic.DocumentData, error) {
+	keys = deduplicateDocumentKeys(keys)
+	documents := make(map[DocumentKey]semantic.DocumentData, len(keys))
+
+	// In order to limit the number of parameters we send to Postgres in the document
+	// fetch query, we process the keys in chunks of maximum size. This will also ensure
+	// that Postgres will not have to load an unbounded number of compressed document data
+	// payloads into memory in order to handle the query.
+
+	for len(keys) > 0 {
+		var batch []DocumentKey
+		if len(keys) <= documentBatchSize {
+			batch, keys = keys, nil
+		} else {
+			batch, keys = keys[:documentBatchSize], keys[documentBatchSize:]
+		}
+
+		pairQueries := make([]*sqlf.Query, 0, len(batch))
+		for _, key := range batch {
+			pairQueries = append(pairQueries, sqlf.Sprintf("(%s, %s)", key.DumpID, key.Path))
+		}
+
+		documentData, err := s.scanDocumentData(s.Store.Query(ctx, sqlf.Sprintf(query, sqlf.Join(pairQueries, ","))))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, documentData := range documentData {
+			documents[DocumentKey{DumpID: documentData.UploadID, Path: documentData.Path}] = documentData.Document
+		}
+	}
+
+	return documents, nil
+}
+
+// deduplicateDocumentKeys returns a copy of the given keys with duplicate entries removed.
+func deduplicateDocumentKeys(keys []DocumentKey) []DocumentKey {
+	seen := make(map[DocumentKey]struct{}, len(keys))
+	deduplicated := make([]DocumentKey, 0, len(keys))
+	for _, key := range keys {
+		if _, ok := seen[key]; ok {
+			continue
+		}
+
+		seen[key] = struct{}{}
+		deduplicated = append(deduplicated, key)
+	}
+
+	return deduplicated
+}
+
+// documentKeysFromPositionKeys returns the document keys enclosing each of the given position keys.
+func documentKeysFromPositionKeys(keys []PositionKey) []DocumentKey {
+	documentKeys := make([]DocumentKey, 0, len(keys))
+	for _, key := range keys {
+		documentKeys = append(documentKeys, key.DocumentKey)
+	}
+
+	return documentKeys
+}
+
+func documentKeysToString(keys []DocumentKey) string {
+	strs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		strs = append(strs, fmt.Sprintf("%d:%s", key.DumpID, key.Path))
+	}
+
+	return strings.Join(strs, ", ")
+}
+
+func positionKeysToString(keys []PositionKey) string {
+	strs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		strs = append(strs, fmt.Sprintf("%d:%s:%d:%d", key.DumpID, key.Path, key.Line, key.Character))
+	}
+
+	return strings.Join(strs, ", ")
+}
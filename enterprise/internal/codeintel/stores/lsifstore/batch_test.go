@@ -0,0 +1,127 @@
+package lsifstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtesting"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestDatabaseBatchDefinitions(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	db := dbtesting.GetDB(t)
+	populateTestStore(t)
+	store := NewStore(db, &observation.TestContext)
+
+	keys := []PositionKey{
+		{DocumentKey: DocumentKey{DumpID: testBundleID, Path: "cmd/lsif-go/main.go"}, Line: 110, Character: 22},
+		{DocumentKey: DocumentKey{DumpID: testBundleID, Path: "missing.go"}, Line: 110, Character: 22},
+		{DocumentKey: DocumentKey{DumpID: testBundleID, Path: "internal/index/indexer.go"}, Line: 20, Character: 3},
+	}
+
+	if actual, err := store.BatchDefinitions(context.Background(), keys, 5); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	} else {
+		expected := [][]Location{
+			{{DumpID: testBundleID, Path: "internal/index/indexer.go", Range: newRange(20, 1, 20, 6)}},
+			nil,
+			{{DumpID: testBundleID, Path: "internal/index/indexer.go", Range: newRange(20, 1, 20, 6)}},
+		}
+
+		if diff := cmp.Diff(expected, actual); diff != "" {
+			t.Errorf("unexpected definitions locations (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestDatabaseBatchHover(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	db := dbtesting.GetDB(t)
+	populateTestStore(t)
+	store := NewStore(db, &observation.TestContext)
+
+	keys := []PositionKey{
+		{DocumentKey: DocumentKey{DumpID: testBundleID, Path: "missing.go"}, Line: 628, Character: 20},
+		{DocumentKey: DocumentKey{DumpID: testBundleID, Path: "internal/index/indexer.go"}, Line: 628, Character: 20},
+	}
+
+	if actual, err := store.BatchHover(context.Background(), keys, HoverFormatMarkdown); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	} else {
+		expectedText, expectedRange, _, err := store.Hover(context.Background(), testBundleID, "internal/index/indexer.go", 628, 20, HoverFormatMarkdown)
+		if err != nil {
+			t.Fatalf("unexpected error %s", err)
+		}
+
+		expected := []HoverResult{
+			{},
+			{Text: expectedText, Range: expectedRange, Exists: true},
+		}
+
+		if diff := cmp.Diff(expected, actual); diff != "" {
+			t.Errorf("unexpected hover results (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestDatabaseBatchRanges(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	db := dbtesting.GetDB(t)
+	populateTestStore(t)
+	store := NewStore(db, &observation.TestContext)
+
+	keys := []DocumentKey{
+		{DumpID: testBundleID, Path: "cmd/lsif-go/main.go"},
+		{DumpID: testBundleID, Path: "missing.go"},
+		{DumpID: testBundleID, Path: "internal/index/indexer.go"},
+	}
+
+	actual, err := store.BatchRanges(context.Background(), keys, 100, 120)
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	// The batched ranges must match the ranges of each document fetched on its own
+	expected := make([][]CodeIntelligenceRange, 0, len(keys))
+	for _, key := range keys {
+		ranges, err := store.Ranges(context.Background(), key.DumpID, key.Path, 100, 120)
+		if err != nil {
+			t.Fatalf("unexpected error %s", err)
+		}
+		expected = append(expected, ranges)
+	}
+
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("unexpected ranges (-want +got):\n%s", diff)
+	}
+}
+
+func TestDatabaseBatchDiagnosticsEscapesPrefix(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	db := dbtesting.GetDB(t)
+	populateTestStore(t)
+	store := NewStore(db, &observation.TestContext)
+
+	// Without escaping, these prefixes would match every document of the dump
+	keys := []DocumentKey{
+		{DumpID: testBundleID, Path: "%"},
+		{DumpID: testBundleID, Path: "_"},
+	}
+
+	if _, totalCounts, err := store.BatchDiagnostics(context.Background(), keys, 100); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	} else if diff := cmp.Diff([]int{0, 0}, totalCounts); diff != "" {
+		t.Errorf("unexpected total counts (-want +got):\n%s", diff)
+	}
+}
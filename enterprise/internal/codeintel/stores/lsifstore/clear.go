@@ -8,6 +8,7 @@ import (
 	"github.com/keegancsmith/sqlf"
 	"github.com/opentracing/opentracing-go/log"
 
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 )
 
@@ -16,6 +17,7 @@ var tableNames = []string{
 	"lsif_data_documents",
 	"lsif_data_documents_schema_versions",
 	"lsif_data_result_chunks",
+	"lsif_data_result_chunks_schema_versions",
 	"lsif_data_definitions",
 	"lsif_data_definitions_schema_versions",
 	"lsif_data_references",
@@ -62,6 +64,71 @@ const clearQuery = `
 DELETE FROM %s WHERE dump_id IN (%s)
 `
 
+// BundleIDs returns the identifiers of the bundles with data in the store that are larger than
+// the given identifier, in ascending order. This is used to page through all bundles held by the
+// store (e.g., to find data whose upload record no longer exists).
+func (s *Store) BundleIDs(ctx context.Context, afterBundleID, limit int) (_ []int, err error) {
+	ctx, endObservation := s.operations.bundleIDs.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("afterBundleID", afterBundleID),
+		log.Int("limit", limit),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return basestore.ScanInts(s.Store.Query(ctx, sqlf.Sprintf(bundleIDsQuery, afterBundleID, limit)))
+}
+
+const bundleIDsQuery = `
+-- source: enterprise/internal/codeintel/stores/lsifstore/clear.go:BundleIDs
+SELECT dump_id FROM lsif_data_metadata WHERE dump_id > %s ORDER BY dump_id LIMIT %s
+`
+
+// BundleSize returns the number of rows and the (approximate) number of bytes of the data of
+// the given bundles across all tables cleared by Clear.
+func (s *Store) BundleSize(ctx context.Context, bundleIDs []int) (numRows int, numBytes int64, err error) {
+	ctx, endObservation := s.operations.bundleSize.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("numBundleIDs", len(bundleIDs)),
+		log.String("bundleIDs", intsToString(bundleIDs)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if len(bundleIDs) == 0 {
+		return 0, 0, nil
+	}
+
+	var ids []*sqlf.Query
+	for _, bundleID := range bundleIDs {
+		ids = append(ids, sqlf.Sprintf("%d", bundleID))
+	}
+
+	var queries []*sqlf.Query
+	for _, tableName := range tableNames {
+		queries = append(queries, sqlf.Sprintf(bundleSizeTableQuery, sqlf.Sprintf(tableName), sqlf.Join(ids, ",")))
+	}
+
+	rows, err := s.Store.Query(ctx, sqlf.Sprintf(bundleSizeQuery, sqlf.Join(queries, "UNION ALL")))
+	if err != nil {
+		return 0, 0, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	for rows.Next() {
+		if err := rows.Scan(&numRows, &numBytes); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return numRows, numBytes, nil
+}
+
+const bundleSizeQuery = `
+-- source: enterprise/internal/codeintel/stores/lsifstore/clear.go:BundleSize
+SELECT COALESCE(SUM(s.num_rows), 0), COALESCE(SUM(s.num_bytes), 0)::bigint FROM (%s) s
+`
+
+const bundleSizeTableQuery = `
+(SELECT COUNT(*) AS num_rows, SUM(pg_column_size(t.*)) AS num_bytes FROM %s t WHERE t.dump_id IN (%s))
+`
+
 func intsToString(vs []int) string {
 	strs := make([]string, 0, len(vs))
 	for _, v := range vs {
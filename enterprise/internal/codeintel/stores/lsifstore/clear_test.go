@@ -40,3 +40,38 @@ func TestClear(t *testing.T) {
 		t.Errorf("unexpected dump identifiers (-want +got):\n%s", diff)
 	}
 }
+
+func TestBundleIDsAndSize(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	db := dbtesting.GetDB(t)
+	store := NewStore(db, &observation.TestContext)
+
+	for i := 0; i < 5; i++ {
+		query := sqlf.Sprintf("INSERT INTO lsif_data_metadata (dump_id, num_result_chunks) VALUES (%s, 0)", i+1)
+
+		if _, err := db.Exec(query.Query(sqlf.PostgresBindVar), query.Args()...); err != nil {
+			t.Fatalf("unexpected error inserting metadata: %s", err)
+		}
+	}
+
+	bundleIDs, err := store.BundleIDs(context.Background(), 2, 2)
+	if err != nil {
+		t.Fatalf("unexpected error listing bundle identifiers: %s", err)
+	}
+	if diff := cmp.Diff([]int{3, 4}, bundleIDs); diff != "" {
+		t.Errorf("unexpected bundle identifiers (-want +got):\n%s", diff)
+	}
+
+	numRows, numBytes, err := store.BundleSize(context.Background(), []int{2, 4, 6})
+	if err != nil {
+		t.Fatalf("unexpected error computing bundle size: %s", err)
+	}
+	if numRows != 2 {
+		t.Errorf("unexpected number of rows. want=%d have=%d", 2, numRows)
+	}
+	if numBytes <= 0 {
+		t.Errorf("expected a positive number of bytes. have=%d", numBytes)
+	}
+}
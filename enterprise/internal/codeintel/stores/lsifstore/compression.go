@@ -0,0 +1,222 @@
+package lsifstore
+
+import (
+	"bytes"
+	"compress/gzip"
+	"embed"
+	"io/ioutil"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/go-multierror"
+	"github.com/klauspost/compress/zstd"
+)
+
+// PayloadKind identifies the type of a compressed payload. Each kind of payload is
+// compressed with a zstd dictionary trained on a sample of payloads of that kind.
+type PayloadKind string
+
+const (
+	// DocumentPayload is the kind of the encoded ranges, hovers, monikers, packages,
+	// and diagnostics columns of lsif_data_documents (as well as legacy data column).
+	DocumentPayload PayloadKind = "documents"
+
+	// ResultChunkPayload is the kind of the data column of lsif_data_result_chunks.
+	ResultChunkPayload PayloadKind = "result_chunks"
+
+	// LocationsPayload is the kind of the data column of lsif_data_definitions and
+	// lsif_data_references.
+	LocationsPayload PayloadKind = "locations"
+
+	// DocumentationPayload is the kind of documentation page payloads. There is no
+	// trained dictionary for this kind, so payloads are compressed without one.
+	DocumentationPayload PayloadKind = "documentation"
+)
+
+// zstdMagic is the prefix of every zstd frame. Payloads written before zstd compression
+// was introduced are gzip streams, which always begin with 0x1f 0x8b instead.
+var zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+
+// dictionaryFiles holds the zstd dictionaries trained by dictionaries/train.sh. Files are
+// named <kind>-<dictionary id>.zdict. Payloads of a kind are compressed with the dictionary
+// with the largest id, but can be decompressed with any dictionary referenced by a frame.
+//
+//go:embed dictionaries/*.zdict
+var dictionaryFiles embed.FS
+
+type zstdCodecs struct {
+	encoders map[PayloadKind]*zstd.Encoder
+	decoder  *zstd.Decoder
+}
+
+var (
+	codecsOnce   sync.Once
+	sharedCodecs *zstdCodecs
+	codecsErr    error
+)
+
+// getZstdCodecs returns the (shared) zstd encoders and decoder. These values are safe
+// for concurrent use when only EncodeAll and DecodeAll are called.
+func getZstdCodecs() (*zstdCodecs, error) {
+	codecsOnce.Do(func() {
+		sharedCodecs, codecsErr = newZstdCodecs()
+	})
+
+	return sharedCodecs, codecsErr
+}
+
+func newZstdCodecs() (*zstdCodecs, error) {
+	entries, err := dictionaryFiles.ReadDir("dictionaries")
+	if err != nil {
+		return nil, err
+	}
+
+	var dictionaries [][]byte
+	currentDictionaries := map[PayloadKind][]byte{}
+	currentIDs := map[PayloadKind]int{}
+
+	for _, entry := range entries {
+		kind, id, ok := parseDictionaryFilename(entry.Name())
+		if !ok {
+			continue
+		}
+
+		dictionary, err := dictionaryFiles.ReadFile(path.Join("dictionaries", entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		dictionaries = append(dictionaries, dictionary)
+
+		if id > currentIDs[kind] {
+			currentIDs[kind] = id
+			currentDictionaries[kind] = dictionary
+		}
+	}
+
+	encoders := map[PayloadKind]*zstd.Encoder{}
+	for _, kind := range []PayloadKind{DocumentPayload, ResultChunkPayload, LocationsPayload, DocumentationPayload} {
+		options := []zstd.EOption{zstd.WithEncoderLevel(zstd.SpeedDefault)}
+		if dictionary, ok := currentDictionaries[kind]; ok {
+			options = append(options, zstd.WithEncoderDict(dictionary))
+		}
+
+		encoder, err := zstd.NewWriter(nil, options...)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create %s encoder", kind)
+		}
+		encoders[kind] = encoder
+	}
+
+	decoder, err := zstd.NewReader(nil, zstd.WithDecoderDicts(dictionaries...))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create decoder")
+	}
+
+	return &zstdCodecs{encoders: encoders, decoder: decoder}, nil
+}
+
+// parseDictionaryFilename returns the payload kind and dictionary id encoded in the given
+// dictionary filename.
+func parseDictionaryFilename(filename string) (PayloadKind, int, bool) {
+	name := strings.TrimSuffix(filename, ".zdict")
+	if index := strings.LastIndex(name, "-"); index >= 0 {
+		if id, err := strconv.Atoi(name[index+1:]); err == nil {
+			return PayloadKind(name[:index]), id, true
+		}
+	}
+
+	return "", 0, false
+}
+
+// compress compresses the given data with zstd using the dictionary for the given kind.
+func (s *Serializer) compress(kind PayloadKind, data []byte) ([]byte, error) {
+	codecs, err := getZstdCodecs()
+	if err != nil {
+		return nil, err
+	}
+
+	encoder, ok := codecs.encoders[kind]
+	if !ok {
+		return nil, errors.Errorf("unknown payload kind %q", kind)
+	}
+
+	return encoder.EncodeAll(data, nil), nil
+}
+
+// compressLegacy compresses the given data with gzip.
+func (s *Serializer) compressLegacy(data []byte) ([]byte, error) {
+	gzipWriter := s.writers.Get().(*gzip.Writer)
+	defer s.writers.Put(gzipWriter)
+
+	compressBuf := new(bytes.Buffer)
+	gzipWriter.Reset(compressBuf)
+
+	if _, err := gzipWriter.Write(data); err != nil {
+		return nil, err
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return nil, err
+	}
+
+	return compressBuf.Bytes(), nil
+}
+
+// decompress decompresses the given data. Data is assumed to be a zstd frame if it begins
+// with the zstd magic number, and a gzip stream otherwise.
+func (s *Serializer) decompress(data []byte) (_ []byte, err error) {
+	if bytes.HasPrefix(data, zstdMagic) {
+		codecs, err := getZstdCodecs()
+		if err != nil {
+			return nil, err
+		}
+
+		return codecs.decoder.DecodeAll(data, nil)
+	}
+
+	r := s.readers.Get().(*gzip.Reader)
+	defer s.readers.Put(r)
+
+	if err := r.Reset(bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := r.Close(); closeErr != nil {
+			err = multierror.Append(err, closeErr)
+		}
+	}()
+
+	return ioutil.ReadAll(r)
+}
+
+// Recompress decompresses the given payload and re-compresses it with zstd using the
+// dictionary for the given kind. Empty payloads are returned unchanged.
+func (s *Serializer) Recompress(kind PayloadKind, data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return data, nil
+	}
+
+	decompressed, err := s.decompress(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.compress(kind, decompressed)
+}
+
+// RecompressLegacy decompresses the given payload and re-compresses it with gzip. This is
+// the inverse of Recompress. Empty payloads are returned unchanged.
+func (s *Serializer) RecompressLegacy(data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return data, nil
+	}
+
+	decompressed, err := s.decompress(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.compressLegacy(decompressed)
+}
@@ -0,0 +1,115 @@
+package lsifstore
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
+)
+
+func TestDictionaries(t *testing.T) {
+	entries, err := dictionaryFiles.ReadDir("dictionaries")
+	if err != nil {
+		t.Fatalf("unexpected error reading dictionaries: %s", err)
+	}
+
+	kinds := map[PayloadKind]struct{}{}
+	for _, entry := range entries {
+		if kind, _, ok := parseDictionaryFilename(entry.Name()); ok {
+			kinds[kind] = struct{}{}
+		}
+	}
+
+	for _, kind := range []PayloadKind{DocumentPayload, ResultChunkPayload, LocationsPayload} {
+		if _, ok := kinds[kind]; !ok {
+			t.Errorf("no dictionary for payload kind %q", kind)
+		}
+	}
+
+	if _, err := getZstdCodecs(); err != nil {
+		t.Fatalf("unexpected error creating codecs: %s", err)
+	}
+}
+
+func TestParseDictionaryFilename(t *testing.T) {
+	testCases := []struct {
+		filename string
+		kind     PayloadKind
+		id       int
+		ok       bool
+	}{
+		{filename: "documents-100001.zdict", kind: DocumentPayload, id: 100001, ok: true},
+		{filename: "result_chunks-100002.zdict", kind: ResultChunkPayload, id: 100002, ok: true},
+		{filename: "train.sh", ok: false},
+		{filename: "locations.zdict", ok: false},
+	}
+
+	for _, testCase := range testCases {
+		kind, id, ok := parseDictionaryFilename(testCase.filename)
+		if kind != testCase.kind || id != testCase.id || ok != testCase.ok {
+			t.Errorf(
+				"unexpected result for %q. want=(%q, %d, %v) have=(%q, %d, %v)",
+				testCase.filename,
+				testCase.kind, testCase.id, testCase.ok,
+				kind, id, ok,
+			)
+		}
+	}
+}
+
+func TestRecompress(t *testing.T) {
+	expected := []semantic.LocationData{
+		{URI: "internal/index/indexer.go", StartLine: 36, StartCharacter: 26, EndLine: 36, EndCharacter: 32},
+		{URI: "protocol/writer.go", StartLine: 100, StartCharacter: 9, EndLine: 100, EndCharacter: 15},
+	}
+
+	serializer := NewSerializer()
+
+	data, err := serializer.MarshalLocations(expected)
+	if err != nil {
+		t.Fatalf("unexpected error marshalling locations: %s", err)
+	}
+	if !bytes.HasPrefix(data, zstdMagic) {
+		t.Fatalf("expected payload to be zstd-compressed")
+	}
+
+	legacyData, err := serializer.RecompressLegacy(data)
+	if err != nil {
+		t.Fatalf("unexpected error recompressing locations: %s", err)
+	}
+	if !bytes.HasPrefix(legacyData, []byte{0x1f, 0x8b}) {
+		t.Fatalf("expected payload to be gzip-compressed")
+	}
+
+	recompressedData, err := serializer.Recompress(LocationsPayload, legacyData)
+	if err != nil {
+		t.Fatalf("unexpected error recompressing locations: %s", err)
+	}
+	if !bytes.HasPrefix(recompressedData, zstdMagic) {
+		t.Fatalf("expected payload to be zstd-compressed")
+	}
+
+	for _, payload := range [][]byte{data, legacyData, recompressedData} {
+		locations, err := serializer.UnmarshalLocations(payload)
+		if err != nil {
+			t.Fatalf("unexpected error unmarshalling locations: %s", err)
+		}
+
+		if diff := cmp.Diff(expected, locations); diff != "" {
+			t.Errorf("unexpected locations (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestRecompressEmpty(t *testing.T) {
+	serializer := NewSerializer()
+
+	if data, err := serializer.Recompress(DocumentPayload, nil); err != nil || data != nil {
+		t.Errorf("unexpected result recompressing empty payload. want=(nil, nil) have=(%v, %v)", data, err)
+	}
+	if data, err := serializer.RecompressLegacy(nil); err != nil || data != nil {
+		t.Errorf("unexpected result recompressing empty payload. want=(nil, nil) have=(%v, %v)", data, err)
+	}
+}
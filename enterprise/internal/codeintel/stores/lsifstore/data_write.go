@@ -15,13 +15,16 @@ import (
 )
 
 // CurrentDocumentSchemaVersion is the schema version used for new lsif_data_documents rows.
-const CurrentDocumentSchemaVersion = 3
+const CurrentDocumentSchemaVersion = 4
+
+// CurrentResultChunkSchemaVersion is the schema version used for new lsif_data_result_chunks rows.
+const CurrentResultChunkSchemaVersion = 2
 
 // CurrentDefinitionsSchemaVersion is the schema version used for new lsif_data_definitions rows.
-const CurrentDefinitionsSchemaVersion = 2
+const CurrentDefinitionsSchemaVersion = 3
 
 // CurrentReferencesSchemaVersion is the schema version used for new lsif_data_references rows.
-const CurrentReferencesSchemaVersion = 2
+const CurrentReferencesSchemaVersion = 3
 
 // WriteMeta is called (transactionally) from the precise-code-intel-worker.
 func (s *Store) WriteMeta(ctx context.Context, bundleID int, meta semantic.MetaData) (err error) {
@@ -137,7 +140,7 @@ func (s *Store) WriteResultChunks(ctx context.Context, bundleID int, resultChunk
 	}
 	defer func() { err = tx.Done(err) }()
 
-	// Create temporary table symmetric to lsif_data_documents without the dump id
+	// Create temporary table symmetric to lsif_data_result_chunks without the dump id or schema version
 	if err := tx.Exec(ctx, sqlf.Sprintf(writeResultChunksTemporaryTableQuery)); err != nil {
 		return err
 	}
@@ -173,8 +176,9 @@ func (s *Store) WriteResultChunks(ctx context.Context, bundleID int, resultChunk
 	traceLog(log.Int("numResultChunkRecords", int(count)))
 
 	// Insert the values from the temporary table into the target table. We select a
-	// parameterized dump id here since it is the same for all rows in this operation.
-	return tx.Exec(ctx, sqlf.Sprintf(writeResultChunksInsertQuery, bundleID))
+	// parameterized dump id and schema version here since it is the same for all rows
+	// in this operation.
+	return tx.Exec(ctx, sqlf.Sprintf(writeResultChunksInsertQuery, bundleID, CurrentResultChunkSchemaVersion))
 }
 
 const writeResultChunksTemporaryTableQuery = `
@@ -187,8 +191,8 @@ CREATE TEMPORARY TABLE t_lsif_data_result_chunks (
 
 const writeResultChunksInsertQuery = `
 -- source: enterprise/internal/codeintel/stores/lsifstore/data_write.go:WriteResultChunks
-INSERT INTO lsif_data_result_chunks (dump_id, idx, data)
-SELECT %s, source.idx, source.data
+INSERT INTO lsif_data_result_chunks (dump_id, schema_version, idx, data)
+SELECT %s, %s, source.idx, source.data
 FROM t_lsif_data_result_chunks source
 `
 
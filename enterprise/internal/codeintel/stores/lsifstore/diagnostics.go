@@ -26,11 +26,19 @@ func (s *Store) Diagnostics(ctx context.Context, bundleID int, prefix string, li
 	}
 	traceLog(log.Int("numDocuments", len(documentData)))
 
+	diagnostics, totalCount := pageDiagnostics(bundleID, documentData, limit, offset)
+	traceLog(log.Int("totalCount", totalCount))
+
+	return diagnostics, totalCount, nil
+}
+
+// pageDiagnostics returns the page of diagnostics attached to the given documents specified by limit
+// and offset, as well as the total number of diagnostics attached to the documents.
+func pageDiagnostics(bundleID int, documentData []QualifiedDocumentData, limit, offset int) ([]Diagnostic, int) {
 	totalCount := 0
 	for _, documentData := range documentData {
 		totalCount += len(documentData.Document.Diagnostics)
 	}
-	traceLog(log.Int("totalCount", totalCount))
 
 	diagnostics := make([]Diagnostic, 0, limit)
 	for _, documentData := range documentData {
@@ -47,7 +55,7 @@ func (s *Store) Diagnostics(ctx context.Context, bundleID int, prefix string, li
 		}
 	}
 
-	return diagnostics, totalCount, nil
+	return diagnostics, totalCount
 }
 
 const diagnosticsQuery = `
@@ -0,0 +1,148 @@
+package lsifstore
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
+)
+
+// aggregateDiagnosticsBatchSize is the maximum number of bundles whose documents are read
+// by a single query in AggregateDiagnostics. This bounds the number of compressed document
+// payloads held in memory at once.
+const aggregateDiagnosticsBatchSize = 10
+
+// AggregateDiagnostics returns the diagnostics attached to any document within the given bundles that
+// match the given options. Diagnostics are ordered by bundle identifier, then by path. This method also
+// returns the size of the complete result set to aid in pagination and, if requested by the options, the
+// number of matching diagnostics in each group ordered by descending count.
+func (s *Store) AggregateDiagnostics(ctx context.Context, bundleIDs []int, opts AggregateDiagnosticsOptions) (_ []Diagnostic, _ int, _ []DiagnosticGroup, err error) {
+	ctx, traceLog, endObservation := s.operations.aggregateDiagnostics.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("numBundleIDs", len(bundleIDs)),
+		log.String("bundleIDs", intsToString(bundleIDs)),
+		log.String("severities", intsToString(opts.Severities)),
+		log.String("groupBy", string(opts.GroupBy)),
+		log.Int("limit", opts.Limit),
+		log.Int("offset", opts.Offset),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	bundleIDs = append([]int(nil), bundleIDs...)
+	sort.Ints(bundleIDs)
+
+	severities := make(map[int]struct{}, len(opts.Severities))
+	for _, severity := range opts.Severities {
+		severities[severity] = struct{}{}
+	}
+
+	offset := opts.Offset
+	totalCount := 0
+	groupCounts := map[string]int{}
+	diagnostics := make([]Diagnostic, 0, opts.Limit)
+
+	for len(bundleIDs) > 0 {
+		var batch []int
+		if len(bundleIDs) <= aggregateDiagnosticsBatchSize {
+			batch, bundleIDs = bundleIDs, nil
+		} else {
+			batch, bundleIDs = bundleIDs[:aggregateDiagnosticsBatchSize], bundleIDs[aggregateDiagnosticsBatchSize:]
+		}
+
+		ids := make([]*sqlf.Query, 0, len(batch))
+		for _, id := range batch {
+			ids = append(ids, sqlf.Sprintf("%s", id))
+		}
+
+		documentData, err := s.scanDocumentData(s.Store.Query(ctx, sqlf.Sprintf(aggregateDiagnosticsQuery, sqlf.Join(ids, ","))))
+		if err != nil {
+			return nil, 0, nil, err
+		}
+		traceLog(log.Int("numDocuments", len(documentData)))
+
+		for _, documentData := range documentData {
+			for _, diagnostic := range documentData.Document.Diagnostics {
+				if _, ok := severities[diagnostic.Severity]; len(severities) > 0 && !ok {
+					continue
+				}
+
+				totalCount++
+				if opts.GroupBy != DiagnosticGroupNone {
+					groupCounts[diagnosticGroupKey(opts.GroupBy, documentData.Path, diagnostic)]++
+				}
+
+				offset--
+				if offset < 0 && len(diagnostics) < opts.Limit {
+					diagnostics = append(diagnostics, Diagnostic{
+						DumpID:         documentData.UploadID,
+						Path:           documentData.Path,
+						DiagnosticData: diagnostic,
+					})
+				}
+			}
+		}
+	}
+
+	groups := make([]DiagnosticGroup, 0, len(groupCounts))
+	for key, count := range groupCounts {
+		groups = append(groups, DiagnosticGroup{Key: key, Count: count})
+	}
+	sortDiagnosticGroups(groups)
+
+	traceLog(
+		log.Int("totalCount", totalCount),
+		log.Int("numGroups", len(groups)),
+	)
+
+	return diagnostics, totalCount, groups, nil
+}
+
+// sortDiagnosticGroups orders the given groups by descending count, then by key.
+func sortDiagnosticGroups(groups []DiagnosticGroup) {
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].Count == groups[j].Count {
+			return groups[i].Key < groups[j].Key
+		}
+
+		return groups[i].Count > groups[j].Count
+	})
+}
+
+// diagnosticGroupKey returns the value of the given property of a diagnostic attached to the given path.
+func diagnosticGroupKey(field DiagnosticGroupField, path string, diagnostic semantic.DiagnosticData) string {
+	switch field {
+	case DiagnosticGroupSeverity:
+		return strconv.Itoa(diagnostic.Severity)
+	case DiagnosticGroupSource:
+		return diagnostic.Source
+	case DiagnosticGroupCode:
+		return diagnostic.Code
+	case DiagnosticGroupPath:
+		return path
+	}
+
+	return ""
+}
+
+const aggregateDiagnosticsQuery = `
+-- source: enterprise/internal/codeintel/stores/lsifstore/diagnostics_aggregate.go:AggregateDiagnostics
+SELECT
+	dump_id,
+	path,
+	data,
+	NULL AS ranges,
+	NULL AS hovers,
+	NULL AS monikers,
+	NULL AS packages,
+	diagnostics
+FROM
+	lsif_data_documents
+WHERE
+	dump_id IN (%s) AND
+	num_diagnostics > 0
+ORDER BY dump_id, path
+`
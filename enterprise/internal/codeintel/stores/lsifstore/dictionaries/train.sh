@@ -0,0 +1,78 @@
+#!/usr/bin/env bash
+
+# Trains a new set of zstd dictionaries used to compress lsifstore payloads from a sample
+# of gzip-encoded rows in a codeintel database. Each dictionary is written next to this
+# script as <kind>-<dictionary id>.zdict. The serializer compresses new payloads with the
+# dictionary with the highest id for each kind, and can decompress payloads written with
+# any dictionary in this directory. Existing dictionaries must therefore never be removed
+# or modified while rows compressed with them may still exist.
+#
+# Usage: ./train.sh <first dictionary id>
+#
+# The dictionary ids must be distinct from all ids already in this directory. Connection
+# parameters are read from the standard PG* environment variables (e.g. CODEINTEL_PG*).
+
+set -euo pipefail
+cd "$(dirname "${BASH_SOURCE[0]}")"
+
+if [[ $# -ne 1 ]]; then
+  echo 'usage: ./train.sh <first dictionary id>'
+  exit 1
+fi
+
+NEXT_ID=$1
+SAMPLE_SIZE=${SAMPLE_SIZE:-5000}
+MAX_DICT_SIZE=${MAX_DICT_SIZE:-16384}
+
+WORKDIR=$(mktemp -d)
+trap 'rm -rf "$WORKDIR"' EXIT
+
+# sample <kind> <query>
+sample() {
+  mkdir -p "${WORKDIR}/$1"
+
+  local i=0
+  psql -Atc "$2" | while read -r payload; do
+    echo -n "${payload}" | xxd -r -p | gunzip >"${WORKDIR}/$1/${i}"
+    i=$((i + 1))
+  done
+}
+
+# Only sample payloads that are still gzip-encoded. Training on payloads decompressed from
+# zstd frames is possible but would require threading every existing dictionary through.
+GZIP_PREFIX="'\\x1f8b'::bytea"
+
+sample documents "
+  SELECT encode(payload, 'hex') FROM (
+    SELECT unnest(ARRAY[ranges, hovers, monikers, packages, diagnostics]) AS payload
+    FROM (SELECT * FROM lsif_data_documents TABLESAMPLE SYSTEM (1) LIMIT ${SAMPLE_SIZE}) s
+  ) p
+  WHERE substring(payload FROM 1 FOR 2) = ${GZIP_PREFIX}
+"
+
+sample result_chunks "
+  SELECT encode(data, 'hex')
+  FROM lsif_data_result_chunks TABLESAMPLE SYSTEM (1)
+  WHERE substring(data FROM 1 FOR 2) = ${GZIP_PREFIX}
+  LIMIT ${SAMPLE_SIZE}
+"
+
+sample locations "
+  SELECT encode(data, 'hex') FROM (
+    (SELECT data FROM lsif_data_definitions TABLESAMPLE SYSTEM (1) LIMIT ${SAMPLE_SIZE})
+    UNION ALL
+    (SELECT data FROM lsif_data_references TABLESAMPLE SYSTEM (1) LIMIT ${SAMPLE_SIZE})
+  ) s
+  WHERE substring(data FROM 1 FOR 2) = ${GZIP_PREFIX}
+"
+
+for kind in documents result_chunks locations; do
+  # Payloads are split into 4KiB blocks so that large documents and result chunks
+  # contribute more than a single sample each.
+  zstd --train -r "${WORKDIR}/${kind}" -B4096 \
+    --maxdict="${MAX_DICT_SIZE}" \
+    --dictID="${NEXT_ID}" \
+    -o "${kind}-${NEXT_ID}.zdict"
+
+  NEXT_ID=$((NEXT_ID + 1))
+done
@@ -0,0 +1,92 @@
+package lsifstore
+
+import (
+	"context"
+	"sort"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
+)
+
+// DocumentSymbols returns the symbols defined or declared within the given document, ordered by
+// the start of their full range. Only bundles processed after range tags were retained contain
+// symbols, so an empty result does not imply the indexer emitted none.
+func (s *Store) DocumentSymbols(ctx context.Context, bundleID int, path string) (_ []DocumentSymbol, err error) {
+	ctx, traceLog, endObservation := s.operations.documentSymbols.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("bundleID", bundleID),
+		log.String("path", path),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	documentData, exists, err := s.scanFirstDocumentData(s.Store.Query(ctx, sqlf.Sprintf(documentSymbolsDocumentQuery, bundleID, path)))
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	return documentSymbols(documentData.Document, traceLog), nil
+}
+
+// documentSymbols returns the symbols attached to the ranges of the given document.
+func documentSymbols(document semantic.DocumentData, traceLog observation.TraceLogger) []DocumentSymbol {
+	traceLog(log.Int("numRanges", len(document.Ranges)))
+
+	symbols := make([]DocumentSymbol, 0, len(document.Ranges))
+	for _, r := range document.Ranges {
+		if r.Symbol == nil {
+			continue
+		}
+
+		symbols = append(symbols, DocumentSymbol{
+			Name:      r.Symbol.Name,
+			Detail:    r.Symbol.Detail,
+			Kind:      r.Symbol.Kind,
+			Range:     newRange(r.StartLine, r.StartCharacter, r.EndLine, r.EndCharacter),
+			FullRange: newRange(r.Symbol.FullStartLine, r.Symbol.FullStartCharacter, r.Symbol.FullEndLine, r.Symbol.FullEndCharacter),
+		})
+	}
+	traceLog(log.Int("numSymbols", len(symbols)))
+
+	sort.Slice(symbols, func(i, j int) bool {
+		if r1, r2 := symbols[i].FullRange, symbols[j].FullRange; r1.Start != r2.Start {
+			return comparePositions(r1.Start, r2.Start)
+		} else if r1.End != r2.End {
+			// Enclosing symbols sharing a start position come first
+			return comparePositions(r2.End, r1.End)
+		}
+
+		return symbols[i].Name < symbols[j].Name
+	})
+
+	return symbols
+}
+
+// comparePositions returns true if p1 is before p2.
+func comparePositions(p1, p2 Position) bool {
+	if p1.Line != p2.Line {
+		return p1.Line < p2.Line
+	}
+
+	return p1.Character < p2.Character
+}
+
+const documentSymbolsDocumentQuery = `
+-- source: enterprise/internal/codeintel/stores/lsifstore/document_symbols.go:DocumentSymbols
+SELECT
+	dump_id,
+	path,
+	data,
+	ranges,
+	NULL AS hovers,
+	NULL AS monikers,
+	NULL AS packages,
+	NULL AS diagnostics
+FROM
+	lsif_data_documents
+WHERE
+	dump_id = %s AND
+	path = %s
+LIMIT 1
+`
@@ -0,0 +1,40 @@
+package lsifstore
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
+)
+
+func TestDocumentSymbols(t *testing.T) {
+	document := semantic.DocumentData{
+		Ranges: map[semantic.ID]semantic.RangeData{
+			"r1": {StartLine: 12, StartCharacter: 5, EndLine: 12, EndCharacter: 8, Symbol: &semantic.SymbolData{
+				Name: "Bar", Kind: protocol.Method, FullStartLine: 12, FullStartCharacter: 0, FullEndLine: 14, FullEndCharacter: 1,
+			}},
+			"r2": {StartLine: 2, StartCharacter: 5, EndLine: 2, EndCharacter: 8, Symbol: &semantic.SymbolData{
+				Name: "Foo", Detail: "struct", Kind: protocol.Struct, FullStartLine: 2, FullStartCharacter: 0, FullEndLine: 5, FullEndCharacter: 1,
+			}},
+			"r3": {StartLine: 3, StartCharacter: 1, EndLine: 3, EndCharacter: 4, Symbol: &semantic.SymbolData{
+				Name: "baz", Kind: protocol.Field, FullStartLine: 3, FullStartCharacter: 1, FullEndLine: 3, FullEndCharacter: 8,
+			}},
+			"r4": {StartLine: 13, StartCharacter: 8, EndLine: 13, EndCharacter: 11},
+		},
+	}
+
+	traceLog := func(...log.Field) {}
+	symbols := documentSymbols(document, traceLog)
+
+	expectedSymbols := []DocumentSymbol{
+		{Name: "Foo", Detail: "struct", Kind: protocol.Struct, Range: newRange(2, 5, 2, 8), FullRange: newRange(2, 0, 5, 1)},
+		{Name: "baz", Kind: protocol.Field, Range: newRange(3, 1, 3, 4), FullRange: newRange(3, 1, 3, 8)},
+		{Name: "Bar", Kind: protocol.Method, Range: newRange(12, 5, 12, 8), FullRange: newRange(12, 0, 14, 1)},
+	}
+	if diff := cmp.Diff(expectedSymbols, symbols); diff != "" {
+		t.Errorf("unexpected document symbols (-want +got):\n%s", diff)
+	}
+}
@@ -40,6 +40,67 @@ WHERE
 	path_id = %s
 `
 
+// DocumentationAtPosition returns the documentation nodes attached to the ranges that enclose the
+// given position, ordered from the inner-most range outward.
+func (s *Store) DocumentationAtPosition(ctx context.Context, bundleID int, path string, line, character int) (_ []DocumentationLink, err error) {
+	ctx, traceLog, endObservation := s.operations.documentationAtPosition.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("bundleID", bundleID),
+		log.String("path", path),
+		log.Int("line", line),
+		log.Int("character", character),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	documentData, exists, err := s.scanFirstDocumentData(s.Store.Query(ctx, sqlf.Sprintf(documentationAtPositionDocumentQuery, bundleID, path)))
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	return documentationAtPosition(documentData.Document, line, character, traceLog), nil
+}
+
+// documentationAtPosition returns the documentation links attached to the ranges of the given
+// document that enclose the given position.
+func documentationAtPosition(document semantic.DocumentData, line, character int, traceLog observation.TraceLogger) []DocumentationLink {
+	traceLog(log.Int("numRanges", len(document.Ranges)))
+	ranges := semantic.FindRanges(document.Ranges, line, character)
+	traceLog(log.Int("numIntersectingRanges", len(ranges)))
+
+	links := make([]DocumentationLink, 0, len(ranges))
+	for _, r := range ranges {
+		if r.DocumentationPathID == "" {
+			continue
+		}
+
+		links = append(links, DocumentationLink{
+			PathID:     r.DocumentationPathID,
+			PagePathID: r.DocumentationPagePathID,
+			Range:      newRange(r.StartLine, r.StartCharacter, r.EndLine, r.EndCharacter),
+		})
+	}
+
+	return links
+}
+
+const documentationAtPositionDocumentQuery = `
+-- source: enterprise/internal/codeintel/stores/lsifstore/documentation.go:DocumentationAtPosition
+SELECT
+	dump_id,
+	path,
+	data,
+	ranges,
+	NULL AS hovers,
+	NULL AS monikers,
+	NULL AS packages,
+	NULL AS diagnostics
+FROM
+	lsif_data_documents
+WHERE
+	dump_id = %s AND
+	path = %s
+LIMIT 1
+`
+
 // scanFirstDocumentationPageData reads the first DocumentationPageData row. If no rows match the
 // query, a nil is returned.
 func (s *Store) scanFirstDocumentationPageData(rows *sql.Rows, queryErr error) (_ *semantic.DocumentationPageData, err error) {
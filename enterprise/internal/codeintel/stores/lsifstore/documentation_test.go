@@ -0,0 +1,32 @@
+package lsifstore
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
+)
+
+func TestDocumentationAtPosition(t *testing.T) {
+	document := semantic.DocumentData{
+		Ranges: map[semantic.ID]semantic.RangeData{
+			"r1": {StartLine: 1, StartCharacter: 0, EndLine: 5, EndCharacter: 1, DocumentationPathID: "/pkg#Foo", DocumentationPagePathID: "/pkg"},
+			"r2": {StartLine: 2, StartCharacter: 4, EndLine: 2, EndCharacter: 8, DocumentationPathID: "/pkg/sub#Bar", DocumentationPagePathID: "/pkg/sub"},
+			"r3": {StartLine: 2, StartCharacter: 5, EndLine: 2, EndCharacter: 6},
+			"r4": {StartLine: 7, StartCharacter: 0, EndLine: 7, EndCharacter: 4, DocumentationPathID: "/pkg#Baz", DocumentationPagePathID: "/pkg"},
+		},
+	}
+
+	traceLog := func(...log.Field) {}
+	links := documentationAtPosition(document, 2, 5, traceLog)
+
+	expectedLinks := []DocumentationLink{
+		{PathID: "/pkg/sub#Bar", PagePathID: "/pkg/sub", Range: newRange(2, 4, 2, 8)},
+		{PathID: "/pkg#Foo", PagePathID: "/pkg", Range: newRange(1, 0, 5, 1)},
+	}
+	if diff := cmp.Diff(expectedLinks, links); diff != "" {
+		t.Errorf("unexpected documentation links (-want +got):\n%s", diff)
+	}
+}
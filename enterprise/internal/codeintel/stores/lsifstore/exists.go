@@ -26,3 +26,18 @@ const existsQuery = `
 -- source: enterprise/internal/codeintel/stores/lsifstore/exists.go:Exists
 SELECT path FROM lsif_data_documents WHERE dump_id = %s AND path = %s LIMIT 1
 `
+
+// DocumentPaths returns the paths of all documents in the given bundle.
+func (s *Store) DocumentPaths(ctx context.Context, bundleID int) (_ []string, err error) {
+	ctx, endObservation := s.operations.documentPaths.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("bundleID", bundleID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return basestore.ScanStrings(s.Store.Query(ctx, sqlf.Sprintf(documentPathsQuery, bundleID)))
+}
+
+const documentPathsQuery = `
+-- source: enterprise/internal/codeintel/stores/lsifstore/exists.go:DocumentPaths
+SELECT path FROM lsif_data_documents WHERE dump_id = %s ORDER BY path
+`
@@ -2,6 +2,7 @@ package lsifstore
 
 import (
 	"context"
+	"sort"
 	"testing"
 
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtesting"
@@ -33,3 +34,30 @@ func TestDatabaseExists(t *testing.T) {
 		}
 	}
 }
+
+func TestDatabaseDocumentPaths(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	db := dbtesting.GetDB(t)
+	populateTestStore(t)
+	store := NewStore(db, &observation.TestContext)
+
+	paths, err := store.DocumentPaths(context.Background(), testBundleID)
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	pathSet := map[string]struct{}{}
+	for _, path := range paths {
+		pathSet[path] = struct{}{}
+	}
+	for _, path := range []string{"cmd/lsif-go/main.go", "internal/index/indexer.go"} {
+		if _, ok := pathSet[path]; !ok {
+			t.Errorf("expected %s to be among document paths", path)
+		}
+	}
+	if !sort.StringsAreSorted(paths) {
+		t.Errorf("expected document paths to be sorted")
+	}
+}
@@ -10,13 +10,14 @@ import (
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
 )
 
-// Hover returns the hover text of the symbol at the given position.
-func (s *Store) Hover(ctx context.Context, bundleID int, path string, line, character int) (_ string, _ Range, _ bool, err error) {
+// Hover returns the hover text of the symbol at the given position rendered in the given format.
+func (s *Store) Hover(ctx context.Context, bundleID int, path string, line, character int, format HoverFormat) (_ string, _ Range, _ bool, err error) {
 	ctx, traceLog, endObservation := s.operations.hover.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
 		log.Int("bundleID", bundleID),
 		log.String("path", path),
 		log.Int("line", line),
 		log.Int("character", character),
+		log.String("format", string(format)),
 	}})
 	defer endObservation(1, observation.Args{})
 
@@ -25,17 +26,28 @@ func (s *Store) Hover(ctx context.Context, bundleID int, path string, line, char
 		return "", Range{}, false, err
 	}
 
-	traceLog(log.Int("numRanges", len(documentData.Document.Ranges)))
-	ranges := semantic.FindRanges(documentData.Document.Ranges, line, character)
+	result := hoverAtPosition(documentData.Document, line, character, format, traceLog)
+	return result.Text, result.Range, result.Exists, nil
+}
+
+// hoverAtPosition returns the hover text attached to the inner-most range of the given document that
+// encloses the given position, rendered in the given format.
+func hoverAtPosition(document semantic.DocumentData, line, character int, format HoverFormat, traceLog observation.TraceLogger) HoverResult {
+	traceLog(log.Int("numRanges", len(document.Ranges)))
+	ranges := semantic.FindRanges(document.Ranges, line, character)
 	traceLog(log.Int("numIntersectingRanges", len(ranges)))
 
 	for _, r := range ranges {
-		if text, ok := documentData.Document.HoverResults[r.HoverResultID]; ok {
-			return text, newRange(r.StartLine, r.StartCharacter, r.EndLine, r.EndCharacter), true, nil
+		if text, ok := document.HoverResults[r.HoverResultID]; ok {
+			return HoverResult{
+				Text:   FormatHover(text, format),
+				Range:  newRange(r.StartLine, r.StartCharacter, r.EndLine, r.EndCharacter),
+				Exists: true,
+			}
 		}
 	}
 
-	return "", Range{}, false, nil
+	return HoverResult{}
 }
 
 const hoverDocumentQuery = `
@@ -0,0 +1,127 @@
+package lsifstore
+
+import (
+	"regexp"
+	"strings"
+)
+
+// HoverFormat names the representation in which hover text is returned.
+type HoverFormat string
+
+const (
+	// HoverFormatMarkdown returns the hover text as written by the indexer.
+	HoverFormatMarkdown HoverFormat = "markdown"
+
+	// HoverFormatPlaintext returns the hover text with markdown syntax removed.
+	HoverFormatPlaintext HoverFormat = "plaintext"
+
+	// HoverFormatSignature returns only the signature of the symbol, which is the content of the
+	// first code block of the hover text. If the hover text has no code block, its first paragraph
+	// is returned as plaintext instead.
+	HoverFormatSignature HoverFormat = "signature"
+)
+
+// FormatHover converts the given markdown hover text into the given format. An empty or unknown
+// format leaves the text unchanged.
+func FormatHover(text string, format HoverFormat) string {
+	switch format {
+	case HoverFormatPlaintext:
+		return hoverPlaintext(text)
+	case HoverFormatSignature:
+		return hoverSignature(text)
+	}
+
+	return text
+}
+
+// hoverSignature returns the content of the first fenced code block of the given markdown text,
+// or the first paragraph of the text if it has no code block.
+func hoverSignature(text string) string {
+	var lines []string
+	inCode := false
+	for _, line := range strings.Split(text, "\n") {
+		if isCodeFence(line) {
+			if inCode {
+				return strings.TrimSpace(strings.Join(lines, "\n"))
+			}
+
+			inCode = true
+			continue
+		}
+
+		if inCode {
+			lines = append(lines, line)
+		}
+	}
+
+	plaintext := hoverPlaintext(text)
+	if i := strings.Index(plaintext, "\n\n"); i >= 0 {
+		return plaintext[:i]
+	}
+	return plaintext
+}
+
+var (
+	markdownHeadingPattern   = regexp.MustCompile(`^\s{0,3}#{1,6}\s+`)
+	markdownLinkPattern      = regexp.MustCompile(`!?\[([^\]]*)\]\([^)]*\)`)
+	markdownStrongPattern    = regexp.MustCompile(`(\*\*|__)(\S(?:.*?\S)?)(\*\*|__)`)
+	markdownEmphasisPattern  = regexp.MustCompile(`(^|[^\w*])[*_](\S(?:[^*_]*?\S)?)[*_]([^\w*]|$)`)
+	markdownEscapePattern    = regexp.MustCompile("\\\\([\\\\`*_{}\\[\\]()#+\\-.!<>|])")
+	markdownBlankLinePattern = regexp.MustCompile(`\n{3,}`)
+)
+
+// hoverPlaintext removes markdown syntax from the given text. The content of code blocks is kept
+// verbatim, and horizontal rules separating sections are replaced by blank lines.
+func hoverPlaintext(text string) string {
+	lines := strings.Split(text, "\n")
+	out := make([]string, 0, len(lines))
+
+	inCode := false
+	for _, line := range lines {
+		if isCodeFence(line) {
+			inCode = !inCode
+			out = append(out, "")
+			continue
+		}
+		if inCode {
+			out = append(out, line)
+			continue
+		}
+		if isThematicBreak(line) {
+			out = append(out, "")
+			continue
+		}
+
+		line = markdownHeadingPattern.ReplaceAllString(line, "")
+		line = markdownLinkPattern.ReplaceAllString(line, "$1")
+		line = markdownStrongPattern.ReplaceAllString(line, "$2")
+		line = markdownEmphasisPattern.ReplaceAllString(line, "$1$2$3")
+		line = strings.ReplaceAll(line, "`", "")
+		line = markdownEscapePattern.ReplaceAllString(line, "$1")
+		out = append(out, strings.TrimRight(line, " \t"))
+	}
+
+	return strings.TrimSpace(markdownBlankLinePattern.ReplaceAllString(strings.Join(out, "\n"), "\n\n"))
+}
+
+// isCodeFence returns true if the given line opens or closes a fenced code block.
+func isCodeFence(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	return strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~")
+}
+
+// isThematicBreak returns true if the given line is a markdown horizontal rule.
+func isThematicBreak(line string) bool {
+	trimmed := strings.ReplaceAll(strings.TrimSpace(line), " ", "")
+	if len(trimmed) < 3 {
+		return false
+	}
+
+	for _, c := range trimmed {
+		if c != rune(trimmed[0]) || (c != '-' && c != '*' && c != '_') {
+			return false
+		}
+	}
+
+	return true
+}
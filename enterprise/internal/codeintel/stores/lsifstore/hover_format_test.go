@@ -0,0 +1,39 @@
+package lsifstore
+
+import "testing"
+
+func TestFormatHover(t *testing.T) {
+	text := "```go\nfunc findContents(pkgs []*Package, obj Object) ([]MarkedString, error)\n```\n\n---\n\n" +
+		"## Overview\n\nfindContents returns **contents** used as _hover info_ for the given `obj`.\nSee [the docs](https://example.com) for snake_case details."
+
+	testCases := []struct {
+		format   HoverFormat
+		text     string
+		expected string
+	}{
+		{format: HoverFormatMarkdown, text: text, expected: text},
+		{format: "", text: text, expected: text},
+		{
+			format: HoverFormatPlaintext,
+			text:   text,
+			expected: "func findContents(pkgs []*Package, obj Object) ([]MarkedString, error)\n\n" +
+				"Overview\n\nfindContents returns contents used as hover info for the given obj.\nSee the docs for snake_case details.",
+		},
+		{
+			format:   HoverFormatSignature,
+			text:     text,
+			expected: "func findContents(pkgs []*Package, obj Object) ([]MarkedString, error)",
+		},
+		{
+			format:   HoverFormatSignature,
+			text:     "Returns the **first** value.\n\nMore details.",
+			expected: "Returns the first value.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		if actual := FormatHover(testCase.text, testCase.format); actual != testCase.expected {
+			t.Errorf("unexpected %q hover text. want=%q have=%q", testCase.format, testCase.expected, actual)
+		}
+	}
+}
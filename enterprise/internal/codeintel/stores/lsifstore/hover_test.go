@@ -21,7 +21,7 @@ func TestDatabaseHover(t *testing.T) {
 	// `\tcontents, err := findContents(pkgs, p, f, obj)`
 	//                     ^^^^^^^^^^^^
 
-	if actualText, actualRange, exists, err := store.Hover(context.Background(), testBundleID, "internal/index/indexer.go", 628, 20); err != nil {
+	if actualText, actualRange, exists, err := store.Hover(context.Background(), testBundleID, "internal/index/indexer.go", 628, 20, HoverFormatMarkdown); err != nil {
 		t.Fatalf("unexpected error %s", err)
 	} else if !exists {
 		t.Errorf("no hover found")
@@ -40,3 +40,24 @@ func TestDatabaseHover(t *testing.T) {
 		}
 	}
 }
+
+func TestDatabaseHoverSignature(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	db := dbtesting.GetDB(t)
+	populateTestStore(t)
+	store := NewStore(db, &observation.TestContext)
+
+	if actualText, _, exists, err := store.Hover(context.Background(), testBundleID, "internal/index/indexer.go", 628, 20, HoverFormatSignature); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	} else if !exists {
+		t.Errorf("no hover found")
+	} else {
+		expectedText := "func findContents(pkgs []*Package, p *Package, f *File, obj Object) ([]MarkedString, error)"
+
+		if actualText != expectedText {
+			t.Errorf("unexpected hover text. want=%s have=%s", expectedText, actualText)
+		}
+	}
+}
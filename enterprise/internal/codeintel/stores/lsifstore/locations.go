@@ -18,18 +18,36 @@ import (
 func (s *Store) Definitions(ctx context.Context, bundleID int, path string, line, character, limit, offset int) (_ []Location, _ int, err error) {
 	extractor := func(r semantic.RangeData) semantic.ID { return r.DefinitionResultID }
 	operation := s.operations.definitions
-	return s.definitionsReferences(ctx, extractor, operation, bundleID, path, line, character, limit, offset)
+	return s.definitionsReferences(ctx, extractor, operation, bundleID, path, line, character, limit, offset, false)
 }
 
 // References returns the set of locations referencing the symbol at the given position.
 func (s *Store) References(ctx context.Context, bundleID int, path string, line, character, limit, offset int) (_ []Location, _ int, err error) {
 	extractor := func(r semantic.RangeData) semantic.ID { return r.ReferenceResultID }
 	operation := s.operations.references
-	return s.definitionsReferences(ctx, extractor, operation, bundleID, path, line, character, limit, offset)
+	return s.definitionsReferences(ctx, extractor, operation, bundleID, path, line, character, limit, offset, false)
 }
 
-func (s *Store) definitionsReferences(ctx context.Context, extractor func(r semantic.RangeData) semantic.ID, operation *observation.Operation, bundleID int, path string, line, character, limit, offset int) (_ []Location, _ int, err error) {
-	ctx, traceLog, endObservation := operation.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
+// WriteAccessReferences returns the subset of locations referencing the symbol at the given position at which
+// the symbol is written or assigned. This requires an indexer that reports the access kind of references.
+func (s *Store) WriteAccessReferences(ctx context.Context, bundleID int, path string, line, character, limit, offset int) (_ []Location, _ int, err error) {
+	extractor := func(r semantic.RangeData) semantic.ID { return r.ReferenceResultID }
+	operation := s.operations.writeAccessReferences
+	return s.definitionsReferences(ctx, extractor, operation, bundleID, path, line, character, limit, offset, true)
+}
+
+// Implementations returns the set of locations implementing the symbol at the given position.
+func (s *Store) Implementations(ctx context.Context, bundleID int, path string, line, character, limit, offset int) (_ []Location, _ int, err error) {
+	extractor := func(r semantic.RangeData) semantic.ID { return r.ImplementationResultID }
+	operation := s.operations.implementations
+	return s.definitionsReferences(ctx, extractor, operation, bundleID, path, line, character, limit, offset, false)
+}
+
+// ReferenceCount returns the number of locations referencing the symbol at the given position. Unlike
+// References, this method reads only the result chunks of the bundle and does not hydrate the range data
+// of each location from its containing document.
+func (s *Store) ReferenceCount(ctx context.Context, bundleID int, path string, line, character int) (_ int, err error) {
+	ctx, traceLog, endObservation := s.operations.referenceCount.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
 		log.Int("bundleID", bundleID),
 		log.String("path", path),
 		log.Int("line", line),
@@ -39,15 +57,59 @@ func (s *Store) definitionsReferences(ctx context.Context, extractor func(r sema
 
 	documentData, exists, err := s.scanFirstDocumentData(s.Store.Query(ctx, sqlf.Sprintf(locationsDocumentQuery, bundleID, path)))
 	if err != nil || !exists {
-		return nil, 0, err
+		return 0, err
 	}
 
 	traceLog(log.Int("numRanges", len(documentData.Document.Ranges)))
 	ranges := semantic.FindRanges(documentData.Document.Ranges, line, character)
 	traceLog(log.Int("numIntersectingRanges", len(ranges)))
 
+	ids := extractResultIDs(ranges, func(r semantic.RangeData) semantic.ID { return r.ReferenceResultID })
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	indexes, err := s.translateIDsToResultChunkIndexes(ctx, bundleID, ids)
+	if err != nil {
+		return 0, err
+	}
+
+	_, totalCount, err := s.readLocationsFromResultChunks(ctx, bundleID, ids, indexes, "", false)
+	if err != nil {
+		return 0, err
+	}
+	traceLog(log.Int("totalCount", totalCount))
+
+	return totalCount, nil
+}
+
+func (s *Store) definitionsReferences(ctx context.Context, extractor func(r semantic.RangeData) semantic.ID, operation *observation.Operation, bundleID int, path string, line, character, limit, offset int, writeOnly bool) (_ []Location, _ int, err error) {
+	ctx, traceLog, endObservation := operation.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("bundleID", bundleID),
+		log.String("path", path),
+		log.Int("line", line),
+		log.Int("character", character),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	documentData, exists, err := s.scanFirstDocumentData(s.Store.Query(ctx, sqlf.Sprintf(locationsDocumentQuery, bundleID, path)))
+	if err != nil || !exists {
+		return nil, 0, err
+	}
+
+	return s.locationsAtPosition(ctx, extractor, bundleID, documentData.Document, line, character, limit, offset, writeOnly, traceLog)
+}
+
+// locationsAtPosition returns the set of locations attached to the ranges of the given document that
+// enclose the given position. The extractor determines whether definition or reference results are used.
+// If writeOnly is true, only locations at which the symbol is written are returned.
+func (s *Store) locationsAtPosition(ctx context.Context, extractor func(r semantic.RangeData) semantic.ID, bundleID int, document semantic.DocumentData, line, character, limit, offset int, writeOnly bool, traceLog observation.TraceLogger) ([]Location, int, error) {
+	traceLog(log.Int("numRanges", len(document.Ranges)))
+	ranges := semantic.FindRanges(document.Ranges, line, character)
+	traceLog(log.Int("numIntersectingRanges", len(ranges)))
+
 	orderedResultIDs := extractResultIDs(ranges, extractor)
-	locationsMap, totalCount, err := s.locations(ctx, bundleID, orderedResultIDs, limit, offset)
+	locationsMap, totalCount, err := s.locations(ctx, bundleID, orderedResultIDs, limit, offset, writeOnly)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -63,14 +125,16 @@ func (s *Store) definitionsReferences(ctx context.Context, extractor func(r sema
 
 // locations queries the locations associated with the given definition or reference identifiers. This
 // method returns a map from result set identifiers to another map from document paths to locations
-// within that document, as well as a total count of locations within the map.
-func (s *Store) locations(ctx context.Context, bundleID int, ids []semantic.ID, limit, offset int) (_ map[semantic.ID][]Location, _ int, err error) {
+// within that document, as well as a total count of locations within the map. If writeOnly is true, only
+// locations flagged as writes to the referenced symbol are included.
+func (s *Store) locations(ctx context.Context, bundleID int, ids []semantic.ID, limit, offset int, writeOnly bool) (_ map[semantic.ID][]Location, _ int, err error) {
 	ctx, traceLog, endObservation := s.operations.locations.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
 		log.Int("bundleID", bundleID),
 		log.Int("numIDs", len(ids)),
 		log.String("ids", idsToString(ids)),
 		log.Int("limit", limit),
 		log.Int("offset", offset),
+		log.Bool("writeOnly", writeOnly),
 	}})
 	defer endObservation(1, observation.Args{})
 
@@ -90,7 +154,7 @@ func (s *Store) locations(ctx context.Context, bundleID int, ids []semantic.ID,
 
 	// Read the result sets and construct the set of documents we need to open to resolve range
 	// identifiers into actual offsets in a document.
-	rangeIDsByResultID, totalCount, err := s.readLocationsFromResultChunks(ctx, bundleID, ids, indexes, "")
+	rangeIDsByResultID, totalCount, err := s.readLocationsFromResultChunks(ctx, bundleID, ids, indexes, "", writeOnly)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -242,8 +306,9 @@ const resultChunkBatchSize = 50
 // readLocationsFromResultChunks reads the given result chunk indexes for a given bundle. This method returns
 // a map from documents to range identifiers that compose each of the given input result set identifiers. If
 // a non-empty target path is supplied, then any range falling outside that document path will be omitted from
-// the output.
-func (s *Store) readLocationsFromResultChunks(ctx context.Context, bundleID int, ids []semantic.ID, indexes []int, targetPath string) (map[semantic.ID]map[string][]semantic.ID, int, error) {
+// the output. If writeOnly is true, then any range that is not a write to the referenced symbol will be omitted
+// from the output as well.
+func (s *Store) readLocationsFromResultChunks(ctx context.Context, bundleID int, ids []semantic.ID, indexes []int, targetPath string, writeOnly bool) (map[semantic.ID]map[string][]semantic.ID, int, error) {
 	totalCount := 0
 	rangeIDsByResultID := make(map[semantic.ID]map[string][]semantic.ID, len(ids))
 
@@ -271,31 +336,45 @@ func (s *Store) readLocationsFromResultChunks(ctx context.Context, bundleID int,
 		)))
 
 		if err := visitResultChunks(func(index int, resultChunkData semantic.ResultChunkData) {
-			for _, id := range ids {
-				documentIDRangeIDs, exists := resultChunkData.DocumentIDRangeIDs[id]
-				if !exists {
-					continue
-				}
+			totalCount += readLocationsFromResultChunk(resultChunkData, ids, targetPath, writeOnly, rangeIDsByResultID)
+		}); err != nil {
+			return nil, totalCount, err
+		}
+	}
 
-				rangeIDsByDocument := make(map[string][]semantic.ID, len(documentIDRangeIDs))
-				for _, documentIDRangeID := range documentIDRangeIDs {
-					if path, ok := resultChunkData.DocumentPaths[documentIDRangeID.DocumentID]; ok {
-						if targetPath != "" && path != targetPath {
-							continue
-						}
+	return rangeIDsByResultID, totalCount, nil
+}
 
-						totalCount++
-						rangeIDsByDocument[path] = append(rangeIDsByDocument[path], documentIDRangeID.RangeID)
-					}
+// readLocationsFromResultChunk populates the given rangeIDsByResultID map with the range identifiers of each
+// of the given result set identifiers that exist in the given result chunk, keyed by document path. The target
+// path and writeOnly flag filter ranges as described in readLocationsFromResultChunks. This method returns the
+// number of ranges added to the map.
+func readLocationsFromResultChunk(resultChunkData semantic.ResultChunkData, ids []semantic.ID, targetPath string, writeOnly bool, rangeIDsByResultID map[semantic.ID]map[string][]semantic.ID) int {
+	totalCount := 0
+	for _, id := range ids {
+		documentIDRangeIDs, exists := resultChunkData.DocumentIDRangeIDs[id]
+		if !exists {
+			continue
+		}
+
+		rangeIDsByDocument := make(map[string][]semantic.ID, len(documentIDRangeIDs))
+		for _, documentIDRangeID := range documentIDRangeIDs {
+			if path, ok := resultChunkData.DocumentPaths[documentIDRangeID.DocumentID]; ok {
+				if targetPath != "" && path != targetPath {
+					continue
 				}
-				rangeIDsByResultID[id] = rangeIDsByDocument
+				if writeOnly && !documentIDRangeID.WriteAccess {
+					continue
+				}
+
+				totalCount++
+				rangeIDsByDocument[path] = append(rangeIDsByDocument[path], documentIDRangeID.RangeID)
 			}
-		}); err != nil {
-			return nil, totalCount, err
 		}
+		rangeIDsByResultID[id] = rangeIDsByDocument
 	}
 
-	return rangeIDsByResultID, totalCount, nil
+	return totalCount
 }
 
 const readLocationsFromResultChunksQuery = `
@@ -457,7 +536,7 @@ func extractResultIDs(ranges []semantic.RangeData, fn func(r semantic.RangeData)
 }
 
 const locationsDocumentQuery = `
--- source: enterprise/internal/codeintel/stores/lsifstore/locations.go:{Definitions,References}
+-- source: enterprise/internal/codeintel/stores/lsifstore/locations.go:{Definitions,References,WriteAccessReferences,ReferenceCount}
 SELECT
 	dump_id,
 	path,
@@ -85,3 +85,49 @@ func TestDatabaseReferences(t *testing.T) {
 		})
 	}
 }
+
+func TestDatabaseWriteAccessReferences(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	db := dbtesting.GetDB(t)
+	populateTestStore(t)
+	store := NewStore(db, &observation.TestContext)
+
+	// The test index was produced by an indexer that does not report the access kind of
+	// references, so none of the references of `EmitRange` are known to be writes.
+	if actual, totalCount, err := store.WriteAccessReferences(context.Background(), testBundleID, "protocol/writer.go", 85, 20, 5, 0); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	} else {
+		if totalCount != 0 {
+			t.Errorf("unexpected count. want=%d have=%d", 0, totalCount)
+		}
+		if len(actual) != 0 {
+			t.Errorf("unexpected write reference locations. want=%d have=%d", 0, len(actual))
+		}
+	}
+}
+
+func TestDatabaseReferenceCount(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	db := dbtesting.GetDB(t)
+	populateTestStore(t)
+	store := NewStore(db, &observation.TestContext)
+
+	// `func (w *Writer) EmitRange(start, end Pos) (string, error) {`
+	//                   ^^^^^^^^^
+
+	if count, err := store.ReferenceCount(context.Background(), testBundleID, "protocol/writer.go", 85, 20); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	} else if count != 3 {
+		t.Errorf("unexpected count. want=%d have=%d", 3, count)
+	}
+
+	if count, err := store.ReferenceCount(context.Background(), testBundleID, "protocol/missing.go", 85, 20); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	} else if count != 0 {
+		t.Errorf("unexpected count. want=%d have=%d", 0, count)
+	}
+}
@@ -20,3 +20,23 @@ const ReferencesCountMigrationID = 5
 // columns by type. This is associated with the out-of-band migration record inserted in
 // migrations/frontend/1528395810_split_document_payload.up.sql.
 const DocumentColumnSplitMigrationID = 7
+
+// DocumentZstdRecompressionMigrationID is the primary key of the migration record handled by
+// an instance of zstdRecompressionMigrator over lsif_data_documents. This is associated with
+// the out-of-band migration record inserted in migrations/frontend/1528395856_zstd_recompression.up.sql.
+const DocumentZstdRecompressionMigrationID = 11
+
+// ResultChunkZstdRecompressionMigrationID is the primary key of the migration record handled by
+// an instance of zstdRecompressionMigrator over lsif_data_result_chunks. This is associated with
+// the out-of-band migration record inserted in migrations/frontend/1528395856_zstd_recompression.up.sql.
+const ResultChunkZstdRecompressionMigrationID = 12
+
+// DefinitionsZstdRecompressionMigrationID is the primary key of the migration record handled by
+// an instance of zstdRecompressionMigrator over lsif_data_definitions. This is associated with
+// the out-of-band migration record inserted in migrations/frontend/1528395856_zstd_recompression.up.sql.
+const DefinitionsZstdRecompressionMigrationID = 13
+
+// ReferencesZstdRecompressionMigrationID is the primary key of the migration record handled by
+// an instance of zstdRecompressionMigrator over lsif_data_references. This is associated with
+// the out-of-band migration record inserted in migrations/frontend/1528395856_zstd_recompression.up.sql.
+const ReferencesZstdRecompressionMigrationID = 14
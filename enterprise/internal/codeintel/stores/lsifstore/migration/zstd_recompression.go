@@ -0,0 +1,128 @@
+package migration
+
+import (
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/oobmigration"
+)
+
+type zstdRecompressionMigrator struct {
+	serializer     *lsifstore.Serializer
+	kind           lsifstore.PayloadKind
+	numPrimaryKeys int
+	numPayloads    int
+}
+
+// NewDocumentZstdRecompressionMigrator creates a new Migrator instance that reads records from
+// the lsif_data_documents table with a schema version of 3 and re-compresses the ranges, hovers,
+// monikers, packages, and diagnostics payloads with zstd. Updated records will have a schema
+// version of 4.
+func NewDocumentZstdRecompressionMigrator(store *lsifstore.Store, batchSize int) oobmigration.Migrator {
+	return newZstdRecompressionMigrator(store, "lsif_data_documents", lsifstore.DocumentPayload, 4, batchSize,
+		[]fieldSpec{{name: "path", postgresType: "text not null", primaryKey: true}},
+		[]string{"ranges", "hovers", "monikers", "packages", "diagnostics"},
+	)
+}
+
+// NewResultChunkZstdRecompressionMigrator creates a new Migrator instance that reads records from
+// the lsif_data_result_chunks table with a schema version of 1 and re-compresses the payload with
+// zstd. Updated records will have a schema version of 2.
+func NewResultChunkZstdRecompressionMigrator(store *lsifstore.Store, batchSize int) oobmigration.Migrator {
+	return newZstdRecompressionMigrator(store, "lsif_data_result_chunks", lsifstore.ResultChunkPayload, 2, batchSize,
+		[]fieldSpec{{name: "idx", postgresType: "integer not null", primaryKey: true}},
+		[]string{"data"},
+	)
+}
+
+// NewLocationsZstdRecompressionMigrator creates a new Migrator instance that reads records from
+// the given table with a schema version of 2 and re-compresses the payload with zstd. Updated
+// records will have a schema version of 3.
+func NewLocationsZstdRecompressionMigrator(store *lsifstore.Store, tableName string, batchSize int) oobmigration.Migrator {
+	return newZstdRecompressionMigrator(store, tableName, lsifstore.LocationsPayload, 3, batchSize,
+		[]fieldSpec{
+			{name: "scheme", postgresType: "text not null", primaryKey: true},
+			{name: "identifier", postgresType: "text not null", primaryKey: true},
+		},
+		[]string{"data"},
+	)
+}
+
+func newZstdRecompressionMigrator(
+	store *lsifstore.Store,
+	tableName string,
+	kind lsifstore.PayloadKind,
+	targetVersion int,
+	batchSize int,
+	primaryKeys []fieldSpec,
+	payloadColumns []string,
+) oobmigration.Migrator {
+	driver := &zstdRecompressionMigrator{
+		serializer:     lsifstore.NewSerializer(),
+		kind:           kind,
+		numPrimaryKeys: len(primaryKeys),
+		numPayloads:    len(payloadColumns),
+	}
+
+	fields := primaryKeys
+	for _, name := range payloadColumns {
+		fields = append(fields, fieldSpec{name: name, postgresType: "bytea"})
+	}
+
+	return newMigrator(store, driver, migratorOptions{
+		tableName:     tableName,
+		targetVersion: targetVersion,
+		batchSize:     batchSize,
+		fields:        fields,
+	})
+}
+
+// MigrateRowUp reads the payloads of the given row and re-compresses them with zstd.
+func (m *zstdRecompressionMigrator) MigrateRowUp(scanner scanner) ([]interface{}, error) {
+	return m.migrateRow(scanner, func(data []byte) ([]byte, error) {
+		return m.serializer.Recompress(m.kind, data)
+	})
+}
+
+// MigrateRowDown reads the payloads of the given row and re-compresses them with gzip to
+// undo the migration up direction.
+func (m *zstdRecompressionMigrator) MigrateRowDown(scanner scanner) ([]interface{}, error) {
+	return m.migrateRow(scanner, m.serializer.RecompressLegacy)
+}
+
+// migrateRow scans the primary keys and payloads of the given row and returns the primary
+// keys along with the result of the given function applied to each payload.
+func (m *zstdRecompressionMigrator) migrateRow(scanner scanner, recompress func(data []byte) ([]byte, error)) ([]interface{}, error) {
+	primaryKeys := make([]interface{}, m.numPrimaryKeys)
+	payloads := make([][]byte, m.numPayloads)
+
+	dest := make([]interface{}, 0, m.numPrimaryKeys+m.numPayloads)
+	for i := range primaryKeys {
+		dest = append(dest, &primaryKeys[i])
+	}
+	for i := range payloads {
+		dest = append(dest, &payloads[i])
+	}
+
+	if err := scanner.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	values := make([]interface{}, 0, m.numPrimaryKeys+m.numPayloads)
+	values = append(values, primaryKeys...)
+
+	for _, payload := range payloads {
+		if payload == nil {
+			// Preserve null columns
+			values = append(values, nil)
+			continue
+		}
+
+		recompressed, err := recompress(payload)
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, recompressed)
+	}
+
+	return values, nil
+}
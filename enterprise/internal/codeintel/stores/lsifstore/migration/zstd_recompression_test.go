@@ -0,0 +1,134 @@
+package migration
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtesting"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
+)
+
+func TestZstdRecompressionMigrator(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	db := dbtesting.GetDB(t)
+	store := lsifstore.NewStore(db, &observation.TestContext)
+	migrator := NewLocationsZstdRecompressionMigrator(store, "lsif_data_definitions", 250)
+	serializer := lsifstore.NewSerializer()
+
+	assertProgress := func(expectedProgress float64) {
+		if progress, err := migrator.Progress(context.Background()); err != nil {
+			t.Fatalf("unexpected error querying progress: %s", err)
+		} else if progress != expectedProgress {
+			t.Errorf("unexpected progress. want=%.2f have=%.2f", expectedProgress, progress)
+		}
+	}
+
+	scanLocationCounts := func(rows *sql.Rows, queryErr error) (counts []int, prefixes [][]byte, err error) {
+		if queryErr != nil {
+			return nil, nil, queryErr
+		}
+		defer func() { err = basestore.CloseRows(rows, err) }()
+
+		for rows.Next() {
+			var rawData []byte
+			if err := rows.Scan(&rawData); err != nil {
+				return nil, nil, err
+			}
+
+			decoded, err := serializer.UnmarshalLocations(rawData)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			counts = append(counts, len(decoded))
+			prefixes = append(prefixes, rawData[:2])
+		}
+
+		return counts, prefixes, nil
+	}
+
+	assertPayloads := func(expectedCounts []int, expectedPrefix []byte) {
+		query := sqlf.Sprintf(`SELECT data FROM lsif_data_definitions ORDER BY scheme, identifier`)
+
+		counts, prefixes, err := scanLocationCounts(store.Query(context.Background(), query))
+		if err != nil {
+			t.Fatalf("unexpected error querying locations: %s", err)
+		}
+		if diff := cmp.Diff(expectedCounts, counts); diff != "" {
+			t.Errorf("unexpected counts (-want +got):\n%s", diff)
+		}
+		for _, prefix := range prefixes {
+			if !bytes.Equal(prefix, expectedPrefix) {
+				t.Fatalf("unexpected payload prefix. want=%x have=%x", expectedPrefix, prefix)
+			}
+		}
+	}
+
+	n := 500
+	expectedCounts := make([]int, 0, n)
+	locations := make([]semantic.LocationData, 0, n)
+
+	for i := 0; i < n; i++ {
+		expectedCounts = append(expectedCounts, i+1)
+		locations = append(locations, semantic.LocationData{URI: fmt.Sprintf("file://%d", i)})
+
+		data, err := serializer.MarshalLocations(locations)
+		if err != nil {
+			t.Fatalf("unexpected error serializing locations: %s", err)
+		}
+		legacyData, err := serializer.RecompressLegacy(data)
+		if err != nil {
+			t.Fatalf("unexpected error recompressing locations: %s", err)
+		}
+
+		if err := store.Exec(context.Background(), sqlf.Sprintf(
+			"INSERT INTO lsif_data_definitions (dump_id, scheme, identifier, data, schema_version, num_locations) VALUES (%s, %s, %s, %s, 2, %s)",
+			42+i/(n/2), // 50% id=42, 50% id=43
+			fmt.Sprintf("s%04d", i),
+			fmt.Sprintf("i%04d", i),
+			legacyData,
+			len(locations),
+		)); err != nil {
+			t.Fatalf("unexpected error inserting row: %s", err)
+		}
+	}
+
+	gzipPrefix := []byte{0x1f, 0x8b}
+	zstdPrefix := []byte{0x28, 0xb5}
+
+	assertProgress(0)
+	assertPayloads(expectedCounts, gzipPrefix)
+
+	if err := migrator.Up(context.Background()); err != nil {
+		t.Fatalf("unexpected error performing up migration: %s", err)
+	}
+	assertProgress(0.5)
+
+	if err := migrator.Up(context.Background()); err != nil {
+		t.Fatalf("unexpected error performing up migration: %s", err)
+	}
+	assertProgress(1)
+	assertPayloads(expectedCounts, zstdPrefix)
+
+	if err := migrator.Down(context.Background()); err != nil {
+		t.Fatalf("unexpected error performing down migration: %s", err)
+	}
+	assertProgress(0.5)
+
+	if err := migrator.Down(context.Background()); err != nil {
+		t.Fatalf("unexpected error performing down migration: %s", err)
+	}
+	assertProgress(0)
+	assertPayloads(expectedCounts, gzipPrefix)
+}
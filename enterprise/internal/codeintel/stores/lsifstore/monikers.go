@@ -8,6 +8,7 @@ import (
 	"github.com/keegancsmith/sqlf"
 	"github.com/opentracing/opentracing-go/log"
 
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
 )
@@ -153,6 +154,52 @@ const bulkMonikerResultsQuery = `
 SELECT dump_id, scheme, identifier, data FROM %s WHERE dump_id IN (%s) AND (scheme, identifier) IN (%s) ORDER BY (dump_id, scheme, identifier)
 `
 
+// BulkMonikerResultsCount returns the number of locations within one of the given bundles that define
+// or reference one of the given monikers. This count is calculated from the location counts stored
+// alongside each moniker and does not decode any location payloads.
+func (s *Store) BulkMonikerResultsCount(ctx context.Context, tableName string, uploadIDs []int, monikers []semantic.MonikerData) (_ int, err error) {
+	ctx, traceLog, endObservation := s.operations.bulkMonikerResultsCount.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("tableName", tableName),
+		log.Int("numUploadIDs", len(uploadIDs)),
+		log.String("uploadIDs", intsToString(uploadIDs)),
+		log.Int("numMonikers", len(monikers)),
+		log.String("monikers", monikersToString(monikers)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if len(uploadIDs) == 0 || len(monikers) == 0 {
+		return 0, nil
+	}
+
+	idQueries := make([]*sqlf.Query, 0, len(uploadIDs))
+	for _, id := range uploadIDs {
+		idQueries = append(idQueries, sqlf.Sprintf("%s", id))
+	}
+
+	monikerQueries := make([]*sqlf.Query, 0, len(monikers))
+	for _, arg := range monikers {
+		monikerQueries = append(monikerQueries, sqlf.Sprintf("(%s, %s)", arg.Scheme, arg.Identifier))
+	}
+
+	totalCount, _, err := basestore.ScanFirstInt(s.Store.Query(ctx, sqlf.Sprintf(
+		bulkMonikerResultsCountQuery,
+		sqlf.Sprintf(fmt.Sprintf("lsif_data_%s", tableName)),
+		sqlf.Join(idQueries, ", "),
+		sqlf.Join(monikerQueries, ", "),
+	)))
+	if err != nil {
+		return 0, err
+	}
+	traceLog(log.Int("totalCount", totalCount))
+
+	return totalCount, nil
+}
+
+const bulkMonikerResultsCountQuery = `
+-- source: enterprise/internal/codeintel/stores/lsifstore/monikers.go:BulkMonikerResultsCount
+SELECT COALESCE(SUM(num_locations), 0) FROM %s WHERE dump_id IN (%s) AND (scheme, identifier) IN (%s)
+`
+
 func monikersToString(vs []semantic.MonikerData) string {
 	strs := make([]string, 0, len(vs))
 	for _, v := range vs {
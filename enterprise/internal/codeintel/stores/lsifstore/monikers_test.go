@@ -124,3 +124,46 @@ func TestDatabaseBulkMonikerResults(t *testing.T) {
 		})
 	}
 }
+
+func TestDatabaseBulkMonikerResultsCount(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	db := dbtesting.GetDB(t)
+	populateTestStore(t)
+	store := NewStore(db, &observation.TestContext)
+
+	edgeMoniker := semantic.MonikerData{Scheme: "gomod", Identifier: "github.com/sourcegraph/lsif-go/protocol:Edge"}
+	markdownMoniker := semantic.MonikerData{Scheme: "gomod", Identifier: "github.com/slimsag/godocmd:ToMarkdown"}
+
+	testCases := []struct {
+		tableName          string
+		uploadIDs          []int
+		monikers           []semantic.MonikerData
+		expectedTotalCount int
+	}{
+		// empty cases
+		{"definitions", []int{}, []semantic.MonikerData{edgeMoniker}, 0},
+		{"definitions", []int{testBundleID}, []semantic.MonikerData{}, 0},
+
+		{"definitions", []int{testBundleID}, []semantic.MonikerData{edgeMoniker}, 2},
+		{"references", []int{testBundleID}, []semantic.MonikerData{edgeMoniker}, 29},
+		{"references", []int{testBundleID}, []semantic.MonikerData{markdownMoniker}, 1},
+		{"references", []int{testBundleID}, []semantic.MonikerData{edgeMoniker, markdownMoniker}, 30},
+	}
+
+	for i, testCase := range testCases {
+		t.Run(fmt.Sprintf("i=%d", i), func(t *testing.T) {
+			if totalCount, err := store.BulkMonikerResultsCount(
+				context.Background(),
+				testCase.tableName,
+				testCase.uploadIDs,
+				testCase.monikers,
+			); err != nil {
+				t.Fatalf("unexpected error for test case #%d: %s", i, err)
+			} else if totalCount != testCase.expectedTotalCount {
+				t.Errorf("unexpected moniker result total count for test case #%d. want=%d have=%d", i, testCase.expectedTotalCount, totalCount)
+			}
+		})
+	}
+}
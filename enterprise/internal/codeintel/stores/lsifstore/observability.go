@@ -8,18 +8,33 @@ import (
 )
 
 type operations struct {
+	aggregateDiagnostics    *observation.Operation
+	batchDefinitions        *observation.Operation
+	batchDiagnostics        *observation.Operation
+	batchHover              *observation.Operation
+	batchRanges             *observation.Operation
 	bulkMonikerResults      *observation.Operation
+	bulkMonikerResultsCount *observation.Operation
+	bundleIDs               *observation.Operation
+	bundleSize              *observation.Operation
 	clear                   *observation.Operation
 	definitions             *observation.Operation
 	diagnostics             *observation.Operation
+	documentPaths           *observation.Operation
+	documentSymbols         *observation.Operation
 	exists                  *observation.Operation
 	hover                   *observation.Operation
+	implementations         *observation.Operation
 	monikerResults          *observation.Operation
 	monikersByPosition      *observation.Operation
 	packageInformation      *observation.Operation
 	ranges                  *observation.Operation
+	referenceCount          *observation.Operation
 	references              *observation.Operation
+	symbols                 *observation.Operation
 	documentationPage       *observation.Operation
+	documentationAtPosition *observation.Operation
+	writeAccessReferences   *observation.Operation
 	writeDefinitions        *observation.Operation
 	writeDocuments          *observation.Operation
 	writeMeta               *observation.Operation
@@ -57,18 +72,33 @@ func newOperations(observationContext *observation.Context) *operations {
 	}
 
 	return &operations{
+		aggregateDiagnostics:    op("AggregateDiagnostics"),
+		batchDefinitions:        op("BatchDefinitions"),
+		batchDiagnostics:        op("BatchDiagnostics"),
+		batchHover:              op("BatchHover"),
+		batchRanges:             op("BatchRanges"),
 		bulkMonikerResults:      op("BulkMonikerResults"),
+		bulkMonikerResultsCount: op("BulkMonikerResultsCount"),
+		bundleIDs:               op("BundleIDs"),
+		bundleSize:              op("BundleSize"),
 		clear:                   op("Clear"),
 		definitions:             op("Definitions"),
 		diagnostics:             op("Diagnostics"),
+		documentPaths:           op("DocumentPaths"),
+		documentSymbols:         op("DocumentSymbols"),
 		exists:                  op("Exists"),
 		hover:                   op("Hover"),
+		implementations:         op("Implementations"),
 		monikerResults:          op("MonikerResults"),
 		monikersByPosition:      op("MonikersByPosition"),
 		packageInformation:      op("PackageInformation"),
 		ranges:                  op("Ranges"),
+		referenceCount:          op("ReferenceCount"),
 		references:              op("References"),
+		symbols:                 op("Symbols"),
 		documentationPage:       op("DocumentationPage"),
+		documentationAtPosition: op("DocumentationAtPosition"),
+		writeAccessReferences:   op("WriteAccessReferences"),
 		writeDefinitions:        op("WriteDefinitions"),
 		writeDocuments:          op("WriteDocuments"),
 		writeMeta:               op("WriteMeta"),
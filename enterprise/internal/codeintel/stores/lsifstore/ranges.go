@@ -30,18 +30,24 @@ func (s *Store) Ranges(ctx context.Context, bundleID int, path string, startLine
 		return nil, err
 	}
 
-	traceLog(log.Int("numRanges", len(documentData.Document.Ranges)))
-	ranges := semantic.FindRangesInWindow(documentData.Document.Ranges, startLine, endLine)
+	return s.rangesInWindow(ctx, bundleID, path, documentData.Document, startLine, endLine, traceLog)
+}
+
+// rangesInWindow returns definition, reference, and hover data for each range of the given document
+// within the given span of lines.
+func (s *Store) rangesInWindow(ctx context.Context, bundleID int, path string, document semantic.DocumentData, startLine, endLine int, traceLog observation.TraceLogger) ([]CodeIntelligenceRange, error) {
+	traceLog(log.Int("numRanges", len(document.Ranges)))
+	ranges := semantic.FindRangesInWindow(document.Ranges, startLine, endLine)
 	traceLog(log.Int("numIntersectingRanges", len(ranges)))
 
 	definitionResultIDs := extractResultIDs(ranges, func(r semantic.RangeData) semantic.ID { return r.DefinitionResultID })
-	definitionLocations, _, err := s.locations(ctx, bundleID, definitionResultIDs, MaximumRangesDefinitionLocations, 0)
+	definitionLocations, _, err := s.locations(ctx, bundleID, definitionResultIDs, MaximumRangesDefinitionLocations, 0, false)
 	if err != nil {
 		return nil, err
 	}
 
 	referenceResultIDs := extractResultIDs(ranges, func(r semantic.RangeData) semantic.ID { return r.ReferenceResultID })
-	referenceLocations, err := s.locationsWithinFile(ctx, bundleID, referenceResultIDs, path, documentData.Document)
+	referenceLocations, err := s.locationsWithinFile(ctx, bundleID, referenceResultIDs, path, document)
 	if err != nil {
 		return nil, err
 	}
@@ -52,7 +58,7 @@ func (s *Store) Ranges(ctx context.Context, bundleID int, path string, startLine
 			Range:       newRange(r.StartLine, r.StartCharacter, r.EndLine, r.EndCharacter),
 			Definitions: definitionLocations[r.DefinitionResultID],
 			References:  referenceLocations[r.ReferenceResultID],
-			HoverText:   documentData.Document.HoverResults[r.HoverResultID],
+			HoverText:   document.HoverResults[r.HoverResultID],
 		})
 	}
 	sort.Slice(codeintelRanges, func(i, j int) bool {
@@ -109,7 +115,7 @@ func (s *Store) locationsWithinFile(ctx context.Context, bundleID int, ids []sem
 
 	// Read the result sets and gather the set of range identifiers we need to resolve with
 	// the given document data.
-	rangeIDsByResultID, _, err := s.readLocationsFromResultChunks(ctx, bundleID, ids, indexes, path)
+	rangeIDsByResultID, _, err := s.readLocationsFromResultChunks(ctx, bundleID, ids, indexes, path, false)
 	if err != nil {
 		return nil, err
 	}
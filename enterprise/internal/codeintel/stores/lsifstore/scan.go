@@ -139,6 +139,35 @@ func (s *Store) makeResultChunkVisitor(rows *sql.Rows, queryErr error) func(func
 	}
 }
 
+// makeQualifiedResultChunkVisitor returns a function that accepts a mapping function, reads
+// result chunk values along with their dump identifier from the given row object and calls
+// the mapping function on each decoded result set.
+func (s *Store) makeQualifiedResultChunkVisitor(rows *sql.Rows, queryErr error) func(func(int, int, semantic.ResultChunkData)) error {
+	return func(f func(int, int, semantic.ResultChunkData)) (err error) {
+		if queryErr != nil {
+			return queryErr
+		}
+		defer func() { err = basestore.CloseRows(rows, err) }()
+
+		var rawData []byte
+		for rows.Next() {
+			var dumpID, index int
+			if err := rows.Scan(&dumpID, &index, &rawData); err != nil {
+				return err
+			}
+
+			data, err := s.serializer.UnmarshalResultChunkData(rawData)
+			if err != nil {
+				return err
+			}
+
+			f(dumpID, index, data)
+		}
+
+		return nil
+	}
+}
+
 type QualifiedMonikerLocations struct {
 	DumpID int
 	semantic.MonikerLocations
@@ -181,3 +210,25 @@ func (s *Store) scanSingleQualifiedMonikerLocationsObject(rows *sql.Rows) (Quali
 
 	return record, nil
 }
+
+// scanIntPairs reads pairs of integers from the given row object into a map from the first
+// value of each pair to the second.
+func scanIntPairs(rows *sql.Rows, queryErr error) (_ map[int]int, err error) {
+	if queryErr != nil {
+		return nil, queryErr
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	values := map[int]int{}
+	for rows.Next() {
+		var value1 int
+		var value2 int
+		if err := rows.Scan(&value1, &value2); err != nil {
+			return nil, err
+		}
+
+		values[value1] = value2
+	}
+
+	return values, nil
+}
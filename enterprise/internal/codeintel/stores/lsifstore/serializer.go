@@ -4,11 +4,8 @@ import (
 	"bytes"
 	"compress/gzip"
 	"encoding/gob"
-	"io"
 	"sync"
 
-	"github.com/hashicorp/go-multierror"
-
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
 )
 
@@ -41,19 +38,19 @@ type MarshalledDocumentData struct {
 // MarshalDocumentData transforms the fields of the given document data payload into a set of
 // string of bytes writable to disk.
 func (s *Serializer) MarshalDocumentData(document semantic.DocumentData) (data MarshalledDocumentData, err error) {
-	if data.Ranges, err = s.encode(&document.Ranges); err != nil {
+	if data.Ranges, err = s.encode(DocumentPayload, &document.Ranges); err != nil {
 		return MarshalledDocumentData{}, err
 	}
-	if data.HoverResults, err = s.encode(&document.HoverResults); err != nil {
+	if data.HoverResults, err = s.encode(DocumentPayload, &document.HoverResults); err != nil {
 		return MarshalledDocumentData{}, err
 	}
-	if data.Monikers, err = s.encode(&document.Monikers); err != nil {
+	if data.Monikers, err = s.encode(DocumentPayload, &document.Monikers); err != nil {
 		return MarshalledDocumentData{}, err
 	}
-	if data.PackageInformation, err = s.encode(&document.PackageInformation); err != nil {
+	if data.PackageInformation, err = s.encode(DocumentPayload, &document.PackageInformation); err != nil {
 		return MarshalledDocumentData{}, err
 	}
-	if data.Diagnostics, err = s.encode(&document.Diagnostics); err != nil {
+	if data.Diagnostics, err = s.encode(DocumentPayload, &document.Diagnostics); err != nil {
 		return MarshalledDocumentData{}, err
 	}
 
@@ -62,40 +59,27 @@ func (s *Serializer) MarshalDocumentData(document semantic.DocumentData) (data M
 
 // MarshalLegacyDocumentData encodes a legacy-formatted document (the value in the `data` column).
 func (s *Serializer) MarshalLegacyDocumentData(document semantic.DocumentData) ([]byte, error) {
-	return s.encode(&document)
+	return s.encode(DocumentPayload, &document)
 }
 
 // MarshalResultChunkData transforms result chunk data into a string of bytes writable to disk.
 func (s *Serializer) MarshalResultChunkData(resultChunks semantic.ResultChunkData) ([]byte, error) {
-	return s.encode(&resultChunks)
+	return s.encode(ResultChunkPayload, &resultChunks)
 }
 
 // MarshalLocations transforms a slice of locations into a string of bytes writable to disk.
 func (s *Serializer) MarshalLocations(locations []semantic.LocationData) ([]byte, error) {
-	return s.encode(&locations)
+	return s.encode(LocationsPayload, &locations)
 }
 
 // encode gob-encodes and compresses the given payload.
-func (s *Serializer) encode(payload interface{}) (_ []byte, err error) {
-	gzipWriter := s.writers.Get().(*gzip.Writer)
-	defer s.writers.Put(gzipWriter)
-
+func (s *Serializer) encode(kind PayloadKind, payload interface{}) ([]byte, error) {
 	encodeBuf := new(bytes.Buffer)
 	if err := gob.NewEncoder(encodeBuf).Encode(payload); err != nil {
 		return nil, err
 	}
 
-	compressBuf := new(bytes.Buffer)
-	gzipWriter.Reset(compressBuf)
-
-	if _, err := io.Copy(gzipWriter, encodeBuf); err != nil {
-		return nil, err
-	}
-	if err := gzipWriter.Close(); err != nil {
-		return nil, err
-	}
-
-	return compressBuf.Bytes(), nil
+	return s.compress(kind, encodeBuf.Bytes())
 }
 
 // UnmarshalDocumentData is the inverse of MarshalDocumentData.
@@ -137,24 +121,17 @@ func (s *Serializer) UnmarshalLocations(data []byte) (locations []semantic.Locat
 	return locations, err
 }
 
-// encode decompresses gob-decodes the given data and sets the given pointer. If the given data
-// is empty, the pointer will not be assigned.
-func (s *Serializer) decode(data []byte, target interface{}) (err error) {
+// decode decompresses gob-decodes the given data and sets the given pointer. If the given data
+// is empty, the pointer will not be assigned. Data may be compressed with either zstd or gzip.
+func (s *Serializer) decode(data []byte, target interface{}) error {
 	if len(data) == 0 {
 		return nil
 	}
 
-	r := s.readers.Get().(*gzip.Reader)
-	defer s.readers.Put(r)
-
-	if err := r.Reset(bytes.NewReader(data)); err != nil {
+	decompressed, err := s.decompress(data)
+	if err != nil {
 		return err
 	}
-	defer func() {
-		if closeErr := r.Close(); closeErr != nil {
-			err = multierror.Append(err, closeErr)
-		}
-	}()
 
-	return gob.NewDecoder(r).Decode(target)
+	return gob.NewDecoder(bytes.NewReader(decompressed)).Decode(target)
 }
@@ -14,7 +14,7 @@ func init() {
 
 // MarshalDocumentationPageData transforms documentation page data into a string of bytes writable to disk.
 func (s *Serializer) MarshalDocumentationPageData(documentationPage *semantic.DocumentationPageData) ([]byte, error) {
-	return s.encode(&documentationPage)
+	return s.encode(DocumentationPayload, &documentationPage)
 }
 
 // UnmarshalDocumentationPageData is the inverse of MarshalDocumentationPageData.
@@ -0,0 +1,428 @@
+package lsifstore
+
+import (
+	"context"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
+)
+
+// ShardedStore routes reads of bundle data to one of several stores, each backed by a distinct
+// codeintel database. The data of every bundle lives in exactly one shard, determined by the
+// bundle identifier (see ShardIndex). Requests that span several bundles are fanned out to the
+// shards that hold them and the results are merged as if they were read from a single store.
+//
+// The first shard is the primary codeintel database. A sharded store with a single shard behaves
+// exactly like the store of that shard.
+type ShardedStore struct {
+	shards []*Store
+}
+
+// NewShardedStore creates a store that routes reads across the given shards. At least one shard
+// must be supplied.
+func NewShardedStore(shards ...*Store) *ShardedStore {
+	if len(shards) == 0 {
+		panic("lsifstore: a sharded store requires at least one shard")
+	}
+
+	return &ShardedStore{shards: shards}
+}
+
+// ShardIndex returns the index of the shard that holds the data of the given bundle when the data
+// is distributed across the given number of shards.
+func ShardIndex(bundleID, numShards int) int {
+	return bundleID % numShards
+}
+
+// Shards returns the store of each shard.
+func (s *ShardedStore) Shards() []*Store {
+	return s.shards
+}
+
+// Shard returns the store of the shard that holds the data of the given bundle.
+func (s *ShardedStore) Shard(bundleID int) *Store {
+	return s.shards[ShardIndex(bundleID, len(s.shards))]
+}
+
+func (s *ShardedStore) Exists(ctx context.Context, bundleID int, path string) (bool, error) {
+	return s.Shard(bundleID).Exists(ctx, bundleID, path)
+}
+
+func (s *ShardedStore) DocumentPaths(ctx context.Context, bundleID int) ([]string, error) {
+	return s.Shard(bundleID).DocumentPaths(ctx, bundleID)
+}
+
+func (s *ShardedStore) Ranges(ctx context.Context, bundleID int, path string, startLine, endLine int) ([]CodeIntelligenceRange, error) {
+	return s.Shard(bundleID).Ranges(ctx, bundleID, path, startLine, endLine)
+}
+
+func (s *ShardedStore) DocumentSymbols(ctx context.Context, bundleID int, path string) ([]DocumentSymbol, error) {
+	return s.Shard(bundleID).DocumentSymbols(ctx, bundleID, path)
+}
+
+func (s *ShardedStore) Definitions(ctx context.Context, bundleID int, path string, line, character, limit, offset int) ([]Location, int, error) {
+	return s.Shard(bundleID).Definitions(ctx, bundleID, path, line, character, limit, offset)
+}
+
+func (s *ShardedStore) References(ctx context.Context, bundleID int, path string, line, character, limit, offset int) ([]Location, int, error) {
+	return s.Shard(bundleID).References(ctx, bundleID, path, line, character, limit, offset)
+}
+
+func (s *ShardedStore) WriteAccessReferences(ctx context.Context, bundleID int, path string, line, character, limit, offset int) ([]Location, int, error) {
+	return s.Shard(bundleID).WriteAccessReferences(ctx, bundleID, path, line, character, limit, offset)
+}
+
+func (s *ShardedStore) ReferenceCount(ctx context.Context, bundleID int, path string, line, character int) (int, error) {
+	return s.Shard(bundleID).ReferenceCount(ctx, bundleID, path, line, character)
+}
+
+func (s *ShardedStore) Implementations(ctx context.Context, bundleID int, path string, line, character, limit, offset int) ([]Location, int, error) {
+	return s.Shard(bundleID).Implementations(ctx, bundleID, path, line, character, limit, offset)
+}
+
+func (s *ShardedStore) Hover(ctx context.Context, bundleID int, path string, line, character int, format HoverFormat) (string, Range, bool, error) {
+	return s.Shard(bundleID).Hover(ctx, bundleID, path, line, character, format)
+}
+
+func (s *ShardedStore) Diagnostics(ctx context.Context, bundleID int, prefix string, limit, offset int) ([]Diagnostic, int, error) {
+	return s.Shard(bundleID).Diagnostics(ctx, bundleID, prefix, limit, offset)
+}
+
+func (s *ShardedStore) MonikersByPosition(ctx context.Context, bundleID int, path string, line, character int) ([][]semantic.MonikerData, error) {
+	return s.Shard(bundleID).MonikersByPosition(ctx, bundleID, path, line, character)
+}
+
+func (s *ShardedStore) PackageInformation(ctx context.Context, bundleID int, path, packageInformationID string) (semantic.PackageInformationData, bool, error) {
+	return s.Shard(bundleID).PackageInformation(ctx, bundleID, path, packageInformationID)
+}
+
+func (s *ShardedStore) DocumentationPage(ctx context.Context, bundleID int, pathID string) (*semantic.DocumentationPageData, error) {
+	return s.Shard(bundleID).DocumentationPage(ctx, bundleID, pathID)
+}
+
+func (s *ShardedStore) DocumentationAtPosition(ctx context.Context, bundleID int, path string, line, character int) ([]DocumentationLink, error) {
+	return s.Shard(bundleID).DocumentationAtPosition(ctx, bundleID, path, line, character)
+}
+
+// BatchRanges fans out to BatchRanges of each shard holding one of the given keys. The returned
+// slice is parallel to the given keys.
+func (s *ShardedStore) BatchRanges(ctx context.Context, keys []DocumentKey, startLine, endLine int) ([][]CodeIntelligenceRange, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	ranges := make([][]CodeIntelligenceRange, len(keys))
+	for shardIndex, indexes := range s.partitionKeys(len(keys), func(i int) int { return keys[i].DumpID }) {
+		shardKeys := make([]DocumentKey, 0, len(indexes))
+		for _, i := range indexes {
+			shardKeys = append(shardKeys, keys[i])
+		}
+
+		shardRanges, err := s.shards[shardIndex].BatchRanges(ctx, shardKeys, startLine, endLine)
+		if err != nil {
+			return nil, err
+		}
+		for j, i := range indexes {
+			ranges[i] = shardRanges[j]
+		}
+	}
+
+	return ranges, nil
+}
+
+// BatchDefinitions fans out to BatchDefinitions of each shard holding one of the given keys. The
+// returned slice is parallel to the given keys.
+func (s *ShardedStore) BatchDefinitions(ctx context.Context, keys []PositionKey, limit int) ([][]Location, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	locations := make([][]Location, len(keys))
+	for shardIndex, indexes := range s.partitionKeys(len(keys), func(i int) int { return keys[i].DumpID }) {
+		shardKeys := make([]PositionKey, 0, len(indexes))
+		for _, i := range indexes {
+			shardKeys = append(shardKeys, keys[i])
+		}
+
+		shardLocations, err := s.shards[shardIndex].BatchDefinitions(ctx, shardKeys, limit)
+		if err != nil {
+			return nil, err
+		}
+		for j, i := range indexes {
+			locations[i] = shardLocations[j]
+		}
+	}
+
+	return locations, nil
+}
+
+// BatchHover fans out to BatchHover of each shard holding one of the given keys. The returned
+// slice is parallel to the given keys.
+func (s *ShardedStore) BatchHover(ctx context.Context, keys []PositionKey, format HoverFormat) ([]HoverResult, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	hovers := make([]HoverResult, len(keys))
+	for shardIndex, indexes := range s.partitionKeys(len(keys), func(i int) int { return keys[i].DumpID }) {
+		shardKeys := make([]PositionKey, 0, len(indexes))
+		for _, i := range indexes {
+			shardKeys = append(shardKeys, keys[i])
+		}
+
+		shardHovers, err := s.shards[shardIndex].BatchHover(ctx, shardKeys, format)
+		if err != nil {
+			return nil, err
+		}
+		for j, i := range indexes {
+			hovers[i] = shardHovers[j]
+		}
+	}
+
+	return hovers, nil
+}
+
+// BatchDiagnostics fans out to BatchDiagnostics of each shard holding one of the given keys. The
+// returned slices are parallel to the given keys.
+func (s *ShardedStore) BatchDiagnostics(ctx context.Context, keys []DocumentKey, limit int) ([][]Diagnostic, []int, error) {
+	if len(keys) == 0 {
+		return nil, nil, nil
+	}
+
+	diagnostics := make([][]Diagnostic, len(keys))
+	totalCounts := make([]int, len(keys))
+	for shardIndex, indexes := range s.partitionKeys(len(keys), func(i int) int { return keys[i].DumpID }) {
+		shardKeys := make([]DocumentKey, 0, len(indexes))
+		for _, i := range indexes {
+			shardKeys = append(shardKeys, keys[i])
+		}
+
+		shardDiagnostics, shardTotalCounts, err := s.shards[shardIndex].BatchDiagnostics(ctx, shardKeys, limit)
+		if err != nil {
+			return nil, nil, err
+		}
+		for j, i := range indexes {
+			diagnostics[i] = shardDiagnostics[j]
+			totalCounts[i] = shardTotalCounts[j]
+		}
+	}
+
+	return diagnostics, totalCounts, nil
+}
+
+// AggregateDiagnostics fans out to AggregateDiagnostics of each shard holding one of the given bundles.
+// As bundles are disjoint between shards, the diagnostics of all shards are merged by bundle identifier
+// before the requested page is selected.
+func (s *ShardedStore) AggregateDiagnostics(ctx context.Context, bundleIDs []int, opts AggregateDiagnosticsOptions) ([]Diagnostic, int, []DiagnosticGroup, error) {
+	partitions := s.partitionBundleIDs(bundleIDs)
+	if len(partitions) <= 1 {
+		return s.shardOf(partitions).AggregateDiagnostics(ctx, bundleIDs, opts)
+	}
+
+	shardOpts := opts
+	shardOpts.Limit = opts.Limit + opts.Offset
+	shardOpts.Offset = 0
+
+	var diagnostics []Diagnostic
+	totalCount := 0
+	groupCounts := map[string]int{}
+	for shardIndex, ids := range partitions {
+		shardDiagnostics, shardTotalCount, shardGroups, err := s.shards[shardIndex].AggregateDiagnostics(ctx, ids, shardOpts)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+
+		diagnostics = append(diagnostics, shardDiagnostics...)
+		totalCount += shardTotalCount
+		for _, group := range shardGroups {
+			groupCounts[group.Key] += group.Count
+		}
+	}
+
+	sort.SliceStable(diagnostics, func(i, j int) bool { return diagnostics[i].DumpID < diagnostics[j].DumpID })
+	lo, hi := pageBounds(len(diagnostics), opts.Limit, opts.Offset)
+	diagnostics = diagnostics[lo:hi]
+
+	groups := make([]DiagnosticGroup, 0, len(groupCounts))
+	for key, count := range groupCounts {
+		groups = append(groups, DiagnosticGroup{Key: key, Count: count})
+	}
+	sortDiagnosticGroups(groups)
+
+	return diagnostics, totalCount, groups, nil
+}
+
+// BulkMonikerResults fans out to BulkMonikerResults of each shard holding one of the given bundles. As
+// bundles are disjoint between shards, the locations of all shards are merged by bundle identifier
+// before the requested page is selected.
+func (s *ShardedStore) BulkMonikerResults(ctx context.Context, tableName string, uploadIDs []int, monikers []semantic.MonikerData, limit, offset int) ([]Location, int, error) {
+	partitions := s.partitionBundleIDs(uploadIDs)
+	if len(partitions) <= 1 {
+		return s.shardOf(partitions).BulkMonikerResults(ctx, tableName, uploadIDs, monikers, limit, offset)
+	}
+
+	var locations []Location
+	totalCount := 0
+	for shardIndex, ids := range partitions {
+		shardLocations, shardTotalCount, err := s.shards[shardIndex].BulkMonikerResults(ctx, tableName, ids, monikers, limit+offset, 0)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		locations = append(locations, shardLocations...)
+		totalCount += shardTotalCount
+	}
+
+	sort.SliceStable(locations, func(i, j int) bool { return locations[i].DumpID < locations[j].DumpID })
+	lo, hi := pageBounds(len(locations), limit, offset)
+	locations = locations[lo:hi]
+
+	return locations, totalCount, nil
+}
+
+// BulkMonikerResultsCount sums BulkMonikerResultsCount of each shard holding one of the given bundles.
+func (s *ShardedStore) BulkMonikerResultsCount(ctx context.Context, tableName string, uploadIDs []int, monikers []semantic.MonikerData) (int, error) {
+	totalCount := 0
+	for shardIndex, ids := range s.partitionBundleIDs(uploadIDs) {
+		count, err := s.shards[shardIndex].BulkMonikerResultsCount(ctx, tableName, ids, monikers)
+		if err != nil {
+			return 0, err
+		}
+
+		totalCount += count
+	}
+
+	return totalCount, nil
+}
+
+// Symbols fans out to Symbols of each shard holding one of the given bundles, then merges the results
+// in the order defined by the symbols query of a single store.
+func (s *ShardedStore) Symbols(ctx context.Context, bundleIDs []int, query string, limit int) ([]Symbol, error) {
+	partitions := s.partitionBundleIDs(bundleIDs)
+	if len(partitions) <= 1 {
+		return s.shardOf(partitions).Symbols(ctx, bundleIDs, query, limit)
+	}
+
+	var symbols []Symbol
+	for shardIndex, ids := range partitions {
+		shardSymbols, err := s.shards[shardIndex].Symbols(ctx, ids, query, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		symbols = append(symbols, shardSymbols...)
+	}
+
+	sort.SliceStable(symbols, func(i, j int) bool {
+		if li, lj := utf8.RuneCountInString(symbols[i].Identifier), utf8.RuneCountInString(symbols[j].Identifier); li != lj {
+			return li < lj
+		}
+		if symbols[i].Identifier != symbols[j].Identifier {
+			return symbols[i].Identifier < symbols[j].Identifier
+		}
+		if symbols[i].DumpID != symbols[j].DumpID {
+			return symbols[i].DumpID < symbols[j].DumpID
+		}
+		return symbols[i].Scheme < symbols[j].Scheme
+	})
+	if len(symbols) > limit {
+		symbols = symbols[:limit]
+	}
+
+	return symbols, nil
+}
+
+// Clear removes the data of the given bundles from the shards that hold them.
+func (s *ShardedStore) Clear(ctx context.Context, bundleIDs ...int) error {
+	for shardIndex, ids := range s.partitionBundleIDs(bundleIDs) {
+		if err := s.shards[shardIndex].Clear(ctx, ids...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// BundleIDs returns the identifiers of the bundles with data in any shard that are larger than the
+// given identifier, in ascending order.
+func (s *ShardedStore) BundleIDs(ctx context.Context, afterBundleID, limit int) ([]int, error) {
+	var bundleIDs []int
+	for _, shard := range s.shards {
+		ids, err := shard.BundleIDs(ctx, afterBundleID, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		bundleIDs = append(bundleIDs, ids...)
+	}
+
+	sort.Ints(bundleIDs)
+	if len(bundleIDs) > limit {
+		bundleIDs = bundleIDs[:limit]
+	}
+
+	return bundleIDs, nil
+}
+
+// BundleSize returns the number of rows and bytes of the data of the given bundles summed over the
+// shards that hold them.
+func (s *ShardedStore) BundleSize(ctx context.Context, bundleIDs []int) (numRows int, numBytes int64, err error) {
+	for shardIndex, ids := range s.partitionBundleIDs(bundleIDs) {
+		shardRows, shardBytes, err := s.shards[shardIndex].BundleSize(ctx, ids)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		numRows += shardRows
+		numBytes += shardBytes
+	}
+
+	return numRows, numBytes, nil
+}
+
+// partitionKeys groups the indexes [0, n) by the shard holding the bundle of the key at that index.
+func (s *ShardedStore) partitionKeys(n int, bundleID func(i int) int) map[int][]int {
+	partitions := map[int][]int{}
+	for i := 0; i < n; i++ {
+		shardIndex := ShardIndex(bundleID(i), len(s.shards))
+		partitions[shardIndex] = append(partitions[shardIndex], i)
+	}
+
+	return partitions
+}
+
+// partitionBundleIDs groups the given bundle identifiers by the shard that holds them.
+func (s *ShardedStore) partitionBundleIDs(bundleIDs []int) map[int][]int {
+	partitions := map[int][]int{}
+	for _, bundleID := range bundleIDs {
+		shardIndex := ShardIndex(bundleID, len(s.shards))
+		partitions[shardIndex] = append(partitions[shardIndex], bundleID)
+	}
+
+	return partitions
+}
+
+// pageBounds returns the bounds of the page of the given limit and offset within a slice of length n.
+func pageBounds(n, limit, offset int) (lo, hi int) {
+	lo = offset
+	if lo > n {
+		lo = n
+	}
+	hi = lo + limit
+	if hi > n {
+		hi = n
+	}
+
+	return lo, hi
+}
+
+// shardOf returns the store of the only shard in the given partitions. If the partitions are empty,
+// the primary shard is returned.
+func (s *ShardedStore) shardOf(partitions map[int][]int) *Store {
+	for shardIndex := range partitions {
+		return s.shards[shardIndex]
+	}
+
+	return s.shards[0]
+}
@@ -0,0 +1,163 @@
+package lsifstore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/cockroachdb/errors"
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/batch"
+)
+
+// shardedTableNames are the tables holding the data of a bundle. All rows of a bundle are moved
+// together when the bundle is assigned to another shard. The schema version tables are populated
+// by triggers when rows are inserted into their parent table; as they follow their parent table in
+// this list, the copied rows replace the ones derived by the trigger.
+var shardedTableNames = append(append([]string(nil), tableNames...), "lsif_data_documentation_pages")
+
+// Rebalance moves the data of every bundle that is not held by the shard assigned to it by ShardIndex
+// into that shard. This must be run after the set of shards changes. Until a bundle has been moved it
+// cannot be read through the sharded store. The given callback, if non-nil, is invoked after each bundle
+// is moved. The number of moved bundles is returned.
+//
+// Each bundle is first written to its target shard in a single transaction, replacing any partial copy
+// left behind by a previously interrupted rebalance, and only then removed from its source shard. This
+// makes it safe to re-run an interrupted rebalance.
+func (s *ShardedStore) Rebalance(ctx context.Context, moved func(bundleID, sourceShard, targetShard int)) (int, error) {
+	count := 0
+	for sourceShard, source := range s.shards {
+		bundleIDs, err := basestore.ScanInts(source.Query(ctx, sqlf.Sprintf(rebalanceBundleIDsQuery)))
+		if err != nil {
+			return count, err
+		}
+
+		for _, bundleID := range bundleIDs {
+			targetShard := ShardIndex(bundleID, len(s.shards))
+			if targetShard == sourceShard {
+				continue
+			}
+
+			if err := moveBundle(ctx, source, s.shards[targetShard], bundleID); err != nil {
+				return count, errors.Wrapf(err, "moving bundle %d from shard %d to shard %d", bundleID, sourceShard, targetShard)
+			}
+
+			count++
+			if moved != nil {
+				moved(bundleID, sourceShard, targetShard)
+			}
+		}
+	}
+
+	return count, nil
+}
+
+const rebalanceBundleIDsQuery = `
+-- source: enterprise/internal/codeintel/stores/lsifstore/sharded_rebalance.go:Rebalance
+SELECT dump_id FROM lsif_data_metadata ORDER BY dump_id
+`
+
+// moveBundle copies every row of the given bundle from the source store into the target store, then
+// removes the rows from the source store.
+func moveBundle(ctx context.Context, source, target *Store, bundleID int) error {
+	if err := copyBundle(ctx, source, target, bundleID); err != nil {
+		return err
+	}
+
+	return deleteBundle(ctx, source, bundleID)
+}
+
+// copyBundle transactionally replaces the rows of the given bundle in the target store with the rows
+// of the given bundle in the source store.
+func copyBundle(ctx context.Context, source, target *Store, bundleID int) (err error) {
+	tx, err := target.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	for _, tableName := range shardedTableNames {
+		if err := tx.Exec(ctx, sqlf.Sprintf(moveBundleDeleteQuery, sqlf.Sprintf(tableName), bundleID)); err != nil {
+			return err
+		}
+
+		if err := copyBundleRows(ctx, source, tx, tableName, bundleID); err != nil {
+			return errors.Wrapf(err, "copying %s", tableName)
+		}
+	}
+
+	return nil
+}
+
+// deleteBundle transactionally removes the rows of the given bundle from the given store.
+func deleteBundle(ctx context.Context, store *Store, bundleID int) (err error) {
+	tx, err := store.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	for _, tableName := range shardedTableNames {
+		if err := tx.Exec(ctx, sqlf.Sprintf(moveBundleDeleteQuery, sqlf.Sprintf(tableName), bundleID)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+const moveBundleDeleteQuery = `
+-- source: enterprise/internal/codeintel/stores/lsifstore/sharded_rebalance.go:copyBundle
+DELETE FROM %s WHERE dump_id = %s
+`
+
+// copyBundleRows inserts the rows of the given table belonging to the given bundle read from the source
+// store into the target store. The columns are copied verbatim.
+func copyBundleRows(ctx context.Context, source, target *Store, tableName string, bundleID int) (err error) {
+	rows, err := source.Query(ctx, sqlf.Sprintf(copyBundleRowsQuery, sqlf.Sprintf(tableName), bundleID))
+	if err != nil {
+		return err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	columnNames, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+
+	return batch.WithInserter(ctx, target.Handle().DB(), tableName, columnNames, func(inserter *batch.Inserter) error {
+		for rows.Next() {
+			values, err := scanRowValues(rows, len(columnNames))
+			if err != nil {
+				return err
+			}
+
+			if err := inserter.Insert(ctx, values...); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+const copyBundleRowsQuery = `
+-- source: enterprise/internal/codeintel/stores/lsifstore/sharded_rebalance.go:copyBundleRows
+SELECT * FROM %s WHERE dump_id = %s
+`
+
+// scanRowValues scans the given number of columns of the current row into a slice of values.
+func scanRowValues(rows *sql.Rows, numColumns int) ([]interface{}, error) {
+	values := make([]interface{}, numColumns)
+	pointers := make([]interface{}, numColumns)
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+
+	if err := rows.Scan(pointers...); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
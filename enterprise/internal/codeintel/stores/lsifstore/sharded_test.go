@@ -0,0 +1,65 @@
+package lsifstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtesting"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
+)
+
+func TestShardedStoreShard(t *testing.T) {
+	shards := []*Store{{}, {}, {}}
+	store := NewShardedStore(shards...)
+
+	for bundleID, expectedShard := range map[int]int{3: 0, 4: 1, 5: 2, 39162: 0} {
+		if shard := store.Shard(bundleID); shard != shards[expectedShard] {
+			t.Errorf("unexpected shard for bundle %d. want=%d", bundleID, expectedShard)
+		}
+	}
+}
+
+func TestDatabaseShardedBulkMonikerResults(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	db := dbtesting.GetDB(t)
+	populateTestStore(t)
+
+	// Every shard reads from the same database, so only the routing and merging is under test.
+	// The bundles adjacent to the test bundle hold no data but are routed to the other shards.
+	store := NewShardedStore(
+		NewStore(db, &observation.TestContext),
+		NewStore(db, &observation.TestContext),
+		NewStore(db, &observation.TestContext),
+	)
+	uploadIDs := []int{testBundleID + 1, testBundleID, testBundleID + 2}
+
+	edgeMoniker := semantic.MonikerData{Scheme: "gomod", Identifier: "github.com/sourcegraph/lsif-go/protocol:Edge"}
+	edgeReferenceLocations := []Location{
+		{DumpID: testBundleID, Path: "protocol/protocol.go", Range: newRange(448, 8, 448, 12)},
+		{DumpID: testBundleID, Path: "protocol/protocol.go", Range: newRange(449, 3, 449, 10)},
+	}
+
+	locations, totalCount, err := store.BulkMonikerResults(context.Background(), "references", uploadIDs, []semantic.MonikerData{edgeMoniker}, 2, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if totalCount != 29 {
+		t.Errorf("unexpected total count. want=%d have=%d", 29, totalCount)
+	}
+	if diff := cmp.Diff(edgeReferenceLocations, locations); diff != "" {
+		t.Errorf("unexpected locations (-want +got):\n%s", diff)
+	}
+
+	count, err := store.BulkMonikerResultsCount(context.Background(), "references", uploadIDs, []semantic.MonikerData{edgeMoniker})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if count != 29 {
+		t.Errorf("unexpected count. want=%d have=%d", 29, count)
+	}
+}
@@ -0,0 +1,80 @@
+package lsifstore
+
+import (
+	"context"
+	"strings"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// Symbols returns the monikers defined in any of the given bundles whose identifier contains the
+// given query as a case-insensitive substring. Symbols with shorter identifiers, which are more
+// likely to be exact matches, are returned first.
+func (s *Store) Symbols(ctx context.Context, bundleIDs []int, query string, limit int) (_ []Symbol, err error) {
+	ctx, traceLog, endObservation := s.operations.symbols.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("numBundleIDs", len(bundleIDs)),
+		log.String("bundleIDs", intsToString(bundleIDs)),
+		log.String("query", query),
+		log.Int("limit", limit),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if len(bundleIDs) == 0 || limit <= 0 {
+		return nil, nil
+	}
+
+	idQueries := make([]*sqlf.Query, 0, len(bundleIDs))
+	for _, id := range bundleIDs {
+		idQueries = append(idQueries, sqlf.Sprintf("%s", id))
+	}
+
+	locationData, err := s.scanQualifiedMonikerLocations(s.Store.Query(ctx, sqlf.Sprintf(
+		symbolsQuery,
+		sqlf.Join(idQueries, ", "),
+		"%"+escapeLikePattern(query)+"%",
+		limit,
+	)))
+	if err != nil {
+		return nil, err
+	}
+	traceLog(log.Int("numSymbols", len(locationData)))
+
+	symbols := make([]Symbol, 0, len(locationData))
+	for _, monikerLocations := range locationData {
+		locations := make([]Location, 0, len(monikerLocations.Locations))
+		for _, row := range monikerLocations.Locations {
+			locations = append(locations, Location{
+				DumpID: monikerLocations.DumpID,
+				Path:   row.URI,
+				Range:  newRange(row.StartLine, row.StartCharacter, row.EndLine, row.EndCharacter),
+			})
+		}
+
+		symbols = append(symbols, Symbol{
+			DumpID:     monikerLocations.DumpID,
+			Scheme:     monikerLocations.Scheme,
+			Identifier: monikerLocations.Identifier,
+			Locations:  locations,
+		})
+	}
+
+	return symbols, nil
+}
+
+const symbolsQuery = `
+-- source: enterprise/internal/codeintel/stores/lsifstore/symbols.go:Symbols
+SELECT dump_id, scheme, identifier, data
+FROM lsif_data_definitions
+WHERE dump_id IN (%s) AND identifier ILIKE %s
+ORDER BY length(identifier), identifier, dump_id, scheme
+LIMIT %s
+`
+
+// escapeLikePattern escapes the characters of the given string that have a special meaning
+// within a LIKE pattern, so that the string matches only itself.
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -0,0 +1,39 @@
+package lsifstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtesting"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestDatabaseSymbols(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	db := dbtesting.GetDB(t)
+	populateTestStore(t)
+	store := NewStore(db, &observation.TestContext)
+
+	symbols, err := store.Symbols(context.Background(), []int{testBundleID}, "protocol:newmetadata", 5)
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if len(symbols) != 1 {
+		t.Fatalf("unexpected number of symbols. want=%d have=%d", 1, len(symbols))
+	}
+	if symbols[0].Identifier != "github.com/sourcegraph/lsif-go/protocol:NewMetaData" {
+		t.Errorf("unexpected identifier. want=%q have=%q", "github.com/sourcegraph/lsif-go/protocol:NewMetaData", symbols[0].Identifier)
+	}
+	if len(symbols[0].Locations) == 0 || symbols[0].Locations[0].Path != "protocol/protocol.go" {
+		t.Errorf("unexpected locations: %v", symbols[0].Locations)
+	}
+}
+
+func TestEscapeLikePattern(t *testing.T) {
+	if escaped := escapeLikePattern(`a_b%c\d`); escaped != `a\_b\%c\\d` {
+		t.Errorf("unexpected escaped pattern. want=%q have=%q", `a\_b\%c\\d`, escaped)
+	}
+}
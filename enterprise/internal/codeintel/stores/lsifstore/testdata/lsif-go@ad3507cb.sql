@@ -869,15 +869,15 @@ INSERT INTO public.lsif_data_references VALUES (39163, 'gomod', 'github.com/sour
 -- Data for Name: lsif_data_result_chunks; Type: TABLE DATA; Schema: public; Owner: sg
 --
 
-INSERT INTO public.lsif_data_result_chunks VALUES (39162, 3, '\x1f8b08000000000000ff6c7bcfb267c791d639f79cb68c43c66070d83c012baccaff996c58a005de11de3abc50588dac40ea5648ad001e015e00633cf35af322b3764f5456659eb63d9b1bf53bffab2af3cb2fbfccfb5fdeffdfeb3c7ff2ebd7df7dffd5bbfffcfbefdffcf74f3f7bf7d9f9fe0fc7f972fef8d3b7bffbfeebd76fdefdd7cfdefdfebbf3fdff39ce9fd6a15f7dfaebcfde7cf1fa579f7e77befffbe338fefdfbff7d9fe7cfbffeec9bdf7cf5dd97ffedbb776fbf7dfdcb5f7dfadbefde7dfbe59b2ff2de8fcf8f8fe33fbdffbbfb3c7ff937d7fde6b7cfcfbf79c77cc5f9f1f9fe4fc7f1e3f7ffffe57c79ffa7e37cffc7e3f88fefffdf759efffa9fb9e18f73023f7a4e9c1f1fe74775f6e3e338feacfff0fe0fe74767fc9bdf7dfdf927f3e5ffe18bb79f7cfdd9976f7ef9c5db937ef1e59b77afbf7df3d9579f7cf1f6ebb79f7ff2f5dbcfbfffeaf53ce53fef535fbef9fcf5fffce4ddfffae6f577f30cffdb6fbe7dfbeeedefde7ef5490de661fdc55fddf0fbd75f7df3fadb79cafedd5f9dcabfeb9cfcb49ff63fbefdf2dd3af8e7f31f6f5594f33cf99c233a5e8150c87d9e7ade28c8c71cbd8241027b88c3650f09c38f57400efab21e21635ec6e76d8476bc820136cef3b4f908d0386e1a1679608ee6156a0c1fe7017488635d4bc3c61e0e56de43b4a175d4cc6a3802eb361c9ec33b1ceb2028423d00bc2fa5d18f85313f8489e987e749e7053e9f42e7ed82b1471e5023da674dd96a34a8ef90ba4e624e8fddf7da445e34d7c6749ee221f6f3f394b9d0342726e71d232f92f3b6105e2307c01a718d48c7be8372c973c45123b11a611d03a8d1a07dd644f631d3d8cf3352aa77d4579958f4b7d45761dfcb087dacdf6b5ef3603f6e74b573efb2ca71131b9c799e381743c5a60de979f988323a8b31d729d4eebc971dcb0060a0d7fe898cdeeb9106c96d75fbf1c866fb08b2e971a143febed0e2b8d949cffd0ae2e3c641633905cef7dc62e4ebbc18e97c8389ad0b58250f2870bd5261fa9102f0f22375ec2949944b21511d25829c28dbf43c3e6fd734b26935d36b96d5445a3f9f77cc659efe33a7a0d393a667613b324e47be20e617c67941481a22eab5a640517e8643cb21106d1cb728c57aa8284daf101898b75d41e571003efa2e1ae9e62cede6ccf375a87ce5064b701b996fa3f0f9de57c01af0b23cc0adec79181d7728edc985e61bd4647ec79cdddcbfbd22b157c93120e708f0d19cf30bcf558ef376ce4b72941e1373f9d263f218518fead89cdc2dc2b4375c783e5a34707d2a81511bf93c05ac3579986035bf83c7767a1da33e56f5b8c100d7bcc0861d97c032cb4b40d24c9ddb4c3dad8868ec2b44d246c0d63a90096e7b20281bb1b97838cc9773e1303d6ee4b4de5cbbb45eb641ebfb0ccad03c1873df78edf7adeab5e124517e873af232617ab5d68791da27a9517b80d490a20d473cb7497cbf5e21a2e680393d775d6643a85ec84565400230b184614fc7c9e69a8e58bf610688198fccafe559601dac80f3ebe6d2477a0485d1b57dd8cba4d00b1bcde9b801b65bcf111eb712c95a5a25a2047183f5104569e8a6074ce1b81416b85c0a7cdc042e3b02c27c8568454451b3e316a632bd699473b9807fb66d116cc737d32740767c1b4fd44332ed604ad1db228d9a03466fefa8dbc65ce18231ee10abd27b6cfd30c0068f0154db8dfebc18db0800a943377e10847b88aa78dc82bc715890f978094e087b896973208ce567cb29c9461d211b70dc42b4b74b88e6f3a8d65a28dd49586638a6f38615fb3266934c2bda11e1069b212158e05c78c1328ee96e7c3e8e7783e83c5f4f9adba413bde37c712ea049ebce1117d0104e846018b15c80183b52921f7784ad39cdd19c127a2f89f3718b0f5e16283ea645b2e8e66f2cf3d183a342dde0d0f469e4f5325cc83d0ddd33b68af1587eac61f26c770f919a2645d33350cf9b416863a2177df0910823643366a79f44813e358b08c5e20e3ed76e58787db345c61daf65908c6e44bdfc34979f9136c631e61b41d0db3a927b12a8967580a6c7539ad70ab92cc7cd4cb8c3f844879b543694904a7e06d28492386f20de112244a0b75556188b7b735a82c70f70fbaa6a7b6d4ccb929c5a3e290308336d0c91e870c1e93b1398475abfaa6cd01467ea9832b915e2c624c464f01a52fbed527c3c268f518551647f31a500b8ea833baa13a42dcc8d35cd278aec650889651bb790e1e3dd7cdc81c87b5ef33b6e74df1c0fdd226328433ee5c2b1dd216848af6a260ca39d7c0c86e36609da5b2431d13f746f1946fa1db915579341c74d920e313f5ed221c8b5a39d4253283a6e75dd50abaef35d46c5ea6c62029b6cf86093b90e93619491298ff935b15103232d59dc8a664d9b2b5ae0de89cb8ec086f7a255a28fdb59d3aad198ce633d17f69e2a535906281d17e30a1f17e30c86316ccd1e6224a588b48e649c91d82334363b61312c730b3966c2b7fc63a57ed3b5c69e3f4ef30184c529e7488e0b37ebbb26bcbd021da3721d1bf560f34cae8cb63b83071fb7416cd43288044472dbc0ac9962640ae5390333ae194c7ef30a24283ea44d4c6cff6a8576c8e893f17ee472e66d82a38e3a76f64a992d2e9ad017103374d2fb1c55eb5478680dc9f0799b35d1585b9a2f46f77e82d6050838dd1eb14d1d91d3b423b6c34482a032c46643936a6ec46c141dec8ba1ebb5360da3dfc69d45c144e48b75f1e98bd369d8c5b62b4283593ba564ce04c851c68eecf31965696a791eadcf63a23e28af80c00aded9664647e78acc96090285ce846fb1874d456eccd04b916637a79da835c97785daf5e6703c6bb11d8e3b6cfbe51c65687294b271a74a1278d22b71da19ce64a33745b1490a9a69940b56a6ca0bb586accc947381d20674a427a9d202fe49bd0a3c0df4911ea8a327c271ebe00d893a97e536302e37b04479d7bd991cd46f13e9a43148f2c50e3f5cd9b2d73ea3ebe6b761e383cc12db9ab98fceb8b587406de343f4b819a03010808edb05e8dc3c09928681575805480725893214929811499c0b927d066b30a867a669d1800ecd3353b80974bfb54275f8f84b526f19ff32127bd12ace9c482c178dce0bb0a1034ac3f1b55524f0b2a09ebde9b00e3d6ea7e40949ce327d9b695ccf70d20408df7162999b4a4ce0f3f356f1b98d9a71229520999190f37c7e61a29b84c9f5e8074553b4f1de95e7b219d5b24dea0792c947dafd4c3ec87c633b99af6cc98a454ae571c6eb1408bef4c3b72f125a061f01fd97db28401a034714f0cd94a18f96ea4091c947aa13cd22236a244495c90dab64ca76a48ac58b92774a91176e489bb3b92376209b234f5a243b3f53928e53d0b767fe4541954b2e67cb5476c5588c78f5e46e39321abdf9c14d6de5c9646c522a0edc68c58193ec0facf03570ac3c625c3bc596d2e4c628ccc30c53ecbe3503442d896c3e6f7292d839e1e453b7e9f032a025460e828d5fc4f5545ac16e441341a83cd379e91fb25513f6e98730b43f5b536192113fa989f64a90e883589d1582ef0bee702f2aeb1fe68f4d5cbc9351e087ce0ca0e6932d250c31ef17b83cab3e9fe093558e91446b3c442b8f0c8ce23103d3a92693a828e041c96e774ea00a99f8286f619a2ce3779a27676825a485ead1211a70492ec2561142272b32aab06c64d30d55cebf60c79a6e9819fb33511b699fa83511421d13f25d0af267faafe25b9c51f189bfe852894d7e0e2bd4d3893be9879ca070292984bc27e83ce4b82023648ad9e3b8d077e446b7e382815b19cc9515e51d2b89bc5cd7d38215837e90372efe91d94c2b04882dc792b4f403cbff3316a7c24f04c51388d6beb0d99e12706b910e3aa1162b0d9ff966629cfc74f9eee80414891f4142e9b1e30f34fdb62def6b519ed08c5d4b9854fed13ce2d1aa9abe0f6efa3e8c9fd9fba39ad043d7a5d58de895b20c54ea205bfdf3c59891641326f24208c880058a56d6ada8c78d54988434310919ad54bda59d81f15541f5116b57b966be4264da9f41418d41665cc2ed58b2b4dbc09dcf66b8db53e0de77804601b2c9a9386ccf8b23d55b4cce90a412123ebd54b4c9936e5c2adafc74b4f97bc5d30c7cd3919962d3249eb1fe0697fd1b2627bbc18a2d804d0f654d5575f1ce9164a1301257544bfa978587e0286ec90b69816ad5b08b4a485523d1b57524d4462c4be218869556e2769b804c2bbb7aa003fd789115c55f64223f6a7b034e94ba44ec1178273bd910aebc92664a2d79a2118035bda799e90e2ab562eef72d91a2f5d2dc531f73da991e1054f1604c6a41c6546a5666d9083b1f63ccea85a82fd67b230dedd487b61ab02b1b13320b3cc5faeb46d3071a9aa63a6a7f2787be99eb4379665b73aa3bcfd4955a0c446db047396ec75a52474ce22c9d3f0a1f978f45d02e1fa9a840e82aad91b616bba258aab2d1c9548f1c9f635d00abd06e524e64dcc5b3e5e9796f4b5cc1bb64e6e62deb590b6054c533eb9a083e899df43ba0533cebc2607d55a03e72786e878d15202e6ff41a325709405abd4ee313cda85718b2605589d75336e3206f2295de1d4c566c76a69212f650978916e15b0564abb5f13494a144b58d4af9014efa838d040f92923e54e401557b6ac06a8f9432414ca3f898062c1d4b36134e30dc96ae59b919833eda5ceea91f47a33be18366f000fdaa2dad0206767ca0095762255e88051fd75289f4bc9648c438c655b38eaefc11ac24ee164e8a270c5b2d21b5a8058f71dce4ba8d9d26df9a0be49560217926fd063b9d11b467521931a1a4d31c66850ac793c90f3f6e815149308c0907032ab318493275f8d8d4754939cb31162039efe8a0fe14d97be5c0e8836241e66758064af481814ec402eeef580c367c1b28583c15a4552f07a9368311e51694ea0205ea8f9ea4752708da322956a56c746ef2947c561a96790d6369ae546c4a45eb18cef0d92b656ba5587decd46e3c5592aabedce196b98cecd87c05368951eb88aab94fc8f454a9a7ab596c81cd6295947d93566eb569486e3a81740e3ec3f3cd411d9367b098cbb7b53b4ed84089ae354aac5c6a70e58ca38a59e2e9b838a04d70801fb70b45e90234737ee08de108f3375949eb6459c10592a6f4242343e12380696e3f2b6f2cc1f0aaa639d07105ed7afd5cbff90da33f7e24d156af460b462dae0f8b18a1f316f1b5ea30c1c45d91c135792f3d0a773dec26c465950a57153a1acc3bf9a2ac6a8a30562510cb6634da8eb278334a504e63b90c6a7fe71c5517e3bb3425105c2c6ae98829bdb029bcaa34b4fd6d006f5b0bec92435770102cf555f35dabb1559a5d69ea6a7c09ae9a588a13c90d279101272bbe4a933c914a1561948e0bb8928c14dd54c07731891b326185c4c582b5d9e4a04e29598fdb570d7dd5e9331cf928b77afa7706774880b1a41f502fb9bdcbcc84dd1134524024b6227d6cabba5430c3884f9489a5f11377eab96abc42bc77d68b4699376a2465c5d135f6417adc04558924580eb404d0a5d4f124c956e27a58da341b2e4cbf747c50134848b7be196cdeec8ebbfae89e820454074b0e25a5c628129a44578896589960b20de6891df6244196982aa2e55056fcd6223707999f368595c878c96bd9dfb3a73543358f6ead59ec516cf496b51287abdb69815116179962ec2742d383ad053265e88cf325ed3525eb9ce388dc85c4c5c8fe150ef3adfb45ab1c83b382cb55ec9bf03c39a5d2f9d489e6a462c72d7ba40c9d71cbd4b7426d6a4bd09452a469484219695cbbfde7e941582c6ed524788954583a1dc1eeb2b856a055ac8a5faaa805288b468d0e934f970667379e8aeeafe6d61747e6d6cc5832ec64983743e50f0cd36695b95a01d7ae12b7c24f8c4b852d6aa296660dd4a630b39223a375f7026d3dd522da7af31b87535fe3b45b667ce71bab516d5d9dd90023d14befdc0eacdb9a74006c36028fb24a3d73a0c7bf577d9c957728367ad6a7e1c870654e6a3b735a6d23a91cad8e28c99c7d7e028cce1757594506b69452bd494694f16b2b30b12424d3f311a6b2e912b653a0379c3f0a9ba6799345a5fce6bc6860ece4909e5e4de424a9092ff3944d0c44a3aac64eb8b96877995c4433ffe32a0c334f82c8a3146f1eabd6cf2a9bb8dba3910c68a0e5e875ae2ecc5cfdd60bd7c2224895e911a6d72948191dac1a6b8c46b255c26492b18b22cbda7721aff3cd554244f3e83ae162c9826bab6fa44776e4c7226cba85b075c34d765c8ce820bd689719bfda39f9e6f37778d764a892e03bf4f174cc7aceb0dd4796c3093a5ee56bf0946425583793d7c6b9b1b65723cbeea9dc3fa928b56cae8baaa7e994f8524dbaf164e3a960bb7b15605d132abde8d88885b8c3a4dc2c78fb432ec1ad48bba6ae98eeca23acae95a244863c8e8bb78077f1ca469636b4b673db3aea0f373292ed958b2eb52260ab07f2480fae4fa9f5a9db0ef0479b90e3c2b168447618dc32a0771296ae548047919d633baf5a307ac76a17cabe84e55fd80d029aa6e1b11154dc233335af4c4d35fb47aaad5539668cf7ae4bfa382e4faff2f3f22c232955ebcecac165087fbcfc5b4bb632f3e88249e5e520452bcdbc529bd26e3da8aa255ead02c6ddf90022dd505ad7d1e2ff801dc400e1b8272daf7a7f366c0a0ed8bde1d1ee37409f12a2796fa38de30e8f2aeaf88a4e0a5bfae50fd86036c601526528383316e4d2a49061a19dc82648dd2901db097887d1d5a9b14970eae783bb9a3738fb7500192a1e61325722f596a87789199b71054eba4656dd2d64d9b6571daaa249df943caad3bbfb3177079fe2ae8bebe81658dd2a40545d3ce150a08057c0578f5b874a32e2ec1784f3018c191e45963fc1ea99dc55ff55f8bd4da9fb20b8bd6c3c508520dd1dc188856fb85468ed1abf4e0b37842a5fe07429eadf347f5fbe13fa6b55a42490ab34d2b93f1a6557aa75576a96251548ffc56ed0a4fe389502d7781a280a7ce7c11658b8bbac11fc29983f057283699126bbc72596b2cba4726d6c0bae9c73586b80ab648abb16496b1ecb934a5a1e2942a90fffd12e585abb6fe709d64a0275d3ad3e65a94774c3516e5e8eece65d005d35157dca4a9a71449d1727be9d5bda805d6e899dbea2b57ae9a53b0624add1a173f517db78e8933fc997762d603c851692a74110f821601ffcfb45ee85cf4468667a84bab5fa550f93e557d5507691152f59bd71817695e8ed157cbbec8c985dcb42b2c8f645f2b4e150ffcb8a091c57358d5c33dcddaa6c05be890a0a0aa578033f9d12b4fec30537309b76253b6a3fad6ae4f6412776d71d4655cb7d6853497e9a52ba6a0d5c8a54d5c85d6379cace3690d533d993d1c95e36a9905277c26a628e206d9a4b4f4f223c2c0874b586661c97f3062a393b46aa762cb05ad4269bb0f65d91a76537a7ede79d3cf09eac7be3376d66526a8ec653ff4e931c6c5d74e22550a8c06e31a49d07dda2414fc173858fb052a9a145bd552871959fed0cbc3a154cca598db8c29e46ab7cd6cdeff57f4b4e32ba8edac5d07edeb36fc0759d7859c4ea8d5c21d8bbdf413a54735b44ff07434f822ac776ec9e4d9911c12d85b599ea5b3608db90e214bb52688f60314313bbf57fb3d8eab50a797922e98e3fd31b63fdc3410a622b5a56777a0ef938fe290000ffff664ae68bd4370000');
-INSERT INTO public.lsif_data_result_chunks VALUES (39162, 1, '\x1f8b08000000000000ff6c5b4daf26d751eebe6f5f5b0913459844090b562c5811d7c7a9aa53b06081176487b28d2264c5836361cf589eb1809f007f8010023f3583aacea9ea216173756ebffd71fa9cfa789ea7aaffeedd7f3ccef3fb3f7bf9e6db2fdffeedafbe7df54f9f7cfaf6d3f3ddaf8ff3e9fcde27af7ff9ed572f5fbdfdfb4fdffeeacdf9eedf8ff3a33af4d34f7ef6e9abcf5ffef49337e7bbff398ee32fdefddb759e3ffaead3af7ffee59b2ffef1cddbd7dfbcfcc94f3ff9c59bb7df7cf1eaf3bcf6c5f9e238fee6dd7f5fe7f9933f38efe7bfb8fffd8367c423ce17e7bbdf1ec7f7defdd7d3f9f4eeb7c7f9ee37c7f157effef3719e7ffcff5cf09b7881efde3f9c2f8ef3c3faf5c5711cbffbeb7f78f7ebf3c3937ffcc5abb72fbf79f5e9971f7ffefaabd79f7dfcd5ebcfbefdf2e54f3e7f7dca475f7ff3faedeb5fbefef2e37ffee68bb72fbf8983f3477dfe17af3e7bf92f1fbffdd7af5fbe895fc60ffaf41ac461ff935f7ef5d9c7f1767ff9f9eb8fbffaf48b5771547ffc7bb7f9d5cb2fbf5e4fb03ffdbd9ff2effaed774fd7c534f43ccf71c6681c9712f8fa5f09ec7846e561cfe769e743871f3178469c8c7b08e31e826a0dd938af25795a37131a478e4c548e8b60da19e7c6681ccf2802f0c179eaf990bca1c66398740f8927d451b43e8a50e792191c971a8c3d7b033a9e714cb6a77c0e9af5f485247e73a67cb34bee976006a8e19075f4f249b30e1aceb896015fc4932f75b03d0766e79a8e60cd9788690f610ea9736dd4d40167bf3158dd8145eb0e40de270c19352494e332305b6f6c60723c319ce7e9e713cde342ccfff4bc10e37fb5396b75a6c65b00fada1f99148f90f39a2061062e7ce6ffea438e8b85709b89a01fd7e041ebf7c103e25602fc9c270c765b5b3d630fd6dc86f83e26562326382e9421e779f21923ce1d83f19486b0aec9578d8b9e71a8c98bbc96646c739ac0738d1cea19364dea57dbe7392aee6303799fe70635d21a99dde749dd4ff9b804699bace0b21f1cfcd436b99fc3c0765ca8e26b795025bc0807c8595b08e3b87c98e44ec5681cd740db771fa8f3b848c75e7052f6e3a2e1dbb269388503199503191c8fbaff63001f97ceb1efa661728f3125ff7d8c196b0c3cd6ff391cc74568b8ef861a4b8db3cfc03996830a3edd81612d2ab91d970bd4ab48bc1ab98e7d33573c2e6181bd703cc279142c6e25e7c573d2323b07e1e3f289bc2cd627d27121e85c976204970bc9713d0a69faf2c3b0e0301fcd597198d83224c1f9c869a20bd64e7adb8dc7aa4fdfab38a6c371b167588a99f98c3d16c571e5cacdb4ea5810d2a13b2e18491d1c10f311ac7893dbb863864ac60c73fd5ede4ba6752425aea12aaddb9af66d11a8632a8dba2138d655a01958730ae0dc43ebdbd2e8391a8e7e42c676255d7b3a0ccb2dc3151ea89a5ef9408d65b2328f61691e6332efb08adaf17279a98ac5bae71ee596a46130afd11c33d642ccfe8f45aa297e54eb563724b6d96b3eeb28480f49916a27b09754856a4630b01752f95ebd4e59566bee53ef7594ce0661d0fb0603eab634b96760629d39061cd71080e5a76378c6dcf4eb1931d7c2a2c01577248058eacb4766e08c04e1abc66bad1f467e5c2a5a194d94e2feb8f3f3100ce743b57d35aaae3da5eda70c65f622184e2d73965387095e42b4cd5f6805639e158c592328b12d771cc8e3b878d4c379c4c3c94496bb90091f0ff6f5ae0ff6785572b5fdaae4393901a1c7f22f4c1f88846373ac91b9e9111194d7a41818e3a1b5a2ccb1a2b67ecf8cc798799cf069af5825ec8506d437b6510d6f0f10b1035358d645223b7792484c98a740c5b841e50f19b368d0ac7719e93c086a671b97e6e524fb7963a104c21d0d8ce69e9a445ab96801955c39a0e38154fe46917d87d5c487fa71996532e0484f996dd535fc6ff9d38e684446c7252ab2b75423a2aa9bef4ce0697f22b6b2d62591aab6252a426438ed0c17204da0ae15b0b0b5e97b677c460ee201b3cc01124fb8d1ba374d6c78639cf90375db651aeeca1fcc9c56a1bcac620c879d0f10a4ac224c917996e9f1b4e33177327e4c92e341e4cbf088c22c71d2de579cb036662753859ca802e39fef55eaf008e6ef85d20e11dab811c43a180fad6880b663ede53a1b6256a87d46c68e3c8c7d2f021e7714837d0387fb54ef68af9d7a607452813b08015bd9bdf72b80df11d1acf3c9e82482115f2a47ece077b973c733948a8dda898587f55c10b4c32453cf10ee1bcc5e5af1ca6d4c725c8a041b5e22cee30929cdfb29ac70ccfc2f82ce8cff592617f49c18c847ca7540222202eedd1e9056cc380b6e606c36c8c61f311c015edd2b9d79a208a511292be192be47673aca13a4eb1854bae4428e5311234a5321eb203a814b5cff281cf44199583df1eedc23cab495119fa07e4d8759bf8e1acdd1e7d5b523ed758dac47192412ed6790a04cd204f454196b13984b283c576447e618c57e4cdb6ba833d0a4e3cece01349e518784836a2e58fbb5dce48b12e030cd028d4cc9ea944d7fb80106179ae659387d542030362e9c5ee0d29c0a933317a370a96363344e87c6f370ef0a77f82e0600d8e990b0021078fd3afbd79ea9bad6fd9a6f2c2c47c07eb62727a8d5393fd84b8c0d4098375434f2d150a583c0b432b14082c929a63d9553d1ce8b1c993f22a8e38775f67be0121b80816bdf19dbcfc9db395778083022d383a6cc8dd451f3f1626e4f1bbba13476cb2cab3a8b2b4a2d06258d24de5c248798700eac70fb588690393a0cced214b705f1f1985a915c935591f3f245117c0f66791370a234492be0bf305a00ff29703c5896693f58820fcfc587238718881472904c3c73fa86236ba3244d6c2ffba4205e32b0926266ee49b4d9ce24ca9b28d3877bc20d0661f65680b6394448ecade00eb9fa5ec48cd51392b9f891525e10597004d5c2c58f625d23c1c6c369b332a2a2ab53138d0c938a56308b87cb9084e820fbbd97a8136f2bd32bf54638414ae01a0b4c495005b18027e4efa445c502b310e9560c8834a7360d9e368eeb4440e1c0cfa8d379390b61836d44b981bd746e199d8ac3d63bf92c45c00a39d0b47bfa2565684e146d960a12f8724ce9fc2281bf95f706bb728283e1bc51a05ad3c54835444517893cde4398c63ed56ed523d50125d9da0a2d2893f433921f4ddfc982828e5eca732734e51504d4ea52bd3735519422edb90d12edb9cd4c7b5bec118600b7435a30c8b97b990e799a0e79a2e3b52bbea0a16cb9ceb078b24d5cb90497578691362525964ddf718e940d7416e247cdd880b2d17c0ef3ed5c46391d94f141718019975d42dac424381241ab1f81cc0200036ec848e5b92626753b484dca7d91f74b7d685b596b79247790b4f43cc589cf37212a07a61b08dcf84bb5e153449acb7d6cb4ec41192ed79d4463348ecb903682354c022132774465aa74e59476cdba6d3487785c2e097f331ccf1970989b1451ecfeb4cdc4645ac07e642f80c5765c36a51e3ec35a7c0015e984787340c20249985c5005b784353c89444931bab89cf22c2281ad6512f20ae20acffb5d3b59cc5603595ba99bb3f274da372f996b3b7e86d7a1567e34fae40886c4b01d9b18c268960e958118f8b89007ef08c5238824b814b18c3722b2b1221c91ad35f0e278809e5b44b0dd8d17a05f027686371f63b14ec686b941c19e11676e567a41bce0853c7b26016145bd499af3f158f28a9e0f4bb60763549a1a99eb64ec20cfc1cbb6d38fc5f65d7e58efe895b0b553b7b6002325c0447428c8d9e78dfa150b72ce31a18fed2b9c81ea98d7af83eb2e5460d6850a9aa235709dd40057eb983610e67dade9a2ce8edb390c6e15592dd54b1ead5e268104016b840f295c54fc4839290c640747229f4704cf1d82338c5ed4550f5a558f3151ca3769f6c6c7c3d8ac6d820b6faeaa804cd4a75b70d8b64a190709cbb690301ec132dbc7652e210361d3678405922ed70c0842b6b0fcd2540b792ea1738c2d7452cb9be6784f6dc561281214713128f3525293a05cc3a529d1580a9fcb475b0b9837176e0d0cf8bda3d4ca58019ce0794d9b816fc07bd366f20eb0c36fc5cec6adb2ddea5f8b73001d8111e13ef7d6fc64dc20788925ec50a6a4450628058e218ded520092a95c99979a416038214c2fc40fd313f1a3d3ce89604d800972ebe6f9d0a550dbc46bd3d1fb24e3a6fcdca2adf852cb9bbe4a6a6658aa9504e38b98622de34d8b402855c3cbba486ca6d7668aa5ac0725ebd1722ed66bd3bf59451652985d6dd3bb06e7743c74ae24fed0085f43db7b02a75dae5e994f7d55c726fe684bef95d9de2bd9789580ac0b448e37d0a99460ed43be2494246c45fb1c199bbaf556513fc3f73314cc9abad5d3226117a19c5d10a81162979446dd19b0d19636fb491241425ccaa0106509b5d426743f2ec22a6aa45b5f8c500227422ea796a4ab19e014c90adc14a431166c98c30b24eea420bd9e8e927286e1d871c447932dd5054be7d8dc6255c4925b044ee659ba2a4fc6c4cddbee926d5ee2a5c0894f8ba4e55ba70e1bd45541ca975e2502dcaa6c7ac7f2d9349f040965c094e8440d377a5143cee25785634d931fecf483cd17ee0882cd9e015b9622835b796b1601d0ba16e2adbc89753cb35248fc3d75ff16be7089e9eb6137bfa7d6c050e65d93b9699e8efb0e5da961be21342025bcc3867719b388ed3b0b5ddc7a1a57f9c6d5a5468d425966df54ee17d75bc95c602b258804d13061764883955a670739ce420d5bd597070687569da527eb8cad93029ebaed12b4e438f24c5d9c9a4258f34a1f6971b26abda4cf5b26a00a886a5d7db9178fc6b86b320801472755813a574c24f1189f4fe9e5290740bc41ac5b854b66488f9dd21ebb742a30293521fd8c23fc04f016c3b1ea37629907d46427699cd0c57a5dbc1aa77fb076adcb7a30ee1a21ccbb8628b7edded5be99c8004d9a3399a42e035e7513c022390b0e27e38d283f57cd2e4157baba806f34c2ae5a186695db5836ba22efca3c71425e067a1466f4edb6fc5ee531f2a660d1b3d88ca0ccb3810f7418cdf48503b58107e62acdb92b01cc1dd731bb0cd4abfcaac94841b4ed51220829db0e52ba952629c8252b9366d85efa27a07cb02d2197384696a26e1ed3ae17f7965bfa659a8105a5d3312bb1651cd249e3e9ee56d8760241ea7d54cdc13920bff15e22b4343a81e231c3a0f34b7a08617b1d61f205e7cd00d079ed3f433100b1d6725605dcb658b491cf123e962bc256276869a4abae12646e29b559e89a7c3cc6a86aec581077c2a384e79ded86721320e0e341ba2f215d1695789dcf0772f9df120e6175a8640e024b656a909694c42925a500b2aafa14097273d18b31c0a9a235998d5c6354cd294659d81c36bcd07a6771a6459060b9eb63de249553a1619bf3b993236fbc5da8f3413bf85cc65d65765aa52c91eb96c28a8f154bf11666cc28d2fba8081a51e212d8f1e892b0d3f8dfcfbb2017587fda4667d8ed4020d44384ee0c5a185380b66d2936a186b41c65b00a020d8420399217271a0bcf8e21f867db539d776c19ab7d40b21fa6143646aeda2d8fb22b2cd56d95d17204359aee55cd6b23e559f16be983896dc6ae0afb8d7cdc2b6b305a9d47751ed41513fa69deaa2933d69ca5e6bcb8555e7bbf1135ba62eb37eab9545572c61a06b6517c34e3e9ac3b3644784c5e5ea4fa74a398ed3bb4e81c8e0d9e60b512313d95c36d72bc64d5e9d5d1363d450d002d651dc6d219ab99465bf8745d62216c8816c61344ba75856c8ec3d1441ac79af2c05b7e59a51f9fbe6f3fb4d43a0e582bad6f89e6a9445ee51f22cf09d82c20cbb3a53eacc5445e4d68000514a97ecb7e8f384050b1835288141ff3daa8f4a67f3797c4bb6e9aad294946fc3bcb9d08e4ee27d426d852a3d9f5f0ead9bb5cc1bb90edddc86763b9e0654bf291c9b090ccb82b2f34e9a6cb6d264c3720a0d8525ac5b72562e78cc17738d3dbba17b352aaee0ec351397dacc23e8d5da4932589aeb6c8aeb670765089c92ef948408b4b256369968402efcc6eec9b41b59e51aceb3ed67c2cdb017052558fe2452f9ea563675b579890763d70a4458a56658375b62f078e1ceecd59ddb2debfe547e625f5cfee55b446339ec538a0c64b40f9528c95303811950c83c7b69ad152028fc660911c2e53de28dc94e1b80ca17a2b3172bc0aecca818e056d658e5de2c046ff84ab136c829752dfc548b655fd48b4593e5c97adb2c1d4ad8e4756eb745ed169615db25649c952256567ef88e477b55f76a8c3b136534bcfd4c51656135f8aa5b8393d86a95e6c587dc18623d91f9e37ddbc547057905516aab361cbfc2266b47a707b1c60361a766f20663b13e8e6c20392bbc2b496f2a6e971cd41bbc1698e95d1590ad574b273120f5c50b66b34e078b2d5ccf4b4ba8aa4c5dfc53d86043acc46ad86aca33aa666175eccab7836cd5a03482a200316c5bdd4dbe9198c8e87d8164cc4c2b040f5bc15a880ff5c6f49bbe82480df3dab0cdaecb2099fde15347caf63f08e804d8b49eebe856a48b97cb6ae863093ed8f76d6111b31bc1a7a8743f60e57e7e50ad3c2d5cb2443abe165e68e0c66f8ced9c27bed5ecf0856c6ddc2571fa5bbdcc77477b870abe9d8d21804a1bde62c1d6bce85f0ddb75bd3dd81c2dc5d3a08b82d66a3679cd5e73b7daee22d46dc4efddc5a21e75be52e7d7a353bc431596df14efcfd62955d9c46c77b9f5a7120b8a501e9e00fda954ff026f9b070ca5aa0bb9d887bff91bb9140bbdc8ca4f73b273adcbd50cf386477b967e6d97d42c36fc9166fe9f47d6524d8162ea29c74677240592ee01e6ce0424f8968064fd114c011c6aa85a3def2ae7493a55a636abacbbe74f711f5b482374754824d083392045117db4527b9b13121658b7a7588c9aaafa956048652f46cec26f301c58c585b325cc57784add7c2b8db703b6b20792b2e3896329c796f35f82fab222be437461752e788b00a526135391a68f715825236d90daa16e8484aeec5b5dd118f00e47b49129a0722aa265ea67a1787fc3640b53a8234e223f1a8c233afea116b756568f702a2240b21c226a5b4aa1b46e383f231b825b0dbf360de9f49b48886abb3b614948b66b13b5ab565759e8f6d65de4c7c343bcf721b03148be4a42f734eac6030e1b814aaaaaee0d9309801ac7a3bc211543e2adabde1b8b8b661707fa1e0d25f0054a322e8dd81d43d41d434feae678e5b6c86064bd2ed8eddb344dd6dd44dbab32b0ecb7732d1a408a7736ed03f886652b7596029d6026d545b88659e1f621b2612dcb34c883f480340fbf9583432cb7a5aa543f62ed7e99292275f3b3fdff5ee5b0fa2f79a8b168518d83db44b2259507221fd6c89d846b660f56ef253ca66846c79f1801bc3bb32e3d930c85812c00afdd2d567eeba052b278630acca1a58f63a0c183b51a4a250a1b681528291243bbb93d8a15d9f6cac8f3daa310b9b68f2ccdaf998dd57bc2afb83b8f821b2dfd1ad68290f097849bc2195510a012b9a9659460a8176414f2d9367611feba61207e2e3615c6a7c7aaf12e9a3be37b91baeb88c81683775f16ea3c8cf7cea03202d7ebc4a0693aa7e46f74361f7dfd8cefcda2b06706773bec3c07bb8c5c75d2de35bb486fb5387fba38225d98249b59881a5e6437443a8887b0fdd82db23b0fb8377d75656e7e391d225f9451a580cbbfcba6039969498edcc5bc45c6d379ae27230031e1d3b221c230c7dafd3ed1ae0e5aa901c98b5cb43acd9ba3620758185257ceb8dd96b36d0a5c4f6006122fdc9d1fa640d60b4620f4b9174d4eda077fb32d8bc01d75d2f3518c793ad94f09416a6033741a7d5599c8d60c6c5cc205035163330c4d55836e479497d134a03b5eec153af63435a604d7d80bbc58498966cccf262477ea4be552bb458b7b75268e7fa90248f61eb8cc475056b8da8f3075adfaf2739ed787882723d1fae233f2daa4fb6c857cd89f8c31d28abff81ea33161fd5c3e0aba73b47debf563f8557c31dae52f183ba039cb98b34116657d890edb48c58e806e758b2c383d78e5b73e8f54bd65358222526995abb1034d8bcbee5b4ec1ed4fa9c232071d2e4aad971ecb482575776e49dc76addda9f64c62c9b7546ceceef035ac9dc353f1c25367a1991add554d01d8101bb0c35efd6fa744a21d6a7bb15aeaa93781cff1b0000ffff75d57d375c3c0000');
-INSERT INTO public.lsif_data_result_chunks VALUES (39162, 2, '\x1f8b08000000000000ff6c7bcbce26c7915dd55fd5a22d53302c1b90fc045e498c7b4678e385b9b07686b6821684d8a60893dd04bb09db8fe07981d16834f3a8ea41446664b5a4d934f2afaaaf2a2f7139e744f4fff8f0f7d779fefb5fbf7ef7c337effffbef7f78f3bf3fffe2fd17e7873f1ce7cbf993cfdffeee876f5fbf79ff3fbf78fffb77e787bf3bce9ff6a55f7dfeeb2fde7cf5fa579fbf3b3ffcf3711cffe5c3ffbfcff367df7ef1dd6fbe79f7f5ff7af7feedf7af7ff9abcf7ffbeefdf75fbff9aa7efbe9f9e971fcb70fff749fe72fffe6b9dffcf6f9f36fbe919f383f3d3ffce9387ef2e11f5fce970f7f3ace0f7f3c8efffae11faef3fc0fffca0ffe980bf8f173e3fcf4383fe9bb9f1ec7f16779f7e10fe72767fcc7df7dfbe567f9f15f7cf5f6b36fbff8facd2fbf7a7bca7ffaeefbb7efdffeeeed379ff5202f8ffffcf59bf7afbf7ff3c5379f7dfde6cbd7ff77fefbfafbbcc73fdff7be7afbeddb2f3ffbf6ed973f7cf33a6fd9cfffea67bf7ffdcd77f357fad3fda1fff3fdd7efe745ffd95f3dfffeff7df7fa5ddef9f3cbcf5fa122d2759ee3bc19828f1cbd42c24135bc63201ef594bd9ca79cb745e42d39efe134f21690ffb89e3517ed1778e01ae2183d84a1be862cb0af3a52ff0cf65586e7d981f03c2bc7ad427ad62755888f9b147dfe4d8a765c3ee6edcb071faf90ccc6b93e6066c71dae709ea79d77b8c4710f1ff9fb5ad3c8350199d6033594e3c5ed3ccf385f5c8e1b85693e8dc270dc2cc6e779ea99233c6e37a2f9b71be17193d8fa9bc420dfee1267af47fcb81d87d4fb73543346c69e3132d415e47d05e97891352391e3a65133903347795c18fb0b18e2c72b1419fed3da13917dca4e7b5f85465fd521bdf128d6431dba9f1db4af3e8764b09f45dc67abdcef4553db6fc0d8070adb66c4f7cf40dbfe6cec17c890e3151a314c5364afafe7b145e42d618e5f4c5bc6c7bc187aba4028ebb5cfd2d191f7675db625eec9a0906d5bb6be4ae3b15a813d47b6fd3231db0f98ee3728f4146c4f8187efd970bf0063bf0b0df799d89e39c5e3b08f0f61eccd455fc33b3edae6a1fbb5b2cf970cfab5ccfa9cefde7d06da7314ebd371de8fda5e0390ef77c90e09b98ffb63fb0bc0fb2ab2efad815e02713ce7cfcf0c028e5bd4759abda8f3710f8db47a3e7394466f86566efc4274dc62753b1fb7985e3eacfd90861eb711ad386044562ff098b62652512c6d4d498f57c864be7ecc64e3b851c1e6d751418edbbcc240c545d4e32687f57272c8970b88960f5f18f9465393f9b101b262ac43a4f78b135790be48b71b626c63458c7cca28ae0a34469cf73204b1aed16018c72dd4b312a20c5e261dbc4c3274b060870e16cc5d540d5bfbc61ac71de473de394ab7e321f9dd386fe4b2debc851173e4546f618776dba84851ab935c9d05e3bac5b43cda79d07113e1587b46b9872ab603bf65a054679d1b23fcb8583c8e07f914ce156438470d3d6e19846b4903e3b871c04a08686539e2a22f2ba256f2c953492fce07d606a2a51186c058bb21f358c9d266ecbc28724a5656ec948faaf7a39a16e383d6577d40ed118f9565ddadb32c96b985a671ac2865f34885112a033bcd9f61b8ae9fb1954d19fb9cccb0383aa3b19501d363c094d3917207cdbc3632cb9af1da6ccbbca6c3616e9a8e9159cb6519bc67e27f850691734cfb631ccbfe8024f71ba1f71b3c3fd59b86025a299a789d101951becc49ef19cb696716f21d8ac19e502c8a75a6eddea3808919e774388da79c37673a6aa61aaeedd7bc8ed7adcec086d18feb5018f696d1dcd77994d6438c35bc0785f7ee72cdcb2a2456c6abab31f65522de57d5d2f8da2571509da73ad6595fec3bfc721917fb480b29075bb0ea462f88c663ac3d66032fab1fb0adde222350e0b43772cfbf01d78e1160ed18ea980184a99253868da00e202e5c0fc5988051c19fdccd4f36e404491163d976a4c7a12a7774d44294c27391d3bafab7c195c7839721192e5b1fea15e3d05718c9e3ed2972c68aa05e5d543864106903873975c0e944b78cb1330c7a6dfa009e6ba741b18c577df427a2f201f90a374f9ec7e959330706d5f98df51985277f52c871f102ae17176eb51dde2cc39b802c6429c07984c4bc511f33150e5cfb5ac39a130e9c1317115c13475dc7e7a3428428eacfe697d85722e3695d65fade981e9d776c963952d7350aac9d29e8853b43b503d94496f53e5ad1ab32c68a9eb2be2689a8576cb375b7d63baf8d5821cca8e732328c2ff681fb7db49ed3611d2bc3e8b86dd042c336082b105430cff320d93933c48e9bb15c222d1ebd5c000cef69b7997a7ba761ba6c3207dbde2f5250bbbc6c01b6386ee1d187c8038e9b7881901c55bac4e85f30a61fea0c00964bc99caccabafe56e6f4255d0bc228df3151590f24ce7d850863e37d1895c0d06c45588c2421e64d42cce1b86d4e223709dd8f9b231696e2449519ecbd83bd2765a262149a493f290b23f6f3887cdcecb1decf1e705c0a7342974291442728237d416ddf8a686022335c59db3a6a9e63e0625d39d2e30e8395fcc31260891b2c289344e015aac98a0d35e79507c73892b4caf9d0d73bdcc77a5526da57a8ec19d42cdf5574c126bb911ea6f51660a60ea9e96cb7ead84735f8b84c276fbb2c79a549433e6b6c002bc1f3685f0d482c4048b1282c663216d6ce98737bd861a143c1f69a91613df1132c722e66cbc92231695af9a2c235948a7321335c68b2e375a4d623985034585f162a0cdd31851214d00605456e2d9a7a5ada99318d736100cab84fa39115991f578c69b95724be6396053998cb9b00a57d0390730b778eb2cc51b7cebc92675c79858ddbeeac88b2a137ec96da0235590b66efa818ac1d2993b6dc288d0731a9e92d34d62a248941bec465da0791b77d00ecaccf60133cc88afc88fa881a232d85d897a524feba712c53b9714865b65149ef2f82f018950f35885fe66f811a472061dd43e9a3c2f628a75231245602bcc9eb13d81e93c384f24a6309274a232725e12b4bcb4c2e1250ebbeb4c27745437dc00ee8e4dd6ed7ca29da79411b8afac880a3d02e57b0e176765e9a4766a41b2757cb4f5b693c6cb69e67930c28cbb324a2726c0952e3bc823651c7891cd9fddf2e57da8c913e4ad8c89bbe3e143de1c576949dd0d5365115dda416a1566d21af26b6960d0905a0330534cc63665917878fe38e80b5b40848663890ce876c6498f00e13b2777119228fe5eb65ffd3d7b940adf0883b77f0c2024d0977b5569d8c78948250d7d26f85ac495da6e3b452db9102ea8ae18ad9374ff85b70a2b94760e556027a7e46457b3b711487b969f8b2734acc75c58a1f756e0503570035193398d9e689dc718ed3623480daf5018e4b7052864b0affd2f0867b349c2b647a7326729e277d5364ca1503590e6e40c73d66dc29e72be405eade0aa0163260b3bec236230b8d055329a2f94e4525c59d16a6a02783174843d37e94f78f30d7630545fcbccc179bd77f331d40055b8d0d7d04d43dfc4823b1d82a4cc0b4f87bd0b6ed4cad130ec6274b70f42d6e4d1458c309ff4b8ae005173d1ae80d326c588c953d07db8c4f82de610259c7cc5bbe0cd67d9d678096c192fad20da8fcac9871eb0621a547803e1941332310766624accc68d049959c3bfd41514041e69765ffe5a34f641329d1a27273e7531fd488184a7ee085ed6ee23a7151f77b65bf8dec21763c8947e20cf5caeacd4910e3b874f9c2a5238e3b1457080cc5cc86512ac4c4509e74a0e528019afb5cd242f9d23e672ad159d0e37c8e206dd46d9b6bb90fd3c2a1ca544840cad34ab6299bac4daf102a5cb7ea6ddc1888ac4c596d749e82b1437cb27a19325a5b2a614744575e301f4db3a37f34c68ce48e634a14b699356ba101768d8ff0d36d660d5a4dfdb868ac5892fcfe66c3bf24c02a3a55b35916d8d1ff915dcb7a0700acb8693b46b6b58cc17dcd071e17b29cf3d9e49ca2d23a52d9a6cfcac37cbaf6d86d4922340d70225fac9000f4f22c66999dc271270aaa4890a33c0e88a510d4b0bcc2635ff17a9d12c6bf5be1829e20c18f906a9b8ae24e65045b84a6b9d2f991fd2c283e8581fd32d0ad5233eb94aeda2a7806b8d2f00a4c326c12cb90bbc6d2bb56f3bb59c597ddab949d07c3a4d9ae3bb2d123500be77b11b90332222786d64a61b9d999f7ee7c4f6b0e854d61c37180e94f2a9f4cb8be8d82754b4ccc0f7cc05d1f818f86be35714a2891e9d99660cc3a9a0d23558d27ac6b3ca1c765e08b294001af5570ca11d796b42d2b27dd225df98a490be4b43877516d0635a3a9614d45085e2d6ae20d1a69b06f1887f2c85b1bdc498566c521d7e256bb1c04e64fa504f4b888c7c77350298b1ee735b63004500a2c84fbb6e08a4f6201f3e14943e6c13a1ed780f5d651d2a039e19c8af9ae5c00ae487bc7526684d6c67f2c1b48e58bd8f9c22b2c782ca587793462e666401e15ba38d85a1b9bcadb5363c3b0e276d0442783b50d59c66763e964ad6a1b6cc2e630aba934565498fa4861800a7936885a43e535a52145f3289ea26014d761db62105b4e4a89a1f13c4d56bf582553a2be012de50f5815b3a12fed22b1429e1559309131833789b760137b348a824f105f52748278c5ad5c20f3718758b4e26d3ed15d2b70ce4f99d1a7966fbecce71104a7c8a2632c8081c31ef2914be66882c89104d1b88508e3aa9d1828aca4f994f700a9d6c8d82224ef82357124f1f44d3c1d13c746d78c292453bbab7639678a3236bc75b8025245a75b071f5c323e2ae8d6648b45280e5872aa8c670af0941c295735bfd64e706b903416264a4ae19b5278f9a2ca2403376a150c2b27956857473ca8af4d7377f7abbfbdabed84a32b0d5a7565755a2204193fa02933a1d31663aade2304bcd0c5849f552f5aea82d87e4ba34b2ad08bcc5d92cbcc527142c68f1e59b7a148ec8826c63b8c3d5775b374a402f4ca9a6c6dd61c775c67dc5905cc9fa457bff6f4e5c5bab66ee3bceb80631220d981172a7b9916915fb0a45f3e295a346350f756207414275c0a5e8eca53062eacc7b06ba8605cc40361851601ed60c725b3b32e872b4c77b368cb23c95f6ff155b5cc911df7205872caa08ab63ab4c3f24611e833102bd0f594ab9613ba35b7f52a069a84dc53e131d93511dc9a1a8d5d494906739bf60c4c2193b435cb3303c8f0ddf7096156c23a2eb1b518ec48d0c07f6db4ae5228a7792ea9673be3287830069f4f50b966134642bb41d3f0167a549687543db571e172fb115d92849a9f80742642e80f0a8ce372eeb6142e4ee8dd53c3de0ab6cfd2b02ac4cba38bb5923f513bc7ab75c285978b3e1441ac30cb4da49462976247910bef3859df20f29d4e689640825700f6dd3d415ab88603178bb3298914f94b8b8a497567454a8a49772f0d8a1db784352908d3fc1badff46396edecc9b27f3360a9b1c2b19e9465a4f6fc8d8580d70d65b28f46aa61c4fbfc20ef6c92e9343b5a20aab221db1e4018cd5237223a45b38c1aad43a416e9eb53e583697887d2dca4c0bae45df5f652a8f95b04cf131f8c5bb74059341d81891663a1e3a5eadfcfde8870a7b1bf0e90391a78da300488c16cc62e44687d67a27bbac7a98e2ae8729f271a94cca58dade8d536ecf8c812eb5a64eac5aed12ca862f4f9f53cba78546c7e36ed1a86592f1ca7dd55c81d28e8eb3080bdc45588d2d3250250f70bc3af83c8a06e84a478653e014a5c52fa1295440d7850625c9468e1d11631c972d39e132f6e38564f787e45769ecafd22399e05a2c59e130095dd1976257ad18f8b869579e689438eed0fac12842cf861f7752e439f4dc66bd20b0b5eb52a9083a5a131406a1680c327bef68e1971214f67cf9b87db492eb23aab8df7d1eb370e1c26b5b5ccaed30581a99c48c4f61b4b0da2366a35286ce590daae45eb6c3b117e25357de38d492fc9043fbd3cc6e16d45b0d1b6ff0a8309e79579328ba77d1d85d9338face665e447968b486aee378c129dabf54abc8b05d542b6d5e19ba72c19a9b69d008bfca49da893c479a58b9bb8b06143e2506d8013321a2067283b092200577759e78c6ab2ba6ad9be244316aab95e08e19656df677eda202f2d329d0fd051133d0c5728b2b9e9e355bd1216d70f601f4bcabfc9901d6a79a50ed7c79ae0e1f29eb79a64abbf25658968975f786b0cc64452f4fefc05a40359280f0e676923cdc7627820d4b975ba774998ee31adef4ce930647df2b2b25c05db54c07d03e70d1921988465b29d16c95f4d8b2afcf6a89e092224876350213a4a2ad8eab1cf1718b39b40a5c660900d1aa2f4c0d099027e8b129f3d96c696d24ca83a6d4d41d0b62b84bef304530d14f3a506f8515066c4d979e1ebb783adc9ef6c2a92afa7965124c3bd4e610c4557fe110ee0620ee4a3eadd291afc85ccda7ab2767747387281e977ad76a2733f7012f0f893c5b65cc9315ee183e3518f32e5be64655711bc62e6e4fe20cde47c6e948b9eb2d6b9adb71b1754f44a1320cfeb8708d043bd440a5a92e9e20a6dbf2a0564612e95d53835c45999ce058752279081e648cf2094c8af217c64109dec5dda0e39a7faf9f265924dbe58475243746f15a9dea4e75e394fa225cc9ad3aca78cb9ac9f16f236ae560b6c689a95c2b3afa72668cdde8470cf39071573366eeafca4c999f89f3fdc0fab3bb513602923d444e948606d1d5b6dad5906e450bd6e3a62d9595fc71b370a33499063f629569d0c76e552bad4707afbc81f6486503664932e2d5b4b5a751561f5a1ebba881115bba4c6696d9b7751cd3b968d21fad38b6e55d22ee165f7b3a78e1e9631e1b1612555e90d10527936e74712bbf02d7dd20eaa58b21c4d88d16253ca57bf6950471b7e06a7dc85119ac6c839d628685be3c8965e19ee03a153c1f352673967443e06e9a71b384d986ab657c58e67b1bdc1d0e63360ae96e55a0a407f7b0ae450ca32456b0dbd2c18a1ad2a686b3da20d202f5e8b682c11c09359b39c528fca73ca80969b74b3a5065d981e7839e6e17edb2af9490a9120d796517b708c663fddb3a783c407816184070cbe082982999b55332f371cdafa50b6b72cbd9829b9e9794f666b0b50e86a96fa8da5f704010bc76af1eec7aaeaf222f5aa9fc30a8431c8cc447a2fd5f024a38b92956a7718e28b1068c8f48e32d3016f013288ca9282d1e4e65b2e4cc2993012ef1309970c7f1ca0c1ab472ab63b216ecf20963f59b82ae7e0004adeac590ae5e54b90bc33f9638898ae55403a56af521ad595774caefc9620483f4693df3fc542f186176ed83acd642038e2e21eff97399bbf9582043113ac32270064d216b684cf3bf01c0aaa8e62c5a6e839ec59872bc72f7372c5d9bb939b7fa16b0b8a046473851f2c4f2bbf6a7e55042a4d7a6fe1fb5c5cfd0322d4cbd05519dc91a63176a3066dd8763bc3c3c6bd59b34d70fac1d5d2031ea35cb4bc9d0728a48d2e181388ee35f020000ffffe8abd15470350000');
-INSERT INTO public.lsif_data_result_chunks VALUES (39162, 0, '\x1f8b08000000000000ff6c7bcff226c7b155f7748f653be420b071182260cd0aabf27f261b1668817784b70e2f14d6202b2ccd28a451008f002f8031e63ee07d08cf8dcaaaccfed9be9b899afebeafbbba2af3e43927ebf75f3efc9feb3cffc5afdf7cf7fd57effff3efbf7ffb874f3f7bffd9f9e18fc7f9eafcc9a7ef7ef7fdd76fdebeffaf9fbdfffd77e787ff7d9c3fad4bbffaf4d79fbdfde2cdaf3efdeefcf00fc771fcfb0fffeb3ecf5f7cfdd937bff9eabb2fffdb77efdf7dfbe697bffaf4b7dfbdfff6cbb75fe46f3f3e3f3e8efff4e1ffdfe7f9cbbffbde6f7efbfcf7ef9e311f717e7c7ef8f371fce4c3ff7b75befaf0e7e3fcf0a7e3f88f1ffeef759efff29ff9c19fe60bfcf8f9e0fcf8383faa4f3f3e8ee32ff2870f7f3c3f3af55f7ff9f6fd9b6fdf7ef6d5275fbefdfccdfff8e4f76fbefae6cdb7bffce2ddc9ffea9b6fdfbd7ff7bb775f7d528379d9fecddffc22ff5d3f919ff64ffefbb75fbe5f17fd177ff383f7fff39b37dfcd4fe879f817efbe7ef7f9275fbffbfcfbafdecc8fe267bffbfaf34fe6a2fc872fde7df2f5675fbe9d57ff72fee36b50b338cfd3ce8b218edbd1e03ccf38e7681caf8175c4759e72de4c3c8e1cf970dc2314995f8aa13f9f37b9c1707ec9ced70043620f87720d09477d011db9aec2c01cde618a7503b01a226a7d1545bc7ec5d64f88d1c381d13f1bd6b3e92704c91eb9f75c46484f6b3fe036ecb9100f3a6e2391f33cf99c233e5e83b8e2ab7501118e357280f91198beda6bc2502f4776bc0645f1b5a60a646b25cd87ec359d6bf11a2868ac6731c4386ec57ab6a2d0da197895bfc061baef4216f3a3e1f4ea3cf5bcc574f412b1ce79a9f27d9e74de20385f94cedb45658dcc724fe648d5e7d787d99df1a1d8bb41d237b551eb3640e7fd9559d6b49470bf5200e67b8bec1943d4db06c1fc0814ecdc3ba5a0c72dec2b2ee7c88e3b70d80accc0a1c78de87ce62b223a1eb706efefeb7ccf8b18565813c57a74acdde0f00e0fb7b989aae0aff7674f9cdad00ed9a821847640d998f921117b2f01a3f69272c3e75e92783ec1ed87f904c4e8fcf00ece0152e13d90c613734ff4fa8bef5620cf1bbc06f2a0158314222b06ddd48f9b59e622ccdc65ceb810c61fe5a245c0dc7bcdc7e4af7248b1867704ea0ee795857364667b24a31e1450a0804ac705e2e70c9f0b646ea2baad4d1275c97c89190171dec0798b041a9c0b09005e11002b26c23b26c2676c0101d6155a59e6466bef301a2d0047e7adeedc9b571b3886e898211239b98bd88e9b06ae88b969c0da34f11dc806b4a3157d07b2ec88f1078d463446e9e82141a74d78e62d67c4f079a3d8c60c57aa4596a0e3660cda3b8701c70d64b2a21fc8e8b8c1622dcd1cc97153a89f3b06320b99dc57c4414074c8f6620c72e9abd461c60fe27ae7381177c4d14c1846db2b431c5d0978ee071aaeb4cce17c131f3b4fd9071e37d1d8b046187ecc3bf0f9dceb35306438af49c65e77359ef722ac5521cca71548e430372d5433c05ee5c4265c406489a290bd20da394cfae27dbd8b1152a38076ba0ede487a1b8d2a202678dc6c1efb156d85870edbeb330ae72c126bc871ec649df8ba9335414274e80f36e2755da30719009e6a244f111ddadbcb0ec7ada07b9114742e1251a71191eb711352050bd20c1654d35a46b5b95a88326a1b31a10387f477c62afa46f32de97c95109065647da0811f2dec7deaf378700fac5386faed889dfaaabf28f67ccc22b6272ca67a5c1eb012d77da19aef4a8bd45b8b2c368b2673154dc65c64c72b7f6b421bf52092d8e8aa08b915489bf4202215a817bc07d07c4d05801fecb221fd58e7069905a10bbeb5120f27b6d55a67642a0a5f6bdfa9d700b5ef83b0661eb8310787bda821f58bf1b0a981a03de40cbb40ba0a85041b0470f30695892ace51e48f9372a8ef5c55353eae085feb1e9161c3a015240c09ef61737513a672b566fe31f01e494692627092938b016add55c7710b8d09ef3e57228b2b0bd8cf7202131656b62878e78d6ed0e4919b39af41ae42c6b6ef6a153036cc3267714dfe0645d706efbbb8c4fe054b1430d3d07a861744c7d83330c4a83a88fbceb954f5dcf9be2249c62643e0c233d358e4ca57cdbb3962228798e8820724de0f8e2170dc24b936f3c566f25fc12ba9af60396e24a773ffce67d2eb18f07ab3bc0caae476c96ee608252a65eb9aaf6412d79d4c02d894987376ea584ca317d701b2de80ef89b3569eb8d8ca131fbbe6cfb9ed5098550e29b8661d134823df4293a4cdb7006b7c074b7c67c9cccc7d0baee259b1613192d00dc19d992f6a32f00bace9e2c79d0d68a3532e9280b058acfbc0681a0da89de10a9d72140f73ec8a4f0366f156ddd4344bcf2d209b690870cc9208b1b776916121b46b577779785fc3ff40cab5709a38ab737ad16c4ebdc00c54ea2a2cd2a8799f514312ae2f0c764c62e3633d9901bc09c2032753afdc2250dc5cc08edb6c2cd6374796c860a3ebb2665821eb06397b813ffd0c52ccaf31c88eb04e465f42048d8bfbe1e43137b3ed9867b659f8480aeb49f0b83016f7bc703124b1f361c97313ea1d4072b30376702b8dca6ae531eba5f9a628683a61311f6c99eb4bea39ed9c51a0475146167cda9b8b3e3253822271fc5a747a95b946f431b0d5e9b3e113f16f33d9b7b2c518c0903a3d108f0b626cf6bd92c59d7e980142ab0025d48e7cec8a951601b878c61201d6571971eb014f8999b134e73d7754fda31da42d59c0ba820de44735350f407851d7fc5158b3cc831603874d05dd771e30f2b3402f9625d385c265018caf809aa88736ef18be8903844b8acacabc49b16e129302554b6e3e92b92de2be6d845b06c614a0a42540b3fe8b295d9bd94ae79ce05ae8dbd0e9b8c569d35b71c239835133988871b3e29e212bea71316d58e7f58ce0359f9b56894b572220e388b594cf4b12d08b0e2ff840cb11c0444851d6cae0585017eb41975adf4c408e4b50d68c64d279f0210ba2937aa4fee545a896fe4a3089e4a4399cb79bf579af7356ead7a026b6f3dcab5c3a59fa13ee7e3d51d010d48417cd8fdb253135abcc94bae463b35ef201c7c5b6939fb306096011bac5fbe60ff5f12074b21f0fd9b7f4e063d6a84deb43a65209d5bd7531d9caac12dc2817b4e02be0a35d78dafc22116d76480fb3a5c798d92cf80e0bab51c6abee2a3f47095a005b4f4f6e71458c4dc8dc8f4b6def54ae39a995d05a003a796e0bec98bb3077b8ae0c19c7ac237856459984ccd52a7a359f1f0d9a8baf0cc47bd31e96a23d4c45b2908ac32c1111a357cc238e8bbc5c9a958e40958eab7062174eccbad38f9f513b037fbdf14a8199beb8e30c67edbbc96a4bc926e110e6ceffdc3046861587602aadf1934111f2f54c66f30b28ee3959c00dce9d0d53a006296f8c20c59c70ed9778a4f26af18b7edc38320dd3c24b9ececc74ed22c4f550d4325b466ee4584e85ae6a91d6a06aa91ccddbc8d07bcf98777a716f9071b1d698f27492b7812db9bd2437a40b0203db721929ba09a1a28610d2031bccf72eee8ff1e1840f2a8d4771eb715b7b2d3620a6c06b38b2bd0e32f6e2dba818828a260f5f1528b574ce35df28939a462a70c26dbe8e876e0cf72932368f98a31419c3b6879bf46bbbb12ac755e5f53284e39562d6d65753a94c2dbe2313161680b61682992a2a09fc3eb5d5047e64df7b84eccb7574fad162110ed285b01d1a945d29efb0fdf96dd4f8315c1e9cd6476876fd4dea78a38cca081974dc0a6373088561597fc6b65434f75b22f6a232119677e153756fc27479f225f49d7c399cc55670add01c4dda34b6ef30472904868dbb32aa75935798ab5721305a926294a458e26f79e3e9310fde700e5cf1ea8b76e7e62db3537307e68e2dad454526e2a1ed118fa90d7cdc6cb123916d160174d8920497adafcabb726d936f25d972aea0c8030cdb963f8cf5322cfcf3e2941b4352f4946ad52a82f5a93d0eacd7f762942e356eb8c53611a914aaa3d6f76c94e27569b3b174b30f2c4deb8509c69566665688210f90779b436cc64f704bb649cad563ff5f7d5630089062609035c74576a280670f8558ab2810cb52cc0abbe784deceab96a7ce00c7655ab9a970dc8ea98ed34d1e8b92a7a7afe725545c04979db83d6fdbc4d6f81149696228e942b38bb1aab1a23d26ee632827615107dcdd9ca787c3a32276caefdb97564967c56661c05d8be728bb17b45d520d4ab7c7796c00f3a7ef2289ee2abc98f76b18f670687aec6d7e08a15a4f57091eab7a4645829364540395f881b652c6eae740c8b5d3b8050bf8c3c79d62966ad35d09358d4eb46ee8e0d48fb72f3d965bb4ec2319ed31727261a8aac0abf628a4cd9e08106dfa5805b454850ca09af34ce274429beaf032dd41c7ee88293ecc2cab67b4611a3396d879ef0d7be63586b4cb1ccbaa35e3bde514a35b6f50f893dc82421b0e02f767cc592e94aa67f3c242b2580261b34ee1b14c97a22824859531269b70930db86e328e0ba4d41f4fee235ac6bae87c0d406b3305d3e61c34aae532685603702bbd34f5de2d081da6b04c48b7b58c60a36424cad214a36a224e3099af8ae75fbfb4fa0eb215b60b63623936e53a613b2d48d95260c1e2020c65e90d2890729a7b4625b3b26add00d8f539bb4b62b98a75a1ebd62234de00846b3a415b76313e6d408ac5f1cd967e7fdc1f326ef37af5621334da701a129a3ecf6ec711a533c88edb41567daa793c0eb275611ff1c805a1f6e534ed71974d2d64212a1bc04230b11729ffd82e4dd206274d76d8ce0939ccd523ea6e13a5c813846b33a1c7e66a7e47983a1c89bb45402957163dce8971ca3ef759b71789daae6302f1ea37f324195b10ec1a8ea16928bfca5675b673b222a4b11c70dc5122ec8ea9c1a63069736d7507806217ec6e5619ea9cf0b0def8612940dcf47c64e97cbeda9a70ee6a4e186a24b894b38eea3eec3dba4353a24b30dd9d91fb47501eacc9d3734ffa30605cbb12d15394066de4d3e576a881fd785fa87eac477300e4160bd84c02b83f2db0197dcdb4688c74fb3859ba44b58f25d66e787644d312d127b0309522526d75aa04f528560df5064689c5e45c563624276033fa772b5ebbe34da36d56b4a79dfb7476c1a12bdbe0c780e2e77448f7cc119f3e933c7ae0e90d93b4f74bd8fd52227c9abbfab407b79ebf0d4d1fb6fd1c3a69bd0f0fb3c727ad89ec0106840743fa2445e823087abac0d1e557e5b1a345a78ededc9c7cea641b2da72633e751365836315e038874af5d96fb89c61bd859a91cac58073ad0ee7d9804cbe7a2f144a83d2ea267f96527cdacb9113bc8553b6f64b976f9c4b51114c7734ee156d23aea424aab9b1165b03650c2aa2a140eaf6a614bb168f8aa38aed7a3af77f7ae9bf943e9980a627b049b2ac8605f326556bb3ef05023924a21582ebc18bc7a1870797f927de1e233abca0d337af00617b98a05aca86d1cb3951db40f0c10ea6ea0a057577be6eae551922cdd18022800a6c56551098b634dfaf71a44a12ab8539dfb00d72d52f6c90496060f86261de9baaf231859696126fec04df8654a838b4645e2241468be5d7734e7a98aab22a8a4612254a77a8474ca2d92171c62d6ed51bb0b0b06fcbc2876ffb9396b831ea42141ce5a9ca9f44fec0323347639e0f1e052960389b29bd8334d0699353fb22981a1d61bec98f85b5a7ad85a7ff2bdda0479d20b4db959f00a65a8136324d8f0bd0ec78cb1894e7ac17bf93b0423cf5e1069bb2fa4495f8b306a8cd5c84837275dc1275d699db1197da26629e91156726c844daca034a8394f9e4c9229a3948be4b934c1b01fac90f5362bbbeb31c6cb337b4fdba8d1136cf23561eed35dbcbc1c92da967e774b238e517437951976edf7b5ad19379abd9f418518038e1b91aa1f8ab4048ed196949aed0dc9656fd76811d465d6cf27c1e67a1d62d85ad847cc48a82308631f41c0e580113cb617fb8b8662771c5d7dd64bc0aa97338dc2ad688cebd453b0c5d1eaeddd22dd3394099193166c823b66e24c4952e6cb72bc65ec9e14c6532835f9a142d15b79d9cc1c236bfe8e6909b1e34258bb7f21a4ba2728758fe9677a752e6730dc34b82d9f490089ac5c79ca4e19732afb4c99879e48d911144babed331ad918abb6d33059c35ba6dcbbdd6894fac1046265b8f61268914a23edd164ba606508439ae45cf8943de1594d78571a43ada2b40e1b91ec633e39f474e34baaad036b3af25c5402355bf355386e52dfed0ad2b950a66944675de4ad8daaf13abc58a4e1d34ccb6c95a84623fb739e26e6c64589d439ca7ea93af5a11e5f274a3dea10086dba7247ecc2b3d4ee1d117d9f3ca3c094272753470a970dc77904b30ed720ce9f2a631d1761f09994dc49c92b09a1a838763d0dc31948bb6ccc911ed73a0b3889739e2e18ae255d872f370f98aab26b9d405c81b45ce0e5ccf896ced8d436882441b3ead0ea1c0075c71a6826a0a0b4f4159c958c772b042751bb6233a82b4433310a843d3be43aba71b32c761b7cf5c1c83a7ad1d267d26d2e4b6b8eb25409f0f51c025cca657900cbe59455cfa4de31da05495d3bd0cba11d38abaf5050371f270aaf33b53380714cc2e2ce7f550081fa0eb3a6ce92a7b5c563b5a0d1b54d85e5f25a9dc6e287c60e9f0141510593c29696d1f1f24491423612aadfb463adf6d7961f97c76a69f5e547f5e5d58f0b65f74e27e9bc698cbd617908ef66a80d6590acee0695cfc3d26fe2c1bba32b424d33e339b025b40edcd20e2b5907bf961945c7cdcb774a22eb336888ca12c9198bf4a18349826f13d8f063029aa83e0ad571665094aad28048c601b5fc33480debf33c7e7e8956af36b9065a3b3e68e9f810b2b7d591859729ea65cc9af365eb60318d19f1132d2e80b14a018cec86ee26d41c25b7a8966b0e759d10e06d72f5f9aca07478d5d17eb49192aaa63397b217b0aeee758dea40a743936056e94fb98f9bf97129f26e85e24417afb62039d2e4ac9569a963a70882f60b05e8b8314a14ec43bb28d4682ae9d0a28637b50e4b0c2b7b67b1070ec71fae59c46324370fa0f11cf2ec9e3b68c063ddf6d91f7a71f67e6c54967f5b60f1e2cf1dfa96dc27d907771921e85b123face039b084f61c807f880cf28b3f8ef0c78d7ece39d193e9dc8ef4186d388f3a87758745cf461ef2212fbce9e78f32181eb1f7fcad08f3e395f5c97cd247c1133e5d767b4e31cdb045a17d96076535664c620333b727455ca3348095ac580b776fdbdbc2945831c4cdad65fda5c5a8d4022e9c88894d578c3abc97c541a34bac79c1de48b6ad58ad10f1f1b4e3bc3ce74926509bec4e8d37b9aa9d0f6bbd11f6d1dd395aaded81fb04faa06a3cf5a1461f0b8b0779a11c769f6a755711ab8e5bb5837c46d9643750870e2c8e1b99bbebc97cdce0d55e038f348d251bbdb91f5a9dbcb03a26193c158ec9de333591e3f8a7000000ffffd1ab593468360000');
-INSERT INTO public.lsif_data_result_chunks VALUES (39163, 3, '\x1f8b08000000000000ff6c7bcbb226c7715ef7e91e8374807698b683f6da0baf44545eab521b2d8485b85370cbe002418c408480190430084a8f20bd80288ad2a3721495b71e91da9ca8d3f7aacacbf77d99ff5fbdffa7eb3cfffbcf5f7ff7fd57effef2d7dfbff9db4f3f7bf7d9f9feb7c7f972fee8d3b7bffafeebd76fdefdf567ef7efdddf9fe1f8ff3c775e8679ffefcb3375fbcfed9a7df9defffed388efffffe1feef3fcc9d79f7df38bafbefbf26fbe7bf7f6dbd73ffdd9a7bffceeddb75fbef9c2effdf8fcf838fee2fdbfdee7f9d33fb9ee17bf7cfefd9377ec579c1f9fef7f7f1c3f7aff2f2fe7cbfbdf1fe7fbdf1dc79fbfffe7eb3cffc77f72c3eff604feeb73e2fcf8383faab31f1fc7f187f99bf7bf3d3f3af5ff7cf9e6ddeb6fdf7cf6d5275fbef9fcf5df7df2ebd75f7df3fadb9f7ef1f6a4e7d4176fbf7efbf9275fbffdfcfbaf5eef53eb277f74d7bbbfffe6f577fbccfcbf7f74c6ffc603e5c7df7cfbf6dddb5fbdfdea93df7cfbe5bb38c8ffab0fd6601fb6fff9abaf3fff642fca9f7df1f693af3ffbf2cd3efa8797fff60a886c9ee7394f1feaf10a782cbdce93cf1b27c1719e72de4bb046ca7cdccc2beeda23395e01daa27c0cdac2e315c85cebf24b04271ef90a199c4310dc6f53c4719fa79d374d92c347863272c40b72242ac76d22e3ccffd9f6ed0be4f6b728af78f46daa562f011a391c3a697f161bbff2cb886ce539a45597ed711d1d0a358449c73d9172d61369cf9a15f5c5978506ac58a04973ec53ccf851dd2c35691af8c13b31bf770eed45917a254cd947d75e3fa0e36664c92547a6e35622387d9b94702f05f2ec2de0e95b40ba3f6f5f3265bf8ccf7b2dddcbc053254ff194383597c971c98cd75c32e97891759e279d2fa2c72dcbf20b64191d378cb1cfcab9477b2fc59463cb41a8b660ac05cf94f9b889dc54f6a2d102ff4ca217bf0d4da8b70bd8adc8e007e7a9e7ad087b8f74afa2ce5943d218deb64472a4be6f7e0c392f04f1458edb75ee2590c93fcccbb4cf4da83b6848df6cee087cde843273219928d78d78d4b141e92613c0dfb1e47fc729a947282ae78d4c3532ceb336301f6be05fec7bd6d74db63a0b399a8a395a93732b97ac5967a14775efe2fa96193ec0eed73d9a3dc551d7f1e82fc5bd786a334d0bfbd49a328e7b0ec6b0cb39c8ed1249ca2e917c5345396ee6d14b07bcdd86e6c00a4773ec2524b45547d0a6db8462d8cb04c4b62d58c78d4c7ce667a11d372c1e69a28be6718b19a7099ba1fb01acf81459b514d3dc9fe61810a7268c5ab985ebb841ea292086c7cdc4f93f13fb53897608b5f346f5cdde018be6aad170fb836157d8170e28831e2616c6cbc303d698c239ff31651fa1a11db287bae3216046c127c062ecb2df3854eaa88d79dc540fdd233c6eb599914d4d7c7f59323e109401adedd4372c458f09376c8f7b05bca6e5ecc5c6710b6aaeb9a0ec3d018c2dbd11f61ec9827dbdee251f3b90c59e790827771a538aa82a11fe3ded18c49aedcfb2f35ec322c2dc8ccb03fbc0dc2eb5d14e62f3d8f1353fd0e658c7b69a99160a281e8046da23ac555ec5e64f05c9d8b487b59cec0104068cdc0718c37cdfd7f47dbf60f5b6d3ac0c0676dc24332d944477269d155479c61b27c84beea53ba23f9f717b8712e095c95233592e7483b1f39ee2215365cc5a8899febb88f0b8172a9d7993eeff6161fe0f109198c7155b635c2111d6acc03ac53cf1e472ea9edb4de289c967b41313d3e0d85fa6114800ece531ae5c43245fc3b9c699ef99d3c3c5a24e638bc29bc6de83fd55f804711ebadf5d5b4bb2ed7a61adeec2f01e6128ef111ec776483b1fd7dcd6865449927bb966baf1cee17b3b3d7d3a20c11c2d18b5b12cabb7b8208cf4b6b3ed6d67a1fc5096bd95288ea0dcfb656d2746c5322754b0c81e9641d6a0b2c27090a6021bc6ecbc6828b926b83c7dc6f2e0b3520e4d7cb8b7f9a6c59a09786d30273c5606455f548df8b2ce3d92082f0173aee9706afb232e3d6eb491ae8b36706f07e5d693c4d62d69579e9d1aa7477120c898b57424123268e446a3a0004a2037147c49bbeac98db53d5c5761235ddb0427ac348b096b1e974aa4a44b3990d2e2464acbb1d4d2c252cb339451ae3cd9287844ba1cb0025df5610fb00b34b7ce1b71ade3e625239d60f13a6e32cc004b061b4b095a0649c1b99dc6d28998cc67209545c1ed454601369854580ec316ac721802569ade18785f9aeee0376d54ba30b03d806ae7f6cac118931f9841d6936a060cd8c16a642edea388fdba1200c7263a57604798889899554df3d4daf1971073b509118e9b1534d72a401f0fd6974af93e59396fdc58ee96a9e94732158e2b78089fd746ff7bb7a1f67dda5e55e9559548a61db2c71c3b98f15cf16ee519d8648af3838b753e9ca06106a3146ae73e681e2e081dd8baa3a173a061aba0cfb0fd81407e60e31172c333c7ec15ab37e8b15978a4609881ee4b3503c61e05f9f0acb5c3e2b046aaa86ee134b9a20bb9f730c1f861b8aa26ddb86d3b6da3f446088b8b8ee007735cb3d184ace673f8f09925e6e02423b20f77fa175e09c984f57881e5e1f7657b01af9996c0cb579fa8b12211f1715b80892085b6fdc6f269226eac8caa5762af35db1b470f459cd44e592f85bed2a185c93d8b572cf91526ecf11ffd6bd64c5c80837b33b6c90295c9026dc700a09c08c0fe6cd6c234acee78c2362284c63a7a1cdc06b8cc83319d7bb4b7554123f45c64da885c8e9b54326490cadcb86a6488c1e130873cd5f914034b9bd855343a3f1f987a22b0ed42552a304566111208970674dae17e0b78dc3256e589b1a33f004a4d1a3d422f4d08465a8c65a1f171af012b67b9a1ee9e6581141129e241ecf805e6ded1edf2e2ce26ce0e320ccc2972dc48986611b89f446b16ae13c07244ee20d511392a946fa2eec92cc3faa2cd6836851d1149ccd671b14620b9766ebf852c21acd086b8b01cd23aad7060c6ea62879b69fac9cdd21e4363c32f85ba4b0134ec6b7d1c8bb05d3cf77a3587c31acdc27173692d16cc871d5624b75567798d667d05dfcd66db93c71ae6763676554791ca6ab7954f1d89e4a798ab2d43322d322b345b02270c26e987229dc4ed892a26cb9338ce0404b85a31d1504c3cf4b9bc20ab81efde2cc0599616b04e0664dee139ca32d871051acf56a2583726642bd4cb9ec117cd04122ad0c47ffb2d1815b9311a9b27b9893be0463a2e434e23414fa7634251b805568fc2d21f04d70e1688a59320eedc1f40cf3323ac609ae9f2ab641adb01e7a234988b701cb74e2a943389b6ffd928ff5bfb39c04d15813ddf299677f38efa7bb546fdbffd124de47ca4971b56e523d871ea36d33c6fa67c5c08811c2f0c7546d9ae00861e607c1730718041b03974b66e1e14b0053edc01ac300f82639e51f39b63bba4a2e4c72af28cd7416c9c6ef8d10ad09e290e9f49b06971632e5ac2eab444a8fe4f6c0a56286300a62df2c2a0a0cefd1d66404b832bc2aa626738458f0e54719168a362ad64804aaedcad56ee9caa0e6ca56ee0c4e366b0da26705c253cb1d01eb7cce46b3e984b2119ccceda0c47049f8999026fdd54bcc8bf332d8944e3ef58e128b0da5160afb751831102c7a8501875535761b1e20912fb21997b113e1050a5e0c59aee6e638cff92dbdac22c2935131cadc30e6a26b09eef5fbab74b67f2b48b2ab619843f4b6951303a837b1a46d4b23027bca46b540a8d6f5b56c9164bfdf1e44a6368a5c51182a9f1e59101a7836ccf1c0e1169c7475f52d6e2ee8e4e73413c23cd60c97eed8eb5468295ad65078959e6ea3bfee2ae4de7cb261373480916232417147355f4d2d9dc4e68354d2fe780d0d443581a45e337d6ca834c25aaa2c0ce6ccd05818704d15c918d09247d7c11a5bbd808cc6298cb68b3a417f43c2f63cd8fc2ea7624afe0d41a3b41ab2a1b91d7d1490d41e9433d9e8e1b03603a28731d4d169744c3d899877d7f592c4d8355679b868b404a45b0747b11c268420e2e67334ac219670f496e0235514522640fd999423c8f8d86f03a9b13a91c175ad250dc2082185749025e0a61128e682a9dd3082a9a0e8a344ba985e8a35483d9267bac5a5ba79b2ae1480a2938f68781626546d048446495883c46e890203ff71c4d02603c3a223ce51bc5e6e7ea9f06d6d22d984bb73ae788092926039cb646013955176c73119077ec9cc31fa2fb0bc6385e6680e39719bc10e6551c441fb03fc3426e5a1e21c1a6f5878419404ab9170da8d7231e170ec91d193b0189db84fba8eb63e80be6c8217688955f253b2ba97d72c3aad995145d8db21dba034bea22c0745c9ab677a906e99b859b49a0a5cbbda7a157efa860b6a9f282e2151b926fb08ca5ad0dacb83bbd548646c59c5cea066d6606ba9999606504c11d92108a97bb8eb0314d8b73b8369fc69689d1f6bcc066999ccdfd8441195f7144758f5692099ca37c813c9e9320165b968090210c3f32ba0253c49e092d21a814299918c47651e20be4965ac75e7591d2b9c411018f82b7ecf6c98a594870d9e8acc2131e17242bbb2038c93208c75083871d637bc368b50a26b66e009a41085fe57b1fba3c816abb1e6139e4e740e41bf2e1e00e760e8190a8b784d08e4ba8ca751b6a9062a52dc5a0c773fea02792b6fd41feb23a6690a3e5b1c7471e7bfcac1b769cdd941e29df82e8218f44bb6a236ed2cc24213471d4f4820a568edd29fc0693c2afc66bff5f0a87f3869b66a94d349d9a0e9885580638d8d795055847c8691616e2bcad1296871f515d81f7026425aadd6c9296657ea565dbac716901761719909a2961905451afb8ec4b409ac7148a5ce2a53627aee25853adf0e4682d690cb6edd24e355d298c72494372700c0fb1c551c4f4fa19f0ec48cb138e6ba6c4724d765e09dc253308e4bc80ef478e4c03c2f90cb9881080baa0011578603330e60e3c1c814764e48ca01132e2e6b93cca3c3892166b15933c8a5531c9899c41a9ef81f89cbc9a9719749426ebeb42038bf27b3a53719972d3695b54ec031c805b12075457dd14539606c54dc455ab74ae2e5a29950ac8160706d80f93b44c2e0914bb08309fe20bd531a23a46d6d7751180fbac2338d52921c7297d007bf0e11a1f40a4d611365d48c37df03dcec90f94eeacbd51e42624a3a8092e4f35d4e4659243605841bb2f466d74bec99f40a16797273684ecc2e330d5e392917450bcd0a334123e7008c7de8cd1138167a6230a2a11306c4521965f120dada7ec455e1911e9ca48b47d084c78f5e86f0966a3ef23ca02d0e5ab69dd2cc0a1700de1aaf86897dd5d12e135f0a5788cd71ed76600be65c4d2418e37649f119f76588c98c36360e393d53b819926aea86320503d079322ab17c89cd73674c78d816f595e61112f96fa9e506a4802a15e4e0ed078ef8d6cd45821293445b2543cfc6b522da2698e0a97566c83e51531e19569970c1b886aa55dcdbe9d11c8fa9a2d4945014f407327955b861de63b2973cefff7408eca7a4ff284f564d7d16951acbb499ea3808fde0b5ddf26e887116bdb1b8eb6c2f29cdbe6e343068d7117351490a791c8e469dc79c8095b4b7cf2a064c527c4afe7c39ea93da505847ef10689cf6d3d0995074eb477d37cae257c5a6d8c3a3c3cd064b62592cb44c48f44cfc355b9359242d1036f88c88e4b136f5c2e75a06ab25854d7d505306b4f3aba4f40830711a7d4c7c31af48716c1a9fe5ed8cae686c2371a14613770b2c75080d29b49081a9292977874ce84d63a25ab61e3a535ba544d67cc522d622e61ef0561778811f3b3dd6d3aa4526061f5be82ce0fecb4311b79d976bfa83c4aa25443a9e9dfb0aafab0d42544c1b9eea4068a9d8357934ae85e27d8fce2664bfd678fbcdd6256571646d7d7408bd8c89139d4eda19f6dddd64461a8f16ca0e35a9cbc64311c2f921244882c2623917c74e70516775826d68ad4c691f7a68c051e76d05a36bac63142bfe07535604919992b7a9953ecc1d87d2f9c48df0b03657cf9c01a4dde181fa404cb0dd18e9bd10a8ba0794dc7f0835af0cdc100fdfc08655952efd8ec35e7c9d0b56b99c76d86ad8a06ca66dcf06aa36c2ccc8caee8873644f5b12eb168a9391b441db7d1eabacdf290be12badec0911b9706d6ba541fd5cca5e5218964c0c1248c55b06dac50cd667661f2136507b61443d31568206c932594e3961007779e31af931a1464326f75119b924922ea0f218e58937baf7fa26a0b9d2ac76514e5cfcb5cc08ea2ccde2cdd2b2313aacc3221fa5d2465505ceb31146f82b2c55523860a2f6c39e9d4d36fd596f57845efd858d590606dc6a1f00dad27e2c8547a1147974a036a987e847841c521f65d5393552d54bc8da42767ce289764e960392350edc6a2e88c083632a35659ed712c8589e7985e4928c68410da6a779e45094e01b205936643ac819da676803d6e585666b319e78da059ce44080880945db24adab632f4517f1acaa13c0fdfb4fd9ad972776d7cf99210e9c5a25b074af18a7a1253955e4dba0701a376094f28ba7156b5ce49f78dcb4aaa58e65d540593bc1eb4ad58a00d5ac671dbaaae24db8e70cd2ca65eeed1a25865e58037730086688daba1c3d026fc831f9831f8e9d9edb500f0e7ec18dbdfe1cc58b1e1113ee577dcdba0500db50a5ead01e8b200785960d8c83c026d196a61bd5244ead14aa6463e32ea7c145d8f461c6da91c5a70941ba9db3e5b2aad26111bdd1b85865d506c32877507d5774d5bdde63aa9fbaa5a01a8eb6cf4db74418ffa5be8d1d4ba3116a4df56516107a1cd2153f27436e92c754fb448633562168f236cf6a6a3dbba8aa905608bb3d81cb0fbfaa4ce225793cce8a62fb2e680cf75dddfced0ada0dca36e2263b596b15ad0d27eb246d3c2a06a4e7dbad6d638365bc9eeade42d2c38b3e767406dc9ea6e5f09c26b5d70b26ac99d5eeb36f6d410bd6f113bac4aeb368f7bad2c55dc6bc7921d7df93ff6774af5e0aad0f25e80ba61ecd4c2d0354ac8eac61a2f8f341f09656dac4352fd3d24ce358640898843a2e31abc2419ecb1fb541230ecb03fd15bd715cfa76474712a1617bbc0a2b31aefb43bbf27d338ee191d6e9ed62902eea00cb8da2404e41179205b9a48aad8188d3de2fcc881b228fd244b3f55a420ecf2fe53697fda9067b6035e54cd137371eead4857fc57757ca356ffe05ae5968e180ab0e7533ec897b347507d0806d529f641aba456c9fa83beb435ebf3d43a5650349754ef287a93b58857d0fd77083581c5d2bd05146da96345aba0ffdaa4784fcb1e88f810bd34214843011457f0704237a1fb0f3444470218a6596a9d8c0230ec695e09334bb33ead0c918b4797cac7848d90caa4749387dbb4d475538e6fc2fa3506d6af69a645094b24a91249cbdb516345d4ee55c4f8e9067a3d353a5746214b2a183c0debd884c6a23902b56c72abc652e0a7b17f3beb98994875ccd0f932e55cd2750e98ded2b3aa3832bc7f95cca155704ecbdfb6b0275f01c9e42ba1872a5b367fee9d6a279d0f9385e727331abdb828f59b81d56d5aaafb43b83f84f5b86094743f1cf00b74974b344893ad942ead54b650986fe5ce1f3ca3fab92865bad5ada938676b01369abe63b7bda03dc2027e40ea7b7a28faf0fb90536972fcce85cc3ef8718b3c85dafe85001bb5466455be1de1031a9e815509a6bd202b02b837a57b28aa46f945ddb5b1f137d9ac5f0598d8711374af3acc70c4eae406ce5ff5dcb62cda62b97e10d2a5b1b5bc658f476b90b65cd8ad7effa804299056d5b1eb1f99ab52ec687d883f406062c7f1ef010000ffff5ff961f574380000');
-INSERT INTO public.lsif_data_result_chunks VALUES (39163, 2, '\x1f8b08000000000000ff6c7bcdd265c7b1d53edfd96ddd1f3908c4250c4fc0e85af99f954c18a0019e11777ac30385d5c80aa46e85d40ae011e00530c6f03cbc0f7337515995599f6d261dbbcfd967ff5465ae5c6b657effeee37f7f3e1effe41fdefef8d3b71ffeed6f7f7af71fbff8f2c3978f8fbfbb1e2f8f9f7ff1fe373f7df7f6dd877fffe587dffef8f8f8dfaec767f5d1afbef8872fdf7dfdf6575ffcf8f8f8bfafebfa571fffebfd78fce2bb2fbfffc76f7ffce63ffcf8e1fd0f6f7ff9ab2f7efde3871fbe79f775fef6d3c7a7d7f56f3efeaffbf1f8e55f9cf78fbf3efffd8b7bcc5b3c3e7d7cfcc375fdfce3ff7c79bc7cfcc3f5f8f8fbebfad71fffc7f3f1f8a7ff9f1ffc7ebec0df9c2f1e9f5e8f4feadb4fafebfaa3ff9f8fbf7b7cf218bff8e6dd87b73fbcfbf2dbcfbf79f7d5dbfffcf987fff2fddb1f7ff9f5fb87ffcb3ffb26ff7dfbc3fc4e3ffbfe87f71fdeffe6fdb79fffa71fbef9b03eb47ff1673ff8eddb6fbf5f5ff1f9eaebf7dfbdffeaf3efde7ff5d3b76fe757f1cf7ef3dd579fcfb7fffbafdf7ffedd97dfbc9b9fcadff50dea607efcc7c7ff7d83264c2f8f073f6ed2d02b8f46a05cb722c1e3f1b0c7ad88e3ba95151f8f873c6e6589eb0d9a8ef9537fdc82e2d73c7a83e8c3af37c8c0fe589f30b05d6f5068f8dfe5d96862753605ee4360eec3e1ebf00e17a90f55691f5238f4a18d7dc8c8f5104c23fa67607d87a84fc9fb67a4c1f5338d7e30393f331afd691fb291ed671c36aedb88235f781e79ae0efacb5cae27c1bc813cee31065d6f901871af0d31c23c37949f790a19d6c9988f288fdb47f03c0910d67233492d15c280eb6963edd4d37c5c3749e8e3f188c73c9abfe4207d59978f5c237ddcee267357389f729eabf998f1b84354ae1b07f8da6e1ca0d76d03c6fabf0db0eb196345c3337c068378f833af4c41b8ef11b88f023262140cee15031a545bac1d3b1ca3d654e6ba6048ad14467e62247ae725d1bc2e8e194ff3863c681d0d0ebe6eb4313785e7d943af3708209a2b95877301540c9e2bfe2d5795e7da8c5199c0f32473182f2b15006792583ead4b2e6ec073ed33efad0b24df4784f3bd4165d46d553cb321d36eaeb65aac75271af399074aad3bd2f542922bf04274dd34c6de031ac3f2c2648f0a749a6f68c8b8f75a51f653cc879eaf4af2cc3bb153ed3543d4ae8fb8ee10b6c7fe3fcf5d47c205028618d74d4463c716915d37b3cf05d5c73ce2ebe9b4a3c2f3c58d8df2f99e920b09a1b5a1100a1937f9bc73435df7860ed799278a412b83a233c82612d1205a0f4503fdbad501363259cc65410e79d42e650a2892ff6d8696f2c961f24a721a8d024827df551a31b80181c0bd2145e180569f8b505085a67adde45cfbe68cf301410b1e11d4aedb1137b83ac2dc07e2bdceb1e04435ecaf16d802f6f351df08663e17e046671436d43252679cf443eb989b0098f1999f4c28bf453cd6e388b84f8059cfffb40c3b1d249fe4b548b860309c1b31a51e8b25fa09a3d79590a29e8529cb4560978b80eb661f2b70e651c689a92c7c41804af705c7336264c60182f04e1d10bc6ed18d51b7a847be15ae6015b1c8dc35dd81a323d1804356a223f3d8894e2172dd4c5c4f440cb33c66e4cf8842a3eb6613dfdf9bcc74436a989f1171dd3c7cec3386cf65341bfa5c1f0074f88d449885873a1235c51656b065b98cc7ed132bde207350ad1b075e374a3d058ae875c7d07dcf18ea33bf135d32bf715e415471951ea4dcb59965121bbf06fb4ad2e075158a7c3705a09fed9725a900388594c629c57872ccce0980b3503957a172cccb32aefae0a47882bc39c044f10aa1b9e30e0c6b471d3079c7d8242523f58e18b65f3fb200a03a14324ce07d1a5464835e4fa5b57acf05a66a180b81e64eacf4e499ae2c63577b96e43bea6c0bc6d8d4bb2e65c9e2d0aa30b1eb8a8f8ce13c22b86e275ab172fbac18b772aecc9870e578ddc154b0ccf9642826155c627323b148c03ccc68c7b1af2998ff67de6b21bcaa1ae8d8b441661242bd541e8ebca6d62793ed5df7581fcc8cb31d9b59f62d6333aa32824b1d124efc1baa55b734a3ce7d3f1b2dbe2846bac35032fe932ed12e606e8859edfa7140d7edc3e1af36a1b00a1822d01d92d611379952e3373552791357f0464d1a16d78d3e7655419fb42a0c76c2874182c548eaa08fe7c8ca9fabd288c46c1381a4f64079964f1fbb5c92fb023e8c06be59db847c27b090ebac6d22eb17ea321741622fc24d93964f14515a5b6090ec6e6116d5217ae475a2e0ca2735528d8a300d4ce240a388431199e1945fd1c0cd3c172aae55d619882408456605e27a86c3e685498bc56cb346b145a570f3133d78c3135019b2e48d898673edd87cc73c9b4f3da25efa6366ee1d625a506613a021b150e6ef670931db35761ecdb53691c5c86fc72cdbf9862317558d3edb00d72595a99929abbe22fed25a6707d21d473940508b93c5c0f253f056378278484513051e7050eea0e72120e3b08ee0e2c9d64f108ad7d322369ac5e4455c3c4979a20c86ec154a36fde4d85bc563ea3b839ddb6ae093f00fd9d46f529b3b38433531282315ea6a02097444121b818824d3937931b89b25d9510228cb944b4930c6944b3e1f5528f6a30af97513442106c4e495597cf5f1744ec2e254f00773132600c758c9c848450f523c2e7a80d7cd31aafec62c0e32a0007100af841e0bc44d2aa3dd32eb34d456c1330fe8a8682235394f6d9441efefcaa43cd72957c478c7203bd48a8c91e268707cbad6d3a4954486cd52121b165d048ad7437d1626059a16758450f039ea338efa8569dd03967008de221191b89e2ccbbf016f02320877ce668ecd7521bf17c16f264ce3887af326333ad58d00c1e3d0d85b89b7dad1a5271c3785cbf5b9c56da39db84d8a87454c1167dc084a7d8f29ac8c41fef9aaa478522e3a93014f7a7ad37f36383c7e744a8de620dca7e2c0fe3ece55b5f3ff5039208443621a4014a339790b7906281e65dc8c293a7e88a87109e74af02aa1a9e64c5399ed95259a2b6babb24ce48524c75c8505791616c64a6fc641d773f0aa4c59cce68a58e13a9b667c2ae8cbae200d4514930e19ed936f231dd71313c9f9f1c465fd802ea368caf8b113cbd65b00da7e0a40496544d0ca68694800aec0042c73242facae52bc74f177237a9e2a57a5df5b309965c964dca293476184471248119625e743a2e970a70a4f85eeb5b2e8e8d7937155e8449eb94e2c3fdf50417a08eff19eb8a102a0d3e3b06794d101207c44d52ba9d9b100c791026a0604a772016499e6c13baf88a734627128023bebb4066f9daf41a9f3853651a1047721c297e306ee481d4b42006cf74a281aaeb825441a4d62fcb3ed1e8ea675d2babe118aa24af3d0c22a57bc6e8b2a11160bb18ce15ec45a1296d2b0c9eabc88b5b49d99a6c0b026c823239ad74bee423b6f21c5534d745515a6ad3a9707a189ab52047fabc890e7ba308b15f305de0cec36575bd6d55ac1a766d6679de4d43de2b4fd2556a6ba40d0622d0a6fb634e00e27e73643b9c309691c623bf3d050b3c83e0df9ba4d71678b6946aaa9eb8ef5287963d6652605938d244d53114bee8cad68e66b92c1f67e78cab928edb955a289c5ce5ca83a369c33a94725b544d5c181e908292aad1756784d79dade102f001eed0333466e850ddb7bcccbf113217ed90656469b4de086c9862cacccc190eb899911f17862620406b4f60918c9d5fd78da29e079c8e6cc1a5e925957520ca97c61aa75034cb7433056a94d9ab6190a25c632cacb06e983f7ac3ac33fb610b088c419f78d75ec76ca443bdfac99788a3c5e161843a58626899241fce9ca1f17ebfca13ac9a03ed3fe8cfa3ce96fb944ad26c75b06aaf491f56febbc14363452d24e8499997a6bc4f69b3542670d815d439238011eed87a9fd44d917ecd82c501b47bcbdf6842d55e2bf5efcc03a65500fed262957cc9a2e20c209b6667b80edbb011e1f62f54274a48d599efb76ee248b140dd8c94dd6e631004e765390264e7ebd241cf1e365927313792dce8549fe7685673a6a297bdab1e78ca3bca7eda301af3edb1eef5875218f44f711c23e0a05acebf1ca9da1bf58f96b63e76fd2f18dca479e73f3d0519a4ecb4372cfa059ec13fb17d82cd5ea336aaedb7e2fd755c84a947a9cbb71259d5b5fb91311b9f5a5d611f9a8cf461d65770b094b1ba55967a145694296c39340a88f2715fefbe064136e5be5086887dfe464ba145189dd5914a02a0ef08c008ce4bb5960a646898036ac40af5bac348b4c3c7b0a8deda74fb68c6152bf36ca2614160f5b7802833ed96e829d364bf32186576dae235ba8b5281e09cbabdadbc87ec7841d6dc71ba24f439398e25db779c2c6cb2c51de7d4636ecc29cb81a835f4a30152012d87a01d64f4a5761bf0145f9c7c33a95c749e557b67802c29817a45c4fd84d4dd1982236a00c9a9115449df067db010eac7aa1553906962b0c7e605dba2944baaec2a946938cf3a16d7c5658f8ba1d6cdbaa0e86594f86fc894f86aad5df419d550009b6853d19e3753309972b9d4295ac5b666432cb9c62f17e5d6d201a63557d894ae0d04a51a14517e34fbc9611f9a4b96746d961c9eee0eab0c00c45b451c2cce6ff3db69b801e537e88d6f73225036959efa4e95f6ac4ee262bc77150c8afe7d895e13962160ec132c02429bb86c4f370d7da1e6b2205bc0c2e93ad0d58b503d78ecf9c2e912c17b49a0653bd8ced583148754f39f5079876dfc4d433c1d13bc171bea7e78695fb3b1f635826c28b1fa69afc705483419071a6fa6eea4af6bcb4a58e2acc40b6ededc8926746c4d58d07afecc88633ab347151b16bee77759b76178e4dfe7a5f0d8bc1d26a832e1b57dad1b521950cd0df5b70ff2a7ae1dd8be8828e996da35485ccf27cb3739486e5b9b546a3162bd7443009d66278d5331259145281aa1f7e7cb6d5d821e5b6a294c7d49985c4b98933fb6bb1358d0c111e9f150ddd9541d1db3729cd3250ea6849d9ac7078c85f3391b618074137f2ab4a4d16bc13aeed6e396e4ee95c976837a74a8b73731c4f4456dd067002231faeb9735a62b74047ec0ebd759b5cab59e1b2c4ab179ec87cd89b967f9bc9665321b96d1d699e90a5c3dbe186e4c488d5eb66ccfc7480dd54936594ef881ad7ed08500838e1d8cc6b8a62d6f109a456d6b35ab9fa2319a129f26a164dd83cd2b82a43b475ca8e34819037e2a04e192f5ead3171d5eb19a0dbb89ee24610da5802cf8e4b1ae6f9ecb2083839bfd9b8832d8d002afc5f092674a5d64e91da4865b37742691340ba5d4bae32d7a7cc7e9f71770b8cf65cb32daaac6ba2e279ac467c5528471b0c80d9b5142dbf4d94ae5b8714eb1e4bb3c1f06d749ac5b1b7bac9c7b50bea8d32c73ce3f994ae52bbbaba1e64a3aca9d504081b7b36a6f8a2eff1837c9c24b7499e851dd7cc02715aec8bb1364f5d126740dbcc5cd9e9731707669ae78fb2cb41a2d2ed8a2cb186601b37095eb9b5724da652fd499ebb610225c10456c7a2480e6448124a95ebdcd537a896e43f0ba9d45008da5a69c3cdcdf0b47c0df96c989c3655c7fe388d86ae6248e8d71ddacd4e4dedab23da39ab5e4ba0f9f50cb41deb38a3ca47f59c3c7fc8d6b1ce16334c84a9c2869748872dedd5ad9dfd195142dc0dac89e9c8b21957b6d4e796ea7614449b42e1d274583dca3ccc891d6f450ff3e16ed3a839248d1522e5da670f78cbe9194d1adda20fe7ebc9c0bbdf31a9370f2aab79649b0ba5c9390aa4e354f30416cb1eca57abfec0dc02e8fa1f891282dc5cb6c62c76ee86fba24cf368b5ff8dffe6d113033bf2e86879d1d36c3ba6f2a0d374802343fd9cdbed23b01e9fc3f9ce33249097b982715a5605adb35a1e150c67466e4ab88972fc38789700e81b9c8f6206c7e576d56c83e999578966eb04967c097a800120335440ad4abe6dfffc168a34110d772551eafe26c358ade0b0fd9d8e66200cb3aa385527d093de4a946f2342ed77f17c47807daacec59db7b4edd5b4607459b28f9d775f9f93092c6ba34c8e64b9861dac1876dd8cd53f67549fffaf6461cc7037cf5aa9f30da3c65c2c79a8627561b314d7a269725b93587d70163a9d088e5e786f1977c41d2cb99a63387886205b3c91660da601451f2947ff78bfd47342f26d5e16bef944131b45196cd89a6eb46d80d89a75dbdc70123ece7e78ade10c1b86d58855e483743db809a3a78c68109e3ec99999b1d1ed9b3e553bbf403bbf4000dbfbe95ae62d1d598fb48c0e618c8ef457cd597fb5234bd4ae19b5f4137cb9e4bc673c737c76d340d1b64bd251d58224564c11556acf475c4f47d85dd019d6cc6275764e288554472764121e195cb340d9e556f3dd7c44d6669883af276ddd99f578c6c0388261ccb458979a61b92e65067a6ff0ec96e218de365cd1d61542e67de7e6ca81c4c9f8cab2987ce356b20219b235ef609be61bf7802fdaf264c4e47ef47845c545a3196b01484442bc908ebfdff0773a76e374e1907b30ed5031d33380743ea59e03a09a71bac3e49573d2062e8cfade1b7449bb354aa30fc1ce144a74cb08b1db8f20d1a13e7a8e05f595cb70e0167a0e8f469305e633700ba7a7c4eda5639cf983e3a43037ab9c7cee3cef09fc23a5a19304d50f19ed840238692cbd73ccd2787426c0cf5c0fe0d958eab5e1b30a785af394ae13236905324e4a17ab866544a4e001ecc943c05482c03d5a056c9c9e517b70b05cb74d6f33886ea772717d56a5ec00c227bba9395a01db19285cfec39ad3e98611ad35b1e514f5200fe81e7f1c65f61f730e0f2c01f8750ff0ada707f8b89e83765a0f5a9d49b47bcdcd2e7378dd6b74259523f82d99351a730f80314dccd98317f36809c66a4f65dbb9d6da8f18e9413ef663bb407a16b8c6a3d6349ec07c81e07a81a5b144374742a1574ecda1355ea6df78955aa751f66afc9fb403edfcd901c5b996a4476bce9bd5e0313829fd5524db047082c235a55b75bc8aee47ac52474ebdf3be2a02f5cc0ad384be0124f5b2949273b4e41c3eb9881414427a7bc67ce65be39a12fd4fc43a73e92c66cb9667588f19874dfea151738b1a6bee2e075eb323e0dba648836edeada44b0e319737b2fe6a80b7e39653e16538781ba2936edf34dcabc3338b3da24179973aae3bc86b1675954895147bd9fde243727b83183d29196eda99ad72d87ef1cca9a75acd6fceed1170dd1e19ec1bf0be819e116d825736f68049d4f790fa3c82eb26f7b1c7cd1771d770d97a53bb83ad9bcf52fb7f3049b30fdc5d461f389f69cd599419923dc42d228dc744281a35d64639d320836786c5e305b70076ada390140379722ed31ae4cfc17ddb4c730d5aa85acdc8afd1490e2f9e4d63f7066e92b1fecac26bd87a4d5066b33b7b5e399ebf46a22c9635ffd4336d308e97415d5bd80f514269e0f7a65a1869bd0245232fadbf792187fdd701444d87133b84735ea9feb663d36fcdc1d71eba9ce5fc893e7663d7ed7ac69e127c46c0f524df92903cddaefefb8ab5888cbb47699cd3294a66a578e8fcad10ae762a6f88966816c07286793496f6d03d67a5d68db1912c5ecce9e5709f02e535e9cac3f7d5879d71961e2542a31ceb809e4b8645bc60cda8ae91f73da45a0dacb1ac770e8a97aa7832aa01b3ee8bbeffeac8e4b42fd6141e4194fccd827273604d0404f2c4cbddbeb813b2dfa00da79a0868e78773f06be4cc508ee44c216681a3fa6fe9a32f559160626b50a88d01846577b04ac187168d337de585454e6e6feb3b47126f47af1e0c3a5ed7ff0b0000ffff76a7af5dc5380000');
-INSERT INTO public.lsif_data_result_chunks VALUES (39163, 1, '\x1f8b08000000000000ff6c7b4dd365c791d6b9ef3d2d198706338689810858b3c2caafcaca64c3022df08e98edc42c1456232b90ba15522b809f007f80c1187e264b3791599559afedd14271fa9e7bcf4755e6f33cf964beffe1e3ff7c3e1ebff89bb73ffef4ed877fffdb9fdefde72fbefcf0e5e3e3df5f8f97c75f7cf1fe373f7df7f6dd87fff8e587dffef8f8f83faec72feba35f7ff1375fbefbfaedafbff8f1f1f1ff5ed7f5af3ffef7fbf1f8ebefbefcfe6fbffdf19bfff4e387f73fbcfdd5afbff8bb1f3ffcf0cdbbaff3b79f3d3ebbae7ff7f1ffdc8fc7affeec7b7ffb77e79f7f768fb8c5e3b3c7c7df5fd75f7cfcdf2f8f978fbfbf1e1f7f775dfff6e3ff7a3e1e7ff90ffce077f1023f3f271e9f5d8f4febec67d775fdc1fef2e3df3f3e7d8c5f7effc3fb0fef7ff3fedbcfffcb0fdf7c78fbc3afbe7efff07ffa9befbefa3c9ee8df7cfdfef3efbefce65d7ccafffc9b771fdefef0eecb6f3ffffafd77efbffafcbbf75ffdf4eddb38a5e7d437efbe7afb5f3fffeddb6fbf5fd7b2bffe93531ffedbf76f7f8c33f35ffcc999fcfffa95fcb37eac3a888ffff0f87f3791ebe3f1e0471cc9758baa3f1e0f79c4d1bcdea082c2a78fc77cdc023aae387a838036f72111601dba6b1d2af33e4432df878ccad73d90ed91571cc87add3478ac5bd2e03c6fb8fe3d70fa7523e4f9f18823ce4772de175095eb561bb2fead36e8ba87cef9fafc0085c7e3618f7bc0b0eb1e8463ff9b302e48aab1089a0faee37a833205ff6a3d13185c793479c83e9239d69199eccf6c3aae2387514768fd99d52f06eccfe654abefb1d55578f659a87b607f0f689fd57337f4fd3dea279dd05799e717f1aa83d09e6b313d7f38e212b48f6c4cbcde208af863ef9e88c59223f1bfca259d24b5b3acb6b6fe761b67bbbd4283797468cc7cb6f8aa4f3c9131f6873a2bb478005494597f0ae65eb79a1d64c61d854075a9996bb9e2912af240b01e1060d6af6072dd0087f4b5b06f4b36b93fd57a0305ef5f69bfec70e93b9ccba2eaf59c3e33a09fd3c775b3b3aef06467b96e12e19d8222b1fcc3a6bdac049051dbc7aa57dc65d6cef098b133a08cfb135086885d62ac7c128dfbc1ba7d1c8d488759f905ead73d7cece7193e0202982cb3218ee2a6205e3705f10c07f1b9a268588673c48eb2543c01e76d7c5f3656fc0dca18f2ccfb2a9377bc773680c7bdc8a8ee4516aba173ea3396e745e3fafcb8e7c8b8e1c76d0014ab324d7ebe40cb89f795d9bdaf3c2a7f142b0bbdf2512ae34cb1326edaacecd2ba9efabc6e06808545e46ed7cd93f75ab1ba5f4f19ebe99f32e0bae74860ca471e917d628eeb41d10a4c1c1b4218751fe1b44eef7a3ca1f3a09dd49dfc32fa856424a69934a659c2a602bcacb81b7e12c722242c1132f6ca022189bd42882db66528ebcb7acf916814d78591771ad3e7bde097a53387a5d2c598fa669cd1038ceb0743b09315a0c0647652814724b9c90e50378904d2c47fcf45c7eb9e90af3a1e7114f8cf3af6db7010ce045f2912e7e36dd0a615bed99cd76deb8304dbf8b78cb9194602116f99455132695cb78e4c217ddc3a34c3d6c0f66a8341443b9b8e95c4ea8de42c76dd62c4eb71c5282eee5e1777e7eb16cc9b47fe61dcdc60c27a5d5bf90a6495bf999fec835ff20b4e422b33d03c9e6202c4a98857ca0d8945128ea48131a91e79c1bece49bfccb7c6950a2be3619ec3574cde18385b00b082f5216ad34007008a34481e1e00753f94d1d705b1fe19517fe1849858d30b60938a4508e84cc81a8f38d29579632116331762cd04815c121fb9008ab9674f036d0af7ebf6c17b0b7c905f4f1f2b3d9e2eb1fbc40e956b912ff71cb8bf3f47c29a4c5b57be91f0a4368618d9911347a1860cf6ee5340eb1b5423786eb97454d71cd02b3d135ac868ed3492eed73224cb50cc9dcee815bb5ef25de4f14276dd88639f431c703dc556603f25a597c25656a840d7cd42fbdb2c145c33443711c81873dfd5b8226d4eb86e21f21dcf44f30af4a72dd798e0bac7b0b15760185d4f99b031542315b9a04938301565eee446592f6ea4f7fa39265bee882e94a291d240338e922043f954fa3bc622cc12bd38f39e003bbdd903f5c56cdf536c41295534a91254348dada2a6bb24df4d5a7cc74056346e0df614710ac9fd8112211a22a8a1f88f24f71f79a35f1e8eeb16a1cde7229462d5666c421236d40ad0524c798820d7ed02ba635820715b6d162cc6be98a76c8e57f1b972063d23ef39a8058d28b690f30cae212b6788362b390546f2f0bd713c2c950a9134b5536edddc387caba644001e25c481c77a4899ebf2731639862c4c00a975019f71431a450b24f37a8eb176f91991790b6c6abe2580e5c95b043d39301559375e443e251410beec1d860aec91eb26846bb903ebf144dcd8cf472348478836e908615228fa8680a105017326140f677e39199247ee9ebf129697c365a55b15ae9b8d4a471acaf574a50d4a0a29d967e0860787272c278118ef234c5c52e35a244c8da63ed79368d40b3b6dd85265196d464b122c5192f9e0a558354ab68d9c999e3c27ef534b4ca7bec2b5dbd85a8f31b14e207239d188a525588936d44e9e40231960c5d2a0d7936d2f005b2e004cdc7aa738ecf611aa6da2db160cb8d801c6fa6a2082ed34169394d3386c8515d18c75c0d98a1b6702ca50842d687c170cb7bb1e763a75882ea241c085e94c0ccd5c4d62bc8437e928a0221d299dcc79976f9c5b9961299bd01c7d3186d0271b22559a265af3017424d1d88c7dbb9daf72b21a3be84b972d6bb9629ddfa0b8c3ded3e14594c073d597754ab47790c7754ff20d2f935cafe7321ae6e3a909b9a49d2e23440eeed888a3dc06157dd9d2dada86588f835921aca5f62e19ddd7b23c99974acab45d42468eeca4bd78882be174d88e9ab34c8cc821f67123284f4c0905d4011cd7098d3d7043b1cfa61dca92365162992ac23356294abe91f9122502e9c253945ac0c15dc507a19021573804f0845e2c664d0414002f8cdb2294d716be1401595cf41670da12147c45b02ea8bf15c7382103a77ecf3534dfd253435a04e9d7ae45fd7c0fc0b9a91c30f61cc48a62242f30abb0d090584fd2257d9fa4e94e4c596ed4cbc88d9d48f792b30b71a2e4f3217d6455108a5fcfe18bc49f51dddc4ab655b552284283a1a5a217b3188c972d2c8edad5b43b066c3c7b6ad78e0471552b5457c30853910a531188c28036e969d449812cb2f3015b204d9f894f6cfcdc7c950f90f2326d88784a92445634dfb2aef585a32c01cf1d7d8331a4a36c614059278d49db381b73f12d246324bc9a5d4fccf0e5c7136901e6e015045225d8edb66d8ddb274b9a01cc45d1ab9050447a599ab14b4386842b9c381b2a51930571bf8fba530b46baa222de4f9fb5f14d867b29c9c062435d6b435da20acc9a2cabc0f8bd2bee6470c55d667af92ca6a11c376edf28e451e452599f4c723d97d00cfac86787c95524c24c6c53d28dab03b54b1a94839b5d1f811fb20694465b0c852f1943e9f744163354b1c99075d820dc9cafb36d54b0287569248826702c2661f21544937c538f1c1044dcf1ac735d91428bedc7a550e744634301d148a015c437ebeea702a3a1a7b2ef6a0d8df55c2cc35d68c5c28d4a478a5aa627e67d9ec396f298637fd3ad90129c42a9aefa3dd28155528c613917c7fdc395056ce45b5659fb3723a5a978825ac878b4d669f3e89a14f72a95251a62e6c6597619cea5f74cf5cd5a73256d0baa60dbf498516d500d8c626972154bf16f019d85cecb6416b34fb7226f72c663890095914ce84505369b55bbe05a9b2c6ce3b305beb25564e4a8729701da8b40524b1e5950492ba30e49e6ec4fdd9ac8fa0a0cfddda0d35edca41d5cc09227317971ccaa4e476cf12e9856f502be659afbdc6f341456c2f1acbde5ad33a6cf0811dd96641c25d2d0f67cf230d912b5b08730cdd3a14c9fad3062686a076d01b6ecf575e86d5a111db1607c3ed5cef5b363feca0b39a6863602a0a6033cbdcae8e9c11a40fb6d74393a0307ae9a567478afbef4f6400731d09c51fb6cf08a2a68661d29b505db5566e97a89252ba80173cb56c657398dc7e4e1b6684ce6aa29ec67ebb946470590beaa1464972ff3c49d9e60c3d46479de2b6870db88e24babdc3c8ececf1c1f53a8a89ae56c865f4f814dfa21f16f112eb340d2fa1894fe572a733f1e52161bc2802fc777df9a228b1615d14f9609b36a8e15cab3a39fd55bd161e7cf04db6f67293907802fb54e90e1926a5d8f4d90f469ba3b3203a5bec450168a622e8e6fae7a718dd794925dc27edd3a011ea76a8b94e42ab577cf4c06cc7baf6939cf6cb31b465d5c2ff213d4b1c5329e860ccdb91f8b39bd7976dd75df84f69f4710d598364b7be45745f3358382d63a2e193b1af75cecba7d944af3912a4dd5e4930d9a271ac7681b51bacc023fa5147552132e6f53110b80da1ee3906c386a3171c46212dad61914d41ed9a2dd73110dd5eb8865d86e770fb6ea9f00ed3ba1794328258d0d9d9f9496eae7e36e9d811fde7e659a7b22be0571e215fa7cb3482af5e7c06dd80dc85ed290ed1eca085145229bcc285309554abea1aee462d0371b155befe3699dd1a0c6ca921869d7cf606a2f5f74f50980b7979787723d5781108f279ef2654b9dd421b7eaf6226fd57423058b7b25e31e277b3deedccd83b44363ada9598e6834da788ac3e5c78738b4ac11a31add253a40aff2ec30619a8dd1c44b9e23fca2cb713b6676af111fa4d4de44e2f6aa711e95385ed592ad1289b861d7a1850d9cfc18745a8d24c783b38c889b81b75137ee25e4b9054a1547e6d5139806e94ba2cb4b419aaeca34d476ece7ca85558840686cddf51bab468139b4eac69134c3cede3841c7afcf2c88af4ddc1eb695fe9c47188f28195d0b2822316f72c6eab0915f4fb32d1b2d351d4ed00e889467ea6c9fee1af36081dae94f9c86b3f171cd41daa839dd8728f3c547f763b26b370cf0e76d8aefe8c6765a7959c08bcaa0d882113a3a5797782585cf964daac585d40a4be6eab8eeb4c9c3884656a944e0a8516f72dbee1fb9496ccdd65c7104e969ef75ccc231f23cadbdb48ebc3bff9e9595ceaaac523a6139c379a88b2bc73fd985bde05903e85734696d72b811acd7c0b13314808f746c153927b7b66ceb9ae7ae2242e7f57da7bc62dfa2dc71f666354e04cc5e0a4377cedf93a26cf7893bae7d599151f026aa3c958eae81da20675cd68c659fedb9d26635dce47a1a2ec6781a4a128a17a1483a11541e3f51d60550c638a71be969aa702a82e5da9431b05566e623701da555a25366f169bb9b7aa81a178f9b492910ee4db76a801964bb14a5daa5e92a49b5846475b10936214e0a8e51e51ab7c9c21b75ee7e66146ad7ed2ebb7be29e20326594dae59904bb3d13c2346a078a6d6ea661d53a4afb837d96310bb2b542995bf15f5719e9110e33fcb4f8a9c1198ff349c2c704ed0625adbed1c28dac21ed718f9431c4da1e81e2751bc1ee3118e5e801702b2d600ef410e55abdf805d3b640e248b20a995be78e95296b0c22551e8ddd0b5449c76fb06c0b96fccc262c4188d58112e21294bdc5b8366e6cb69d5149661374eb51d66925825a99721a3cc3e72cdbfc3419219f471c65130d36a950134d7e3dc967757364ce46b1c4b531d3528e07587810b715f0ebe665a5c619400bd8f70dfb96b6f2a0fd4374ace81593a59fb7612a83a98b440b0d3ab62c1e332073a0d5440ae655096d748998171bb40dd3a751cf58e86a33e26edf64eb73ed4508b61bb5e42046fedd51efedfce7445e61d75ad1aeb30813d741a1bb589a7c46463db861c41173e9f365cc71441496cdc718a43d6ceeeee5b0b93cf86dcf3fbda266ba6a9aece50f93eef1802ab0b5da5736c957db4336b3f33cb5aef112cfcf19e43db1da97132547154a3f1864f3046617e13057112ee916cae3397b7665c188438df9e5d447d06af97b99dd3a68b702a9877266163743a4662dc8a5b72d418226b52f909500739bf6cc81c73af616c45146948add7b037b140c17ff6c8b464b3b247b0c9eb88b9472cf6f377ba52da9be7fc61b26f5f09cd3f5445d9b9cdc12baa96644b682528137fb16b3f5ca3c6377356f139185c7fe4b8796010ba41872e271b8ef1563b79ec0ca26875bb95671e68ed4afce288cb465f7b00dcd6c732b5562644d5066f91a2819f0b21b262997d2ea5a1ee7c0fd00492abbb1bbb486eb368488da98274a9b87ecb4c783e56f27ab0a84a6a7015f103a4833396ad8cd342a109af247dd3c111fbfe8eaae85f7ec5e1c486fe96ca508764a35efc619329eb92a3eaaf34cba605719c44780ca9942ec011ad4dcc560225e152cf0781ebb7a01ed72511286b323858c5dde312e7b61fa1a39db33b96b440c7be4eccc235837570b0ab4e6e00c7bb654a8ce8ed169e87cdd634d66048df76406edc8b03364e7d999809ee0f58030a9b6494e84bd41521f3dbd9661464eddfc72ca9dc3b4c913177131cf0b425414d05bee1615f21fb5b8097bf88b70863e53a859218da296a66c3d46535640e2d2275983ec0989343580a99b219c0f35407c5b55d66621ea6a2d1adb72f568f92da970094e9f50e0086358592f2f6d9a6fff28a786d64c7496b3019ea4b6d79ed428c073c7c19d3359d9a2967f541645dfc5b654bf5dbb4421a51e1c590d8025b4db1006ed311b1aa7b3496bc2710eba77f2ea09f0565ddcfe3d2fc615ad799e1102ef56879a0e325fd34bdd5146e9414eb02046b42246cc319d59ee9fe972c475fe6cf3dd0172c46a79eb69d69fb631cd57be6d7dd3f9f854d9776139902a0b5289c65f6d84eeb29eb45f9de1a8293c33f37a9ce881a7eeef216b9c7a46e6ce24fd90e30ff51548db6540684d8bd42f9743abc57d6753e8cc3e8b9f1bf79c34cef6db304d281c34abc459d696faee1f670f6bc365b78fa0409fe74b515772a80565a768213ab30e5105dc6a540ddc9441b41aba89dcb6e0c5aa53bf0c91f502abd3c948cf231eaaa38acd416bc041363e21385471b45a332cfe66b1204b2b0a38a32d3d943b8b3d97552dc2fc6c437ad3da21b8055c02f02fd7838f57c3957a66ace4cc84b46b0dd38ed3d99102639616197622e9ec976a275cb50523dbc7d999f663d05f353af0846287819ee702a3f3885d48c1d148c74be457c3fdd8de1bcd13f8e77d81db066339212e94453a09d5e095f3ab86de063199b4ca0e3ce3eac9268c5edcc1e88b3b1cca1b98bcfbd0638686a5591e17e5989520d72c3066979d6a3225e5c96d9ee57c14409630af9473d65176588f1e421af5cc58235b0c69d45b57206826d73d06d770efe090ec6b4e2d8420e77c88c2dc630bf9d703b51d6c518870b9a741f5b78297bb0a3981c7d36bced1d79f754cdd6c355e17c9c97a40d0f3c121e76e66a7f62db2ced9f30771945dac51b30fb01a92435727393dabb5af2f6792f70cf502eb19e9d1a5f3e4e83cc1eb05d7a4c24b5602bc3cf065b3e5a4880eb64ae5d23673cd8f4eebe1578ba744d12d1071590c42c7dea7f5dc4cffb81892f0587787a847f3e22c28e0f3a73baee7af36ba8a2c996d4ad2552a95e4ea1171ac3acc9730c8feeecc619f9a72175ff3782b109788c82261026e937b9e2201b1538a5e0d9b055cddbc66b2d29c30bd6eb68a7db6887d122bbf5ed2aa1116fcd9a3e75d2afada4a413ffcce5d00c5cf5af69e31b4c1a76f9a6d2b40ac396a40f0eb362973d844f34fc54a2ca48f7c0b530f076354f8cb7acbec9190725a660ce96a27c9d8f38dbc6cf1dd184fd7d3a0fd248b425e6b81e3283b45b0274c3070fb297b20e5292ed73d87f71f7b385fd7ff0f0000ffff45192fb70e390000');
-INSERT INTO public.lsif_data_result_chunks VALUES (39163, 0, '\x1f8b08000000000000ff6c7bcdce26c95175d55bd5b6be4f63216c249b056b5678e2ff870d0b668177c85bcb8b91a7198f98e91e4df708b804b8018c315c1e97e14619999135b2d9b4eaadaaa72a2b33f2c43927a2ffeec3bf5fe7f9273f7ffdeedb2fdfffedafbf7df38f9f7cfafed3f3c36f8ef3e5fcc1276f7ff5ed57afdfbcfffb4fdffffaddf9e1df8ef3877dea679ffcfcd3379fbffed927efce0fff7d1cc75f7ef8d7fb3c7ffcd5a75fffe2cb775ffcc3bbf76fbf79fdd39f7df2cb77efbff9e2cde7f5db8fce8f8ee36f3efcd77d9e3ffda3fb7ef1cbe7cf3f7ac778c5f9d1f9e177c7f1830ffff972be7cf8dd717ef8ed71fcf587ffb8cef34fff8f1ffc767cc0ff7f2e9c1f1de7f7fbea47c771fc5efee7c36fceef9ffac3afbf79fbfeedafde7ef9f13f7df3c5fbd7dffcf4f3b7a7fcd93ed907e374fcf88b37ef5f7ff3e6d32f3ffee2cd67affff9e3f7fff2f5eb77e30aff645ff9fced576f3ffbf8abb79f7dfbe5eb71c9fffc0f7e54ffcef7e48f7ef5d5671f8f2fffabcfdf7efcd5a75fbc1967ed277ff08b5fbffef2ebf983dfbf5cb7b1d3799e7e8e23386e41d2f9b720f1712b839de729e73892e3159aa05d7582d2f9a8a3245a479e907513e8cb387191eaba07745c914cbfebf928322ef9f90a1923d62112d83ecb7adc169c730016ecc72d1c789ea79eb7b0e77113a0cfeb0458f7bbf6fdcee38d11febd7a23a5f71b89a1dfc8bede786784ecebf19d61707d92e8351f23e87d0df62fd83c8f4b83cff38cf3d2c0f1a394983f72c0ecb730ec57ebb84b08c690f3bcd9918e574898e3849d7558b38e89735988808e5b33602d4bba1fb74ad29c159584e3152ae418ee38a1f5663d6f97c0759416c7ed0aeb47ae3006421173f1ebb0de1b1c2b3c4cc7d08c1d6b656fb180b9b4e1e6e3d7aedea376b5ba996718dc846c2b424c75dcac207db3021f17f15cb68b988f5b5c57d8894b1c3769ad3a9f37e9587585b4faf93892e3c5acaebed818b446c4ff9b9f0189bdb89eb41797f60a80f659f4c8750860bd585e315ed74dc7619cb73a8d50667558b3c56a2314a5f70a09c87193d9da5b64eac76d466bbecd682c92918fbd342601cc466c5a8d89d65c31cdb5ccf0977a8ec20e4b048f113bc6b2ae71764813661eb701f29c2303c4b1dcbee6d0d5c773412a5aeb8b25e8b83cb2c67779f8717b58df1f56e350b797799d6305126b1c776a7d589ee3a822da89ee7a3523c6fa2e62c57588c1d26795f1b88dc1d7d430e8311025d71389e2b859784d2d0bcb719b66c396261c3733e2bace301787652f4e6d60d5e0971a13a5ed816405aa64d0cb7c3d56c0e8002cc063dcbb26b17e75732aaf17a50ebccc9a243b6f49cd3110cd3930668ee3460598d7c74bc6df31c775e308e45768603ea1325116546214e2a8eaab09c581b44373631893766cb24ae31038e3dca70bae0464832b3e002703ae44e6602e91012a5c1bb862a936a9b1e7dcbfe81e6bb31b44c5a4c6cb42b61d77e030064eb4e38a280ad0d8f66e67ab7b14b3ef518ce30e4499131b883c763cf66652ac5d2d6413d1c85356f8b934a2858e10152cc499b89d3eaf51142c88e55a64a4905e64d781a602ebc393a3a61ec7d4e779a18d8fcbf3762a78181129c9fb48fba8964cc9716637f1e87502cb5e271a49700d8f65ac139060ef4192fa04775f2b47fb6e40a785484663c4ee2bccd47d80afbae0c27bacfca2e71dc20bf92373c41a66eda20a851cc9d5a1b3bf0fc87b851c420bdec57d21b62bcf5c26f70c86706c30f795de031bb462bcff153a00bdecd8eacfa71a2b007cb45276367118a1337f9f35d1e31c47079d4876f8d91e1662bfd1a14986342599105ed9a9694a18545447ed382b86900d04e695de50577a43206d4e3310269dd6c64d279eb7e67c0c2a783f065d8e1bb3811e1347b6e2d8242ae4b89970e1251316b2dac61fc60ecdc93b94c0e64633d20df05ab00558dca8f2070eb48928e2c0e738aafcad012b987c2f0363e15f85c1e048373ac5fc193a55760ee90753c8d811043b4e09669c92ace48ee8de63d6812313f3a4c8c8c03c9105ce2883c29115ec8c646e32d3852e9c51e3b5bca15694c4685312a3f14988dac88e589109617d0f8cad7ea7c7620739b6e17801e68452c7f0b5911e368833802605dc6029381910ade48beaf8300899246b458b0367075ec53869c0c6b709f412e323c7a24731ce828d31fd8cb448168f5d745312cff0a1ac74aa623657711ced241f9bba18d8712757be1f8f65a4e336f7a61b5ef91e897a4c832254dcb9ce3c6d56e15131ecd4540458ec98136945685575d38d9d9328b2f69516708da80f5e01e1060dd5917e5cee33322eb78a2a64ef591a0c7150e28439a5067bf75b5db2e4b112236cbcde3dc21c50067e932df69b34d881c34ad2e695c409e4bbec19395752474e3c6e9d6c63b049aad543c5e89952f4c91efce521288bfcc8181786365bc7d011179ca03fae5b980b7c0604f39e09c77e806503b4ca9ab170db8fdf69ceb2cfc9be9aba3858186dc0effb22007adeddfa1dfdb6c4ec5151f453a447e0dc09c4b3a54382725fa5fd64a1d257c504460c2975b44cf9a3d2fb1a27d216a867012d00d662bed4008a94d66f00375500341e3434d6627a1499508b49436fb5f09dc76b8f110dd6535bc056c21481bd6db5929c2237a4c6c3999d2a2245362f91521ee04b6fd24c919ab25892780ef0545d027510e4415d339abae660fddcf71bcb10a89e2b18cd47623157e9602d89a50270f5f6eff8e0daecf5a5936127e3f71666f08363f150b1e07de8f9ec54dfdf5b924f5027fdbc99a8b994d140e92c8add42ff5689a61c0384068381a679cc508b1ab164bfc4e2b22e3e690ec3a639a59e08364127980adb5416d5f6da468ab20185a23f1f3b7487641974688d2aad1610f1e1386366c663c03b51156e144ac750482e1b27a4c8bd45937bb3e356d3f5b79ada7123f494208ca513d28523423a59b745270bd9334d93bf5ace38b8d42ac78a6d962c560b8fb9dc02cecdfc08753b11bc34394ed578db7c89cd476c2a933c5978ee09a69c0c2e972630de814284db090180e3c2fad9a0be73a3aa51ab90dc74d473c79ceee862e0ad42cc97b38157134eecef80b019d617891c1797c5a0e7c56c43b52e4e338ebcc282b0330237db9ca06c2933595cd1da29bce485d88ac45b805a56818fb076cbef44d94d101de610c56b5217ff4681ed17013cc4bd488766467316e94435130284ee600fc963a0f6c2109752d2a214f762b6b178330e99baf8ac517fcd24cca47c2d790a8de6ded81c41765ca47e6ec7ed26d658a8b5a41c84ce372ad163c5348f67ea37462d1c27e4cbcab0f5c69175a92825f06636c085ba4cd86c9147340d6eccdea4559a6f0d8631f4f2daaf9236d071a766a389215289a194fda6a93424e1b8d65c4ce801bd90da5e64b276b9f12302fcb819b2e915a4d5cec0cdcd0866469035c1c8b61331e04e2ee3ed6cb2fd1ee3d2535606dad40f05a7a6216b8bc57696280d36a98ce950f5a61a8fd8b45cb69eb7ade7d96dd33c45386e9d96dc58c9181b861da4ddc3b2064d9b7d5b51f7413757a466b61b8b9ec7cd112b3571841c979507a7e76554f324681ba1506758f45e647db459cd8e6b5326f6910709bd49d710af3765ab004a9e94aa3d1e9a49c222e95e19e6718125b76791fc10f0e526d34c9557b6111092fbc80bb3c9cf47d1dd4cde184f3e772b357d83285a2f1c31831fb3a8d0d80659ceac72d96d76de6c10dbcd9a6814b14406a9c24eb37bf8acdb731efb647f54d271bbd7a61a34c86b4f29445c9db479e3ae968d1ae765d32369e3b83cd48175ed9a427d090b502f8acc7c0f4e3379a86e4883dcb400896b0a44e17a24dc02a32dcc43c6ccce04336696c637d8f4686be79627c11cbe09420c61b3bd7c9a5e7eb6ab27b95ecb6b7414d01e1dfb4e6d5c428726bd2f2708863457e7c501b454bcaa2e115126dd0ad3a1c407c958cadcaca45729c5b1798688bd456d7d83a8e6718b2c277e1cf1f42ee45ae507db741a9b39310eda10ba5c158dd2b70a64eda341bb0a26454eace5bdd88e14cc9cf4d9177121de533f54e9ed40d8becdf82c49d29ec8694e882f9d26aedb88d2c91f34b1f9656ef6efb13fc6cb118ba58872ac3513604bd4b292d8172bbfac74ba262cae66e0d4c2a4058797a96b226bb94d448e3bc0960d12b0c43eec0ac258e9c12362db631603fd286149bd215dc697f2c4fd8bdbf149d43c2e92a9098b64dcaab87cda8afbdb60152bc6d16489b666c53cad89702c3d9584d3a8707e796ce6650f310ff1cdd8e2bbfc2f0cd9123264e021b72e209e6948f5b140b5c4876dee4ac6238d3b6db242785c2933f00bee06cbf19880c933814f8778eb1d14de67a5a88900c652a64ab495694701b75674d9e7a6b9359524b52e84ad33a59fe2c2db8aedfb462268f5bf95ae6e99b1a9bc72cf33b64a0e6de5ec49fb6d86db68dada78332246d80a76b9b8b9f834db43a271933a1f0ac22077244c1f2321ca14b9541a78c0371c11e5834c232163f00ac9922983074b2f2343ed7291841fcdfdc9cbf1bca22997332c1a365077eb93e58319e59652b07e61b26d57a51586923b202dd76f595a7547e83aa7a24df51827b25f934a1648daa24ea6d97eaece829d39edd8ac744db9f91065f1214ef24e5ab208da9d416b81306dccb760eb618122e4b9cc77aab293e9d24593005e61939f5fb30825e4b0927314b28d9c49b3d28b046dfe132cda893aad3aa6e5dfac3adf6478f565e91b757250065369093204d66db223442a425440a6f8ae7a41e3136cfa26fb2cfa7376eeae99ff4b418b92cc2c2b8a9b8af3a6e295ecb8c86ac146555e20a549272c1890cae9c508edf1c9b77d1d4b94c4a6395aa9ae0b2ef5f2914956a5caa15e244153035c48b1271a67497dd0e55a03594e9c7b2eaae423b2ef00e606772ecfad0b67c85ad5486ac6a3e54592e7f6020702df18d1b6714c9de1d64c8d7d9674d297ccd35d340d453dee0c5c3938637c9d4d9957f969e411435e2cd9b036ad322fce40f1ec385ac5f741ecf87c91cd06b93f9b4cfb9cc13ee7c7ed24ab93c24906d5085f4293c36759975ae9d8c669c498058115cab73c46024ad17213f357b523d2a2b77cdbe69edef3306dc60aa409871e8b8538766d23641acc91f8fd29faddbade553037ab5c7da4a27db52cff3a877d34e2fb166b3d5d0d02b7784f4355206ec35e552b0d82ae8bcca12b1ed72c038ea02bc20d84d6614b6361752e6c05fb405f325cd487ac44a224e70f1ff6b5a60e723372ddc63502e5de914f651db7e262df930fc04f15cf36a5ca6dc2b3d9e6d3b61b0c00cc7703c2e3b9c0f3d8dd7540f6f82b592b0d2c8fd42e8415ac42d1ac472e8015233c6ee10e78e19a17a55551271dba078516ac62af396a5bf96c4f4d79224a2e60d2695b57ba68a7220cad1477740dde639a06b98a873a758a565d1046ec97b537684794c7c9c4be2d83e9ee1bad8615537e58296fffb980d70bfc0b0c8b000c78c188e34e6b299b5678876e0d27e836c72bb6c4226f331b36058989ecb43e81ac3b977c04c1209ed1c4330b97db3ba459c995945d9fb62622d66f4a5ab66dd1fd0125dbf61e60a1a01dd52063a141b12708b4a69bb35b6218a75c76e4764af089c2c14607cf5b9b8a33cb3358d8ae41650f15d18cf3a6c1a26f755f9b527dd68133eda5d96ba752b298228d74b68144dbef912373e3d661552a1b24207bf3d2c89c57f8e2afd365c2dcc53dacc28da0edeeb3f2f1cc4dae477fcde2c25cbef9d4e925236d6e82633320f12a082d3dcbd1d847b4cd35ab4f152dbfaf388ff206fe2667a1257fdaf8ad969c9ba56522cb9089eac5fe9fde20e1907b84e74b3549158a42a32871f70fd078b87113a0b2656fb52d716c4c2b932dfac154ceb12aadc6908c6daa523c6e19c3869fd9ce1223ed4dd7d97293368b1a2a58b74c1076935a4dff4d5085c91126d33b99397b8c44715a4bdb74a3a44d536b3fe3ae59a6cae31077a1d55651547075bf18f4ef67998592af95fd713f5a68b71bc87123748f08561d30ad4b2b693919a1b58bc2fccc4faef2e4e5b3974513eeb5000f70fb83f7cf4f61e4a85bb84b21c2b542d061cb50252bd1e63652161c00341801d4f490e3c3766a7f9b57c7ce08f5d06d2d4d95360f671b0623afc28f3e26be3c0ea3efb64a886d28d22ca0d4ccf463baee1384ddc60933c7d8e21e19bbd607b10a3ccbff6379bce3a1af91bb3d0287147d89e949be4c4b5d4cd716a3e81554df4ec8b42cc0a3cdddc708d522e419cbb5b1ac3e1bf2adb0c84b61195561ba334ca36f4512bb74430de663c4eec903e4e3566c235ab16cd4d9c45435e118320462c91098756a5f89c2829efe925923efb215f9638558271a93f145b305a20bef375a1739d1028f9b10b2cd53f0e32292e5ee0f6e4d60d035c9d97f876d3e68f59308202c9b1270dba7b84c8312696d79ad1c9ab9db9c2a95936d093b40e766e8e96058b5dd6a6d1d100b3bc9213d15661d5c70d7c76cec1b4469c710ab760c9cd8598eabd75198166c54cfe40cc3c41ddd5cb52d81729b463288d5b77067f71476bb2376443a8e49c3cc35a598e5bf4830bd3c4ef08a4aaece47a62e39cf6e3acf76e2a059b5cb443087ae46fb6eaf158cc14c21bafd7328394e6a1294634042b8d2a5504914ed045f89e8566bc1a05682c1b85e65e7a520bb59a39c23e6adce99273b682f69a528b45594ba71462036e3309c4e24377bb369a6cf76ee39ddabeb6348dbe5a4dc982e9550f44928455dc47691c061b76895fda4d02e5c153bca365c4d9955416dc203715cb3cd6748122dd720ac5d83e962a6ea9af7e97c145fd43c2e5ecaee628ae3f265f75f3e9084716730c4382e96d5f1ca65d1619756aa1b6568955cb883e1b394d2ed71deddcfee2ce3c1ab6d611c8d2dead83d4eb3a71b83765d3aaac94a93ed7a2469f77cec6e8dd9418e64df7bbc87457bc077032bed163672ed5cc11afc74b8c2208449bb0db59a0e82bfc3dd6f76eb68f1e98b43ff8d308819681bcc50f983438708ade502d9de98052eae88bb39156ce77f449d7dcf555d28f48bfd5181debd46c5a9509a70cfe0b169b156a9b00a48a2caf742087a4ad31bf080aaad6bb032a85d22d92cb0ea0d2b7d95c6878916b3ed84267710fa4e3340b55aaf51abcb6e6a9eadd0b3f76ff6c5815bd7bc899f9af7aa25df1a3953d8d312bb6ad620dc15ccddbd6334fbd79e6e7e6acefd9d461c9dbc3ce32fb68c5b9629ee6ea5f0a7c1a76ddec4d6693edbb9ea3e7dec56df36ef6eb4896dcb3e2ac777fd43ba31dce9b9ca5b2574f35274c087096ca3796b31e9fb760d36b0df167ba41eba8b16db689e2a752623dd9ddc3b2dd536d6d0fe7f0912ed8458652473a04516d29e5e99dd378db3af5284d7ff8229bdb63cdbee010cb6919577b748422e48a666cbb8d96ecec2023fad2e1b0b7c4f26c371a9cc647a69f5aae8ce2683238eed110b4ed0bbf3d973b79a31d49621a4a7acb8b978af388557b821ae4854ea488c91448fff0d0000ffff05bf4832e3350000');
-INSERT INTO public.lsif_data_result_chunks VALUES (39161, 3, '\x1f8b08000000000000ff6c574d8e254711ce7a952d4ba8ad11b0e008ac18c77f66b261412f981df2d6f2a26586f1084f0f72f71c022e803186fb71093f145f54f52ccce6d5cfcbca8c8cf87e22ff70fdc7be6d2f3e7ffdf8e19ba7df7ffde1e12f77f74ff7dbf5bbb65db64fefde7ff5e1ddeb87a73fde3f7dfdb85dffdeb65f9caf5edd7d7efff0e6f5abbbc7edfa9fd6daafaf7febdbf6ab77f77ffde29bc7b77f7e7c7affedeb97afeebe7c7cfaf6edc31b7c7bbbddb6f6bbebbffbb6bdfcc9b82fbefcf8f893357289ed76bbfed0daa7d77f5db6cbf587b65dbf6fedb7d77feedbf6f3fff3c1f7b9819f7dfc63bb6ddb27e7bfb7adb51f5ffcf7faddb66deb975fbdfbd367b9f86fdebcffecddfddb87976fde6f3f5e5edc70e8a076c3413a72e0d6174bbe30236937ec24dc6e5862e653f0320c1ea375615ead874e699dcdf20f5decf9ed8a91175f91175db375f7e0b607fed035730996554bac7cf211d13ab353ebc13a5a0f1a58cd2287318b21aeb1303a479885b7ae34a3edce39b5f0d4fcc61d9b987cec8a75add6d9c38f670fc5da3e737868c6ad6369cdadaddb947c277371a5235af799abb293b65d435a1719d1fa32ca597c0dce31331f34d85a5f32f848ab20cfaa92c9d09923d967eb42960bd098a3f5550b2c59adabf86a7d6864182eb32ec35a3747b8e6f8d28748eb219965a699bf33ebe34367eb46a66df78c4c839094652b23d375468671f8e508ec84ebe2c0800e14dfddf064b650156cd363223d311453cf85b4472da480560c443b33862060c23570b179d467f2904ce400706c066086414c8c695ca5becc812bb0f520cea411b53e0daf620289ce8a283546ebc3aabcd3a5d256a914acc583da6e808199d827050f99d12a3b36f4b84b9c1e199bf3b8735ac79d4e3bee1893f974a0377ce04972f70318f699b095c9d2f6b9ac7535051e8664a956d57bb52eeaa3edaeab5d86205117a0c846c19b9027324be610502b4a39934aebc6ac28d8c87828dc93a8cb012de02119b75ce30042c12a12c05de68acc0ba89918ea2bb8a835c1e131783fa8255c3b9f34cf0c19b012290b7d88204c1a47cdbcf50828092dc19c224543032ac6b4cc95830a9652e384d135912f5d6d6799087b6789d62537d96d26a3c256409228dfcc922207bcfc64636dcc746042f1c830d95bf7a068bb4a213e50aeb180259705f1c2933983f9eb79c62c984f5e6d1f0eb811a8415c17c9c4fb306f7d3076ed06e1638a95c5d2fc5f2b1f167139801827d48a32b68a70a3222f9122d1a29f68ca614a951d0c53aea4d78a03e4b095b1eba25c7760ab145688caa4aba47ecc9267b14c8be5431772c4606b809f950942d534e1dc8d672e0e808764ed6ca5e285432e48b08c2c02321725f83587c5aaa479618593b72c9cc58f54365168b3c9a40376b24eea65710fea25eedd0385a2049a7889c828fd373df5df9271015daced98323c8c2de395c2cd986513b8a8a439f1444a4d3977c40b52699e8a2ea405cabc43f21cf5f128d56330208696c322d79c8935f3240aa79ce9a0da35e58404e8110cd3b58a04ab70d4c5175446275011c77249fcaea8a58cc43397a032d21ef54d88eb2747b07ca472f2b0673d3b932a746a619a2bee86f1f94ea48436b731e990a9bce3a494a251203bb2c2a9d4ba729b921e9fdc5300e3c4f3a29b2a50d03823a1538113e6679cf11c671aaa61624ff11bace99101c35481af44494f0a5cc26502d740afda841c65ce390e8cc12735109249e2811dd58e012d75d2443987b52ed9ccf470743e3e910d1ef031162e035bb3ed8b24ab9db1b12f6006d971116bbb71196ef9ee215a2107852bc3f05d7d4e1b950ae7b2ac842d87b64b3240961641cbf0c3b97c00200a183de70e8452359d6d944123636b40ec35f9325228dc138a96b175f1590e36ca8c73ef5e9ea2392ac6e12108dd1812626bfad96b54a724494d5b04194a6966343eb24ab4e6e1ee92fa316d647ea8f2439767d13f9090bd92691a4dea66dbc7f232096c5a0c044603140e1a05839b3424d110b0e450ad92d03c23f5c30c2d651d2da84dd4700ab57da625b2ac6a3acb4d92b8374c7354bd08944cce74f1345f8b43dd4bea049d5df0516c87e11b040cf8d0891908fac823e5d5d5d1eb31b57d00714125f08cedba655963641e18e645d9ed74113e8529bfeda2d9702c1a871c2e423d4553154325c93461e1ce74529a81269f6c6d9fa524291cdd920dd92997b657428d135aaa02bfcbd875a8b47d61561bb07f552f1f005a22a2fa7da2b6a3f68cae50565554b36999ac7ec6a3395c521b3b73a4a69855d14af335931eaa25bac6ed7264e1c26563139a0584f02c843122e23c02ec9264959956641e89f4128850b48e4ea9c383721619988bc3cee070be20074dddf230a0e0fbd275f65b8a7d0d42345ad83755e91fc5f2505b79d6627eee3269b41dc70181d185a59dabe1f4e6ab5a7625782f611f40934ea994471de8aab79e79849040513d46590a1a1c113a090119b25ac0c4177cf8e27612914fc196ec5a33829d8faf77669c45b2d965a5a3678285f755766d0bbd453895f126adb3c3cddfa2ad405ac4d0ebc4c0490825f63ced751fb30412faa5729e67b554d4d186105c21ea38eb751ca514a5be06da05c189720289769cbdb8ba53c5b7a6d9ae39a5fb6a64a3145e7dd04c4e7145115a679f055d57aba799501d84936a145dc2cbf8ecd029008cab04115cc72160afe22380de239d2eb4283a26b5f6bf000000ffff3648f0eee6100000');
-INSERT INTO public.lsif_data_result_chunks VALUES (39161, 1, '\x1f8b08000000000000ff6c574d8f244711cdeaca1102ad650142fc044eac333e33830b07f6c0de90af960f23b3ac5778679167f647c01fc01883c41f75a37851d57bb02f33dd5d599911efbd7811f9c7eb3ff76dfbf8d3578fefbf7afac397ef1ffefae2fee97ebb7ed3b6cbf6d18b775fbc7ffbeae1e94ff74f5f3e6ed77fb4ed17e74f2f5f7c7afff0fad5cb178fdbf5bfadb5df5cffdeb7edd76feffff6d9578f6ffef2f8f4eeeb57cf5fbef8fcf1e9eb370faff1eeb3ed596bbfbffea76fdbf31facfbecf30f5f7f70461eb13ddbaedfb5f6d1f5df97ed72fdae6dd76f5bfbddf55ffbb6fdfc475ef83613f8d98707dbb3b6fde47cfaacb5f6fdc7ffbb7eb36d5bfcf28bb77ffe240fffedeb779fbcbd7ff3f0fcf5bbedfb4befcab1daae53db1d5158b43e953d5fd9f293b53b128dd1babbcfd66d91b4ae2edceec80749bb23238b76472a1aadd3f2d17a985aed11a6b9f5a031f321cd7c212cf7b535f3e8959f69b8b45d86b7eea6b9b9b2e549a12bff469e60be66bb444577096d5d95b8ed53f2a90771eb3aa6b62e1c886cad687b6678b1e32dcb68642db9548abca8e101793e10a2d66530221833377136ca7f4ed1bac7f0b607e762a73c6a911da92ec2e6ce6b65c6b6326325a9a7a4846d06b233f6fca673e0476201fe3ab0645aeb3a6db6ce06c47c8d3c9174e5721e03f007cf5cc72be35adc3a5942604c86bd5d5a67526f5d49b575d6517c014d354ebe94335ad370bcc3382ec25b0f563d486495dc579198021d9e0b5c669889d55a8974c6cf3657e10696671d4033d399f5db504428798c98e2cc69da7aa89f67aa67b0aac09ac4736b25066c94d886969a3c95c02440724d2c582b970fb10c552673eb1231daee99890aded419c0515d014171c1e049c6cc7347cc12e32ac62545672b117773e4b5c65e049b4b3b84c0747e5269bb2f693b8d4a6c2790674e1921b900770398b2985a7771a0ef7ca20fad98225a09aed40ae4c08f1aa408139520cb93d99451be36eba005381724962781074b60687aeb94face7c209e61007a28b4af0e842823e8565a74463d3894eb521c1254e42340cc1ac53222d5a84c39d24604f10e490593d8c828d85af709e2dcf14fd7c857c58b27c9152c49a6d140cdb31fbc605dd03c2093b204b895553da5d1749b917e62561c072263d7b693a28c080181f42199aebaced67d20fab42ec00795c9aa22b68c5f07b4e60b421d23e54ea3be8897c261a76e954d94be173290b25af788d683f8308da0722225c40e7fe158a5d301b7992968d6643c1618574749baaf5a00265d6cb56e07ae5ace1608d956aac0391dd82c0f8293ae53b1f9a996af483cb2dc4c6739b203674282c2c88cc1e71cb915bba6a1126a7bf83ad21a5edea17930442fd920ee886632362977a04cb427c789a14375126839e22352459e6f3249db9956bb1080b54cc7f0b2665be9e453b2a0e2ec491c00d207b466290c35c87980068d2a7e2f9b58418806a98a6471ea388bb35c83aa6c1828abc0f25460a6a6d9389965d41bcc0c632fae748ccb879f0fd2935231cf7ad0dc5054ab42a89a5a019b64047a8d59f5169384cdaa49804471494255122e65adb6959b49c50dee632e9c88fd79da00b586246894162f5eae8f7824619559b20e58c098a848e3a4ce9764e994635975ef31a2b0cd6982d5aba95ab9cd28aff70c7986674fa7d627660019599a929cec734626a55887a1450256e69eef724c58c4d0ac624640b34601a9a23044a90105f85ae97cb3321519e55a01bfcc81c52903e0c1747037522551c26181f89906b59db1870b062395aaedc9693f2619fe0253ea2665f4f0e6900a2a15216bceb6a7c174a2e42ebda175e27508275b2e0a5fb0798ab39b0c381c66801970a590b219b0337425db9a7664547cccb2e78106a6aee56cb98eabb9988dc3262638676b7b0e6b5dc8aded3ea09655a620a8a591638691633aa4ead5f8e7a4d676c163c72ce892fd86c966db174a840f372fdff574cd3db209728e5b5d18f5e439aa749ef9c00a9b5b19920414eed56aaa79c18168ce12ae16f05292cc39cf0712919284a7740225b428c19813dd7ea583196b2182c661846f3ce32811b85f698f08a591352a13fe3ac26b103a9a413ea29ca5b23f94848b90c07ca50adf66c86612746541961e773445ad11477f758852f8708eec098787cc387f8bdbd39bc3f0b473ddba7d1ac7f83295d631be0c3f3e85999e238d9c3b8f356e9f6e67449ceb6eef4a9ceb48c6b9f3f45ba4f33c17e2f39c0d3a33ee056ab326b891d4afb229c0c061b82c480d8460c269d5c85383c7aaead25583475d563cbb0daf85d179a6b1cf923348542b99cc59df6063837284ae9ab0bcfa6451aeb3285388161323003cd5a286a6803d8c3818cc2632c97189c8737d4e69bba27cac6e1412e9d334050d2cf26a336b0ca372fef4133d2e6b84ae04957030a61aac524e8fb401a3d1b2799b808984719313cd7a1e6d87ce9900908d2ac2a3dfe6cda467336d7d716246236bd178e126513eb770f3b3e1f372ccc01fc426a830aa19a40a25ca3216afb69b6010cf6b8b6b4d8f1c751952fa694dcaa70e836f0acacbe1a12f3a7568e3d4b088dc7ae8a975a1f3a962869b831284889c1aaca6cd1a070d43a3a6c7750a5c77ab4055cac80b9259a33a62e6eca9dd1df7195fc7ea795e09dced5621b74aca71455235214cadfd3f0000ffffad3736419e100000');
-INSERT INTO public.lsif_data_result_chunks VALUES (39161, 0, '\x1f8b08000000000000ff6c57bbaea44711eefffcbd4282b51010f00844d85df72e120236c019726a395899656de1dd453ec70f012f8031065e8867f2a0fa6a661c98e4cccc7fe9aefe6e55e7f797bf9fc7f1d38f5e3d7ef5c5d3ef3efbeaed9f5fbc7c7a795cbe1ec7c3f1de8b779f7ef5e6d5dba73fbc7cfaecf1b8fc6d1c3fbf5dfaf0c5472fdfbe7ef5e18bc7e3f2ef31c6af2e7f9dc7f1cb372ffff2f1178f9fffe9f1e9dd97afdefff0c5278f4f5f7efef635de7d7e3c1fe3b7977fcde378ff07cf7dfcc9f73f7fb0476d713c3f2edf8ef1dee59f0fc7c3e5db715cbe19e337977f9cc7f1b3fff3c23775801f7f7fe3783e8e1fddee3e1f637cf7de7f2e5f1fc791bff8f4cd1f3fa8cd7ffdfadd076f5e7efef6fdd7ef8eef8eff9eac3c9ed15a9ce341773d7a3ca88f33698d194b633c2332cf319955c73352561bcf8877fa9892beeb1a058d67e4a43aa6b1d398b688c7540aaf1b41528ff9cafa504f6c3493688f49a13c4e12c7c59344c7548e7ad462d5c22a6b8fa9a13426afacb52437eea4d098b951132d5448cc7b4c4e5c73c59e6e6bd57a66f5b152706f6375236114695247661b0fba1a09d9636678ad6ac934268949d74e52bf397762c1c5e3e4cd635a6fc86480c9acb7d73da62bd7ee6a5ca0994a1d6479ec312d1736516a20573f6878626d2994ea54b4b818b185077cc91a337d03dd745c4b91315959c7b9a99830b2620f1bb0268fd3c5c6a4eb96098e25017b68d65f9751e4d09da68523d5426202663402cc443c341ea634fa7101acae5d6a33a95e7ae020288473cc4db9c614b53526efa8a57dd1989b25c7946d10a0947eb4743833080b9a17b2b6e9aaa24d81856a690e3660d122d975d01468dc7581f40c19533d8acd406d2e785a590a10e7527b92f3edf4de2a01cabe5bc3beaae0a418a76d94a5ba4f8826f58643f2f51b0340137862a78d538a75092ec51897e10a0dda9013f1d556146306ef3a04e59d8d041bd6f249e53189716c4b86f61c1f1a5aab88a4b6476f782d288e04042a6d1cbad08b70c7f6196392398fe96ca5155ecd7602dd285db980b9a8ba37b1ddc86080b8a28a94302dc904905f1b6faf32fd5e5b6fe5005871d4e3ac38160796d5b82daba82c8135b9c0fc6a0dd3864836846c02162dac8f52c2e2cd6b9cd61811e1bed4062a60d33682c389194c161f22edb016cd2a7758f4d90a98aa14b81279ed40bbd260a32e83bf36d1ba15df9aa99028016aaf1cbbe588636bbd6f05f3642f553821bf4c33c769d0bf9a5f25a0d648e244ec05a887db383dac6c9f63fac66e1abd9b252a63649947d03829b502ad34262b4b12a59b29158ed3a5780d82ba369cbc12296e0bc2730e7fe8c05e77992300514eac7a9fb5a911542409ba14fa5d8b911221707eb6cfc54bea2137a907105b86a3504a2525d0976dd1d68530a9b29b575c55c8cb0b12efc0428a4a7833e91d965cc28c6a5822d2dda91491aa760359d1882cea2fe427da49e22da1856c572ab730c31ac65d8ff583d53a26e7b51516387b53696b8f33d18a5ce178d78a5ca50584bda23e3730f11d45d352829c37da35a191ac2edc0389ac52ae5409adee5d4893740b34a8c51dded10d2b7b8729197ef156b431ee8ff6ab1284b0a9e18ba206dc1ad7f7ea63c5b0c938b9b5b61c6709ae288bed636a5abdd0c342502dbb76278e3a448568b76ee06aed1f0dc0d0c383baa05d32ee09ed447c2327f7d6bed5a9a955620214b3527492dd5313706bf4d2864cf7554494dda76605a06b43190802c90e2c49fac97d34b905ba5fbfd1bdedb1ddbe5518dc34b4aedfd6beb785edf74619b73796dddfadeccd52ae5edb0023ca3cb353b7861bc79cc04da99930acf810ebee44afbc0275ec38ecda0b122b06752f6437baab24e2890284704062d6f2d492d834e926a33059a14c69591b94beddb865081198c398b677e56b94dc039633a1a26797d0438cca78ba504b238f48f76cab7387233a580d4298148046a5d0ccfb1c96623dc156749a022a3534745714c665f9293b3adc5b84e4d55fd6adc9ef058c74b54730887028a291da7f590800300a28d2bbcd72b73aab31a1a6a0354ef52b611013734754258e959bd16250749f966bd9a956f0a66bf7036c2181a459154d6705c8195cb3206d74d74c1e0ffb3aab5653d7d5f3a3c339b17adeecdee90284bd6711d51e94a268b20af87323ce640b5d739d6e6a326def003a13cc232d2a7634414717d965d14982d0f3128e607611b19eee7a140e993d3172ecdb06743791d8dd30de9b56eb486f89f535f346a5a65fa36cf3c3b94bda185099d4447c2664433b8acc1a60aae7d5dd558d5bb7c878d8d7ff7c7af96a46339b3dab824e0c0b51fff94c4d68c0760f573b811cd5005704a8d4c8263563b2b5964d7aeec81eb7e33657cab231fe170000ffffb71c2a4d9c0e0000');
-INSERT INTO public.lsif_data_result_chunks VALUES (39161, 2, '\x1f8b08000000000000ff6c57bdae5d47159e7df75848c8510414143c0015c9acdf99a1a1c005e950da2885158c1311db103b0f012f40088147cd41dfb7f6b9294273eff9993d336badefeffce1f6cff338defff8c5dbafbf7cf7fbcfbf7efd9767cfdf3d3f6edfb4e3e178efd99bcfbe7ef5e2f5bb3f3e7ff7f9dbe3f68f76fcfcfed147cf3e7efefae58b8f9ebd3d6eff6dadfdfaf6f77e1cbf7cf5fcaf9f7cf9f68b3fbf7df7e6ab171f7cf4ecd3b7efbefae2f54b3efbf478dadaef6effe9c7f1c18fd67df2e90f6f7f74068e389e1eb7ef5a7beff6ef87e3e1f65d3b6edfb6f6dbdbbfcee3f8d9ff79e05b14f0d31fbe389eb6e327f76f9fb6d6be7fff6fb76f8ee3d8bff8ecd59f3ec4e1bf79f9e6c357cfbf78fdc1cb37c7f70fbfeab9a6b51e5bbdf5edb9b1f8c0abd59e881bff854c6fdd720fbc99db4f2e125bda6a798c59af96b8b427929ef8a7b6bc7555c5831e7bb727623c709accd6f7346bdd5d57ebbe3db0bdf1490f9bada76a9d3fa2755deead8bb97359e0dff08587c690c4f597ddafbfb4f5bd3d71a05fe79ae1667b65ebbaa3761913878de465226a81e236f828d7f276fad0d6a78fab37d3c7aa3af090eb63cb78d72113c788db46cb826ddc6b70e7e06932271f79982c6ecfd5fa948db263b02d8bb74b497cb30c6f3cc76c5d6da11357453bd9b15c5c306db396c1ce6b5a3bddb5f59c684e7a2607e3c63b08f6b159d78ba889eac8754dd42ceeafb0d3b268dd96ced663b2425b832dd4bd5b4fd97863732b8ab718adc7b0e4f0162f1bb9dba99abcaa6359cee43f35dc221797e76423d2225a17d9bced20a86c7b35ae2f317c2012dcc076f0eb01300d8ccf6ceed665ec9a1421236360f5586bb7733ac62bba5b8f248652a73f702c9e57e52a78c09de85802289b000be8714fc5ac6c18663b54a59d81cfd501ddc0d97dcbbeaebc65b3f4c4c1a6899ec85c00446695b7d0490df42e95778d19a0a5e4ba6f927840a368699ca5a51696f39a3fb71ec51c75502bb6b62e1b344b1b38c5317f13d0d3877a3b95f0881dc91e1173ea8b0780bfdd44885055bd2ea3c103377915538bf88243d6c6f494680829006bc643e9868b5c1d16342c320b930124e9c4aee156a7193b54909341380cdf00f4801c849b42be1c9f2ce2c97519662b6c406078b68900d5c2bb3a375dec7e4c82d554db6944716cb46b6e012c74ecd1bae41c55b564463b13a3922dd840d626add5b49dbea9a616ed844275d3c1ee04311e0b5292022a5baab4ee82b3d49c456fa02b37af17e102512ab205ef6a816a5d157fd76487a440bab05a368e57a12ef8605b235947409e1e30e9dcd24ea939cf60e733a84f8be5fb926c27a0d973808531d0d008aa69986e6ad39ee027201535e7b41284a4d899f35d7a8940125eee934b161b37dc40a9cc05e6b00183ad4c811b65ce001e286b919cad2a192f03226e4e65c94d7b099b3c694d16bf0290f7840aaba32b1ab39d6bd11222d008c100720cfc65a12246e6b21b814bd882f5d828a91ed4ad584bc9d784930408edc105b2b41e3542c7286523081638629751435702676ac0a558a066ce761a44c61c147507eb3cb8d4163d2753b2a4924a56b2985af45959feb6eb0190690ef63b175b9312c41d87ed8b52123e6b9ca3141957f58b068b2a93a39c764f4acf946867242c1cf8ea29d40857bd6641fa076a5d843e2858ba14e833f9ac525e6517c9a9df31699603e6d04328e028adbb17f2043eb6bcbe78e4a3023e22254aa708b190dc310305fb944222c7ea7b5c2d013e266169db1e358d5e6a3b16f041255d1c66c42c1b2eff9cb0e655a2e9b1cb3f1fecd13d1f3da4989d36a0c36680ad11fdb1cb17a5aadee5ff3eebe2605738a0a5e4bc6d70de62c3787c61b309bbcbe5d0e65d11c02af02c2ab57ac0817215b3029d04f1ba7b755f42aa570c5c9002381b3d48a3f460951ee068474c49613acb154539aa926e86831905a85dffd83553b8b126e6a02bcaa6f7c5a56c6706eb21b4c66200b44c0e68d6ed36b45ec72483c9489bf48c0a0d507450dbdab9693369cc4621084a3e8bca8b08f062b10f6a4880f8882c0ee61b5a2acaec9451fc9eec9c978227d24c9f0ae946a2387e88c08c30035acd1d5327de88c5ddb4217311cc25e2d409ade206c9679aa3bc8ed0f241f24cd4ad03be1657b731f06d22d7be2683190e32a11c79e6be825516d4818dc5e30d3889acc02459898146a349648f90728dda62f34e6edbae68a832eeb128ec7ae5e56a1ba0d93aef2945394f675a4c21d1f29e9e853916844092246c332ae54d9838f2f0b592a9c5377e7b4425e288c82764dabaf34bfc31a7ca7cccaef757a533428b174133c3f87b873f3ca6ec8a4c85a54dc866aa5594c54f0a9bf79f143669bd6869e6e258b298551018798538b87670b022dcd8c5393fd47366ecf690059f8784dd0fd88841cb7b6e802c46e5930ab91995320473ce0dcbc8c8120b2b6d4145a98c036ea0f12a29d5c54ca6434b66018135f655d1aa49dba2567a52c763e31841bbbbf3874fce516dc1c420d23477297657f2196c55dd330a89ee562ec3afc6bef8b291e0bac0bfba66598f5f6d648acd8c64b6817bc90248442bbd97cbbb1695c1da092b0342c8a9ac382a68aa218ef7084885c086cfc99f4fb3f2c754c637b44a0476e3f54b222a86f8c02542191d99fe4560c669d3dbb92f239895e7a5e2ffa437b9b6f6bf000000ffffc7b5d1246e100000');
+INSERT INTO public.lsif_data_result_chunks VALUES (39162, 3, '\x1f8b08000000000000ff6c7bcfb267c791d639f79cb68c43c66070d83c012baccaff996c58a005de11de3abc50588dac40ea5648ad001e015e00633cf35af322b3764f5456659eb63d9b1bf53bffab2af3cb2fbfccfb5fdeffdfeb3c7ff2ebd7df7dffd5bbfffcfbefdffcf74f3f7bf7d9f9fe0fc7f972fef8d3b7bffbfeebd76fdefdd7cfdefdfebbf3fdff39ce9fd6a15f7dfaebcfde7cf1fa579f7e77befffbe338fefdfbff7d9fe7cfbffeec9bdf7cf5dd97ffedbb776fbf7dfdcb5f7dfadbefde7dfbe59b2ff2de8fcf8f8fe33fbdffbbfb3c7ff937d7fde6b7cfcfbf79c77cc5f9f1f9fe4fc7f1e3f7ffffe57c79ffa7e37cffc7e3f88fefffdf759efffa9fb9e18f73023f7a4e9c1f1fe74775f6e3e338feacfff0fe0fe74767fc9bdf7dfdf927f3e5ffe18bb79f7cfdd9976f7ef9c5db937ef1e59b77afbf7df3d9579f7cf1f6ebb79f7ff2f5dbcfbfffeaf53ce53fef535fbef9fcf5fffce4ddfffae6f577f30cffdb6fbe7dfbeeedefde7ef5490de661fdc55fddf0fbd75f7df3fadb79cafedd5f9dcabfeb9cfcb49ff63fbefdf2dd3af8e7f31f6f5594f33cf99c233a5e8150c87d9e7ade28c8c71cbd8241027b88c3650f09c38f57400efab21e21635ec6e76d8476bc820136cef3b4f908d0386e1a1679608ee6156a0c1fe7017488635d4bc3c61e0e56de43b4a175d4cc6a3802eb361c9ec33b1ceb2028423d00bc2fa5d18f85313f8489e987e749e7053e9f42e7ed82b1471e5023da674dd96a34a8ef90ba4e624e8fddf7da445e34d7c6749ee221f6f3f394b9d0342726e71d232f92f3b6105e2307c01a718d48c7be8372c973c45123b11a611d03a8d1a07dd644f631d3d8cf3352aa77d4579958f4b7d45761dfcb087dacdf6b5ef3603f6e74b573efb2ca71131b9c799e381743c5a60de979f988323a8b31d729d4eebc971dcb0060a0d7fe898cdeeb9106c96d75fbf1c866fb08b2e971a143febed0e2b8d949cffd0ae2e3c641633905cef7dc62e4ebbc18e97c8389ad0b58250f2870bd5261fa9102f0f22375ec2949944b21511d25829c28dbf43c3e6fd734b26935d36b96d5445a3f9f77cc659efe33a7a0d393a667613b324e47be20e617c67941481a22eab5a640517e8643cb21106d1cb728c57aa8284daf101898b75d41e571003efa2e1ae9e62cede6ccf375a87ce5064b701b996fa3f0f9de57c01af0b23cc0adec79181d7728edc985e61bd4647ec79cdddcbfbd22b157c93120e708f0d19cf30bcf558ef376ce4b72941e1373f9d263f218518fead89cdc2dc2b4375c783e5a34707d2a81511bf93c05ac3579986035bf83c7767a1da33e56f5b8c100d7bcc0861d97c032cb4b40d24c9ddb4c3dad8868ec2b44d246c0d63a90096e7b20281bb1b97838cc9773e1303d6ee4b4de5cbbb45eb641ebfb0ccad03c1873df78edf7adeab5e124517e873af232617ab5d68791da27a9517b80d490a20d473cb7497cbf5e21a2e680393d775d6643a85ec84565400230b184614fc7c9e69a8e58bf610688198fccafe559601dac80f3ebe6d2477a0485d1b57dd8cba4d00b1bcde9b801b65bcf111eb712c95a5a25a2047183f5104569e8a6074ce1b81416b85c0a7cdc042e3b02c27c8568454451b3e316a632bd699473b9807fb66d116cc737d32740767c1b4fd44332ed604ad1db228d9a03466fefa8dbc65ce18231ee10abd27b6cfd30c0068f0154db8dfebc18db0800a943377e10847b88aa78dc82bc715890f978094e087b896973208ce567cb29c9461d211b70dc42b4b74b88e6f3a8d65a28dd49586638a6f38615fb3266934c2bda11e1069b212158e05c78c1328ee96e7c3e8e7783e83c5f4f9adba413bde37c712ea049ebce1117d0104e846018b15c80183b52921f7784ad39cdd19c127a2f89f3718b0f5e16283ea645b2e8e66f2cf3d183a342dde0d0f469e4f5325cc83d0ddd33b68af1587eac61f26c770f919a2645d33350cf9b416863a2177df0910823643366a79f44813e358b08c5e20e3ed76e58787db345c61daf65908c6e44bdfc34979f9136c631e61b41d0db3a927b12a8967580a6c7539ad70ab92cc7cd4cb8c3f844879b543694904a7e06d28492386f20de112244a0b75556188b7b735a82c70f70fbaa6a7b6d4ccb929c5a3e290308336d0c91e870c1e93b1398475abfaa6cd01467ea9832b915e2c624c464f01a52fbed527c3c268f518551647f31a500b8ea833baa13a42dcc8d35cd278aec650889651bb790e1e3dd7cdc81c87b5ef33b6e74df1c0fdd226328433ee5c2b1dd216848af6a260ca39d7c0c86e36609da5b2431d13f746f1946fa1db915579341c74d920e313f5ed221c8b5a39d4253283a6e75dd50abaef35d46c5ea6c62029b6cf86093b90e93619491298ff935b15103232d59dc8a664d9b2b5ae0de89cb8ec086f7a255a28fdb59d3aad198ce633d17f69e2a535906281d17e30a1f17e30c86316ccd1e6224a588b48e649c91d82334363b61312c730b3966c2b7fc63a57ed3b5c69e3f4ef30184c529e7488e0b37ebbb26bcbd021da3721d1bf560f34cae8cb63b83071fb7416cd43288044472dbc0ac9962640ae5390333ae194c7ef30a24283ea44d4c6cff6a8576c8e893f17ee472e66d82a38e3a76f64a992d2e9ad017103374d2fb1c55eb5478680dc9f0799b35d1585b9a2f46f77e82d6050838dd1eb14d1d91d3b423b6c34482a032c46643936a6ec46c141dec8ba1ebb5360da3dfc69d45c144e48b75f1e98bd369d8c5b62b4283593ba564ce04c851c68eecf31965696a791eadcf63a23e28af80c00aded9664647e78acc96090285ce846fb1874d456eccd04b916637a79da835c97785daf5e6703c6bb11d8e3b6cfbe51c65687294b271a74a1278d22b71da19ce64a33745b1490a9a69940b56a6ca0bb586accc947381d20674a427a9d202fe49bd0a3c0df4911ea8a327c271ebe00d893a97e536302e37b04479d7bd991cd46f13e9a43148f2c50e3f5cd9b2d73ea3ebe6b761e383cc12db9ab98fceb8b587406de343f4b819a03010808edb05e8dc3c09928681575805480725893214929811499c0b927d066b30a867a669d1800ecd3353b80974bfb54275f8f84b526f19ff32127bd12ace9c482c178dce0bb0a1034ac3f1b55524f0b2a09ebde9b00e3d6ea7e40949ce327d9b695ccf70d20408df7162999b4a4ce0f3f356f1b98d9a71229520999190f37c7e61a29b84c9f5e8074553b4f1de95e7b219d5b24dea0792c947dafd4c3ec87c633b99af6cc98a454ae571c6eb1408bef4c3b72f125a061f01fd97db28401a034714f0cd94a18f96ea4091c947aa13cd22236a244495c90dab64ca76a48ac58b92774a91176e489bb3b92376209b234f5a243b3f53928e53d0b767fe4541954b2e67cb5476c5588c78f5e46e39321abdf9c14d6de5c9646c522a0edc68c58193ec0facf03570ac3c625c3bc596d2e4c628ccc30c53ecbe3503442d896c3e6f7292d839e1e453b7e9f032a025460e828d5fc4f5545ac16e441341a83cd379e91fb25513f6e98730b43f5b536192113fa989f64a90e883589d1582ef0bee702f2aeb1fe68f4d5cbc9351e087ce0ca0e6932d250c31ef17b83cab3e9fe093558e91446b3c442b8f0c8ce23103d3a92693a828e041c96e774ea00a99f8286f619a2ce3779a27676825a485ead1211a70492ec2561142272b32aab06c64d30d55cebf60c79a6e9819fb33511b699fa83511421d13f25d0af267faafe25b9c51f189bfe852894d7e0e2bd4d3893be9879ca070292984bc27e83ce4b82023648ad9e3b8d077e446b7e382815b19cc9515e51d2b89bc5cd7d38215837e90372efe91d94c2b04882dc792b4f403cbff3316a7c24f04c51388d6beb0d99e12706b910e3aa1162b0d9ff966629cfc74f9eee80414891f4142e9b1e30f34fdb62def6b519ed08c5d4b9854fed13ce2d1aa9abe0f6efa3e8c9fd9fba39ad043d7a5d58de895b20c54ea205bfdf3c59891641326f24208c880058a56d6ada8c78d54988434310919ad54bda59d81f15541f5116b57b966be4264da9f41418d41665cc2ed58b2b4dbc09dcf66b8db53e0de77804601b2c9a9386ccf8b23d55b4cce90a412123ebd54b4c9936e5c2adafc74b4f97bc5d30c7cd3919962d3249eb1fe0697fd1b2627bbc18a2d804d0f654d5575f1ce9164a1301257544bfa978587e0286ec90b69816ad5b08b4a485523d1b57524d4462c4be218869556e2769b804c2bbb7aa003fd789115c55f64223f6a7b034e94ba44ec1178273bd910aebc92664a2d79a2118035bda799e90e2ab562eef72d91a2f5d2dc531f73da991e1054f1604c6a41c6546a5666d9083b1f63ccea85a82fd67b230dedd487b61ab02b1b13320b3cc5faeb46d3071a9aa63a6a7f2787be99eb4379665b73aa3bcfd4955a0c446db047396ec75a52474ce22c9d3f0a1f978f45d02e1fa9a840e82aad91b616bba258aab2d1c9548f1c9f635d00abd06e524e64dcc5b3e5e9796f4b5cc1bb64e6e62deb590b6054c533eb9a083e899df43ba0533cebc2607d55a03e72786e878d15202e6ff41a325709405abd4ee313cda85718b2605589d75336e3206f2295de1d4c566c76a69212f650978916e15b0564abb5f13494a144b58d4af9014efa838d040f92923e54e401557b6ac06a8f9432414ca3f898062c1d4b36134e30dc96ae59b919833eda5ceea91f47a33be18366f000fdaa2dad0206767ca0095762255e88051fd75289f4bc9648c438c655b38eaefc11ac24ee164e8a270c5b2d21b5a8058f71dce4ba8d9d26df9a0be49560217926fd063b9d11b467521931a1a4d31c66850ac793c90f3f6e815149308c0907032ab318493275f8d8d4754939cb31162039efe8a0fe14d97be5c0e8836241e66758064af481814ec402eeef580c367c1b28583c15a4552f07a9368311e51694ea0205ea8f9ea4752708da322956a56c746ef2947c561a96790d6369ae546c4a45eb18cef0d92b656ba5587decd46e3c5592aabedce196b98cecd87c05368951eb88aab94fc8f454a9a7ab596c81cd6295947d93566eb569486e3a81740e3ec3f3cd411d9367b098cbb7b53b4ed84089ae354aac5c6a70e58ca38a59e2e9b838a04d70801fb70b45e90234737ee08de108f3375949eb6459c10592a6f4242343e12380696e3f2b6f2cc1f0aaa639d07105ed7afd5cbff90da33f7e24d156af460b462dae0f8b18a1f316f1b5ea30c1c45d91c135792f3d0a773dec26c465950a57153a1acc3bf9a2ac6a8a30562510cb6634da8eb278334a504e63b90c6a7fe71c5517e3bb3425105c2c6ae98829bdb029bcaa34b4fd6d006f5b0bec92435770102cf555f35dabb1559a5d69ea6a7c09ae9a588a13c90d279101272bbe4a933c914a1561948e0bb8928c14dd54c07731891b326185c4c582b5d9e4a04e29598fdb570d7dd5e9331cf928b77afa7706774880b1a41f502fb9bdcbcc84dd1134524024b6227d6cabba5430c3884f9489a5f11377eab96abc42bc77d68b4699376a2465c5d135f6417adc04558924580eb404d0a5d4f124c956e27a58da341b2e4cbf747c50134848b7be196cdeec8ebbfae89e820454074b0e25a5c628129a44578896589960b20de6891df6244196982aa2e55056fcd6223707999f368595c878c96bd9dfb3a73543358f6ead59ec516cf496b51287abdb69815116179962ec2742d383ad053265e88cf325ed3525eb9ce388dc85c4c5c8fe150ef3adfb45ab1c83b382cb55ec9bf03c39a5d2f9d489e6a462c72d7ba40c9d71cbd4b7426d6a4bd09452a469484219695cbbfde7e941582c6ed524788954583a1dc1eeb2b856a055ac8a5faaa805288b468d0e934f970667379e8aeeafe6d61747e6d6cc5832ec64983743e50f0cd36695b95a01d7ae12b7c24f8c4b852d6aa296660dd4a630b39223a375f7026d3dd522da7af31b87535fe3b45b667ce71bab516d5d9dd90023d14befdc0eacdb9a74006c36028fb24a3d73a0c7bf577d9c957728367ad6a7e1c870654e6a3b735a6d23a91cad8e28c99c7d7e028cce1757594506b69452bd494694f16b2b30b12424d3f311a6b2e912b653a0379c3f0a9ba6799345a5fce6bc6860ece4909e5e4de424a9092ff3944d0c44a3aac64eb8b96877995c4433ffe32a0c334f82c8a3146f1eabd6cf2a9bb8dba3910c68a0e5e875ae2ecc5cfdd60bd7c2224895e911a6d72948191dac1a6b8c46b255c26492b18b22cbda7721aff3cd554244f3e83ae162c9826bab6fa44776e4c7226cba85b075c34d765c8ce820bd689719bfda39f9e6f37778d764a892e03bf4f174cc7aceb0dd4796c3093a5ee56bf0946425583793d7c6b9b1b65723cbeea9dc3fa928b56cae8baaa7e994f8524dbaf164e3a960bb7b15605d132abde8d88885b8c3a4dc2c78fb432ec1ad48bba6ae98eeca23acae95a244863c8e8bb78077f1ca469636b4b673db3aea0f373292ed958b2eb52260ab07f2480fae4fa9f5a9db0ef0479b90e3c2b168447618dc32a0771296ae548047919d633baf5a307ac76a17cabe84e55fd80d029aa6e1b11154dc233335af4c4d35fb47aaad5539668cf7ae4bfa382e4faff2f3f22c232955ebcecac165087fbcfc5b4bb632f3e88249e5e520452bcdbc529bd26e3da8aa255ead02c6ddf90022dd505ad7d1e2ff801dc400e1b8272daf7a7f366c0a0ed8bde1d1ee37409f12a2796fa38de30e8f2aeaf88a4e0a5bfae50fd86036c601526528383316e4d2a49061a19dc82648dd2901db097887d1d5a9b14970eae783bb9a3738fb7500192a1e61325722f596a87789199b71054eba4656dd2d64d9b6571daaa249df943caad3bbfb3177079fe2ae8bebe81658dd2a40545d3ce150a08057c0578f5b874a32e2ec1784f3018c191e45963fc1ea99dc55ff55f8bd4da9fb20b8bd6c3c508520dd1dc188856fb85468ed1abf4e0b37842a5fe07429eadf347f5fbe13fa6b55a42490ab34d2b93f1a6557aa75576a96251548ffc56ed0a4fe389502d7781a280a7ce7c11658b8bbac11fc29983f057283699126bbc72596b2cba4726d6c0bae9c73586b80ab648abb16496b1ecb934a5a1e2942a90fffd12e585abb6fe709d64a0275d3ad3e65a94774c3516e5e8eece65d005d35157dca4a9a71449d1727be9d5bda805d6e899dbea2b57ae9a53b0624add1a173f517db78e8933fc997762d603c851692a74110f821601ffcfb45ee85cf4468667a84bab5fa550f93e557d5507691152f59bd71817695e8ed157cbbec8c985dcb42b2c8f645f2b4e150ffcb8a091c57358d5c33dcddaa6c05be890a0a0aa578033f9d12b4fec30537309b76253b6a3fad6ae4f6412776d71d4655cb7d6853497e9a52ba6a0d5c8a54d5c85d6379cace3690d533d993d1c95e36a9905277c26a628e206d9a4b4f4f223c2c0874b586661c97f3062a393b46aa762cb05ad4269bb0f65d91a76537a7ede79d3cf09eac7be3376d66526a8ec653ff4e931c6c5d74e22550a8c06e31a49d07dda2414fc173858fb052a9a145bd552871959fed0cbc3a154cca598db8c29e46ab7cd6cdeff57f4b4e32ba8edac5d07edeb36fc0759d7859c4ea8d5c21d8bbdf413a54735b44ff07434f822ac776ec9e4d9911c12d85b599ea5b3608db90e214bb52688f60314313bbf57fb3d8eab50a797922e98e3fd31b63fdc3410a622b5a56777a0ef938fe290000ffff664ae68bd4370000', 1);
+INSERT INTO public.lsif_data_result_chunks VALUES (39162, 1, '\x1f8b08000000000000ff6c5b4daf26d751eebe6f5f5b0913459844090b562c5811d7c7a9aa53b06081176487b28d2264c5836361cf589eb1809f007f8010023f3583aacea9ea216173756ebffd71fa9cfa789ea7aaffeedd7f3ccef3fb3f7bf9e6db2fdffeedafbe7df54f9f7cfaf6d3f3ddaf8ff3e9fcde27af7ff9ed572f5fbdfdfb4fdffeeacdf9eedf8ff3a33af4d34f7ef6e9abcf5ffef49337e7bbff398ee32fdefddb759e3ffaead3af7ffee59b2ffef1cddbd7dfbcfcc94f3ff9c59bb7df7cf1eaf3bcf6c5f9e238fee6dd7f5fe7f9933f38efe7bfb8fffd8367c423ce17e7bbdf1ec7f7defdd7d3f9f4eeb7c7f9ee37c7f157effef3719e7ffcff5cf09b7881efde3f9c2f8ef3c3faf5c5711cbffbeb7f78f7ebf3c3937ffcc5abb72fbf79f5e9971f7ffefaabd79f7dfcd5ebcfbefdf2e54f3e7f7dca475f7ff3faedeb5fbefef2e37ffee68bb72fbf8983f3477dfe17af3e7bf92f1fbffdd7af5fbe895fc60ffaf41ac461ff935f7ef5d9c7f1767ff9f9eb8fbffaf48b5771547ffc7bb7f9d5cb2fbf5e4fb03ffdbd9ff2effaed774fd7c534f43ccf71c6681c9712f8fa5f09ec7846e561cfe769e743871f3178469c8c7b08e31e826a0dd938af25795a37131a478e4c548e8b60da19e7c6681ccf2802f0c179eaf990bca1c66398740f8927d451b43e8a50e792191c971a8c3d7b033a9e714cb6a77c0e9af5f485247e73a67cb34bee976006a8e19075f4f249b30e1aceb896015fc4932f75b03d0766e79a8e60cd9788690f610ea9736dd4d40167bf3158dd8145eb0e40de270c19352494e332305b6f6c60723c319ce7e9e713cde342ccfff4bc10e37fb5396b75a6c65b00fada1f99148f90f39a2061062e7ce6ffea438e8b85709b89a01fd7e041ebf7c103e25602fc9c270c765b5b3d630fd6dc86f83e26562326382e9421e779f21923ce1d83f19486b0aec9578d8b9e71a8c98bbc96646c739ac0738d1cea19364dea57dbe7392aee6303799fe70635d21a99dde749dd4ff9b804699bace0b21f1cfcd436b99fc3c0765ca8e26b795025bc0807c8595b08e3b87c98e44ec5681cd740db771fa8f3b848c75e7052f6e3a2e1dbb269388503199503191c8fbaff63001f97ceb1efa661728f3125ff7d8c196b0c3cd6ff391cc74568b8ef861a4b8db3cfc03996830a3edd81612d2ab91d970bd4ab48bc1ab98e7d33573c2e6181bd703cc279142c6e25e7c573d2323b07e1e3f289bc2cd627d27121e85c976204970bc9713d0a69faf2c3b0e0301fcd597198d83224c1f9c869a20bd64e7adb8dc7aa4fdfab38a6c371b167588a99f98c3d16c571e5cacdb4ea5810d2a13b2e18491d1c10f311ac7893dbb863864ac60c73fd5ede4ba6752425aea12aaddb9af66d11a8632a8dba2138d655a01958730ae0dc43ebdbd2e8391a8e7e42c676255d7b3a0ccb2dc3151ea89a5ef9408d65b2328f61691e6332efb08adaf17279a98ac5bae71ee596a46130afd11c33d642ccfe8f45aa297e54eb563724b6d96b3eeb28480f49916a27b09754856a4630b01752f95ebd4e59566bee53ef7594ce0661d0fb0603eab634b96760629d39061cd71080e5a76378c6dcf4eb1931d7c2a2c01577248058eacb4766e08c04e1abc66bad1f467e5c2a5a194d94e2feb8f3f3100ce743b57d35aaae3da5eda70c65f622184e2d73965387095e42b4cd5f6805639e158c592328b12d771cc8e3b878d4c379c4c3c94496bb90091f0ff6f5ae0ff6785572b5fdaae4393901a1c7f22f4c1f88846373ac91b9e9111194d7a41818e3a1b5a2ccb1a2b67ecf8cc798799cf069af5825ec8506d437b6510d6f0f10b1035358d645223b7792484c98a740c5b841e50f19b368d0ac7719e93c086a671b97e6e524fb7963a104c21d0d8ce69e9a445ab96801955c39a0e38154fe46917d87d5c487fa71996532e0484f996dd535fc6ff9d38e684446c7252ab2b75423a2aa9bef4ce0697f22b6b2d62591aab6252a426438ed0c17204da0ae15b0b0b5e97b677c460ee201b3cc01124fb8d1ba374d6c78639cf90375db651aeeca1fcc9c56a1bcac620c879d0f10a4ac224c917996e9f1b4e33177327e4c92e341e4cbf088c22c71d2de579cb036662753859ca802e39fef55eaf008e6ef85d20e11dab811c43a180fad6880b663ede53a1b6256a87d46c68e3c8c7d2f021e7714837d0387fb54ef68af9d7a607452813b08015bd9bdf72b80df11d1acf3c9e82482115f2a47ece077b973c733948a8dda898587f55c10b4c32453cf10ee1bcc5e5af1ca6d4c725c8a041b5e22cee30929cdfb29ac70ccfc2f82ce8cff592617f49c18c847ca7540222202eedd1e9056cc380b6e606c36c8c61f311c015edd2b9d79a208a511292be192be47673aca13a4eb1854bae4428e5311234a5321eb203a814b5cff281cf44199583df1eedc23cab495119fa07e4d8759bf8e1acdd1e7d5b523ed758dac47192412ed6790a04cd204f454196b13984b283c576447e618c57e4cdb6ba833d0a4e3cece01349e518784836a2e58fbb5dce48b12e030cd028d4cc9ea944d7fb80106179ae659387d542030362e9c5ee0d29c0a933317a370a96363344e87c6f370ef0a77f82e0600d8e990b0021078fd3afbd79ea9bad6fd9a6f2c2c47c07eb62727a8d5393fd84b8c0d4098375434f2d150a583c0b432b14082c929a63d9553d1ce8b1c993f22a8e38775f67be0121b80816bdf19dbcfc9db395778083022d383a6cc8dd451f3f1626e4f1bbba13476cb2cab3a8b2b4a2d06258d24de5c248798700eac70fb588690393a0cced214b705f1f1985a915c935591f3f245117c0f66791370a234492be0bf305a00ff29703c5896693f58820fcfc587238718881472904c3c73fa86236ba3244d6c2ffba4205e32b0926266ee49b4d9ce24ca9b28d3877bc20d0661f65680b6394448ecade00eb9fa5ec48cd51392b9f891525e10597004d5c2c58f625d23c1c6c369b332a2a2ab53138d0c938a56308b87cb9084e820fbbd97a8136f2bd32bf54638414ae01a0b4c495005b18027e4efa445c502b310e9560c8834a7360d9e368eeb4440e1c0cfa8d379390b61836d44b981bd746e199d8ac3d63bf92c45c00a39d0b47bfa2565684e146d960a12f8724ce9fc2281bf95f706bb728283e1bc51a05ad3c54835444517893cde4398c63ed56ed523d50125d9da0a2d2893f433921f4ddfc982828e5eca732734e51504d4ea52bd3735519422edb90d12edb9cd4c7b5bec118600b7435a30c8b97b990e799a0e79a2e3b52bbea0a16cb9ceb078b24d5cb90497578691362525964ddf718e940d7416e247cdd880b2d17c0ef3ed5c46391d94f141718019975d42dac424381241ab1f81cc0200036ec848e5b92626753b484dca7d91f74b7d685b596b79247790b4f43cc589cf37212a07a61b08dcf84bb5e153449acb7d6cb4ec41192ed79d4463348ecb903682354c022132774465aa74e59476cdba6d3487785c2e097f331ccf1970989b1451ecfeb4cdc4645ac07e642f80c5765c36a51e3ec35a7c0015e984787340c20249985c5005b784353c89444931bab89cf22c2281ad6512f20ae20acffb5d3b59cc5603595ba99bb3f274da372f996b3b7e86d7a1567e34fae40886c4b01d9b18c268960e958118f8b89007ef08c5238824b814b18c3722b2b1221c91ad35f0e278809e5b44b0dd8d17a05f027686371f63b14ec686b941c19e11676e567a41bce0853c7b26016145bd499af3f158f28a9e0f4bb60763549a1a99eb64ec20cfc1cbb6d38fc5f65d7e58efe895b0b553b7b6002325c0447428c8d9e78dfa150b72ce31a18fed2b9c81ea98d7af83eb2e5460d6850a9aa235709dd40057eb983610e67dade9a2ce8edb390c6e15592dd54b1ead5e268104016b840f295c54fc4839290c640747229f4704cf1d82338c5ed4550f5a558f3151ca3769f6c6c7c3d8ac6d820b6faeaa804cd4a75b70d8b64a190709cbb690301ec132dbc7652e210361d3678405922ed70c0842b6b0fcd2540b792ea1738c2d7452cb9be6784f6dc561281214713128f3525293a05cc3a529d1580a9fcb475b0b9837176e0d0cf8bda3d4ca58019ce0794d9b816fc07bd366f20eb0c36fc5cec6adb2ddea5f8b73001d8111e13ef7d6fc64dc20788925ec50a6a4450628058e218ded520092a95c99979a416038214c2fc40fd313f1a3d3ce89604d800972ebe6f9d0a550dbc46bd3d1fb24e3a6fcdca2adf852cb9bbe4a6a6658aa9504e38b98622de34d8b402855c3cbba486ca6d7668aa5ac0725ebd1722ed66bd3bf59451652985d6dd3bb06e7743c74ae24fed0085f43db7b02a75dae5e994f7d55c726fe684bef95d9de2bd9789580ac0b448e37d0a99460ed43be2494246c45fb1c199bbaf556513fc3f73314cc9abad5d3226117a19c5d10a81162979446dd19b0d19636fb491241425ccaa0106509b5d426743f2ec22a6aa45b5f8c500227422ea796a4ab19e014c90adc14a431166c98c30b24eea420bd9e8e927286e1d871c447932dd5054be7d8dc6255c4925b044ee659ba2a4fc6c4cddbee926d5ee2a5c0894f8ba4e55ba70e1bd45541ca975e2502dcaa6c7ac7f2d9349f040965c094e8440d377a5143cee25785634d931fecf483cd17ee0882cd9e015b9622835b796b1601d0ba16e2adbc89753cb35248fc3d75ff16be7089e9eb6137bfa7d6c050e65d93b9699e8efb0e5da961be21342025bcc3867719b388ed3b0b5ddc7a1a57f9c6d5a5468d425966df54ee17d75bc95c602b258804d13061764883955a670739ce420d5bd597070687569da527eb8cad93029ebaed12b4e438f24c5d9c9a4258f34a1f6971b26abda4cf5b26a00a886a5d7db9178fc6b86b320801472755813a574c24f1189f4fe9e5290740bc41ac5b854b66488f9dd21ebb742a30293521fd8c23fc04f016c3b1ea37629907d46427699cd0c57a5dbc1aa77fb076adcb7a30ee1a21ccbb8628b7edded5be99c8004d9a3399a42e035e7513c022390b0e27e38d283f57cd2e4157baba806f34c2ae5a186695db5836ba22efca3c71425e067a1466f4edb6fc5ee531f2a660d1b3d88ca0ccb3810f7418cdf48503b58107e62acdb92b01cc1dd731bb0cd4abfcaac94841b4ed51220829db0e52ba952629c8252b9366d85efa27a07cb02d2197384696a26e1ed3ae17f7965bfa659a8105a5d3312bb1651cd249e3e9ee56d8760241ea7d54cdc13920bff15e22b4343a81e231c3a0f34b7a08617b1d61f205e7cd00d079ed3f433100b1d6725605dcb658b491cf123e962bc256276869a4abae12646e29b559e89a7c3cc6a86aec581077c2a384e79ded86721320e0e341ba2f215d1695789dcf0772f9df120e6175a8640e024b656a909694c42925a500b2aafa14097273d18b31c0a9a235998d5c6354cd294659d81c36bcd07a6771a6459060b9eb63de249553a1619bf3b993236fbc5da8f3413bf85cc65d65765aa52c91eb96c28a8f154bf11666cc28d2fba8081a51e212d8f1e892b0d3f8dfcfbb2017587fda4667d8ed4020d44384ee0c5a185380b66d2936a186b41c65b00a020d8420399217271a0bcf8e21f867db539d776c19ab7d40b21fa6143646aeda2d8fb22b2cd56d95d17204359aee55cd6b23e559f16be983896dc6ae0afb8d7cdc2b6b305a9d47751ed41513fa69deaa2933d69ca5e6bcb8555e7bbf1135ba62eb37eab9545572c61a06b6517c34e3e9ac3b3644784c5e5ea4fa74a398ed3bb4e81c8e0d9e60b512313d95c36d72bc64d5e9d5d1363d450d002d651dc6d219ab99465bf8745d62216c8816c61344ba75856c8ec3d1441ac79af2c05b7e59a51f9fbe6f3fb4d43a0e582bad6f89e6a9445ee51f22cf09d82c20cbb3a53eacc5445e4d68000514a97ecb7e8f384050b1835288141ff3daa8f4a67f3797c4bb6e9aad294946fc3bcb9d08e4ee27d426d852a3d9f5f0ead9bb5cc1bb90edddc86763b9e0654bf291c9b090ccb82b2f34e9a6cb6d264c3720a0d8525ac5b72562e78cc17738d3dbba17b352aaee0ec351397dacc23e8d5da4932589aeb6c8aeb670765089c92ef948408b4b256369968402efcc6eec9b41b59e51aceb3ed67c2cdb017052558fe2452f9ea563675b579890763d70a4458a56658375b62f078e1ceecd59ddb2debfe547e625f5cfee55b446339ec538a0c64b40f9528c95303811950c83c7b69ad152028fc660911c2e53de28dc94e1b80ca17a2b3172bc0aecca818e056d658e5de2c046ff84ab136c829752dfc548b655fd48b4593e5c97adb2c1d4ad8e4756eb745ed169615db25649c952256567ef88e477b55f76a8c3b136534bcfd4c51656135f8aa5b8393d86a95e6c587dc18623d91f9e37ddbc547057905516aab361cbfc2266b47a707b1c60361a766f20663b13e8e6c20392bbc2b496f2a6e971cd41bbc1698e95d1590ad574b273120f5c50b66b34e078b2d5ccf4b4ba8aa4c5dfc53d86043acc46ad86aca33aa666175eccab7836cd5a03482a200316c5bdd4dbe9198c8e87d8164cc4c2b040f5bc15a880ff5c6f49bbe82480df3dab0cdaecb2099fde15347caf63f08e804d8b49eebe856a48b97cb6ae863093ed8f76d6111b31bc1a7a8743f60e57e7e50ad3c2d5cb2443abe165e68e0c66f8ced9c27bed5ecf0856c6ddc2571fa5bbdcc77477b870abe9d8d21804a1bde62c1d6bce85f0ddb75bd3dd81c2dc5d3a08b82d66a3679cd5e73b7daee22d46dc4efddc5a21e75be52e7d7a353bc431596df14efcfd62955d9c46c77b9f5a7120b8a501e9e00fda954ff026f9b070ca5aa0bb9d887bff91bb9140bbdc8ca4f73b273adcbd50cf386477b967e6d97d42c36fc9166fe9f47d6524d8162ea29c74677240592ee01e6ce0424f8968064fd114c011c6aa85a3def2ae7493a55a636abacbbe74f711f5b482374754824d083392045117db4527b9b13121658b7a7588c9aaafa956048652f46cec26f301c58c585b325cc57784add7c2b8db703b6b20792b2e3896329c796f35f82fab222be437461752e788b00a526135391a68f715825236d90daa16e8484aeec5b5dd118f00e47b49129a0722aa265ea67a1787fc3640b53a8234e223f1a8c233afea116b756568f702a2240b21c226a5b4aa1b46e383f231b825b0dbf360de9f49b48886abb3b614948b66b13b5ab565759e8f6d65de4c7c343bcf721b03148be4a42f734eac6030e1b814aaaaaee0d9309801ac7a3bc211543e2adabde1b8b8b661707fa1e0d25f0054a322e8dd81d43d41d434feae678e5b6c86064bd2ed8eddb344dd6dd44dbab32b0ecb7732d1a408a7736ed03f886652b7596029d6026d545b88659e1f621b2612dcb34c883f480340fbf9583432cb7a5aa543f62ed7e99292275f3b3fdff5ee5b0fa2f79a8b168518d83db44b2259507221fd6c89d846b660f56ef253ca66846c79f1801bc3bb32e3d930c85812c00afdd2d567eeba052b278630acca1a58f63a0c183b51a4a250a1b681528291243bbb93d8a15d9f6cac8f3daa310b9b68f2ccdaf998dd57bc2afb83b8f821b2dfd1ad68290f097849bc2195510a012b9a9659460a8176414f2d9367611feba61207e2e3615c6a7c7aaf12e9a3be37b91baeb88c81683775f16ea3c8cf7cea03202d7ebc4a0693aa7e46f74361f7dfd8cefcda2b06706773bec3c07bb8c5c75d2de35bb486fb5387fba38225d98249b59881a5e6437443a8887b0fdd82db23b0fb8377d75656e7e391d225f9451a580cbbfcba6039969498edcc5bc45c6d379ae27230031e1d3b221c230c7dafd3ed1ae0e5aa901c98b5cb43acd9ba3620758185257ceb8dd96b36d0a5c4f6006122fdc9d1fa640d60b4620f4b9174d4eda077fb32d8bc01d75d2f3518c793ad94f09416a6033741a7d5599c8d60c6c5cc205035163330c4d55836e479497d134a03b5eec153af63435a604d7d80bbc58498966cccf262477ea4be552bb458b7b75268e7fa90248f61eb8cc475056b8da8f3075adfaf2739ed787882723d1fae233f2daa4fb6c857cd89f8c31d28abff81ea33161fd5c3e0aba73b47debf563f8557c31dae52f183ba039cb98b34116657d890edb48c58e806e758b2c383d78e5b73e8f54bd65358222526995abb1034d8bcbee5b4ec1ed4fa9c232071d2e4aad971ecb482575776e49dc76addda9f64c62c9b7546ceceef035ac9dc353f1c25367a1991add554d01d8101bb0c35efd6fa744a21d6a7bb15aeaa93781cff1b0000ffff75d57d375c3c0000', 1);
+INSERT INTO public.lsif_data_result_chunks VALUES (39162, 2, '\x1f8b08000000000000ff6c7bcbce26c7915dd55fd5a22d53302c1b90fc045e498c7b4678e385b9b07686b6821684d8a60893dd04bb09db8fe07981d16834f3a8ea41446664b5a4d934f2afaaaf2a2f7139e744f4fff8f0f7d779fefb5fbf7ef7c337effffbef7f78f3bf3fffe2fd17e7873f1ce7cbf993cfdffeee876f5fbf79ff3fbf78fffb77e787bf3bce9ff6a55f7dfeeb2fde7cf5fa579fbf3b3ffcf3711cffe5c3ffbfcff367df7ef1dd6fbe79f7f5ff7af7feedf7af7ff9abcf7ffbeefdf75fbff9aa7efbe9f9e971fcb70fff749fe72fffe6b9dffcf6f9f36fbe919f383f3d3ffce9387ef2e11f5fce970f7f3ace0f7f3c8efffae11faef3fc0fffca0ffe980bf8f173e3fcf4383fe9bb9f1ec7f16779f7e10fe72767fcc7df7dfbe567f9f15f7cf5f6b36fbff8facd2fbf7a7bca7ffaeefbb7efdffeeeed379ff5202f8ffffcf59bf7afbf7ff3c5379f7dfde6cbd7ff77fefbfafbbcc73fdff7be7afbeddb2f3ffbf6ed973f7cf33a6fd9cfffea67bf7ffdcd77f357fad3fda1fff3fdd7efe745ffd95f3dfffeff7df7fa5ddef9f3cbcf5fa122d2759ee3bc19828f1cbd42c24135bc63201ef594bd9ca79cb745e42d39efe134f21690ffb89e3517ed1778e01ae2183d84a1be862cb0af3a52ff0cf65586e7d981f03c2bc7ad427ad62755888f9b147dfe4d8a765c3ee6edcb071faf90ccc6b93e6066c71dae709ea79d77b8c4710f1ff9fb5ad3c8350199d6033594e3c5ed3ccf385f5c8e1b85693e8dc270dc2cc6e779ea99233c6e37a2f9b71be17193d8fa9bc420dfee1267af47fcb81d87d4fb73543346c69e3132d415e47d05e97891352391e3a65133903347795c18fb0b18e2c72b1419fed3da13917dca4e7b5f85465fd521bdf128d6431dba9f1db4af3e8764b09f45dc67abdcef4553db6fc0d8070adb66c4f7cf40dbfe6cec17c890e3151a314c5364afafe7b145e42d618e5f4c5bc6c7bc187aba4028ebb5cfd2d191f7675db625eec9a0906d5bb6be4ae3b15a813d47b6fd3231db0f98ee3728f4146c4f8187efd970bf0063bf0b0df799d89e39c5e3b08f0f61eccd455fc33b3edae6a1fbb5b2cf970cfab5ccfa9cefde7d06da7314ebd371de8fda5e0390ef77c90e09b98ffb63fb0bc0fb2ab2efad815e02713ce7cfcf0c028e5bd4759abda8f3710f8db47a3e7394466f86566efc4274dc62753b1fb7985e3eacfd90861eb711ad386044562ff098b62652512c6d4d498f57c864be7ecc64e3b851c1e6d751418edbbcc240c545d4e32687f57272c8970b88960f5f18f9465393f9b101b262ac43a4f78b135790be48b71b626c63458c7cca28ae0a34469cf73204b1aed16018c72dd4b312a20c5e261dbc4c3274b060870e16cc5d540d5bfbc61ac71de473de394ab7e321f9dd386fe4b2debc851173e4546f618776dba84851ab935c9d05e3bac5b43cda79d07113e1587b46b9872ab603bf65a054679d1b23fcb8583c8e07f914ce156438470d3d6e19846b4903e3b871c04a08686539e2a22f2ba256f2c953492fce07d606a2a51186c058bb21f358c9d266ecbc28724a5656ec948faaf7a39a16e383d6577d40ed118f9565ddadb32c96b985a671ac2865f34885112a033bcd9f61b8ae9fb1954d19fb9cccb0383aa3b19501d363c094d3917207cdbc3632cb9af1da6ccbbca6c3616e9a8e9159cb6519bc67e27f850691734cfb631ccbfe8024f71ba1f71b3c3fd59b86025a299a789d101951becc49ef19cb696716f21d8ac19e502c8a75a6eddea3808919e774388da79c37673a6aa61aaeedd7bc8ed7adcec086d18feb5018f696d1dcd77994d6438c35bc0785f7ee72cdcb2a2456c6abab31f65522de57d5d2f8da2571509da73ad6595fec3bfc721917fb480b29075bb0ea462f88c663ac3d66032fab1fb0adde222350e0b43772cfbf01d78e1160ed18ea980184a99253868da00e202e5c0fc5988051c19fdccd4f36e404491163d976a4c7a12a7774d44294c27391d3bafab7c195c7839721192e5b1fea15e3d05718c9e3ed2972c68aa05e5d543864106903873975c0e944b78cb1330c7a6dfa009e6ba741b18c577df427a2f201f90a374f9ec7e959330706d5f98df51985277f52c871f102ae17176eb51dde2cc39b802c6429c07984c4bc511f33150e5cfb5ac39a130e9c1317115c13475dc7e7a3428428eacfe697d85722e3695d65fade981e9d776c963952d7350aac9d29e8853b43b503d94496f53e5ad1ab32c68a9eb2be2689a8576cb375b7d63baf8d5821cca8e732328c2ff681fb7db49ed3611d2bc3e8b86dd042c336082b105430cff320d93933c48e9bb15c222d1ebd5c000cef69b7997a7ba761ba6c3207dbde2f5250bbbc6c01b6386ee1d187c8038e9b7881901c55bac4e85f30a61fea0c00964bc99caccabafe56e6f4255d0bc228df3151590f24ce7d850863e37d1895c0d06c45588c2421e64d42cce1b86d4e223709dd8f9b231696e2449519ecbd83bd2765a262149a493f290b23f6f3887cdcecb1decf1e705c0a7342974291442728237d416ddf8a686022335c59db3a6a9e63e0625d39d2e30e8395fcc31260891b2c289344e015aac98a0d35e79507c73892b4caf9d0d73bdcc77a5526da57a8ec19d42cdf5574c126bb911ea6f51660a60ea9e96cb7ead84735f8b84c276fbb2c79a549433e6b6c002bc1f3685f0d482c4048b1282c663216d6ce98737bd861a143c1f69a91613df1132c722e66cbc92231695af9a2c235948a7321335c68b2e375a4d623985034585f162a0cdd31851214d00605456e2d9a7a5ada99318d736100cab84fa39115991f578c69b95724be6396053998cb9b00a57d0390730b778eb2cc51b7cebc92675c79858ddbeeac88b2a137ec96da0235590b66efa818ac1d2993b6dc288d0731a9e92d34d62a248941bec465da0791b77d00ecaccf60133cc88afc88fa881a232d85d897a524feba712c53b9714865b65149ef2f82f018950f35885fe66f811a472061dd43e9a3c2f628a75231245602bcc9eb13d81e93c384f24a6309274a232725e12b4bcb4c2e1250ebbeb4c27745437dc00ee8e4dd6ed7ca29da79411b8afac880a3d02e57b0e176765e9a4766a41b2757cb4f5b693c6cb69e67930c28cbb324a2726c0952e3bc823651c7891cd9fddf2e57da8c913e4ad8c89bbe3e143de1c576949dd0d5365115dda416a1566d21af26b6960d0905a0330534cc63665917878fe38e80b5b40848663890ce876c6498f00e13b2777119228fe5eb65ffd3d7b940adf0883b77f0c2024d0977b5569d8c78948250d7d26f85ac495da6e3b452db9102ea8ae18ad9374ff85b70a2b94760e556027a7e46457b3b711487b969f8b2734acc75c58a1f756e0503570035193398d9e689dc718ed3623480daf5018e4b7052864b0affd2f0867b349c2b647a7326729e277d5364ca1503590e6e40c73d66dc29e72be405eade0aa0163260b3bec236230b8d055329a2f94e4525c59d16a6a02783174843d37e94f78f30d7630545fcbccc179bd77f331d40055b8d0d7d04d43dfc4823b1d82a4cc0b4f87bd0b6ed4cad130ec6274b70f42d6e4d1458c309ff4b8ae005173d1ae80d326c588c953d07db8c4f82de610259c7cc5bbe0cd67d9d678096c192fad20da8fcac9871eb0621a547803e1941332310766624accc68d049959c3bfd41514041e69765ffe5a34f641329d1a27273e7531fd488184a7ee085ed6ee23a7151f77b65bf8dec21763c8947e20cf5caeacd4910e3b874f9c2a5238e3b1457080cc5cc86512ac4c4509e74a0e528019afb5cd242f9d23e672ad159d0e37c8e206dd46d9b6bb90fd3c2a1ca544840cad34ab6299bac4daf102a5cb7ea6ddc1888ac4c596d749e82b1437cb27a19325a5b2a614744575e301f4db3a37f34c68ce48e634a14b699356ba101768d8ff0d36d660d5a4dfdb868ac5892fcfe66c3bf24c02a3a55b35916d8d1ff915dcb7a0700acb8693b46b6b58cc17dcd071e17b29cf3d9e49ca2d23a52d9a6cfcac37cbaf6d86d4922340d70225fac9000f4f22c66999dc271270aaa4890a33c0e88a510d4b0bcc2635ff17a9d12c6bf5be1829e20c18f906a9b8ae24e65045b84a6b9d2f991fd2c283e8581fd32d0ad5233eb94aeda2a7806b8d2f00a4c326c12cb90bbc6d2bb56f3bb59c597ddab949d07c3a4d9ae3bb2d123500be77b11b90332222786d64a61b9d999f7ee7c4f6b0e854d61c37180e94f2a9f4cb8be8d82754b4ccc0f7cc05d1f818f86be35714a2891e9d99660cc3a9a0d23558d27ac6b3ca1c765e08b294001af5570ca11d796b42d2b27dd225df98a490be4b43877516d0635a3a9614d45085e2d6ae20d1a69b06f1887f2c85b1bdc498566c521d7e256bb1c04e64fa504f4b888c7c77350298b1ee735b63004500a2c84fbb6e08a4f6201f3e14943e6c13a1ed780f5d651d2a039e19c8af9ae5c00ae487bc7526684d6c67f2c1b48e58bd8f9c22b2c782ca587793462e666401e15ba38d85a1b9bcadb5363c3b0e276d0442783b50d59c66763e964ad6a1b6cc2e630aba934565498fa4861800a7936885a43e535a52145f3289ea26014d761db62105b4e4a89a1f13c4d56bf582553a2be012de50f5815b3a12fed22b1429e1559309131833789b760137b348a824f105f52748278c5ad5c20f3718758b4e26d3ed15d2b70ce4f99d1a7966fbecce71104a7c8a2632c8081c31ef2914be66882c89104d1b88508e3aa9d1828aca4f994f700a9d6c8d82224ef82357124f1f44d3c1d13c746d78c292453bbab7639678a3236bc75b8025245a75b071f5c323e2ae8d6648b45280e5872aa8c670af0941c295735bfd64e706b903416264a4ae19b5278f9a2ca2403376a150c2b27956857473ca8af4d7377f7abbfbdabed84a32b0d5a7565755a2204193fa02933a1d31663aade2304bcd0c5849f552f5aea82d87e4ba34b2ad08bcc5d92cbcc527142c68f1e59b7a148ec8826c63b8c3d5775b374a402f4ca9a6c6dd61c775c67dc5905cc9fa457bff6f4e5c5bab66ee3bceb80631220d981172a7b9916915fb0a45f3e295a346350f756207414275c0a5e8eca53062eacc7b06ba8605cc40361851601ed60c725b3b32e872b4c77b368cb23c95f6ff155b5cc911df7205872caa08ab63ab4c3f24611e833102bd0f594ab9613ba35b7f52a069a84dc53e131d93511dc9a1a8d5d494906739bf60c4c2193b435cb3303c8f0ddf7096156c23a2eb1b518ec48d0c07f6db4ae5228a7792ea9673be3287830069f4f50b966134642bb41d3f0167a549687543db571e172fb115d92849a9f80742642e80f0a8ce372eeb6142e4ee8dd53c3de0ab6cfd2b02ac4cba38bb5923f513bc7ab75c285978b3e1441ac30cb4da49462976247910bef3859df20f29d4e689640825700f6dd3d415ab88603178bb3298914f94b8b8a497567454a8a49772f0d8a1db784352908d3fc1badff46396edecc9b27f3360a9b1c2b19e9465a4f6fc8d8580d70d65b28f46aa61c4fbfc20ef6c92e9343b5a20aab221db1e4018cd5237223a45b38c1aad43a416e9eb53e583697887d2dca4c0bae45df5f652a8f95b04cf131f8c5bb74059341d81891663a1e3a5eadfcfde8870a7b1bf0e90391a78da300488c16cc62e44687d67a27bbac7a98e2ae8729f271a94cca58dade8d536ecf8c812eb5a64eac5aed12ca862f4f9f53cba78546c7e36ed1a86592f1ca7dd55c81d28e8eb3080bdc45588d2d3250250f70bc3af83c8a06e84a478653e014a5c52fa1295440d7850625c9468e1d11631c972d39e132f6e38564f787e45769ecafd22399e05a2c59e130095dd1976257ad18f8b869579e689438eed0fac12842cf861f7752e439f4dc66bd20b0b5eb52a9083a5a131406a1680c327bef68e1971214f67cf9b87db492eb23aab8df7d1eb370e1c26b5b5ccaed30581a99c48c4f61b4b0da2366a35286ce590daae45eb6c3b117e25357de38d492fc9043fbd3cc6e16d45b0d1b6ff0a8309e79579328ba77d1d85d9338face665e447968b486aee378c129dabf54abc8b05d542b6d5e19ba72c19a9b69d008bfca49da893c479a58b9bb8b06143e2506d8013321a2067283b092200577759e78c6ab2ba6ad9be244316aab95e08e19656df677eda202f2d329d0fd051133d0c5728b2b9e9e355bd1216d70f601f4bcabfc9901d6a79a50ed7c79ae0e1f29eb79a64abbf25658968975f786b0cc64452f4fefc05a40359280f0e676923cdc7627820d4b975ba774998ee31adef4ce930647df2b2b25c05db54c07d03e70d1921988465b29d16c95f4d8b2afcf6a89e092224876350213a4a2ad8eab1cf1718b39b40a5c660900d1aa2f4c0d099027e8b129f3d96c696d24ca83a6d4d41d0b62b84bef304530d14f3a506f8515066c4d979e1ebb783adc9ef6c2a92afa7965124c3bd4e610c4557fe110ee0620ee4a3eadd291afc85ccda7ab2767747387281e977ad76a2733f7012f0f893c5b65cc9315ee183e3518f32e5be64655711bc62e6e4fe20cde47c6e948b9eb2d6b9adb71b1754f44a1320cfeb8708d043bd440a5a92e9e20a6dbf2a0564612e95d53835c45999ce058752279081e648cf2094c8af217c64109dec5dda0e39a7faf9f265924dbe58475243746f15a9dea4e75e394fa225cc9ad3aca78cb9ac9f16f236ae560b6c689a95c2b3afa72668cdde8470cf39071573366eeafca4c999f89f3fdc0fab3bb513602923d444e948606d1d5b6dad5906e450bd6e3a62d9595fc71b370a33499063f629569d0c76e552bad4707afbc81f6486503664932e2d5b4b5a751561f5a1ebba881115bba4c6696d9b7751cd3b968d21fad38b6e55d22ee165f7b3a78e1e9631e1b1612555e90d10527936e74712bbf02d7dd20eaa58b21c4d88d16253ca57bf6950471b7e06a7dc85119ac6c839d628685be3c8965e19ee03a153c1f352673967443e06e9a71b384d986ab657c58e67b1bdc1d0e63360ae96e55a0a407f7b0ae450ca32456b0dbd2c18a1ad2a686b3da20d202f5e8b682c11c09359b39c528fca73ca80969b74b3a5065d981e7839e6e17edb2af9490a9120d796517b708c663fddb3a783c407816184070cbe082982999b55332f371cdafa50b6b72cbd9829b9e9794f666b0b50e86a96fa8da5f704010bc76af1eec7aaeaf222f5aa9fc30a8431c8cc447a2fd5f024a38b92956a7718e28b1068c8f48e32d3016f013288ca9282d1e4e65b2e4cc2993012ef1309970c7f1ca0c1ab472ab63b216ecf20963f59b82ae7e0004adeac590ae5e54b90bc33f9638898ae55403a56af521ad595774caefc9620483f4693df3fc542f186176ed83acd642038e2e21eff97399bbf9582043113ac32270064d216b684cf3bf01c0aaa8e62c5a6e839ec59872bc72f7372c5d9bb939b7fa16b0b8a046473851f2c4f2bbf6a7e55042a4d7a6fe1fb5c5cfd0322d4cbd05519dc91a63176a3066dd8763bc3c3c6bd59b34d70fac1d5d2031ea35cb4bc9d0728a48d2e181388ee35f020000ffffe8abd15470350000', 1);
+INSERT INTO public.lsif_data_result_chunks VALUES (39162, 0, '\x1f8b08000000000000ff6c7bcff226c7b155f7748f653be420b071182260cd0aabf27f261b1668817784b70e2f14d6202b2ccd28a451008f002f8031e63ee07d08cf8dcaaaccfed9be9b899afebeafbbba2af3e43927ebf75f3efc9feb3cffc5afdf7cf7fd57effff3efbf7ffb874f3f7bffd9f9e18fc7f9eafcc9a7ef7ef7fdd76fdebeffaf9fbdfffd77e787ff7d9c3fad4bbffaf4d79fbdfde2cdaf3efdeefcf00fc771fcfb0fffeb3ecf5f7cfdd937bff9eabb2fffdb77efdf7dfbe697bffaf4b7dfbdfff6cbb75fe46f3f3e3f3e8efff4e1ffdfe7f9cbbffbde6f7efbfcf7ef9e311f717e7c7ef8f371fce4c3ff7b75befaf0e7e3fcf0a7e3f88f1ffeef759efff29ff9c19fe60bfcf8f9e0fcf8383faa4f3f3e8ee32ff2870f7f3c3f3af55f7ff9f6fd9b6fdf7ef6d5275fbefdfccdfff8e4f76fbefae6cdb7bffce2ddc9ffea9b6fdfbd7ff7bb775f7d528379d9fecddffc22ff5d3f919ff64ffefbb75fbe5f17fd177ff383f7fff39b37dfcd4fe879f817efbe7ef7f9275fbffbfcfbafdecc8fe267bffbfaf34fe6a2fc872fde7df2f5675fbe9d57ff72fee36b50b338cfd3ce8b218edbd1e03ccf38e7681caf8175c4759e72de4c3c8e1cf970dc2314995f8aa13f9f37b9c1707ec9ced70043620f87720d09477d011db9aec2c01cde618a7503b01a226a7d1545bc7ec5d64f88d1c381d13f1bd6b3e92704c91eb9f75c46484f6b3fe036ecb9100f3a6e2391f33cf99c233e5e83b8e2ab7501118e357280f91198beda6bc2502f4776bc0645f1b5a60a646b25cd87ec359d6bf11a2868ac6731c4386ec57ab6a2d0da197895bfc061baef4216f3a3e1f4ea3cf5bcc574f412b1ce79a9f27d9e74de20385f94cedb45658dcc724fe648d5e7d787d99df1a1d8bb41d237b551eb3640e7fd9559d6b49470bf5200e67b8bec1943d4db06c1fc0814ecdc3ba5a0c72dec2b2ee7c88e3b70d80accc0a1c78de87ce62b223a1eb706efefeb7ccf8b18565813c57a74acdde0f00e0fb7b989aae0aff7674f9cdad00ed9a821847640d998f921117b2f01a3f69272c3e75e92783ec1ed87f904c4e8fcf00ece0152e13d90c613734ff4fa8bef5620cf1bbc06f2a0158314222b06ddd48f9b59e622ccdc65ceb810c61fe5a245c0dc7bcdc7e4af7248b1867704ea0ee795857364667b24a31e1450a0804ac705e2e70c9f0b646ea2baad4d1275c97c89190171dec0798b041a9c0b09005e11002b26c23b26c2676c0101d6155a59e6466bef301a2d0047e7adeedc9b571b3886e898211239b98bd88e9b06ae88b969c0da34f11dc806b4a3157d07b2ec88f1078d463446e9e82141a74d78e62d67c4f079a3d8c60c57aa4596a0e3660cda3b8701c70d64b2a21fc8e8b8c1622dcd1cc97153a89f3b06320b99dc57c4414074c8f6620c72e9abd461c60fe27ae7381177c4d14c1846db2b431c5d0978ee071aaeb4cce17c131f3b4fd9071e37d1d8b046187ecc3bf0f9dceb35306438af49c65e77359ef722ac5521cca71548e430372d5433c05ee5c4265c406489a290bd20da394cfae27dbd8b1152a38076ba0ede487a1b8d2a202678dc6c1efb156d85870edbeb330ae72c126bc871ec649df8ba9335414274e80f36e2755da30719009e6a244f111ddadbcb0ec7ada07b9114742e1251a71191eb711352050bd20c1654d35a46b5b95a88326a1b31a10387f477c62afa46f32de97c95109065647da0811f2dec7deaf378700fac5386faed889dfaaabf28f67ccc22b6272ca67a5c1eb012d77da19aef4a8bd45b8b2c368b2673154dc65c64c72b7f6b421bf52092d8e8aa08b915489bf4202215a817bc07d07c4d05801fecb221fd58e7069905a10bbeb5120f27b6d55a67642a0a5f6bdfa9d700b5ef83b0661eb8310787bda821f58bf1b0a981a03de40cbb40ba0a85041b0470f30695892ace51e48f9372a8ef5c55353eae085feb1e9161c3a015240c09ef61737513a672b566fe31f01e494692627092938b016add55c7710b8d09ef3e57228b2b0bd8cf7202131656b62878e78d6ed0e4919b39af41ae42c6b6ef6a153036cc3267714dfe0645d706efbbb8c4fe054b1430d3d07a861744c7d83330c4a83a88fbceb954f5dcf9be2249c62643e0c233d358e4ca57cdbb3962228798e8820724de0f8e2170dc24b936f3c566f25fc12ba9af60396e24a773ffce67d2eb18f07ab3bc0caae476c96ee608252a65eb9aaf6412d79d4c02d894987376ea584ca317d701b2de80ef89b3569eb8d8ca131fbbe6cfb9ed5098550e29b8661d134823df4293a4cdb7006b7c074b7c67c9cccc7d0baee259b1613192d00dc19d992f6a32f00bace9e2c79d0d68a3532e9280b058acfbc0681a0da89de10a9d72140f73ec8a4f0366f156ddd4344bcf2d209b690870cc9208b1b776916121b46b577779785fc3ff40cab5709a38ab737ad16c4ebdc00c54ea2a2cd2a8799f514312ae2f0c764c62e3633d9901bc09c2032753afdc2250dc5cc08edb6c2cd6374796c860a3ebb2665821eb06397b813ffd0c52ccaf31c88eb04e465f42048d8bfbe1e43137b3ed9867b659f8480aeb49f0b83016f7bc703124b1f361c97313ea1d4072b30376702b8dca6ae531eba5f9a628683a61311f6c99eb4bea39ed9c51a0475146167cda9b8b3e3253822271fc5a747a95b946f431b0d5e9b3e113f16f33d9b7b2c518c0903a3d108f0b626cf6bd92c59d7e980142ab0025d48e7cec8a951601b878c61201d6571971eb014f8999b134e73d7754fda31da42d59c0ba820de44735350f407851d7fc5158b3cc831603874d05dd771e30f2b3402f9625d385c265018caf809aa88736ef18be8903844b8acacabc49b16e129302554b6e3e92b92de2be6d845b06c614a0a42540b3fe8b295d9bd94ae79ce05ae8dbd0e9b8c569d35b71c239835133988871b3e29e212bea71316d58e7f58ce0359f9b56894b572220e388b594cf4b12d08b0e2ff840cb11c0444851d6cae0585017eb41975adf4c408e4b50d68c64d279f0210ba2937aa4fee545a896fe4a3089e4a4399cb79bf579af7356ead7a026b6f3dcab5c3a59fa13ee7e3d51d010d48417cd8fdb253135abcc94bae463b35ef201c7c5b6939fb306096011bac5fbe60ff5f12074b21f0fd9b7f4e063d6a84deb43a65209d5bd7531d9caac12dc2817b4e02be0a35d78dafc22116d76480fb3a5c798d92cf80e0bab51c6abee2a3f47095a005b4f4f6e71458c4dc8dc8f4b6def54ae39a995d05a003a796e0bec98bb3077b8ae0c19c7ac237856459984ccd52a7a359f1f0d9a8baf0cc47bd31e96a23d4c45b2908ac32c1111a357cc238e8bbc5c9a958e40958eab7062174eccbad38f9f513b037fbdf14a8199beb8e30c67edbbc96a4bc926e110e6ceffdc3046861587602aadf1934111f2f54c66f30b28ee3959c00dce9d0d53a006296f8c20c59c70ed9778a4f26af18b7edc38320dd3c24b9ececc74ed22c4f550d4325b466ee4584e85ae6a91d6a06aa91ccddbc8d07bcf98777a716f9071b1d698f27492b7812db9bd2437a40b0203db721929ba09a1a28610d2031bccf72eee8ff1e1840f2a8d4771eb715b7b2d3620a6c06b38b2bd0e32f6e2dba818828a260f5f1528b574ce35df28939a462a70c26dbe8e876e0cf72932368f98a31419c3b6879bf46bbbb12ac755e5f53284e39562d6d65753a94c2dbe2313161680b61682992a2a09fc3eb5d5047e64df7b84eccb7574fad162110ed285b01d1a945d29efb0fdf96dd4f8315c1e9cd6476876fd4dea78a38cca081974dc0a6373088561597fc6b65434f75b22f6a232119677e153756fc27479f225f49d7c399cc55670add01c4dda34b6ef30472904868dbb32aa75935798ab5721305a926294a458e26f79e3e9310fde700e5cf1ea8b76e7e62db3537307e68e2dad454526e2a1ed118fa90d7cdc6cb123916d160174d8920497adafcabb726d936f25d972aea0c8030cdb963f8cf5322cfcf3e2941b4352f4946ad52a82f5a93d0eacd7f762942e356eb8c53611a914aaa3d6f76c94e27569b3b174b30f2c4deb8509c69566665688210f90779b436cc64f704bb649cad563ff5f7d5630089062609035c74576a280670f8558ab2810cb52cc0abbe784deceab96a7ce00c7655ab9a970dc8ea98ed34d1e8b92a7a7afe725545c04979db83d6fdbc4d6f81149696228e942b38bb1aab1a23d26ee632827615107dcdd9ca787c3a32276caefdb97564967c56661c05d8be728bb17b45d520d4ab7c7796c00f3a7ef2289ee2abc98f76b18f670687aec6d7e08a15a4f57091eab7a4645829364540395f881b652c6eae740c8b5d3b8050bf8c3c79d62966ad35d09358d4eb46ee8e0d48fb72f3d965bb4ec2319ed31727261a8aac0abf628a4cd9e08106dfa5805b454850ca09af34ce274429beaf032dd41c7ee88293ecc2cab67b4611a3396d879ef0d7be63586b4cb1ccbaa35e3bde514a35b6f50f893dc82421b0e02f767cc592e94aa67f3c242b2580261b34ee1b14c97a22824859531269b70930db86e328e0ba4d41f4fee235ac6bae87c0d406b3305d3e61c34aae532685603702bbd34f5de2d081da6b04c48b7b58c60a36424cad214a36a224e3099af8ae75fbfb4fa0eb215b60b63623936e53a613b2d48d95260c1e2020c65e90d2890729a7b4625b3b26add00d8f539bb4b62b98a75a1ebd62234de00846b3a415b76313e6d408ac5f1cd967e7fdc1f326ef37af5621334da701a129a3ecf6ec711a533c88edb41567daa793c0eb275611ff1c805a1f6e534ed71974d2d64212a1bc04230b11729ffd82e4dd206274d76d8ce0939ccd523ea6e13a5c813846b33a1c7e66a7e47983a1c89bb45402957163dce8971ca3ef759b71789daae6302f1ea37f324195b10ec1a8ea16928bfca5675b673b222a4b11c70dc5122ec8ea9c1a63069736d7507806217ec6e5619ea9cf0b0def8612940dcf47c64e97cbeda9a70ee6a4e186a24b894b38eea3eec3dba4353a24b30dd9d91fb47501eacc9d3734ffa30605cbb12d15394066de4d3e576a881fd785fa87eac477300e4160bd84c02b83f2db0197dcdb4688c74fb3859ba44b58f25d66e787644d312d127b0309522526d75aa04f528560df5064689c5e45c563624276033fa772b5ebbe34da36d56b4a79dfb7476c1a12bdbe0c780e2e77448f7cc119f3e933c7ae0e90d93b4f74bd8fd52227c9abbfab407b79ebf0d4d1fb6fd1c3a69bd0f0fb3c727ad89ec0106840743fa2445e823087abac0d1e557e5b1a345a78ededc9c7cea641b2da72633e751365836315e038874af5d96fb89c61bd859a91cac58073ad0ee7d9804cbe7a2f144a83d2ea267f96527cdacb9113bc8553b6f64b976f9c4b51114c7734ee156d23aea424aab9b1165b03650c2aa2a140eaf6a614bb168f8aa38aed7a3af77f7ae9bf943e9980a627b049b2ac8605f326556bb3ef05023924a21582ebc18bc7a1870797f927de1e233abca0d337af00617b98a05aca86d1cb3951db40f0c10ea6ea0a057577be6eae551922cdd18022800a6c56551098b634dfaf71a44a12ab8539dfb00d72d52f6c90496060f86261de9baaf231859696126fec04df8654a838b4645e2241468be5d7734e7a98aab22a8a4612254a77a8474ca2d92171c62d6ed51bb0b0b06fcbc2876ffb9396b831ea42141ce5a9ca9f44fec0323347639e0f1e052960389b29bd8334d0699353fb22981a1d61bec98f85b5a7ad85a7ff2bdda0479d20b4db959f00a65a8136324d8f0bd0ec78cb1894e7ac17bf93b0423cf5e1069bb2fa4495f8b306a8cd5c84837275dc1275d699db1197da26629e91156726c844daca034a8394f9e4c9229a3948be4b934c1b01fac90f5362bbbeb31c6cb337b4fdba8d1136cf23561eed35dbcbc1c92da967e774b238e517437951976edf7b5ad19379abd9f418518038e1b91aa1f8ab4048ed196949aed0dc9656fd76811d465d6cf27c1e67a1d62d85ad847cc48a82308631f41c0e580113cb617fb8b8662771c5d7dd64bc0aa97338dc2ad688cebd453b0c5d1eaeddd22dd3394099193166c823b66e24c4952e6cb72bc65ec9e14c6532835f9a142d15b79d9cc1c236bfe8e6909b1e34258bb7f21a4ba2728758fe9677a752e6730dc34b82d9f490089ac5c79ca4e19732afb4c99879e48d911144babed331ad918abb6d33059c35ba6dcbbdd6894fac1046265b8f61268914a23edd164ba606508439ae45cf8943de1594d78571a43ada2b40e1b91ec633e39f474e34baaad036b3af25c5402355bf355386e52dfed0ad2b950a66944675de4ad8daaf13abc58a4e1d34ccb6c95a84623fb739e26e6c64589d439ca7ea93af5a11e5f274a3dea10086dba7247ecc2b3d4ee1d117d9f3ca3c094272753470a970dc77904b30ed720ce9f2a631d1761f09994dc49c92b09a1a838763d0dc31948bb6ccc911ed73a0b3889739e2e18ae255d872f370f98aab26b9d405c81b45ce0e5ccf896ced8d436882441b3ead0ea1c0075c71a6826a0a0b4f4159c958c772b042751bb6233a82b4433310a843d3be43aba71b32c761b7cf5c1c83a7ad1d267d26d2e4b6b8eb25409f0f51c025cca657900cbe59455cfa4de31da05495d3bd0cba11d38abaf5050371f270aaf33b53380714cc2e2ce7f550081fa0eb3a6ce92a7b5c563b5a0d1b54d85e5f25a9dc6e287c60e9f0141510593c29696d1f1f24491423612aadfb463adf6d7961f97c76a69f5e547f5e5d58f0b65f74e27e9bc698cbd617908ef66a80d6590acee0695cfc3d26fe2c1bba32b424d33e339b025b40edcd20e2b5907bf961945c7cdcb774a22eb336888ca12c9198bf4a18349826f13d8f063029aa83e0ad571665094aad28048c601b5fc33480debf33c7e7e8956af36b9065a3b3e68e9f810b2b7d591859729ea65cc9af365eb60318d19f1132d2e80b14a018cec86ee26d41c25b7a8966b0e759d10e06d72f5f9aca07478d5d17eb49192aaa63397b217b0aeee758dea40a743936056e94fb98f9bf97129f26e85e24417afb62039d2e4ac9569a963a70882f60b05e8b8314a14ec43bb28d4682ae9d0a28637b50e4b0c2b7b67b1070ec71fae59c46324370fa0f11cf2ec9e3b68c063ddf6d91f7a71f67e6c54967f5b60f1e2cf1dfa96dc27d907771921e85b123face039b084f61c807f880cf28b3f8ef0c78d7ece39d193e9dc8ef4186d388f3a87758745cf461ef2212fbce9e78f32181eb1f7fcad08f3e395f5c97cd247c1133e5d767b4e31cdb045a17d96076535664c620333b727455ca3348095ac580b776fdbdbc2945831c4cdad65fda5c5a8d4022e9c88894d578c3abc97c541a34bac79c1de48b6ad58ad10f1f1b4e3bc3ce74926509bec4e8d37b9aa9d0f6bbd11f6d1dd395aaded81fb04faa06a3cf5a1461f0b8b0779a11c769f6a755711ab8e5bb5837c46d9643750870e2c8e1b99bbebc97cdce0d55e038f348d251bbdb91f5a9dbcb03a26193c158ec9de333591e3f8a7000000ffffd1ab593468360000', 1);
+INSERT INTO public.lsif_data_result_chunks VALUES (39163, 3, '\x1f8b08000000000000ff6c7bcbb226c7715ef7e91e8374807698b683f6da0baf44545eab521b2d8485b85370cbe002418c408480190430084a8f20bd80288ad2a3721495b71e91da9ca8d3f7aacacbf77d99ff5fbdffa7eb3cfffbcf5f7ff7fd57effef2d7dfbff9db4f3f7bf7d9f9feb7c7f972fee8d3b7bffafeebd76fdefdf567ef7efdddf9fe1f8ff3c775e8679ffefcb3375fbcfed9a7df9defffed388efffffe1feef3fcc9d79f7df38bafbefbf26fbe7bf7f6dbd73ffdd9a7bffceeddb75fbef9c2effdf8fcf838fee2fdbfdee7f9d33fb9ee17bf7cfefd9377ec579c1f9fef7f7f1c3f7aff2f2fe7cbfbdf1fe7fbdf1dc79fbfffe7eb3cffc77f72c3eff604feeb73e2fcf8383faab31f1fc7f187f99bf7bf3d3f3af5ff7cf9e6ddeb6fdf7cf6d5275fbef9fcf5df7df2ebd75f7df3fadb9f7ef1f6a4e7d4176fbf7efbf9275fbffdfcfbaf5eef53eb277f74d7bbbfffe6f577fbccfcbf7f74c6ffc603e5c7df7cfbf6dddb5fbdfdea93df7cfbe5bb38c8ffab0fd6601fb6fff9abaf3fff642fca9f7df1f693af3ffbf2cd3efa8797fff60a886c9ee7394f1feaf10a782cbdce93cf1b27c1719e72de4bb046ca7cdccc2beeda23395e01daa27c0cdac2e315c85cebf24b04271ef90a199c4310dc6f53c4719fa79d374d92c347863272c40b72242ac76d22e3ccffd9f6ed0be4f6b728af78f46daa562f011a391c3a697f161bbff2cb886ce539a45597ed711d1d0a358449c73d9172d61369cf9a15f5c5978506ac58a04973ec53ccf851dd2c35691af8c13b31bf770eed45917a254cd947d75e3fa0e36664c92547a6e35622387d9b94702f05f2ec2de0e95b40ba3f6f5f3265bf8ccf7b2dddcbc053254ff194383597c971c98cd75c32e97891759e279d2fa2c72dcbf20b64191d378cb1cfcab9477b2fc59463cb41a8b660ac05cf94f9b889dc54f6a2d102ff4ca217bf0d4da8b70bd8adc8e007e7a9e7ad087b8f74afa2ce5943d218deb64472a4be6f7e0c392f04f1458edb75ee2590c93fcccbb4cf4da83b6848df6cee087cde843273219928d78d78d4b141e92613c0dfb1e47fc729a947282ae78d4c3532ceb336301f6be05fec7bd6d74db63a0b399a8a395a93732b97ac5967a14775efe2fa96193ec0eed73d9a3dc551d7f1e82fc5bd786a334d0bfbd49a328e7b0ec6b0cb39c8ed1249ca2e917c5345396ee6d14b07bcdd86e6c00a4773ec2524b45547d0a6db8462d8cb04c4b62d58c78d4c7ce667a11d372c1e69a28be6718b19a7099ba1fb01acf81459b514d3dc9fe61810a7268c5ab985ebb841ea292086c7cdc4f93f13fb53897608b5f346f5cdde018be6aad170fb836157d8170e28831e2616c6cbc303d698c239ff31651fa1a11db287bae3216046c127c062ecb2df3854eaa88d79dc540fdd233c6eb599914d4d7c7f59323e109401adedd4372c458f09376c8f7b05bca6e5ecc5c6710b6aaeb9a0ec3d018c2dbd11f61ec9827dbdee251f3b90c59e790827771a538aa82a11fe3ded18c49aedcfb2f35ec322c2dc8ccb03fbc0dc2eb5d14e62f3d8f1353fd0e658c7b69a99160a281e8046da23ac555ec5e64f05c9d8b487b59cec0104068cdc0718c37cdfd7f47dbf60f5b6d3ac0c0676dc24332d944477269d155479c61b27c84beea53ba23f9f717b8712e095c95233592e7483b1f39ee2215365cc5a8899febb88f0b8172a9d7993eeff6161fe0f109198c7155b635c2111d6acc03ac53cf1e472ea9edb4de289c967b41313d3e0d85fa6114800ece531ae5c43245fc3b9c699ef99d3c3c5a24e638bc29bc6de83fd55f804711ebadf5d5b4bb2ed7a61adeec2f01e6128ef111ec776483b1fd7dcd6865449927bb966baf1cee17b3b3d7d3a20c11c2d18b5b12cabb7b8208cf4b6b3ed6d67a1fc5096bd95288ea0dcfb656d2746c5322754b0c81e9641d6a0b2c27090a6021bc6ecbc6828b926b83c7dc6f2e0b3520e4d7cb8b7f9a6c59a09786d30273c5606455f548df8b2ce3d92082f0173aee9706afb232e3d6eb491ae8b36706f07e5d693c4d62d69579e9d1aa7477120c898b57424123268e446a3a0004a2037147c49bbeac98db53d5c5761235ddb0427ac348b096b1e974aa4a44b3990d2e2464acbb1d4d2c252cb339451ae3cd9287844ba1cb0025df5610fb00b34b7ce1b71ade3e625239d60f13a6e32cc004b061b4b095a0649c1b99dc6d28998cc67209545c1ed454601369854580ec316ac721802569ade18785f9aeee0376d54ba30b03d806ae7f6cac118931f9841d6936a060cd8c16a642edea388fdba1200c7263a57604798889899554df3d4daf1971073b509118e9b1534d72a401f0fd6974af93e59396fdc58ee96a9e94732158e2b78089fd746ff7bb7a1f67dda5e55e9559548a61db2c71c3b98f15cf16ee519d8648af3838b753e9ca06106a3146ae73e681e2e081dd8baa3a173a061aba0cfb0fd81407e60e31172c333c7ec15ab37e8b15978a4609881ee4b3503c61e05f9f0acb5c3e2b046aaa86ee134b9a20bb9f730c1f861b8aa26ddb86d3b6da3f446088b8b8ee007735cb3d184ace673f8f09925e6e02423b20f77fa175e09c984f57881e5e1f7657b01af9996c0cb579fa8b12211f1715b80892085b6fdc6f269226eac8caa5762af35db1b470f459cd44e592f85bed2a185c93d8b572cf91526ecf11ffd6bd64c5c80837b33b6c90295c9026dc700a09c08c0fe6cd6c234acee78c2362284c63a7a1cdc06b8cc83319d7bb4b7554123f45c64da885c8e9b54326490cadcb86a6488c1e130873cd5f914034b9bd855343a3f1f987a22b0ed42552a304566111208970674dae17e0b78dc3256e589b1a33f004a4d1a3d422f4d08465a8c65a1f171af012b67b9a1ee9e6581141129e241ecf805e6ded1edf2e2ce26ce0e320ccc2972dc48986611b89f446b16ae13c07244ee20d511392a946fa2eec92cc3faa2cd6836851d1149ccd671b14620b9766ebf852c21acd086b8b01cd23aad7060c6ea62879b69fac9cdd21e4363c32f85ba4b0134ec6b7d1c8bb05d3cf77a3587c31acdc27173692d16cc871d5624b75567798d667d05dfcd66db93c71ae6763676554791ca6ab7954f1d89e4a798ab2d43322d322b345b02270c26e987229dc4ed892a26cb9338ce0404b85a31d1504c3cf4b9bc20ab81efde2cc0599616b04e0664dee139ca32d871051acf56a2583726642bd4cb9ec117cd04122ad0c47ffb2d1815b9311a9b27b9893be0463a2e434e23414fa7634251b805568fc2d21f04d70e1688a59320eedc1f40cf3323ac609ae9f2ab641adb01e7a234988b701cb74e2a943389b6ffd928ff5bfb39c04d15813ddf299677f38efa7bb546fdbffd124de47ca4971b56e523d871ea36d33c6fa67c5c08811c2f0c7546d9ae00861e607c1730718041b03974b66e1e14b0053edc01ac300f82639e51f39b63bba4a2e4c72af28cd7416c9c6ef8d10ad09e290e9f49b06971632e5ac2eab444a8fe4f6c0a56286300a62df2c2a0a0cefd1d66404b832bc2aa626738458f0e54719168a362ad64804aaedcad56ee9caa0e6ca56ee0c4e366b0da26705c253cb1d01eb7cce46b3e984b2119ccceda0c47049f8999026fdd54bcc8bf332d8944e3ef58e128b0da5160afb751831102c7a8501875535761b1e20912fb21997b113e1050a5e0c59aee6e638cff92dbdac22c2935131cadc30e6a26b09eef5fbab74b67f2b48b2ab619843f4b6951303a837b1a46d4b23027bca46b540a8d6f5b56c9164bfdf1e44a6368a5c51182a9f1e59101a7836ccf1c0e1169c7475f52d6e2ee8e4e73413c23cd60c97eed8eb5468295ad65078959e6ea3bfee2ae4de7cb261373480916232417147355f4d2d9dc4e68354d2fe780d0d443581a45e337d6ca834c25aaa2c0ce6ccd05818704d15c918d09247d7c11a5bbd808cc6298cb68b3a417f43c2f63cd8fc2ea7624afe0d41a3b41ab2a1b91d7d1490d41e9433d9e8e1b03603a28731d4d169744c3d899877d7f592c4d8355679b868b404a45b0747b11c268420e2e67334ac219670f496e0235514522640fd999423c8f8d86f03a9b13a91c175ad250dc2082185749025e0a61128e682a9dd3082a9a0e8a344ba985e8a35483d9267bac5a5ba79b2ae1480a2938f68781626546d048446495883c46e890203ff71c4d02603c3a223ce51bc5e6e7ea9f06d6d22d984bb73ae788092926039cb646013955176c73119077ec9cc31fa2fb0bc6385e6680e39719bc10e6551c441fb03fc3426e5a1e21c1a6f5878419404ab9170da8d7231e170ec91d193b0189db84fba8eb63e80be6c8217688955f253b2ba97d72c3aad995145d8db21dba034bea22c0745c9ab677a906e99b859b49a0a5cbbda7a157efa860b6a9f282e2151b926fb08ca5ad0dacb83bbd548646c59c5cea066d6606ba9999606504c11d92108a97bb8eb0314d8b73b8369fc69689d1f6bcc066999ccdfd8441195f7144758f5692099ca37c813c9e9320165b968090210c3f32ba0253c49e092d21a814299918c47651e20be4965ac75e7591d2b9c411018f82b7ecf6c98a594870d9e8acc2131e17242bbb2038c93208c75083871d637bc368b50a26b66e009a41085fe57b1fba3c816abb1e6139e4e740e41bf2e1e00e760e8190a8b784d08e4ba8ca751b6a9062a52dc5a0c773fea02792b6fd41feb23a6690a3e5b1c7471e7bfcac1b769cdd941e29df82e8218f44bb6a236ed2cc24213471d4f4820a568edd29fc0693c2afc66bff5f0a87f3869b66a94d349d9a0e9885580638d8d795055847c8691616e2bcad1296871f515d81f7026425aadd6c9296657ea565dbac716901761719909a2961905451afb8ec4b409ac7148a5ce2a53627aee25853adf0e4682d690cb6edd24e355d298c72494372700c0fb1c551c4f4fa19f0ec48cb138e6ba6c4724d765e09dc253308e4bc80ef478e4c03c2f90cb9881080baa0011578603330e60e3c1c814764e48ca01132e2e6b93cca3c3892166b15933c8a5531c9899c41a9ef81f89cbc9a9719749426ebeb42038bf27b3a53719972d3695b54ec031c805b12075457dd14539606c54dc455ab74ae2e5a29950ac8160706d80f93b44c2e0914bb08309fe20bd531a23a46d6d7751180fbac2338d52921c7297d007bf0e11a1f40a4d611365d48c37df03dcec90f94eeacbd51e42624a3a8092e4f35d4e4659243605841bb2f466d74bec99f40a16797273684ecc2e330d5e392917450bcd0a334123e7008c7de8cd1138167a6230a2a11306c4521965f120dada7ec455e1911e9ca48b47d084c78f5e86f0966a3ef23ca02d0e5ab69dd2cc0a1700de1aaf86897dd5d12e135f0a5788cd71ed76600be65c4d2418e37649f119f76588c98c36360e393d53b819926aea86320503d079322ab17c89cd73674c78d816f595e61112f96fa9e506a4802a15e4e0ed078ef8d6cd45821293445b2543cfc6b522da2698e0a97566c83e51531e19569970c1b886aa55dcdbe9d11c8fa9a2d4945014f407327955b861de63b2973cefff7408eca7a4ff284f564d7d16951acbb499ea3808fde0b5ddf26e887116bdb1b8eb6c2f29cdbe6e343068d7117351490a791c8e469dc79c8095b4b7cf2a064c527c4afe7c39ea93da505847ef10689cf6d3d0995074eb477d37cae257c5a6d8c3a3c3cd064b62592cb44c48f44cfc355b9359242d1036f88c88e4b136f5c2e75a06ab25854d7d505306b4f3aba4f40830711a7d4c7c31af48716c1a9fe5ed8cae686c2371a14613770b2c75080d29b49081a9292977874ce84d63a25ab61e3a535ba544d67cc522d622e61ef0561778811f3b3dd6d3aa4526061f5be82ce0fecb4311b79d976bfa83c4aa25443a9e9dfb0aafab0d42544c1b9eea4068a9d8357934ae85e27d8fce2664bfd678fbcdd6256571646d7d7408bd8c89139d4eda19f6dddd64461a8f16ca0e35a9cbc64311c2f921244882c2623917c74e70516775826d68ad4c691f7a68c051e76d05a36bac63142bfe07535604919992b7a9953ecc1d87d2f9c48df0b03657cf9c01a4dde181fa404cb0dd18e9bd10a8ba0794dc7f0835af0cdc100fdfc08655952efd8ec35e7c9d0b56b99c76d86ad8a06ca66dcf06aa36c2ccc8caee8873644f5b12eb168a9391b441db7d1eabacdf290be12badec0911b9706d6ba541fd5cca5e5218964c0c1248c55b06dac50cd667661f2136507b61443d31568206c932594e3961007779e31af931a1464326f75119b924922ea0f218e58937baf7fa26a0b9d2ac76514e5cfcb5cc08ea2ccde2cdd2b2313aacc3221fa5d2465505ceb31146f82b2c55523860a2f6c39e9d4d36fd596f57845efd858d590606dc6a1f00dad27e2c8547a1147974a036a987e847841c521f65d5393552d54bc8da42767ce289764e960392350edc6a2e88c083632a35659ed712c8589e7985e4928c68410da6a779e45094e01b205936643ac819da676803d6e585666b319e78da059ce44080880945db24adab632f4517f1acaa13c0fdfb4fd9ad972776d7cf99210e9c5a25b074af18a7a1253955e4dba0701a376094f28ba7156b5ce49f78dcb4aaa58e65d540593bc1eb4ad58a00d5ac671dbaaae24db8e70cd2ca65eeed1a25865e58037730086688daba1c3d026fc831f9831f8e9d9edb500f0e7ec18dbdfe1cc58b1e1113ee577dcdba0500db50a5ead01e8b200785960d8c83c026d196a61bd5244ead14aa6463e32ea7c145d8f461c6da91c5a70941ba9db3e5b2aad26111bdd1b85865d506c32877507d5774d5bdde63aa9fbaa5a01a8eb6cf4db74418ffa5be8d1d4ba3116a4df56516107a1cd2153f27436e92c754fb448633562168f236cf6a6a3dbba8aa905608bb3d81cb0fbfaa4ce225793cce8a62fb2e680cf75dddfced0ada0dca36e2263b596b15ad0d27eb246d3c2a06a4e7dbad6d638365bc9eeade42d2c38b3e767406dc9ea6e5f09c26b5d70b26ac99d5eeb36f6d410bd6f113bac4aeb368f7bad2c55dc6bc7921d7df93ff6774af5e0aad0f25e80ba61ecd4c2d0354ac8eac61a2f8f341f09656dac4352fd3d24ce358640898843a2e31abc2419ecb1fb541230ecb03fd15bd715cfa76474712a1617bbc0a2b31aefb43bbf27d338ee191d6e9ed62902eea00cb8da2404e41179205b9a48aad8188d3de2fcc881b228fd244b3f55a420ecf2fe53697fda9067b6035e54cd137371eead4857fc57757ca356ffe05ae5968e180ab0e7533ec897b347507d0806d529f641aba456c9fa83beb435ebf3d43a5650349754ef287a93b58857d0fd77083581c5d2bd05146da96345aba0ffdaa4784fcb1e88f810bd34214843011457f0704237a1fb0f3444470218a6596a9d8c0230ec695e09334bb33ead0c918b4797cac7848d90caa4749387dbb4d475538e6fc2fa3506d6af69a645094b24a91249cbdb516345d4ee55c4f8e9067a3d353a5746214b2a183c0debd884c6a23902b56c72abc652e0a7b17f3beb98994875ccd0f932e55cd2750e98ded2b3aa3832bc7f95cca155704ecbdfb6b0275f01c9e42ba1872a5b367fee9d6a279d0f9385e727331abdb828f59b81d56d5aaafb43b83f84f5b86094743f1cf00b74974b344893ad942ead54b650986fe5ce1f3ca3fab92865bad5ada938676b01369abe63b7bda03dc2027e40ea7b7a28faf0fb90536972fcce85cc3ef8718b3c85dafe85001bb5466455be1de1031a9e815509a6bd202b02b837a57b28aa46f945ddb5b1f137d9ac5f0598d8711374af3acc70c4eae406ce5ff5dcb62cda62b97e10d2a5b1b5bc658f476b90b65cd8ad7effa804299056d5b1eb1f99ab52ec687d883f406062c7f1ef010000ffff5ff961f574380000', 1);
+INSERT INTO public.lsif_data_result_chunks VALUES (39163, 2, '\x1f8b08000000000000ff6c7bcdd265c7b1d53edfd96ddd1f3908c4250c4fc0e85af99f954c18a0019e11777ac30385d5c80aa46e85d40ae011e00530c6f03cbc0f7337515995599f6d261dbbcfd967ff5465ae5c6b657effeee37f7f3e1effe41fdefef8d3b71ffeed6f7f7af71fbff8f2c3978f8fbfbb1e2f8f9f7ff1fe373f7df7f6dd877fffe587dffef8f8f8dfaec767f5d1afbef8872fdf7dfdf6575ffcf8f8f8bfafebfa571fffebfd78fce2bb2fbfffc76f7ffce63ffcf8e1fd0f6f7ff9ab2f7efde3871fbe79f775fef6d3c7a7d7f56f3efeaffbf1f8e55f9cf78fbf3efffd8b7bcc5b3c3e7d7cfcc375fdfce3ff7c79bc7cfcc3f5f8f8fbebfad71fffc7f3f1f8a7ff9f1ffc7ebec0df9c2f1e9f5e8f4feadb4fafebfaa3ff9f8fbf7b7cf218bff8e6dd87b73fbcfbf2dbcfbf79f7d5dbfffcf987fff2fddb1f7ff9f5fb87ffcb3ffb26ff7dfbc3fc4e3ffbfe87f71fdeffe6fdb79fffa71fbef9b03eb47ff1673ff8eddb6fbf5f5ff1f9eaebf7dfbdffeaf3efde7ff5d3b76fe757f1cf7ef3dd579fcfb7fffbafdf7ffedd97dfbc9b9fcadff50dea607efcc7c7ff7d83264c2f8f073f6ed2d02b8f46a05cb722c1e3f1b0c7ad88e3ba95151f8f873c6e6589eb0d9a8ef9537fdc82e2d73c7a83e8c3af37c8c0fe589f30b05d6f5068f8dfe5d96862753605ee4360eec3e1ebf00e17a90f55691f5238f4a18d7dc8c8f5104c23fa67607d87a84fc9fb67a4c1f5338d7e30393f331afd691fb291ed671c36aedb88235f781e79ae0efacb5cae27c1bc813cee31065d6f901871af0d31c23c37949f790a19d6c9988f288fdb47f03c0910d67233492d15c280eb6963edd4d37c5c3749e8e3f188c73c9abfe4207d59978f5c237ddcee267357389f729eabf998f1b84354ae1b07f8da6e1ca0d76d03c6fabf0db0eb196345c3337c068378f833af4c41b8ef11b88f023262140cee15031a545bac1d3b1ca3d654e6ba6048ad14467e62247ae725d1bc2e8e194ff3863c681d0d0ebe6eb4313785e7d943af3708209a2b95877301540c9e2bfe2d5795e7da8c5199c0f32473182f2b15006792583ead4b2e6ec073ed33efad0b24df4784f3bd4165d46d553cb321d36eaeb65aac75271af399074aad3bd2f542922bf04274dd34c6de031ac3f2c2648f0a749a6f68c8b8f75a51f653cc879eaf4af2cc3bb153ed3543d4ae8fb8ee10b6c7fe3fcf5d47c205028618d74d4463c716915d37b3cf05d5c73ce2ebe9b4a3c2f3c58d8df2f99e920b09a1b5a1100a1937f9bc73435df7860ed799278a412b83a233c82612d1205a0f4503fdbad501363259cc65410e79d42e650a2892ff6d8696f2c961f24a721a8d024827df551a31b80181c0bd2145e180569f8b505085a67adde45cfbe68cf301410b1e11d4aedb1137b83ac2dc07e2bdceb1e04435ecaf16d802f6f351df08663e17e046671436d43252679cf443eb989b0098f1999f4c28bf453cd6e388b84f8059cfffb40c3b1d249fe4b548b860309c1b31a51e8b25fa09a3d79590a29e8529cb4560978b80eb661f2b70e651c689a92c7c41804af705c7336264c60182f04e1d10bc6ed18d51b7a847be15ae6015b1c8dc35dd81a323d1804356a223f3d8894e2172dd4c5c4f440cb33c66e4cf8842a3eb6613dfdf9bcc74436a989f1171dd3c7cec3386cf65341bfa5c1f0074f88d449885873a1235c51656b065b98cc7ed132bde207350ad1b075e374a3d058ae875c7d07dcf18ea33bf135d32bf715e415471951ea4dcb59965121bbf06fb4ad2e075158a7c3705a09fed9725a900388594c629c57872ccce0980b3503957a172cccb32aefae0a47882bc39c044f10aa1b9e30e0c6b471d3079c7d8242523f58e18b65f3fb200a03a14324ce07d1a5464835e4fa5b57acf05a66a180b81e64eacf4e499ae2c63577b96e43bea6c0bc6d8d4bb2e65c9e2d0aa30b1eb8a8f8ce13c22b86e275ab172fbac18b772aecc9870e578ddc154b0ccf9642826155c627323b148c03ccc68c7b1af2998ff67de6b21bcaa1ae8d8b441661242bd541e8ebca6d62793ed5df7581fcc8cb31d9b59f62d6333aa32824b1d124efc1baa55b734a3ce7d3f1b2dbe2846bac35032fe932ed12e606e8859edfa7140d7edc3e1af36a1b00a1822d01d92d611379952e3373552791357f0464d1a16d78d3e7655419fb42a0c76c2874182c548eaa08fe7c8ca9fabd288c46c1381a4f64079964f1fbb5c92fb023e8c06be59db847c27b090ebac6d22eb17ea321741622fc24d93964f14515a5b6090ec6e6116d5217ae475a2e0ca2735528d8a300d4ce240a388431199e1945fd1c0cd3c172aae55d619882408456605e27a86c3e685498bc56cb346b145a570f3133d78c3135019b2e48d898673edd87cc73c9b4f3da25efa6366ee1d625a506613a021b150e6ef670931db35761ecdb53691c5c86fc72cdbf9862317558d3edb00d72595a99929abbe22fed25a6707d21d473940508b93c5c0f253f056378278484513051e7050eea0e72120e3b08ee0e2c9d64f108ad7d322369ac5e4455c3c4979a20c86ec154a36fde4d85bc563ea3b839ddb6ae093f00fd9d46f529b3b38433531282315ea6a02097444121b818824d3937931b89b25d9510228cb944b4930c6944b3e1f5528f6a30af97513442106c4e495597cf5f1744ec2e254f00773132600c758c9c848450f523c2e7a80d7cd31aafec62c0e32a0007100af841e0bc44d2aa3dd32eb34d456c1330fe8a8682235394f6d9441efefcaa43cd72957c478c7203bd48a8c91e268707cbad6d3a4954486cd52121b165d048ad7437d1626059a16758450f039ea338efa8569dd03967008de221191b89e2ccbbf016f02320877ce668ecd7521bf17c16f264ce3887af326333ad58d00c1e3d0d85b89b7dad1a5271c3785cbf5b9c56da39db84d8a87454c1167dc084a7d8f29ac8c41fef9aaa478522e3a93014f7a7ad37f36383c7e744a8de620dca7e2c0fe3ece55b5f3ff5039208443621a4014a339790b7906281e65dc8c293a7e88a87109e74af02aa1a9e64c5399ed95259a2b6babb24ce48524c75c8505791616c64a6fc641d773f0aa4c59cce68a58e13a9b667c2ae8cbae200d4514930e19ed936f231dd71313c9f9f1c465fd802ea368caf8b113cbd65b00da7e0a40496544d0ca68694800aec0042c73242facae52bc74f177237a9e2a57a5df5b309965c964dca293476184471248119625e743a2e970a70a4f85eeb5b2e8e8d7937155e8449eb94e2c3fdf50417a08eff19eb8a102a0d3e3b06794d101207c44d52ba9d9b100c791026a0604a772016499e6c13baf88a734627128023bebb4066f9daf41a9f3853651a1047721c297e306ee481d4b42006cf74a281aaeb825441a4d62fcb3ed1e8ea675d2babe118aa24af3d0c22a57bc6e8b2a11160bb18ce15ec45a1296d2b0c9eabc88b5b49d99a6c0b026c823239ad74bee423b6f21c5534d745515a6ad3a9707a189ab52047fabc890e7ba308b15f305de0cec36575bd6d55ac1a766d6679de4d43de2b4fd2556a6ba40d0622d0a6fb634e00e27e73643b9c309691c623bf3d050b3c83e0df9ba4d71678b6946aaa9eb8ef5287963d6652605938d244d53114bee8cad68e66b92c1f67e78cab928edb955a289c5ce5ca83a369c33a94725b544d5c181e908292aad1756784d79dade102f001eed0333466e850ddb7bcccbf113217ed90656469b4de086c9862cacccc190eb899911f17862620406b4f60918c9d5fd78da29e079c8e6cc1a5e925957520ca97c61aa75034cb7433056a94d9ab6190a25c632cacb06e983f7ac3ac33fb610b088c419f78d75ec76ca443bdfac99788a3c5e161843a58626899241fce9ca1f17ebfca13ac9a03ed3fe8cfa3ce96fb944ad26c75b06aaf491f56febbc14363452d24e8499997a6bc4f69b3542670d815d439238011eed87a9fd44d917ecd82c501b47bcbdf6842d55e2bf5efcc03a65500fed262957cc9a2e20c209b6667b80edbb011e1f62f54274a48d599efb76ee248b140dd8c94dd6e631004e765390264e7ebd241cf1e365927313792dce8549fe7685673a6a297bdab1e78ca3bca7eda301af3edb1eef5875218f44f711c23e0a05acebf1ca9da1bf58f96b63e76fd2f18dca479e73f3d0519a4ecb4372cfa059ec13fb17d82cd5ea336aaedb7e2fd755c84a947a9cbb71259d5b5fb91311b9f5a5d611f9a8cf461d65770b094b1ba55967a145694296c39340a88f2715fefbe064136e5be5086887dfe464ba145189dd5914a02a0ef08c008ce4bb5960a646898036ac40af5bac348b4c3c7b0a8deda74fb68c6152bf36ca2614160f5b7802833ed96e829d364bf32186576dae235ba8b5281e09cbabdadbc87ec7841d6dc71ba24f439398e25db779c2c6cb2c51de7d4636ecc29cb81a835f4a30152012d87a01d64f4a5761bf0145f9c7c33a95c749e557b67802c29817a45c4fd84d4dd1982236a00c9a9115449df067db010eac7aa1553906962b0c7e605dba2944baaec2a946938cf3a16d7c5658f8ba1d6cdbaa0e86594f86fc894f86aad5df419d550009b6853d19e3753309972b9d4295ac5b666432cb9c62f17e5d6d201a63557d894ae0d04a51a14517e34fbc9611f9a4b96746d961c9eee0eab0c00c45b451c2cce6ff3db69b801e537e88d6f73225036959efa4e95f6ac4ee262bc77150c8afe7d895e13962160ec132c02429bb86c4f370d7da1e6b2205bc0c2e93ad0d58b503d78ecf9c2e912c17b49a0653bd8ced583148754f39f5079876dfc4d433c1d13bc171bea7e78695fb3b1f635826c28b1fa69afc705483419071a6fa6eea4af6bcb4a58e2acc40b6ededc8926746c4d58d07afecc88633ab347151b16bee77759b76178e4dfe7a5f0d8bc1d26a832e1b57dad1b521950cd0df5b70ff2a7ae1dd8be8828e996da35485ccf27cb3739486e5b9b546a3162bd7443009d66278d5331259145281aa1f7e7cb6d5d821e5b6a294c7d49985c4b98933fb6bb1358d0c111e9f150ddd9541d1db3729cd3250ea6849d9ac7078c85f3391b618074137f2ab4a4d16bc13aeed6e396e4ee95c976837a74a8b73731c4f4456dd067002231faeb9735a62b74047ec0ebd759b5cab59e1b2c4ab179ec87cd89b967f9bc9665321b96d1d699e90a5c3dbe186e4c488d5eb66ccfc7480dd54936594ef881ad7ed08500838e1d8cc6b8a62d6f109a456d6b35ab9fa2319a129f26a164dd83cd2b82a43b475ca8e34819037e2a04e192f5ead3171d5eb19a0dbb89ee24610da5802cf8e4b1ae6f9ecb2083839bfd9b8832d8d002afc5f092674a5d64e91da4865b37742691340ba5d4bae32d7a7cc7e9f71770b8cf65cb32daaac6ba2e279ac467c5528471b0c80d9b5142dbf4d94ae5b8714eb1e4bb3c1f06d749ac5b1b7bac9c7b50bea8d32c73ce3f994ae52bbbaba1e64a3aca9d504081b7b36a6f8a2eff1837c9c24b7499e851dd7cc02715aec8bb1364f5d126740dbcc5cd9e9731707669ae78fb2cb41a2d2ed8a2cb186601b37095eb9b5724da652fd499ebb610225c10456c7a2480e6448124a95ebdcd537a896e43f0ba9d45008da5a69c3cdcdf0b47c0df96c989c3655c7fe388d86ae6248e8d71ddacd4e4dedab23da39ab5e4ba0f9f50cb41deb38a3ca47f59c3c7fc8d6b1ce16334c84a9c2869748872dedd5ad9dfd195142dc0dac89e9c8b21957b6d4e796ea7614449b42e1d274583dca3ccc891d6f450ff3e16ed3a839248d1522e5da670f78cbe9194d1adda20fe7ebc9c0bbdf31a9370f2aab79649b0ba5c9390aa4e354f30416cb1eca57abfec0dc02e8fa1f891282dc5cb6c62c76ee86fba24cf368b5ff8dffe6d113033bf2e86879d1d36c3ba6f2a0d374802343fd9cdbed23b01e9fc3f9ce33249097b982715a5605adb35a1e150c67466e4ab88972fc38789700e81b9c8f6206c7e576d56c83e999578966eb04967c097a800120335440ad4abe6dfffc168a34110d772551eafe26c358ade0b0fd9d8e66200cb3aa385527d093de4a946f2342ed77f17c47807daacec59db7b4edd5b4607459b28f9d775f9f93092c6ba34c8e64b9861dac1876dd8cd53f67549fffaf6461cc7037cf5aa9f30da3c65c2c79a8627561b314d7a269725b93587d70163a9d088e5e786f1977c41d2cb99a63387886205b3c91660da601451f2947ff78bfd47342f26d5e16bef944131b45196cd89a6eb46d80d89a75dbdc70123ece7e78ade10c1b86d58855e483743db809a3a78c68109e3ec99999b1d1ed9b3e553bbf403bbf4000dbfbe95ae62d1d598fb48c0e618c8ef457cd597fb5234bd4ae19b5f4137cb9e4bc673c737c76d340d1b64bd251d58224564c11556acf475c4f47d85dd019d6cc6275764e288554472764121e195cb340d9e556f3dd7c44d6669883af276ddd99f578c6c0388261ccb458979a61b92e65067a6ff0ec96e218de365cd1d61542e67de7e6ca81c4c9f8cab2987ce356b20219b235ef609be61bf7802fdaf264c4e47ef47845c545a3196b01484442bc908ebfdff0773a76e374e1907b30ed5031d33380743ea59e03a09a71bac3e49573d2062e8cfade1b7449bb354aa30fc1ce144a74cb08b1db8f20d1a13e7a8e05f595cb70e0167a0e8f469305e633700ba7a7c4eda5639cf983e3a43037ab9c7cee3cef09fc23a5a19304d50f19ed840238692cbd73ccd2787426c0cf5c0fe0d958eab5e1b30a785af394ae13236905324e4a17ab866544a4e001ecc943c05482c03d5a056c9c9e517b70b05cb74d6f33886ea772717d56a5ec00c227bba9395a01db19285cfec39ad3e98611ad35b1e514f5200fe81e7f1c65f61f730e0f2c01f8750ff0ada707f8b89e83765a0f5a9d49b47bcdcd2e7378dd6b74259523f82d99351a730f80314dccd98317f36809c66a4f65dbb9d6da8f18e9413ef663bb407a16b8c6a3d6349ec07c81e07a81a5b144374742a1574ecda1355ea6df78955aa751f66afc9fb403edfcd901c5b996a4476bce9bd5e0313829fd5524db047082c235a55b75bc8aee47ac52474ebdf3be2a02f5cc0ad384be0124f5b2949273b4e41c3eb9881414427a7bc67ce65be39a12fd4fc43a73e92c66cb9667588f19874dfea151738b1a6bee2e075eb323e0dba648836edeada44b0e319737b2fe6a80b7e39653e16538781ba2936edf34dcabc3338b3da24179973aae3bc86b1675954895147bd9fde243727b83183d29196eda99ad72d87ef1cca9a75acd6fceed1170dd1e19ec1bf0be819e116d825736f68049d4f790fa3c82eb26f7b1c7cd1771d770d97a53bb83ad9bcf52fb7f3049b30fdc5d461f389f69cd599419923dc42d228dc744281a35d64639d320836786c5e305b70076ada390140379722ed31ae4cfc17ddb4c730d5aa85acdc8afd1490e2f9e4d63f7066e92b1fecac26bd87a4d5066b33b7b5e399ebf46a22c9635ffd4336d308e97415d5bd80f514269e0f7a65a1869bd0245232fadbf792187fdd701444d87133b84735ea9feb663d36fcdc1d71eba9ce5fc893e7663d7ed7ac69e127c46c0f524df92903cddaefefb8ab5888cbb47699cd3294a66a578e8fcad10ae762a6f88966816c07286793496f6d03d67a5d68db1912c5ecce9e5709f02e535e9cac3f7d5879d71961e2542a31ceb809e4b8645bc60cda8ae91f73da45a0dacb1ac770e8a97aa7832aa01b3ee8bbeffeac8e4b42fd6141e4194fccd827273604d0404f2c4cbddbeb813b2dfa00da79a0868e78773f06be4cc508ee44c216681a3fa6fe9a32f559160626b50a88d01846577b04ac187168d337de585454e6e6feb3b47126f47af1e0c3a5ed7ff0b0000ffff76a7af5dc5380000', 1);
+INSERT INTO public.lsif_data_result_chunks VALUES (39163, 1, '\x1f8b08000000000000ff6c7b4dd365c791d6b9ef3d2d198706338689810858b3c2caafcaca64c3022df08e98edc42c1456232b90ba15522b809f007f80c1187e264b3791599559afedd14271fa9e7bcf4755e6f33cf964beffe1e3ff7c3e1ebff89bb73ffef4ed877fffdb9fdefde72fbefcf0e5e3e3df5f8f97c75f7cf1fe373f7df7f6dd87fff8e587dffef8f8f83faec72feba35f7ff1375fbefbfaedafbff8f1f1f1ff5ed7f5af3ffef7fbf1f8ebefbefcfe6fbffdf19bfff4e387f73fbcfdd5afbff8bb1f3ffcf0cdbbaff3b79f3d3ebbae7ff7f1ffdc8fc7affeec7b7ffb77e79f7f768fb8c5e3b3c7c7df5fd75f7cfcdf2f8f978fbfbf1e1f7f775dfff6e3ff7a3e1e7ff90ffce077f1023f3f271e9f5d8f4febec67d775fdc1fef2e3df3f3e7d8c5f7effc3fb0fef7ff3fedbcfffcb0fdf7c78fbc3afbe7efff07ffa9befbefa3c9ee8df7cfdfef3efbefce65d7ccafffc9b771fdefef0eecb6f3ffffafd77efbffafcbbf75ffdf4eddb38a5e7d437efbe7afb5f3fffeddb6fbf5fd7b2bffe93531ffedbf76f7f8c33f35ffcc999fcfffa95fcb37eac3a888ffff0f87f3791ebe3f1e0471cc9758baa3f1e0f79c4d1bcdea082c2a78fc77cdc023aae387a838036f72111601dba6b1d2af33e4432df878ccad73d90ed91571cc87add3478ac5bd2e03c6fb8fe3d70fa7523e4f9f18823ce4772de175095eb561bb2fead36e8ba87cef9fafc0085c7e3618f7bc0b0eb1e8463ff9b302e48aab1089a0faee37a833205ff6a3d13185c793479c83e9239d69199eccf6c3aae2387514768fd99d52f06eccfe654abefb1d55578f659a87b607f0f689fd57337f4fd3dea279dd05799e717f1aa83d09e6b313d7f38e212b48f6c4cbcde208af863ef9e88c59223f1bfca259d24b5b3acb6b6fe761b67bbbd4283797468cc7cb6f8aa4f3c9131f6873a2bb478005494597f0ae65eb79a1d64c61d854075a9996bb9e2912af240b01e1060d6af6072dd0087f4b5b06f4b36b93fd57a0305ef5f69bfec70e93b9ccba2eaf59c3e33a09fd3c775b3b3aef06467b96e12e19d8222b1fcc3a6bdac049051dbc7aa57dc65d6cef098b133a08cfb135086885d62ac7c128dfbc1ba7d1c8d488759f905ead73d7cece7193e0202982cb3218ee2a6205e3705f10c07f1b9a268588673c48eb2543c01e76d7c5f3656fc0dca18f2ccfb2a9377bc773680c7bdc8a8ee4516aba173ea3396e745e3fafcb8e7c8b8e1c76d0014ab324d7ebe40cb89f795d9bdaf3c2a7f142b0bbdf2512ae34cb1326edaacecd2ba9efabc6e06808545e46ed7cd93f75ab1ba5f4f19ebe99f32e0bae74860ca471e917d628eeb41d10a4c1c1b4218751fe1b44eef7a3ca1f3a09dd49dfc32fa856424a69934a659c2a602bcacb81b7e12c722242c1132f6ca022189bd42882db66528ebcb7acf916814d78591771ad3e7bde097a53387a5d2c598fa669cd1038ceb0743b09315a0c0647652814724b9c90e50378904d2c47fcf45c7eb9e90af3a1e7114f8cf3af6db7010ce045f2912e7e36dd0a615bed99cd76deb8304dbf8b78cb9194602116f99455132695cb78e4c217ddc3a34c3d6c0f66a8341443b9b8e95c4ea8de42c76dd62c4eb71c5282eee5e1777e7eb16cc9b47fe61dcdc60c27a5d5bf90a6495bf999fec835ff20b4e422b33d03c9e6202c4a98857ca0d8945128ea48131a91e79c1bece49bfccb7c6950a2be3619ec3574cde18385b00b082f5216ad34007008a34481e1e00753f94d1d705b1fe19517fe1849858d30b60938a4508e84cc81a8f38d29579632116331762cd04815c121fb9008ab9674f036d0af7ebf6c17b0b7c905f4f1f2b3d9e2eb1fbc40e956b912ff71cb8bf3f47c29a4c5b57be91f0a4368618d9911347a1860cf6ee5340eb1b5423786eb97454d71cd02b3d135ac868ed3492eed73224cb50cc9dcee815bb5ef25de4f14276dd88639f431c703dc556603f25a597c25656a840d7cd42fbdb2c145c33443711c81873dfd5b8226d4eb86e21f21dcf44f30af4a72dd798e0bac7b0b15760185d4f99b031542315b9a04938301565eee446592f6ea4f7fa39265bee882e94a291d240338e922043f954fa3bc622cc12bd38f39e003bbdd903f5c56cdf536c41295534a91254348dada2a6bb24df4d5a7cc74056346e0df614710ac9fd8112211a22a8a1f88f24f71f79a35f1e8eeb16a1cde7229462d5666c421236d40ad0524c798820d7ed02ba635820715b6d162cc6be98a76c8e57f1b972063d23ef39a8058d28b690f30cae212b6788362b390546f2f0bd713c2c950a9134b5536edddc387caba644001e25c481c77a4899ebf2731639862c4c00a975019f71431a450b24f37a8eb176f91991790b6c6abe2580e5c95b043d39301559375e443e251410beec1d860aec91eb26846bb903ebf144dcd8cf472348478836e908615228fa8680a105017326140f677e39199247ee9ebf129697c365a55b15ae9b8d4a471acaf574a50d4a0a29d967e0860787272c278118ef234c5c52e35a244c8da63ed79368d40b3b6dd85265196d464b122c5192f9e0a558354ab68d9c999e3c27ef534b4ca7bec2b5dbd85a8f31b14e207239d188a525588936d44e9e40231960c5d2a0d7936d2f005b2e004cdc7aa738ecf611aa6da2db160cb8d801c6fa6a2082ed34169394d3386c8515d18c75c0d98a1b6702ca50842d687c170cb7bb1e763a75882ea241c085e94c0ccd5c4d62bc8437e928a0221d299dcc79976f9c5b9961299bd01c7d3186d0271b22559a265af3017424d1d88c7dbb9daf72b21a3be84b972d6bb9629ddfa0b8c3ded3e14594c073d597754ab47790c7754ff20d2f935cafe7321ae6e3a909b9a49d2e23440eeed888a3dc06157dd9d2dada86588f835921aca5f62e19ddd7b23c99974acab45d42468eeca4bd78882be174d88e9ab34c8cc821f67123284f4c0905d4011cd7098d3d7043b1cfa61dca92365162992ac23356294abe91f9122502e9c253945ac0c15dc507a19021573804f0845e2c664d0414002f8cdb2294d716be1401595cf41670da12147c45b02ea8bf15c7382103a77ecf3534dfd253435a04e9d7ae45fd7c0fc0b9a91c30f61cc48a62242f30abb0d090584fd2257d9fa4e94e4c596ed4cbc88d9d48f792b30b71a2e4f3217d6455108a5fcfe18bc49f51dddc4ab655b552284283a1a5a217b3188c972d2c8edad5b43b066c3c7b6ad78e0471552b5457c30853910a531188c28036e969d449812cb2f3015b204d9f894f6cfcdc7c950f90f2326d88784a92445634dfb2aef585a32c01cf1d7d8331a4a36c614059278d49db381b73f12d246324bc9a5d4fccf0e5c7136901e6e015045225d8edb66d8ddb274b9a01cc45d1ab9050447a599ab14b4386842b9c381b2a51930571bf8fba530b46baa222de4f9fb5f14d867b29c9c062435d6b435da20acc9a2cabc0f8bd2bee6470c55d667af92ca6a11c376edf28e451e452599f4c723d97d00cfac86787c95524c24c6c53d28dab03b54b1a94839b5d1f811fb20694465b0c852f1943e9f744163354b1c99075d820dc9cafb36d54b0287569248826702c2661f21544937c538f1c1044dcf1ac735d91428bedc7a550e744634301d148a015c437ebeea702a3a1a7b2ef6a0d8df55c2cc35d68c5c28d4a478a5aa627e67d9ec396f298637fd3ad90129c42a9aefa3dd28155528c613917c7fdc395056ce45b5659fb3723a5a978825ac878b4d669f3e89a14f72a95251a62e6c6597619cea5f74cf5cd5a73256d0baa60dbf498516d500d8c626972154bf16f019d85cecb6416b34fb7226f72c663890095914ce84505369b55bbe05a9b2c6ce3b305beb25564e4a8729701da8b40524b1e5950492ba30e49e6ec4fdd9ac8fa0a0cfddda0d35edca41d5cc09227317971ccaa4e476cf12e9856f502be659afbdc6f341456c2f1acbde5ad33a6cf0811dd96641c25d2d0f67cf230d912b5b08730cdd3a14c9fad3062686a076d01b6ecf575e86d5a111db1607c3ed5cef5b363feca0b39a6863602a0a6033cbdcae8e9c11a40fb6d74393a0307ae9a567478afbef4f6400731d09c51fb6cf08a2a68661d29b505db5566e97a89252ba80173cb56c657398dc7e4e1b6684ce6aa29ec67ebb946470590beaa1464972ff3c49d9e60c3d46479de2b6870db88e24babdc3c8ececf1c1f53a8a89ae56c865f4f814dfa21f16f112eb340d2fa1894fe572a733f1e52161bc2802fc777df9a228b1615d14f9609b36a8e15cab3a39fd55bd161e7cf04db6f67293907802fb54e90e1926a5d8f4d90f469ba3b3203a5bec450168a622e8e6fae7a718dd794925dc27edd3a011ea76a8b94e42ab577cf4c06cc7baf6939cf6cb31b465d5c2ff213d4b1c5329e860ccdb91f8b39bd7976dd75df84f69f4710d598364b7be45745f3358382d63a2e193b1af75cecba7d944af3912a4dd5e4930d9a271ac7681b51bacc023fa5147552132e6f53110b80da1ee3906c386a3171c46212dad61914d41ed9a2dd73110dd5eb8865d86e770fb6ea9f00ed3ba1794328258d0d9d9f9496eae7e36e9d811fde7e659a7b22be0571e215fa7cb3482af5e7c06dd80dc85ed290ed1eca085145229bcc285309554abea1aee462d0371b155befe3699dd1a0c6ca921869d7cf606a2f5f74f50980b7979787723d5781108f279ef2654b9dd421b7eaf6226fd57423058b7b25e31e277b3deedccd83b44363ada9598e6834da788ac3e5c78738b4ac11a31add253a40aff2ec30619a8dd1c44b9e23fca2cb713b6676af111fa4d4de44e2f6aa711e95385ed592ad1289b861d7a1850d9cfc18745a8d24c783b38c889b81b75137ee25e4b9054a1547e6d5139806e94ba2cb4b419aaeca34d476ece7ca85558840686cddf51bab468139b4eac69134c3cede3841c7afcf2c88af4ddc1eb695fe9c47188f28195d0b2822316f72c6eab0915f4fb32d1b2d351d4ed00e889467ea6c9fee1af36081dae94f9c86b3f171cd41daa839dd8728f3c547f763b26b370cf0e76d8aefe8c6765a7959c08bcaa0d882113a3a5797782585cf964daac585d40a4be6eab8eeb4c9c3884656a944e0a8516f72dbee1fb9496ccdd65c7104e969ef75ccc231f23cadbdb48ebc3bff9e9595ceaaac523a6139c379a88b2bc73fd985bde05903e85734696d72b811acd7c0b13314808f746c153927b7b66ceb9ae7ae2242e7f57da7bc62dfa2dc71f666354e04cc5e0a4377cedf93a26cf7893bae7d599151f026aa3c958eae81da20675cd68c659fedb9d26635dce47a1a2ec6781a4a128a17a1483a11541e3f51d60550c638a71be969aa702a82e5da9431b05566e623701da555a25366f169bb9b7aa81a178f9b492910ee4db76a801964bb14a5daa5e92a49b5846475b10936214e0a8e51e51ab7c9c21b75ee7e66146ad7ed2ebb7be29e20326594dae59904bb3d13c2346a078a6d6ea661d53a4afb837d96310bb2b542995bf15f5719e9110e33fcb4f8a9c1198ff349c2c704ed0625adbed1c28dac21ed718f9431c4da1e81e2751bc1ee3118e5e801702b2d600ef410e55abdf805d3b640e248b20a995be78e95296b0c22551e8ddd0b5449c76fb06c0b96fccc262c4188d58112e21294bdc5b8366e6cb69d5149661374eb51d66925825a99721a3cc3e72cdbfc3419219f471c65130d36a950134d7e3dc967757364ce46b1c4b531d3528e07587810b715f0ebe665a5c619400bd8f70dfb96b6f2a0fd4374ace81593a59fb7612a83a98b440b0d3ab62c1e332073a0d5440ae655096d748998171bb40dd3a751cf58e86a33e26edf64eb73ed4508b61bb5e42046fedd51efedfce7445e61d75ad1aeb30813d741a1bb589a7c46463db861c41173e9f365cc71441496cdc718a43d6ceeeee5b0b93cf86dcf3fbda266ba6a9aece50f93eef1802ab0b5da5736c957db4336b3f33cb5aef112cfcf19e43db1da97132547154a3f1864f3046617e13057112ee916cae3397b7665c188438df9e5d447d06af97b99dd3a68b702a9877266163743a4662dc8a5b72d418226b52f909500739bf6cc81c73af616c45146948add7b037b140c17ff6c8b464b3b247b0c9eb88b9472cf6f377ba52da9be7fc61b26f5f09cd3f5445d9b9cdc12baa96644b682528137fb16b3f5ca3c6377356f139185c7fe4b8796010ba41872e271b8ef1563b79ec0ca26875bb95671e68ed4afce288cb465f7b00dcd6c732b5562644d5066f91a2819f0b21b262997d2ea5a1ee7c0fd00492abbb1bbb486eb368488da98274a9b87ecb4c783e56f27ab0a84a6a7015f103a4833396ad8cd342a109af247dd3c111fbfe8eaae85f7ec5e1c486fe96ca508764a35efc619329eb92a3eaaf34cba605719c44780ca9942ec011ad4dcc560225e152cf0781ebb7a01ed72511286b323858c5dde312e7b61fa1a39db33b96b440c7be4eccc235837570b0ab4e6e00c7bb654a8ce8ed169e87cdd634d66048df76406edc8b03364e7d999809ee0f58030a9b6494e84bd41521f3dbd9661464eddfc72ca9dc3b4c913177131cf0b425414d05bee1615f21fb5b8097bf88b70863e53a859218da296a66c3d46535640e2d2275983ec0989343580a99b219c0f35407c5b55d66621ea6a2d1adb72f568f92da970094e9f50e0086358592f2f6d9a6fff28a786d64c7496b3019ea4b6d79ed428c073c7c19d3359d9a2967f541645dfc5b654bf5dbb4421a51e1c590d8025b4db1006ed311b1aa7b3496bc2710eba77f2ea09f0565ddcfe3d2fc615ad799e1102ef56879a0e325fd34bdd5146e9414eb02046b42246cc319d59ee9fe972c475fe6cf3dd0172c46a79eb69d69fb631cd57be6d7dd3f9f854d9776139902a0b5289c65f6d84eeb29eb45f9de1a8293c33f37a9ce881a7eeef216b9c7a46e6ce24fd90e30ff51548db6540684d8bd42f9743abc57d6753e8cc3e8b9f1bf79c34cef6db304d281c34abc459d696faee1f670f6bc365b78fa0409fe74b515772a80565a768213ab30e5105dc6a540ddc9441b41aba89dcb6e0c5aa53bf0c91f502abd3c948cf231eaaa38acd416bc041363e21385471b45a332cfe66b1204b2b0a38a32d3d943b8b3d97552dc2fc6c437ad3da21b8055c02f02fd7838f57c3957a66ace4cc84b46b0dd38ed3d99102639616197622e9ec976a275cb50523dbc7d999f663d05f353af0846287819ee702a3f3885d48c1d148c74be457c3fdd8de1bcd13f8e77d81db066339212e94453a09d5e095f3ab86de063199b4ca0e3ce3eac9268c5edcc1e88b3b1cca1b98bcfbd0638686a5591e17e5989520d72c3066979d6a3225e5c96d9ee57c14409630af9473d65176588f1e421af5cc58235b0c69d45b57206826d73d06d770efe090ec6b4e2d8420e77c88c2dc630bf9d703b51d6c518870b9a741f5b78297bb0a3981c7d36bced1d79f754cdd6c355e17c9c97a40d0f3c121e76e66a7f62db2ced9f30771945dac51b30fb01a92435727393dabb5af2f6792f70cf502eb19e9d1a5f3e4e83cc1eb05d7a4c24b5602bc3cf065b3e5a4880eb64ae5d23673cd8f4eebe1578ba744d12d1071590c42c7dea7f5dc4cffb81892f0587787a847f3e22c28e0f3a73baee7af36ba8a2c996d4ad2552a95e4ea1171ac3acc9730c8feeecc619f9a72175ff3782b109788c82261026e937b9e2201b1538a5e0d9b055cddbc66b2d29c30bd6eb68a7db6887d122bbf5ed2aa1116fcd9a3e75d2afada4a413ffcce5d00c5cf5af69e31b4c1a76f9a6d2b40ac396a40f0eb362973d844f34fc54a2ca48f7c0b530f076354f8cb7acbec9190725a660ce96a27c9d8f38dbc6cf1dd184fd7d3a0fd248b425e6b81e3283b45b0274c3070fb297b20e5292ed73d87f71f7b385fd7ff0f0000ffff45192fb70e390000', 1);
+INSERT INTO public.lsif_data_result_chunks VALUES (39163, 0, '\x1f8b08000000000000ff6c7bcdce26c95175d55bd5b6be4f63216c249b056b5678e2ff870d0b668177c85bcb8b91a7198f98e91e4df708b804b8018c315c1e97e14619999135b2d9b4eaadaaa72a2b33f2c43927a2ffeec3bf5fe7f9273f7ffdeedb2fdfffedafbf7df38f9f7cfafed3f3c36f8ef3e5fcc1276f7ff5ed57afdfbcfffb4fdffffaddf9e1df8ef3877dea679ffcfcd3379fbffed927efce0fff7d1cc75f7ef8d7fb3c7ffcd5a75fffe2cb775ffcc3bbf76fbf79fdd39f7df2cb77efbff9e2cde7f5db8fce8f8ee36f3efcd77d9e3ffda3fb7ef1cbe7cf3f7ac778c5f9d1f9e177c7f1830ffff972be7cf8dd717ef8ed71fcf587ffb8cef34fff8f1ffc767cc0ff7f2e9c1f1de7f7fbea47c771fc5efee7c36fceef9ffac3afbf79fbfeedafde7ef9f13f7df3c5fbd7dffcf4f3b7a7fcd93ed907e374fcf88b37ef5f7ff3e6d32f3ffee2cd67affff9e3f7fff2f5eb77e30aff645ff9fced576f3ffbf8abb79f7dfbe5eb71c9fffc0f7e54ffcef7e48f7ef5d5671f8f2fffabcfdf7efcd5a75fbc1967ed277ff08b5fbffef2ebf983dfbf5cb7b1d3799e7e8e23386e41d2f9b720f1712b839de729e73892e3159aa05d7582d2f9a8a3245a479e907513e8cb387191eaba07745c914cbfebf928322ef9f90a1923d62112d83ecb7adc169c730016ecc72d1c789ea79eb7b0e77113a0cfeb0458f7bbf6fdcee38d11febd7a23a5f71b89a1dfc8bede786784ecebf19d61707d92e8351f23e87d0df62fd83c8f4b83cff38cf3d2c0f1a394983f72c0ecb730ec57ebb84b08c690f3bcd9918e574898e3849d7558b38e89735988808e5b33602d4bba1fb74ad29c159584e3152ae418ee38a1f5663d6f97c0759416c7ed0aeb47ae3006421173f1ebb0de1b1c2b3c4cc7d08c1d6b656fb180b9b4e1e6e3d7aedea376b5ba996718dc846c2b424c75dcac207db3021f17f15cb68b988f5b5c57d8894b1c3769ad3a9f37e9587585b4faf93892e3c5acaebed818b446c4ff9b9f0189bdb89eb41797f60a80f659f4c8750860bd585e315ed74dc7619cb73a8d50667558b3c56a2314a5f70a09c87193d9da5b64eac76d466bbecd682c92918fbd342601cc466c5a8d89d65c31cdb5ccf0977a8ec20e4b048f113bc6b2ae71764813661eb701f29c2303c4b1dcbee6d0d5c773412a5aeb8b25e8b83cb2c67779f8717b58df1f56e350b797799d6305126b1c776a7d589ee3a822da89ee7a3523c6fa2e62c57588c1d26795f1b88dc1d7d430e8311025d71389e2b859784d2d0bcb719b66c396261c3733e2bace301787652f4e6d60d5e0971a13a5ed816405aa64d0cb7c3d56c0e8002cc063dcbb26b17e75732aaf17a50ebccc9a243b6f49cd3110cd3930668ee3460598d7c74bc6df31c775e308e45768603ea1325116546214e2a8eaab09c581b44373631893766cb24ae31038e3dca70bae0464832b3e002703ae44e6602e91012a5c1bb862a936a9b1e7dcbfe81e6bb31b44c5a4c6cb42b61d77e030064eb4e38a280ad0d8f66e67ab7b14b3ef518ce30e4499131b883c763cf66652ac5d2d6413d1c85356f8b934a2858e10152cc499b89d3eaf51142c88e55a64a4905e64d781a602ebc393a3a61ec7d4e779a18d8fcbf3762a78181129c9fb48fba8964cc9716637f1e87502cb5e271a49700d8f65ac139060ef4192fa04775f2b47fb6e40a785484663c4ee2bccd47d80afbae0c27bacfca2e71dc20bf92373c41a66eda20a851cc9d5a1b3bf0fc87b851c420bdec57d21b62bcf5c26f70c86706c30f795de031bb462bcff153a00bdecd8eacfa71a2b007cb45276367118a1337f9f35d1e31c47079d4876f8d91e1662bfd1a14986342599105ed9a9694a18545447ed382b86900d04e695de50577a43206d4e3310269dd6c64d279eb7e67c0c2a783f065d8e1bb3811e1347b6e2d8242ae4b89970e1251316b2dac61fc60ecdc93b94c0e64633d20df05ab00558dca8f2070eb48928e2c0e738aafcad012b987c2f0363e15f85c1e048373ac5fc193a55760ee90753c8d811043b4e09669c92ace48ee8de63d6812313f3a4c8c8c03c9105ce2883c29115ec8c646e32d3852e9c51e3b5bca15694c4685312a3f14988dac88e589109617d0f8cad7ea7c7620739b6e17801e68452c7f0b5911e368833802605dc6029381910ade48beaf8300899246b458b0367075ec53869c0c6b709f412e323c7a24731ce828d31fd8cb448168f5d745312cff0a1ac74aa623657711ced241f9bba18d8712757be1f8f65a4e336f7a61b5ef91e897a4c832254dcb9ce3c6d56e15131ecd4540458ec98136945685575d38d9d9328b2f69516708da80f5e01e1060dd5917e5cee33322eb78a2a64ef591a0c7150e28439a5067bf75b5db2e4b112236cbcde3dc21c50067e932df69b34d881c34ad2e695c409e4bbec19395752474e3c6e9d6c63b049aad543c5e89952f4c91efce521288bfcc8181786365bc7d011179ca03fae5b980b7c0604f39e09c77e806503b4ca9ab170db8fdf69ceb2cfc9be9aba3858186dc0effb22007adeddfa1dfdb6c4ec5151f453a447e0dc09c4b3a54382725fa5fd64a1d257c504460c2975b44cf9a3d2fb1a27d216a867012d00d662bed4008a94d66f00375500341e3434d6627a1499508b49436fb5f09dc76b8f110dd6535bc056c21481bd6db5929c2237a4c6c3999d2a2245362f91521ee04b6fd24c919ab25892780ef0545d027510e4415d339abae660fddcf71bcb10a89e2b18cd47623157e9602d89a50270f5f6eff8e0daecf5a5936127e3f71666f08363f150b1e07de8f9ec54dfdf5b924f5027fdbc99a8b994d140e92c8add42ff5689a61c0384068381a679cc508b1ab164bfc4e2b22e3e690ec3a639a59e08364127980adb5416d5f6da468ab20185a23f1f3b7487641974688d2aad1610f1e1386366c663c03b51156e144ac750482e1b27a4c8bd45937bb3e356d3f5b79ada7123f494208ca513d28523423a59b745270bd9334d93bf5ace38b8d42ac78a6d962c560b8fb9dc02cecdfc08753b11bc34394ed578db7c89cd476c2a933c5978ee09a69c0c2e972630de814284db090180e3c2fad9a0be73a3aa51ab90dc74d473c79ceee862e0ad42cc97b38157134eecef80b019d617891c1797c5a0e7c56c43b52e4e338ebcc282b0330237db9ca06c2933595cd1da29bce485d88ac45b805a56818fb076cbef44d94d101de610c56b5217ff4681ed17013cc4bd488766467316e94435130284ee600fc963a0f6c2109752d2a214f762b6b178330e99baf8ac517fcd24cca47c2d790a8de6ded81c41765ca47e6ec7ed26d658a8b5a41c84ce372ad163c5348f67ea37462d1c27e4cbcab0f5c69175a92825f06636c085ba4cd86c9147340d6eccdea4559a6f0d8631f4f2daaf9236d071a766a389215289a194fda6a93424e1b8d65c4ce801bd90da5e64b276b9f12302fcb819b2e915a4d5cec0cdcd0866469035c1c8b61331e04e2ee3ed6cb2fd1ee3d2535606dad40f05a7a6216b8bc57696280d36a98ce950f5a61a8fd8b45cb69eb7ade7d96dd33c45386e9d96dc58c9181b861da4ddc3b2064d9b7d5b51f7413757a466b61b8b9ec7cd112b3571841c979507a7e76554f324681ba1506758f45e647db459cd8e6b5326f6910709bd49d710af3765ab004a9e94aa3d1e9a49c222e95e19e6718125b76791fc10f0e526d34c9557b6111092fbc80bb3c9cf47d1dd4cde184f3e772b357d83285a2f1c31831fb3a8d0d80659ceac72d96d76de6c10dbcd9a6814b14406a9c24eb37bf8acdb731efb647f54d271bbd7a61a34c86b4f29445c9db479e3ae968d1ae765d32369e3b83cd48175ed9a427d090b502f8acc7c0f4e3379a86e4883dcb400896b0a44e17a24dc02a32dcc43c6ccce04336696c637d8f4686be79627c11cbe09420c61b3bd7c9a5e7eb6ab27b95ecb6b7414d01e1dfb4e6d5c428726bd2f2708863457e7c501b454bcaa2e115126dd0ad3a1c407c958cadcaca45729c5b1798688bd456d7d83a8e6718b2c277e1cf1f42ee45ae507db741a9b39310eda10ba5c158dd2b70a64eda341bb0a26454eace5bdd88e14cc9cf4d9177121de533f54e9ed40d8becdf82c49d29ec8694e882f9d26aedb88d2c91f34b1f9656ef6efb13fc6cb118ba58872ac3513604bd4b292d8172bbfac74ba262cae66e0d4c2a4058797a96b226bb94d448e3bc0960d12b0c43eec0ac258e9c12362db631603fd286149bd215dc697f2c4fd8bdbf149d43c2e92a9098b64dcaab87cda8afbdb60152bc6d16489b666c53cad89702c3d9584d3a8707e796ce6650f310ff1cdd8e2bbfc2f0cd9123264e021b72e209e6948f5b140b5c4876dee4ac6238d3b6db242785c2933f00bee06cbf19880c933814f8778eb1d14de67a5a88900c652a64ab495694701b75674d9e7a6b9359524b52e84ad33a59fe2c2db8aedfb462268f5bf95ae6e99b1a9bc72cf33b64a0e6de5ec49fb6d86db68dada78332246d80a76b9b8b9f834db43a271933a1f0ac22077244c1f2321ca14b9541a78c0371c11e5834c232163f00ac9922983074b2f2343ed7291841fcdfdc9cbf1bca22997332c1a365077eb93e58319e59652b07e61b26d57a51586923b202dd76f595a7547e83aa7a24df51827b25f934a1648daa24ea6d97eaece829d39edd8ac744db9f91065f1214ef24e5ab208da9d416b81306dccb760eb618122e4b9cc77aab293e9d24593005e61939f5fb30825e4b0927314b28d9c49b3d28b046dfe132cda893aad3aa6e5dfac3adf6478f565e91b757250065369093204d66db223442a425440a6f8ae7a41e3136cfa26fb2cfa7376eeae99ff4b418b92cc2c2b8a9b8af3a6e295ecb8c86ac146555e20a549272c1890cae9c508edf1c9b77d1d4b94c4a6395aa9ae0b2ef5f2914956a5caa15e244153035c48b1271a67497dd0e55a03594e9c7b2eaae423b2ef00e606772ecfad0b67c85ad5486ac6a3e54592e7f6020702df18d1b6714c9de1d64c8d7d9674d297ccd35d340d453dee0c5c3938637c9d4d9957f969e411435e2cd9b036ad322fce40f1ec385ac5f741ecf87c91cd06b93f9b4cfb9cc13ee7c7ed24ab93c24906d5085f4293c36759975ae9d8c669c498058115cab73c46024ad17213f357b523d2a2b77cdbe69edef3306dc60aa409871e8b8538766d23641acc91f8fd29faddbade553037ab5c7da4a27db52cff3a877d34e2fb166b3d5d0d02b7784f4355206ec35e552b0d82ae8bcca12b1ed72c038ea02bc20d84d6614b6361752e6c05fb405f325cd487ac44a224e70f1ff6b5a60e723372ddc63502e5de914f651db7e262df930fc04f15cf36a5ca6dc2b3d9e6d3b61b0c00cc7703c2e3b9c0f3d8dd7540f6f82b592b0d2c8fd42e8415ac42d1ac472e8015233c6ee10e78e19a17a55551271dba078516ac62af396a5bf96c4f4d79224a2e60d2695b57ba68a7220cad1477740dde639a06b98a873a758a565d1046ec97b537684794c7c9c4be2d83e9ee1bad8615537e58296fffb980d70bfc0b0c8b000c78c188e34e6b299b5678876e0d27e836c72bb6c4226f331b36058989ecb43e81ac3b977c04c1209ed1c4330b97db3ba459c995945d9fb62622d66f4a5ab66dd1fd0125dbf61e60a1a01dd52063a141b12708b4a69bb35b6218a75c76e4764af089c2c14607cf5b9b8a33cb3358d8ae41650f15d18cf3a6c1a26f755f9b527dd68133eda5d96ba752b298228d74b68144dbef912373e3d661552a1b24207bf3d2c89c57f8e2afd365c2dcc53dacc28da0edeeb3f2f1cc4dae477fcde2c25cbef9d4e925236d6e82633320f12a082d3dcbd1d847b4cd35ab4f152dbfaf388ff206fe2667a1257fdaf8ad969c9ba56522cb9089eac5fe9fde20e1907b84e74b3549158a42a32871f70fd078b87113a0b2656fb52d716c4c2b932dfac154ceb12aadc6908c6daa523c6e19c3869fd9ce1223ed4dd7d97293368b1a2a58b74c1076935a4dff4d5085c91126d33b99397b8c44715a4bdb74a3a44d536b3fe3ae59a6cae31077a1d55651547075bf18f4ef67998592af95fd713f5a68b71bc87123748f08561d30ad4b2b693919a1b58bc2fccc4faef2e4e5b3974513eeb5000f70fb83f7cf4f61e4a85bb84b21c2b542d061cb50252bd1e63652161c00341801d4f490e3c3766a7f9b57c7ce08f5d06d2d4d95360f671b0623afc28f3e26be3c0ea3efb64a886d28d22ca0d4ccf463baee1384ddc60933c7d8e21e19bbd607b10a3ccbff6379bce3a1af91bb3d0287147d89e949be4c4b5d4cd716a3e81554df4ec8b42cc0a3cdddc708d522e419cbb5b1ac3e1bf2adb0c84b61195561ba334ca36f4512bb74430de663c4eec903e4e3566c235ab16cd4d9c45435e118320462c91098756a5f89c2829efe925923efb215f9638558271a93f145b305a20bef375a1739d1028f9b10b2cd53f0e32292e5ee0f6e4d60d035c9d97f876d3e68f59308202c9b1270dba7b84c8312696d79ad1c9ab9db9c2a95936d093b40e766e8e96058b5dd6a6d1d100b3bc9213d15661d5c70d7c76cec1b4469c710ab760c9cd8598eabd75198166c54cfe40cc3c41ddd5cb52d81729b463288d5b77067f71476bb2376443a8e49c3cc35a598e5bf4830bd3c4ef08a4aaece47a62e39cf6e3acf76e2a059b5cb443087ae46fb6eaf158cc14c21bafd7328394e6a1294634042b8d2a5504914ed045f89e8566bc1a05682c1b85e65e7a520bb59a39c23e6adce99273b682f69a528b45594ba71462036e3309c4e24377bb369a6cf76ee39ddabeb6348dbe5a4dc982e9550f44928455dc47691c061b76895fda4d02e5c153bca365c4d9955416dc203715cb3cd6748122dd720ac5d83e962a6ea9af7e97c145fd43c2e5ecaee628ae3f265f75f3e9084716730c4382e96d5f1ca65d1619756aa1b6568955cb883e1b394d2ed71deddcfee2ce3c1ab6d611c8d2dead83d4eb3a71b83765d3aaac94a93ed7a2469f77cec6e8dd9418e64df7bbc87457bc077032bed163672ed5cc11afc74b8c2208449bb0db59a0e82bfc3dd6f76eb68f1e98b43ff8d308819681bcc50f983438708ade502d9de98052eae88bb39156ce77f449d7dcf555d28f48bfd5181debd46c5a9509a70cfe0b169b156a9b00a48a2caf742087a4ad31bf080aaad6bb032a85d22d92cb0ea0d2b7d95c6878916b3ed84267710fa4e3340b55aaf51abcb6e6a9eadd0b3f76ff6c5815bd7bc899f9af7aa25df1a3953d8d312bb6ad620dc15ccddbd6334fbd79e6e7e6acefd9d461c9dbc3ce32fb68c5b9629ee6ea5f0a7c1a76ddec4d6693edbb9ea3e7dec56df36ef6eb4896dcb3e2ac777fd43ba31dce9b9ca5b2574f35274c087096ca3796b31e9fb760d36b0df167ba41eba8b16db689e2a752623dd9ddc3b2dd536d6d0fe7f0912ed8458652473a04516d29e5e99dd378db3af5284d7ff8229bdb63cdbee010cb6919577b748422e48a666cbb8d96ecec2023fad2e1b0b7c4f26c371a9cc647a69f5aae8ce2683238eed110b4ed0bbf3d973b79a31d49621a4a7acb8b978af388557b821ae4854ea488c91448fff0d0000ffff05bf4832e3350000', 1);
+INSERT INTO public.lsif_data_result_chunks VALUES (39161, 3, '\x1f8b08000000000000ff6c574d8e254711ce7a952d4ba8ad11b0e008ac18c77f66b261412f981df2d6f2a26586f1084f0f72f71c022e803186fb71093f145f54f52ccce6d5cfcbca8c8cf87e22ff70fdc7be6d2f3e7ffdf8e19ba7df7ffde1e12f77f74ff7dbf5bbb65db64fefde7ff5e1ddeb87a73fde3f7dfdb85dffdeb65f9caf5edd7d7efff0e6f5abbbc7edfa9fd6daafaf7febdbf6ab77f77ffde29bc7b77f7e7c7affedeb97afeebe7c7cfaf6edc31b7c7bbbddb6f6bbebbffbb6bdfcc9b82fbefcf8f893357289ed76bbfed0daa7d77f5db6cbf587b65dbf6fedb7d77feedbf6f3fff3c1f7b9819f7dfc63bb6ddb27e7bfb7adb51f5ffcf7faddb66deb975fbdfbd367b9f86fdebcffecddfddb87976fde6f3f5e5edc70e8a076c3413a72e0d6174bbe30236937ec24dc6e5862e653f0320c1ea375615ead874e699dcdf20f5decf9ed8a91175f91175db375f7e0b607fed035730996554bac7cf211d13ab353ebc13a5a0f1a58cd2287318b21aeb1303a479885b7ae34a3edce39b5f0d4fcc61d9b987cec8a75add6d9c38f670fc5da3e737868c6ad6369cdadaddb947c277371a5235af799abb293b65d435a1719d1fa32ca597c0dce31331f34d85a5f32f848ab20cfaa92c9d09923d967eb42960bd098a3f5550b2c59adabf86a7d6864182eb32ec35a3747b8e6f8d28748eb219965a699bf33ebe34367eb46a66df78c4c839094652b23d375468671f8e508ec84ebe2c0800e14dfddf064b650156cd363223d311453cf85b4472da480560c443b33862060c23570b179d467f2904ce400706c066086414c8c695ca5becc812bb0f520cea411b53e0daf620289ce8a283546ebc3aabcd3a5d256a914acc583da6e808199d827050f99d12a3b36f4b84b9c1e199bf3b8735ac79d4e3bee1893f974a0377ce04972f70318f699b095c9d2f6b9ac7535051e8664a956d57bb52eeaa3edaeab5d86205117a0c846c19b9027324be610502b4a39934aebc6ac28d8c87828dc93a8cb012de02119b75ce30042c12a12c05de68acc0ba89918ea2bb8a835c1e131783fa8255c3b9f34cf0c19b012290b7d88204c1a47cdbcf50828092dc19c224543032ac6b4cc95830a9652e384d135912f5d6d6799087b6789d62537d96d26a3c256409228dfcc922207bcfc64636dcc746042f1c830d95bf7a068bb4a213e50aeb180259705f1c2933983f9eb79c62c984f5e6d1f0eb811a8415c17c9c4fb306f7d3076ed06e1638a95c5d2fc5f2b1f167139801827d48a32b68a70a3222f9122d1a29f68ca614a951d0c53aea4d78a03e4b095b1eba25c7760ab145688caa4aba47ecc9267b14c8be5431772c4606b809f950942d534e1dc8d672e0e808764ed6ca5e285432e48b08c2c02321725f83587c5aaa479618593b72c9cc58f54365168b3c9a40376b24eea65710fea25eedd0385a2049a7889c828fd373df5df9271015daced98323c8c2de395c2cd986513b8a8a439f1444a4d3977c40b52699e8a2ea405cabc43f21cf5f128d56330208696c322d79c8935f3240aa79ce9a0da35e58404e8110cd3b58a04ab70d4c5175446275011c77249fcaea8a58cc43397a032d21ef54d88eb2747b07ca472f2b0673d3b932a746a619a2bee86f1f94ea48436b731e990a9bce3a494a251203bb2c2a9d4ba729b921e9fdc5300e3c4f3a29b2a50d03823a1538113e6679cf11c671aaa61624ff11bace99101c35481af44494f0a5cc26502d740afda841c65ce390e8cc12735109249e2811dd58e012d75d2443987b52ed9ccf470743e3e910d1ef031162e035bb3ed8b24ab9db1b12f6006d971116bbb71196ef9ee215a2107852bc3f05d7d4e1b950ae7b2ac842d87b64b3240961641cbf0c3b97c00200a183de70e8452359d6d944123636b40ec35f9325228dc138a96b175f1590e36ca8c73ef5e9ea2392ac6e12108dd1812626bfad96b54a724494d5b04194a6966343eb24ab4e6e1ee92fa316d647ea8f2439767d13f9090bd92691a4dea66dbc7f232096c5a0c044603140e1a05839b3424d110b0e450ad92d03c23f5c30c2d651d2da84dd4700ab57da625b2ac6a3acb4d92b8374c7354bd08944cce74f1345f8b43dd4bea049d5df0516c87e11b040cf8d0891908fac823e5d5d5d1eb31b57d00714125f08cedba655963641e18e645d9ed74113e8529bfeda2d9702c1a871c2e423d4553154325c93461e1ce74529a81269f6c6d9fa524291cdd920dd92997b657428d135aaa02bfcbd875a8b47d61561bb07f552f1f005a22a2fa7da2b6a3f68cae50565554b36999ac7ec6a3395c521b3b73a4a69855d14af335931eaa25bac6ed7264e1c26563139a0584f02c843122e23c02ec9264959956641e89f4128850b48e4ea9c383721619988bc3cee070be20074dddf230a0e0fbd275f65b8a7d0d42345ad83755e91fc5f2505b79d6627eee3269b41dc70181d185a59dabe1f4e6ab5a7625782f611f40934ea994471de8aab79e79849040513d46590a1a1c113a090119b25ac0c4177cf8e27612914fc196ec5a33829d8faf77669c45b2d965a5a3678285f755766d0bbd453895f126adb3c3cddfa2ad405ac4d0ebc4c0490825f63ced751fb30412faa5729e67b554d4d186105c21ea38eb751ca514a5be06da05c189720289769cbdb8ba53c5b7a6d9ae39a5fb6a64a3145e7dd04c4e7145115a679f055d57aba799501d84936a145dc2cbf8ecd029008cab04115cc72160afe22380de239d2eb4283a26b5f6bf000000ffff3648f0eee6100000', 1);
+INSERT INTO public.lsif_data_result_chunks VALUES (39161, 1, '\x1f8b08000000000000ff6c574d8f244711cdeaca1102ad650142fc044eac333e33830b07f6c0de90af960f23b3ac5778679167f647c01fc01883c41f75a37851d57bb02f33dd5d599911efbd7811f9c7eb3ff76dfbf8d3578fefbf7afac397ef1ffefae2fee97ebb7ed3b6cbf6d18b775fbc7ffbeae1e94ff74f5f3e6ed77fb4ed17e74f2f5f7c7afff0fad5cb178fdbf5bfadb5df5cffdeb7edd76feffff6d9578f6ffef2f8f4eeeb57cf5fbef8fcf1e9eb370faff1eeb3ed596bbfbffea76fdbf31facfbecf30f5f7f70461eb13ddbaedfb5f6d1f5df97ed72fdae6dd76f5bfbddf55ffbb6fdfc475ef83613f8d98707dbb3b6fde47cfaacb5f6fdc7ffbb7eb36d5bfcf28bb77ffe240fffedeb779fbcbd7ff3f0fcf5bbedfb4befcab1daae53db1d5158b43e953d5fd9f293b53b128dd1babbcfd66d91b4ae2edceec80749bb23238b76472a1aadd3f2d17a985aed11a6b9f5a031f321cd7c212cf7b535f3e8959f69b8b45d86b7eea6b9b9b2e549a12bff469e60be66bb444577096d5d95b8ed53f2a90771eb3aa6b62e1c886cad687b6678b1e32dcb68642db9548abca8e101793e10a2d66530221833377136ca7f4ed1bac7f0b607e762a73c6a911da92ec2e6ce6b65c6b6326325a9a7a4846d06b233f6fca673e0476201fe3ab0645aeb3a6db6ce06c47c8d3c9174e5721e03f007cf5cc72be35adc3a5942604c86bd5d5a67526f5d49b575d6517c014d354ebe94335ad370bcc3382ec25b0f563d486495dc579198021d9e0b5c669889d55a8974c6cf3657e10696671d4033d399f5db504428798c98e2cc69da7aa89f67aa67b0aac09ac4736b25066c94d886969a3c95c02440724d2c582b970fb10c552673eb1231daee99890aded419c0515d014171c1e049c6cc7347cc12e32ac62545672b117773e4b5c65e049b4b3b84c0747e5269bb2f693b8d4a6c2790674e1921b900770398b2985a7771a0ef7ca20fad98225a09aed40ae4c08f1aa408139520cb93d99451be36eba005381724962781074b60687aeb94face7c209e61007a28b4af0e842823e8565a74463d3894eb521c1254e42340cc1ac53222d5a84c39d24604f10e490593d8c828d85af709e2dcf14fd7c857c58b27c9152c49a6d140cdb31fbc605dd03c2093b204b895553da5d1749b917e62561c072263d7b693a28c080181f42199aebaced67d20fab42ec00795c9aa22b68c5f07b4e60b421d23e54ea3be8897c261a76e954d94be173290b25af788d683f8308da0722225c40e7fe158a5d301b7992968d6643c1618574749baaf5a00265d6cb56e07ae5ace1608d956aac0391dd82c0f8293ae53b1f9a996af483cb2dc4c6739b203674282c2c88cc1e71cb915bba6a1126a7bf83ad21a5edea17930442fd920ee886632362977a04cb427c789a14375126839e22352459e6f3249db9956bb1080b54cc7f0b2665be9e453b2a0e2ec491c00d207b466290c35c87980068d2a7e2f9b58418806a98a6471ea388bb35c83aa6c1828abc0f25460a6a6d9389965d41bcc0c632fae748ccb879f0fd2935231cf7ad0dc5054ab42a89a5a019b64047a8d59f5169384cdaa49804471494255122e65adb6959b49c50dee632e9c88fd79da00b586246894162f5eae8f7824619559b20e58c098a848e3a4ce9764e994635975ef31a2b0cd6982d5aba95ab9cd28aff70c7986674fa7d627660019599a929cec734626a55887a1450256e69eef724c58c4d0ac624640b34601a9a23044a90105f85ae97cb3321519e55a01bfcc81c52903e0c1747037522551c26181f89906b59db1870b062395aaedc9693f2619fe0253ea2665f4f0e6900a2a15216bceb6a7c174a2e42ebda175e27508275b2e0a5fb0798ab39b0c381c66801970a590b219b0337425db9a7664547cccb2e78106a6aee56cb98eabb9988dc3262638676b7b0e6b5dc8aded3ea09655a620a8a591638691633aa4ead5f8e7a4d676c163c72ce892fd86c966db174a840f372fdff574cd3db209728e5b5d18f5e439aa749ef9c00a9b5b19920414eed56aaa79c18168ce12ae16f05292cc39cf0712919284a7740225b428c19813dd7ea583196b2182c661846f3ce32811b85f698f08a591352a13fe3ac26b103a9a413ea29ca5b23f94848b90c07ca50adf66c86612746541961e773445ad11477f758852f8708eec098787cc387f8bdbd39bc3f0b473ddba7d1ac7f83295d631be0c3f3e85999e238d9c3b8f356e9f6e67449ceb6eef4a9ceb48c6b9f3f45ba4f33c17e2f39c0d3a33ee056ab326b891d4afb229c0c061b82c480d8460c269d5c85383c7aaead25583475d563cbb0daf85d179a6b1cf923348542b99cc59df6063837284ae9ab0bcfa6451aeb3285388161323003cd5a286a6803d8c3818cc2632c97189c8737d4e69bba27cac6e1412e9d334050d2cf26a336b0ca372fef4133d2e6b84ae04957030a61aac524e8fb401a3d1b2799b808984719313cd7a1e6d87ce9900908d2ac2a3dfe6cda467336d7d716246236bd178e126513eb770f3b3e1f372ccc01fc426a830aa19a40a25ca3216afb69b6010cf6b8b6b4d8f1c751952fa694dcaa70e836f0acacbe1a12f3a7568e3d4b088dc7ae8a975a1f3a962869b831284889c1aaca6cd1a070d43a3a6c7750a5c77ab4055cac80b9259a33a62e6eca9dd1df7195fc7ea795e09dced5621b74aca71455235214cadfd3f0000ffffad3736419e100000', 1);
+INSERT INTO public.lsif_data_result_chunks VALUES (39161, 0, '\x1f8b08000000000000ff6c57bbaea44711eefffcbd4282b51010f00844d85df72e120236c019726a395899656de1dd453ec70f012f8031065e8867f2a0fa6a661c98e4cccc7fe9aefe6e55e7f797bf9fc7f1d38f5e3d7ef5c5d3ef3efbeaed9f5fbc7c7a795cbe1ec7c3f1de8b779f7ef5e6d5dba73fbc7cfaecf1b8fc6d1c3fbf5dfaf0c5472fdfbe7ef5e18bc7e3f2ef31c6af2e7f9dc7f1cb372ffff2f1178f9fffe9f1e9dd97afdefff0c5278f4f5f7efef635de7d7e3c1fe3b7977fcde378ff07cf7dfcc9f73f7fb0476d713c3f2edf8ef1dee59f0fc7c3e5db715cbe19e337977f9cc7f1b3fff3c23775801f7f7fe3783e8e1fddee3e1f637cf7de7f2e5f1fc791bff8f4cd1f3fa8cd7ffdfadd076f5e7efef6fdd7ef8eef8eff9eac3c9ed15a9ce341773d7a3ca88f33698d194b633c2332cf319955c73352561bcf8877fa9892beeb1a058d67e4a43aa6b1d398b688c7540aaf1b41528ff9cafa504f6c3493688f49a13c4e12c7c59344c7548e7ad462d5c22a6b8fa9a13426afacb52437eea4d098b951132d5448cc7b4c4e5c73c59e6e6bd57a66f5b152706f6375236114695247661b0fba1a09d9636678ad6ac934268949d74e52bf397762c1c5e3e4cd635a6fc86480c9acb7d73da62bd7ee6a5ca0994a1d6479ec312d1736516a20573f6878626d2994ea54b4b818b185077cc91a337d03dd745c4b91315959c7b9a99830b2620f1bb0268fd3c5c6a4eb96098e25017b68d65f9751e4d09da68523d5426202663402cc443c341ea634fa7101acae5d6a33a95e7ae020288473cc4db9c614b53526efa8a57dd1989b25c7946d10a0947eb4743833080b9a17b2b6e9aaa24d81856a690e3660d122d975d01468dc7581f40c19533d8acd406d2e785a590a10e7527b92f3edf4de2a01cabe5bc3beaae0a418a76d94a5ba4f8826f58643f2f51b0340137862a78d538a75092ec51897e10a0dda9013f1d556146306ef3a04e59d8d041bd6f249e53189716c4b86f61c1f1a5aab88a4b6476f782d288e04042a6d1cbad08b70c7f6196392398fe96ca5155ecd7602dd285db980b9a8ba37b1ddc86080b8a28a94302dc904905f1b6faf32fd5e5b6fe5005871d4e3ac38160796d5b82daba82c8135b9c0fc6a0dd3864836846c02162dac8f52c2e2cd6b9cd61811e1bed4062a60d33682c389194c161f22edb016cd2a7758f4d90a98aa14b81279ed40bbd260a32e83bf36d1ba15df9aa99028016aaf1cbbe588636bbd6f05f3642f553821bf4c33c769d0bf9a5f25a0d648e244ec05a887db383dac6c9f63fac66e1abd9b252a63649947d03829b502ad34262b4b12a59b29158ed3a5780d82ba369cbc12296e0bc2730e7fe8c05e77992300514eac7a9fb5a911542409ba14fa5d8b911221707eb6cfc54bea2137a907105b86a3504a2525d0976dd1d68530a9b29b575c55c8cb0b12efc0428a4a7833e91d965cc28c6a5822d2dda91491aa760359d1882cea2fe427da49e22da1856c572ab730c31ac65d8ff583d53a26e7b51516387b53696b8f33d18a5ce178d78a5ca50584bda23e3730f11d45d352829c37da35a191ac2edc0389ac52ae5409adee5d4893740b34a8c51dded10d2b7b8729197ef156b431ee8ff6ab1284b0a9e18ba206dc1ad7f7ea63c5b0c938b9b5b61c6709ae288bed636a5abdd0c342502dbb76278e3a448568b76ee06aed1f0dc0d0c383baa05d32ee09ed447c2327f7d6bed5a9a955620214b3527492dd5313706bf4d2864cf7554494dda76605a06b43190802c90e2c49fac97d34b905ba5fbfd1bdedb1ddbe5518dc34b4aedfd6beb785edf74619b73796dddfadeccd52ae5edb0023ca3cb353b7861bc79cc04da99930acf810ebee44afbc0275ec38ecda0b122b06752f6437baab24e2890284704062d6f2d492d834e926a33059a14c69591b94beddb865081198c398b677e56b94dc039633a1a26797d0438cca78ba504b238f48f76cab7387233a580d4298148046a5d0ccfb1c96623dc156749a022a3534745714c665f9293b3adc5b84e4d55fd6adc9ef058c74b54730887028a291da7f590800300a28d2bbcd72b73aab31a1a6a0354ef52b611013734754258e959bd16250749f966bd9a956f0a66bf7036c2181a459154d6705c8195cb3206d74d74c1e0ffb3aab5653d7d5f3a3c339b17adeecdee90284bd6711d51e94a268b20af87323ce640b5d739d6e6a326def003a13cc232d2a7634414717d965d14982d0f3128e607611b19eee7a140e993d3172ecdb06743791d8dd30de9b56eb486f89f535f346a5a65fa36cf3c3b94bda185099d4447c2664433b8acc1a60aae7d5dd558d5bb7c878d8d7ff7c7af96a46339b3dab824e0c0b51fff94c4d68c0760f573b811cd5005704a8d4c8263563b2b5964d7aeec81eb7e33657cab231fe170000ffffb71c2a4d9c0e0000', 1);
+INSERT INTO public.lsif_data_result_chunks VALUES (39161, 2, '\x1f8b08000000000000ff6c57bdae5d47159e7df75848c8510414143c0015c9acdf99a1a1c005e950da2885158c1311db103b0f012f40088147cd41dfb7f6b9294273eff9993d336badefeffce1f6cff338defff8c5dbafbf7cf7fbcfbf7efd9767cfdf3d3f6edfb4e3e178efd99bcfbe7ef5e2f5bb3f3e7ff7f9dbe3f68f76fcfcfed147cf3e7efefae58b8f9ebd3d6eff6dadfdfaf6f77e1cbf7cf5fcaf9f7cf9f68b3fbf7df7e6ab171f7cf4ecd3b7efbefae2f54b3efbf478dadaef6effe9c7f1c18fd67df2e90f6f7f74068e389e1eb7ef5a7beff6ef87e3e1f65d3b6edfb6f6dbdbbfcee3f8d9ff79e05b14f0d31fbe389eb6e327f76f9fb6d6be7fff6fb76f8ee3d8bff8ecd59f3ec4e1bf79f9e6c357cfbf78fdc1cb37c7f70fbfeab9a6b51e5bbdf5edb9b1f8c0abd59e881bff854c6fdd720fbc99db4f2e125bda6a798c59af96b8b427929ef8a7b6bc7555c5831e7bb727623c709accd6f7346bdd5d57ebbe3db0bdf1490f9bada76a9d3fa2755deead8bb97359e0dff08587c690c4f597ddafbfb4f5bd3d71a05fe79ae1667b65ebbaa3761913878de465226a81e236f828d7f276fad0d6a78fab37d3c7aa3af090eb63cb78d72113c788db46cb826ddc6b70e7e06932271f79982c6ecfd5fa948db263b02d8bb74b497cb30c6f3cc76c5d6da11357453bd9b15c5c306db396c1ce6b5a3bddb5f59c684e7a2607e3c63b08f6b159d78ba889eac8754dd42ceeafb0d3b268dd96ced663b2425b832dd4bd5b4fd97863732b8ab718adc7b0e4f0162f1bb9dba99abcaa6359cee43f35dc221797e76423d2225a17d9bced20a86c7b35ae2f317c2012dcc076f0eb01300d8ccf6ceed665ec9a1421236360f5586bb7733ac62bba5b8f248652a73f702c9e57e52a78c09de85802289b000be8714fc5ac6c18663b54a59d81cfd501ddc0d97dcbbeaebc65b3f4c4c1a6899ec85c00446695b7d0490df42e95778d19a0a5e4ba6f927840a368699ca5a51696f39a3fb71ec51c75502bb6b62e1b344b1b38c5317f13d0d3877a3b95f0881dc91e1173ea8b0780bfdd44885055bd2ea3c103377915538bf88243d6c6f494680829006bc643e9868b5c1d16342c320b930124e9c4aee156a7193b54909341380cdf00f4801c849b42be1c9f2ce2c97519662b6c406078b68900d5c2bb3a375dec7e4c82d554db6944716cb46b6e012c74ecd1bae41c55b564463b13a3922dd840d626add5b49dbea9a616ed844275d3c1ee04311e0b5292022a5baab4ee82b3d49c456fa02b37af17e102512ab205ef6a816a5d157fd76487a440bab05a368e57a12ef8605b235947409e1e30e9dcd24ea939cf60e733a84f8be5fb926c27a0d973808531d0d008aa69986e6ad39ee027201535e7b41284a4d899f35d7a8940125eee934b161b37dc40a9cc05e6b00183ad4c811b65ce001e286b919cad2a192f03226e4e65c94d7b099b3c694d16bf0290f7840aaba32b1ab39d6bd11222d008c100720cfc65a12246e6b21b814bd882f5d828a91ed4ad584bc9d784930408edc105b2b41e3542c7286523081638629751435702676ac0a558a066ce761a44c61c147507eb3cb8d4163d2753b2a4924a56b2985af45959feb6eb0190690ef63b175b9312c41d87ed8b52123e6b9ca3141957f58b068b2a93a39c764f4acf946867242c1cf8ea29d40857bd6641fa076a5d843e2858ba14e833f9ac525e6517c9a9df31699603e6d04328e028adbb17f2043eb6bcbe78e4a3023e22254aa708b190dc310305fb944222c7ea7b5c2d013e266169db1e358d5e6a3b16f041255d1c66c42c1b2eff9cb0e655a2e9b1cb3f1fecd13d1f3da4989d36a0c36680ad11fdb1cb17a5aadee5ff3eebe2605738a0a5e4bc6d70de62c3787c61b309bbcbe5d0e65d11c02af02c2ab57ac0817215b3029d04f1ba7b755f42aa570c5c9002381b3d48a3f460951ee068474c49613acb154539aa926e86831905a85dffd83553b8b126e6a02bcaa6f7c5a56c6706eb21b4c66200b44c0e68d6ed36b45ec72483c9489bf48c0a0d507450dbdab9693369cc4621084a3e8bca8b08f062b10f6a4880f8882c0ee61b5a2acaec9451fc9eec9c978227d24c9f0ae946a2387e88c08c30035acd1d5327de88c5ddb4217311cc25e2d409ade206c9679aa3bc8ed0f241f24cd4ad03be1657b731f06d22d7be2683190e32a11c79e6be825516d4818dc5e30d3889acc02459898146a349648f90728dda62f34e6edbae68a832eeb128ec7ae5e56a1ba0d93aef2945394f675a4c21d1f29e9e853916844092246c332ae54d9838f2f0b592a9c5377e7b4425e288c82764dabaf34bfc31a7ca7cccaef757a533428b174133c3f87b873f3ca6ec8a4c85a54dc866aa5594c54f0a9bf79f143669bd6869e6e258b298551018798538b87670b022dcd8c5393fd47366ecf690059f8784dd0fd88841cb7b6e802c46e5930ab91995320473ce0dcbc8c8120b2b6d4145a98c036ea0f12a29d5c54ca6434b66018135f655d1aa49dba2567a52c763e31841bbbbf3874fce516dc1c420d23477297657f2196c55dd330a89ee562ec3afc6bef8b291e0bac0bfba66598f5f6d648acd8c64b6817bc90248442bbd97cbbb1695c1da092b0342c8a9ac382a68aa218ef7084885c086cfc99f4fb3f2c754c637b44a0476e3f54b222a86f8c02542191d99fe4560c669d3dbb92f239895e7a5e2ffa437b9b6f6bf000000ffffc7b5d1246e100000', 1);
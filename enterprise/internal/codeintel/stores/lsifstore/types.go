@@ -1,6 +1,7 @@
 package lsifstore
 
 import (
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
 )
 
@@ -52,3 +53,82 @@ type CodeIntelligenceRange struct {
 	References  []Location
 	HoverText   string
 }
+
+// DocumentKey identifies a document (or a document path prefix) within a particular dump.
+type DocumentKey struct {
+	DumpID int
+	Path   string
+}
+
+// PositionKey identifies a position within a document of a particular dump.
+type PositionKey struct {
+	DocumentKey
+	Line      int
+	Character int
+}
+
+// HoverResult is the hover text and range attached to a position. If no hover text is attached to
+// the position, Exists is false.
+type HoverResult struct {
+	Text   string
+	Range  Range
+	Exists bool
+}
+
+// DocumentationLink identifies the documentation node attached to a range. The node with the
+// path ID PathID can be found in the tree of the documentation page with the path ID PagePathID.
+type DocumentationLink struct {
+	PathID     string
+	PagePathID string
+	Range      Range
+}
+
+// DiagnosticGroupField names the diagnostic property used to group aggregated diagnostics.
+type DiagnosticGroupField string
+
+const (
+	DiagnosticGroupNone     DiagnosticGroupField = ""
+	DiagnosticGroupSeverity DiagnosticGroupField = "severity"
+	DiagnosticGroupSource   DiagnosticGroupField = "source"
+	DiagnosticGroupCode     DiagnosticGroupField = "code"
+	DiagnosticGroupPath     DiagnosticGroupField = "path"
+)
+
+// AggregateDiagnosticsOptions configures the set of diagnostics returned from AggregateDiagnostics.
+type AggregateDiagnosticsOptions struct {
+	// Severities restricts the result set to diagnostics with one of the given severities.
+	// An empty slice matches diagnostics of any severity.
+	Severities []int
+
+	// GroupBy, if set, additionally returns the number of matching diagnostics sharing
+	// each distinct value of the given property.
+	GroupBy DiagnosticGroupField
+
+	Limit  int
+	Offset int
+}
+
+// DiagnosticGroup is the number of matching diagnostics sharing a common value of a property.
+type DiagnosticGroup struct {
+	Key   string
+	Count int
+}
+
+// Symbol is a moniker defined within a particular dump, along with the locations of its
+// definitions within that dump.
+type Symbol struct {
+	DumpID     int
+	Scheme     string
+	Identifier string
+	Locations  []Location
+}
+
+// DocumentSymbol is a symbol defined or declared within a document. Range covers the symbol's
+// name and FullRange covers its entire extent (e.g. a function including its body).
+type DocumentSymbol struct {
+	Name      string
+	Detail    string
+	Kind      protocol.SymbolKind
+	Range     Range
+	FullRange Range
+}
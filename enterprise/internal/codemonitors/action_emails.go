@@ -88,6 +88,24 @@ func (s *Store) ActionEmailByIDInt64(ctx context.Context, emailID int64) (m *Mon
 	return s.runEmailQuery(ctx, sqlf.Sprintf(actionEmailByIDFmtStr, emailID))
 }
 
+const allActionEmailsForMonitorIDInt64FmtStr = `
+SELECT id, monitor, enabled, priority, header, created_by, created_at, changed_by, changed_at
+FROM cm_emails
+WHERE monitor = %s
+ORDER BY id ASC
+`
+
+// AllActionEmailsForMonitorIDInt64 returns all email actions of the given
+// monitor.
+func (s *Store) AllActionEmailsForMonitorIDInt64(ctx context.Context, monitorID int64) ([]*MonitorEmail, error) {
+	rows, err := s.Query(ctx, sqlf.Sprintf(allActionEmailsForMonitorIDInt64FmtStr, monitorID))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return ScanEmails(rows)
+}
+
 func (s *Store) runEmailQuery(ctx context.Context, q *sqlf.Query) (*MonitorEmail, error) {
 	rows, err := s.Query(ctx, q)
 	if err != nil {
@@ -140,27 +158,6 @@ func (s *Store) updateActionEmailQuery(ctx context.Context, monitorID int64, arg
 	), nil
 }
 
-const readActionEmailFmtStr = `
-SELECT id, monitor, enabled, priority, header, created_by, created_at, changed_by, changed_at
-FROM cm_emails
-WHERE monitor = %s
-AND id > %s
-LIMIT %s;
-`
-
-func (s *Store) ReadActionEmailQuery(ctx context.Context, monitorID int64, args *graphqlbackend.ListActionArgs) (*sqlf.Query, error) {
-	after, err := unmarshalAfter(args.After)
-	if err != nil {
-		return nil, err
-	}
-	return sqlf.Sprintf(
-		readActionEmailFmtStr,
-		monitorID,
-		after,
-		args.First,
-	), nil
-}
-
 const createActionEmailFmtStr = `
 INSERT INTO cm_emails
 (monitor, enabled, priority, header, created_by, created_at, changed_by, changed_at)
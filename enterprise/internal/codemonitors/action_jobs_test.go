@@ -7,9 +7,11 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
 )
 
-func TestEnqueueActionEmailsForQueryIDInt64QueryByRecordID(t *testing.T) {
+func TestEnqueueActionJobsForQueryIDInt64QueryByRecordID(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
 	}
@@ -24,7 +26,7 @@ func TestEnqueueActionEmailsForQueryIDInt64QueryByRecordID(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = s.EnqueueActionEmailsForQueryIDInt64(ctx, 1, 1)
+	err = s.EnqueueActionJobsForQueryIDInt64(ctx, 1, 1)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -35,9 +37,10 @@ func TestEnqueueActionEmailsForQueryIDInt64QueryByRecordID(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	var wantEmailID int64 = 1
 	want := &ActionJob{
 		Id:             1,
-		Email:          1,
+		Email:          &wantEmailID,
 		TriggerEvent:   1,
 		State:          "queued",
 		FailureMessage: nil,
@@ -53,6 +56,108 @@ func TestEnqueueActionEmailsForQueryIDInt64QueryByRecordID(t *testing.T) {
 	}
 }
 
+func TestEnqueueActionJobsForQueryIDInt64Webhooks(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx, s := newTestStore(t)
+	_, _, _, userCTX := newTestUser(ctx, t)
+	m, err := s.insertTestMonitor(userCTX, t)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := s.CreateActionWebhook(userCTX, m.ID, &graphqlbackend.CreateActionWebhookArgs{
+		Enabled: true,
+		URL:     "https://example.com/hook",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sw, err := s.CreateActionSlackWebhook(userCTX, m.ID, &graphqlbackend.CreateActionSlackWebhookArgs{
+		Enabled: true,
+		URL:     "https://hooks.slack.com/services/test",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Disabled actions are not enqueued.
+	_, err = s.CreateActionWebhook(userCTX, m.ID, &graphqlbackend.CreateActionWebhookArgs{
+		Enabled: false,
+		URL:     "https://example.com/disabled",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = s.EnqueueTriggerQueries(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = s.EnqueueActionJobsForQueryIDInt64(ctx, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	webhookJobs, err := s.ReadActionWebhookEvents(ctx, w.Id, nil, &graphqlbackend.ListEventsArgs{First: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(webhookJobs) != 1 || *webhookJobs[0].Webhook != w.Id || webhookJobs[0].Email != nil || webhookJobs[0].SlackWebhook != nil {
+		t.Fatalf("unexpected webhook jobs: %+v", webhookJobs)
+	}
+
+	slackJobs, err := s.ReadActionSlackWebhookEvents(ctx, sw.Id, nil, &graphqlbackend.ListEventsArgs{First: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slackJobs) != 1 || *slackJobs[0].SlackWebhook != sw.Id {
+		t.Fatalf("unexpected Slack webhook jobs: %+v", slackJobs)
+	}
+
+	// 2 emails, 1 webhook and 1 Slack webhook.
+	var count int
+	err = s.QueryRow(ctx, sqlf.Sprintf("SELECT COUNT(*) FROM cm_action_jobs")).Scan(&count)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Fatalf("got %d action jobs, want 4", count)
+	}
+
+	// Actions with a queued job are not enqueued again.
+	err = s.EnqueueActionJobsForQueryIDInt64(ctx, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	total, err := s.TotalActionWebhookEvents(ctx, w.Id, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 1 {
+		t.Fatalf("got %d webhook jobs, want 1", total)
+	}
+}
+
+func TestCreateActionWebhookInvalidURL(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx, s := newTestStore(t)
+	_, _, _, userCTX := newTestUser(ctx, t)
+	m, err := s.insertTestMonitor(userCTX, t)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, u := range []string{"", "example.com/hook", "ftp://example.com/hook", "https://"} {
+		_, err = s.CreateActionWebhook(userCTX, m.ID, &graphqlbackend.CreateActionWebhookArgs{Enabled: true, URL: u})
+		if err == nil {
+			t.Errorf("expected error for URL %q", u)
+		}
+	}
+}
+
 func TestGetActionJobMetadata(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
@@ -73,12 +178,20 @@ func TestGetActionJobMetadata(t *testing.T) {
 		wantNumResults       = 42
 		wantQuery            = testQuery + " after:\"" + s.Now().UTC().Format(time.RFC3339) + "\""
 		wantMonitorID  int64 = 1
+		wantResults          = []TriggerResult{{
+			Repository: "github.com/sourcegraph/sourcegraph",
+			Commit:     "deadbeef",
+			Author:     "Alice",
+			Date:       time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC),
+			Subject:    "fix the build",
+			URL:        "/github.com/sourcegraph/sourcegraph/-/commit/deadbeef",
+		}}
 	)
-	err = s.LogSearch(ctx, wantQuery, wantNumResults, 1)
+	err = s.LogSearch(ctx, wantQuery, wantNumResults, wantResults, 1)
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = s.EnqueueActionEmailsForQueryIDInt64(ctx, 1, 1)
+	err = s.EnqueueActionJobsForQueryIDInt64(ctx, 1, 1)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -92,6 +205,7 @@ func TestGetActionJobMetadata(t *testing.T) {
 		Query:       wantQuery,
 		NumResults:  &wantNumResults,
 		MonitorID:   wantMonitorID,
+		Results:     wantResults,
 	}
 	if diff := cmp.Diff(got, want); diff != "" {
 		t.Fatalf("diff: %s", diff)
@@ -119,7 +233,7 @@ func TestScanActionJobs(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = s.EnqueueActionEmailsForQueryIDInt64(ctx, testQueryID, testTriggerEventID)
+	err = s.EnqueueActionJobsForQueryIDInt64(ctx, testQueryID, testTriggerEventID)
 	if err != nil {
 		t.Fatal(err)
 	}
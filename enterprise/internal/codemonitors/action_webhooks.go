@@ -0,0 +1,174 @@
+package codemonitors
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/graph-gophers/graphql-go/relay"
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+)
+
+type MonitorWebhook struct {
+	Id        int64
+	Monitor   int64
+	Enabled   bool
+	URL       string
+	CreatedBy int32
+	CreatedAt time.Time
+	ChangedBy int32
+	ChangedAt time.Time
+}
+
+var WebhooksColumns = []*sqlf.Query{
+	sqlf.Sprintf("cm_webhooks.id"),
+	sqlf.Sprintf("cm_webhooks.monitor"),
+	sqlf.Sprintf("cm_webhooks.enabled"),
+	sqlf.Sprintf("cm_webhooks.url"),
+	sqlf.Sprintf("cm_webhooks.created_by"),
+	sqlf.Sprintf("cm_webhooks.created_at"),
+	sqlf.Sprintf("cm_webhooks.changed_by"),
+	sqlf.Sprintf("cm_webhooks.changed_at"),
+}
+
+const createActionWebhookFmtStr = `
+INSERT INTO cm_webhooks
+(monitor, enabled, url, created_by, created_at, changed_by, changed_at)
+VALUES (%s,%s,%s,%s,%s,%s,%s)
+RETURNING %s;
+`
+
+func (s *Store) CreateActionWebhook(ctx context.Context, monitorID int64, args *graphqlbackend.CreateActionWebhookArgs) (*MonitorWebhook, error) {
+	if err := ValidateWebhookURL(args.URL); err != nil {
+		return nil, err
+	}
+	now := s.Now()
+	a := actor.FromContext(ctx)
+	q := sqlf.Sprintf(
+		createActionWebhookFmtStr,
+		monitorID,
+		args.Enabled,
+		args.URL,
+		a.UID,
+		now,
+		a.UID,
+		now,
+		sqlf.Join(WebhooksColumns, ", "),
+	)
+	return s.runWebhookQuery(ctx, q)
+}
+
+const updateActionWebhookFmtStr = `
+UPDATE cm_webhooks
+SET enabled = %s,
+	url = %s,
+	changed_by = %s,
+	changed_at = %s
+WHERE id = %s
+AND monitor = %s
+RETURNING %s;
+`
+
+func (s *Store) UpdateActionWebhook(ctx context.Context, monitorID int64, args *graphqlbackend.EditActionWebhookArgs) (*MonitorWebhook, error) {
+	if args.Id == nil {
+		return nil, fmt.Errorf("nil is not a valid action ID")
+	}
+	var webhookID int64
+	if err := relay.UnmarshalSpec(*args.Id, &webhookID); err != nil {
+		return nil, err
+	}
+	if err := ValidateWebhookURL(args.Update.URL); err != nil {
+		return nil, err
+	}
+	a := actor.FromContext(ctx)
+	q := sqlf.Sprintf(
+		updateActionWebhookFmtStr,
+		args.Update.Enabled,
+		args.Update.URL,
+		a.UID,
+		s.Now(),
+		webhookID,
+		monitorID,
+		sqlf.Join(WebhooksColumns, ", "),
+	)
+	return s.runWebhookQuery(ctx, q)
+}
+
+const deleteActionWebhooksFmtStr = `DELETE FROM cm_webhooks WHERE id = ANY(%s) AND monitor = %s`
+
+func (s *Store) DeleteActionWebhooks(ctx context.Context, monitorID int64, webhookIDs []int64) error {
+	if len(webhookIDs) == 0 {
+		return nil
+	}
+	return s.Exec(ctx, sqlf.Sprintf(deleteActionWebhooksFmtStr, pq.Array(webhookIDs), monitorID))
+}
+
+const actionWebhookByIDFmtStr = `
+SELECT %s
+FROM cm_webhooks
+WHERE id = %s
+`
+
+func (s *Store) ActionWebhookByIDInt64(ctx context.Context, webhookID int64) (*MonitorWebhook, error) {
+	return s.runWebhookQuery(ctx, sqlf.Sprintf(actionWebhookByIDFmtStr, sqlf.Join(WebhooksColumns, ", "), webhookID))
+}
+
+const allActionWebhooksForMonitorIDInt64FmtStr = `
+SELECT %s
+FROM cm_webhooks
+WHERE monitor = %s
+ORDER BY id ASC
+`
+
+// AllActionWebhooksForMonitorIDInt64 returns all webhook actions of the given
+// monitor.
+func (s *Store) AllActionWebhooksForMonitorIDInt64(ctx context.Context, monitorID int64) ([]*MonitorWebhook, error) {
+	rows, err := s.Query(ctx, sqlf.Sprintf(allActionWebhooksForMonitorIDInt64FmtStr, sqlf.Join(WebhooksColumns, ", "), monitorID))
+	if err != nil {
+		return nil, err
+	}
+	return scanWebhooks(rows, nil)
+}
+
+func (s *Store) runWebhookQuery(ctx context.Context, q *sqlf.Query) (*MonitorWebhook, error) {
+	rows, err := s.Query(ctx, q)
+	ws, err := scanWebhooks(rows, err)
+	if err != nil {
+		return nil, err
+	}
+	if len(ws) == 0 {
+		return nil, fmt.Errorf("operation failed. Query should have returned 1 row")
+	}
+	return ws[0], nil
+}
+
+func scanWebhooks(rows *sql.Rows, queryErr error) (ws []*MonitorWebhook, err error) {
+	if queryErr != nil {
+		return nil, queryErr
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	for rows.Next() {
+		w := &MonitorWebhook{}
+		if err := rows.Scan(
+			&w.Id,
+			&w.Monitor,
+			&w.Enabled,
+			&w.URL,
+			&w.CreatedBy,
+			&w.CreatedAt,
+			&w.ChangedBy,
+			&w.ChangedAt,
+		); err != nil {
+			return nil, err
+		}
+		ws = append(ws, w)
+	}
+	return ws, nil
+}
@@ -2,20 +2,53 @@ package codemonitors
 
 import (
 	"context"
+	"fmt"
+	"net/url"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
 )
 
 func (s *Store) CreateActions(ctx context.Context, args []*graphqlbackend.CreateActionArgs, monitorID int64) (err error) {
 	for _, a := range args {
-		e, err := s.CreateActionEmail(ctx, monitorID, a)
-		if err != nil {
-			return err
-		}
-		err = s.CreateRecipients(ctx, a.Email.Recipients, e.Id)
-		if err != nil {
-			return err
+		switch {
+		case a.Email != nil:
+			e, err := s.CreateActionEmail(ctx, monitorID, a)
+			if err != nil {
+				return err
+			}
+			err = s.CreateRecipients(ctx, a.Email.Recipients, e.Id)
+			if err != nil {
+				return err
+			}
+		case a.Webhook != nil:
+			_, err = s.CreateActionWebhook(ctx, monitorID, a.Webhook)
+			if err != nil {
+				return err
+			}
+		case a.SlackWebhook != nil:
+			_, err = s.CreateActionSlackWebhook(ctx, monitorID, a.SlackWebhook)
+			if err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("action must be an email, a webhook or a Slack webhook")
 		}
 	}
 	return err
 }
+
+// ValidateWebhookURL returns an error if rawURL cannot be used as the
+// destination of a webhook or Slack webhook action.
+func ValidateWebhookURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid webhook URL: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("invalid webhook URL %q: scheme must be http or https", rawURL)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("invalid webhook URL %q: missing host", rawURL)
+	}
+	return nil
+}
@@ -8,8 +8,10 @@ import (
 	"log"
 	"net/url"
 	"runtime"
+	"strings"
 	"time"
 
+	cm "github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 
 	"golang.org/x/net/context/ctxhttp"
@@ -80,6 +82,7 @@ const gqlSearchQuery = `query Search(
 						}
 						oid
 						abbreviatedOID
+						url
 						author {
 							person {
 								displayName
@@ -196,3 +199,68 @@ func extractTime(result interface{}) (t *time.Time, err error) {
 		return nil, fmt.Errorf("unexpected result __typename %q", typeName)
 	}
 }
+
+// maxTriggerResults is the maximum number of search results of a run which are
+// recorded and included in the notifications of webhook and Slack webhook
+// actions.
+const maxTriggerResults = 50
+
+// extractResults summarizes the first maxTriggerResults commit search results
+// of a search. Results which aren't commits are skipped.
+func extractResults(results []interface{}) []cm.TriggerResult {
+	summaries := make([]cm.TriggerResult, 0, len(results))
+	for _, result := range results {
+		if len(summaries) == maxTriggerResults {
+			break
+		}
+		summary, err := extractResult(result)
+		if err != nil {
+			// Error already logged by extractResult.
+			continue
+		}
+		if summary != nil {
+			summaries = append(summaries, *summary)
+		}
+	}
+	return summaries
+}
+
+// extractResult summarizes the given search result, or returns nil if it isn't
+// a commit search result.
+func extractResult(result interface{}) (summary *cm.TriggerResult, err error) {
+	// Use recover because we assume the data structure here a lot, for less
+	// error checking.
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("failed to extract search result: %v", r)
+			err = fmt.Errorf("failed to extract search result")
+		}
+	}()
+
+	m := result.(map[string]interface{})
+	if m["__typename"].(string) != "CommitSearchResult" {
+		return nil, nil
+	}
+	commit := m["commit"].(map[string]interface{})
+	author := commit["author"].(map[string]interface{})
+	person := author["person"].(map[string]interface{})
+	repository := commit["repository"].(map[string]interface{})
+
+	date, err := time.Parse(time.RFC3339, author["date"].(string))
+	if err != nil {
+		return nil, err
+	}
+	subject := commit["message"].(string)
+	if i := strings.IndexByte(subject, '\n'); i >= 0 {
+		subject = subject[:i]
+	}
+
+	return &cm.TriggerResult{
+		Repository: repository["name"].(string),
+		Commit:     commit["oid"].(string),
+		Author:     person["displayName"].(string),
+		Date:       date,
+		Subject:    subject,
+		URL:        commit["url"].(string),
+	}, nil
+}
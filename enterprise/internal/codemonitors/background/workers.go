@@ -11,6 +11,7 @@ import (
 
 	cm "github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors/email"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors/webhook"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker"
@@ -153,14 +154,18 @@ func (r *queryRunner) Handle(ctx context.Context, workerStore dbworkerstore.Stor
 	if err != nil {
 		return err
 	}
-	var numResults int
+	var (
+		numResults int
+		summaries  []cm.TriggerResult
+	)
 	if results != nil {
 		numResults = len(results.Data.Search.Results.Results)
+		summaries = extractResults(results.Data.Search.Results.Results)
 	}
 	if numResults > 0 {
-		err := s.EnqueueActionEmailsForQueryIDInt64(ctx, q.Id, record.RecordID())
+		err := s.EnqueueActionJobsForQueryIDInt64(ctx, q.Id, record.RecordID())
 		if err != nil {
-			return fmt.Errorf("store.EnqueueActionEmailsForQueryIDInt64: %w", err)
+			return fmt.Errorf("store.EnqueueActionJobsForQueryIDInt64: %w", err)
 		}
 	}
 	// Log next_run and latest_result to table cm_queries.
@@ -170,7 +175,7 @@ func (r *queryRunner) Handle(ctx context.Context, workerStore dbworkerstore.Stor
 		return err
 	}
 	// Log the actual query we ran and whether we got any new results.
-	err = s.LogSearch(ctx, newQuery, numResults, record.RecordID())
+	err = s.LogSearch(ctx, newQuery, numResults, summaries, record.RecordID())
 	if err != nil {
 		return fmt.Errorf("LogSearch: %w", err)
 
@@ -192,36 +197,42 @@ func (r *actionRunner) Handle(ctx context.Context, workerStore dbworkerstore.Sto
 
 	s := r.Store.With(workerStore)
 
-	var (
-		j    *cm.ActionJob
-		m    *cm.ActionJobMetadata
-		e    *cm.MonitorEmail
-		recs []*cm.Recipient
-		data *email.TemplateDataNewSearchResults
-	)
-
-	var ok bool
-	j, ok = record.(*cm.ActionJob)
+	j, ok := record.(*cm.ActionJob)
 	if !ok {
 		return fmt.Errorf("type assertion failed")
 	}
 
-	m, err = s.GetActionJobMetadata(ctx, record.RecordID())
+	m, err := s.GetActionJobMetadata(ctx, record.RecordID())
 	if err != nil {
 		return fmt.Errorf("store.GetActionJobMetadata: %w", err)
 	}
 
-	e, err = s.ActionEmailByIDInt64(ctx, j.Email)
+	// Each job delivers exactly one action. A failed delivery is retried by the
+	// worker without affecting the other actions of the monitor.
+	switch {
+	case j.Email != nil:
+		return handleEmail(ctx, s, *j.Email, m)
+	case j.Webhook != nil:
+		return handleWebhook(ctx, s, *j.Webhook, m)
+	case j.SlackWebhook != nil:
+		return handleSlackWebhook(ctx, s, *j.SlackWebhook, m)
+	default:
+		return fmt.Errorf("action job %d has no action", j.Id)
+	}
+}
+
+func handleEmail(ctx context.Context, s *cm.Store, emailID int64, m *cm.ActionJobMetadata) error {
+	e, err := s.ActionEmailByIDInt64(ctx, emailID)
 	if err != nil {
 		return fmt.Errorf("store.ActionEmailByIDInt64: %w", err)
 	}
 
-	recs, err = s.AllRecipientsForEmailIDInt64(ctx, j.Email)
+	recs, err := s.AllRecipientsForEmailIDInt64(ctx, emailID)
 	if err != nil {
 		return fmt.Errorf("store.AllRecipientsForEmailIDInt64: %w", err)
 	}
 
-	data, err = email.NewTemplateDataForNewSearchResults(ctx, m.Description, m.Query, e, zeroOrVal(m.NumResults))
+	data, err := email.NewTemplateDataForNewSearchResults(ctx, m.Description, m.Query, e, zeroOrVal(m.NumResults))
 	if err != nil {
 		return fmt.Errorf("email.NewTemplateDataForNewSearchResults: %w", err)
 	}
@@ -241,6 +252,32 @@ func (r *actionRunner) Handle(ctx context.Context, workerStore dbworkerstore.Sto
 	return nil
 }
 
+func handleWebhook(ctx context.Context, s *cm.Store, webhookID int64, m *cm.ActionJobMetadata) error {
+	w, err := s.ActionWebhookByIDInt64(ctx, webhookID)
+	if err != nil {
+		return fmt.Errorf("store.ActionWebhookByIDInt64: %w", err)
+	}
+
+	payload, err := webhook.NewWebhookPayload(ctx, m)
+	if err != nil {
+		return fmt.Errorf("webhook.NewWebhookPayload: %w", err)
+	}
+	return webhook.SendWebhook(ctx, w.URL, payload)
+}
+
+func handleSlackWebhook(ctx context.Context, s *cm.Store, slackWebhookID int64, m *cm.ActionJobMetadata) error {
+	w, err := s.ActionSlackWebhookByIDInt64(ctx, slackWebhookID)
+	if err != nil {
+		return fmt.Errorf("store.ActionSlackWebhookByIDInt64: %w", err)
+	}
+
+	payload, err := webhook.NewSlackWebhookPayload(ctx, m)
+	if err != nil {
+		return fmt.Errorf("webhook.NewSlackWebhookPayload: %w", err)
+	}
+	return webhook.SendSlackWebhook(ctx, w.URL, payload)
+}
+
 // newQueryWithAfterFilter constructs a new query which finds search results
 // introduced after the last time we queried.
 func newQueryWithAfterFilter(q *cm.MonitorQuery) string {
@@ -9,10 +9,12 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/graph-gophers/graphql-go/relay"
 
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors/email"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors/resolvers"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors/storetest"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors/webhook"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtesting"
 )
 
@@ -92,11 +94,11 @@ func TestActionRunner(t *testing.T) {
 			if err != nil {
 				t.Fatal(err)
 			}
-			err = ts.LogSearch(ctx, testQuery, tt.numResults, triggerEvent)
+			err = ts.LogSearch(ctx, testQuery, tt.numResults, nil, triggerEvent)
 			if err != nil {
 				t.Fatal(err)
 			}
-			err = ts.EnqueueActionEmailsForQueryIDInt64(ctx, queryID, triggerEvent)
+			err = ts.EnqueueActionJobsForQueryIDInt64(ctx, queryID, triggerEvent)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -118,3 +120,156 @@ func TestActionRunner(t *testing.T) {
 		})
 	}
 }
+
+func TestActionRunnerWebhooks(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	externalURL := "https://www.sourcegraph.com"
+	testQuery := "test patternType:literal"
+
+	// Mocks.
+	email.MockSendEmailForNewSearchResult = func(ctx context.Context, userID int32, data *email.TemplateDataNewSearchResults) error {
+		return nil
+	}
+	got := map[string]webhook.Payload{}
+	webhook.MockSendWebhook = func(ctx context.Context, url string, payload *webhook.Payload) error {
+		got[url] = *payload
+		return nil
+	}
+	webhook.MockSendSlackWebhook = func(ctx context.Context, url string, payload *webhook.Payload) error {
+		got[url] = *payload
+		return nil
+	}
+	t.Cleanup(func() {
+		webhook.MockSendWebhook = nil
+		webhook.MockSendSlackWebhook = nil
+	})
+	email.MockExternalURL = func() *url.URL {
+		externalURL, _ := url.Parse(externalURL)
+		return externalURL
+	}
+
+	db := dbtesting.GetDB(t)
+	now := time.Now()
+	clock := func() time.Time { return now }
+	s := codemonitors.NewStoreWithClock(db, clock)
+	ctx, ts := storetest.NewTestStoreWithStore(t, s)
+	dbtesting.SetupGlobalTestDB(t)
+
+	_, _, _, userCtx := storetest.NewTestUser(ctx, t)
+	m, err := ts.InsertTestMonitor(userCtx, t)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := ts.CreateActionWebhook(userCtx, m.ID, &graphqlbackend.CreateActionWebhookArgs{Enabled: true, URL: "https://example.com/hook"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sw, err := ts.CreateActionSlackWebhook(userCtx, m.ID, &graphqlbackend.CreateActionSlackWebhookArgs{Enabled: true, URL: "https://hooks.slack.com/services/test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		queryID      int64 = 1
+		triggerEvent       = 1
+		result             = codemonitors.TriggerResult{
+			Repository: "github.com/sourcegraph/sourcegraph",
+			Commit:     "deadbeef",
+			Author:     "Alice",
+			Date:       time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC),
+			Subject:    "fix the build",
+			URL:        "/github.com/sourcegraph/sourcegraph/-/commit/deadbeef",
+		}
+	)
+	err = ts.EnqueueTriggerQueries(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ts.LogSearch(ctx, testQuery, 2, []codemonitors.TriggerResult{result}, triggerEvent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ts.EnqueueActionJobsForQueryIDInt64(ctx, queryID, triggerEvent)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	webhookJobs, err := ts.ReadActionWebhookEvents(ctx, w.Id, nil, &graphqlbackend.ListEventsArgs{First: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	slackJobs, err := ts.ReadActionSlackWebhookEvents(ctx, sw.Id, nil, &graphqlbackend.ListEventsArgs{First: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := actionRunner{s}
+	for _, j := range append(webhookJobs, slackJobs...) {
+		if err := a.Handle(ctx, createDBWorkerStoreForActionJobs(s), j); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	monitorURL := externalURL + "/code-monitoring/" + string(relay.MarshalID(resolvers.MonitorKind, m.ID))
+	resultWithURL := func(utmSource string) []codemonitors.TriggerResult {
+		r := result
+		r.URL = externalURL + result.URL + "?utm_source=" + utmSource
+		return []codemonitors.TriggerResult{r}
+	}
+	want := map[string]webhook.Payload{
+		"https://example.com/hook": {
+			MonitorDescription: "test description",
+			MonitorURL:         monitorURL + "?utm_source=code-monitoring-webhook",
+			Query:              testQuery,
+			NumResults:         2,
+			ResultsURL:         externalURL + "/search?q=test+patternType%3Aliteral&utm_source=code-monitoring-webhook",
+			Results:            resultWithURL("code-monitoring-webhook"),
+		},
+		"https://hooks.slack.com/services/test": {
+			MonitorDescription: "test description",
+			MonitorURL:         monitorURL + "?utm_source=code-monitoring-slack-webhook",
+			Query:              testQuery,
+			NumResults:         2,
+			ResultsURL:         externalURL + "/search?q=test+patternType%3Aliteral&utm_source=code-monitoring-slack-webhook",
+			Results:            resultWithURL("code-monitoring-slack-webhook"),
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected deliveries (-want +got):\n%s", diff)
+	}
+}
+
+func TestExtractResults(t *testing.T) {
+	results := []interface{}{
+		map[string]interface{}{
+			"__typename": "CommitSearchResult",
+			"commit": map[string]interface{}{
+				"repository": map[string]interface{}{"name": "github.com/sourcegraph/sourcegraph"},
+				"oid":        "deadbeef",
+				"url":        "/github.com/sourcegraph/sourcegraph/-/commit/deadbeef",
+				"author": map[string]interface{}{
+					"person": map[string]interface{}{"displayName": "Alice"},
+					"date":   "2021-06-01T12:00:00Z",
+				},
+				"message": "fix the build\n\nIt was broken.",
+			},
+		},
+		map[string]interface{}{"__typename": "FileMatch"},
+		map[string]interface{}{"__typename": "CommitSearchResult"},
+	}
+
+	want := []codemonitors.TriggerResult{{
+		Repository: "github.com/sourcegraph/sourcegraph",
+		Commit:     "deadbeef",
+		Author:     "Alice",
+		Date:       time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC),
+		Subject:    "fix the build",
+		URL:        "/github.com/sourcegraph/sourcegraph/-/commit/deadbeef",
+	}}
+	if diff := cmp.Diff(want, extractResults(results)); diff != "" {
+		t.Fatalf("unexpected results (-want +got):\n%s", diff)
+	}
+}
@@ -46,12 +46,12 @@ func NewTemplateDataForNewSearchResults(ctx context.Context, monitorDescription,
 		priority                  string
 		numberOfResultsWithDetail string
 	)
-	searchURL, err = getSearchURL(ctx, queryString, utmSourceEmail)
+	searchURL, err = GetSearchURL(ctx, queryString, utmSourceEmail)
 	if err != nil {
 		return nil, err
 	}
 
-	codeMonitorURL, err = getCodeMonitorURL(ctx, email.Monitor, utmSourceEmail)
+	codeMonitorURL, err = GetCodeMonitorURL(ctx, email.Monitor, utmSourceEmail)
 	if err != nil {
 		return nil, err
 	}
@@ -104,14 +104,24 @@ func sendEmail(ctx context.Context, userID int32, template txtypes.Templates, da
 	return nil
 }
 
-func getSearchURL(ctx context.Context, query, utmSource string) (string, error) {
+// GetSearchURL returns the URL of the search results of query on this
+// instance, tagged with the given utm_source.
+func GetSearchURL(ctx context.Context, query, utmSource string) (string, error) {
 	return sourcegraphURL(ctx, "search", query, utmSource)
 }
 
-func getCodeMonitorURL(ctx context.Context, monitorID int64, utmSource string) (string, error) {
+// GetCodeMonitorURL returns the URL of the page of the given code monitor on
+// this instance, tagged with the given utm_source.
+func GetCodeMonitorURL(ctx context.Context, monitorID int64, utmSource string) (string, error) {
 	return sourcegraphURL(ctx, fmt.Sprintf("code-monitoring/%s", relay.MarshalID(MonitorKind, monitorID)), "", utmSource)
 }
 
+// GetCommitURL returns the URL of the commit with the given path on this
+// instance, tagged with the given utm_source.
+func GetCommitURL(ctx context.Context, commitPath, utmSource string) (string, error) {
+	return sourcegraphURL(ctx, commitPath, "", utmSource)
+}
+
 func sourcegraphURL(ctx context.Context, path, query, utmSource string) (string, error) {
 	if MockExternalURL != nil {
 		externalURL = MockExternalURL()
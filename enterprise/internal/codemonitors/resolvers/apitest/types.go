@@ -50,7 +50,9 @@ type ActionConnection struct {
 }
 
 type Action struct {
+	Typename string `json:"__typename"`
 	ActionEmail
+	ActionWebhook
 }
 
 type ActionEmail struct {
@@ -62,6 +64,12 @@ type ActionEmail struct {
 	Events     ActionEventConnection
 }
 
+// ActionWebhook holds the fields of webhooks and Slack webhooks which are not
+// shared with ActionEmail.
+type ActionWebhook struct {
+	Url string
+}
+
 type RecipientsConnection struct {
 	Nodes      []UserOrg
 	TotalCount int
@@ -15,6 +15,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
 	cm "github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors/email"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors/webhook"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 )
 
@@ -170,6 +171,9 @@ func (r *Resolver) UpdateCodeMonitor(ctx context.Context, args *graphqlbackend.U
 	}
 
 	toCreate, toDelete, err := splitActionIDs(ctx, args, actionIDs)
+	if err != nil {
+		return nil, err
+	}
 	if len(toDelete) == len(actionIDs) {
 		return nil, fmt.Errorf("you tried to delete all actions, but every monitor must be connected to at least 1 action")
 	}
@@ -182,7 +186,7 @@ func (r *Resolver) UpdateCodeMonitor(ctx context.Context, args *graphqlbackend.U
 	}
 	defer func() { err = tx.store.Done(err) }()
 
-	err = tx.store.DeleteActionsInt64(ctx, toDelete, monitorID)
+	err = tx.deleteActions(ctx, monitorID, toDelete)
 	if err != nil {
 		return nil, err
 	}
@@ -235,6 +239,34 @@ func (r *Resolver) TriggerTestEmailAction(ctx context.Context, args *graphqlback
 	return &graphqlbackend.EmptyResponse{}, nil
 }
 
+func (r *Resolver) TriggerTestWebhookAction(ctx context.Context, args *graphqlbackend.TriggerTestWebhookActionArgs) (*graphqlbackend.EmptyResponse, error) {
+	err := r.isAllowedToCreate(ctx, args.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if err := cm.ValidateWebhookURL(args.Webhook.URL); err != nil {
+		return nil, err
+	}
+	if err := webhook.SendWebhook(ctx, args.Webhook.URL, webhook.NewTestPayload(args.Description)); err != nil {
+		return nil, err
+	}
+	return &graphqlbackend.EmptyResponse{}, nil
+}
+
+func (r *Resolver) TriggerTestSlackWebhookAction(ctx context.Context, args *graphqlbackend.TriggerTestSlackWebhookActionArgs) (*graphqlbackend.EmptyResponse, error) {
+	err := r.isAllowedToCreate(ctx, args.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if err := cm.ValidateWebhookURL(args.SlackWebhook.URL); err != nil {
+		return nil, err
+	}
+	if err := webhook.SendSlackWebhook(ctx, args.SlackWebhook.URL, webhook.NewTestPayload(args.Description)); err != nil {
+		return nil, err
+	}
+	return &graphqlbackend.EmptyResponse{}, nil
+}
+
 func sendTestEmail(ctx context.Context, recipient graphql.ID, description string) error {
 	var (
 		userID int32
@@ -252,92 +284,138 @@ func sendTestEmail(ctx context.Context, recipient graphql.ID, description string
 	return email.SendEmailForNewSearchResult(ctx, userID, data)
 }
 
-func (r *Resolver) actionIDsForMonitorIDInt64(ctx context.Context, monitorID int64) (actionIDs []graphql.ID, err error) {
-	limit := 50
-	var (
-		ids   []graphql.ID
-		after *string
-		q     *sqlf.Query
-	)
-	// Paging.
-	for {
-		q, err = r.store.ReadActionEmailQuery(ctx, monitorID, &graphqlbackend.ListActionArgs{
-			First: int32(limit),
-			After: after,
-		})
-		if err != nil {
-			return nil, err
-		}
-		es, cur, err := r.actionIDsForMonitorIDINT64SinglePage(ctx, q, limit)
-		if err != nil {
-			return nil, err
-		}
-		ids = append(ids, es...)
-		if cur == nil {
-			break
-		}
-		after = cur
+func (r *Resolver) actionIDsForMonitorIDInt64(ctx context.Context, monitorID int64) ([]graphql.ID, error) {
+	actions, err := r.allActionsForMonitorIDInt64(ctx, monitorID, nil)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]graphql.ID, 0, len(actions))
+	for _, a := range actions {
+		ids = append(ids, a.id())
 	}
 	return ids, nil
 }
 
-func (r *Resolver) actionIDsForMonitorIDINT64SinglePage(ctx context.Context, q *sqlf.Query, limit int) (ids []graphql.ID, cursor *string, err error) {
-	var rows *sql.Rows
-	rows, err = r.store.Query(ctx, q)
+// allActionsForMonitorIDInt64 returns all actions of the monitor, ordered by
+// kind (emails, webhooks, Slack webhooks) and ID.
+func (r *Resolver) allActionsForMonitorIDInt64(ctx context.Context, monitorID int64, triggerEventID *int) ([]*action, error) {
+	es, err := r.store.AllActionEmailsForMonitorIDInt64(ctx, monitorID)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
-	defer rows.Close()
-
-	var es []*cm.MonitorEmail
-	es, err = cm.ScanEmails(rows)
+	ws, err := r.store.AllActionWebhooksForMonitorIDInt64(ctx, monitorID)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
-	ids = make([]graphql.ID, 0, len(es))
-	for _, e := range es {
-		ids = append(ids, (&monitorEmail{MonitorEmail: e}).ID())
+	sws, err := r.store.AllActionSlackWebhooksForMonitorIDInt64(ctx, monitorID)
+	if err != nil {
+		return nil, err
 	}
 
-	// Set the cursor if the result size equals limit.
-	if len(ids) == limit {
-		stringID := string(ids[len(ids)-1])
-		cursor = &stringID
+	actions := make([]*action, 0, len(es)+len(ws)+len(sws))
+	for _, e := range es {
+		actions = append(actions, &action{
+			email: &monitorEmail{
+				Resolver:       r,
+				MonitorEmail:   e,
+				triggerEventID: triggerEventID,
+			},
+		})
 	}
-	return ids, cursor, nil
+	for _, w := range ws {
+		actions = append(actions, &action{
+			webhook: &monitorWebhook{
+				Resolver:       r,
+				MonitorWebhook: w,
+				triggerEventID: triggerEventID,
+			},
+		})
+	}
+	for _, w := range sws {
+		actions = append(actions, &action{
+			slackWebhook: &monitorSlackWebhook{
+				Resolver:            r,
+				MonitorSlackWebhook: w,
+				triggerEventID:      triggerEventID,
+			},
+		})
+	}
+	return actions, nil
 }
 
 // splitActionIDs splits actions into three buckets: create, delete and update.
 // Note: args is mutated. After splitActionIDs, args only contains actions to be updated.
-func splitActionIDs(ctx context.Context, args *graphqlbackend.UpdateCodeMonitorArgs, actionIDs []graphql.ID) (toCreate []*graphqlbackend.CreateActionArgs, toDelete []int64, err error) {
+func splitActionIDs(ctx context.Context, args *graphqlbackend.UpdateCodeMonitorArgs, actionIDs []graphql.ID) (toCreate []*graphqlbackend.CreateActionArgs, toDelete []graphql.ID, err error) {
 	aMap := make(map[graphql.ID]struct{}, len(actionIDs))
 	for _, id := range actionIDs {
 		aMap[id] = struct{}{}
 	}
 	var toUpdateActions []*graphqlbackend.EditActionArgs
 	for _, a := range args.Actions {
-		if a.Email.Id == nil {
-			toCreate = append(toCreate, &graphqlbackend.CreateActionArgs{Email: a.Email.Update})
-			continue
+		var id *graphql.ID
+		switch {
+		case a.Email != nil:
+			if a.Email.Id == nil {
+				toCreate = append(toCreate, &graphqlbackend.CreateActionArgs{Email: a.Email.Update})
+				continue
+			}
+			id = a.Email.Id
+		case a.Webhook != nil:
+			if a.Webhook.Id == nil {
+				toCreate = append(toCreate, &graphqlbackend.CreateActionArgs{Webhook: a.Webhook.Update})
+				continue
+			}
+			id = a.Webhook.Id
+		case a.SlackWebhook != nil:
+			if a.SlackWebhook.Id == nil {
+				toCreate = append(toCreate, &graphqlbackend.CreateActionArgs{SlackWebhook: a.SlackWebhook.Update})
+				continue
+			}
+			id = a.SlackWebhook.Id
+		default:
+			return nil, nil, fmt.Errorf("action must be an email, a webhook or a Slack webhook")
 		}
-		if _, ok := aMap[*a.Email.Id]; !ok {
-			return nil, nil, fmt.Errorf("unknown ID=%s for action", *a.Email.Id)
+		if _, ok := aMap[*id]; !ok {
+			return nil, nil, fmt.Errorf("unknown ID=%s for action", *id)
 		}
 		toUpdateActions = append(toUpdateActions, a)
-		delete(aMap, *a.Email.Id)
+		delete(aMap, *id)
 	}
-	var actionID int64
 	for k := range aMap {
-		err = relay.UnmarshalSpec(k, &actionID)
-		if err != nil {
-			return nil, nil, err
-		}
-		toDelete = append(toDelete, actionID)
+		toDelete = append(toDelete, k)
 	}
 	args.Actions = toUpdateActions
 	return toCreate, toDelete, nil
 }
 
+// deleteActions deletes the actions with the given IDs from the monitor.
+func (r *Resolver) deleteActions(ctx context.Context, monitorID int64, actionIDs []graphql.ID) error {
+	var emailIDs, webhookIDs, slackWebhookIDs []int64
+	for _, id := range actionIDs {
+		var actionID int64
+		if err := relay.UnmarshalSpec(id, &actionID); err != nil {
+			return err
+		}
+		switch kind := relay.UnmarshalKind(id); kind {
+		case monitorActionEmailKind:
+			emailIDs = append(emailIDs, actionID)
+		case monitorActionWebhookKind:
+			webhookIDs = append(webhookIDs, actionID)
+		case monitorActionSlackWebhookKind:
+			slackWebhookIDs = append(slackWebhookIDs, actionID)
+		default:
+			return fmt.Errorf("unknown action kind %q", kind)
+		}
+	}
+	if err := r.store.DeleteActionsInt64(ctx, emailIDs, monitorID); err != nil {
+		return err
+	}
+	if err := r.store.DeleteActionWebhooks(ctx, monitorID, webhookIDs); err != nil {
+		return err
+	}
+	return r.store.DeleteActionSlackWebhooks(ctx, monitorID, slackWebhookIDs)
+}
+
 func (r *Resolver) updateCodeMonitor(ctx context.Context, args *graphqlbackend.UpdateCodeMonitorArgs) (m graphqlbackend.MonitorResolver, err error) {
 	// Update monitor.
 	var mo *cm.Monitor
@@ -357,25 +435,17 @@ func (r *Resolver) updateCodeMonitor(ctx context.Context, args *graphqlbackend.U
 			Monitor:  mo,
 		}, nil
 	}
-	var emailID int64
-	var e *cm.MonitorEmail
 	for i, action := range args.Actions {
-		if action.Email == nil {
-			return nil, fmt.Errorf("missing email object for action %d", i)
-		}
-		err = relay.UnmarshalSpec(*action.Email.Id, &emailID)
-		if err != nil {
-			return nil, err
+		switch {
+		case action.Email != nil:
+			err = r.updateActionEmail(ctx, mo.ID, action)
+		case action.Webhook != nil:
+			_, err = r.store.UpdateActionWebhook(ctx, mo.ID, action.Webhook)
+		case action.SlackWebhook != nil:
+			_, err = r.store.UpdateActionSlackWebhook(ctx, mo.ID, action.SlackWebhook)
+		default:
+			err = fmt.Errorf("missing action object for action %d", i)
 		}
-		err = r.store.DeleteRecipients(ctx, emailID)
-		if err != nil {
-			return nil, err
-		}
-		e, err = r.store.UpdateActionEmail(ctx, mo.ID, action)
-		if err != nil {
-			return nil, err
-		}
-		err = r.store.CreateRecipients(ctx, action.Email.Update.Recipients, e.Id)
 		if err != nil {
 			return nil, err
 		}
@@ -386,6 +456,23 @@ func (r *Resolver) updateCodeMonitor(ctx context.Context, args *graphqlbackend.U
 	}, nil
 }
 
+func (r *Resolver) updateActionEmail(ctx context.Context, monitorID int64, action *graphqlbackend.EditActionArgs) error {
+	var emailID int64
+	err := relay.UnmarshalSpec(*action.Email.Id, &emailID)
+	if err != nil {
+		return err
+	}
+	err = r.store.DeleteRecipients(ctx, emailID)
+	if err != nil {
+		return err
+	}
+	e, err := r.store.UpdateActionEmail(ctx, monitorID, action)
+	if err != nil {
+		return err
+	}
+	return r.store.CreateRecipients(ctx, action.Email.Update.Recipients, e.Id)
+}
+
 func (r *Resolver) transact(ctx context.Context) (*Resolver, error) {
 	txStore, err := r.store.Transact(ctx)
 	if err != nil {
@@ -521,6 +608,8 @@ const (
 	monitorTriggerQueryKind         = "CodeMonitorTriggerQuery"
 	monitorTriggerEventKind         = "CodeMonitorTriggerEvent"
 	monitorActionEmailKind          = "CodeMonitorActionEmail"
+	monitorActionWebhookKind        = "CodeMonitorActionWebhook"
+	monitorActionSlackWebhookKind   = "CodeMonitorActionSlackWebhook"
 	monitorActionEventKind          = "CodeMonitorActionEmailEvent"
 	monitorActionEmailRecipientKind = "CodeMonitorActionEmailRecipient"
 )
@@ -567,38 +656,60 @@ func (m *monitor) Actions(ctx context.Context, args *graphqlbackend.ListActionAr
 }
 
 func (r *Resolver) actionConnectionResolverWithTriggerID(ctx context.Context, triggerEventID *int, monitorID int64, args *graphqlbackend.ListActionArgs) (graphqlbackend.MonitorActionConnectionResolver, error) {
-	// For now, we only support emails as actions. Once we add other actions such as
-	// webhooks, we have to query those tables here too.
-	q, err := r.store.ReadActionEmailQuery(ctx, monitorID, args)
-	if err != nil {
-		return nil, err
-	}
-	rows, err := r.store.Query(ctx, q)
+	// Monitors only have a handful of actions, so we load all of them and page
+	// through them in memory.
+	all, err := r.allActionsForMonitorIDInt64(ctx, monitorID, triggerEventID)
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
-	es, err := cm.ScanEmails(rows)
-	if err != nil {
-		return nil, err
+	totalCount := int32(len(all))
+
+	if args.After != nil {
+		afterKind, afterID, err := unmarshalActionID(graphql.ID(*args.After))
+		if err != nil {
+			return nil, err
+		}
+		i := 0
+		for ; i < len(all); i++ {
+			kind, id, err := unmarshalActionID(all[i].id())
+			if err != nil {
+				return nil, err
+			}
+			if kind > afterKind || (kind == afterKind && id > afterID) {
+				break
+			}
+		}
+		all = all[i:]
 	}
-	totalCount, err := r.store.TotalCountActionEmails(ctx, monitorID)
-	if err != nil {
-		return nil, err
+	if args.First >= 0 && int(args.First) < len(all) {
+		all = all[:args.First]
 	}
-	actions := make([]graphqlbackend.MonitorAction, 0, len(es))
-	for _, e := range es {
-		actions = append(actions, &action{
-			email: &monitorEmail{
-				Resolver:       r,
-				MonitorEmail:   e,
-				triggerEventID: triggerEventID,
-			},
-		})
+
+	actions := make([]graphqlbackend.MonitorAction, 0, len(all))
+	for _, a := range all {
+		actions = append(actions, a)
 	}
 	return &monitorActionConnection{actions: actions, totalCount: totalCount}, nil
 }
 
+// actionKindOrder is the order in which the actions of a monitor are listed.
+var actionKindOrder = map[string]int{
+	monitorActionEmailKind:        0,
+	monitorActionWebhookKind:      1,
+	monitorActionSlackWebhookKind: 2,
+}
+
+// unmarshalActionID returns the position of the kind of the action in
+// actionKindOrder and the database ID of the action.
+func unmarshalActionID(actionID graphql.ID) (kind int, id int64, err error) {
+	kind, ok := actionKindOrder[relay.UnmarshalKind(actionID)]
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid action ID %q", actionID)
+	}
+	err = relay.UnmarshalSpec(actionID, &id)
+	return kind, id, err
+}
+
 //
 // MonitorTrigger <<UNION>>
 //
@@ -734,24 +845,45 @@ func (a *monitorActionConnection) PageInfo(ctx context.Context) (*graphqlutil.Pa
 	if len(a.actions) == 0 {
 		return graphqlutil.HasNextPage(false), nil
 	}
-	last := a.actions[len(a.actions)-1]
-	if email, ok := last.ToMonitorEmail(); ok {
-		return graphqlutil.NextPageCursor(string(email.ID())), nil
+	last, ok := a.actions[len(a.actions)-1].(*action)
+	if !ok {
+		return nil, fmt.Errorf("unexpected action type %T", a.actions[len(a.actions)-1])
 	}
-	return nil, fmt.Errorf("we only support email actions for now")
+	return graphqlutil.NextPageCursor(string(last.id())), nil
 }
 
 //
 // Action <<UNION>>
 //
 type action struct {
-	email graphqlbackend.MonitorEmailResolver
+	email        graphqlbackend.MonitorEmailResolver
+	webhook      graphqlbackend.MonitorWebhookResolver
+	slackWebhook graphqlbackend.MonitorSlackWebhookResolver
+}
+
+func (a *action) id() graphql.ID {
+	switch {
+	case a.email != nil:
+		return a.email.ID()
+	case a.webhook != nil:
+		return a.webhook.ID()
+	default:
+		return a.slackWebhook.ID()
+	}
 }
 
 func (a *action) ToMonitorEmail() (graphqlbackend.MonitorEmailResolver, bool) {
 	return a.email, a.email != nil
 }
 
+func (a *action) ToMonitorWebhook() (graphqlbackend.MonitorWebhookResolver, bool) {
+	return a.webhook, a.webhook != nil
+}
+
+func (a *action) ToMonitorSlackWebhook() (graphqlbackend.MonitorSlackWebhookResolver, bool) {
+	return a.slackWebhook, a.slackWebhook != nil
+}
+
 //
 // Email
 //
@@ -826,11 +958,89 @@ func (m *monitorEmail) Events(ctx context.Context, args *graphqlbackend.ListEven
 	if err != nil {
 		return nil, err
 	}
+	return m.newActionEventConnection(ajs, totalCount), nil
+}
+
+func (r *Resolver) newActionEventConnection(ajs []*cm.ActionJob, totalCount int32) *monitorActionEventConnection {
 	events := make([]graphqlbackend.MonitorActionEventResolver, len(ajs))
 	for i, aj := range ajs {
-		events[i] = &monitorActionEvent{Resolver: m.Resolver, ActionJob: aj}
+		events[i] = &monitorActionEvent{Resolver: r, ActionJob: aj}
+	}
+	return &monitorActionEventConnection{events: events, totalCount: totalCount}
+}
+
+//
+// Webhook
+//
+type monitorWebhook struct {
+	*Resolver
+	*cm.MonitorWebhook
+
+	// If triggerEventID == nil, all events of this action will be returned.
+	// Otherwise, only those events of this action which are related to the specified
+	// trigger event will be returned.
+	triggerEventID *int
+}
+
+func (m *monitorWebhook) ID() graphql.ID {
+	return relay.MarshalID(monitorActionWebhookKind, m.Id)
+}
+
+func (m *monitorWebhook) Enabled() bool {
+	return m.MonitorWebhook.Enabled
+}
+
+func (m *monitorWebhook) URL() string {
+	return m.MonitorWebhook.URL
+}
+
+func (m *monitorWebhook) Events(ctx context.Context, args *graphqlbackend.ListEventsArgs) (graphqlbackend.MonitorActionEventConnectionResolver, error) {
+	ajs, err := m.store.ReadActionWebhookEvents(ctx, m.Id, m.triggerEventID, args)
+	if err != nil {
+		return nil, err
+	}
+	totalCount, err := m.store.TotalActionWebhookEvents(ctx, m.Id, m.triggerEventID)
+	if err != nil {
+		return nil, err
+	}
+	return m.newActionEventConnection(ajs, totalCount), nil
+}
+
+//
+// Slack webhook
+//
+type monitorSlackWebhook struct {
+	*Resolver
+	*cm.MonitorSlackWebhook
+
+	// If triggerEventID == nil, all events of this action will be returned.
+	// Otherwise, only those events of this action which are related to the specified
+	// trigger event will be returned.
+	triggerEventID *int
+}
+
+func (m *monitorSlackWebhook) ID() graphql.ID {
+	return relay.MarshalID(monitorActionSlackWebhookKind, m.Id)
+}
+
+func (m *monitorSlackWebhook) Enabled() bool {
+	return m.MonitorSlackWebhook.Enabled
+}
+
+func (m *monitorSlackWebhook) URL() string {
+	return m.MonitorSlackWebhook.URL
+}
+
+func (m *monitorSlackWebhook) Events(ctx context.Context, args *graphqlbackend.ListEventsArgs) (graphqlbackend.MonitorActionEventConnectionResolver, error) {
+	ajs, err := m.store.ReadActionSlackWebhookEvents(ctx, m.Id, m.triggerEventID, args)
+	if err != nil {
+		return nil, err
+	}
+	totalCount, err := m.store.TotalActionSlackWebhookEvents(ctx, m.Id, m.triggerEventID)
+	if err != nil {
+		return nil, err
 	}
-	return &monitorActionEventConnection{events: events, totalCount: totalCount}, nil
+	return m.newActionEventConnection(ajs, totalCount), nil
 }
 
 //
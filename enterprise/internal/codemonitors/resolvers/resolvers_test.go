@@ -18,6 +18,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors/email"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors/resolvers/apitest"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors/storetest"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors/webhook"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtesting"
@@ -352,11 +353,11 @@ func TestQueryMonitor(t *testing.T) {
 	// update the job status.
 	postHookOpt := WithPostHooks([]hook{
 		func() error { return r.store.EnqueueTriggerQueries(ctx) },
-		func() error { return r.store.EnqueueActionEmailsForQueryIDInt64(ctx, 1, 1) },
+		func() error { return r.store.EnqueueActionJobsForQueryIDInt64(ctx, 1, 1) },
 		func() error {
 			return (&storetest.TestStore{Store: r.store}).SetJobStatus(ctx, storetest.ActionJobs, storetest.Completed, 1)
 		},
-		func() error { return r.store.EnqueueActionEmailsForQueryIDInt64(ctx, 1, 1) },
+		func() error { return r.store.EnqueueActionJobsForQueryIDInt64(ctx, 1, 1) },
 		// Set the job status of trigger job with id = 1 to "completed". Since we already
 		// created another monitor, there is still a second trigger job (id = 2) which
 		// remains in status queued.
@@ -379,14 +380,14 @@ func TestQueryMonitor(t *testing.T) {
 		func() error { return r.store.EnqueueTriggerQueries(ctx) },
 		// To have a consistent state we have to log the number of search results for
 		// each completed trigger job.
-		func() error { return r.store.LogSearch(ctx, "", 1, 1) },
+		func() error { return r.store.LogSearch(ctx, "", 1, nil, 1) },
 	})
 	_, err = r.insertTestMonitorWithOpts(ctx, t, actionOpt, postHookOpt)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	schema, err := graphqlbackend.NewSchema(db, nil, nil, nil, nil, r, nil, nil)
+	schema, err := graphqlbackend.NewSchema(db, nil, nil, nil, nil, r, nil, nil, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -622,7 +623,7 @@ func TestEditCodeMonitor(t *testing.T) {
 
 	// Update the code monitor.
 	// We update all fields, delete one action, and add a new action.
-	schema, err := graphqlbackend.NewSchema(db, nil, nil, nil, nil, r, nil, nil)
+	schema, err := graphqlbackend.NewSchema(db, nil, nil, nil, nil, r, nil, nil, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1189,6 +1190,202 @@ func TestTriggerTestEmailAction(t *testing.T) {
 	}
 }
 
+func TestEditCodeMonitorWebhooks(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := backend.WithAuthzBypass(context.Background())
+	db := dbtesting.GetDB(t)
+	r := newTestResolver(t, db)
+
+	userID := insertTestUser(t, db, "cm-user1", true)
+	ns := relay.MarshalID("User", userID)
+
+	// Create a code monitor with an email, a webhook and a Slack webhook.
+	ctx = actor.WithActor(ctx, actor.FromUser(userID))
+	actionOpt := WithActions([]*graphqlbackend.CreateActionArgs{
+		{
+			Email: &graphqlbackend.CreateActionEmailArgs{
+				Enabled:    true,
+				Priority:   "NORMAL",
+				Recipients: []graphql.ID{ns},
+				Header:     "header",
+			},
+		},
+		{
+			Webhook: &graphqlbackend.CreateActionWebhookArgs{
+				Enabled: true,
+				URL:     "https://example.com/hook",
+			},
+		},
+		{
+			SlackWebhook: &graphqlbackend.CreateActionSlackWebhookArgs{
+				Enabled: true,
+				URL:     "https://hooks.slack.com/services/1",
+			},
+		},
+	})
+	_, err := r.insertTestMonitorWithOpts(ctx, t, actionOpt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Delete the email, update the webhook, and replace the Slack webhook.
+	schema, err := graphqlbackend.NewSchema(db, nil, nil, nil, nil, r, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	updateInput := map[string]interface{}{
+		"monitorID": string(relay.MarshalID(MonitorKind, 1)),
+		"triggerID": string(relay.MarshalID(monitorTriggerQueryKind, 1)),
+		"webhookID": string(relay.MarshalID(monitorActionWebhookKind, 1)),
+		"userID":    ns,
+	}
+	got := apitest.UpdateCodeMonitorResponse{}
+	batchesApitest.MustExec(ctx, t, schema, updateInput, &got, editMonitorWebhooks)
+
+	want := []apitest.Action{
+		{
+			Typename:      "MonitorWebhook",
+			ActionEmail:   apitest.ActionEmail{Id: string(relay.MarshalID(monitorActionWebhookKind, 1)), Enabled: false},
+			ActionWebhook: apitest.ActionWebhook{Url: "https://example.com/updated"},
+		},
+		{
+			Typename:      "MonitorSlackWebhook",
+			ActionEmail:   apitest.ActionEmail{Id: string(relay.MarshalID(monitorActionSlackWebhookKind, 2)), Enabled: true},
+			ActionWebhook: apitest.ActionWebhook{Url: "https://hooks.slack.com/services/2"},
+		},
+	}
+	if diff := cmp.Diff(want, got.UpdateCodeMonitor.Actions.Nodes); diff != "" {
+		t.Fatalf("unexpected actions (-want +got):\n%s", diff)
+	}
+	if got.UpdateCodeMonitor.Actions.TotalCount != 2 {
+		t.Fatalf("got totalCount %d, want 2", got.UpdateCodeMonitor.Actions.TotalCount)
+	}
+
+	// Invalid URLs are rejected.
+	webhookID := relay.MarshalID(monitorActionWebhookKind, 1)
+	_, err = r.UpdateCodeMonitor(ctx, &graphqlbackend.UpdateCodeMonitorArgs{
+		Monitor: &graphqlbackend.EditMonitorArgs{
+			Id:     relay.MarshalID(MonitorKind, 1),
+			Update: &graphqlbackend.CreateMonitorArgs{Namespace: ns, Description: "updated test monitor"},
+		},
+		Trigger: &graphqlbackend.EditTriggerArgs{
+			Id:     relay.MarshalID(monitorTriggerQueryKind, 1),
+			Update: &graphqlbackend.CreateTriggerArgs{Query: "repo:bar"},
+		},
+		Actions: []*graphqlbackend.EditActionArgs{
+			{
+				Webhook: &graphqlbackend.EditActionWebhookArgs{
+					Id:     &webhookID,
+					Update: &graphqlbackend.CreateActionWebhookArgs{Enabled: true, URL: "https://example.com/updated"},
+				},
+			},
+			{
+				Webhook: &graphqlbackend.EditActionWebhookArgs{
+					Update: &graphqlbackend.CreateActionWebhookArgs{Enabled: true, URL: "file:///etc/passwd"},
+				},
+			},
+		},
+	})
+	if err == nil {
+		t.Fatal("expected error for invalid webhook URL")
+	}
+}
+
+const editMonitorWebhooks = `
+mutation ($monitorID: ID!, $triggerID: ID!, $webhookID: ID!, $userID: ID!) {
+  updateCodeMonitor(
+    monitor: {id: $monitorID, update: {description: "updated test monitor", enabled: true, namespace: $userID}},
+    trigger: {id: $triggerID, update: {query: "repo:bar"}},
+    actions: [
+      {webhook: {id: $webhookID, update: {enabled: false, url: "https://example.com/updated"}}}
+      {slackWebhook: {update: {enabled: true, url: "https://hooks.slack.com/services/2"}}}
+    ]
+  )
+  {
+    actions {
+      totalCount
+      nodes {
+        __typename
+        ... on MonitorWebhook {
+          id
+          enabled
+          url
+        }
+        ... on MonitorSlackWebhook {
+          id
+          enabled
+          url
+        }
+      }
+    }
+  }
+}
+`
+
+func TestTriggerTestWebhookAction(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	var (
+		gotURL     string
+		gotPayload *webhook.Payload
+	)
+	webhook.MockSendWebhook = func(ctx context.Context, url string, payload *webhook.Payload) error {
+		gotURL, gotPayload = url, payload
+		return nil
+	}
+	webhook.MockSendSlackWebhook = webhook.MockSendWebhook
+	t.Cleanup(func() {
+		webhook.MockSendWebhook = nil
+		webhook.MockSendSlackWebhook = nil
+	})
+
+	ctx := backend.WithAuthzBypass(context.Background())
+	r := newTestResolver(t, nil)
+
+	userID := int32(1)
+	namespaceID := relay.MarshalID("User", userID)
+	ctx = actor.WithActor(ctx, actor.FromUser(userID))
+
+	_, err := r.TriggerTestWebhookAction(ctx, &graphqlbackend.TriggerTestWebhookActionArgs{
+		Namespace:   namespaceID,
+		Description: "A code monitor name",
+		Webhook:     &graphqlbackend.CreateActionWebhookArgs{Enabled: true, URL: "https://example.com/hook"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotURL != "https://example.com/hook" || !gotPayload.IsTest || gotPayload.MonitorDescription != "A code monitor name" {
+		t.Fatalf("unexpected delivery to %q: %+v", gotURL, gotPayload)
+	}
+
+	_, err = r.TriggerTestSlackWebhookAction(ctx, &graphqlbackend.TriggerTestSlackWebhookActionArgs{
+		Namespace:    namespaceID,
+		Description:  "A code monitor name",
+		SlackWebhook: &graphqlbackend.CreateActionSlackWebhookArgs{Enabled: true, URL: "https://hooks.slack.com/services/1"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotURL != "https://hooks.slack.com/services/1" || !gotPayload.IsTest {
+		t.Fatalf("unexpected delivery to %q: %+v", gotURL, gotPayload)
+	}
+
+	gotURL = ""
+	_, err = r.TriggerTestWebhookAction(ctx, &graphqlbackend.TriggerTestWebhookActionArgs{
+		Namespace:   namespaceID,
+		Description: "A code monitor name",
+		Webhook:     &graphqlbackend.CreateActionWebhookArgs{Enabled: true, URL: "not a url"},
+	})
+	if err == nil || gotURL != "" {
+		t.Fatalf("expected invalid URL to be rejected without a delivery, got err=%v url=%q", err, gotURL)
+	}
+}
+
 func TestMonitorKindEqualsResolvers(t *testing.T) {
 	got := email.MonitorKind
 	want := MonitorKind
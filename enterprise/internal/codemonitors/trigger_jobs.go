@@ -3,6 +3,7 @@ package codemonitors
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/graph-gophers/graphql-go"
@@ -42,12 +43,37 @@ const logSearchFmtStr = `
 UPDATE cm_trigger_jobs
 SET query_string = %s,
     results = %s,
-    num_results = %s
+    num_results = %s,
+    search_results = %s
 WHERE id = %s
 `
 
-func (s *Store) LogSearch(ctx context.Context, queryString string, numResults int, recordID int) error {
-	return s.Store.Exec(ctx, sqlf.Sprintf(logSearchFmtStr, queryString, numResults > 0, numResults, recordID))
+// LogSearch records the query that the trigger job recordID ran, and the
+// number and a summary of the results it found. The summary is included in the
+// notifications of webhook and Slack webhook actions.
+func (s *Store) LogSearch(ctx context.Context, queryString string, numResults int, results []TriggerResult, recordID int) error {
+	var searchResults interface{}
+	if len(results) > 0 {
+		b, err := json.Marshal(results)
+		if err != nil {
+			return err
+		}
+		searchResults = b
+	}
+	return s.Store.Exec(ctx, sqlf.Sprintf(logSearchFmtStr, queryString, numResults > 0, numResults, searchResults, recordID))
+}
+
+// TriggerResult summarizes a commit found by the query of a code monitor.
+type TriggerResult struct {
+	Repository string    `json:"repository"`
+	Commit     string    `json:"commit"`
+	Author     string    `json:"author"`
+	Date       time.Time `json:"date"`
+	// Subject is the first line of the commit message.
+	Subject string `json:"subject"`
+	// URL is the URL of the commit, relative to the external URL of this
+	// instance.
+	URL string `json:"url"`
 }
 
 const deleteObsoleteJobLogsFmtStr = `
@@ -0,0 +1,341 @@
+// Package webhook delivers the notifications of code monitors to webhooks and
+// Slack webhooks.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors/email"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+)
+
+const (
+	utmSourceWebhook      = "code-monitoring-webhook"
+	utmSourceSlackWebhook = "code-monitoring-slack-webhook"
+
+	// deliveryTimeout is the maximum duration of a single delivery.
+	deliveryTimeout = 30 * time.Second
+
+	// maxSlackResults is the maximum number of results listed in a Slack
+	// message.
+	maxSlackResults = 5
+)
+
+var MockSendWebhook func(ctx context.Context, url string, payload *Payload) error
+var MockSendSlackWebhook func(ctx context.Context, url string, payload *Payload) error
+
+// Payload is the JSON body POSTed to webhook actions. Slack webhook actions
+// receive a message rendered from it.
+type Payload struct {
+	MonitorDescription string `json:"monitorDescription"`
+	MonitorURL         string `json:"monitorURL"`
+	Query              string `json:"query"`
+	NumResults         int    `json:"numResults"`
+	ResultsURL         string `json:"resultsURL"`
+	// Results are the first results of the query, with absolute URLs. There
+	// may be fewer of them than NumResults.
+	Results []codemonitors.TriggerResult `json:"results"`
+	IsTest  bool                         `json:"isTest"`
+}
+
+// newPayload returns the payload of a delivery of the action job described by
+// m. utmSource is either the utm_source of webhooks or of Slack webhooks.
+func newPayload(ctx context.Context, m *codemonitors.ActionJobMetadata, utmSource string) (*Payload, error) {
+	resultsURL, err := email.GetSearchURL(ctx, m.Query, utmSource)
+	if err != nil {
+		return nil, err
+	}
+	monitorURL, err := email.GetCodeMonitorURL(ctx, m.MonitorID, utmSource)
+	if err != nil {
+		return nil, err
+	}
+
+	var numResults int
+	if m.NumResults != nil {
+		numResults = *m.NumResults
+	}
+
+	results := make([]codemonitors.TriggerResult, 0, len(m.Results))
+	for _, r := range m.Results {
+		r.URL, err = email.GetCommitURL(ctx, r.URL, utmSource)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, r)
+	}
+
+	return &Payload{
+		MonitorDescription: m.Description,
+		MonitorURL:         monitorURL,
+		Query:              m.Query,
+		NumResults:         numResults,
+		ResultsURL:         resultsURL,
+		Results:            results,
+	}, nil
+}
+
+// NewWebhookPayload returns the payload of a delivery to a webhook action.
+func NewWebhookPayload(ctx context.Context, m *codemonitors.ActionJobMetadata) (*Payload, error) {
+	return newPayload(ctx, m, utmSourceWebhook)
+}
+
+// NewSlackWebhookPayload returns the payload of a delivery to a Slack webhook
+// action.
+func NewSlackWebhookPayload(ctx context.Context, m *codemonitors.ActionJobMetadata) (*Payload, error) {
+	return newPayload(ctx, m, utmSourceSlackWebhook)
+}
+
+// NewTestPayload returns the payload sent when a user tests a webhook or Slack
+// webhook action before saving the code monitor.
+func NewTestPayload(monitorDescription string) *Payload {
+	return &Payload{
+		MonitorDescription: monitorDescription,
+		NumResults:         1,
+		Results: []codemonitors.TriggerResult{{
+			Repository: "github.com/sourcegraph/example",
+			Commit:     "0000000000000000000000000000000000000000",
+			Author:     "Example Author",
+			Date:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			Subject:    "Example commit",
+		}},
+		IsTest: true,
+	}
+}
+
+// SendWebhook POSTs payload as JSON to url.
+func SendWebhook(ctx context.Context, url string, payload *Payload) error {
+	if MockSendWebhook != nil {
+		return MockSendWebhook(ctx, url, payload)
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return post(ctx, publicDoer(), url, body)
+}
+
+// SendSlackWebhook posts a message describing payload to the Slack incoming
+// webhook at url.
+func SendSlackWebhook(ctx context.Context, url string, payload *Payload) error {
+	if MockSendSlackWebhook != nil {
+		return MockSendSlackWebhook(ctx, url, payload)
+	}
+	body, err := json.Marshal(slackMessage(payload))
+	if err != nil {
+		return err
+	}
+	return post(ctx, publicDoer(), url, body)
+}
+
+func post(ctx context.Context, doer httpcli.Doer, url string, body []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+	defer cancel()
+
+	req, err := http.NewRequest("POST", url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Sourcegraph-Code-Monitoring")
+
+	resp, err := doer.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// 🚨 SECURITY: Never include the response body in the error. Errors of
+	// test deliveries are returned to the user, who could otherwise read the
+	// responses of arbitrary endpoints.
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+var (
+	publicDoerOnce sync.Once
+	publicDoerVal  httpcli.Doer
+)
+
+// publicDoer returns the client used to deliver notifications. It only
+// connects to public addresses.
+func publicDoer() httpcli.Doer {
+	publicDoerOnce.Do(func() {
+		var err error
+		// Deliveries are POSTs, which aren't cached, so unlike
+		// httpcli.NewExternalHTTPClientFactory we don't use a cache.
+		publicDoerVal, err = httpcli.NewFactory(
+			httpcli.NewMiddleware(httpcli.ContextErrorMiddleware),
+			// ExternalTransportOpt needs to be before TracedTransportOpt
+			// since it wants to extract a http.Transport.
+			httpcli.ExternalTransportOpt,
+			httpcli.TracedTransportOpt,
+		).Doer(publicAddressesOnlyOpt)
+		if err != nil {
+			panic("webhook: failed to create the delivery client. This should not happen: " + err.Error())
+		}
+	})
+	return publicDoerVal
+}
+
+// publicAddressesOnlyOpt makes a client refuse to connect to addresses which
+// aren't publicly routable.
+//
+// 🚨 SECURITY: Any user who can create a code monitor chooses the URLs that
+// Sourcegraph POSTs to. Without this, they could reach internal services
+// such as cloud metadata endpoints or other services in the cluster.
+func publicAddressesOnlyOpt(cli *http.Client) error {
+	tr := http.DefaultTransport.(*http.Transport).Clone()
+	// Connect directly, since a proxy could reach the internal addresses which
+	// we refuse to connect to.
+	tr.Proxy = nil
+	tr.DialContext = (&net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   checkPublicAddress,
+	}).DialContext
+	cli.Transport = tr
+	return nil
+}
+
+// checkPublicAddress is a net.Dialer Control function which refuses to connect
+// to addresses which aren't publicly routable. It runs after host names are
+// resolved, so it also covers host names resolving to internal addresses, and
+// redirects.
+func checkPublicAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !isPublicIP(ip) {
+		return fmt.Errorf("refusing to deliver to non-public address %s", host)
+	}
+	return nil
+}
+
+// nonPublicNetworks are the networks which aren't publicly routable, in
+// addition to the loopback, link-local, multicast and unspecified addresses.
+var nonPublicNetworks = mustParseCIDRs(
+	"0.0.0.0/8",      // "this" network
+	"10.0.0.0/8",     // private
+	"100.64.0.0/10",  // carrier-grade NAT
+	"172.16.0.0/12",  // private
+	"192.0.0.0/24",   // IETF protocol assignments
+	"192.168.0.0/16", // private
+	"198.18.0.0/15",  // benchmarking
+	"240.0.0.0/4",    // reserved
+	"fc00::/7",       // unique local
+)
+
+func isPublicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return false
+	}
+	for _, n := range nonPublicNetworks {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	nets := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		nets = append(nets, n)
+	}
+	return nets
+}
+
+// slackMessage renders payload as the body of a message sent to a Slack
+// incoming webhook. See https://api.slack.com/messaging/webhooks.
+func slackMessage(p *Payload) map[string]interface{} {
+	title := slackEscape(p.MonitorDescription)
+	if p.MonitorURL != "" {
+		title = fmt.Sprintf("<%s|%s>", p.MonitorURL, title)
+	}
+
+	var results string
+	if p.NumResults == 1 {
+		results = "There was 1 new search result for your query"
+	} else {
+		results = fmt.Sprintf("There were %d new search results for your query", p.NumResults)
+	}
+	if p.ResultsURL != "" {
+		results = fmt.Sprintf("<%s|%s>", p.ResultsURL, results)
+	}
+
+	text := fmt.Sprintf("Code monitor *%s*: %s", title, results)
+	if p.IsTest {
+		text = "_This is a test message._ " + text
+	}
+
+	blocks := []interface{}{
+		map[string]interface{}{
+			"type": "section",
+			"text": map[string]interface{}{
+				"type": "mrkdwn",
+				"text": text,
+			},
+		},
+	}
+	if len(p.Results) > 0 {
+		blocks = append(blocks, map[string]interface{}{
+			"type": "section",
+			"text": map[string]interface{}{
+				"type": "mrkdwn",
+				"text": slackResultList(p.Results),
+			},
+		})
+	}
+
+	return map[string]interface{}{
+		"text":   text,
+		"blocks": blocks,
+	}
+}
+
+// slackResultList renders the first maxSlackResults results as a mrkdwn list.
+func slackResultList(results []codemonitors.TriggerResult) string {
+	var b strings.Builder
+	for i, r := range results {
+		if i == maxSlackResults {
+			fmt.Fprintf(&b, "• …and %d more", len(results)-maxSlackResults)
+			break
+		}
+		commit := r.Commit
+		if len(commit) > 7 {
+			commit = commit[:7]
+		}
+		name := slackEscape(r.Repository + "@" + commit)
+		if r.URL != "" {
+			name = fmt.Sprintf("<%s|%s>", r.URL, name)
+		}
+		fmt.Fprintf(&b, "• %s: %s (%s)\n", name, slackEscape(r.Subject), slackEscape(r.Author))
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// slackEscape escapes the control characters of Slack's mrkdwn format.
+func slackEscape(s string) string {
+	return slackEscaper.Replace(s)
+}
@@ -0,0 +1,181 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors/email"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+)
+
+func TestNewWebhookPayload(t *testing.T) {
+	email.MockExternalURL = func() *url.URL {
+		u, _ := url.Parse("https://www.sourcegraph.com")
+		return u
+	}
+	t.Cleanup(func() { email.MockExternalURL = nil })
+
+	numResults := 3
+	result := codemonitors.TriggerResult{
+		Repository: "github.com/sourcegraph/sourcegraph",
+		Commit:     "deadbeef",
+		Author:     "Alice",
+		Date:       time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC),
+		Subject:    "fix the build",
+		URL:        "/github.com/sourcegraph/sourcegraph/-/commit/deadbeef",
+	}
+	got, err := NewWebhookPayload(context.Background(), &codemonitors.ActionJobMetadata{
+		Description: "test description",
+		MonitorID:   1,
+		NumResults:  &numResults,
+		Query:       "test patternType:literal",
+		Results:     []codemonitors.TriggerResult{result},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Payload{
+		MonitorDescription: "test description",
+		MonitorURL:         "https://www.sourcegraph.com/code-monitoring/" + string(relay.MarshalID(email.MonitorKind, 1)) + "?utm_source=code-monitoring-webhook",
+		Query:              "test patternType:literal",
+		NumResults:         3,
+		ResultsURL:         "https://www.sourcegraph.com/search?q=test+patternType%3Aliteral&utm_source=code-monitoring-webhook",
+		Results: []codemonitors.TriggerResult{{
+			Repository: "github.com/sourcegraph/sourcegraph",
+			Commit:     "deadbeef",
+			Author:     "Alice",
+			Date:       time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC),
+			Subject:    "fix the build",
+			URL:        "https://www.sourcegraph.com/github.com/sourcegraph/sourcegraph/-/commit/deadbeef?utm_source=code-monitoring-webhook",
+		}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected payload (-want +got):\n%s", diff)
+	}
+}
+
+func TestPost(t *testing.T) {
+	var gotBody, gotContentType string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		gotBody = string(b)
+		gotContentType = r.Header.Get("Content-Type")
+		if strings.Contains(gotBody, "fail") {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte("no_service\n"))
+		}
+	}))
+	defer ts.Close()
+
+	doer := httpcli.Doer(http.DefaultClient)
+
+	if err := post(context.Background(), doer, ts.URL, []byte(`{"ok":true}`)); err != nil {
+		t.Fatal(err)
+	}
+	if gotBody != `{"ok":true}` || gotContentType != "application/json" {
+		t.Fatalf("unexpected request: body=%q content-type=%q", gotBody, gotContentType)
+	}
+
+	// The response body must not be part of the error.
+	err := post(context.Background(), doer, ts.URL, []byte(`{"fail":true}`))
+	if err == nil || err.Error() != "unexpected status code 404" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestPublicDoer(t *testing.T) {
+	var called bool
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer ts.Close()
+
+	err := post(context.Background(), publicDoer(), ts.URL, []byte(`{}`))
+	if err == nil || !strings.Contains(err.Error(), "refusing to deliver to non-public address 127.0.0.1") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if called {
+		t.Fatal("expected the loopback server not to be called")
+	}
+}
+
+func TestIsPublicIP(t *testing.T) {
+	for addr, want := range map[string]bool{
+		"8.8.8.8":         true,
+		"2001:4860::8888": true,
+		"127.0.0.1":       false,
+		"::1":             false,
+		"0.0.0.0":         false,
+		"10.1.2.3":        false,
+		"172.20.0.1":      false,
+		"192.168.1.1":     false,
+		"100.64.0.1":      false,
+		"169.254.169.254": false,
+		"fe80::1":         false,
+		"fd00::1":         false,
+		"::ffff:10.0.0.1": false,
+	} {
+		if got := isPublicIP(net.ParseIP(addr)); got != want {
+			t.Errorf("isPublicIP(%s) = %v, want %v", addr, got, want)
+		}
+	}
+}
+
+func TestSlackMessage(t *testing.T) {
+	msg := slackMessage(&Payload{
+		MonitorDescription: "a <b> & c",
+		MonitorURL:         "https://sourcegraph.test/code-monitoring/1",
+		NumResults:         2,
+		ResultsURL:         "https://sourcegraph.test/search?q=a",
+	})
+	b, err := json.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "Code monitor *<https://sourcegraph.test/code-monitoring/1|a &lt;b&gt; &amp; c>*: <https://sourcegraph.test/search?q=a|There were 2 new search results for your query>"
+	if msg["text"] != want {
+		t.Fatalf("unexpected text:\ngot:  %s\nwant: %s", msg["text"], want)
+	}
+	if !strings.Contains(string(b), `"type":"mrkdwn"`) {
+		t.Fatalf("expected a mrkdwn section block, got %s", b)
+	}
+
+	msg = slackMessage(&Payload{
+		MonitorDescription: "test",
+		NumResults:         1,
+		Results: []codemonitors.TriggerResult{{
+			Repository: "github.com/a/b",
+			Commit:     "0123456789abcdef",
+			Author:     "Alice",
+			Subject:    "fix <x>",
+			URL:        "https://sourcegraph.test/github.com/a/b/-/commit/0123456789abcdef",
+		}},
+	})
+	blocks := msg["blocks"].([]interface{})
+	if len(blocks) != 2 {
+		t.Fatalf("expected a block listing the results, got %d blocks", len(blocks))
+	}
+	list := blocks[1].(map[string]interface{})["text"].(map[string]interface{})["text"]
+	if want := "• <https://sourcegraph.test/github.com/a/b/-/commit/0123456789abcdef|github.com/a/b@0123456>: fix &lt;x&gt; (Alice)"; list != want {
+		t.Fatalf("unexpected result list:\ngot:  %s\nwant: %s", list, want)
+	}
+
+	msg = slackMessage(NewTestPayload("test"))
+	if want := "_This is a test message._ Code monitor *test*: There was 1 new search result for your query"; msg["text"] != want {
+		t.Fatalf("unexpected text:\ngot:  %s\nwant: %s", msg["text"], want)
+	}
+}
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"github.com/sourcegraph/sourcegraph/internal/authz/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/authz/bitbucketserver"
 	"github.com/sourcegraph/sourcegraph/internal/authz/github"
 	"github.com/sourcegraph/sourcegraph/internal/authz/gitlab"
@@ -23,6 +24,9 @@ func NewExternalServicesStore(d dbutil.DB) *database.ExternalServiceStore {
 	es.BitbucketServerValidators = []func(*schema.BitbucketServerConnection) error{
 		bitbucketserver.ValidateAuthz,
 	}
+	es.BitbucketCloudValidators = []func(*schema.BitbucketCloudConnection) error{
+		bitbucketcloud.ValidateAuthz,
+	}
 	es.PerforceValidators = []func(connection *schema.PerforceConnection) error{
 		perforce.ValidateAuthz,
 	}
@@ -44,6 +44,8 @@ func TestIntegration_PermsStore(t *testing.T) {
 		{"UserIDsWithOldestPerms", testPermsStore_UserIDsWithOldestPerms(db)},
 		{"ReposIDsWithOldestPerms", testPermsStore_ReposIDsWithOldestPerms(db)},
 		{"Metrics", testPermsStore_Metrics(db)},
+
+		{"SubRepoPerms/SetUserPermissions", testSubRepoPermsStore_SetUserPermissions(db)},
 	} {
 		t.Run(tc.name, tc.test)
 	}
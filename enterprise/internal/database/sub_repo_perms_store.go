@@ -0,0 +1,132 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	otlog "github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+)
+
+// SubRepoPermsStore is the unified interface for managing sub-repository
+// permissions explicitly in the database. It maintains the
+// 'sub_repo_permissions' table.
+type SubRepoPermsStore struct {
+	*basestore.Store
+}
+
+var _ authz.SubRepoPermissionsGetter = (*SubRepoPermsStore)(nil)
+
+// SubRepoPerms returns a new SubRepoPermsStore backed by the given database.
+func SubRepoPerms(db dbutil.DB) *SubRepoPermsStore {
+	return &SubRepoPermsStore{Store: basestore.NewWithDB(db, sql.TxOptions{})}
+}
+
+// SubRepoPermsWith returns a new SubRepoPermsStore sharing the underlying
+// database handle of the given store.
+func SubRepoPermsWith(other basestore.ShareableStore) *SubRepoPermsStore {
+	return &SubRepoPermsStore{Store: basestore.NewWithHandle(other.Handle())}
+}
+
+// Transact begins a new transaction and make a new SubRepoPermsStore over it.
+func (s *SubRepoPermsStore) Transact(ctx context.Context) (*SubRepoPermsStore, error) {
+	txBase, err := s.Store.Transact(ctx)
+	return &SubRepoPermsStore{Store: txBase}, err
+}
+
+// SetUserPermissions replaces the sub-repository permissions of the given user
+// with the given permissions, keyed by repository.
+func (s *SubRepoPermsStore) SetUserPermissions(ctx context.Context, userID int32, perms map[api.RepoID]authz.SubRepoPermissions) (err error) {
+	if Mocks.SubRepoPerms.SetUserPermissions != nil {
+		return Mocks.SubRepoPerms.SetUserPermissions(ctx, userID, perms)
+	}
+
+	tr, ctx := trace.New(ctx, "database.SubRepoPermsStore.SetUserPermissions", "")
+	defer func() {
+		tr.LogFields(otlog.Int32("userID", userID), otlog.Int("repos", len(perms)))
+		tr.SetError(err)
+		tr.Finish()
+	}()
+
+	tx, err := s.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	repoIDs := make([]int32, 0, len(perms))
+	for repoID, p := range perms {
+		repoIDs = append(repoIDs, int32(repoID))
+
+		if err := tx.Exec(ctx, sqlf.Sprintf(upsertSubRepoPermissionsQuery, userID, repoID, pq.Array(p.PathRules))); err != nil {
+			return err
+		}
+	}
+
+	return tx.Exec(ctx, sqlf.Sprintf(deleteSubRepoPermissionsQuery, userID, pq.Array(repoIDs)))
+}
+
+const upsertSubRepoPermissionsQuery = `
+-- source: enterprise/internal/database/sub_repo_perms_store.go:SetUserPermissions
+INSERT INTO sub_repo_permissions (user_id, repo_id, path_rules, updated_at)
+VALUES (%s, %s, %s, NOW())
+ON CONFLICT (user_id, repo_id) DO UPDATE
+SET
+	path_rules = EXCLUDED.path_rules,
+	updated_at = EXCLUDED.updated_at
+`
+
+const deleteSubRepoPermissionsQuery = `
+-- source: enterprise/internal/database/sub_repo_perms_store.go:SetUserPermissions
+DELETE FROM sub_repo_permissions
+WHERE user_id = %s AND NOT (repo_id = ANY (%s))
+`
+
+// GetByUser returns the sub-repository permissions of the given user, keyed by
+// the names of the repositories.
+func (s *SubRepoPermsStore) GetByUser(ctx context.Context, userID int32) (_ map[api.RepoName]authz.SubRepoPermissions, err error) {
+	if Mocks.SubRepoPerms.GetByUser != nil {
+		return Mocks.SubRepoPerms.GetByUser(ctx, userID)
+	}
+
+	tr, ctx := trace.New(ctx, "database.SubRepoPermsStore.GetByUser", "")
+	defer func() {
+		tr.LogFields(otlog.Int32("userID", userID))
+		tr.SetError(err)
+		tr.Finish()
+	}()
+
+	rows, err := s.Query(ctx, sqlf.Sprintf(getSubRepoPermissionsByUserQuery, userID))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	perms := make(map[api.RepoName]authz.SubRepoPermissions)
+	for rows.Next() {
+		var (
+			name api.RepoName
+			p    authz.SubRepoPermissions
+		)
+		if err := rows.Scan(&name, pq.Array(&p.PathRules)); err != nil {
+			return nil, err
+		}
+		perms[name] = p
+	}
+	return perms, nil
+}
+
+const getSubRepoPermissionsByUserQuery = `
+-- source: enterprise/internal/database/sub_repo_perms_store.go:GetByUser
+SELECT r.name, p.path_rules
+FROM sub_repo_permissions p
+JOIN repo r ON r.id = p.repo_id
+WHERE p.user_id = %s AND r.deleted_at IS NULL
+`
@@ -0,0 +1,13 @@
+package database
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+)
+
+type MockSubRepoPerms struct {
+	SetUserPermissions func(ctx context.Context, userID int32, perms map[api.RepoID]authz.SubRepoPermissions) error
+	GetByUser          func(ctx context.Context, userID int32) (map[api.RepoName]authz.SubRepoPermissions, error)
+}
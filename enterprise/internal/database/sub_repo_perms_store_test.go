@@ -0,0 +1,104 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+)
+
+func testSubRepoPermsStore_SetUserPermissions(db *sql.DB) func(*testing.T) {
+	return func(t *testing.T) {
+		perms := Perms(db, time.Now)
+		s := SubRepoPermsWith(perms)
+		t.Cleanup(func() {
+			cleanupUsersTable(t, perms)
+			cleanupReposTable(t, perms)
+		})
+
+		ctx := context.Background()
+
+		qs := []*sqlf.Query{
+			sqlf.Sprintf(`INSERT INTO users(username) VALUES('alice')`), // ID=1
+			sqlf.Sprintf(`INSERT INTO users(username) VALUES('bob')`),   // ID=2
+			sqlf.Sprintf(`INSERT INTO repo(id, name, private) VALUES(1, 'perforce/engineering', TRUE)`),
+			sqlf.Sprintf(`INSERT INTO repo(id, name, private) VALUES(2, 'perforce/security', TRUE)`),
+			sqlf.Sprintf(`INSERT INTO repo(id, name, private, deleted_at) VALUES(3, 'perforce/deleted', TRUE, NOW())`),
+		}
+		for _, q := range qs {
+			if err := perms.execute(ctx, q); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if err := s.SetUserPermissions(ctx, 1, map[api.RepoID]authz.SubRepoPermissions{
+			1: {PathRules: []string{"**", "-secret/**"}},
+			2: {PathRules: []string{"docs/**"}},
+			3: {PathRules: []string{"**"}},
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if err := s.SetUserPermissions(ctx, 2, map[api.RepoID]authz.SubRepoPermissions{
+			2: {PathRules: []string{"**"}},
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		// Permissions of deleted repositories are not returned.
+		have, err := s.GetByUser(ctx, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := map[api.RepoName]authz.SubRepoPermissions{
+			"perforce/engineering": {PathRules: []string{"**", "-secret/**"}},
+			"perforce/security":    {PathRules: []string{"docs/**"}},
+		}
+		if diff := cmp.Diff(want, have); diff != "" {
+			t.Fatalf("unexpected permissions (-want +have):\n%s", diff)
+		}
+
+		// Permissions of repositories not given are removed, others are
+		// replaced.
+		if err := s.SetUserPermissions(ctx, 1, map[api.RepoID]authz.SubRepoPermissions{
+			2: {PathRules: []string{"**"}},
+		}); err != nil {
+			t.Fatal(err)
+		}
+		have, err = s.GetByUser(ctx, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want = map[api.RepoName]authz.SubRepoPermissions{
+			"perforce/security": {PathRules: []string{"**"}},
+		}
+		if diff := cmp.Diff(want, have); diff != "" {
+			t.Fatalf("unexpected permissions after update (-want +have):\n%s", diff)
+		}
+
+		// Permissions of other users are untouched.
+		have, err = s.GetByUser(ctx, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, have); diff != "" {
+			t.Fatalf("unexpected permissions of other user (-want +have):\n%s", diff)
+		}
+
+		if err := s.SetUserPermissions(ctx, 2, nil); err != nil {
+			t.Fatal(err)
+		}
+		have, err = s.GetByUser(ctx, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(have) != 0 {
+			t.Fatalf("expected permissions to be removed, have %v", have)
+		}
+	}
+}
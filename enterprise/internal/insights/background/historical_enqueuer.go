@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -176,7 +177,7 @@ type RepoStore interface {
 //   * For every repository on Sourcegraph (a subset on Sourcegraph.com):
 //     * Consider yielding/sleeping.
 //     * Find the oldest commit in the repository.
-//       * For every unique search insight series (i.e. search query):
+//       * For every unique search insight series (i.e. search query) not scoped to other repositories:
 //         * Consider yielding/sleeping.
 //         * If the series has data for this timeframe+repo already, nothing to do.
 //         * If the timeframe we're generating data for is before the oldest commit in the repo, record a zero value.
@@ -307,16 +308,32 @@ func (h *historicalEnqueuer) buildFrame(
 	//    perform (because all have had historical data built already.)
 	//
 
+	// Series scoped to specific repositories only need data for those repositories. If every
+	// series is scoped, there is no need to walk over all repositories on Sourcegraph.
+	forEachRepo := h.allReposIterator
+	if scopedRepos, ok := scopedRepositories(uniqueSeries); ok {
+		forEachRepo = func(ctx context.Context, each func(repoName string) error) error {
+			for _, repoName := range scopedRepos {
+				if err := each(repoName); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
 	// For every repository that we want to potentially gather historical data for.
-	hardErr = h.allReposIterator(ctx, func(repoName string) error {
+	hardErr = forEachRepo(ctx, func(repoName string) error {
 		// Lookup the repository (we need its database ID)
 		repo, err := h.repoStore.GetByName(ctx, api.RepoName(repoName))
 		if err != nil {
 			// Ignore RepoNotFoundErr because it could just be that the repository was actually
-			// deleted and allReposIterator had it cached.
+			// deleted and allReposIterator had it cached, or that a series is scoped to a
+			// repository which does not exist.
 			if _, ok := err.(*database.RepoNotFoundErr); !ok {
 				return err // hard DB error
 			}
+			return nil
 		}
 
 		// Find the first commit made to the repository on the default branch.
@@ -336,6 +353,9 @@ func (h *historicalEnqueuer) buildFrame(
 		// For every series that we want to potentially gather historical data for, try.
 		for _, seriesID := range sortedSeriesIDs {
 			series := uniqueSeries[seriesID]
+			if !seriesIncludesRepository(series, repoName) {
+				continue
+			}
 			err := h.limiter.Wait(ctx)
 			if err != nil {
 				return err
@@ -378,6 +398,37 @@ func (h *historicalEnqueuer) buildFrame(
 	return
 }
 
+// scopedRepositories returns the sorted names of all repositories the given series are scoped to.
+// It returns false if any of the series runs against all repositories.
+func scopedRepositories(uniqueSeries map[string]*schema.InsightSeries) ([]string, bool) {
+	seen := map[string]struct{}{}
+	for _, series := range uniqueSeries {
+		repos := discovery.SeriesRepositories(series)
+		if len(repos) == 0 {
+			return nil, false
+		}
+		for _, repo := range repos {
+			seen[repo] = struct{}{}
+		}
+	}
+	scopedRepos := make([]string, 0, len(seen))
+	for repo := range seen {
+		scopedRepos = append(scopedRepos, repo)
+	}
+	sort.Strings(scopedRepos)
+	return scopedRepos, true
+}
+
+// seriesIncludesRepository tells if the series needs data for the given repository.
+func seriesIncludesRepository(series *schema.InsightSeries, repoName string) bool {
+	repos := discovery.SeriesRepositories(series)
+	if len(repos) == 0 {
+		return true
+	}
+	i := sort.SearchStrings(repos, repoName)
+	return i < len(repos) && repos[i] == repoName
+}
+
 // buildSeriesContext describes context/parameters for a call to buildSeries()
 type buildSeriesContext struct {
 	// The timeframe we're building historical data for.
@@ -14,6 +14,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/discovery"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store"
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
 )
@@ -73,7 +74,11 @@ func testHistoricalEnqueuer(t *testing.T, p *testParams) *testResults {
 	}
 	repoStore.GetByNameFunc.SetDefaultHook(func(ctx context.Context, name api.RepoName) (*types.Repo, error) {
 		r.reposGetByName++
-		return repos[name], nil
+		repo, ok := repos[name]
+		if !ok {
+			return nil, &database.RepoNotFoundErr{Name: name}
+		}
+		return repo, nil
 	})
 
 	enqueueQueryRunnerJob := func(ctx context.Context, job *queryrunner.Job) error {
@@ -197,4 +202,28 @@ func Test_historicalEnqueuer(t *testing.T) {
 			recordSleepOperations: true,
 		}))
 	})
+
+	// Test that series scoped to repositories only build data for those repositories, and that
+	// all repositories are not walked over if every series is scoped.
+	t.Run("scoped_series", func(t *testing.T) {
+		want := autogold.Want("scoped_series", &testResults{
+			reposGetByName: 2,
+			operations: []string{
+				`enqueueQueryRunnerJob("2020-12-28T12:00:01Z", "errorf count:9999999 repo:^repo/0$@")`,
+			},
+		})
+		want.Equal(t, testHistoricalEnqueuer(t, &testParams{
+			settings: &api.Settings{ID: 1, Contents: `{
+				"insights": [
+					{
+						"title": "errorf",
+						"description": "errorf usage",
+						"series": [{"label": "errorf", "search": "errorf", "repositoriesList": ["repo/0", "repo/9"]}]
+					}
+				]
+			}`},
+			numRepos: 2,
+			frames:   1,
+		}))
+	})
 }
@@ -2,6 +2,8 @@ package background
 
 import (
 	"context"
+	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
@@ -93,7 +95,7 @@ func discoverAndEnqueueInsights(
 			offset += queryJobOffsetTime
 			err = enqueueQueryRunnerJob(ctx, &queryrunner.Job{
 				SeriesID:     seriesID,
-				SearchQuery:  withCountUnlimited(series.Search),
+				SearchQuery:  withRepositoryScope(withCountUnlimited(series.Search), discovery.SeriesRepositories(series)),
 				ProcessAfter: &processAfter,
 				State:        "queued",
 			})
@@ -117,3 +119,17 @@ func withCountUnlimited(s string) string {
 	}
 	return s + " count:9999999"
 }
+
+// withRepositoryScope adds a `repo:` filter matching exactly the given repositories to the given
+// search query string. The query is returned unchanged if there are no repositories, in which case
+// it runs against all repositories.
+func withRepositoryScope(s string, repos []string) string {
+	if len(repos) == 0 {
+		return s
+	}
+	quoted := make([]string, 0, len(repos))
+	for _, repo := range repos {
+		quoted = append(quoted, regexp.QuoteMeta(repo))
+	}
+	return fmt.Sprintf("%s repo:^(%s)$", s, strings.Join(quoted, "|"))
+}
@@ -148,3 +148,8 @@ func Test_discoverAndEnqueueInsights(t *testing.T) {
   }
 ]`).Equal(t, string(enqueuedJSON))
 }
+
+func Test_withRepositoryScope(t *testing.T) {
+	autogold.Want("unscoped", "errorf count:9999999").Equal(t, withRepositoryScope("errorf count:9999999", nil))
+	autogold.Want("scoped", "errorf count:9999999 repo:^(github\\.com/golang/go|github\\.com/golang/tools)$").Equal(t, withRepositoryScope("errorf count:9999999", []string{"github.com/golang/go", "github.com/golang/tools"}))
+}
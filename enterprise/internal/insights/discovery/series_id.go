@@ -3,6 +3,8 @@ package discovery
 import (
 	"crypto/sha256"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/sourcegraph/sourcegraph/schema"
 )
@@ -15,7 +17,12 @@ import (
 // Note that since the series ID hash is stored in the database, it must remain stable or else past
 // data will not be queryable.
 func EncodeSeriesID(series *schema.InsightSeries) (string, error) {
+	repos := SeriesRepositories(series)
 	switch {
+	case series.Search != "" && len(repos) > 0:
+		// The IDs of series without a repository scope predate repository scopes, so the
+		// repositories are only hashed if there are any.
+		return fmt.Sprintf("s:%s", sha256String(series.Search+"\n"+strings.Join(repos, "\n"))), nil
 	case series.Search != "":
 		return fmt.Sprintf("s:%s", sha256String(series.Search)), nil
 	case series.Webhook != "":
@@ -28,3 +35,23 @@ func EncodeSeriesID(series *schema.InsightSeries) (string, error) {
 func sha256String(s string) string {
 	return fmt.Sprintf("%X", sha256.Sum256([]byte(s)))
 }
+
+// SeriesRepositories returns the sorted and deduplicated names of the repositories the series is
+// scoped to, or nil if the series runs against all repositories.
+func SeriesRepositories(series *schema.InsightSeries) []string {
+	repos := make([]string, 0, len(series.RepositoriesList))
+	seen := make(map[string]struct{}, len(series.RepositoriesList))
+	for _, repo := range series.RepositoriesList {
+		repo = strings.TrimSpace(repo)
+		if _, ok := seen[repo]; ok || repo == "" {
+			continue
+		}
+		seen[repo] = struct{}{}
+		repos = append(repos, repo)
+	}
+	if len(repos) == 0 {
+		return nil
+	}
+	sort.Strings(repos)
+	return repos
+}
@@ -21,6 +21,16 @@ func TestEncodeSeriesID(t *testing.T) {
 				"<nil>",
 			}),
 		},
+		{
+			input: &schema.InsightSeries{
+				Search:           "fmt.Errorf",
+				RepositoriesList: []string{"github.com/golang/tools", "github.com/golang/go", "github.com/golang/tools"},
+			},
+			want: autogold.Want("scoped_search", [2]interface{}{
+				"s:AE7BF4E5B1B6080E8AD5A47FE0E04A102B2569FC899B242AE5300B24D9AD1562",
+				"<nil>",
+			}),
+		},
 		{
 			input: &schema.InsightSeries{Webhook: "https://example.com/getData?foo=bar"},
 			want: autogold.Want("basic_webhook", [2]interface{}{
@@ -0,0 +1,20 @@
+package background
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/uploadstore"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/searchexports"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+)
+
+func StartBackgroundJobs(ctx context.Context, store *searchexports.Store, uploadStore uploadstore.Store, search SearchFunc) {
+	metrics := newMetrics()
+
+	routines := []goroutine.BackgroundRoutine{
+		newExportWorker(ctx, store, uploadStore, search, metrics),
+		newExportResetter(ctx, store, metrics),
+		newExportJanitor(ctx, store, uploadStore),
+	}
+	go goroutine.MonitorBackgroundRoutines(ctx, routines...)
+}
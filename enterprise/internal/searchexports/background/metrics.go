@@ -0,0 +1,51 @@
+package background
+
+import (
+	"github.com/inconshreveable/log15"
+	"github.com/opentracing/opentracing-go"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil"
+)
+
+type searchExportsMetrics struct {
+	workerMetrics workerutil.WorkerMetrics
+	resets        prometheus.Counter
+	resetFailures prometheus.Counter
+	errors        prometheus.Counter
+}
+
+func newMetrics() searchExportsMetrics {
+	observationContext := &observation.Context{
+		Logger:     log15.Root(),
+		Tracer:     &trace.Tracer{Tracer: opentracing.GlobalTracer()},
+		Registerer: prometheus.DefaultRegisterer,
+	}
+
+	resetFailures := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "src_search_exports_reset_failures_total",
+		Help: "The number of reset failures.",
+	})
+	observationContext.Registerer.MustRegister(resetFailures)
+
+	resets := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "src_search_exports_resets_total",
+		Help: "The number of records reset.",
+	})
+	observationContext.Registerer.MustRegister(resets)
+
+	errors := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "src_search_exports_errors_total",
+		Help: "The number of errors that occur during job.",
+	})
+	observationContext.Registerer.MustRegister(errors)
+
+	return searchExportsMetrics{
+		workerMetrics: workerutil.NewMetrics(observationContext, "search_exports", nil),
+		resets:        resets,
+		resetFailures: resetFailures,
+		errors:        errors,
+	}
+}
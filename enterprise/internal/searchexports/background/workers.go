@@ -0,0 +1,165 @@
+package background
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/uploadstore"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/searchexports"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker"
+	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
+)
+
+const (
+	// maxExportRows is the maximum number of rows written by a single export.
+	maxExportRows = 1000000
+
+	// exportRetention is the time after which finished exports and their files
+	// are deleted.
+	exportRetention = 24 * time.Hour
+)
+
+// SearchFunc returns a page of the results of the given search query, starting
+// at the given cursor, and the cursor of the next page. A nil cursor denotes
+// the first page of results when given and the last one when returned.
+type SearchFunc func(ctx context.Context, query, patternType string, after *string) (_ []result.Match, next *string, _ error)
+
+func newExportWorker(ctx context.Context, s *searchexports.Store, uploadStore uploadstore.Store, search SearchFunc, metrics searchExportsMetrics) *workerutil.Worker {
+	options := workerutil.WorkerOptions{
+		Name:        "search_exports_worker",
+		NumHandlers: 1,
+		Interval:    5 * time.Second,
+		Metrics:     metrics.workerMetrics,
+	}
+	handler := &exportHandler{store: s, uploadStore: uploadStore, search: search}
+	return dbworker.NewWorker(ctx, createDBWorkerStore(s), handler, options)
+}
+
+func newExportResetter(ctx context.Context, s *searchexports.Store, metrics searchExportsMetrics) *dbworker.Resetter {
+	options := dbworker.ResetterOptions{
+		Name:     "search_exports_worker_resetter",
+		Interval: 1 * time.Minute,
+		Metrics: dbworker.ResetterMetrics{
+			Errors:              metrics.errors,
+			RecordResetFailures: metrics.resetFailures,
+			RecordResets:        metrics.resets,
+		},
+	}
+	return dbworker.NewResetter(createDBWorkerStore(s), options)
+}
+
+func newExportJanitor(ctx context.Context, s *searchexports.Store, uploadStore uploadstore.Store) goroutine.BackgroundRoutine {
+	deleteExports := goroutine.NewHandlerWithErrorMessage(
+		"search_exports_janitor",
+		func(ctx context.Context) error {
+			objectKeys, err := s.DeleteExportsFinishedBefore(ctx, s.Now().Add(-exportRetention))
+			if err != nil {
+				return err
+			}
+			for _, key := range objectKeys {
+				if err := uploadStore.Delete(ctx, key); err != nil {
+					return errors.Wrap(err, "uploadStore.Delete")
+				}
+			}
+			return nil
+		})
+	return goroutine.NewPeriodicGoroutine(ctx, 60*time.Minute, deleteExports)
+}
+
+func createDBWorkerStore(s *searchexports.Store) dbworkerstore.Store {
+	return dbworkerstore.New(s.Handle(), dbworkerstore.Options{
+		Name:              "search_exports_worker_store",
+		TableName:         "search_exports",
+		ColumnExpressions: searchexports.ExportColumns,
+		Scan:              searchexports.ScanExport,
+		StalledMaxAge:     60 * time.Second,
+		RetryAfter:        10 * time.Second,
+		MaxNumRetries:     3,
+		OrderByExpression: sqlf.Sprintf("id"),
+	})
+}
+
+type exportHandler struct {
+	store       *searchexports.Store
+	uploadStore uploadstore.Store
+	search      SearchFunc
+}
+
+func (h *exportHandler) Handle(ctx context.Context, workerStore dbworkerstore.Store, record workerutil.Record) (err error) {
+	defer func() {
+		if err != nil {
+			log15.Error("exportHandler.Handle", "error", err)
+		}
+	}()
+
+	export, ok := record.(*searchexports.Export)
+	if !ok {
+		return fmt.Errorf("type assertion failed")
+	}
+
+	// 🚨 SECURITY: The search runs as the user that created the export, so the
+	// file only contains results from repositories they have access to.
+	ctx = actor.WithActor(ctx, actor.FromUser(export.UserID))
+
+	rowCount := make(chan int, 1)
+	pr, pw := io.Pipe()
+	go func() {
+		n, err := writeRows(ctx, h.search, export, pw)
+		rowCount <- n
+		_ = pw.CloseWithError(err)
+	}()
+
+	key := searchexports.ObjectKey(export)
+	if _, err := h.uploadStore.Upload(ctx, key, pr); err != nil {
+		// Unblock the writer if the upload stopped reading early
+		_ = pr.CloseWithError(err)
+		return errors.Wrap(err, "uploadStore.Upload")
+	}
+
+	return h.store.SetExportResult(ctx, export.ID, key, <-rowCount)
+}
+
+// writeRows writes the rows of all results of the export's search query to the
+// given writer in the export's format. It returns the number of rows written.
+func writeRows(ctx context.Context, search SearchFunc, export *searchexports.Export, w io.Writer) (int, error) {
+	rowWriter, err := searchexports.NewRowWriter(w, export.Format)
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	var cursor *string
+	for {
+		matches, next, err := search(ctx, export.Query, export.PatternType, cursor)
+		if err != nil {
+			return n, err
+		}
+
+		for _, row := range searchexports.RowsFromMatches(matches) {
+			if n >= maxExportRows {
+				return n, fmt.Errorf("search export exceeds the maximum of %d rows, narrow down the query", maxExportRows)
+			}
+			if err := rowWriter.Write(row); err != nil {
+				return n, err
+			}
+			n++
+		}
+
+		if next == nil {
+			break
+		}
+		cursor = next
+	}
+
+	return n, rowWriter.Flush()
+}
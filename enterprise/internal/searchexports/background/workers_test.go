@@ -0,0 +1,61 @@
+package background
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/searchexports"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+func TestWriteRows(t *testing.T) {
+	pages := map[string][]result.Match{
+		"":      {&result.RepoMatch{Name: "github.com/a/b"}, &result.RepoMatch{Name: "github.com/c/d"}},
+		"page2": {&result.RepoMatch{Name: "github.com/e/f"}},
+	}
+	next := map[string]string{"": "page2"}
+
+	var cursors []string
+	search := func(ctx context.Context, query, patternType string, after *string) ([]result.Match, *string, error) {
+		if query != "repo:." || patternType != "regexp" {
+			t.Errorf("unexpected search arguments: %q %q", query, patternType)
+		}
+
+		cursor := ""
+		if after != nil {
+			cursor = *after
+		}
+		cursors = append(cursors, cursor)
+
+		if n, ok := next[cursor]; ok {
+			return pages[cursor], &n, nil
+		}
+		return pages[cursor], nil, nil
+	}
+
+	export := &searchexports.Export{Query: "repo:.", PatternType: "regexp", Format: searchexports.FormatCSV}
+
+	var buf bytes.Buffer
+	n, err := writeRows(context.Background(), search, export, &buf)
+	if err != nil {
+		t.Fatalf("unexpected error writing rows: %s", err)
+	}
+	if n != 3 {
+		t.Errorf("unexpected row count. want=%d have=%d", 3, n)
+	}
+
+	if diff := cmp.Diff([]string{"", "page2"}, cursors); diff != "" {
+		t.Errorf("unexpected cursors (-want +got):\n%s", diff)
+	}
+
+	expected := "type,repository,commit,path,line,preview\n" +
+		"repo,github.com/a/b,,,,\n" +
+		"repo,github.com/c/d,,,,\n" +
+		"repo,github.com/e/f,,,,\n"
+	if diff := cmp.Diff(expected, buf.String()); diff != "" {
+		t.Errorf("unexpected output (-want +got):\n%s", diff)
+	}
+}
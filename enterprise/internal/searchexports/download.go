@@ -0,0 +1,69 @@
+package searchexports
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/uploadstore"
+)
+
+// ObjectKey returns the blob store key of the file written by the given export.
+func ObjectKey(export *Export) string {
+	return fmt.Sprintf("search-exports/%d.%s", export.ID, export.Format)
+}
+
+var contentTypes = map[string]string{
+	FormatCSV:   "text/csv; charset=utf-8",
+	FormatJSONL: "application/x-ndjson",
+}
+
+// NewDownloadHandler returns a handler that serves the files written by
+// completed exports to the holders of a signed download link.
+func NewDownloadHandler(store *Store, uploadStore uploadstore.Store) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+		if err != nil {
+			http.Error(w, "invalid search export id", http.StatusBadRequest)
+			return
+		}
+
+		export, exists, err := store.GetExport(ctx, id)
+		if err != nil {
+			log15.Error("Failed to retrieve search export", "id", id, "error", err)
+			http.Error(w, "failed to retrieve search export", http.StatusInternalServerError)
+			return
+		}
+		if !exists || export.State != "completed" || export.ObjectKey == nil {
+			http.Error(w, "search export not found", http.StatusNotFound)
+			return
+		}
+
+		// 🚨 SECURITY: The signature grants access to the file, as the export
+		// ran with the permissions of the user that created the link.
+		if err := VerifyDownload(export, r.URL.Query(), store.Now()); err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+
+		rc, err := uploadStore.Get(ctx, *export.ObjectKey)
+		if err != nil {
+			log15.Error("Failed to retrieve search export file", "id", id, "error", err)
+			http.Error(w, "failed to retrieve search export file", http.StatusInternalServerError)
+			return
+		}
+		defer rc.Close()
+
+		w.Header().Set("Content-Type", contentTypes[export.Format])
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="search-export-%d.%s"`, export.ID, export.Format))
+		if _, err := io.Copy(w, rc); err != nil {
+			log15.Error("Failed to write search export file", "id", id, "error", err)
+		}
+	})
+}
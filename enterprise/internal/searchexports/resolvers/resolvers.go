@@ -0,0 +1,168 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/searchexports"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+)
+
+const searchExportKind = "SearchExport"
+
+// NewResolver returns a new Resolver backed by the given store.
+func NewResolver(store *searchexports.Store) graphqlbackend.SearchExportsResolver {
+	return &Resolver{store: store}
+}
+
+type Resolver struct {
+	store *searchexports.Store
+}
+
+func (r *Resolver) NodeResolvers() map[string]graphqlbackend.NodeByIDFunc {
+	return map[string]graphqlbackend.NodeByIDFunc{
+		searchExportKind: func(ctx context.Context, id graphql.ID) (graphqlbackend.Node, error) {
+			return r.searchExportByID(ctx, id)
+		},
+	}
+}
+
+func (r *Resolver) SearchExports(ctx context.Context, args *graphqlbackend.ListSearchExportsArgs) (graphqlbackend.SearchExportConnectionResolver, error) {
+	a := actor.FromContext(ctx)
+	if !a.IsAuthenticated() {
+		return nil, backend.ErrNotAuthenticated
+	}
+	if args.First < 0 {
+		return nil, fmt.Errorf("first must be non-negative")
+	}
+
+	return &searchExportConnectionResolver{store: r.store, userID: a.UID, first: args.First}, nil
+}
+
+func (r *Resolver) CreateSearchExport(ctx context.Context, args *graphqlbackend.CreateSearchExportArgs) (graphqlbackend.SearchExportResolver, error) {
+	a := actor.FromContext(ctx)
+	if !a.IsAuthenticated() {
+		return nil, backend.ErrNotAuthenticated
+	}
+
+	format := strings.ToLower(args.Format)
+	if !searchexports.ValidFormat(format) {
+		return nil, fmt.Errorf("unknown export format %q", args.Format)
+	}
+
+	var patternType string
+	if args.PatternType != nil {
+		patternType = *args.PatternType
+	}
+
+	export, err := r.store.CreateExport(ctx, a.UID, args.Query, patternType, format)
+	if err != nil {
+		return nil, err
+	}
+
+	return &searchExportResolver{store: r.store, export: export}, nil
+}
+
+func (r *Resolver) searchExportByID(ctx context.Context, id graphql.ID) (graphqlbackend.SearchExportResolver, error) {
+	var exportID int64
+	if err := relay.UnmarshalSpec(id, &exportID); err != nil {
+		return nil, err
+	}
+
+	export, exists, err := r.store.GetExport(ctx, exportID)
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	// 🚨 SECURITY: Only the owner of an export and site admins may view it.
+	if err := backend.CheckSiteAdminOrSameUser(ctx, r.store.Handle().DB(), export.UserID); err != nil {
+		return nil, err
+	}
+
+	return &searchExportResolver{store: r.store, export: export}, nil
+}
+
+type searchExportResolver struct {
+	store  *searchexports.Store
+	export *searchexports.Export
+}
+
+func (r *searchExportResolver) ID() graphql.ID {
+	return relay.MarshalID(searchExportKind, r.export.ID)
+}
+
+func (r *searchExportResolver) Query() string {
+	return r.export.Query
+}
+
+func (r *searchExportResolver) Format() string {
+	return strings.ToUpper(r.export.Format)
+}
+
+func (r *searchExportResolver) State() string {
+	return strings.ToUpper(r.export.State)
+}
+
+func (r *searchExportResolver) Failure() *string {
+	return r.export.FailureMessage
+}
+
+func (r *searchExportResolver) ResultCount() int32 {
+	return r.export.ResultCount
+}
+
+func (r *searchExportResolver) CreatedAt() graphqlbackend.DateTime {
+	return graphqlbackend.DateTime{Time: r.export.CreatedAt}
+}
+
+func (r *searchExportResolver) FinishedAt() *graphqlbackend.DateTime {
+	return graphqlbackend.DateTimeOrNil(r.export.FinishedAt)
+}
+
+func (r *searchExportResolver) DownloadURL(ctx context.Context) (*string, error) {
+	if r.export.State != "completed" || r.export.ObjectKey == nil {
+		return nil, nil
+	}
+
+	url := strings.TrimSuffix(conf.ExternalURL(), "/") + searchexports.DownloadURL(r.export, r.store.Now().Add(searchexports.DownloadURLTTL))
+	return &url, nil
+}
+
+type searchExportConnectionResolver struct {
+	store  *searchexports.Store
+	userID int32
+	first  int32
+}
+
+func (r *searchExportConnectionResolver) Nodes(ctx context.Context) ([]graphqlbackend.SearchExportResolver, error) {
+	exports, err := r.store.ListExports(ctx, r.userID, int(r.first))
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]graphqlbackend.SearchExportResolver, 0, len(exports))
+	for _, export := range exports {
+		resolvers = append(resolvers, &searchExportResolver{store: r.store, export: export})
+	}
+	return resolvers, nil
+}
+
+func (r *searchExportConnectionResolver) TotalCount(ctx context.Context) (int32, error) {
+	return r.store.CountExports(ctx, r.userID)
+}
+
+func (r *searchExportConnectionResolver) PageInfo(ctx context.Context) (*graphqlutil.PageInfo, error) {
+	totalCount, err := r.TotalCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return graphqlutil.HasNextPage(totalCount > r.first), nil
+}
@@ -0,0 +1,166 @@
+package searchexports
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+// The formats of exported files.
+const (
+	FormatCSV   = "csv"
+	FormatJSONL = "jsonl"
+)
+
+// ValidFormat returns true if the given format is a known format.
+func ValidFormat(format string) bool {
+	return format == FormatCSV || format == FormatJSONL
+}
+
+// The types of exported records.
+const (
+	RowTypeContent = "content"
+	RowTypePath    = "path"
+	RowTypeSymbol  = "symbol"
+	RowTypeCommit  = "commit"
+	RowTypeDiff    = "diff"
+	RowTypeRepo    = "repo"
+)
+
+// Row is a record of an exported file. Each matched line of a file is exported
+// as its own record.
+type Row struct {
+	Type       string `json:"type"`
+	Repository string `json:"repository"`
+	Commit     string `json:"commit"`
+	Path       string `json:"path"`
+
+	// Line is the 1-based line number of the match, or zero if the match is
+	// not on a line of a file.
+	Line int `json:"line"`
+
+	// Preview is the matched line, the matched symbol, or the subject of the
+	// matched commit.
+	Preview string `json:"preview"`
+}
+
+// RowsFromMatches converts the given search results into records.
+func RowsFromMatches(matches []result.Match) []Row {
+	var rows []Row
+	for _, match := range matches {
+		switch m := match.(type) {
+		case *result.FileMatch:
+			file := Row{
+				Repository: string(m.Repo.Name),
+				Commit:     string(m.CommitID),
+				Path:       m.Path,
+			}
+
+			for _, lineMatch := range m.LineMatches {
+				row := file
+				row.Type = RowTypeContent
+				row.Line = int(lineMatch.LineNumber) + 1
+				row.Preview = lineMatch.Preview
+				rows = append(rows, row)
+			}
+			for _, symbolMatch := range m.Symbols {
+				row := file
+				row.Type = RowTypeSymbol
+				row.Line = symbolMatch.Symbol.Line
+				row.Preview = symbolMatch.Symbol.Name
+				rows = append(rows, row)
+			}
+			if len(m.LineMatches) == 0 && len(m.Symbols) == 0 {
+				row := file
+				row.Type = RowTypePath
+				rows = append(rows, row)
+			}
+
+		case *result.CommitMatch:
+			rowType := RowTypeCommit
+			if m.DiffPreview != nil {
+				rowType = RowTypeDiff
+			}
+			rows = append(rows, Row{
+				Type:       rowType,
+				Repository: string(m.RepoName.Name),
+				Commit:     string(m.Commit.ID),
+				Preview:    m.Commit.Message.Subject(),
+			})
+
+		case *result.RepoMatch:
+			rows = append(rows, Row{
+				Type:       RowTypeRepo,
+				Repository: string(m.Name),
+			})
+		}
+	}
+
+	return rows
+}
+
+// RowWriter writes records to an exported file.
+type RowWriter interface {
+	// Write writes the given record.
+	Write(row Row) error
+
+	// Flush writes any buffered data to the underlying writer.
+	Flush() error
+}
+
+// NewRowWriter returns a writer of records in the given format.
+func NewRowWriter(w io.Writer, format string) (RowWriter, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVRowWriter(w)
+	case FormatJSONL:
+		return &jsonlRowWriter{encoder: json.NewEncoder(w)}, nil
+	}
+
+	return nil, fmt.Errorf("unknown export format %q", format)
+}
+
+var csvHeader = []string{"type", "repository", "commit", "path", "line", "preview"}
+
+type csvRowWriter struct {
+	writer *csv.Writer
+}
+
+func newCSVRowWriter(w io.Writer) (*csvRowWriter, error) {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return nil, err
+	}
+
+	return &csvRowWriter{writer: writer}, nil
+}
+
+func (w *csvRowWriter) Write(row Row) error {
+	line := ""
+	if row.Line != 0 {
+		line = strconv.Itoa(row.Line)
+	}
+
+	return w.writer.Write([]string{row.Type, row.Repository, row.Commit, row.Path, line, row.Preview})
+}
+
+func (w *csvRowWriter) Flush() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+type jsonlRowWriter struct {
+	encoder *json.Encoder
+}
+
+func (w *jsonlRowWriter) Write(row Row) error {
+	return w.encoder.Encode(row)
+}
+
+func (w *jsonlRowWriter) Flush() error {
+	return nil
+}
@@ -0,0 +1,87 @@
+package searchexports
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestRowsFromMatches(t *testing.T) {
+	matches := []result.Match{
+		&result.FileMatch{
+			File: result.File{Repo: types.RepoName{Name: "github.com/a/b"}, CommitID: "deadbeef", Path: "main.go"},
+			LineMatches: []*result.LineMatch{
+				{Preview: "func main() {", LineNumber: 4},
+				{Preview: "\tmain()", LineNumber: 9},
+			},
+		},
+		&result.FileMatch{
+			File: result.File{Repo: types.RepoName{Name: "github.com/a/b"}, CommitID: "deadbeef", Path: "README.md"},
+		},
+		&result.RepoMatch{Name: "github.com/c/d"},
+	}
+
+	expected := []Row{
+		{Type: RowTypeContent, Repository: "github.com/a/b", Commit: "deadbeef", Path: "main.go", Line: 5, Preview: "func main() {"},
+		{Type: RowTypeContent, Repository: "github.com/a/b", Commit: "deadbeef", Path: "main.go", Line: 10, Preview: "\tmain()"},
+		{Type: RowTypePath, Repository: "github.com/a/b", Commit: "deadbeef", Path: "README.md"},
+		{Type: RowTypeRepo, Repository: "github.com/c/d"},
+	}
+	if diff := cmp.Diff(expected, RowsFromMatches(matches)); diff != "" {
+		t.Errorf("unexpected rows (-want +got):\n%s", diff)
+	}
+}
+
+func TestRowWriter(t *testing.T) {
+	rows := []Row{
+		{Type: RowTypeContent, Repository: "github.com/a/b", Commit: "deadbeef", Path: "main.go", Line: 5, Preview: `fmt.Println("a, b")`},
+		{Type: RowTypeRepo, Repository: "github.com/c/d"},
+	}
+
+	testCases := []struct {
+		format   string
+		expected string
+	}{
+		{
+			format: FormatCSV,
+			expected: "type,repository,commit,path,line,preview\n" +
+				"content,github.com/a/b,deadbeef,main.go,5,\"fmt.Println(\"\"a, b\"\")\"\n" +
+				"repo,github.com/c/d,,,,\n",
+		},
+		{
+			format: FormatJSONL,
+			expected: `{"type":"content","repository":"github.com/a/b","commit":"deadbeef","path":"main.go","line":5,"preview":"fmt.Println(\"a, b\")"}` + "\n" +
+				`{"type":"repo","repository":"github.com/c/d","commit":"","path":"","line":0,"preview":""}` + "\n",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.format, func(t *testing.T) {
+			var buf bytes.Buffer
+			w, err := NewRowWriter(&buf, testCase.format)
+			if err != nil {
+				t.Fatalf("unexpected error creating writer: %s", err)
+			}
+			for _, row := range rows {
+				if err := w.Write(row); err != nil {
+					t.Fatalf("unexpected error writing row: %s", err)
+				}
+			}
+			if err := w.Flush(); err != nil {
+				t.Fatalf("unexpected error flushing writer: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.expected, buf.String()); diff != "" {
+				t.Errorf("unexpected output (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	if _, err := NewRowWriter(&bytes.Buffer{}, "xml"); err == nil {
+		t.Errorf("expected error for unknown format")
+	}
+}
@@ -0,0 +1,53 @@
+package searchexports
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// DownloadURLTTL is the duration for which a signed download link is valid.
+const DownloadURLTTL = time.Hour
+
+// ErrInvalidSignature occurs when a download link is not signed by the
+// export's key, or has expired.
+var ErrInvalidSignature = errors.New("invalid or expired download link")
+
+// DownloadURL returns a link to download the file written by the given export,
+// signed with the export's key and valid until the given expiry. The link is
+// relative to the external URL of the instance.
+func DownloadURL(export *Export, expires time.Time) string {
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expires.Unix(), 10))
+	query.Set("signature", sign(export, expires.Unix()))
+
+	return fmt.Sprintf("/.api/search-exports/%d/download?%s", export.ID, query.Encode())
+}
+
+// VerifyDownload returns ErrInvalidSignature if the given query parameters of
+// a download link are not a valid signature for the given export at the given
+// time.
+func VerifyDownload(export *Export, query url.Values, now time.Time) error {
+	expires, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	if err != nil || now.Unix() > expires {
+		return ErrInvalidSignature
+	}
+
+	if !hmac.Equal([]byte(query.Get("signature")), []byte(sign(export, expires))) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+func sign(export *Export, expires int64) string {
+	mac := hmac.New(sha256.New, []byte(export.DownloadSecret))
+	_, _ = fmt.Fprintf(mac, "%d:%d", export.ID, expires)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,40 @@
+package searchexports
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestVerifyDownload(t *testing.T) {
+	now := time.Unix(1625000000, 0)
+	export := &Export{ID: 42, DownloadSecret: "secret"}
+
+	link, err := url.Parse(DownloadURL(export, now.Add(DownloadURLTTL)))
+	if err != nil {
+		t.Fatalf("unexpected error parsing download URL: %s", err)
+	}
+	if link.Path != "/.api/search-exports/42/download" {
+		t.Errorf("unexpected path: %s", link.Path)
+	}
+	query := link.Query()
+
+	if err := VerifyDownload(export, query, now); err != nil {
+		t.Errorf("unexpected error verifying download: %s", err)
+	}
+	if err := VerifyDownload(export, query, now.Add(2*DownloadURLTTL)); err != ErrInvalidSignature {
+		t.Errorf("unexpected error for expired link. want=%q have=%q", ErrInvalidSignature, err)
+	}
+	if err := VerifyDownload(&Export{ID: 42, DownloadSecret: "other"}, query, now); err != ErrInvalidSignature {
+		t.Errorf("unexpected error for other secret. want=%q have=%q", ErrInvalidSignature, err)
+	}
+	if err := VerifyDownload(&Export{ID: 43, DownloadSecret: "secret"}, query, now); err != ErrInvalidSignature {
+		t.Errorf("unexpected error for other export. want=%q have=%q", ErrInvalidSignature, err)
+	}
+
+	// Extending the expiry invalidates the signature
+	query.Set("expires", "9999999999")
+	if err := VerifyDownload(export, query, now); err != ErrInvalidSignature {
+		t.Errorf("unexpected error for tampered link. want=%q have=%q", ErrInvalidSignature, err)
+	}
+}
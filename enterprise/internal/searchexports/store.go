@@ -0,0 +1,240 @@
+package searchexports
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/randstring"
+	"github.com/sourcegraph/sourcegraph/internal/timeutil"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil"
+)
+
+// Export is a job exporting all results of a search query to a file in the
+// blob store.
+type Export struct {
+	ID             int64
+	UserID         int32
+	Query          string
+	PatternType    string
+	Format         string
+	DownloadSecret string
+	State          string
+	FailureMessage *string
+	StartedAt      *time.Time
+	FinishedAt     *time.Time
+	ProcessAfter   *time.Time
+	NumResets      int32
+	NumFailures    int32
+	ResultCount    int32
+	ObjectKey      *string
+	CreatedAt      time.Time
+}
+
+// RecordID implements workerutil.Record.
+func (e *Export) RecordID() int {
+	return int(e.ID)
+}
+
+// Store exposes methods to read and write search exports from persistent
+// storage.
+type Store struct {
+	*basestore.Store
+	now func() time.Time
+}
+
+// NewStore returns a new Store backed by the given database.
+func NewStore(db dbutil.DB) *Store {
+	return NewStoreWithClock(db, timeutil.Now)
+}
+
+// NewStoreWithClock returns a new Store backed by the given database and
+// clock for timestamps.
+func NewStoreWithClock(db dbutil.DB, clock func() time.Time) *Store {
+	return &Store{Store: basestore.NewWithDB(db, sql.TxOptions{}), now: clock}
+}
+
+// Now returns the current time of the store's clock.
+func (s *Store) Now() time.Time {
+	return s.now()
+}
+
+// ExportColumns are the columns scanned by ScanExport, in order.
+var ExportColumns = []*sqlf.Query{
+	sqlf.Sprintf("search_exports.id"),
+	sqlf.Sprintf("search_exports.user_id"),
+	sqlf.Sprintf("search_exports.query"),
+	sqlf.Sprintf("search_exports.pattern_type"),
+	sqlf.Sprintf("search_exports.format"),
+	sqlf.Sprintf("search_exports.download_secret"),
+	sqlf.Sprintf("search_exports.state"),
+	sqlf.Sprintf("search_exports.failure_message"),
+	sqlf.Sprintf("search_exports.started_at"),
+	sqlf.Sprintf("search_exports.finished_at"),
+	sqlf.Sprintf("search_exports.process_after"),
+	sqlf.Sprintf("search_exports.num_resets"),
+	sqlf.Sprintf("search_exports.num_failures"),
+	sqlf.Sprintf("search_exports.result_count"),
+	sqlf.Sprintf("search_exports.object_key"),
+	sqlf.Sprintf("search_exports.created_at"),
+}
+
+// ScanExport scans a single export from the return value of `*Store.Query`.
+// It is used as the record scanner of the dbworker store.
+func ScanExport(rows *sql.Rows, err error) (_ workerutil.Record, exists bool, _ error) {
+	exports, err := scanExports(rows, err)
+	if err != nil || len(exports) == 0 {
+		return nil, false, err
+	}
+	return exports[0], true, nil
+}
+
+func scanExports(rows *sql.Rows, queryErr error) (_ []*Export, err error) {
+	if queryErr != nil {
+		return nil, queryErr
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var exports []*Export
+	for rows.Next() {
+		var e Export
+		if err := rows.Scan(
+			&e.ID,
+			&e.UserID,
+			&e.Query,
+			&e.PatternType,
+			&e.Format,
+			&e.DownloadSecret,
+			&e.State,
+			&e.FailureMessage,
+			&e.StartedAt,
+			&e.FinishedAt,
+			&e.ProcessAfter,
+			&e.NumResets,
+			&e.NumFailures,
+			&e.ResultCount,
+			&e.ObjectKey,
+			&e.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		exports = append(exports, &e)
+	}
+
+	return exports, nil
+}
+
+const createExportFmtStr = `
+-- source: enterprise/internal/searchexports/store.go:CreateExport
+INSERT INTO search_exports (user_id, query, pattern_type, format, download_secret, created_at)
+VALUES (%s, %s, %s, %s, %s, %s)
+RETURNING %s
+`
+
+// downloadSecretLength is the length of the keys signing download links.
+const downloadSecretLength = 32
+
+// CreateExport queues a new export of the results of the given query for the
+// given user.
+func (s *Store) CreateExport(ctx context.Context, userID int32, query, patternType, format string) (*Export, error) {
+	exports, err := scanExports(s.Query(ctx, sqlf.Sprintf(
+		createExportFmtStr,
+		userID,
+		query,
+		patternType,
+		format,
+		randstring.NewLen(downloadSecretLength),
+		s.now(),
+		sqlf.Join(ExportColumns, ", "),
+	)))
+	if err != nil {
+		return nil, err
+	}
+	return exports[0], nil
+}
+
+const getExportFmtStr = `
+-- source: enterprise/internal/searchexports/store.go:GetExport
+SELECT %s FROM search_exports WHERE id = %s
+`
+
+// GetExport returns the export with the given identifier, and a false flag if
+// there is no such export.
+func (s *Store) GetExport(ctx context.Context, id int64) (*Export, bool, error) {
+	exports, err := scanExports(s.Query(ctx, sqlf.Sprintf(getExportFmtStr, sqlf.Join(ExportColumns, ", "), id)))
+	if err != nil || len(exports) == 0 {
+		return nil, false, err
+	}
+	return exports[0], true, nil
+}
+
+const listExportsFmtStr = `
+-- source: enterprise/internal/searchexports/store.go:ListExports
+SELECT %s FROM search_exports
+WHERE user_id = %s
+ORDER BY created_at DESC, id DESC
+LIMIT %s
+`
+
+// ListExports returns the most recent exports of the given user, up to the
+// given limit.
+func (s *Store) ListExports(ctx context.Context, userID int32, limit int) ([]*Export, error) {
+	return scanExports(s.Query(ctx, sqlf.Sprintf(listExportsFmtStr, sqlf.Join(ExportColumns, ", "), userID, limit)))
+}
+
+const countExportsFmtStr = `
+-- source: enterprise/internal/searchexports/store.go:CountExports
+SELECT COUNT(*) FROM search_exports WHERE user_id = %s
+`
+
+// CountExports returns the number of exports of the given user.
+func (s *Store) CountExports(ctx context.Context, userID int32) (int32, error) {
+	count, _, err := basestore.ScanFirstInt(s.Query(ctx, sqlf.Sprintf(countExportsFmtStr, userID)))
+	return int32(count), err
+}
+
+const setExportResultFmtStr = `
+-- source: enterprise/internal/searchexports/store.go:SetExportResult
+UPDATE search_exports SET object_key = %s, result_count = %s WHERE id = %s
+`
+
+// SetExportResult records the blob store key of the file written by the given
+// export, and the number of records it holds.
+func (s *Store) SetExportResult(ctx context.Context, id int64, objectKey string, resultCount int) error {
+	return s.Exec(ctx, sqlf.Sprintf(setExportResultFmtStr, objectKey, resultCount, id))
+}
+
+const deleteExportsFinishedBeforeFmtStr = `
+-- source: enterprise/internal/searchexports/store.go:DeleteExportsFinishedBefore
+DELETE FROM search_exports
+WHERE state IN ('completed', 'failed') AND finished_at < %s
+RETURNING object_key
+`
+
+// DeleteExportsFinishedBefore deletes the completed and failed exports that
+// finished before the given time. It returns the blob store keys of the files
+// written by the deleted exports.
+func (s *Store) DeleteExportsFinishedBefore(ctx context.Context, t time.Time) (_ []string, err error) {
+	rows, err := s.Query(ctx, sqlf.Sprintf(deleteExportsFinishedBeforeFmtStr, t))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var objectKeys []string
+	for rows.Next() {
+		var objectKey *string
+		if err := rows.Scan(&objectKey); err != nil {
+			return nil, err
+		}
+		if objectKey != nil {
+			objectKeys = append(objectKeys, *objectKey)
+		}
+	}
+
+	return objectKeys, nil
+}
@@ -168,6 +168,46 @@ func (c *internalClient) SavedQueriesDeleteInfo(ctx context.Context, query strin
 	return c.postInternal(ctx, "saved-queries/delete-info", query, nil)
 }
 
+// SavedQueryMatch represents a line matched by a saved query.
+type SavedQueryMatch struct {
+	// Repository is the name of the repository of the match.
+	Repository string
+
+	// Commit is the commit of the match. It is empty for matches of file
+	// results.
+	Commit string
+
+	// Path is the path of the matched file. It is empty for matches of commit
+	// messages.
+	Path string
+
+	// LineNumber is the 1-based line number of the match in the file at the
+	// commit, or in the commit message.
+	LineNumber int
+
+	// Preview is the content of the matched line.
+	Preview string
+}
+
+// SavedQueryMatches represents the matches found by an execution of a saved
+// query.
+type SavedQueryMatches struct {
+	Query   string
+	Matches []*SavedQueryMatch
+}
+
+// SavedQueriesReplaceMatches replaces the matches stored in the DB for the
+// given query with the given matches, and returns the matches that were not
+// stored before (i.e., the new matches since the query's last execution).
+func (c *internalClient) SavedQueriesReplaceMatches(ctx context.Context, matches *SavedQueryMatches) ([]*SavedQueryMatch, error) {
+	var result []*SavedQueryMatch
+	err := c.postInternal(ctx, "saved-queries/replace-matches", matches, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *internalClient) SettingsGetForSubject(ctx context.Context, subject SettingsSubject) (parsed *schema.Settings, settings *Settings, err error) {
 	err = c.postInternal(ctx, "settings/get-for-subject", subject, &settings)
 	if err == nil {
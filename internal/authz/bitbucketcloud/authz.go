@@ -0,0 +1,76 @@
+package bitbucketcloud
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// NewAuthzProviders returns the set of Bitbucket Cloud authz providers derived from the connections.
+// It also returns any validation problems with the config, separating these into "serious problems" and
+// "warnings". "Serious problems" are those that should make Sourcegraph set authz.allowAccessByDefault
+// to false. "Warnings" are all other validation problems.
+func NewAuthzProviders(
+	conns []*types.BitbucketCloudConnection,
+) (ps []authz.Provider, problems []string, warnings []string) {
+	for _, c := range conns {
+		p, err := newAuthzProvider(c)
+		if err != nil {
+			problems = append(problems, err.Error())
+		} else if p != nil {
+			ps = append(ps, p)
+		}
+	}
+
+	for _, p := range ps {
+		for _, problem := range p.Validate() {
+			warnings = append(warnings, fmt.Sprintf("BitbucketCloud config for %s was invalid: %s", p.ServiceID(), problem))
+		}
+	}
+
+	return ps, problems, warnings
+}
+
+func newAuthzProvider(c *types.BitbucketCloudConnection) (*Provider, error) {
+	if c.Authorization == nil {
+		return nil, nil
+	}
+
+	if len(c.Teams) == 0 {
+		return nil, errors.New(`"authorization" requires at least one workspace in "teams"`)
+	}
+
+	baseURL, err := url.Parse(c.Url)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse url")
+	}
+
+	apiURL := c.ApiURL
+	if apiURL == "" {
+		apiURL = "https://api.bitbucket.org"
+	}
+	u, err := url.Parse(apiURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse apiURL")
+	}
+
+	cli := bitbucketcloud.NewClient(extsvc.NormalizeBaseURL(u), nil)
+	cli.Username = c.Username
+	cli.AppPassword = c.AppPassword
+
+	return NewProvider(cli, c.URN, baseURL, c.Teams), nil
+}
+
+// ValidateAuthz validates the authorization fields of the given BitbucketCloud external
+// service config.
+func ValidateAuthz(c *schema.BitbucketCloudConnection) error {
+	_, err := newAuthzProvider(&types.BitbucketCloudConnection{BitbucketCloudConnection: c})
+	return err
+}
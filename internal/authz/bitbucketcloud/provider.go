@@ -0,0 +1,217 @@
+// Package bitbucketcloud contains an authorization provider for Bitbucket Cloud.
+package bitbucketcloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	otlog "github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// Provider is an implementation of AuthzProvider that provides repository permissions as
+// determined from the Bitbucket Cloud API.
+type Provider struct {
+	urn        string
+	client     *bitbucketcloud.Client
+	codeHost   *extsvc.CodeHost
+	workspaces []string
+	pageSize   int // Page size to use in paginated requests.
+}
+
+var _ authz.Provider = (*Provider)(nil)
+
+// NewProvider returns a new Bitbucket Cloud authorization provider that uses the given
+// bitbucketcloud.Client to read permissions of the given workspaces. The client must be
+// authenticated as an administrator of every workspace. The baseURL is the URL of
+// Bitbucket Cloud (not of its API), which is what repositories are keyed by. It assumes
+// usernames of Sourcegraph accounts match 1-1 with nicknames of workspace members.
+func NewProvider(cli *bitbucketcloud.Client, urn string, baseURL *url.URL, workspaces []string) *Provider {
+	return &Provider{
+		urn:        urn,
+		client:     cli,
+		codeHost:   extsvc.NewCodeHost(baseURL, extsvc.TypeBitbucketCloud),
+		workspaces: workspaces,
+		pageSize:   100,
+	}
+}
+
+// Validate validates that the Provider can read the repository permissions of all its
+// workspaces with the credentials it was configured with.
+func (p *Provider) Validate() (problems []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for _, ws := range p.workspaces {
+		if _, _, err := p.client.RepoPermissions(ctx, &bitbucketcloud.PageToken{Pagelen: 1}, ws, ""); err != nil {
+			problems = append(problems, err.Error())
+		}
+	}
+
+	return problems
+}
+
+func (p *Provider) URN() string {
+	return p.urn
+}
+
+// ServiceID returns the absolute URL that identifies the Bitbucket Cloud instance
+// this provider is configured with.
+func (p *Provider) ServiceID() string { return p.codeHost.ServiceID }
+
+// ServiceType returns the type of this Provider, namely, "bitbucketCloud".
+func (p *Provider) ServiceType() string { return p.codeHost.ServiceType }
+
+// FetchAccount satisfies the authz.Provider interface. It returns the member of any of
+// the provider's workspaces whose nickname is the username of the given user, or nil
+// if there is none.
+func (p *Provider) FetchAccount(ctx context.Context, user *types.User, _ []*extsvc.Account, _ []string) (acct *extsvc.Account, err error) {
+	if user == nil {
+		return nil, nil
+	}
+
+	tr, ctx := trace.New(ctx, "bitbucketcloud.authz.provider.FetchAccount", "")
+	defer func() {
+		tr.LogFields(
+			otlog.String("user.name", user.Username),
+			otlog.Int32("user.id", user.ID),
+		)
+
+		if err != nil {
+			tr.SetError(err)
+		}
+
+		tr.Finish()
+	}()
+
+	bitbucketUser, err := p.member(ctx, user.Username)
+	if err != nil || bitbucketUser == nil {
+		return nil, err
+	}
+
+	accountData, err := json.Marshal(bitbucketUser)
+	if err != nil {
+		return nil, err
+	}
+
+	return &extsvc.Account{
+		UserID: user.ID,
+		AccountSpec: extsvc.AccountSpec{
+			ServiceType: p.codeHost.ServiceType,
+			ServiceID:   p.codeHost.ServiceID,
+			AccountID:   bitbucketUser.UUID,
+		},
+		AccountData: extsvc.AccountData{
+			Data: (*json.RawMessage)(&accountData),
+		},
+	}, nil
+}
+
+// FetchUserPerms returns a list of repository UUIDs that the given account has at
+// least read access to in the provider's workspaces. The repository UUID has the same
+// value as it would be used as api.ExternalRepoSpec.ID.
+//
+// This method may return partial but valid results in case of error, and it is up to
+// callers to decide whether to discard.
+func (p *Provider) FetchUserPerms(ctx context.Context, account *extsvc.Account) (*authz.ExternalUserPermissions, error) {
+	switch {
+	case account == nil:
+		return nil, errors.New("no account provided")
+	case !extsvc.IsHostOfAccount(p.codeHost, account):
+		return nil, fmt.Errorf("not a code host of the account: want %q but have %q",
+			p.codeHost.ServiceID, account.AccountSpec.ServiceID)
+	}
+
+	perms, err := p.repoPermissions(ctx, "user.uuid="+strconv.Quote(account.AccountID))
+
+	extIDs := make([]extsvc.RepoID, 0, len(perms))
+	for _, perm := range perms {
+		if perm.Repo != nil {
+			extIDs = append(extIDs, extsvc.RepoID(perm.Repo.UUID))
+		}
+	}
+
+	return &authz.ExternalUserPermissions{
+		Exacts: extIDs,
+	}, err
+}
+
+// FetchRepoPerms returns a list of user UUIDs who have at least read access to the given
+// repo, either directly or through a group. The user UUID has the same value as it would
+// be used as extsvc.Account.AccountID.
+//
+// This method may return partial but valid results in case of error, and it is up to
+// callers to decide whether to discard.
+func (p *Provider) FetchRepoPerms(ctx context.Context, repo *extsvc.Repository) ([]extsvc.AccountID, error) {
+	switch {
+	case repo == nil:
+		return nil, errors.New("no repo provided")
+	case !extsvc.IsHostOfRepo(p.codeHost, &repo.ExternalRepoSpec):
+		return nil, fmt.Errorf("not a code host of the repo: want %q but have %q",
+			p.codeHost.ServiceID, repo.ServiceID)
+	}
+
+	perms, err := p.repoPermissions(ctx, "repository.uuid="+strconv.Quote(repo.ID))
+
+	extIDs := make([]extsvc.AccountID, 0, len(perms))
+	for _, perm := range perms {
+		if perm.User != nil {
+			extIDs = append(extIDs, extsvc.AccountID(perm.User.UUID))
+		}
+	}
+
+	return extIDs, err
+}
+
+// repoPermissions returns the repository permissions matching the query q across all
+// workspaces of the provider. It returns the permissions fetched so far on error.
+func (p *Provider) repoPermissions(ctx context.Context, q string) (perms []*bitbucketcloud.RepoPermission, err error) {
+	for _, ws := range p.workspaces {
+		t := &bitbucketcloud.PageToken{Pagelen: p.pageSize}
+		for first := true; first || t.HasMore(); first = false {
+			page, next, err := p.client.RepoPermissions(ctx, t, ws, q)
+			if err != nil {
+				return perms, err
+			}
+
+			perms = append(perms, page...)
+			t = next
+		}
+	}
+
+	return perms, nil
+}
+
+// member returns the member of any of the provider's workspaces whose nickname is the
+// given username, or nil if there is none.
+func (p *Provider) member(ctx context.Context, username string) (*bitbucketcloud.User, error) {
+	for _, ws := range p.workspaces {
+		t := &bitbucketcloud.PageToken{Pagelen: p.pageSize}
+		for first := true; first || t.HasMore(); first = false {
+			users, next, err := p.client.WorkspaceMembers(ctx, t, ws)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, u := range users {
+				if u.Nickname == username {
+					return u, nil
+				}
+			}
+
+			t = next
+		}
+	}
+
+	return nil, nil
+}
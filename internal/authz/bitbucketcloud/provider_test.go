@@ -0,0 +1,202 @@
+package bitbucketcloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+const (
+	aliceUUID = "{d7d3ad3c-4a0c-4b1a-9c3e-2a3c1a3e6a01}"
+	bobUUID   = "{d7d3ad3c-4a0c-4b1a-9c3e-2a3c1a3e6a02}"
+	muxUUID   = "{e1e75436-05e6-4c38-8543-9c36ec26fad1}"
+	langUUID  = "{421b93e9-1f00-4054-8156-4d821d4a768b}"
+)
+
+// newTestProvider returns a Provider for the workspace "sglocal" backed by a fake
+// Bitbucket Cloud API. The members endpoint is served in two pages to exercise
+// pagination, and every request's "q" parameter is recorded in queries.
+func newTestProvider(t *testing.T) (p *Provider, queries *[]string) {
+	t.Helper()
+
+	alice := map[string]string{"uuid": aliceUUID, "nickname": "alice"}
+	bob := map[string]string{"uuid": bobUUID, "nickname": "bob"}
+	mux := map[string]string{"uuid": muxUUID, "full_name": "sglocal/mux"}
+	lang := map[string]string{"uuid": langUUID, "full_name": "sglocal/python-langserver"}
+
+	perms := []map[string]interface{}{
+		{"permission": "admin", "user": alice, "repository": mux},
+		{"permission": "read", "user": alice, "repository": lang},
+		{"permission": "write", "user": bob, "repository": mux},
+	}
+
+	queries = new([]string)
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var resp map[string]interface{}
+		switch r.URL.Path {
+		case "/2.0/workspaces/sglocal/members":
+			if r.URL.Query().Get("page") == "2" {
+				resp = map[string]interface{}{"values": []interface{}{map[string]interface{}{"user": bob}}}
+			} else {
+				resp = map[string]interface{}{
+					"values": []interface{}{map[string]interface{}{"user": alice}},
+					"next":   srv.URL + "/2.0/workspaces/sglocal/members?page=2",
+				}
+			}
+		case "/2.0/workspaces/sglocal/permissions/repositories":
+			q := r.URL.Query().Get("q")
+			*queries = append(*queries, q)
+
+			var values []interface{}
+			for _, perm := range perms {
+				user := perm["user"].(map[string]string)
+				repo := perm["repository"].(map[string]string)
+				if q == "" || q == `user.uuid="`+user["uuid"]+`"` || q == `repository.uuid="`+repo["uuid"]+`"` {
+					values = append(values, perm)
+				}
+			}
+			resp = map[string]interface{}{"values": values}
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(srv.Close)
+
+	apiURL, _ := url.Parse(srv.URL)
+	cli := bitbucketcloud.NewClient(apiURL, http.DefaultClient)
+	baseURL, _ := url.Parse("https://bitbucket.org")
+	return NewProvider(cli, "extsvc:bitbucketcloud:1", baseURL, []string{"sglocal"}), queries
+}
+
+func TestProvider_FetchAccount(t *testing.T) {
+	p, _ := newTestProvider(t)
+
+	for _, tc := range []struct {
+		name string
+		user *types.User
+		want string
+	}{
+		{name: "no user"},
+		{name: "first page", user: &types.User{ID: 1, Username: "alice"}, want: aliceUUID},
+		{name: "second page", user: &types.User{ID: 2, Username: "bob"}, want: bobUUID},
+		{name: "not a member", user: &types.User{ID: 3, Username: "mallory"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			acct, err := p.FetchAccount(context.Background(), tc.user, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tc.want == "" {
+				if acct != nil {
+					t.Fatalf("want no account, have %+v", acct)
+				}
+				return
+			}
+
+			want := extsvc.AccountSpec{
+				ServiceType: extsvc.TypeBitbucketCloud,
+				ServiceID:   "https://bitbucket.org/",
+				AccountID:   tc.want,
+			}
+			if diff := cmp.Diff(want, acct.AccountSpec); diff != "" {
+				t.Fatal(diff)
+			}
+			if acct.UserID != tc.user.ID {
+				t.Fatalf("UserID: have %d, want %d", acct.UserID, tc.user.ID)
+			}
+		})
+	}
+}
+
+func TestProvider_FetchUserPerms(t *testing.T) {
+	p, queries := newTestProvider(t)
+
+	if _, err := p.FetchUserPerms(context.Background(), &extsvc.Account{
+		AccountSpec: extsvc.AccountSpec{
+			ServiceType: extsvc.TypeBitbucketCloud,
+			ServiceID:   "https://bitbucket.example.com/",
+			AccountID:   aliceUUID,
+		},
+	}); err == nil {
+		t.Fatal("want error for account of another code host")
+	}
+
+	have, err := p.FetchUserPerms(context.Background(), &extsvc.Account{
+		AccountSpec: extsvc.AccountSpec{
+			ServiceType: extsvc.TypeBitbucketCloud,
+			ServiceID:   "https://bitbucket.org/",
+			AccountID:   aliceUUID,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &authz.ExternalUserPermissions{
+		Exacts: []extsvc.RepoID{muxUUID, langUUID},
+	}
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Fatal(diff)
+	}
+
+	if diff := cmp.Diff([]string{`user.uuid="` + aliceUUID + `"`}, *queries); diff != "" {
+		t.Fatalf("queries: %s", diff)
+	}
+}
+
+func TestProvider_FetchRepoPerms(t *testing.T) {
+	p, _ := newTestProvider(t)
+
+	if _, err := p.FetchRepoPerms(context.Background(), &extsvc.Repository{
+		ExternalRepoSpec: api.ExternalRepoSpec{
+			ID:          muxUUID,
+			ServiceType: extsvc.TypeBitbucketCloud,
+			ServiceID:   "https://bitbucket.example.com/",
+		},
+	}); err == nil {
+		t.Fatal("want error for repo of another code host")
+	}
+
+	have, err := p.FetchRepoPerms(context.Background(), &extsvc.Repository{
+		ExternalRepoSpec: api.ExternalRepoSpec{
+			ID:          muxUUID,
+			ServiceType: extsvc.TypeBitbucketCloud,
+			ServiceID:   "https://bitbucket.org/",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []extsvc.AccountID{aliceUUID, bobUUID}
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestProvider_Validate(t *testing.T) {
+	p, _ := newTestProvider(t)
+	if problems := p.Validate(); len(problems) > 0 {
+		t.Fatalf("unexpected problems: %v", problems)
+	}
+
+	p.workspaces = []string{"unknown"}
+	if problems := p.Validate(); len(problems) != 1 {
+		t.Fatalf("want 1 problem, have %v", problems)
+	}
+}
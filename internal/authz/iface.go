@@ -11,7 +11,8 @@ import (
 
 // ExternalUserPermissions is a collection of accessible repository/project IDs
 // (on code host). It contains exact IDs, as well as prefixes to both include
-// and exclude IDs.
+// and exclude IDs. Repositories of which the user can only access some paths
+// also have SubRepoPermissions.
 //
 // 🚨 SECURITY: Every call site should evaluate all fields of this struct to
 // have a complete set of IDs.
@@ -19,6 +20,8 @@ type ExternalUserPermissions struct {
 	Exacts          []extsvc.RepoID
 	IncludePrefixes []extsvc.RepoID
 	ExcludePrefixes []extsvc.RepoID
+
+	SubRepoPermissions map[extsvc.RepoID]*SubRepoPermissions
 }
 
 // Provider defines a source of truth of which repositories a user is authorized to view. The
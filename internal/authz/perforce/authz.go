@@ -2,8 +2,10 @@ package perforce
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/schema"
 )
@@ -15,7 +17,7 @@ import (
 // false. "Warnings" are all other validation problems.
 func NewAuthzProviders(conns []*types.PerforceConnection) (ps []authz.Provider, problems []string, warnings []string) {
 	for _, c := range conns {
-		p, err := newAuthzProvider(c.URN, c.Authorization, c.P4Port, c.P4User, c.P4Passwd)
+		p, err := newAuthzProvider(c.URN, c.Authorization, c.P4Port, c.P4User, c.P4Passwd, c.Depots)
 		if err != nil {
 			problems = append(problems, err.Error())
 		} else if p != nil {
@@ -36,17 +38,24 @@ func newAuthzProvider(
 	urn string,
 	a *schema.PerforceAuthorization,
 	host, user, password string,
+	depots []string,
 ) (authz.Provider, error) {
 	if a == nil {
 		return nil, nil
 	}
 
-	return NewProvider(urn, host, user, password), nil
+	depotIDs := make([]extsvc.RepoID, 0, len(depots))
+	for _, depot := range depots {
+		// Tolerate depots configured without a trailing slash, as the syncer
+		// does.
+		depotIDs = append(depotIDs, extsvc.RepoID(strings.TrimSuffix(depot, "/")+"/"))
+	}
+	return NewProvider(urn, host, user, password, depotIDs), nil
 }
 
 // ValidateAuthz validates the authorization fields of the given Perforce
 // external service config.
 func ValidateAuthz(cfg *schema.PerforceConnection) error {
-	_, err := newAuthzProvider("", cfg.Authorization, cfg.P4Port, cfg.P4User, cfg.P4Passwd)
+	_, err := newAuthzProvider("", cfg.Authorization, cfg.P4Port, cfg.P4User, cfg.P4Passwd, cfg.Depots)
 	return err
 }
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/url"
+	"sort"
 	"strings"
 
 	"github.com/cockroachdb/errors"
@@ -33,6 +34,10 @@ type Provider struct {
 	user     string
 	password string
 
+	// depots are the depots synced as repositories, used to compute
+	// sub-repository permissions.
+	depots []extsvc.RepoID
+
 	// NOTE: We do not need mutex because there is no concurrent access to these
 	// 	fields in the current implementation.
 	cachedAllUserEmails map[string]string   // username <-> email
@@ -42,8 +47,9 @@ type Provider struct {
 // NewProvider returns a new Perforce authorization provider that uses the given
 // host, user and password to talk to a Perforce Server that is the source of
 // truth for permissions. It assumes emails of Sourcegraph accounts match 1-1
-// with emails of Perforce Server users.
-func NewProvider(urn, host, user, password string) *Provider {
+// with emails of Perforce Server users. Protections of paths within the given
+// depots are returned as sub-repository permissions.
+func NewProvider(urn, host, user, password string, depots []extsvc.RepoID) *Provider {
 	baseURL, _ := url.Parse(host)
 	return &Provider{
 		urn:                urn,
@@ -51,6 +57,7 @@ func NewProvider(urn, host, user, password string) *Provider {
 		host:               host,
 		user:               user,
 		password:           password,
+		depots:             depots,
 		cachedGroupMembers: make(map[string][]string),
 	}
 }
@@ -167,7 +174,8 @@ func (p *Provider) canGrantReadAccess(level string) bool {
 }
 
 // FetchUserPerms returns a list of depot prefixes that the given user has
-// access to on the Perforce Server.
+// access to on the Perforce Server, and the paths the user has access to
+// within the depots of the provider.
 func (p *Provider) FetchUserPerms(ctx context.Context, account *extsvc.Account) (*authz.ExternalUserPermissions, error) {
 	if account == nil {
 		return nil, errors.New("no account provided")
@@ -190,6 +198,7 @@ func (p *Provider) FetchUserPerms(ctx context.Context, account *extsvc.Account)
 	defer func() { _ = rc.Close() }()
 
 	var includePrefixes, excludePrefixes []extsvc.RepoID
+	subRepoPerms := make(map[extsvc.RepoID]*authz.SubRepoPermissions)
 	scanner := bufio.NewScanner(rc)
 	for scanner.Scan() {
 		line := scanner.Text()
@@ -215,6 +224,7 @@ func (p *Provider) FetchUserPerms(ctx context.Context, account *extsvc.Account)
 			if !p.canRevokeReadAccess(level) {
 				continue
 			}
+			p.addSubRepoRules(subRepoPerms, strings.TrimPrefix(fields[4], "-"), true)
 
 			for i, prefix := range includePrefixes {
 				if !strings.HasPrefix(depotPrefix, string(prefix)) {
@@ -236,17 +246,71 @@ func (p *Provider) FetchUserPerms(ctx context.Context, account *extsvc.Account)
 			if !p.canGrantReadAccess(level) {
 				continue
 			}
+			p.addSubRepoRules(subRepoPerms, fields[4], false)
 
 			includePrefixes = append(includePrefixes, extsvc.RepoID(depotPrefix))
 		}
 	}
 
-	// As per interface definition for this method, implementation should return
-	// partial but valid results even when something went wrong.
-	return &authz.ExternalUserPermissions{
+	perms := &authz.ExternalUserPermissions{
 		IncludePrefixes: includePrefixes,
 		ExcludePrefixes: excludePrefixes,
-	}, errors.Wrap(scanner.Err(), "scanner.Err")
+	}
+	for depot, rules := range subRepoPerms {
+		if len(rules.PathRules) == 1 && rules.PathRules[0] == "**" {
+			// The user can access all paths of the depot.
+			continue
+		}
+		if perms.SubRepoPermissions == nil {
+			perms.SubRepoPermissions = make(map[extsvc.RepoID]*authz.SubRepoPermissions)
+		}
+		perms.SubRepoPermissions[depot] = rules
+
+		// Users with access to some paths within a depot have access to the
+		// depot itself, which the prefixes above do not grant.
+		perms.Exacts = append(perms.Exacts, depot)
+	}
+	sort.Slice(perms.Exacts, func(i, j int) bool { return perms.Exacts[i] < perms.Exacts[j] })
+
+	// As per interface definition for this method, implementation should return
+	// partial but valid results even when something went wrong.
+	return perms, errors.Wrap(scanner.Err(), "scanner.Err")
+}
+
+// addSubRepoRules appends the rule for the given depot path of a protections
+// table entry to the sub-repository permissions of each depot of the provider
+// the entry applies to. Depot paths use Perforce wildcards, where "*" matches
+// within a directory and "..." matches across directories.
+//
+// Entries with wildcards above the root of a depot, such as "//.../secret/...",
+// are not supported and are ignored.
+func (p *Provider) addSubRepoRules(perms map[extsvc.RepoID]*authz.SubRepoPermissions, depotPath string, exclude bool) {
+	for _, depot := range p.depots {
+		var rule string
+		switch {
+		case strings.HasPrefix(depotPath, string(depot)):
+			// e.g. //Sourcegraph/Engineering/Backend/... applies to Backend/**
+			// in the depot //Sourcegraph/Engineering/
+			rule = strings.ReplaceAll(strings.TrimPrefix(depotPath, string(depot)), "...", "**")
+		case strings.HasSuffix(depotPath, "...") && strings.HasPrefix(string(depot), strings.TrimSuffix(depotPath, "...")):
+			// e.g. //Sourcegraph/... applies to all paths of the depot
+			// //Sourcegraph/Engineering/
+			rule = "**"
+		default:
+			continue
+		}
+		if rule == "" {
+			continue
+		}
+
+		if exclude {
+			rule = "-" + rule
+		}
+		if perms[depot] == nil {
+			perms[depot] = &authz.SubRepoPermissions{}
+		}
+		perms[depot].PathRules = append(perms[depot].PathRules, rule)
+	}
 }
 
 // getAllUserEmails returns a set of username <-> email pairs of all users in the Perforce server.
@@ -48,7 +48,7 @@ cindy <cindy@example.com> (Cindy) accessed 2020/12/04
 	}
 
 	t.Run("no matching account", func(t *testing.T) {
-		p := NewProvider("", "ssl:111.222.333.444:1666", "admin", "password")
+		p := NewProvider("", "ssl:111.222.333.444:1666", "admin", "password", nil)
 		got, err := p.FetchAccount(ctx, user, nil, []string{"bob@example.com"})
 		if err != nil {
 			t.Fatal(err)
@@ -60,7 +60,7 @@ cindy <cindy@example.com> (Cindy) accessed 2020/12/04
 	})
 
 	t.Run("found matching account", func(t *testing.T) {
-		p := NewProvider("", "ssl:111.222.333.444:1666", "admin", "password")
+		p := NewProvider("", "ssl:111.222.333.444:1666", "admin", "password", nil)
 		got, err := p.FetchAccount(ctx, user, nil, []string{"alice@example.com"})
 		if err != nil {
 			t.Fatal(err)
@@ -97,7 +97,7 @@ func TestProvider_FetchUserPerms(t *testing.T) {
 	ctx := context.Background()
 
 	t.Run("nil account", func(t *testing.T) {
-		p := NewProvider("", "ssl:111.222.333.444:1666", "admin", "password")
+		p := NewProvider("", "ssl:111.222.333.444:1666", "admin", "password", nil)
 		_, err := p.FetchUserPerms(ctx, nil)
 		want := "no account provided"
 		got := fmt.Sprintf("%v", err)
@@ -107,7 +107,7 @@ func TestProvider_FetchUserPerms(t *testing.T) {
 	})
 
 	t.Run("not the code host of the account", func(t *testing.T) {
-		p := NewProvider("", "ssl:111.222.333.444:1666", "admin", "password")
+		p := NewProvider("", "ssl:111.222.333.444:1666", "admin", "password", nil)
 		_, err := p.FetchUserPerms(context.Background(),
 			&extsvc.Account{
 				AccountSpec: extsvc.AccountSpec{
@@ -124,7 +124,7 @@ func TestProvider_FetchUserPerms(t *testing.T) {
 	})
 
 	t.Run("no user found in account data", func(t *testing.T) {
-		p := NewProvider("", "ssl:111.222.333.444:1666", "admin", "password")
+		p := NewProvider("", "ssl:111.222.333.444:1666", "admin", "password", nil)
 		_, err := p.FetchUserPerms(ctx,
 			&extsvc.Account{
 				AccountSpec: extsvc.AccountSpec{
@@ -170,6 +170,7 @@ func TestProvider_FetchUserPerms(t *testing.T) {
 
 	tests := []struct {
 		name      string
+		depots    []extsvc.RepoID
 		response  string
 		wantPerms *authz.ExternalUserPermissions
 	}{
@@ -228,12 +229,46 @@ open user alice * -//Sourcegraph/Engineering/Backend/Credentials/... ## sub-matc
 				},
 			},
 		},
+		{
+			name:   "sub-repository permissions",
+			depots: []extsvc.RepoID{"//Sourcegraph/Engineering/", "//Sourcegraph/Handbook/", "//Sourcegraph/Security/"},
+			response: `
+read user alice * //Sourcegraph/...
+read user alice * -//Sourcegraph/Engineering/Backend/Credentials/...
+read user alice * //Sourcegraph/Engineering/Backend/Credentials/README.md
+list user alice * -//Sourcegraph/Security/...
+read user alice * //Sourcegraph/Security/Policies/*.md
+`,
+			wantPerms: &authz.ExternalUserPermissions{
+				Exacts: []extsvc.RepoID{
+					"//Sourcegraph/Engineering/",
+					"//Sourcegraph/Security/",
+				},
+				IncludePrefixes: []extsvc.RepoID{
+					"//Sourcegraph/",
+					"//Sourcegraph/Engineering/Backend/Credentials/README.md",
+					"//Sourcegraph/Security/Policies/*.md",
+				},
+				ExcludePrefixes: []extsvc.RepoID{
+					"//Sourcegraph/Engineering/Backend/Credentials/",
+					"//Sourcegraph/Security/",
+				},
+				SubRepoPermissions: map[extsvc.RepoID]*authz.SubRepoPermissions{
+					"//Sourcegraph/Engineering/": {
+						PathRules: []string{"**", "-Backend/Credentials/**", "Backend/Credentials/README.md"},
+					},
+					"//Sourcegraph/Security/": {
+						PathRules: []string{"**", "-**", "Policies/*.md"},
+					},
+				},
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			mockResponse = test.response
 
-			p := NewProvider("", "ssl:111.222.333.444:1666", "admin", "password")
+			p := NewProvider("", "ssl:111.222.333.444:1666", "admin", "password", test.depots)
 			got, err := p.FetchUserPerms(ctx,
 				&extsvc.Account{
 					AccountSpec: extsvc.AccountSpec{
@@ -260,7 +295,7 @@ func TestProvider_FetchRepoPerms(t *testing.T) {
 	ctx := context.Background()
 
 	t.Run("nil repository", func(t *testing.T) {
-		p := NewProvider("", "ssl:111.222.333.444:1666", "admin", "password")
+		p := NewProvider("", "ssl:111.222.333.444:1666", "admin", "password", nil)
 		_, err := p.FetchRepoPerms(ctx, nil)
 		want := "no repository provided"
 		got := fmt.Sprintf("%v", err)
@@ -270,7 +305,7 @@ func TestProvider_FetchRepoPerms(t *testing.T) {
 	})
 
 	t.Run("not the code host of the repository", func(t *testing.T) {
-		p := NewProvider("", "ssl:111.222.333.444:1666", "admin", "password")
+		p := NewProvider("", "ssl:111.222.333.444:1666", "admin", "password", nil)
 		_, err := p.FetchRepoPerms(ctx,
 			&extsvc.Repository{
 				URI: "gitlab.com/user/repo",
@@ -350,7 +385,7 @@ Users:
 		return []string{strings.TrimPrefix(server.URL, "http://")}
 	}
 
-	p := NewProvider("", "ssl:111.222.333.444:1666", "admin", "password")
+	p := NewProvider("", "ssl:111.222.333.444:1666", "admin", "password", nil)
 	got, err := p.FetchRepoPerms(ctx,
 		&extsvc.Repository{
 			URI: "gitlab.com/user/repo",
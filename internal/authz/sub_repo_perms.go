@@ -0,0 +1,202 @@
+package authz
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gobwas/glob"
+	lru "github.com/hashicorp/golang-lru"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+// SubRepoPermissions denotes access control rules within a repository's
+// contents.
+//
+// Rules are glob patterns of paths relative to the root of the repository,
+// where "*" matches within a path component and "**" matches across path
+// components. Rules starting with "-" revoke access. Like in Perforce
+// protection tables, rules are evaluated in order and the last matching rule
+// wins. Paths matching no rule are not accessible.
+type SubRepoPermissions struct {
+	PathRules []string
+}
+
+// RepoContent specifies content existing in a repository.
+type RepoContent struct {
+	Repo api.RepoName
+	Path string
+}
+
+// SubRepoPermissionChecker checks access to the content of repositories with
+// sub-repository permissions.
+//
+// 🚨 SECURITY: Sub-repository permissions are enforced in addition to
+// repository permissions, which callers must enforce separately.
+type SubRepoPermissionChecker interface {
+	// Permissions returns the level of access the given user has for the given
+	// content. Users without sub-repository permissions for the repository
+	// have Read access to all of its content.
+	Permissions(ctx context.Context, userID int32, content RepoContent) (Perms, error)
+}
+
+// DefaultSubRepoPermsChecker is the checker used by search and code
+// intelligence. It allows access to all content unless sub-repository
+// permissions are configured.
+var DefaultSubRepoPermsChecker SubRepoPermissionChecker = noopSubRepoPermsChecker{}
+
+type noopSubRepoPermsChecker struct{}
+
+func (noopSubRepoPermsChecker) Permissions(context.Context, int32, RepoContent) (Perms, error) {
+	return Read, nil
+}
+
+// SubRepoPermissionsGetter returns the sub-repository permissions of users.
+type SubRepoPermissionsGetter interface {
+	// GetByUser returns the sub-repository permissions of the given user,
+	// keyed by repository.
+	GetByUser(ctx context.Context, userID int32) (map[api.RepoName]SubRepoPermissions, error)
+}
+
+const (
+	// subRepoPermsCacheTTL is how long the compiled rules of a user are
+	// cached. Permissions are synced in the background every few hours, so
+	// this only avoids loading them for every path of a request.
+	subRepoPermsCacheTTL = 10 * time.Second
+
+	// subRepoPermsCacheSize is the maximum number of users whose rules are
+	// cached.
+	subRepoPermsCacheSize = 1000
+)
+
+type subRepoPermsChecker struct {
+	getter SubRepoPermissionsGetter
+	clock  func() time.Time
+	cache  *lru.Cache // user ID -> *cachedRules
+}
+
+type cachedRules struct {
+	once      sync.Once
+	expiresAt time.Time
+	repos     map[api.RepoName][]compiledRule
+	err       error
+}
+
+type compiledRule struct {
+	glob    glob.Glob
+	exclude bool
+}
+
+// NewSubRepoPermsChecker returns a checker of the sub-repository permissions
+// returned by getter.
+func NewSubRepoPermsChecker(getter SubRepoPermissionsGetter) SubRepoPermissionChecker {
+	cache, _ := lru.New(subRepoPermsCacheSize)
+	return &subRepoPermsChecker{
+		getter: getter,
+		clock:  time.Now,
+		cache:  cache,
+	}
+}
+
+func (s *subRepoPermsChecker) Permissions(ctx context.Context, userID int32, content RepoContent) (Perms, error) {
+	repos, err := s.rules(ctx, userID)
+	if err != nil {
+		return None, err
+	}
+
+	rules, ok := repos[content.Repo]
+	if !ok {
+		return Read, nil
+	}
+
+	path := strings.TrimPrefix(content.Path, "/")
+	perms := None
+	for _, rule := range rules {
+		if rule.glob.Match(path) {
+			if rule.exclude {
+				perms = None
+			} else {
+				perms = Read
+			}
+		}
+	}
+	return perms, nil
+}
+
+// rules returns the compiled rules of the given user, loading them at most
+// once per TTL.
+func (s *subRepoPermsChecker) rules(ctx context.Context, userID int32) (map[api.RepoName][]compiledRule, error) {
+	now := s.clock()
+	var entry *cachedRules
+	if v, ok := s.cache.Get(userID); ok && now.Before(v.(*cachedRules).expiresAt) {
+		entry = v.(*cachedRules)
+	} else {
+		entry = &cachedRules{expiresAt: now.Add(subRepoPermsCacheTTL)}
+		s.cache.Add(userID, entry)
+	}
+
+	entry.once.Do(func() {
+		entry.repos, entry.err = s.load(ctx, userID)
+		if entry.err != nil {
+			// Errors are not cached.
+			s.cache.Remove(userID)
+		}
+	})
+	return entry.repos, entry.err
+}
+
+func (s *subRepoPermsChecker) load(ctx context.Context, userID int32) (map[api.RepoName][]compiledRule, error) {
+	perms, err := s.getter.GetByUser(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get sub-repository permissions")
+	}
+
+	repos := make(map[api.RepoName][]compiledRule, len(perms))
+	for repo, p := range perms {
+		rules := make([]compiledRule, 0, len(p.PathRules))
+		for _, pattern := range p.PathRules {
+			rule := compiledRule{}
+			if strings.HasPrefix(pattern, "-") {
+				rule.exclude = true
+				pattern = pattern[1:]
+			}
+			rule.glob, err = glob.Compile(strings.TrimPrefix(pattern, "/"), '/')
+			if err != nil {
+				return nil, errors.Wrapf(err, "compile path rule %q of repository %q", pattern, repo)
+			}
+			rules = append(rules, rule)
+		}
+		repos[repo] = rules
+	}
+	return repos, nil
+}
+
+// ActorPermissions returns the level of access the actor of the context has
+// for the given content. Internal actors have Read access to all content.
+func ActorPermissions(ctx context.Context, s SubRepoPermissionChecker, content RepoContent) (Perms, error) {
+	a := actor.FromContext(ctx)
+	if a.Internal {
+		return Read, nil
+	}
+	return s.Permissions(ctx, a.UID, content)
+}
+
+// FilterActorPaths returns the paths of the given repository the actor of the
+// context can read.
+func FilterActorPaths(ctx context.Context, s SubRepoPermissionChecker, repo api.RepoName, paths []string) ([]string, error) {
+	filtered := make([]string, 0, len(paths))
+	for _, path := range paths {
+		perms, err := ActorPermissions(ctx, s, RepoContent{Repo: repo, Path: path})
+		if err != nil {
+			return nil, err
+		}
+		if perms.Include(Read) {
+			filtered = append(filtered, path)
+		}
+	}
+	return filtered, nil
+}
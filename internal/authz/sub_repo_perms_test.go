@@ -0,0 +1,95 @@
+package authz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+type subRepoPermissionsGetterFunc func(ctx context.Context, userID int32) (map[api.RepoName]SubRepoPermissions, error)
+
+func (f subRepoPermissionsGetterFunc) GetByUser(ctx context.Context, userID int32) (map[api.RepoName]SubRepoPermissions, error) {
+	return f(ctx, userID)
+}
+
+func TestSubRepoPermsChecker(t *testing.T) {
+	calls := 0
+	checker := NewSubRepoPermsChecker(subRepoPermissionsGetterFunc(func(ctx context.Context, userID int32) (map[api.RepoName]SubRepoPermissions, error) {
+		calls++
+		if userID != 1 {
+			return nil, nil
+		}
+		return map[api.RepoName]SubRepoPermissions{
+			"perforce/depot": {PathRules: []string{"**", "-secret/**", "secret/public/*.md", "-*.key"}},
+		}, nil
+	}))
+
+	for _, tc := range []struct {
+		userID int32
+		repo   api.RepoName
+		path   string
+		want   Perms
+	}{
+		{userID: 1, repo: "perforce/depot", path: "README.md", want: Read},
+		{userID: 1, repo: "perforce/depot", path: "/cmd/main.go", want: Read},
+		{userID: 1, repo: "perforce/depot", path: "secret/plans.txt", want: None},
+		{userID: 1, repo: "perforce/depot", path: "secret/public/README.md", want: Read},
+		{userID: 1, repo: "perforce/depot", path: "secret/public/nested/README.md", want: None},
+		{userID: 1, repo: "perforce/depot", path: "id.key", want: None},
+		{userID: 1, repo: "perforce/depot", path: "cmd/id.key", want: Read},
+		{userID: 1, repo: "github.com/foo/bar", path: "secret/plans.txt", want: Read},
+		{userID: 2, repo: "perforce/depot", path: "secret/plans.txt", want: Read},
+	} {
+		have, err := checker.Permissions(context.Background(), tc.userID, RepoContent{Repo: tc.repo, Path: tc.path})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have != tc.want {
+			t.Errorf("user %d, %s/%s: want %s, have %s", tc.userID, tc.repo, tc.path, tc.want, have)
+		}
+	}
+
+	if calls != 2 {
+		t.Errorf("expected permissions to be loaded once per user, loaded %d times", calls)
+	}
+
+	// Rules are reloaded once they expire.
+	checker.(*subRepoPermsChecker).clock = func() time.Time { return time.Now().Add(subRepoPermsCacheTTL) }
+	if _, err := checker.Permissions(context.Background(), 1, RepoContent{Repo: "perforce/depot"}); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 3 {
+		t.Errorf("expected expired permissions to be reloaded, loaded %d times", calls)
+	}
+}
+
+func TestFilterActorPaths(t *testing.T) {
+	checker := NewSubRepoPermsChecker(subRepoPermissionsGetterFunc(func(ctx context.Context, userID int32) (map[api.RepoName]SubRepoPermissions, error) {
+		return map[api.RepoName]SubRepoPermissions{
+			"perforce/depot": {PathRules: []string{"docs/**"}},
+		}, nil
+	}))
+	paths := []string{"docs/README.md", "main.go"}
+
+	ctx := actor.WithActor(context.Background(), &actor.Actor{UID: 1})
+	have, err := FilterActorPaths(ctx, checker, "perforce/depot", paths)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"docs/README.md"}, have); diff != "" {
+		t.Errorf("unexpected paths (-want +have):\n%s", diff)
+	}
+
+	have, err = FilterActorPaths(actor.WithInternalActor(context.Background()), checker, "perforce/depot", paths)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(paths, have); diff != "" {
+		t.Errorf("unexpected paths for internal actor (-want +have):\n%s", diff)
+	}
+}
@@ -10,7 +10,7 @@ import (
 
 func (args Args) String() string {
 	s := []string{
-		args.MatchTemplate,
+		args.matchTemplate(),
 		args.RewriteTemplate,
 		"-json-lines",
 	}
@@ -47,3 +47,11 @@ func (args Args) String() string {
 
 	return strings.Join(s, " ")
 }
+
+// matchTemplate returns the match template passed to comby.
+func (args Args) matchTemplate() string {
+	if args.CaseInsensitive {
+		return CaseInsensitiveTemplate(args.MatchTemplate)
+	}
+	return args.MatchTemplate
+}
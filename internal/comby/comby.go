@@ -27,10 +27,10 @@ func exists() bool {
 }
 
 func rawArgs(args Args) (rawArgs []string) {
-	rawArgs = append(rawArgs, args.MatchTemplate, args.RewriteTemplate)
+	rawArgs = append(rawArgs, args.matchTemplate(), args.RewriteTemplate)
 
 	if args.Rule != "" {
-		rawArgs = append(rawArgs, "-rule", args.Rule)
+		rawArgs = append(rawArgs, "-rule", normalizeRule(args.Rule))
 	}
 
 	if len(args.FilePatterns) > 0 {
@@ -0,0 +1,46 @@
+package comby
+
+import (
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
+)
+
+// normalizeRule returns rule with the "where" keyword prepended if it is a bare
+// list of constraints, such as ":[x] == :[y]". Comby requires rules to start
+// with "where".
+func normalizeRule(rule string) string {
+	rule = strings.TrimSpace(rule)
+	if rule == "" || strings.HasPrefix(rule, "where ") || strings.HasPrefix(rule, "where\n") {
+		return rule
+	}
+	return "where " + rule
+}
+
+// rewriteHoleRegexp matches the holes of a rewrite template. Submatch 1 is the
+// name of an alphanumeric hole (:[[x]]), submatch 2 the name of any other hole
+// (:[x], :[x.], :[x\n], or :[ x]).
+var rewriteHoleRegexp = lazyregexp.New(`:\[\[(\w+)\]\]|:\[[ ]*(\w+)(?:\.|\\n)?\]`)
+
+// Rewrite returns the rewrite template with its holes substituted by their
+// values in environment. It computes what comby substitutes for a match when
+// rewriting, so that a preview of the rewrite can be shown for a match found
+// with MatchOnly. Holes that are not bound in environment are kept as-is.
+func Rewrite(template string, environment []Environment) string {
+	values := make(map[string]string, len(environment))
+	for _, e := range environment {
+		values[e.Variable] = e.Value
+	}
+
+	return rewriteHoleRegexp.ReplaceAllStringFunc(template, func(hole string) string {
+		submatches := rewriteHoleRegexp.FindStringSubmatch(hole)
+		name := submatches[1]
+		if name == "" {
+			name = submatches[2]
+		}
+		if value, ok := values[name]; ok {
+			return value
+		}
+		return hole
+	})
+}
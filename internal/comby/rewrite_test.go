@@ -0,0 +1,48 @@
+package comby
+
+import (
+	"testing"
+)
+
+func TestNormalizeRule(t *testing.T) {
+	cases := []struct {
+		rule string
+		want string
+	}{
+		{rule: "", want: ""},
+		{rule: ":[x] == :[y]", want: "where :[x] == :[y]"},
+		{rule: "  :[x] != \"nil\", :[y] == :[z] ", want: "where :[x] != \"nil\", :[y] == :[z]"},
+		{rule: "where :[x] == :[y]", want: "where :[x] == :[y]"},
+		{rule: "where\n:[x] == :[y]", want: "where\n:[x] == :[y]"},
+	}
+	for _, c := range cases {
+		if got := normalizeRule(c.rule); got != c.want {
+			t.Errorf("normalizeRule(%q) = %q, want %q", c.rule, got, c.want)
+		}
+	}
+}
+
+func TestRewrite(t *testing.T) {
+	environment := []Environment{
+		{Variable: "x", Value: "a"},
+		{Variable: "y", Value: "b.c"},
+		{Variable: "body", Value: "return nil"},
+	}
+
+	cases := []struct {
+		template string
+		want     string
+	}{
+		{template: "", want: ""},
+		{template: "errors.Is(:[x], :[y])", want: "errors.Is(a, b.c)"},
+		{template: ":[[x]] := :[y.]", want: "a := b.c"},
+		{template: "if :[ x] {\n\t:[body\\n]}", want: "if a {\n\treturn nil}"},
+		{template: ":[x] + :[x]", want: "a + a"},
+		{template: ":[x] + :[z]", want: "a + :[z]"},
+	}
+	for _, c := range cases {
+		if got := Rewrite(c.template, environment); got != c.want {
+			t.Errorf("Rewrite(%q) = %q, want %q", c.template, got, c.want)
+		}
+	}
+}
@@ -189,3 +189,41 @@ func StructuralPatToRegexpQuery(pattern string, shortcircuit bool) string {
 	}
 	return "(" + strings.Join(pieces, ")(.|\\s)*?(") + ")"
 }
+
+// CaseInsensitiveTemplate converts a comby pattern to one that matches
+// regardless of case. Comby does not support case-insensitive matching, so
+// each run of ASCII letters in the literals of the pattern is replaced by a
+// regular expression hole matching either case of each letter. Holes are left
+// unchanged.
+//
+// Example:
+// "fmt.Sprintf(:[args])" -> ":[~[fF][mM][tT]].:[~[sS][pP][rR][iI][nN][tT][fF]](:[args])"
+func CaseInsensitiveTemplate(pattern string) string {
+	var b strings.Builder
+	for _, term := range parseTemplate([]byte(pattern)) {
+		literal, ok := term.(Literal)
+		if !ok {
+			b.WriteString(term.String())
+			continue
+		}
+		inLetters := false
+		for _, r := range literal.String() {
+			isLetter := ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z')
+			if isLetter && !inLetters {
+				b.WriteString(":[~")
+			} else if !isLetter && inLetters {
+				b.WriteString("]")
+			}
+			inLetters = isLetter
+			if isLetter {
+				b.WriteString("[" + strings.ToLower(string(r)) + strings.ToUpper(string(r)) + "]")
+			} else {
+				b.WriteRune(r)
+			}
+		}
+		if inLetters {
+			b.WriteString("]")
+		}
+	}
+	return b.String()
+}
@@ -119,3 +119,40 @@ func TestStructuralPatToRegexpQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestCaseInsensitiveTemplate(t *testing.T) {
+	cases := []struct {
+		Name    string
+		Pattern string
+		Want    string
+	}{
+		{
+			Name:    "Letters around holes",
+			Pattern: `fmt.Sprintf(:[args])`,
+			Want:    `:[~[fF][mM][tT]].:[~[sS][pP][rR][iI][nN][tT][fF]](:[args])`,
+		},
+		{
+			Name:    "Regex hole is unchanged",
+			Pattern: `a:[x~[a-z]+]b`,
+			Want:    `:[~[aA]]:[x~[a-z]+]:[~[bB]]`,
+		},
+		{
+			Name:    "Punctuation, digits and whitespace are unchanged",
+			Pattern: `x[0] == 1 ...`,
+			Want:    `:[~[xX]][0] == 1 ...`,
+		},
+		{
+			Name:    "Non-ASCII letters are unchanged",
+			Pattern: `café`,
+			Want:    `:[~[cC][aA][fF]]é`,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			got := CaseInsensitiveTemplate(tt.Pattern)
+			if diff := cmp.Diff(tt.Want, got); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -17,6 +17,10 @@ type Args struct {
 	// A template pattern that expresses what to match
 	MatchTemplate string
 
+	// If CaseInsensitive is set to true, the letters of MatchTemplate outside
+	// of holes match regardless of their case
+	CaseInsensitive bool
+
 	// A rule that places constraints on matching or rewriting
 	Rule string
 
@@ -51,8 +55,16 @@ type Range struct {
 
 // Match represents a range of matched characters and the matched content
 type Match struct {
-	Range   Range  `json:"range"`
-	Matched string `json:"matched"`
+	Range       Range         `json:"range"`
+	Environment []Environment `json:"environment"`
+	Matched     string        `json:"matched"`
+}
+
+// Environment is the value bound to a hole of the match template in a match
+type Environment struct {
+	Variable string `json:"variable"`
+	Value    string `json:"value"`
+	Range    Range  `json:"range"`
 }
 
 // FileMatch represents all the matches in a single file
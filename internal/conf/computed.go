@@ -321,6 +321,13 @@ func AndOrQueryEnabled() bool {
 	return val == "enabled"
 }
 
+// GitServerRebalancingEnabled returns true if repositories are placed on
+// gitserver shards by rendezvous hashing and moved between shards when the
+// set of shards changes.
+func GitServerRebalancingEnabled() bool {
+	return ExperimentalFeatures().GitServerRebalancing == "enabled"
+}
+
 func ExperimentalFeatures() schema.ExperimentalFeatures {
 	val := Get().ExperimentalFeatures
 	if val == nil {
@@ -70,6 +70,12 @@ func validateCustom(cfg Unified) (problems Problems) {
 		}
 	}
 
+	for _, rule := range cfg.GitCloneStrategy {
+		if _, err := regexp.Compile(rule.Pattern); err != nil {
+			invalid(NewSiteProblem(fmt.Sprintf("CloneStrategyRule pattern is not valid regex: %q", rule.Pattern)))
+		}
+	}
+
 	for _, f := range contributedValidators {
 		problems = append(problems, f(cfg)...)
 	}
@@ -88,7 +88,13 @@ func (s *AccessTokenStore) Create(ctx context.Context, subjectUserID int32, scop
 		return 0, "", errors.New("access tokens without scopes are not supported")
 	}
 
-	if err := s.Handle().DB().QueryRowContext(ctx,
+	tx, err := s.Transact(ctx)
+	if err != nil {
+		return 0, "", err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	if err := tx.Handle().DB().QueryRowContext(ctx,
 		// Include users table query (with "FOR UPDATE") to ensure that subject/creator users have
 		// not been deleted. If they were deleted, the query will return an error.
 		`
@@ -108,6 +114,20 @@ INSERT INTO access_tokens(subject_user_id, scopes, value_sha256, note, creator_u
 	).Scan(&id); err != nil {
 		return 0, "", err
 	}
+
+	if err := AuditLogsWith(tx).Record(ctx, &AuditLogEvent{
+		Action:      AuditLogActionAccessTokenCreated,
+		SubjectType: AuditLogSubjectAccessToken,
+		SubjectID:   id,
+		After: map[string]interface{}{
+			"subjectUserID": subjectUserID,
+			"creatorUserID": creatorUserID,
+			"scopes":        scopes,
+			"note":          note,
+		},
+	}); err != nil {
+		return 0, "", err
+	}
 	return id, token, nil
 }
 
@@ -0,0 +1,226 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/requestclient"
+)
+
+// The actions recorded in the audit log.
+const (
+	AuditLogActionUserCreated            = "user.created"
+	AuditLogActionUserSiteAdminUpdated   = "user.site_admin_updated"
+	AuditLogActionAccessTokenCreated     = "access_token.created"
+	AuditLogActionExternalServiceCreated = "external_service.created"
+	AuditLogActionExternalServiceUpdated = "external_service.updated"
+	AuditLogActionExternalServiceDeleted = "external_service.deleted"
+	AuditLogActionRepoPermissionsUpdated = "repo.permissions_updated"
+)
+
+// The types of the subjects of audit log entries.
+const (
+	AuditLogSubjectUser            = "user"
+	AuditLogSubjectAccessToken     = "access_token"
+	AuditLogSubjectExternalService = "external_service"
+	AuditLogSubjectRepo            = "repo"
+)
+
+// AuditLogRetention is how long audit log entries are kept. It is longer than a
+// year so that a full year of entries is always available to auditors.
+const AuditLogRetention = 400 * 24 * time.Hour
+
+// AuditLog is an entry of the audit log, which records security-relevant
+// mutations.
+type AuditLog struct {
+	ID          int64
+	ActorUserID int32 // 0 for mutations made by Sourcegraph itself or by anonymous users
+	Action      string
+	SubjectType string
+	SubjectID   string
+	IP          string
+	Before      json.RawMessage // nil for creations
+	After       json.RawMessage // nil for deletions
+	CreatedAt   time.Time
+}
+
+// AuditLogEvent is a security-relevant mutation to record in the audit log.
+type AuditLogEvent struct {
+	Action      string
+	SubjectType string
+	SubjectID   interface{}
+
+	// Before and After are the states of the subject before and after the
+	// mutation, which are recorded as JSON.
+	//
+	// 🚨 SECURITY: Callers must redact secrets from them.
+	Before, After interface{}
+}
+
+// AuditLogStore provides access to the audit_logs table.
+type AuditLogStore struct {
+	*basestore.Store
+}
+
+// AuditLogs instantiates and returns a new AuditLogStore with prepared statements.
+func AuditLogs(db dbutil.DB) *AuditLogStore {
+	return &AuditLogStore{Store: basestore.NewWithDB(db, sql.TxOptions{})}
+}
+
+// AuditLogsWith instantiates and returns a new AuditLogStore using the other store handle.
+func AuditLogsWith(other basestore.ShareableStore) *AuditLogStore {
+	return &AuditLogStore{Store: basestore.NewWithHandle(other.Handle())}
+}
+
+// Record records e in the audit log. The actor and the client IP address of
+// the mutation are taken from ctx. Callers should record events in the same
+// transaction as the mutation, so that no mutation goes unrecorded.
+func (s *AuditLogStore) Record(ctx context.Context, e *AuditLogEvent) error {
+	if Mocks.AuditLogs.Record != nil {
+		return Mocks.AuditLogs.Record(ctx, e)
+	}
+
+	before, err := auditLogStateColumn(e.Before)
+	if err != nil {
+		return err
+	}
+	after, err := auditLogStateColumn(e.After)
+	if err != nil {
+		return err
+	}
+
+	return s.Exec(ctx, sqlf.Sprintf(
+		recordAuditLogQuery,
+		nullInt32Column(actor.FromContext(ctx).UID),
+		e.Action,
+		e.SubjectType,
+		fmt.Sprint(e.SubjectID),
+		nullStringColumn(requestclient.FromContext(ctx).OriginIP()),
+		before,
+		after,
+	))
+}
+
+const recordAuditLogQuery = `
+-- source: internal/database/audit_logs.go:AuditLogStore.Record
+INSERT INTO audit_logs (actor_user_id, action, subject_type, subject_id, ip, before, after)
+VALUES (%s, %s, %s, %s, %s, %s, %s)
+`
+
+func auditLogStateColumn(state interface{}) (*string, error) {
+	if state == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(state)
+	if err != nil {
+		return nil, err
+	}
+	s := string(b)
+	return &s, nil
+}
+
+// AuditLogsListOptions contains options for listing audit log entries.
+type AuditLogsListOptions struct {
+	// Action, if set, only lists entries of this action.
+	Action string
+	// SubjectType and SubjectID, if set, only list entries about these subjects.
+	SubjectType string
+	SubjectID   string
+	// ActorUserID, if set, only lists entries of mutations made by this user.
+	ActorUserID int32
+	// AfterID, if set, only lists entries older than the entry with this ID.
+	AfterID int64
+
+	*LimitOffset
+}
+
+func (o AuditLogsListOptions) sqlConditions() []*sqlf.Query {
+	conds := []*sqlf.Query{sqlf.Sprintf("TRUE")}
+	if o.Action != "" {
+		conds = append(conds, sqlf.Sprintf("action = %s", o.Action))
+	}
+	if o.SubjectType != "" {
+		conds = append(conds, sqlf.Sprintf("subject_type = %s", o.SubjectType))
+	}
+	if o.SubjectID != "" {
+		conds = append(conds, sqlf.Sprintf("subject_id = %s", o.SubjectID))
+	}
+	if o.ActorUserID != 0 {
+		conds = append(conds, sqlf.Sprintf("actor_user_id = %s", o.ActorUserID))
+	}
+	if o.AfterID != 0 {
+		conds = append(conds, sqlf.Sprintf("id < %s", o.AfterID))
+	}
+	return conds
+}
+
+// List returns the audit log entries matching opt, newest first.
+func (s *AuditLogStore) List(ctx context.Context, opt AuditLogsListOptions) (_ []*AuditLog, err error) {
+	rows, err := s.Query(ctx, sqlf.Sprintf(listAuditLogsQuery, sqlf.Join(opt.sqlConditions(), "AND"), opt.LimitOffset.SQL()))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var logs []*AuditLog
+	for rows.Next() {
+		var l AuditLog
+		var before, after dbutil.NullJSONRawMessage
+		if err := rows.Scan(
+			&l.ID,
+			&dbutil.NullInt32{N: &l.ActorUserID},
+			&l.Action,
+			&l.SubjectType,
+			&l.SubjectID,
+			&dbutil.NullString{S: &l.IP},
+			&before,
+			&after,
+			&l.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		l.Before, l.After = before.Raw, after.Raw
+		logs = append(logs, &l)
+	}
+	return logs, nil
+}
+
+const listAuditLogsQuery = `
+-- source: internal/database/audit_logs.go:AuditLogStore.List
+SELECT id, actor_user_id, action, subject_type, subject_id, ip, before, after, created_at
+FROM audit_logs
+WHERE %s
+ORDER BY id DESC
+%s
+`
+
+// Count returns the number of audit log entries matching opt.
+func (s *AuditLogStore) Count(ctx context.Context, opt AuditLogsListOptions) (int, error) {
+	count, _, err := basestore.ScanFirstInt(s.Query(ctx, sqlf.Sprintf(countAuditLogsQuery, sqlf.Join(opt.sqlConditions(), "AND"))))
+	return count, err
+}
+
+const countAuditLogsQuery = `
+-- source: internal/database/audit_logs.go:AuditLogStore.Count
+SELECT COUNT(*) FROM audit_logs WHERE %s
+`
+
+// DeleteOlderThan deletes the audit log entries created before the given time.
+func (s *AuditLogStore) DeleteOlderThan(ctx context.Context, before time.Time) error {
+	return s.Exec(ctx, sqlf.Sprintf(`
+-- source: internal/database/audit_logs.go:AuditLogStore.DeleteOlderThan
+DELETE FROM audit_logs WHERE created_at < %s
+`, before))
+}
+
+type MockAuditLogs struct {
+	Record func(ctx context.Context, e *AuditLogEvent) error
+}
@@ -0,0 +1,97 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/requestclient"
+)
+
+func TestAuditLogs(t *testing.T) {
+	t.Parallel()
+	db := dbtest.NewDB(t, "")
+	ctx := context.Background()
+
+	admin, err := Users(db).Create(ctx, NewUser{Username: "admin"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	user, err := Users(db).Create(ctx, NewUser{Username: "u"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Promoting a user to site admin records an entry with the actor and the
+	// client IP address taken from the context.
+	actorCtx := actor.WithActor(ctx, actor.FromUser(admin.ID))
+	actorCtx = requestclient.WithClient(actorCtx, &requestclient.Client{IP: "10.0.0.1", ForwardedFor: "192.168.0.1, 10.0.0.2"})
+	if err := Users(db).SetIsSiteAdmin(actorCtx, user.ID, true); err != nil {
+		t.Fatal(err)
+	}
+
+	store := AuditLogs(db)
+	logs, err := store.List(ctx, AuditLogsListOptions{Action: AuditLogActionUserSiteAdminUpdated})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(logs) != 1 {
+		t.Fatalf("want 1 entry, got %d", len(logs))
+	}
+	l := logs[0]
+	if l.ActorUserID != admin.ID || l.SubjectType != AuditLogSubjectUser || l.SubjectID != strconv.Itoa(int(user.ID)) || l.IP != "192.168.0.1" {
+		t.Fatalf("unexpected entry: %+v", l)
+	}
+	var before, after userAuditLogState
+	if err := json.Unmarshal(l.Before, &before); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(l.After, &after); err != nil {
+		t.Fatal(err)
+	}
+	if before.SiteAdmin || !after.SiteAdmin {
+		t.Fatalf("want site admin to change from false to true, got %v to %v", before.SiteAdmin, after.SiteAdmin)
+	}
+
+	// Creating the users recorded entries without an actor, newest first.
+	logs, err = store.List(ctx, AuditLogsListOptions{Action: AuditLogActionUserCreated})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(logs) != 2 || logs[0].SubjectID != strconv.Itoa(int(user.ID)) || logs[1].SubjectID != strconv.Itoa(int(admin.ID)) {
+		t.Fatalf("unexpected user.created entries: %+v", logs)
+	}
+	if logs[0].ActorUserID != 0 || logs[0].Before != nil || logs[0].After == nil {
+		t.Fatalf("unexpected user.created entry: %+v", logs[0])
+	}
+
+	// Paginating by ID.
+	page, err := store.List(ctx, AuditLogsListOptions{AfterID: logs[0].ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page) != 1 || page[0].ID != logs[1].ID {
+		t.Fatalf("unexpected page: %+v", page)
+	}
+
+	count, err := store.Count(ctx, AuditLogsListOptions{ActorUserID: admin.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Fatalf("want 1 entry by admin, got %d", count)
+	}
+
+	if err := store.DeleteOlderThan(ctx, time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if count, err := store.Count(ctx, AuditLogsListOptions{}); err != nil {
+		t.Fatal(err)
+	} else if count != 0 {
+		t.Fatalf("want all entries deleted, got %d left", count)
+	}
+}
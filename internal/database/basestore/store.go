@@ -6,6 +6,7 @@ import (
 
 	"github.com/keegancsmith/sqlf"
 
+	"github.com/sourcegraph/sourcegraph/internal/database/dbconn"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 )
 
@@ -93,6 +94,17 @@ func (s *Store) With(other ShareableStore) *Store {
 	return &Store{handle: other.Handle()}
 }
 
+// ReadOnly returns a store whose queries go to the read-only replica of the
+// database when possible, or else the receiver. See dbconn.ReadOnly for when
+// that's the case. Use it only for expensive read-only queries which tolerate
+// replication lag.
+func (s *Store) ReadOnly(ctx context.Context) *Store {
+	if db := dbconn.ReadOnly(ctx, s.handle.db); dbconn.Replica != nil && db == dbutil.DB(dbconn.Replica) {
+		return NewWithDB(db, s.handle.txOptions)
+	}
+	return s
+}
+
 // Query performs QueryContext on the underlying connection.
 func (s *Store) Query(ctx context.Context, query *sqlf.Query) (*sql.Rows, error) {
 	return s.handle.db.QueryContext(ctx, query.Query(sqlf.PostgresBindVar), query.Args()...)
@@ -41,14 +41,25 @@ var (
 )
 
 // SetupGlobalConnection connects to the given data source and stores the handle
-// globally.
+// globally. It also connects to the replica if SRC_PGSQL_REPLICA_DATASOURCE is
+// set.
 //
 // Note: github.com/jackc/pgx parses the environment as well. This function will
 // also use the value of PGDATASOURCE if supplied and dataSource is the empty
 // string.
 func SetupGlobalConnection(dataSource string) (err error) {
 	Global, err = New(dataSource, "_app")
-	return err
+	if err != nil {
+		return err
+	}
+
+	if replicaDataSource != "" {
+		Replica, err = New(replicaDataSource, "_app_replica")
+		if err != nil {
+			return errors.Wrap(err, "connecting to replica")
+		}
+	}
+	return nil
 }
 
 // New connects to the given data source and returns the handle.
@@ -253,7 +264,10 @@ func Ping(ctx context.Context) error { return Global.PingContext(ctx) }
 
 type key int
 
-const bulkInsertionKey key = iota
+const (
+	bulkInsertionKey key = iota
+	primaryKey
+)
 
 // BulkInsertion returns true if the bulkInsertionKey context value is true.
 func BulkInsertion(ctx context.Context) bool {
@@ -0,0 +1,40 @@
+package dbconn
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/env"
+)
+
+var (
+	// Replica is the global connection to a read-only replica of the DB, or
+	// nil if none is configured. Only use this through ReadOnly.
+	Replica *sql.DB
+
+	replicaDataSource = env.Get("SRC_PGSQL_REPLICA_DATASOURCE", "", "dataSource of a read-only replica of the frontend DB. When set, expensive read-only queries which tolerate replication lag are sent to it.")
+)
+
+// ReadOnly returns the handle to use for expensive read-only queries on db
+// which tolerate replication lag: the replica if db is the global connection
+// and a replica is configured, or else db itself. Queries in transactions, and
+// queries with a context from WithPrimary, always go to db.
+func ReadOnly(ctx context.Context, db dbutil.DB) dbutil.DB {
+	if Replica == nil || Global == nil || db != dbutil.DB(Global) || primary(ctx) {
+		return db
+	}
+	return Replica
+}
+
+// WithPrimary returns a context whose queries all go to the primary, even the
+// ones which would otherwise go to the replica. Use it to read data right after
+// writing it, before it may have been replicated.
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey, true)
+}
+
+func primary(ctx context.Context) bool {
+	v, _ := ctx.Value(primaryKey).(bool)
+	return v
+}
@@ -0,0 +1,39 @@
+package dbconn
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+)
+
+func TestReadOnly(t *testing.T) {
+	ctx := context.Background()
+	primaryDB, replicaDB, otherDB := &sql.DB{}, &sql.DB{}, &sql.DB{}
+
+	oldGlobal, oldReplica := Global, Replica
+	t.Cleanup(func() { Global, Replica = oldGlobal, oldReplica })
+	Global = primaryDB
+
+	// Without a replica, all queries go to the primary.
+	Replica = nil
+	if db := ReadOnly(ctx, primaryDB); db != dbutil.DB(primaryDB) {
+		t.Error("want primary when no replica is configured")
+	}
+
+	Replica = replicaDB
+	if db := ReadOnly(ctx, primaryDB); db != dbutil.DB(replicaDB) {
+		t.Error("want replica for the global connection")
+	}
+	if db := ReadOnly(WithPrimary(ctx), primaryDB); db != dbutil.DB(primaryDB) {
+		t.Error("want primary for a context from WithPrimary")
+	}
+	if db := ReadOnly(ctx, otherDB); db != dbutil.DB(otherDB) {
+		t.Error("want other connections to be kept")
+	}
+	tx := &sql.Tx{}
+	if db := ReadOnly(ctx, tx); db != dbutil.DB(tx) {
+		t.Error("want transactions to be kept")
+	}
+}
@@ -372,7 +372,7 @@ func (l *EventLogStore) countPerPeriodBySQL(ctx context.Context, countExpr, inte
 		FROM all_periods
 		LEFT OUTER JOIN count_by_period ON all_periods.period = (count_by_period.period)::timestamp
 		ORDER BY period DESC`, allPeriods, countByPeriod)
-	rows, err := l.Query(ctx, q)
+	rows, err := l.ReadOnly(ctx).Query(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -425,14 +425,14 @@ func (l *EventLogStore) countUniqueUsersBySQL(ctx context.Context, startDate, en
 	q := sqlf.Sprintf(`SELECT COUNT(DISTINCT `+userIDQueryFragment+`)
 		FROM event_logs
 		WHERE (DATE(TIMEZONE('UTC'::text, timestamp)) >= %s) AND (DATE(TIMEZONE('UTC'::text, timestamp)) <= %s) %s`, startDate, endDate, querySuffix)
-	r := l.QueryRow(ctx, q)
+	r := l.ReadOnly(ctx).QueryRow(ctx, q)
 	var count int
 	err := r.Scan(&count)
 	return count, err
 }
 
 func (l *EventLogStore) ListUniqueUsersAll(ctx context.Context, startDate, endDate time.Time) ([]int32, error) {
-	rows, err := l.Handle().DB().QueryContext(ctx, `SELECT user_id
+	rows, err := l.ReadOnly(ctx).Handle().DB().QueryContext(ctx, `SELECT user_id
 		FROM event_logs
 		WHERE user_id > 0 AND DATE(TIMEZONE('UTC'::text, timestamp)) >= $1 AND DATE(TIMEZONE('UTC'::text, timestamp)) <= $2
 		GROUP BY user_id`, startDate, endDate)
@@ -458,7 +458,7 @@ func (l *EventLogStore) ListUniqueUsersAll(ctx context.Context, startDate, endDa
 // UsersUsageCounts returns a list of UserUsageCounts for all active users that produced 'SearchResultsQueried' and any
 // '%codeintel%' events in the event_logs table.
 func (l *EventLogStore) UsersUsageCounts(ctx context.Context) (counts []types.UserUsageCounts, err error) {
-	rows, err := l.Handle().DB().QueryContext(ctx, usersUsageCountsQuery)
+	rows, err := l.ReadOnly(ctx).Handle().DB().QueryContext(ctx, usersUsageCountsQuery)
 	if err != nil {
 		return nil, err
 	}
@@ -507,7 +507,7 @@ func (l *EventLogStore) SiteUsage(ctx context.Context) (types.SiteUsageSummary,
 func (l *EventLogStore) siteUsage(ctx context.Context, now time.Time) (summary types.SiteUsageSummary, err error) {
 	query := sqlf.Sprintf(siteUsageQuery, now, now, now, now)
 
-	err = l.QueryRow(ctx, query).Scan(
+	err = l.ReadOnly(ctx).QueryRow(ctx, query).Scan(
 		&summary.Month,
 		&summary.Week,
 		&summary.Day,
@@ -700,7 +700,7 @@ func (l *EventLogStore) codeIntelligenceWeeklyUsersCount(ctx context.Context, ev
 		names = append(names, sqlf.Sprintf("%s", name))
 	}
 
-	if err := l.QueryRow(ctx, sqlf.Sprintf(codeIntelWeeklyUsersQuery, now, sqlf.Join(names, ", "))).Scan(&wau); err != nil {
+	if err := l.ReadOnly(ctx).QueryRow(ctx, sqlf.Sprintf(codeIntelWeeklyUsersQuery, now, sqlf.Join(names, ", "))).Scan(&wau); err != nil {
 		return 0, err
 	}
 
@@ -721,7 +721,7 @@ WHERE
 func (l *EventLogStore) CodeIntelligenceRepositoryCounts(ctx context.Context) (withUploads int, withoutUploads int, err error) {
 	var totalRepositories int
 
-	rows, err := l.Query(ctx, sqlf.Sprintf(codeIntelligenceRepositoryCountsQuery))
+	rows, err := l.ReadOnly(ctx).Query(ctx, sqlf.Sprintf(codeIntelligenceRepositoryCountsQuery))
 	if err != nil {
 		return 0, 0, err
 	}
@@ -782,7 +782,7 @@ func (l *EventLogStore) aggregatedCodeIntelEvents(ctx context.Context, now time.
 
 	query := sqlf.Sprintf(aggregatedCodeIntelEventsQuery, now, now, sqlf.Join(eventNameQueries, ", "))
 
-	rows, err := l.Query(ctx, query)
+	rows, err := l.ReadOnly(ctx).Query(ctx, query)
 	if err != nil {
 		return nil, err
 	}
@@ -850,7 +850,7 @@ func (l *EventLogStore) AggregatedSearchEvents(ctx context.Context, now time.Tim
 func (l *EventLogStore) aggregatedSearchEvents(ctx context.Context, queryString string, now time.Time) (events []types.SearchAggregatedEvent, err error) {
 	query := sqlf.Sprintf(queryString, now, now, now, now)
 
-	rows, err := l.Query(ctx, query)
+	rows, err := l.ReadOnly(ctx).Query(ctx, query)
 	if err != nil {
 		return nil, err
 	}
@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -46,6 +48,7 @@ type ExternalServiceStore struct {
 	GitHubValidators          []func(*schema.GitHubConnection) error
 	GitLabValidators          []func(*schema.GitLabConnection, []schema.AuthProviders) error
 	BitbucketServerValidators []func(*schema.BitbucketServerConnection) error
+	BitbucketCloudValidators  []func(*schema.BitbucketCloudConnection) error
 	PerforceValidators        []func(*schema.PerforceConnection) error
 
 	key encryption.Key
@@ -60,6 +63,7 @@ func (e *ExternalServiceStore) copy() *ExternalServiceStore {
 		GitHubValidators:          e.GitHubValidators,
 		GitLabValidators:          e.GitLabValidators,
 		BitbucketServerValidators: e.BitbucketServerValidators,
+		BitbucketCloudValidators:  e.BitbucketCloudValidators,
 		PerforceValidators:        e.PerforceValidators,
 	}
 }
@@ -148,8 +152,8 @@ type ExternalServicesListOptions struct {
 	NamespaceUserID int32
 	// When specified, only include external services with given list of kinds.
 	Kinds []string
-	// When specified, only include external services with ID below this number
-	// (because we're sorting results by ID in descending order).
+	// When specified, only include external services after the one with this
+	// ID, in the order given by OrderByDirection.
 	AfterID int64
 	// Possible values are ASC or DESC. Defaults to DESC.
 	OrderByDirection string
@@ -160,6 +164,11 @@ type ExternalServicesListOptions struct {
 	*LimitOffset
 }
 
+// keyset returns the order in which external services are listed.
+func (o ExternalServicesListOptions) keyset() Keyset {
+	return Keyset{{Name: "id", Descending: o.OrderByDirection != "ASC"}}
+}
+
 func (o ExternalServicesListOptions) sqlConditions() []*sqlf.Query {
 	conds := []*sqlf.Query{sqlf.Sprintf("deleted_at IS NULL")}
 	if len(o.IDs) > 0 {
@@ -182,7 +191,10 @@ func (o ExternalServicesListOptions) sqlConditions() []*sqlf.Query {
 		conds = append(conds, sqlf.Sprintf("kind IN (%s)", sqlf.Join(kinds, ",")))
 	}
 	if o.AfterID > 0 {
-		conds = append(conds, sqlf.Sprintf(`id < %d`, o.AfterID))
+		// The cursor always has as many values as the keyset has columns, so
+		// this can't fail.
+		after, _ := o.keyset().After(KeysetCursor{strconv.FormatInt(o.AfterID, 10)})
+		conds = append(conds, after)
 	}
 	if o.OnlyCloudDefault {
 		conds = append(conds, sqlf.Sprintf("cloud_default = true"))
@@ -400,7 +412,14 @@ func (e *ExternalServiceStore) validateBitbucketServerConnection(ctx context.Con
 }
 
 func (e *ExternalServiceStore) validateBitbucketCloudConnection(ctx context.Context, id int64, c *schema.BitbucketCloudConnection) error {
-	return e.validateDuplicateRateLimits(ctx, id, extsvc.KindBitbucketCloud, c)
+	err := new(multierror.Error)
+	for _, validate := range e.BitbucketCloudValidators {
+		err = multierror.Append(err, validate(c))
+	}
+
+	err = multierror.Append(err, e.validateDuplicateRateLimits(ctx, id, extsvc.KindBitbucketCloud, c))
+
+	return err.ErrorOrNil()
 }
 
 func (e *ExternalServiceStore) validatePerforceConnection(ctx context.Context, id int64, c *schema.PerforceConnection) error {
@@ -535,7 +554,7 @@ func upsertAuthorizationToExternalService(kind, config string) (string, error) {
 // recalculated based on whether "authorization" field is presented in
 // `es.Config`. For Sourcegraph Cloud, the `es.Unrestricted` will always be
 // false (i.e. enforce permissions).
-func (e *ExternalServiceStore) Create(ctx context.Context, confGet func() *conf.Unified, es *types.ExternalService) error {
+func (e *ExternalServiceStore) Create(ctx context.Context, confGet func() *conf.Unified, es *types.ExternalService) (err error) {
 	if Mocks.ExternalServices.Create != nil {
 		return Mocks.ExternalServices.Create(ctx, confGet, es)
 	}
@@ -577,11 +596,43 @@ func (e *ExternalServiceStore) Create(ctx context.Context, confGet func() *conf.
 		return err
 	}
 
-	return e.Store.Handle().DB().QueryRowContext(
+	tx, err := e.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	if err := tx.Handle().DB().QueryRowContext(
 		ctx,
 		"INSERT INTO external_services(kind, display_name, config, encryption_key_id, created_at, updated_at, namespace_user_id, unrestricted, cloud_default) VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING id",
 		es.Kind, es.DisplayName, config, keyID, es.CreatedAt, es.UpdatedAt, nullInt32Column(es.NamespaceUserID), es.Unrestricted, es.CloudDefault,
-	).Scan(&es.ID)
+	).Scan(&es.ID); err != nil {
+		return err
+	}
+
+	return AuditLogsWith(tx).Record(ctx, &AuditLogEvent{
+		Action:      AuditLogActionExternalServiceCreated,
+		SubjectType: AuditLogSubjectExternalService,
+		SubjectID:   es.ID,
+		After:       externalServiceAuditLogState(es),
+	})
+}
+
+// externalServiceAuditLogState returns the state of es recorded in the audit
+// log, with the secrets in its config redacted.
+func externalServiceAuditLogState(es *types.ExternalService) interface{} {
+	redacted := *es
+	if err := redacted.RedactConfigSecrets(); err != nil {
+		// Don't risk recording secrets of configs we fail to parse.
+		redacted.Config = types.RedactedSecret
+	}
+	return map[string]interface{}{
+		"kind":            redacted.Kind,
+		"displayName":     redacted.DisplayName,
+		"config":          redacted.Config,
+		"namespaceUserID": redacted.NamespaceUserID,
+		"cloudDefault":    redacted.CloudDefault,
+	}
 }
 
 // maybeEncryptConfig encrypts and returns externals service config if an encryption.Key is configured
@@ -876,14 +927,19 @@ func (e *ExternalServiceStore) Update(ctx context.Context, ps []schema.AuthProvi
 		}
 		return nil
 	}
-	tx, err := e.Store.Handle().Transact(ctx)
+	tx, err := e.Transact(ctx)
 	if err != nil {
 		return err
 	}
 	defer func() { err = tx.Done(err) }()
 
+	before, err := tx.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
 	if update.DisplayName != nil {
-		if err := execUpdate(ctx, tx.DB(), sqlf.Sprintf("display_name=%s", update.DisplayName)); err != nil {
+		if err := execUpdate(ctx, tx.Handle().DB(), sqlf.Sprintf("display_name=%s", update.DisplayName)); err != nil {
 			return err
 		}
 	}
@@ -891,17 +947,28 @@ func (e *ExternalServiceStore) Update(ctx context.Context, ps []schema.AuthProvi
 	if update.Config != nil {
 		unrestricted := !envvar.SourcegraphDotComMode() && !gjson.GetBytes(normalized, "authorization").Exists()
 		q := sqlf.Sprintf(`config = %s, encryption_key_id = %s, next_sync_at = NOW(), unrestricted = %s`, update.Config, keyID, unrestricted)
-		if err := execUpdate(ctx, tx.DB(), q); err != nil {
+		if err := execUpdate(ctx, tx.Handle().DB(), q); err != nil {
 			return err
 		}
 	}
 
 	if update.CloudDefault != nil {
-		if err := execUpdate(ctx, tx.DB(), sqlf.Sprintf("cloud_default=%s", update.CloudDefault)); err != nil {
+		if err := execUpdate(ctx, tx.Handle().DB(), sqlf.Sprintf("cloud_default=%s", update.CloudDefault)); err != nil {
 			return err
 		}
 	}
-	return nil
+
+	after, err := tx.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	return AuditLogsWith(tx).Record(ctx, &AuditLogEvent{
+		Action:      AuditLogActionExternalServiceUpdated,
+		SubjectType: AuditLogSubjectExternalService,
+		SubjectID:   id,
+		Before:      externalServiceAuditLogState(before),
+		After:       externalServiceAuditLogState(after),
+	})
 }
 
 type externalServiceNotFoundError struct {
@@ -931,6 +998,11 @@ func (e *ExternalServiceStore) Delete(ctx context.Context, id int64) (err error)
 	}
 	defer func() { err = tx.Done(err) }()
 
+	before, err := tx.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
 	// Create a temporary table where we'll store repos affected by the deletion of
 	// the external service
 	if err := tx.Exec(ctx, sqlf.Sprintf(`
@@ -995,7 +1067,12 @@ CREATE TEMPORARY TABLE IF NOT EXISTS
 	if nrows == 0 {
 		return externalServiceNotFoundError{id: id}
 	}
-	return nil
+	return AuditLogsWith(tx).Record(ctx, &AuditLogEvent{
+		Action:      AuditLogActionExternalServiceDeleted,
+		SubjectType: AuditLogSubjectExternalService,
+		SubjectID:   id,
+		Before:      externalServiceAuditLogState(before),
+	})
 }
 
 // GetByID returns the external service for id.
@@ -1165,17 +1242,14 @@ WHERE deleted_at IS NULL
 }
 
 func (e *ExternalServiceStore) list(ctx context.Context, opt ExternalServicesListOptions) ([]*types.ExternalService, error) {
-	if opt.OrderByDirection != "ASC" {
-		opt.OrderByDirection = "DESC"
-	}
-
 	q := sqlf.Sprintf(`
 		SELECT id, kind, display_name, config, encryption_key_id, created_at, updated_at, deleted_at, last_sync_at, next_sync_at, namespace_user_id, unrestricted, cloud_default
 		FROM external_services
 		WHERE (%s)
-		ORDER BY id `+opt.OrderByDirection+`
+		%s
 		%s`,
 		sqlf.Join(opt.sqlConditions(), ") AND ("),
+		opt.keyset().OrderBy(),
 		opt.LimitOffset.SQL(),
 	)
 
@@ -1297,6 +1371,166 @@ WHERE EXISTS(
 	return v && exists, nil
 }
 
+// RecordSyncStats records the telemetry of the latest sync of an external
+// service. The consecutive failures and error counts accumulate over syncs, so
+// those fields of stats are ignored.
+func (e *ExternalServiceStore) RecordSyncStats(ctx context.Context, stats *types.ExternalServiceSyncStats) error {
+	e.ensureStore()
+
+	var (
+		lastSuccessAt       *time.Time
+		consecutiveFailures int
+		errorCounts         = map[string]int{}
+	)
+	if stats.Error == "" {
+		lastSuccessAt = &stats.FinishedAt
+	} else {
+		consecutiveFailures = 1
+		errorCounts[stats.ErrorClass] = 1
+	}
+	counts, err := json.Marshal(errorCounts)
+	if err != nil {
+		return err
+	}
+
+	var (
+		rateLimitRemaining *int
+		rateLimitResetAt   *time.Time
+	)
+	if stats.RateLimitKnown {
+		rateLimitRemaining = &stats.RateLimitRemaining
+		rateLimitResetAt = &stats.RateLimitResetAt
+	}
+
+	return e.Exec(ctx, sqlf.Sprintf(
+		recordSyncStatsQueryFmtstr,
+		stats.ExternalServiceID,
+		stats.StartedAt,
+		stats.FinishedAt,
+		dbutil.NullTime{Time: lastSuccessAt},
+		stats.ReposSourced,
+		stats.ReposAdded,
+		stats.ReposModified,
+		stats.ReposRemoved,
+		dbutil.NewNullString(stats.Error),
+		dbutil.NewNullString(stats.ErrorClass),
+		consecutiveFailures,
+		string(counts),
+		dbutil.NullInt{N: rateLimitRemaining},
+		dbutil.NullTime{Time: rateLimitResetAt},
+	))
+}
+
+const recordSyncStatsQueryFmtstr = `
+-- source: internal/database/external_services.go:ExternalServiceStore.RecordSyncStats
+INSERT INTO external_service_sync_stats (
+	external_service_id,
+	started_at,
+	finished_at,
+	last_success_at,
+	repos_sourced,
+	repos_added,
+	repos_modified,
+	repos_removed,
+	error,
+	error_class,
+	consecutive_failures,
+	error_counts,
+	rate_limit_remaining,
+	rate_limit_reset_at
+)
+VALUES (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s::jsonb, %s, %s)
+ON CONFLICT (external_service_id) DO UPDATE SET
+	started_at = excluded.started_at,
+	finished_at = excluded.finished_at,
+	last_success_at = COALESCE(excluded.last_success_at, external_service_sync_stats.last_success_at),
+	repos_sourced = excluded.repos_sourced,
+	repos_added = excluded.repos_added,
+	repos_modified = excluded.repos_modified,
+	repos_removed = excluded.repos_removed,
+	error = excluded.error,
+	error_class = excluded.error_class,
+	consecutive_failures = CASE
+		WHEN excluded.error IS NULL THEN 0
+		ELSE external_service_sync_stats.consecutive_failures + 1
+	END,
+	error_counts = CASE
+		WHEN excluded.error IS NULL THEN external_service_sync_stats.error_counts
+		ELSE external_service_sync_stats.error_counts || jsonb_build_object(
+			excluded.error_class,
+			COALESCE((external_service_sync_stats.error_counts->>excluded.error_class)::integer, 0) + 1
+		)
+	END,
+	rate_limit_remaining = excluded.rate_limit_remaining,
+	rate_limit_reset_at = excluded.rate_limit_reset_at
+`
+
+// GetSyncStats returns the telemetry of the latest sync of the external
+// service with the given id, or nil if it was never synced.
+//
+// 🚨 SECURITY: The caller must ensure that the actor is a site admin or owner of the external service.
+func (e *ExternalServiceStore) GetSyncStats(ctx context.Context, id int64) (*types.ExternalServiceSyncStats, error) {
+	e.ensureStore()
+
+	q := sqlf.Sprintf(`
+-- source: internal/database/external_services.go:ExternalServiceStore.GetSyncStats
+SELECT
+	external_service_id,
+	started_at,
+	finished_at,
+	last_success_at,
+	repos_sourced,
+	repos_added,
+	repos_modified,
+	repos_removed,
+	error,
+	error_class,
+	consecutive_failures,
+	error_counts,
+	rate_limit_remaining,
+	rate_limit_reset_at
+FROM external_service_sync_stats
+WHERE external_service_id = %s
+`, id)
+
+	var (
+		stats              types.ExternalServiceSyncStats
+		errorCounts        []byte
+		rateLimitRemaining *int
+	)
+	err := e.QueryRow(ctx, q).Scan(
+		&stats.ExternalServiceID,
+		&stats.StartedAt,
+		&stats.FinishedAt,
+		&dbutil.NullTime{Time: &stats.LastSuccessAt},
+		&stats.ReposSourced,
+		&stats.ReposAdded,
+		&stats.ReposModified,
+		&stats.ReposRemoved,
+		&dbutil.NullString{S: &stats.Error},
+		&dbutil.NullString{S: &stats.ErrorClass},
+		&stats.ConsecutiveFailures,
+		&errorCounts,
+		&rateLimitRemaining,
+		&dbutil.NullTime{Time: &stats.RateLimitResetAt},
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "scanning external service sync stats")
+	}
+
+	if err := json.Unmarshal(errorCounts, &stats.ErrorCounts); err != nil {
+		return nil, errors.Wrap(err, "unmarshalling error counts")
+	}
+	if rateLimitRemaining != nil {
+		stats.RateLimitKnown = true
+		stats.RateLimitRemaining = *rateLimitRemaining
+	}
+	return &stats, nil
+}
+
 // MockExternalServices mocks the external services store.
 type MockExternalServices struct {
 	Create           func(ctx context.Context, confGet func() *conf.Unified, externalService *types.ExternalService) error
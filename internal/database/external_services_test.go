@@ -33,6 +33,7 @@ func TestExternalServicesListOptions_sqlConditions(t *testing.T) {
 		namespaceUserID  int32
 		kinds            []string
 		afterID          int64
+		orderByDirection string
 		wantQuery        string
 		onlyCloudDefault bool
 		wantArgs         []interface{}
@@ -69,7 +70,14 @@ func TestExternalServicesListOptions_sqlConditions(t *testing.T) {
 			name:      "has after ID",
 			afterID:   10,
 			wantQuery: "deleted_at IS NULL AND id < $1",
-			wantArgs:  []interface{}{int64(10)},
+			wantArgs:  []interface{}{"10"},
+		},
+		{
+			name:             "has after ID in ascending order",
+			afterID:          10,
+			orderByDirection: "ASC",
+			wantQuery:        "deleted_at IS NULL AND id > $1",
+			wantArgs:         []interface{}{"10"},
 		},
 		{
 			name:             "has OnlyCloudDefault",
@@ -84,6 +92,7 @@ func TestExternalServicesListOptions_sqlConditions(t *testing.T) {
 				NamespaceUserID:  test.namespaceUserID,
 				Kinds:            test.kinds,
 				AfterID:          test.afterID,
+				OrderByDirection: test.orderByDirection,
 				OnlyCloudDefault: test.onlyCloudDefault,
 			}
 			q := sqlf.Join(opts.sqlConditions(), "AND")
@@ -1713,3 +1722,101 @@ VALUES ($1,$2)
 	}
 	assertDue(1*time.Minute, false)
 }
+
+func TestExternalServiceStore_SyncStats(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	t.Parallel()
+	db := dbtest.NewDB(t, "")
+	ctx := context.Background()
+
+	svc := &types.ExternalService{
+		Kind:        extsvc.KindGitHub,
+		DisplayName: "Github - Test",
+		Config:      `{"url": "https://github.com", "token": "abc", "repositoryQuery": ["none"]}`,
+	}
+	store := ExternalServices(db)
+	if err := store.Upsert(ctx, svc); err != nil {
+		t.Fatal(err)
+	}
+
+	if stats, err := store.GetSyncStats(ctx, svc.ID); err != nil {
+		t.Fatal(err)
+	} else if stats != nil {
+		t.Fatalf("expected no stats before the first sync, got %+v", stats)
+	}
+
+	now := timeutil.Now()
+	record := func(stats types.ExternalServiceSyncStats) {
+		t.Helper()
+		stats.ExternalServiceID = svc.ID
+		if err := store.RecordSyncStats(ctx, &stats); err != nil {
+			t.Fatal(err)
+		}
+	}
+	assertStats := func(want *types.ExternalServiceSyncStats) {
+		t.Helper()
+		have, err := store.GetSyncStats(ctx, svc.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, have); diff != "" {
+			t.Fatalf("unexpected stats (-want +got):\n%s", diff)
+		}
+	}
+
+	record(types.ExternalServiceSyncStats{
+		StartedAt:          now,
+		FinishedAt:         now.Add(time.Minute),
+		ReposSourced:       10,
+		ReposAdded:         10,
+		RateLimitKnown:     true,
+		RateLimitRemaining: 4000,
+		RateLimitResetAt:   now.Add(time.Hour),
+	})
+	record(types.ExternalServiceSyncStats{
+		StartedAt:  now.Add(time.Hour),
+		FinishedAt: now.Add(time.Hour + time.Minute),
+		Error:      "bad credentials",
+		ErrorClass: "unauthorized",
+	})
+	record(types.ExternalServiceSyncStats{
+		StartedAt:  now.Add(2 * time.Hour),
+		FinishedAt: now.Add(2*time.Hour + time.Minute),
+		Error:      "bad credentials",
+		ErrorClass: "unauthorized",
+	})
+
+	assertStats(&types.ExternalServiceSyncStats{
+		ExternalServiceID:   svc.ID,
+		StartedAt:           now.Add(2 * time.Hour),
+		FinishedAt:          now.Add(2*time.Hour + time.Minute),
+		LastSuccessAt:       now.Add(time.Minute),
+		Error:               "bad credentials",
+		ErrorClass:          "unauthorized",
+		ConsecutiveFailures: 2,
+		ErrorCounts:         map[string]int{"unauthorized": 2},
+	})
+
+	// A successful sync resets the consecutive failures, but not the error
+	// counts.
+	record(types.ExternalServiceSyncStats{
+		StartedAt:     now.Add(3 * time.Hour),
+		FinishedAt:    now.Add(3*time.Hour + time.Minute),
+		ReposSourced:  9,
+		ReposRemoved:  1,
+		ReposModified: 2,
+	})
+
+	assertStats(&types.ExternalServiceSyncStats{
+		ExternalServiceID: svc.ID,
+		StartedAt:         now.Add(3 * time.Hour),
+		FinishedAt:        now.Add(3*time.Hour + time.Minute),
+		LastSuccessAt:     now.Add(3*time.Hour + time.Minute),
+		ReposSourced:      9,
+		ReposRemoved:      1,
+		ReposModified:     2,
+		ErrorCounts:       map[string]int{"unauthorized": 2},
+	})
+}
@@ -207,6 +207,71 @@ SET (last_error, shard_id, updated_at) =
 	return errors.Wrap(err, "setting last error")
 }
 
+// LogIntegrityFailure records a corruption of a repository clone found by the
+// integrity checks of gitserver. The ID and CreatedAt of f are set from the
+// created row.
+func (s *GitserverRepoStore) LogIntegrityFailure(ctx context.Context, f *types.GitserverRepoIntegrityFailure) error {
+	row := s.QueryRow(ctx, sqlf.Sprintf(`
+-- source: internal/database/gitserver_repos.go:GitserverRepoStore.LogIntegrityFailure
+INSERT INTO gitserver_repo_integrity_failures(repo_id, shard_id, check_name, output, repair)
+VALUES (%s, %s, %s, %s, %s)
+RETURNING id, created_at
+`, f.RepoID, f.ShardID, f.Check, sanitizeToUTF8(f.Output), f.Repair))
+
+	return errors.Wrap(row.Scan(&f.ID, &f.CreatedAt), "logging integrity failure")
+}
+
+// SetIntegrityFailureRepaired records that the repair of the integrity failure
+// with the given ID finished, with the given error or an empty string if it
+// succeeded.
+func (s *GitserverRepoStore) SetIntegrityFailureRepaired(ctx context.Context, id int64, repairError string) error {
+	err := s.Exec(ctx, sqlf.Sprintf(`
+-- source: internal/database/gitserver_repos.go:GitserverRepoStore.SetIntegrityFailureRepaired
+UPDATE gitserver_repo_integrity_failures
+SET repair_error = %s, repaired_at = now()
+WHERE id = %s
+`, dbutil.NewNullString(sanitizeToUTF8(repairError)), id))
+
+	return errors.Wrap(err, "setting integrity failure repaired")
+}
+
+// ListIntegrityFailures returns the most recent integrity failures of the
+// given repo, up to limit.
+func (s *GitserverRepoStore) ListIntegrityFailures(ctx context.Context, id api.RepoID, limit int) (_ []*types.GitserverRepoIntegrityFailure, err error) {
+	rows, err := s.Query(ctx, sqlf.Sprintf(`
+-- source: internal/database/gitserver_repos.go:GitserverRepoStore.ListIntegrityFailures
+SELECT id, repo_id, shard_id, check_name, output, repair, repair_error, repaired_at, created_at
+FROM gitserver_repo_integrity_failures
+WHERE repo_id = %s
+ORDER BY created_at DESC, id DESC
+LIMIT %s
+`, id, limit))
+	if err != nil {
+		return nil, errors.Wrap(err, "listing integrity failures")
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var failures []*types.GitserverRepoIntegrityFailure
+	for rows.Next() {
+		var f types.GitserverRepoIntegrityFailure
+		if err := rows.Scan(
+			&f.ID,
+			&f.RepoID,
+			&f.ShardID,
+			&f.Check,
+			&f.Output,
+			&f.Repair,
+			&dbutil.NullString{S: &f.RepairError},
+			&f.RepairedAt,
+			&f.CreatedAt,
+		); err != nil {
+			return nil, errors.Wrap(err, "scanning integrity failure")
+		}
+		failures = append(failures, &f)
+	}
+	return failures, nil
+}
+
 // sanitizeToUTF8 will remove any null character terminated string. The null character can be
 // represented in one of the following ways in Go:
 //
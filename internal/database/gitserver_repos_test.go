@@ -453,6 +453,75 @@ func TestGitserverRepoUpsert(t *testing.T) {
 	}
 }
 
+func TestGitserverRepoIntegrityFailures(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	db := dbtest.NewDB(t, "")
+	ctx := context.Background()
+
+	repo1 := &types.Repo{
+		Name: "github.com/sourcegraph/repo1",
+		URI:  "github.com/sourcegraph/repo1",
+	}
+	if err := Repos(db).Create(ctx, repo1); err != nil {
+		t.Fatal(err)
+	}
+
+	store := GitserverRepos(db)
+	fsck := &types.GitserverRepoIntegrityFailure{
+		RepoID:  repo1.ID,
+		ShardID: "test",
+		Check:   "fsck",
+		Output:  "error: object file is empty\x00",
+		Repair:  "reclone",
+	}
+	if err := store.LogIntegrityFailure(ctx, fsck); err != nil {
+		t.Fatal(err)
+	}
+	fetch := &types.GitserverRepoIntegrityFailure{
+		RepoID:  repo1.ID,
+		ShardID: "test",
+		Check:   "last-fetch",
+		Output:  "missing object",
+		Repair:  "refetch",
+	}
+	if err := store.LogIntegrityFailure(ctx, fetch); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.SetIntegrityFailureRepaired(ctx, fsck.ID, ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.SetIntegrityFailureRepaired(ctx, fetch.ID, "fetch failed"); err != nil {
+		t.Fatal(err)
+	}
+
+	failures, err := store.ListIntegrityFailures(ctx, repo1.ID, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fsck.Output = "error: object file is empty"
+	fetch.RepairError = "fetch failed"
+	want := []*types.GitserverRepoIntegrityFailure{fetch, fsck}
+	if diff := cmp.Diff(want, failures, cmpopts.IgnoreFields(types.GitserverRepoIntegrityFailure{}, "RepairedAt")); diff != "" {
+		t.Fatal(diff)
+	}
+	for _, f := range failures {
+		if f.RepairedAt == nil {
+			t.Errorf("integrity failure %d has no repair time", f.ID)
+		}
+	}
+
+	if failures, err = store.ListIntegrityFailures(ctx, repo1.ID, 1); err != nil {
+		t.Fatal(err)
+	} else if len(failures) != 1 {
+		t.Fatalf("got %d integrity failures, want 1", len(failures))
+	}
+}
+
 func TestSanitizeToUTF8(t *testing.T) {
 	testSet := map[string]string{
 		"test\x00":     "test",
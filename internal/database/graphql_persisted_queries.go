@@ -0,0 +1,204 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+)
+
+// PersistedQuery is a GraphQL query which clients can run by sending only its
+// hash.
+type PersistedQuery struct {
+	// Hash is the hex-encoded SHA-256 hash of Query.
+	Hash  string
+	Query string
+	// Allowed is whether a site admin added the query to the allow-list.
+	Allowed   bool
+	CreatedAt time.Time
+	// LastUsedAt is when the query was last run by its hash, updated at
+	// most hourly.
+	LastUsedAt time.Time
+}
+
+// MaxPersistedQuerySize is the maximum size in bytes of a query which clients
+// can register.
+const MaxPersistedQuerySize = 64 * 1024
+
+// maxPersistedQueries is the maximum number of persisted queries which are
+// not on the allow-list. Beyond it, the least recently used ones are evicted,
+// so that clients can't grow the table without bound. It is a variable so
+// that tests can lower it.
+var maxPersistedQueries = 10000
+
+// PersistedQueryStore manages the persisted GraphQL queries.
+type PersistedQueryStore struct {
+	*basestore.Store
+}
+
+// PersistedQueries instantiates and returns a new PersistedQueryStore with prepared statements.
+func PersistedQueries(db dbutil.DB) *PersistedQueryStore {
+	return &PersistedQueryStore{Store: basestore.NewWithDB(db, sql.TxOptions{})}
+}
+
+// PersistedQueriesWith instantiates and returns a new PersistedQueryStore using the other store handle.
+func PersistedQueriesWith(other basestore.ShareableStore) *PersistedQueryStore {
+	return &PersistedQueryStore{Store: basestore.NewWithHandle(other.Handle())}
+}
+
+func (s *PersistedQueryStore) With(other basestore.ShareableStore) *PersistedQueryStore {
+	return &PersistedQueryStore{Store: s.Store.With(other)}
+}
+
+func (s *PersistedQueryStore) Transact(ctx context.Context) (*PersistedQueryStore, error) {
+	txBase, err := s.Store.Transact(ctx)
+	return &PersistedQueryStore{Store: txBase}, err
+}
+
+const persistedQueryColumns = "hash, query, allowed, created_at, last_used_at"
+
+// GetByHash returns the persisted query with the given hash, and marks it as
+// used. It returns false if no query with this hash was persisted.
+func (s *PersistedQueryStore) GetByHash(ctx context.Context, hash string) (*PersistedQuery, bool, error) {
+	if Mocks.PersistedQueries.GetByHash != nil {
+		return Mocks.PersistedQueries.GetByHash(ctx, hash)
+	}
+
+	var q PersistedQuery
+	err := scanPersistedQuery(s.QueryRow(ctx, sqlf.Sprintf(getPersistedQueryByHashQuery, hash, hash)), &q)
+	if err == sql.ErrNoRows {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return &q, true, nil
+}
+
+// The last use of a query is updated at most hourly, so that running a
+// persisted query doesn't write to the database every time.
+const getPersistedQueryByHashQuery = `
+-- source: internal/database/graphql_persisted_queries.go:PersistedQueryStore.GetByHash
+WITH touched AS (
+	UPDATE graphql_persisted_queries
+	SET last_used_at = now()
+	WHERE hash = %s AND last_used_at < now() - interval '1 hour'
+)
+SELECT ` + persistedQueryColumns + `
+FROM graphql_persisted_queries
+WHERE hash = %s
+`
+
+// Create persists the query with the given hash. If the query already exists,
+// it is added to the allow-list if allowed is true, and left unchanged
+// otherwise. The caller must ensure that hash is the hash of the query.
+// Queries which are not on the allow-list are evicted, least recently used
+// first, once there are more than maxPersistedQueries of them.
+//
+// 🚨 SECURITY: Only site admins may add queries to the allow-list.
+func (s *PersistedQueryStore) Create(ctx context.Context, hash, query string, allowed bool) (_ *PersistedQuery, err error) {
+	if Mocks.PersistedQueries.Create != nil {
+		return Mocks.PersistedQueries.Create(ctx, hash, query, allowed)
+	}
+
+	tx, err := s.Transact(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	var q PersistedQuery
+	if err := scanPersistedQuery(tx.QueryRow(ctx, sqlf.Sprintf(createPersistedQueryQuery, hash, query, allowed)), &q); err != nil {
+		return nil, err
+	}
+	if !q.Allowed {
+		if err := tx.Exec(ctx, sqlf.Sprintf(evictPersistedQueriesQuery, maxPersistedQueries)); err != nil {
+			return nil, err
+		}
+	}
+	return &q, nil
+}
+
+const createPersistedQueryQuery = `
+-- source: internal/database/graphql_persisted_queries.go:PersistedQueryStore.Create
+INSERT INTO graphql_persisted_queries (hash, query, allowed)
+VALUES (%s, %s, %s)
+ON CONFLICT (hash) DO UPDATE SET allowed = graphql_persisted_queries.allowed OR EXCLUDED.allowed
+RETURNING ` + persistedQueryColumns
+
+const evictPersistedQueriesQuery = `
+-- source: internal/database/graphql_persisted_queries.go:PersistedQueryStore.Create
+DELETE FROM graphql_persisted_queries
+WHERE hash IN (
+	SELECT hash
+	FROM graphql_persisted_queries
+	WHERE NOT allowed
+	ORDER BY last_used_at DESC, created_at DESC
+	OFFSET %s
+)
+`
+
+// Delete deletes the persisted query with the given hash, which also removes
+// it from the allow-list. It is not an error if the query does not exist.
+//
+// 🚨 SECURITY: The caller must ensure that the actor is a site admin.
+func (s *PersistedQueryStore) Delete(ctx context.Context, hash string) error {
+	return s.Exec(ctx, sqlf.Sprintf(`
+-- source: internal/database/graphql_persisted_queries.go:PersistedQueryStore.Delete
+DELETE FROM graphql_persisted_queries WHERE hash = %s
+`, hash))
+}
+
+// PersistedQueriesListOptions specifies the options for listing persisted
+// queries.
+type PersistedQueriesListOptions struct {
+	// AllowedOnly, if true, only lists the queries on the allow-list.
+	AllowedOnly bool
+	*LimitOffset
+}
+
+// List lists the persisted queries matching the options, newest first.
+//
+// 🚨 SECURITY: The caller must ensure that the actor is a site admin.
+func (s *PersistedQueryStore) List(ctx context.Context, opts PersistedQueriesListOptions) (_ []*PersistedQuery, err error) {
+	conds := []*sqlf.Query{sqlf.Sprintf("TRUE")}
+	if opts.AllowedOnly {
+		conds = append(conds, sqlf.Sprintf("allowed"))
+	}
+
+	rows, err := s.Query(ctx, sqlf.Sprintf(`
+-- source: internal/database/graphql_persisted_queries.go:PersistedQueryStore.List
+SELECT `+persistedQueryColumns+`
+FROM graphql_persisted_queries
+WHERE %s
+ORDER BY created_at DESC, hash
+%s
+`, sqlf.Join(conds, "AND"), opts.LimitOffset.SQL()))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var queries []*PersistedQuery
+	for rows.Next() {
+		var q PersistedQuery
+		if err := scanPersistedQuery(rows, &q); err != nil {
+			return nil, err
+		}
+		queries = append(queries, &q)
+	}
+	return queries, nil
+}
+
+func scanPersistedQuery(sc dbutil.Scanner, q *PersistedQuery) error {
+	return sc.Scan(&q.Hash, &q.Query, &q.Allowed, &q.CreatedAt, &q.LastUsedAt)
+}
+
+type MockPersistedQueries struct {
+	GetByHash func(ctx context.Context, hash string) (*PersistedQuery, bool, error)
+	Create    func(ctx context.Context, hash, query string, allowed bool) (*PersistedQuery, error)
+}
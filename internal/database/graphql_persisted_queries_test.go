@@ -0,0 +1,118 @@
+package database
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+)
+
+func TestPersistedQueries(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	t.Parallel()
+	db := dbtest.NewDB(t, "")
+	ctx := context.Background()
+	s := PersistedQueries(db)
+
+	if _, ok, err := s.GetByHash(ctx, "h1"); err != nil {
+		t.Fatal(err)
+	} else if ok {
+		t.Fatal("expected query not to exist")
+	}
+
+	if _, err := s.Create(ctx, "h1", "query A { a }", false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Create(ctx, "h2", "query B { b }", true); err != nil {
+		t.Fatal(err)
+	}
+
+	q, ok, err := s.GetByHash(ctx, "h1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok || q.Query != "query A { a }" || q.Allowed {
+		t.Fatalf("unexpected query: %+v", q)
+	}
+
+	// Persisting a query again does not remove it from the allow-list.
+	if q, err := s.Create(ctx, "h2", "query B { b }", false); err != nil {
+		t.Fatal(err)
+	} else if !q.Allowed {
+		t.Fatal("expected query to stay on the allow-list")
+	}
+
+	// Persisting an allowed query adds it to the allow-list.
+	if q, err := s.Create(ctx, "h1", "query A { a }", true); err != nil {
+		t.Fatal(err)
+	} else if !q.Allowed {
+		t.Fatal("expected query to be added to the allow-list")
+	}
+
+	if err := s.Delete(ctx, "h2"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Create(ctx, "h3", "query C { c }", false); err != nil {
+		t.Fatal(err)
+	}
+
+	allowed, err := s.List(ctx, PersistedQueriesListOptions{AllowedOnly: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(allowed) != 1 || allowed[0].Hash != "h1" {
+		t.Fatalf("unexpected allowed queries: %+v", allowed)
+	}
+	if all, err := s.List(ctx, PersistedQueriesListOptions{}); err != nil {
+		t.Fatal(err)
+	} else if len(all) != 2 {
+		t.Fatalf("unexpected number of queries. want=%d have=%d", 2, len(all))
+	}
+}
+
+func TestPersistedQueries_Evict(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	db := dbtest.NewDB(t, "")
+	ctx := context.Background()
+	s := PersistedQueries(db)
+
+	old := maxPersistedQueries
+	maxPersistedQueries = 2
+	t.Cleanup(func() { maxPersistedQueries = old })
+
+	if _, err := s.Create(ctx, "allowed", "query A { a }", true); err != nil {
+		t.Fatal(err)
+	}
+	for i, hash := range []string{"h1", "h2", "h3"} {
+		if _, err := s.Create(ctx, hash, "query B { b }", false); err != nil {
+			t.Fatal(err)
+		}
+		// Backdate the queries so that they are used in order.
+		if err := s.Exec(ctx, sqlf.Sprintf("UPDATE graphql_persisted_queries SET last_used_at = now() - (%s * interval '1 day') WHERE hash = %s", 3-i, hash)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The least recently used query is evicted, but allowed queries are
+	// kept regardless of how many there are.
+	all, err := s.List(ctx, PersistedQueriesListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var hashes []string
+	for _, q := range all {
+		hashes = append(hashes, q.Hash)
+	}
+	sort.Strings(hashes)
+	if want := []string{"allowed", "h2", "h3"}; !reflect.DeepEqual(hashes, want) {
+		t.Fatalf("unexpected queries. want=%v have=%v", want, hashes)
+	}
+}
@@ -0,0 +1,137 @@
+package database
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/keegancsmith/sqlf"
+)
+
+// KeysetColumn is a column by which a keyset-paginated list is ordered.
+type KeysetColumn struct {
+	// Name is the SQL expression of the column, such as "repo.name".
+	//
+	// 🚨 SECURITY: It is interpolated into queries verbatim, so it must never
+	// come from user input.
+	Name       string
+	Descending bool
+}
+
+// Keyset is the ordering of a keyset-paginated list.
+//
+// Unlike LIMIT/OFFSET pagination, keyset pagination selects the rows after the
+// last row of the previous page by their ordering columns, so that the
+// database can seek to the page in an index instead of scanning and
+// discarding all previous rows. For that to return every row exactly once,
+// the columns must be NOT NULL and unique together, which usually means
+// ending with the primary key.
+type Keyset []KeysetColumn
+
+// OrderBy returns the SQL ORDER BY clause of the keyset.
+func (k Keyset) OrderBy() *sqlf.Query {
+	if len(k) == 0 {
+		return sqlf.Sprintf("")
+	}
+	clauses := make([]string, 0, len(k))
+	for _, c := range k {
+		if c.Descending {
+			clauses = append(clauses, c.Name+" DESC")
+		} else {
+			clauses = append(clauses, c.Name+" ASC")
+		}
+	}
+	return sqlf.Sprintf("ORDER BY " + strings.Join(clauses, ", "))
+}
+
+// After returns the SQL condition selecting the rows after the one at the
+// cursor, or TRUE if the cursor is empty.
+func (k Keyset) After(cursor KeysetCursor) (*sqlf.Query, error) {
+	if len(cursor) == 0 {
+		return sqlf.Sprintf("TRUE"), nil
+	}
+	if len(cursor) != len(k) {
+		return nil, errors.Errorf("invalid cursor: want %d values, got %d", len(k), len(cursor))
+	}
+
+	if len(k) == 1 {
+		return sqlf.Sprintf(k[0].Name+" "+keysetOperator(k[0])+" %s", cursor[0]), nil
+	}
+
+	// When all columns are ordered in the same direction, a row comparison
+	// selects the rows after the cursor and can be answered with a single
+	// index scan.
+	sameDirection := true
+	for _, c := range k[1:] {
+		if c.Descending != k[0].Descending {
+			sameDirection = false
+			break
+		}
+	}
+	if sameDirection {
+		names := make([]string, 0, len(k))
+		values := make([]*sqlf.Query, 0, len(k))
+		for i, c := range k {
+			names = append(names, c.Name)
+			values = append(values, sqlf.Sprintf("%s", cursor[i]))
+		}
+		return sqlf.Sprintf(
+			fmt.Sprintf("(%s) %s (%%s)", strings.Join(names, ", "), keysetOperator(k[0])),
+			sqlf.Join(values, ","),
+		), nil
+	}
+
+	// Otherwise a row is after the cursor if it's equal to it in the first i
+	// columns and after it in column i, for any i.
+	disjuncts := make([]*sqlf.Query, 0, len(k))
+	for i, c := range k {
+		conjuncts := make([]*sqlf.Query, 0, i+1)
+		for j := 0; j < i; j++ {
+			conjuncts = append(conjuncts, sqlf.Sprintf(k[j].Name+" = %s", cursor[j]))
+		}
+		conjuncts = append(conjuncts, sqlf.Sprintf(c.Name+" "+keysetOperator(c)+" %s", cursor[i]))
+		disjuncts = append(disjuncts, sqlf.Sprintf("(%s)", sqlf.Join(conjuncts, "AND")))
+	}
+	return sqlf.Sprintf("(%s)", sqlf.Join(disjuncts, "OR")), nil
+}
+
+func keysetOperator(c KeysetColumn) string {
+	if c.Descending {
+		return "<"
+	}
+	return ">"
+}
+
+// KeysetCursor is the position of a row in a keyset-paginated list: the
+// values of the keyset columns of the row, in order. The values are passed to
+// the database as text, which it converts to the types of the columns.
+type KeysetCursor []string
+
+// Encode returns the opaque string representation of the cursor, which is
+// safe to hand out to API clients.
+func (c KeysetCursor) Encode() string {
+	if len(c) == 0 {
+		return ""
+	}
+	b, _ := json.Marshal([]string(c))
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeKeysetCursor decodes a cursor encoded with KeysetCursor.Encode. The
+// empty string decodes to the empty cursor, which starts at the first row.
+func DecodeKeysetCursor(s string) (KeysetCursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid cursor")
+	}
+	var c KeysetCursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, errors.Wrap(err, "invalid cursor")
+	}
+	return c, nil
+}
@@ -0,0 +1,96 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/keegancsmith/sqlf"
+)
+
+func TestKeyset_After(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		keyset    Keyset
+		cursor    KeysetCursor
+		wantQuery string
+		wantArgs  []interface{}
+		wantErr   bool
+	}{
+		{
+			name:      "empty cursor",
+			keyset:    Keyset{{Name: "id"}},
+			wantQuery: "TRUE",
+		},
+		{
+			name:      "single column",
+			keyset:    Keyset{{Name: "id", Descending: true}},
+			cursor:    KeysetCursor{"10"},
+			wantQuery: "id < $1",
+			wantArgs:  []interface{}{"10"},
+		},
+		{
+			name:      "same direction",
+			keyset:    Keyset{{Name: "name"}, {Name: "id"}},
+			cursor:    KeysetCursor{"a", "10"},
+			wantQuery: "(name, id) > ($1 , $2)",
+			wantArgs:  []interface{}{"a", "10"},
+		},
+		{
+			name:      "mixed directions",
+			keyset:    Keyset{{Name: "created_at", Descending: true}, {Name: "id"}},
+			cursor:    KeysetCursor{"2021-01-01T00:00:00Z", "10"},
+			wantQuery: "((created_at < $1) OR (created_at = $2 AND id > $3))",
+			wantArgs:  []interface{}{"2021-01-01T00:00:00Z", "2021-01-01T00:00:00Z", "10"},
+		},
+		{
+			name:    "wrong number of values",
+			keyset:  Keyset{{Name: "name"}, {Name: "id"}},
+			cursor:  KeysetCursor{"10"},
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			q, err := tc.keyset.After(tc.cursor)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("want error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.wantQuery, q.Query(sqlf.PostgresBindVar)); diff != "" {
+				t.Errorf("query mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantArgs, q.Args()); diff != "" {
+				t.Errorf("args mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestKeyset_OrderBy(t *testing.T) {
+	k := Keyset{{Name: "created_at", Descending: true}, {Name: "id"}}
+	if have, want := k.OrderBy().Query(sqlf.PostgresBindVar), "ORDER BY created_at DESC, id ASC"; have != want {
+		t.Errorf("have %q, want %q", have, want)
+	}
+}
+
+func TestKeysetCursor_Encode(t *testing.T) {
+	c := KeysetCursor{"github.com/foo/bar", "10"}
+	have, err := DecodeKeysetCursor(c.Encode())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(c, have); diff != "" {
+		t.Errorf("mismatch (-want +have):\n%s", diff)
+	}
+
+	if c, err := DecodeKeysetCursor(""); err != nil || c != nil {
+		t.Errorf("want empty cursor, got %v, %v", c, err)
+	}
+	if _, err := DecodeKeysetCursor("not a cursor!"); err == nil {
+		t.Error("want error for invalid cursor")
+	}
+}
@@ -4,9 +4,15 @@ var Mocks MockStores
 
 // MockStores has a field for each store interface with the concrete mock type (to obviate the need for tedious type assertions in test code).
 type MockStores struct {
-	AccessTokens MockAccessTokens
+	AccessTokens     MockAccessTokens
+	AuditLogs        MockAuditLogs
+	OutboxEvents     MockOutboxEvents
+	OutgoingWebhooks MockOutgoingWebhooks
+	PersistedQueries MockPersistedQueries
+	QuotaOverrides   MockQuotaOverrides
 
 	Repos           MockRepos
+	RepoCollections MockRepoCollections
 	Namespaces      MockNamespaces
 	Orgs            MockOrgs
 	OrgMembers      MockOrgMembers
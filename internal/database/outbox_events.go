@@ -0,0 +1,164 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+)
+
+// The topics of the events published to the outbox.
+const (
+	OutboxTopicRepoCreated      = "repo.created"
+	OutboxTopicUserDeleted      = "user.deleted"
+	OutboxTopicUploadCompleted  = "upload.completed"
+	OutboxTopicSavedSearchFired = "saved_search.fired"
+	OutboxTopicChangesetMerged  = "changeset.merged"
+)
+
+// OutboxEventRetention is how long outbox events are kept. Events which were
+// not delivered to a subscription within this time are dropped.
+const OutboxEventRetention = 7 * 24 * time.Hour
+
+// OutboxEvent is a domain event, such as the creation of a repo, which is
+// delivered to the subscriptions of its topic.
+type OutboxEvent struct {
+	ID        int64
+	Topic     string
+	SubjectID string
+	Payload   json.RawMessage
+	CreatedAt time.Time
+}
+
+// OutboxEventStore provides access to the outbox_events table and the
+// subscriptions to it.
+type OutboxEventStore struct {
+	*basestore.Store
+}
+
+// OutboxEvents instantiates and returns a new OutboxEventStore with prepared statements.
+func OutboxEvents(db dbutil.DB) *OutboxEventStore {
+	return &OutboxEventStore{Store: basestore.NewWithDB(db, sql.TxOptions{})}
+}
+
+// OutboxEventsWith instantiates and returns a new OutboxEventStore using the other store handle.
+func OutboxEventsWith(other basestore.ShareableStore) *OutboxEventStore {
+	return &OutboxEventStore{Store: basestore.NewWithHandle(other.Handle())}
+}
+
+func (s *OutboxEventStore) With(other basestore.ShareableStore) *OutboxEventStore {
+	return &OutboxEventStore{Store: s.Store.With(other)}
+}
+
+func (s *OutboxEventStore) Transact(ctx context.Context) (*OutboxEventStore, error) {
+	txBase, err := s.Store.Transact(ctx)
+	return &OutboxEventStore{Store: txBase}, err
+}
+
+// Publish writes an event about the given subject to the outbox. The payload
+// is recorded as JSON. Callers must publish events in the same transaction as
+// the mutation which caused them, so that an event is published if and only if
+// the mutation is committed.
+func (s *OutboxEventStore) Publish(ctx context.Context, topic string, subjectID, payload interface{}) error {
+	if Mocks.OutboxEvents.Publish != nil {
+		return Mocks.OutboxEvents.Publish(ctx, topic, subjectID, payload)
+	}
+
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return s.Exec(ctx, sqlf.Sprintf(publishOutboxEventQuery, topic, fmt.Sprint(subjectID), string(b)))
+}
+
+const publishOutboxEventQuery = `
+-- source: internal/database/outbox_events.go:OutboxEventStore.Publish
+INSERT INTO outbox_events (topic, subject_id, payload) VALUES (%s, %s, %s)
+`
+
+// LockSubscription creates the subscription with the given name if it does
+// not exist yet, and locks it until the end of the transaction. It returns
+// false if the subscription is already locked by another transaction, in
+// which case another dispatcher is delivering its events. It must be called
+// in a transaction.
+func (s *OutboxEventStore) LockSubscription(ctx context.Context, name string) (bool, error) {
+	if err := s.Exec(ctx, sqlf.Sprintf(createOutboxSubscriptionQuery, name)); err != nil {
+		return false, err
+	}
+	_, ok, err := basestore.ScanFirstString(s.Query(ctx, sqlf.Sprintf(lockOutboxSubscriptionQuery, name)))
+	return ok, err
+}
+
+const createOutboxSubscriptionQuery = `
+-- source: internal/database/outbox_events.go:OutboxEventStore.LockSubscription
+INSERT INTO outbox_subscriptions (name) VALUES (%s) ON CONFLICT DO NOTHING
+`
+
+const lockOutboxSubscriptionQuery = `
+-- source: internal/database/outbox_events.go:OutboxEventStore.LockSubscription
+SELECT name FROM outbox_subscriptions WHERE name = %s FOR UPDATE SKIP LOCKED
+`
+
+// ListUndelivered returns up to limit events of the given topics which were
+// created after the subscription and not yet delivered to it, oldest first.
+func (s *OutboxEventStore) ListUndelivered(ctx context.Context, subscription string, topics []string, limit int) (_ []*OutboxEvent, err error) {
+	rows, err := s.Query(ctx, sqlf.Sprintf(listUndeliveredOutboxEventsQuery, subscription, pq.Array(topics), subscription, limit))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var events []*OutboxEvent
+	for rows.Next() {
+		var e OutboxEvent
+		if err := rows.Scan(&e.ID, &e.Topic, &e.SubjectID, &e.Payload, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		events = append(events, &e)
+	}
+	return events, nil
+}
+
+const listUndeliveredOutboxEventsQuery = `
+-- source: internal/database/outbox_events.go:OutboxEventStore.ListUndelivered
+SELECT e.id, e.topic, e.subject_id, e.payload, e.created_at
+FROM outbox_events e
+JOIN outbox_subscriptions s ON s.name = %s
+WHERE
+	e.topic = ANY(%s) AND
+	e.created_at >= s.created_at AND
+	NOT EXISTS (SELECT 1 FROM outbox_deliveries d WHERE d.subscription = %s AND d.event_id = e.id)
+ORDER BY e.id
+LIMIT %s
+`
+
+// MarkDelivered records that the event was delivered to the subscription, so
+// that it is not delivered to it again.
+func (s *OutboxEventStore) MarkDelivered(ctx context.Context, subscription string, eventID int64) error {
+	return s.Exec(ctx, sqlf.Sprintf(markOutboxEventDeliveredQuery, subscription, eventID))
+}
+
+const markOutboxEventDeliveredQuery = `
+-- source: internal/database/outbox_events.go:OutboxEventStore.MarkDelivered
+INSERT INTO outbox_deliveries (subscription, event_id) VALUES (%s, %s) ON CONFLICT DO NOTHING
+`
+
+// DeleteOlderThan deletes the events created before the given time, along
+// with their deliveries.
+func (s *OutboxEventStore) DeleteOlderThan(ctx context.Context, before time.Time) error {
+	return s.Exec(ctx, sqlf.Sprintf(`
+-- source: internal/database/outbox_events.go:OutboxEventStore.DeleteOlderThan
+DELETE FROM outbox_events WHERE created_at < %s
+`, before))
+}
+
+type MockOutboxEvents struct {
+	Publish func(ctx context.Context, topic string, subjectID, payload interface{}) error
+}
@@ -0,0 +1,105 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+)
+
+func TestOutboxEvents(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	t.Parallel()
+	db := dbtest.NewDB(t, "")
+	ctx := context.Background()
+
+	store := OutboxEvents(db)
+
+	// Events published before a subscription exists are not delivered to it.
+	if err := store.Publish(ctx, OutboxTopicRepoCreated, 1, map[string]int{"id": 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err := store.Transact(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := tx.LockSubscription(ctx, "test"); err != nil {
+		t.Fatal(err)
+	} else if !ok {
+		t.Fatal("expected to lock subscription")
+	}
+
+	// A concurrent dispatcher skips the locked subscription.
+	otherTx, err := store.Transact(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := otherTx.LockSubscription(ctx, "test"); err != nil {
+		t.Fatal(err)
+	} else if ok {
+		t.Fatal("expected subscription to be locked")
+	}
+	if err := otherTx.Done(nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Done(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, e := range []struct {
+		topic string
+		id    int
+	}{
+		{OutboxTopicRepoCreated, 2},
+		{OutboxTopicUserDeleted, 3},
+		{OutboxTopicRepoCreated, 4},
+	} {
+		if err := store.Publish(ctx, e.topic, e.id, map[string]int{"id": e.id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	listSubjects := func() []string {
+		events, err := store.ListUndelivered(ctx, "test", []string{OutboxTopicRepoCreated}, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var subjects []string
+		for _, e := range events {
+			subjects = append(subjects, e.SubjectID)
+		}
+		return subjects
+	}
+
+	if diff := cmp.Diff([]string{"2", "4"}, listSubjects()); diff != "" {
+		t.Fatalf("unexpected undelivered events (-want +got):\n%s", diff)
+	}
+
+	events, err := store.ListUndelivered(ctx, "test", []string{OutboxTopicRepoCreated}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || string(events[0].Payload) != `{"id": 2}` {
+		t.Fatalf("unexpected events: %+v", events)
+	}
+	if err := store.MarkDelivered(ctx, "test", events[0].ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff([]string{"4"}, listSubjects()); diff != "" {
+		t.Fatalf("unexpected undelivered events (-want +got):\n%s", diff)
+	}
+
+	if err := store.DeleteOlderThan(ctx, time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if subjects := listSubjects(); len(subjects) != 0 {
+		t.Fatalf("expected no events after deletion, got %v", subjects)
+	}
+}
@@ -0,0 +1,336 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/encryption/keyring"
+)
+
+// OutgoingWebhook is a URL registered by a site admin to receive the events of
+// the given types.
+type OutgoingWebhook struct {
+	ID  int32
+	URL string
+	// Secret is the secret used to sign the payloads delivered to URL.
+	Secret          string
+	EventTypes      []string
+	Enabled         bool
+	CreatedByUserID int32
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// OutgoingWebhookDelivery is the delivery of an event to an outgoing webhook.
+// Deliveries are processed by a dbworker, and failed attempts are retried with
+// exponential backoff.
+type OutgoingWebhookDelivery struct {
+	ID             int
+	WebhookID      int32
+	EventType      string
+	Payload        json.RawMessage
+	State          string
+	FailureMessage *string
+	StartedAt      *time.Time
+	FinishedAt     *time.Time
+	ProcessAfter   *time.Time
+	NumResets      int
+	NumFailures    int
+	// Attempts is the number of times the delivery was attempted.
+	Attempts int
+	// ResponseStatusCode and ResponseBody describe the response to the last
+	// attempt, if any.
+	ResponseStatusCode *int
+	ResponseBody       *string
+	CreatedAt          time.Time
+}
+
+// RecordID implements workerutil.Record.
+func (d *OutgoingWebhookDelivery) RecordID() int {
+	return d.ID
+}
+
+// OutgoingWebhookNotFoundError occurs when an outgoing webhook is not found.
+type OutgoingWebhookNotFoundError struct {
+	id int32
+}
+
+func (e *OutgoingWebhookNotFoundError) Error() string {
+	return fmt.Sprintf("outgoing webhook not found: %d", e.id)
+}
+
+func (e *OutgoingWebhookNotFoundError) NotFound() bool {
+	return true
+}
+
+// OutgoingWebhookStore manages the outgoing webhooks and their deliveries.
+type OutgoingWebhookStore struct {
+	*basestore.Store
+}
+
+// OutgoingWebhooks instantiates and returns a new OutgoingWebhookStore with prepared statements.
+func OutgoingWebhooks(db dbutil.DB) *OutgoingWebhookStore {
+	return &OutgoingWebhookStore{Store: basestore.NewWithDB(db, sql.TxOptions{})}
+}
+
+// OutgoingWebhooksWith instantiates and returns a new OutgoingWebhookStore using the other store handle.
+func OutgoingWebhooksWith(other basestore.ShareableStore) *OutgoingWebhookStore {
+	return &OutgoingWebhookStore{Store: basestore.NewWithHandle(other.Handle())}
+}
+
+func (s *OutgoingWebhookStore) With(other basestore.ShareableStore) *OutgoingWebhookStore {
+	return &OutgoingWebhookStore{Store: s.Store.With(other)}
+}
+
+func (s *OutgoingWebhookStore) Transact(ctx context.Context) (*OutgoingWebhookStore, error) {
+	txBase, err := s.Store.Transact(ctx)
+	return &OutgoingWebhookStore{Store: txBase}, err
+}
+
+const outgoingWebhookColumns = "id, url, secret, encryption_key_id, event_types, enabled, created_by_user_id, created_at, updated_at"
+
+// Create creates an outgoing webhook. The secret is encrypted with the
+// external service key if one is configured. The ID and timestamps of w are
+// set from the database.
+//
+// 🚨 SECURITY: The caller must ensure that the actor is a site admin.
+func (s *OutgoingWebhookStore) Create(ctx context.Context, w *OutgoingWebhook) error {
+	secret, keyID, err := MaybeEncrypt(ctx, keyring.Default().ExternalServiceKey, w.Secret)
+	if err != nil {
+		return err
+	}
+	row := s.QueryRow(ctx, sqlf.Sprintf(`
+-- source: internal/database/outgoing_webhooks.go:OutgoingWebhookStore.Create
+INSERT INTO outgoing_webhooks (url, secret, encryption_key_id, event_types, enabled, created_by_user_id)
+VALUES (%s, %s, %s, %s, %s, %s)
+RETURNING `+outgoingWebhookColumns,
+		w.URL, secret, keyID, pq.Array(w.EventTypes), w.Enabled, nullInt32Column(w.CreatedByUserID),
+	))
+	return scanOutgoingWebhook(ctx, row, w)
+}
+
+// Update updates the URL, secret, event types and enabled flag of an outgoing
+// webhook.
+//
+// 🚨 SECURITY: The caller must ensure that the actor is a site admin.
+func (s *OutgoingWebhookStore) Update(ctx context.Context, w *OutgoingWebhook) error {
+	secret, keyID, err := MaybeEncrypt(ctx, keyring.Default().ExternalServiceKey, w.Secret)
+	if err != nil {
+		return err
+	}
+	row := s.QueryRow(ctx, sqlf.Sprintf(`
+-- source: internal/database/outgoing_webhooks.go:OutgoingWebhookStore.Update
+UPDATE outgoing_webhooks
+SET url = %s, secret = %s, encryption_key_id = %s, event_types = %s, enabled = %s, updated_at = now()
+WHERE id = %s
+RETURNING `+outgoingWebhookColumns,
+		w.URL, secret, keyID, pq.Array(w.EventTypes), w.Enabled, w.ID,
+	))
+	err = scanOutgoingWebhook(ctx, row, w)
+	if err == sql.ErrNoRows {
+		return &OutgoingWebhookNotFoundError{id: w.ID}
+	}
+	return err
+}
+
+// Delete deletes an outgoing webhook and its deliveries.
+//
+// 🚨 SECURITY: The caller must ensure that the actor is a site admin.
+func (s *OutgoingWebhookStore) Delete(ctx context.Context, id int32) error {
+	res, err := s.ExecResult(ctx, sqlf.Sprintf(`
+-- source: internal/database/outgoing_webhooks.go:OutgoingWebhookStore.Delete
+DELETE FROM outgoing_webhooks WHERE id = %s
+`, id))
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return &OutgoingWebhookNotFoundError{id: id}
+	}
+	return nil
+}
+
+// GetByID returns the outgoing webhook with the given ID.
+//
+// 🚨 SECURITY: The caller must ensure that the actor is a site admin, or that
+// the webhook is only used to deliver events.
+func (s *OutgoingWebhookStore) GetByID(ctx context.Context, id int32) (*OutgoingWebhook, error) {
+	var w OutgoingWebhook
+	row := s.QueryRow(ctx, sqlf.Sprintf(`
+-- source: internal/database/outgoing_webhooks.go:OutgoingWebhookStore.GetByID
+SELECT `+outgoingWebhookColumns+` FROM outgoing_webhooks WHERE id = %s
+`, id))
+	if err := scanOutgoingWebhook(ctx, row, &w); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &OutgoingWebhookNotFoundError{id: id}
+		}
+		return nil, err
+	}
+	return &w, nil
+}
+
+// List lists all outgoing webhooks, ordered by ID.
+//
+// 🚨 SECURITY: The caller must ensure that the actor is a site admin.
+func (s *OutgoingWebhookStore) List(ctx context.Context) (_ []*OutgoingWebhook, err error) {
+	rows, err := s.Query(ctx, sqlf.Sprintf(`
+-- source: internal/database/outgoing_webhooks.go:OutgoingWebhookStore.List
+SELECT `+outgoingWebhookColumns+` FROM outgoing_webhooks ORDER BY id
+`))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var webhooks []*OutgoingWebhook
+	for rows.Next() {
+		var w OutgoingWebhook
+		if err := scanOutgoingWebhook(ctx, rows, &w); err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, &w)
+	}
+	return webhooks, nil
+}
+
+func scanOutgoingWebhook(ctx context.Context, sc dbutil.Scanner, w *OutgoingWebhook) error {
+	var keyID string
+	if err := sc.Scan(
+		&w.ID,
+		&w.URL,
+		&w.Secret,
+		&keyID,
+		pq.Array(&w.EventTypes),
+		&w.Enabled,
+		&dbutil.NullInt32{N: &w.CreatedByUserID},
+		&w.CreatedAt,
+		&w.UpdatedAt,
+	); err != nil {
+		return err
+	}
+	secret, err := MaybeDecrypt(ctx, keyring.Default().ExternalServiceKey, w.Secret, keyID)
+	if err != nil {
+		return err
+	}
+	w.Secret = secret
+	return nil
+}
+
+// EnqueueDeliveries queues a delivery of the event to every enabled outgoing
+// webhook which subscribed to its type.
+func (s *OutgoingWebhookStore) EnqueueDeliveries(ctx context.Context, eventType string, payload json.RawMessage) error {
+	if Mocks.OutgoingWebhooks.EnqueueDeliveries != nil {
+		return Mocks.OutgoingWebhooks.EnqueueDeliveries(ctx, eventType, payload)
+	}
+
+	return s.Exec(ctx, sqlf.Sprintf(`
+-- source: internal/database/outgoing_webhooks.go:OutgoingWebhookStore.EnqueueDeliveries
+INSERT INTO outgoing_webhook_deliveries (webhook_id, event_type, payload)
+SELECT id, %s, %s FROM outgoing_webhooks WHERE enabled AND %s = ANY(event_types)
+`, eventType, string(payload), eventType))
+}
+
+// OutgoingWebhookDeliveryColumns are the columns scanned by
+// ScanOutgoingWebhookDeliveries, in order.
+var OutgoingWebhookDeliveryColumns = []*sqlf.Query{
+	sqlf.Sprintf("outgoing_webhook_deliveries.id"),
+	sqlf.Sprintf("outgoing_webhook_deliveries.webhook_id"),
+	sqlf.Sprintf("outgoing_webhook_deliveries.event_type"),
+	sqlf.Sprintf("outgoing_webhook_deliveries.payload"),
+	sqlf.Sprintf("outgoing_webhook_deliveries.state"),
+	sqlf.Sprintf("outgoing_webhook_deliveries.failure_message"),
+	sqlf.Sprintf("outgoing_webhook_deliveries.started_at"),
+	sqlf.Sprintf("outgoing_webhook_deliveries.finished_at"),
+	sqlf.Sprintf("outgoing_webhook_deliveries.process_after"),
+	sqlf.Sprintf("outgoing_webhook_deliveries.num_resets"),
+	sqlf.Sprintf("outgoing_webhook_deliveries.num_failures"),
+	sqlf.Sprintf("outgoing_webhook_deliveries.attempts"),
+	sqlf.Sprintf("outgoing_webhook_deliveries.response_status_code"),
+	sqlf.Sprintf("outgoing_webhook_deliveries.response_body"),
+	sqlf.Sprintf("outgoing_webhook_deliveries.created_at"),
+}
+
+// ScanOutgoingWebhookDeliveries scans the deliveries from the return value of
+// `*Store.Query`.
+func ScanOutgoingWebhookDeliveries(rows *sql.Rows, queryErr error) (_ []*OutgoingWebhookDelivery, err error) {
+	if queryErr != nil {
+		return nil, queryErr
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var deliveries []*OutgoingWebhookDelivery
+	for rows.Next() {
+		var d OutgoingWebhookDelivery
+		if err := rows.Scan(
+			&d.ID,
+			&d.WebhookID,
+			&d.EventType,
+			&d.Payload,
+			&d.State,
+			&d.FailureMessage,
+			&d.StartedAt,
+			&d.FinishedAt,
+			&d.ProcessAfter,
+			&d.NumResets,
+			&d.NumFailures,
+			&d.Attempts,
+			&d.ResponseStatusCode,
+			&d.ResponseBody,
+			&d.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, &d)
+	}
+	return deliveries, nil
+}
+
+// ListDeliveries returns the most recent deliveries to the outgoing webhook,
+// newest first.
+//
+// 🚨 SECURITY: The caller must ensure that the actor is a site admin.
+func (s *OutgoingWebhookStore) ListDeliveries(ctx context.Context, webhookID int32, limit int) ([]*OutgoingWebhookDelivery, error) {
+	return ScanOutgoingWebhookDeliveries(s.Query(ctx, sqlf.Sprintf(`
+-- source: internal/database/outgoing_webhooks.go:OutgoingWebhookStore.ListDeliveries
+SELECT %s FROM outgoing_webhook_deliveries
+WHERE webhook_id = %s
+ORDER BY id DESC
+LIMIT %s
+`, sqlf.Join(OutgoingWebhookDeliveryColumns, ", "), webhookID, limit)))
+}
+
+// RecordDeliveryAttempt records an attempt to deliver an event. The status
+// code is nil if no response was received, and failureMessage is empty if the
+// attempt succeeded.
+func (s *OutgoingWebhookStore) RecordDeliveryAttempt(ctx context.Context, id int, statusCode *int, responseBody, failureMessage string) error {
+	return s.Exec(ctx, sqlf.Sprintf(`
+-- source: internal/database/outgoing_webhooks.go:OutgoingWebhookStore.RecordDeliveryAttempt
+UPDATE outgoing_webhook_deliveries
+SET attempts = attempts + 1, response_status_code = %s, response_body = %s, failure_message = NULLIF(%s, '')
+WHERE id = %s
+`, statusCode, responseBody, failureMessage, id))
+}
+
+// DeleteDeliveriesFinishedBefore deletes the completed and failed deliveries
+// which finished before the given time.
+func (s *OutgoingWebhookStore) DeleteDeliveriesFinishedBefore(ctx context.Context, t time.Time) error {
+	return s.Exec(ctx, sqlf.Sprintf(`
+-- source: internal/database/outgoing_webhooks.go:OutgoingWebhookStore.DeleteDeliveriesFinishedBefore
+DELETE FROM outgoing_webhook_deliveries
+WHERE state IN ('completed', 'failed') AND finished_at < %s
+`, t))
+}
+
+type MockOutgoingWebhooks struct {
+	EnqueueDeliveries func(ctx context.Context, eventType string, payload json.RawMessage) error
+}
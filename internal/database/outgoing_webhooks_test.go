@@ -0,0 +1,126 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+)
+
+func TestOutgoingWebhooks(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	t.Parallel()
+	db := dbtest.NewDB(t, "")
+	ctx := context.Background()
+	s := OutgoingWebhooks(db)
+
+	repos := &OutgoingWebhook{URL: "https://example.com/repos", Secret: "s1", EventTypes: []string{"repo.added"}, Enabled: true}
+	all := &OutgoingWebhook{URL: "https://example.com/all", Secret: "s2", EventTypes: []string{"repo.added", "changeset.merged"}, Enabled: true}
+	disabled := &OutgoingWebhook{URL: "https://example.com/disabled", Secret: "s3", EventTypes: []string{"repo.added"}}
+	for _, w := range []*OutgoingWebhook{repos, all, disabled} {
+		if err := s.Create(ctx, w); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	w, err := s.GetByID(ctx, all.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(all, w); diff != "" {
+		t.Fatalf("unexpected webhook (-want +got):\n%s", diff)
+	}
+
+	// Only enabled webhooks subscribed to the event type receive deliveries.
+	if err := s.EnqueueDeliveries(ctx, "repo.added", json.RawMessage(`{"id":1}`)); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.EnqueueDeliveries(ctx, "changeset.merged", json.RawMessage(`{"id":2}`)); err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		webhook *OutgoingWebhook
+		want    []string
+	}{
+		{webhook: repos, want: []string{"repo.added"}},
+		{webhook: all, want: []string{"changeset.merged", "repo.added"}},
+		{webhook: disabled, want: nil},
+	} {
+		deliveries, err := s.ListDeliveries(ctx, test.webhook.ID, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var eventTypes []string
+		for _, d := range deliveries {
+			if d.State != "queued" || d.Attempts != 0 {
+				t.Errorf("unexpected delivery: %+v", d)
+			}
+			eventTypes = append(eventTypes, d.EventType)
+		}
+		if diff := cmp.Diff(test.want, eventTypes); diff != "" {
+			t.Errorf("unexpected deliveries to %s (-want +got):\n%s", test.webhook.URL, diff)
+		}
+	}
+
+	deliveries, err := s.ListDeliveries(ctx, repos.ID, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	statusCode := 500
+	if err := s.RecordDeliveryAttempt(ctx, deliveries[0].ID, &statusCode, "oops", "unexpected status code 500"); err != nil {
+		t.Fatal(err)
+	}
+	deliveries, err = s.ListDeliveries(ctx, repos.ID, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := deliveries[0]; d.Attempts != 1 || *d.ResponseStatusCode != 500 || *d.ResponseBody != "oops" || *d.FailureMessage != "unexpected status code 500" {
+		t.Errorf("unexpected delivery: %+v", d)
+	}
+
+	// Finished deliveries are deleted by the janitor.
+	if err := s.Exec(ctx, sqlf.Sprintf("UPDATE outgoing_webhook_deliveries SET state = 'completed', finished_at = now() WHERE id = %s", deliveries[0].ID)); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.DeleteDeliveriesFinishedBefore(ctx, time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if deliveries, err := s.ListDeliveries(ctx, repos.ID, 10); err != nil {
+		t.Fatal(err)
+	} else if len(deliveries) != 0 {
+		t.Errorf("expected deliveries to be deleted, got %d", len(deliveries))
+	}
+
+	disabled.Enabled = true
+	disabled.Secret = "s4"
+	if err := s.Update(ctx, disabled); err != nil {
+		t.Fatal(err)
+	}
+	if w, err := s.GetByID(ctx, disabled.ID); err != nil {
+		t.Fatal(err)
+	} else if !w.Enabled || w.Secret != "s4" {
+		t.Errorf("unexpected webhook: %+v", w)
+	}
+
+	if err := s.Delete(ctx, all.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.GetByID(ctx, all.ID); !errcode.IsNotFound(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+	webhooks, err := s.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(webhooks) != 2 {
+		t.Errorf("unexpected number of webhooks. want=%d have=%d", 2, len(webhooks))
+	}
+}
@@ -0,0 +1,186 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+)
+
+// QuotaOverride overrides the site-wide limit of a usage quota for a user or
+// for the members of an org. Exactly one of UserID and OrgID is set.
+type QuotaOverride struct {
+	ID     int32
+	Quota  string
+	UserID int32
+	OrgID  int32
+	// Limit is the limit per quota window. A nil limit means unlimited.
+	Limit     *int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// QuotaOverrideStore manages the overrides of usage quotas.
+type QuotaOverrideStore struct {
+	*basestore.Store
+}
+
+// QuotaOverrides instantiates and returns a new QuotaOverrideStore with prepared statements.
+func QuotaOverrides(db dbutil.DB) *QuotaOverrideStore {
+	return &QuotaOverrideStore{Store: basestore.NewWithDB(db, sql.TxOptions{})}
+}
+
+// QuotaOverridesWith instantiates and returns a new QuotaOverrideStore using the other store handle.
+func QuotaOverridesWith(other basestore.ShareableStore) *QuotaOverrideStore {
+	return &QuotaOverrideStore{Store: basestore.NewWithHandle(other.Handle())}
+}
+
+func (s *QuotaOverrideStore) With(other basestore.ShareableStore) *QuotaOverrideStore {
+	return &QuotaOverrideStore{Store: s.Store.With(other)}
+}
+
+func (s *QuotaOverrideStore) Transact(ctx context.Context) (*QuotaOverrideStore, error) {
+	txBase, err := s.Store.Transact(ctx)
+	return &QuotaOverrideStore{Store: txBase}, err
+}
+
+const quotaOverrideColumns = "id, quota, user_id, org_id, quota_limit, created_at, updated_at"
+
+// Upsert creates the override of the quota for the user or org of o, or
+// replaces its limit if it already exists. The ID and timestamps of o are set
+// from the database.
+//
+// 🚨 SECURITY: The caller must ensure that the actor is a site admin.
+func (s *QuotaOverrideStore) Upsert(ctx context.Context, o *QuotaOverride) error {
+	conflictTarget := sqlf.Sprintf("(user_id, quota) WHERE user_id IS NOT NULL")
+	if o.OrgID != 0 {
+		conflictTarget = sqlf.Sprintf("(org_id, quota) WHERE org_id IS NOT NULL")
+	}
+
+	row := s.QueryRow(ctx, sqlf.Sprintf(`
+-- source: internal/database/quota_overrides.go:QuotaOverrideStore.Upsert
+INSERT INTO quota_overrides (quota, user_id, org_id, quota_limit)
+VALUES (%s, %s, %s, %s)
+ON CONFLICT %s DO UPDATE SET quota_limit = EXCLUDED.quota_limit, updated_at = now()
+RETURNING `+quotaOverrideColumns,
+		o.Quota, nullInt32Column(o.UserID), nullInt32Column(o.OrgID), o.Limit, conflictTarget,
+	))
+	return scanQuotaOverride(row, o)
+}
+
+// Delete deletes the override of the quota for the given user or org. Exactly
+// one of userID and orgID must be non-zero. It is not an error if the override
+// does not exist.
+//
+// 🚨 SECURITY: The caller must ensure that the actor is a site admin.
+func (s *QuotaOverrideStore) Delete(ctx context.Context, quota string, userID, orgID int32) error {
+	return s.Exec(ctx, sqlf.Sprintf(`
+-- source: internal/database/quota_overrides.go:QuotaOverrideStore.Delete
+DELETE FROM quota_overrides
+WHERE quota = %s AND user_id IS NOT DISTINCT FROM %s AND org_id IS NOT DISTINCT FROM %s
+`, quota, nullInt32Column(userID), nullInt32Column(orgID)))
+}
+
+// QuotaOverridesListOptions specifies the options for listing quota
+// overrides.
+type QuotaOverridesListOptions struct {
+	// UserID, if non-zero, only lists the overrides of this user.
+	UserID int32
+	// OrgID, if non-zero, only lists the overrides of this org.
+	OrgID int32
+}
+
+// List lists the quota overrides matching the options, ordered by ID.
+func (s *QuotaOverrideStore) List(ctx context.Context, opts QuotaOverridesListOptions) (_ []*QuotaOverride, err error) {
+	conds := []*sqlf.Query{sqlf.Sprintf("TRUE")}
+	if opts.UserID != 0 {
+		conds = append(conds, sqlf.Sprintf("user_id = %s", opts.UserID))
+	}
+	if opts.OrgID != 0 {
+		conds = append(conds, sqlf.Sprintf("org_id = %s", opts.OrgID))
+	}
+
+	rows, err := s.Query(ctx, sqlf.Sprintf(`
+-- source: internal/database/quota_overrides.go:QuotaOverrideStore.List
+SELECT `+quotaOverrideColumns+`
+FROM quota_overrides
+WHERE %s
+ORDER BY id
+`, sqlf.Join(conds, "AND")))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var overrides []*QuotaOverride
+	for rows.Next() {
+		var o QuotaOverride
+		if err := scanQuotaOverride(rows, &o); err != nil {
+			return nil, err
+		}
+		overrides = append(overrides, &o)
+	}
+	return overrides, nil
+}
+
+// GetLimitForUser returns the overridden limit of the quota for the user. An
+// override for the user takes precedence over the overrides for their orgs,
+// of which the most generous applies. It returns false if no override applies
+// to the user, and a nil limit if the quota is unlimited for them.
+func (s *QuotaOverrideStore) GetLimitForUser(ctx context.Context, quota string, userID int32) (limit *int64, ok bool, err error) {
+	if Mocks.QuotaOverrides.GetLimitForUser != nil {
+		return Mocks.QuotaOverrides.GetLimitForUser(ctx, quota, userID)
+	}
+
+	rows, err := s.Query(ctx, sqlf.Sprintf(`
+-- source: internal/database/quota_overrides.go:QuotaOverrideStore.GetLimitForUser
+SELECT user_id IS NOT NULL, quota_limit
+FROM quota_overrides
+WHERE quota = %s AND (
+	user_id = %s OR
+	org_id IN (SELECT org_id FROM org_members WHERE user_id = %s)
+)
+`, quota, userID, userID))
+	if err != nil {
+		return nil, false, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var orgLimit *int64
+	var orgOK bool
+	for rows.Next() {
+		var isUser bool
+		var l *int64
+		if err := rows.Scan(&isUser, &l); err != nil {
+			return nil, false, err
+		}
+		if isUser {
+			return l, true, nil
+		}
+		if !orgOK || (orgLimit != nil && (l == nil || *l > *orgLimit)) {
+			orgLimit = l
+		}
+		orgOK = true
+	}
+	return orgLimit, orgOK, nil
+}
+
+func scanQuotaOverride(sc dbutil.Scanner, o *QuotaOverride) error {
+	return sc.Scan(
+		&o.ID,
+		&o.Quota,
+		&dbutil.NullInt32{N: &o.UserID},
+		&dbutil.NullInt32{N: &o.OrgID},
+		&o.Limit,
+		&o.CreatedAt,
+		&o.UpdatedAt,
+	)
+}
+
+type MockQuotaOverrides struct {
+	GetLimitForUser func(ctx context.Context, quota string, userID int32) (*int64, bool, error)
+}
@@ -0,0 +1,97 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+)
+
+func TestQuotaOverrides(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	t.Parallel()
+	db := dbtest.NewDB(t, "")
+	ctx := context.Background()
+	s := QuotaOverrides(db)
+
+	user, err := Users(db).Create(ctx, NewUser{Username: "alice"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var orgIDs []int32
+	for _, name := range []string{"acme", "globex"} {
+		org, err := Orgs(db).Create(ctx, name, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := OrgMembers(db).Create(ctx, org.ID, user.ID); err != nil {
+			t.Fatal(err)
+		}
+		orgIDs = append(orgIDs, org.ID)
+	}
+
+	int64Ptr := func(n int64) *int64 { return &n }
+
+	assertLimit := func(t *testing.T, wantLimit *int64, wantOK bool) {
+		t.Helper()
+		limit, ok, err := s.GetLimitForUser(ctx, "searches", user.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok != wantOK {
+			t.Fatalf("unexpected ok. want=%v have=%v", wantOK, ok)
+		}
+		if (limit == nil) != (wantLimit == nil) || (limit != nil && *limit != *wantLimit) {
+			t.Fatalf("unexpected limit. want=%v have=%v", wantLimit, limit)
+		}
+	}
+
+	assertLimit(t, nil, false)
+
+	// The most generous org override applies.
+	for i, limit := range []int64{10, 20} {
+		if err := s.Upsert(ctx, &QuotaOverride{Quota: "searches", OrgID: orgIDs[i], Limit: int64Ptr(limit)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	assertLimit(t, int64Ptr(20), true)
+
+	// Upserting replaces the limit.
+	if err := s.Upsert(ctx, &QuotaOverride{Quota: "searches", OrgID: orgIDs[0], Limit: nil}); err != nil {
+		t.Fatal(err)
+	}
+	assertLimit(t, nil, true)
+
+	// An override for the user takes precedence.
+	o := &QuotaOverride{Quota: "searches", UserID: user.ID, Limit: int64Ptr(5)}
+	if err := s.Upsert(ctx, o); err != nil {
+		t.Fatal(err)
+	}
+	if o.ID == 0 || o.UpdatedAt.IsZero() {
+		t.Fatalf("expected override to be populated from the database, got %+v", o)
+	}
+	assertLimit(t, int64Ptr(5), true)
+
+	overrides, err := s.List(ctx, QuotaOverridesListOptions{UserID: user.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(overrides) != 1 || overrides[0].ID != o.ID {
+		t.Fatalf("unexpected overrides: %+v", overrides)
+	}
+	if overrides, err := s.List(ctx, QuotaOverridesListOptions{}); err != nil {
+		t.Fatal(err)
+	} else if len(overrides) != 3 {
+		t.Fatalf("unexpected number of overrides. want=%d have=%d", 3, len(overrides))
+	}
+
+	if err := s.Delete(ctx, "searches", user.ID, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Delete(ctx, "searches", 0, orgIDs[0]); err != nil {
+		t.Fatal(err)
+	}
+	assertLimit(t, int64Ptr(20), true)
+}
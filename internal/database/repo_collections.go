@@ -0,0 +1,356 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/jackc/pgconn"
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// RepoCollection is a named group of repositories owned by a user or an org.
+// Exactly one of UserID and OrgID is set.
+type RepoCollection struct {
+	ID          int32
+	Name        string
+	Description string
+	UserID      int32
+	OrgID       int32
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// RepoCollectionNotFoundError occurs when a repo collection is not found.
+type RepoCollectionNotFoundError struct {
+	id int32
+}
+
+func (e *RepoCollectionNotFoundError) Error() string {
+	return fmt.Sprintf("repo collection not found: %d", e.id)
+}
+
+func (e *RepoCollectionNotFoundError) NotFound() bool {
+	return true
+}
+
+var errRepoCollectionNameExists = errors.New("a repo collection with this name already exists in the namespace")
+
+// RepoCollectionStore manages the repo collections of users and orgs.
+type RepoCollectionStore struct {
+	*basestore.Store
+}
+
+// RepoCollections instantiates and returns a new RepoCollectionStore with prepared statements.
+func RepoCollections(db dbutil.DB) *RepoCollectionStore {
+	return &RepoCollectionStore{Store: basestore.NewWithDB(db, sql.TxOptions{})}
+}
+
+// RepoCollectionsWith instantiates and returns a new RepoCollectionStore using the other store handle.
+func RepoCollectionsWith(other basestore.ShareableStore) *RepoCollectionStore {
+	return &RepoCollectionStore{Store: basestore.NewWithHandle(other.Handle())}
+}
+
+func (s *RepoCollectionStore) With(other basestore.ShareableStore) *RepoCollectionStore {
+	return &RepoCollectionStore{Store: s.Store.With(other)}
+}
+
+func (s *RepoCollectionStore) Transact(ctx context.Context) (*RepoCollectionStore, error) {
+	txBase, err := s.Store.Transact(ctx)
+	return &RepoCollectionStore{Store: txBase}, err
+}
+
+const repoCollectionColumns = "id, name, description, user_id, org_id, created_at, updated_at"
+
+// Create creates a repo collection. The ID and timestamps of c are set from
+// the database.
+//
+// 🚨 SECURITY: The caller must ensure that the actor can manage the collections
+// of the owner of c.
+func (s *RepoCollectionStore) Create(ctx context.Context, c *RepoCollection) error {
+	row := s.QueryRow(ctx, sqlf.Sprintf(`
+-- source: internal/database/repo_collections.go:RepoCollectionStore.Create
+INSERT INTO repo_collections (name, description, user_id, org_id)
+VALUES (%s, %s, %s, %s)
+RETURNING `+repoCollectionColumns,
+		c.Name, c.Description, nullInt32Column(c.UserID), nullInt32Column(c.OrgID),
+	))
+	return mapRepoCollectionError(scanRepoCollection(row, c))
+}
+
+// Update updates the name and the description of a repo collection.
+//
+// 🚨 SECURITY: The caller must ensure that the actor can manage the collections
+// of the owner of c.
+func (s *RepoCollectionStore) Update(ctx context.Context, c *RepoCollection) error {
+	row := s.QueryRow(ctx, sqlf.Sprintf(`
+-- source: internal/database/repo_collections.go:RepoCollectionStore.Update
+UPDATE repo_collections
+SET name = %s, description = %s, updated_at = now()
+WHERE id = %s
+RETURNING `+repoCollectionColumns,
+		c.Name, c.Description, c.ID,
+	))
+	err := scanRepoCollection(row, c)
+	if err == sql.ErrNoRows {
+		return &RepoCollectionNotFoundError{id: c.ID}
+	}
+	return mapRepoCollectionError(err)
+}
+
+// Delete deletes a repo collection.
+//
+// 🚨 SECURITY: The caller must ensure that the actor can manage the collections
+// of the owner of the collection.
+func (s *RepoCollectionStore) Delete(ctx context.Context, id int32) error {
+	res, err := s.ExecResult(ctx, sqlf.Sprintf("DELETE FROM repo_collections WHERE id = %s", id))
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return &RepoCollectionNotFoundError{id: id}
+	}
+	return nil
+}
+
+// GetByID returns the repo collection with the given ID.
+//
+// 🚨 SECURITY: The caller must ensure that the actor can view the collections
+// of the owner of the collection.
+func (s *RepoCollectionStore) GetByID(ctx context.Context, id int32) (*RepoCollection, error) {
+	var c RepoCollection
+	row := s.QueryRow(ctx, sqlf.Sprintf("SELECT "+repoCollectionColumns+" FROM repo_collections WHERE id = %s", id))
+	if err := scanRepoCollection(row, &c); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &RepoCollectionNotFoundError{id: id}
+		}
+		return nil, err
+	}
+	return &c, nil
+}
+
+// RepoCollectionsListOptions specifies the options for listing repo
+// collections.
+type RepoCollectionsListOptions struct {
+	// UserID, if non-zero, only lists the collections owned by this user.
+	UserID int32
+	// OrgID, if non-zero, only lists the collections owned by this org.
+	OrgID int32
+	// AccessibleToUserID, if non-zero, only lists the collections owned by
+	// this user or by the orgs they are a member of.
+	AccessibleToUserID int32
+
+	*LimitOffset
+}
+
+func (o RepoCollectionsListOptions) sqlConditions() *sqlf.Query {
+	conds := []*sqlf.Query{sqlf.Sprintf("TRUE")}
+	if o.UserID != 0 {
+		conds = append(conds, sqlf.Sprintf("c.user_id = %s", o.UserID))
+	}
+	if o.OrgID != 0 {
+		conds = append(conds, sqlf.Sprintf("c.org_id = %s", o.OrgID))
+	}
+	if o.AccessibleToUserID != 0 {
+		conds = append(conds, sqlf.Sprintf(
+			"(c.user_id = %s OR c.org_id IN (SELECT org_id FROM org_members WHERE user_id = %s))",
+			o.AccessibleToUserID, o.AccessibleToUserID,
+		))
+	}
+	return sqlf.Join(conds, "AND")
+}
+
+// List lists the repo collections matching the options, ordered by name.
+//
+// 🚨 SECURITY: The caller must ensure that the actor can view the collections
+// of the owners in the options.
+func (s *RepoCollectionStore) List(ctx context.Context, opts RepoCollectionsListOptions) (_ []*RepoCollection, err error) {
+	rows, err := s.Query(ctx, sqlf.Sprintf(`
+-- source: internal/database/repo_collections.go:RepoCollectionStore.List
+SELECT c.id, c.name, c.description, c.user_id, c.org_id, c.created_at, c.updated_at
+FROM repo_collections c
+WHERE %s
+ORDER BY c.name, c.id
+%s`,
+		opts.sqlConditions(), opts.LimitOffset.SQL(),
+	))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var cs []*RepoCollection
+	for rows.Next() {
+		var c RepoCollection
+		if err := scanRepoCollection(rows, &c); err != nil {
+			return nil, err
+		}
+		cs = append(cs, &c)
+	}
+	return cs, nil
+}
+
+// Count counts the repo collections matching the options, ignoring
+// LimitOffset.
+func (s *RepoCollectionStore) Count(ctx context.Context, opts RepoCollectionsListOptions) (int, error) {
+	count, _, err := basestore.ScanFirstInt(s.Query(ctx, sqlf.Sprintf(
+		"SELECT COUNT(*) FROM repo_collections c WHERE %s",
+		opts.sqlConditions(),
+	)))
+	return count, err
+}
+
+// AddRepos adds repositories to a repo collection. Repositories which are
+// already in it are skipped.
+func (s *RepoCollectionStore) AddRepos(ctx context.Context, collectionID int32, repoIDs ...api.RepoID) error {
+	if len(repoIDs) == 0 {
+		return nil
+	}
+	values := make([]*sqlf.Query, 0, len(repoIDs))
+	for _, repoID := range repoIDs {
+		values = append(values, sqlf.Sprintf("(%s, %s)", collectionID, repoID))
+	}
+	return s.Exec(ctx, sqlf.Sprintf(`
+-- source: internal/database/repo_collections.go:RepoCollectionStore.AddRepos
+WITH added AS (
+	INSERT INTO repo_collection_repos (collection_id, repo_id)
+	VALUES %s
+	ON CONFLICT DO NOTHING
+	RETURNING 1
+)
+UPDATE repo_collections SET updated_at = now()
+WHERE id = %s AND EXISTS (SELECT 1 FROM added)
+`, sqlf.Join(values, ","), collectionID))
+}
+
+// RemoveRepos removes repositories from a repo collection.
+func (s *RepoCollectionStore) RemoveRepos(ctx context.Context, collectionID int32, repoIDs ...api.RepoID) error {
+	if len(repoIDs) == 0 {
+		return nil
+	}
+	ids := make([]*sqlf.Query, 0, len(repoIDs))
+	for _, repoID := range repoIDs {
+		ids = append(ids, sqlf.Sprintf("%s", repoID))
+	}
+	return s.Exec(ctx, sqlf.Sprintf(`
+-- source: internal/database/repo_collections.go:RepoCollectionStore.RemoveRepos
+WITH removed AS (
+	DELETE FROM repo_collection_repos
+	WHERE collection_id = %s AND repo_id IN (%s)
+	RETURNING 1
+)
+UPDATE repo_collections SET updated_at = now()
+WHERE id = %s AND EXISTS (SELECT 1 FROM removed)
+`, collectionID, sqlf.Join(ids, ","), collectionID))
+}
+
+// ListRepos returns the repositories in a repo collection which the actor in
+// ctx can access, ordered by name.
+func (s *RepoCollectionStore) ListRepos(ctx context.Context, collectionID int32) (_ []types.RepoName, err error) {
+	authzConds, err := AuthzQueryConds(ctx, s.Handle().DB())
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.Query(ctx, sqlf.Sprintf(`
+-- source: internal/database/repo_collections.go:RepoCollectionStore.ListRepos
+SELECT repo.id, repo.name
+FROM repo_collection_repos cr
+JOIN repo ON repo.id = cr.repo_id
+WHERE cr.collection_id = %s AND repo.deleted_at IS NULL AND (%s) -- populates authzConds
+ORDER BY repo.name
+`, collectionID, authzConds))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var repos []types.RepoName
+	for rows.Next() {
+		var repo types.RepoName
+		if err := rows.Scan(&repo.ID, &repo.Name); err != nil {
+			return nil, err
+		}
+		repos = append(repos, repo)
+	}
+	return repos, nil
+}
+
+// ListRepoGroups returns the repositories in the repo collections accessible to
+// the user, keyed by the name under which they are searched with repogroup:
+// the name of the collection for the collections of the user, and
+// "<org name>/<name>" for the collections of their orgs. Only the
+// repositories which the actor in ctx can access are returned.
+func (s *RepoCollectionStore) ListRepoGroups(ctx context.Context, userID int32) (_ map[string][]api.RepoName, err error) {
+	if Mocks.RepoCollections.ListRepoGroups != nil {
+		return Mocks.RepoCollections.ListRepoGroups(ctx, userID)
+	}
+
+	authzConds, err := AuthzQueryConds(ctx, s.Handle().DB())
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.Query(ctx, sqlf.Sprintf(`
+-- source: internal/database/repo_collections.go:RepoCollectionStore.ListRepoGroups
+SELECT
+	CASE WHEN c.org_id IS NULL THEN c.name ELSE orgs.name || '/' || c.name END,
+	repo.name
+FROM repo_collections c
+LEFT JOIN orgs ON orgs.id = c.org_id
+JOIN repo_collection_repos cr ON cr.collection_id = c.id
+JOIN repo ON repo.id = cr.repo_id
+WHERE
+	(c.user_id = %s OR c.org_id IN (SELECT org_id FROM org_members WHERE user_id = %s))
+	AND (orgs.id IS NULL OR orgs.deleted_at IS NULL)
+	AND repo.deleted_at IS NULL
+	AND (%s) -- populates authzConds
+ORDER BY repo.name
+`, userID, userID, authzConds))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	groups := map[string][]api.RepoName{}
+	for rows.Next() {
+		var group string
+		var repo api.RepoName
+		if err := rows.Scan(&group, &repo); err != nil {
+			return nil, err
+		}
+		groups[group] = append(groups[group], repo)
+	}
+	return groups, nil
+}
+
+func scanRepoCollection(sc dbutil.Scanner, c *RepoCollection) error {
+	return sc.Scan(
+		&c.ID,
+		&c.Name,
+		&c.Description,
+		&dbutil.NullInt32{N: &c.UserID},
+		&dbutil.NullInt32{N: &c.OrgID},
+		&c.CreatedAt,
+		&c.UpdatedAt,
+	)
+}
+
+func mapRepoCollectionError(err error) error {
+	if pgErr, ok := errors.Cause(err).(*pgconn.PgError); ok {
+		switch pgErr.ConstraintName {
+		case "repo_collections_user_id_name", "repo_collections_org_id_name":
+			return errRepoCollectionNameExists
+		case "repo_collections_name_valid_chars", "repo_collections_name_max_length":
+			return errors.Errorf("repo collection name invalid: %s", pgErr.ConstraintName)
+		}
+	}
+	return err
+}
@@ -0,0 +1,11 @@
+package database
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+type MockRepoCollections struct {
+	ListRepoGroups func(ctx context.Context, userID int32) (map[string][]api.RepoName, error)
+}
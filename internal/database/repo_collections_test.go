@@ -0,0 +1,178 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestRepoCollections(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	t.Parallel()
+	db := dbtest.NewDB(t, "")
+	ctx := actor.WithInternalActor(context.Background())
+	s := RepoCollections(db)
+
+	user, err := Users(db).Create(ctx, NewUser{Username: "alice"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := Users(db).Create(ctx, NewUser{Username: "bob"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	org, err := Orgs(db).Create(ctx, "acme", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := OrgMembers(db).Create(ctx, org.ID, user.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	var repoIDs []api.RepoID
+	for _, name := range []api.RepoName{"github.com/acme/api", "github.com/acme/web", "github.com/acme/deleted"} {
+		repo := &types.Repo{Name: name}
+		if err := Repos(db).Create(ctx, repo); err != nil {
+			t.Fatal(err)
+		}
+		repoIDs = append(repoIDs, repo.ID)
+	}
+	if err := Repos(db).Delete(ctx, repoIDs[2]); err != nil {
+		t.Fatal(err)
+	}
+
+	mine := &RepoCollection{Name: "mine", Description: "My repos", UserID: user.ID}
+	if err := s.Create(ctx, mine); err != nil {
+		t.Fatal(err)
+	}
+	services := &RepoCollection{Name: "services", OrgID: org.ID}
+	if err := s.Create(ctx, services); err != nil {
+		t.Fatal(err)
+	}
+	theirs := &RepoCollection{Name: "services", UserID: other.ID}
+	if err := s.Create(ctx, theirs); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("name unique per owner", func(t *testing.T) {
+		if err := s.Create(ctx, &RepoCollection{Name: "mine", UserID: user.ID}); err != errRepoCollectionNameExists {
+			t.Errorf("got error %v, want %v", err, errRepoCollectionNameExists)
+		}
+		if err := s.Create(ctx, &RepoCollection{Name: "not valid", UserID: user.ID}); err == nil {
+			t.Error("want error for invalid name")
+		}
+	})
+
+	t.Run("repos", func(t *testing.T) {
+		if err := s.AddRepos(ctx, mine.ID, repoIDs[0]); err != nil {
+			t.Fatal(err)
+		}
+		if err := s.AddRepos(ctx, services.ID, repoIDs...); err != nil {
+			t.Fatal(err)
+		}
+		// Adding a repo twice is a no-op.
+		if err := s.AddRepos(ctx, services.ID, repoIDs[1]); err != nil {
+			t.Fatal(err)
+		}
+		if err := s.AddRepos(ctx, theirs.ID, repoIDs[1]); err != nil {
+			t.Fatal(err)
+		}
+
+		repos, err := s.ListRepos(ctx, services.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []types.RepoName{{ID: repoIDs[0], Name: "github.com/acme/api"}, {ID: repoIDs[1], Name: "github.com/acme/web"}}
+		if diff := cmp.Diff(want, repos); diff != "" {
+			t.Errorf("unexpected repos (-want +got):\n%s", diff)
+		}
+
+		groups, err := s.ListRepoGroups(ctx, user.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantGroups := map[string][]api.RepoName{
+			"mine":          {"github.com/acme/api"},
+			"acme/services": {"github.com/acme/api", "github.com/acme/web"},
+		}
+		if diff := cmp.Diff(wantGroups, groups); diff != "" {
+			t.Errorf("unexpected repo groups (-want +got):\n%s", diff)
+		}
+
+		if err := s.RemoveRepos(ctx, services.ID, repoIDs[0]); err != nil {
+			t.Fatal(err)
+		}
+		if repos, err = s.ListRepos(ctx, services.ID); err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want[1:], repos); diff != "" {
+			t.Errorf("unexpected repos after removal (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("list", func(t *testing.T) {
+		for _, tc := range []struct {
+			name string
+			opts RepoCollectionsListOptions
+			want []int32
+		}{
+			{name: "user", opts: RepoCollectionsListOptions{UserID: user.ID}, want: []int32{mine.ID}},
+			{name: "org", opts: RepoCollectionsListOptions{OrgID: org.ID}, want: []int32{services.ID}},
+			{name: "accessible", opts: RepoCollectionsListOptions{AccessibleToUserID: user.ID}, want: []int32{mine.ID, services.ID}},
+			{name: "limit", opts: RepoCollectionsListOptions{LimitOffset: &LimitOffset{Limit: 1}}, want: []int32{mine.ID}},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				cs, err := s.List(ctx, tc.opts)
+				if err != nil {
+					t.Fatal(err)
+				}
+				var ids []int32
+				for _, c := range cs {
+					ids = append(ids, c.ID)
+				}
+				if diff := cmp.Diff(tc.want, ids); diff != "" {
+					t.Errorf("unexpected collections (-want +got):\n%s", diff)
+				}
+			})
+		}
+
+		if count, err := s.Count(ctx, RepoCollectionsListOptions{}); err != nil {
+			t.Fatal(err)
+		} else if count != 3 {
+			t.Errorf("got count %d, want 3", count)
+		}
+	})
+
+	t.Run("update and delete", func(t *testing.T) {
+		mine.Name = "renamed"
+		if err := s.Update(ctx, mine); err != nil {
+			t.Fatal(err)
+		}
+		got, err := s.GetByID(ctx, mine.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Name != "renamed" || got.Description != "My repos" {
+			t.Errorf("unexpected collection after update: %+v", got)
+		}
+
+		if err := s.Delete(ctx, mine.ID); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := s.GetByID(ctx, mine.ID); !errcode.IsNotFound(err) {
+			t.Errorf("got error %v, want not found", err)
+		}
+		if err := s.Delete(ctx, mine.ID); !errcode.IsNotFound(err) {
+			t.Errorf("got error %v, want not found", err)
+		}
+	})
+}
@@ -0,0 +1,121 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+)
+
+// RepoDemandKind is the kind of usage recorded as demand for a repo.
+type RepoDemandKind string
+
+const (
+	// RepoDemandSearch is recorded when a repo has search results.
+	RepoDemandSearch RepoDemandKind = "search"
+	// RepoDemandCodeIntel is recorded when code intelligence is queried in a repo.
+	RepoDemandCodeIntel RepoDemandKind = "codeintel"
+	// RepoDemandNavigation is recorded when a user navigates to a repo.
+	RepoDemandNavigation RepoDemandKind = "navigation"
+)
+
+// RepoDemandHalfLife is the time it takes for the demand of a repo to halve
+// when it is not used.
+const RepoDemandHalfLife = 7 * 24 * time.Hour
+
+// repoDemandPruneAge is the age after which demand is considered negligible
+// and deleted, i.e. when it has decayed below 1/1024 of its score.
+const repoDemandPruneAge = 10 * RepoDemandHalfLife
+
+// RepoDemandStore is responsible for data stored in the repo_demand table,
+// which tracks how much repos are used so that the repo-updater fetches
+// actively used repos more often.
+type RepoDemandStore struct {
+	*basestore.Store
+}
+
+// RepoDemand instantiates and returns a new RepoDemandStore.
+func RepoDemand(db dbutil.DB) *RepoDemandStore {
+	return &RepoDemandStore{Store: basestore.NewWithDB(db, sql.TxOptions{})}
+}
+
+// RepoDemandWith instantiates and returns a new RepoDemandStore using the
+// other store handle.
+func RepoDemandWith(other basestore.ShareableStore) *RepoDemandStore {
+	return &RepoDemandStore{Store: basestore.NewWithHandle(other.Handle())}
+}
+
+// Record records one use of the given kind for each of the given repos. The
+// existing demand is decayed by RepoDemandHalfLife before it is incremented.
+func (s *RepoDemandStore) Record(ctx context.Context, kind RepoDemandKind, ids ...api.RepoID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	ints := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		ints = append(ints, int64(id))
+	}
+
+	return s.Exec(ctx, sqlf.Sprintf(
+		recordRepoDemandQueryFmtstr,
+		string(kind),
+		pq.Array(ints),
+		RepoDemandHalfLife.Seconds(),
+	))
+}
+
+const recordRepoDemandQueryFmtstr = `
+-- source: internal/database/repo_demand.go:RepoDemandStore.Record
+INSERT INTO repo_demand (repo_id, kind, score, updated_at)
+SELECT DISTINCT u.id, %s, 1, now()
+FROM unnest(%s::integer[]) AS u(id)
+JOIN repo ON repo.id = u.id AND repo.deleted_at IS NULL
+ON CONFLICT (repo_id, kind) DO UPDATE SET
+	score = repo_demand.score * power(0.5, extract(epoch FROM now() - repo_demand.updated_at) / %s) + 1,
+	updated_at = now()
+`
+
+// Scores returns the current demand of all repos with any recorded demand,
+// summed over all kinds and decayed by RepoDemandHalfLife.
+func (s *RepoDemandStore) Scores(ctx context.Context) (_ map[api.RepoID]float64, err error) {
+	rows, err := s.Query(ctx, sqlf.Sprintf(repoDemandScoresQueryFmtstr, RepoDemandHalfLife.Seconds()))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	scores := make(map[api.RepoID]float64)
+	for rows.Next() {
+		var (
+			id    api.RepoID
+			score float64
+		)
+		if err := rows.Scan(&id, &score); err != nil {
+			return nil, err
+		}
+		scores[id] = score
+	}
+	return scores, nil
+}
+
+const repoDemandScoresQueryFmtstr = `
+-- source: internal/database/repo_demand.go:RepoDemandStore.Scores
+SELECT repo_id, SUM(score * power(0.5, extract(epoch FROM now() - updated_at) / %s))
+FROM repo_demand
+GROUP BY repo_id
+`
+
+// Prune deletes the demand that has decayed to a negligible score.
+func (s *RepoDemandStore) Prune(ctx context.Context) error {
+	return s.Exec(ctx, sqlf.Sprintf(
+		"DELETE FROM repo_demand WHERE updated_at < now() - (%s * interval '1 second')",
+		repoDemandPruneAge.Seconds(),
+	))
+}
@@ -0,0 +1,79 @@
+package database
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestRepoDemand(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	db := dbtest.NewDB(t, "")
+	ctx := context.Background()
+
+	hot := &types.Repo{Name: "github.com/sourcegraph/hot", URI: "github.com/sourcegraph/hot"}
+	cold := &types.Repo{Name: "github.com/sourcegraph/cold", URI: "github.com/sourcegraph/cold"}
+	if err := Repos(db).Create(ctx, hot, cold); err != nil {
+		t.Fatal(err)
+	}
+
+	store := RepoDemand(db)
+	for _, kind := range []RepoDemandKind{RepoDemandSearch, RepoDemandCodeIntel, RepoDemandNavigation} {
+		if err := store.Record(ctx, kind, hot.ID, hot.ID, cold.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := store.Record(ctx, RepoDemandSearch, hot.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	// Age the demand of the cold repo by one half-life.
+	if err := store.Exec(ctx, sqlf.Sprintf(
+		"UPDATE repo_demand SET updated_at = updated_at - (%s * interval '1 second') WHERE repo_id = %s",
+		RepoDemandHalfLife.Seconds(),
+		cold.ID,
+	)); err != nil {
+		t.Fatal(err)
+	}
+
+	scores, err := store.Scores(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for id, want := range map[api.RepoID]float64{hot.ID: 4, cold.ID: 1.5} {
+		if have := scores[id]; math.Abs(have-want) > 0.01 {
+			t.Errorf("repo %d: got score %f, want %f", id, have, want)
+		}
+	}
+
+	if err := store.Exec(ctx, sqlf.Sprintf(
+		"UPDATE repo_demand SET updated_at = updated_at - (%s * interval '1 second') WHERE repo_id = %s",
+		repoDemandPruneAge.Seconds(),
+		cold.ID,
+	)); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Prune(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if scores, err = store.Scores(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := scores[cold.ID]; ok {
+		t.Errorf("demand of repo %d was not pruned", cold.ID)
+	}
+	if _, ok := scores[hot.ID]; !ok {
+		t.Errorf("demand of repo %d was pruned", hot.ID)
+	}
+}
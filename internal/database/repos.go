@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	regexpsyntax "regexp/syntax"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -224,6 +225,7 @@ func (s *RepoStore) Count(ctx context.Context, opt ReposListOptions) (ct int, er
 
 	opt.Select = []string{"COUNT(*)"}
 	opt.OrderBy = nil
+	opt.After = nil
 	opt.LimitOffset = nil
 
 	err = s.list(ctx, tr, opt, func(rows *sql.Rows) error {
@@ -275,6 +277,10 @@ var repoColumns = []string{
 	"repo.fork",
 	"repo.archived",
 	"repo.stars",
+	"repo.topics",
+	"repo.primary_language",
+	"repo.license",
+	"repo.default_branch_protected",
 	"repo.created_at",
 	"repo.updated_at",
 	"repo.deleted_at",
@@ -302,6 +308,10 @@ func scanRepo(rows *sql.Rows, r *types.Repo) (err error) {
 		&r.Fork,
 		&r.Archived,
 		&dbutil.NullInt{N: &r.Stars},
+		pq.Array(&r.Topics),
+		&dbutil.NullString{S: &r.PrimaryLanguage},
+		&dbutil.NullString{S: &r.License},
+		&r.DefaultBranchProtected,
 		&r.CreatedAt,
 		&dbutil.NullTime{Time: &r.UpdatedAt},
 		&dbutil.NullTime{Time: &r.DeletedAt},
@@ -311,6 +321,9 @@ func scanRepo(rows *sql.Rows, r *types.Repo) (err error) {
 	if err != nil {
 		return err
 	}
+	if len(r.Topics) == 0 {
+		r.Topics = nil
+	}
 
 	type sourceInfo struct {
 		ID       int64
@@ -442,6 +455,14 @@ type ReposListOptions struct {
 	// OnlyArchived excludes non-archived repositories from the list.
 	OnlyArchived bool
 
+	// Topics, if non empty, only includes repositories which have all of the
+	// given topics in the list.
+	Topics []string
+
+	// ExcludeTopics excludes repositories which have any of the given topics
+	// from the list.
+	ExcludeTopics []string
+
 	// NoCloned excludes cloned repositories from the list.
 	NoCloned bool
 
@@ -472,6 +493,12 @@ type ReposListOptions struct {
 	// CursorDirection contains the comparison for cursor-based pagination, all possible values are: next, prev.
 	CursorDirection string
 
+	// After, if set, only lists the repositories after the one at this cursor
+	// in the order of OrderBy. Unlike an offset, it doesn't get slower for deep
+	// pages. Use RepoListOrderBy.Cursor to get the cursor of a repository.
+	// Count ignores it.
+	After KeysetCursor
+
 	// UseOr decides between ANDing or ORing the predicates together.
 	UseOr bool
 
@@ -501,6 +528,47 @@ func (r RepoListOrderBy) SQL() *sqlf.Query {
 	return sqlf.Sprintf(`ORDER BY %s`, sqlf.Join(clauses, ", "))
 }
 
+// Keyset returns the keyset of the ordering for keyset pagination, with the
+// repository ID appended as a tie-breaker unless it's already there.
+func (r RepoListOrderBy) Keyset() (Keyset, error) {
+	k := make(Keyset, 0, len(r)+1)
+	hasID := false
+	for _, s := range r {
+		if s.Field == RepoListStars {
+			return nil, errors.New("keyset pagination is not supported when ordering by stars, which may be null")
+		}
+		k = append(k, KeysetColumn{Name: "repo." + string(s.Field), Descending: s.Descending})
+		hasID = hasID || s.Field == RepoListID
+	}
+	if !hasID {
+		k = append(k, KeysetColumn{Name: "repo.id"})
+	}
+	return k, nil
+}
+
+// Cursor returns the cursor of repo in the ordering, for use as
+// ReposListOptions.After to list the repositories after it.
+func (r RepoListOrderBy) Cursor(repo *types.Repo) (KeysetCursor, error) {
+	k, err := r.Keyset()
+	if err != nil {
+		return nil, err
+	}
+	c := make(KeysetCursor, 0, len(k))
+	for _, col := range k {
+		switch RepoListColumn(strings.TrimPrefix(col.Name, "repo.")) {
+		case RepoListID:
+			c = append(c, strconv.Itoa(int(repo.ID)))
+		case RepoListName:
+			c = append(c, string(repo.Name))
+		case RepoListCreatedAt:
+			c = append(c, repo.CreatedAt.Format(time.RFC3339Nano))
+		default:
+			return nil, errors.Errorf("unsupported keyset column %q", col.Name)
+		}
+	}
+	return c, nil
+}
+
 // RepoListSort is a field by which to sort and the direction of the sorting.
 type RepoListSort struct {
 	Field      RepoListColumn
@@ -612,7 +680,9 @@ func (s *RepoStore) list(ctx context.Context, tr *trace.Trace, opt ReposListOpti
 
 	tr.LogFields(trace.SQL(q))
 
-	rows, err := s.Query(ctx, q)
+	// Listing repositories is one of the most expensive queries on large
+	// instances, so it goes to the read-only replica if one is configured.
+	rows, err := s.ReadOnly(ctx).Query(ctx, q)
 	if err != nil {
 		return err
 	}
@@ -728,6 +798,12 @@ func (s *RepoStore) listSQL(ctx context.Context, opt ReposListOptions) (*sqlf.Qu
 	if opt.OnlyArchived {
 		where = append(where, sqlf.Sprintf("archived"))
 	}
+	if len(opt.Topics) > 0 {
+		where = append(where, sqlf.Sprintf("repo.topics @> %s", pq.Array(opt.Topics)))
+	}
+	if len(opt.ExcludeTopics) > 0 {
+		where = append(where, sqlf.Sprintf("NOT (repo.topics && %s)", pq.Array(opt.ExcludeTopics)))
+	}
 	if opt.NoCloned {
 		// TODO(ryanslade): After 3.26 has been released we can assume that gitserver_repos is populated
 		// We'll remove repo.cloned and can then switch to this:
@@ -802,12 +878,27 @@ func (s *RepoStore) listSQL(ctx context.Context, opt ReposListOptions) (*sqlf.Qu
 
 	queryConds = sqlf.Sprintf("(%s)", queryConds)
 
+	orderBy := opt.OrderBy.SQL()
+	if len(opt.After) > 0 {
+		k, err := opt.OrderBy.Keyset()
+		if err != nil {
+			return nil, err
+		}
+		after, err := k.After(opt.After)
+		if err != nil {
+			return nil, err
+		}
+		// The keyset condition must hold regardless of UseOr.
+		queryConds = sqlf.Sprintf("%s AND %s", queryConds, after)
+		orderBy = k.OrderBy()
+	}
+
 	queryPrefix := sqlf.Sprintf("")
 	if len(ctes) > 0 {
 		queryPrefix = sqlf.Sprintf("WITH %s", sqlf.Join(ctes, ",\n"))
 	}
 
-	querySuffix := sqlf.Sprintf("%s %s", opt.OrderBy.SQL(), opt.LimitOffset.SQL())
+	querySuffix := sqlf.Sprintf("%s %s", orderBy, opt.LimitOffset.SQL())
 
 	columns := repoColumns
 	if len(opt.Select) > 0 {
@@ -972,22 +1063,26 @@ func (s *RepoStore) Create(ctx context.Context, repos ...*types.Repo) (err error
 // repoRecord is the json representation of a repository as used in this package
 // Postgres CTEs.
 type repoRecord struct {
-	ID                  api.RepoID      `json:"id"`
-	Name                string          `json:"name"`
-	URI                 *string         `json:"uri,omitempty"`
-	Description         string          `json:"description"`
-	CreatedAt           time.Time       `json:"created_at"`
-	UpdatedAt           *time.Time      `json:"updated_at,omitempty"`
-	DeletedAt           *time.Time      `json:"deleted_at,omitempty"`
-	ExternalServiceType *string         `json:"external_service_type,omitempty"`
-	ExternalServiceID   *string         `json:"external_service_id,omitempty"`
-	ExternalID          *string         `json:"external_id,omitempty"`
-	Archived            bool            `json:"archived"`
-	Fork                bool            `json:"fork"`
-	Stars               int             `json:"stars"`
-	Private             bool            `json:"private"`
-	Metadata            json.RawMessage `json:"metadata"`
-	Sources             json.RawMessage `json:"sources,omitempty"`
+	ID                     api.RepoID      `json:"id"`
+	Name                   string          `json:"name"`
+	URI                    *string         `json:"uri,omitempty"`
+	Description            string          `json:"description"`
+	CreatedAt              time.Time       `json:"created_at"`
+	UpdatedAt              *time.Time      `json:"updated_at,omitempty"`
+	DeletedAt              *time.Time      `json:"deleted_at,omitempty"`
+	ExternalServiceType    *string         `json:"external_service_type,omitempty"`
+	ExternalServiceID      *string         `json:"external_service_id,omitempty"`
+	ExternalID             *string         `json:"external_id,omitempty"`
+	Archived               bool            `json:"archived"`
+	Fork                   bool            `json:"fork"`
+	Stars                  int             `json:"stars"`
+	Topics                 []string        `json:"topics"`
+	PrimaryLanguage        *string         `json:"primary_language,omitempty"`
+	License                *string         `json:"license,omitempty"`
+	DefaultBranchProtected bool            `json:"default_branch_protected"`
+	Private                bool            `json:"private"`
+	Metadata               json.RawMessage `json:"metadata"`
+	Sources                json.RawMessage `json:"sources,omitempty"`
 }
 
 func newRepoRecord(r *types.Repo) (*repoRecord, error) {
@@ -1002,22 +1097,26 @@ func newRepoRecord(r *types.Repo) (*repoRecord, error) {
 	}
 
 	return &repoRecord{
-		ID:                  r.ID,
-		Name:                string(r.Name),
-		URI:                 nullStringColumn(r.URI),
-		Description:         r.Description,
-		CreatedAt:           r.CreatedAt.UTC(),
-		UpdatedAt:           nullTimeColumn(r.UpdatedAt),
-		DeletedAt:           nullTimeColumn(r.DeletedAt),
-		ExternalServiceType: nullStringColumn(r.ExternalRepo.ServiceType),
-		ExternalServiceID:   nullStringColumn(r.ExternalRepo.ServiceID),
-		ExternalID:          nullStringColumn(r.ExternalRepo.ID),
-		Archived:            r.Archived,
-		Fork:                r.Fork,
-		Stars:               r.Stars,
-		Private:             r.Private,
-		Metadata:            metadata,
-		Sources:             sources,
+		ID:                     r.ID,
+		Name:                   string(r.Name),
+		URI:                    nullStringColumn(r.URI),
+		Description:            r.Description,
+		CreatedAt:              r.CreatedAt.UTC(),
+		UpdatedAt:              nullTimeColumn(r.UpdatedAt),
+		DeletedAt:              nullTimeColumn(r.DeletedAt),
+		ExternalServiceType:    nullStringColumn(r.ExternalRepo.ServiceType),
+		ExternalServiceID:      nullStringColumn(r.ExternalRepo.ServiceID),
+		ExternalID:             nullStringColumn(r.ExternalRepo.ID),
+		Archived:               r.Archived,
+		Fork:                   r.Fork,
+		Stars:                  r.Stars,
+		Topics:                 topicsColumn(r.Topics),
+		PrimaryLanguage:        nullStringColumn(r.PrimaryLanguage),
+		License:                nullStringColumn(r.License),
+		DefaultBranchProtected: r.DefaultBranchProtected,
+		Private:                r.Private,
+		Metadata:               metadata,
+		Sources:                sources,
 	}, nil
 }
 
@@ -1042,6 +1141,13 @@ func nullStringColumn(s string) *string {
 	return &s
 }
 
+func topicsColumn(topics []string) []string {
+	if topics == nil {
+		return []string{}
+	}
+	return topics
+}
+
 func metadataColumn(metadata interface{}) (msg json.RawMessage, err error) {
 	switch m := metadata.(type) {
 	case nil:
@@ -1094,6 +1200,10 @@ WITH repos_list AS (
 		archived              boolean,
 		fork                  boolean,
 		stars                 integer,
+		topics                text[],
+		primary_language      text,
+		license               text,
+		default_branch_protected boolean,
 		private               boolean,
 		metadata              jsonb,
 		sources               jsonb
@@ -1115,6 +1225,10 @@ inserted_repos AS (
 	archived,
 	fork,
 	stars,
+	topics,
+	primary_language,
+	license,
+	default_branch_protected,
 	private,
 	metadata
   )
@@ -1131,6 +1245,10 @@ inserted_repos AS (
 	archived,
 	fork,
 	stars,
+	topics,
+	primary_language,
+	license,
+	default_branch_protected,
 	private,
 	metadata
   FROM repos_list
@@ -1223,6 +1341,148 @@ WHERE deleted_at IS NULL
 AND repo.id = repo_ids.id::int
 `
 
+// DeletedRepoRestoreWindow is the duration after which a deleted repo can no
+// longer be restored, and the data that depends on it is purged.
+const DeletedRepoRestoreWindow = 7 * 24 * time.Hour
+
+// ErrRepoRestoreWindowExpired is returned by Restore for repos which were
+// deleted more than DeletedRepoRestoreWindow ago.
+var ErrRepoRestoreWindowExpired = errors.New("repo was deleted too long ago to be restored")
+
+// ErrRepoHasNoSources is returned by Restore for repos none of whose
+// external services exist anymore.
+var ErrRepoHasNoSources = errors.New("none of the external services of the repo exist anymore")
+
+// Restore restores the deleted repo with the given id under the name it had
+// before it was deleted, along with its association to the external services
+// which yielded it. Repos can only be restored within DeletedRepoRestoreWindow
+// of their deletion, only if no other repo took their name in the meantime, and
+// only if at least one of their external services still exists.
+func (s *RepoStore) Restore(ctx context.Context, id api.RepoID) (err error) {
+	s.ensureStore()
+
+	tx, err := s.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	var (
+		name      api.RepoName
+		deletedAt time.Time
+	)
+	row := tx.QueryRow(ctx, sqlf.Sprintf(restoreRepoSelectQuery, id))
+	if err := row.Scan(&name, &deletedAt); err != nil {
+		if err == sql.ErrNoRows {
+			return &RepoNotFoundErr{ID: id}
+		}
+		return err
+	}
+	if time.Since(deletedAt) > DeletedRepoRestoreWindow {
+		return ErrRepoRestoreWindowExpired
+	}
+
+	taken, _, err := basestore.ScanFirstBool(tx.Query(ctx, sqlf.Sprintf(restoreRepoNameTakenQuery, name)))
+	if err != nil {
+		return err
+	}
+	if taken {
+		return errors.Errorf("cannot restore repo: another repo is named %q", name)
+	}
+
+	if err := tx.Exec(ctx, sqlf.Sprintf(restoreRepoQuery, name, id)); err != nil {
+		return err
+	}
+
+	sources, _, err := basestore.ScanFirstInt(tx.Query(ctx, sqlf.Sprintf(restoreRepoSourcesQuery, id, id)))
+	if err != nil {
+		return err
+	}
+	if sources == 0 {
+		return ErrRepoHasNoSources
+	}
+	return nil
+}
+
+const restoreRepoSelectQuery = `
+-- source: internal/database/repos.go:RepoStore.Restore
+SELECT regexp_replace(name, '^DELETED-[0-9.]+-', ''), deleted_at
+FROM repo
+WHERE id = %s AND deleted_at IS NOT NULL
+FOR UPDATE
+`
+
+const restoreRepoNameTakenQuery = `
+-- source: internal/database/repos.go:RepoStore.Restore
+SELECT EXISTS (SELECT 1 FROM repo WHERE name = %s AND deleted_at IS NULL)
+`
+
+const restoreRepoQuery = `
+-- source: internal/database/repos.go:RepoStore.Restore
+UPDATE repo SET name = %s, deleted_at = NULL WHERE id = %s
+`
+
+const restoreRepoSourcesQuery = `
+-- source: internal/database/repos.go:RepoStore.Restore
+WITH deleted AS (
+	DELETE FROM deleted_external_service_repos WHERE repo_id = %s
+	RETURNING external_service_id, repo_id, clone_url, user_id
+),
+restored AS (
+	INSERT INTO external_service_repos (external_service_id, repo_id, clone_url, user_id)
+	SELECT d.external_service_id, d.repo_id, d.clone_url, d.user_id
+	FROM deleted d
+	JOIN external_services es ON es.id = d.external_service_id AND es.deleted_at IS NULL
+	ON CONFLICT ON CONSTRAINT external_service_repos_repo_id_external_service_id_unique DO NOTHING
+	RETURNING 1
+)
+SELECT
+	(SELECT COUNT(*) FROM restored) +
+	(SELECT COUNT(*) FROM external_service_repos WHERE repo_id = %s)
+`
+
+// PurgeDeleted deletes the rows which depend on repos deleted before the given
+// time and are not removed along with them, namely the user public repos, the
+// repo permissions and the external service associations kept for Restore of
+// those repos. It returns the number of deleted rows.
+//
+// Code intelligence uploads and indexes of deleted repos are removed by the
+// code intelligence janitor instead, which also removes their data.
+func (s *RepoStore) PurgeDeleted(ctx context.Context, deletedBefore time.Time) (int, error) {
+	s.ensureStore()
+
+	count, _, err := basestore.ScanFirstInt(s.Query(ctx, sqlf.Sprintf(purgeDeletedReposQuery, deletedBefore)))
+	return count, err
+}
+
+const purgeDeletedReposQuery = `
+-- source: internal/database/repos.go:RepoStore.PurgeDeleted
+WITH purged_repos AS (
+	SELECT id FROM repo WHERE deleted_at < %s
+),
+deleted_user_public_repos AS (
+	DELETE FROM user_public_repos WHERE repo_id IN (SELECT id FROM purged_repos)
+	RETURNING 1
+),
+deleted_repo_permissions AS (
+	DELETE FROM repo_permissions WHERE repo_id IN (SELECT id FROM purged_repos)
+	RETURNING 1
+),
+deleted_repo_pending_permissions AS (
+	DELETE FROM repo_pending_permissions WHERE repo_id IN (SELECT id FROM purged_repos)
+	RETURNING 1
+),
+deleted_sources AS (
+	DELETE FROM deleted_external_service_repos WHERE repo_id IN (SELECT id FROM purged_repos)
+	RETURNING 1
+)
+SELECT
+	(SELECT COUNT(*) FROM deleted_user_public_repos) +
+	(SELECT COUNT(*) FROM deleted_repo_permissions) +
+	(SELECT COUNT(*) FROM deleted_repo_pending_permissions) +
+	(SELECT COUNT(*) FROM deleted_sources)
+`
+
 // ListEnabledNames returns a list of all enabled repo names. This is commonly
 // requested information by other services (repo-updater and
 // indexed-search). We special case just returning enabled names so that we
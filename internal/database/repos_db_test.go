@@ -489,6 +489,40 @@ func TestRepos_List_fork(t *testing.T) {
 	}
 }
 
+func TestRepos_List_topics(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	t.Parallel()
+	db := dbtest.NewDB(t, "")
+	ctx := actor.WithInternalActor(context.Background())
+
+	goSearch := mustCreate(ctx, t, db, &types.Repo{Name: "a/r", Topics: []string{"go", "search"}}, types.CloneStatusNotCloned)
+	goOnly := mustCreate(ctx, t, db, &types.Repo{Name: "b/r", Topics: []string{"go"}}, types.CloneStatusNotCloned)
+	none := mustCreate(ctx, t, db, &types.Repo{Name: "c/r"}, types.CloneStatusNotCloned)
+
+	for _, tc := range []struct {
+		name string
+		opt  ReposListOptions
+		want []*types.Repo
+	}{
+		{"one topic", ReposListOptions{Topics: []string{"go"}}, append(append([]*types.Repo(nil), goSearch...), goOnly...)},
+		{"all topics", ReposListOptions{Topics: []string{"go", "search"}}, goSearch},
+		{"unknown topic", ReposListOptions{Topics: []string{"rust"}}, nil},
+		{"exclude topic", ReposListOptions{ExcludeTopics: []string{"search"}}, append(append([]*types.Repo(nil), goOnly...), none...)},
+		{"topic and exclude topic", ReposListOptions{Topics: []string{"go"}, ExcludeTopics: []string{"search"}}, goOnly},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			repos, err := Repos(db).List(ctx, tc.opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertJSONEqual(t, tc.want, repos)
+		})
+	}
+}
+
 func TestRepos_List_FailedSync(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
@@ -671,6 +705,58 @@ func TestRepos_List_pagination(t *testing.T) {
 	}
 }
 
+func TestRepos_List_keysetPagination(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	t.Parallel()
+	db := dbtest.NewDB(t, "")
+	ctx := actor.WithInternalActor(context.Background())
+
+	for _, name := range []api.RepoName{"b", "a", "c", "d"} {
+		mustCreate(ctx, t, db, &types.Repo{Name: name}, types.CloneStatusNotCloned)
+	}
+
+	for _, orderBy := range []RepoListOrderBy{
+		{{Field: RepoListName}},
+		{{Field: RepoListName, Descending: true}},
+		{{Field: RepoListCreatedAt, Descending: true}, {Field: RepoListName}},
+	} {
+		all, err := Repos(db).List(ctx, ReposListOptions{OrderBy: orderBy})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var paged []*types.Repo
+		opt := ReposListOptions{OrderBy: orderBy, LimitOffset: &LimitOffset{Limit: 3}}
+		for {
+			repos, err := Repos(db).List(ctx, opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			paged = append(paged, repos...)
+			if len(repos) < opt.Limit {
+				break
+			}
+			if opt.After, err = orderBy.Cursor(repos[len(repos)-1]); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if have, want := repoNames(paged), repoNames(all); !reflect.DeepEqual(have, want) {
+			t.Errorf("for order %v, got %v (want %v)", orderBy, have, want)
+		}
+	}
+
+	if _, err := Repos(db).List(ctx, ReposListOptions{
+		OrderBy: RepoListOrderBy{{Field: RepoListStars}},
+		After:   KeysetCursor{"1", "1"},
+	}); err == nil {
+		t.Error("want error for keyset pagination by stars")
+	}
+}
+
 // TestRepos_List_query tests the behavior of Repos.List when called with
 // a query.
 // Test batch 1 (correct filtering)
@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
@@ -206,6 +208,150 @@ func TestRepos_Delete(t *testing.T) {
 	}
 }
 
+func TestRepos_Restore(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	t.Parallel()
+	db := dbtest.NewDB(t, "")
+	ctx := actor.WithInternalActor(context.Background())
+
+	confGet := func() *conf.Unified { return &conf.Unified{} }
+	services := []*types.ExternalService{
+		{Kind: extsvc.KindGitHub, DisplayName: "GitHub 1", Config: `{"url": "https://github.com", "repositoryQuery": ["none"], "token": "abc"}`},
+		{Kind: extsvc.KindGitHub, DisplayName: "GitHub 2", Config: `{"url": "https://github.com", "repositoryQuery": ["none"], "token": "def"}`},
+	}
+	for _, svc := range services {
+		if err := ExternalServices(db).Create(ctx, confGet, svc); err != nil {
+			t.Fatal(err)
+		}
+	}
+	newRepo := func(name string, svc *types.ExternalService) *types.Repo {
+		return &types.Repo{
+			Name:         api.RepoName(name),
+			ExternalRepo: api.ExternalRepoSpec{ID: name, ServiceType: extsvc.TypeGitHub, ServiceID: "https://github.com"},
+			Sources: map[string]*types.SourceInfo{
+				svc.URN(): {ID: svc.URN(), CloneURL: "https://" + name},
+			},
+		}
+	}
+
+	repos := []*types.Repo{
+		newRepo("github.com/foo/a", services[0]),
+		newRepo("github.com/foo/b", services[0]),
+		newRepo("github.com/foo/c", services[0]),
+		newRepo("github.com/foo/d", services[1]),
+	}
+	if err := Repos(db).Create(ctx, repos...); err != nil {
+		t.Fatal(err)
+	}
+	if err := Repos(db).Delete(ctx, repos[0].ID, repos[1].ID, repos[2].ID, repos[3].ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Repos(db).Restore(ctx, repos[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	restored, err := Repos(db).Get(ctx, repos[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restored.Name != repos[0].Name || restored.IsDeleted() {
+		t.Errorf("repo not restored: name %q, deleted at %v", restored.Name, restored.DeletedAt)
+	}
+	if diff := cmp.Diff(repos[0].Sources, restored.Sources); diff != "" {
+		t.Errorf("unexpected sources of restored repo (-want +got):\n%s", diff)
+	}
+	if err := Repos(db).Restore(ctx, repos[0].ID); !IsRepoNotFoundErr(err) {
+		t.Errorf("want not found error restoring repo which isn't deleted, got %v", err)
+	}
+
+	// Another repo took the name of the deleted repo.
+	if err := Repos(db).Create(ctx, &types.Repo{Name: repos[1].Name}); err != nil {
+		t.Fatal(err)
+	}
+	if err := Repos(db).Restore(ctx, repos[1].ID); err == nil {
+		t.Error("want error restoring repo whose name was taken")
+	}
+
+	if _, err := db.ExecContext(ctx, "UPDATE repo SET deleted_at = $1 WHERE id = $2", time.Now().Add(-DeletedRepoRestoreWindow-time.Hour), repos[2].ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := Repos(db).Restore(ctx, repos[2].ID); err != ErrRepoRestoreWindowExpired {
+		t.Errorf("want %v, got %v", ErrRepoRestoreWindowExpired, err)
+	}
+
+	// The only external service of the repo was deleted.
+	if err := ExternalServices(db).Delete(ctx, services[1].ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := Repos(db).Restore(ctx, repos[3].ID); err != ErrRepoHasNoSources {
+		t.Errorf("want %v, got %v", ErrRepoHasNoSources, err)
+	}
+	if _, err := Repos(db).Get(ctx, repos[3].ID); !IsRepoNotFoundErr(err) {
+		t.Errorf("want repo to remain deleted, got %v", err)
+	}
+}
+
+func TestRepos_PurgeDeleted(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	t.Parallel()
+	db := dbtest.NewDB(t, "")
+	ctx := actor.WithInternalActor(context.Background())
+
+	user, err := Users(db).Create(ctx, NewUser{Username: "u", Password: "p"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	repos := []*types.Repo{{Name: "github.com/foo/old"}, {Name: "github.com/foo/recent"}, {Name: "github.com/foo/live"}}
+	if err := Repos(db).Create(ctx, repos...); err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range repos {
+		if err := UserPublicRepos(db).SetUserRepo(ctx, UserPublicRepo{UserID: user.ID, RepoID: r.ID, RepoURI: string(r.Name)}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := db.ExecContext(ctx, "INSERT INTO repo_permissions (repo_id, permission, updated_at) VALUES ($1, 'read', NOW())", r.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := Repos(db).Delete(ctx, repos[0].ID, repos[1].ID); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	if _, err := db.ExecContext(ctx, "UPDATE repo SET deleted_at = $1 WHERE id = $2", now.Add(-DeletedRepoRestoreWindow-time.Hour), repos[0].ID); err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := Repos(db).PurgeDeleted(ctx, now.Add(-DeletedRepoRestoreWindow))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := 2; count != want {
+		t.Errorf("got %d purged rows, want %d", count, want)
+	}
+
+	var remaining []api.RepoID
+	rows, err := db.QueryContext(ctx, "SELECT repo_id FROM repo_permissions ORDER BY repo_id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id api.RepoID
+		if err := rows.Scan(&id); err != nil {
+			t.Fatal(err)
+		}
+		remaining = append(remaining, id)
+	}
+	if diff := cmp.Diff([]api.RepoID{repos[1].ID, repos[2].ID}, remaining); diff != "" {
+		t.Errorf("remaining repo permissions mismatch (-want +got):\n%s", diff)
+	}
+}
+
 func TestRepos_Upsert(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
@@ -2,11 +2,16 @@ package database
 
 import (
 	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
+	"strconv"
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/keegancsmith/sqlf"
 
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 )
@@ -106,5 +111,114 @@ func (s *QueryRunnerStateStore) Delete(ctx context.Context, query string) error
 		"DELETE FROM query_runner_state WHERE query=$1",
 		query,
 	)
+	if err != nil {
+		return err
+	}
+	_, err = s.Handle().DB().ExecContext(
+		ctx,
+		"DELETE FROM saved_search_matches WHERE query_hash=$1",
+		savedQueryHash(query),
+	)
 	return err
 }
+
+// savedSearchFiredPayload is the payload of the saved_search.fired outbox
+// event.
+type savedSearchFiredPayload struct {
+	Query         string `json:"query"`
+	NewMatchCount int    `json:"newMatchCount"`
+}
+
+// ReplaceMatches replaces the stored matches for the given query with the
+// given matches. It returns the given matches that were not stored before, in
+// their original order and without duplicates. If there are new matches, a
+// saved_search.fired outbox event is published.
+func (s *QueryRunnerStateStore) ReplaceMatches(ctx context.Context, query string, matches []*api.SavedQueryMatch) (_ []*api.SavedQueryMatch, err error) {
+	tx, err := s.Transact(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	queryHash := savedQueryHash(query)
+	oldKeys, err := basestore.ScanStrings(tx.Query(ctx, sqlf.Sprintf(
+		"SELECT match_key FROM saved_search_matches WHERE query_hash=%s",
+		queryHash,
+	)))
+	if err != nil {
+		return nil, errors.Wrap(err, "SELECT")
+	}
+	seen := make(map[string]struct{}, len(oldKeys))
+	for _, key := range oldKeys {
+		seen[key] = struct{}{}
+	}
+
+	if err := tx.Exec(ctx, sqlf.Sprintf("DELETE FROM saved_search_matches WHERE query_hash=%s", queryHash)); err != nil {
+		return nil, errors.Wrap(err, "DELETE")
+	}
+
+	var (
+		newMatches []*api.SavedQueryMatch
+		values     []*sqlf.Query
+		keys       = make(map[string]struct{}, len(matches))
+	)
+	for _, match := range matches {
+		key := savedQueryMatchKey(match)
+		if _, ok := keys[key]; ok {
+			continue
+		}
+		keys[key] = struct{}{}
+
+		if _, ok := seen[key]; !ok {
+			newMatches = append(newMatches, match)
+		}
+		values = append(values, sqlf.Sprintf(
+			"(%s, %s, %s, %s, %s, %s, %s)",
+			queryHash,
+			key,
+			match.Repository,
+			match.Commit,
+			match.Path,
+			match.LineNumber,
+			match.Preview,
+		))
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	if err := tx.Exec(ctx, sqlf.Sprintf(
+		"INSERT INTO saved_search_matches(query_hash, match_key, repository, commit, path, line_number, preview) VALUES %s",
+		sqlf.Join(values, ", "),
+	)); err != nil {
+		return nil, errors.Wrap(err, "INSERT")
+	}
+
+	if len(newMatches) > 0 {
+		if err := OutboxEventsWith(tx).Publish(ctx, OutboxTopicSavedSearchFired, queryHash, savedSearchFiredPayload{
+			Query:         query,
+			NewMatchCount: len(newMatches),
+		}); err != nil {
+			return nil, errors.Wrap(err, "publishing outbox event")
+		}
+	}
+	return newMatches, nil
+}
+
+// savedQueryHash returns the hex-encoded SHA-256 hash of the given query,
+// which keys its stored matches.
+func savedQueryHash(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
+
+// savedQueryMatchKey returns a key that identifies the given match across
+// executions of a saved query.
+func savedQueryMatchKey(match *api.SavedQueryMatch) string {
+	h := sha256.New()
+	for _, field := range []string{match.Repository, match.Commit, match.Path, strconv.Itoa(match.LineNumber), match.Preview} {
+		h.Write([]byte(field))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -3,12 +3,14 @@ package database
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/keegancsmith/sqlf"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/types"
 )
 
 func UserPublicRepos(db dbutil.DB) *UserPublicRepoStore {
@@ -26,7 +28,9 @@ type UserPublicRepoStore struct {
 	store *basestore.Store
 }
 
-// SetUserRepos replaces all the repos in user_public_repos for the passed user ID
+// SetUserRepos replaces all the repos in user_public_repos for the passed user ID. It
+// only removes the repos which are not in repos, and only inserts the repos which
+// are new, in a single transaction.
 func (s *UserPublicRepoStore) SetUserRepos(ctx context.Context, userID int32, repos []UserPublicRepo) (err error) {
 	var tx *basestore.Store
 	tx, err = s.store.Transact(ctx)
@@ -36,26 +40,48 @@ func (s *UserPublicRepoStore) SetUserRepos(ctx context.Context, userID int32, re
 	defer func() {
 		err = tx.Done(err)
 	}()
-	// clear existing repos for this user
-	err = tx.Exec(ctx, sqlf.Sprintf(
-		"DELETE FROM user_public_repos WHERE user_id = %v",
-		userID,
-	))
-	if err != nil {
-		return err
-	}
-	if len(repos) == 0 {
-		return nil
-	}
+
+	ids := make([]*sqlf.Query, 0, len(repos))
 	values := make([]*sqlf.Query, 0, len(repos))
+	seen := make(map[api.RepoID]struct{}, len(repos))
 	for _, repo := range repos {
+		// A repo can only be inserted once per statement.
+		if _, ok := seen[repo.RepoID]; ok {
+			continue
+		}
+		seen[repo.RepoID] = struct{}{}
+		ids = append(ids, sqlf.Sprintf("%s", repo.RepoID))
 		values = append(values, sqlf.Sprintf(
 			"(%s, %s, %s)",
 			userID, repo.RepoURI, repo.RepoID,
 		))
 	}
+
+	// remove the repos which are no longer in the set
+	if len(ids) == 0 {
+		return tx.Exec(ctx, sqlf.Sprintf(
+			"DELETE FROM user_public_repos WHERE user_id = %s",
+			userID,
+		))
+	}
+	err = tx.Exec(ctx, sqlf.Sprintf(
+		"DELETE FROM user_public_repos WHERE user_id = %s AND repo_id NOT IN (%s)",
+		userID, sqlf.Join(ids, ","),
+	))
+	if err != nil {
+		return err
+	}
+
+	// insert the new repos, and update the URI of the existing ones if it changed
 	return tx.Exec(ctx, sqlf.Sprintf(
-		"INSERT INTO user_public_repos(user_id, repo_uri, repo_id) VALUES %s",
+		`INSERT INTO
+			user_public_repos(user_id, repo_uri, repo_id)
+		VALUES %s
+		ON CONFLICT(user_id, repo_id) DO UPDATE
+		SET
+			repo_uri = excluded.repo_uri
+		WHERE
+			user_public_repos.repo_uri <> excluded.repo_uri`,
 		sqlf.Join(values, ","),
 	))
 }
@@ -99,8 +125,129 @@ func (s *UserPublicRepoStore) ListByUser(ctx context.Context, userID int32) ([]U
 	return out, nil
 }
 
+// ListByUserWithMetadata returns the public repos of the user, along with the
+// name and the sync state of each repo, in a single query.
+func (s *UserPublicRepoStore) ListByUserWithMetadata(ctx context.Context, userID int32) (_ []UserPublicRepoWithMetadata, err error) {
+	if mock := Mocks.UserPublicRepos.ListByUserWithMetadata; mock != nil {
+		return mock(ctx, userID)
+	}
+	rows, err := s.store.Query(ctx, sqlf.Sprintf(`
+-- source: internal/database/user_public_repos.go:UserPublicRepoStore.ListByUserWithMetadata
+SELECT
+	upr.user_id,
+	upr.repo_uri,
+	upr.repo_id,
+	repo.name,
+	COALESCE(gr.clone_status, %s),
+	gr.last_error,
+	gr.updated_at
+FROM user_public_repos AS upr
+JOIN repo ON repo.id = upr.repo_id
+LEFT JOIN gitserver_repos AS gr ON gr.repo_id = upr.repo_id
+WHERE upr.user_id = %s AND repo.deleted_at IS NULL
+ORDER BY upr.repo_id
+`, types.CloneStatusNotCloned, userID))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var out []UserPublicRepoWithMetadata
+	for rows.Next() {
+		var v UserPublicRepoWithMetadata
+		var cloneStatus string
+		err = rows.Scan(
+			&v.UserID,
+			&v.RepoURI,
+			&v.RepoID,
+			&v.RepoName,
+			&cloneStatus,
+			&dbutil.NullString{S: &v.LastError},
+			&dbutil.NullTime{Time: &v.UpdatedAt},
+		)
+		if err != nil {
+			return out, err
+		}
+		v.CloneStatus = types.ParseCloneStatus(cloneStatus)
+		out = append(out, v)
+	}
+
+	return out, nil
+}
+
+// SetAutoRefresh sets whether the public repos of the user are refreshed
+// automatically from their code host accounts.
+func (s *UserPublicRepoStore) SetAutoRefresh(ctx context.Context, userID int32, enabled bool) error {
+	return s.store.Exec(ctx, sqlf.Sprintf(`
+-- source: internal/database/user_public_repos.go:UserPublicRepoStore.SetAutoRefresh
+INSERT INTO user_public_repos_refresh (user_id, auto_refresh)
+VALUES (%s, %s)
+ON CONFLICT (user_id) DO UPDATE
+SET auto_refresh = EXCLUDED.auto_refresh
+`, userID, enabled))
+}
+
+// AutoRefreshEnabled returns whether the public repos of the user are
+// refreshed automatically. It is enabled unless the user opted out.
+func (s *UserPublicRepoStore) AutoRefreshEnabled(ctx context.Context, userID int32) (bool, error) {
+	enabled, ok, err := basestore.ScanFirstBool(s.store.Query(ctx, sqlf.Sprintf(`
+-- source: internal/database/user_public_repos.go:UserPublicRepoStore.AutoRefreshEnabled
+SELECT auto_refresh FROM user_public_repos_refresh WHERE user_id = %s
+`, userID)))
+	if err != nil {
+		return false, err
+	}
+	return !ok || enabled, nil
+}
+
+// ListUsersToRefresh returns the IDs of at most limit users whose public repos
+// should be refreshed because they were last refreshed before the given time.
+// Only users who added public repos or enabled the automatic refresh, and who
+// didn't opt out of it, are returned.
+func (s *UserPublicRepoStore) ListUsersToRefresh(ctx context.Context, before time.Time, limit int) ([]int32, error) {
+	return basestore.ScanInt32s(s.store.Query(ctx, sqlf.Sprintf(`
+-- source: internal/database/user_public_repos.go:UserPublicRepoStore.ListUsersToRefresh
+SELECT users.id
+FROM users
+LEFT JOIN user_public_repos_refresh AS r ON r.user_id = users.id
+WHERE
+	users.deleted_at IS NULL
+	AND COALESCE(r.auto_refresh, TRUE)
+	AND (r.last_refreshed_at IS NULL OR r.last_refreshed_at < %s)
+	AND (
+		r.user_id IS NOT NULL
+		OR EXISTS (SELECT 1 FROM user_public_repos WHERE user_id = users.id)
+	)
+ORDER BY r.last_refreshed_at ASC NULLS FIRST, users.id
+LIMIT %s
+`, before, limit)))
+}
+
+// MarkRefreshed records that the public repos of the user were refreshed at
+// the given time, and the error of the refresh if it failed.
+func (s *UserPublicRepoStore) MarkRefreshed(ctx context.Context, userID int32, refreshedAt time.Time, refreshErr string) error {
+	return s.store.Exec(ctx, sqlf.Sprintf(`
+-- source: internal/database/user_public_repos.go:UserPublicRepoStore.MarkRefreshed
+INSERT INTO user_public_repos_refresh (user_id, last_refreshed_at, last_error)
+VALUES (%s, %s, %s)
+ON CONFLICT (user_id) DO UPDATE
+SET (last_refreshed_at, last_error) = (EXCLUDED.last_refreshed_at, EXCLUDED.last_error)
+`, userID, refreshedAt, dbutil.NewNullString(refreshErr)))
+}
+
 type UserPublicRepo struct {
 	UserID  int32
 	RepoURI string
 	RepoID  api.RepoID
 }
+
+// UserPublicRepoWithMetadata is a public repo of a user, along with the name
+// and the sync state of the repo.
+type UserPublicRepoWithMetadata struct {
+	UserPublicRepo
+	RepoName    api.RepoName
+	CloneStatus types.CloneStatus
+	LastError   string
+	// UpdatedAt is when the sync state of the repo last changed.
+	UpdatedAt time.Time
+}
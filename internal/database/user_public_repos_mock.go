@@ -3,5 +3,6 @@ package database
 import "context"
 
 type MockUserPublicRepos struct {
-	ListByUser func(ctx context.Context, userID int32) ([]UserPublicRepo, error)
+	ListByUser             func(ctx context.Context, userID int32) ([]UserPublicRepo, error)
+	ListByUserWithMetadata func(ctx context.Context, userID int32) ([]UserPublicRepoWithMetadata, error)
 }
@@ -3,6 +3,10 @@ package database
 import (
 	"context"
 	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
@@ -138,3 +142,154 @@ func TestUserPublicRepos_SetUserRepos(t *testing.T) {
 		}
 	}
 }
+
+func TestUserPublicRepos_SetUserReposDiff(t *testing.T) {
+	t.Parallel()
+	db := dbtest.NewDB(t, "")
+	ctx := context.Background()
+	upr := UserPublicRepos(db)
+
+	user, err := Users(db).Create(ctx, NewUser{
+		Username: "u",
+		Password: "p",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	repos := []*types.Repo{
+		{Name: "github.com/foo/test1", URI: "github.com/foo/test1"},
+		{Name: "github.com/foo/test2", URI: "github.com/foo/test2"},
+		{Name: "github.com/foo/test3", URI: "github.com/foo/test3"},
+	}
+	if err := Repos(db).Create(ctx, repos...); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if err := GitserverRepos(db).SetCloneStatus(ctx, repos[1].ID, types.CloneStatusCloned, "shard"); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if err := GitserverRepos(db).SetLastError(ctx, repos[2].ID, "oops", "shard"); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	set := func(repos ...*types.Repo) {
+		t.Helper()
+		uprs := make([]UserPublicRepo, 0, len(repos))
+		for _, r := range repos {
+			uprs = append(uprs, UserPublicRepo{RepoID: r.ID, RepoURI: r.URI})
+		}
+		if err := upr.SetUserRepos(ctx, user.ID, uprs); err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+	}
+	assertRepos := func(want ...*types.Repo) {
+		t.Helper()
+		have, err := upr.ListByUserWithMetadata(ctx, user.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		if wanted, got := len(want), len(have); wanted != got {
+			t.Fatalf("wanted %v repos, got %v", wanted, got)
+		}
+		for i := range have {
+			if wanted, got := want[i].ID, have[i].RepoID; wanted != got {
+				t.Errorf("wanted repo ID %v, got %v", wanted, got)
+			}
+			if wanted, got := want[i].Name, have[i].RepoName; wanted != got {
+				t.Errorf("wanted repo name %v, got %v", wanted, got)
+			}
+			if wanted, got := user.ID, have[i].UserID; wanted != got {
+				t.Errorf("wanted user ID %v, got %v", wanted, got)
+			}
+		}
+	}
+
+	set(repos[0], repos[1])
+	assertRepos(repos[0], repos[1])
+
+	// Repos that are listed more than once are only added once.
+	set(repos[1], repos[2], repos[1])
+	assertRepos(repos[1], repos[2])
+
+	have, err := upr.ListByUserWithMetadata(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if wanted, got := types.CloneStatusCloned, have[0].CloneStatus; wanted != got {
+		t.Errorf("wanted clone status %v, got %v", wanted, got)
+	}
+	if wanted, got := types.CloneStatusNotCloned, have[1].CloneStatus; wanted != got {
+		t.Errorf("wanted clone status %v, got %v", wanted, got)
+	}
+	if wanted, got := "oops", have[1].LastError; wanted != got {
+		t.Errorf("wanted last error %q, got %q", wanted, got)
+	}
+
+	set()
+	assertRepos()
+}
+
+func TestUserPublicRepos_Refresh(t *testing.T) {
+	t.Parallel()
+	db := dbtest.NewDB(t, "")
+	ctx := context.Background()
+	upr := UserPublicRepos(db)
+
+	var users []*types.User
+	for _, name := range []string{"u1", "u2", "u3"} {
+		user, err := Users(db).Create(ctx, NewUser{Username: name, Password: "p"})
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		users = append(users, user)
+	}
+
+	repo := &types.Repo{Name: "github.com/foo/test1", URI: "github.com/foo/test1"}
+	if err := Repos(db).Create(ctx, repo); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	// u1 added a repo, u2 opted out and u3 never used the feature.
+	if err := upr.SetUserRepos(ctx, users[0].ID, []UserPublicRepo{{RepoID: repo.ID, RepoURI: repo.URI}}); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if err := upr.SetAutoRefresh(ctx, users[1].ID, false); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	for i, want := range []bool{true, false, true} {
+		enabled, err := upr.AutoRefreshEnabled(ctx, users[i].ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		if enabled != want {
+			t.Errorf("user %d: wanted auto refresh %v, got %v", i, want, enabled)
+		}
+	}
+
+	now := time.Now()
+	assertUsers := func(want ...int32) {
+		t.Helper()
+		have, err := upr.ListUsersToRefresh(ctx, now, 10)
+		if err != nil {
+			t.Fatalf("Expected no error, got %s", err)
+		}
+		if diff := cmp.Diff(want, have, cmpopts.EquateEmpty()); diff != "" {
+			t.Errorf("users to refresh mismatch (-want +have):\n%s", diff)
+		}
+	}
+	assertUsers(users[0].ID)
+
+	if err := upr.MarkRefreshed(ctx, users[0].ID, now, ""); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	assertUsers()
+
+	// Refreshes are retried once the user is due again, even if they failed.
+	if err := upr.MarkRefreshed(ctx, users[0].ID, now.Add(-time.Hour), "oops"); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if err := upr.SetAutoRefresh(ctx, users[1].ID, true); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	assertUsers(users[1].ID, users[0].ID)
+}
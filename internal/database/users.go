@@ -360,9 +360,24 @@ func (u *UserStore) create(ctx context.Context, info NewUser) (newUser *types.Us
 		}
 	}
 
+	if err := AuditLogsWith(u).Record(ctx, &AuditLogEvent{
+		Action:      AuditLogActionUserCreated,
+		SubjectType: AuditLogSubjectUser,
+		SubjectID:   user.ID,
+		After:       userAuditLogState{Username: user.Username, SiteAdmin: user.SiteAdmin},
+	}); err != nil {
+		return nil, errors.Wrap(err, "recording audit log")
+	}
+
 	return user, nil
 }
 
+// userAuditLogState is the state of a user recorded in the audit log.
+type userAuditLogState struct {
+	Username  string `json:"username,omitempty"`
+	SiteAdmin bool   `json:"siteAdmin"`
+}
+
 // orgsForAllUsersToJoin returns the list of org names that all users should be joined to. The second return value
 // is a list of errors encountered while generating this list. Note that even if errors are returned, the first
 // return value is still valid.
@@ -460,7 +475,8 @@ func (u *UserStore) Delete(ctx context.Context, id int32) (err error) {
 	}
 	defer func() { err = tx.Done(err) }()
 
-	res, err := tx.ExecResult(ctx, sqlf.Sprintf("UPDATE users SET deleted_at=now() WHERE id=%s AND deleted_at IS NULL", id))
+	// Deactivated users still hold their username, and can be deleted too.
+	res, err := tx.ExecResult(ctx, sqlf.Sprintf("UPDATE users SET deleted_at=now() WHERE id=%s AND (deleted_at IS NULL OR EXISTS (SELECT 1 FROM names WHERE names.user_id=users.id))", id))
 	if err != nil {
 		return err
 	}
@@ -492,6 +508,93 @@ func (u *UserStore) Delete(ctx context.Context, id int32) (err error) {
 		return err
 	}
 
+	if err := OutboxEventsWith(tx).Publish(ctx, OutboxTopicUserDeleted, id, userOutboxPayload{ID: id}); err != nil {
+		return errors.Wrap(err, "publishing outbox event")
+	}
+
+	return nil
+}
+
+// userOutboxPayload is the payload of outbox events about a user.
+type userOutboxPayload struct {
+	ID int32 `json:"id"`
+}
+
+// Deactivate soft-deletes the user and revokes their access tokens, but unlike
+// Delete keeps their username, email addresses and external accounts, so that
+// they can be reactivated with Restore as they were. Deactivating a
+// deactivated user is a no-op, while deactivating a deleted user fails.
+func (u *UserStore) Deactivate(ctx context.Context, id int32) (err error) {
+	if Mocks.Users.Deactivate != nil {
+		return Mocks.Users.Deactivate(ctx, id)
+	}
+	u.ensureStore()
+
+	tx, err := u.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	exists, ok, err := basestore.ScanFirstBool(tx.Query(ctx, sqlf.Sprintf(deactivateUserQuery, id, id)))
+	if err != nil {
+		return err
+	}
+	if !ok || !exists {
+		return userNotFoundErr{args: []interface{}{id}}
+	}
+
+	return tx.Exec(ctx, sqlf.Sprintf("UPDATE access_tokens SET deleted_at=now() WHERE deleted_at IS NULL AND (subject_user_id=%s OR creator_user_id=%s)", id, id))
+}
+
+const deactivateUserQuery = `
+-- source: internal/database/users.go:Deactivate
+WITH deactivated AS (
+	UPDATE users SET deleted_at=now() WHERE id=%s AND deleted_at IS NULL
+	RETURNING id
+)
+SELECT EXISTS (SELECT 1 FROM deactivated) OR EXISTS (SELECT 1 FROM names WHERE user_id=%s)
+`
+
+// Restore restores a soft-deleted user and reclaims their username. The
+// resources deleted along with the user by Delete, such as their email
+// addresses, are not restored. It fails if their username has been taken
+// since.
+func (u *UserStore) Restore(ctx context.Context, id int32) (err error) {
+	if Mocks.Users.Restore != nil {
+		return Mocks.Users.Restore(ctx, id)
+	}
+	u.ensureStore()
+
+	tx, err := u.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	username, ok, err := basestore.ScanFirstString(tx.Query(ctx, sqlf.Sprintf("UPDATE users SET deleted_at=NULL, updated_at=now() WHERE id=%s AND deleted_at IS NOT NULL RETURNING username", id)))
+	if err != nil {
+		if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.ConstraintName == "users_username" {
+			return errCannotCreateUser{errorCodeUsernameExists}
+		}
+		return err
+	}
+	if !ok {
+		return userNotFoundErr{args: []interface{}{id}}
+	}
+
+	// Reclaim the username in the shared users+orgs namespace, unless the user
+	// was deactivated and still holds it.
+	if err := tx.Exec(ctx, sqlf.Sprintf("INSERT INTO names(name, user_id) VALUES(%s, %s) ON CONFLICT (name) DO NOTHING", username, id)); err != nil {
+		return err
+	}
+	held, _, err := basestore.ScanFirstBool(tx.Query(ctx, sqlf.Sprintf("SELECT EXISTS (SELECT 1 FROM names WHERE name=%s AND user_id=%s)", username, id)))
+	if err != nil {
+		return err
+	}
+	if !held {
+		return errCannotCreateUser{errorCodeUsernameExists}
+	}
 	return nil
 }
 
@@ -568,7 +671,7 @@ func (u *UserStore) HardDelete(ctx context.Context, id int32) (err error) {
 }
 
 // SetIsSiteAdmin sets the the user with given ID to be or not to be the site admin.
-func (u *UserStore) SetIsSiteAdmin(ctx context.Context, id int32, isSiteAdmin bool) error {
+func (u *UserStore) SetIsSiteAdmin(ctx context.Context, id int32, isSiteAdmin bool) (err error) {
 	if Mocks.Users.SetIsSiteAdmin != nil {
 		return Mocks.Users.SetIsSiteAdmin(id, isSiteAdmin)
 	}
@@ -580,8 +683,26 @@ func (u *UserStore) SetIsSiteAdmin(ctx context.Context, id int32, isSiteAdmin bo
 		}
 	}
 
-	err := u.Store.Exec(ctx, sqlf.Sprintf("UPDATE users SET site_admin=%s WHERE id=%s", isSiteAdmin, id))
-	return err
+	tx, err := u.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	wasSiteAdmin, ok, err := basestore.ScanFirstBool(tx.Query(ctx, sqlf.Sprintf("SELECT site_admin FROM users WHERE id=%s FOR UPDATE", id)))
+	if err != nil || !ok {
+		return err
+	}
+	if err := tx.Exec(ctx, sqlf.Sprintf("UPDATE users SET site_admin=%s WHERE id=%s", isSiteAdmin, id)); err != nil {
+		return err
+	}
+	return AuditLogsWith(tx).Record(ctx, &AuditLogEvent{
+		Action:      AuditLogActionUserSiteAdminUpdated,
+		SubjectType: AuditLogSubjectUser,
+		SubjectID:   id,
+		Before:      userAuditLogState{SiteAdmin: wasSiteAdmin},
+		After:       userAuditLogState{SiteAdmin: isSiteAdmin},
+	})
 }
 
 // CheckAndDecrementInviteQuota should be called before the user (identified
@@ -736,9 +857,26 @@ type UsersListOptions struct {
 
 	Tag string // only include users with this tag
 
+	// IncludeDeactivated includes the users deactivated with Deactivate.
+	IncludeDeactivated bool
+
+	// After, if set, only lists the users after the one at this cursor.
+	// Unlike an offset, it doesn't get slower for deep pages. Use
+	// UserListCursor to get the cursor of a user. Count ignores it.
+	After KeysetCursor
+
 	*LimitOffset
 }
 
+// usersKeyset is the order in which users are listed.
+var usersKeyset = Keyset{{Name: "u.id"}}
+
+// UserListCursor returns the cursor of user, for use as
+// UsersListOptions.After to list the users after it.
+func UserListCursor(user *types.User) KeysetCursor {
+	return KeysetCursor{strconv.Itoa(int(user.ID))}
+}
+
 func (u *UserStore) List(ctx context.Context, opt *UsersListOptions) (_ []*types.User, err error) {
 	if Mocks.Users.List != nil {
 		return Mocks.Users.List(ctx, opt)
@@ -755,8 +893,13 @@ func (u *UserStore) List(ctx context.Context, opt *UsersListOptions) (_ []*types
 		opt = &UsersListOptions{}
 	}
 	conds := u.listSQL(*opt)
+	after, err := usersKeyset.After(opt.After)
+	if err != nil {
+		return nil, err
+	}
+	conds = append(conds, after)
 
-	q := sqlf.Sprintf("WHERE %s ORDER BY id ASC %s", sqlf.Join(conds, "AND"), opt.LimitOffset.SQL())
+	q := sqlf.Sprintf("WHERE %s %s %s", sqlf.Join(conds, "AND"), usersKeyset.OrderBy(), opt.LimitOffset.SQL())
 	return u.getBySQL(ctx, q)
 }
 
@@ -797,7 +940,12 @@ ORDER BY id ASC
 
 func (*UserStore) listSQL(opt UsersListOptions) (conds []*sqlf.Query) {
 	conds = []*sqlf.Query{sqlf.Sprintf("TRUE")}
-	conds = append(conds, sqlf.Sprintf("deleted_at IS NULL"))
+	if opt.IncludeDeactivated {
+		// Unlike deleted users, deactivated users still hold their username.
+		conds = append(conds, sqlf.Sprintf("(deleted_at IS NULL OR EXISTS (SELECT 1 FROM names WHERE names.user_id=u.id))"))
+	} else {
+		conds = append(conds, sqlf.Sprintf("deleted_at IS NULL"))
+	}
 	if opt.Query != "" {
 		query := "%" + opt.Query + "%"
 		conds = append(conds, sqlf.Sprintf("(username ILIKE %s OR display_name ILIKE %s)", query, query))
@@ -12,6 +12,8 @@ type MockUsers struct {
 	Update                       func(userID int32, update UserUpdate) error
 	Delete                       func(ctx context.Context, id int32) error
 	HardDelete                   func(ctx context.Context, id int32) error
+	Deactivate                   func(ctx context.Context, id int32) error
+	Restore                      func(ctx context.Context, id int32) error
 	SetIsSiteAdmin               func(id int32, isSiteAdmin bool) error
 	CheckAndDecrementInviteQuota func(ctx context.Context, userID int32) (bool, error)
 	GetByID                      func(ctx context.Context, id int32) (*types.User, error)
@@ -684,6 +684,117 @@ func TestUsers_Delete(t *testing.T) {
 	}
 }
 
+func TestUsers_Restore(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	t.Parallel()
+	db := dbtest.NewDB(t, "")
+	ctx := context.Background()
+
+	user, err := Users(db).Create(ctx, NewUser{Username: "u"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Users(db).Restore(ctx, user.ID); !errcode.IsNotFound(err) {
+		t.Fatalf("want not found error for a user which isn't deleted, got %v", err)
+	}
+
+	if err := Users(db).Delete(ctx, user.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := Users(db).Restore(ctx, user.ID); err != nil {
+		t.Fatal(err)
+	}
+	if restored, err := Users(db).GetByID(ctx, user.ID); err != nil {
+		t.Fatal(err)
+	} else if restored.Username != "u" {
+		t.Errorf("got username %q, want %q", restored.Username, "u")
+	}
+
+	// The username can't be restored once another user took it.
+	if err := Users(db).Delete(ctx, user.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Users(db).Create(ctx, NewUser{Username: "u"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := Users(db).Restore(ctx, user.ID); !IsUsernameExists(err) {
+		t.Fatalf("want username exists error, got %v", err)
+	}
+}
+
+func TestUsers_Deactivate(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	t.Parallel()
+	db := dbtest.NewDB(t, "")
+	ctx := context.Background()
+
+	user, err := Users(db).Create(ctx, NewUser{Username: "u", Email: "u@example.com", EmailIsVerified: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	deleted, err := Users(db).Create(ctx, NewUser{Username: "d"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Users(db).Delete(ctx, deleted.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	// Deactivating a deactivated user is a no-op, but deleted users can't be
+	// deactivated.
+	for i := 0; i < 2; i++ {
+		if err := Users(db).Deactivate(ctx, user.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := Users(db).Deactivate(ctx, deleted.ID); !errcode.IsNotFound(err) {
+		t.Fatalf("want not found error for a deleted user, got %v", err)
+	}
+
+	if _, err := Users(db).GetByID(ctx, user.ID); !errcode.IsNotFound(err) {
+		t.Fatalf("want not found error for a deactivated user, got %v", err)
+	}
+	users, err := Users(db).List(ctx, &UsersListOptions{IncludeDeactivated: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 1 || users[0].ID != user.ID {
+		t.Fatalf("got users %+v, want only the deactivated user", users)
+	}
+
+	// The username and email address are kept.
+	if _, err := Users(db).Create(ctx, NewUser{Username: "u"}); !IsUsernameExists(err) {
+		t.Fatalf("want username exists error, got %v", err)
+	}
+	if email, verified, err := UserEmails(db).GetPrimaryEmail(ctx, user.ID); err != nil {
+		t.Fatal(err)
+	} else if email != "u@example.com" || !verified {
+		t.Errorf("got email %q (verified %v), want verified u@example.com", email, verified)
+	}
+
+	if err := Users(db).Restore(ctx, user.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Users(db).GetByID(ctx, user.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	// Deactivated users can be deleted.
+	if err := Users(db).Deactivate(ctx, user.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := Users(db).Delete(ctx, user.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := Users(db).Deactivate(ctx, user.ID); !errcode.IsNotFound(err) {
+		t.Fatalf("want not found error for a deleted user, got %v", err)
+	}
+}
+
 func TestUsers_HasTag(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
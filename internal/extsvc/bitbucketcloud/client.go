@@ -104,6 +104,56 @@ func (c *Client) Repos(ctx context.Context, pageToken *PageToken, accountName st
 	return repos, next, err
 }
 
+// WorkspaceMembers returns a list of users that are members of the given workspace,
+// fetched based on the given pagination criteria. If the argument pageToken.Next is not
+// empty, it will be used directly as the URL to make the request.
+func (c *Client) WorkspaceMembers(ctx context.Context, pageToken *PageToken, workspace string) ([]*User, *PageToken, error) {
+	var memberships []*struct {
+		User *User `json:"user"`
+	}
+	var next *PageToken
+	var err error
+	if pageToken.HasMore() {
+		next, err = c.reqPage(ctx, pageToken.Next, &memberships)
+	} else {
+		next, err = c.page(ctx, fmt.Sprintf("/2.0/workspaces/%s/members", workspace), nil, pageToken, &memberships)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	users := make([]*User, 0, len(memberships))
+	for _, m := range memberships {
+		if m.User != nil {
+			users = append(users, m.User)
+		}
+	}
+	return users, next, nil
+}
+
+// RepoPermissions returns a list of explicit and group-derived repository permissions
+// in the given workspace, fetched based on the given pagination criteria and filtered by
+// the query q (such as `user.uuid="{...}"`) when non-empty. The credentials of the client
+// must belong to an administrator of the workspace. If the argument pageToken.Next is
+// not empty, it will be used directly as the URL to make the request.
+//
+// API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-workspaces/#api-workspaces-workspace-permissions-repositories-get
+func (c *Client) RepoPermissions(ctx context.Context, pageToken *PageToken, workspace, q string) ([]*RepoPermission, *PageToken, error) {
+	var perms []*RepoPermission
+	var next *PageToken
+	var err error
+	if pageToken.HasMore() {
+		next, err = c.reqPage(ctx, pageToken.Next, &perms)
+	} else {
+		var qry url.Values
+		if q != "" {
+			qry = url.Values{"q": []string{q}}
+		}
+		next, err = c.page(ctx, fmt.Sprintf("/2.0/workspaces/%s/permissions/repositories", workspace), qry, pageToken, &perms)
+	}
+	return perms, next, err
+}
+
 func (c *Client) page(ctx context.Context, path string, qry url.Values, token *PageToken, results interface{}) (*PageToken, error) {
 	if qry == nil {
 		qry = make(url.Values)
@@ -236,6 +286,22 @@ type Repo struct {
 	Links       Links  `json:"links"`
 }
 
+// User is a Bitbucket Cloud user account.
+type User struct {
+	UUID        string `json:"uuid"`
+	AccountID   string `json:"account_id"`
+	Nickname    string `json:"nickname"`
+	DisplayName string `json:"display_name"`
+}
+
+// RepoPermission is the permission a user has on a repository.
+type RepoPermission struct {
+	// Permission is one of "read", "write" or "admin".
+	Permission string `json:"permission"`
+	User       *User  `json:"user"`
+	Repo       *Repo  `json:"repository"`
+}
+
 type Links struct {
 	Clone CloneLinks `json:"clone"`
 	HTML  Link       `json:"html"`
@@ -0,0 +1,63 @@
+package bitbucketcloud
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Webhook event keys, as sent in the X-Event-Key header of webhook requests.
+//
+// Bitbucket Cloud does not send events when workspace membership or repository
+// access changes, so these are the closest signals of a permission change.
+const (
+	EventRepoUpdated  = "repo:updated"
+	EventRepoTransfer = "repo:transfer"
+)
+
+// RepoEvent is the payload of webhook events about a repository.
+type RepoEvent struct {
+	Actor      *User `json:"actor"`
+	Repository *Repo `json:"repository"`
+}
+
+// ParseWebhookEvent parses the payload of a webhook request with the given event key.
+// It returns a nil event for event keys it doesn't know about.
+func ParseWebhookEvent(eventKey string, payload []byte) (interface{}, error) {
+	switch eventKey {
+	case EventRepoUpdated, EventRepoTransfer:
+		var e RepoEvent
+		if err := json.Unmarshal(payload, &e); err != nil {
+			return nil, err
+		}
+		return &e, nil
+	default:
+		return nil, nil
+	}
+}
+
+// ValidateSignature checks that sig, the value of the X-Hub-Signature header of a
+// webhook request, is the HMAC-SHA256 of the payload keyed with the given secret.
+func ValidateSignature(sig string, payload, secret []byte) error {
+	hexMAC := strings.TrimPrefix(sig, "sha256=")
+	if hexMAC == sig {
+		return errors.New("missing or unsupported signature")
+	}
+
+	mac, err := hex.DecodeString(hexMAC)
+	if err != nil {
+		return errors.Wrap(err, "decoding signature")
+	}
+
+	h := hmac.New(sha256.New, secret)
+	h.Write(payload)
+	if !hmac.Equal(mac, h.Sum(nil)) {
+		return errors.New("payload signature does not match")
+	}
+
+	return nil
+}
@@ -1651,6 +1651,80 @@ type Repository struct {
 	// Metadata retained for ranking
 	StargazerCount int `json:",omitempty"`
 	ForkCount      int `json:",omitempty"`
+
+	// Metadata retained for search filters
+	RepositoryTopics *RepositoryTopics `json:",omitempty"` // the topics of the repository
+	PrimaryLanguage  *Language         `json:",omitempty"` // the primary language of the repository
+	LicenseInfo      *License          `json:",omitempty"` // the license of the repository
+	DefaultBranchRef *DefaultBranchRef `json:",omitempty"` // the default branch of the repository
+}
+
+// RepositoryTopics is the list of topics of a GitHub repository.
+type RepositoryTopics struct {
+	Nodes []RepositoryTopic
+}
+
+// RepositoryTopic is a topic of a GitHub repository.
+type RepositoryTopic struct {
+	Topic struct {
+		Name string
+	}
+}
+
+// Language is a programming language of a GitHub repository.
+type Language struct {
+	Name string
+}
+
+// License is the license of a GitHub repository.
+type License struct {
+	SpdxID string `json:"spdxId"` // the SPDX identifier, or "NOASSERTION" if unknown
+}
+
+// DefaultBranchRef is the default branch of a GitHub repository.
+type DefaultBranchRef struct {
+	Name string
+	// BranchProtectionRule is nil if the branch isn't protected, or if the
+	// protection is not visible to the viewer.
+	BranchProtectionRule *struct {
+		ID string
+	} `json:",omitempty"`
+}
+
+// Topics returns the names of the topics of the repository.
+func (r *Repository) Topics() []string {
+	if r.RepositoryTopics == nil || len(r.RepositoryTopics.Nodes) == 0 {
+		return nil
+	}
+	topics := make([]string, 0, len(r.RepositoryTopics.Nodes))
+	for _, n := range r.RepositoryTopics.Nodes {
+		topics = append(topics, n.Topic.Name)
+	}
+	return topics
+}
+
+// PrimaryLanguageName returns the name of the primary language of the
+// repository, or "" if it is unknown.
+func (r *Repository) PrimaryLanguageName() string {
+	if r.PrimaryLanguage == nil {
+		return ""
+	}
+	return r.PrimaryLanguage.Name
+}
+
+// License returns the SPDX identifier of the license of the repository, or
+// "" if it is unknown.
+func (r *Repository) License() string {
+	if r.LicenseInfo == nil || r.LicenseInfo.SpdxID == "NOASSERTION" {
+		return ""
+	}
+	return r.LicenseInfo.SpdxID
+}
+
+// DefaultBranchProtected returns true if the default branch of the repository
+// is protected by a branch protection rule.
+func (r *Repository) DefaultBranchProtected() bool {
+	return r.DefaultBranchRef != nil && r.DefaultBranchRef.BranchProtectionRule != nil
 }
 
 func ownerNameCacheKey(owner, name string) string       { return "0:" + owner + "/" + name }
@@ -1722,6 +1796,16 @@ type restRepository struct {
 	Permissions restRepositoryPermissions `json:"permissions"`
 	Stars       int                       `json:"stargazers_count"`
 	Forks       int                       `json:"forks_count"`
+	Topics      []string                  `json:"topics"`
+	Language    string                    `json:"language"`
+	License     *restLicense              `json:"license"`
+	// DefaultBranch is the name of the default branch. The REST API doesn't
+	// report whether it is protected.
+	DefaultBranch string `json:"default_branch"`
+}
+
+type restLicense struct {
+	SpdxID string `json:"spdx_id"`
 }
 
 // getRepositoryFromAPI attempts to fetch a repository from the GitHub API without use of the redis cache.
@@ -1743,7 +1827,7 @@ func (c *V3Client) getRepositoryFromAPI(ctx context.Context, owner, name string)
 // convertRestRepo converts repo information returned by the rest API
 // to a standard format.
 func convertRestRepo(restRepo restRepository) *Repository {
-	return &Repository{
+	repo := &Repository{
 		ID:               restRepo.ID,
 		DatabaseID:       restRepo.DatabaseID,
 		NameWithOwner:    restRepo.FullName,
@@ -1758,6 +1842,22 @@ func convertRestRepo(restRepo restRepository) *Repository {
 		StargazerCount:   restRepo.Stars,
 		ForkCount:        restRepo.Forks,
 	}
+	if len(restRepo.Topics) > 0 {
+		repo.RepositoryTopics = &RepositoryTopics{Nodes: make([]RepositoryTopic, len(restRepo.Topics))}
+		for i, t := range restRepo.Topics {
+			repo.RepositoryTopics.Nodes[i].Topic.Name = t
+		}
+	}
+	if restRepo.Language != "" {
+		repo.PrimaryLanguage = &Language{Name: restRepo.Language}
+	}
+	if restRepo.License != nil {
+		repo.LicenseInfo = &License{SpdxID: restRepo.License.SpdxID}
+	}
+	if restRepo.DefaultBranch != "" {
+		repo.DefaultBranchRef = &DefaultBranchRef{Name: restRepo.DefaultBranch}
+	}
+	return repo
 }
 
 // convertRestRepoPermissions converts repo information returned by the rest API
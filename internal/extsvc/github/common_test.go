@@ -86,21 +86,31 @@ func TestClient_GetRepository(t *testing.T) {
 	"fork": true,
 	"stargazers_count": 30,
 	"watchers_count": 20,
-	"forks_count": 5
+	"forks_count": 5,
+	"topics": ["go", "search"],
+	"language": "Go",
+	"license": {"key": "apache-2.0", "spdx_id": "Apache-2.0"},
+	"default_branch": "main"
 }
 `,
 	}
 	c := newTestClient(t, &mock)
 
 	want := Repository{
-		ID:             "i",
-		NameWithOwner:  "o/r",
-		Description:    "d",
-		URL:            "https://github.example.com/o/r",
-		IsFork:         true,
-		StargazerCount: 30,
-		ForkCount:      5,
-	}
+		ID:               "i",
+		NameWithOwner:    "o/r",
+		Description:      "d",
+		URL:              "https://github.example.com/o/r",
+		IsFork:           true,
+		StargazerCount:   30,
+		ForkCount:        5,
+		RepositoryTopics: &RepositoryTopics{Nodes: make([]RepositoryTopic, 2)},
+		PrimaryLanguage:  &Language{Name: "Go"},
+		LicenseInfo:      &License{SpdxID: "Apache-2.0"},
+		DefaultBranchRef: &DefaultBranchRef{Name: "main"},
+	}
+	want.RepositoryTopics.Nodes[0].Topic.Name = "go"
+	want.RepositoryTopics.Nodes[1].Topic.Name = "search"
 
 	repo, err := c.GetRepository(context.Background(), "owner", "repo")
 	if err != nil {
@@ -448,13 +448,17 @@ fragment RepositoryFields on Repository {
 	viewerPermission
 	stargazerCount
 	forkCount
+	repositoryTopics(first: 100) { nodes { topic { name } } }
+	primaryLanguage { name }
+	licenseInfo { spdxId }
+	defaultBranchRef { name branchProtectionRule { id } }
 }
 	`
 	}
 	ghe300Fields := []string{}
 	version := c.determineGitHubVersion(ctx)
 	if ghe300PlusOrDotComSemver.Check(version) {
-		ghe300Fields = append(ghe300Fields, "stargazerCount")
+		ghe300Fields = append(ghe300Fields, "stargazerCount", "defaultBranchRef { name branchProtectionRule { id } }")
 	}
 	// Some fields are not yet available on GitHub Enterprise yet
 	// or are available but too new to expect our customers to have updated:
@@ -472,6 +476,9 @@ fragment RepositoryFields on Repository {
 	isLocked
 	isDisabled
 	forkCount
+	repositoryTopics(first: 100) { nodes { topic { name } } }
+	primaryLanguage { name }
+	licenseInfo { spdxId }
 	%s
 }
 	`, strings.Join(ghe300Fields, "\n	"))
@@ -30,6 +30,17 @@ type Project struct {
 	Archived          bool           `json:"archived"`
 	StarCount         int            `json:"star_count"`
 	ForksCount        int            `json:"forks_count"`
+	Topics            []string       `json:"topics,omitempty"`   // GitLab 14.0+
+	TagList           []string       `json:"tag_list,omitempty"` // Deprecated in favor of topics
+}
+
+// ProjectTopics returns the topics of the project, which were called tags
+// before GitLab 14.0.
+func (p Project) ProjectTopics() []string {
+	if len(p.Topics) > 0 {
+		return p.Topics
+	}
+	return p.TagList
 }
 
 type ProjectCommon struct {
@@ -112,9 +112,35 @@ func (c *Client) addrForKey(key string) string {
 // It should never be called with an empty slice.
 func AddrForRepo(repo api.RepoName, addrs []string) string {
 	repo = protocol.NormalizeRepo(repo) // in case the caller didn't already normalize it
+	if conf.GitServerRebalancingEnabled() {
+		return RendezvousAddrForRepo(repo, addrs)
+	}
 	return addrForKey(string(repo), addrs)
 }
 
+// RendezvousAddrForRepo returns the gitserver address to use for the given
+// repo name by rendezvous (highest random weight) hashing: each address is
+// weighted by the hash of itself and the repo name, and the address with the
+// highest weight wins. Unlike the modulo hashing of addrForKey, adding an
+// address only moves the repos which are placed on the new address.
+//
+// It should never be called with an empty slice.
+func RendezvousAddrForRepo(repo api.RepoName, addrs []string) string {
+	repo = protocol.NormalizeRepo(repo)
+	var (
+		best       string
+		bestWeight uint64
+	)
+	for _, addr := range addrs {
+		sum := md5.Sum([]byte(addr + "\x00" + string(repo)))
+		weight := binary.BigEndian.Uint64(sum[:])
+		if best == "" || weight > bestWeight || (weight == bestWeight && addr < best) {
+			best, bestWeight = addr, weight
+		}
+	}
+	return best
+}
+
 // addrForKey returns the gitserver address to use for the given string key,
 // which is hashed for sharding purposes.
 func addrForKey(key string, addrs []string) string {
@@ -531,8 +557,10 @@ func (c *Client) ListCloned(ctx context.Context) ([]string, error) {
 			defer wg.Done()
 			r, e := c.doListOne(ctx, "?cloned", addr)
 
-			// Only include repos that belong on addr.
-			if len(r) > 0 {
+			// Only include repos that belong on addr. While rebalancing, repos
+			// are served by the shard they are cloned on until they are moved
+			// to the shard they belong on, so we include all of them.
+			if len(r) > 0 && !conf.GitServerRebalancingEnabled() {
 				filtered := r[:0]
 				for _, repo := range r {
 					if addrForKey(repo, addrs) == addr {
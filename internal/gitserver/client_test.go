@@ -287,6 +287,42 @@ func TestAddrForRepo(t *testing.T) {
 	}
 }
 
+func TestRendezvousAddrForRepo(t *testing.T) {
+	addrs := []string{"gitserver-1", "gitserver-2", "gitserver-3"}
+	grown := append(addrs[:len(addrs):len(addrs)], "gitserver-4")
+
+	counts := map[string]int{}
+	moved := 0
+	for i := 0; i < 1000; i++ {
+		repo := api.RepoName(fmt.Sprintf("github.com/foo/repo%d", i))
+		before := gitserver.RendezvousAddrForRepo(repo, addrs)
+		after := gitserver.RendezvousAddrForRepo(repo, grown)
+		counts[after]++
+
+		if have := gitserver.RendezvousAddrForRepo(repo+".git", addrs); have != before {
+			t.Fatalf("%s: want normalized name on %q, got %q", repo, before, have)
+		}
+
+		// Adding an address must only move repos to the new address.
+		if before != after {
+			moved++
+			if after != "gitserver-4" {
+				t.Fatalf("%s: moved from %q to existing %q", repo, before, after)
+			}
+		}
+	}
+
+	// Each address gets roughly a quarter of the repos.
+	for _, addr := range grown {
+		if counts[addr] < 150 || counts[addr] > 350 {
+			t.Errorf("unbalanced placement on %q: %d of 1000 repos", addr, counts[addr])
+		}
+	}
+	if moved != counts["gitserver-4"] {
+		t.Errorf("want %d moved repos, got %d", counts["gitserver-4"], moved)
+	}
+}
+
 func TestClient_P4Exec(t *testing.T) {
 	root, err := os.MkdirTemp("", t.Name())
 	if err != nil {
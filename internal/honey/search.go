@@ -6,6 +6,7 @@ import (
 	"github.com/honeycombio/libhoney-go"
 
 	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
 )
 
 type SearchEventArgs struct {
@@ -34,5 +35,8 @@ func SearchEvent(ctx context.Context, args SearchEventArgs) *libhoney.Event {
 	ev.AddField("alert_type", args.AlertType)
 	ev.AddField("duration_ms", args.DurationMs)
 	ev.AddField("result_size", args.ResultSize)
+	if traceID := trace.TraceIDFromContext(ctx); traceID != "" {
+		ev.AddField("trace_id", traceID)
+	}
 	return ev
 }
@@ -0,0 +1,120 @@
+// Package outbox delivers the events published to the outbox_events table to
+// the subscriptions registered in this process.
+//
+// Events are published with database.OutboxEventStore.Publish in the same
+// transaction as the mutation which caused them. The dispatcher delivers each
+// event at least once to every subscription of its topic, in the order the
+// events were published.
+package outbox
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+)
+
+// Handler is invoked for every event delivered to a subscription. An event is
+// redelivered until its handler returns a nil error.
+type Handler func(ctx context.Context, event *database.OutboxEvent) error
+
+// Subscription receives the events of the given topics which are published
+// after it is first registered.
+type Subscription struct {
+	// Name uniquely identifies the subscription. Renaming a subscription
+	// creates a new one which does not receive the events published before.
+	Name    string
+	Topics  []string
+	Handler Handler
+}
+
+var (
+	subscriptionsMu sync.Mutex
+	subscriptions   []Subscription
+)
+
+// Subscribe registers the subscription with the dispatchers of this process.
+// It should be called at init time.
+func Subscribe(s Subscription) {
+	subscriptionsMu.Lock()
+	defer subscriptionsMu.Unlock()
+	subscriptions = append(subscriptions, s)
+}
+
+func registeredSubscriptions() []Subscription {
+	subscriptionsMu.Lock()
+	defer subscriptionsMu.Unlock()
+	return append([]Subscription(nil), subscriptions...)
+}
+
+// batchSize is the maximum number of events delivered to a subscription in a
+// single iteration of the dispatcher.
+const batchSize = 100
+
+// NewDispatcher returns a background routine which periodically delivers new
+// events to the registered subscriptions and deletes expired events.
+func NewDispatcher(ctx context.Context, db dbutil.DB) goroutine.BackgroundRoutine {
+	return goroutine.NewPeriodicGoroutine(ctx, 5*time.Second, &dispatcher{
+		store: database.OutboxEvents(db),
+		now:   time.Now,
+	})
+}
+
+type dispatcher struct {
+	store *database.OutboxEventStore
+	now   func() time.Time
+}
+
+var _ goroutine.Handler = &dispatcher{}
+
+func (d *dispatcher) Handle(ctx context.Context) error {
+	for _, s := range registeredSubscriptions() {
+		if err := d.deliver(ctx, s); err != nil {
+			// A failing subscription must not hold up the others. Its events
+			// are redelivered on the next iteration.
+			log15.Error("outbox: failed to deliver events", "subscription", s.Name, "error", err)
+		}
+	}
+
+	return d.store.DeleteOlderThan(ctx, d.now().Add(-database.OutboxEventRetention))
+}
+
+// deliver hands the undelivered events of the subscription to its handler. The
+// subscription is locked for the duration, so that an event is not delivered
+// concurrently by the dispatchers of several processes.
+func (d *dispatcher) deliver(ctx context.Context, s Subscription) (err error) {
+	tx, err := d.store.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	ok, err := tx.LockSubscription(ctx, s.Name)
+	if err != nil || !ok {
+		return err
+	}
+
+	events, err := tx.ListUndelivered(ctx, s.Name, s.Topics, batchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		if err := s.Handler(ctx, event); err != nil {
+			// Stop at the first failure so that events are handled in order,
+			// but commit the deliveries marked so far.
+			log15.Error("outbox: failed to handle event", "subscription", s.Name, "event", event.ID, "error", err)
+			return nil
+		}
+		if err := tx.MarkDelivered(ctx, s.Name, event.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+)
+
+func TestDispatcher(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	db := dbtest.NewDB(t, "")
+	ctx := context.Background()
+
+	store := database.OutboxEvents(db)
+	d := &dispatcher{store: store, now: time.Now}
+
+	var handled []string
+	failOn := "2"
+	s := Subscription{
+		Name:   "test",
+		Topics: []string{database.OutboxTopicRepoCreated},
+		Handler: func(ctx context.Context, event *database.OutboxEvent) error {
+			if event.SubjectID == failOn {
+				return errors.New("boom")
+			}
+			handled = append(handled, event.SubjectID)
+			return nil
+		},
+	}
+
+	// Registers the subscription, so that it receives the events below.
+	if err := d.deliver(ctx, s); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, id := range []int{1, 2, 3} {
+		if err := store.Publish(ctx, database.OutboxTopicRepoCreated, id, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := store.Publish(ctx, database.OutboxTopicUserDeleted, 4, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Delivery stops at the failing event and keeps the earlier deliveries.
+	if err := d.deliver(ctx, s); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"1"}, handled); diff != "" {
+		t.Fatalf("unexpected handled events (-want +got):\n%s", diff)
+	}
+
+	failOn = ""
+	if err := d.deliver(ctx, s); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"1", "2", "3"}, handled); diff != "" {
+		t.Fatalf("unexpected handled events (-want +got):\n%s", diff)
+	}
+}
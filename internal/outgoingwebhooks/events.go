@@ -0,0 +1,90 @@
+// Package outgoingwebhooks delivers platform events, such as the addition of
+// a repository, to the outgoing webhooks registered by site admins.
+//
+// Events are received from the outbox and a delivery is queued for every
+// webhook subscribed to the event's type. Deliveries are processed by a
+// dbworker which retries failed deliveries with exponential backoff.
+package outgoingwebhooks
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/outbox"
+)
+
+// The types of the events delivered to outgoing webhooks.
+const (
+	EventTypeRepoAdded        = "repo.added"
+	EventTypeUploadProcessed  = "upload.processed"
+	EventTypeSavedSearchFired = "saved_search.fired"
+	EventTypeChangesetMerged  = "changeset.merged"
+)
+
+// EventTypes are all types of events which can be delivered to outgoing
+// webhooks.
+var EventTypes = []string{
+	EventTypeRepoAdded,
+	EventTypeUploadProcessed,
+	EventTypeSavedSearchFired,
+	EventTypeChangesetMerged,
+}
+
+// eventTypesByTopic maps the outbox topics to the types of the events
+// delivered to outgoing webhooks.
+var eventTypesByTopic = map[string]string{
+	database.OutboxTopicRepoCreated:      EventTypeRepoAdded,
+	database.OutboxTopicUploadCompleted:  EventTypeUploadProcessed,
+	database.OutboxTopicSavedSearchFired: EventTypeSavedSearchFired,
+	database.OutboxTopicChangesetMerged:  EventTypeChangesetMerged,
+}
+
+// Event is the payload delivered to outgoing webhooks.
+type Event struct {
+	Type      string          `json:"event"`
+	Timestamp time.Time       `json:"timestamp"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// Subscription returns the outbox subscription which queues the deliveries of
+// events to the outgoing webhooks.
+func Subscription(db dbutil.DB) outbox.Subscription {
+	topics := make([]string, 0, len(eventTypesByTopic))
+	for topic := range eventTypesByTopic {
+		topics = append(topics, topic)
+	}
+
+	return outbox.Subscription{
+		Name:   "outgoing_webhooks",
+		Topics: topics,
+		Handler: func(ctx context.Context, e *database.OutboxEvent) error {
+			event, err := eventFromOutbox(e)
+			if err != nil {
+				return err
+			}
+			payload, err := json.Marshal(event)
+			if err != nil {
+				return err
+			}
+			return database.OutgoingWebhooks(db).EnqueueDeliveries(ctx, event.Type, payload)
+		},
+	}
+}
+
+// eventFromOutbox converts an outbox event into the event delivered to
+// outgoing webhooks. The payload of the outbox event is delivered as is, so
+// publishers of the subscribed topics must only publish data which webhooks
+// may receive.
+func eventFromOutbox(e *database.OutboxEvent) (*Event, error) {
+	eventType, ok := eventTypesByTopic[e.Topic]
+	if !ok {
+		return nil, errors.Errorf("unexpected outbox topic %q", e.Topic)
+	}
+
+	return &Event{Type: eventType, Timestamp: e.CreatedAt, Data: e.Payload}, nil
+}
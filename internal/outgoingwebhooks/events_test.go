@@ -0,0 +1,57 @@
+package outgoingwebhooks
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+)
+
+func TestEventFromOutbox(t *testing.T) {
+	createdAt := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	for _, test := range []struct {
+		name     string
+		topic    string
+		payload  string
+		wantType string
+		wantData string
+	}{
+		{
+			name:     "repo",
+			topic:    database.OutboxTopicRepoCreated,
+			payload:  `{"id":1,"name":"github.com/a/b"}`,
+			wantType: EventTypeRepoAdded,
+			wantData: `{"id":1,"name":"github.com/a/b"}`,
+		},
+		{
+			name:     "saved search",
+			topic:    database.OutboxTopicSavedSearchFired,
+			payload:  `{"query":"repo:a","newMatchCount":3}`,
+			wantType: EventTypeSavedSearchFired,
+			wantData: `{"query":"repo:a","newMatchCount":3}`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			event, err := eventFromOutbox(&database.OutboxEvent{
+				Topic:     test.topic,
+				Payload:   json.RawMessage(test.payload),
+				CreatedAt: createdAt,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := &Event{Type: test.wantType, Timestamp: createdAt, Data: json.RawMessage(test.wantData)}
+			if diff := cmp.Diff(want, event); diff != "" {
+				t.Errorf("unexpected event (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	if _, err := eventFromOutbox(&database.OutboxEvent{Topic: database.OutboxTopicUserDeleted}); err == nil {
+		t.Error("expected an error for an unknown topic")
+	}
+}
@@ -0,0 +1,260 @@
+package outgoingwebhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+	"github.com/keegancsmith/sqlf"
+	"github.com/opentracing/opentracing-go"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker"
+	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
+)
+
+const (
+	// maxDeliveryAttempts is the number of times a delivery is attempted
+	// before it is marked as failed.
+	maxDeliveryAttempts = 8
+
+	// initialBackoff is the delay before the first retry of a delivery. The
+	// delay doubles with every further attempt.
+	initialBackoff = 30 * time.Second
+
+	// deliveryTimeout is the maximum duration of a single delivery attempt.
+	deliveryTimeout = 30 * time.Second
+
+	// maxResponseBodySize is the maximum number of bytes of a response body
+	// recorded for a delivery attempt.
+	maxResponseBodySize = 4 * 1024
+
+	// deliveryRetention is the time after which finished deliveries are
+	// deleted.
+	deliveryRetention = 7 * 24 * time.Hour
+)
+
+// Routines returns the background routines which deliver the queued events to
+// the outgoing webhooks.
+func Routines(ctx context.Context, db dbutil.DB) []goroutine.BackgroundRoutine {
+	store := database.OutgoingWebhooks(db)
+	metrics := newMetrics()
+
+	return []goroutine.BackgroundRoutine{
+		newDeliveryWorker(ctx, store, httpcli.ExternalDoer(), metrics),
+		newDeliveryResetter(store, metrics),
+		newDeliveryJanitor(ctx, store),
+	}
+}
+
+func newDeliveryWorker(ctx context.Context, s *database.OutgoingWebhookStore, doer httpcli.Doer, metrics deliveryMetrics) *workerutil.Worker {
+	options := workerutil.WorkerOptions{
+		Name:        "outgoing_webhook_delivery_worker",
+		NumHandlers: 4,
+		Interval:    5 * time.Second,
+		Metrics:     metrics.workerMetrics,
+	}
+	handler := &deliveryHandler{store: s, doer: doer, now: time.Now}
+	return dbworker.NewWorker(ctx, createDBWorkerStore(s), handler, options)
+}
+
+func newDeliveryResetter(s *database.OutgoingWebhookStore, metrics deliveryMetrics) *dbworker.Resetter {
+	options := dbworker.ResetterOptions{
+		Name:     "outgoing_webhook_delivery_worker_resetter",
+		Interval: 1 * time.Minute,
+		Metrics: dbworker.ResetterMetrics{
+			Errors:              metrics.errors,
+			RecordResetFailures: metrics.resetFailures,
+			RecordResets:        metrics.resets,
+		},
+	}
+	return dbworker.NewResetter(createDBWorkerStore(s), options)
+}
+
+func newDeliveryJanitor(ctx context.Context, s *database.OutgoingWebhookStore) goroutine.BackgroundRoutine {
+	deleteDeliveries := goroutine.NewHandlerWithErrorMessage(
+		"outgoing_webhook_delivery_janitor",
+		func(ctx context.Context) error {
+			return s.DeleteDeliveriesFinishedBefore(ctx, time.Now().Add(-deliveryRetention))
+		})
+	return goroutine.NewPeriodicGoroutine(ctx, 60*time.Minute, deleteDeliveries)
+}
+
+func createDBWorkerStore(s *database.OutgoingWebhookStore) dbworkerstore.Store {
+	return dbworkerstore.New(s.Handle(), dbworkerstore.Options{
+		Name:              "outgoing_webhook_delivery_worker_store",
+		TableName:         "outgoing_webhook_deliveries",
+		ColumnExpressions: database.OutgoingWebhookDeliveryColumns,
+		Scan:              scanDelivery,
+		StalledMaxAge:     60 * time.Second,
+		RetryAfter:        initialBackoff,
+		MaxNumRetries:     3,
+		OrderByExpression: sqlf.Sprintf("id"),
+	})
+}
+
+func scanDelivery(rows *sql.Rows, err error) (_ workerutil.Record, exists bool, _ error) {
+	deliveries, err := database.ScanOutgoingWebhookDeliveries(rows, err)
+	if err != nil || len(deliveries) == 0 {
+		return nil, false, err
+	}
+	return deliveries[0], true, nil
+}
+
+type deliveryHandler struct {
+	store *database.OutgoingWebhookStore
+	doer  httpcli.Doer
+	now   func() time.Time
+}
+
+var _ dbworker.Handler = &deliveryHandler{}
+
+// Handle attempts to deliver an event to an outgoing webhook. The attempt is
+// recorded on the delivery. A failed delivery is requeued with exponential
+// backoff until it was attempted maxDeliveryAttempts times.
+func (h *deliveryHandler) Handle(ctx context.Context, workerStore dbworkerstore.Store, record workerutil.Record) error {
+	delivery, ok := record.(*database.OutgoingWebhookDelivery)
+	if !ok {
+		return fmt.Errorf("type assertion failed")
+	}
+
+	store := h.store.With(workerStore)
+	webhook, err := store.GetByID(ctx, delivery.WebhookID)
+	if err != nil {
+		return errors.Wrap(err, "store.GetByID")
+	}
+	if !webhook.Enabled {
+		return errcode.MakeNonRetryable(errors.New("outgoing webhook is disabled"))
+	}
+
+	statusCode, responseBody, deliverErr := deliver(ctx, h.doer, webhook, delivery)
+
+	var failureMessage string
+	if deliverErr != nil {
+		failureMessage = deliverErr.Error()
+	}
+	if err := store.RecordDeliveryAttempt(ctx, delivery.ID, statusCode, responseBody, failureMessage); err != nil {
+		return errors.Wrap(err, "store.RecordDeliveryAttempt")
+	}
+	if deliverErr == nil {
+		return nil
+	}
+
+	attempts := delivery.Attempts + 1
+	if attempts >= maxDeliveryAttempts {
+		return errcode.MakeNonRetryable(deliverErr)
+	}
+	if err := workerStore.Requeue(ctx, delivery.ID, h.now().Add(backoff(attempts))); err != nil {
+		return errors.Wrap(err, "store.Requeue")
+	}
+	log15.Warn("Requeued outgoing webhook delivery", "id", delivery.ID, "attempts", attempts, "error", deliverErr)
+	return nil
+}
+
+// backoff returns the delay before the next attempt of a delivery which was
+// attempted the given number of times.
+func backoff(attempts int) time.Duration {
+	return initialBackoff << (attempts - 1)
+}
+
+// deliver sends the payload of the delivery to the URL of the webhook. It
+// returns the status code and the truncated body of the response, if one was
+// received, and an error if the response does not have a 2xx status code.
+func deliver(ctx context.Context, doer httpcli.Doer, webhook *database.OutgoingWebhook, delivery *database.OutgoingWebhookDelivery) (statusCode *int, responseBody string, err error) {
+	ctx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+	defer cancel()
+
+	req, err := http.NewRequest("POST", webhook.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return nil, "", err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Sourcegraph-Webhooks")
+	req.Header.Set("X-Sourcegraph-Event", delivery.EventType)
+	req.Header.Set("X-Sourcegraph-Delivery", strconv.Itoa(delivery.ID))
+	req.Header.Set("X-Sourcegraph-Signature", Sign(webhook.Secret, delivery.Payload))
+
+	resp, err := doer.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxResponseBodySize))
+	if err != nil {
+		return &resp.StatusCode, "", errors.Wrap(err, "reading response body")
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return &resp.StatusCode, string(body), errors.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return &resp.StatusCode, string(body), nil
+}
+
+// Sign returns the value of the X-Sourcegraph-Signature header of a delivery
+// of the given payload: the hex-encoded HMAC-SHA256 of the payload keyed with
+// the secret of the webhook, prefixed with "sha256=".
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+type deliveryMetrics struct {
+	workerMetrics workerutil.WorkerMetrics
+	resets        prometheus.Counter
+	resetFailures prometheus.Counter
+	errors        prometheus.Counter
+}
+
+func newMetrics() deliveryMetrics {
+	observationContext := &observation.Context{
+		Logger:     log15.Root(),
+		Tracer:     &trace.Tracer{Tracer: opentracing.GlobalTracer()},
+		Registerer: prometheus.DefaultRegisterer,
+	}
+
+	resetFailures := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "src_outgoing_webhook_deliveries_reset_failures_total",
+		Help: "The number of reset failures.",
+	})
+	observationContext.Registerer.MustRegister(resetFailures)
+
+	resets := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "src_outgoing_webhook_deliveries_resets_total",
+		Help: "The number of records reset.",
+	})
+	observationContext.Registerer.MustRegister(resets)
+
+	errors := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "src_outgoing_webhook_deliveries_errors_total",
+		Help: "The number of errors that occur during job.",
+	})
+	observationContext.Registerer.MustRegister(errors)
+
+	return deliveryMetrics{
+		workerMetrics: workerutil.NewMetrics(observationContext, "outgoing_webhook_deliveries", nil),
+		resets:        resets,
+		resetFailures: resetFailures,
+		errors:        errors,
+	}
+}
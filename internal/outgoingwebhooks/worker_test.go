@@ -0,0 +1,82 @@
+package outgoingwebhooks
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+)
+
+func TestDeliver(t *testing.T) {
+	payload := []byte(`{"event":"repo.added","data":{"id":1,"name":"github.com/a/b"}}`)
+
+	var status int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != string(payload) {
+			t.Errorf("unexpected body. want=%s have=%s", payload, body)
+		}
+		if have := r.Header.Get("X-Sourcegraph-Event"); have != "repo.added" {
+			t.Errorf("unexpected event header. want=%q have=%q", "repo.added", have)
+		}
+		if have := r.Header.Get("X-Sourcegraph-Delivery"); have != "42" {
+			t.Errorf("unexpected delivery header. want=%q have=%q", "42", have)
+		}
+		if have, want := r.Header.Get("X-Sourcegraph-Signature"), Sign("s3cr3t", payload); have != want {
+			t.Errorf("unexpected signature header. want=%q have=%q", want, have)
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte("response"))
+	}))
+	defer server.Close()
+
+	webhook := &database.OutgoingWebhook{URL: server.URL, Secret: "s3cr3t"}
+	delivery := &database.OutgoingWebhookDelivery{ID: 42, EventType: "repo.added", Payload: payload}
+
+	for _, test := range []struct {
+		status  int
+		wantErr bool
+	}{
+		{status: http.StatusOK},
+		{status: http.StatusNoContent},
+		{status: http.StatusNotFound, wantErr: true},
+		{status: http.StatusBadGateway, wantErr: true},
+	} {
+		status = test.status
+		statusCode, body, err := deliver(context.Background(), httpcli.ExternalDoer(), webhook, delivery)
+		if (err != nil) != test.wantErr {
+			t.Errorf("status %d: unexpected error: %v", test.status, err)
+		}
+		if statusCode == nil || *statusCode != test.status {
+			t.Errorf("unexpected status code. want=%d have=%v", test.status, statusCode)
+		}
+		if test.status != http.StatusNoContent && body != "response" {
+			t.Errorf("unexpected response body. want=%q have=%q", "response", body)
+		}
+	}
+}
+
+func TestSign(t *testing.T) {
+	// Computed with `printf 'payload' | openssl dgst -sha256 -hmac secret`
+	want := "sha256=b82fcb791acec57859b989b430a826488ce2e479fdf92326bd0a2e8375a42ba4"
+	if have := Sign("secret", []byte("payload")); have != want {
+		t.Errorf("unexpected signature. want=%q have=%q", want, have)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	for attempts, want := range map[int]time.Duration{
+		1: 30 * time.Second,
+		2: time.Minute,
+		7: 32 * time.Minute,
+	} {
+		if have := backoff(attempts); have != want {
+			t.Errorf("unexpected backoff after %d attempts. want=%s have=%s", attempts, want, have)
+		}
+	}
+}
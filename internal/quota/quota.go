@@ -0,0 +1,274 @@
+// Package quota enforces the instance-wide usage quotas configured in the
+// "quotas" site configuration setting.
+//
+// Usage is counted per user, or per access token for API calls, in fixed
+// windows stored in Redis. Site admins can override the limit of a quota for
+// users and orgs; see database.QuotaOverrideStore.
+package quota
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/redispool"
+)
+
+// Quota is the name of a usage quota.
+type Quota string
+
+const (
+	// Searches is the number of searches a user can run per minute.
+	Searches Quota = "searches"
+	// LSIFUploadBytes is the number of bytes of LSIF uploads a user can send
+	// per day.
+	LSIFUploadBytes Quota = "lsif_upload_bytes"
+	// APICallsPerToken is the number of API requests which can be made with
+	// an access token per hour.
+	APICallsPerToken Quota = "api_calls_per_token"
+)
+
+// All is the list of all quotas.
+var All = []Quota{Searches, LSIFUploadBytes, APICallsPerToken}
+
+// Window returns the duration of the windows in which the usage of the quota
+// is counted.
+func (q Quota) Window() time.Duration {
+	switch q {
+	case Searches:
+		return time.Minute
+	case LSIFUploadBytes:
+		return 24 * time.Hour
+	default:
+		return time.Hour
+	}
+}
+
+// siteLimit returns the limit of the quota in the site configuration, or 0 if
+// the quota is not enforced.
+func (q Quota) siteLimit() int64 {
+	c := conf.Get().Quotas
+	if c == nil {
+		return 0
+	}
+	switch q {
+	case Searches:
+		return int64(c.SearchesPerMinute)
+	case LSIFUploadBytes:
+		return int64(c.LsifUploadBytesPerDay)
+	case APICallsPerToken:
+		return int64(c.ApiCallsPerTokenPerHour)
+	}
+	return 0
+}
+
+// ExceededError is returned by Use when a quota is exceeded.
+type ExceededError struct {
+	Quota      Quota
+	Limit      int64
+	RetryAfter time.Duration
+}
+
+func (e *ExceededError) Error() string {
+	return fmt.Sprintf(
+		"%s quota exceeded: the limit is %d per %s. Retry in %s, or ask a site admin to raise your quota.",
+		e.Quota, e.Limit, e.Quota.Window(), e.RetryAfter.Round(time.Second),
+	)
+}
+
+// counter increments the usage counter with the given key and returns its new
+// value. The counter expires at the given time.
+type counter func(key string, amount int64, expireAt time.Time) (int64, error)
+
+func redisCounter(key string, amount int64, expireAt time.Time) (int64, error) {
+	c := redispool.Store.Get()
+	defer c.Close()
+
+	if err := c.Send("MULTI"); err != nil {
+		return 0, err
+	}
+	if err := c.Send("INCRBY", key, amount); err != nil {
+		return 0, err
+	}
+	if err := c.Send("EXPIREAT", key, expireAt.Unix()); err != nil {
+		return 0, err
+	}
+	values, err := redis.Values(c.Do("EXEC"))
+	if err != nil {
+		return 0, err
+	}
+	return redis.Int64(values[0], nil)
+}
+
+var (
+	incr = counter(redisCounter)
+	now  = time.Now
+)
+
+// overrideCacheTTL is how long the quota override of a user is cached, so that
+// metered requests don't query the database every time. Changes to overrides
+// take up to this long to apply.
+const overrideCacheTTL = time.Minute
+
+type overrideCacheKey struct {
+	quota  Quota
+	userID int32
+}
+
+type overrideCacheEntry struct {
+	limit    *int64
+	ok       bool
+	expireAt time.Time
+}
+
+// overrides caches the results of database.QuotaOverrideStore.GetLimitForUser.
+var overrides = struct {
+	sync.Mutex
+	m map[overrideCacheKey]overrideCacheEntry
+}{m: map[overrideCacheKey]overrideCacheEntry{}}
+
+// maxCachedOverrides bounds the size of the override cache, which is cleared
+// when it is full.
+const maxCachedOverrides = 10000
+
+// getOverride returns the override of the quota for the user, as
+// GetLimitForUser does, caching it for overrideCacheTTL.
+func getOverride(ctx context.Context, db dbutil.DB, q Quota, userID int32) (*int64, bool, error) {
+	key := overrideCacheKey{quota: q, userID: userID}
+	overrides.Lock()
+	e, ok := overrides.m[key]
+	overrides.Unlock()
+	if ok && now().Before(e.expireAt) {
+		return e.limit, e.ok, nil
+	}
+
+	limit, ok, err := database.QuotaOverrides(db).GetLimitForUser(ctx, string(q), userID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	overrides.Lock()
+	if len(overrides.m) >= maxCachedOverrides {
+		overrides.m = map[overrideCacheKey]overrideCacheEntry{}
+	}
+	overrides.m[key] = overrideCacheEntry{limit: limit, ok: ok, expireAt: now().Add(overrideCacheTTL)}
+	overrides.Unlock()
+	return limit, ok, nil
+}
+
+// limitForUser returns the limit of the quota for the user, and false if the
+// quota is not enforced for them. Anonymous users (user ID 0) are exempt from
+// all quotas.
+func limitForUser(ctx context.Context, db dbutil.DB, q Quota, userID int32) (int64, bool) {
+	if userID == 0 {
+		return 0, false
+	}
+	limit := q.siteLimit()
+	if limit == 0 {
+		return 0, false
+	}
+
+	override, ok, err := getOverride(ctx, db, q, userID)
+	if err != nil {
+		log15.Warn("quota: failed to get quota override", "quota", q, "user", userID, "error", err)
+	} else if ok {
+		if override == nil {
+			return 0, false
+		}
+		limit = *override
+	}
+	return limit, true
+}
+
+// Use records that the user used amount units of the quota and returns an
+// *ExceededError if the user is over the limit of the current window. The key
+// identifies the usage counter in addition to the user, such as an access
+// token, and may be empty.
+//
+// Quotas which are not set in the site configuration are not enforced.
+// Anonymous users (user ID 0) are exempt: they are not counted, and callers
+// must limit anonymous usage by other means, such as api.ratelimit. Usage is
+// allowed if it can't be counted, so that an unavailable Redis does not take
+// the instance down.
+func Use(ctx context.Context, db dbutil.DB, q Quota, userID int32, key string, amount int64) error {
+	limit, enforced := limitForUser(ctx, db, q, userID)
+	if !enforced {
+		return nil
+	}
+
+	window := q.Window()
+	start := now().Truncate(window)
+	end := start.Add(window)
+
+	count, err := incr(fmt.Sprintf("quota:%s:%d:%s:%d", q, userID, key, start.Unix()), amount, end)
+	if err != nil {
+		log15.Warn("quota: failed to count usage", "quota", q, "user", userID, "error", err)
+		return nil
+	}
+	if count > limit {
+		return &ExceededError{Quota: q, Limit: limit, RetryAfter: end.Sub(now())}
+	}
+	return nil
+}
+
+// WriteExceeded responds with HTTP 429 and a message describing the exceeded
+// quota.
+func WriteExceeded(w http.ResponseWriter, err *ExceededError) {
+	w.Header().Set("Retry-After", strconv.Itoa(int(err.RetryAfter.Seconds())))
+	w.Header().Set("X-Quota-Limit", strconv.FormatInt(err.Limit, 10))
+	http.Error(w, err.Error(), http.StatusTooManyRequests)
+}
+
+// Middleware enforces the quota for the user making the request. Each request
+// uses amount(r) units of the quota, or one if amount is nil. If amount(r) is
+// negative, the usage of the request is unknown, and it is rejected with HTTP
+// 411 if the quota is enforced for the user. Anonymous and internal requests
+// are not counted.
+func Middleware(db dbutil.DB, q Quota, amount func(r *http.Request) int64, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uid := actor.FromContext(r.Context()).UID
+
+		n := int64(1)
+		if amount != nil {
+			n = amount(r)
+		}
+		if n < 0 {
+			// 🚨 SECURITY: Usage which can't be measured up front would not
+			// be charged, so it is only allowed if the quota isn't enforced.
+			if _, enforced := limitForUser(r.Context(), db, q, uid); enforced {
+				http.Error(w, fmt.Sprintf("the %s quota requires the request to declare its size", q), http.StatusLengthRequired)
+				return
+			}
+			n = 0
+		}
+
+		err := Use(r.Context(), db, q, uid, "", n)
+		if e, ok := err.(*ExceededError); ok {
+			WriteExceeded(w, e)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// ContentLength returns the declared size of the request body, or -1 if it is
+// unknown, such as for chunked requests. Used with Middleware, requests of
+// unknown size are rejected while the quota is enforced. The HTTP server never
+// reads more than the declared size of a body.
+func ContentLength(r *http.Request) int64 {
+	if r.ContentLength < 0 {
+		return -1
+	}
+	return r.ContentLength
+}
@@ -0,0 +1,203 @@
+package quota
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func mockCounter(t *testing.T) map[string]int64 {
+	counts := map[string]int64{}
+	incr = func(key string, amount int64, expireAt time.Time) (int64, error) {
+		counts[key] += amount
+		return counts[key], nil
+	}
+	t.Cleanup(func() { incr = redisCounter })
+
+	// Subtests change the overrides, so they must not see cached ones.
+	overrides.m = map[overrideCacheKey]overrideCacheEntry{}
+	return counts
+}
+
+func TestUse(t *testing.T) {
+	conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+		Quotas: &schema.Quotas{SearchesPerMinute: 2},
+	}})
+	t.Cleanup(func() { conf.Mock(nil) })
+
+	now = func() time.Time { return time.Date(2021, 6, 1, 12, 0, 15, 0, time.UTC) }
+	t.Cleanup(func() { now = time.Now })
+
+	userOverrides := map[int32]*int64{}
+	overrideLookups := 0
+	database.Mocks.QuotaOverrides.GetLimitForUser = func(ctx context.Context, quota string, userID int32) (*int64, bool, error) {
+		overrideLookups++
+		limit, ok := userOverrides[userID]
+		return limit, ok, nil
+	}
+	t.Cleanup(func() { database.Mocks.QuotaOverrides = database.MockQuotaOverrides{} })
+
+	ctx := context.Background()
+
+	t.Run("site limit", func(t *testing.T) {
+		mockCounter(t)
+		for i := 0; i < 2; i++ {
+			if err := Use(ctx, nil, Searches, 1, "", 1); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		}
+		err, ok := Use(ctx, nil, Searches, 1, "", 1).(*ExceededError)
+		if !ok {
+			t.Fatal("expected quota to be exceeded")
+		}
+		if err.Limit != 2 || err.RetryAfter != 45*time.Second {
+			t.Errorf("unexpected error: %+v", err)
+		}
+
+		// Other users have their own counter.
+		if err := Use(ctx, nil, Searches, 2, "", 1); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	})
+
+	t.Run("override", func(t *testing.T) {
+		mockCounter(t)
+		limit := int64(3)
+		userOverrides[1] = &limit
+		userOverrides[2] = nil
+		t.Cleanup(func() { delete(userOverrides, 1); delete(userOverrides, 2) })
+
+		for i := 0; i < 3; i++ {
+			if err := Use(ctx, nil, Searches, 1, "", 1); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		}
+		if err := Use(ctx, nil, Searches, 1, "", 1); err == nil {
+			t.Fatal("expected quota to be exceeded")
+		}
+
+		// A nil override is unlimited.
+		for i := 0; i < 10; i++ {
+			if err := Use(ctx, nil, Searches, 2, "", 1); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		}
+	})
+
+	t.Run("override cache", func(t *testing.T) {
+		mockCounter(t)
+		overrideLookups = 0
+
+		for i := 0; i < 2; i++ {
+			if err := Use(ctx, nil, Searches, 1, "", 1); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		}
+		if overrideLookups != 1 {
+			t.Errorf("expected the override to be looked up once, got %d lookups", overrideLookups)
+		}
+
+		// Cached overrides expire.
+		defer func(now0 func() time.Time) { now = now0 }(now)
+		later := now().Add(overrideCacheTTL)
+		now = func() time.Time { return later }
+		if err := Use(ctx, nil, Searches, 1, "", 1); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if overrideLookups != 2 {
+			t.Errorf("expected the expired override to be looked up again, got %d lookups", overrideLookups)
+		}
+	})
+
+	t.Run("anonymous", func(t *testing.T) {
+		counts := mockCounter(t)
+		for i := 0; i < 3; i++ {
+			if err := Use(ctx, nil, Searches, 0, "", 1); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		}
+		if len(counts) != 0 {
+			t.Errorf("expected no usage to be counted, got %v", counts)
+		}
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		counts := mockCounter(t)
+		if err := Use(ctx, nil, LSIFUploadBytes, 1, "", 1<<40); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if len(counts) != 0 {
+			t.Errorf("expected no usage to be counted, got %v", counts)
+		}
+	})
+
+	t.Run("middleware", func(t *testing.T) {
+		mockCounter(t)
+		h := Middleware(nil, Searches, nil, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+		codes := []int{}
+		for i := 0; i < 3; i++ {
+			req := httptest.NewRequest("GET", "/", nil)
+			req = req.WithContext(actor.WithActor(req.Context(), &actor.Actor{UID: 1}))
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			codes = append(codes, rec.Code)
+			if rec.Code == http.StatusTooManyRequests && rec.Header().Get("Retry-After") != "45" {
+				t.Errorf("unexpected Retry-After header: %q", rec.Header().Get("Retry-After"))
+			}
+		}
+		if codes[0] != 200 || codes[1] != 200 || codes[2] != http.StatusTooManyRequests {
+			t.Errorf("unexpected status codes: %v", codes)
+		}
+	})
+	t.Run("unknown length", func(t *testing.T) {
+		conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+			Quotas: &schema.Quotas{LsifUploadBytesPerDay: 100},
+		}})
+		t.Cleanup(func() {
+			conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+				Quotas: &schema.Quotas{SearchesPerMinute: 2},
+			}})
+		})
+		counts := mockCounter(t)
+		h := Middleware(nil, LSIFUploadBytes, ContentLength, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+		serve := func(uid int32, contentLength int64) int {
+			req := httptest.NewRequest("POST", "/", strings.NewReader("upload"))
+			req.ContentLength = contentLength
+			if uid != 0 {
+				req = req.WithContext(actor.WithActor(req.Context(), &actor.Actor{UID: uid}))
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			return rec.Code
+		}
+
+		if code := serve(1, -1); code != http.StatusLengthRequired {
+			t.Errorf("unexpected status code for a chunked upload: %d", code)
+		}
+		if code := serve(1, 6); code != http.StatusOK {
+			t.Errorf("unexpected status code for an upload of known size: %d", code)
+		}
+		// Anonymous uploads are exempt from quotas.
+		if code := serve(0, -1); code != http.StatusOK {
+			t.Errorf("unexpected status code for an anonymous chunked upload: %d", code)
+		}
+
+		var total int64
+		for _, n := range counts {
+			total += n
+		}
+		if total != 6 {
+			t.Errorf("expected 6 bytes to be counted, got %d", total)
+		}
+	})
+}
@@ -175,6 +175,13 @@ func (s BitbucketServerSource) makeRepo(repo *bitbucketserver.Repo, isArchived b
 	}
 }
 
+// excludesRepo returns whether the configuration of s excludes r, a repo
+// previously yielded by s.
+func (s *BitbucketServerSource) excludesRepo(r *types.Repo) bool {
+	repo, ok := r.Metadata.(*bitbucketserver.Repo)
+	return ok && s.excludes(repo)
+}
+
 func (s *BitbucketServerSource) excludes(r *bitbucketserver.Repo) bool {
 	name := r.Slug
 	if r.Project != nil {
@@ -223,6 +223,12 @@ func (s GithubSource) ExternalServices() types.ExternalServices {
 	return types.ExternalServices{s.svc}
 }
 
+// RateLimitMonitor returns the rate limit monitor of the REST API client,
+// which is used to list repositories.
+func (s GithubSource) RateLimitMonitor() *ratelimit.Monitor {
+	return s.v3Client.RateLimitMonitor()
+}
+
 // GetRepo returns the Github repository with the given name and owner
 // ("org/repo-name")
 func (s GithubSource) GetRepo(ctx context.Context, nameWithOwner string) (*types.Repo, error) {
@@ -246,12 +252,16 @@ func (s GithubSource) makeRepo(r *github.Repository) *types.Repo {
 			s.originalHostname,
 			r.NameWithOwner,
 		)),
-		ExternalRepo: github.ExternalRepoSpec(r, s.baseURL),
-		Description:  r.Description,
-		Fork:         r.IsFork,
-		Archived:     r.IsArchived,
-		Stars:        r.StargazerCount,
-		Private:      r.IsPrivate,
+		ExternalRepo:           github.ExternalRepoSpec(r, s.baseURL),
+		Description:            r.Description,
+		Fork:                   r.IsFork,
+		Archived:               r.IsArchived,
+		Stars:                  r.StargazerCount,
+		Private:                r.IsPrivate,
+		Topics:                 r.Topics(),
+		License:                r.License(),
+		PrimaryLanguage:        r.PrimaryLanguageName(),
+		DefaultBranchProtected: r.DefaultBranchProtected(),
 		Sources: map[string]*types.SourceInfo{
 			urn: {
 				ID:       urn,
@@ -275,6 +285,13 @@ func (s *GithubSource) remoteURL(repo *github.Repository) string {
 	return repo.URL
 }
 
+// excludesRepo returns whether the configuration of s excludes r, a repo
+// previously yielded by s.
+func (s *GithubSource) excludesRepo(r *types.Repo) bool {
+	repo, ok := r.Metadata.(*github.Repository)
+	return ok && s.excludes(repo)
+}
+
 func (s *GithubSource) excludes(r *github.Repository) bool {
 	if r.IsLocked || r.IsDisabled {
 		return true
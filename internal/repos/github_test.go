@@ -60,10 +60,11 @@ func TestGithubSource_GetRepo(t *testing.T) {
 				t.Helper()
 
 				want := &types.Repo{
-					Name:        "github.com/sourcegraph/sourcegraph",
-					Description: "Code search and navigation tool (self-hosted)",
-					URI:         "github.com/sourcegraph/sourcegraph",
-					Stars:       2220,
+					Name:            "github.com/sourcegraph/sourcegraph",
+					Description:     "Code search and navigation tool (self-hosted)",
+					URI:             "github.com/sourcegraph/sourcegraph",
+					Stars:           2220,
+					PrimaryLanguage: "HTML",
 					ExternalRepo: api.ExternalRepoSpec{
 						ID:          "MDEwOlJlcG9zaXRvcnk0MTI4ODcwOA==",
 						ServiceType: "github",
@@ -76,13 +77,16 @@ func TestGithubSource_GetRepo(t *testing.T) {
 						},
 					},
 					Metadata: &github.Repository{
-						ID:             "MDEwOlJlcG9zaXRvcnk0MTI4ODcwOA==",
-						DatabaseID:     41288708,
-						NameWithOwner:  "sourcegraph/sourcegraph",
-						Description:    "Code search and navigation tool (self-hosted)",
-						URL:            "https://github.com/sourcegraph/sourcegraph",
-						StargazerCount: 2220,
-						ForkCount:      164,
+						ID:               "MDEwOlJlcG9zaXRvcnk0MTI4ODcwOA==",
+						DatabaseID:       41288708,
+						NameWithOwner:    "sourcegraph/sourcegraph",
+						Description:      "Code search and navigation tool (self-hosted)",
+						URL:              "https://github.com/sourcegraph/sourcegraph",
+						StargazerCount:   2220,
+						ForkCount:        164,
+						PrimaryLanguage:  &github.Language{Name: "HTML"},
+						LicenseInfo:      &github.License{SpdxID: "NOASSERTION"},
+						DefaultBranchRef: &github.DefaultBranchRef{Name: "master"},
 					},
 				}
 
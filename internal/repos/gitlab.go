@@ -175,6 +175,11 @@ func (s GitLabSource) ExternalServices() types.ExternalServices {
 	return types.ExternalServices{s.svc}
 }
 
+// RateLimitMonitor returns the rate limit monitor of the API client.
+func (s GitLabSource) RateLimitMonitor() *ratelimit.Monitor {
+	return s.client.RateLimitMonitor()
+}
+
 func (s GitLabSource) makeRepo(proj *gitlab.Project) *types.Repo {
 	urn := s.svc.URN()
 	return &types.Repo{
@@ -196,6 +201,7 @@ func (s GitLabSource) makeRepo(proj *gitlab.Project) *types.Repo {
 		Archived:     proj.Archived,
 		Stars:        proj.StarCount,
 		Private:      proj.Visibility == "private",
+		Topics:       proj.ProjectTopics(),
 		Sources: map[string]*types.SourceInfo{
 			urn: {
 				ID:       urn,
@@ -217,6 +223,13 @@ func (s *GitLabSource) remoteURL(proj *gitlab.Project) string {
 	return proj.HTTPURLToRepo
 }
 
+// excludesRepo returns whether the configuration of s excludes r, a repo
+// previously yielded by s.
+func (s *GitLabSource) excludesRepo(r *types.Repo) bool {
+	repo, ok := r.Metadata.(*gitlab.Project)
+	return ok && s.excludes(repo)
+}
+
 func (s *GitLabSource) excludes(p *gitlab.Project) bool {
 	return s.exclude(p.PathWithNamespace) || s.exclude(strconv.Itoa(p.ID))
 }
@@ -89,6 +89,7 @@ func TestGitLabSource_GetRepo(t *testing.T) {
 					Description: "Gitaly is a Git RPC service for handling all the git calls made by GitLab",
 					URI:         "gitlab.com/gitlab-org/gitaly",
 					Stars:       168,
+					Topics:      []string{"git", "gitlab", "rpc"},
 					ExternalRepo: api.ExternalRepoSpec{
 						ID:          "2009901",
 						ServiceType: "gitlab",
@@ -113,6 +114,7 @@ func TestGitLabSource_GetRepo(t *testing.T) {
 						Archived:   false,
 						StarCount:  168,
 						ForksCount: 76,
+						TagList:    []string{"git", "gitlab", "rpc"},
 					},
 				}
 
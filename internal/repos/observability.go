@@ -9,6 +9,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/internal/logging"
 	"github.com/sourcegraph/sourcegraph/internal/metrics"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
 )
 
 // ObservedSource returns a decorator that wraps a Source
@@ -65,6 +66,15 @@ func NewSourceMetrics() SourceMetrics {
 	}
 }
 
+// RateLimitMonitor returns the rate limit monitor of the inner Source, if it
+// has one.
+func (o *observedSource) RateLimitMonitor() *ratelimit.Monitor {
+	if s, ok := o.Source.(RateLimitMonitorSource); ok {
+		return s.RateLimitMonitor()
+	}
+	return nil
+}
+
 // ListRepos calls into the inner Source registers the observed results.
 func (o *observedSource) ListRepos(ctx context.Context, results chan SourceResult) {
 	var (
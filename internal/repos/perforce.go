@@ -1,15 +1,19 @@
 package repos
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	"net/url"
 	"strings"
 
+	"github.com/cockroachdb/errors"
+
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/perforce"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/jsonc"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/schema"
@@ -40,13 +44,52 @@ func newPerforceSource(svc *types.ExternalService, c *schema.PerforceConnection)
 }
 
 // ListRepos returns all Perforce depots accessible to all connections
-// configured in Sourcegraph via the external services configuration.
+// configured in Sourcegraph via the external services configuration. If no
+// depots are configured, all local depots of the Perforce Server are listed.
 func (s PerforceSource) ListRepos(ctx context.Context, results chan SourceResult) {
-	for _, depot := range s.config.Depots {
+	depots := s.config.Depots
+	if len(depots) == 0 {
+		var err error
+		if depots, err = s.discoverDepots(ctx); err != nil {
+			results <- SourceResult{Source: s, Err: err}
+			return
+		}
+	}
+
+	for _, depot := range depots {
 		results <- SourceResult{Source: s, Repo: s.makeRepo(depot)}
 	}
 }
 
+// discoverDepots returns the paths of all local depots on the Perforce
+// Server, e.g. "//Sourcegraph/".
+func (s PerforceSource) discoverDepots(ctx context.Context) ([]string, error) {
+	rc, _, err := gitserver.DefaultClient.P4Exec(ctx, s.config.P4Port, s.config.P4User, s.config.P4Passwd, "depots")
+	if err != nil {
+		return nil, errors.Wrap(err, "list depots")
+	}
+	defer func() { _ = rc.Close() }()
+
+	var depots []string
+	scanner := bufio.NewScanner(rc)
+	for scanner.Scan() {
+		// e.g. Depot Sourcegraph 2020/12/04 local Sourcegraph/... 'Created by admin. '
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 4 || fields[0] != "Depot" {
+			continue
+		}
+
+		// Only local depots can be imported with git p4. Other types of depots
+		// either have no files (e.g. spec, unload) or are served by other
+		// servers (e.g. remote).
+		if fields[3] != "local" {
+			continue
+		}
+		depots = append(depots, "//"+fields[1]+"/")
+	}
+	return depots, errors.Wrap(scanner.Err(), "scanner.Err")
+}
+
 // composePerforceCloneURL composes a clone URL for a Perforce depot based on
 // given information. e.g.
 // perforce://ssl:111.222.333.444:1666//Sourcegraph/
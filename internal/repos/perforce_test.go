@@ -3,13 +3,17 @@ package repos
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/inconshreveable/log15"
 
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/testutil"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/schema"
@@ -131,3 +135,49 @@ func TestPerforceSource_makeRepo(t *testing.T) {
 		})
 	}
 }
+
+func TestPerforceSource_ListRepos_DiscoverDepots(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`Depot Sourcegraph 2020/12/04 local Sourcegraph/... 'Created by admin. '
+Depot remote 2020/12/04 remote remote/... 'Remote depot. '
+Depot spec 2020/12/04 spec spec/... 'Spec depot. '
+Depot Engineering 2021/01/15 local Engineering/... 'Created by admin. '
+`))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}))
+	defer server.Close()
+
+	// The gitserver client does not want the protocol in its addresses.
+	addrs := gitserver.DefaultClient.Addrs
+	gitserver.DefaultClient.Addrs = func() []string {
+		return []string{strings.TrimPrefix(server.URL, "http://")}
+	}
+	defer func() { gitserver.DefaultClient.Addrs = addrs }()
+
+	conf := &schema.PerforceConnection{
+		P4Port:   "ssl:111.222.333.444:1666",
+		P4User:   "admin",
+		P4Passwd: "pa$$word",
+	}
+	svc := &types.ExternalService{
+		Kind:   extsvc.KindPerforce,
+		Config: marshalJSON(t, conf),
+	}
+	src, err := newPerforceSource(svc, conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repos, err := listAll(context.Background(), src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	have := types.Repos(repos).Names()
+	sort.Strings(have)
+	if diff := cmp.Diff([]string{"Engineering", "Sourcegraph"}, have); diff != "" {
+		t.Fatalf("Mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,140 @@
+package repos
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	otlog "github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// SyncPreview describes the changes that syncing an external service would
+// make to its repositories.
+type SyncPreview struct {
+	// Added are the repos that would be added to the external service.
+	Added types.Repos
+	// Removed are the repos that would be removed from the external service
+	// because the code host no longer yields them.
+	Removed types.Repos
+	// Excluded are the repos that would be removed from the external service
+	// because its configuration excludes them.
+	Excluded types.Repos
+	// Renamed are the repos whose names would change.
+	Renamed []RepoRename
+	// Unmodified is the number of repos whose names would not change.
+	Unmodified int
+}
+
+// RepoRename is the change of name of a repo.
+type RepoRename struct {
+	From api.RepoName
+	To   api.RepoName
+}
+
+// excluder is implemented by sources that can tell whether their
+// configuration excludes a repo they yield.
+type excluder interface {
+	excludesRepo(*types.Repo) bool
+}
+
+// PreviewExternalServiceSync returns the changes that syncing svc would make
+// to its repositories, without making them. It is used to validate
+// configuration changes before saving them, so svc need not be stored yet.
+// A zero svc.ID previews the sync of a new external service.
+func (s *Syncer) PreviewExternalServiceSync(ctx context.Context, svc *types.ExternalService) (_ *SyncPreview, err error) {
+	tr, ctx := trace.New(ctx, "Syncer.PreviewExternalServiceSync", svc.DisplayName)
+	defer func() {
+		tr.SetError(err)
+		tr.Finish()
+	}()
+
+	srcs, err := s.Sourcer(svc)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unlike SyncExternalService, we report authorization errors instead of
+	// treating them as if no repos were found, since they most likely mean
+	// the candidate configuration is wrong.
+	sourced, err := listAll(ctx, srcs)
+	if err != nil {
+		return nil, errors.Wrap(err, "fetching from code host "+svc.DisplayName)
+	}
+
+	if svc.NamespaceUserID > 0 {
+		mode, err := database.UsersWith(s.Store).UserAllowedExternalServices(ctx, svc.NamespaceUserID)
+		if err != nil {
+			return nil, errors.Wrap(err, "checking if user can add private code")
+		}
+		if mode != conf.ExternalServiceModeAll {
+			sourced = types.Repos(sourced).Filter(func(r *types.Repo) bool { return !r.Private })
+		}
+	}
+
+	var stored types.Repos
+	if svc.ID != 0 {
+		if stored, err = s.Store.RepoStore.List(ctx, database.ReposListOptions{ExternalServiceIDs: []int64{svc.ID}}); err != nil {
+			return nil, errors.Wrap(err, "syncer.preview.store.list-repos")
+		}
+	}
+
+	preview := newSyncPreview(srcs, sourced, stored)
+	tr.LogFields(
+		otlog.Int("added", len(preview.Added)),
+		otlog.Int("removed", len(preview.Removed)),
+		otlog.Int("excluded", len(preview.Excluded)),
+		otlog.Int("renamed", len(preview.Renamed)),
+		otlog.Int("unmodified", preview.Unmodified),
+	)
+	return preview, nil
+}
+
+// newSyncPreview returns the preview of a sync of srcs, which yielded the
+// sourced repos, given the currently stored repos of their external service.
+func newSyncPreview(srcs Sources, sourced, stored []*types.Repo) *SyncPreview {
+	// NewDiff updates the stored repos with the sourced ones, so we need to
+	// keep track of their current names to find renames.
+	names := make(map[api.ExternalRepoSpec]api.RepoName, len(stored))
+	for _, r := range stored {
+		names[r.ExternalRepo] = r.Name
+	}
+
+	diff := NewDiff(sourced, stored)
+	diff.Sort()
+
+	preview := &SyncPreview{Added: diff.Added}
+	for _, r := range diff.Deleted {
+		if excludesRepo(srcs, r) {
+			preview.Excluded = append(preview.Excluded, r)
+		} else {
+			preview.Removed = append(preview.Removed, r)
+		}
+	}
+	for _, r := range diff.Modified {
+		if from := names[r.ExternalRepo]; from != r.Name {
+			preview.Renamed = append(preview.Renamed, RepoRename{From: from, To: r.Name})
+		} else {
+			preview.Unmodified++
+		}
+	}
+	preview.Unmodified += len(diff.Unmodified)
+	return preview
+}
+
+// excludesRepo returns whether any of srcs excludes r.
+func excludesRepo(srcs Sources, r *types.Repo) bool {
+	for _, src := range srcs {
+		if o, ok := src.(*observedSource); ok {
+			src = o.Source
+		}
+		if e, ok := src.(excluder); ok && e.excludesRepo(r) {
+			return true
+		}
+	}
+	return false
+}
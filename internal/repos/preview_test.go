@@ -0,0 +1,100 @@
+package repos
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// excludingSource is a FakeSource that excludes the repos with the given
+// names.
+type excludingSource struct {
+	*FakeSource
+	excluded map[api.RepoName]bool
+}
+
+func (s excludingSource) excludesRepo(r *types.Repo) bool { return s.excluded[r.Name] }
+
+func TestNewSyncPreview(t *testing.T) {
+	repo := func(id api.RepoID, name string) *types.Repo {
+		return &types.Repo{
+			ID:   id,
+			Name: api.RepoName(name),
+			ExternalRepo: api.ExternalRepoSpec{
+				ID:          name,
+				ServiceType: extsvc.TypeGitHub,
+				ServiceID:   "https://github.com/",
+			},
+		}
+	}
+	renamed := func(r *types.Repo, name string) *types.Repo {
+		r = r.Clone()
+		r.Name = api.RepoName(name)
+		return r
+	}
+
+	var (
+		unmodified = repo(1, "github.com/org/unmodified")
+		renamedTo  = renamed(repo(2, "github.com/org/renamed"), "github.com/org/new-name")
+		removed    = repo(3, "github.com/org/removed")
+		excluded   = repo(4, "github.com/org/excluded")
+		added      = repo(5, "github.com/org/added")
+	)
+
+	svc := &types.ExternalService{Kind: extsvc.KindGitHub}
+	srcs := Sources{excludingSource{
+		FakeSource: NewFakeSource(svc, nil),
+		excluded:   map[api.RepoName]bool{excluded.Name: true},
+	}}
+
+	sourced := []*types.Repo{unmodified.Clone(), renamedTo, added}
+	stored := []*types.Repo{
+		unmodified.Clone(),
+		repo(2, "github.com/org/renamed"),
+		removed,
+		excluded,
+	}
+
+	have := newSyncPreview(srcs, sourced, stored)
+	want := &SyncPreview{
+		Added:    types.Repos{added},
+		Removed:  types.Repos{removed},
+		Excluded: types.Repos{excluded},
+		Renamed: []RepoRename{{
+			From: "github.com/org/renamed",
+			To:   "github.com/org/new-name",
+		}},
+		Unmodified: 1,
+	}
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Fatalf("unexpected preview (-want +got):\n%s", diff)
+	}
+}
+
+func TestSyncer_PreviewExternalServiceSync_NewService(t *testing.T) {
+	svc := &types.ExternalService{Kind: extsvc.KindGitHub, DisplayName: "GitHub"}
+	r := &types.Repo{
+		Name: "github.com/org/foo",
+		ExternalRepo: api.ExternalRepoSpec{
+			ID:          "foo",
+			ServiceType: extsvc.TypeGitHub,
+			ServiceID:   "https://github.com/",
+		},
+	}
+
+	// New external services have no stored repos, so no store is needed.
+	syncer := &Syncer{Sourcer: NewFakeSourcer(nil, NewFakeSource(svc, nil, r))}
+
+	preview, err := syncer.PreviewExternalServiceSync(context.Background(), svc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{string(r.Name)}, preview.Added.Names()); diff != "" {
+		t.Fatalf("unexpected added repos (-want +got):\n%s", diff)
+	}
+}
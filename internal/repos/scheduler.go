@@ -3,6 +3,7 @@ package repos
 import (
 	"container/heap"
 	"context"
+	"math"
 	"regexp"
 	"strings"
 	"sync"
@@ -83,6 +84,10 @@ const (
 
 	// maxDelay is the maximum amount of time between scheduled updates for a single repository.
 	maxDelay = 8 * time.Hour
+
+	// coldDelayFactor is the factor by which the update interval of repositories without
+	// any demand is multiplied.
+	coldDelayFactor = 4
 )
 
 // updateScheduler schedules repo update (or clone) requests to gitserver.
@@ -95,6 +100,11 @@ const (
 // then the next update will be scheduled 6 hours from then.
 // This heuristic is simple to compute and has nice backoff properties.
 //
+// Once the demand of repos is known (see SetDemand), the interval is divided by the
+// demand level of the repo, so that actively used repos are updated more often,
+// and multiplied by coldDelayFactor for repos without any demand, so that unused
+// repos quickly decay to maxDelay. Repos with higher demand are also dequeued first.
+//
 // If an error occurs when attempting to fetch a repo we perform exponential
 // backoff by doubling the current interval. This ensures that problematic repos
 // don't stay in the front of the schedule clogging up the queue.
@@ -214,7 +224,7 @@ func (s *updateScheduler) runUpdateLoop(ctx context.Context) {
 					// This is the heuristic that is described in the updateScheduler documentation.
 					// Update that documentation if you update this logic.
 					interval := resp.LastFetched.Sub(*resp.LastChanged) / 2
-					s.schedule.updateInterval(repo, interval)
+					s.schedule.updateInterval(repo, s.schedule.demandInterval(repo, interval))
 				}
 			}(ctx, repo, cancel)
 		}
@@ -310,6 +320,26 @@ func (s *updateScheduler) SetCloned(names []string) {
 	s.schedule.setCloned(names)
 }
 
+// SetDemand sets the demand of repos, as recorded in the repo_demand table. Repos
+// missing from scores have no demand.
+//
+// This method should be called periodically so that the scheduler updates
+// actively used repos more often and unused repos less often.
+func (s *updateScheduler) SetDemand(scores map[api.RepoID]float64) {
+	s.schedule.setDemand(scores)
+	s.updateQueue.setDemand(scores)
+}
+
+// demandLevel returns the logarithmic level of the given demand score, so
+// that repos with similar demand are treated alike. Repos without demand have
+// level 0.
+func demandLevel(score float64) int {
+	if score <= 0 {
+		return 0
+	}
+	return 1 + int(math.Log2(1+score))
+}
+
 // EnsureScheduled ensures that all repos in repos exist in the scheduler.
 func (s *updateScheduler) EnsureScheduled(repos []types.RepoName) {
 	s.schedule.insertNew(repos)
@@ -461,6 +491,10 @@ type updateQueue struct {
 
 	seq uint64
 
+	// demand is the demand score of repos. Within the same priority, repos
+	// with a higher demand level are dequeued first.
+	demand map[api.RepoID]float64
+
 	// The queue performs a non-blocking send on this channel
 	// when a new value is enqueued so that the update loop
 	// can wake up if it is idle.
@@ -539,6 +573,15 @@ func (q *updateQueue) enqueue(repo configuredRepo, p priority) (updated bool) {
 	return true
 }
 
+// setDemand sets the demand of repos and reorders the queue accordingly.
+func (q *updateQueue) setDemand(scores map[api.RepoID]float64) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.demand = scores
+	heap.Init(q)
+}
+
 // nextSeq increments and returns the next sequence number.
 // The caller must hold the lock on q.mu.
 func (q *updateQueue) nextSeq() uint64 {
@@ -606,7 +649,11 @@ func (q *updateQueue) Less(i, j int) bool {
 		// We want Pop to give us the highest, not lowest, priority so we use greater than here.
 		return qi.Priority > qj.Priority
 	}
-	// Queue semantics for items with the same priority.
+	if li, lj := demandLevel(q.demand[qi.Repo.ID]), demandLevel(q.demand[qj.Repo.ID]); li != lj {
+		// Repos with more demand are updated first.
+		return li > lj
+	}
+	// Queue semantics for items with the same priority and demand.
 	return qi.Seq < qj.Seq
 }
 
@@ -641,6 +688,10 @@ type schedule struct {
 	heap  []*scheduledRepoUpdate // min heap of scheduledRepoUpdates based on their due time.
 	index map[api.RepoID]*scheduledRepoUpdate
 
+	// demand is the demand score of repos. It is nil until the demand is
+	// known, in which case intervals are not adjusted.
+	demand map[api.RepoID]float64
+
 	// timer sends a value on the wakeup channel when it is time
 	timer  *time.Timer
 	wakeup chan struct{}
@@ -777,6 +828,32 @@ func (s *schedule) updateInterval(repo configuredRepo, interval time.Duration) {
 	s.mu.Unlock()
 }
 
+// setDemand sets the demand of repos, which adjusts the intervals returned by
+// demandInterval.
+func (s *schedule) setDemand(scores map[api.RepoID]float64) {
+	s.mu.Lock()
+	s.demand = scores
+	s.mu.Unlock()
+}
+
+// demandInterval adjusts the given update interval of a repo to its demand.
+// Repos with more demand get shorter intervals, and repos without any demand
+// get longer intervals. The interval is returned as is until the demand is
+// known.
+func (s *schedule) demandInterval(repo configuredRepo, interval time.Duration) time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.demand == nil {
+		return interval
+	}
+	level := demandLevel(s.demand[repo.ID])
+	if level == 0 {
+		return interval * coldDelayFactor
+	}
+	return interval / time.Duration(level)
+}
+
 // getCurrentInterval gets the current interval for the supplied repo and a bool
 // indicating whether it was found.
 func (s *schedule) getCurrentInterval(repo configuredRepo) (time.Duration, bool) {
@@ -1487,6 +1487,7 @@ func Test_updateQueue_Less(t *testing.T) {
 	tests := []struct {
 		name   string
 		heap   []*repoUpdate
+		demand map[api.RepoID]float64
 		expVal bool
 	}{
 		{
@@ -1505,6 +1506,24 @@ func Test_updateQueue_Less(t *testing.T) {
 			},
 			expVal: true,
 		},
+		{
+			name: "demand",
+			heap: []*repoUpdate{
+				{Repo: configuredRepo{ID: 1}, Seq: 2},
+				{Repo: configuredRepo{ID: 2}, Seq: 1},
+			},
+			demand: map[api.RepoID]float64{1: 10, 2: 1},
+			expVal: true,
+		},
+		{
+			name: "similar demand",
+			heap: []*repoUpdate{
+				{Repo: configuredRepo{ID: 1}, Seq: 2},
+				{Repo: configuredRepo{ID: 2}, Seq: 1},
+			},
+			demand: map[api.RepoID]float64{1: 1.5, 2: 1},
+			expVal: false,
+		},
 		{
 			name: "seq",
 			heap: []*repoUpdate{
@@ -1517,6 +1536,7 @@ func Test_updateQueue_Less(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			q.heap = test.heap
+			q.demand = test.demand
 			got := q.Less(0, 1)
 			if test.expVal != got {
 				t.Fatalf("want %v but got: %v", test.expVal, got)
@@ -1525,6 +1545,32 @@ func Test_updateQueue_Less(t *testing.T) {
 	}
 }
 
+func TestSchedule_demandInterval(t *testing.T) {
+	s := &schedule{}
+	repo := configuredRepo{ID: 1, Name: "repo"}
+
+	if have, want := s.demandInterval(repo, time.Hour), time.Hour; have != want {
+		t.Fatalf("unknown demand: got interval %s, want %s", have, want)
+	}
+
+	for _, tc := range []struct {
+		name   string
+		demand map[api.RepoID]float64
+		want   time.Duration
+	}{
+		{name: "no demand", demand: map[api.RepoID]float64{}, want: coldDelayFactor * time.Hour},
+		{name: "some demand", demand: map[api.RepoID]float64{1: 1}, want: 30 * time.Minute},
+		{name: "high demand", demand: map[api.RepoID]float64{1: 15}, want: 12 * time.Minute},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s.setDemand(tc.demand)
+			if have := s.demandInterval(repo, time.Hour); have != tc.want {
+				t.Fatalf("got interval %s, want %s", have, tc.want)
+			}
+		})
+	}
+}
+
 func TestGetCustomInterval(t *testing.T) {
 	for _, tc := range []struct {
 		name     string
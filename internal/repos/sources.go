@@ -12,6 +12,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 )
 
@@ -106,6 +107,14 @@ type AffiliatedRepositorySource interface {
 	AffiliatedRepositories(ctx context.Context) ([]types.CodeHostRepository, error)
 }
 
+// A RateLimitMonitorSource reports the rate limit status of the code host API
+// it talks to.
+type RateLimitMonitorSource interface {
+	// RateLimitMonitor returns the rate limit monitor of the API client, or
+	// nil if the rate limit isn't monitored.
+	RateLimitMonitor() *ratelimit.Monitor
+}
+
 // UnsupportedAuthenticatorError is returned by WithAuthenticator if the
 // authenticator isn't supported on that code host.
 type UnsupportedAuthenticatorError struct {
@@ -521,6 +521,10 @@ func (s *Store) UpsertRepos(ctx context.Context, repos ...*types.Repo) (err erro
 		}
 	}
 
+	// created are the repos which did not exist yet, as opposed to the inserts
+	// which existed and were soft-deleted.
+	var created []createdRepo
+
 	for _, op := range []struct {
 		name  string
 		query string
@@ -545,6 +549,13 @@ func (s *Store) UpsertRepos(ctx context.Context, repos ...*types.Repo) (err erro
 			return errors.Wrap(err, op.name)
 		}
 
+		if op.name == "insert" {
+			if created, err = scanCreatedRepos(rows); err != nil {
+				return errors.Wrap(err, op.name)
+			}
+			continue
+		}
+
 		if op.name != "list" {
 			if err = rows.Close(); err != nil {
 				return errors.Wrap(err, op.name)
@@ -579,9 +590,36 @@ func (s *Store) UpsertRepos(ctx context.Context, repos ...*types.Repo) (err erro
 		}
 	}
 
+	for _, r := range created {
+		if err := database.OutboxEventsWith(s.Store).Publish(ctx, database.OutboxTopicRepoCreated, r.ID, r); err != nil {
+			return errors.Wrap(err, "publishing outbox event")
+		}
+	}
+
 	return nil
 }
 
+// createdRepo is a repo created by UpsertRepos. It is the payload of the
+// repo.created outbox event.
+type createdRepo struct {
+	ID   api.RepoID   `json:"id"`
+	Name api.RepoName `json:"name"`
+}
+
+func scanCreatedRepos(rows *sql.Rows) (_ []createdRepo, err error) {
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var created []createdRepo
+	for rows.Next() {
+		var r createdRepo
+		if err := rows.Scan(&r.ID, &r.Name); err != nil {
+			return nil, err
+		}
+		created = append(created, r)
+	}
+	return created, nil
+}
+
 // EnqueueSingleSyncJob enqueues a single sync job for the given external
 // service if it is not already queued or processing.
 func (s *Store) EnqueueSingleSyncJob(ctx context.Context, id int64) (err error) {
@@ -746,6 +784,10 @@ WITH batch AS (
       archived              boolean,
       fork                  boolean,
       stars                 integer,
+      topics                text[],
+      primary_language      text,
+      license               text,
+      default_branch_protected boolean,
       private               boolean,
       metadata              jsonb
     )
@@ -768,6 +810,10 @@ SET
   archived              = batch.archived,
   fork                  = batch.fork,
   stars                 = batch.stars,
+  topics                = batch.topics,
+  primary_language      = batch.primary_language,
+  license               = batch.license,
+  default_branch_protected = batch.default_branch_protected,
   private               = batch.private,
   metadata              = batch.metadata
 FROM batch
@@ -804,6 +850,10 @@ INSERT INTO repo (
   archived,
   fork,
   stars,
+  topics,
+  primary_language,
+  license,
+  default_branch_protected,
   private,
   metadata
 )
@@ -820,10 +870,15 @@ SELECT
   archived,
   fork,
   stars,
+  topics,
+  primary_language,
+  license,
+  default_branch_protected,
   private,
   metadata
 FROM batch
 ON CONFLICT (external_service_type, external_service_id, external_id) DO NOTHING
+RETURNING id, name
 `
 
 var listRepoIDsQuery = batchReposQueryFmtstr + `
@@ -846,6 +901,13 @@ func nullStringColumn(s string) *string {
 	return &s
 }
 
+func topicsColumn(topics []string) []string {
+	if topics == nil {
+		return []string{}
+	}
+	return topics
+}
+
 func metadataColumn(metadata interface{}) (msg json.RawMessage, err error) {
 	switch m := metadata.(type) {
 	case nil:
@@ -908,22 +970,26 @@ func closeErr(c io.Closer, err *error) {
 // repoRecord is the json representation of a repository as used in this package
 // Postgres CTEs.
 type repoRecord struct {
-	ID                  api.RepoID      `json:"id"`
-	Name                string          `json:"name"`
-	URI                 *string         `json:"uri,omitempty"`
-	Description         string          `json:"description"`
-	CreatedAt           time.Time       `json:"created_at"`
-	UpdatedAt           *time.Time      `json:"updated_at,omitempty"`
-	DeletedAt           *time.Time      `json:"deleted_at,omitempty"`
-	ExternalServiceType *string         `json:"external_service_type,omitempty"`
-	ExternalServiceID   *string         `json:"external_service_id,omitempty"`
-	ExternalID          *string         `json:"external_id,omitempty"`
-	Archived            bool            `json:"archived"`
-	Fork                bool            `json:"fork"`
-	Stars               int             `json:"stars"`
-	Private             bool            `json:"private"`
-	Metadata            json.RawMessage `json:"metadata"`
-	Sources             json.RawMessage `json:"sources,omitempty"`
+	ID                     api.RepoID      `json:"id"`
+	Name                   string          `json:"name"`
+	URI                    *string         `json:"uri,omitempty"`
+	Description            string          `json:"description"`
+	CreatedAt              time.Time       `json:"created_at"`
+	UpdatedAt              *time.Time      `json:"updated_at,omitempty"`
+	DeletedAt              *time.Time      `json:"deleted_at,omitempty"`
+	ExternalServiceType    *string         `json:"external_service_type,omitempty"`
+	ExternalServiceID      *string         `json:"external_service_id,omitempty"`
+	ExternalID             *string         `json:"external_id,omitempty"`
+	Archived               bool            `json:"archived"`
+	Fork                   bool            `json:"fork"`
+	Stars                  int             `json:"stars"`
+	Topics                 []string        `json:"topics"`
+	PrimaryLanguage        *string         `json:"primary_language,omitempty"`
+	License                *string         `json:"license,omitempty"`
+	DefaultBranchProtected bool            `json:"default_branch_protected"`
+	Private                bool            `json:"private"`
+	Metadata               json.RawMessage `json:"metadata"`
+	Sources                json.RawMessage `json:"sources,omitempty"`
 }
 
 func newRepoRecord(r *types.Repo) (*repoRecord, error) {
@@ -938,22 +1004,26 @@ func newRepoRecord(r *types.Repo) (*repoRecord, error) {
 	}
 
 	return &repoRecord{
-		ID:                  r.ID,
-		Name:                string(r.Name),
-		URI:                 nullStringColumn(r.URI),
-		Description:         r.Description,
-		CreatedAt:           r.CreatedAt.UTC(),
-		UpdatedAt:           nullTimeColumn(r.UpdatedAt.UTC()),
-		DeletedAt:           nullTimeColumn(r.DeletedAt.UTC()),
-		ExternalServiceType: nullStringColumn(r.ExternalRepo.ServiceType),
-		ExternalServiceID:   nullStringColumn(r.ExternalRepo.ServiceID),
-		ExternalID:          nullStringColumn(r.ExternalRepo.ID),
-		Archived:            r.Archived,
-		Fork:                r.Fork,
-		Stars:               r.Stars,
-		Private:             r.Private,
-		Metadata:            metadata,
-		Sources:             sources,
+		ID:                     r.ID,
+		Name:                   string(r.Name),
+		URI:                    nullStringColumn(r.URI),
+		Description:            r.Description,
+		CreatedAt:              r.CreatedAt.UTC(),
+		UpdatedAt:              nullTimeColumn(r.UpdatedAt.UTC()),
+		DeletedAt:              nullTimeColumn(r.DeletedAt.UTC()),
+		ExternalServiceType:    nullStringColumn(r.ExternalRepo.ServiceType),
+		ExternalServiceID:      nullStringColumn(r.ExternalRepo.ServiceID),
+		ExternalID:             nullStringColumn(r.ExternalRepo.ID),
+		Archived:               r.Archived,
+		Fork:                   r.Fork,
+		Stars:                  r.Stars,
+		Topics:                 topicsColumn(r.Topics),
+		PrimaryLanguage:        nullStringColumn(r.PrimaryLanguage),
+		License:                nullStringColumn(r.License),
+		DefaultBranchProtected: r.DefaultBranchProtected,
+		Private:                r.Private,
+		Metadata:               metadata,
+		Sources:                sources,
 	}, nil
 }
 
@@ -0,0 +1,103 @@
+package repos
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/go-multierror"
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// The classes of errors of external service syncs.
+const (
+	syncErrorUnauthorized     = "unauthorized"
+	syncErrorForbidden        = "forbidden"
+	syncErrorAccountSuspended = "account_suspended"
+	syncErrorRateLimited      = "rate_limited"
+	syncErrorNotFound         = "not_found"
+	syncErrorTimeout          = "timeout"
+	syncErrorTemporary        = "temporary"
+	syncErrorOther            = "other"
+)
+
+// syncErrorClass returns the class of the error of an external service sync,
+// so that recurring failures can be told apart on the health dashboard.
+func syncErrorClass(err error) string {
+	switch {
+	case errcode.IsUnauthorized(err):
+		return syncErrorUnauthorized
+	case errcode.IsForbidden(err):
+		return syncErrorForbidden
+	case errcode.IsAccountSuspended(err):
+		return syncErrorAccountSuspended
+	case isRateLimited(err):
+		return syncErrorRateLimited
+	case errcode.IsNotFound(err):
+		return syncErrorNotFound
+	case errcode.IsTimeout(err), errors.Is(err, context.DeadlineExceeded):
+		return syncErrorTimeout
+	case errcode.IsTemporary(err):
+		return syncErrorTemporary
+	}
+	return syncErrorOther
+}
+
+// isRateLimited returns true if err, or any of the errors of all sources it
+// aggregates, is caused by exceeding the rate limit of a code host.
+func isRateLimited(err error) bool {
+	errs := []error{err}
+	if multi, ok := err.(*multierror.Error); ok {
+		errs = multi.Errors
+	}
+	for _, err := range errs {
+		if github.IsRateLimitExceeded(err) || errcode.IsHTTPErrorCode(err, http.StatusTooManyRequests) {
+			return true
+		}
+	}
+	return false
+}
+
+// recordSyncStats records the outcome of the sync of svc which began at
+// began. Errors are only logged, since they must not fail the sync.
+func (s *Syncer) recordSyncStats(ctx context.Context, svc *types.ExternalService, srcs Sources, began time.Time, sourced int, diff Diff, syncErr, classErr error) {
+	now := s.Now()
+	stats := &types.ExternalServiceSyncStats{
+		ExternalServiceID: svc.ID,
+		StartedAt:         began,
+		FinishedAt:        now,
+		ReposSourced:      sourced,
+		ReposAdded:        len(diff.Added),
+		ReposModified:     len(diff.Modified),
+		ReposRemoved:      len(diff.Deleted),
+	}
+	if syncErr != nil {
+		stats.Error = syncErr.Error()
+		stats.ErrorClass = syncErrorClass(classErr)
+	}
+
+	for _, src := range srcs {
+		rs, ok := src.(RateLimitMonitorSource)
+		if !ok {
+			continue
+		}
+		monitor := rs.RateLimitMonitor()
+		if monitor == nil {
+			continue
+		}
+		if remaining, reset, _, known := monitor.Get(); known {
+			stats.RateLimitKnown = true
+			stats.RateLimitRemaining = remaining
+			stats.RateLimitResetAt = now.Add(reset)
+		}
+	}
+
+	if err := s.Store.ExternalServiceStore.RecordSyncStats(ctx, stats); err != nil {
+		log15.Warn("failed to record external service sync stats", "externalService", svc.ID, "error", err)
+	}
+}
@@ -0,0 +1,43 @@
+package repos
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
+)
+
+func TestSyncErrorClass(t *testing.T) {
+	rateLimited := &github.APIError{
+		Code:    http.StatusForbidden,
+		Message: "API rate limit exceeded for user ID 1.",
+	}
+
+	for _, tc := range []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"unauthorized", &ErrUnauthorized{}, syncErrorUnauthorized},
+		{"forbidden", &ErrForbidden{}, syncErrorForbidden},
+		{"account suspended", &ErrAccountSuspended{}, syncErrorAccountSuspended},
+		{"rate limited", rateLimited, syncErrorRateLimited},
+		{
+			"rate limited source",
+			multierror.Append(nil, &SourceError{Err: errors.New("boom")}, &SourceError{Err: rateLimited}),
+			syncErrorRateLimited,
+		},
+		{"deadline exceeded", errors.Wrap(context.DeadlineExceeded, "listing repos"), syncErrorTimeout},
+		{"other", errors.New("boom"), syncErrorOther},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if have := syncErrorClass(tc.err); have != tc.want {
+				t.Errorf("have %q, want %q", have, tc.want)
+			}
+		})
+	}
+}
@@ -172,6 +172,22 @@ func (s *Syncer) SyncExternalService(ctx context.Context, tx *Store, externalSer
 	svc := svcs[0]
 	isUserOwned := svc.NamespaceUserID > 0
 
+	// Record the outcome of the sync for the external service health
+	// dashboard. The stats are written outside of tx, so that failed syncs
+	// are recorded too.
+	var (
+		srcs      Sources
+		sourced   types.Repos
+		sourceErr error
+	)
+	defer func(began time.Time) {
+		classErr := err
+		if sourceErr != nil {
+			classErr = sourceErr
+		}
+		s.recordSyncStats(ctx, svc, srcs, began, len(sourced), diff, err, classErr)
+	}(s.Now())
+
 	onSourced := func(*types.Repo) error { return nil } //noop
 
 	if isUserOwned {
@@ -213,8 +229,11 @@ func (s *Syncer) SyncExternalService(ctx context.Context, tx *Store, externalSer
 	}
 
 	// Fetch repos from the source
-	var sourced types.Repos
-	if sourced, err = s.sourced(ctx, svc, onSourced); err != nil {
+	if srcs, err = s.Sourcer(svc); err == nil {
+		sourced, err = listAll(ctx, srcs, onSourced)
+	}
+	if err != nil {
+		sourceErr = err
 		unauthorized = errcode.IsUnauthorized(err)
 		forbidden = errcode.IsForbidden(err)
 		accountSuspended = errcode.IsAccountSuspended(err)
@@ -800,15 +819,6 @@ func merge(o, n *types.Repo) {
 	o.Update(n)
 }
 
-func (s *Syncer) sourced(ctx context.Context, svc *types.ExternalService, onSourced ...func(*types.Repo) error) ([]*types.Repo, error) {
-	srcs, err := s.Sourcer(svc)
-	if err != nil {
-		return nil, err
-	}
-
-	return listAll(ctx, srcs, onSourced...)
-}
-
 // makeNewRepoInserter returns a function that will insert repos.
 // If publicOnly is set it will never insert a private repo.
 func (s *Syncer) makeNewRepoInserter(ctx context.Context, store *Store, publicOnly bool) (func(*types.Repo) error, error) {
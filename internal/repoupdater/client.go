@@ -268,6 +268,83 @@ func (c *Client) SyncExternalService(ctx context.Context, svc api.ExternalServic
 	return &result, nil
 }
 
+// PreviewExternalServiceSync requests the changes that syncing the given
+// external service would make to its repositories, without making them.
+func (c *Client) PreviewExternalServiceSync(ctx context.Context, svc api.ExternalService) (*protocol.ExternalServiceSyncPreviewResult, error) {
+	req := &protocol.ExternalServiceSyncPreviewRequest{ExternalService: svc}
+	resp, err := c.httpPost(ctx, "preview-external-service-sync", req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bs, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		return nil, errors.New(string(bs))
+	}
+
+	var result protocol.ExternalServiceSyncPreviewResult
+	if err = json.Unmarshal(bs, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// MockTypeahead mocks (*Client).Typeahead for tests.
+var MockTypeahead func(ctx context.Context, args protocol.TypeaheadRequest) (*protocol.TypeaheadResult, error)
+
+// Typeahead requests the repositories and recently viewed files matching the
+// given typeahead query.
+func (c *Client) Typeahead(ctx context.Context, args protocol.TypeaheadRequest) (*protocol.TypeaheadResult, error) {
+	if MockTypeahead != nil {
+		return MockTypeahead(ctx, args)
+	}
+
+	resp, err := c.httpPost(ctx, "typeahead", args)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bs, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		return nil, errors.New(string(bs))
+	}
+
+	var result protocol.TypeaheadResult
+	if err = json.Unmarshal(bs, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RecordTypeaheadFiles records the given files as recently viewed in the
+// typeahead index.
+func (c *Client) RecordTypeaheadFiles(ctx context.Context, files []protocol.TypeaheadFile) error {
+	resp, err := c.httpPost(ctx, "typeahead-record-files", protocol.TypeaheadRecordFilesRequest{Files: files})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		bs, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return errors.Wrap(err, "failed to read response body")
+		}
+		return errors.New(string(bs))
+	}
+	return nil
+}
+
 // RepoExternalServices requests the external services associated with a
 // repository with the given id.
 func (c *Client) RepoExternalServices(ctx context.Context, id api.RepoID) ([]api.ExternalService, error) {
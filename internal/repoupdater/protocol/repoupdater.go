@@ -142,6 +142,25 @@ type RepoUpdateResponse struct {
 	URL string `json:"url"`
 }
 
+// WebhookRequest is the payload of a generic webhook that updates the
+// contents of the given repos as soon as possible.
+type WebhookRequest struct {
+	// Repos are the names of the repos to update.
+	Repos []api.RepoName `json:"repos"`
+	// ExternalServiceIDs are the IDs of external services to sync, so that
+	// newly created repos are added without waiting for the next sync.
+	ExternalServiceIDs []int64 `json:"externalServiceIDs,omitempty"`
+}
+
+// WebhookResult is the response to a webhook request.
+type WebhookResult struct {
+	// Repos are the names of the repos that got an update request.
+	Repos []api.RepoName `json:"repos"`
+	// ExternalServiceIDs are the IDs of the external services that got a
+	// sync request.
+	ExternalServiceIDs []int64 `json:"externalServiceIDs"`
+}
+
 // ChangesetSyncRequest is a request to sync a number of changesets
 type ChangesetSyncRequest struct {
 	IDs []int64
@@ -177,3 +196,70 @@ type ExternalServiceSyncResult struct {
 	ExternalService api.ExternalService
 	Error           string
 }
+
+// ExternalServiceSyncPreviewRequest is a request to preview the changes that
+// syncing an external service with a candidate configuration would make,
+// without making them. A zero ExternalService.ID previews a new external
+// service.
+type ExternalServiceSyncPreviewRequest struct {
+	ExternalService api.ExternalService
+}
+
+// ExternalServiceSyncPreviewResult is the result of an
+// ExternalServiceSyncPreviewRequest.
+type ExternalServiceSyncPreviewResult struct {
+	// Added are the repos that would be added.
+	Added []api.RepoName
+	// Removed are the repos that would be removed because the code host no
+	// longer yields them.
+	Removed []api.RepoName
+	// Excluded are the repos that would be removed because the configuration
+	// excludes them.
+	Excluded []api.RepoName
+	// Renamed are the repos that would be renamed.
+	Renamed []RepoRename
+	// Unmodified is the number of repos whose names would not change.
+	Unmodified int
+}
+
+// RepoRename is the change of name of a repo.
+type RepoRename struct {
+	From api.RepoName
+	To   api.RepoName
+}
+
+// TypeaheadRequest is a request for the repositories and recently viewed files
+// whose names start a word with the query.
+type TypeaheadRequest struct {
+	Query string
+	Limit int
+}
+
+// TypeaheadResult is the result of a TypeaheadRequest.
+type TypeaheadResult struct {
+	// Suggestions are ordered from best to worst match.
+	Suggestions []TypeaheadSuggestion
+}
+
+// TypeaheadSuggestion is a repository or file matching a typeahead query.
+type TypeaheadSuggestion struct {
+	Kind     string // "repo" or "file"
+	RepoID   api.RepoID
+	RepoName api.RepoName
+	CommitID api.CommitID `json:",omitempty"`
+	Path     string       `json:",omitempty"`
+	Score    int
+}
+
+// TypeaheadRecordFilesRequest records files that were viewed, so that they
+// are suggested by later typeahead requests.
+type TypeaheadRecordFilesRequest struct {
+	Files []TypeaheadFile
+}
+
+// TypeaheadFile is a file viewed at a commit.
+type TypeaheadFile struct {
+	RepoID   api.RepoID
+	CommitID api.CommitID
+	Path     string
+}
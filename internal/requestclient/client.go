@@ -0,0 +1,67 @@
+// Package requestclient provides the client of the HTTP request being handled
+// to the code handling it, such as for recording it in audit logs.
+package requestclient
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// Client is the client of an HTTP request.
+type Client struct {
+	// IP is the remote address of the connection the request was made over,
+	// without the port. It is the address of the proxy in front of
+	// Sourcegraph if there is one.
+	IP string
+	// ForwardedFor is the value of the X-Forwarded-For header of the request.
+	ForwardedFor string
+}
+
+// OriginIP returns the IP address of the client which made the request: the
+// first address in the X-Forwarded-For header if it is set, and the remote
+// address of the connection otherwise.
+//
+// 🚨 SECURITY: The X-Forwarded-For header is set by the client, so the origin IP
+// must only be used for informational purposes, never for access control.
+func (c *Client) OriginIP() string {
+	if c == nil {
+		return ""
+	}
+	if c.ForwardedFor != "" {
+		return strings.TrimSpace(strings.Split(c.ForwardedFor, ",")[0])
+	}
+	return c.IP
+}
+
+type key int
+
+const clientKey key = iota
+
+// FromContext returns the client of the request ctx belongs to, or nil if ctx
+// doesn't belong to a request.
+func FromContext(ctx context.Context) *Client {
+	c, _ := ctx.Value(clientKey).(*Client)
+	return c
+}
+
+// WithClient returns a copy of ctx with the given client.
+func WithClient(ctx context.Context, c *Client) context.Context {
+	return context.WithValue(ctx, clientKey, c)
+}
+
+// HTTPMiddleware adds the client of each request to its context.
+func HTTPMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			ip = r.RemoteAddr
+		}
+		ctx := WithClient(r.Context(), &Client{
+			IP:           ip,
+			ForwardedFor: r.Header.Get("X-Forwarded-For"),
+		})
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
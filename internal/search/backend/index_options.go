@@ -31,6 +31,13 @@ type zoektIndexOptions struct {
 	// Symbols if true will make zoekt index the output of ctags.
 	Symbols bool
 
+	// Fork and Archived are true if the repository is a fork or archived.
+	// They are recorded in the metadata of the shards of the repository, so
+	// that global searches can skip these shards unless a query asks for
+	// forks or archived repositories.
+	Fork     bool
+	Archived bool
+
 	// Branches is a slice of branches to index.
 	Branches []zoekt.RepositoryBranch `json:",omitempty"`
 
@@ -54,6 +61,12 @@ type RepoIndexOptions struct {
 	// Priority indicates ranking in results, higher first.
 	Priority float64
 
+	// Fork is true if the repository is a fork.
+	Fork bool
+
+	// Archived is true if the repository is archived.
+	Archived bool
+
 	// GetVersion is used to resolve revisions for a repo. If it fails, the
 	// error is encoded in the body. If the revision is missing, an empty
 	// string should be returned rather than an error.
@@ -105,6 +118,8 @@ func getIndexOptions(
 		RepoID:     opts.RepoID,
 		Public:     opts.Public,
 		Priority:   opts.Priority,
+		Fork:       opts.Fork,
+		Archived:   opts.Archived,
 		LargeFiles: c.SearchLargeFiles,
 		Symbols:    getBoolPtr(c.SearchIndexSymbolsEnabled, true),
 	}
@@ -192,6 +192,30 @@ func TestGetIndexOptions(t *testing.T) {
 			},
 			Priority: 10,
 		},
+	}, {
+		name: "fork",
+		conf: schema.SiteConfiguration{},
+		repo: "fork",
+		want: zoektIndexOptions{
+			RepoID:  6,
+			Symbols: true,
+			Fork:    true,
+			Branches: []zoekt.RepositoryBranch{
+				{Name: "HEAD", Version: "!HEAD"},
+			},
+		},
+	}, {
+		name: "archived",
+		conf: schema.SiteConfiguration{},
+		repo: "archived",
+		want: zoektIndexOptions{
+			RepoID:   7,
+			Symbols:  true,
+			Archived: true,
+			Branches: []zoekt.RepositoryBranch{
+				{Name: "HEAD", Version: "!HEAD"},
+			},
+		},
 	}}
 
 	{
@@ -221,7 +245,7 @@ func TestGetIndexOptions(t *testing.T) {
 
 	getRepoIndexOptions := func(repo string) (*RepoIndexOptions, error) {
 		repoID := int32(1)
-		for _, r := range []string{"repo", "foo", "not_in_version_context", "priority", "public"} {
+		for _, r := range []string{"repo", "foo", "not_in_version_context", "priority", "public", "fork", "archived"} {
 			if r == repo {
 				break
 			}
@@ -235,6 +259,8 @@ func TestGetIndexOptions(t *testing.T) {
 			RepoID:   repoID,
 			Public:   repo == "public",
 			Priority: priority,
+			Fork:     repo == "fork",
+			Archived: repo == "archived",
 			GetVersion: func(branch string) (string, error) {
 				return "!" + branch, nil
 			},
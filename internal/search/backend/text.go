@@ -27,6 +27,7 @@ type Zoekt struct {
 	mu       sync.RWMutex
 	state    int32 // 0 not running, 1 running, 2 stopped
 	set      map[string]*zoekt.Repository
+	sizes    map[string]int64
 	err      error
 	disabled bool
 }
@@ -59,12 +60,34 @@ func (c *Zoekt) ListAll(ctx context.Context) (map[string]*zoekt.Repository, erro
 		if !c.DisableCache {
 			go c.start()
 		}
-		set, err = c.list(ctx)
+		set, _, err = c.list(ctx)
 	}
 
 	return set, err
 }
 
+// RepoSizes returns the size in bytes of the indexed content of each
+// repository in the response of List, keyed by repository name.
+func (c *Zoekt) RepoSizes(ctx context.Context) (map[string]int64, error) {
+	if !c.Enabled() {
+		return map[string]int64{}, nil
+	}
+
+	c.mu.RLock()
+	sizes, err := c.sizes, c.err
+	c.mu.RUnlock()
+
+	// No cached responses, start up and just do uncached query.
+	if sizes == nil && err == nil {
+		if !c.DisableCache {
+			go c.start()
+		}
+		_, sizes, err = c.list(ctx)
+	}
+
+	return sizes, err
+}
+
 // SetEnabled will disable zoekt if b is false.
 func (c *Zoekt) SetEnabled(b bool) {
 	c.mu.Lock()
@@ -81,18 +104,20 @@ func (c *Zoekt) Enabled() bool {
 	return c.Client != nil && !b
 }
 
-func (c *Zoekt) list(ctx context.Context) (map[string]*zoekt.Repository, error) {
+func (c *Zoekt) list(ctx context.Context) (map[string]*zoekt.Repository, map[string]int64, error) {
 	resp, err := c.Client.List(ctx, &zoektquery.Const{Value: true})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	set := make(map[string]*zoekt.Repository, len(resp.Repos))
+	sizes := make(map[string]int64, len(resp.Repos))
 	for _, r := range resp.Repos {
 		set[r.Repository.Name] = &r.Repository
+		sizes[r.Repository.Name] = r.Stats.ContentBytes
 	}
 
-	return set, nil
+	return set, sizes, nil
 }
 
 // start starts a goroutine that keeps the listResp and listErr fields updated
@@ -117,13 +142,13 @@ func (c *Zoekt) start() {
 			c.mu.Lock()
 			defer c.mu.Unlock()
 			if c.state == 1 {
-				c.state, c.set, c.err = 0, nil, nil
+				c.state, c.set, c.sizes, c.err = 0, nil, nil, nil
 			}
 			return
 		}
 
 		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
-		set, err := c.list(ctx)
+		set, sizes, err := c.list(ctx)
 		cancel()
 
 		if err != nil {
@@ -138,7 +163,7 @@ func (c *Zoekt) start() {
 		// to prevent us caching transient errors, and instead fallback on the
 		// old list.
 		if errorCount == 0 || errorCount > 3 {
-			c.set, c.err = set, err
+			c.set, c.sizes, c.err = set, sizes, err
 		}
 		c.mu.Unlock()
 
@@ -0,0 +1,38 @@
+package backtrack
+
+import "strings"
+
+// HasLookaround returns true if the given regular expression contains a lookahead or lookbehind
+// assertion, which can only be evaluated by this package.
+func HasLookaround(expr string) bool {
+	inClass := false
+	for i := 0; i < len(expr); i++ {
+		switch expr[i] {
+		case '\\':
+			if strings.HasPrefix(expr[i:], `\Q`) {
+				end := strings.Index(expr[i:], `\E`)
+				if end < 0 {
+					return false
+				}
+				i += end + 1
+				continue
+			}
+			// Skip the escaped character
+			i++
+		case '[':
+			inClass = true
+		case ']':
+			inClass = false
+		case '(':
+			if inClass {
+				continue
+			}
+			rest := expr[i+1:]
+			if strings.HasPrefix(rest, "?=") || strings.HasPrefix(rest, "?!") || strings.HasPrefix(rest, "?<=") || strings.HasPrefix(rest, "?<!") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,703 @@
+package backtrack
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type opcode int
+
+const (
+	opEmpty opcode = iota
+	opLiteral
+	opAnyChar
+	opClass
+	opBeginLine
+	opEndLine
+	opBeginText
+	opEndText
+	opWordBoundary
+	opNoWordBoundary
+	opConcat
+	opAlternate
+	opRepeat
+	opCapture
+	opLookahead
+	opLookbehind
+)
+
+// node is a node of a parsed regular expression.
+type node struct {
+	op    opcode
+	runes []rune     // opLiteral
+	class *charClass // opClass
+	subs  []*node    // opConcat, opAlternate, opRepeat, opCapture, opLookahead, opLookbehind
+
+	fold   bool // opLiteral, opClass: match case insensitively
+	dotNL  bool // opAnyChar: match newlines
+	negate bool // opLookahead, opLookbehind: the assertion succeeds if the subexpression does not match
+	greedy bool // opRepeat: prefer more iterations
+	min    int  // opRepeat
+	max    int  // opRepeat: -1 for no upper bound
+
+	// width is the maximum number of runes matched by the subexpression of an opLookbehind
+	// node, or -1 if it is unbounded.
+	width int
+}
+
+// flags are the inline flags in effect at a point of the expression.
+type flags struct {
+	fold      bool // i
+	multiLine bool // m
+	dotNL     bool // s
+	ungreedy  bool // U
+}
+
+// charClass is a set of runes.
+type charClass struct {
+	ranges []runeRange
+	tables []*unicode.RangeTable
+	// negTables are tables whose complement is part of the class.
+	negTables []*unicode.RangeTable
+	negate    bool
+}
+
+type runeRange struct{ lo, hi rune }
+
+func (c *charClass) contains(r rune) bool {
+	return c.containsNoNegate(r) != c.negate
+}
+
+func (c *charClass) containsNoNegate(r rune) bool {
+	for _, rr := range c.ranges {
+		if rr.lo <= r && r <= rr.hi {
+			return true
+		}
+	}
+	for _, t := range c.tables {
+		if unicode.Is(t, r) {
+			return true
+		}
+	}
+	for _, t := range c.negTables {
+		if !unicode.Is(t, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// matches returns true if the class contains r or, when fold is set, any case variant of r.
+func (c *charClass) matches(r rune, fold bool) bool {
+	if !fold {
+		return c.contains(r)
+	}
+
+	in := c.containsNoNegate(r)
+	for f := unicode.SimpleFold(r); !in && f != r; f = unicode.SimpleFold(f) {
+		in = c.containsNoNegate(f)
+	}
+	return in != c.negate
+}
+
+var (
+	digitRanges = []runeRange{{'0', '9'}}
+	wordRanges  = []runeRange{{'0', '9'}, {'A', 'Z'}, {'_', '_'}, {'a', 'z'}}
+	spaceRanges = []runeRange{{'\t', '\n'}, {'\f', '\r'}, {' ', ' '}}
+)
+
+var posixClasses = map[string][]runeRange{
+	"alnum":  {{'0', '9'}, {'A', 'Z'}, {'a', 'z'}},
+	"alpha":  {{'A', 'Z'}, {'a', 'z'}},
+	"ascii":  {{0, 0x7f}},
+	"blank":  {{'\t', '\t'}, {' ', ' '}},
+	"cntrl":  {{0, 0x1f}, {0x7f, 0x7f}},
+	"digit":  digitRanges,
+	"graph":  {{'!', '~'}},
+	"lower":  {{'a', 'z'}},
+	"print":  {{' ', '~'}},
+	"punct":  {{'!', '/'}, {':', '@'}, {'[', '`'}, {'{', '~'}},
+	"space":  {{'\t', '\r'}, {' ', ' '}},
+	"upper":  {{'A', 'Z'}},
+	"word":   wordRanges,
+	"xdigit": {{'0', '9'}, {'A', 'F'}, {'a', 'f'}},
+}
+
+// complement returns the ranges of runes not covered by the given sorted, non-overlapping ranges.
+func complement(ranges []runeRange) []runeRange {
+	var out []runeRange
+	next := rune(0)
+	for _, rr := range ranges {
+		if rr.lo > next {
+			out = append(out, runeRange{next, rr.lo - 1})
+		}
+		next = rr.hi + 1
+	}
+	if next <= unicode.MaxRune {
+		out = append(out, runeRange{next, unicode.MaxRune})
+	}
+	return out
+}
+
+type parser struct {
+	expr string
+	pos  int
+}
+
+func parse(expr string) (*node, error) {
+	p := &parser{expr: expr}
+	n, err := p.parseAlternate(&flags{})
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.expr) {
+		// parseAlternate only stops early at an unmatched closing parenthesis.
+		return nil, p.errorf("unexpected )")
+	}
+	return n, nil
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("error parsing regexp: %s: `%s`", fmt.Sprintf(format, args...), p.expr)
+}
+
+func (p *parser) more() bool {
+	return p.pos < len(p.expr)
+}
+
+func (p *parser) peek() rune {
+	r, _ := utf8.DecodeRuneInString(p.expr[p.pos:])
+	return r
+}
+
+func (p *parser) next() rune {
+	r, size := utf8.DecodeRuneInString(p.expr[p.pos:])
+	p.pos += size
+	return r
+}
+
+func (p *parser) consume(prefix string) bool {
+	if strings.HasPrefix(p.expr[p.pos:], prefix) {
+		p.pos += len(prefix)
+		return true
+	}
+	return false
+}
+
+// parseAlternate parses alternatives until the end of the expression or an unmatched closing
+// parenthesis. Inline flags set within one alternative remain in effect for the following ones.
+func (p *parser) parseAlternate(fl *flags) (*node, error) {
+	var alternatives []*node
+	for {
+		n, err := p.parseConcat(fl)
+		if err != nil {
+			return nil, err
+		}
+		alternatives = append(alternatives, n)
+
+		if !p.consume("|") {
+			break
+		}
+	}
+
+	if len(alternatives) == 1 {
+		return alternatives[0], nil
+	}
+	return &node{op: opAlternate, subs: alternatives}, nil
+}
+
+func (p *parser) parseConcat(fl *flags) (*node, error) {
+	var subs []*node
+	for p.more() {
+		switch p.peek() {
+		case '|', ')':
+			return concat(subs), nil
+		case '*', '+', '?':
+			return nil, p.errorf("missing argument to repetition operator")
+		}
+
+		atom, err := p.parseAtom(fl)
+		if err != nil {
+			return nil, err
+		}
+		if atom == nil {
+			// Inline flag group such as (?i)
+			continue
+		}
+
+		atom, err = p.parseRepeat(atom, fl)
+		if err != nil {
+			return nil, err
+		}
+		subs = append(subs, atom)
+	}
+
+	return concat(subs), nil
+}
+
+func concat(subs []*node) *node {
+	switch len(subs) {
+	case 0:
+		return &node{op: opEmpty}
+	case 1:
+		return subs[0]
+	}
+	return &node{op: opConcat, subs: subs}
+}
+
+func (p *parser) parseRepeat(atom *node, fl *flags) (*node, error) {
+	for p.more() {
+		start := p.pos
+
+		var min, max int
+		switch p.peek() {
+		case '*':
+			p.next()
+			min, max = 0, -1
+		case '+':
+			p.next()
+			min, max = 1, -1
+		case '?':
+			p.next()
+			min, max = 0, 1
+		case '{':
+			var ok bool
+			if min, max, ok = p.parseBraces(); !ok {
+				// Not a valid repetition; the brace is a literal.
+				p.pos = start
+				return atom, nil
+			}
+			if max != -1 && max < min {
+				return nil, p.errorf("invalid repeat count")
+			}
+		default:
+			return atom, nil
+		}
+
+		if isAssertion(atom) {
+			return nil, p.errorf("invalid nested repetition operator")
+		}
+
+		greedy := !fl.ungreedy
+		if p.consume("?") {
+			greedy = !greedy
+		}
+		atom = &node{op: opRepeat, subs: []*node{atom}, min: min, max: max, greedy: greedy}
+	}
+
+	return atom, nil
+}
+
+func isAssertion(n *node) bool {
+	switch n.op {
+	case opBeginLine, opEndLine, opBeginText, opEndText, opWordBoundary, opNoWordBoundary, opLookahead, opLookbehind:
+		return true
+	}
+	return false
+}
+
+// parseBraces parses a {n}, {n,}, or {n,m} repetition.
+func (p *parser) parseBraces() (min, max int, ok bool) {
+	end := strings.IndexByte(p.expr[p.pos:], '}')
+	if end < 0 {
+		return 0, 0, false
+	}
+	body := p.expr[p.pos+1 : p.pos+end]
+
+	lo, hi := body, body
+	if i := strings.IndexByte(body, ','); i >= 0 {
+		lo, hi = body[:i], body[i+1:]
+	}
+
+	var err error
+	if min, err = strconv.Atoi(lo); err != nil || min > 1000 || min < 0 {
+		return 0, 0, false
+	}
+	if hi == "" {
+		max = -1
+	} else if max, err = strconv.Atoi(hi); err != nil || max > 1000 || max < 0 {
+		return 0, 0, false
+	}
+
+	p.pos += end + 1
+	return min, max, true
+}
+
+// parseAtom parses a single atom. It returns a nil node for an inline flag group.
+func (p *parser) parseAtom(fl *flags) (*node, error) {
+	switch r := p.next(); r {
+	case '(':
+		return p.parseGroup(fl)
+	case '[':
+		class, err := p.parseClass()
+		if err != nil {
+			return nil, err
+		}
+		return &node{op: opClass, class: class, fold: fl.fold}, nil
+	case '.':
+		return &node{op: opAnyChar, dotNL: fl.dotNL}, nil
+	case '^':
+		if fl.multiLine {
+			return &node{op: opBeginLine}, nil
+		}
+		return &node{op: opBeginText}, nil
+	case '$':
+		if fl.multiLine {
+			return &node{op: opEndLine}, nil
+		}
+		return &node{op: opEndText}, nil
+	case '\\':
+		return p.parseEscape(fl)
+	default:
+		return &node{op: opLiteral, runes: []rune{r}, fold: fl.fold}, nil
+	}
+}
+
+func (p *parser) parseGroup(fl *flags) (*node, error) {
+	var (
+		op     = opCapture
+		negate bool
+		inner  = *fl
+	)
+
+	switch {
+	case p.consume("?="):
+		op = opLookahead
+	case p.consume("?!"):
+		op, negate = opLookahead, true
+	case p.consume("?<="):
+		op = opLookbehind
+	case p.consume("?<!"):
+		op, negate = opLookbehind, true
+	case p.consume("?P<"), p.consume("?<"):
+		end := strings.IndexByte(p.expr[p.pos:], '>')
+		if end <= 0 {
+			return nil, p.errorf("invalid named capture")
+		}
+		p.pos += end + 1
+	case p.consume("?"):
+		// Flag group: (?flags) or (?flags:re)
+		enable := true
+		for {
+			if !p.more() {
+				return nil, p.errorf("missing closing )")
+			}
+
+			switch c := p.next(); c {
+			case 'i':
+				inner.fold = enable
+			case 'm':
+				inner.multiLine = enable
+			case 's':
+				inner.dotNL = enable
+			case 'U':
+				inner.ungreedy = enable
+			case '-':
+				if !enable {
+					return nil, p.errorf("invalid or unsupported Perl syntax")
+				}
+				enable = false
+			case ')':
+				// The flags apply to the remainder of the enclosing group.
+				*fl = inner
+				return nil, nil
+			case ':':
+				op = opConcat
+			default:
+				return nil, p.errorf("invalid or unsupported Perl syntax")
+			}
+
+			if op == opConcat {
+				break
+			}
+		}
+	}
+
+	sub, err := p.parseAlternate(&inner)
+	if err != nil {
+		return nil, err
+	}
+	if !p.consume(")") {
+		return nil, p.errorf("missing closing )")
+	}
+
+	switch op {
+	case opConcat:
+		return sub, nil
+	case opLookbehind:
+		return &node{op: op, subs: []*node{sub}, negate: negate, width: maxWidth(sub)}, nil
+	}
+	return &node{op: op, subs: []*node{sub}, negate: negate}, nil
+}
+
+// maxWidth returns the maximum number of runes matched by n, or -1 if it is unbounded.
+func maxWidth(n *node) int {
+	switch n.op {
+	case opLiteral:
+		return len(n.runes)
+	case opAnyChar, opClass:
+		return 1
+	case opConcat:
+		width := 0
+		for _, sub := range n.subs {
+			w := maxWidth(sub)
+			if w < 0 {
+				return -1
+			}
+			width += w
+		}
+		return width
+	case opAlternate:
+		width := 0
+		for _, sub := range n.subs {
+			w := maxWidth(sub)
+			if w < 0 {
+				return -1
+			}
+			if w > width {
+				width = w
+			}
+		}
+		return width
+	case opRepeat:
+		w := maxWidth(n.subs[0])
+		if w < 0 || n.max < 0 {
+			return -1
+		}
+		return w * n.max
+	case opCapture:
+		return maxWidth(n.subs[0])
+	}
+
+	// Empty matches and assertions
+	return 0
+}
+
+func (p *parser) parseEscape(fl *flags) (*node, error) {
+	if !p.more() {
+		return nil, p.errorf("trailing backslash at end of expression")
+	}
+
+	switch c := p.peek(); c {
+	case 'A':
+		p.next()
+		return &node{op: opBeginText}, nil
+	case 'z':
+		p.next()
+		return &node{op: opEndText}, nil
+	case 'b':
+		p.next()
+		return &node{op: opWordBoundary}, nil
+	case 'B':
+		p.next()
+		return &node{op: opNoWordBoundary}, nil
+	case 'Q':
+		p.next()
+		literal := p.expr[p.pos:]
+		if end := strings.Index(literal, `\E`); end >= 0 {
+			literal = literal[:end]
+			p.pos += 2
+		}
+		p.pos += len(literal)
+		return &node{op: opLiteral, runes: []rune(literal), fold: fl.fold}, nil
+	case '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		return nil, p.errorf("backreferences are not supported")
+	}
+
+	class, r, err := p.parseClassEscape()
+	if err != nil {
+		return nil, err
+	}
+	if class != nil {
+		return &node{op: opClass, class: class, fold: fl.fold}, nil
+	}
+	return &node{op: opLiteral, runes: []rune{r}, fold: fl.fold}, nil
+}
+
+// parseClassEscape parses an escape sequence valid both inside and outside of a character class
+// (following the backslash). It returns either a class or a single rune.
+func (p *parser) parseClassEscape() (*charClass, rune, error) {
+	switch c := p.next(); c {
+	case 'd':
+		return &charClass{ranges: digitRanges}, 0, nil
+	case 'D':
+		return &charClass{ranges: digitRanges, negate: true}, 0, nil
+	case 'w':
+		return &charClass{ranges: wordRanges}, 0, nil
+	case 'W':
+		return &charClass{ranges: wordRanges, negate: true}, 0, nil
+	case 's':
+		return &charClass{ranges: spaceRanges}, 0, nil
+	case 'S':
+		return &charClass{ranges: spaceRanges, negate: true}, 0, nil
+	case 'p', 'P':
+		table, err := p.parseUnicodeClass()
+		if err != nil {
+			return nil, 0, err
+		}
+		return &charClass{tables: []*unicode.RangeTable{table}, negate: c == 'P'}, 0, nil
+	case 'a':
+		return nil, '\a', nil
+	case 'f':
+		return nil, '\f', nil
+	case 'n':
+		return nil, '\n', nil
+	case 'r':
+		return nil, '\r', nil
+	case 't':
+		return nil, '\t', nil
+	case 'v':
+		return nil, '\v', nil
+	case 'x':
+		r, err := p.parseHex()
+		return nil, r, err
+	default:
+		if c < utf8.RuneSelf && !isWordRune(c) {
+			// Escaped punctuation
+			return nil, c, nil
+		}
+		return nil, 0, p.errorf("invalid escape sequence: `\\%c`", c)
+	}
+}
+
+func (p *parser) parseUnicodeClass() (*unicode.RangeTable, error) {
+	var name string
+	if p.consume("{") {
+		end := strings.IndexByte(p.expr[p.pos:], '}')
+		if end < 0 {
+			return nil, p.errorf("invalid character class range")
+		}
+		name = p.expr[p.pos : p.pos+end]
+		p.pos += end + 1
+	} else if p.more() {
+		name = string(p.next())
+	}
+
+	if table, ok := unicode.Categories[name]; ok {
+		return table, nil
+	}
+	if table, ok := unicode.Scripts[name]; ok {
+		return table, nil
+	}
+	return nil, p.errorf("invalid character class range: `%s`", name)
+}
+
+func (p *parser) parseHex() (rune, error) {
+	var digits string
+	if p.consume("{") {
+		end := strings.IndexByte(p.expr[p.pos:], '}')
+		if end < 0 {
+			return 0, p.errorf("invalid escape sequence")
+		}
+		digits = p.expr[p.pos : p.pos+end]
+		p.pos += end + 1
+	} else {
+		if p.pos+2 > len(p.expr) {
+			return 0, p.errorf("invalid escape sequence")
+		}
+		digits = p.expr[p.pos : p.pos+2]
+		p.pos += 2
+	}
+
+	v, err := strconv.ParseUint(digits, 16, 32)
+	if err != nil || v > unicode.MaxRune {
+		return 0, p.errorf("invalid escape sequence: `\\x%s`", digits)
+	}
+	return rune(v), nil
+}
+
+// parseClass parses a bracketed character class (following the opening bracket).
+func (p *parser) parseClass() (*charClass, error) {
+	class := &charClass{}
+	if p.consume("^") {
+		class.negate = true
+	}
+
+	first := true
+	for {
+		if !p.more() {
+			return nil, p.errorf("missing closing ]")
+		}
+		if !first && p.consume("]") {
+			break
+		}
+		first = false
+
+		if p.consume("[:") {
+			end := strings.Index(p.expr[p.pos:], ":]")
+			if end < 0 {
+				return nil, p.errorf("invalid character class range")
+			}
+			name := p.expr[p.pos : p.pos+end]
+			p.pos += end + 2
+
+			negate := strings.HasPrefix(name, "^")
+			ranges, ok := posixClasses[strings.TrimPrefix(name, "^")]
+			if !ok {
+				return nil, p.errorf("invalid character class range: `[:%s:]`", name)
+			}
+			if negate {
+				ranges = complement(ranges)
+			}
+			class.ranges = append(class.ranges, ranges...)
+			continue
+		}
+
+		lo, sub, err := p.parseClassRune()
+		if err != nil {
+			return nil, err
+		}
+		if sub != nil {
+			addClass(class, sub)
+			continue
+		}
+
+		hi := lo
+		if strings.HasPrefix(p.expr[p.pos:], "-") && !strings.HasPrefix(p.expr[p.pos:], "-]") {
+			p.next()
+			if hi, sub, err = p.parseClassRune(); err != nil {
+				return nil, err
+			}
+			if sub != nil || hi < lo {
+				return nil, p.errorf("invalid character class range")
+			}
+		}
+		class.ranges = append(class.ranges, runeRange{lo, hi})
+	}
+
+	return class, nil
+}
+
+// parseClassRune parses a single rune or escape sequence within a character class.
+func (p *parser) parseClassRune() (rune, *charClass, error) {
+	if !p.consume(`\`) {
+		return p.next(), nil, nil
+	}
+	if !p.more() {
+		return 0, nil, p.errorf("missing closing ]")
+	}
+
+	class, r, err := p.parseClassEscape()
+	return r, class, err
+}
+
+// addClass adds the runes of sub to class.
+func addClass(class, sub *charClass) {
+	if !sub.negate {
+		class.ranges = append(class.ranges, sub.ranges...)
+		class.tables = append(class.tables, sub.tables...)
+		return
+	}
+
+	if len(sub.ranges) > 0 {
+		class.ranges = append(class.ranges, complement(sub.ranges)...)
+	}
+	class.negTables = append(class.negTables, sub.tables...)
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || ('0' <= r && r <= '9') || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z')
+}
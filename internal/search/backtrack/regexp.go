@@ -0,0 +1,371 @@
+// Package backtrack implements a backtracking regular expression matcher. Unlike the RE2-based
+// engines used by zoekt and the regexp package, it supports lookahead and lookbehind assertions.
+// Backtracking can take exponential time, so every search is bounded by a Budget.
+//
+// The supported syntax is that of the regexp package with the addition of the (?=re), (?!re),
+// (?<=re), and (?<!re) assertions. The semantics of ^, $, and inline flags follow the regexp
+// package, and matches are leftmost-first.
+package backtrack
+
+import (
+	"bytes"
+	"errors"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrBudgetExceeded is returned when a search exceeds its budget.
+var ErrBudgetExceeded = errors.New("regular expression search exceeded its budget")
+
+// Budget bounds the resources used by a single search.
+type Budget struct {
+	// MaxSteps is the maximum number of steps taken by the matcher.
+	MaxSteps int64
+
+	// MaxDepth is the maximum depth of the backtracking stack, which bounds the memory used by
+	// the matcher.
+	MaxDepth int
+
+	// Timeout is the maximum wall-clock duration of the search.
+	Timeout time.Duration
+}
+
+// DefaultBudget is the budget used by regular expressions returned from Compile.
+var DefaultBudget = Budget{
+	MaxSteps: 10000000,
+	MaxDepth: 20000,
+	Timeout:  time.Second,
+}
+
+// Regexp is a compiled regular expression. It is safe for concurrent use.
+type Regexp struct {
+	expr   string
+	root   *node
+	prefix []byte
+	budget Budget
+}
+
+// Compile parses a regular expression and returns a Regexp that searches within the default budget.
+func Compile(expr string) (*Regexp, error) {
+	return CompileWithBudget(expr, DefaultBudget)
+}
+
+// CompileWithBudget parses a regular expression and returns a Regexp that searches within the
+// given budget.
+func CompileWithBudget(expr string, budget Budget) (*Regexp, error) {
+	root, err := parse(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Regexp{
+		expr:   expr,
+		root:   root,
+		prefix: []byte(string(literalPrefix(root))),
+		budget: budget,
+	}, nil
+}
+
+// MustCompile is like Compile but panics if the expression cannot be parsed.
+func MustCompile(expr string) *Regexp {
+	re, err := Compile(expr)
+	if err != nil {
+		panic(err)
+	}
+	return re
+}
+
+// String returns the source text used to compile the regular expression.
+func (re *Regexp) String() string {
+	return re.expr
+}
+
+// literalPrefix returns the case-sensitive literal that every match of n starts with.
+func literalPrefix(n *node) []rune {
+	switch n.op {
+	case opLiteral:
+		if !n.fold {
+			return n.runes
+		}
+	case opCapture:
+		return literalPrefix(n.subs[0])
+	case opConcat:
+		return literalPrefix(n.subs[0])
+	}
+	return nil
+}
+
+// Match reports whether b contains a match of the regular expression. Matches that would exceed
+// the budget are not reported.
+func (re *Regexp) Match(b []byte) bool {
+	locs, _ := re.FindAllIndex(b, 1)
+	return len(locs) > 0
+}
+
+// MatchString reports whether s contains a match of the regular expression.
+func (re *Regexp) MatchString(s string) bool {
+	return re.Match([]byte(s))
+}
+
+// FindAllIndex returns the start and end offsets of successive non-overlapping matches of the
+// regular expression in b, as the method of the same name in the regexp package. At most n
+// matches are returned if n >= 0. If the search exceeds its budget, the matches found so far
+// are returned along with ErrBudgetExceeded.
+func (re *Regexp) FindAllIndex(b []byte, n int) ([][]int, error) {
+	m := &machine{input: b, budget: re.budget}
+	if re.budget.Timeout > 0 {
+		m.deadline = time.Now().Add(re.budget.Timeout)
+	}
+
+	var locs [][]int
+	prevEnd := -1
+	for pos := 0; pos <= len(b) && (n < 0 || len(locs) < n); {
+		start, end, ok := m.find(re, pos)
+		if m.err != nil {
+			return locs, m.err
+		}
+		if !ok {
+			break
+		}
+
+		if start == end && start == prevEnd {
+			// An empty match directly after the previous match is ignored.
+			if start == len(b) {
+				break
+			}
+			_, size := utf8.DecodeRune(b[start:])
+			pos = start + size
+			continue
+		}
+
+		locs = append(locs, []int{start, end})
+		prevEnd = end
+
+		if end > start {
+			pos = end
+		} else if start == len(b) {
+			break
+		} else {
+			_, size := utf8.DecodeRune(b[start:])
+			pos = start + size
+		}
+	}
+
+	return locs, nil
+}
+
+// machine holds the state of a single search.
+type machine struct {
+	input    []byte
+	budget   Budget
+	deadline time.Time
+	steps    int64
+	depth    int
+	err      error
+}
+
+// find returns the leftmost match starting at or after pos.
+func (m *machine) find(re *Regexp, pos int) (start, end int, ok bool) {
+	for start = pos; start <= len(m.input); {
+		if len(re.prefix) > 0 {
+			i := bytes.Index(m.input[start:], re.prefix)
+			if i < 0 {
+				return 0, 0, false
+			}
+			start += i
+		}
+
+		if m.match(re.root, start, func(p int) bool { end = p; return true }) {
+			return start, end, true
+		}
+		if m.err != nil || start == len(m.input) {
+			return 0, 0, false
+		}
+
+		_, size := utf8.DecodeRune(m.input[start:])
+		start += size
+	}
+
+	return 0, 0, false
+}
+
+// match reports whether n matches at pos such that the continuation k accepts the position
+// following the match. Alternatives are tried in order of preference.
+func (m *machine) match(n *node, pos int, k func(int) bool) bool {
+	if m.err != nil {
+		return false
+	}
+
+	m.steps++
+	if m.budget.MaxSteps > 0 && m.steps > m.budget.MaxSteps {
+		m.err = ErrBudgetExceeded
+		return false
+	}
+	if m.steps%4096 == 0 && !m.deadline.IsZero() && time.Now().After(m.deadline) {
+		m.err = ErrBudgetExceeded
+		return false
+	}
+
+	m.depth++
+	defer func() { m.depth-- }()
+	if m.budget.MaxDepth > 0 && m.depth > m.budget.MaxDepth {
+		m.err = ErrBudgetExceeded
+		return false
+	}
+
+	switch n.op {
+	case opEmpty:
+		return k(pos)
+
+	case opLiteral:
+		for _, r := range n.runes {
+			c, size := m.runeAt(pos)
+			if size == 0 || !(c == r || (n.fold && equalFold(c, r))) {
+				return false
+			}
+			pos += size
+		}
+		return k(pos)
+
+	case opAnyChar:
+		c, size := m.runeAt(pos)
+		if size == 0 || (c == '\n' && !n.dotNL) {
+			return false
+		}
+		return k(pos + size)
+
+	case opClass:
+		c, size := m.runeAt(pos)
+		if size == 0 || !n.class.matches(c, n.fold) {
+			return false
+		}
+		return k(pos + size)
+
+	case opBeginLine:
+		return (pos == 0 || m.input[pos-1] == '\n') && k(pos)
+	case opEndLine:
+		return (pos == len(m.input) || m.input[pos] == '\n') && k(pos)
+	case opBeginText:
+		return pos == 0 && k(pos)
+	case opEndText:
+		return pos == len(m.input) && k(pos)
+	case opWordBoundary:
+		return m.isWordBoundary(pos) && k(pos)
+	case opNoWordBoundary:
+		return !m.isWordBoundary(pos) && k(pos)
+
+	case opConcat:
+		return m.matchConcat(n.subs, pos, k)
+
+	case opAlternate:
+		for _, sub := range n.subs {
+			if m.match(sub, pos, k) {
+				return true
+			}
+			if m.err != nil {
+				return false
+			}
+		}
+		return false
+
+	case opCapture:
+		return m.match(n.subs[0], pos, k)
+
+	case opRepeat:
+		return m.matchRepeat(n, pos, 0, k)
+
+	case opLookahead:
+		matched := m.match(n.subs[0], pos, func(int) bool { return true })
+		if m.err != nil {
+			return false
+		}
+		return matched != n.negate && k(pos)
+
+	case opLookbehind:
+		matched := m.matchBehind(n, pos)
+		if m.err != nil {
+			return false
+		}
+		return matched != n.negate && k(pos)
+	}
+
+	return false
+}
+
+func (m *machine) matchConcat(subs []*node, pos int, k func(int) bool) bool {
+	if len(subs) == 0 {
+		return k(pos)
+	}
+
+	return m.match(subs[0], pos, func(p int) bool {
+		return m.matchConcat(subs[1:], p, k)
+	})
+}
+
+// matchRepeat matches the remaining iterations of a repetition of which count iterations have
+// already matched. Iterations past the minimum must consume input, which guarantees termination.
+func (m *machine) matchRepeat(n *node, pos, count int, k func(int) bool) bool {
+	if count < n.min {
+		return m.match(n.subs[0], pos, func(p int) bool {
+			return m.matchRepeat(n, p, count+1, k)
+		})
+	}
+	if n.max >= 0 && count >= n.max {
+		return k(pos)
+	}
+
+	iterate := func() bool {
+		return m.match(n.subs[0], pos, func(p int) bool {
+			return p != pos && m.matchRepeat(n, p, count+1, k)
+		})
+	}
+
+	if n.greedy {
+		return iterate() || (m.err == nil && k(pos))
+	}
+	return k(pos) || (m.err == nil && iterate())
+}
+
+// matchBehind reports whether the subexpression of the lookbehind node n matches text ending at
+// pos. Candidate start positions are tried from nearest to farthest.
+func (m *machine) matchBehind(n *node, pos int) bool {
+	for start, width := pos, 0; ; width++ {
+		if m.match(n.subs[0], start, func(p int) bool { return p == pos }) {
+			return true
+		}
+		if m.err != nil || start == 0 || (n.width >= 0 && width >= n.width) {
+			return false
+		}
+
+		_, size := utf8.DecodeLastRune(m.input[:start])
+		start -= size
+	}
+}
+
+// runeAt decodes the rune at pos. The returned size is zero at the end of the input.
+func (m *machine) runeAt(pos int) (rune, int) {
+	if pos >= len(m.input) {
+		return 0, 0
+	}
+	if c := m.input[pos]; c < utf8.RuneSelf {
+		return rune(c), 1
+	}
+	return utf8.DecodeRune(m.input[pos:])
+}
+
+func (m *machine) isWordBoundary(pos int) bool {
+	before := pos > 0 && isWordRune(rune(m.input[pos-1]))
+	after := pos < len(m.input) && isWordRune(rune(m.input[pos]))
+	return before != after
+}
+
+// equalFold reports whether a and b are equal under simple Unicode case folding.
+func equalFold(a, b rune) bool {
+	for f := unicode.SimpleFold(a); f != a; f = unicode.SimpleFold(f) {
+		if f == b {
+			return true
+		}
+	}
+	return false
+}
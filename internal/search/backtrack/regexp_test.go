@@ -0,0 +1,133 @@
+package backtrack
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFindAllIndexMatchesRegexp(t *testing.T) {
+	input := "foo bar baz\nfunc Foo(x int) error {\n\treturn nil\n}\nαβγ FOO_bar 123-456\n"
+
+	// Expressions without lookaround must behave exactly as the regexp package.
+	exprs := []string{
+		`foo`,
+		`(?i)foo`,
+		`ba.`,
+		`b(a|o)r|baz`,
+		`\w+`,
+		`\bFoo\b`,
+		`\d{3}-\d{2,}`,
+		`x*`,
+		`[^a-z\s]+`,
+		`(?m)^\w+`,
+		`(?m)\w+$`,
+		`^foo`,
+		`\}\n$`,
+		`(?s)func.*nil`,
+		`func.*?\(`,
+		`[[:upper:]]+`,
+		`\p{Greek}+`,
+		`a??b`,
+		`(?:x|y)+?`,
+		`\Qa.b\E|\x{3b2}`,
+		`(?i)αΒγ`,
+	}
+
+	for _, expr := range exprs {
+		expected := regexp.MustCompile(expr).FindAllIndex([]byte(input), -1)
+		actual, err := MustCompile(expr).FindAllIndex([]byte(input), -1)
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %s", expr, err)
+		}
+		if diff := cmp.Diff(expected, actual); diff != "" {
+			t.Errorf("unexpected matches for %q (-want +got):\n%s", expr, diff)
+		}
+	}
+}
+
+func TestFindAllIndexLookaround(t *testing.T) {
+	input := "price: $30, cost: 40 USD, total: $100\nfoobar foobaz barfoo\n"
+
+	testCases := []struct {
+		expr     string
+		expected []string
+	}{
+		{expr: `\d+(?= USD)`, expected: []string{"40"}},
+		{expr: `(?<=\$)\d+`, expected: []string{"30", "100"}},
+		{expr: `(?<!\$)\b\d+`, expected: []string{"40"}},
+		{expr: `foo(?!bar)\w*`, expected: []string{"foobaz", "foo"}},
+		{expr: `(?<=bar)foo`, expected: []string{"foo"}},
+		{expr: `(?<=\w+ )foo\w+`, expected: []string{"foobaz"}},
+		{expr: `(?i)(?<=TOTAL: )\$\d+`, expected: []string{"$100"}},
+		{expr: `(?m)^(?=foo)\w+`, expected: []string{"foobar"}},
+		{expr: `\b(?!foo)\w+(?=\s)`, expected: []string{"40", "100", "barfoo"}},
+	}
+
+	for _, testCase := range testCases {
+		locs, err := MustCompile(testCase.expr).FindAllIndex([]byte(input), -1)
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %s", testCase.expr, err)
+		}
+
+		var matches []string
+		for _, loc := range locs {
+			matches = append(matches, input[loc[0]:loc[1]])
+		}
+		if diff := cmp.Diff(testCase.expected, matches); diff != "" {
+			t.Errorf("unexpected matches for %q (-want +got):\n%s", testCase.expr, diff)
+		}
+	}
+}
+
+func TestFindAllIndexBudget(t *testing.T) {
+	input := []byte("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaa!")
+
+	re, err := CompileWithBudget(`(a|aa)+(?=b)`, Budget{MaxSteps: 100000})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := re.FindAllIndex(input, -1); err != ErrBudgetExceeded {
+		t.Errorf("unexpected error. want=%q have=%q", ErrBudgetExceeded, err)
+	}
+
+	re, err = CompileWithBudget(`.+(?=!)`, Budget{MaxDepth: 10})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := re.FindAllIndex(input, -1); err != ErrBudgetExceeded {
+		t.Errorf("unexpected error. want=%q have=%q", ErrBudgetExceeded, err)
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	for _, expr := range []string{`(?=foo`, `foo)`, `[a-`, `*a`, `a{2,1}`, `(\w)\1`, `\q`, `(?<=a`} {
+		if _, err := Compile(expr); err == nil {
+			t.Errorf("expected error compiling %q", expr)
+		}
+	}
+}
+
+func TestHasLookaround(t *testing.T) {
+	testCases := map[string]bool{
+		`foo(?=bar)`:    true,
+		`(?!foo)bar`:    true,
+		`(?<=foo)bar`:   true,
+		`(?<!foo)bar`:   true,
+		`(?P<x>foo)`:    false,
+		`(?<x>foo)`:     false,
+		`(?i:foo)`:      false,
+		`\(?=foo`:       false,
+		`[(?=]foo`:      false,
+		`\Q(?=\E`:       false,
+		`[\]](?!x)`:     true,
+		`foo|bar(?!\w)`: true,
+	}
+
+	for expr, expected := range testCases {
+		if actual := HasLookaround(expr); actual != expected {
+			t.Errorf("unexpected result for %q. want=%v have=%v", expr, expected, actual)
+		}
+	}
+}
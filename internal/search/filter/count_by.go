@@ -0,0 +1,26 @@
+package filter
+
+import "fmt"
+
+// CountBy is the property by which the count-by aggregation groups results.
+type CountBy string
+
+const (
+	CountByRepo   CountBy = "repo"
+	CountByFile   CountBy = "file"
+	CountByAuthor CountBy = "author"
+)
+
+var validCountBys = map[CountBy]struct{}{
+	CountByRepo:   empty,
+	CountByFile:   empty,
+	CountByAuthor: empty,
+}
+
+// CountByFromString parses and validates a count-by value.
+func CountByFromString(s string) (CountBy, error) {
+	if _, ok := validCountBys[CountBy(s)]; !ok {
+		return "", fmt.Errorf("invalid count-by value '%s' (valid values are: repo, file, author)", s)
+	}
+	return CountBy(s), nil
+}
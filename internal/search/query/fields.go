@@ -15,6 +15,7 @@ const (
 	FieldType               = "type"
 	FieldRepoHasFile        = "repohasfile"
 	FieldRepoHasCommitAfter = "repohascommitafter"
+	FieldRepoTopic          = "repo.topic"
 	FieldPatternType        = "patterntype"
 	FieldContent            = "content"
 	FieldVisibility         = "visibility"
@@ -27,14 +28,19 @@ const (
 	FieldAuthor    = "author"
 	FieldCommitter = "committer"
 	FieldMessage   = "message"
+	FieldBranch    = "branch" // Selects the branches to search, as a regexp or * for all branches.
 
 	// Temporary experimental fields:
-	FieldIndex     = "index"
-	FieldCount     = "count"  // Searches that specify `count:` will fetch at least that number of results, or the full result set
-	FieldStable    = "stable" // Forces search to return a stable result ordering (currently limited to file content matches).
-	FieldTimeout   = "timeout"
-	FieldCombyRule = "rule"
-	FieldSelect    = "select"
+	FieldIndex       = "index"
+	FieldCount       = "count"  // Searches that specify `count:` will fetch at least that number of results, or the full result set
+	FieldStable      = "stable" // Forces search to return a stable result ordering (currently limited to file content matches).
+	FieldRank        = "rank"   // Ranks results by repository stars, recent commit activity, and path depth.
+	FieldTimeout     = "timeout"
+	FieldRepoTimeout = "repotimeout" // Bounds the time spent searching each repository revision.
+	FieldCombyRule   = "rule"
+	FieldReplace     = "replace" // Rewrites structural search matches, for a preview of the rewrite.
+	FieldSelect      = "select"
+	FieldCountBy     = "count-by" // Aggregates results into counts grouped by repo, file, or author.
 )
 
 var allFields = map[string]struct{}{
@@ -57,6 +63,7 @@ var allFields = map[string]struct{}{
 	FieldVisibility:         empty,
 	FieldRepoHasFile:        empty,
 	FieldRepoHasCommitAfter: empty,
+	FieldRepoTopic:          empty,
 	FieldBefore:             empty,
 	"until":                 empty,
 	FieldAfter:              empty,
@@ -64,16 +71,21 @@ var allFields = map[string]struct{}{
 	FieldAuthor:             empty,
 	FieldCommitter:          empty,
 	FieldMessage:            empty,
+	FieldBranch:             empty,
 	"m":                     empty,
 	"msg":                   empty,
 	FieldIndex:              empty,
 	FieldCount:              empty,
 	FieldStable:             empty,
+	FieldRank:               empty,
 	FieldTimeout:            empty,
+	FieldRepoTimeout:        empty,
 	FieldCombyRule:          empty,
+	FieldReplace:            empty,
 	FieldRev:                empty,
 	"revision":              empty,
 	FieldSelect:             empty,
+	FieldCountBy:            empty,
 }
 
 var aliases = map[string]string{
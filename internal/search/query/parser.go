@@ -574,7 +574,8 @@ loop:
 }
 
 // ScanField scans an optional '-' at the beginning of a string, and then scans
-// one or more alphabetic characters until it encounters a ':'. The prefix
+// one or more alphabetic characters, possibly separated by single '-' or '.'
+// characters (e.g., "count-by" or "repo.topic"), until it encounters a ':'. The prefix
 // string is checked against valid fields. If it is valid, the function returns
 // the value before the colon, whether it's negated, and its length. In all
 // other cases it returns zero values.
@@ -604,6 +605,10 @@ func ScanField(buf []byte) (string, bool, int) {
 			result = append(result, r)
 			continue
 		}
+		if (r == '-' || r == '.') && strings.ContainsRune(allowed, result[len(result)-1]) {
+			result = append(result, r)
+			continue
+		}
 		if r == ':' {
 			// Invariant: len(result) > 0. If len(result) == 1,
 			// check that it is not just a '-'. If len(result) > 1, it is valid.
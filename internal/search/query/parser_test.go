@@ -262,6 +262,13 @@ func TestScanField(t *testing.T) {
 	autogold.Want("-repo", `{"Field":"","Negated":false,"Advance":0}`).Equal(t, test("-repo"))
 	autogold.Want("--repo:", `{"Field":"","Negated":false,"Advance":0}`).Equal(t, test("--repo:"))
 	autogold.Want(":foo", `{"Field":"","Negated":false,"Advance":0}`).Equal(t, test(":foo"))
+	autogold.Want("count-by:repo", `{"Field":"count-by","Negated":false,"Advance":9}`).Equal(t, test("count-by:repo"))
+	autogold.Want("count--by:repo", `{"Field":"","Negated":false,"Advance":0}`).Equal(t, test("count--by:repo"))
+	autogold.Want("repo-:foo", `{"Field":"","Negated":false,"Advance":0}`).Equal(t, test("repo-:foo"))
+	autogold.Want("repo.topic:go", `{"Field":"repo.topic","Negated":false,"Advance":11}`).Equal(t, test("repo.topic:go"))
+	autogold.Want("-repo.topic:go", `{"Field":"repo.topic","Negated":true,"Advance":12}`).Equal(t, test("-repo.topic:go"))
+	autogold.Want("repo..topic:go", `{"Field":"","Negated":false,"Advance":0}`).Equal(t, test("repo..topic:go"))
+	autogold.Want("github.com:foo", `{"Field":"","Negated":false,"Advance":0}`).Equal(t, test("github.com:foo"))
 }
 
 func parseAndOrGrammar(in string) ([]Node, error) {
@@ -31,6 +31,11 @@ var DefaultPredicateRegistry = predicateRegistry{
 		"contains.file":         func() Predicate { return &RepoContainsFilePredicate{} },
 		"contains.content":      func() Predicate { return &RepoContainsContentPredicate{} },
 		"contains.commit.after": func() Predicate { return &RepoContainsCommitAfterPredicate{} },
+		"has.file":              func() Predicate { return &RepoHasFilePredicate{} },
+		"has.content":           func() Predicate { return &RepoHasContentPredicate{} },
+	},
+	FieldFile: {
+		"contains": func() Predicate { return &FileContainsPredicate{} },
 	},
 }
 
@@ -168,15 +173,9 @@ type RepoContainsContentPredicate struct {
 	Pattern string
 }
 
-func (f *RepoContainsContentPredicate) ParseParams(params string) error {
-	if _, err := regexp.Compile(params); err != nil {
-		return fmt.Errorf("contains.content argument: %w", err)
-	}
-	if params == "" {
-		return fmt.Errorf("contains.content argument should not be empty")
-	}
-	f.Pattern = params
-	return nil
+func (f *RepoContainsContentPredicate) ParseParams(params string) (err error) {
+	f.Pattern, err = parseRegexpParam(f.Name(), params)
+	return err
 }
 
 func (f *RepoContainsContentPredicate) Field() string { return FieldRepo }
@@ -192,15 +191,9 @@ type RepoContainsFilePredicate struct {
 	Pattern string
 }
 
-func (f *RepoContainsFilePredicate) ParseParams(params string) error {
-	if _, err := regexp.Compile(params); err != nil {
-		return fmt.Errorf("contains.file argument: %w", err)
-	}
-	if params == "" {
-		return fmt.Errorf("contains.file argument should not be empty")
-	}
-	f.Pattern = params
-	return nil
+func (f *RepoContainsFilePredicate) ParseParams(params string) (err error) {
+	f.Pattern, err = parseRegexpParam(f.Name(), params)
+	return err
 }
 
 func (f *RepoContainsFilePredicate) Field() string { return FieldRepo }
@@ -210,6 +203,84 @@ func (f *RepoContainsFilePredicate) Plan(parent Basic) (Plan, error) {
 	return contains.Plan(parent)
 }
 
+/* repo:has.file(pattern) */
+
+// RepoHasFilePredicate represents the `repo:has.file()` predicate, which
+// filters to repos that contain a file path matching a regular expression. It
+// is evaluated by an inner search for matching files before the outer query
+// runs, so it can be combined with other repo predicates such as
+// `repo:has.content()` to require both.
+type RepoHasFilePredicate struct {
+	Pattern string
+}
+
+func (f *RepoHasFilePredicate) ParseParams(params string) (err error) {
+	f.Pattern, err = parseRegexpParam(f.Name(), params)
+	return err
+}
+
+func (f *RepoHasFilePredicate) Field() string { return FieldRepo }
+func (f *RepoHasFilePredicate) Name() string  { return "has.file" }
+func (f *RepoHasFilePredicate) Plan(parent Basic) (Plan, error) {
+	contains := RepoContainsPredicate{File: f.Pattern}
+	return contains.Plan(parent)
+}
+
+/* repo:has.content(pattern) */
+
+// RepoHasContentPredicate represents the `repo:has.content()` predicate,
+// which filters to repos that contain file content matching a regular
+// expression.
+type RepoHasContentPredicate struct {
+	Pattern string
+}
+
+func (f *RepoHasContentPredicate) ParseParams(params string) (err error) {
+	f.Pattern, err = parseRegexpParam(f.Name(), params)
+	return err
+}
+
+func (f *RepoHasContentPredicate) Field() string { return FieldRepo }
+func (f *RepoHasContentPredicate) Name() string  { return "has.content" }
+func (f *RepoHasContentPredicate) Plan(parent Basic) (Plan, error) {
+	contains := RepoContainsPredicate{Content: f.Pattern}
+	return contains.Plan(parent)
+}
+
+// parseRegexpParam validates the argument of a predicate that takes a single
+// non-empty regular expression.
+func parseRegexpParam(name, params string) (string, error) {
+	if _, err := regexp.Compile(params); err != nil {
+		return "", fmt.Errorf("%s argument: %w", name, err)
+	}
+	if params == "" {
+		return "", fmt.Errorf("%s argument should not be empty", name)
+	}
+	return params, nil
+}
+
+/* file:contains(pattern) */
+
+// FileContainsPredicate represents the `file:contains()` predicate, which
+// filters to files whose content matches a regular expression. Unlike repo
+// predicates, it is not evaluated by a subquery: the search backends
+// intersect the files they return with the files containing the pattern
+// (see search.TextPatternInfo.IncludeContentPatterns).
+type FileContainsPredicate struct {
+	Pattern string
+}
+
+func (f *FileContainsPredicate) ParseParams(params string) (err error) {
+	f.Pattern, err = parseRegexpParam(f.Name(), params)
+	return err
+}
+
+func (f *FileContainsPredicate) Field() string { return FieldFile }
+func (f *FileContainsPredicate) Name() string  { return "contains" }
+func (f *FileContainsPredicate) Plan(parent Basic) (Plan, error) {
+	return nil, errors.New("file:contains() is evaluated by the search backends and has no plan")
+}
+
 /* repo:contains.commit.after(...) */
 
 type RepoContainsCommitAfterPredicate struct {
@@ -256,6 +327,7 @@ func nonPredicateRepos(q Basic) []Node {
 			FieldIndex,
 			FieldFork,
 			FieldArchived,
+			FieldRepoTopic,
 			FieldVisibility,
 			FieldCase:
 			res = append(res, Parameter{
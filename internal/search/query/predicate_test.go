@@ -3,6 +3,8 @@ package query
 import (
 	"reflect"
 	"testing"
+
+	"github.com/hexops/autogold"
 )
 
 func TestRepoContainsPredicate(t *testing.T) {
@@ -79,3 +81,71 @@ func TestParseAsPredicate(t *testing.T) {
 	}
 
 }
+
+func TestRepoHasPredicates(t *testing.T) {
+	t.Run("ParseParams", func(t *testing.T) {
+		for _, p := range []Predicate{&RepoHasFilePredicate{}, &RepoHasContentPredicate{}} {
+			if err := p.ParseParams(`log4j\.xml`); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if err := p.ParseParams(``); err == nil {
+				t.Fatalf("%s: expected error for empty argument but got none", p.Name())
+			}
+			if err := p.ParseParams(`(`); err == nil {
+				t.Fatalf("%s: expected error for invalid regexp but got none", p.Name())
+			}
+		}
+	})
+
+	t.Run("Plan", func(t *testing.T) {
+		test := func(input string, predicate Predicate) string {
+			plan, err := Pipeline(InitLiteral(input))
+			if err != nil {
+				t.Fatal(err)
+			}
+			inner, err := predicate.Plan(plan[0])
+			if err != nil {
+				t.Fatal(err)
+			}
+			return inner.ToParseTree().String()
+		}
+
+		autogold.Want("has.file", `(and "select:repo" "count:99999" "file:Dockerfile" "repo:^github\\.com/foo/")`).
+			Equal(t, test(`repo:has.file(Dockerfile) repo:^github\.com/foo/ log4j`, &RepoHasFilePredicate{Pattern: "Dockerfile"}))
+		autogold.Want("has.content", `(and "select:repo" "count:99999" "repo:^github\\.com/foo/" "log4j")`).
+			Equal(t, test(`repo:has.content(log4j) repo:^github\.com/foo/ Dockerfile`, &RepoHasContentPredicate{Pattern: "log4j"}))
+	})
+}
+
+func TestFileContainsPredicate(t *testing.T) {
+	t.Run("ParseParams", func(t *testing.T) {
+		p := &FileContainsPredicate{}
+		if err := p.ParseParams(`import "net/http"`); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if p.Pattern != `import "net/http"` {
+			t.Fatalf("unexpected pattern %q", p.Pattern)
+		}
+		if err := p.ParseParams(``); err == nil {
+			t.Fatal("expected error for empty argument but got none")
+		}
+		if err := p.ParseParams(`(`); err == nil {
+			t.Fatal("expected error for invalid regexp but got none")
+		}
+	})
+
+	t.Run("Parse", func(t *testing.T) {
+		plan, err := Pipeline(InitRegexp(`file:contains(import "net/http") file:\.go$ http\.Get`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var predicates []string
+		VisitParameter(plan[0].ToParseTree(), func(field, value string, _ bool, ann Annotation) {
+			if ann.Labels.IsSet(IsPredicate) {
+				predicates = append(predicates, field+":"+value)
+			}
+		})
+		autogold.Want("file:contains", []string{`file:contains(import "net/http")`}).Equal(t, predicates)
+	})
+}
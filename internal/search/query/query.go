@@ -143,5 +143,6 @@ func Pipeline(steps ...step) (Plan, error) {
 		return nil, err
 	}
 	plan = MapPlan(plan, ConcatRevFilters)
+	plan = MapPlan(plan, FoldNegatedPatterns)
 	return plan, nil
 }
@@ -252,6 +252,9 @@ func Globbing(nodes []Node) ([]Node, error) {
 	var globErrors []globError
 
 	nodes = MapParameter(nodes, func(field, value string, negated bool, annotation Annotation) Node {
+		if annotation.Labels.IsSet(IsPredicate) {
+			return Parameter{Field: field, Value: value, Negated: negated, Annotation: annotation}
+		}
 		var err error
 		switch field {
 		case FieldRepo:
@@ -670,6 +673,61 @@ func ConcatRevFilters(b Basic) Basic {
 	return Basic{Parameters: toParameters(modified), Pattern: b.Pattern}
 }
 
+// FoldNegatedPatterns moves negated patterns that are ANDed with non-negated
+// patterns into -content: parameters. A query like "foo -content:bar" (or "foo
+// NOT bar") is then evaluated as a single search for files that match foo and
+// do not contain bar, rather than as an intersection of two searches that are
+// each limited in the number of results. Queries searching commits or diffs
+// and structural queries are left as-is, since their backends do not support
+// -content:.
+func FoldNegatedPatterns(b Basic) Basic {
+	operator, ok := b.Pattern.(Operator)
+	if !ok || operator.Kind != And {
+		return b
+	}
+
+	searchesCommits := false
+	b.VisitParameter(FieldType, func(value string, _ bool, _ Annotation) {
+		if value == "commit" || value == "diff" {
+			searchesCommits = true
+		}
+	})
+	if searchesCommits {
+		return b
+	}
+
+	var positive []Node
+	var negated []Parameter
+	for _, operand := range operator.Operands {
+		pattern, ok := operand.(Pattern)
+		if !ok {
+			// Nested expressions are not folded.
+			return b
+		}
+		if pattern.Annotation.Labels.IsSet(Structural) {
+			// Structural search does not support -content:.
+			return b
+		}
+		if pattern.Negated {
+			negated = append(negated, Parameter{Field: FieldContent, Value: pattern.Value, Negated: true, Annotation: pattern.Annotation})
+		} else {
+			positive = append(positive, pattern)
+		}
+	}
+	if len(positive) == 0 || len(negated) == 0 {
+		return b
+	}
+
+	parameters := make([]Parameter, 0, len(b.Parameters)+len(negated))
+	parameters = append(parameters, b.Parameters...)
+	parameters = append(parameters, negated...)
+
+	if len(positive) == 1 {
+		return Basic{Parameters: parameters, Pattern: positive[0]}
+	}
+	return Basic{Parameters: parameters, Pattern: Operator{Kind: And, Operands: positive}}
+}
+
 // labelStructural converts Literal labels to Structural labels. Structural
 // queries are parsed the same as literal queries, we just convert the labels as
 // a postprocessing step to keep the parser lean.
@@ -951,6 +951,49 @@ func TestConcatRevFiltersTopLevelAnd(t *testing.T) {
 	}
 }
 
+func TestFoldNegatedPatterns(t *testing.T) {
+	cases := []struct {
+		input string
+		want  string
+	}{
+		{
+			input: "foo -content:bar",
+			want:  `(and "-content:bar" "foo")`,
+		},
+		{
+			input: "foo NOT bar",
+			want:  `(and "-content:bar" "foo")`,
+		},
+		{
+			input: "repo:x foo bar -content:baz -content:qux",
+			want:  `(and "repo:x" "-content:baz" "-content:qux" "foo" "bar")`,
+		},
+		{
+			input: "-content:bar",
+			want:  `(not "bar")`,
+		},
+		{
+			input: "foo or bar",
+			want:  `(or "foo" "bar")`,
+		},
+		{
+			input: "type:diff foo -content:bar",
+			want:  `(and "type:diff" (not "bar") "foo")`,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.input, func(t *testing.T) {
+			query, _ := Parse(c.input, SearchTypeRegex)
+			query = SubstituteAliases(SearchTypeRegex)(query)
+			plan, _ := ToPlan(Dnf(query))
+			p := MapPlan(plan, FoldNegatedPatterns)
+			if diff := cmp.Diff(c.want, toString(p.ToParseTree())); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
 func TestQueryField(t *testing.T) {
 	test := func(input, field string) string {
 		q, _ := ParseLiteral(input)
@@ -5,6 +5,8 @@ import (
 	"regexp"
 	"strconv"
 	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/search/backtrack"
 )
 
 type ExpectedOperand struct {
@@ -294,6 +296,17 @@ func (q Q) Count() *int {
 	return count
 }
 
+// Rank returns the value of the rank: field, which toggles the ranking of
+// results by repository activity, or nil if it is not set.
+func (q Q) Rank() *bool {
+	var rank *bool
+	VisitField(q, FieldRank, func(value string, _ bool, _ Annotation) {
+		r, _ := parseBool(value) // err was checked during parsing and validation.
+		rank = &r
+	})
+	return rank
+}
+
 func (q Q) Archived() *YesNoOnly {
 	return q.yesNoOnlyValue(FieldArchived)
 }
@@ -326,10 +339,35 @@ func (q Q) Timeout() *time.Duration {
 	return timeout
 }
 
+// RepoTimeout returns the value of the repotimeout: field, which bounds the
+// time spent searching each repository revision, or nil if it is not set.
+func (q Q) RepoTimeout() *time.Duration {
+	var timeout *time.Duration
+	VisitField(q, FieldRepoTimeout, func(value string, _ bool, _ Annotation) {
+		t, err := time.ParseDuration(value)
+		if err != nil {
+			panic(fmt.Sprintf("Value %q for repotimeout cannot be parsed as an duration: %s", value, err))
+		}
+		timeout = &t
+	})
+	return timeout
+}
+
 func (q Q) IsCaseSensitive() bool {
 	return q.BoolValue("case")
 }
 
+// ContainsLookaround returns true if any regular expression search pattern of
+// the query contains a lookahead or lookbehind assertion. Such patterns cannot
+// be evaluated by zoekt and must be searched by the backtracking matcher of
+// searcher.
+func (q Q) ContainsLookaround() bool {
+	return exists(q, func(node Node) bool {
+		pattern, ok := node.(Pattern)
+		return ok && pattern.Annotation.Labels.IsSet(Regexp) && backtrack.HasLookaround(pattern.Value)
+	})
+}
+
 func (q Q) Repositories() (repos []string, negatedRepos []string) {
 	VisitField(q, FieldRepo, func(value string, negated bool, _ Annotation) {
 		if negated {
@@ -341,6 +379,22 @@ func (q Q) Repositories() (repos []string, negatedRepos []string) {
 	return repos, negatedRepos
 }
 
+// BranchAll is the value of the branch: field that selects all branches.
+const BranchAll = "*"
+
+// Branches returns the values of the branch: field, which are regular
+// expressions matching branch names or BranchAll.
+func (q Q) Branches() (branches []string, negatedBranches []string) {
+	VisitField(q, FieldBranch, func(value string, negated bool, _ Annotation) {
+		if negated {
+			negatedBranches = append(negatedBranches, value)
+			return
+		}
+		branches = append(branches, value)
+	})
+	return branches, negatedBranches
+}
+
 func parseRegexpOrPanic(field, value string) *regexp.Regexp {
 	r, err := regexp.Compile(value)
 	if err != nil {
@@ -416,7 +470,9 @@ func (q Q) valueToTypedValue(field, value string, label labels) []*Value {
 		FieldIndex,
 		FieldCount,
 		FieldTimeout,
-		FieldCombyRule:
+		FieldRepoTimeout,
+		FieldCombyRule,
+		FieldReplace:
 		return []*Value{{String: &value}}
 	}
 	return []*Value{{String: &value}}
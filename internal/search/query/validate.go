@@ -10,6 +10,7 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/go-enry/go-enry/v2"
 
+	"github.com/sourcegraph/sourcegraph/internal/search/backtrack"
 	"github.com/sourcegraph/sourcegraph/internal/search/filter"
 )
 
@@ -236,7 +237,7 @@ func validateField(field, value string, negated bool, seen map[string]struct{})
 	isDuration := func() error {
 		_, err := time.ParseDuration(value)
 		if err != nil {
-			return errors.New(`invalid value for field 'timeout' (examples: "timeout:2s", "timeout:200ms")`)
+			return fmt.Errorf(`invalid value for field '%[1]s' (examples: "%[1]s:2s", "%[1]s:200ms")`, field)
 		}
 		return nil
 	}
@@ -261,11 +262,23 @@ func validateField(field, value string, negated bool, seen map[string]struct{})
 		return fmt.Errorf("unrecognized field %q", field)
 	}
 
+	isValidBranch := func() error {
+		if value == BranchAll {
+			return nil
+		}
+		return isValidRegexp()
+	}
+
 	isValidSelect := func() error {
 		_, err := filter.SelectPathFromString(value)
 		return err
 	}
 
+	isValidCountBy := func() error {
+		_, err := filter.CountByFromString(value)
+		return err
+	}
+
 	satisfies := func(fns ...func() error) error {
 		for _, fn := range fns {
 			if err := fn(); err != nil {
@@ -309,6 +322,9 @@ func validateField(field, value string, negated bool, seen map[string]struct{})
 	case
 		FieldRepoHasCommitAfter:
 		return satisfies(isSingular, isNotNegated)
+	case
+		FieldRepoTopic:
+		return nil
 	case
 		FieldBefore,
 		FieldAfter:
@@ -327,13 +343,16 @@ func validateField(field, value string, negated bool, seen map[string]struct{})
 		FieldCount:
 		return satisfies(isSingular, isNumber, isNotNegated)
 	case
-		FieldStable:
+		FieldStable,
+		FieldRank:
 		return satisfies(isSingular, isBoolean, isNotNegated)
 	case
-		FieldCombyRule:
+		FieldCombyRule,
+		FieldReplace:
 		return satisfies(isSingular, isNotNegated)
 	case
-		FieldTimeout:
+		FieldTimeout,
+		FieldRepoTimeout:
 		return satisfies(isSingular, isNotNegated, isDuration)
 	case
 		FieldRev:
@@ -341,6 +360,12 @@ func validateField(field, value string, negated bool, seen map[string]struct{})
 	case
 		FieldSelect:
 		return satisfies(isSingular, isNotNegated, isValidSelect)
+	case
+		FieldCountBy:
+		return satisfies(isSingular, isNotNegated, isValidCountBy)
+	case
+		FieldBranch:
+		return satisfies(isValidBranch)
 	default:
 		return isUnrecognizedField()
 	}
@@ -369,21 +394,73 @@ func validateRepoRevPair(nodes []Node) error {
 	return nil
 }
 
+// validateBranch validates that branch: is not combined with other ways of
+// specifying the revisions to search.
+func validateBranch(nodes []Node) error {
+	var seenBranch, seenRev bool
+	VisitParameter(nodes, func(field, value string, negated bool, _ Annotation) {
+		switch field {
+		case FieldBranch:
+			seenBranch = true
+		case FieldRev:
+			seenRev = true
+		case FieldRepo:
+			if !negated && strings.ContainsRune(value, '@') {
+				seenRev = true
+			}
+		}
+	})
+	if seenBranch && seenRev {
+		return errors.New("invalid syntax. You specified both branch: and a revision with @ or rev:. Remove either and try again")
+	}
+	return nil
+}
+
 // Queries containing commit parameters without type:diff or type:commit are not
-// valid. cf. https://docs.sourcegraph.com/code_search/reference/language#commit-parameter
+// valid. The author:, before:, and after: parameters are also valid with
+// type:file, where they filter matched lines by their git blame. cf.
+// https://docs.sourcegraph.com/code_search/reference/language#commit-parameter
 func validateCommitParameters(nodes []Node) error {
-	var seenCommitParam string
-	var typeCommitExists bool
+	var seenCommitParam, seenBlameParam string
+	var typeCommitExists, typeFileExists bool
 	VisitParameter(nodes, func(field, value string, _ bool, _ Annotation) {
-		if field == FieldAuthor || field == FieldBefore || field == FieldAfter || field == FieldMessage {
+		switch field {
+		case FieldMessage, FieldBranch:
 			seenCommitParam = field
+		case FieldAuthor, FieldBefore, FieldAfter:
+			seenBlameParam = field
+		case FieldType:
+			if value == "commit" || value == "diff" {
+				typeCommitExists = true
+			}
+			if value == "file" {
+				typeFileExists = true
+			}
+		}
+	})
+	if seenCommitParam != "" && !typeCommitExists {
+		return fmt.Errorf(`your query contains the field '%s', which requires type:commit or type:diff in the query`, seenCommitParam)
+	}
+	if seenBlameParam != "" && !typeCommitExists && !typeFileExists {
+		return fmt.Errorf(`your query contains the field '%s', which requires type:commit, type:diff, or type:file in the query`, seenBlameParam)
+	}
+	return nil
+}
+
+// validateCountBy validates that count-by:author is only used with results
+// that have an author.
+func validateCountBy(nodes []Node) error {
+	var countByAuthor, typeCommitExists bool
+	VisitParameter(nodes, func(field, value string, _ bool, _ Annotation) {
+		if field == FieldCountBy && value == string(filter.CountByAuthor) {
+			countByAuthor = true
 		}
 		if field == FieldType && (value == "commit" || value == "diff") {
 			typeCommitExists = true
 		}
 	})
-	if seenCommitParam != "" && !typeCommitExists {
-		return fmt.Errorf(`your query contains the field '%s', which requires type:commit or type:diff in the query`, seenCommitParam)
+	if countByAuthor && !typeCommitExists {
+		return errors.New(`your query contains 'count-by:author', which requires type:commit or type:diff in the query`)
 	}
 	return nil
 }
@@ -412,6 +489,47 @@ func validateTypeStructural(nodes []Node) error {
 	return nil
 }
 
+// holeNameRegexp matches the holes of a structural search pattern or rewrite
+// template. Submatch 1 is the name of the hole.
+var holeNameRegexp = regexp.MustCompile(`:\[\[?[ ]*(\w+)`)
+
+// validateReplace validates that replace: is used with a structural search
+// pattern, and that the holes of the rewrite template are bound by the
+// pattern. An unbound hole would be kept verbatim in the rewritten code.
+func validateReplace(nodes []Node) error {
+	var rewrite string
+	var seenReplace bool
+	VisitField(nodes, FieldReplace, func(value string, _ bool, _ Annotation) {
+		rewrite = value
+		seenReplace = true
+	})
+	if !seenReplace {
+		return nil
+	}
+
+	var seenStructural bool
+	bound := map[string]struct{}{}
+	VisitPattern(nodes, func(value string, _ bool, annotation Annotation) {
+		if !annotation.Labels.IsSet(Structural) {
+			return
+		}
+		seenStructural = true
+		for _, submatches := range holeNameRegexp.FindAllStringSubmatch(value, -1) {
+			bound[submatches[1]] = struct{}{}
+		}
+	})
+	if !seenStructural {
+		return errors.New("replace: rewrites structural search matches and requires a structural search pattern. Add patterntype:structural to the query")
+	}
+
+	for _, submatches := range holeNameRegexp.FindAllStringSubmatch(rewrite, -1) {
+		if _, ok := bound[submatches[1]]; !ok {
+			return fmt.Errorf("the replace: template refers to the hole :[%s], which does not appear in the search pattern", submatches[1])
+		}
+	}
+	return nil
+}
+
 // validatePredicates validates predicate parameters with respect to their validation logic.
 func validatePredicates(nodes []Node) error {
 	var err error
@@ -496,7 +614,13 @@ func validatePattern(nodes []Node) error {
 			if err != nil {
 				return
 			}
-			_, err = regexp.Compile(value)
+			if backtrack.HasLookaround(value) {
+				// Patterns with lookaround are searched by the backtracking
+				// matcher of searcher instead of zoekt.
+				_, err = backtrack.Compile(value)
+			} else {
+				_, err = regexp.Compile(value)
+			}
 		}
 		if annotation.Labels.IsSet(Structural) && negated {
 			if err != nil {
@@ -522,10 +646,13 @@ func validate(nodes []Node) error {
 		validateParameters,
 		validatePattern,
 		validateRepoRevPair,
+		validateBranch,
 		validateRepoHasFile,
 		validateCommitParameters,
+		validateCountBy,
 		validatePredicates,
 		validateTypeStructural,
+		validateReplace,
 	)
 }
 
@@ -49,6 +49,10 @@ func TestAndOrQuery_Validation(t *testing.T) {
 			input: "count:-1",
 			want:  "field count requires a positive number",
 		},
+		{
+			input: "repotimeout:fast",
+			want:  `invalid value for field 'repotimeout' (examples: "repotimeout:2s", "repotimeout:200ms")`,
+		},
 		{
 			input: "+",
 			want:  "error parsing regexp: missing argument to repetition operator: `+`",
@@ -77,7 +81,23 @@ func TestAndOrQuery_Validation(t *testing.T) {
 		},
 		{
 			input: "repo:foo author:rob@saucegraph.com",
-			want:  `your query contains the field 'author', which requires type:commit or type:diff in the query`,
+			want:  `your query contains the field 'author', which requires type:commit, type:diff, or type:file in the query`,
+		},
+		{
+			input: "type:file repo:foo message:fix",
+			want:  `your query contains the field 'message', which requires type:commit or type:diff in the query`,
+		},
+		{
+			input: "repo:foo branch:*",
+			want:  `your query contains the field 'branch', which requires type:commit or type:diff in the query`,
+		},
+		{
+			input: "type:commit repo:foo branch:main rev:dev",
+			want:  "invalid syntax. You specified both branch: and a revision with @ or rev:. Remove either and try again",
+		},
+		{
+			input: "type:commit repo:foo@dev branch:main",
+			want:  "invalid syntax. You specified both branch: and a revision with @ or rev:. Remove either and try again",
 		},
 		{
 			input: "repohasfile:README type:symbol yolo",
@@ -95,6 +115,18 @@ func TestAndOrQuery_Validation(t *testing.T) {
 			input: "type:symbol select:symbol.timelime",
 			want:  "invalid field 'timelime' on select type 'symbol'",
 		},
+		{
+			input: "foo count-by:commit",
+			want:  "invalid count-by value 'commit' (valid values are: repo, file, author)",
+		},
+		{
+			input: "foo count-by:repo count-by:file",
+			want:  `field "count-by" may not be used more than once`,
+		},
+		{
+			input: "foo count-by:author",
+			want:  "your query contains 'count-by:author', which requires type:commit or type:diff in the query",
+		},
 		{
 			input:      "nice try type:repo",
 			want:       "this structural search query specifies `type:` and is not supported. Structural search syntax only applies to searching file contents",
@@ -105,6 +137,15 @@ func TestAndOrQuery_Validation(t *testing.T) {
 			want:       "this structural search query specifies `type:` and is not supported. Structural search syntax only applies to searching file contents and is not currently supported for diff searches",
 			searchType: SearchTypeStructural,
 		},
+		{
+			input: "foo replace:bar",
+			want:  "replace: rewrites structural search matches and requires a structural search pattern. Add patterntype:structural to the query",
+		},
+		{
+			input:      "errors.New(:[x]) replace:'fmt.Errorf(:[y])'",
+			want:       "the replace: template refers to the hole :[y], which does not appear in the search pattern",
+			searchType: SearchTypeStructural,
+		},
 	}
 	for _, c := range cases {
 		t.Run("validate and/or query", func(t *testing.T) {
@@ -408,3 +449,29 @@ func TestHasTypeRepo(t *testing.T) {
 		})
 	}
 }
+
+func TestContainsLookaround(t *testing.T) {
+	cases := []struct {
+		input      string
+		searchType SearchType
+		want       bool
+	}{
+		{input: `foo(?=bar)`, searchType: SearchTypeRegex, want: true},
+		{input: `repo:foo (?<!bar)baz`, searchType: SearchTypeRegex, want: true},
+		{input: `foo or bar(?!baz)`, searchType: SearchTypeRegex, want: true},
+		{input: `foo(bar)`, searchType: SearchTypeRegex, want: false},
+		{input: `foo(?=bar)`, searchType: SearchTypeLiteral, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.input, func(t *testing.T) {
+			query, err := ParseSearchType(c.input, c.searchType)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := query.ContainsLookaround(); got != c.want {
+				t.Errorf("got %t, expected %t", got, c.want)
+			}
+		})
+	}
+}
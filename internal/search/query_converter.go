@@ -30,9 +30,9 @@ func unionRegexp(values []string) string {
 	return "(" + strings.Join(values, ")|(") + ")"
 }
 
-// langToFileRegexp converts a lang: parameter to its corresponding file
+// LangToFileRegexp converts a lang: parameter to its corresponding file
 // patterns for file filters. The lang value must be valid, cf. validate.go
-func langToFileRegexp(lang string) string {
+func LangToFileRegexp(lang string) string {
 	lang, _ = enry.GetLanguageByAlias(lang) // Invariant: lang is valid.
 	extensions := enry.GetLanguageExtensions(lang)
 	patterns := make([]string, len(extensions))
@@ -52,7 +52,11 @@ func mapSlice(values []string, f func(string) string) []string {
 }
 
 func IncludeExcludeValues(q query.Basic, field string) (include, exclude []string) {
-	q.VisitParameter(field, func(v string, negated bool, _ query.Annotation) {
+	q.VisitParameter(field, func(v string, negated bool, annotation query.Annotation) {
+		if annotation.Labels.IsSet(query.IsPredicate) {
+			// Predicates are not values of the field (cf. file:contains()).
+			return
+		}
 		if negated {
 			exclude = append(exclude, v)
 		} else {
@@ -104,8 +108,34 @@ func ToTextPatternInfo(q query.Basic, p Protocol, transform query.BasicPass) *Te
 	filesInclude, filesExclude := IncludeExcludeValues(q, query.FieldFile)
 	// Handle lang: and -lang: filters.
 	langInclude, langExclude := IncludeExcludeValues(q, query.FieldLang)
-	filesInclude = append(filesInclude, mapSlice(langInclude, langToFileRegexp)...)
-	filesExclude = append(filesExclude, mapSlice(langExclude, langToFileRegexp)...)
+	filesInclude = append(filesInclude, mapSlice(langInclude, LangToFileRegexp)...)
+	filesExclude = append(filesExclude, mapSlice(langExclude, LangToFileRegexp)...)
+	// Handle -content: filters (cf. query.FoldNegatedPatterns).
+	var excludeContent []string
+	q.VisitParameter(query.FieldContent, func(value string, negated bool, annotation query.Annotation) {
+		if !negated {
+			return
+		}
+		if annotation.Labels.IsSet(query.Literal) {
+			value = regexp.QuoteMeta(value)
+		}
+		excludeContent = append(excludeContent, value)
+	})
+	// Handle file:contains() predicates.
+	var includeContent []string
+	q.VisitParameter(query.FieldFile, func(value string, _ bool, annotation query.Annotation) {
+		if !annotation.Labels.IsSet(query.IsPredicate) {
+			return
+		}
+		name, params := query.ParseAsPredicate(value)
+		predicate := query.DefaultPredicateRegistry.Get(query.FieldFile, name)
+		if err := predicate.ParseParams(params); err != nil {
+			return // Invariant: predicates are validated.
+		}
+		if contains, ok := predicate.(*query.FileContainsPredicate); ok {
+			includeContent = append(includeContent, contains.Pattern)
+		}
+	})
 	filesReposMustInclude, filesReposMustExclude := IncludeExcludeValues(q, query.FieldRepoHasFile)
 	selector, _ := filter.SelectPathFromString(q.FindValue(query.FieldSelect)) // Invariant: select is validated
 	count := count(q, p)
@@ -148,11 +178,14 @@ func ToTextPatternInfo(q query.Basic, p Protocol, transform query.BasicPass) *Te
 		// Values dependent on parameters.
 		IncludePatterns:              filesInclude,
 		ExcludePattern:               unionRegexp(filesExclude),
+		ExcludeContentPatterns:       excludeContent,
+		IncludeContentPatterns:       includeContent,
 		FilePatternsReposMustInclude: filesReposMustInclude,
 		FilePatternsReposMustExclude: filesReposMustExclude,
 		Languages:                    langInclude,
 		PathPatternsAreCaseSensitive: q.IsCaseSensitive(),
 		CombyRule:                    q.FindValue(query.FieldCombyRule),
+		CombyRewrite:                 q.FindValue(query.FieldReplace),
 		Index:                        q.Index(),
 		Select:                       selector,
 	}
@@ -12,10 +12,12 @@ import (
 type RepoStatus uint8
 
 const (
-	RepoStatusCloning  RepoStatus = 1 << iota // could not be searched because they were still being cloned
-	RepoStatusMissing                         // could not be searched because they do not exist
-	RepoStatusLimitHit                        // searched, but have results that were not returned due to exceeded limits
-	RepoStatusTimedout                        // repos that were not searched due to timeout
+	RepoStatusCloning   RepoStatus = 1 << iota // could not be searched because they were still being cloned
+	RepoStatusMissing                          // could not be searched because they do not exist
+	RepoStatusLimitHit                         // searched, but have results that were not returned due to exceeded limits
+	RepoStatusTimedout                         // repos that were not searched due to timeout
+	RepoStatusSearched                         // searched, regardless of whether there were results
+	RepoStatusRepoLimit                        // could not be searched because the number of repos to search exceeded a limit
 )
 
 var repoStatusName = []struct {
@@ -26,6 +28,8 @@ var repoStatusName = []struct {
 	{RepoStatusMissing, "missing"},
 	{RepoStatusLimitHit, "limithit"},
 	{RepoStatusTimedout, "timedout"},
+	{RepoStatusSearched, "searched"},
+	{RepoStatusRepoLimit, "repolimit"},
 }
 
 func (s RepoStatus) String() string {
@@ -0,0 +1,92 @@
+package repos
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	lru "github.com/hashicorp/golang-lru"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/globals"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+const (
+	// privateReposCacheTTL is how long the private repositories of an actor
+	// are cached. Permissions are synced in the background, so a short TTL
+	// only avoids listing them for every search of a user.
+	privateReposCacheTTL = 30 * time.Second
+
+	// privateReposCacheSize is the maximum number of actors whose private
+	// repositories are cached.
+	privateReposCacheSize = 500
+)
+
+type cachedPrivateRepos struct {
+	once      sync.Once
+	expiresAt time.Time
+	repos     []types.RepoName
+	err       error
+}
+
+var (
+	privateReposCache, _ = lru.New(privateReposCacheSize) // actor UID -> *cachedPrivateRepos
+	privateReposClock    = time.Now
+)
+
+// PrivateReposForActor returns the private repositories the actor in ctx has
+// access to. Global indexed searches use it to skip the shards of private
+// repositories the actor cannot see, instead of searching them and filtering
+// their results afterwards.
+//
+// It returns nil if repository permissions are not enforced for the actor, in
+// which case there are no shards to skip. Results are cached per actor for a
+// short time.
+func PrivateReposForActor(ctx context.Context, db dbutil.DB) ([]types.RepoName, error) {
+	a := actor.FromContext(ctx)
+	if a.Internal || !authzEnforced() {
+		return nil, nil
+	}
+
+	key := a.UID
+	now := privateReposClock()
+	var entry *cachedPrivateRepos
+	if v, ok := privateReposCache.Get(key); ok && now.Before(v.(*cachedPrivateRepos).expiresAt) {
+		entry = v.(*cachedPrivateRepos)
+	} else {
+		entry = &cachedPrivateRepos{expiresAt: now.Add(privateReposCacheTTL)}
+		privateReposCache.Add(key, entry)
+	}
+
+	entry.once.Do(func() {
+		entry.repos, entry.err = listPrivateRepos(ctx, db)
+		if entry.err != nil {
+			// Errors are not cached.
+			privateReposCache.Remove(key)
+		}
+	})
+	return entry.repos, entry.err
+}
+
+// authzEnforced returns false if all users may access all repositories, which
+// mirrors the bypass in database.AuthzQueryConds.
+func authzEnforced() bool {
+	allowByDefault, providers := authz.GetProviders()
+	return !allowByDefault || len(providers) > 0 || globals.PermissionsUserMapping().Enabled
+}
+
+func listPrivateRepos(ctx context.Context, db dbutil.DB) ([]types.RepoName, error) {
+	repos, err := database.Repos(db).ListRepoNames(ctx, database.ReposListOptions{OnlyPrivate: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing private repositories")
+	}
+	if repos == nil {
+		repos = []types.RepoName{}
+	}
+	return repos, nil
+}
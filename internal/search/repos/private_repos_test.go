@@ -0,0 +1,87 @@
+package repos
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestPrivateReposForActor(t *testing.T) {
+	now := time.Now()
+	privateReposClock = func() time.Time { return now }
+	defer func() { privateReposClock = time.Now }()
+	defer privateReposCache.Purge()
+
+	calls := map[int32]int{}
+	database.Mocks.Repos.ListRepoNames = func(ctx context.Context, opts database.ReposListOptions) ([]types.RepoName, error) {
+		if !opts.OnlyPrivate {
+			t.Fatal("expected only private repositories to be listed")
+		}
+		uid := actor.FromContext(ctx).UID
+		calls[uid]++
+		return []types.RepoName{{ID: 1, Name: "private"}}, nil
+	}
+	defer func() { database.Mocks.Repos.ListRepoNames = nil }()
+
+	t.Run("not enforced", func(t *testing.T) {
+		authz.SetProviders(true, nil)
+		ctx := actor.WithActor(context.Background(), &actor.Actor{UID: 1})
+		repos, err := PrivateReposForActor(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if repos != nil {
+			t.Fatalf("expected no repositories, got %v", repos)
+		}
+		if calls[1] != 0 {
+			t.Fatalf("expected no calls, got %d", calls[1])
+		}
+	})
+
+	authz.SetProviders(false, nil)
+	defer authz.SetProviders(true, nil)
+
+	t.Run("internal actor", func(t *testing.T) {
+		ctx := actor.WithInternalActor(context.Background())
+		repos, err := PrivateReposForActor(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if repos != nil {
+			t.Fatalf("expected no repositories, got %v", repos)
+		}
+	})
+
+	t.Run("cached per actor", func(t *testing.T) {
+		want := []types.RepoName{{ID: 1, Name: "private"}}
+		for _, uid := range []int32{1, 1, 2, 1, 2} {
+			ctx := actor.WithActor(context.Background(), &actor.Actor{UID: uid})
+			repos, err := PrivateReposForActor(ctx, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, repos); diff != "" {
+				t.Fatalf("unexpected repositories (-want +got):\n%s", diff)
+			}
+		}
+		if diff := cmp.Diff(map[int32]int{1: 1, 2: 1}, calls); diff != "" {
+			t.Fatalf("unexpected calls (-want +got):\n%s", diff)
+		}
+
+		now = now.Add(privateReposCacheTTL)
+		ctx := actor.WithActor(context.Background(), &actor.Actor{UID: 1})
+		if _, err := PrivateReposForActor(ctx, nil); err != nil {
+			t.Fatal(err)
+		}
+		if calls[1] != 2 {
+			t.Fatalf("expected expired entry to be reloaded, got %d calls", calls[1])
+		}
+	})
+}
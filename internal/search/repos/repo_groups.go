@@ -10,6 +10,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/schema"
 )
 
@@ -35,7 +36,10 @@ func (r RepoRegexpPattern) String() string {
 
 var MockResolveRepoGroups func() (map[string][]RepoGroupValue, error)
 
-func ResolveRepoGroups(ctx context.Context, settings *schema.Settings) (groups map[string][]RepoGroupValue, err error) {
+// ResolveRepoGroups returns the repo groups of the current user, keyed by
+// name: the ones from their settings, the repo collections of the user and of
+// their orgs, and "my" for the repos they added.
+func ResolveRepoGroups(ctx context.Context, db dbutil.DB, settings *schema.Settings) (groups map[string][]RepoGroupValue, err error) {
 	if MockResolveRepoGroups != nil {
 		return MockResolveRepoGroups()
 	}
@@ -61,13 +65,32 @@ func ResolveRepoGroups(ctx context.Context, settings *schema.Settings) (groups m
 		groups[name] = repos
 	}
 
+	a := actor.FromContext(ctx)
+	if a.IsAuthenticated() {
+		collections, err := database.RepoCollections(db).ListRepoGroups(ctx, a.UID)
+		if err != nil {
+			return groups, err
+		}
+		for name, repoNames := range collections {
+			// Groups from settings take precedence over collections with the
+			// same name.
+			if _, ok := groups[name]; ok {
+				continue
+			}
+			values := make([]RepoGroupValue, 0, len(repoNames))
+			for _, repoName := range repoNames {
+				values = append(values, RepoPath(repoName))
+			}
+			groups[name] = values
+		}
+	}
+
 	if mode, err := database.GlobalUsers.CurrentUserAllowedExternalServices(ctx); err != nil {
 		return groups, err
 	} else if mode == conf.ExternalServiceModeDisabled {
 		return groups, nil
 	}
 
-	a := actor.FromContext(ctx)
 	repos, err := database.GlobalRepos.ListRepoNames(ctx, database.ReposListOptions{UserID: a.UID})
 	if err != nil {
 		log15.Warn("getting user added repos", "err", err)
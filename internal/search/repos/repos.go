@@ -76,7 +76,7 @@ func (r *Resolver) Resolve(ctx context.Context, op Options) (Resolved, error) {
 	// groups and the set of repos specified with repo:. (If none are specified
 	// with repo:, then include all from the group.)
 	if groupNames := op.RepoGroupFilters; len(groupNames) > 0 {
-		groups, err := ResolveRepoGroups(ctx, op.UserSettings)
+		groups, err := ResolveRepoGroups(ctx, r.DB, op.UserSettings)
 		if err != nil {
 			return Resolved{}, err
 		}
@@ -121,7 +121,11 @@ func (r *Resolver) Resolve(ctx context.Context, op Options) (Resolved, error) {
 
 	var defaultRepos []types.RepoName
 
-	if envvar.SourcegraphDotComMode() && len(includePatterns) == 0 && !query.HasTypeRepo(op.Query) && searchcontexts.IsGlobalSearchContext(searchContext) {
+	// Default repos are not filtered by topics, so we list the repos with the
+	// topics instead.
+	hasTopics := len(op.RepoTopics) > 0 || len(op.MinusRepoTopics) > 0
+
+	if envvar.SourcegraphDotComMode() && len(includePatterns) == 0 && !hasTopics && !query.HasTypeRepo(op.Query) && searchcontexts.IsGlobalSearchContext(searchContext) {
 		start := time.Now()
 		defaultRepos, err = defaultRepositories(ctx, r.DefaultReposFunc, r.Zoekt, excludePatterns)
 		if err != nil {
@@ -157,6 +161,9 @@ func (r *Resolver) Resolve(ctx context.Context, op Options) (Resolved, error) {
 			OnlyArchived: op.OnlyArchived,
 			NoPrivate:    op.OnlyPublic,
 			OnlyPrivate:  op.OnlyPrivate,
+
+			Topics:        op.RepoTopics,
+			ExcludeTopics: op.MinusRepoTopics,
 		}
 
 		if searchContext.ID != 0 {
@@ -317,6 +324,8 @@ type Options struct {
 	RepoFilters        []string
 	MinusRepoFilters   []string
 	RepoGroupFilters   []string
+	RepoTopics         []string
+	MinusRepoTopics    []string
 	SearchContextSpec  string
 	VersionContextName string
 	UserSettings       *schema.Settings
@@ -350,6 +359,12 @@ func (op *Options) String() string {
 	if len(op.RepoGroupFilters) > 0 {
 		_, _ = fmt.Fprintf(&b, " groups=%v", op.RepoGroupFilters)
 	}
+	if len(op.RepoTopics) > 0 {
+		_, _ = fmt.Fprintf(&b, " topics=%v", op.RepoTopics)
+	}
+	if len(op.MinusRepoTopics) > 0 {
+		_, _ = fmt.Fprintf(&b, " -topics=%v", op.MinusRepoTopics)
+	}
 	if op.VersionContextName != "" {
 		_, _ = fmt.Fprintf(&b, " versionContext=%q", op.VersionContextName)
 	}
@@ -0,0 +1,52 @@
+package result
+
+import (
+	"github.com/sourcegraph/sourcegraph/internal/search/filter"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// CountMatch is the number of results of a count-by aggregation that belong to
+// a single group. A search emits a CountMatch for a group each time it finds
+// more results in that group, so Count is the number of results found since
+// the previous CountMatch for the same group. Consumers sum the counts of each
+// group to obtain the total.
+type CountMatch struct {
+	// By is the property by which results are grouped.
+	By filter.CountBy
+
+	// Repo is the repository of the group for count-by:repo and count-by:file.
+	Repo types.RepoName
+
+	// Path is the file path of the group for count-by:file.
+	Path string
+
+	// Author is the commit author name of the group for count-by:author.
+	Author string
+
+	// Count is the number of results in the group.
+	Count int
+}
+
+func (r *CountMatch) ResultCount() int {
+	return r.Count
+}
+
+func (r *CountMatch) Limit(limit int) int {
+	// Counts are never truncated and each represents one displayed result.
+	return limit - 1
+}
+
+func (r *CountMatch) Select(path filter.SelectPath) Match {
+	return nil
+}
+
+func (r *CountMatch) Key() Key {
+	return Key{
+		TypeRank: rankCountMatch,
+		Repo:     r.Repo.Name,
+		Path:     r.Path,
+		Group:    r.Author,
+	}
+}
+
+func (r *CountMatch) searchResultMarker() {}
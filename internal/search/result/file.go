@@ -44,6 +44,10 @@ type FileMatch struct {
 	LineMatches []*LineMatch
 	Symbols     []*SymbolMatch `json:"-"`
 
+	// Rewrites are the rewrites of the structural matches of the file by the
+	// replace: template of the query, if any.
+	Rewrites []*Rewrite `json:"-"`
+
 	LimitHit bool
 }
 
@@ -70,6 +74,7 @@ func (fm *FileMatch) Select(t filter.SelectPath) Match {
 	case filter.File:
 		fm.LineMatches = nil
 		fm.Symbols = nil
+		fm.Rewrites = nil
 		return fm
 	case filter.Symbol:
 		if len(fm.Symbols) > 0 {
@@ -102,6 +107,7 @@ func (fm *FileMatch) Select(t filter.SelectPath) Match {
 func (fm *FileMatch) AppendMatches(src *FileMatch) {
 	fm.LineMatches = append(fm.LineMatches, src.LineMatches...)
 	fm.Symbols = append(fm.Symbols, src.Symbols...)
+	fm.Rewrites = append(fm.Rewrites, src.Rewrites...)
 	fm.LimitHit = fm.LimitHit || src.LimitHit
 }
 
@@ -147,3 +153,15 @@ type LineMatch struct {
 	OffsetAndLengths [][2]int32
 	LineNumber       int32
 }
+
+// Rewrite is a structural match and the content it is rewritten to by the
+// replace: template of a query.
+type Rewrite struct {
+	// StartLine and EndLine are the 0-based lines the match starts and ends
+	// on.
+	StartLine int32
+	EndLine   int32
+
+	Matched     string
+	Replacement string
+}
@@ -5,7 +5,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/search/filter"
 )
 
-// Match is *FileMatch | *RepoMatch | *CommitMatch. We have a private method
+// Match is *FileMatch | *RepoMatch | *CommitMatch | *CountMatch. We have a private method
 // to ensure only those types implement Match.
 type Match interface {
 	ResultCount() int
@@ -24,6 +24,7 @@ var (
 	_ Match = (*FileMatch)(nil)
 	_ Match = (*RepoMatch)(nil)
 	_ Match = (*CommitMatch)(nil)
+	_ Match = (*CountMatch)(nil)
 )
 
 // Match ranks are used for sorting the different match types.
@@ -34,6 +35,7 @@ const (
 	rankCommitMatch = 1
 	rankDiffMatch   = 2
 	rankRepoMatch   = 3
+	rankCountMatch  = 4
 )
 
 // Key is a sorting or deduplicating key for a Match.
@@ -53,6 +55,11 @@ type Key struct {
 	// Empty if there is no file associated with the match (e.g. RepoMatch or CommitMatch)
 	Path string
 
+	// Group is the name of the aggregation group of a CountMatch that is not
+	// identified by a repo or path (e.g. a commit author).
+	// Empty for all other matches.
+	Group string
+
 	// TypeRank is the sorting rank of the type this key belongs to.
 	TypeRank int
 }
@@ -75,6 +82,10 @@ func (k Key) Less(other Key) bool {
 		return k.Path < other.Path
 	}
 
+	if k.Group != other.Group {
+		return k.Group < other.Group
+	}
+
 	return k.TypeRank < other.TypeRank
 }
 
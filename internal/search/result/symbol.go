@@ -142,6 +142,38 @@ func (s Symbol) Range() lsp.Range {
 	}
 }
 
+// ParentChain returns the names of the symbols that contain s, outermost
+// first. Ctags reports the scope of a symbol as a single qualified name such as
+// "pkg.Type" or "ns::Class", so the chain is derived by splitting it.
+func (s Symbol) ParentChain() []string {
+	if s.Parent == "" {
+		return nil
+	}
+	return strings.Split(s.Parent, s.scopeSeparator())
+}
+
+// QualifiedName returns the name of s qualified by the names of the symbols
+// that contain it, e.g. "pkg.Type.Method".
+func (s Symbol) QualifiedName() string {
+	if s.Parent == "" {
+		return s.Name
+	}
+	return s.Parent + s.scopeSeparator() + s.Name
+}
+
+// scopeSeparator returns the separator between the names of nested symbols
+// in the language of s.
+func (s Symbol) scopeSeparator() string {
+	if strings.Contains(s.Parent, "::") {
+		return "::"
+	}
+	switch strings.ToLower(s.Language) {
+	case "c++", "rust", "ruby", "perl":
+		return "::"
+	}
+	return "."
+}
+
 // Symbols is the result of a search on the symbols service.
 type Symbols = []Symbol
 
@@ -67,3 +67,43 @@ func TestSymbolURL(t *testing.T) {
 		})
 	}
 }
+
+func TestSymbolQualifiedName(t *testing.T) {
+	cases := []struct {
+		symbol        Symbol
+		parentChain   []string
+		qualifiedName string
+	}{
+		{
+			symbol:        Symbol{Name: "main", Language: "Go"},
+			parentChain:   nil,
+			qualifiedName: "main",
+		},
+		{
+			symbol:        Symbol{Name: "Method", Parent: "pkg.Type", Language: "Go"},
+			parentChain:   []string{"pkg", "Type"},
+			qualifiedName: "pkg.Type.Method",
+		},
+		{
+			symbol:        Symbol{Name: "method", Parent: "ns::Class", Language: "C++"},
+			parentChain:   []string{"ns", "Class"},
+			qualifiedName: "ns::Class::method",
+		},
+		{
+			symbol:        Symbol{Name: "new", Parent: "Point", Language: "Rust"},
+			parentChain:   []string{"Point"},
+			qualifiedName: "Point::new",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.qualifiedName, func(t *testing.T) {
+			if diff := cmp.Diff(tc.parentChain, tc.symbol.ParentChain()); diff != "" {
+				t.Errorf("unexpected parent chain (-want +got):\n%s", diff)
+			}
+			if got := tc.symbol.QualifiedName(); got != tc.qualifiedName {
+				t.Errorf("unexpected qualified name. want=%q have=%q", tc.qualifiedName, got)
+			}
+		})
+	}
+}
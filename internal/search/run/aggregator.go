@@ -3,10 +3,12 @@ package run
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/hashicorp/go-multierror"
 	"github.com/inconshreveable/log15"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/internal/search"
 	searchrepos "github.com/sourcegraph/sourcegraph/internal/search/repos"
@@ -15,11 +17,17 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 )
 
-func NewAggregator(db dbutil.DB, stream streaming.Sender) *Aggregator {
+// NewAggregator returns an aggregator of the results sent by searches run as
+// the actor of the given context. Results the actor cannot read because of
+// sub-repository permissions are dropped.
+func NewAggregator(ctx context.Context, db dbutil.DB, stream streaming.Sender) *Aggregator {
 	return &Aggregator{
 		db:           db,
 		parentStream: stream,
 		errors:       &multierror.Error{},
+		subRepoPermsFilter: func(matches []result.Match) ([]result.Match, error) {
+			return filterSubRepoPermissions(ctx, authz.DefaultSubRepoPermsChecker, matches)
+		},
 	}
 }
 
@@ -27,6 +35,8 @@ type Aggregator struct {
 	parentStream streaming.Sender
 	db           dbutil.DB
 
+	subRepoPermsFilter func([]result.Match) ([]result.Match, error)
+
 	mu      sync.Mutex
 	results []result.Match
 	stats   streaming.Stats
@@ -43,6 +53,16 @@ func (a *Aggregator) Get() ([]result.Match, streaming.Stats, *multierror.Error)
 }
 
 func (a *Aggregator) Send(event streaming.SearchEvent) {
+	if len(event.Results) > 0 {
+		results, err := a.subRepoPermsFilter(event.Results)
+		if err != nil {
+			// 🚨 SECURITY: Drop results whose permissions could not be checked.
+			a.Error(errors.Wrap(err, "checking sub-repository permissions"))
+			results = nil
+		}
+		event.Results = results
+	}
+
 	if a.parentStream != nil {
 		a.parentStream.Send(event)
 	}
@@ -97,10 +117,19 @@ func (a *Aggregator) DoFilePathSearch(ctx context.Context, args *search.TextPara
 		tr.Finish()
 	}()
 
+	var stream streaming.Sender = a
+	blame, err := newBlameFilter(ctx, a.db, args.Query, time.Now())
+	if err != nil {
+		return err
+	}
+	if blame != nil {
+		stream = blame.stream(ctx, a)
+	}
+
 	isDefaultStructuralSearch := args.PatternInfo.IsStructuralPat && args.PatternInfo.FileMatchLimit == defaultMaxSearchResults
 
 	if !isDefaultStructuralSearch {
-		return SearchFilesInRepos(ctx, args, a)
+		return SearchFilesInRepos(ctx, args, stream)
 	}
 
 	// For structural search with default limits we retry if we get no results.
@@ -130,7 +159,7 @@ func (a *Aggregator) DoFilePathSearch(ctx context.Context, args *search.TextPara
 		matches = append(matches, fm)
 	}
 
-	a.Send(streaming.SearchEvent{
+	stream.Send(streaming.SearchEvent{
 		Results: matches,
 		Stats:   stats,
 	})
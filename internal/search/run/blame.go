@@ -0,0 +1,300 @@
+package run
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
+)
+
+// blameConcurrency bounds the number of concurrent requests to gitserver made
+// to blame the files matched by a search.
+const blameConcurrency = 16
+
+// blameFilter restricts the matched lines of file matches to lines last
+// changed by an author matching the author: fields of a query, and within the
+// time window given by its before: and after: fields. It runs on the matched
+// files only, so its cost is bounded by the size of the result set.
+type blameFilter struct {
+	authors    []*regexp.Regexp
+	notAuthors []*regexp.Regexp
+
+	// after and before bound the author date of matched lines. They are zero
+	// if unbounded.
+	after  time.Time
+	before time.Time
+}
+
+// newBlameFilter returns the blame filter of a type:file query, or nil if the
+// query does not contain any of the author:, before:, or after: fields.
+func newBlameFilter(ctx context.Context, db dbutil.DB, q query.Q, now time.Time) (*blameFilter, error) {
+	authors, notAuthors := q.RegexpPatterns(query.FieldAuthor)
+	befores, _ := q.StringValues(query.FieldBefore)
+	afters, _ := q.StringValues(query.FieldAfter)
+	if len(authors) == 0 && len(notAuthors) == 0 && len(befores) == 0 && len(afters) == 0 {
+		return nil, nil
+	}
+
+	f := &blameFilter{}
+
+	var err error
+	if authors, err = expandUsernamesToEmails(ctx, db, authors); err != nil {
+		return nil, errors.WithMessage(err, "expanding usernames in field author")
+	}
+	if notAuthors, err = expandUsernamesToEmails(ctx, db, notAuthors); err != nil {
+		return nil, errors.WithMessage(err, "expanding usernames in field author")
+	}
+	compile := func(patterns []string) ([]*regexp.Regexp, error) {
+		res := make([]*regexp.Regexp, 0, len(patterns))
+		for _, pattern := range patterns {
+			if !q.IsCaseSensitive() {
+				pattern = "(?i:" + pattern + ")"
+			}
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, re)
+		}
+		return res, nil
+	}
+	if f.authors, err = compile(authors); err != nil {
+		return nil, err
+	}
+	if f.notAuthors, err = compile(notAuthors); err != nil {
+		return nil, err
+	}
+
+	// Like git log, multiple bounds of the same kind narrow the window.
+	for _, value := range befores {
+		t, err := parseBlameDate(value, now)
+		if err != nil {
+			return nil, err
+		}
+		if f.before.IsZero() || t.Before(f.before) {
+			f.before = t
+		}
+	}
+	for _, value := range afters {
+		t, err := parseBlameDate(value, now)
+		if err != nil {
+			return nil, err
+		}
+		if t.After(f.after) {
+			f.after = t
+		}
+	}
+
+	return f, nil
+}
+
+// matchHunk returns whether the lines of hunk satisfy f.
+func (f *blameFilter) matchHunk(hunk *git.Hunk) bool {
+	if !f.after.IsZero() && !hunk.Author.Date.After(f.after) {
+		return false
+	}
+	if !f.before.IsZero() && !hunk.Author.Date.Before(f.before) {
+		return false
+	}
+	// Authors are matched against "Name <email>", as with git log --author.
+	author := fmt.Sprintf("%s <%s>", hunk.Author.Name, hunk.Author.Email)
+	for _, re := range f.authors {
+		if !re.MatchString(author) {
+			return false
+		}
+	}
+	for _, re := range f.notAuthors {
+		if re.MatchString(author) {
+			return false
+		}
+	}
+	return true
+}
+
+// filterFileMatch removes the line matches of fm on lines that do not satisfy
+// f. It returns nil if no line matches remain.
+func (f *blameFilter) filterFileMatch(ctx context.Context, fm *result.FileMatch) (*result.FileMatch, error) {
+	if len(fm.LineMatches) == 0 {
+		// Path matches do not have any lines to blame.
+		return nil, nil
+	}
+
+	// Only blame the range of lines that contains matches.
+	start, end := fm.LineMatches[0].LineNumber, fm.LineMatches[0].LineNumber
+	for _, lm := range fm.LineMatches {
+		if lm.LineNumber < start {
+			start = lm.LineNumber
+		}
+		if lm.LineNumber > end {
+			end = lm.LineNumber
+		}
+	}
+	hunks, err := git.BlameFile(ctx, fm.Repo.Name, fm.Path, &git.BlameOptions{
+		NewestCommit: fm.CommitID,
+		StartLine:    int(start) + 1,
+		EndLine:      int(end) + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// keep is the set of 0-based line numbers that satisfy f.
+	keep := map[int32]struct{}{}
+	for _, hunk := range hunks {
+		if !f.matchHunk(hunk) {
+			continue
+		}
+		for line := hunk.StartLine; line < hunk.EndLine; line++ {
+			keep[int32(line-1)] = struct{}{}
+		}
+	}
+
+	lineMatches := make([]*result.LineMatch, 0, len(fm.LineMatches))
+	for _, lm := range fm.LineMatches {
+		if _, ok := keep[lm.LineNumber]; ok {
+			lineMatches = append(lineMatches, lm)
+		}
+	}
+	if len(lineMatches) == 0 {
+		return nil, nil
+	}
+	var rewrites []*result.Rewrite
+	for _, rw := range fm.Rewrites {
+		if _, ok := keep[int32(rw.StartLine)]; ok {
+			rewrites = append(rewrites, rw)
+		}
+	}
+
+	filtered := *fm
+	filtered.LineMatches = lineMatches
+	filtered.Rewrites = rewrites
+	return &filtered, nil
+}
+
+// filterMatches applies f to the file matches among matches. Matches that
+// cannot be blamed are dropped, since they cannot be shown to satisfy f.
+func (f *blameFilter) filterMatches(ctx context.Context, matches []result.Match) []result.Match {
+	filtered := make([]result.Match, len(matches))
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		sem     = make(chan struct{}, blameConcurrency)
+		failed  int
+		lastErr error
+	)
+	for i, match := range matches {
+		fm, ok := match.(*result.FileMatch)
+		if !ok {
+			filtered[i] = match
+			continue
+		}
+
+		i := i
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			fm, err := f.filterFileMatch(ctx, fm)
+			if err != nil {
+				mu.Lock()
+				failed++
+				lastErr = err
+				mu.Unlock()
+				return
+			}
+			if fm != nil {
+				filtered[i] = fm
+			}
+		}()
+	}
+	wg.Wait()
+	if failed > 0 && ctx.Err() == nil {
+		log15.Warn("Failed to blame files to filter search results", "failed", failed, "total", len(matches), "error", lastErr)
+	}
+
+	// Compact the matches in place, keeping their order.
+	kept := filtered[:0]
+	for _, match := range filtered {
+		if match != nil {
+			kept = append(kept, match)
+		}
+	}
+	return kept
+}
+
+// stream returns a stream that applies f to the results of each event before
+// sending it to s.
+func (f *blameFilter) stream(ctx context.Context, s streaming.Sender) streaming.Sender {
+	return streaming.StreamFunc(func(event streaming.SearchEvent) {
+		event.Results = f.filterMatches(ctx, event.Results)
+		s.Send(event)
+	})
+}
+
+// relativeDatePattern matches dates relative to now like "3 weeks ago".
+var relativeDatePattern = lazyregexp.New(`^(\d+)\s*(second|minute|hour|day|week|month|year)s?\s+ago$`)
+
+// parseBlameDate parses the value of a before: or after: field. It supports
+// the absolute and relative date formats most commonly used with git log,
+// such as "2021-06-01" and "2 weeks ago".
+func parseBlameDate(value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	switch strings.ToLower(value) {
+	case "now":
+		return now, nil
+	case "today":
+		y, m, d := now.Date()
+		return time.Date(y, m, d, 0, 0, 0, 0, now.Location()), nil
+	case "yesterday":
+		y, m, d := now.AddDate(0, 0, -1).Date()
+		return time.Date(y, m, d, 0, 0, 0, 0, now.Location()), nil
+	}
+
+	if m := relativeDatePattern.FindStringSubmatch(strings.ToLower(value)); m != nil {
+		n, err := strconv.Atoi(m[1])
+		if err != nil {
+			return time.Time{}, err
+		}
+		switch m[2] {
+		case "second":
+			return now.Add(-time.Duration(n) * time.Second), nil
+		case "minute":
+			return now.Add(-time.Duration(n) * time.Minute), nil
+		case "hour":
+			return now.Add(-time.Duration(n) * time.Hour), nil
+		case "day":
+			return now.AddDate(0, 0, -n), nil
+		case "week":
+			return now.AddDate(0, 0, -7*n), nil
+		case "month":
+			return now.AddDate(0, -n, 0), nil
+		case "year":
+			return now.AddDate(-n, 0, 0), nil
+		}
+	}
+
+	for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02", "2006/01/02", "2006-01"} {
+		if t, err := time.ParseInLocation(layout, value, now.Location()); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf(`invalid date %q. Use a date like "2021-06-01" or a relative date like "2 weeks ago"`, value)
+}
@@ -0,0 +1,129 @@
+package run
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
+)
+
+func TestBlameFilter(t *testing.T) {
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	alice := git.Signature{Name: "Alice", Email: "alice@example.com", Date: now.AddDate(0, 0, -1)}
+	bob := git.Signature{Name: "Bob", Email: "bob@example.com", Date: now.AddDate(-1, 0, 0)}
+
+	git.Mocks.BlameFile = func(repo api.RepoName, path string, opt *git.BlameOptions) ([]*git.Hunk, error) {
+		if opt.StartLine != 2 || opt.EndLine != 5 {
+			t.Errorf("unexpected blamed lines %d-%d", opt.StartLine, opt.EndLine)
+		}
+		if path == "b.go" {
+			return []*git.Hunk{{StartLine: 2, EndLine: 6, Author: bob}}, nil
+		}
+		return []*git.Hunk{
+			{StartLine: 2, EndLine: 3, Author: alice},
+			{StartLine: 3, EndLine: 5, Author: bob},
+			{StartLine: 5, EndLine: 6, Author: alice},
+		}, nil
+	}
+	defer git.ResetMocks()
+
+	fileMatch := func(path string, lines ...int32) *result.FileMatch {
+		fm := &result.FileMatch{File: result.File{
+			Repo:     types.RepoName{ID: 1, Name: "repo"},
+			CommitID: "head",
+			Path:     path,
+		}}
+		for _, line := range lines {
+			fm.LineMatches = append(fm.LineMatches, &result.LineMatch{LineNumber: line})
+		}
+		return fm
+	}
+
+	cases := []struct {
+		name   string
+		filter *blameFilter
+		want   map[string][]int32
+	}{{
+		name:   "author",
+		filter: &blameFilter{authors: []*regexp.Regexp{regexp.MustCompile("alice")}},
+		want:   map[string][]int32{"a.go": {1, 4}},
+	}, {
+		name:   "negated author",
+		filter: &blameFilter{notAuthors: []*regexp.Regexp{regexp.MustCompile("alice")}},
+		want:   map[string][]int32{"a.go": {2}, "b.go": {1, 4}},
+	}, {
+		name:   "before",
+		filter: &blameFilter{before: now.AddDate(0, -1, 0)},
+		want:   map[string][]int32{"a.go": {2}, "b.go": {1, 4}},
+	}, {
+		name:   "after",
+		filter: &blameFilter{after: now.AddDate(0, 0, -7)},
+		want:   map[string][]int32{"a.go": {1, 4}},
+	}}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			matches := []result.Match{
+				fileMatch("a.go", 1, 2, 4),
+				&result.RepoMatch{ID: 1, Name: "repo"},
+				fileMatch("b.go", 1, 4),
+				fileMatch("c.go"),
+			}
+
+			got := map[string][]int32{}
+			var repoMatches int
+			for _, match := range tc.filter.filterMatches(context.Background(), matches) {
+				switch m := match.(type) {
+				case *result.FileMatch:
+					for _, lm := range m.LineMatches {
+						got[m.Path] = append(got[m.Path], lm.LineNumber)
+					}
+				case *result.RepoMatch:
+					repoMatches++
+				}
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("unexpected matched lines (-want +got):\n%s", diff)
+			}
+			if repoMatches != 1 {
+				t.Errorf("got %d repo matches, want 1", repoMatches)
+			}
+		})
+	}
+}
+
+func TestParseBlameDate(t *testing.T) {
+	now := time.Date(2021, 6, 15, 12, 30, 0, 0, time.UTC)
+	cases := []struct {
+		value string
+		want  time.Time
+	}{
+		{"now", now},
+		{"yesterday", time.Date(2021, 6, 14, 0, 0, 0, 0, time.UTC)},
+		{"3 days ago", time.Date(2021, 6, 12, 12, 30, 0, 0, time.UTC)},
+		{"2 Weeks Ago", time.Date(2021, 6, 1, 12, 30, 0, 0, time.UTC)},
+		{"1 year ago", time.Date(2020, 6, 15, 12, 30, 0, 0, time.UTC)},
+		{"2021-01-02", time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{"2021-01-02T03:04:05Z", time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)},
+	}
+	for _, tc := range cases {
+		got, err := parseBlameDate(tc.value, now)
+		if err != nil {
+			t.Errorf("parseBlameDate(%q) failed: %s", tc.value, err)
+			continue
+		}
+		if !got.Equal(tc.want) {
+			t.Errorf("parseBlameDate(%q) = %s, want %s", tc.value, got, tc.want)
+		}
+	}
+
+	if _, err := parseBlameDate("last tuesday", now); err == nil {
+		t.Error("expected parseBlameDate to fail on an unsupported date")
+	}
+}
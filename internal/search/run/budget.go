@@ -0,0 +1,101 @@
+package run
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// minRepoBudget is the least time a repository revision is given when the
+// time until the deadline of a search is divided between repositories.
+const minRepoBudget = time.Second
+
+// repoBudget divides the time until the deadline of a search between the
+// repository revisions searched by searcher, so that a single large
+// repository cannot consume the time of the whole search.
+//
+// When there are more revisions than can be searched concurrently, each
+// revision gets its share of the remaining time, scaled by the size of the
+// repository relative to the other repositories. Large repositories get
+// proportionally more time and small repositories fail fast. Repositories
+// of unknown size are treated as being of average size. The repotimeout:
+// field of a query overrides the share of every revision.
+type repoBudget struct {
+	deadline    time.Time
+	repoTimeout time.Duration
+	share       time.Duration
+	sizes       map[string]int64
+	meanSize    float64
+}
+
+// newRepoBudget returns the budget of searching repos within the deadline of
+// ctx, where at most concurrency revisions are searched at a time. sizes maps
+// repository names to their size in bytes and may be incomplete.
+func newRepoBudget(ctx context.Context, repos []*search.RepositoryRevisions, sizes map[string]int64, repoTimeout time.Duration, concurrency int) *repoBudget {
+	b := &repoBudget{repoTimeout: repoTimeout}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return b
+	}
+	b.deadline = deadline
+
+	revs := 0
+	for _, repo := range repos {
+		revs += len(repo.Revs)
+	}
+	if concurrency <= 0 || revs <= concurrency {
+		// Every revision is searched right away, so a large repository
+		// does not hold up the others.
+		return b
+	}
+	b.share = time.Until(deadline) * time.Duration(concurrency) / time.Duration(revs)
+
+	var total int64
+	var known int
+	for _, repo := range repos {
+		if size, ok := sizes[string(repo.Repo.Name)]; ok && size > 0 {
+			total += size
+			known++
+		}
+	}
+	if known > 0 {
+		b.sizes = sizes
+		b.meanSize = float64(total) / float64(known)
+	}
+
+	return b
+}
+
+// timeout returns the time a revision of repo may be searched for, starting
+// now. It returns zero if the search of the revision is only bounded by the
+// deadline of the search.
+func (b *repoBudget) timeout(repo types.RepoName) time.Duration {
+	var remaining time.Duration
+	if !b.deadline.IsZero() {
+		remaining = time.Until(b.deadline)
+	}
+
+	var d time.Duration
+	switch {
+	case b.repoTimeout > 0:
+		d = b.repoTimeout
+	case b.share > 0:
+		d = b.share
+		if size, ok := b.sizes[string(repo.Name)]; ok && size > 0 && b.meanSize > 0 {
+			d = time.Duration(float64(d) * float64(size) / b.meanSize)
+		}
+		if d < minRepoBudget {
+			d = minRepoBudget
+		}
+	default:
+		return 0
+	}
+
+	if remaining > 0 && d >= remaining {
+		return 0
+	}
+	return d
+}
@@ -0,0 +1,126 @@
+package run
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestRepoBudget(t *testing.T) {
+	repos := makeRepositoryRevisions("foo/big", "foo/medium", "foo/small", "foo/unknown", "foo/tiny")
+	repo := func(name string) types.RepoName {
+		return types.RepoName{Name: api.RepoName("foo/" + name)}
+	}
+	// The mean size of the repositories of known size is 2000.
+	sizes := map[string]int64{
+		"foo/big":    3000,
+		"foo/medium": 3999,
+		"foo/small":  1000,
+		"foo/tiny":   1,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Second)
+	defer cancel()
+
+	cases := []struct {
+		name        string
+		ctx         context.Context
+		repos       []*search.RepositoryRevisions
+		repoTimeout time.Duration
+		concurrency int
+		repo        string
+		want        time.Duration
+	}{
+		{
+			name:        "no deadline",
+			ctx:         context.Background(),
+			repos:       repos,
+			concurrency: 2,
+			repo:        "big",
+			want:        0,
+		},
+		{
+			name:        "no deadline with repotimeout",
+			ctx:         context.Background(),
+			repos:       repos,
+			repoTimeout: 5 * time.Second,
+			concurrency: 2,
+			repo:        "big",
+			want:        5 * time.Second,
+		},
+		{
+			name:        "all revisions searched concurrently",
+			ctx:         ctx,
+			repos:       repos,
+			concurrency: 5,
+			repo:        "big",
+			want:        0,
+		},
+		{
+			name:        "large repository",
+			ctx:         ctx,
+			repos:       repos,
+			concurrency: 2,
+			repo:        "big",
+			want:        60 * time.Second,
+		},
+		{
+			name:        "small repository",
+			ctx:         ctx,
+			repos:       repos,
+			concurrency: 2,
+			repo:        "small",
+			want:        20 * time.Second,
+		},
+		{
+			name:        "repository of unknown size",
+			ctx:         ctx,
+			repos:       repos,
+			concurrency: 2,
+			repo:        "unknown",
+			want:        40 * time.Second,
+		},
+		{
+			name:        "tiny repository",
+			ctx:         ctx,
+			repos:       repos,
+			concurrency: 2,
+			repo:        "tiny",
+			want:        minRepoBudget,
+		},
+		{
+			name:        "repotimeout overrides share",
+			ctx:         ctx,
+			repos:       repos,
+			repoTimeout: 5 * time.Second,
+			concurrency: 2,
+			repo:        "big",
+			want:        5 * time.Second,
+		},
+		{
+			name:        "repotimeout beyond deadline",
+			ctx:         ctx,
+			repos:       repos,
+			repoTimeout: time.Hour,
+			concurrency: 2,
+			repo:        "big",
+			want:        0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			budget := newRepoBudget(tc.ctx, tc.repos, sizes, tc.repoTimeout, tc.concurrency)
+			have := budget.timeout(repo(tc.repo))
+
+			// The budget shrinks as the deadline approaches.
+			if diff := tc.want - have; diff < 0 || diff > time.Second {
+				t.Errorf("unexpected timeout. want=%s have=%s", tc.want, have)
+			}
+		})
+	}
+}
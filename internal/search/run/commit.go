@@ -15,6 +15,7 @@ import (
 
 	"github.com/cockroachdb/errors"
 
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
@@ -419,7 +420,24 @@ func SearchCommitsInRepos(ctx context.Context, db dbutil.DB, args *search.TextPa
 		tr.Finish()
 	}()
 
+	branches, err := newBranchFilter(args.Query)
+	if err != nil {
+		return err
+	}
+
 	repoSearch := func(ctx context.Context, repoRev *search.RepositoryRevisions) error {
+		if branches != nil {
+			revs, err := branches.revisions(ctx, repoRev.GitserverRepo())
+			if err != nil || len(revs) == 0 {
+				// The repository is either missing or has no matching
+				// branches to search.
+				stats, err := handleRepoSearchResult(repoRev, false, false, err)
+				params.ResultChannel.Send(streaming.SearchEvent{Stats: stats})
+				return err
+			}
+			repoRev = &search.RepositoryRevisions{Repo: repoRev.Repo, Revs: revs}
+		}
+
 		commitParams := params.CommitParams
 		commitParams.RepoRevs = repoRev
 		return SearchCommitsInRepoStream(ctx, db, commitParams, params.ResultChannel)
@@ -444,6 +462,91 @@ func SearchCommitsInRepos(ctx context.Context, db dbutil.DB, args *search.TextPa
 	return g.Wait()
 }
 
+// branchFilter selects the branches traversed by commit and diff search, as
+// specified by the branch: fields of a query.
+type branchFilter struct {
+	// all is true if every branch that is not excluded is selected.
+	all     bool
+	include []*regexp.Regexp
+	exclude []*regexp.Regexp
+}
+
+// newBranchFilter returns the branch filter of q, or nil if q does not
+// contain a branch: field.
+func newBranchFilter(q query.Q) (*branchFilter, error) {
+	branches, negatedBranches := q.Branches()
+	if len(branches) == 0 && len(negatedBranches) == 0 {
+		return nil, nil
+	}
+
+	compile := func(value string) (*regexp.Regexp, error) {
+		if value == query.BranchAll {
+			return regexp.Compile("")
+		}
+		return regexp.Compile(value)
+	}
+
+	// A query with only negated branch: fields searches all other branches.
+	f := &branchFilter{all: len(branches) == 0}
+	for _, b := range branches {
+		if b == query.BranchAll {
+			f.all = true
+			continue
+		}
+		re, err := compile(b)
+		if err != nil {
+			return nil, err
+		}
+		f.include = append(f.include, re)
+	}
+	for _, b := range negatedBranches {
+		re, err := compile(b)
+		if err != nil {
+			return nil, err
+		}
+		f.exclude = append(f.exclude, re)
+	}
+	return f, nil
+}
+
+func (f *branchFilter) matches(name string) bool {
+	for _, re := range f.exclude {
+		if re.MatchString(name) {
+			return false
+		}
+	}
+	if f.all {
+		return true
+	}
+	for _, re := range f.include {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// revisions returns the revisions of repo selected by the filter.
+func (f *branchFilter) revisions(ctx context.Context, repo api.RepoName) ([]search.RevisionSpecifier, error) {
+	if f.all && len(f.exclude) == 0 {
+		// git log traverses all branches itself.
+		return []search.RevisionSpecifier{{RefGlob: "refs/heads/*"}}, nil
+	}
+
+	branches, err := git.ListBranches(ctx, repo, git.BranchesOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var revs []search.RevisionSpecifier
+	for _, b := range branches {
+		if f.matches(b.Name) {
+			revs = append(revs, search.RevisionSpecifier{RevSpec: "refs/heads/" + b.Name})
+		}
+	}
+	return revs, nil
+}
+
 // SearchCommitDiffsInRepos searches a set of repos for matching commit diffs.
 func SearchCommitDiffsInRepos(ctx context.Context, db dbutil.DB, args *search.TextParametersForCommitParameters, resultChannel streaming.Sender) error {
 	return SearchCommitsInRepos(ctx, db, args, SearchCommitsInReposParameters{
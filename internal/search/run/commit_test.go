@@ -13,6 +13,7 @@ import (
 	"github.com/davecgh/go-spew/spew"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtesting"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
@@ -108,6 +109,80 @@ func TestSearchCommitsInRepo(t *testing.T) {
 	}
 }
 
+func TestBranchFilter_revisions(t *testing.T) {
+	git.Mocks.ListBranches = func(repo api.RepoName, opt git.BranchesOptions) ([]*git.Branch, error) {
+		return []*git.Branch{
+			{Name: "main"},
+			{Name: "release/1.0"},
+			{Name: "release/2.0-rc"},
+		}, nil
+	}
+	defer git.ResetMocks()
+
+	cases := []struct {
+		query string
+		want  []search.RevisionSpecifier
+	}{
+		{
+			query: "p",
+			want:  nil,
+		},
+		{
+			query: "branch:* p",
+			want:  []search.RevisionSpecifier{{RefGlob: "refs/heads/*"}},
+		},
+		{
+			query: "branch:^release/ p",
+			want: []search.RevisionSpecifier{
+				{RevSpec: "refs/heads/release/1.0"},
+				{RevSpec: "refs/heads/release/2.0-rc"},
+			},
+		},
+		{
+			query: "branch:^release/ -branch:rc p",
+			want:  []search.RevisionSpecifier{{RevSpec: "refs/heads/release/1.0"}},
+		},
+		{
+			query: "-branch:main p",
+			want: []search.RevisionSpecifier{
+				{RevSpec: "refs/heads/release/1.0"},
+				{RevSpec: "refs/heads/release/2.0-rc"},
+			},
+		},
+		{
+			query: "branch:nomatch p",
+			want:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.query, func(t *testing.T) {
+			q, err := query.ParseLiteral(tc.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			f, err := newBranchFilter(q)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if f == nil {
+				if tc.want != nil {
+					t.Fatalf("got no branch filter, want %v", tc.want)
+				}
+				return
+			}
+
+			got, err := f.revisions(context.Background(), "repo")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
 func resetMocks() {
 	database.Mocks = database.MockStores{}
 	backend.Mocks = backend.MockServices{}
@@ -0,0 +1,74 @@
+package run
+
+import (
+	"github.com/sourcegraph/sourcegraph/internal/search/filter"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+)
+
+// WithCountBy returns a child Stream of parent that replaces the results of
+// each event with their counts, grouped by the given property. Results that
+// do not have the property (e.g. repository results for count-by:file) are
+// dropped. Counts are sent as each event arrives rather than when the search
+// finishes, so a group can appear in many events and consumers must sum the
+// counts of each group.
+func WithCountBy(parent streaming.Sender, by filter.CountBy) streaming.Sender {
+	return streaming.StreamFunc(func(e streaming.SearchEvent) {
+		e.Results = countBy(e.Results, by)
+		parent.Send(e)
+	})
+}
+
+// countBy groups the given matches by the given property and returns one
+// CountMatch per group, in order of the first match of each group.
+func countBy(matches []result.Match, by filter.CountBy) []result.Match {
+	var counts []result.Match
+	groups := map[result.Key]*result.CountMatch{}
+
+	for _, match := range matches {
+		group, ok := countGroup(match, by)
+		if !ok {
+			continue
+		}
+
+		key := group.Key()
+		if existing, ok := groups[key]; ok {
+			existing.Count += match.ResultCount()
+			continue
+		}
+
+		group.Count = match.ResultCount()
+		groups[key] = group
+		counts = append(counts, group)
+	}
+
+	return counts
+}
+
+// countGroup returns an empty CountMatch describing the group of the given
+// match, and false if the match does not belong to any group.
+func countGroup(match result.Match, by filter.CountBy) (*result.CountMatch, bool) {
+	switch by {
+	case filter.CountByRepo:
+		switch v := match.(type) {
+		case *result.FileMatch:
+			return &result.CountMatch{By: by, Repo: v.Repo}, true
+		case *result.RepoMatch:
+			return &result.CountMatch{By: by, Repo: v.RepoName()}, true
+		case *result.CommitMatch:
+			return &result.CountMatch{By: by, Repo: v.RepoName}, true
+		}
+
+	case filter.CountByFile:
+		if v, ok := match.(*result.FileMatch); ok {
+			return &result.CountMatch{By: by, Repo: v.Repo, Path: v.Path}, true
+		}
+
+	case filter.CountByAuthor:
+		if v, ok := match.(*result.CommitMatch); ok {
+			return &result.CountMatch{By: by, Author: v.Commit.Author.Name}, true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,97 @@
+package run
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/sourcegraph/internal/search/filter"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
+)
+
+func TestWithCountBy(t *testing.T) {
+	foo := types.RepoName{ID: 1, Name: "foo"}
+	bar := types.RepoName{ID: 2, Name: "bar"}
+
+	fileMatch := func(repo types.RepoName, path string, matches int) *result.FileMatch {
+		lineMatch := &result.LineMatch{}
+		for i := 0; i < matches; i++ {
+			lineMatch.OffsetAndLengths = append(lineMatch.OffsetAndLengths, [2]int32{int32(i), 1})
+		}
+		return &result.FileMatch{
+			File:        result.File{Repo: repo, Path: path},
+			LineMatches: []*result.LineMatch{lineMatch},
+		}
+	}
+	commitMatch := func(repo types.RepoName, author string) *result.CommitMatch {
+		return &result.CommitMatch{
+			RepoName: repo,
+			Commit:   git.Commit{Author: git.Signature{Name: author}},
+		}
+	}
+
+	matches := []result.Match{
+		fileMatch(foo, "a.go", 2),
+		fileMatch(bar, "a.go", 1),
+		fileMatch(foo, "b.go", 3),
+		fileMatch(foo, "a.go", 1),
+		&result.RepoMatch{ID: bar.ID, Name: bar.Name},
+		commitMatch(foo, "alice"),
+		commitMatch(bar, "bob"),
+		commitMatch(bar, "alice"),
+	}
+
+	cases := []struct {
+		by   filter.CountBy
+		want []result.Match
+	}{
+		{
+			by: filter.CountByRepo,
+			want: []result.Match{
+				&result.CountMatch{By: filter.CountByRepo, Repo: foo, Count: 7},
+				&result.CountMatch{By: filter.CountByRepo, Repo: bar, Count: 4},
+			},
+		},
+		{
+			by: filter.CountByFile,
+			want: []result.Match{
+				&result.CountMatch{By: filter.CountByFile, Repo: foo, Path: "a.go", Count: 3},
+				&result.CountMatch{By: filter.CountByFile, Repo: bar, Path: "a.go", Count: 1},
+				&result.CountMatch{By: filter.CountByFile, Repo: foo, Path: "b.go", Count: 3},
+			},
+		},
+		{
+			by: filter.CountByAuthor,
+			want: []result.Match{
+				&result.CountMatch{By: filter.CountByAuthor, Author: "alice", Count: 2},
+				&result.CountMatch{By: filter.CountByAuthor, Author: "bob", Count: 1},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(string(tc.by), func(t *testing.T) {
+			var events []streaming.SearchEvent
+			stream := WithCountBy(streaming.StreamFunc(func(e streaming.SearchEvent) {
+				events = append(events, e)
+			}), tc.by)
+
+			stream.Send(streaming.SearchEvent{
+				Results: matches,
+				Stats:   streaming.Stats{IsLimitHit: true},
+			})
+
+			if len(events) != 1 {
+				t.Fatalf("unexpected number of events. want=%d have=%d", 1, len(events))
+			}
+			if diff := cmp.Diff(tc.want, events[0].Results); diff != "" {
+				t.Errorf("unexpected counts (-want +got):\n%s", diff)
+			}
+			if !events[0].Stats.IsLimitHit {
+				t.Errorf("expected stats to be forwarded")
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package run
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+// SearchCursorOrder is the order of the results a SearchCursor paginates
+// over.
+type SearchCursorOrder string
+
+const (
+	// SearchCursorOrderRepo orders results by repository name, and then by
+	// result key. It is the order of paginated text search, and the order of
+	// cursors created before cursors had an order.
+	SearchCursorOrderRepo SearchCursorOrder = "repo"
+
+	// SearchCursorOrderDate orders commit and diff results by author date,
+	// newest first, and then by result key.
+	SearchCursorOrderDate SearchCursorOrder = "date"
+
+	// SearchCursorOrderRank orders results by result type (files, commits,
+	// diffs, and then repositories), and then by result key.
+	SearchCursorOrderRank SearchCursorOrder = "rank"
+)
+
+// Validate returns an error if o is not a known order. The zero value is
+// valid and is equivalent to SearchCursorOrderRepo.
+func (o SearchCursorOrder) Validate() error {
+	switch o {
+	case "", SearchCursorOrderRepo, SearchCursorOrderDate, SearchCursorOrderRank:
+		return nil
+	}
+	return fmt.Errorf("invalid search cursor order %q", string(o))
+}
+
+// Equal reports whether o and other are the same order.
+func (o SearchCursorOrder) Equal(other SearchCursorOrder) bool {
+	return o.orDefault() == other.orDefault()
+}
+
+func (o SearchCursorOrder) orDefault() SearchCursorOrder {
+	if o == "" {
+		return SearchCursorOrderRepo
+	}
+	return o
+}
+
+// SearchCursorPosition identifies the position of a result in an order. It
+// only depends on the result itself, so a position remains meaningful while
+// the set of repositories being searched changes.
+type SearchCursorPosition struct {
+	Key result.Key
+
+	// Date is the author date of a commit or diff result.
+	Date time.Time
+}
+
+// PositionOf returns the position of the given match.
+func PositionOf(match result.Match) SearchCursorPosition {
+	position := SearchCursorPosition{Key: match.Key()}
+	if commit, ok := match.(*result.CommitMatch); ok {
+		position.Date = commit.Commit.Author.Date
+	}
+	return position
+}
+
+func (p SearchCursorPosition) equal(other SearchCursorPosition) bool {
+	return p.Key == other.Key && p.Date.Equal(other.Date)
+}
+
+// less reports whether the position a sorts before the position b in o.
+func (o SearchCursorOrder) less(a, b SearchCursorPosition) bool {
+	switch o.orDefault() {
+	case SearchCursorOrderDate:
+		if !a.Date.Equal(b.Date) {
+			return a.Date.After(b.Date)
+		}
+	case SearchCursorOrderRank:
+		if a.Key.TypeRank != b.Key.TypeRank {
+			return a.Key.TypeRank < b.Key.TypeRank
+		}
+	}
+	return a.Key.Less(b.Key)
+}
+
+// Sort sorts matches in o.
+func (o SearchCursorOrder) Sort(matches []result.Match) {
+	sort.SliceStable(matches, func(i, j int) bool {
+		return o.less(PositionOf(matches[i]), PositionOf(matches[j]))
+	})
+}
+
+// MatchesAfter returns the suffix of matches that follows the match at the
+// given position. If no match is at the position, e.g. because the match no
+// longer exists, the suffix of matches ordered after the position in o is
+// returned. The matches must be in a deterministic order consistent with o.
+func (o SearchCursorOrder) MatchesAfter(matches []result.Match, after SearchCursorPosition) []result.Match {
+	for i, match := range matches {
+		if PositionOf(match).equal(after) {
+			return matches[i+1:]
+		}
+	}
+	for i, match := range matches {
+		if o.less(after, PositionOf(match)) {
+			return matches[i:]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package run
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
+)
+
+func TestSearchCursorOrder(t *testing.T) {
+	foo := types.RepoName{ID: 1, Name: "foo"}
+	bar := types.RepoName{ID: 2, Name: "bar"}
+	day := func(n int) time.Time { return time.Date(2021, 1, n, 0, 0, 0, 0, time.UTC) }
+
+	fileMatch := func(repo types.RepoName, path string) *result.FileMatch {
+		return &result.FileMatch{File: result.File{Repo: repo, Path: path}}
+	}
+	commitMatch := func(repo types.RepoName, id string, date time.Time) *result.CommitMatch {
+		return &result.CommitMatch{
+			RepoName: repo,
+			Commit:   git.Commit{ID: api.CommitID(id), Author: git.Signature{Date: date}},
+		}
+	}
+
+	fooB := fileMatch(foo, "b.go")
+	barA := fileMatch(bar, "a.go")
+	barRepo := &result.RepoMatch{ID: bar.ID, Name: bar.Name}
+	c1 := commitMatch(foo, "c1", day(2))
+	c2 := commitMatch(bar, "c2", day(3))
+	c3 := commitMatch(foo, "c3", day(1))
+
+	matches := []result.Match{fooB, barRepo, c1, c2, barA, c3}
+
+	t.Run("Sort", func(t *testing.T) {
+		cases := []struct {
+			order SearchCursorOrder
+			want  []result.Match
+		}{
+			{order: "", want: []result.Match{barRepo, barA, c2, fooB, c1, c3}},
+			{order: SearchCursorOrderRepo, want: []result.Match{barRepo, barA, c2, fooB, c1, c3}},
+			{order: SearchCursorOrderDate, want: []result.Match{c2, c1, c3, barRepo, barA, fooB}},
+			{order: SearchCursorOrderRank, want: []result.Match{barA, fooB, c2, c1, c3, barRepo}},
+		}
+
+		for _, tc := range cases {
+			t.Run(string(tc.order), func(t *testing.T) {
+				have := append([]result.Match(nil), matches...)
+				tc.order.Sort(have)
+				if diff := cmp.Diff(tc.want, have); diff != "" {
+					t.Error(diff)
+				}
+			})
+		}
+	})
+
+	t.Run("MatchesAfter", func(t *testing.T) {
+		sorted := []result.Match{c2, c1, c3, barRepo, barA, fooB}
+		removed := commitMatch(foo, "c0", day(2).Add(time.Hour))
+
+		cases := []struct {
+			name  string
+			after result.Match
+			want  []result.Match
+		}{
+			{name: "existing match", after: c1, want: []result.Match{c3, barRepo, barA, fooB}},
+			{name: "removed match", after: removed, want: []result.Match{c1, c3, barRepo, barA, fooB}},
+			{name: "last match", after: fooB, want: []result.Match{}},
+		}
+
+		for _, tc := range cases {
+			t.Run(tc.name, func(t *testing.T) {
+				have := SearchCursorOrderDate.MatchesAfter(sorted, PositionOf(tc.after))
+				if len(have) == 0 && len(tc.want) == 0 {
+					return
+				}
+				if diff := cmp.Diff(tc.want, have); diff != "" {
+					t.Error(diff)
+				}
+			})
+		}
+	})
+
+	t.Run("Validate", func(t *testing.T) {
+		for _, order := range []SearchCursorOrder{"", SearchCursorOrderRepo, SearchCursorOrderDate, SearchCursorOrderRank} {
+			if err := order.Validate(); err != nil {
+				t.Errorf("unexpected error for order %q: %s", order, err)
+			}
+		}
+		if err := SearchCursorOrder("size").Validate(); err == nil {
+			t.Error("expected an error for an unknown order")
+		}
+	})
+}
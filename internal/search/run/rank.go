@@ -0,0 +1,212 @@
+package run
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
+)
+
+const (
+	// activityWeight is the score of a repository revision committed to just
+	// now. Stars are counted on a logarithmic scale, so this is worth as much
+	// as about 20 stars.
+	activityWeight = 3.0
+
+	// activityHalfLife is the age of the last commit of a revision at which its
+	// activity score is halved.
+	activityHalfLife = 30 * 24 * time.Hour
+
+	// depthWeight is the score subtracted for each directory a matched file is
+	// nested in, so that files closer to the root of a repository come first.
+	depthWeight = 0.1
+
+	// rankConcurrency bounds the number of concurrent requests to gitserver
+	// made to find the date of the last commit of the searched revisions.
+	rankConcurrency = 16
+)
+
+// rankSignals are the signals used to score matches.
+type rankSignals struct {
+	// stars maps repositories to their star count on the code host.
+	stars map[api.RepoID]int
+
+	// commitDates maps the searched commits to their commit date.
+	commitDates map[api.CommitID]time.Time
+
+	// repoDates maps repositories to the latest date in commitDates among
+	// the commits of the repository. It is used for matches that do not
+	// refer to a commit, such as repository matches.
+	repoDates map[api.RepoID]time.Time
+}
+
+// RankMatches reorders matches by a score combining the star count of their
+// repository, how recently the searched revision was committed to, and for
+// file matches how deeply nested their path is. Matches with the same score
+// keep their relative order, so callers should sort matches by name first.
+// Commit and diff matches are not ranked and are placed after all other
+// matches.
+//
+// Signals that cannot be retrieved are treated as missing rather than failing
+// the search.
+func RankMatches(ctx context.Context, db dbutil.DB, matches []result.Match) {
+	if len(matches) < 2 {
+		return
+	}
+
+	signals := fetchRankSignals(ctx, db, matches)
+	now := time.Now()
+
+	type scoredMatch struct {
+		match  result.Match
+		ranked bool
+		score  float64
+	}
+	scored := make([]scoredMatch, 0, len(matches))
+	for _, match := range matches {
+		score, ranked := signals.score(match, now)
+		scored = append(scored, scoredMatch{match: match, ranked: ranked, score: score})
+	}
+
+	sort.SliceStable(scored, func(i, j int) bool {
+		if scored[i].ranked != scored[j].ranked {
+			return scored[i].ranked
+		}
+		return scored[i].score > scored[j].score
+	})
+	for i := range scored {
+		matches[i] = scored[i].match
+	}
+}
+
+// score returns the score of match at the given time, and false if match is
+// not ranked.
+func (s *rankSignals) score(match result.Match, now time.Time) (float64, bool) {
+	switch m := match.(type) {
+	case *result.RepoMatch:
+		return s.repoScore(m.ID, s.repoDates[m.ID], now), true
+
+	case *result.FileMatch:
+		date, ok := s.commitDates[m.CommitID]
+		if !ok {
+			date = s.repoDates[m.Repo.ID]
+		}
+		depth := strings.Count(strings.Trim(m.Path, "/"), "/")
+		return s.repoScore(m.Repo.ID, date, now) - depthWeight*float64(depth), true
+	}
+	return 0, false
+}
+
+// repoScore returns the score of a revision of the given repository last
+// committed to at the given date, which may be zero if unknown.
+func (s *rankSignals) repoScore(repoID api.RepoID, date, now time.Time) float64 {
+	score := math.Log1p(float64(s.stars[repoID]))
+	if !date.IsZero() {
+		age := now.Sub(date)
+		if age < 0 {
+			age = 0
+		}
+		score += activityWeight * math.Exp2(-float64(age)/float64(activityHalfLife))
+	}
+	return score
+}
+
+// fetchRankSignals retrieves the star counts of the repositories of matches
+// and the commit dates of the commits searched by file matches.
+func fetchRankSignals(ctx context.Context, db dbutil.DB, matches []result.Match) *rankSignals {
+	signals := &rankSignals{
+		stars:       map[api.RepoID]int{},
+		commitDates: map[api.CommitID]time.Time{},
+		repoDates:   map[api.RepoID]time.Time{},
+	}
+
+	type commit struct {
+		repoID   api.RepoID
+		repoName api.RepoName
+		id       api.CommitID
+	}
+	var repoIDs []api.RepoID
+	var commits []commit
+	seenRepos := map[api.RepoID]struct{}{}
+	seenCommits := map[api.CommitID]struct{}{}
+	for _, match := range matches {
+		var repoID api.RepoID
+		switch m := match.(type) {
+		case *result.RepoMatch:
+			repoID = m.ID
+		case *result.FileMatch:
+			repoID = m.Repo.ID
+			if _, ok := seenCommits[m.CommitID]; !ok && m.CommitID != "" {
+				seenCommits[m.CommitID] = struct{}{}
+				commits = append(commits, commit{repoID: m.Repo.ID, repoName: m.Repo.Name, id: m.CommitID})
+			}
+		default:
+			continue
+		}
+		if _, ok := seenRepos[repoID]; !ok {
+			seenRepos[repoID] = struct{}{}
+			repoIDs = append(repoIDs, repoID)
+		}
+	}
+
+	repos, err := database.Repos(db).GetByIDs(ctx, repoIDs...)
+	if err != nil {
+		log15.Warn("Failed to fetch repositories to rank search results", "error", err)
+	}
+	for _, repo := range repos {
+		signals.stars[repo.ID] = repo.Stars
+	}
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		sem     = make(chan struct{}, rankConcurrency)
+		failed  int
+		lastErr error
+	)
+	for _, c := range commits {
+		c := c
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			commit, err := git.GetCommit(ctx, c.repoName, c.id, git.ResolveRevisionOptions{NoEnsureRevision: true})
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				failed++
+				lastErr = err
+				return
+			}
+			date := commit.Author.Date
+			if commit.Committer != nil {
+				date = commit.Committer.Date
+			}
+			signals.commitDates[c.id] = date
+			if date.After(signals.repoDates[c.repoID]) {
+				signals.repoDates[c.repoID] = date
+			}
+		}()
+	}
+	wg.Wait()
+	if failed > 0 {
+		log15.Warn("Failed to fetch commits to rank search results", "failed", failed, "total", len(commits), "error", lastErr)
+	}
+
+	return signals
+}
@@ -0,0 +1,79 @@
+package run
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtesting"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
+)
+
+func TestRankMatches(t *testing.T) {
+	database.Mocks.Repos.GetByIDs = func(_ context.Context, ids ...api.RepoID) ([]*types.Repo, error) {
+		return []*types.Repo{
+			{ID: 1, Name: "popular", Stars: 1000},
+			{ID: 2, Name: "active", Stars: 10},
+			{ID: 3, Name: "stale", Stars: 10},
+		}, nil
+	}
+	defer func() { database.Mocks = database.MockStores{} }()
+
+	now := time.Now()
+	git.Mocks.GetCommit = func(id api.CommitID) (*git.Commit, error) {
+		date := now.Add(-365 * 24 * time.Hour)
+		if id == "active-head" {
+			date = now.Add(-time.Hour)
+		}
+		return &git.Commit{ID: id, Committer: &git.Signature{Date: date}}, nil
+	}
+	defer git.ResetMocks()
+
+	fileMatch := func(repoID api.RepoID, repoName, commit, path string) *result.FileMatch {
+		return &result.FileMatch{File: result.File{
+			Repo:     types.RepoName{ID: repoID, Name: api.RepoName(repoName)},
+			CommitID: api.CommitID(commit),
+			Path:     path,
+		}}
+	}
+	commitMatch := &result.CommitMatch{}
+
+	matches := []result.Match{
+		fileMatch(2, "active", "active-head", "a/b/c.go"),
+		fileMatch(2, "active", "active-head", "d.go"),
+		commitMatch,
+		fileMatch(1, "popular", "popular-head", "a/b/c.go"),
+		&result.RepoMatch{ID: 2, Name: "active"},
+		fileMatch(3, "stale", "stale-head", "d.go"),
+	}
+	RankMatches(context.Background(), new(dbtesting.MockDB), matches)
+
+	var got []string
+	for _, match := range matches {
+		switch m := match.(type) {
+		case *result.FileMatch:
+			got = append(got, string(m.Repo.Name)+"/"+m.Path)
+		case *result.RepoMatch:
+			got = append(got, string(m.Name))
+		case *result.CommitMatch:
+			got = append(got, "commit")
+		}
+	}
+	want := []string{
+		"popular/a/b/c.go",
+		"active/d.go",
+		"active",
+		"active/a/b/c.go",
+		"stale/d.go",
+		"commit",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected order (-want +got):\n%s", diff)
+	}
+}
@@ -50,14 +50,18 @@ func SearchRepositories(ctx context.Context, args *search.TextParameters, limit
 		query.FieldIndex:              {},
 		query.FieldCount:              {},
 		query.FieldTimeout:            {},
+		query.FieldRepoTimeout:        {},
+		query.FieldRank:               {},
 		query.FieldFork:               {},
 		query.FieldArchived:           {},
 		query.FieldVisibility:         {},
 		query.FieldCase:               {},
 		query.FieldRepoHasFile:        {},
 		query.FieldRepoHasCommitAfter: {},
+		query.FieldRepoTopic:          {},
 		query.FieldPatternType:        {},
 		query.FieldSelect:             {},
+		query.FieldCountBy:            {},
 	}
 	// Don't return repo results if the search contains fields that aren't on the allowlist.
 	// Matching repositories based whether they contain files at a certain path (etc.) is not yet implemented.
@@ -4,7 +4,11 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"regexp"
+	"strings"
+	"time"
 
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/search"
@@ -28,6 +32,10 @@ type SearchInputs struct {
 
 	// DefaultLimit is the default limit to use if not specified in query.
 	DefaultLimit int
+
+	// DefaultTimeout is the default timeout to use if not specified in query.
+	// If zero, the timeout of the search backend is used.
+	DefaultTimeout time.Duration
 }
 
 // MaxResults computes the limit for the query.
@@ -80,6 +88,16 @@ type SearchCursor struct {
 	// Finished tells if there are more results for the query or if we've
 	// consumed them all.
 	Finished bool
+
+	// Order is the order of the results being paginated over. The zero value
+	// is SearchCursorOrderRepo.
+	Order SearchCursorOrder `json:",omitempty"`
+
+	// After is the position of the last result consumed. Unlike the offsets
+	// above, it does not depend on the set of repositories being searched, so
+	// it remains valid while repositories are added or removed. If set, it
+	// takes precedence over the offsets.
+	After *SearchCursorPosition `json:",omitempty"`
 }
 
 // handleRepoSearchResult handles the limitHit and searchErr returned by a search function,
@@ -111,10 +129,59 @@ func handleRepoSearchResult(repoRev *search.RepositoryRevisions, limitHit, timed
 	} else if searchErr != nil {
 		fatalErr = searchErr
 	}
-	return streaming.Stats{
+
+	if fatalErr == nil && status&(search.RepoStatusCloning|search.RepoStatusMissing|search.RepoStatusTimedout) == 0 {
+		status |= search.RepoStatusSearched
+	}
+
+	stats := streaming.Stats{
 		Status:     search.RepoStatusSingleton(repoRev.Repo.ID, status),
 		IsLimitHit: limitHit,
-	}, fatalErr
+	}
+	if skipped, ok := skippedRepo(repoRev, status); ok {
+		stats.SkippedRepos = map[api.RepoID]streaming.SkippedRepo{repoRev.Repo.ID: skipped}
+	}
+	return stats, fatalErr
+}
+
+// skippedRepo describes why the repository of repoRev was not searched given
+// its search status, and suggests how to narrow the query to search it on
+// retry. It returns false if the repository was searched.
+func skippedRepo(repoRev *search.RepositoryRevisions, status search.RepoStatus) (streaming.SkippedRepo, bool) {
+	skipped := streaming.SkippedRepo{Repo: repoRev.Repo}
+	switch {
+	case status&search.RepoStatusCloning != 0:
+		skipped.Reason = search.RepoStatusCloning
+		skipped.Message = "The repository is still being cloned. Try again once cloning has completed."
+		skipped.SuggestedQuery = repoFilter(repoRev)
+	case status&search.RepoStatusMissing != 0:
+		skipped.Reason = search.RepoStatusMissing
+		skipped.Message = "The repository could not be found."
+	case status&search.RepoStatusTimedout != 0:
+		skipped.Reason = search.RepoStatusTimedout
+		skipped.Message = "The repository could not be searched in time. Narrow the search to this repository or increase the timeout with timeout:."
+		skipped.SuggestedQuery = repoFilter(repoRev)
+	default:
+		return streaming.SkippedRepo{}, false
+	}
+	return skipped, true
+}
+
+// repoFilter returns a repo: filter that matches exactly the repository and
+// revisions of repoRev.
+func repoFilter(repoRev *search.RepositoryRevisions) string {
+	filter := "repo:^" + regexp.QuoteMeta(string(repoRev.Repo.Name)) + "$"
+
+	var revs []string
+	for _, rev := range repoRev.Revs {
+		if s := rev.String(); s != "" {
+			revs = append(revs, s)
+		}
+	}
+	if len(revs) > 0 {
+		filter += "@" + strings.Join(revs, ":")
+	}
+	return filter
 }
 
 // getRepos is a wrapper around p.Get. It returns an error if the promise
@@ -1,6 +1,16 @@
 package run
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+)
 
 func TestOrderedFuzzyRegexp(t *testing.T) {
 	got := orderedFuzzyRegexp([]string{})
@@ -18,3 +28,63 @@ func TestOrderedFuzzyRegexp(t *testing.T) {
 		t.Errorf("got %q, want %q", got, want)
 	}
 }
+
+func TestHandleRepoSearchResultSkipped(t *testing.T) {
+	repo := types.RepoName{ID: 1, Name: "github.com/foo/bar.baz"}
+	repoRev := &search.RepositoryRevisions{
+		Repo: repo,
+		Revs: []search.RevisionSpecifier{{RevSpec: "main"}},
+	}
+
+	cases := []struct {
+		name     string
+		timedOut bool
+		err      error
+		want     *streaming.SkippedRepo
+	}{{
+		name: "searched",
+	}, {
+		name:     "timed out",
+		timedOut: true,
+		want: &streaming.SkippedRepo{
+			Repo:           repo,
+			Reason:         search.RepoStatusTimedout,
+			Message:        "The repository could not be searched in time. Narrow the search to this repository or increase the timeout with timeout:.",
+			SuggestedQuery: `repo:^github\.com/foo/bar\.baz$@main`,
+		},
+	}, {
+		name: "cloning",
+		err:  &vcs.RepoNotExistError{Repo: repo.Name, CloneInProgress: true},
+		want: &streaming.SkippedRepo{
+			Repo:           repo,
+			Reason:         search.RepoStatusCloning,
+			Message:        "The repository is still being cloned. Try again once cloning has completed.",
+			SuggestedQuery: `repo:^github\.com/foo/bar\.baz$@main`,
+		},
+	}, {
+		name: "missing",
+		err:  &vcs.RepoNotExistError{Repo: repo.Name},
+		want: &streaming.SkippedRepo{
+			Repo:    repo,
+			Reason:  search.RepoStatusMissing,
+			Message: "The repository could not be found.",
+		},
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			stats, err := handleRepoSearchResult(repoRev, false, tc.timedOut, tc.err)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var want map[api.RepoID]streaming.SkippedRepo
+			if tc.want != nil {
+				want = map[api.RepoID]streaming.SkippedRepo{repo.ID: *tc.want}
+			}
+			if diff := cmp.Diff(want, stats.SkippedRepos); diff != "" {
+				t.Errorf("unexpected skipped repos (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
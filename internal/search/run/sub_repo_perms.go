@@ -0,0 +1,73 @@
+package run
+
+import (
+	"context"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+// filterSubRepoPermissions returns the matches the actor of the context can
+// read. File matches are removed if their path is restricted, and diff matches
+// if they change any restricted path.
+func filterSubRepoPermissions(ctx context.Context, checker authz.SubRepoPermissionChecker, matches []result.Match) ([]result.Match, error) {
+	filtered := make([]result.Match, 0, len(matches))
+	for _, m := range matches {
+		ok, err := canReadMatch(ctx, checker, m)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			filtered = append(filtered, m)
+		}
+	}
+	return filtered, nil
+}
+
+func canReadMatch(ctx context.Context, checker authz.SubRepoPermissionChecker, m result.Match) (bool, error) {
+	switch m := m.(type) {
+	case *result.FileMatch:
+		return canReadPath(ctx, checker, m.Repo.Name, m.Path)
+
+	case *result.CommitMatch:
+		if m.DiffPreview == nil {
+			return true, nil
+		}
+		for _, path := range diffPaths(m.DiffPreview.Value) {
+			if ok, err := canReadPath(ctx, checker, m.RepoName.Name, path); err != nil || !ok {
+				return false, err
+			}
+		}
+	}
+
+	return true, nil
+}
+
+func canReadPath(ctx context.Context, checker authz.SubRepoPermissionChecker, repo api.RepoName, path string) (bool, error) {
+	perms, err := authz.ActorPermissions(ctx, checker, authz.RepoContent{Repo: repo, Path: path})
+	if err != nil {
+		return false, err
+	}
+	return perms.Include(authz.Read), nil
+}
+
+// diffPaths returns the old and new paths of the files of a raw diff, taken
+// from its "diff --git a/<old> b/<new>" headers.
+func diffPaths(rawDiff string) []string {
+	var paths []string
+	for _, line := range strings.Split(rawDiff, "\n") {
+		if !strings.HasPrefix(line, "diff --git a/") {
+			continue
+		}
+
+		header := strings.TrimPrefix(line, "diff --git a/")
+		i := strings.LastIndex(header, " b/")
+		if i < 0 {
+			continue
+		}
+		paths = append(paths, header[:i], header[i+len(" b/"):])
+	}
+	return paths
+}
@@ -0,0 +1,87 @@
+package run
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// secretPathsChecker denies access to paths starting with "secret/" of the
+// repository "perforce/depot" to all users but user 2.
+type secretPathsChecker struct{}
+
+func (secretPathsChecker) Permissions(ctx context.Context, userID int32, content authz.RepoContent) (authz.Perms, error) {
+	if userID != 2 && content.Repo == "perforce/depot" && strings.HasPrefix(content.Path, "secret/") {
+		return authz.None, nil
+	}
+	return authz.Read, nil
+}
+
+func TestFilterSubRepoPermissions(t *testing.T) {
+	depot := types.RepoName{ID: 1, Name: "perforce/depot"}
+	fileMatch := func(repo types.RepoName, path string) *result.FileMatch {
+		return &result.FileMatch{File: result.File{Repo: repo, Path: path}}
+	}
+	diffMatch := func(rawDiff string) *result.CommitMatch {
+		return &result.CommitMatch{RepoName: depot, DiffPreview: &result.HighlightedString{Value: rawDiff}}
+	}
+
+	matches := []result.Match{
+		fileMatch(depot, "README.md"),
+		fileMatch(depot, "secret/plans.txt"),
+		fileMatch(types.RepoName{ID: 2, Name: "github.com/foo/bar"}, "secret/plans.txt"),
+		diffMatch("diff --git a/README.md b/README.md\nindex 1..2\n@@ -1 +1 @@\n-a\n+b\n"),
+		diffMatch("diff --git a/README.md b/README.md\n@@ -1 +1 @@\n-a\n+b\ndiff --git a/docs/plans.txt b/secret/plans.txt\n"),
+		&result.CommitMatch{RepoName: depot},
+		&result.RepoMatch{Name: depot.Name, ID: depot.ID},
+	}
+
+	ctx := actor.WithActor(context.Background(), &actor.Actor{UID: 1})
+	have, err := filterSubRepoPermissions(ctx, secretPathsChecker{}, matches)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []result.Match{matches[0], matches[2], matches[3], matches[5], matches[6]}
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Errorf("unexpected matches (-want +have):\n%s", diff)
+	}
+
+	ctx = actor.WithActor(context.Background(), &actor.Actor{UID: 2})
+	have, err = filterSubRepoPermissions(ctx, secretPathsChecker{}, matches)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(matches, have); diff != "" {
+		t.Errorf("unexpected matches of user with access (-want +have):\n%s", diff)
+	}
+}
+
+func TestAggregatorSubRepoPermissions(t *testing.T) {
+	old := authz.DefaultSubRepoPermsChecker
+	authz.DefaultSubRepoPermsChecker = secretPathsChecker{}
+	defer func() { authz.DefaultSubRepoPermsChecker = old }()
+
+	depot := types.RepoName{ID: 1, Name: "perforce/depot"}
+	ctx := actor.WithActor(context.Background(), &actor.Actor{UID: 1})
+
+	var sent []result.Match
+	agg := NewAggregator(ctx, nil, streaming.StreamFunc(func(e streaming.SearchEvent) {
+		sent = append(sent, e.Results...)
+	}))
+	agg.Send(streaming.SearchEvent{Results: []result.Match{
+		&result.FileMatch{File: result.File{Repo: depot, Path: "secret/plans.txt"}},
+		&result.FileMatch{File: result.File{Repo: depot, Path: "README.md"}},
+	}})
+
+	if len(sent) != 1 || sent[0].(*result.FileMatch).Path != "README.md" {
+		t.Errorf("unexpected matches sent: %+v", sent)
+	}
+}
@@ -84,6 +84,16 @@ func SearchFilesInRepo(ctx context.Context, searcherURLs *endpoint.Map, repo typ
 			})
 		}
 
+		var rewrites []*result.Rewrite
+		for _, rw := range fm.Rewrites {
+			rewrites = append(rewrites, &result.Rewrite{
+				StartLine:   int32(rw.StartLine),
+				EndLine:     int32(rw.EndLine),
+				Matched:     rw.Matched,
+				Replacement: rw.Replacement,
+			})
+		}
+
 		fileMatches = append(fileMatches, &result.FileMatch{
 			File: result.File{
 				Path:     fm.Path,
@@ -92,6 +102,7 @@ func SearchFilesInRepo(ctx context.Context, searcherURLs *endpoint.Map, repo typ
 				InputRev: &rev,
 			},
 			LineMatches: lineMatches,
+			Rewrites:    rewrites,
 			LimitHit:    fm.LimitHit,
 		})
 	}
@@ -227,13 +238,14 @@ func SearchFilesInRepos(ctx context.Context, args *search.TextParameters, stream
 	if args.Mode != search.SearcherOnly {
 		// Run searches on indexed repositories.
 
-		if !args.PatternInfo.IsStructuralPat {
+		if !args.PatternInfo.IsStructuralPat && !args.PatternInfo.IsLookaround() {
 			// Run literal and regexp searches.
 			g.Go(func() error {
 				return indexed.Search(ctx, stream)
 			})
 		} else {
-			// Run structural search (fulfilled via searcher).
+			// Run structural searches and regexp searches with lookaround,
+			// which zoekt cannot evaluate (fulfilled via searcher).
 			g.Go(func() error {
 				repos := make([]*search.RepositoryRevisions, 0, len(indexed.Repos()))
 				for _, repo := range indexed.Repos() {
@@ -300,6 +312,22 @@ func callSearcherOverRepos(
 	}
 	textSearchLimiter.SetLimit(len(eps) * 32)
 
+	var repoTimeout time.Duration
+	if t := args.Query.RepoTimeout(); t != nil {
+		repoTimeout = *t
+	}
+	var sizes map[string]int64
+	if args.Zoekt != nil {
+		// Sizes are only known for indexed repositories. Without them every
+		// repository gets the same share of the budget.
+		var sizesErr error
+		sizes, sizesErr = args.Zoekt.RepoSizes(ctx)
+		if sizesErr != nil {
+			log15.Warn("failed to list repository sizes", "error", sizesErr)
+		}
+	}
+	budget := newRepoBudget(ctx, searcherRepos, sizes, repoTimeout, len(eps)*32)
+
 	g, ctx := errgroup.WithContext(ctx)
 	g.Go(func() error {
 		for _, repoAllRevs := range searcherRepos {
@@ -324,13 +352,22 @@ func callSearcherOverRepos(
 					ctx, done := limitCtx, limitDone
 					defer done()
 
-					matches, repoLimitHit, err := SearchFilesInRepo(ctx, args.SearcherURLs, repoRev.Repo, repoRev.GitserverRepo(), repoRev.RevSpecs()[0], index, args.PatternInfo, fetchTimeout)
+					repoCtx := ctx
+					if timeout := budget.timeout(repoRev.Repo); timeout > 0 {
+						var cancel context.CancelFunc
+						repoCtx, cancel = context.WithTimeout(ctx, timeout)
+						defer cancel()
+					}
+
+					matches, repoLimitHit, err := SearchFilesInRepo(repoCtx, args.SearcherURLs, repoRev.Repo, repoRev.GitserverRepo(), repoRev.RevSpecs()[0], index, args.PatternInfo, fetchTimeout)
 					if err != nil {
 						tr.LogFields(otlog.String("repo", string(repoRev.Repo.Name)), otlog.Error(err), otlog.Bool("timeout", errcode.IsTimeout(err)), otlog.Bool("temporary", errcode.IsTemporary(err)))
 						log15.Warn("searchFilesInRepo failed", "error", err, "repo", repoRev.Repo.Name)
 					}
-					// non-diff search reports timeout through err, so pass false for timedOut
-					stats, err := handleRepoSearchResult(repoRev, repoLimitHit, false, err)
+					// non-diff search reports timeout through err, unless the
+					// budget of the revision ran out while the search goes on.
+					timedOut := repoCtx.Err() == context.DeadlineExceeded && ctx.Err() == nil
+					stats, err := handleRepoSearchResult(repoRev, repoLimitHit, timedOut, err)
 					stream.Send(streaming.SearchEvent{
 						Results: fileMatchesToMatches(matches),
 						Stats:   stats,
@@ -102,10 +102,14 @@ func TestSearchFilesInRepos(t *testing.T) {
 		repoNames[rr.Repo.ID] = string(rr.Repo.Name)
 	}
 	assertReposStatus(t, repoNames, common.Status, map[string]search.RepoStatus{
+		"foo/one":              search.RepoStatusSearched,
+		"foo/two":              search.RepoStatusSearched,
+		"foo/empty":            search.RepoStatusSearched,
 		"foo/cloning":          search.RepoStatusCloning,
 		"foo/missing":          search.RepoStatusMissing,
 		"foo/missing-database": search.RepoStatusMissing,
 		"foo/timedout":         search.RepoStatusTimedout,
+		"foo/no-rev":           search.RepoStatusSearched,
 	})
 
 	// If we specify a rev and it isn't found, we fail the whole search since
@@ -127,6 +131,62 @@ func TestSearchFilesInRepos(t *testing.T) {
 	}
 }
 
+func TestSearchFilesInRepos_repoTimeout(t *testing.T) {
+	mockSearchFilesInRepo = func(ctx context.Context, repo types.RepoName, gitserverRepo api.RepoName, rev string, info *search.TextPatternInfo, fetchTimeout time.Duration) (matches []*result.FileMatch, limitHit bool, err error) {
+		switch repo.Name {
+		case "foo/one":
+			return []*result.FileMatch{{
+				File: result.File{
+					Repo:     repo,
+					InputRev: &rev,
+					Path:     "main.go",
+				},
+			}}, false, nil
+		case "foo/slow":
+			<-ctx.Done()
+			return nil, false, ctx.Err()
+		default:
+			return nil, false, errors.New("Unexpected repo")
+		}
+	}
+	defer func() { mockSearchFilesInRepo = nil }()
+
+	q, err := query.ParseLiteral("repotimeout:10ms foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	repoRevs := makeRepositoryRevisions("foo/one", "foo/slow")
+	args := &search.TextParameters{
+		PatternInfo: &search.TextPatternInfo{
+			FileMatchLimit: defaultMaxSearchResults,
+			Pattern:        "foo",
+		},
+		RepoPromise:  (&search.Promise{}).Resolve(repoRevs),
+		Query:        q,
+		Zoekt:        &searchbackend.Zoekt{Client: &searchbackend.FakeSearcher{}},
+		SearcherURLs: endpoint.Static("test"),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	matches, common, err := SearchFilesInReposBatch(ctx, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matches) != 1 {
+		t.Errorf("expected one result, got %d", len(matches))
+	}
+	repoNames := map[api.RepoID]string{}
+	for _, rr := range repoRevs {
+		repoNames[rr.Repo.ID] = string(rr.Repo.Name)
+	}
+	assertReposStatus(t, repoNames, common.Status, map[string]search.RepoStatus{
+		"foo/one":  search.RepoStatusSearched,
+		"foo/slow": search.RepoStatusTimedout,
+	})
+}
+
 func TestSearchFilesInReposStream(t *testing.T) {
 	mockSearchFilesInRepo = func(ctx context.Context, repo types.RepoName, gitserverRepo api.RepoName, rev string, info *search.TextPatternInfo, fetchTimeout time.Duration) (matches []*result.FileMatch, limitHit bool, err error) {
 		repoName := repo.Name
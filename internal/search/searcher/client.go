@@ -56,15 +56,18 @@ func Search(ctx context.Context, searcherURLs *endpoint.Map, repo api.RepoName,
 	}()
 
 	q := url.Values{
-		"Repo":            []string{string(repo)},
-		"Commit":          []string{string(commit)},
-		"Branch":          []string{branch},
-		"Pattern":         []string{p.Pattern},
-		"ExcludePattern":  []string{p.ExcludePattern},
-		"IncludePatterns": p.IncludePatterns,
-		"FetchTimeout":    []string{fetchTimeout.String()},
-		"Languages":       p.Languages,
-		"CombyRule":       []string{p.CombyRule},
+		"Repo":                   []string{string(repo)},
+		"Commit":                 []string{string(commit)},
+		"Branch":                 []string{branch},
+		"Pattern":                []string{p.Pattern},
+		"ExcludePattern":         []string{p.ExcludePattern},
+		"IncludePatterns":        p.IncludePatterns,
+		"ExcludeContentPatterns": p.ExcludeContentPatterns,
+		"IncludeContentPatterns": p.IncludeContentPatterns,
+		"FetchTimeout":           []string{fetchTimeout.String()},
+		"Languages":              p.Languages,
+		"CombyRule":              []string{p.CombyRule},
+		"CombyRewrite":           []string{p.CombyRewrite},
 
 		"PathPatternsAreRegExps": []string{"true"},
 		"IndexerEndpoints":       indexerEndpoints,
@@ -18,6 +18,7 @@ func BuildProgressEvent(stats ProgressStats) Progress {
 
 	return Progress{
 		RepositoriesCount: stats.RepositoriesCount,
+		Repositories:      buildRepositoriesProgress(stats),
 		MatchCount:        stats.MatchCount,
 		DurationMs:        stats.ElapsedMilliseconds,
 		Skipped:           skipped,
@@ -25,6 +26,56 @@ func BuildProgressEvent(stats ProgressStats) Progress {
 	}
 }
 
+// buildRepositoriesProgress estimates how far the search has gotten by
+// assuming every repository takes the same time to search.
+func buildRepositoriesProgress(stats ProgressStats) *RepositoriesProgress {
+	if stats.TotalRepositories == nil {
+		return nil
+	}
+
+	total := *stats.TotalRepositories
+	progress := &RepositoriesProgress{
+		Total:    total,
+		Searched: stats.SearchedRepositories,
+		Skipped:  stats.SkippedRepositories,
+	}
+
+	for _, skipped := range []struct {
+		reason SkippedReason
+		repos  []Namer
+	}{
+		{RepositoryMissing, stats.Missing},
+		{RepositoryCloning, stats.Cloning},
+		{RepositoryLimit, stats.RepositoryLimit},
+		{ShardTimeout, stats.Timedout},
+	} {
+		if len(skipped.repos) == 0 {
+			continue
+		}
+		if progress.SkippedReasons == nil {
+			progress.SkippedReasons = map[SkippedReason]int{}
+		}
+		progress.SkippedReasons[skipped.reason] = len(skipped.repos)
+	}
+
+	completed := stats.SearchedRepositories + stats.SkippedRepositories
+	if completed > total {
+		completed = total
+	}
+
+	progress.PercentComplete = 100
+	if total > 0 {
+		progress.PercentComplete = completed * 100 / total
+	}
+
+	if completed > 0 && completed < total {
+		remaining := stats.ElapsedMilliseconds * (total - completed) / completed
+		progress.RemainingMs = &remaining
+	}
+
+	return progress
+}
+
 type Namer interface {
 	Name() string
 }
@@ -36,9 +87,21 @@ type ProgressStats struct {
 	ExcludedArchived    int
 	ExcludedForks       int
 
-	Timedout []Namer
-	Missing  []Namer
-	Cloning  []Namer
+	// TotalRepositories is the number of repositories being searched. It is
+	// nil until the set of repositories has been resolved.
+	TotalRepositories *int
+
+	// SearchedRepositories is the number of repositories searched so far.
+	SearchedRepositories int
+
+	// SkippedRepositories is the number of distinct repositories that could
+	// not be searched.
+	SkippedRepositories int
+
+	Timedout        []Namer
+	Missing         []Namer
+	Cloning         []Namer
+	RepositoryLimit []Namer
 
 	LimitHit bool
 
@@ -96,6 +159,15 @@ func repositoryMissingHandler(resultsResolver ProgressStats) (Skipped, bool) {
 	})
 }
 
+func repositoryLimitHandler(resultsResolver ProgressStats) (Skipped, bool) {
+	repos := resultsResolver.RepositoryLimit
+	messageReason := fmt.Sprintf("%s not searched since the number of repositories to search was too large", plural("was", "were", len(repos)))
+	return skippedReposHandler(repos, "excluded by limit", messageReason, Skipped{
+		Reason:   RepositoryLimit,
+		Severity: SeverityWarn,
+	})
+}
+
 func shardTimeoutHandler(resultsResolver ProgressStats) (Skipped, bool) {
 	// This is not the same, but once we expose this more granular details
 	// from our backend it will be shard specific.
@@ -191,7 +263,7 @@ var skippedHandlers = []func(stats ProgressStats) (Skipped, bool){
 	repositoryCloningHandler,
 	// documentMatchLimitHandler,
 	shardMatchLimitHandler,
-	// repositoryLimitHandler,
+	repositoryLimitHandler,
 	shardTimeoutHandler,
 	excludedForkHandler,
 	excludedArchiveHandler,
@@ -50,6 +50,15 @@ func TestSearchProgress(t *testing.T) {
 		"traced": {
 			Trace: "abcd",
 		},
+		"repositories": {
+			ElapsedMilliseconds:  3000,
+			TotalRepositories:    intPtr(10),
+			SearchedRepositories: 4,
+			SkippedRepositories:  2,
+			Cloning:              []Namer{repo{"cloning-1"}},
+			RepositoryLimit:      []Namer{repo{"limit-1"}, repo{"limit-2"}},
+			DisplayLimit:         math.MaxInt32,
+		},
 	}
 
 	for name, c := range cases {
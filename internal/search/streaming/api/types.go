@@ -9,6 +9,11 @@ type Progress struct {
 	// non-nil once the set of repositories has been resolved.
 	RepositoriesCount *int `json:"repositoriesCount,omitempty"`
 
+	// Repositories describes how far the search has gotten through the
+	// repositories it searches. It is non-nil once the set of repositories
+	// has been resolved.
+	Repositories *RepositoriesProgress `json:"repositories,omitempty"`
+
 	// MatchCount is number of non-overlapping matches. If skipped is
 	// non-empty, then this is a lower bound.
 	MatchCount int `json:"matchCount"`
@@ -23,10 +28,40 @@ type Progress struct {
 	// found, it may appear anywhere in the list.
 	Skipped []Skipped `json:"skipped"`
 
+	// SkippedRepositories lists each repository that could not be searched,
+	// ordered by name. It is only set on the final progress event.
+	SkippedRepositories []SkippedRepository `json:"skippedRepositories,omitempty"`
+
 	// Trace is the URL of an associated trace if the query is logging one.
 	Trace string `json:"trace,omitempty"`
 }
 
+// RepositoriesProgress describes how far a search has gotten through the
+// repositories it searches.
+type RepositoriesProgress struct {
+	// Total is the number of repositories being searched.
+	Total int `json:"total"`
+
+	// Searched is the number of repositories that have been searched.
+	Searched int `json:"searched"`
+
+	// Skipped is the number of repositories that could not be searched. A
+	// repository skipped for more than one reason is only counted once.
+	Skipped int `json:"skipped"`
+
+	// SkippedReasons is the number of skipped repositories by reason. eg
+	// RepositoryCloning.
+	SkippedReasons map[SkippedReason]int `json:"skippedReasons,omitempty"`
+
+	// PercentComplete is an estimate from 0 to 100 of how much of the search
+	// has completed, based on the number of repositories searched or skipped.
+	PercentComplete int `json:"percentComplete"`
+
+	// RemainingMs is an estimate of the wall clock time in milliseconds until
+	// the search completes. It is nil until an estimate can be made.
+	RemainingMs *int `json:"remainingMs,omitempty"`
+}
+
 // Skipped is a description of shards or documents that were skipped.
 type Skipped struct {
 	// Reason is why a document/shard/repository was skipped. We group counts
@@ -42,6 +77,19 @@ type Skipped struct {
 	Suggested *SkippedSuggested `json:"suggested,omitempty"`
 }
 
+// SkippedRepository describes a single repository that could not be searched.
+type SkippedRepository struct {
+	// Name is the name of the repository.
+	Name string `json:"name"`
+	// Reason is why the repository was skipped. eg ShardTimeout
+	Reason SkippedReason `json:"reason"`
+	// Message is a message to show the user explaining the reason.
+	Message string `json:"message,omitempty"`
+	// Suggested is a query expression to search the repository on retry. eg
+	// a repo: filter narrowing the query to the repository.
+	Suggested *SkippedSuggested `json:"suggested,omitempty"`
+}
+
 // SkippedSuggested is a query to suggest to the user to resolve the reason
 // for skipping.
 type SkippedSuggested struct {
@@ -168,6 +168,10 @@ func (r *eventMatchUnmarshaller) UnmarshalJSON(b []byte) error {
 		r.EventMatch = &EventSymbolMatch{}
 	case CommitMatchType:
 		r.EventMatch = &EventCommitMatch{}
+	case CountMatchType:
+		r.EventMatch = &EventCountMatch{}
+	case RewriteMatchType:
+		r.EventMatch = &EventRewriteMatch{}
 	default:
 		return fmt.Errorf("unknown MatchType %v", typeU.Type)
 	}
@@ -39,11 +39,33 @@ func TestDecoder(t *testing.T) {
 			&EventSymbolMatch{
 				Type: SymbolMatchType,
 				Path: "test",
+				Symbols: []Symbol{{
+					Name:          "Method",
+					ContainerName: "pkg.Type",
+					Kind:          "METHOD",
+					QualifiedName: "pkg.Type.Method",
+					Parents:       []string{"pkg", "Type"},
+					Signature:     "(a int)",
+				}},
 			},
 			&EventCommitMatch{
 				Type:   CommitMatchType,
 				Detail: "test",
 			},
+			&EventCountMatch{
+				Type:       CountMatchType,
+				By:         "repo",
+				Repository: "test",
+				Count:      5,
+			},
+			&EventRewriteMatch{
+				Type:       RewriteMatchType,
+				Path:       "test",
+				Repository: "test",
+				Rewrites: []EventRewrite{
+					{StartLine: 1, EndLine: 2, Matched: "foo(x, x)", Replacement: "bar(x)"},
+				},
+			},
 		},
 	}, {
 		Name: "filters",
@@ -23,6 +23,10 @@ type EventFileMatch struct {
 	Version    string   `json:"version,omitempty"`
 
 	LineMatches []EventLineMatch `json:"lineMatches"`
+
+	// Truncated is true if line matches were omitted or shortened to limit
+	// the size of the event.
+	Truncated bool `json:"truncated,omitempty"`
 }
 
 func (e *EventFileMatch) eventMatch() {}
@@ -34,6 +38,30 @@ type EventLineMatch struct {
 	OffsetAndLengths [][2]int32 `json:"offsetAndLengths"`
 }
 
+// EventRewriteMatch is EventFileMatch but with the rewrites of structural
+// matches by the replace: template of a query instead of LineMatches.
+type EventRewriteMatch struct {
+	// Type is always RewriteMatchType. Included here for marshalling.
+	Type MatchType `json:"type"`
+
+	Path       string   `json:"name"`
+	Repository string   `json:"repository"`
+	Branches   []string `json:"branches,omitempty"`
+	Version    string   `json:"version,omitempty"`
+
+	Rewrites []EventRewrite `json:"rewrites"`
+}
+
+func (e *EventRewriteMatch) eventMatch() {}
+
+// EventRewrite is a structural match and the content it is rewritten to.
+type EventRewrite struct {
+	StartLine   int32  `json:"startLine"`
+	EndLine     int32  `json:"endLine"`
+	Matched     string `json:"matched"`
+	Replacement string `json:"replacement"`
+}
+
 // EventRepoMatch is a subset of zoekt.FileMatch for our Event API.
 type EventRepoMatch struct {
 	// Type is always RepoMatchType. Included here for marshalling.
@@ -65,6 +93,17 @@ type Symbol struct {
 	Name          string `json:"name"`
 	ContainerName string `json:"containerName"`
 	Kind          string `json:"kind"`
+
+	// QualifiedName is Name qualified by the names of the symbols that
+	// contain it, e.g. "pkg.Type.Method".
+	QualifiedName string `json:"qualifiedName,omitempty"`
+
+	// Parents are the names of the symbols that contain this symbol,
+	// outermost first.
+	Parents []string `json:"parents,omitempty"`
+
+	// Signature is the signature of a function or method, e.g. "(a int)".
+	Signature string `json:"signature,omitempty"`
 }
 
 // EventCommitMatch is the generic results interface from GQL. There is a lot
@@ -86,6 +125,22 @@ type EventCommitMatch struct {
 
 func (e *EventCommitMatch) eventMatch() {}
 
+// EventCountMatch is the number of results of a count-by aggregation in a
+// single group. Counts of the same group are sent as more results are found,
+// so clients sum the counts of each group.
+type EventCountMatch struct {
+	// Type is always CountMatchType. Included here for marshalling.
+	Type MatchType `json:"type"`
+
+	By         string `json:"by"`
+	Repository string `json:"repository,omitempty"`
+	Path       string `json:"name,omitempty"`
+	Author     string `json:"author,omitempty"`
+	Count      int    `json:"count"`
+}
+
+func (e *EventCountMatch) eventMatch() {}
+
 // EventFilter is a suggestion for a search filter. Currently has a 1-1
 // correspondance with the SearchFilter graphql type.
 type EventFilter struct {
@@ -123,6 +178,8 @@ const (
 	RepoMatchType
 	SymbolMatchType
 	CommitMatchType
+	CountMatchType
+	RewriteMatchType
 )
 
 func (t MatchType) MarshalJSON() ([]byte, error) {
@@ -135,6 +192,10 @@ func (t MatchType) MarshalJSON() ([]byte, error) {
 		return []byte(`"symbol"`), nil
 	case CommitMatchType:
 		return []byte(`"commit"`), nil
+	case CountMatchType:
+		return []byte(`"count"`), nil
+	case RewriteMatchType:
+		return []byte(`"rewrite"`), nil
 	default:
 		return nil, fmt.Errorf("unknown MatchType: %d", t)
 	}
@@ -150,6 +211,10 @@ func (t *MatchType) UnmarshalJSON(b []byte) error {
 		*t = SymbolMatchType
 	} else if bytes.Equal(b, []byte(`"commit"`)) {
 		*t = CommitMatchType
+	} else if bytes.Equal(b, []byte(`"count"`)) {
+		*t = CountMatchType
+	} else if bytes.Equal(b, []byte(`"rewrite"`)) {
+		*t = RewriteMatchType
 	} else {
 		return fmt.Errorf("unknown MatchType: %s", b)
 	}
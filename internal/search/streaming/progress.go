@@ -3,6 +3,7 @@ package streaming
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
@@ -33,6 +34,30 @@ type Stats struct {
 
 	// IsIndexUnavailable is true if indexed search was unavailable.
 	IsIndexUnavailable bool
+
+	// SkippedRepos describes the repositories that could not be searched,
+	// keyed by repository ID.
+	SkippedRepos map[api.RepoID]SkippedRepo
+}
+
+// SkippedRepo describes why a repository could not be searched, and how the
+// user may get results from it.
+type SkippedRepo struct {
+	// Repo is the repository that could not be searched.
+	Repo types.RepoName
+
+	// Reason is the status of the repository that caused it to be skipped,
+	// e.g. search.RepoStatusTimedout.
+	Reason search.RepoStatus
+
+	// Message is a human readable explanation of why the repository was
+	// skipped.
+	Message string
+
+	// SuggestedQuery is a query expression to add to the query to search the
+	// repository on retry, e.g. a repo: filter narrowing the search to it. It
+	// is empty if retrying would not help.
+	SuggestedQuery string
 }
 
 // update updates c with the other data, deduping as necessary. It modifies c but
@@ -56,6 +81,16 @@ func (c *Stats) Update(other *Stats) {
 
 	c.ExcludedForks = c.ExcludedForks + other.ExcludedForks
 	c.ExcludedArchived = c.ExcludedArchived + other.ExcludedArchived
+
+	if c.SkippedRepos == nil && len(other.SkippedRepos) > 0 {
+		c.SkippedRepos = make(map[api.RepoID]SkippedRepo, len(other.SkippedRepos))
+	}
+	for id, skipped := range other.SkippedRepos {
+		// The first reason reported for a repository is kept.
+		if _, ok := c.SkippedRepos[id]; !ok {
+			c.SkippedRepos[id] = skipped
+		}
+	}
 }
 
 // Zero returns true if stats is empty. IE calling Update will result in no
@@ -70,7 +105,8 @@ func (c *Stats) Zero() bool {
 		c.Status.Len() > 0 ||
 		c.ExcludedForks > 0 ||
 		c.ExcludedArchived > 0 ||
-		c.IsIndexUnavailable)
+		c.IsIndexUnavailable ||
+		len(c.SkippedRepos) > 0)
 }
 
 func (c *Stats) String() string {
@@ -88,6 +124,7 @@ func (c *Stats) String() string {
 		{"repos", len(c.Repos)},
 		{"excludedForks", c.ExcludedForks},
 		{"excludedArchived", c.ExcludedArchived},
+		{"skippedRepos", len(c.SkippedRepos)},
 	}
 	for _, p := range nums {
 		if p.n != 0 {
@@ -109,6 +146,46 @@ func (c *Stats) Equal(other *Stats) bool {
 	return reflect.DeepEqual(c, other)
 }
 
+// skippedRepoStatuses are the statuses of repositories that could not be
+// searched, in the order of precedence used to pick a single reason.
+var skippedRepoStatuses = []search.RepoStatus{
+	search.RepoStatusCloning,
+	search.RepoStatusMissing,
+	search.RepoStatusTimedout,
+	search.RepoStatusRepoLimit,
+}
+
+// Skipped returns the repositories that could not be searched, ordered by
+// name. Repositories marked as skipped by their status without a description
+// in SkippedRepos are included with only their reason set.
+func (c *Stats) Skipped() []SkippedRepo {
+	if c == nil {
+		return nil
+	}
+
+	var skipped []SkippedRepo
+	c.Status.Iterate(func(id api.RepoID, status search.RepoStatus) {
+		if s, ok := c.SkippedRepos[id]; ok {
+			skipped = append(skipped, s)
+			return
+		}
+		repo, ok := c.Repos[id]
+		if !ok {
+			return
+		}
+		for _, reason := range skippedRepoStatuses {
+			if status&reason != 0 {
+				skipped = append(skipped, SkippedRepo{Repo: repo, Reason: reason})
+				return
+			}
+		}
+	})
+	sort.Slice(skipped, func(i, j int) bool {
+		return skipped[i].Repo.Name < skipped[j].Repo.Name
+	})
+	return skipped
+}
+
 func (c *Stats) AllReposTimedOut() bool {
 	return c.Status.All(search.RepoStatusTimedout) && c.Status.Len() == len(c.Repos)
 }
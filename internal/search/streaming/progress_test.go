@@ -0,0 +1,49 @@
+package streaming
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestStatsSkipped(t *testing.T) {
+	searched := types.RepoName{ID: 1, Name: "a/searched"}
+	timedout := types.RepoName{ID: 2, Name: "c/timedout"}
+	cloning := types.RepoName{ID: 3, Name: "b/cloning"}
+
+	var stats Stats
+	for _, repo := range []types.RepoName{searched, timedout, cloning} {
+		stats.Update(&Stats{Repos: map[api.RepoID]types.RepoName{repo.ID: repo}})
+	}
+
+	// The status of timed out repositories reported by indexed search comes
+	// without a description.
+	stats.Update(&Stats{Status: search.RepoStatusSingleton(searched.ID, search.RepoStatusSearched)})
+	stats.Update(&Stats{Status: search.RepoStatusSingleton(timedout.ID, search.RepoStatusTimedout)})
+	stats.Update(&Stats{
+		Status: search.RepoStatusSingleton(cloning.ID, search.RepoStatusCloning),
+		SkippedRepos: map[api.RepoID]SkippedRepo{cloning.ID: {
+			Repo:           cloning,
+			Reason:         search.RepoStatusCloning,
+			Message:        "cloning",
+			SuggestedQuery: "repo:^b/cloning$",
+		}},
+	})
+
+	want := []SkippedRepo{{
+		Repo:           cloning,
+		Reason:         search.RepoStatusCloning,
+		Message:        "cloning",
+		SuggestedQuery: "repo:^b/cloning$",
+	}, {
+		Repo:   timedout,
+		Reason: search.RepoStatusTimedout,
+	}}
+	if diff := cmp.Diff(want, stats.Skipped()); diff != "" {
+		t.Errorf("unexpected skipped repos (-want +got):\n%s", diff)
+	}
+}
@@ -3,14 +3,27 @@ package search
 
 import (
 	"regexp/syntax"
+
+	"github.com/sourcegraph/sourcegraph/internal/search/backtrack"
 )
 
 func (p *TextPatternInfo) IsEmpty() bool {
-	return p.Pattern == "" && p.ExcludePattern == "" && len(p.IncludePatterns) == 0
+	return p.Pattern == "" && p.ExcludePattern == "" && len(p.IncludePatterns) == 0 && len(p.IncludeContentPatterns) == 0
+}
+
+// IsLookaround returns true if the pattern is a regular expression containing
+// lookahead or lookbehind assertions. Such patterns are not supported by zoekt
+// and are evaluated by searcher with a backtracking matcher instead.
+func (p *TextPatternInfo) IsLookaround() bool {
+	return p.IsRegExp && backtrack.HasLookaround(p.Pattern)
 }
 
 func (p *TextPatternInfo) Validate() error {
-	if p.IsRegExp {
+	if p.IsLookaround() {
+		if _, err := backtrack.Compile(p.Pattern); err != nil {
+			return err
+		}
+	} else if p.IsRegExp {
 		if _, err := syntax.Parse(p.Pattern, syntax.Perl); err != nil {
 			return err
 		}
@@ -26,6 +39,11 @@ func (p *TextPatternInfo) Validate() error {
 			return err
 		}
 	}
+	for _, expr := range p.IncludeContentPatterns {
+		if _, err := syntax.Parse(expr, syntax.Perl); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
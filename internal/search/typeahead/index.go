@@ -0,0 +1,326 @@
+// Package typeahead maintains an in-memory index of repository names and
+// recently viewed file paths. It answers typeahead queries by prefix lookups
+// in a trie instead of running a search.
+package typeahead
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+// Kind is the kind of an indexed entry.
+type Kind string
+
+const (
+	KindRepo Kind = "repo"
+	KindFile Kind = "file"
+)
+
+// Entry is a suggestion held by the index.
+type Entry struct {
+	Kind     Kind
+	RepoID   api.RepoID
+	RepoName api.RepoName
+
+	// CommitID and Path are only set for files. CommitID is the commit at
+	// which the file was last viewed.
+	CommitID api.CommitID
+	Path     string
+}
+
+// Label is the text matched against queries.
+func (e Entry) Label() string {
+	if e.Kind == KindFile {
+		return e.Path
+	}
+	return string(e.RepoName)
+}
+
+// Match is an entry matching a query.
+type Match struct {
+	Entry
+	Score int
+}
+
+// DefaultMaxFilesPerRepo is the default number of recently viewed files
+// retained for each repository.
+const DefaultMaxFilesPerRepo = 200
+
+// Index is a trie over the tokens of the labels of its entries. Tokens are
+// the lowercased label and each of its suffixes that starts a word, so that
+// "github.com/gorilla/mux" is found by the queries "git", "gor" and "mu".
+//
+// An Index is safe for concurrent use.
+type Index struct {
+	maxFilesPerRepo int
+
+	mu      sync.RWMutex
+	root    *node
+	nextID  int
+	entries map[int]Entry
+	repos   map[api.RepoID]int
+	files   map[fileKey]int
+	recent  map[api.RepoID][]int // file entry IDs, least recently viewed first
+}
+
+type fileKey struct {
+	repoID api.RepoID
+	path   string
+}
+
+type node struct {
+	children map[byte]*node
+	ids      map[int]struct{}
+}
+
+func newNode() *node {
+	return &node{children: map[byte]*node{}, ids: map[int]struct{}{}}
+}
+
+// NewIndex returns an empty index retaining at most maxFilesPerRepo files for
+// each repository.
+func NewIndex(maxFilesPerRepo int) *Index {
+	return &Index{
+		maxFilesPerRepo: maxFilesPerRepo,
+		root:            newNode(),
+		entries:         map[int]Entry{},
+		repos:           map[api.RepoID]int{},
+		files:           map[fileKey]int{},
+		recent:          map[api.RepoID][]int{},
+	}
+}
+
+// Len returns the number of entries in the index.
+func (ix *Index) Len() int {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+	return len(ix.entries)
+}
+
+// UpsertRepo adds the repository with the given ID, or renames it if it is
+// already indexed. Files of a renamed repository are kept.
+func (ix *Index) UpsertRepo(id api.RepoID, name api.RepoName) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	if entryID, ok := ix.repos[id]; ok {
+		if ix.entries[entryID].RepoName == name {
+			return
+		}
+		ix.remove(entryID)
+		for _, fileID := range ix.recent[id] {
+			file := ix.entries[fileID]
+			file.RepoName = name
+			ix.entries[fileID] = file
+		}
+	}
+
+	ix.repos[id] = ix.insert(Entry{Kind: KindRepo, RepoID: id, RepoName: name})
+}
+
+// DeleteRepo removes the repository with the given ID and all of its files.
+func (ix *Index) DeleteRepo(id api.RepoID) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	if entryID, ok := ix.repos[id]; ok {
+		ix.remove(entryID)
+		delete(ix.repos, id)
+	}
+	for _, fileID := range ix.recent[id] {
+		delete(ix.files, fileKey{repoID: id, path: ix.entries[fileID].Path})
+		ix.remove(fileID)
+	}
+	delete(ix.recent, id)
+}
+
+// AddFile records that the given file was viewed. If the repository already
+// has the maximum number of files, its least recently viewed file is evicted.
+// Files of repositories that are not indexed are ignored.
+func (ix *Index) AddFile(repoID api.RepoID, commitID api.CommitID, path string) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	repoEntryID, ok := ix.repos[repoID]
+	if !ok || path == "" {
+		return
+	}
+
+	key := fileKey{repoID: repoID, path: path}
+	if entryID, ok := ix.files[key]; ok {
+		entry := ix.entries[entryID]
+		entry.CommitID = commitID
+		ix.entries[entryID] = entry
+		ix.recent[repoID] = append(without(ix.recent[repoID], entryID), entryID)
+		return
+	}
+
+	entryID := ix.insert(Entry{
+		Kind:     KindFile,
+		RepoID:   repoID,
+		RepoName: ix.entries[repoEntryID].RepoName,
+		CommitID: commitID,
+		Path:     path,
+	})
+	ix.files[key] = entryID
+	ix.recent[repoID] = append(ix.recent[repoID], entryID)
+
+	if recent := ix.recent[repoID]; len(recent) > ix.maxFilesPerRepo {
+		evicted := recent[0]
+		delete(ix.files, fileKey{repoID: repoID, path: ix.entries[evicted].Path})
+		ix.remove(evicted)
+		ix.recent[repoID] = recent[1:]
+	}
+}
+
+// Search returns at most limit entries with a token starting with the given
+// query, ordered by descending score, then by ascending label length and
+// finally alphabetically.
+func (ix *Index) Search(query string, limit int) []Match {
+	q := strings.ToLower(strings.TrimSpace(query))
+	if q == "" || limit <= 0 {
+		return nil
+	}
+
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	n := ix.root
+	for i := 0; i < len(q); i++ {
+		if n = n.children[q[i]]; n == nil {
+			return nil
+		}
+	}
+
+	// Visit the subtree breadth-first so that entries with shorter tokens,
+	// which are closer matches, are collected first.
+	maxCandidates := limit * 10
+	seen := map[int]struct{}{}
+	matches := make([]Match, 0, limit)
+	for queue := []*node{n}; len(queue) > 0 && len(seen) < maxCandidates; queue = queue[1:] {
+		for id := range queue[0].ids {
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+
+			entry := ix.entries[id]
+			matches = append(matches, Match{Entry: entry, Score: score(entry, q)})
+		}
+		for _, child := range queue[0].children {
+			queue = append(queue, child)
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		if li, lj := matches[i].Label(), matches[j].Label(); len(li) != len(lj) {
+			return len(li) < len(lj)
+		} else if li != lj {
+			return li < lj
+		}
+		return matches[i].RepoName < matches[j].RepoName
+	})
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	return matches
+}
+
+// score ranks an entry whose label has a token starting with the lowercase
+// query q. Exact matches rank first, then matches of the start of the label,
+// then matches of the start of the last path component. Repositories rank
+// above files with the same score.
+func score(entry Entry, q string) int {
+	label := strings.ToLower(entry.Label())
+
+	s := 1
+	switch {
+	case label == q:
+		s = 4
+	case strings.HasPrefix(label, q):
+		s = 3
+	case strings.HasPrefix(label[strings.LastIndexByte(label, '/')+1:], q):
+		s = 2
+	}
+
+	s *= 2
+	if entry.Kind == KindRepo {
+		s++
+	}
+	return s
+}
+
+func (ix *Index) insert(entry Entry) int {
+	id := ix.nextID
+	ix.nextID++
+	ix.entries[id] = entry
+
+	for _, token := range tokens(entry.Label()) {
+		n := ix.root
+		for i := 0; i < len(token); i++ {
+			child, ok := n.children[token[i]]
+			if !ok {
+				child = newNode()
+				n.children[token[i]] = child
+			}
+			n = child
+		}
+		n.ids[id] = struct{}{}
+	}
+
+	return id
+}
+
+func (ix *Index) remove(id int) {
+	for _, token := range tokens(ix.entries[id].Label()) {
+		removeToken(ix.root, token, id)
+	}
+	delete(ix.entries, id)
+}
+
+// removeToken removes the given ID from the node of the token below n and
+// prunes nodes left empty. It returns true if n itself is empty.
+func removeToken(n *node, token string, id int) bool {
+	if token == "" {
+		delete(n.ids, id)
+	} else if child, ok := n.children[token[0]]; ok && removeToken(child, token[1:], id) {
+		delete(n.children, token[0])
+	}
+
+	return len(n.ids) == 0 && len(n.children) == 0
+}
+
+// tokens returns the lowercased label and each of its suffixes that follows a
+// word separator.
+func tokens(label string) []string {
+	label = strings.ToLower(label)
+
+	tokens := []string{label}
+	for i := 0; i < len(label)-1; i++ {
+		if isSeparator(label[i]) && !isSeparator(label[i+1]) {
+			tokens = append(tokens, label[i+1:])
+		}
+	}
+	return tokens
+}
+
+func isSeparator(c byte) bool {
+	return c == '/' || c == '.' || c == '-' || c == '_' || c == ' '
+}
+
+func without(ids []int, id int) []int {
+	filtered := ids[:0]
+	for _, v := range ids {
+		if v != id {
+			filtered = append(filtered, v)
+		}
+	}
+	return filtered
+}
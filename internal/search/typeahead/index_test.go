@@ -0,0 +1,86 @@
+package typeahead
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func labels(matches []Match) []string {
+	labels := make([]string, 0, len(matches))
+	for _, m := range matches {
+		labels = append(labels, m.Label())
+	}
+	return labels
+}
+
+func TestIndexSearch(t *testing.T) {
+	ix := NewIndex(DefaultMaxFilesPerRepo)
+	ix.UpsertRepo(1, "github.com/gorilla/mux")
+	ix.UpsertRepo(2, "github.com/gorilla/muxy")
+	ix.UpsertRepo(3, "github.com/sourcegraph/sourcegraph")
+	ix.UpsertRepo(4, "mux")
+	ix.AddFile(3, "deadbeef", "cmd/frontend/graphqlbackend/mux.go")
+
+	for _, tc := range []struct {
+		query string
+		limit int
+		want  []string
+	}{
+		{query: "mux", limit: 10, want: []string{"mux", "github.com/gorilla/mux", "github.com/gorilla/muxy", "cmd/frontend/graphqlbackend/mux.go"}},
+		{query: "MUX", limit: 2, want: []string{"mux", "github.com/gorilla/mux"}},
+		{query: "gorilla/m", limit: 10, want: []string{"github.com/gorilla/mux", "github.com/gorilla/muxy"}},
+		{query: "github.com/s", limit: 10, want: []string{"github.com/sourcegraph/sourcegraph"}},
+		{query: "graphqlb", limit: 10, want: []string{"cmd/frontend/graphqlbackend/mux.go"}},
+		{query: "orilla", limit: 10, want: []string{}},
+		{query: " ", limit: 10, want: []string{}},
+	} {
+		t.Run(tc.query, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, labels(ix.Search(tc.query, tc.limit))); diff != "" {
+				t.Errorf("unexpected matches (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIndexUpdates(t *testing.T) {
+	ix := NewIndex(2)
+	ix.UpsertRepo(1, "github.com/foo/bar")
+	ix.AddFile(1, "a", "README.md")
+	ix.AddFile(1, "a", "main.go")
+	ix.AddFile(2, "a", "unknown.go")
+
+	// Renaming a repository keeps its files
+	ix.UpsertRepo(1, "github.com/foo/baz")
+	if got := labels(ix.Search("bar", 10)); len(got) != 0 {
+		t.Errorf("unexpected matches for old name: %v", got)
+	}
+	if got := ix.Search("main", 10); len(got) != 1 || got[0].RepoName != "github.com/foo/baz" {
+		t.Errorf("unexpected matches for file of renamed repository: %+v", got)
+	}
+
+	// Viewing README.md again makes main.go the least recently viewed file
+	ix.AddFile(1, "b", "README.md")
+	ix.AddFile(1, "b", "util.go")
+	if diff := cmp.Diff([]string{}, labels(ix.Search("main", 10))); diff != "" {
+		t.Errorf("expected main.go to be evicted (-want +got):\n%s", diff)
+	}
+	if got := ix.Search("readme", 10); len(got) != 1 || got[0].CommitID != "b" {
+		t.Errorf("unexpected matches for README.md: %+v", got)
+	}
+
+	ix.DeleteRepo(1)
+	if n := ix.Len(); n != 0 {
+		t.Errorf("unexpected number of entries after deletion. want=%d have=%d", 0, n)
+	}
+	if len(ix.root.children) != 0 {
+		t.Errorf("expected trie to be pruned, got %d children", len(ix.root.children))
+	}
+}
+
+func TestTokens(t *testing.T) {
+	want := []string{"github.com/foo-bar/baz", "com/foo-bar/baz", "foo-bar/baz", "bar/baz", "baz"}
+	if diff := cmp.Diff(want, tokens("github.com/Foo-Bar/baz")); diff != "" {
+		t.Errorf("unexpected tokens (-want +got):\n%s", diff)
+	}
+}
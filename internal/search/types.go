@@ -9,6 +9,7 @@ import (
 	searchbackend "github.com/sourcegraph/sourcegraph/internal/search/backend"
 	"github.com/sourcegraph/sourcegraph/internal/search/filter"
 	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
 )
 
@@ -131,6 +132,19 @@ type TextParameters struct {
 	RepoPromise *Promise
 	Mode        GlobalSearchMode
 
+	// Fork and Archived are the effective fork: and archived: options of the
+	// query. In ZoektGlobalSearch mode they determine whether the shards of
+	// forks and archived repositories are searched. The zero value searches
+	// all shards.
+	Fork     query.YesNoOnly
+	Archived query.YesNoOnly
+
+	// UserPrivateRepos are the private repositories the user may search. In
+	// ZoektGlobalSearch mode the shards of other private repositories are not
+	// searched. If nil, all shards are searched and results are filtered
+	// against the resolved repositories afterwards.
+	UserPrivateRepos []types.RepoName
+
 	// Query is the parsed query from the user. You should be using Pattern
 	// instead, but Query is useful for checking extra fields that are set and
 	// ignored by Pattern, such as index:no
@@ -167,6 +181,7 @@ type TextPatternInfo struct {
 	IsRegExp        bool
 	IsStructuralPat bool
 	CombyRule       string
+	CombyRewrite    string
 	IsWordMatch     bool
 	IsCaseSensitive bool
 	FileMatchLimit  int32
@@ -178,6 +193,14 @@ type TextPatternInfo struct {
 	IncludePatterns []string
 	ExcludePattern  string
 
+	// ExcludeContentPatterns are regular expressions from -content: filters.
+	// Files whose content matches any of them are not returned.
+	ExcludeContentPatterns []string
+
+	// IncludeContentPatterns are regular expressions from file:contains()
+	// predicates. Only files whose content matches all of them are returned.
+	IncludeContentPatterns []string
+
 	FilePatternsReposMustInclude []string
 	FilePatternsReposMustExclude []string
 
@@ -200,6 +223,9 @@ func (p *TextPatternInfo) String() string {
 		} else {
 			args = append(args, "comby")
 		}
+		if p.CombyRewrite != "" {
+			args = append(args, fmt.Sprintf("replace:%q", p.CombyRewrite))
+		}
 	}
 	if p.IsWordMatch {
 		args = append(args, "word")
@@ -219,6 +245,12 @@ func (p *TextPatternInfo) String() string {
 	for _, lang := range p.Languages {
 		args = append(args, fmt.Sprintf("lang:%s", lang))
 	}
+	for _, exc := range p.ExcludeContentPatterns {
+		args = append(args, fmt.Sprintf("-content:%q", exc))
+	}
+	for _, inc := range p.IncludeContentPatterns {
+		args = append(args, fmt.Sprintf("file:contains(%q)", inc))
+	}
 
 	for _, inc := range p.FilePatternsReposMustInclude {
 		args = append(args, fmt.Sprintf("repositoryPathPattern:%s", inc))
@@ -227,7 +227,7 @@ func NewIndexedSearchRequest(ctx context.Context, args *search.TextParameters, t
 		}
 
 		return &IndexedSearchRequest{
-			Unindexed:        limitUnindexedRepos(repos, maxUnindexedRepoRevSearchesPerQuery, stream),
+			Unindexed:        limitUnindexedRepos(repos, maxUnindexedRepoRevSearchesPerQuery, search.RepoStatusRepoLimit, stream),
 			IndexUnavailable: true,
 		}, nil
 	}
@@ -238,14 +238,14 @@ func NewIndexedSearchRequest(ctx context.Context, args *search.TextParameters, t
 			return nil, fmt.Errorf("invalid index:%q (revsions with glob pattern cannot be resolved for indexed searches)", args.PatternInfo.Index)
 		}
 		return &IndexedSearchRequest{
-			Unindexed: limitUnindexedRepos(repos, maxUnindexedRepoRevSearchesPerQuery, stream),
+			Unindexed: limitUnindexedRepos(repos, maxUnindexedRepoRevSearchesPerQuery, search.RepoStatusRepoLimit, stream),
 		}, nil
 	}
 
 	// Fallback to Unindexed if index:no
 	if args.PatternInfo.Index == query.No {
 		return &IndexedSearchRequest{
-			Unindexed: limitUnindexedRepos(repos, maxUnindexedRepoRevSearchesPerQuery, stream),
+			Unindexed: limitUnindexedRepos(repos, maxUnindexedRepoRevSearchesPerQuery, search.RepoStatusRepoLimit, stream),
 		}, nil
 	}
 
@@ -272,7 +272,7 @@ func NewIndexedSearchRequest(ctx context.Context, args *search.TextParameters, t
 		}
 
 		return &IndexedSearchRequest{
-			Unindexed:        limitUnindexedRepos(repos, maxUnindexedRepoRevSearchesPerQuery, stream),
+			Unindexed:        limitUnindexedRepos(repos, maxUnindexedRepoRevSearchesPerQuery, search.RepoStatusRepoLimit, stream),
 			IndexUnavailable: true,
 		}, ctx.Err()
 	}
@@ -289,20 +289,92 @@ func NewIndexedSearchRequest(ctx context.Context, args *search.TextParameters, t
 
 	// Disable unindexed search
 	if args.PatternInfo.Index == query.Only {
-		searcherRepos = limitUnindexedRepos(searcherRepos, 0, stream)
+		searcherRepos = limitUnindexedRepos(searcherRepos, 0, search.RepoStatusMissing, stream)
 	}
 
 	return &IndexedSearchRequest{
 		Args: args,
 		Typ:  typ,
 
-		Unindexed: limitUnindexedRepos(searcherRepos, maxUnindexedRepoRevSearchesPerQuery, stream),
+		Unindexed: limitUnindexedRepos(searcherRepos, maxUnindexedRepoRevSearchesPerQuery, search.RepoStatusRepoLimit, stream),
 		RepoRevs:  indexed,
 
 		DisableUnindexedSearch: args.PatternInfo.Index == query.Only,
 	}, nil
 }
 
+// shardClassQuery returns a query which restricts a global search to the
+// shards in indexed of repositories matching the fork: and archived: options
+// of a query. It returns nil if all shards should be searched.
+//
+// Forks and archived repositories are recorded as such in the metadata of their
+// shards, so that default queries skip them without resolving repositories
+// first. Shards indexed before this was recorded are always searched, their
+// results are filtered against the resolved repositories as usual.
+func shardClassQuery(indexed map[string]*zoekt.Repository, fork, archived query.YesNoOnly) zoektquery.Q {
+	include := map[string]bool{}
+	exclude := map[string]bool{}
+	for name, repo := range indexed {
+		if inShardClass(repo.RawConfig, "fork", fork) && inShardClass(repo.RawConfig, "archived", archived) {
+			include[name] = true
+		} else {
+			exclude[name] = true
+		}
+	}
+
+	if fork == query.Only || archived == query.Only {
+		return &zoektquery.RepoSet{Set: include}
+	}
+	if len(exclude) == 0 {
+		return nil
+	}
+	return &zoektquery.Not{Child: &zoektquery.RepoSet{Set: exclude}}
+}
+
+// permissionQuery returns a query which restricts a global search to the
+// shards in indexed the user may see: those of public repositories and of the
+// private repositories in userPrivateRepos. It returns nil if all shards may be
+// searched.
+//
+// Excluding the other shards up front means they neither count towards the
+// match limits of zoekt nor are searched at all. Shards which do not record
+// whether their repository is public are always searched, their results are
+// filtered against the resolved repositories as usual.
+func permissionQuery(indexed map[string]*zoekt.Repository, userPrivateRepos []types.RepoName) zoektquery.Q {
+	visible := make(map[string]bool, len(userPrivateRepos))
+	for _, r := range userPrivateRepos {
+		visible[string(r.Name)] = true
+	}
+
+	exclude := map[string]bool{}
+	for name, repo := range indexed {
+		if public, ok := repo.RawConfig["public"]; ok && public != "1" && !visible[name] {
+			exclude[name] = true
+		}
+	}
+	if len(exclude) == 0 {
+		return nil
+	}
+	return &zoektquery.Not{Child: &zoektquery.RepoSet{Set: exclude}}
+}
+
+// inShardClass returns true if a shard with the given metadata should be
+// searched according to opt, where key is the metadata recording whether the
+// repository of the shard belongs to the class.
+func inShardClass(rawConfig map[string]string, key string, opt query.YesNoOnly) bool {
+	v, ok := rawConfig[key]
+	if !ok {
+		return true
+	}
+	switch opt {
+	case query.No:
+		return v != "1"
+	case query.Only:
+		return v == "1"
+	}
+	return true
+}
+
 // zoektSearch searches repositories using zoekt.
 //
 // Timeouts are reported through the context, and as a special case errNoResultsInTimeout
@@ -330,6 +402,23 @@ func zoektSearch(ctx context.Context, args *search.TextParameters, repos *Indexe
 	var finalQuery zoektquery.Q
 	if args.Mode == search.ZoektGlobalSearch {
 		finalQuery = zoektquery.NewAnd(&zoektquery.Branch{Pattern: "HEAD", Exact: true}, queryExceptRepos)
+		filterShardClass := args.Fork == query.No || args.Fork == query.Only || args.Archived == query.No || args.Archived == query.Only
+		if filterShardClass || args.UserPrivateRepos != nil {
+			indexed, err := args.Zoekt.ListAll(ctx)
+			if err != nil {
+				return err
+			}
+			if filterShardClass {
+				if q := shardClassQuery(indexed, args.Fork, args.Archived); q != nil {
+					finalQuery = zoektquery.NewAnd(q, finalQuery)
+				}
+			}
+			if args.UserPrivateRepos != nil {
+				if q := permissionQuery(indexed, args.UserPrivateRepos); q != nil {
+					finalQuery = zoektquery.NewAnd(q, finalQuery)
+				}
+			}
+		}
 	} else {
 		finalQuery = zoektquery.NewAnd(&zoektquery.RepoBranches{Set: repos.repoBranches}, queryExceptRepos)
 	}
@@ -496,6 +585,20 @@ func zoektSearch(ctx context.Context, args *search.TextParameters, repos *Indexe
 		c.Send(streaming.SearchEvent{Stats: streaming.Stats{Status: mkStatusMap(search.RepoStatusTimedout)}})
 		return nil
 	}
+
+	// Zoekt does not tell us which repositories it has finished searching
+	// until the search is complete. For a global search we don't know which
+	// of the resolved repositories are indexed, so we report all of them,
+	// including those that may still be searched by searcher.
+	searched := mkStatusMap(search.RepoStatusSearched)
+	if args.Mode == search.ZoektGlobalSearch {
+		for _, r := range repoRevMap {
+			searched.Update(r.Repo.ID, search.RepoStatusSearched)
+		}
+	}
+	if searched.Len() > 0 {
+		c.Send(streaming.SearchEvent{Stats: streaming.Stats{Status: searched}})
+	}
 	return nil
 }
 
@@ -755,6 +858,20 @@ func queryToZoektQuery(query *search.TextPatternInfo, typ IndexedRequestType) (z
 		}
 		and = append(and, &zoektquery.Not{Child: q})
 	}
+	for _, p := range query.ExcludeContentPatterns {
+		q, err := parseRe(p, false, true, query.IsCaseSensitive)
+		if err != nil {
+			return nil, err
+		}
+		and = append(and, &zoektquery.Not{Child: q})
+	}
+	for _, p := range query.IncludeContentPatterns {
+		q, err := parseRe(p, false, true, query.IsCaseSensitive)
+		if err != nil {
+			return nil, err
+		}
+		and = append(and, q)
+	}
 
 	// For conditionals that happen on a repo we can use type:repo queries. eg
 	// (type:repo file:foo) (type:repo file:bar) will match all repos which
@@ -816,11 +933,11 @@ func zoektIndexedRepos(indexedSet map[string]*zoekt.Repository, revs []*search.R
 // timeouts or long delays.
 //
 // It returns the new repositories destined for the unindexed searcher code
-// path, and sends an event to stream for any repositories that are limited /
-// excluded.
+// path, and sends an event to stream with the given status for any
+// repositories that are limited / excluded.
 //
 // A slice to the input list is returned, it is not copied.
-func limitUnindexedRepos(unindexed []*search.RepositoryRevisions, limit int, stream streaming.Sender) []*search.RepositoryRevisions {
+func limitUnindexedRepos(unindexed []*search.RepositoryRevisions, limit int, limitStatus search.RepoStatus, stream streaming.Sender) []*search.RepositoryRevisions {
 	var missing []*search.RepositoryRevisions
 
 	for i, repoRevs := range unindexed {
@@ -835,7 +952,7 @@ func limitUnindexedRepos(unindexed []*search.RepositoryRevisions, limit int, str
 	if len(missing) > 0 {
 		var status search.RepoStatusMap
 		for _, r := range missing {
-			status.Update(r.Repo.ID, search.RepoStatusMissing)
+			status.Update(r.Repo.ID, limitStatus)
 		}
 		stream.Send(streaming.SearchEvent{
 			Stats: streaming.Stats{
@@ -69,6 +69,12 @@ func TestIndexedSearch(t *testing.T) {
 				useFullDeadline: false,
 				since:           func(time.Time) time.Duration { return time.Second - time.Millisecond },
 			},
+			wantCommon: streaming.Stats{
+				Status: mkStatusMap(map[string]search.RepoStatus{
+					"foo/bar":    search.RepoStatusSearched,
+					"foo/foobar": search.RepoStatusSearched,
+				}),
+			},
 			wantErr: false,
 		},
 		{
@@ -154,6 +160,12 @@ func TestIndexedSearch(t *testing.T) {
 				"",
 				"",
 			},
+			wantCommon: streaming.Stats{
+				Status: mkStatusMap(map[string]search.RepoStatus{
+					"foo/bar":    search.RepoStatusSearched,
+					"foo/foobar": search.RepoStatusSearched,
+				}),
+			},
 			wantErr: false,
 		},
 		{
@@ -191,6 +203,11 @@ func TestIndexedSearch(t *testing.T) {
 				"dev",
 				"dev",
 			},
+			wantCommon: streaming.Stats{
+				Status: mkStatusMap(map[string]search.RepoStatus{
+					"foo/bar": search.RepoStatusSearched,
+				}),
+			},
 			wantErr: false,
 		},
 		{
@@ -218,6 +235,11 @@ func TestIndexedSearch(t *testing.T) {
 			},
 			wantMatchCount:     1,
 			wantMatchInputRevs: []string{"HEAD"},
+			wantCommon: streaming.Stats{
+				Status: mkStatusMap(map[string]search.RepoStatus{
+					"foo/bar": search.RepoStatusSearched,
+				}),
+			},
 		},
 		{
 			// Fallback to unindexed search if the query contains ref-globs.
@@ -464,6 +486,81 @@ func TestZoektResultCountFactor(t *testing.T) {
 	}
 }
 
+func TestShardClassQuery(t *testing.T) {
+	indexed := map[string]*zoekt.Repository{
+		"repo":     {Name: "repo", RawConfig: map[string]string{"fork": "0", "archived": "0"}},
+		"fork":     {Name: "fork", RawConfig: map[string]string{"fork": "1", "archived": "0"}},
+		"archived": {Name: "archived", RawConfig: map[string]string{"fork": "0", "archived": "1"}},
+		"legacy":   {Name: "legacy", RawConfig: map[string]string{"repoid": "4"}},
+	}
+	set := func(names ...string) *zoektquery.RepoSet {
+		s := &zoektquery.RepoSet{Set: map[string]bool{}}
+		for _, name := range names {
+			s.Set[name] = true
+		}
+		return s
+	}
+
+	cases := []struct {
+		name     string
+		fork     query.YesNoOnly
+		archived query.YesNoOnly
+		want     zoektquery.Q
+	}{{
+		name:     "all",
+		fork:     query.Yes,
+		archived: query.Yes,
+		want:     nil,
+	}, {
+		name:     "default",
+		fork:     query.No,
+		archived: query.No,
+		want:     &zoektquery.Not{Child: set("fork", "archived")},
+	}, {
+		name:     "forks",
+		fork:     query.Yes,
+		archived: query.No,
+		want:     &zoektquery.Not{Child: set("archived")},
+	}, {
+		name:     "only forks",
+		fork:     query.Only,
+		archived: query.Yes,
+		want:     set("fork", "legacy"),
+	}, {
+		name:     "only archived",
+		fork:     query.No,
+		archived: query.Only,
+		want:     set("archived", "legacy"),
+	}}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := shardClassQuery(indexed, tc.fork, tc.archived)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPermissionQuery(t *testing.T) {
+	indexed := map[string]*zoekt.Repository{
+		"public":  {Name: "public", RawConfig: map[string]string{"public": "1"}},
+		"visible": {Name: "visible", RawConfig: map[string]string{"public": "0"}},
+		"hidden":  {Name: "hidden", RawConfig: map[string]string{"public": "0"}},
+		"legacy":  {Name: "legacy"},
+	}
+
+	got := permissionQuery(indexed, []types.RepoName{{ID: 2, Name: "visible"}})
+	want := &zoektquery.Not{Child: &zoektquery.RepoSet{Set: map[string]bool{"hidden": true}}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+
+	if got := permissionQuery(indexed, []types.RepoName{{ID: 2, Name: "visible"}, {ID: 3, Name: "hidden"}}); got != nil {
+		t.Errorf("expected no restriction if all private repositories are visible, got %s", got)
+	}
+}
+
 func TestQueryToZoektQuery(t *testing.T) {
 	cases := []struct {
 		Name    string
@@ -549,6 +646,28 @@ func TestQueryToZoektQuery(t *testing.T) {
 			},
 			Query: `foo case:yes f:\.go$ f:\.yaml$ -f:\bvendor\b`,
 		},
+		{
+			Name: "exclude content",
+			Type: TextRequest,
+			Pattern: &search.TextPatternInfo{
+				IsRegExp:               true,
+				IsCaseSensitive:        false,
+				Pattern:                "foo",
+				ExcludeContentPatterns: []string{"bar", `baz\(`},
+			},
+			Query: `foo case:no -c:bar -c:baz\(`,
+		},
+		{
+			Name: "file contains",
+			Type: TextRequest,
+			Pattern: &search.TextPatternInfo{
+				IsRegExp:               true,
+				IsCaseSensitive:        false,
+				Pattern:                "foo",
+				IncludeContentPatterns: []string{"bar", `baz\(`},
+			},
+			Query: `foo case:no c:bar c:baz\(`,
+		},
 		{
 			Name: "path matches only",
 			Type: TextRequest,
@@ -142,6 +142,9 @@ func HTTPTraceMiddleware(next http.Handler) http.Handler {
 		span.SetTag("http.referer", r.Header.Get("referer"))
 		defer span.Finish()
 		rw.Header().Set("X-Trace", SpanURL(span))
+		if id := TraceID(span); id != "" {
+			rw.Header().Set("X-Trace-ID", id)
+		}
 		ctx = opentracing.ContextWithSpan(ctx, span)
 
 		routeName := "unknown"
@@ -50,6 +50,33 @@ func SetSpanURLFunc(f func(span opentracing.Span) string) {
 	spanURL.Store(f)
 }
 
+var traceID atomic.Value
+
+// TraceID returns the ID of the trace the given span belongs to, or an empty
+// string if the span is not recorded by a tracer. The span must be non-nil.
+func TraceID(span opentracing.Span) string {
+	f, _ := traceID.Load().(func(span opentracing.Span) string)
+	if f == nil {
+		return ""
+	}
+	return f(span)
+}
+
+// TraceIDFromContext returns the ID of the trace of the span attached to the
+// given context. An empty string is returned if there is no span associated
+// with the given context, or if it is not recorded.
+func TraceIDFromContext(ctx context.Context) string {
+	if span := opentracing.SpanFromContext(ctx); span != nil {
+		return TraceID(span)
+	}
+	return ""
+}
+
+// SetTraceIDFunc sets the function that TraceID uses.
+func SetTraceIDFunc(f func(span opentracing.Span) string) {
+	traceID.Store(f)
+}
+
 // New returns a new Trace with the specified family and title.
 func New(ctx context.Context, family, title string, tags ...Tag) (*Trace, context.Context) {
 	tr := Tracer{Tracer: ot.GetTracer(ctx)}
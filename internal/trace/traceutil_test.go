@@ -20,3 +20,18 @@ func TestSpanURL(t *testing.T) {
 	SetSpanURLFunc(nil)
 	want("#tracer-not-enabled")
 }
+
+func TestTraceID(t *testing.T) {
+	want := func(want string) {
+		t.Helper()
+		got := TraceID(nil)
+		if got != want {
+			t.Fatalf("want %q, got %q", want, got)
+		}
+	}
+	want("")
+	SetTraceIDFunc(func(span opentracing.Span) string { return "test" })
+	want("test")
+	SetTraceIDFunc(nil)
+	want("")
+}
@@ -78,6 +78,7 @@ type jaegerOpts struct {
 func initTracer(serviceName string) {
 	globalTracer := newSwitchableTracer()
 	opentracing.SetGlobalTracer(globalTracer)
+	trace.SetTraceIDFunc(jaegerTraceID)
 
 	// initial tracks if its our first run of conf.Watch. This is used to
 	// prevent logging "changes" when its the first run.
@@ -183,6 +184,19 @@ func newTracer(opts *jaegerOpts) (opentracing.Tracer, func(span opentracing.Span
 	return tracer, spanURL, closer, nil
 }
 
+// jaegerTraceID returns the ID of the Jaeger trace of span, or an empty string
+// if span is not recorded by Jaeger.
+func jaegerTraceID(span opentracing.Span) string {
+	if span == nil {
+		return ""
+	}
+	spanCtx, ok := span.Context().(jaeger.SpanContext)
+	if !ok {
+		return ""
+	}
+	return spanCtx.TraceID().String()
+}
+
 // switchableTracer implements opentracing.Tracer. The underlying tracer used is switchable (set via
 // the `set` method).
 type switchableTracer struct {
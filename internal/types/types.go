@@ -48,6 +48,17 @@ type Repo struct {
 	Archived bool
 	// Stars is the star count the repository has in the code host.
 	Stars int `json:",omitempty"`
+	// Topics are the topics (or tags) of the repository in the code host.
+	Topics []string `json:",omitempty"`
+	// PrimaryLanguage is the primary language of the repository reported by
+	// the code host, if known.
+	PrimaryLanguage string `json:",omitempty"`
+	// License is the SPDX identifier of the license of the repository
+	// reported by the code host, if known.
+	License string `json:",omitempty"`
+	// DefaultBranchProtected is whether the default branch of the repository
+	// is protected in the code host.
+	DefaultBranchProtected bool `json:",omitempty"`
 	// Private is whether the repository is private.
 	Private bool
 	// CreatedAt is when this repository was created on Sourcegraph.
@@ -126,6 +137,22 @@ func (r *Repo) Update(n *Repo) (modified bool) {
 		r.Stars, modified = n.Stars, true
 	}
 
+	if !topicsEqual(r.Topics, n.Topics) {
+		r.Topics, modified = n.Topics, true
+	}
+
+	if r.PrimaryLanguage != n.PrimaryLanguage {
+		r.PrimaryLanguage, modified = n.PrimaryLanguage, true
+	}
+
+	if r.License != n.License {
+		r.License, modified = n.License, true
+	}
+
+	if r.DefaultBranchProtected != n.DefaultBranchProtected {
+		r.DefaultBranchProtected, modified = n.DefaultBranchProtected, true
+	}
+
 	if !reflect.DeepEqual(r.Sources, n.Sources) {
 		r.Sources, modified = n.Sources, true
 	}
@@ -139,7 +166,8 @@ func (r *Repo) Update(n *Repo) (modified bool) {
 		cp.ViewerPermission = ""
 		n = n.With(func(clone *Repo) {
 			// Repo.Clone does not currently clone metadata for any types as they could contain hard to clone
-			// items such as maps. However, we know that copying github.Repository is safe as it only contains values.
+			// items such as maps. However, we know that a shallow copy of github.Repository is safe as we only
+			// change one of its values.
 			clone.Metadata = &cp
 		})
 	}
@@ -151,12 +179,30 @@ func (r *Repo) Update(n *Repo) (modified bool) {
 	return modified
 }
 
+// topicsEqual returns true if a and b contain the same topics in the same
+// order. nil and empty topics are equal, since the repo table doesn't tell them
+// apart.
+func topicsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
 // Clone returns a clone of the given repo.
 func (r *Repo) Clone() *Repo {
 	if r == nil {
 		return nil
 	}
 	clone := *r
+	if r.Topics != nil {
+		clone.Topics = append([]string(nil), r.Topics...)
+	}
 	if r.Sources != nil {
 		clone.Sources = make(map[string]*SourceInfo, len(r.Sources))
 		for k, v := range r.Sources {
@@ -420,6 +466,26 @@ type GitserverRepo struct {
 	UpdatedAt time.Time
 }
 
+// GitserverRepoIntegrityFailure is a corruption of a repository clone found by
+// the integrity checks of gitserver.
+type GitserverRepoIntegrityFailure struct {
+	ID     int64
+	RepoID api.RepoID
+	// The gitserver that found the corruption
+	ShardID string
+	// The check that failed, e.g. "fsck"
+	Check string
+	// The output of the failed check
+	Output string
+	// The repair attempted, e.g. "reclone"
+	Repair string
+	// The error of the repair, or empty if it succeeded or hasn't finished
+	RepairError string
+	// When the repair finished, or nil if it hasn't finished
+	RepairedAt *time.Time
+	CreatedAt  time.Time
+}
+
 // ExternalService is a connection to an external service.
 type ExternalService struct {
 	ID              int64
@@ -449,6 +515,31 @@ type ExternalServiceSyncJob struct {
 	NumFailures       int
 }
 
+// ExternalServiceSyncStats is the telemetry of the latest sync of an external
+// service.
+type ExternalServiceSyncStats struct {
+	ExternalServiceID int64
+	StartedAt         time.Time
+	FinishedAt        time.Time
+	LastSuccessAt     time.Time // zero if no sync succeeded yet
+
+	ReposSourced  int
+	ReposAdded    int
+	ReposModified int
+	ReposRemoved  int
+
+	Error               string // empty if the latest sync succeeded
+	ErrorClass          string
+	ConsecutiveFailures int
+	ErrorCounts         map[string]int // number of failed syncs by error class
+
+	// RateLimitRemaining and RateLimitResetAt are the code host API rate limit
+	// status at the end of the sync, if RateLimitKnown.
+	RateLimitKnown     bool
+	RateLimitRemaining int
+	RateLimitResetAt   time.Time
+}
+
 // URN returns a unique resource identifier of this external service,
 // used as the key in a repo's Sources map as well as the SourceInfo ID.
 func (e *ExternalService) URN() string {
@@ -1,8 +1,10 @@
 package git
 
 import (
+	"bufio"
 	"context"
 	"fmt"
+	"io"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -21,6 +23,11 @@ type BlameOptions struct {
 
 	StartLine int `json:",omitempty" url:",omitempty"` // 1-indexed start byte (or 0 for beginning of file)
 	EndLine   int `json:",omitempty" url:",omitempty"` // 1-indexed end byte (or 0 for end of file)
+
+	// IgnoreRevs are commits whose changes are attributed to the commits that
+	// previously changed the lines, like reformatting commits. Only supported
+	// by StreamBlameFile.
+	IgnoreRevs []api.CommitID `json:",omitempty" url:",omitempty"`
 }
 
 // A Hunk is a contiguous portion of a file associated with a commit.
@@ -32,10 +39,25 @@ type Hunk struct {
 	api.CommitID
 	Author  Signature
 	Message string
+
+	// Filename is the path of the file in the commit of the hunk, which
+	// differs from the blamed path if the file was renamed since.
+	Filename string
+
+	// PreviousCommit is the parent of the commit of the hunk, in which the
+	// lines of the hunk existed at PreviousFilename before they were changed.
+	// It is empty if the lines were added by the commit. Only set by
+	// StreamBlameFile.
+	PreviousCommit   api.CommitID
+	PreviousFilename string
 }
 
 // BlameFile returns Git blame information about a file.
 func BlameFile(ctx context.Context, repo api.RepoName, path string, opt *BlameOptions) ([]*Hunk, error) {
+	if Mocks.BlameFile != nil {
+		return Mocks.BlameFile(repo, path, opt)
+	}
+
 	span, ctx := ot.StartSpanFromContext(ctx, "Git: BlameFile")
 	span.SetTag("repo", repo)
 	span.SetTag("path", path)
@@ -158,3 +180,152 @@ func blameFileCmd(ctx context.Context, command cmdFunc, path string, opt *BlameO
 
 	return hunks, nil
 }
+
+// StreamBlameFile runs an incremental blame of a file and calls onHunk with
+// every hunk as soon as git attributes it to a commit, so that large files can
+// be rendered progressively. Hunks are reported in no particular order, and
+// their byte offsets are not set.
+func StreamBlameFile(ctx context.Context, repo api.RepoName, path string, opt *BlameOptions, onHunk func(*Hunk) error) (err error) {
+	span, ctx := ot.StartSpanFromContext(ctx, "Git: StreamBlameFile")
+	span.SetTag("repo", repo)
+	span.SetTag("path", path)
+	span.SetTag("opt", opt)
+	defer span.Finish()
+
+	args, err := streamBlameArgs(path, opt)
+	if err != nil {
+		return err
+	}
+
+	rc, err := ExecReader(ctx, repo, args)
+	if err != nil {
+		return errors.Wrap(err, "executing git blame")
+	}
+	defer func() {
+		if closeErr := rc.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	return parseIncrementalBlame(rc, onHunk)
+}
+
+func streamBlameArgs(path string, opt *BlameOptions) ([]string, error) {
+	if opt == nil {
+		opt = &BlameOptions{}
+	}
+	if opt.OldestCommit != "" {
+		return nil, fmt.Errorf("OldestCommit not implemented")
+	}
+	if err := checkSpecArgSafety(string(opt.NewestCommit)); err != nil {
+		return nil, err
+	}
+
+	args := []string{"blame", "-w", "--incremental"}
+	if opt.StartLine != 0 || opt.EndLine != 0 {
+		args = append(args, "-L", fmt.Sprintf("%d,%d", opt.StartLine, opt.EndLine))
+	}
+	for _, rev := range opt.IgnoreRevs {
+		if err := checkSpecArgSafety(string(rev)); err != nil {
+			return nil, err
+		}
+		args = append(args, "--ignore-rev="+string(rev))
+	}
+	return append(args, string(opt.NewestCommit), "--", filepath.ToSlash(path)), nil
+}
+
+// parseIncrementalBlame parses the output of git blame --incremental. Every
+// entry starts with a line "<commit> <original line> <line> <lines>",
+// followed by the details of the commit if it wasn't seen before, and ends
+// with a "filename" line.
+func parseIncrementalBlame(r io.Reader, onHunk func(*Hunk) error) error {
+	commits := make(map[api.CommitID]*Commit)
+	var (
+		hunk   *Hunk
+		commit *Commit
+	)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if hunk == nil {
+			fields := strings.Split(line, " ")
+			if len(fields) != 4 {
+				return fmt.Errorf("unexpected blame entry header %q", line)
+			}
+			startLine, err := strconv.Atoi(fields[2])
+			if err != nil {
+				return errors.Wrapf(err, "parsing blame entry header %q", line)
+			}
+			numLines, err := strconv.Atoi(fields[3])
+			if err != nil {
+				return errors.Wrapf(err, "parsing blame entry header %q", line)
+			}
+
+			id := api.CommitID(fields[0])
+			commit = commits[id]
+			if commit == nil {
+				commit = &Commit{ID: id}
+				commits[id] = commit
+			}
+			hunk = &Hunk{CommitID: id, StartLine: startLine, EndLine: startLine + numLines}
+			continue
+		}
+
+		key, value := line, ""
+		if i := strings.IndexByte(line, ' '); i >= 0 {
+			key, value = line[:i], line[i+1:]
+		}
+		switch key {
+		case "author":
+			commit.Author.Name = value
+		case "author-mail":
+			commit.Author.Email = strings.TrimSuffix(strings.TrimPrefix(value, "<"), ">")
+		case "author-time":
+			authorTime, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return fmt.Errorf("Failed to parse author-time %q", value)
+			}
+			commit.Author.Date = time.Unix(authorTime, 0).UTC()
+		case "summary":
+			commit.Message = Message(value)
+		case "previous":
+			if i := strings.IndexByte(value, ' '); i >= 0 {
+				hunk.PreviousCommit, hunk.PreviousFilename = api.CommitID(value[:i]), value[i+1:]
+			}
+		case "filename":
+			hunk.Filename = value
+			hunk.Author = commit.Author
+			hunk.Message = string(commit.Message)
+			if err := onHunk(hunk); err != nil {
+				return err
+			}
+			hunk = nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	if hunk != nil {
+		return fmt.Errorf("unterminated blame entry of commit %s", hunk.CommitID)
+	}
+	return nil
+}
+
+// ParseIgnoreRevs parses a file listing commits to ignore in blames, in the
+// format of git blame --ignore-revs-file: one full commit ID per line, with
+// comments starting with "#".
+func ParseIgnoreRevs(data []byte) []api.CommitID {
+	var revs []api.CommitID
+	for _, line := range strings.Split(string(data), "\n") {
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		if IsAbsoluteRevision(line) {
+			revs = append(revs, api.CommitID(line))
+		}
+	}
+	return revs
+}
@@ -3,6 +3,8 @@ package git
 import (
 	"context"
 	"reflect"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -67,3 +69,122 @@ func TestRepository_BlameFile(t *testing.T) {
 		}
 	}
 }
+
+func TestRepository_StreamBlameFile(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := MakeGitRepository(t,
+		"echo line1 > f",
+		"git add f",
+		"GIT_COMMITTER_NAME=a GIT_COMMITTER_EMAIL=a@a.com GIT_COMMITTER_DATE=2006-01-02T15:04:05Z git commit -m foo --author='a <a@a.com>' --date 2006-01-02T15:04:05Z",
+		"echo line2 >> f",
+		"git add f",
+		"GIT_COMMITTER_NAME=a GIT_COMMITTER_EMAIL=a@a.com GIT_COMMITTER_DATE=2006-01-02T15:04:05Z git commit -m foo --author='a <a@a.com>' --date 2006-01-02T15:04:05Z",
+	)
+	newestCommitID, err := ResolveRevision(ctx, repo, "master", ResolveRevisionOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var hunks []*Hunk
+	if err := StreamBlameFile(ctx, repo, "f", &BlameOptions{NewestCommit: newestCommitID}, func(h *Hunk) error {
+		hunks = append(hunks, h)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	sort.Slice(hunks, func(i, j int) bool { return hunks[i].StartLine < hunks[j].StartLine })
+
+	author := Signature{Name: "a", Email: "a@a.com", Date: MustParseTime(time.RFC3339, "2006-01-02T15:04:05Z")}
+	wantHunks := []*Hunk{
+		{
+			StartLine: 1, EndLine: 2, CommitID: "e6093374dcf5725d8517db0dccbbf69df65dbde0",
+			Message: "foo", Author: author, Filename: "f",
+		},
+		{
+			StartLine: 2, EndLine: 3, CommitID: "fad406f4fe02c358a09df0d03ec7a36c2c8a20f1",
+			Message: "foo", Author: author, Filename: "f",
+			PreviousCommit: "e6093374dcf5725d8517db0dccbbf69df65dbde0", PreviousFilename: "f",
+		},
+	}
+	if !reflect.DeepEqual(hunks, wantHunks) {
+		t.Errorf("hunks != wantHunks\n\nhunks ==========\n%s\n\nwantHunks ==========\n%s", AsJSON(hunks), AsJSON(wantHunks))
+	}
+}
+
+func TestParseIncrementalBlame(t *testing.T) {
+	const output = `fad406f4fe02c358a09df0d03ec7a36c2c8a20f1 2 2 1
+author a
+author-mail <a@a.com>
+author-time 1136214245
+author-tz +0000
+committer a
+committer-mail <a@a.com>
+committer-time 1136214245
+committer-tz +0000
+summary second
+previous e6093374dcf5725d8517db0dccbbf69df65dbde0 old name
+filename f
+e6093374dcf5725d8517db0dccbbf69df65dbde0 1 1 1
+author b
+author-mail <b@b.com>
+author-time 1136214245
+author-tz +0000
+committer b
+committer-mail <b@b.com>
+committer-time 1136214245
+committer-tz +0000
+summary first
+boundary
+filename old name
+fad406f4fe02c358a09df0d03ec7a36c2c8a20f1 4 3 2
+previous e6093374dcf5725d8517db0dccbbf69df65dbde0 old name
+filename f
+`
+
+	var hunks []*Hunk
+	if err := parseIncrementalBlame(strings.NewReader(output), func(h *Hunk) error {
+		hunks = append(hunks, h)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	date := MustParseTime(time.RFC3339, "2006-01-02T15:04:05Z")
+	second := &Hunk{
+		StartLine: 2, EndLine: 3, CommitID: "fad406f4fe02c358a09df0d03ec7a36c2c8a20f1",
+		Author: Signature{Name: "a", Email: "a@a.com", Date: date}, Message: "second", Filename: "f",
+		PreviousCommit: "e6093374dcf5725d8517db0dccbbf69df65dbde0", PreviousFilename: "old name",
+	}
+	wantHunks := []*Hunk{
+		second,
+		{
+			StartLine: 1, EndLine: 2, CommitID: "e6093374dcf5725d8517db0dccbbf69df65dbde0",
+			Author: Signature{Name: "b", Email: "b@b.com", Date: date}, Message: "first", Filename: "old name",
+		},
+		{
+			StartLine: 3, EndLine: 5, CommitID: second.CommitID, Author: second.Author, Message: second.Message, Filename: "f",
+			PreviousCommit: second.PreviousCommit, PreviousFilename: second.PreviousFilename,
+		},
+	}
+	if !reflect.DeepEqual(hunks, wantHunks) {
+		t.Errorf("hunks != wantHunks\n\nhunks ==========\n%s\n\nwantHunks ==========\n%s", AsJSON(hunks), AsJSON(wantHunks))
+	}
+
+	if err := parseIncrementalBlame(strings.NewReader("fad406f4fe02c358a09df0d03ec7a36c2c8a20f1 1 1 1\nauthor a\n"), func(*Hunk) error { return nil }); err == nil {
+		t.Error("expected error for unterminated entry")
+	}
+}
+
+func TestParseIgnoreRevs(t *testing.T) {
+	data := []byte(`# Reformat with gofmt
+e6093374dcf5725d8517db0dccbbf69df65dbde0
+  fad406f4fe02c358a09df0d03ec7a36c2c8a20f1 # Rename packages
+not-a-commit
+`)
+	want := []api.CommitID{"e6093374dcf5725d8517db0dccbbf69df65dbde0", "fad406f4fe02c358a09df0d03ec7a36c2c8a20f1"}
+	if have := ParseIgnoreRevs(data); !reflect.DeepEqual(have, want) {
+		t.Errorf("ParseIgnoreRevs: have %v, want %v", have, want)
+	}
+}
@@ -1,9 +1,12 @@
 package git
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 
 	"github.com/cockroachdb/errors"
@@ -18,6 +21,10 @@ type DiffOptions struct {
 	// These fields must be valid <commit> inputs as defined by gitrevisions(7).
 	Base string
 	Head string
+
+	// DirectRange diffs Head against Base itself (Base..Head) instead of
+	// against the merge base of Base and Head (Base...Head).
+	DirectRange bool
 }
 
 // Diff returns an iterator that can be used to access the diff between two
@@ -27,7 +34,7 @@ func Diff(ctx context.Context, opts DiffOptions) (*DiffFileIterator, error) {
 	rangeType := "..."
 	// Rare case: the base is the empty tree, in which case we must use ..
 	// instead of ... as the latter only works for commits.
-	if opts.Base == DevNullSHA {
+	if opts.Base == DevNullSHA || opts.DirectRange {
 		rangeType = ".."
 	}
 	rangeSpec := opts.Base + rangeType + opts.Head
@@ -40,10 +47,7 @@ func Diff(ctx context.Context, opts DiffOptions) (*DiffFileIterator, error) {
 	rdr, err := ExecReader(ctx, opts.Repo, []string{
 		"diff",
 		"--find-renames",
-		// TODO(eseliger): Enable once we have support for copy detection in go-diff
-		// and actually expose a `isCopy` field in the api, otherwise this
-		// information is thrown away anyways.
-		// "--find-copies",
+		"--find-copies",
 		"--full-index",
 		"--inter-hunk-context=3",
 		"--no-prefix",
@@ -55,14 +59,18 @@ func Diff(ctx context.Context, opts DiffOptions) (*DiffFileIterator, error) {
 	}
 
 	return &DiffFileIterator{
-		rdr:  rdr,
-		mfdr: diff.NewMultiFileDiffReader(rdr),
+		rdr: rdr,
+		br:  bufio.NewReader(rdr),
 	}, nil
 }
 
 type DiffFileIterator struct {
-	rdr  io.ReadCloser
-	mfdr *diff.MultiFileDiffReader
+	rdr io.ReadCloser
+	br  *bufio.Reader
+
+	// next is the "diff --git" line starting the next file diff, which was
+	// read while reading the previous one.
+	next []byte
 }
 
 func (i *DiffFileIterator) Close() error {
@@ -72,5 +80,114 @@ func (i *DiffFileIterator) Close() error {
 // Next returns the next file diff. If no more diffs are available, the diff
 // will be nil and the error will be io.EOF.
 func (i *DiffFileIterator) Next() (*diff.FileDiff, error) {
-	return i.mfdr.ReadFile()
+	// go-diff cannot parse file diffs without hunks it doesn't know of, such
+	// as copies of unchanged files, so every file diff is read separately.
+	var buf bytes.Buffer
+	buf.Write(i.next)
+	i.next = nil
+	for {
+		line, err := i.br.ReadBytes('\n')
+		if len(line) > 0 && buf.Len() > 0 && bytes.HasPrefix(line, []byte("diff --git ")) {
+			i.next = line
+			break
+		}
+		buf.Write(line)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if buf.Len() == 0 {
+		return nil, io.EOF
+	}
+
+	return parseFileDiff(buf.Bytes())
+}
+
+// parseFileDiff parses the diff of a single file. Diffs without hunks that
+// go-diff fails to parse are built from their extended header lines.
+func parseFileDiff(raw []byte) (*diff.FileDiff, error) {
+	fd, err := diff.ParseFileDiff(raw)
+	if err == nil && fd.NewName != "" {
+		return fd, nil
+	}
+	if err == nil {
+		err = errors.New("missing file names")
+	}
+	if bytes.Contains(raw, []byte("\n@@ ")) {
+		return nil, errors.Wrap(err, "parsing file diff")
+	}
+
+	lines := strings.Split(strings.TrimSuffix(string(raw), "\n"), "\n")
+	fd = &diff.FileDiff{Extended: lines}
+	for _, line := range lines {
+		for _, h := range []struct {
+			prefix string
+			name   *string
+		}{
+			{"copy from ", &fd.OrigName},
+			{"copy to ", &fd.NewName},
+			{"rename from ", &fd.OrigName},
+			{"rename to ", &fd.NewName},
+		} {
+			if strings.HasPrefix(line, h.prefix) {
+				*h.name = unquoteDiffPath(strings.TrimPrefix(line, h.prefix))
+			}
+		}
+	}
+	if fd.OrigName == "" && fd.NewName == "" && strings.HasPrefix(lines[0], "diff --git ") {
+		// Changes of the file mode only, where the old and new paths are equal.
+		names := strings.TrimPrefix(lines[0], "diff --git ")
+		if n := len(names); n%2 == 1 && names[:n/2] == names[n/2+1:] {
+			fd.OrigName, fd.NewName = names[:n/2], names[:n/2]
+		}
+	}
+	if fd.OrigName == "" || fd.NewName == "" {
+		return nil, errors.Wrapf(err, "parsing file diff %q", lines[0])
+	}
+	return fd, nil
+}
+
+// unquoteDiffPath returns the given path of a diff header, which git quotes if
+// it contains special characters.
+func unquoteDiffPath(path string) string {
+	if strings.HasPrefix(path, `"`) {
+		if unquoted, err := strconv.Unquote(path); err == nil {
+			return unquoted
+		}
+	}
+	return path
+}
+
+// FileDiffInfo describes how a file changed, as reported by the extended
+// header lines of its diff.
+type FileDiffInfo struct {
+	Renamed bool
+	Copied  bool
+	Binary  bool
+
+	// Similarity is the similarity index of the old and new file of renamed
+	// and copied files, in percent.
+	Similarity int
+}
+
+// ParseFileDiffInfo returns the FileDiffInfo of the given file diff.
+func ParseFileDiffInfo(fd *diff.FileDiff) FileDiffInfo {
+	var info FileDiffInfo
+	for _, line := range fd.Extended {
+		switch {
+		case strings.HasPrefix(line, "rename from "):
+			info.Renamed = true
+		case strings.HasPrefix(line, "copy from "):
+			info.Copied = true
+		case strings.HasPrefix(line, "Binary files ") && strings.HasSuffix(line, " differ"),
+			line == "GIT binary patch":
+			info.Binary = true
+		case strings.HasPrefix(line, "similarity index "):
+			info.Similarity, _ = strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(line, "similarity index "), "%"))
+		}
+	}
+	return info
 }
@@ -1,11 +1,14 @@
 package git
 
 import (
+	"bufio"
 	"context"
 	"errors"
 	"io"
 	"strings"
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
 )
 
 func TestDiff(t *testing.T) {
@@ -35,12 +38,13 @@ func TestDiff(t *testing.T) {
 			want string
 		}{
 			{opts: DiffOptions{Base: "foo", Head: "bar"}, want: "foo...bar"},
+			{opts: DiffOptions{Base: "foo", Head: "bar", DirectRange: true}, want: "foo..bar"},
 		} {
 			t.Run("rangeSpec: "+tc.want, func(t *testing.T) {
 				Mocks.ExecReader = func(args []string) (io.ReadCloser, error) {
-					// The range spec is the sixth argument.
-					if args[5] != tc.want {
-						t.Errorf("unexpected rangeSpec: have: %s; want: %s", args[5], tc.want)
+					// The range spec is the seventh argument.
+					if args[6] != tc.want {
+						t.Errorf("unexpected rangeSpec: have: %s; want: %s", args[6], tc.want)
 					}
 					return nil, nil
 				}
@@ -165,6 +169,57 @@ index 9bd8209..d2acfa9 100644
 }
 
 func TestDiffFileIterator(t *testing.T) {
+	t.Run("Next", func(t *testing.T) {
+		const testDiff = `diff --git a.txt b.txt
+similarity index 100%
+copy from a.txt
+copy to b.txt
+diff --git c.txt d.txt
+similarity index 90%
+rename from c.txt
+rename to d.txt
+index 9bd8209..d2acfa9 100644
+--- c.txt
++++ d.txt
+@@ -1 +1 @@
+-foo
++bar
+diff --git run.sh run.sh
+old mode 100644
+new mode 100755
+diff --git logo.png logo.png
+index 9bd8209..d2acfa9 100644
+Binary files logo.png and logo.png differ
+`
+		i := &DiffFileIterator{rdr: io.NopCloser(nil), br: bufio.NewReader(strings.NewReader(testDiff))}
+
+		type file struct {
+			OrigName, NewName string
+			Hunks             int
+			Info              FileDiffInfo
+		}
+		var have []file
+		for {
+			fd, err := i.Next()
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatalf("unexpected iteration error: %+v", err)
+			}
+			have = append(have, file{OrigName: fd.OrigName, NewName: fd.NewName, Hunks: len(fd.Hunks), Info: ParseFileDiffInfo(fd)})
+		}
+
+		want := []file{
+			{OrigName: "a.txt", NewName: "b.txt", Info: FileDiffInfo{Copied: true, Similarity: 100}},
+			{OrigName: "c.txt", NewName: "d.txt", Hunks: 1, Info: FileDiffInfo{Renamed: true, Similarity: 90}},
+			{OrigName: "run.sh", NewName: "run.sh"},
+			{OrigName: "logo.png", NewName: "logo.png", Info: FileDiffInfo{Binary: true}},
+		}
+		if diff := cmp.Diff(want, have); diff != "" {
+			t.Errorf("unexpected file diffs (-want +have):\n%s", diff)
+		}
+	})
+
 	t.Run("Close", func(t *testing.T) {
 		c := new(closer)
 		i := &DiffFileIterator{rdr: c}
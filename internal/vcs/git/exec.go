@@ -97,7 +97,7 @@ var (
 		"show":   append([]string{}, gitCommonAllowlist...),
 		"remote": {"-v"},
 		"diff":   append([]string{}, gitCommonAllowlist...),
-		"blame":  {"--root", "--incremental", "-w", "-p", "--porcelain", "--"},
+		"blame":  {"--root", "--incremental", "-w", "-p", "--porcelain", "-L", "--ignore-rev", "--"},
 		"branch": {"-r", "-a", "--contains"},
 
 		"rev-parse":    {"--abbrev-ref", "--symbolic-full-name"},
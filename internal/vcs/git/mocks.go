@@ -25,6 +25,8 @@ var Mocks, emptyMocks struct {
 	GetObject        func(objectName string) (OID, ObjectType, error)
 	Commits          func(repo api.RepoName, opt CommitsOptions) ([]*Commit, error)
 	MergeBase        func(repo api.RepoName, a, b api.CommitID) (api.CommitID, error)
+	ListBranches     func(repo api.RepoName, opt BranchesOptions) ([]*Branch, error)
+	BlameFile        func(repo api.RepoName, path string, opt *BlameOptions) ([]*Hunk, error)
 }
 
 // ResetMocks clears the mock functions set on Mocks (so that subsequent tests don't inadvertently
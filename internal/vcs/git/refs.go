@@ -146,6 +146,10 @@ func (f branchFilter) add(list []string) {
 
 // ListBranches returns a list of all branches in the repository.
 func ListBranches(ctx context.Context, repo api.RepoName, opt BranchesOptions) ([]*Branch, error) {
+	if Mocks.ListBranches != nil {
+		return Mocks.ListBranches(repo, opt)
+	}
+
 	span, ctx := ot.StartSpanFromContext(ctx, "Git: Branches")
 	span.SetTag("Opt", opt)
 	defer span.Finish()
@@ -45,6 +45,7 @@ func canonicalizeDocuments(state *State) {
 
 			canonicalizeDocumentsInDefinitionReferences(state, state.DefinitionData, documentID, canonicalID)
 			canonicalizeDocumentsInDefinitionReferences(state, state.ReferenceData, documentID, canonicalID)
+			canonicalizeDocumentsInDefinitionReferences(state, state.ImplementationData, documentID, canonicalID)
 
 			// Remove non-canonical document
 			delete(state.DocumentData, documentID)
@@ -152,7 +153,7 @@ func canonicalizeResultSetData(state *State, id int, item ResultSet) ResultSet {
 	return item
 }
 
-// mergeNextResultSetData merges the definition, reference, and hover result identifiers from
+// mergeNextResultSetData merges the definition, reference, hover, implementation, and documentation result identifiers from
 // nextItem into item when not already defined. The moniker identifiers of nextItem are unioned
 // into the moniker identifiers of item.
 func mergeNextResultSetData(state *State, itemID int, item ResultSet, nextID int, nextItem ResultSet) ResultSet {
@@ -165,12 +166,18 @@ func mergeNextResultSetData(state *State, itemID int, item ResultSet, nextID int
 	if item.HoverResultID == 0 {
 		item = item.SetHoverResultID(nextItem.HoverResultID)
 	}
+	if item.ImplementationResultID == 0 {
+		item = item.SetImplementationResultID(nextItem.ImplementationResultID)
+	}
+	if item.DocumentationResultID == 0 {
+		item = item.SetDocumentationResultID(nextItem.DocumentationResultID)
+	}
 
 	state.Monikers.SetUnion(itemID, state.Monikers.Get(nextID))
 	return item
 }
 
-// mergeNextRangeData merges the definition, reference, and hover result identifiers from nextItem
+// mergeNextRangeData merges the definition, reference, hover, implementation, and documentation result identifiers from nextItem
 // into item when not already defined. The moniker identifiers of nextItem are unioned into the
 // moniker identifiers of item.
 func mergeNextRangeData(state *State, itemID int, item Range, nextID int, nextItem ResultSet) Range {
@@ -183,6 +190,12 @@ func mergeNextRangeData(state *State, itemID int, item Range, nextID int, nextIt
 	if item.HoverResultID == 0 {
 		item = item.SetHoverResultID(nextItem.HoverResultID)
 	}
+	if item.ImplementationResultID == 0 {
+		item = item.SetImplementationResultID(nextItem.ImplementationResultID)
+	}
+	if item.DocumentationResultID == 0 {
+		item = item.SetDocumentationResultID(nextItem.DocumentationResultID)
+	}
 
 	state.Monikers.SetUnion(itemID, state.Monikers.Get(nextID))
 	return item
@@ -150,16 +150,17 @@ func correlateElement(state *wrappedState, element Element) error {
 }
 
 var vertexHandlers = map[string]func(state *wrappedState, element Element) error{
-	"metaData":           correlateMetaData,
-	"document":           correlateDocument,
-	"range":              correlateRange,
-	"resultSet":          correlateResultSet,
-	"definitionResult":   correlateDefinitionResult,
-	"referenceResult":    correlateReferenceResult,
-	"hoverResult":        correlateHoverResult,
-	"moniker":            correlateMoniker,
-	"packageInformation": correlatePackageInformation,
-	"diagnosticResult":   correlateDiagnosticResult,
+	"metaData":             correlateMetaData,
+	"document":             correlateDocument,
+	"range":                correlateRange,
+	"resultSet":            correlateResultSet,
+	"definitionResult":     correlateDefinitionResult,
+	"referenceResult":      correlateReferenceResult,
+	"implementationResult": correlateImplementationResult,
+	"hoverResult":          correlateHoverResult,
+	"moniker":              correlateMoniker,
+	"packageInformation":   correlatePackageInformation,
+	"diagnosticResult":     correlateDiagnosticResult,
 
 	// Sourcegraph extensions
 	string(protocol.VertexSourcegraphDocumentationResult): correlateDocumentationResult,
@@ -183,16 +184,17 @@ func correlateVertex(state *wrappedState, element Element) error {
 }
 
 var edgeHandlers = map[string]func(state *wrappedState, id int, edge Edge) error{
-	"contains":                correlateContainsEdge,
-	"next":                    correlateNextEdge,
-	"item":                    correlateItemEdge,
-	"textDocument/definition": correlateTextDocumentDefinitionEdge,
-	"textDocument/references": correlateTextDocumentReferencesEdge,
-	"textDocument/hover":      correlateTextDocumentHoverEdge,
-	"moniker":                 correlateMonikerEdge,
-	"nextMoniker":             correlateNextMonikerEdge,
-	"packageInformation":      correlatePackageInformationEdge,
-	"textDocument/diagnostic": correlateDiagnosticEdge,
+	"contains":                    correlateContainsEdge,
+	"next":                        correlateNextEdge,
+	"item":                        correlateItemEdge,
+	"textDocument/definition":     correlateTextDocumentDefinitionEdge,
+	"textDocument/references":     correlateTextDocumentReferencesEdge,
+	"textDocument/implementation": correlateTextDocumentImplementationEdge,
+	"textDocument/hover":          correlateTextDocumentHoverEdge,
+	"moniker":                     correlateMonikerEdge,
+	"nextMoniker":                 correlateNextMonikerEdge,
+	"packageInformation":          correlatePackageInformationEdge,
+	"textDocument/diagnostic":     correlateDiagnosticEdge,
 
 	// Sourcegraph extensions
 	string(protocol.EdgeSourcegraphDocumentationResult):   correlateDocumentationResultEdge,
@@ -288,6 +290,11 @@ func correlateReferenceResult(state *wrappedState, element Element) error {
 	return nil
 }
 
+func correlateImplementationResult(state *wrappedState, element Element) error {
+	state.ImplementationData[element.ID] = datastructures.NewDefaultIDSetMap()
+	return nil
+}
+
 func correlateHoverResult(state *wrappedState, element Element) error {
 	payload, ok := element.Payload.(string)
 	if !ok {
@@ -384,7 +391,25 @@ func correlateItemEdge(state *wrappedState, id int, edge Edge) error {
 
 				// Link reference data to a reference range
 				documentMap.SetAdd(edge.Document, inV)
+
+				if edge.Property == protocol.ItemPropertyWriteReferences {
+					// Mark the range as a write to the referenced symbol
+					state.WriteReferenceRanges.Add(inV)
+				}
+			}
+		}
+
+		return nil
+	}
+
+	if documentMap, ok := state.ImplementationData[edge.OutV]; ok {
+		for _, inV := range edge.InVs {
+			if _, ok := state.RangeData[inV]; !ok {
+				return malformedDump(id, inV, "range")
 			}
+
+			// Link implementation data to an implementing range
+			documentMap.SetAdd(edge.Document, inV)
 		}
 
 		return nil
@@ -428,6 +453,21 @@ func correlateTextDocumentReferencesEdge(state *wrappedState, id int, edge Edge)
 	return nil
 }
 
+func correlateTextDocumentImplementationEdge(state *wrappedState, id int, edge Edge) error {
+	if _, ok := state.ImplementationData[edge.InV]; !ok {
+		return malformedDump(id, edge.InV, "implementationResult")
+	}
+
+	if source, ok := state.RangeData[edge.OutV]; ok {
+		state.RangeData[edge.OutV] = source.SetImplementationResultID(edge.InV)
+	} else if source, ok := state.ResultSetData[edge.OutV]; ok {
+		state.ResultSetData[edge.OutV] = source.SetImplementationResultID(edge.InV)
+	} else {
+		return malformedDump(id, edge.OutV, "range", "resultSet")
+	}
+	return nil
+}
+
 func correlateTextDocumentHoverEdge(state *wrappedState, id int, edge Edge) error {
 	if _, ok := state.HoverData[edge.InV]; !ok {
 		return malformedDump(id, edge.InV, "hoverResult")
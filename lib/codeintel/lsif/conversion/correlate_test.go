@@ -89,8 +89,9 @@ func TestCorrelate(t *testing.T) {
 		},
 		ResultSetData: map[int]ResultSet{
 			10: {
-				DefinitionResultID: 12,
-				ReferenceResultID:  14,
+				DefinitionResultID:     12,
+				ReferenceResultID:      14,
+				ImplementationResultID: 51,
 			},
 			11: {
 				HoverResultID: 16,
@@ -104,6 +105,9 @@ func TestCorrelate(t *testing.T) {
 			14: datastructures.DefaultIDSetMapWith(map[int]*datastructures.IDSet{2: datastructures.IDSetWith(4, 5)}),
 			15: datastructures.DefaultIDSetMapWith(map[int]*datastructures.IDSet{}),
 		},
+		ImplementationData: map[int]*datastructures.DefaultIDSetMap{
+			51: datastructures.DefaultIDSetMapWith(map[int]*datastructures.IDSet{3: datastructures.IDSetWith(9)}),
+		},
 		HoverData: map[int]string{
 			16: "```go\ntext A\n```",
 			17: "```go\ntext B\n```",
@@ -190,6 +194,7 @@ func TestCorrelate(t *testing.T) {
 		DocumentationChildren:           map[int][]int{},
 		DocumentationStringLabel:        map[int]int{},
 		DocumentationStringDetail:       map[int]int{},
+		WriteReferenceRanges:            datastructures.IDSetWith(5),
 	}
 
 	if diff := cmp.Diff(expectedState, state, datastructures.Comparers...); diff != "" {
@@ -218,6 +223,7 @@ func TestCorrelateMetaDataRoot(t *testing.T) {
 		ResultSetData:          map[int]ResultSet{},
 		DefinitionData:         map[int]*datastructures.DefaultIDSetMap{},
 		ReferenceData:          map[int]*datastructures.DefaultIDSetMap{},
+		ImplementationData:     map[int]*datastructures.DefaultIDSetMap{},
 		HoverData:              map[int]string{},
 		MonikerData:            map[int]Moniker{},
 		PackageInformationData: map[int]PackageInformation{},
@@ -239,6 +245,7 @@ func TestCorrelateMetaDataRoot(t *testing.T) {
 		DocumentationChildren:           map[int][]int{},
 		DocumentationStringLabel:        map[int]int{},
 		DocumentationStringDetail:       map[int]int{},
+		WriteReferenceRanges:            datastructures.NewIDSet(),
 	}
 
 	if diff := cmp.Diff(expectedState, state, datastructures.Comparers...); diff != "" {
@@ -267,6 +274,7 @@ func TestCorrelateMetaDataRootX(t *testing.T) {
 		ResultSetData:          map[int]ResultSet{},
 		DefinitionData:         map[int]*datastructures.DefaultIDSetMap{},
 		ReferenceData:          map[int]*datastructures.DefaultIDSetMap{},
+		ImplementationData:     map[int]*datastructures.DefaultIDSetMap{},
 		HoverData:              map[int]string{},
 		MonikerData:            map[int]Moniker{},
 		PackageInformationData: map[int]PackageInformation{},
@@ -288,6 +296,7 @@ func TestCorrelateMetaDataRootX(t *testing.T) {
 		DocumentationChildren:           map[int][]int{},
 		DocumentationStringLabel:        map[int]int{},
 		DocumentationStringDetail:       map[int]int{},
+		WriteReferenceRanges:            datastructures.NewIDSet(),
 	}
 
 	if diff := cmp.Diff(expectedState, state, datastructures.Comparers...); diff != "" {
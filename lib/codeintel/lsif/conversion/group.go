@@ -24,7 +24,7 @@ const resultsPerResultChunk = 512
 
 // groupBundleData converts a raw (but canonicalized) correlation State into a GroupedBundleData.
 func groupBundleData(ctx context.Context, state *State) (*semantic.GroupedBundleDataChans, error) {
-	numResults := len(state.DefinitionData) + len(state.ReferenceData)
+	numResults := len(state.DefinitionData) + len(state.ReferenceData) + len(state.ImplementationData)
 	numResultChunks := int(math.Max(1, math.Floor(float64(numResults)/resultsPerResultChunk)))
 
 	meta := semantic.MetaData{NumResultChunks: numResultChunks}
@@ -57,6 +57,8 @@ func serializeBundleDocuments(ctx context.Context, state *State) chan semantic.K
 	go func() {
 		defer close(ch)
 
+		documentationPathIDs := collectDocumentationPathIDs(state)
+
 		for documentID, uri := range state.DocumentData {
 			if strings.HasPrefix(uri, "..") {
 				continue
@@ -64,7 +66,7 @@ func serializeBundleDocuments(ctx context.Context, state *State) chan semantic.K
 
 			data := semantic.KeyedDocumentData{
 				Path:     uri,
-				Document: serializeDocument(state, documentID),
+				Document: serializeDocument(state, documentID, documentationPathIDs),
 			}
 
 			select {
@@ -78,7 +80,7 @@ func serializeBundleDocuments(ctx context.Context, state *State) chan semantic.K
 	return ch
 }
 
-func serializeDocument(state *State, documentID int) semantic.DocumentData {
+func serializeDocument(state *State, documentID int, documentationPathIDs map[int]documentationPathIDs) semantic.DocumentData {
 	document := semantic.DocumentData{
 		Ranges:             make(map[semantic.ID]semantic.RangeData, state.Contains.SetLen(documentID)),
 		HoverResults:       map[semantic.ID]string{},
@@ -111,15 +113,21 @@ func serializeDocument(state *State, documentID int) semantic.DocumentData {
 			}
 		})
 
+		pathIDs := documentationPathIDs[rangeData.DocumentationResultID]
+
 		document.Ranges[toID(rangeID)] = semantic.RangeData{
-			StartLine:          rangeData.Start.Line,
-			StartCharacter:     rangeData.Start.Character,
-			EndLine:            rangeData.End.Line,
-			EndCharacter:       rangeData.End.Character,
-			DefinitionResultID: toID(rangeData.DefinitionResultID),
-			ReferenceResultID:  toID(rangeData.ReferenceResultID),
-			HoverResultID:      toID(rangeData.HoverResultID),
-			MonikerIDs:         monikerIDs,
+			StartLine:               rangeData.Start.Line,
+			StartCharacter:          rangeData.Start.Character,
+			EndLine:                 rangeData.End.Line,
+			EndCharacter:            rangeData.End.Character,
+			DefinitionResultID:      toID(rangeData.DefinitionResultID),
+			ReferenceResultID:       toID(rangeData.ReferenceResultID),
+			HoverResultID:           toID(rangeData.HoverResultID),
+			ImplementationResultID:  toID(rangeData.ImplementationResultID),
+			MonikerIDs:              monikerIDs,
+			DocumentationPathID:     pathIDs.pathID,
+			DocumentationPagePathID: pathIDs.pagePathID,
+			Symbol:                  symbolData(rangeData),
 		}
 
 		if rangeData.HoverResultID != 0 {
@@ -146,6 +154,30 @@ func serializeDocument(state *State, documentID int) semantic.DocumentData {
 	return document
 }
 
+// symbolData returns the symbol described by the tag of the given range, or nil if the
+// range does not define or declare a symbol. Ranges without an explicit full range span
+// only the symbol's name.
+func symbolData(r Range) *semantic.SymbolData {
+	if r.Tag == nil || (r.Tag.Type != "definition" && r.Tag.Type != "declaration") {
+		return nil
+	}
+
+	fullRange := r.RangeData
+	if r.Tag.FullRange != nil {
+		fullRange = *r.Tag.FullRange
+	}
+
+	return &semantic.SymbolData{
+		Name:               r.Tag.Text,
+		Detail:             r.Tag.Detail,
+		Kind:               r.Tag.Kind,
+		FullStartLine:      fullRange.Start.Line,
+		FullStartCharacter: fullRange.Start.Character,
+		FullEndLine:        fullRange.End.Line,
+		FullEndCharacter:   fullRange.End.Character,
+	}
+}
+
 func serializeResultChunks(ctx context.Context, state *State, numResultChunks int) chan semantic.IndexedResultChunkData {
 	chunkAssignments := make(map[int][]int, numResultChunks)
 	for id := range state.DefinitionData {
@@ -156,6 +188,10 @@ func serializeResultChunks(ctx context.Context, state *State, numResultChunks in
 		index := semantic.HashKey(toID(id), numResultChunks)
 		chunkAssignments[index] = append(chunkAssignments[index], id)
 	}
+	for id := range state.ImplementationData {
+		index := semantic.HashKey(toID(id), numResultChunks)
+		chunkAssignments[index] = append(chunkAssignments[index], id)
+	}
 
 	ch := make(chan semantic.IndexedResultChunkData)
 
@@ -172,8 +208,13 @@ func serializeResultChunks(ctx context.Context, state *State, numResultChunks in
 
 			for _, resultID := range resultIDs {
 				documentRanges, ok := state.DefinitionData[resultID]
+				isReferenceResult := false
+				if !ok {
+					documentRanges, ok = state.ReferenceData[resultID]
+					isReferenceResult = ok
+				}
 				if !ok {
-					documentRanges = state.ReferenceData[resultID]
+					documentRanges = state.ImplementationData[resultID]
 				}
 
 				rangeIDMap := map[semantic.ID]int{}
@@ -187,8 +228,9 @@ func serializeResultChunks(ctx context.Context, state *State, numResultChunks in
 						rangeIDMap[toID(rangeID)] = rangeID
 
 						documentIDRangeIDs = append(documentIDRangeIDs, semantic.DocumentIDRangeID{
-							DocumentID: docID,
-							RangeID:    toID(rangeID),
+							DocumentID:  docID,
+							RangeID:     toID(rangeID),
+							WriteAccess: isReferenceResult && state.WriteReferenceRanges.Contains(rangeID),
 						})
 					})
 				})
@@ -7,8 +7,9 @@ import (
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
 )
 
-// TODO(slimsag): future: today we do not consume state.DocumentationResultsByResultSet which will
-// become important for e.g. letting one documentationResult link to another.
+// TODO(slimsag): future: today we only consume state.DocumentationResultsByResultSet (via the
+// documentation result identifiers of ranges) to link ranges to their documentation, but it will
+// also become important for e.g. letting one documentationResult link to another.
 
 func collectDocumentationPages(ctx context.Context, state *State) chan *semantic.DocumentationPageData {
 	ch := make(chan *semantic.DocumentationPageData)
@@ -136,3 +137,49 @@ func (p *pageCollector) collect(ctx context.Context, ch chan<- *semantic.Documen
 	close(ch) // collected all pages
 	return nil
 }
+
+// documentationPathIDs pairs the path ID of a documentation node with the path ID of the page
+// on which that node is rendered.
+type documentationPathIDs struct {
+	pathID     string
+	pagePathID string
+}
+
+// collectDocumentationPathIDs returns the path IDs of every documentationResult reachable from
+// the project root, keyed by documentationResult vertex. The path IDs are constructed in the same
+// way as the pages emitted by collectDocumentationPages, which allows a range to be linked to the
+// page (and node within that page) that documents it.
+func collectDocumentationPathIDs(state *State) map[int]documentationPathIDs {
+	pathIDs := map[int]documentationPathIDs{}
+	if state.DocumentationResultRoot == -1 {
+		return pathIDs
+	}
+
+	var walk func(documentationResult int, parentPathID, pagePathID string)
+	walk = func(documentationResult int, parentPathID, pagePathID string) {
+		if _, ok := pathIDs[documentationResult]; ok {
+			return
+		}
+
+		pathID := "/"
+		if documentationResult != state.DocumentationResultRoot {
+			documentation := state.DocumentationResultsData[documentationResult]
+			pathID = parentPathID + documentation.Slug
+
+			if documentation.NewPage {
+				pagePathID = pathID
+			}
+		} else {
+			pagePathID = pathID
+		}
+
+		pathIDs[documentationResult] = documentationPathIDs{pathID: pathID, pagePathID: pagePathID}
+
+		for _, child := range state.DocumentationChildren[documentationResult] {
+			walk(child, pathID, pagePathID)
+		}
+	}
+	walk(state.DocumentationResultRoot, "", "")
+
+	return pathIDs
+}
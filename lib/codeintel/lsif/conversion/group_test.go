@@ -151,6 +151,7 @@ func TestGroupBundleData(t *testing.T) {
 				1003: datastructures.IDSetWith(2007, 2009),
 			}),
 		},
+		WriteReferenceRanges: datastructures.IDSetWith(2009),
 		HoverData: map[int]string{
 			3008: "foo",
 			3009: "bar",
@@ -551,12 +552,12 @@ func TestGroupBundleData(t *testing.T) {
 				"3006": {
 					{DocumentID: "1001", RangeID: "2003"},
 					{DocumentID: "1003", RangeID: "2007"},
-					{DocumentID: "1003", RangeID: "2009"},
+					{DocumentID: "1003", RangeID: "2009", WriteAccess: true},
 				},
 				"3007": {
 					{DocumentID: "1001", RangeID: "2002"},
 					{DocumentID: "1003", RangeID: "2007"},
-					{DocumentID: "1003", RangeID: "2009"},
+					{DocumentID: "1003", RangeID: "2009", WriteAccess: true},
 				},
 			},
 		},
@@ -626,6 +627,80 @@ func TestGroupBundleData(t *testing.T) {
 	}
 }
 
+func TestCollectDocumentationPathIDs(t *testing.T) {
+	state := newState()
+	state.DocumentationResultRoot = 1
+	state.DocumentationResultsData = map[int]protocol.Documentation{
+		1: {Slug: "/index", NewPage: true},
+		2: {Slug: "pkg", NewPage: true},
+		3: {Slug: "#Foo"},
+		4: {Slug: "#Bar"},
+	}
+	state.DocumentationChildren = map[int][]int{
+		1: {2},
+		2: {3},
+		3: {4},
+	}
+
+	expectedPathIDs := map[int]documentationPathIDs{
+		1: {pathID: "/", pagePathID: "/"},
+		2: {pathID: "/pkg", pagePathID: "/pkg"},
+		3: {pathID: "/pkg#Foo", pagePathID: "/pkg"},
+		4: {pathID: "/pkg#Foo#Bar", pagePathID: "/pkg"},
+	}
+	if diff := cmp.Diff(expectedPathIDs, collectDocumentationPathIDs(state), cmp.AllowUnexported(documentationPathIDs{})); diff != "" {
+		t.Errorf("unexpected path IDs (-want +got):\n%s", diff)
+	}
+}
+
+func TestSymbolData(t *testing.T) {
+	rangeData := protocol.RangeData{Start: protocol.Pos{Line: 3, Character: 5}, End: protocol.Pos{Line: 3, Character: 8}}
+	fullRange := protocol.RangeData{Start: protocol.Pos{Line: 3, Character: 0}, End: protocol.Pos{Line: 10, Character: 1}}
+
+	testCases := []struct {
+		name     string
+		tag      *protocol.RangeTag
+		expected *semantic.SymbolData
+	}{
+		{name: "untagged"},
+		{name: "reference", tag: &protocol.RangeTag{Type: "reference", Text: "foo"}},
+		{
+			name: "definition",
+			tag:  &protocol.RangeTag{Type: "definition", Text: "foo", Detail: "func()", Kind: protocol.Function, FullRange: &fullRange},
+			expected: &semantic.SymbolData{
+				Name:               "foo",
+				Detail:             "func()",
+				Kind:               protocol.Function,
+				FullStartLine:      3,
+				FullStartCharacter: 0,
+				FullEndLine:        10,
+				FullEndCharacter:   1,
+			},
+		},
+		{
+			name: "declaration without full range",
+			tag:  &protocol.RangeTag{Type: "declaration", Text: "bar", Kind: protocol.Variable},
+			expected: &semantic.SymbolData{
+				Name:               "bar",
+				Kind:               protocol.Variable,
+				FullStartLine:      3,
+				FullStartCharacter: 5,
+				FullEndLine:        3,
+				FullEndCharacter:   8,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := Range{Range: reader.Range{RangeData: rangeData, Tag: testCase.tag}}
+			if diff := cmp.Diff(testCase.expected, symbolData(r)); diff != "" {
+				t.Errorf("unexpected symbol data (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 //
 //
 
@@ -14,6 +14,7 @@ type State struct {
 	ResultSetData          map[int]ResultSet
 	DefinitionData         map[int]*datastructures.DefaultIDSetMap
 	ReferenceData          map[int]*datastructures.DefaultIDSetMap
+	ImplementationData     map[int]*datastructures.DefaultIDSetMap
 	HoverData              map[int]string
 	MonikerData            map[int]Moniker
 	PackageInformationData map[int]PackageInformation
@@ -35,6 +36,7 @@ type State struct {
 	DocumentationChildren           map[int][]int                  // maps documentationResult vertex -> ordered list of children documentationResult vertices
 	DocumentationStringLabel        map[int]int                    // maps documentationResult vertex -> label documentationString vertex
 	DocumentationStringDetail       map[int]int                    // maps documentationResult vertex -> detail documentationString vertex
+	WriteReferenceRanges            *datastructures.IDSet          // range ids at which a referenced symbol is written
 }
 
 // newState create a new State with zero-valued map fields.
@@ -45,6 +47,7 @@ func newState() *State {
 		ResultSetData:          map[int]ResultSet{},
 		DefinitionData:         map[int]*datastructures.DefaultIDSetMap{},
 		ReferenceData:          map[int]*datastructures.DefaultIDSetMap{},
+		ImplementationData:     map[int]*datastructures.DefaultIDSetMap{},
 		HoverData:              map[int]string{},
 		MonikerData:            map[int]Moniker{},
 		PackageInformationData: map[int]PackageInformation{},
@@ -66,5 +69,6 @@ func newState() *State {
 		DocumentationChildren:           map[int][]int{},
 		DocumentationStringLabel:        map[int]int{},
 		DocumentationStringDetail:       map[int]int{},
+		WriteReferenceRanges:            datastructures.NewIDSet(),
 	}
 }
@@ -10,69 +10,129 @@ type Diagnostic reader.Diagnostic
 
 type Range struct {
 	reader.Range
-	DefinitionResultID int
-	ReferenceResultID  int
-	HoverResultID      int
+	DefinitionResultID     int
+	ReferenceResultID      int
+	HoverResultID          int
+	ImplementationResultID int
+	DocumentationResultID  int
 }
 
 func (r Range) SetDefinitionResultID(id int) Range {
 	return Range{
-		Range:              r.Range,
-		DefinitionResultID: id,
-		ReferenceResultID:  r.ReferenceResultID,
-		HoverResultID:      r.HoverResultID,
+		Range:                  r.Range,
+		DefinitionResultID:     id,
+		ReferenceResultID:      r.ReferenceResultID,
+		HoverResultID:          r.HoverResultID,
+		ImplementationResultID: r.ImplementationResultID,
+		DocumentationResultID:  r.DocumentationResultID,
 	}
 }
 
 func (r Range) SetReferenceResultID(id int) Range {
 	return Range{
-		Range:              r.Range,
-		DefinitionResultID: r.DefinitionResultID,
-		ReferenceResultID:  id,
-		HoverResultID:      r.HoverResultID,
+		Range:                  r.Range,
+		DefinitionResultID:     r.DefinitionResultID,
+		ReferenceResultID:      id,
+		HoverResultID:          r.HoverResultID,
+		ImplementationResultID: r.ImplementationResultID,
+		DocumentationResultID:  r.DocumentationResultID,
+	}
+}
+
+func (r Range) SetImplementationResultID(id int) Range {
+	return Range{
+		Range:                  r.Range,
+		DefinitionResultID:     r.DefinitionResultID,
+		ReferenceResultID:      r.ReferenceResultID,
+		HoverResultID:          r.HoverResultID,
+		ImplementationResultID: id,
+		DocumentationResultID:  r.DocumentationResultID,
 	}
 }
 
 func (r Range) SetHoverResultID(id int) Range {
 	return Range{
-		Range:              r.Range,
-		DefinitionResultID: r.DefinitionResultID,
-		ReferenceResultID:  r.ReferenceResultID,
-		HoverResultID:      id,
+		Range:                  r.Range,
+		DefinitionResultID:     r.DefinitionResultID,
+		ReferenceResultID:      r.ReferenceResultID,
+		HoverResultID:          id,
+		ImplementationResultID: r.ImplementationResultID,
+		DocumentationResultID:  r.DocumentationResultID,
+	}
+}
+
+func (r Range) SetDocumentationResultID(id int) Range {
+	return Range{
+		Range:                  r.Range,
+		DefinitionResultID:     r.DefinitionResultID,
+		ReferenceResultID:      r.ReferenceResultID,
+		HoverResultID:          r.HoverResultID,
+		ImplementationResultID: r.ImplementationResultID,
+		DocumentationResultID:  id,
 	}
 }
 
 type ResultSet struct {
 	reader.ResultSet
-	DefinitionResultID int
-	ReferenceResultID  int
-	HoverResultID      int
+	DefinitionResultID     int
+	ReferenceResultID      int
+	HoverResultID          int
+	ImplementationResultID int
+	DocumentationResultID  int
 }
 
 func (rs ResultSet) SetDefinitionResultID(id int) ResultSet {
 	return ResultSet{
-		ResultSet:          rs.ResultSet,
-		DefinitionResultID: id,
-		ReferenceResultID:  rs.ReferenceResultID,
-		HoverResultID:      rs.HoverResultID,
+		ResultSet:              rs.ResultSet,
+		DefinitionResultID:     id,
+		ReferenceResultID:      rs.ReferenceResultID,
+		HoverResultID:          rs.HoverResultID,
+		ImplementationResultID: rs.ImplementationResultID,
+		DocumentationResultID:  rs.DocumentationResultID,
 	}
 }
 
 func (rs ResultSet) SetReferenceResultID(id int) ResultSet {
 	return ResultSet{
-		ResultSet:          rs.ResultSet,
-		DefinitionResultID: rs.DefinitionResultID,
-		ReferenceResultID:  id,
-		HoverResultID:      rs.HoverResultID,
+		ResultSet:              rs.ResultSet,
+		DefinitionResultID:     rs.DefinitionResultID,
+		ReferenceResultID:      id,
+		HoverResultID:          rs.HoverResultID,
+		ImplementationResultID: rs.ImplementationResultID,
+		DocumentationResultID:  rs.DocumentationResultID,
 	}
 }
 
 func (rs ResultSet) SetHoverResultID(id int) ResultSet {
 	return ResultSet{
-		ResultSet:          rs.ResultSet,
-		DefinitionResultID: rs.DefinitionResultID,
-		ReferenceResultID:  rs.ReferenceResultID,
-		HoverResultID:      id,
+		ResultSet:              rs.ResultSet,
+		DefinitionResultID:     rs.DefinitionResultID,
+		ReferenceResultID:      rs.ReferenceResultID,
+		HoverResultID:          id,
+		ImplementationResultID: rs.ImplementationResultID,
+		DocumentationResultID:  rs.DocumentationResultID,
+	}
+}
+
+func (rs ResultSet) SetImplementationResultID(id int) ResultSet {
+	return ResultSet{
+		ResultSet:              rs.ResultSet,
+		DefinitionResultID:     rs.DefinitionResultID,
+		ReferenceResultID:      rs.ReferenceResultID,
+		HoverResultID:          rs.HoverResultID,
+		ImplementationResultID: id,
+		DocumentationResultID:  rs.DocumentationResultID,
+	}
+}
+
+func (rs ResultSet) SetDocumentationResultID(id int) ResultSet {
+	return ResultSet{
+		ResultSet:              rs.ResultSet,
+		DefinitionResultID:     rs.DefinitionResultID,
+		ReferenceResultID:      rs.ReferenceResultID,
+		HoverResultID:          rs.HoverResultID,
+		ImplementationResultID: rs.ImplementationResultID,
+		DocumentationResultID:  id,
 	}
 }
 
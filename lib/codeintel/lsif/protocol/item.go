@@ -1,5 +1,10 @@
 package protocol
 
+// ItemPropertyWriteReferences is a Sourcegraph extension to the property of an item edge. An
+// item edge with this property links a reference result to the ranges at which the referenced
+// symbol is written or assigned. These ranges are references in every other respect.
+const ItemPropertyWriteReferences = "sourcegraph:writeReferences"
+
 type Item struct {
 	Edge
 	OutV     uint64   `json:"outV"`
@@ -36,3 +41,7 @@ func NewItemOfDefinitions(id, outV uint64, inVs []uint64, document uint64) Item
 func NewItemOfReferences(id, outV uint64, inVs []uint64, document uint64) Item {
 	return NewItemWithProperty(id, outV, inVs, document, "references")
 }
+
+func NewItemOfWriteReferences(id, outV uint64, inVs []uint64, document uint64) Item {
+	return NewItemWithProperty(id, outV, inVs, document, ItemPropertyWriteReferences)
+}
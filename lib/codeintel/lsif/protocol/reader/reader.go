@@ -24,6 +24,24 @@ func Read(ctx context.Context, r io.Reader) <-chan Pair {
 	})
 }
 
+// Unmarshaller converts individual lines of an LSIF index into elements. Identifiers are interned
+// consistently across all lines unmarshalled by the same instance, so unmarshalling a line a second
+// time produces an identical element.
+type Unmarshaller struct {
+	interner *Interner
+}
+
+// NewUnmarshaller creates a new Unmarshaller with an empty interner.
+func NewUnmarshaller() *Unmarshaller {
+	return &Unmarshaller{interner: NewInterner()}
+}
+
+// Unmarshal converts a single line of an LSIF index into an element. This method is safe to call
+// from multiple goroutines.
+func (u *Unmarshaller) Unmarshal(line []byte) (Element, error) {
+	return unmarshalElement(u.interner, line)
+}
+
 // LineBufferSize is the maximum size of the buffer used to read each line of a raw LSIF index. Lines in
 // LSIF can get very long as it include escaped hover text (package documentation), as well as large edges
 // such as the contains edge of large documents.
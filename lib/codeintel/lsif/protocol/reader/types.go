@@ -14,6 +14,7 @@ type Edge struct {
 	InV      int
 	InVs     []int
 	Document int
+	Property string
 }
 
 type MetaData struct {
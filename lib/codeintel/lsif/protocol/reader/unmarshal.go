@@ -59,6 +59,7 @@ func unmarshalEdge(interner *Interner, line []byte) (interface{}, error) {
 		InV      json.RawMessage   `json:"inV"`
 		InVs     []json.RawMessage `json:"inVs"`
 		Document json.RawMessage   `json:"document"`
+		Property string            `json:"property"`
 	}
 	if err := unmarshaller.Unmarshal(line, &payload); err != nil {
 		return Edge{}, err
@@ -92,6 +93,7 @@ func unmarshalEdge(interner *Interner, line []byte) (interface{}, error) {
 		InV:      inV,
 		InVs:     inVs,
 		Document: document,
+		Property: payload.Property,
 	}, nil
 }
 
@@ -104,10 +106,11 @@ func unmarshalEdge(interner *Interner, line []byte) (interface{}, error) {
 // do not net the same benefit.
 func unmarshalEdgeFast(line []byte) (Edge, bool) {
 	var payload struct {
-		InVs     []int `json:"inVs"`
-		OutV     int   `json:"outV"`
-		InV      int   `json:"inV"`
-		Document int   `json:"document"`
+		InVs     []int  `json:"inVs"`
+		OutV     int    `json:"outV"`
+		InV      int    `json:"inV"`
+		Document int    `json:"document"`
+		Property string `json:"property"`
 	}
 	if err := unmarshaller.Unmarshal(line, &payload); err != nil {
 		return Edge{}, false
@@ -118,6 +121,7 @@ func unmarshalEdgeFast(line []byte) (Edge, bool) {
 		InV:      payload.InV,
 		InVs:     payload.InVs,
 		Document: payload.Document,
+		Property: payload.Property,
 	}, true
 }
 
@@ -42,7 +42,7 @@ func TestUnmarshalElementNumericIDs(t *testing.T) {
 }
 
 func TestUnmarshalEdge(t *testing.T) {
-	edge, err := unmarshalEdge(NewInterner(), []byte(`{"id": "35", "type": "edge", "label": "item", "outV": "12", "inVs": ["07"], "document": "03"}`))
+	edge, err := unmarshalEdge(NewInterner(), []byte(`{"id": "35", "type": "edge", "label": "item", "outV": "12", "inVs": ["07"], "document": "03", "property": "references"}`))
 	if err != nil {
 		t.Fatalf("unexpected error unmarshalling meta data: %s", err)
 	}
@@ -52,6 +52,7 @@ func TestUnmarshalEdge(t *testing.T) {
 		InV:      0,
 		InVs:     []int{7},
 		Document: 3,
+		Property: "references",
 	}
 	if diff := cmp.Diff(expectedEdge, edge); diff != "" {
 		t.Errorf("unexpected edge (-want +got):\n%s", diff)
@@ -59,7 +60,7 @@ func TestUnmarshalEdge(t *testing.T) {
 }
 
 func TestUnmarshalEdgeNumericIDs(t *testing.T) {
-	edge, err := unmarshalEdge(NewInterner(), []byte(`{"id": 35, "type": "edge", "label": "item", "outV": 12, "inVs": [7], "document": 3}`))
+	edge, err := unmarshalEdge(NewInterner(), []byte(`{"id": 35, "type": "edge", "label": "item", "outV": 12, "inVs": [7], "document": 3, "property": "sourcegraph:writeReferences"}`))
 	if err != nil {
 		t.Fatalf("unexpected error unmarshalling meta data: %s", err)
 	}
@@ -69,6 +70,7 @@ func TestUnmarshalEdgeNumericIDs(t *testing.T) {
 		InV:      0,
 		InVs:     []int{7},
 		Document: 3,
+		Property: "sourcegraph:writeReferences",
 	}
 	if diff := cmp.Diff(expectedEdge, edge); diff != "" {
 		t.Errorf("unexpected edge (-want +got):\n%s", diff)
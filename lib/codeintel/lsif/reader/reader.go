@@ -1,6 +1,8 @@
 package reader
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"io"
 
@@ -13,7 +15,14 @@ type ElementMapper func(lineContext LineContext)
 // Read consumes the given reader as newline-delimited JSON-encoded LSIF. Each parsed vertex and each
 // parsed edge element is registered to the given Stasher. If vertex or edge mappers are supplied, they
 // are invoked on each parsed element.
+//
+// If the given Stasher is streaming, the reader must yield the same content as the stasher's source.
+// Only the location of each element is registered, and elements are parsed sequentially.
 func Read(r io.Reader, stasher *Stasher, vertexMapper, edgeMapper ElementMapper) error {
+	if stasher.stream != nil {
+		return readStreaming(r, stasher.stream, vertexMapper, edgeMapper)
+	}
+
 	index := 0
 	for pair := range reader.Read(context.Background(), r) {
 		if pair.Err != nil {
@@ -45,3 +54,54 @@ func Read(r io.Reader, stasher *Stasher, vertexMapper, edgeMapper ElementMapper)
 
 	return nil
 }
+
+// readStreaming consumes the given reader as newline-delimited JSON-encoded LSIF and registers the
+// location of each parsed vertex and edge element to the given stream.
+func readStreaming(r io.Reader, stream *stream, vertexMapper, edgeMapper ElementMapper) error {
+	br := bufio.NewReaderSize(r, streamBufferSize)
+
+	index := 0
+	var offset int64
+	for {
+		line, err := br.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+
+		lineOffset := offset
+		offset += int64(len(line))
+
+		if line = bytes.TrimRight(line, "\r\n"); len(line) != 0 {
+			element, unmarshalErr := stream.unmarshaller.Unmarshal(line)
+			if unmarshalErr != nil {
+				return unmarshalErr
+			}
+
+			index++
+			lineContext := LineContext{
+				Index:   index,
+				Element: element,
+			}
+
+			if element.Type == "vertex" {
+				if vertexMapper != nil {
+					vertexMapper(lineContext)
+				}
+
+				stream.stash(lineContext, lineOffset, len(line), false)
+			}
+
+			if element.Type == "edge" {
+				if edgeMapper != nil {
+					edgeMapper(lineContext)
+				}
+
+				stream.stash(lineContext, lineOffset, len(line), true)
+			}
+		}
+
+		if err == io.EOF {
+			return nil
+		}
+	}
+}
@@ -1,11 +1,20 @@
 package reader
 
-import "github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol/reader"
+import (
+	"io"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol/reader"
+)
 
 // Stasher maintains a mapping from identifiers to vertex and edge elements.
+//
+// By default, every element is held in memory. A stasher created with NewStreamingStasher
+// instead holds only the location of each element within its source, and re-reads elements
+// from that source on demand.
 type Stasher struct {
 	vertices map[int]LineContext
 	edges    map[int]LineContext
+	stream   *stream
 }
 
 // NewStasher creates a new empty Stasher.
@@ -16,9 +25,25 @@ func NewStasher() *Stasher {
 	}
 }
 
+// NewStreamingStasher creates a new empty Stasher that re-reads elements from the given source
+// on demand. Recently read elements are cached until their combined encoded size exceeds the given
+// memory budget (in bytes). Elements must be registered via Read, where the reader supplied to Read
+// yields the same content as the given source.
+func NewStreamingStasher(source io.ReaderAt, memoryBudget int) *Stasher {
+	return &Stasher{
+		stream: newStream(source, memoryBudget),
+	}
+}
+
 // Vertices invokes the given function on each registered vertex. If any invocation returns false,
 // iteration of the vertices will not complete and false will be returned immediately.
 func (s *Stasher) Vertices(f func(lineContext LineContext) bool) bool {
+	if s.stream != nil {
+		return s.stream.each(false, func(lineContext LineContext) bool {
+			return f(lineContext)
+		})
+	}
+
 	for _, lineContext := range s.vertices {
 		if !f(lineContext) {
 			return false
@@ -31,13 +56,21 @@ func (s *Stasher) Vertices(f func(lineContext LineContext) bool) bool {
 // Edges invokes the given function on each registered edge. If any invocation returns false,
 // iteration of the edges will not complete and false will be returned immediately.
 func (s *Stasher) Edges(f func(lineContext LineContext, edge reader.Edge) bool) bool {
-	for _, lineContext := range s.edges {
+	g := func(lineContext LineContext) bool {
 		edge, ok := lineContext.Element.Payload.(reader.Edge)
 		if !ok {
-			continue
+			return true
 		}
 
-		if !f(lineContext, edge) {
+		return f(lineContext, edge)
+	}
+
+	if s.stream != nil {
+		return s.stream.each(true, g)
+	}
+
+	for _, lineContext := range s.edges {
+		if !g(lineContext) {
 			return false
 		}
 	}
@@ -47,12 +80,20 @@ func (s *Stasher) Edges(f func(lineContext LineContext, edge reader.Edge) bool)
 
 // Vertex returns a vertex element by its identifier.
 func (s *Stasher) Vertex(id int) (LineContext, bool) {
+	if s.stream != nil {
+		return s.stream.get(id, false)
+	}
+
 	v, ok := s.vertices[id]
 	return v, ok
 }
 
 // Edge returns a edge element by its identifier.
 func (s *Stasher) Edge(id int) (LineContext, bool) {
+	if s.stream != nil {
+		return s.stream.get(id, true)
+	}
+
 	v, ok := s.edges[id]
 	return v, ok
 }
@@ -0,0 +1,76 @@
+package reader
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol/reader"
+)
+
+func TestStreamingStasher(t *testing.T) {
+	for _, filename := range []string{"dump1.lsif", "dump2.lsif", "dump3.lsif"} {
+		content, err := os.ReadFile("../testdata/" + filename)
+		if err != nil {
+			t.Fatalf("unexpected error reading testdata: %s", err)
+		}
+
+		expected := NewStasher()
+		if err := Read(bytes.NewReader(content), expected, nil, nil); err != nil {
+			t.Fatalf("unexpected error reading index: %s", err)
+		}
+		expectedVertices, expectedEdges := collectElements(expected)
+
+		for _, memoryBudget := range []int{0, 256, len(content)} {
+			stasher := NewStreamingStasher(bytes.NewReader(content), memoryBudget)
+			if err := Read(bytes.NewReader(content), stasher, nil, nil); err != nil {
+				t.Fatalf("unexpected error reading index: %s", err)
+			}
+
+			vertices, edges := collectElements(stasher)
+			if diff := cmp.Diff(expectedVertices, vertices); diff != "" {
+				t.Errorf("unexpected vertices for %s with budget %d (-want +got):\n%s", filename, memoryBudget, diff)
+			}
+			if diff := cmp.Diff(expectedEdges, edges); diff != "" {
+				t.Errorf("unexpected edges for %s with budget %d (-want +got):\n%s", filename, memoryBudget, diff)
+			}
+
+			for id, lineContext := range expectedVertices {
+				if v, ok := stasher.Vertex(id); !ok {
+					t.Errorf("expected vertex %d to exist in %s with budget %d", id, filename, memoryBudget)
+				} else if diff := cmp.Diff(lineContext, v); diff != "" {
+					t.Errorf("unexpected vertex %d for %s with budget %d (-want +got):\n%s", id, filename, memoryBudget, diff)
+				}
+
+				if _, ok := stasher.Edge(id); ok {
+					t.Errorf("expected vertex %d not to be returned as an edge", id)
+				}
+			}
+			for id, lineContext := range expectedEdges {
+				if e, ok := stasher.Edge(id); !ok {
+					t.Errorf("expected edge %d to exist in %s with budget %d", id, filename, memoryBudget)
+				} else if diff := cmp.Diff(lineContext, e); diff != "" {
+					t.Errorf("unexpected edge %d for %s with budget %d (-want +got):\n%s", id, filename, memoryBudget, diff)
+				}
+			}
+		}
+	}
+}
+
+func collectElements(stasher *Stasher) (map[int]LineContext, map[int]LineContext) {
+	vertices := map[int]LineContext{}
+	_ = stasher.Vertices(func(lineContext LineContext) bool {
+		vertices[lineContext.Element.ID] = lineContext
+		return true
+	})
+
+	edges := map[int]LineContext{}
+	_ = stasher.Edges(func(lineContext LineContext, edge reader.Edge) bool {
+		edges[lineContext.Element.ID] = lineContext
+		return true
+	})
+
+	return vertices, edges
+}
@@ -0,0 +1,180 @@
+package reader
+
+import (
+	"bufio"
+	"container/list"
+	"io"
+	"math"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol/reader"
+)
+
+// streamBufferSize is the size of the buffer used to sequentially read the source of a
+// streaming Stasher.
+const streamBufferSize = 1 << 20
+
+// stream indexes the location of each element within an LSIF index so that elements can
+// be re-read on demand instead of being held in memory.
+type stream struct {
+	source       io.ReaderAt
+	unmarshaller *reader.Unmarshaller
+	locations    map[int]location
+	order        []int
+	cache        *elementCache
+}
+
+// location describes the position of a single element's line within the source.
+type location struct {
+	offset int64
+	length int
+	index  int
+	edge   bool
+}
+
+func newStream(source io.ReaderAt, memoryBudget int) *stream {
+	return &stream{
+		source:       source,
+		unmarshaller: reader.NewUnmarshaller(),
+		locations:    map[int]location{},
+		cache:        newElementCache(memoryBudget),
+	}
+}
+
+// stash registers the location of the given element. This method fails if another element has
+// already been registered with the same identifier.
+func (s *stream) stash(lineContext LineContext, offset int64, length int, edge bool) *ValidationError {
+	id := lineContext.Element.ID
+	if other, ok := s.locations[id]; ok {
+		otherContext, _ := s.get(id, other.edge)
+		return NewValidationError("identifier already exists").AddContext(lineContext, otherContext)
+	}
+
+	s.locations[id] = location{offset: offset, length: length, index: lineContext.Index, edge: edge}
+	s.order = append(s.order, id)
+	s.cache.add(id, lineContext, length)
+	return nil
+}
+
+// get returns the vertex or edge element with the given identifier. Elements that are not
+// present in the cache are re-read from the source. This method returns false if the element
+// does not exist or cannot be re-read.
+func (s *stream) get(id int, edge bool) (LineContext, bool) {
+	loc, ok := s.locations[id]
+	if !ok || loc.edge != edge {
+		return LineContext{}, false
+	}
+
+	if lineContext, ok := s.cache.get(id); ok {
+		return lineContext, true
+	}
+
+	line := make([]byte, loc.length)
+	if n, err := s.source.ReadAt(line, loc.offset); n != loc.length || (err != nil && err != io.EOF) {
+		return LineContext{}, false
+	}
+
+	element, err := s.unmarshaller.Unmarshal(line)
+	if err != nil {
+		return LineContext{}, false
+	}
+
+	lineContext := LineContext{Index: loc.index, Element: element}
+	s.cache.add(id, lineContext, loc.length)
+	return lineContext, true
+}
+
+// each invokes the given function on each vertex (or edge) in the order in which they occur in
+// the source. The source is read sequentially; elements read this way are not added to the cache.
+// If any invocation returns false, iteration will not complete and false will be returned immediately.
+func (s *stream) each(edge bool, f func(lineContext LineContext) bool) bool {
+	r := bufio.NewReaderSize(io.NewSectionReader(s.source, 0, math.MaxInt64), streamBufferSize)
+
+	var position int64
+	for _, id := range s.order {
+		loc := s.locations[id]
+		if loc.edge != edge {
+			continue
+		}
+
+		lineContext, ok := s.cache.get(id)
+		if !ok {
+			if _, err := r.Discard(int(loc.offset - position)); err != nil {
+				return false
+			}
+
+			line := make([]byte, loc.length)
+			if _, err := io.ReadFull(r, line); err != nil {
+				return false
+			}
+			position = loc.offset + int64(loc.length)
+
+			element, err := s.unmarshaller.Unmarshal(line)
+			if err != nil {
+				return false
+			}
+
+			lineContext = LineContext{Index: loc.index, Element: element}
+		}
+
+		if !f(lineContext) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// elementCache is a least-recently-used cache of parsed elements bounded by the total encoded
+// size of its elements.
+type elementCache struct {
+	budget  int
+	size    int
+	entries map[int]*list.Element
+	lru     *list.List
+}
+
+type cacheEntry struct {
+	id          int
+	lineContext LineContext
+	size        int
+}
+
+func newElementCache(budget int) *elementCache {
+	return &elementCache{
+		budget:  budget,
+		entries: map[int]*list.Element{},
+		lru:     list.New(),
+	}
+}
+
+func (c *elementCache) get(id int) (LineContext, bool) {
+	e, ok := c.entries[id]
+	if !ok {
+		return LineContext{}, false
+	}
+
+	c.lru.MoveToFront(e)
+	return e.Value.(*cacheEntry).lineContext, true
+}
+
+func (c *elementCache) add(id int, lineContext LineContext, size int) {
+	if size > c.budget {
+		return
+	}
+
+	if e, ok := c.entries[id]; ok {
+		c.lru.MoveToFront(e)
+		return
+	}
+
+	c.entries[id] = c.lru.PushFront(&cacheEntry{id: id, lineContext: lineContext, size: size})
+	c.size += size
+
+	for c.size > c.budget {
+		e := c.lru.Back()
+		entry := e.Value.(*cacheEntry)
+		c.lru.Remove(e)
+		delete(c.entries, entry.id)
+		c.size -= entry.size
+	}
+}
@@ -8,7 +8,13 @@ import (
 )
 
 type Validator struct {
-	Context                    *ValidationContext
+	Context *ValidationContext
+
+	// RequireMonikerPackageInformation additionally ensures that each import and export
+	// moniker has attached package information. Not all indexers emit package information,
+	// so this check is opt-in.
+	RequireMonikerPackageInformation bool
+
 	raisedMissingMetadataError bool
 }
 
@@ -21,6 +27,10 @@ func (v *Validator) Validate(indexFile io.Reader) error {
 		for _, rv := range relationshipValidators {
 			rv(v.Context)
 		}
+
+		if v.RequireMonikerPackageInformation {
+			ensureMonikerPackageInformation(v.Context)
+		}
 	}
 
 	return nil
@@ -204,3 +204,38 @@ func ensureUnambiguousResultSets(ctx *ValidationContext) bool {
 
 	return valid
 }
+
+// ensureMonikerPackageInformation ensures that each import and export moniker has a packageInformation
+// edge. Without package information, such monikers cannot be used to resolve cross-repository definitions
+// and references.
+func ensureMonikerPackageInformation(ctx *ValidationContext) bool {
+	packageInformationSources := map[int]struct{}{}
+	_ = ctx.Stasher.Edges(func(lineContext reader.LineContext, edge protocolReader.Edge) bool {
+		if lineContext.Element.Label == "packageInformation" {
+			packageInformationSources[edge.OutV] = struct{}{}
+		}
+
+		return true
+	})
+
+	valid := true
+	_ = ctx.Stasher.Vertices(func(lineContext reader.LineContext) bool {
+		if lineContext.Element.Label != "moniker" {
+			return true
+		}
+
+		moniker, ok := lineContext.Element.Payload.(protocolReader.Moniker)
+		if !ok || (moniker.Kind != "import" && moniker.Kind != "export") {
+			return true
+		}
+
+		if _, ok := packageInformationSources[lineContext.Element.ID]; !ok {
+			valid = false
+			ctx.AddError("%s moniker %d has no package information", moniker.Kind, lineContext.Element.ID).AddContext(lineContext)
+		}
+
+		return true
+	})
+
+	return valid
+}
@@ -161,32 +161,27 @@ func diffQualifiedMonikers(builder *strings.Builder, old, new []semantic.Qualifi
 		}] = struct{}{}
 	}
 
+	// Monikers are emitted in sorted order so that the output is stable across runs
+	var removedMonikers, addedMonikers []string
 	for moniker := range oldSet {
 		if _, exists := newSet[moniker]; !exists {
-			removed(builder, fmt.Sprintf(
-				"%v%v:%v:%v@%v:%v",
-				prefix,
-				moniker.Kind,
-				moniker.Scheme,
-				moniker.Name,
-				moniker.Version,
-				moniker.Identifier,
-			))
+			removedMonikers = append(removedMonikers, qualifiedMonikerString(moniker.Kind, moniker.Scheme, moniker.Name, moniker.Version, moniker.Identifier))
 		}
 	}
 	for moniker := range newSet {
 		if _, exists := oldSet[moniker]; !exists {
-			added(builder, fmt.Sprintf(
-				"%v%v:%v:%v@%v:%v",
-				prefix,
-				moniker.Kind,
-				moniker.Scheme,
-				moniker.Name,
-				moniker.Version,
-				moniker.Identifier,
-			))
+			addedMonikers = append(addedMonikers, qualifiedMonikerString(moniker.Kind, moniker.Scheme, moniker.Name, moniker.Version, moniker.Identifier))
 		}
 	}
+	sort.Strings(removedMonikers)
+	sort.Strings(addedMonikers)
+
+	for _, moniker := range removedMonikers {
+		removed(builder, prefix+moniker)
+	}
+	for _, moniker := range addedMonikers {
+		added(builder, prefix+moniker)
+	}
 }
 
 func diffLocations(builder *strings.Builder, old, new []semantic.LocationData, prefix string) {
@@ -273,6 +268,10 @@ func locationString(location semantic.LocationData) string {
 	)
 }
 
+func qualifiedMonikerString(kind, scheme, name, version, identifier string) string {
+	return fmt.Sprintf("%v:%v:%v@%v:%v", kind, scheme, name, version, identifier)
+}
+
 func removed(builder *strings.Builder, value string) {
 	builder.WriteString(color.RedString(fmt.Sprintf("- %v\n", value)))
 }
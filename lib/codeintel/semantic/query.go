@@ -5,10 +5,11 @@ import (
 )
 
 type QueryResult struct {
-	Definitions []LocationData
-	References  []LocationData
-	Hover       string
-	Monikers    []QualifiedMonikerData
+	Definitions     []LocationData
+	References      []LocationData
+	Implementations []LocationData
+	Hover           string
+	Monikers        []QualifiedMonikerData
 }
 
 func Query(bundle *GroupedBundleDataMaps, path string, line, character int) ([]QueryResult, error) {
@@ -37,10 +38,11 @@ func Resolve(bundle *GroupedBundleDataMaps, document DocumentData, rng RangeData
 	}
 
 	return QueryResult{
-		Definitions: resolveLocations(bundle, rng.DefinitionResultID),
-		References:  resolveLocations(bundle, rng.ReferenceResultID),
-		Hover:       hover,
-		Monikers:    monikers,
+		Definitions:     resolveLocations(bundle, rng.DefinitionResultID),
+		References:      resolveLocations(bundle, rng.ReferenceResultID),
+		Implementations: resolveLocations(bundle, rng.ImplementationResultID),
+		Hover:           hover,
+		Monikers:        monikers,
 	}
 }
 
@@ -25,14 +25,31 @@ type DocumentData struct {
 // that was reachable via a result set has been collapsed into this object during
 // conversion.
 type RangeData struct {
-	StartLine          int  // 0-indexed, inclusive
-	StartCharacter     int  // 0-indexed, inclusive
-	EndLine            int  // 0-indexed, inclusive
-	EndCharacter       int  // 0-indexed, inclusive
-	DefinitionResultID ID   // possibly empty
-	ReferenceResultID  ID   // possibly empty
-	HoverResultID      ID   // possibly empty
-	MonikerIDs         []ID // possibly empty
+	StartLine               int         // 0-indexed, inclusive
+	StartCharacter          int         // 0-indexed, inclusive
+	EndLine                 int         // 0-indexed, inclusive
+	EndCharacter            int         // 0-indexed, inclusive
+	DefinitionResultID      ID          // possibly empty
+	ReferenceResultID       ID          // possibly empty
+	HoverResultID           ID          // possibly empty
+	ImplementationResultID  ID          // possibly empty
+	MonikerIDs              []ID        // possibly empty
+	DocumentationPathID     string      // possibly empty; the path ID of the documenting DocumentationNode
+	DocumentationPagePathID string      // possibly empty; the path ID of the page containing that node
+	Symbol                  *SymbolData // possibly nil; set for ranges tagged as definitions or declarations
+}
+
+// SymbolData describes the symbol defined or declared at a range, as reported by the
+// range's tag. The full range spans the entire symbol (e.g. a function including its
+// body) and is used to build the document outline and folding ranges.
+type SymbolData struct {
+	Name               string
+	Detail             string
+	Kind               protocol.SymbolKind
+	FullStartLine      int // 0-indexed, inclusive
+	FullStartCharacter int // 0-indexed, inclusive
+	FullEndLine        int // 0-indexed, inclusive
+	FullEndCharacter   int // 0-indexed, inclusive
 }
 
 // MonikerData represent a unique name (eventually) attached to a range.
@@ -95,6 +112,10 @@ type DocumentIDRangeID struct {
 
 	// The identifier of the range.
 	RangeID ID
+
+	// WriteAccess is true if the referenced symbol is written or assigned at
+	// this range. This is only reported by indexers that distinguish access kinds.
+	WriteAccess bool
 }
 
 // DocumentPathRangeID denotes a range qualified by its containing document.
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alecthomas/kingpin"
+)
+
+var app = kingpin.New(
+	"lsif-semantic-diff",
+	"lsif-semantic-diff compares the definitions, references, hovers, and monikers of two LSIF indexes of the same project.",
+).Version(version)
+
+var (
+	oldDumpPath string
+	newDumpPath string
+	exitCode    bool
+)
+
+func init() {
+	app.HelpFlag.Short('h')
+	app.VersionFlag.Short('v')
+	app.HelpFlag.Hidden()
+
+	app.Flag("exit-code", "Exit with a non-zero status if the indexes differ.").BoolVar(&exitCode)
+
+	app.Arg("old", "The baseline LSIF index. Must be located in the project's root directory.").Required().StringVar(&oldDumpPath)
+	app.Arg("new", "The LSIF index to compare against the baseline. Must be located in the project's root directory.").Required().StringVar(&newDumpPath)
+}
+
+func parseArgs(args []string) (err error) {
+	if _, err := app.Parse(args); err != nil {
+		return err
+	}
+
+	for _, path := range []string{oldDumpPath, newDumpPath} {
+		if !strings.HasSuffix(path, ".lsif") {
+			return fmt.Errorf("%s is not an LSIF index (expected .lsif extension)", path)
+		}
+	}
+
+	return nil
+}
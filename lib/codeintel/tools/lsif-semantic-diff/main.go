@@ -2,61 +2,60 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
-	"strings"
 
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/conversion"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/semantic/diff"
 )
 
+const version = "0.1.0"
+
 func main() {
-	if len(os.Args) != 3 {
-		usage()
+	if err := mainErr(); err != nil {
+		fmt.Fprintf(os.Stderr, "\nerror: %v\n", err)
+		os.Exit(1)
 	}
+}
 
-	dumpPath1 := os.Args[1]
-	dumpPath2 := os.Args[2]
-
-	if !strings.HasSuffix(dumpPath1, ".lsif") {
-		usage()
-	}
-	if !strings.HasSuffix(dumpPath2, ".lsif") {
-		usage()
+func mainErr() error {
+	if err := parseArgs(os.Args[1:]); err != nil {
+		return err
 	}
 
-	bundle1, err := conversion.CorrelateLocalGit(
-		context.Background(),
-		dumpPath1,
-		filepath.Dir(dumpPath1),
-	)
+	oldBundle, err := correlate(oldDumpPath)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "error: %v\n", err)
-		os.Exit(1)
+		return err
 	}
 
-	bundle2, err := conversion.CorrelateLocalGit(
-		context.Background(),
-		dumpPath2,
-		filepath.Dir(dumpPath2),
-	)
+	newBundle, err := correlate(newDumpPath)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "error: %v\n", err)
-		os.Exit(1)
+		return err
+	}
+
+	output := diff.Diff(oldBundle, newBundle)
+	fmt.Print(output)
+
+	if exitCode && output != "" {
+		return errors.New("indexes differ")
 	}
 
-	fmt.Print(diff.Diff(
-		semantic.GroupedBundleDataChansToMaps(bundle1),
-		semantic.GroupedBundleDataChansToMaps(bundle2),
-	))
+	return nil
 }
 
-func usage() {
-	fmt.Println(`
-usage: diff old.lsif new.lsif
+// correlate reads the LSIF index at the given path, which must be located in the project's root directory.
+func correlate(dumpPath string) (*semantic.GroupedBundleDataMaps, error) {
+	bundle, err := conversion.CorrelateLocalGit(
+		context.Background(),
+		dumpPath,
+		filepath.Dir(dumpPath),
+	)
+	if err != nil {
+		return nil, err
+	}
 
-lsif dumps must be in project's root directory`)
-	os.Exit(1)
+	return semantic.GroupedBundleDataChansToMaps(bundle), nil
 }
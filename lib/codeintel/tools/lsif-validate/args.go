@@ -12,7 +12,10 @@ var app = kingpin.New(
 ).Version(version)
 
 var (
-	indexFile *os.File
+	indexFile                 *os.File
+	format                    string
+	memoryBudget              int
+	requirePackageInformation bool
 )
 
 func init() {
@@ -20,6 +23,10 @@ func init() {
 	app.VersionFlag.Short('v')
 	app.HelpFlag.Hidden()
 
+	app.Flag("format", "The format of the error report: human-readable 'text' or machine-readable 'json'.").Default("text").EnumVar(&format, "text", "json")
+	app.Flag("memory-budget", "Stream the index from disk, caching at most this many megabytes of parsed elements. By default, the entire index is held in memory.").Default("0").IntVar(&memoryBudget)
+	app.Flag("require-package-information", "Ensure that each import and export moniker has attached package information.").Default("false").BoolVar(&requirePackageInformation)
+
 	app.Arg("index-file", "The LSIF index to validate.").Default("dump.lsif").FileVar(&indexFile)
 }
 
@@ -20,5 +20,5 @@ func mainErr() error {
 	}
 	defer indexFile.Close()
 
-	return validate(indexFile)
+	return validate(indexFile, format, memoryBudget, requirePackageInformation)
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -8,15 +9,31 @@ import (
 	"time"
 
 	"github.com/efritz/pentimento"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/reader"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/validation"
 )
 
 var updateInterval = time.Second / 4
 var ticker = pentimento.NewAnimatedString([]string{"⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏", "⠋", "⠙", "⠹"}, updateInterval)
 
-func validate(indexFile *os.File) error {
+func validate(indexFile *os.File, format string, memoryBudget int, requirePackageInformation bool) error {
 	ctx := validation.NewValidationContext()
-	validator := &validation.Validator{Context: ctx}
+	if memoryBudget > 0 {
+		ctx.Stasher = reader.NewStreamingStasher(indexFile, memoryBudget*1024*1024)
+	}
+	validator := &validation.Validator{
+		Context:                          ctx,
+		RequireMonikerPackageInformation: requirePackageInformation,
+	}
+
+	if format == "json" {
+		if err := validator.Validate(indexFile); err != nil {
+			return err
+		}
+
+		return printReport(ctx)
+	}
+
 	errs := make(chan error, 1)
 
 	go func() {
@@ -42,6 +59,59 @@ func validate(indexFile *os.File) error {
 	return nil
 }
 
+type report struct {
+	NumVertices uint64        `json:"numVertices"`
+	NumEdges    uint64        `json:"numEdges"`
+	Errors      []reportError `json:"errors"`
+}
+
+type reportError struct {
+	Message string       `json:"message"`
+	Lines   []reportLine `json:"lines"`
+}
+
+type reportLine struct {
+	Line  int    `json:"line"`
+	ID    int    `json:"id"`
+	Type  string `json:"type"`
+	Label string `json:"label"`
+}
+
+// printReport writes the errors of the given validation context to standard out as a
+// single JSON object.
+func printReport(ctx *validation.ValidationContext) error {
+	r := report{
+		NumVertices: ctx.NumVertices,
+		NumEdges:    ctx.NumEdges,
+		Errors:      make([]reportError, 0, len(ctx.Errors)),
+	}
+	for _, err := range ctx.Errors {
+		lines := make([]reportLine, 0, len(err.RelevantLines))
+		for _, lineContext := range err.RelevantLines {
+			lines = append(lines, reportLine{
+				Line:  lineContext.Index,
+				ID:    lineContext.Element.ID,
+				Type:  lineContext.Element.Type,
+				Label: lineContext.Element.Label,
+			})
+		}
+
+		r.Errors = append(r.Errors, reportError{Message: err.Message, Lines: lines})
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(r); err != nil {
+		return err
+	}
+
+	if len(ctx.Errors) > 0 {
+		return errors.New(fmt.Sprintf("Detected %d errors", len(ctx.Errors)))
+	}
+
+	return nil
+}
+
 func printProgress(ctx *validation.ValidationContext, validator *validation.Validator, errs <-chan error) error {
 	return pentimento.PrintProgress(func(printer *pentimento.Printer) error {
 		defer func() {
@@ -15,7 +15,11 @@ var (
 	indexFile     *os.File
 	fromID        int
 	subgraphDepth int
+	document      string
 	exclude       []string
+	output        string
+	memoryBudget  int
+	stats         bool
 )
 
 func init() {
@@ -24,8 +28,13 @@ func init() {
 	app.HelpFlag.Hidden()
 
 	app.Flag("from-id", "The edge/vertex ID to visualize a subgraph from. Must be used in combination with '-depth'.").Default("2").IntVar(&fromID)
+	app.Flag("document", "The URI (or URI suffix) of a document to visualize a subgraph from. Takes precedence over '-from-id'.").StringVar(&document)
 	app.Flag("depth", "Depth limit of the subgraph to be output").Default("-1").IntVar(&subgraphDepth)
 	app.Flag("exclude", "Vertices to exclude from the visualization").StringsVar(&exclude)
+	app.Flag("output", "The output format: a Graphviz 'dot' graph or a self-contained interactive 'html' page.").Default("dot").EnumVar(&output, "dot", "html")
+
+	app.Flag("stats", "Print summary statistics of the index instead of a graph.").BoolVar(&stats)
+	app.Flag("memory-budget", "Stream the index from disk, caching at most this many megabytes of parsed elements. By default, the entire index is held in memory.").Default("0").IntVar(&memoryBudget)
 
 	app.Arg("index-file", "The LSIF index to visualize.").Default("dump.lsif").FileVar(&indexFile)
 }
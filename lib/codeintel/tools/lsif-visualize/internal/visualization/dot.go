@@ -0,0 +1,74 @@
+package visualization
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	protocolReader "github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol/reader"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/reader"
+)
+
+var quoteRe = regexp.MustCompile(`(^|[^\\]?)(")`)
+
+// writeDot writes the given subgraph to w as a Graphviz digraph. Vertices contained by a document
+// are grouped into a cluster labeled with the document's URI.
+func (v *Visualizer) writeDot(w io.Writer, vertices map[int]struct{}, containingDocuments map[int]int, exclude []string) error {
+	fmt.Fprintf(w, "digraph G {\n")
+
+	// Vertex statements belonging to a document are buffered by document identifier so
+	// that they can be emitted together as a cluster.
+	clusters := map[int][]string{}
+
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	v.forEachVertex(vertices, exclude, func(lineContext reader.LineContext) {
+		var statement string
+		if lineContext.Element.Payload != nil {
+			if err := enc.Encode(lineContext.Element.Payload); err != nil {
+				fmt.Fprintln(w, ":bomb emoji:")
+				return
+			}
+			payloadStr := b.String()
+			payloadStr = quoteRe.ReplaceAllString(payloadStr, `$1\"`)
+			payloadStr = strings.ReplaceAll(payloadStr, "\\\\\"", "\\\"")
+			payloadStr = strings.TrimSpace(payloadStr)
+
+			statement = fmt.Sprintf("v%d [label=\"(%d) %s %s\"];", lineContext.Element.ID, lineContext.Element.ID, lineContext.Element.Label, payloadStr)
+			b.Reset()
+		} else {
+			statement = fmt.Sprintf("v%d [label=\"(%d) %s\"];", lineContext.Element.ID, lineContext.Element.ID, lineContext.Element.Label)
+		}
+
+		if documentID, ok := containingDocuments[lineContext.Element.ID]; ok {
+			clusters[documentID] = append(clusters[documentID], statement)
+		} else if lineContext.Element.Label == "document" {
+			clusters[lineContext.Element.ID] = append(clusters[lineContext.Element.ID], statement)
+		} else {
+			fmt.Fprintf(w, "\t%s\n", statement)
+		}
+	})
+
+	for documentID, statements := range clusters {
+		fmt.Fprintf(w, "\tsubgraph cluster_v%d {\n", documentID)
+		if vertex, ok := v.Context.Stasher.Vertex(documentID); ok {
+			if uri, ok := vertex.Element.Payload.(string); ok {
+				fmt.Fprintf(w, "\t\tlabel=%q;\n", uri)
+			}
+		}
+		for _, statement := range statements {
+			fmt.Fprintf(w, "\t\t%s\n", statement)
+		}
+		fmt.Fprintf(w, "\t}\n")
+	}
+
+	v.forEachEdge(vertices, exclude, func(lineContext reader.LineContext, edge protocolReader.Edge, inV int) {
+		fmt.Fprintf(w, "\tv%d -> v%d [label=\"(%d) %s\"];\n", edge.OutV, inV, lineContext.Element.ID, lineContext.Element.Label)
+	})
+
+	fmt.Fprintf(w, "}\n")
+	return nil
+}
@@ -0,0 +1,81 @@
+package visualization
+
+import (
+	_ "embed"
+	"encoding/json"
+	"html/template"
+	"io"
+
+	protocolReader "github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol/reader"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/reader"
+)
+
+//go:embed html.tmpl
+var htmlSource string
+
+var htmlTemplate = template.Must(template.New("html").Parse(htmlSource))
+
+type htmlGraph struct {
+	Nodes []htmlNode `json:"nodes"`
+	Links []htmlLink `json:"links"`
+}
+
+type htmlNode struct {
+	ID       int    `json:"id"`
+	Label    string `json:"label"`
+	Document int    `json:"document,omitempty"`
+	Payload  string `json:"payload,omitempty"`
+}
+
+type htmlLink struct {
+	ID     int    `json:"id"`
+	Label  string `json:"label"`
+	Source int    `json:"source"`
+	Target int    `json:"target"`
+}
+
+// writeHTML writes the given subgraph to w as a self-contained HTML page that renders the graph
+// with an interactive force-directed layout. Vertices contained by a document share a color.
+func (v *Visualizer) writeHTML(w io.Writer, vertices map[int]struct{}, containingDocuments map[int]int, exclude []string) error {
+	graph := htmlGraph{
+		Nodes: []htmlNode{},
+		Links: []htmlLink{},
+	}
+
+	var err error
+	v.forEachVertex(vertices, exclude, func(lineContext reader.LineContext) {
+		node := htmlNode{
+			ID:       lineContext.Element.ID,
+			Label:    lineContext.Element.Label,
+			Document: containingDocuments[lineContext.Element.ID],
+		}
+		if lineContext.Element.Label == "document" {
+			node.Document = lineContext.Element.ID
+		}
+
+		if lineContext.Element.Payload != nil {
+			payload, marshalErr := json.MarshalIndent(lineContext.Element.Payload, "", "  ")
+			if marshalErr != nil {
+				err = marshalErr
+				return
+			}
+			node.Payload = string(payload)
+		}
+
+		graph.Nodes = append(graph.Nodes, node)
+	})
+	if err != nil {
+		return err
+	}
+
+	v.forEachEdge(vertices, exclude, func(lineContext reader.LineContext, edge protocolReader.Edge, inV int) {
+		graph.Links = append(graph.Links, htmlLink{
+			ID:     lineContext.Element.ID,
+			Label:  lineContext.Element.Label,
+			Source: edge.OutV,
+			Target: inV,
+		})
+	})
+
+	return htmlTemplate.Execute(w, graph)
+}
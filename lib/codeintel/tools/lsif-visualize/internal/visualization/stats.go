@@ -0,0 +1,118 @@
+package visualization
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	protocolReader "github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol/reader"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/reader"
+)
+
+// Stats reads the given LSIF index and writes a summary of its contents to standard out: the
+// number of vertices and edges by label, the number of ranges per document, the distribution of
+// hover text sizes, and the number of monikers by scheme.
+func (v *Visualizer) Stats(indexFile io.Reader) error {
+	if err := reader.Read(indexFile, v.Context.Stasher, nil, nil); err != nil {
+		return err
+	}
+
+	vertexCounts := map[string]int{}
+	monikerCounts := map[string]int{}
+	var hoverSizes []int
+	_ = v.Context.Stasher.Vertices(func(lineContext reader.LineContext) bool {
+		vertexCounts[lineContext.Element.Label]++
+
+		switch payload := lineContext.Element.Payload.(type) {
+		case string:
+			if lineContext.Element.Label == "hoverResult" {
+				hoverSizes = append(hoverSizes, len(payload))
+			}
+		case protocolReader.Moniker:
+			monikerCounts[payload.Scheme]++
+		}
+
+		return true
+	})
+
+	edgeCounts := map[string]int{}
+	_ = v.Context.Stasher.Edges(func(lineContext reader.LineContext, edge protocolReader.Edge) bool {
+		edgeCounts[lineContext.Element.Label]++
+		return true
+	})
+
+	numContainedRanges := 0
+	for id := range buildContainingDocuments(v.Context.Stasher) {
+		if vertex, ok := v.Context.Stasher.Vertex(id); ok && vertex.Element.Label == "range" {
+			numContainedRanges++
+		}
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+
+	fmt.Fprintf(w, "Vertices\t%d\n", sum(vertexCounts))
+	writeCounts(w, vertexCounts)
+	fmt.Fprintf(w, "\nEdges\t%d\n", sum(edgeCounts))
+	writeCounts(w, edgeCounts)
+
+	numDocuments := vertexCounts["document"]
+	fmt.Fprintf(w, "\nDocuments\t%d\n", numDocuments)
+	if numDocuments > 0 {
+		fmt.Fprintf(w, "  average ranges per document\t%.2f\n", float64(numContainedRanges)/float64(numDocuments))
+	}
+
+	fmt.Fprintf(w, "\nHover results\t%d\n", len(hoverSizes))
+	if len(hoverSizes) > 0 {
+		sort.Ints(hoverSizes)
+		fmt.Fprintf(w, "  total bytes\t%d\n", sumInts(hoverSizes))
+		fmt.Fprintf(w, "  min bytes\t%d\n", hoverSizes[0])
+		for _, p := range []int{50, 90, 99} {
+			fmt.Fprintf(w, "  p%d bytes\t%d\n", p, percentile(hoverSizes, p))
+		}
+		fmt.Fprintf(w, "  max bytes\t%d\n", hoverSizes[len(hoverSizes)-1])
+	}
+
+	fmt.Fprintf(w, "\nMonikers\t%d\n", sum(monikerCounts))
+	writeCounts(w, monikerCounts)
+
+	return w.Flush()
+}
+
+// writeCounts writes one indented line per key of the given map, ordered by descending count.
+func writeCounts(w io.Writer, counts map[string]int) {
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+
+	for _, key := range keys {
+		fmt.Fprintf(w, "  %s\t%d\n", key, counts[key])
+	}
+}
+
+// percentile returns the value at the given percentile of the given sorted, non-empty slice.
+func percentile(sorted []int, p int) int {
+	return sorted[(len(sorted)-1)*p/100]
+}
+
+func sum(counts map[string]int) (total int) {
+	for _, count := range counts {
+		total += count
+	}
+	return total
+}
+
+func sumInts(values []int) (total int) {
+	for _, value := range values {
+		total += value
+	}
+	return total
+}
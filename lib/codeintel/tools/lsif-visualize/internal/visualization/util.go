@@ -26,16 +26,15 @@ func forEachInV(edge protocolReader.Edge, f func(inV int) bool) bool {
 }
 
 // buildForwardGraph returns a map from OutV to InV/InVs properties across all edges of the graph.
+// Contains edges originating from a document are skipped: following them from any range would pull
+// every other range of that document into the subgraph. These relationships are instead rendered as
+// a cluster per document (see buildContainingDocuments).
 func buildForwardGraph(stasher *reader.Stasher) map[int][]int {
 	edges := map[int][]int{}
 	_ = stasher.Edges(func(lineContext reader.LineContext, edge protocolReader.Edge) bool {
-		// Note: skip contains relationships because it ruins the visualizer
-		// We need to replace this with a smarter graph output that won't go up/down
-		// contains relationships: if we have a range, we have ALL ranges in that
-		// document due to this relationship.
-		// if lineContext.Element.Label == "contains" {
-		// 	return true
-		// }
+		if isDocumentContainsEdge(stasher, lineContext, edge) {
+			return true
+		}
 
 		return forEachInV(edge, func(inV int) bool {
 			edges[edge.OutV] = append(edges[edge.OutV], inV)
@@ -46,6 +45,34 @@ func buildForwardGraph(stasher *reader.Stasher) map[int][]int {
 	return edges
 }
 
+// buildContainingDocuments returns a map from the identifier of each vertex contained by a document
+// to the identifier of that document.
+func buildContainingDocuments(stasher *reader.Stasher) map[int]int {
+	documents := map[int]int{}
+	_ = stasher.Edges(func(lineContext reader.LineContext, edge protocolReader.Edge) bool {
+		if !isDocumentContainsEdge(stasher, lineContext, edge) {
+			return true
+		}
+
+		return forEachInV(edge, func(inV int) bool {
+			documents[inV] = edge.OutV
+			return true
+		})
+	})
+
+	return documents
+}
+
+// isDocumentContainsEdge returns true if the given edge is a contains edge whose source is a document.
+func isDocumentContainsEdge(stasher *reader.Stasher, lineContext reader.LineContext, edge protocolReader.Edge) bool {
+	if lineContext.Element.Label != "contains" {
+		return false
+	}
+
+	vertex, ok := stasher.Vertex(edge.OutV)
+	return ok && vertex.Element.Label == "document"
+}
+
 func invertEdges(m map[int][]int) map[int][]int {
 	inverted := map[int][]int{}
 	for k, vs := range m {
@@ -1,69 +1,86 @@
 package visualization
 
 import (
-	"bytes"
-	"encoding/json"
 	"fmt"
 	"io"
-	"regexp"
+	"os"
 	"strings"
 
 	protocolReader "github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol/reader"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/reader"
 )
 
-var quoteRe = regexp.MustCompile(`(^|[^\\]?)(")`)
-
 type Visualizer struct {
 	Context *VisualizationContext
 }
 
-func (v *Visualizer) Visualize(indexFile io.Reader, fromID, subgraphDepth int, exclude []string) error {
+// Visualize reads the given LSIF index and writes the subgraph selected by the given parameters to
+// standard out. The output format may be either "dot" (Graphviz) or "html".
+func (v *Visualizer) Visualize(indexFile io.Reader, fromID, subgraphDepth int, document string, exclude []string, output string) error {
 	if err := reader.Read(indexFile, v.Context.Stasher, nil, nil); err != nil {
 		return err
 	}
 
+	containingDocuments := buildContainingDocuments(v.Context.Stasher)
+
+	fromIDs := []int{fromID}
+	if document != "" {
+		documentIDs := findDocuments(v.Context.Stasher, document)
+		if len(documentIDs) == 0 {
+			return fmt.Errorf("no document matching %q", document)
+		}
+
+		// Contains edges are not traversed, so seed the search with the ranges of the
+		// matching documents as well as the documents themselves.
+		fromIDs = documentIDs
+		for rangeID, documentID := range containingDocuments {
+			if containsID(documentID, documentIDs) {
+				fromIDs = append(fromIDs, rangeID)
+			}
+		}
+	}
+
 	forwardEdges := buildForwardGraph(v.Context.Stasher)
 	backwardEdges := invertEdges(forwardEdges)
-	vertices := map[int]struct{}{}
-	getReachableVerticesAtDepth(fromID, forwardEdges, backwardEdges, subgraphDepth, vertices)
+	vertices := getReachableVerticesAtDepth(fromIDs, forwardEdges, backwardEdges, subgraphDepth)
 
-	fmt.Printf("digraph G {\n")
+	switch output {
+	case "html":
+		return v.writeHTML(os.Stdout, vertices, containingDocuments, exclude)
+	default:
+		return v.writeDot(os.Stdout, vertices, containingDocuments, exclude)
+	}
+}
 
-	var b bytes.Buffer
-	enc := json.NewEncoder(&b)
+// forEachVertex invokes the given function on each vertex in the given set that does not have
+// an excluded label.
+func (v *Visualizer) forEachVertex(vertices map[int]struct{}, exclude []string, f func(lineContext reader.LineContext)) {
 	_ = v.Context.Stasher.Vertices(func(lineContext reader.LineContext) bool {
 		if _, ok := vertices[lineContext.Element.ID]; !ok {
 			return true
 		}
 
-		if contains(lineContext.Element.Label, exclude) {
-			return true
-		}
-
-		if lineContext.Element.Payload != nil {
-			if err := enc.Encode(lineContext.Element.Payload); err != nil {
-				fmt.Println(":bomb emoji:")
-				return true
-			}
-			payloadStr := b.String()
-			payloadStr = quoteRe.ReplaceAllString(payloadStr, `$1\"`)
-			payloadStr = strings.ReplaceAll(payloadStr, "\\\\\"", "\\\"")
-			payloadStr = strings.TrimSpace(payloadStr)
-
-			fmt.Printf("\tv%d [label=\"(%d) %s %s\"];\n", lineContext.Element.ID, lineContext.Element.ID, lineContext.Element.Label, payloadStr)
-			b.Reset()
-		} else {
-			fmt.Printf("\tv%d [label=\"(%d) %s\"];\n", lineContext.Element.ID, lineContext.Element.ID, lineContext.Element.Label)
+		if !contains(lineContext.Element.Label, exclude) {
+			f(lineContext)
 		}
 		return true
 	})
+}
 
+// forEachEdge invokes the given function on each (edge, inV) pair such that both endpoints are in
+// the given set and neither endpoint has an excluded label. Contains edges originating from a document
+// are skipped.
+func (v *Visualizer) forEachEdge(vertices map[int]struct{}, exclude []string, f func(lineContext reader.LineContext, edge protocolReader.Edge, inV int)) {
 	_ = v.Context.Stasher.Edges(func(lineContext reader.LineContext, edge protocolReader.Edge) bool {
 		if _, ok := vertices[edge.OutV]; !ok {
 			return true
 		}
 
+		// Rendered as clusters
+		if isDocumentContainsEdge(v.Context.Stasher, lineContext, edge) {
+			return true
+		}
+
 		vertex, _ := v.Context.Stasher.Vertex(edge.OutV)
 		if contains(vertex.Element.Label, exclude) {
 			return true
@@ -75,30 +92,75 @@ func (v *Visualizer) Visualize(indexFile io.Reader, fromID, subgraphDepth int, e
 				if contains(vertex.Element.Label, exclude) {
 					return true
 				}
-				fmt.Printf("\tv%d -> v%d [label=\"(%d) %s\"];\n", edge.OutV, inV, lineContext.Element.ID, lineContext.Element.Label)
+				f(lineContext, edge, inV)
 			}
 
 			return true
 		})
 	})
-
-	fmt.Printf("}\n")
-	return nil
 }
 
-func getReachableVerticesAtDepth(from int, forwardEdges, backwardEdges map[int][]int, depth int, vertices map[int]struct{}) {
-	if _, ok := vertices[from]; ok || depth == 0 {
-		return
+// getReachableVerticesAtDepth performs a breadth-first search from the given vertices, following
+// edges in both directions. A vertex is included if its distance from the nearest source vertex
+// is less than depth. A negative depth does not limit the search.
+func getReachableVerticesAtDepth(from []int, forwardEdges, backwardEdges map[int][]int, depth int) map[int]struct{} {
+	vertices := map[int]struct{}{}
+	if depth == 0 {
+		return vertices
+	}
+
+	frontier := make([]int, 0, len(from))
+	for _, id := range from {
+		if _, ok := vertices[id]; !ok {
+			vertices[id] = struct{}{}
+			frontier = append(frontier, id)
+		}
 	}
 
-	vertices[from] = struct{}{}
+	for level := 1; len(frontier) > 0 && (depth < 0 || level < depth); level++ {
+		var next []int
+		for _, id := range frontier {
+			for _, neighbors := range [][]int{forwardEdges[id], backwardEdges[id]} {
+				for _, neighbor := range neighbors {
+					if _, ok := vertices[neighbor]; !ok {
+						vertices[neighbor] = struct{}{}
+						next = append(next, neighbor)
+					}
+				}
+			}
+		}
 
-	for _, v := range forwardEdges[from] {
-		getReachableVerticesAtDepth(v, forwardEdges, backwardEdges, depth-1, vertices)
+		frontier = next
 	}
-	for _, v := range backwardEdges[from] {
-		getReachableVerticesAtDepth(v, forwardEdges, backwardEdges, depth-1, vertices)
+
+	return vertices
+}
+
+// findDocuments returns the identifiers of all document vertices whose URI is equal to or ends
+// with the given path.
+func findDocuments(stasher *reader.Stasher, path string) []int {
+	var ids []int
+	_ = stasher.Vertices(func(lineContext reader.LineContext) bool {
+		if lineContext.Element.Label != "document" {
+			return true
+		}
+
+		if uri, ok := lineContext.Element.Payload.(string); ok && (uri == path || strings.HasSuffix(uri, "/"+path)) {
+			ids = append(ids, lineContext.Element.ID)
+		}
+		return true
+	})
+
+	return ids
+}
+
+func containsID(id int, ids []int) bool {
+	for _, other := range ids {
+		if other == id {
+			return true
+		}
 	}
+	return false
 }
 
 func contains(s string, ss []string) bool {
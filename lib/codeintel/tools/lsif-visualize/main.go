@@ -20,5 +20,9 @@ func mainErr() error {
 	}
 	defer indexFile.Close()
 
-	return visualize(indexFile, fromID, subgraphDepth, exclude)
+	if stats {
+		return printStats(indexFile, memoryBudget)
+	}
+
+	return visualize(indexFile, fromID, subgraphDepth, document, exclude, output, memoryBudget)
 }
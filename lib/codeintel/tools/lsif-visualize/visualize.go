@@ -3,11 +3,25 @@ package main
 import (
 	"os"
 
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/reader"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/tools/lsif-visualize/internal/visualization"
 )
 
-func visualize(indexFile *os.File, fromID, subgraphDepth int, exclude []string) error {
+func visualize(indexFile *os.File, fromID, subgraphDepth int, document string, exclude []string, output string, memoryBudget int) error {
+	visualizer := &visualization.Visualizer{Context: newVisualizationContext(indexFile, memoryBudget)}
+	return visualizer.Visualize(indexFile, fromID, subgraphDepth, document, exclude, output)
+}
+
+func printStats(indexFile *os.File, memoryBudget int) error {
+	visualizer := &visualization.Visualizer{Context: newVisualizationContext(indexFile, memoryBudget)}
+	return visualizer.Stats(indexFile)
+}
+
+func newVisualizationContext(indexFile *os.File, memoryBudget int) *visualization.VisualizationContext {
 	ctx := visualization.NewVisualizationContext()
-	visualizer := &visualization.Visualizer{Context: ctx}
-	return visualizer.Visualize(indexFile, fromID, subgraphDepth, exclude)
+	if memoryBudget > 0 {
+		ctx.Stasher = reader.NewStreamingStasher(indexFile, memoryBudget*1024*1024)
+	}
+
+	return ctx
 }
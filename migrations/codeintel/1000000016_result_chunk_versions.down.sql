@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP TABLE lsif_data_result_chunks_schema_versions;
+DROP TRIGGER lsif_data_result_chunks_schema_versions_insert ON lsif_data_result_chunks;
+DROP FUNCTION update_lsif_data_result_chunks_schema_versions_insert;
+
+ALTER TABLE lsif_data_result_chunks DROP COLUMN IF EXISTS schema_version;
+
+COMMIT;
@@ -0,0 +1,65 @@
+BEGIN;
+
+-- faster to supply default than manual update
+ALTER TABLE lsif_data_result_chunks ADD COLUMN schema_version int DEFAULT 1 NOT NULL;
+
+-- drop default after all existing columns have been set
+ALTER TABLE lsif_data_result_chunks ALTER COLUMN schema_version DROP DEFAULT;
+
+COMMENT ON COLUMN lsif_data_result_chunks.schema_version IS 'The schema version of this row - used to determine presence and encoding of data.';
+
+CREATE TABLE lsif_data_result_chunks_schema_versions (
+    dump_id integer NOT NULL,
+    min_schema_version integer,
+    max_schema_version integer
+);
+ALTER TABLE lsif_data_result_chunks_schema_versions ADD PRIMARY KEY (dump_id);
+CREATE INDEX IF NOT EXISTS lsif_data_result_chunks_schema_versions_dump_id_schema_version_bounds ON lsif_data_result_chunks_schema_versions (dump_id, min_schema_version, max_schema_version);
+
+COMMENT ON TABLE lsif_data_result_chunks_schema_versions IS 'Tracks the range of schema_versions for each upload in the lsif_data_result_chunks table.';
+COMMENT ON COLUMN lsif_data_result_chunks_schema_versions.dump_id IS 'The identifier of the associated dump in the lsif_uploads table.';
+COMMENT ON COLUMN lsif_data_result_chunks_schema_versions.min_schema_version IS 'A lower-bound on the `lsif_data_result_chunks.schema_version` where `lsif_data_result_chunks.dump_id = dump_id`.';
+COMMENT ON COLUMN lsif_data_result_chunks_schema_versions.max_schema_version IS 'An upper-bound on the `lsif_data_result_chunks.schema_version` where `lsif_data_result_chunks.dump_id = dump_id`.';
+
+-- Ensure that there is a lsif_data_result_chunks_schema_versions record for each distinct dump_id
+-- in the lsif_data_result_chunks table. All result chunks in the database at this point necessarily
+-- have a schema_version of 1, so we can gather all of the dump ids quickly by scanning the metadata
+-- table. Grouping the result chunks table directly would take too much time in a migration.
+
+INSERT INTO lsif_data_result_chunks_schema_versions
+    SELECT
+        dump_id,
+        1 AS min_schema_version,
+        1 AS max_schema_version
+    FROM
+        lsif_data_metadata
+    ORDER BY
+        lsif_data_metadata;
+
+-- See the comment on update_lsif_data_definitions_schema_versions_insert for the rationale behind
+-- this trigger.
+
+CREATE OR REPLACE FUNCTION update_lsif_data_result_chunks_schema_versions_insert() RETURNS trigger AS $$ BEGIN
+    INSERT INTO
+        lsif_data_result_chunks_schema_versions
+    SELECT
+        dump_id,
+        MIN(schema_version) as min_schema_version,
+        MAX(schema_version) as max_schema_version
+    FROM
+        newtab
+    GROUP BY
+        dump_id
+    ON CONFLICT (dump_id) DO UPDATE SET
+        -- Update with min(old_min, new_min) and max(old_max, new_max)
+        min_schema_version = LEAST(lsif_data_result_chunks_schema_versions.min_schema_version, EXCLUDED.min_schema_version),
+        max_schema_version = GREATEST(lsif_data_result_chunks_schema_versions.max_schema_version, EXCLUDED.max_schema_version);
+
+    RETURN NULL;
+END $$ LANGUAGE plpgsql;
+
+CREATE TRIGGER lsif_data_result_chunks_schema_versions_insert
+AFTER INSERT ON lsif_data_result_chunks REFERENCING NEW TABLE AS newtab
+FOR EACH STATEMENT EXECUTE PROCEDURE update_lsif_data_result_chunks_schema_versions_insert();
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP INDEX IF EXISTS lsif_data_result_chunks_dump_id_schema_version;
+
+COMMIT;
@@ -0,0 +1 @@
+CREATE INDEX CONCURRENTLY IF NOT EXISTS lsif_data_result_chunks_dump_id_schema_version ON lsif_data_result_chunks (dump_id, schema_version);
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE lsif_uploads DROP COLUMN IF EXISTS protected;
+DROP TABLE IF EXISTS lsif_retention_policies;
+
+COMMIT;
@@ -0,0 +1,24 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS lsif_retention_policies (
+    id serial PRIMARY KEY,
+    name text NOT NULL,
+    repository_pattern text NOT NULL,
+    type text NOT NULL,
+    pattern text NOT NULL,
+    retention_duration_hours integer,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT lsif_retention_policies_type_valid CHECK (type IN ('GIT_BRANCH', 'GIT_TAG'))
+);
+
+COMMENT ON TABLE lsif_retention_policies IS 'Stores the policies that determine which code intelligence uploads are protected from expiration.';
+COMMENT ON COLUMN lsif_retention_policies.repository_pattern IS 'A glob pattern matched against repository names. Only uploads of matching repositories are governed by the policy.';
+COMMENT ON COLUMN lsif_retention_policies.type IS 'The type of git ref matched by the policy (GIT_BRANCH or GIT_TAG).';
+COMMENT ON COLUMN lsif_retention_policies.pattern IS 'A glob pattern matched against branch or tag names.';
+COMMENT ON COLUMN lsif_retention_policies.retention_duration_hours IS 'The maximum age (in hours) of an upload protected by this policy. A null value protects matching uploads indefinitely.';
+
+ALTER TABLE lsif_uploads ADD COLUMN IF NOT EXISTS protected boolean DEFAULT false NOT NULL;
+
+COMMENT ON COLUMN lsif_uploads.protected IS 'Whether or not the upload is protected from expiration by a retention policy.';
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE lsif_index_configuration DROP COLUMN IF EXISTS inferred;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE lsif_index_configuration ADD COLUMN inferred boolean DEFAULT false NOT NULL;
+
+COMMENT ON COLUMN lsif_index_configuration.inferred IS 'Whether or not the configuration was inferred from the repository structure by the auto-indexer rather than supplied by a user.';
+
+COMMIT;
@@ -0,0 +1,19 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS lsif_usage_statistics (
+    repository_id integer NOT NULL,
+    date date NOT NULL,
+    action text NOT NULL,
+    precise_count integer DEFAULT 0 NOT NULL,
+    search_count integer DEFAULT 0 NOT NULL,
+    PRIMARY KEY (repository_id, date, action),
+    CONSTRAINT lsif_usage_statistics_action_valid CHECK (action IN ('definitions', 'hover', 'references'))
+);
+
+COMMENT ON TABLE lsif_usage_statistics IS 'Stores daily per-repository counts of precise and search-based code intelligence results served. Rows are aggregated from event_logs and outlive the retention of the source events.';
+COMMENT ON COLUMN lsif_usage_statistics.date IS 'The (UTC) day on which the code intelligence results were served.';
+COMMENT ON COLUMN lsif_usage_statistics.action IS 'The type of code intelligence request (definitions, hover, or references).';
+COMMENT ON COLUMN lsif_usage_statistics.precise_count IS 'The number of requests answered with precise code intelligence.';
+COMMENT ON COLUMN lsif_usage_statistics.search_count IS 'The number of requests answered with search-based code intelligence.';
+
+COMMIT;
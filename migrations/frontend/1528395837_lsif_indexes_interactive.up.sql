@@ -0,0 +1,33 @@
+BEGIN;
+
+ALTER TABLE lsif_indexes ADD COLUMN interactive boolean DEFAULT false NOT NULL;
+
+COMMENT ON COLUMN lsif_indexes.interactive IS 'Whether or not the index job was explicitly requested by a user rather than scheduled by the auto-indexer. Interactive index jobs and their uploads are processed ahead of other queued records.';
+
+CREATE OR REPLACE VIEW lsif_indexes_with_repository_name AS
+ SELECT u.id,
+    u.commit,
+    u.queued_at,
+    u.state,
+    u.failure_message,
+    u.started_at,
+    u.finished_at,
+    u.repository_id,
+    u.process_after,
+    u.num_resets,
+    u.num_failures,
+    u.docker_steps,
+    u.root,
+    u.indexer,
+    u.indexer_args,
+    u.outfile,
+    u.log_contents,
+    u.execution_logs,
+    u.local_steps,
+    r.name AS repository_name,
+    u.interactive
+   FROM (lsif_indexes u
+     JOIN repo r ON ((r.id = u.repository_id)))
+  WHERE (r.deleted_at IS NULL);
+
+COMMIT;
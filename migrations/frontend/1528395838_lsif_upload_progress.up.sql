@@ -0,0 +1,16 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS lsif_upload_progress (
+    upload_id integer PRIMARY KEY,
+    phase text NOT NULL,
+    bytes_read bigint DEFAULT 0 NOT NULL,
+    documents_written integer DEFAULT 0 NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+COMMENT ON TABLE lsif_upload_progress IS 'Stores the progress of uploads being processed by the precise-code-intel-worker. Rows are written outside of the transaction holding the lock on the upload record, so the upload_id column deliberately has no foreign key to lsif_uploads.';
+COMMENT ON COLUMN lsif_upload_progress.phase IS 'The processing phase of the upload (correlating, writing, or finalizing).';
+COMMENT ON COLUMN lsif_upload_progress.bytes_read IS 'The number of bytes of the compressed upload payload read so far. This can be compared against lsif_uploads.upload_size.';
+COMMENT ON COLUMN lsif_upload_progress.documents_written IS 'The number of documents written to the codeintel database so far.';
+
+COMMIT;
@@ -0,0 +1,23 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS saved_search_matches (
+    query_hash text NOT NULL,
+    match_key text NOT NULL,
+    repository text NOT NULL,
+    commit text NOT NULL,
+    path text NOT NULL,
+    line_number integer NOT NULL,
+    preview text NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    PRIMARY KEY (query_hash, match_key)
+);
+
+COMMENT ON TABLE saved_search_matches IS 'Stores the matches found by the latest run of a saved search that had results. The query-runner diffs the matches of each run against these to notify subscribers of the new matches only.';
+COMMENT ON COLUMN saved_search_matches.query_hash IS 'The hex-encoded SHA-256 hash of the saved search query.';
+COMMENT ON COLUMN saved_search_matches.match_key IS 'The hex-encoded SHA-256 hash of the remaining match columns, which identifies the match across runs.';
+COMMENT ON COLUMN saved_search_matches.commit IS 'The commit of the match. Empty for matches of file results.';
+COMMENT ON COLUMN saved_search_matches.path IS 'The path of the matched file. Empty for matches of commit messages.';
+COMMENT ON COLUMN saved_search_matches.line_number IS 'The 1-based line number of the match in the file at the commit, or in the commit message.';
+COMMENT ON COLUMN saved_search_matches.preview IS 'The content of the matched line.';
+
+COMMIT;
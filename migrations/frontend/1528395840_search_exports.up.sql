@@ -0,0 +1,34 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS search_exports (
+    id bigserial PRIMARY KEY,
+    user_id integer NOT NULL REFERENCES users(id) ON DELETE CASCADE DEFERRABLE,
+    query text NOT NULL,
+    pattern_type text NOT NULL,
+    format text NOT NULL,
+    download_secret text NOT NULL,
+    state text DEFAULT 'queued' NOT NULL,
+    failure_message text,
+    started_at timestamp with time zone,
+    finished_at timestamp with time zone,
+    process_after timestamp with time zone,
+    num_resets integer DEFAULT 0 NOT NULL,
+    num_failures integer DEFAULT 0 NOT NULL,
+    execution_logs json[],
+    result_count integer DEFAULT 0 NOT NULL,
+    object_key text,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS search_exports_user_id_created_at ON search_exports(user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS search_exports_state ON search_exports(state);
+
+COMMENT ON TABLE search_exports IS 'Stores the jobs exporting all results of a search query to a file in the blob store.';
+COMMENT ON COLUMN search_exports.user_id IS 'The user that created the export. The query is run with the permissions of this user.';
+COMMENT ON COLUMN search_exports.pattern_type IS 'The pattern type of the query, unless specified in the query itself.';
+COMMENT ON COLUMN search_exports.format IS 'The format of the exported file (csv or jsonl).';
+COMMENT ON COLUMN search_exports.download_secret IS 'The key signing the download links of the export.';
+COMMENT ON COLUMN search_exports.result_count IS 'The number of rows written to the exported file.';
+COMMENT ON COLUMN search_exports.object_key IS 'The key of the exported file in the blob store. Set once the export completes.';
+
+COMMIT;
@@ -0,0 +1,18 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS repo_demand (
+    repo_id integer NOT NULL REFERENCES repo(id) ON DELETE CASCADE DEFERRABLE,
+    kind text NOT NULL,
+    score double precision DEFAULT 0 NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    PRIMARY KEY (repo_id, kind)
+);
+
+CREATE INDEX IF NOT EXISTS repo_demand_updated_at ON repo_demand(updated_at);
+
+COMMENT ON TABLE repo_demand IS 'Tracks how much repositories are used, so that the repo-updater fetches actively used repositories more often.';
+COMMENT ON COLUMN repo_demand.kind IS 'The kind of usage: search, codeintel or navigation.';
+COMMENT ON COLUMN repo_demand.score IS 'The number of recorded uses, decayed exponentially as of updated_at.';
+COMMENT ON COLUMN repo_demand.updated_at IS 'When the score was last updated.';
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS gitserver_repo_integrity_failures;
+
+COMMIT;
@@ -0,0 +1,25 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS gitserver_repo_integrity_failures (
+    id bigserial PRIMARY KEY,
+    repo_id integer NOT NULL REFERENCES repo(id) ON DELETE CASCADE DEFERRABLE,
+    shard_id text NOT NULL,
+    check_name text NOT NULL,
+    output text NOT NULL,
+    repair text NOT NULL,
+    repair_error text,
+    repaired_at timestamp with time zone,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS gitserver_repo_integrity_failures_repo_id_created_at ON gitserver_repo_integrity_failures(repo_id, created_at DESC);
+
+COMMENT ON TABLE gitserver_repo_integrity_failures IS 'Records the corruption that the integrity checks of gitserver found in repository clones, and how it was repaired.';
+COMMENT ON COLUMN gitserver_repo_integrity_failures.shard_id IS 'The gitserver that found the corruption.';
+COMMENT ON COLUMN gitserver_repo_integrity_failures.check_name IS 'The check that failed: fsck or last-fetch.';
+COMMENT ON COLUMN gitserver_repo_integrity_failures.output IS 'The output of the failed check.';
+COMMENT ON COLUMN gitserver_repo_integrity_failures.repair IS 'The repair attempted: reclone, refetch, or none if automatic updates are disabled.';
+COMMENT ON COLUMN gitserver_repo_integrity_failures.repair_error IS 'The error of the repair, if it failed.';
+COMMENT ON COLUMN gitserver_repo_integrity_failures.repaired_at IS 'When the repair finished, whether or not it succeeded.';
+
+COMMIT;
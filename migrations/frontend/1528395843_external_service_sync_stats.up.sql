@@ -0,0 +1,32 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS external_service_sync_stats (
+    external_service_id bigint PRIMARY KEY REFERENCES external_services(id) ON DELETE CASCADE DEFERRABLE,
+    started_at timestamp with time zone NOT NULL,
+    finished_at timestamp with time zone NOT NULL,
+    last_success_at timestamp with time zone,
+    repos_sourced integer DEFAULT 0 NOT NULL,
+    repos_added integer DEFAULT 0 NOT NULL,
+    repos_modified integer DEFAULT 0 NOT NULL,
+    repos_removed integer DEFAULT 0 NOT NULL,
+    error text,
+    error_class text,
+    consecutive_failures integer DEFAULT 0 NOT NULL,
+    error_counts jsonb DEFAULT '{}'::jsonb NOT NULL,
+    rate_limit_remaining integer,
+    rate_limit_reset_at timestamp with time zone
+);
+
+COMMENT ON TABLE external_service_sync_stats IS 'Telemetry of the latest sync of each external service, used to show the health of code host connections.';
+COMMENT ON COLUMN external_service_sync_stats.started_at IS 'When the latest sync started.';
+COMMENT ON COLUMN external_service_sync_stats.finished_at IS 'When the latest sync finished, whether or not it succeeded.';
+COMMENT ON COLUMN external_service_sync_stats.last_success_at IS 'When the latest successful sync finished.';
+COMMENT ON COLUMN external_service_sync_stats.repos_sourced IS 'The number of repos the latest sync listed from the code host.';
+COMMENT ON COLUMN external_service_sync_stats.error IS 'The error of the latest sync, if it failed.';
+COMMENT ON COLUMN external_service_sync_stats.error_class IS 'The class of the error of the latest sync, e.g. unauthorized or rate_limited.';
+COMMENT ON COLUMN external_service_sync_stats.consecutive_failures IS 'The number of syncs that failed since the latest successful sync.';
+COMMENT ON COLUMN external_service_sync_stats.error_counts IS 'The number of failed syncs by error class.';
+COMMENT ON COLUMN external_service_sync_stats.rate_limit_remaining IS 'The remaining code host API rate limit reported at the end of the latest sync, if known.';
+COMMENT ON COLUMN external_service_sync_stats.rate_limit_reset_at IS 'When the code host API rate limit resets, if known.';
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+DROP INDEX IF EXISTS repo_topics_idx;
+
+ALTER TABLE repo DROP COLUMN IF EXISTS topics;
+ALTER TABLE repo DROP COLUMN IF EXISTS primary_language;
+ALTER TABLE repo DROP COLUMN IF EXISTS license;
+ALTER TABLE repo DROP COLUMN IF EXISTS default_branch_protected;
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+
+ALTER TABLE repo ADD COLUMN IF NOT EXISTS topics text[] DEFAULT '{}'::text[] NOT NULL;
+ALTER TABLE repo ADD COLUMN IF NOT EXISTS primary_language text;
+ALTER TABLE repo ADD COLUMN IF NOT EXISTS license text;
+ALTER TABLE repo ADD COLUMN IF NOT EXISTS default_branch_protected boolean DEFAULT false NOT NULL;
+
+CREATE INDEX IF NOT EXISTS repo_topics_idx ON repo USING GIN (topics);
+
+COMMENT ON COLUMN repo.topics IS 'The topics (or tags) of the repository on the code host.';
+COMMENT ON COLUMN repo.primary_language IS 'The primary language of the repository reported by the code host, if known.';
+COMMENT ON COLUMN repo.license IS 'The SPDX identifier of the license of the repository reported by the code host, if known.';
+COMMENT ON COLUMN repo.default_branch_protected IS 'Whether the default branch of the repository is protected on the code host.';
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS user_public_repos_refresh (
+    user_id integer PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    auto_refresh boolean DEFAULT true NOT NULL,
+    last_refreshed_at timestamp with time zone,
+    last_error text
+);
+
+COMMENT ON TABLE user_public_repos_refresh IS 'The state of the automatic refresh of the public repos of each user from their code host accounts.';
+COMMENT ON COLUMN user_public_repos_refresh.auto_refresh IS 'Whether the public repos of the user are refreshed automatically. Users can opt out by setting it to false.';
+COMMENT ON COLUMN user_public_repos_refresh.last_refreshed_at IS 'When the public repos of the user were last refreshed.';
+COMMENT ON COLUMN user_public_repos_refresh.last_error IS 'The error of the last refresh, if it failed.';
+
+COMMIT;
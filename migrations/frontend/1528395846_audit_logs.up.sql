@@ -0,0 +1,28 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS audit_logs (
+    id bigserial PRIMARY KEY,
+    actor_user_id integer,
+    action text NOT NULL,
+    subject_type text NOT NULL,
+    subject_id text NOT NULL,
+    ip text,
+    before jsonb,
+    after jsonb,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS audit_logs_created_at_idx ON audit_logs(created_at);
+CREATE INDEX IF NOT EXISTS audit_logs_actor_user_id_idx ON audit_logs(actor_user_id);
+CREATE INDEX IF NOT EXISTS audit_logs_subject_idx ON audit_logs(subject_type, subject_id);
+
+COMMENT ON TABLE audit_logs IS 'Records of security-relevant mutations, such as the creation of users and access tokens, and changes to permissions and external services.';
+COMMENT ON COLUMN audit_logs.actor_user_id IS 'The user who made the mutation. It is NULL for mutations made by Sourcegraph itself or by anonymous users, such as signing up. Users are not referenced by a foreign key so that records outlive them.';
+COMMENT ON COLUMN audit_logs.action IS 'The kind of the mutation, such as user.created.';
+COMMENT ON COLUMN audit_logs.subject_type IS 'The kind of the entity the mutation was made to, such as user.';
+COMMENT ON COLUMN audit_logs.subject_id IS 'The ID of the entity the mutation was made to.';
+COMMENT ON COLUMN audit_logs.ip IS 'The IP address of the client which made the mutation, if it was made in a request.';
+COMMENT ON COLUMN audit_logs.before IS 'The state of the entity before the mutation, with secrets redacted. It is NULL for creations.';
+COMMENT ON COLUMN audit_logs.after IS 'The state of the entity after the mutation, with secrets redacted. It is NULL for deletions.';
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS repo_collection_repos;
+DROP TABLE IF EXISTS repo_collections;
+
+COMMIT;
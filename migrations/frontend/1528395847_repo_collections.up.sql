@@ -0,0 +1,33 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS repo_collections (
+    id serial PRIMARY KEY,
+    name text NOT NULL,
+    description text DEFAULT ''::text NOT NULL,
+    user_id integer REFERENCES users(id) ON DELETE CASCADE DEFERRABLE,
+    org_id integer REFERENCES orgs(id) ON DELETE CASCADE DEFERRABLE,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT repo_collections_has_one_owner CHECK ((user_id IS NULL) <> (org_id IS NULL)),
+    CONSTRAINT repo_collections_name_valid_chars CHECK (name ~ '^[a-zA-Z0-9][a-zA-Z0-9._-]*$'),
+    CONSTRAINT repo_collections_name_max_length CHECK (char_length(name) <= 255)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS repo_collections_user_id_name ON repo_collections(user_id, name) WHERE user_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS repo_collections_org_id_name ON repo_collections(org_id, name) WHERE org_id IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS repo_collection_repos (
+    collection_id integer NOT NULL REFERENCES repo_collections(id) ON DELETE CASCADE,
+    repo_id integer NOT NULL REFERENCES repo(id) ON DELETE CASCADE,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    PRIMARY KEY (collection_id, repo_id)
+);
+
+CREATE INDEX IF NOT EXISTS repo_collection_repos_repo_id ON repo_collection_repos(repo_id);
+
+COMMENT ON TABLE repo_collections IS 'Named groups of repositories owned by a user or an org, which can be searched with repogroup:.';
+COMMENT ON COLUMN repo_collections.name IS 'The name of the collection, unique per owner. Collections of orgs are searched as repogroup:<org name>/<name>.';
+
+COMMENT ON TABLE repo_collection_repos IS 'The repositories in each repo collection.';
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS outbox_deliveries;
+DROP TABLE IF EXISTS outbox_subscriptions;
+DROP TABLE IF EXISTS outbox_events;
+
+COMMIT;
@@ -0,0 +1,37 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id bigserial PRIMARY KEY,
+    topic text NOT NULL,
+    subject_id text NOT NULL,
+    payload jsonb NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS outbox_events_topic_id_idx ON outbox_events(topic, id);
+CREATE INDEX IF NOT EXISTS outbox_events_created_at_idx ON outbox_events(created_at);
+
+COMMENT ON TABLE outbox_events IS 'Domain events, such as the creation of a repo, written in the same transaction as the mutation which caused them and delivered to in-process subscriptions by the outbox dispatcher.';
+COMMENT ON COLUMN outbox_events.topic IS 'The kind of the event, such as repo.created.';
+COMMENT ON COLUMN outbox_events.subject_id IS 'The ID of the entity the event is about.';
+COMMENT ON COLUMN outbox_events.payload IS 'The topic-specific data of the event.';
+
+CREATE TABLE IF NOT EXISTS outbox_subscriptions (
+    name text PRIMARY KEY,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+COMMENT ON TABLE outbox_subscriptions IS 'The subscriptions to outbox events. A subscription receives the events created after it.';
+
+CREATE TABLE IF NOT EXISTS outbox_deliveries (
+    subscription text NOT NULL REFERENCES outbox_subscriptions(name) ON DELETE CASCADE,
+    event_id bigint NOT NULL REFERENCES outbox_events(id) ON DELETE CASCADE,
+    delivered_at timestamp with time zone DEFAULT now() NOT NULL,
+    PRIMARY KEY (subscription, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS outbox_deliveries_event_id_idx ON outbox_deliveries(event_id);
+
+COMMENT ON TABLE outbox_deliveries IS 'Records which outbox events were successfully delivered to which subscription. Events without a delivery are retried.';
+
+COMMIT;
@@ -0,0 +1,22 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS quota_overrides (
+    id serial PRIMARY KEY,
+    quota text NOT NULL,
+    user_id integer REFERENCES users(id) ON DELETE CASCADE DEFERRABLE,
+    org_id integer REFERENCES orgs(id) ON DELETE CASCADE DEFERRABLE,
+    quota_limit bigint,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT quota_overrides_has_one_subject CHECK ((user_id IS NULL) <> (org_id IS NULL)),
+    CONSTRAINT quota_overrides_limit_not_negative CHECK (quota_limit >= 0)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS quota_overrides_user_id_quota ON quota_overrides(user_id, quota) WHERE user_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS quota_overrides_org_id_quota ON quota_overrides(org_id, quota) WHERE org_id IS NOT NULL;
+
+COMMENT ON TABLE quota_overrides IS 'Per-user and per-org overrides of the instance-wide usage quotas in the site configuration.';
+COMMENT ON COLUMN quota_overrides.quota IS 'The name of the quota, such as searches or lsif_upload_bytes.';
+COMMENT ON COLUMN quota_overrides.quota_limit IS 'The limit per quota window. NULL means unlimited.';
+
+COMMIT;
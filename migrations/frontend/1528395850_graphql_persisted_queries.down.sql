@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS graphql_persisted_queries;
+
+COMMIT;
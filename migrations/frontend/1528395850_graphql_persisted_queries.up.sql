@@ -0,0 +1,14 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS graphql_persisted_queries (
+    hash text PRIMARY KEY,
+    query text NOT NULL,
+    allowed boolean DEFAULT false NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+COMMENT ON TABLE graphql_persisted_queries IS 'GraphQL queries which clients can run by sending only their hash.';
+COMMENT ON COLUMN graphql_persisted_queries.hash IS 'The hex-encoded SHA-256 hash of the query.';
+COMMENT ON COLUMN graphql_persisted_queries.allowed IS 'Whether a site admin added the query to the allow-list. Only allowed queries run when the allow-list is enforced.';
+
+COMMIT;
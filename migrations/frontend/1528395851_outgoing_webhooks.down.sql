@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS outgoing_webhook_deliveries;
+DROP TABLE IF EXISTS outgoing_webhooks;
+
+COMMIT;
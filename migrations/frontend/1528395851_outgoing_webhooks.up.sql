@@ -0,0 +1,46 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS outgoing_webhooks (
+    id serial PRIMARY KEY,
+    url text NOT NULL,
+    secret text NOT NULL,
+    encryption_key_id text DEFAULT ''::text NOT NULL,
+    event_types text[] NOT NULL,
+    enabled boolean DEFAULT true NOT NULL,
+    created_by_user_id integer REFERENCES users(id) ON DELETE SET NULL DEFERRABLE,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+COMMENT ON TABLE outgoing_webhooks IS 'URLs registered by site admins to receive platform events.';
+COMMENT ON COLUMN outgoing_webhooks.secret IS 'The secret used to sign the payloads, encrypted with the external service key if one is configured.';
+COMMENT ON COLUMN outgoing_webhooks.event_types IS 'The types of the events delivered to the URL, such as repo:added.';
+
+CREATE TABLE IF NOT EXISTS outgoing_webhook_deliveries (
+    id serial PRIMARY KEY,
+    webhook_id integer NOT NULL REFERENCES outgoing_webhooks(id) ON DELETE CASCADE DEFERRABLE,
+    event_type text NOT NULL,
+    payload jsonb NOT NULL,
+    state text DEFAULT 'queued'::text NOT NULL,
+    failure_message text,
+    started_at timestamp with time zone,
+    finished_at timestamp with time zone,
+    process_after timestamp with time zone,
+    num_resets integer DEFAULT 0 NOT NULL,
+    num_failures integer DEFAULT 0 NOT NULL,
+    execution_logs json[],
+    attempts integer DEFAULT 0 NOT NULL,
+    response_status_code integer,
+    response_body text,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS outgoing_webhook_deliveries_state ON outgoing_webhook_deliveries(state);
+CREATE INDEX IF NOT EXISTS outgoing_webhook_deliveries_webhook_id ON outgoing_webhook_deliveries(webhook_id, id);
+
+COMMENT ON TABLE outgoing_webhook_deliveries IS 'The queue and log of the deliveries of events to outgoing webhooks.';
+COMMENT ON COLUMN outgoing_webhook_deliveries.attempts IS 'The number of times the delivery was attempted. Failed attempts are retried with exponential backoff.';
+COMMENT ON COLUMN outgoing_webhook_deliveries.response_status_code IS 'The HTTP status code of the response to the last attempt, if any.';
+COMMENT ON COLUMN outgoing_webhook_deliveries.response_body IS 'The start of the body of the response to the last attempt.';
+
+COMMIT;
@@ -0,0 +1,31 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS batch_spec_executions (
+    id bigserial PRIMARY KEY,
+    rand_id text NOT NULL,
+    state text DEFAULT 'queued'::text,
+    failure_message text,
+    started_at timestamp with time zone,
+    finished_at timestamp with time zone,
+    process_after timestamp with time zone,
+    num_resets integer NOT NULL DEFAULT 0,
+    num_failures integer NOT NULL DEFAULT 0,
+    execution_logs json[],
+    batch_spec text NOT NULL,
+    batch_spec_id bigint REFERENCES batch_specs(id) ON DELETE SET NULL DEFERRABLE,
+    user_id integer NOT NULL REFERENCES users(id) ON DELETE CASCADE DEFERRABLE,
+    namespace_user_id integer REFERENCES users(id) ON DELETE CASCADE DEFERRABLE,
+    namespace_org_id integer REFERENCES orgs(id) ON DELETE CASCADE DEFERRABLE,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    updated_at timestamp with time zone NOT NULL DEFAULT now(),
+
+    CONSTRAINT batch_spec_executions_has_1_namespace CHECK ((namespace_user_id IS NULL) <> (namespace_org_id IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS batch_spec_executions_rand_id ON batch_spec_executions(rand_id);
+
+COMMENT ON TABLE batch_spec_executions IS 'The queue of batch specs executed server-side by executors.';
+COMMENT ON COLUMN batch_spec_executions.batch_spec IS 'The raw batch spec submitted for execution.';
+COMMENT ON COLUMN batch_spec_executions.batch_spec_id IS 'The batch spec created by the execution, once it completed.';
+
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS sub_repo_permissions (
+    repo_id integer NOT NULL REFERENCES repo(id) ON DELETE CASCADE,
+    user_id integer NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    path_rules text[] NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    PRIMARY KEY (user_id, repo_id)
+);
+
+CREATE INDEX IF NOT EXISTS sub_repo_permissions_repo_id ON sub_repo_permissions(repo_id);
+
+COMMENT ON TABLE sub_repo_permissions IS 'Responsible for storing permissions at a finer granularity than repo';
+COMMENT ON COLUMN sub_repo_permissions.path_rules IS 'Glob patterns of the paths of the repository the user can access, in order. Patterns starting with "-" revoke access and the last matching pattern wins.';
+
+COMMIT;
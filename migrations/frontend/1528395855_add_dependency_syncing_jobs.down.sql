@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS lsif_dependency_syncing_jobs;
+
+COMMIT;
@@ -0,0 +1,26 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS lsif_dependency_syncing_jobs (
+    id serial PRIMARY KEY,
+    state text DEFAULT 'queued' NOT NULL,
+    failure_message text,
+    queued_at timestamp with time zone DEFAULT NOW() NOT NULL,
+    started_at timestamp with time zone,
+    finished_at timestamp with time zone,
+    process_after timestamp with time zone,
+    num_resets integer DEFAULT 0 NOT NULL,
+    num_failures integer DEFAULT 0 NOT NULL,
+    execution_logs json[],
+    scheme text NOT NULL,
+    name text NOT NULL,
+    version text NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS lsif_dependency_syncing_jobs_scheme_name_version ON lsif_dependency_syncing_jobs(scheme, name, version);
+
+COMMENT ON TABLE lsif_dependency_syncing_jobs IS 'Tracks jobs that clone and auto-index the repository of a package referenced by a precise code intelligence query but not yet indexed on the instance.';
+COMMENT ON COLUMN lsif_dependency_syncing_jobs.scheme IS 'The scheme of the package moniker, e.g. gomod.';
+COMMENT ON COLUMN lsif_dependency_syncing_jobs.name IS 'The name of the package.';
+COMMENT ON COLUMN lsif_dependency_syncing_jobs.version IS 'The version of the package.';
+
+COMMIT;
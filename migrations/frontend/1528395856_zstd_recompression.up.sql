@@ -0,0 +1,19 @@
+BEGIN;
+
+INSERT INTO out_of_band_migrations (id, team, component, description, introduced_version_major, introduced_version_minor, non_destructive, is_enterprise)
+VALUES (11, 'code-intelligence', 'codeintel-db.lsif_data_documents', 'Recompress document payloads with zstd', 3, 29, false, true)
+ON CONFLICT DO NOTHING;
+
+INSERT INTO out_of_band_migrations (id, team, component, description, introduced_version_major, introduced_version_minor, non_destructive, is_enterprise)
+VALUES (12, 'code-intelligence', 'codeintel-db.lsif_data_result_chunks', 'Recompress result chunk payloads with zstd', 3, 29, false, true)
+ON CONFLICT DO NOTHING;
+
+INSERT INTO out_of_band_migrations (id, team, component, description, introduced_version_major, introduced_version_minor, non_destructive, is_enterprise)
+VALUES (13, 'code-intelligence', 'codeintel-db.lsif_data_definitions', 'Recompress definition payloads with zstd', 3, 29, false, true)
+ON CONFLICT DO NOTHING;
+
+INSERT INTO out_of_band_migrations (id, team, component, description, introduced_version_major, introduced_version_minor, non_destructive, is_enterprise)
+VALUES (14, 'code-intelligence', 'codeintel-db.lsif_data_references', 'Recompress reference payloads with zstd', 3, 29, false, true)
+ON CONFLICT DO NOTHING;
+
+COMMIT;
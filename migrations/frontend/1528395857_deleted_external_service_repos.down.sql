@@ -0,0 +1,21 @@
+BEGIN;
+
+CREATE OR REPLACE FUNCTION delete_repo_ref_on_external_service_repos() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+    BEGIN
+        -- if a repo is soft-deleted, delete every row that references that repo
+        IF (OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL) THEN
+        DELETE FROM
+            external_service_repos
+        WHERE
+            repo_id = OLD.id;
+        END IF;
+
+        RETURN OLD;
+    END;
+$$;
+
+DROP TABLE IF EXISTS deleted_external_service_repos;
+
+COMMIT;
@@ -0,0 +1,36 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS deleted_external_service_repos (
+    external_service_id bigint NOT NULL REFERENCES external_services(id) ON DELETE CASCADE DEFERRABLE,
+    repo_id integer NOT NULL REFERENCES repo(id) ON DELETE CASCADE DEFERRABLE,
+    clone_url text NOT NULL,
+    user_id integer REFERENCES users(id) ON DELETE CASCADE DEFERRABLE,
+    PRIMARY KEY (repo_id, external_service_id)
+);
+
+COMMENT ON TABLE deleted_external_service_repos IS 'The external_service_repos rows of soft-deleted repos, kept so that the repos can be restored with their sources.';
+
+CREATE OR REPLACE FUNCTION delete_repo_ref_on_external_service_repos() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+    BEGIN
+        -- if a repo is soft-deleted, move every row that references that repo
+        -- to deleted_external_service_repos
+        IF (OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL) THEN
+        INSERT INTO deleted_external_service_repos (external_service_id, repo_id, clone_url, user_id)
+            SELECT external_service_id, repo_id, clone_url, user_id
+            FROM external_service_repos
+            WHERE repo_id = OLD.id
+        ON CONFLICT DO NOTHING;
+
+        DELETE FROM
+            external_service_repos
+        WHERE
+            repo_id = OLD.id;
+        END IF;
+
+        RETURN OLD;
+    END;
+$$;
+
+COMMIT;
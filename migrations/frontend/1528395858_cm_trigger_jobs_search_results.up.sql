@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE cm_trigger_jobs ADD COLUMN IF NOT EXISTS search_results jsonb;
+
+COMMENT ON COLUMN cm_trigger_jobs.search_results IS 'A summary of the first search results of the run, included in the notifications of webhook and Slack webhook actions';
+
+COMMIT;
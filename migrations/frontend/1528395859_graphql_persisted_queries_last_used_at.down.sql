@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX IF EXISTS graphql_persisted_queries_last_used_at;
+
+ALTER TABLE graphql_persisted_queries DROP COLUMN IF EXISTS last_used_at;
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE graphql_persisted_queries ADD COLUMN IF NOT EXISTS last_used_at timestamp with time zone DEFAULT now() NOT NULL;
+
+CREATE INDEX IF NOT EXISTS graphql_persisted_queries_last_used_at ON graphql_persisted_queries (last_used_at) WHERE NOT allowed;
+
+COMMENT ON COLUMN graphql_persisted_queries.last_used_at IS 'When the query was last run by its hash, updated at most hourly. Queries which are not on the allow-list are evicted in this order once there are too many.';
+
+COMMIT;
@@ -156,6 +156,44 @@ func Worker() *monitoring.Container {
 							PossibleSolutions: "none",
 						},
 					},
+					{
+						{
+							Name:           "codeintel_orphaned_uploads_purged",
+							Description:    "data for uploads without a live upload record removed every 5m",
+							Query:          `sum(increase(src_codeintel_background_orphaned_uploads_purged_total{job=~"worker"}[5m]))`,
+							NoAlert:        true,
+							Panel:          monitoring.Panel().LegendFormat("uploads purged"),
+							Owner:          monitoring.ObservableOwnerCodeIntel,
+							Interpretation: "none",
+						},
+						{
+							Name:           "codeintel_orphaned_rows_removed",
+							Description:    "orphaned codeintel database rows removed every 5m",
+							Query:          `sum(increase(src_codeintel_background_orphaned_rows_removed_total{job=~"worker"}[5m]))`,
+							NoAlert:        true,
+							Panel:          monitoring.Panel().LegendFormat("rows removed"),
+							Owner:          monitoring.ObservableOwnerCodeIntel,
+							Interpretation: "none",
+						},
+						{
+							Name:           "codeintel_orphaned_bytes_reclaimed",
+							Description:    "orphaned codeintel database bytes reclaimed every 5m",
+							Query:          `sum(increase(src_codeintel_background_orphaned_bytes_reclaimed_total{job=~"worker"}[5m]))`,
+							NoAlert:        true,
+							Panel:          monitoring.Panel().LegendFormat("bytes reclaimed").Unit(monitoring.Bytes),
+							Owner:          monitoring.ObservableOwnerCodeIntel,
+							Interpretation: "none",
+						},
+						{
+							Name:           "codeintel_stuck_uploads_failed",
+							Description:    "upload records errored after processing too long every 5m",
+							Query:          `sum(increase(src_codeintel_background_stuck_uploads_failed_total{job=~"worker"}[5m]))`,
+							NoAlert:        true,
+							Panel:          monitoring.Panel().LegendFormat("uploads"),
+							Owner:          monitoring.ObservableOwnerCodeIntel,
+							Interpretation: "none",
+						},
+					},
 				},
 			},
 			{
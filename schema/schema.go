@@ -60,6 +60,20 @@ type AdditionalProperties struct {
 	Value string `json:"value"`
 }
 
+// ApiPersistedQueries description: Persisted GraphQL queries, which clients can run by sending only the SHA-256 hash of the query. Authenticated clients register a query by sending it together with its hash. Site admins add queries to the allow-list with the allowPersistedQuery GraphQL mutation.
+type ApiPersistedQueries struct {
+	// EnforceAllowList description: If true, only queries on the allow-list can be run by users other than site admins. Enforcing the allow-list breaks all clients (including the Sourcegraph web app for regular users) whose queries are not on the allow-list.
+	EnforceAllowList bool `json:"enforceAllowList,omitempty"`
+}
+
+// ApiQueryCostLimit description: Limits on the estimated cost of GraphQL API requests. Requests over a limit, and requests whose cost can't be estimated, are rejected before they run. The cost of a request is the worst-case number of fields in its result, where the fields of a list are multiplied by the requested page size (the first or last argument). Requests made by Sourcegraph services are exempt.
+type ApiQueryCostLimit struct {
+	// MaxCost description: The maximum cost of a request. 0 means no limit.
+	MaxCost int `json:"maxCost,omitempty"`
+	// MaxDepth description: The maximum nesting depth of a request. 0 means no limit.
+	MaxDepth int `json:"maxDepth,omitempty"`
+}
+
 // ApiRatelimit description: Configuration for API rate limiting
 type ApiRatelimit struct {
 	// Enabled description: Whether API rate limiting is enabled
@@ -137,6 +151,21 @@ func (v *AuthProviders) UnmarshalJSON(data []byte) error {
 	return fmt.Errorf("tagged union type must have a %q property whose value is one of %s", "type", []string{"builtin", "saml", "openidconnect", "http-header", "github", "gitlab"})
 }
 
+// AuthScim description: Settings for the SCIM 2.0 provisioning API at /.api/scim/v2, which enables identity providers such as Okta and Azure AD to create, update and deactivate users and orgs (as SCIM groups). The API is disabled unless a token is set.
+type AuthScim struct {
+	// AuthToken description: The bearer token which the identity provider must send in the Authorization header of SCIM requests. Use a long random string.
+	AuthToken string `json:"authToken"`
+}
+
+// Badges description: Settings for the repository badges served at /.api/badges/REPOSITORY/-/BADGE.svg, which can be embedded in READMEs to show the number of results of a saved search in a repository, its precise code intelligence coverage or the age of its most recent code intelligence upload.
+type Badges struct {
+	// Enabled description: Enables the badges endpoint.
+	Enabled bool `json:"enabled,omitempty"`
+	// Public description: Allows anonymous users to fetch badges, for example from READMEs rendered by a code host. Badges requested anonymously are only served for public repositories and for the saved searches listed in shareableSavedSearches.
+	Public bool `json:"public,omitempty"`
+	// ShareableSavedSearches description: The GraphQL IDs of the saved searches whose search result badges may be fetched by anyone who can fetch badges, not just by the owner of the saved search. The saved search is still run with the permissions of the requesting user.
+	ShareableSavedSearches []string `json:"shareableSavedSearches,omitempty"`
+}
 type BatchChangeRolloutWindow struct {
 	// Days description: Day(s) the window applies to. If omitted, this rule applies to all days of the week.
 	Days []string `json:"days,omitempty"`
@@ -168,12 +197,18 @@ type BatchSpec struct {
 	Workspaces []*WorkspaceConfiguration `json:"workspaces,omitempty"`
 }
 
+// BitbucketCloudAuthorization description: If non-null, enforces Bitbucket Cloud repository permissions. Permissions are read from the workspaces listed in "teams", so the "username" must belong to an administrator of each of them. Sourcegraph assumes usernames are identical to the nicknames of Bitbucket Cloud workspace members, and `auth.enableUsernameChanges` must be set to false for security reasons.
+type BitbucketCloudAuthorization struct {
+}
+
 // BitbucketCloudConnection description: Configuration for a connection to Bitbucket Cloud.
 type BitbucketCloudConnection struct {
 	// ApiURL description: The API URL of Bitbucket Cloud, such as https://api.bitbucket.org. Generally, admin should not modify the value of this option because Bitbucket Cloud is a public hosting platform.
 	ApiURL string `json:"apiURL,omitempty"`
 	// AppPassword description: The app password to use when authenticating to the Bitbucket Cloud. Also set the corresponding "username" field.
 	AppPassword string `json:"appPassword"`
+	// Authorization description: If non-null, enforces Bitbucket Cloud repository permissions. Permissions are read from the workspaces listed in "teams", so the "username" must belong to an administrator of each of them. Sourcegraph assumes usernames are identical to the nicknames of Bitbucket Cloud workspace members, and `auth.enableUsernameChanges` must be set to false for security reasons.
+	Authorization *BitbucketCloudAuthorization `json:"authorization,omitempty"`
 	// Exclude description: A list of repositories to never mirror from Bitbucket Cloud. Takes precedence over "teams" configuration.
 	//
 	// Supports excluding by name ({"name": "myorg/myrepo"}) or by UUID ({"uuid": "{fceb73c7-cef6-4abe-956d-e471281126bd}"}).
@@ -200,6 +235,8 @@ type BitbucketCloudConnection struct {
 	Url string `json:"url"`
 	// Username description: The username to use when authenticating to the Bitbucket Cloud. Also set the corresponding "appPassword" field.
 	Username string `json:"username"`
+	// Webhooks description: An array of configurations defining existing Bitbucket Cloud webhooks that send updates back to Sourcegraph.
+	Webhooks []*BitbucketCloudWebhook `json:"webhooks,omitempty"`
 }
 
 // BitbucketCloudRateLimit description: Rate limit applied when making background API requests to Bitbucket Cloud.
@@ -209,6 +246,10 @@ type BitbucketCloudRateLimit struct {
 	// RequestsPerHour description: Requests per hour permitted. This is an average, calculated per second. Internally, the burst limit is set to 500, which implies that for a requests per hour limit as low as 1, users will continue to be able to send a maximum of 500 requests immediately, provided that the complexity cost of each request is 1.
 	RequestsPerHour float64 `json:"requestsPerHour"`
 }
+type BitbucketCloudWebhook struct {
+	// Secret description: The secret used when creating the webhook
+	Secret string `json:"secret"`
+}
 
 // BitbucketServerAuthorization description: If non-null, enforces Bitbucket Server repository permissions.
 type BitbucketServerAuthorization struct {
@@ -374,6 +415,15 @@ type ChangesetTemplate struct {
 	Title string `json:"title"`
 }
 
+type CloneStrategyRule struct {
+	// Depth description: The number of commits of each ref to clone when strategy is "shallow".
+	Depth int `json:"depth,omitempty"`
+	// Pattern description: A regular expression matching a repo name
+	Pattern string `json:"pattern"`
+	// Strategy description: How to clone the repo. "full" clones all history, "shallow" clones only the most recent `depth` commits of each ref, and "blobless" clones all commits and trees but fetches file contents on demand. History of shallow clones is deepened on demand when a missing commit is requested.
+	Strategy string `json:"strategy"`
+}
+
 // CloneURLToRepositoryName description: Describes a mapping from clone URL to repository name. The `from` field contains a regular expression with named capturing groups. The `to` field contains a template string that references capturing group names. For instance, if `from` is "^../(?P<name>\w+)$" and `to` is "github.com/user/{name}", the clone URL "../myRepository" would be mapped to the repository name "github.com/user/myRepository".
 type CloneURLToRepositoryName struct {
 	// From description: A regular expression that matches a set of clone URLs. The regular expression should use the Go regular expression syntax (https://golang.org/pkg/regexp/) and contain at least one named capturing group. The regular expression matches partially by default, so use "^...$" if whole-string matching is desired.
@@ -533,6 +583,8 @@ type ExperimentalFeatures struct {
 	EnablePostSignupFlow bool `json:"enablePostSignupFlow,omitempty"`
 	// EventLogging description: Enables user event logging inside of the Sourcegraph instance. This will allow admins to have greater visibility of user activity, such as frequently viewed pages, frequent searches, and more. These event logs (and any specific user actions) are only stored locally, and never leave this Sourcegraph instance.
 	EventLogging string `json:"eventLogging,omitempty"`
+	// GitServerRebalancing description: Places repositories on gitserver shards by rendezvous hashing, and moves existing repositories to their new shard when gitserver replicas are added. Repositories are copied from the shard they are cloned on, which keeps serving them until the copy is complete.
+	GitServerRebalancing string `json:"gitServerRebalancing,omitempty"`
 	// Perforce description: Allow adding Perforce code host connections
 	Perforce string `json:"perforce,omitempty"`
 	// Ranking description: Experimental search result ranking options.
@@ -856,8 +908,8 @@ type Insight struct {
 type InsightSeries struct {
 	// Label description: The label to use for the series in the graph.
 	Label string `json:"label"`
-	// RepositoriesList description: Performs a search query and shows the number of results returned.
-	RepositoriesList []interface{} `json:"repositoriesList,omitempty"`
+	// RepositoriesList description: The names of the repositories the search query runs against, such as "github.com/sourcegraph/sourcegraph". The search query runs against all repositories if empty.
+	RepositoriesList []string `json:"repositoriesList,omitempty"`
 	// Search description: Performs a search query and shows the number of results returned.
 	Search string `json:"search,omitempty"`
 	// Webhook description: (not yet supported) Fetch data from a webhook URL.
@@ -1077,7 +1129,7 @@ type PerforceAuthorization struct {
 type PerforceConnection struct {
 	// Authorization description: If non-null, enforces Perforce depot permissions.
 	Authorization *PerforceAuthorization `json:"authorization,omitempty"`
-	// Depots description: Depots can have arbitrary paths, e.g. a path to depot root or a subdirectory.
+	// Depots description: Depots can have arbitrary paths, e.g. a path to depot root or a subdirectory. If empty, all local depots of the Perforce Server are discovered and synced.
 	Depots []string `json:"depots,omitempty"`
 	// MaxChanges description: Only import at most n changes when possible (git p4 clone --max-changes).
 	MaxChanges float64 `json:"maxChanges,omitempty"`
@@ -1130,6 +1182,16 @@ type PhabricatorConnection struct {
 	// Url description: URL of a Phabricator instance, such as https://phabricator.example.com
 	Url string `json:"url,omitempty"`
 }
+
+// Quotas description: Instance-wide usage quotas, counted per user. Requests over a quota are rejected with HTTP 429 until the current quota window ends. A quota which is not set is unlimited. Site admins can override the limits for users and organizations with the setQuotaOverride GraphQL mutation.
+type Quotas struct {
+	// ApiCallsPerTokenPerHour description: The number of API requests which can be made with an access token per hour.
+	ApiCallsPerTokenPerHour int `json:"apiCallsPerTokenPerHour,omitempty"`
+	// LsifUploadBytesPerDay description: The number of bytes of LSIF uploads a user can send per day.
+	LsifUploadBytesPerDay int `json:"lsifUploadBytesPerDay,omitempty"`
+	// SearchesPerMinute description: The number of searches a user can run per minute.
+	SearchesPerMinute int `json:"searchesPerMinute,omitempty"`
+}
 type QuickLink struct {
 	// Description description: A description for this quick link
 	Description string `json:"description,omitempty"`
@@ -1141,6 +1203,8 @@ type QuickLink struct {
 
 // Ranking description: Experimental search result ranking options.
 type Ranking struct {
+	// RankResults description: Whether search results are ranked by the star count of their repository, how recently the searched revision was committed to, and the depth of their path when the query does not contain rank:yes or rank:no. Otherwise, results are sorted by repository and path name.
+	RankResults bool `json:"rankResults,omitempty"`
 	// RepoScores description: a map of URI directories to numeric scores for specifying search result importance, like {"github.com": 500, "github.com/sourcegraph": 300, "github.com/sourcegraph/sourcegraph": 100}. Would rank "github.com/sourcegraph/sourcegraph" as 500+300+100=900, and "github.com/other/foo" as 500.
 	RepoScores map[string]float64 `json:"repoScores,omitempty"`
 }
@@ -1286,8 +1350,12 @@ type Settings struct {
 	SearchContextLines int `json:"search.contextLines,omitempty"`
 	// SearchDefaultCaseSensitive description: Whether query patterns are treated case sensitively. Patterns are case insensitive by default.
 	SearchDefaultCaseSensitive bool `json:"search.defaultCaseSensitive,omitempty"`
+	// SearchDefaultCount description: The maximum number of results of search queries that do not specify a `count:`. Defaults to 500 for streaming searches, and 30 otherwise.
+	SearchDefaultCount int `json:"search.defaultCount,omitempty"`
 	// SearchDefaultPatternType description: The default pattern type (literal or regexp) that search queries will be intepreted as.
 	SearchDefaultPatternType string `json:"search.defaultPatternType,omitempty"`
+	// SearchDefaultTimeoutSeconds description: The timeout in seconds of search queries that do not specify a `timeout:`. It cannot exceed the `search.limits.maxTimeoutSeconds` site configuration. Default is 20.
+	SearchDefaultTimeoutSeconds int `json:"search.defaultTimeoutSeconds,omitempty"`
 	// SearchGlobbing description: Enables globbing for supported field values
 	SearchGlobbing *bool `json:"search.globbing,omitempty"`
 	// SearchHideSuggestions description: Disable search suggestions below the search bar when constructing queries. Defaults to false.
@@ -1354,6 +1422,10 @@ type SettingsExperimentalFeatures struct {
 
 // SiteConfiguration description: Configuration for a Sourcegraph site.
 type SiteConfiguration struct {
+	// ApiPersistedQueries description: Persisted GraphQL queries, which clients can run by sending only the SHA-256 hash of the query. Authenticated clients register a query by sending it together with its hash. Site admins add queries to the allow-list with the allowPersistedQuery GraphQL mutation.
+	ApiPersistedQueries *ApiPersistedQueries `json:"api.persistedQueries,omitempty"`
+	// ApiQueryCostLimit description: Limits on the estimated cost of GraphQL API requests. Requests over a limit, and requests whose cost can't be estimated, are rejected before they run. The cost of a request is the worst-case number of fields in its result, where the fields of a list are multiplied by the requested page size (the first or last argument). Requests made by Sourcegraph services are exempt.
+	ApiQueryCostLimit *ApiQueryCostLimit `json:"api.queryCostLimit,omitempty"`
 	// ApiRatelimit description: Configuration for API rate limiting
 	ApiRatelimit *ApiRatelimit `json:"api.ratelimit,omitempty"`
 	// AuthAccessTokens description: Settings for access tokens, which enable external tools to access the Sourcegraph API with the privileges of the user.
@@ -1368,6 +1440,8 @@ type SiteConfiguration struct {
 	AuthProviders []AuthProviders `json:"auth.providers,omitempty"`
 	// AuthPublic description: WARNING: This option has been removed as of 3.8.
 	AuthPublic bool `json:"auth.public,omitempty"`
+	// AuthScim description: Settings for the SCIM 2.0 provisioning API at /.api/scim/v2, which enables identity providers such as Okta and Azure AD to create, update and deactivate users and orgs (as SCIM groups). The API is disabled unless a token is set.
+	AuthScim *AuthScim `json:"auth.scim,omitempty"`
 	// AuthSessionExpiry description: The duration of a user session, after which it expires and the user is required to re-authenticate. The default is 90 days. There is typically no need to set this, but some users may have specific internal security requirements.
 	//
 	// The string format is that of the Duration type in the Go time package (https://golang.org/pkg/time/#ParseDuration). E.g., "720h", "43200m", "2592000s" all indicate a timespan of 30 days.
@@ -1386,6 +1460,8 @@ type SiteConfiguration struct {
 	AuthUserOrgMap map[string][]string `json:"auth.userOrgMap,omitempty"`
 	// AuthzEnforceForSiteAdmins description: When true, site admins will only be able to see private code they have access to via our authz system.
 	AuthzEnforceForSiteAdmins bool `json:"authz.enforceForSiteAdmins,omitempty"`
+	// Badges description: Settings for the repository badges served at /.api/badges/REPOSITORY/-/BADGE.svg, which can be embedded in READMEs to show the number of results of a saved search in a repository, its precise code intelligence coverage or the age of its most recent code intelligence upload.
+	Badges *Badges `json:"badges,omitempty"`
 	// BatchChangesEnabled description: Enables/disables the Batch Changes feature.
 	BatchChangesEnabled *bool `json:"batchChanges.enabled,omitempty"`
 	// BatchChangesRestrictToAdmins description: When enabled, only site admins can create and apply batch changes.
@@ -1400,6 +1476,18 @@ type SiteConfiguration struct {
 	CampaignsEnabled *bool `json:"campaigns.enabled,omitempty"`
 	// CampaignsRestrictToAdmins description: DEPRECATED: Use batchChanges.restrictToAdmins instead. When enabled, only site admins can create and apply campaigns.
 	CampaignsRestrictToAdmins *bool `json:"campaigns.restrictToAdmins,omitempty"`
+	// CodeIntelDatabaseShards description: Connection strings of additional Postgres databases across which precise code intelligence data is sharded. The codeintel database is always the first shard and these databases follow it in order. The data of each upload is held by the shard whose position is the upload ID modulo the total number of shards. Changing this list requires a restart of all services, and existing data must be moved to its new shard with the codeintel-rebalance command.
+	CodeIntelDatabaseShards []string `json:"codeIntel.databaseShards,omitempty"`
+	// CodeIntelInterpolationWindow description: The maximum number of commits walked in each direction from a commit without precise code intelligence data to find the nearest indexed commits. Results from the nearest indexes on both sides are blended, preferring indexes whose files differ least from the requested commit. When unset, only the nearest ancestors are used.
+	CodeIntelInterpolationWindow int `json:"codeIntel.interpolationWindow,omitempty"`
+	// CodeIntelMaximumIndexesPerMonikerSearch description: The maximum number of remote indexes searched at once when resolving cross-repository references to a symbol.
+	CodeIntelMaximumIndexesPerMonikerSearch int `json:"codeIntel.maximumIndexesPerMonikerSearch,omitempty"`
+	// CodeIntelMonikerLimit description: The maximum number of monikers attached to a symbol that are used to search other indexes for its definitions and references.
+	CodeIntelMonikerLimit int `json:"codeIntel.monikerLimit,omitempty"`
+	// CodeIntelSearchBasedFallback description: Supplements precise code intelligence with search-based definitions and references when precise results are missing or incomplete, including for files not covered by any upload. Search-based results are marked as imprecise and listed after precise results.
+	CodeIntelSearchBasedFallback bool `json:"codeIntel.searchBasedFallback,omitempty"`
+	// CodeIntelSlowRequestThresholds description: Overrides the duration after which a precise code intelligence request is logged as slow, keyed by the name of the request (e.g. "References"). The string format is that of the Duration type in the Go time package (https://golang.org/pkg/time/#ParseDuration).
+	CodeIntelSlowRequestThresholds map[string]string `json:"codeIntel.slowRequestThresholds,omitempty"`
 	// CodeIntelAutoIndexingEnabled description: Enables/disables the code intel auto indexing feature.
 	CodeIntelAutoIndexingEnabled *bool `json:"codeIntelAutoIndexing.enabled,omitempty"`
 	// CorsOrigin description: Required when using any of the native code host integrations for Phabricator, GitLab, or Bitbucket Server. It is a space-separated list of allowed origins for cross-origin HTTP requests which should be the base URL for your Phabricator, GitLab, or Bitbucket Server instance.
@@ -1434,6 +1522,8 @@ type SiteConfiguration struct {
 	ExternalServiceUserMode string `json:"externalService.userMode,omitempty"`
 	// ExternalURL description: The externally accessible URL for Sourcegraph (i.e., what you type into your browser). Previously called `appURL`. Only root URLs are allowed.
 	ExternalURL string `json:"externalURL,omitempty"`
+	// GitCloneStrategy description: JSON array of repo name patterns and clone strategies. If a repo matches a pattern, the associated strategy is used when cloning and fetching it. If it matches no patterns it is fully cloned. Pattern matches are attempted in the order they are provided. Changing the strategy of a repo takes effect when it is next re-cloned, except that shallow clones are completed by the next fetch when changed to full.
+	GitCloneStrategy []*CloneStrategyRule `json:"gitCloneStrategy,omitempty"`
 	// GitCloneURLToRepositoryName description: JSON array of configuration that maps from Git clone URL to repository name. Sourcegraph automatically resolves remote clone URLs to their proper code host. However, there may be non-remote clone URLs (e.g., in submodule declarations) that Sourcegraph cannot automatically map to a code host. In this case, use this field to specify the mapping. The mappings are tried in the order they are specified and take precedence over automatic mappings.
 	GitCloneURLToRepositoryName []*CloneURLToRepositoryName `json:"git.cloneURLToRepositoryName,omitempty"`
 	// GitMaxCodehostRequestsPerSecond description: Maximum number of remote code host git operations (e.g. clone or ls-remote) to be run per second per gitserver. Default is -1, which is unlimited.
@@ -1490,10 +1580,14 @@ type SiteConfiguration struct {
 	PermissionsUserMapping *PermissionsUserMapping `json:"permissions.userMapping,omitempty"`
 	// ProductResearchPageEnabled description: Enables users access to the product research page in their settings.
 	ProductResearchPageEnabled *bool `json:"productResearchPage.enabled,omitempty"`
+	// Quotas description: Instance-wide usage quotas, counted per user. Requests over a quota are rejected with HTTP 429 until the current quota window ends. A quota which is not set is unlimited. Site admins can override the limits for users and organizations with the setQuotaOverride GraphQL mutation.
+	Quotas *Quotas `json:"quotas,omitempty"`
 	// RepoConcurrentExternalServiceSyncers description: The number of concurrent external service syncers that can run.
 	RepoConcurrentExternalServiceSyncers int `json:"repoConcurrentExternalServiceSyncers,omitempty"`
 	// RepoListUpdateInterval description: Interval (in minutes) for checking code hosts (such as GitHub, Gitolite, etc.) for new repositories.
 	RepoListUpdateInterval int `json:"repoListUpdateInterval,omitempty"`
+	// RepoUpdateWebhookSecret description: The secret that authenticates generic webhook requests to repo-updater, which update repositories without waiting for the next update interval. Requests must send it in an `Authorization: token <secret>` header. Generic webhooks are disabled if unset. Code host webhooks are authenticated with the webhook secrets of their external service instead.
+	RepoUpdateWebhookSecret string `json:"repoUpdateWebhookSecret,omitempty"`
 	// SearchIndexEnabled description: Whether indexed search is enabled. If unset Sourcegraph detects the environment to decide if indexed search is enabled. Indexed search is RAM heavy, and is disabled by default in the single docker image. All other environments will have it enabled by default. The size of all your repository working copies is the amount of additional RAM required.
 	SearchIndexEnabled *bool `json:"search.index.enabled,omitempty"`
 	// SearchIndexSymbolsEnabled description: Whether indexed symbol search is enabled. This is contingent on the indexed search configuration, and is true by default for instances with indexed search enabled. Enabling this will cause every repository to re-index, which is a time consuming (several hours) operation. Additionally, it requires more storage and ram to accommodate the added symbols information in the search index.